@@ -181,6 +181,8 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		WithBlockGasMeter(gasMeter).
 		WithHeaderHash(req.Hash)
 
+	app.signedQueries.recordBlockHash(req.Header.Height, req.Hash)
+
 	// we also set block gas meter to checkState in case the application needs to
 	// verify gas consumption during (Re)CheckTx
 	if app.checkState != nil {
@@ -189,9 +191,15 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 			WithHeaderHash(req.Hash)
 	}
 
+	if app.blockReportEnabled {
+		app.blockReport = &sdk.EventBlockReport{Height: req.Header.Height}
+	}
+
 	if app.beginBlocker != nil {
-		res = app.beginBlocker(app.deliverState.ctx, req)
+		spanCtx, span := telemetry.StartSpan(app.deliverState.ctx.Context(), telemetry.SpanNameBeginBlock, telemetry.HeightAttr(req.Header.Height))
+		res = app.beginBlocker(app.deliverState.ctx.WithContext(spanCtx), req)
 		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
+		span.End()
 	}
 	// set the signed validators for addition to context in deliverTx
 	app.voteInfos = req.LastCommitInfo.GetVotes()
@@ -207,8 +215,19 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 	}
 
 	if app.endBlocker != nil {
-		res = app.endBlocker(app.deliverState.ctx, req)
+		spanCtx, span := telemetry.StartSpan(app.deliverState.ctx.Context(), telemetry.SpanNameEndBlock, telemetry.HeightAttr(req.Height))
+		ctx := app.deliverState.ctx.WithContext(spanCtx)
+		if app.blockReport != nil {
+			ctx = sdk.ContextWithBlockReport(ctx, app.blockReport)
+		}
+		res = app.endBlocker(ctx, req)
 		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
+		span.End()
+	}
+
+	if app.blockReport != nil {
+		res.Events = append(res.Events, app.blockReportEvents()...)
+		app.blockReport = nil
 	}
 
 	if cp := app.GetConsensusParams(app.deliverState.ctx); cp != nil {
@@ -218,6 +237,18 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 	return res
 }
 
+// blockReportEvents returns the EventBlockReport of the block being executed,
+// marked for indexing.
+func (app *BaseApp) blockReportEvents() []abci.Event {
+	event, err := sdk.TypedEventToEvent(app.blockReport)
+	if err != nil {
+		app.logger.Error("failed to emit the block report", "height", app.blockReport.Height, "err", err)
+		return nil
+	}
+
+	return sdk.MarkEventsToIndex([]abci.Event{abci.Event(event)}, app.indexEvents)
+}
+
 // CheckTx implements the ABCI interface and executes a tx in CheckTx mode. In
 // CheckTx mode, messages are not executed. This means messages are only validated
 // and only the AnteHandler is executed. State is persisted to the BaseApp's
@@ -231,6 +262,11 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 
 	switch {
 	case req.Type == abci.CheckTxType_New:
+		// a draining node keeps rechecking its mempool but accepts no new txs
+		if app.IsDraining() {
+			return sdkerrors.ResponseCheckTx(sdkerrors.ErrNodeDraining, 0, 0, app.trace)
+		}
+
 		mode = runTxModeCheck
 
 	case req.Type == abci.CheckTxType_Recheck:
@@ -259,16 +295,28 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 // Otherwise, the ResponseDeliverTx will contain releveant error information.
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
-func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliverTx) {
 	defer telemetry.MeasureSince(time.Now(), "abci", "deliver_tx")
 
+	if app.blockReport != nil {
+		defer func() { app.blockReport.RecordTx(res) }()
+	}
+
 	tx, err := app.txDecoder(req.Tx)
 	if err != nil {
 		return sdkerrors.ResponseDeliverTx(err, 0, 0, app.trace)
 	}
 
-	ctx := app.getContextForTx(runTxModeDeliver, req.Tx)
-	res, err := app.txHandler.DeliverTx(ctx, tx, req)
+	// start the root span of the tx, correlating the ante handler and Msg
+	// handler spans with the tx hash and block height
+	sdkCtx := sdk.UnwrapSDKContext(app.getContextForTx(runTxModeDeliver, req.Tx))
+	spanCtx, span := telemetry.StartSpan(
+		sdkCtx.Context(), telemetry.SpanNameDeliverTx,
+		telemetry.HeightAttr(sdkCtx.BlockHeight()), telemetry.TxHashAttr(req.Tx),
+	)
+	ctx := sdk.WrapSDKContext(sdkCtx.WithContext(spanCtx))
+	res, err = app.txHandler.DeliverTx(ctx, tx, req)
+	telemetry.EndSpan(span, err)
 	if err != nil {
 		return sdkerrors.ResponseDeliverTx(err, uint64(res.GasUsed), uint64(res.GasWanted), app.trace)
 	}
@@ -282,7 +330,8 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 // latest header and reset the deliver state. Also, if a non-zero halt height is
 // defined in config, Commit will execute a deferred function call to check
 // against that height and gracefully halt if it matches the latest committed
-// height.
+// height. The node is likewise halted if any halt trigger fires, a halt was
+// requested through RequestHalt or a draining node reached its halt height.
 func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	defer telemetry.MeasureSince(time.Now(), "abci", "commit")
 
@@ -305,26 +354,24 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// empty/reset the deliver state
 	app.deliverState = nil
 
-	var halt bool
-
-	switch {
-	case app.haltHeight > 0 && uint64(header.Height) >= app.haltHeight:
-		halt = true
-
-	case app.haltTime > 0 && header.Time.Unix() >= int64(app.haltTime):
-		halt = true
-	}
-
-	if halt {
+	if reason, halt := app.haltReason(header, commitID); halt {
 		// Halt the binary and allow Tendermint to receive the ResponseCommit
 		// response with the commit ID hash. This will allow the node to successfully
 		// restart and process blocks assuming the halt configuration has been
 		// reset or moved to a more distant value.
-		app.halt()
+		app.halt(reason)
 	}
 
-	if app.snapshotInterval > 0 && uint64(header.Height)%app.snapshotInterval == 0 {
-		go app.snapshot(header.Height)
+	if app.preUpgradeSnapshotHeight == header.Height {
+		// take the snapshot synchronously since the node halts at the
+		// beginning of the upgrade height
+		app.preUpgradeSnapshotHeight = 0
+		app.snapshot(header.Height, false)
+	} else if app.snapshotInterval > 0 && uint64(header.Height)%app.snapshotInterval == 0 {
+		go app.snapshot(header.Height, false)
+	} else if app.snapshotInterval > 0 && app.snapshotDeltaInterval > 0 &&
+		uint64(header.Height)%app.snapshotDeltaInterval == 0 {
+		go app.snapshot(header.Height, true)
 	}
 
 	return abci.ResponseCommit{
@@ -335,8 +382,8 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
 // back on os.Exit if both fail.
-func (app *BaseApp) halt() {
-	app.logger.Info("halting node per configuration", "height", app.haltHeight, "time", app.haltTime)
+func (app *BaseApp) halt(reason string) {
+	app.logger.Info("halting node per configuration", "reason", reason, "height", app.haltHeight, "time", app.haltTime)
 
 	p, err := os.FindProcess(os.Getpid())
 	if err == nil {
@@ -355,16 +402,25 @@ func (app *BaseApp) halt() {
 	os.Exit(0)
 }
 
-// snapshot takes a snapshot of the current state and prunes any old snapshottypes.
-func (app *BaseApp) snapshot(height int64) {
+// snapshot takes a snapshot of the current state and prunes any old snapshottypes. If delta is
+// true, a delta snapshot is taken on top of the latest snapshot.
+func (app *BaseApp) snapshot(height int64, delta bool) {
 	if app.snapshotManager == nil {
 		app.logger.Info("snapshot manager not configured")
 		return
 	}
 
-	app.logger.Info("creating state snapshot", "height", height)
+	app.logger.Info("creating state snapshot", "height", height, "delta", delta)
 
-	snapshot, err := app.snapshotManager.Create(uint64(height))
+	var (
+		snapshot *snapshottypes.Snapshot
+		err      error
+	)
+	if delta {
+		snapshot, err = app.snapshotManager.CreateDelta(uint64(height))
+	} else {
+		snapshot, err = app.snapshotManager.Create(uint64(height))
+	}
 	if err != nil {
 		app.logger.Error("failed to create state snapshot", "height", height, "err", err)
 		return
@@ -562,6 +618,13 @@ func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQu
 		return sdkerrors.QueryResult(err, app.trace)
 	}
 
+	if app.signedQueries.requires(req.Path) {
+		req, ctx, err = app.verifySignedQuery(ctx, req)
+		if err != nil {
+			return sdkerrors.QueryResult(err, app.trace)
+		}
+	}
+
 	res, err := handler(ctx, req)
 	if err != nil {
 		res = sdkerrors.QueryResult(gRPCErrorToSDKError(err), app.trace)
@@ -1,15 +1,19 @@
 package baseapp
 
 import (
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestGetBlockRentionHeight(t *testing.T) {
@@ -139,3 +143,79 @@ func TestBaseAppCreateQueryContextRejectsNegativeHeights(t *testing.T) {
 		})
 	}
 }
+
+func TestHaltReason(t *testing.T) {
+	logger := defaultLogger()
+	db := dbm.NewMemDB()
+	name := t.Name()
+
+	mismatch := HaltTrigger(func(height int64, appHash []byte) error {
+		if height == 12 {
+			return errors.New("app hash mismatch")
+		}
+		return nil
+	})
+
+	app := NewBaseApp(name, logger, db, nil, SetHaltHeight(20), SetHaltTime(2000), AddHaltTrigger(mismatch))
+	header := func(height int64, unix int64) tmprototypes.Header {
+		return tmprototypes.Header{Height: height, Time: time.Unix(unix, 0)}
+	}
+
+	_, halt := app.haltReason(header(10, 1000), storetypes.CommitID{})
+	require.False(t, halt)
+
+	reason, halt := app.haltReason(header(20, 1000), storetypes.CommitID{})
+	require.True(t, halt)
+	require.Equal(t, "halt height 20 reached", reason)
+
+	reason, halt = app.haltReason(header(10, 2000), storetypes.CommitID{})
+	require.True(t, halt)
+	require.Equal(t, "halt time 2000 reached", reason)
+
+	reason, halt = app.haltReason(header(12, 1000), storetypes.CommitID{})
+	require.True(t, halt)
+	require.Equal(t, "app hash mismatch", reason)
+
+	// halt requests are consumed by the next commit
+	app.RequestHalt("invariant broken")
+	app.RequestHalt("operator request")
+	reason, halt = app.haltReason(header(11, 1000), storetypes.CommitID{})
+	require.True(t, halt)
+	require.Equal(t, "invariant broken; operator request", reason)
+
+	_, halt = app.haltReason(header(11, 1000), storetypes.CommitID{})
+	require.False(t, halt)
+}
+
+func TestDrain(t *testing.T) {
+	logger := defaultLogger()
+	db := dbm.NewMemDB()
+	name := t.Name()
+
+	app := NewBaseApp(name, logger, db, nil)
+	require.NoError(t, app.LoadLatestVersion())
+	header := func(height int64) tmprototypes.Header {
+		return tmprototypes.Header{Height: height}
+	}
+
+	require.False(t, app.IsDraining())
+
+	// without an epoch length, the node halts after the next block
+	require.Equal(t, int64(1), app.Drain(0))
+	require.True(t, app.IsDraining())
+	reason, halt := app.haltReason(header(1), storetypes.CommitID{})
+	require.True(t, halt)
+	require.Equal(t, "node drained at height 1", reason)
+
+	// with an epoch length, the node halts at the end of the epoch
+	require.Equal(t, int64(5), app.Drain(5))
+	_, halt = app.haltReason(header(4), storetypes.CommitID{})
+	require.False(t, halt)
+	_, halt = app.haltReason(header(5), storetypes.CommitID{})
+	require.True(t, halt)
+
+	// new txs are rejected while draining
+	res := app.CheckTx(abci.RequestCheckTx{Tx: []byte("tx"), Type: abci.CheckTxType_New})
+	require.Equal(t, sdkerrors.ErrNodeDraining.ABCICode(), res.Code)
+	require.Equal(t, sdkerrors.ErrNodeDraining.Codespace(), res.Codespace)
+}
@@ -63,9 +63,15 @@ type BaseApp struct { // nolint: maligned
 	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
 	// manages snapshots, i.e. dumps of app state at certain intervals
-	snapshotManager    *snapshots.Manager
-	snapshotInterval   uint64 // block interval between state sync snapshots
-	snapshotKeepRecent uint32 // recent state sync snapshots to keep
+	snapshotManager       *snapshots.Manager
+	snapshotInterval      uint64 // block interval between state sync snapshots
+	snapshotDeltaInterval uint64 // block interval between delta state sync snapshots
+	snapshotKeepRecent    uint32 // recent state sync snapshots to keep
+
+	// preUpgradeSnapshot takes a snapshot of the state committed at the block
+	// before an upgrade height, when requested by the upgrade module
+	preUpgradeSnapshot       bool
+	preUpgradeSnapshotHeight int64 // height of the requested pre-upgrade snapshot
 
 	// volatile states:
 	//
@@ -100,6 +106,16 @@ type BaseApp struct { // nolint: maligned
 	// minimum block time (in Unix seconds) at which to halt the chain and gracefully shutdown
 	haltTime uint64
 
+	// additional conditions evaluated on Commit which halt the chain and
+	// gracefully shutdown when triggered
+	haltTriggers []HaltTrigger
+
+	// halt requests submitted through RequestHalt since the last Commit
+	haltRequests *haltRequests
+
+	// draining mode requested through Drain
+	draining *drainState
+
 	// minRetainBlocks defines the minimum block height offset from the current
 	// block being committed, such that all blocks past this offset are pruned
 	// from Tendermint. It is used as part of the process of determining the
@@ -125,6 +141,16 @@ type BaseApp struct { // nolint: maligned
 	// indexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
+
+	// blockReportEnabled emits an EventBlockReport at the end of each block
+	blockReportEnabled bool
+
+	// blockReport accumulates the resource usage of the block being executed
+	// when the block report is enabled
+	blockReport *sdk.EventBlockReport
+
+	// signedQueries gates the gRPC queries requiring a signature
+	signedQueries *signedQueries
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -145,6 +171,9 @@ func NewBaseApp(
 		grpcQueryRouter: NewGRPCQueryRouter(),
 		txDecoder:       txDecoder,
 		fauxMerkleMode:  false,
+		signedQueries:   newSignedQueries(),
+		haltRequests:    &haltRequests{},
+		draining:        &drainState{},
 	}
 
 	for _, option := range options {
@@ -300,6 +329,11 @@ func (app *BaseApp) init() error {
 				"state sync snapshot interval %v must be a multiple of pruning keep every interval %v",
 				app.snapshotInterval, pruningOpts.KeepEvery)
 		}
+		if pruningOpts.KeepEvery > 0 && app.snapshotDeltaInterval%pruningOpts.KeepEvery != 0 {
+			return fmt.Errorf(
+				"state sync snapshot delta interval %v must be a multiple of pruning keep every interval %v",
+				app.snapshotDeltaInterval, pruningOpts.KeepEvery)
+		}
 	}
 
 	return nil
@@ -317,6 +351,10 @@ func (app *BaseApp) setHaltTime(haltTime uint64) {
 	app.haltTime = haltTime
 }
 
+func (app *BaseApp) addHaltTrigger(trigger HaltTrigger) {
+	app.haltTriggers = append(app.haltTriggers, trigger)
+}
+
 func (app *BaseApp) setMinRetainBlocks(minRetainBlocks uint64) {
 	app.minRetainBlocks = minRetainBlocks
 }
@@ -325,10 +363,22 @@ func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
 
+func (app *BaseApp) setCommitWorkers(workers int) {
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		panic("parallel commit requires a rootmulti store")
+	}
+	rms.SetCommitWorkers(workers)
+}
+
 func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
 
+func (app *BaseApp) setBlockReport(enabled bool) {
+	app.blockReportEnabled = enabled
+}
+
 func (app *BaseApp) setIndexEvents(ie []string) {
 	app.indexEvents = make(map[string]struct{})
 
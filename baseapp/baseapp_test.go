@@ -1028,7 +1028,10 @@ func TestDeliverTx(t *testing.T) {
 			events := res.GetEvents()
 			require.Len(t, events, 3, "should contain ante handler, message type and counter events respectively")
 			require.Equal(t, sdk.MarkEventsToIndex(counterEvent("ante_handler", counter).ToABCIEvents(), map[string]struct{}{})[0], events[0], "ante handler event")
-			require.Equal(t, sdk.MarkEventsToIndex(counterEvent(sdk.EventTypeMessage, counter).ToABCIEvents(), map[string]struct{}{})[0], events[2], "msg handler update counter event")
+			msgEvents := counterEvent(sdk.EventTypeMessage, counter).
+				WithAttributeIfMissing(sdk.NewAttribute(sdk.AttributeKeyMsgTypeURL, sdk.MsgTypeURL(tx.Msgs[0]))).
+				WithAttributeIfMissing(sdk.NewAttribute(sdk.AttributeKeyMsgIndex, "0"))
+			require.Equal(t, sdk.MarkEventsToIndex(msgEvents.ToABCIEvents(), map[string]struct{}{})[0], events[2], "msg handler update counter event")
 		}
 
 		app.EndBlock(abci.RequestEndBlock{})
@@ -1777,6 +1780,38 @@ func TestListSnapshots(t *testing.T) {
 	}}, resp)
 }
 
+func TestPreUpgradeSnapshot(t *testing.T) {
+	testCases := map[string]struct {
+		enabled   bool
+		snapshots []*abci.Snapshot
+	}{
+		"enabled":  {true, []*abci.Snapshot{{Height: 3, Format: 1, Chunks: 1}, {Height: 2, Format: 1, Chunks: 1}}},
+		"disabled": {false, []*abci.Snapshot{{Height: 2, Format: 1, Chunks: 1}}},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			app, teardown := setupBaseAppWithSnapshots(t, 2, 1, SetPreUpgradeSnapshot(tc.enabled))
+			defer teardown()
+
+			// the snapshot is taken at the commit of the requested height, which
+			// is not a multiple of the snapshot interval
+			app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 3}})
+			app.RequestPreUpgradeSnapshot(3)
+			app.EndBlock(abci.RequestEndBlock{Height: 3})
+			app.Commit()
+
+			resp := app.ListSnapshots(abci.RequestListSnapshots{})
+			for _, s := range resp.Snapshots {
+				s.Hash = nil
+				s.Metadata = nil
+			}
+			require.Equal(t, tc.snapshots, resp.Snapshots)
+		})
+	}
+}
+
 func TestLoadSnapshotChunk(t *testing.T) {
 	app, teardown := setupBaseAppWithSnapshots(t, 2, 5)
 	defer teardown()
@@ -2018,3 +2053,83 @@ func TestBaseApp_EndBlock(t *testing.T) {
 	require.Equal(t, int64(100), res.GetValidatorUpdates()[0].Power)
 	require.Equal(t, cp.Block.MaxGas, res.ConsensusParamUpdates.Block.MaxGas)
 }
+
+func TestBaseApp_BlockReport(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	txHandlerOpt := func(bapp *BaseApp) {
+		legacyRouter := middleware.NewLegacyRouter()
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		legacyRouter.AddRoute(r)
+		txHandler, err := middleware.NewDefaultTxHandler(middleware.TxHandlerOptions{
+			LegacyRouter:      legacyRouter,
+			LegacyAnteHandler: anteHandlerTxTest(t, capKey1, anteKey),
+			MsgServiceRouter:  middleware.NewMsgServiceRouter(interfaceRegistry),
+		})
+		require.NoError(t, err)
+		bapp.SetTxHandler(txHandler)
+	}
+	endBlockerOpt := func(bapp *BaseApp) {
+		bapp.SetEndBlocker(func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+			sdk.RecordEndBlockDuration(ctx, "foo", time.Millisecond)
+			sdk.RecordEndBlockDuration(ctx, "bar", time.Second)
+			return abci.ResponseEndBlock{}
+		})
+	}
+	app := setupBaseApp(t, txHandlerOpt, endBlockerOpt, SetBlockReport(true))
+	app.InitChain(abci.RequestInitChain{})
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	var gasUsed uint64
+	for i, msgCounter := range []int64{0, 1, 1} {
+		// the second tx fails in its handler, leaving the msg counter as is
+		failOnHandler := i == 1
+		tx := newTxCounter(int64(i), msgCounter)
+		tx.setFailOnHandler(failOnHandler)
+		txBytes, err := cdc.Marshal(tx)
+		require.NoError(t, err)
+
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.Equal(t, !failOnHandler, res.IsOK(), fmt.Sprintf("%v", res))
+		if res.GasUsed > 0 {
+			gasUsed += uint64(res.GasUsed)
+		}
+	}
+
+	// a tx which cannot be decoded fails too
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: []byte("invalid")})
+	require.False(t, res.IsOK())
+
+	endRes := app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	require.Len(t, endRes.Events, 1)
+
+	event, err := sdk.ParseTypedEvent(endRes.Events[0])
+	require.NoError(t, err)
+	require.Equal(t, &sdk.EventBlockReport{
+		Height:        header.Height,
+		GasUsed:       gasUsed,
+		TxCount:       4,
+		FailedTxCount: 2,
+		EndBlockDurations: []sdk.ModuleDuration{
+			{Module: "foo", DurationNs: time.Millisecond.Nanoseconds()},
+			{Module: "bar", DurationNs: time.Second.Nanoseconds()},
+		},
+	}, event)
+	app.Commit()
+
+	// the report starts over with the next block
+	header = tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	endRes = app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	require.Len(t, endRes.Events, 1)
+
+	event, err = sdk.ParseTypedEvent(endRes.Events[0])
+	require.NoError(t, err)
+	require.Equal(t, header.Height, event.(*sdk.EventBlockReport).Height)
+	require.Zero(t, event.(*sdk.EventBlockReport).TxCount)
+}
@@ -3,6 +3,7 @@ package baseapp
 import (
 	"context"
 	"reflect"
+	"strconv"
 
 	gogogrpc "github.com/gogo/protobuf/grpc"
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -11,7 +12,9 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
@@ -95,14 +98,69 @@ func (app *BaseApp) RegisterGRPCServer(clientCtx client.Context, server gogogrpc
 			}
 		}
 
+		// Server streaming queries cannot be routed through ABCI, they are
+		// served in-process against the state at the requested height.
+		newStreams := make([]grpc.StreamDesc, len(desc.Streams))
+		for i, stream := range desc.Streams {
+			streamHandler := stream.Handler
+			newStreams[i] = stream
+			newStreams[i].Handler = func(srv interface{}, stream grpc.ServerStream) (err error) {
+				defer func() {
+					if r := recover(); r != nil {
+						err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "%v", r)
+					}
+				}()
+
+				sdkCtx, err := app.createStreamQueryContext(stream.Context())
+				if err != nil {
+					return err
+				}
+
+				return streamHandler(srv, &queryServerStream{ServerStream: stream, ctx: sdk.WrapSDKContext(sdkCtx)})
+			}
+		}
+
 		newDesc := &grpc.ServiceDesc{
 			ServiceName: desc.ServiceName,
 			HandlerType: desc.HandlerType,
 			Methods:     newMethods,
-			Streams:     desc.Streams,
+			Streams:     newStreams,
 			Metadata:    desc.Metadata,
 		}
 
 		server.RegisterService(newDesc, data.handler)
 	}
 }
+
+// createStreamQueryContext creates a query context for a server streaming
+// query at the height given in the gRPC metadata, or the latest height.
+func (app *BaseApp) createStreamQueryContext(grpcCtx context.Context) (sdk.Context, error) {
+	var height int64
+	md, _ := metadata.FromIncomingContext(grpcCtx)
+	if heights := md.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+		var err error
+		height, err = strconv.ParseInt(heights[0], 10, 64)
+		if err != nil {
+			return sdk.Context{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid %s header: %s", grpctypes.GRPCBlockHeightHeader, err)
+		}
+	}
+
+	ctx, err := app.createQueryContext(height, false)
+	if err != nil {
+		return sdk.Context{}, err
+	}
+
+	return ctx.WithContext(grpcCtx), nil
+}
+
+// queryServerStream is a grpc.ServerStream whose context wraps the sdk.Context
+// the streaming query is served against.
+type queryServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context implements grpc.ServerStream.
+func (s *queryServerStream) Context() context.Context {
+	return s.ctx
+}
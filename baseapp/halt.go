@@ -0,0 +1,112 @@
+package baseapp
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// HaltTrigger is an operator-configured condition evaluated on every Commit,
+// after the state of the block at height has been committed with the given app
+// hash. Returning a non-nil error gracefully halts the node, the error being
+// logged as the reason of the halt.
+type HaltTrigger func(height int64, appHash []byte) error
+
+// haltRequests collects the reasons of the halts requested through
+// RequestHalt until the next Commit.
+type haltRequests struct {
+	mtx     sync.Mutex
+	reasons []string
+}
+
+// RequestHalt requests the node to gracefully halt once the block currently
+// being executed has been committed. It is safe for concurrent use and is meant
+// for conditions detected during block execution, such as a broken invariant,
+// which should stop the node without leaving the block uncommitted.
+func (app *BaseApp) RequestHalt(reason string) {
+	app.haltRequests.mtx.Lock()
+	defer app.haltRequests.mtx.Unlock()
+
+	app.haltRequests.reasons = append(app.haltRequests.reasons, reason)
+}
+
+// drainState records whether the node is draining and the height of the last
+// block it commits before halting.
+type drainState struct {
+	mtx        sync.RWMutex
+	active     bool
+	haltHeight int64
+}
+
+// Drain puts the node into draining mode ahead of maintenance: CheckTx rejects
+// new transactions, so that the node stops gossiping them, and the node
+// gracefully halts once the block currently being executed has been committed.
+// When epochLength is positive, the halt is delayed until the first block whose
+// height is a multiple of epochLength has been committed, e.g. to restart the
+// node at a validator set epoch boundary. It returns the height of the last
+// block committed before halting and may be called again to change it.
+func (app *BaseApp) Drain(epochLength uint64) int64 {
+	haltHeight := app.LastBlockHeight() + 1
+	if epoch := int64(epochLength); epoch > 0 && haltHeight%epoch != 0 {
+		haltHeight += epoch - haltHeight%epoch
+	}
+
+	app.draining.mtx.Lock()
+	defer app.draining.mtx.Unlock()
+
+	app.draining.active = true
+	app.draining.haltHeight = haltHeight
+	app.logger.Info("draining node", "halt_height", haltHeight)
+
+	return haltHeight
+}
+
+// IsDraining returns whether the node was put into draining mode with Drain.
+func (app *BaseApp) IsDraining() bool {
+	app.draining.mtx.RLock()
+	defer app.draining.mtx.RUnlock()
+
+	return app.draining.active
+}
+
+// haltReason returns whether the node must halt after committing the block with
+// the given header and commit ID and, if so, the reason of the halt. Pending
+// halt requests are consumed.
+func (app *BaseApp) haltReason(header tmproto.Header, commitID storetypes.CommitID) (string, bool) {
+	var reasons []string
+
+	if app.haltHeight > 0 && uint64(header.Height) >= app.haltHeight {
+		reasons = append(reasons, fmt.Sprintf("halt height %d reached", app.haltHeight))
+	}
+
+	if app.haltTime > 0 && header.Time.Unix() >= int64(app.haltTime) {
+		reasons = append(reasons, fmt.Sprintf("halt time %d reached", app.haltTime))
+	}
+
+	for _, trigger := range app.haltTriggers {
+		if err := trigger(header.Height, commitID.Hash); err != nil {
+			reasons = append(reasons, err.Error())
+		}
+	}
+
+	app.draining.mtx.RLock()
+	if app.draining.active && header.Height >= app.draining.haltHeight {
+		reasons = append(reasons, fmt.Sprintf("node drained at height %d", app.draining.haltHeight))
+	}
+	app.draining.mtx.RUnlock()
+
+	app.haltRequests.mtx.Lock()
+	reasons = append(reasons, app.haltRequests.reasons...)
+	app.haltRequests.reasons = nil
+	app.haltRequests.mtx.Unlock()
+
+	if len(reasons) == 0 {
+		return "", false
+	}
+
+	return strings.Join(reasons, "; "), true
+}
@@ -0,0 +1,128 @@
+package baseapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TxLane reserves a share of the block space for the transactions whose
+// messages all have one of its message type URLs, e.g. governance votes.
+type TxLane struct {
+	Name        string
+	MsgTypeURLs []string
+	// MaxBlockShare is the share of the block bytes and gas reserved for the
+	// lane, between 0 and 1.
+	MaxBlockShare sdk.Dec
+}
+
+// matches returns whether all the messages of a transaction have one of the
+// lane message type URLs.
+func (l TxLane) matches(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+	for _, msg := range msgs {
+		found := false
+		for _, typeURL := range l.MsgTypeURLs {
+			if sdk.MsgTypeURL(msg) == typeURL {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// blockSpace tracks the bytes and gas of selected transactions against limits,
+// where a negative limit is unlimited.
+type blockSpace struct {
+	maxBytes, maxGas int64
+	bytes, gas       int64
+}
+
+func (s *blockSpace) fits(bytes, gas int64) bool {
+	return (s.maxBytes < 0 || s.bytes+bytes <= s.maxBytes) && (s.maxGas < 0 || s.gas+gas <= s.maxGas)
+}
+
+func (s *blockSpace) add(bytes, gas int64) {
+	s.bytes += bytes
+	s.gas += gas
+}
+
+// SelectLaneTxs selects the transactions of a block proposal from candidate
+// transactions ordered by precedence, within maxBytes and maxGas, where -1 is
+// unlimited. Transactions of the first lane they match are selected first,
+// within the lane share of the block space. The remaining space is then filled
+// with the other transactions, including the lane transactions exceeding their
+// share. Selected transactions keep their candidate order, so that account
+// sequences stay ordered, and transactions which fail to decode are dropped.
+//
+// NOTE: Tendermint v0.34 doesn't let the application build block proposals, so
+// this helper isn't used by BaseApp. It's meant for proposal handlers once the
+// consensus engine supports them.
+func SelectLaneTxs(lanes []TxLane, txs [][]byte, txDecoder sdk.TxDecoder, maxBytes, maxGas int64) [][]byte {
+	type candidate struct {
+		bytes, gas int64
+		lane       int
+		selected   bool
+	}
+
+	candidates := make([]*candidate, len(txs))
+	for i, bz := range txs {
+		tx, err := txDecoder(bz)
+		if err != nil {
+			continue
+		}
+		c := &candidate{bytes: int64(len(bz)), lane: -1}
+		if gasTx, ok := tx.(interface{ GetGas() uint64 }); ok {
+			c.gas = int64(gasTx.GetGas())
+		}
+		for j, lane := range lanes {
+			if lane.matches(tx) {
+				c.lane = j
+				break
+			}
+		}
+		candidates[i] = c
+	}
+
+	block := blockSpace{maxBytes: maxBytes, maxGas: maxGas}
+	for j, lane := range lanes {
+		laneSpace := blockSpace{maxBytes: laneLimit(maxBytes, lane.MaxBlockShare), maxGas: laneLimit(maxGas, lane.MaxBlockShare)}
+		for _, c := range candidates {
+			if c == nil || c.lane != j || !laneSpace.fits(c.bytes, c.gas) || !block.fits(c.bytes, c.gas) {
+				continue
+			}
+			laneSpace.add(c.bytes, c.gas)
+			block.add(c.bytes, c.gas)
+			c.selected = true
+		}
+	}
+
+	for _, c := range candidates {
+		if c == nil || c.selected || !block.fits(c.bytes, c.gas) {
+			continue
+		}
+		block.add(c.bytes, c.gas)
+		c.selected = true
+	}
+
+	var selected [][]byte
+	for i, c := range candidates {
+		if c != nil && c.selected {
+			selected = append(selected, txs[i])
+		}
+	}
+	return selected
+}
+
+// laneLimit returns the share of a block limit reserved for a lane.
+func laneLimit(limit int64, share sdk.Dec) int64 {
+	if limit < 0 {
+		return -1
+	}
+	return share.MulInt64(limit).TruncateInt64()
+}
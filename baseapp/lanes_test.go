@@ -0,0 +1,59 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSelectLaneTxs(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	newTx := func(msg sdk.Msg) []byte {
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msg))
+		txBuilder.SetGasLimit(100)
+		bz, err := encCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+	test1, test2, test3 := newTx(testdata.NewTestMsg(addr)), newTx(testdata.NewTestMsg(addr)), newTx(testdata.NewTestMsg(addr))
+	dog1, dog2 := newTx(&testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "rex"}}), newTx(&testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "spot"}})
+	invalid := []byte("invalid")
+
+	dogLane := baseapp.TxLane{
+		Name:          "dogs",
+		MsgTypeURLs:   []string{sdk.MsgTypeURL(&testdata.MsgCreateDog{})},
+		MaxBlockShare: sdk.NewDecWithPrec(4, 1),
+	}
+
+	testCases := []struct {
+		name     string
+		lanes    []baseapp.TxLane
+		txs      [][]byte
+		maxBytes int64
+		maxGas   int64
+		expTxs   [][]byte
+	}{
+		{"no lanes", nil, [][]byte{test1, test2, test3, dog1}, -1, 300, [][]byte{test1, test2, test3}},
+		{"lane space is reserved", []baseapp.TxLane{dogLane}, [][]byte{test1, test2, test3, dog1}, -1, 300, [][]byte{test1, test2, dog1}},
+		{"lane txs exceeding the lane share", []baseapp.TxLane{dogLane}, [][]byte{dog1, dog2, test1}, -1, 300, [][]byte{dog1, dog2, test1}},
+		{"lane share of bytes", []baseapp.TxLane{dogLane}, [][]byte{test1, dog1}, int64(len(test1)), -1, [][]byte{test1}},
+		{"unlimited block", []baseapp.TxLane{dogLane}, [][]byte{test1, dog1, test2}, -1, -1, [][]byte{test1, dog1, test2}},
+		{"invalid txs are dropped", []baseapp.TxLane{dogLane}, [][]byte{invalid, test1}, -1, -1, [][]byte{test1}},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			txs := baseapp.SelectLaneTxs(tc.lanes, tc.txs, encCfg.TxConfig.TxDecoder(), tc.maxBytes, tc.maxGas)
+			require.Equal(t, tc.expTxs, txs)
+		})
+	}
+}
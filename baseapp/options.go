@@ -41,6 +41,12 @@ func SetHaltTime(haltTime uint64) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setHaltTime(haltTime) }
 }
 
+// AddHaltTrigger returns a BaseApp option function that adds a halt trigger
+// evaluated on every Commit.
+func AddHaltTrigger(trigger HaltTrigger) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.addHaltTrigger(trigger) }
+}
+
 // SetMinRetainBlocks returns a BaseApp option function that sets the minimum
 // block retention height value when determining which heights to prune during
 // ABCI Commit.
@@ -53,6 +59,12 @@ func SetTrace(trace bool) func(*BaseApp) {
 	return func(app *BaseApp) { app.setTrace(trace) }
 }
 
+// SetBlockReport provides a BaseApp option function that enables the
+// EventBlockReport emitted at the end of each block.
+func SetBlockReport(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.setBlockReport(enabled) }
+}
+
 // SetIndexEvents provides a BaseApp option function that sets the events to index.
 func SetIndexEvents(ie []string) func(*BaseApp) {
 	return func(app *BaseApp) { app.setIndexEvents(ie) }
@@ -64,21 +76,50 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetCommitWorkers sets the number of goroutines committing the stores of the
+// multistore in parallel. Values below 2 commit the stores serially.
+func SetCommitWorkers(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.setCommitWorkers(workers) }
+}
+
 // SetSnapshotInterval sets the snapshot interval.
 func SetSnapshotInterval(interval uint64) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotInterval(interval) }
 }
 
+// SetSnapshotDeltaInterval sets the delta snapshot interval.
+func SetSnapshotDeltaInterval(interval uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotDeltaInterval(interval) }
+}
+
 // SetSnapshotKeepRecent sets the recent snapshots to keep.
 func SetSnapshotKeepRecent(keepRecent uint32) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotKeepRecent(keepRecent) }
 }
 
+// SetPreUpgradeSnapshot sets whether a snapshot of the state is taken before
+// upgrades.
+func SetPreUpgradeSnapshot(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetPreUpgradeSnapshot(enabled) }
+}
+
 // SetSnapshotStore sets the snapshot store.
 func SetSnapshotStore(snapshotStore *snapshots.Store) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotStore(snapshotStore) }
 }
 
+// SetSignedQueryPaths returns a BaseApp option function that sets the gRPC
+// queries requiring a signature.
+func SetSignedQueryPaths(paths []string) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSignedQueryPaths(paths) }
+}
+
+// SetSignedQueryMaxAge returns a BaseApp option function that sets the number
+// of blocks for which a signed query remains valid.
+func SetSignedQueryMaxAge(maxAge int64) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSignedQueryMaxAge(maxAge) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -216,6 +257,15 @@ func (app *BaseApp) SetSnapshotInterval(snapshotInterval uint64) {
 	app.snapshotInterval = snapshotInterval
 }
 
+// SetSnapshotDeltaInterval sets the interval of delta snapshots, which are taken on top of the
+// latest snapshot at heights that are not a multiple of the snapshot interval.
+func (app *BaseApp) SetSnapshotDeltaInterval(snapshotDeltaInterval uint64) {
+	if app.sealed {
+		panic("SetSnapshotDeltaInterval() on sealed BaseApp")
+	}
+	app.snapshotDeltaInterval = snapshotDeltaInterval
+}
+
 // SetSnapshotKeepRecent sets the number of recent snapshots to keep.
 func (app *BaseApp) SetSnapshotKeepRecent(snapshotKeepRecent uint32) {
 	if app.sealed {
@@ -224,6 +274,28 @@ func (app *BaseApp) SetSnapshotKeepRecent(snapshotKeepRecent uint32) {
 	app.snapshotKeepRecent = snapshotKeepRecent
 }
 
+// SetPreUpgradeSnapshot sets whether a snapshot of the state is taken at the
+// commit of the block before an upgrade height when requested with
+// RequestPreUpgradeSnapshot, so that a node can recover from a failed upgrade
+// without a full resync.
+func (app *BaseApp) SetPreUpgradeSnapshot(enabled bool) {
+	if app.sealed {
+		panic("SetPreUpgradeSnapshot() on sealed BaseApp")
+	}
+	app.preUpgradeSnapshot = enabled
+}
+
+// RequestPreUpgradeSnapshot requests a snapshot of the state committed at the
+// given height, the one before an upgrade height. The snapshot is taken
+// synchronously at the commit of the height, before the node halts for the
+// upgrade, if pre-upgrade snapshots are enabled.
+func (app *BaseApp) RequestPreUpgradeSnapshot(height int64) {
+	if !app.preUpgradeSnapshot {
+		return
+	}
+	app.preUpgradeSnapshotHeight = height
+}
+
 // SetInterfaceRegistry sets the InterfaceRegistry.
 func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 	app.interfaceRegistry = registry
@@ -0,0 +1,180 @@
+package baseapp
+
+import (
+	"bytes"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// DefaultSignedQueryMaxAge is the default number of blocks for which a signed
+// query bound to a block remains valid.
+const DefaultSignedQueryMaxAge = 10
+
+// SignedQueryAuthorizer decides whether the signer of a signed query may run
+// the query at path, returning an error if it may not.
+type SignedQueryAuthorizer func(ctx sdk.Context, path string, signer sdk.AccAddress) error
+
+// signedQueries gates the gRPC queries requiring a signature. It keeps the
+// hashes of the recent blocks the signed queries are bound to, and the
+// signatures of the signed queries already run, which are rejected if replayed.
+type signedQueries struct {
+	mtx sync.Mutex
+
+	paths      map[string]struct{}
+	maxAge     int64
+	authorizer SignedQueryAuthorizer
+
+	blockHashes    map[int64][]byte
+	usedSignatures map[string]int64 // signature hash -> block height
+}
+
+func newSignedQueries() *signedQueries {
+	return &signedQueries{
+		paths:          make(map[string]struct{}),
+		maxAge:         DefaultSignedQueryMaxAge,
+		blockHashes:    make(map[int64][]byte),
+		usedSignatures: make(map[string]int64),
+	}
+}
+
+// enabled returns true if any query requires a signature.
+func (sq *signedQueries) enabled() bool {
+	return len(sq.paths) > 0
+}
+
+// requires returns true if the query at path requires a signature.
+func (sq *signedQueries) requires(path string) bool {
+	_, ok := sq.paths[path]
+	return ok
+}
+
+// recordBlockHash records the hash of the block at height, and forgets the
+// blocks and the signatures which are no longer recent.
+func (sq *signedQueries) recordBlockHash(height int64, hash []byte) {
+	if !sq.enabled() {
+		return
+	}
+
+	sq.mtx.Lock()
+	defer sq.mtx.Unlock()
+
+	sq.blockHashes[height] = hash
+	for h := range sq.blockHashes {
+		if h <= height-sq.maxAge {
+			delete(sq.blockHashes, h)
+		}
+	}
+	for sig, h := range sq.usedSignatures {
+		if h <= height-sq.maxAge {
+			delete(sq.usedSignatures, sig)
+		}
+	}
+}
+
+// SetSignedQueryPaths sets the full method names of the gRPC queries which
+// require a signature, e.g. "/cosmos.bank.v1beta1.Query/AllBalances".
+func (app *BaseApp) SetSignedQueryPaths(paths []string) {
+	if app.sealed {
+		panic("SetSignedQueryPaths() on sealed BaseApp")
+	}
+
+	for _, path := range paths {
+		app.signedQueries.paths[path] = struct{}{}
+	}
+}
+
+// SetSignedQueryMaxAge sets the number of blocks for which a signed query
+// bound to a block remains valid.
+func (app *BaseApp) SetSignedQueryMaxAge(maxAge int64) {
+	if app.sealed {
+		panic("SetSignedQueryMaxAge() on sealed BaseApp")
+	}
+	if maxAge <= 0 {
+		panic("signed query max age must be positive")
+	}
+
+	app.signedQueries.maxAge = maxAge
+}
+
+// SetSignedQueryAuthorizer sets the authorizer deciding whether the signer of
+// a signed query may run it. Without an authorizer, any valid signature is
+// accepted, and the query handlers may restrict their response to the signer
+// returned by query.SignerFromContext.
+func (app *BaseApp) SetSignedQueryAuthorizer(authorizer SignedQueryAuthorizer) {
+	if app.sealed {
+		panic("SetSignedQueryAuthorizer() on sealed BaseApp")
+	}
+
+	app.signedQueries.authorizer = authorizer
+}
+
+// verifySignedQuery verifies the signed query wrapping the request of req,
+// which must be bound to the chain and to a recent block and must not have
+// been run before. It returns the unwrapped request, and the query context
+// carrying the signer.
+func (app *BaseApp) verifySignedQuery(ctx sdk.Context, req abci.RequestQuery) (abci.RequestQuery, sdk.Context, error) {
+	var signed query.SignedQuery
+	if err := signed.Unmarshal(req.Data); err != nil {
+		return req, ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "query %s requires a signature: %s", req.Path, err)
+	}
+	if len(signed.Signature) == 0 {
+		return req, ctx, sdkerrors.Wrapf(sdkerrors.ErrNoSignatures, "query %s requires a signature", req.Path)
+	}
+	if err := signed.UnpackInterfaces(app.interfaceRegistry); err != nil {
+		return req, ctx, err
+	}
+
+	if chainID := app.checkState.ctx.ChainID(); signed.ChainId != chainID {
+		return req, ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidChainID, "got %s, expected %s", signed.ChainId, chainID)
+	}
+
+	sq := app.signedQueries
+	latest := app.LastBlockHeight()
+	if signed.BlockHeight > latest || signed.BlockHeight <= latest-sq.maxAge {
+		return req, ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "signed query block height %d is not within the last %d blocks", signed.BlockHeight, sq.maxAge,
+		)
+	}
+
+	pubKey, err := signed.GetPubKey()
+	if err != nil {
+		return req, ctx, err
+	}
+	signBytes, err := signed.GetSignBytes(req.Path)
+	if err != nil {
+		return req, ctx, err
+	}
+	if !pubKey.VerifySignature(signBytes, signed.Signature) {
+		return req, ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signed query signature verification failed")
+	}
+
+	signer := sdk.AccAddress(pubKey.Address())
+	ctx = query.WithSigner(ctx, signer)
+	if sq.authorizer != nil {
+		if err := sq.authorizer(ctx, req.Path, signer); err != nil {
+			return req, ctx, err
+		}
+	}
+
+	sq.mtx.Lock()
+	defer sq.mtx.Unlock()
+
+	if hash, ok := sq.blockHashes[signed.BlockHeight]; !ok || !bytes.Equal(hash, signed.BlockHash) {
+		return req, ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "unknown hash of block %d", signed.BlockHeight)
+	}
+
+	sigHash := string(tmhash.Sum(signed.Signature))
+	if _, ok := sq.usedSignatures[sigHash]; ok {
+		return req, ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signed query already run")
+	}
+	sq.usedSignatures[sigHash] = signed.BlockHeight
+
+	req.Data = signed.Request
+	return req, ctx, nil
+}
@@ -0,0 +1,128 @@
+package baseapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func TestSignedQuery(t *testing.T) {
+	const (
+		chainID = "test-chain"
+		path    = "/testdata.Query/SayHello"
+	)
+	privKey := secp256k1.GenPrivKey()
+	otherKey := secp256k1.GenPrivKey()
+
+	signedQueryOpt := func(bapp *BaseApp) {
+		registry := codectypes.NewInterfaceRegistry()
+		cryptocodec.RegisterInterfaces(registry)
+		bapp.SetInterfaceRegistry(registry)
+		testdata.RegisterQueryServer(bapp.GRPCQueryRouter(), testdata.QueryImpl{})
+		bapp.SetSignedQueryPaths([]string{path})
+		bapp.SetSignedQueryMaxAge(2)
+		bapp.SetSignedQueryAuthorizer(func(ctx sdk.Context, _ string, signer sdk.AccAddress) error {
+			if ctxSigner, ok := query.SignerFromContext(ctx); !ok || !ctxSigner.Equals(signer) {
+				return sdkerrors.ErrLogic
+			}
+			if signer.Equals(sdk.AccAddress(otherKey.PubKey().Address())) {
+				return sdkerrors.ErrUnauthorized
+			}
+			return nil
+		})
+	}
+	app := setupBaseApp(t, signedQueryOpt)
+	app.InitChain(abci.RequestInitChain{ChainId: chainID})
+
+	hashes := map[int64][]byte{}
+	nextBlock := func() {
+		header := tmproto.Header{ChainID: chainID, Height: app.LastBlockHeight() + 1}
+		hashes[header.Height] = []byte{byte(header.Height)}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header, Hash: hashes[header.Height]})
+		app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		app.Commit()
+	}
+	nextBlock()
+	nextBlock()
+
+	reqBz, err := (&testdata.SayHelloRequest{Name: "foo"}).Marshal()
+	require.NoError(t, err)
+
+	sign := func(signed query.SignedQuery, key *secp256k1.PrivKey) []byte {
+		signed.PublicKey, err = codectypes.NewAnyWithValue(key.PubKey())
+		require.NoError(t, err)
+		signBytes, err := signed.GetSignBytes(path)
+		require.NoError(t, err)
+		signed.Signature, err = key.Sign(signBytes)
+		require.NoError(t, err)
+		bz, err := signed.Marshal()
+		require.NoError(t, err)
+		return bz
+	}
+	signedQuery := func(name string, height int64) query.SignedQuery {
+		bz, err := (&testdata.SayHelloRequest{Name: name}).Marshal()
+		require.NoError(t, err)
+		return query.SignedQuery{Request: bz, ChainId: chainID, BlockHeight: height, BlockHash: hashes[height]}
+	}
+
+	// a query requiring a signature fails without one
+	res := app.Query(abci.RequestQuery{Path: path, Data: reqBz})
+	require.Equal(t, sdkerrors.ErrNoSignatures.ABCICode(), res.Code, res)
+
+	// a signed query bound to a recent block succeeds
+	data := sign(signedQuery("foo", 2), privKey)
+	res = app.Query(abci.RequestQuery{Path: path, Data: data})
+	require.Equal(t, abci.CodeTypeOK, res.Code, res)
+	var helloRes testdata.SayHelloResponse
+	require.NoError(t, helloRes.Unmarshal(res.Value))
+	require.Equal(t, "Hello foo!", helloRes.Greeting)
+
+	// a signed query may not be replayed
+	res = app.Query(abci.RequestQuery{Path: path, Data: data})
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.Code, res)
+
+	// the signer must be authorized
+	res = app.Query(abci.RequestQuery{Path: path, Data: sign(signedQuery("foo", 2), otherKey)})
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.Code, res)
+
+	// the signed query must be bound to the chain
+	wrongChain := signedQuery("bar", 2)
+	wrongChain.ChainId = "other-chain"
+	res = app.Query(abci.RequestQuery{Path: path, Data: sign(wrongChain, privKey)})
+	require.Equal(t, sdkerrors.ErrInvalidChainID.ABCICode(), res.Code, res)
+
+	// the signed query must be bound to the hash of the block
+	wrongHash := signedQuery("bar", 2)
+	wrongHash.BlockHash = []byte("wrong")
+	res = app.Query(abci.RequestQuery{Path: path, Data: sign(wrongHash, privKey)})
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.Code, res)
+
+	// the signature must match the signed query
+	tampered := signedQuery("bar", 2)
+	tampered.PublicKey, err = codectypes.NewAnyWithValue(privKey.PubKey())
+	require.NoError(t, err)
+	tampered.Signature = make([]byte, 64)
+	tamperedBz, err := tampered.Marshal()
+	require.NoError(t, err)
+	res = app.Query(abci.RequestQuery{Path: path, Data: tamperedBz})
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.Code, res)
+
+	// the signed query expires after the max age
+	nextBlock()
+	res = app.Query(abci.RequestQuery{Path: path, Data: sign(signedQuery("bar", 2), privKey)})
+	require.Equal(t, abci.CodeTypeOK, res.Code, res)
+	nextBlock()
+	res = app.Query(abci.RequestQuery{Path: path, Data: sign(signedQuery("baz", 2), privKey)})
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), res.Code, res)
+	require.Len(t, app.signedQueries.blockHashes, 2)
+}
@@ -0,0 +1,192 @@
+// Package chainregistry loads the metadata of chains from a chain registry,
+// such as https://github.com/cosmos/chain-registry, to configure clients.
+//
+// A registry is a local directory or a remote URL holding a chain.json file
+// for each chain in the directory named after the chain, e.g.
+// <registry>/cosmoshub/chain.json.
+package chainregistry
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultRegistry is the URL of the cosmos chain registry.
+const DefaultRegistry = "https://raw.githubusercontent.com/cosmos/chain-registry/master"
+
+// chainFile is the name of the chain metadata file of a chain in a registry.
+const chainFile = "chain.json"
+
+// httpTimeout bounds the time to fetch the metadata of a chain from a remote
+// registry.
+const httpTimeout = 30 * time.Second
+
+// Chain is the metadata of a chain in a chain registry. Only the fields used
+// to configure clients are decoded.
+type Chain struct {
+	ChainName    string  `json:"chain_name"`
+	ChainID      string  `json:"chain_id"`
+	Bech32Prefix string  `json:"bech32_prefix"`
+	Fees         Fees    `json:"fees"`
+	Staking      Staking `json:"staking"`
+	APIs         APIs    `json:"apis"`
+}
+
+// Fees are the tokens the fees of a chain can be paid with.
+type Fees struct {
+	FeeTokens []FeeToken `json:"fee_tokens"`
+}
+
+// FeeToken is a token the fees of a chain can be paid with, along with its gas
+// prices.
+type FeeToken struct {
+	Denom            string      `json:"denom"`
+	FixedMinGasPrice json.Number `json:"fixed_min_gas_price,omitempty"`
+	LowGasPrice      json.Number `json:"low_gas_price,omitempty"`
+	AverageGasPrice  json.Number `json:"average_gas_price,omitempty"`
+	HighGasPrice     json.Number `json:"high_gas_price,omitempty"`
+}
+
+// Staking are the tokens which can be staked on a chain.
+type Staking struct {
+	StakingTokens []StakingToken `json:"staking_tokens"`
+}
+
+// StakingToken is a token which can be staked on a chain.
+type StakingToken struct {
+	Denom string `json:"denom"`
+}
+
+// APIs are the public endpoints of the nodes of a chain.
+type APIs struct {
+	RPC  []Endpoint `json:"rpc"`
+	REST []Endpoint `json:"rest"`
+	GRPC []Endpoint `json:"grpc"`
+}
+
+// Endpoint is a public endpoint of a node of a chain.
+type Endpoint struct {
+	Address  string `json:"address"`
+	Provider string `json:"provider,omitempty"`
+}
+
+// LoadChain loads the metadata of the chain with the given name from a
+// registry, which is either a local directory or a http(s) URL.
+func LoadChain(registry, name string) (Chain, error) {
+	if name == "" || strings.Contains(name, "..") || strings.ContainsAny(name, `\?#`) {
+		return Chain{}, fmt.Errorf("invalid chain name %q", name)
+	}
+
+	var (
+		bz  []byte
+		err error
+	)
+	if isURL(registry) {
+		bz, err = fetch(strings.TrimSuffix(registry, "/") + "/" + name + "/" + chainFile)
+	} else {
+		bz, err = ioutil.ReadFile(filepath.Join(registry, filepath.FromSlash(name), chainFile))
+	}
+	if err != nil {
+		return Chain{}, fmt.Errorf("couldn't load chain %s from registry %s: %w", name, registry, err)
+	}
+
+	var chain Chain
+	if err := json.Unmarshal(bz, &chain); err != nil {
+		return Chain{}, fmt.Errorf("couldn't decode chain %s: %w", name, err)
+	}
+
+	return chain, chain.Validate()
+}
+
+// Validate performs a basic validation of the metadata of the chain.
+func (c Chain) Validate() error {
+	if c.ChainID == "" {
+		return fmt.Errorf("chain %s has no chain id", c.ChainName)
+	}
+	if c.Bech32Prefix == "" {
+		return fmt.Errorf("chain %s has no bech32 prefix", c.ChainName)
+	}
+
+	for _, token := range c.Fees.FeeTokens {
+		if err := sdk.ValidateDenom(token.Denom); err != nil {
+			return fmt.Errorf("invalid fee token of chain %s: %w", c.ChainName, err)
+		}
+	}
+	for _, token := range c.Staking.StakingTokens {
+		if err := sdk.ValidateDenom(token.Denom); err != nil {
+			return fmt.Errorf("invalid staking token of chain %s: %w", c.ChainName, err)
+		}
+	}
+
+	_, err := c.GasPrices()
+	return err
+}
+
+// GasPrices returns the gas prices to pay the fees of transactions on the
+// chain with: the average gas price of its first fee token, or its low or
+// fixed minimum gas price when the former isn't set. No gas prices are returned
+// when the chain has no fee token or its gas price is zero.
+func (c Chain) GasPrices() (sdk.DecCoins, error) {
+	if len(c.Fees.FeeTokens) == 0 {
+		return sdk.DecCoins{}, nil
+	}
+
+	token := c.Fees.FeeTokens[0]
+	price := token.AverageGasPrice
+	if price == "" {
+		price = token.LowGasPrice
+	}
+	if price == "" {
+		price = token.FixedMinGasPrice
+	}
+	if price == "" {
+		return sdk.DecCoins{}, nil
+	}
+
+	amount, err := sdk.NewDecFromStr(price.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid gas price %s of fee token %s: %w", price, token.Denom, err)
+	}
+	if amount.IsNegative() {
+		return nil, fmt.Errorf("negative gas price %s of fee token %s", price, token.Denom)
+	}
+
+	return sdk.NewDecCoins(sdk.NewDecCoinFromDec(token.Denom, amount)), nil
+}
+
+// RPCAddress returns the address of the first RPC endpoint of the chain, or
+// an empty string if it has none.
+func (c Chain) RPCAddress() string {
+	if len(c.APIs.RPC) == 0 {
+		return ""
+	}
+
+	return c.APIs.RPC[0].Address
+}
+
+func isURL(registry string) bool {
+	return strings.HasPrefix(registry, "http://") || strings.HasPrefix(registry, "https://")
+}
+
+func fetch(url string) ([]byte, error) {
+	client := http.Client{Timeout: httpTimeout}
+
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+
+	return ioutil.ReadAll(resp.Body)
+}
@@ -0,0 +1,121 @@
+package chainregistry_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/chainregistry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const chainJSON = `{
+  "$schema": "../chain.schema.json",
+  "chain_name": "testchain",
+  "status": "live",
+  "chain_id": "testchain-1",
+  "bech32_prefix": "cosmos",
+  "fees": {
+    "fee_tokens": [
+      {"denom": "utest", "fixed_min_gas_price": 0, "low_gas_price": 0.01, "average_gas_price": 0.025, "high_gas_price": 0.03},
+      {"denom": "uother", "average_gas_price": 1}
+    ]
+  },
+  "staking": {"staking_tokens": [{"denom": "utest"}]},
+  "apis": {
+    "rpc": [{"address": "https://rpc.testchain.example:443", "provider": "example"}],
+    "rest": [{"address": "https://api.testchain.example"}],
+    "grpc": [{"address": "grpc.testchain.example:9090"}]
+  }
+}`
+
+func writeRegistry(t *testing.T, name, content string) string {
+	registry := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(registry, name), 0o755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(registry, name, "chain.json"), []byte(content), 0o600))
+	return registry
+}
+
+func TestLoadChain(t *testing.T) {
+	registry := writeRegistry(t, "testchain", chainJSON)
+
+	chain, err := chainregistry.LoadChain(registry, "testchain")
+	require.NoError(t, err)
+	require.Equal(t, "testchain-1", chain.ChainID)
+	require.Equal(t, "cosmos", chain.Bech32Prefix)
+	require.Equal(t, "utest", chain.Staking.StakingTokens[0].Denom)
+	require.Equal(t, "https://rpc.testchain.example:443", chain.RPCAddress())
+	require.Equal(t, "grpc.testchain.example:9090", chain.APIs.GRPC[0].Address)
+
+	gasPrices, err := chain.GasPrices()
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("utest", sdk.NewDecWithPrec(25, 3))), gasPrices)
+
+	_, err = chainregistry.LoadChain(registry, "unknown")
+	require.Error(t, err)
+	_, err = chainregistry.LoadChain(registry, "../testchain")
+	require.Error(t, err)
+}
+
+func TestLoadChainFromURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/testchain/chain.json" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(chainJSON))
+	}))
+	defer server.Close()
+
+	chain, err := chainregistry.LoadChain(server.URL+"/", "testchain")
+	require.NoError(t, err)
+	require.Equal(t, "testchain-1", chain.ChainID)
+
+	_, err = chainregistry.LoadChain(server.URL, "unknown")
+	require.Error(t, err)
+}
+
+func TestChainValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		json   string
+		expErr bool
+	}{
+		{"no chain id", `{"chain_name": "testchain", "bech32_prefix": "cosmos"}`, true},
+		{"no bech32 prefix", `{"chain_name": "testchain", "chain_id": "testchain-1"}`, true},
+		{"invalid fee denom", `{"chain_name": "testchain", "chain_id": "testchain-1", "bech32_prefix": "cosmos", "fees": {"fee_tokens": [{"denom": "1"}]}}`, true},
+		{"negative gas price", `{"chain_name": "testchain", "chain_id": "testchain-1", "bech32_prefix": "cosmos", "fees": {"fee_tokens": [{"denom": "utest", "low_gas_price": -1}]}}`, true},
+		{"no fee tokens", `{"chain_name": "testchain", "chain_id": "testchain-1", "bech32_prefix": "cosmos"}`, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			registry := writeRegistry(t, "testchain", tc.json)
+			_, err := chainregistry.LoadChain(registry, "testchain")
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestChainGasPrices(t *testing.T) {
+	chain := chainregistry.Chain{Fees: chainregistry.Fees{FeeTokens: []chainregistry.FeeToken{{Denom: "utest", LowGasPrice: "0.01"}}}}
+	gasPrices, err := chain.GasPrices()
+	require.NoError(t, err)
+	require.Equal(t, "0.010000000000000000utest", gasPrices.String())
+
+	// a zero gas price doesn't require fees
+	chain.Fees.FeeTokens[0] = chainregistry.FeeToken{Denom: "utest", FixedMinGasPrice: "0"}
+	gasPrices, err = chain.GasPrices()
+	require.NoError(t, err)
+	require.Empty(t, gasPrices)
+}
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/chainregistry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const flagRegistry = "registry"
+
+// chainCmd returns a CLI command to configure the client for a chain of a
+// chain registry.
+func chainCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chain <name>",
+		Short: "Configure the client for a chain of the chain registry",
+		Long: `Load the metadata of a chain from a chain registry, and set the chain ID, the
+node, i.e. the first RPC endpoint of the chain, and the default gas prices of
+the client configuration file from it.
+
+The registry is a local directory or a URL holding a <name>/chain.json file for
+each chain, e.g. a clone of https://github.com/cosmos/chain-registry. The bech32
+prefix of the chain must be the one of the application.`,
+		Example: fmt.Sprintf(`$ %[1]s config chain cosmoshub
+$ %[1]s config chain testnets/theta --registry ~/chain-registry`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: runConfigChainCmd,
+	}
+
+	cmd.Flags().String(flagRegistry, chainregistry.DefaultRegistry, "Local directory or URL of the chain registry")
+
+	return cmd
+}
+
+func runConfigChainCmd(cmd *cobra.Command, args []string) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	configPath := filepath.Join(clientCtx.HomeDir, "config")
+
+	conf, err := getClientConfig(configPath, clientCtx.Viper)
+	if err != nil {
+		return fmt.Errorf("couldn't get client config: %v", err)
+	}
+
+	registry, _ := cmd.Flags().GetString(flagRegistry)
+	chain, err := chainregistry.LoadChain(registry, args[0])
+	if err != nil {
+		return err
+	}
+
+	if prefix := sdk.GetConfig().GetBech32AccountAddrPrefix(); chain.Bech32Prefix != prefix {
+		return fmt.Errorf("chain %s uses the bech32 prefix %q, the application uses %q", args[0], chain.Bech32Prefix, prefix)
+	}
+
+	gasPrices, err := chain.GasPrices()
+	if err != nil {
+		return err
+	}
+
+	conf.SetChainID(chain.ChainID)
+	conf.SetGasPrices(gasPrices.String())
+	if node := chain.RPCAddress(); node != "" {
+		conf.SetNode(node)
+	}
+
+	confFile := filepath.Join(configPath, "client.toml")
+	if err := writeConfigToFile(confFile, conf); err != nil {
+		return fmt.Errorf("could not write client config to the file: %v", err)
+	}
+
+	s, err := json.MarshalIndent(conf, "", "\t")
+	if err != nil {
+		return err
+	}
+	cmd.Println(string(s))
+
+	return nil
+}
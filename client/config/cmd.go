@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Cmd returns a CLI command to interactively create an application CLI
@@ -22,6 +23,7 @@ func Cmd() *cobra.Command {
 		RunE:  runConfigCmd,
 		Args:  cobra.RangeArgs(0, 2),
 	}
+	cmd.AddCommand(chainCmd())
 	return cmd
 }
 
@@ -58,6 +60,8 @@ func runConfigCmd(cmd *cobra.Command, args []string) error {
 			cmd.Println(conf.Node)
 		case flags.FlagBroadcastMode:
 			cmd.Println(conf.BroadcastMode)
+		case flags.FlagGasPrices:
+			cmd.Println(conf.GasPrices)
 		default:
 			err := errUnknownConfigKey(key)
 			return fmt.Errorf("couldn't get the value for the key: %v, error:  %v", key, err)
@@ -78,6 +82,11 @@ func runConfigCmd(cmd *cobra.Command, args []string) error {
 			conf.SetNode(value)
 		case flags.FlagBroadcastMode:
 			conf.SetBroadcastMode(value)
+		case flags.FlagGasPrices:
+			if _, err := sdk.ParseDecCoins(value); err != nil {
+				return fmt.Errorf("invalid gas prices: %w", err)
+			}
+			conf.SetGasPrices(value)
 		default:
 			return errUnknownConfigKey(key)
 		}
@@ -15,6 +15,7 @@ const (
 	output         = "text"
 	node           = "tcp://localhost:26657"
 	broadcastMode  = "sync"
+	gasPrices      = ""
 )
 
 type ClientConfig struct {
@@ -23,11 +24,12 @@ type ClientConfig struct {
 	Output         string `mapstructure:"output" json:"output"`
 	Node           string `mapstructure:"node" json:"node"`
 	BroadcastMode  string `mapstructure:"broadcast-mode" json:"broadcast-mode"`
+	GasPrices      string `mapstructure:"gas-prices" json:"gas-prices"`
 }
 
 // defaultClientConfig returns the reference to ClientConfig with default values.
 func defaultClientConfig() *ClientConfig {
-	return &ClientConfig{chainID, keyringBackend, output, node, broadcastMode}
+	return &ClientConfig{chainID, keyringBackend, output, node, broadcastMode, gasPrices}
 }
 
 func (c *ClientConfig) SetChainID(chainID string) {
@@ -50,6 +52,10 @@ func (c *ClientConfig) SetBroadcastMode(broadcastMode string) {
 	c.BroadcastMode = broadcastMode
 }
 
+func (c *ClientConfig) SetGasPrices(gasPrices string) {
+	c.GasPrices = gasPrices
+}
+
 // ReadFromClientConfig reads values from client.toml file and updates them in client Context
 func ReadFromClientConfig(ctx client.Context) (client.Context, error) {
 	configPath := filepath.Join(ctx.HomeDir, "config")
@@ -91,7 +97,8 @@ func ReadFromClientConfig(ctx client.Context) (client.Context, error) {
 
 	ctx = ctx.WithNodeURI(conf.Node).
 		WithClient(client).
-		WithBroadcastMode(conf.BroadcastMode)
+		WithBroadcastMode(conf.BroadcastMode).
+		WithGasPrices(conf.GasPrices)
 
 	return ctx, nil
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -13,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/config"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/client/cli"
 )
 
@@ -105,3 +107,43 @@ func TestConfigCmdEnvFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigChainCmd(t *testing.T) {
+	clientCtx, cleanup := initClientContext(t, "")
+	defer cleanup()
+
+	registry := t.TempDir()
+	writeChain := func(name, prefix string) {
+		require.NoError(t, os.MkdirAll(filepath.Join(registry, name), 0o755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(registry, name, "chain.json"), []byte(fmt.Sprintf(`{
+  "chain_name": "%s",
+  "chain_id": "%s-1",
+  "bech32_prefix": "%s",
+  "fees": {"fee_tokens": [{"denom": "utest", "average_gas_price": 0.025}]},
+  "apis": {"rpc": [{"address": "%s"}]}
+}`, name, name, prefix, testNode1)), 0o600))
+	}
+	writeChain("testchain", sdk.GetConfig().GetBech32AccountAddrPrefix())
+	writeChain("otherchain", "other")
+
+	cmd := config.Cmd()
+	_, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{"chain", "testchain", "--registry", registry})
+	require.NoError(t, err)
+
+	for key, exp := range map[string]string{
+		flags.FlagChainID:   "testchain-1",
+		flags.FlagNode:      testNode1,
+		flags.FlagGasPrices: "0.025000000000000000utest",
+	} {
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, config.Cmd(), []string{key})
+		require.NoError(t, err)
+		require.Equal(t, exp+"\n", out.String())
+	}
+
+	// the bech32 prefix of the chain must be the one of the application
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, config.Cmd(), []string{"chain", "otherchain", "--registry", registry})
+	require.Error(t, err)
+
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, config.Cmd(), []string{"chain", "unknown", "--registry", registry})
+	require.Error(t, err)
+}
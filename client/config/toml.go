@@ -26,6 +26,8 @@ output = "{{ .Output }}"
 node = "{{ .Node }}"
 # Transaction broadcasting mode (sync|async|block)
 broadcast-mode = "{{ .BroadcastMode }}"
+# Default gas prices to determine the transaction fee when neither the fees nor the gas prices are given (e.g. 0.1uatom)
+gas-prices = "{{ .GasPrices }}"
 `
 
 // writeConfigToFile parses defaultConfigTemplate, renders config using the template and writes it to
@@ -48,6 +48,7 @@ type Context struct {
 	AccountRetriever  AccountRetriever
 	NodeURI           string
 	FeeGranter        sdk.AccAddress
+	GasPrices         string
 	Viper             *viper.Viper
 
 	// TODO: Deprecated (remove).
@@ -189,6 +190,14 @@ func (ctx Context) WithFeeGranterAddress(addr sdk.AccAddress) Context {
 	return ctx
 }
 
+// WithGasPrices returns a copy of the context with updated default gas
+// prices, used to determine the fees of transactions when neither the fees nor
+// the gas prices are given.
+func (ctx Context) WithGasPrices(gasPrices string) Context {
+	ctx.GasPrices = gasPrices
+	return ctx
+}
+
 // WithBroadcastMode returns a copy of the context with an updated broadcast
 // mode.
 func (ctx Context) WithBroadcastMode(mode string) Context {
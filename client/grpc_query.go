@@ -111,6 +111,14 @@ func RunGRPCQuery(ctx Context, grpcCtx gocontext.Context, method string, req int
 		ctx = ctx.WithHeight(height)
 	}
 
+	// wrap the request in the signed query of the signature header
+	if signatures := md.Get(grpctypes.GRPCQuerySignatureHeader); len(signatures) > 0 {
+		reqBz, err = wrapSignedQuery(signatures[0], reqBz)
+		if err != nil {
+			return abci.ResponseQuery{}, nil, err
+		}
+	}
+
 	abciReq := abci.RequestQuery{
 		Path:   method,
 		Data:   reqBz,
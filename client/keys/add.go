@@ -21,15 +21,16 @@ import (
 )
 
 const (
-	flagInteractive = "interactive"
-	flagRecover     = "recover"
-	flagNoBackup    = "no-backup"
-	flagCoinType    = "coin-type"
-	flagAccount     = "account"
-	flagIndex       = "index"
-	flagMultisig    = "multisig"
-	flagNoSort      = "nosort"
-	flagHDPath      = "hd-path"
+	flagInteractive     = "interactive"
+	flagRecover         = "recover"
+	flagNoBackup        = "no-backup"
+	flagCoinType        = "coin-type"
+	flagAccount         = "account"
+	flagIndex           = "index"
+	flagMultisig        = "multisig"
+	flagMultisigWeights = "multisig-weights"
+	flagNoSort          = "nosort"
+	flagHDPath          = "hd-path"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
@@ -58,6 +59,12 @@ sorted by address, unless the flag --nosort is set.
 Example:
 
     keys add mymultisig --multisig "keyname1,keyname2,keyname3" --multisig-threshold 2
+
+A weighted multisig key is created by also passing the weight of each key through
+--multisig-weights, in which case --multisig-threshold is the total weight the signers
+must reach:
+
+    keys add mymultisig --multisig "keyname1,keyname2,keyname3" --multisig-weights 2,1,1 --multisig-threshold 3
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: runAddCmdPrepare,
@@ -65,6 +72,7 @@ Example:
 	f := cmd.Flags()
 	f.StringSlice(flagMultisig, nil, "List of key names stored in keyring to construct a public legacy multisig key")
 	f.Int(flagMultiSigThreshold, 1, "K out of N required signatures. For use in conjunction with --multisig")
+	f.UintSlice(flagMultisigWeights, nil, "Weights of the keys passed to --multisig, to construct a public weighted multisig key")
 	f.Bool(flagNoSort, false, "Keys passed to --multisig are taken in the order they're supplied")
 	f.String(FlagPublicKey, "", "Parse a public key in JSON format and saves key info to <name> file.")
 	f.BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase and mnemonic")
@@ -143,7 +151,12 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		if len(multisigKeys) != 0 {
 			pks := make([]cryptotypes.PubKey, len(multisigKeys))
 			multisigThreshold, _ := cmd.Flags().GetInt(flagMultiSigThreshold)
-			if err := validateMultisigThreshold(multisigThreshold, len(multisigKeys)); err != nil {
+			multisigWeights, _ := cmd.Flags().GetUintSlice(flagMultisigWeights)
+			if len(multisigWeights) != 0 {
+				if err := validateMultisigWeights(multisigThreshold, multisigWeights, len(multisigKeys)); err != nil {
+					return err
+				}
+			} else if err := validateMultisigThreshold(multisigThreshold, len(multisigKeys)); err != nil {
 				return err
 			}
 
@@ -156,13 +169,21 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 				pks[i] = k.GetPubKey()
 			}
 
+			// the weights are sorted along with the keys they belong to
+			weights := make([]uint32, len(multisigWeights))
+			for i, w := range multisigWeights {
+				weights[i] = uint32(w)
+			}
 			if noSort, _ := cmd.Flags().GetBool(flagNoSort); !noSort {
-				sort.Slice(pks, func(i, j int) bool {
-					return bytes.Compare(pks[i].Address(), pks[j].Address()) < 0
-				})
+				sort.Sort(multisigMembers{pks, weights})
 			}
 
-			pk := multisig.NewLegacyAminoPubKey(multisigThreshold, pks)
+			var pk cryptotypes.PubKey
+			if len(weights) != 0 {
+				pk = multisig.NewWeightedPubKey(uint32(multisigThreshold), pks, weights)
+			} else {
+				pk = multisig.NewLegacyAminoPubKey(multisigThreshold, pks)
+			}
 			info, err := kb.SaveMultisig(name, pk)
 			if err != nil {
 				return err
@@ -322,3 +343,23 @@ func printCreate(cmd *cobra.Command, info keyring.Info, showMnemonic bool, mnemo
 
 	return nil
 }
+
+// multisigMembers sorts the keys of a multisig key by address, along with their
+// weights if any.
+type multisigMembers struct {
+	pks     []cryptotypes.PubKey
+	weights []uint32
+}
+
+func (m multisigMembers) Len() int { return len(m.pks) }
+
+func (m multisigMembers) Less(i, j int) bool {
+	return bytes.Compare(m.pks[i].Address(), m.pks[j].Address()) < 0
+}
+
+func (m multisigMembers) Swap(i, j int) {
+	m.pks[i], m.pks[j] = m.pks[j], m.pks[i]
+	if len(m.weights) != 0 {
+		m.weights[i], m.weights[j] = m.weights[j], m.weights[i]
+	}
+}
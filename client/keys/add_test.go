@@ -162,6 +162,17 @@ func Test_runAddCmdDryRun(t *testing.T) {
 			},
 			added: false,
 		},
+		{
+			name: "weighted multisig account is added",
+			args: []string{
+				"testkey",
+				fmt.Sprintf("--%s=%s", flags.FlagDryRun, "false"),
+				fmt.Sprintf("--%s=%s", flagMultisig, "subkey"),
+				fmt.Sprintf("--%s=%s", flagMultisigWeights, "2"),
+				fmt.Sprintf("--%s=%s", flagMultiSigThreshold, "2"),
+			},
+			added: true,
+		},
 		{
 			name: "pubkey account is added",
 			args: []string{
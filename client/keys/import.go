@@ -7,7 +7,9 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
 )
 
 // ImportKeyCommand imports private keys from a keyfile.
@@ -38,3 +40,34 @@ func ImportKeyCommand() *cobra.Command {
 		},
 	}
 }
+
+// ImportKeyHexCommand imports a hex encoded raw private key, such as an Ethereum
+// private key.
+func ImportKeyHexCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-hex <name> <hex>",
+		Short: "Import a hex encoded private key into the local keybase",
+		Long: `Import a hex encoded raw private key into the local keybase, e.g. a private key
+exported from an Ethereum wallet, imported with --key-type=eth_secp256k1 to derive the same
+address as on Ethereum. The key type must be supported by the keyring of the application.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			algoStr, _ := cmd.Flags().GetString(flags.FlagKeyAlgorithm)
+			info, err := clientCtx.Keyring.ImportPrivKeyHex(args[0], args[1], algoStr)
+			if err != nil {
+				return err
+			}
+
+			return printCreate(cmd, info, false, "", clientCtx.OutputFormat)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagKeyAlgorithm, string(hd.Secp256k1Type), "Key signing algorithm of the private key")
+
+	return cmd
+}
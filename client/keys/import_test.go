@@ -12,6 +12,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -116,3 +117,75 @@ HbP+c6JmeJy9JXe2rbbF1QtCX1gLqGcDQPBXiCtFvP7/8wTZtVOPj8vREzhZ9ElO
 		})
 	}
 }
+
+func Test_runImportHexCmd(t *testing.T) {
+	testCases := []struct {
+		name        string
+		keyType     string
+		privKey     string
+		options     []keyring.Option
+		expectError bool
+	}{
+		{
+			name:    "secp256k1 key",
+			keyType: string(hd.Secp256k1Type),
+			privKey: "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318",
+		},
+		{
+			name:    "eth_secp256k1 key",
+			keyType: string(hd.EthSecp256k1Type),
+			privKey: "0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318",
+			options: []keyring.Option{func(options *keyring.Options) {
+				options.SupportedAlgos = keyring.SigningAlgoList{hd.Secp256k1, hd.EthSecp256k1}
+			}},
+		},
+		{
+			name:        "eth_secp256k1 key not supported by the keyring",
+			keyType:     string(hd.EthSecp256k1Type),
+			privKey:     "0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318",
+			expectError: true,
+		},
+		{
+			name:        "invalid hex",
+			keyType:     string(hd.Secp256k1Type),
+			privKey:     "not hex",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := ImportKeyHexCommand()
+			cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+			mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+
+			kbHome := t.TempDir()
+			kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil, tc.options...)
+			require.NoError(t, err)
+
+			clientCtx := client.Context{}.
+				WithKeyringDir(kbHome).
+				WithKeyring(kb).
+				WithKeyringOptions(tc.options...).
+				WithInput(mockIn)
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+			cmd.SetArgs([]string{
+				"keyname1", tc.privKey,
+				fmt.Sprintf("--%s=%s", flags.FlagKeyAlgorithm, tc.keyType),
+				fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+			})
+
+			err = cmd.ExecuteContext(ctx)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			info, err := kb.Key("keyname1")
+			require.NoError(t, err)
+			require.Equal(t, hd.PubKeyType(tc.keyType), info.GetAlgo())
+		})
+	}
+}
@@ -3,6 +3,7 @@ package keys
 import (
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/libs/cli"
@@ -183,6 +184,27 @@ func validateMultisigThreshold(k, nKeys int) error {
 	return nil
 }
 
+func validateMultisigWeights(threshold int, weights []uint, nKeys int) error {
+	if threshold <= 0 || uint64(threshold) > math.MaxUint32 {
+		return fmt.Errorf("threshold must be a positive 32-bit integer")
+	}
+	if len(weights) != nKeys {
+		return fmt.Errorf("number of weights %d differs from number of keys %d", len(weights), nKeys)
+	}
+
+	var total uint64
+	for _, w := range weights {
+		if w == 0 || uint64(w) > math.MaxUint32 {
+			return fmt.Errorf("weights must be positive 32-bit integers")
+		}
+		total += uint64(w)
+	}
+	if total < uint64(threshold) {
+		return fmt.Errorf("weighted multisignature: total weight %d < threshold %d", total, threshold)
+	}
+	return nil
+}
+
 func getBechKeyOut(bechPrefix string) (bechKeyOutFn, error) {
 	switch bechPrefix {
 	case sdk.PrefixAccount:
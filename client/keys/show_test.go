@@ -184,6 +184,34 @@ func Test_validateMultisigThreshold(t *testing.T) {
 	}
 }
 
+func Test_validateMultisigWeights(t *testing.T) {
+	type args struct {
+		k       int
+		weights []uint
+		nKeys   int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{"zero threshold", args{0, []uint{1}, 1}, true},
+		{"missing weight", args{1, []uint{1}, 2}, true},
+		{"zero weight", args{1, []uint{1, 0}, 2}, true},
+		{"unreachable threshold", args{4, []uint{2, 1}, 2}, true},
+		{"reachable threshold", args{3, []uint{2, 1}, 2}, false},
+		{"threshold lower than weight", args{1, []uint{2, 1}, 2}, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateMultisigWeights(tt.args.k, tt.args.weights, tt.args.nKeys); (err != nil) != tt.wantErr {
+				t.Errorf("validateMultisigWeights() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func Test_getBechKeyOut(t *testing.T) {
 	type args struct {
 		bechPrefix string
@@ -0,0 +1,81 @@
+package client
+
+import (
+	gocontext "context"
+	"encoding/base64"
+
+	"google.golang.org/grpc/metadata"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// SignQuery signs the request of the gRPC query method with the key of the
+// from account of ctx, binding it to the chain and to the latest block. It
+// returns the metadata to send along with the query, e.g. in the outgoing
+// context of the call.
+func SignQuery(ctx Context, method string, req interface{}) (metadata.MD, error) {
+	reqBz, err := protoCodec.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := ctx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	block, err := node.Block(gocontext.Background(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	signed := query.SignedQuery{
+		Request:     reqBz,
+		ChainId:     ctx.ChainID,
+		BlockHeight: block.Block.Height,
+		BlockHash:   block.BlockID.Hash,
+	}
+	signBytes, err := signed.GetSignBytes(method)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, pubKey, err := ctx.Keyring.Sign(ctx.GetFromName(), signBytes)
+	if err != nil {
+		return nil, err
+	}
+	signed.PublicKey, err = codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	signed.Signature = sig
+
+	// the request is sent as the query data, and is wrapped back on the
+	// receiving end
+	signed.Request = nil
+	bz, err := signed.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata.Pairs(grpctypes.GRPCQuerySignatureHeader, base64.StdEncoding.EncodeToString(bz)), nil
+}
+
+// wrapSignedQuery wraps the encoded request of a query in the base64 encoded
+// signed query of its signature header.
+func wrapSignedQuery(header string, reqBz []byte) ([]byte, error) {
+	bz, err := base64.StdEncoding.DecodeString(header)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid %s header: %s", grpctypes.GRPCQuerySignatureHeader, err)
+	}
+
+	var signed query.SignedQuery
+	if err := signed.Unmarshal(bz); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid %s header: %s", grpctypes.GRPCQuerySignatureHeader, err)
+	}
+	signed.Request = reqBz
+
+	return signed.Marshal()
+}
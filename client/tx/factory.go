@@ -74,6 +74,9 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 	f = f.WithFees(feesStr)
 
 	gasPricesStr, _ := flagSet.GetString(flags.FlagGasPrices)
+	if gasPricesStr == "" && feesStr == "" {
+		gasPricesStr = clientCtx.GasPrices
+	}
 	f = f.WithGasPrices(gasPricesStr)
 
 	return f
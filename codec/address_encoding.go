@@ -0,0 +1,211 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// AddressEncoding defines the encoding of the addresses and public keys in
+// JSON documents.
+type AddressEncoding string
+
+// Supported address encodings. Bech32 is the default encoding of the JSON
+// codecs, the other ones are meant for integrators using tooling which expects
+// raw bytes, e.g. EVM-style tooling.
+const (
+	// AddressEncodingBech32 encodes the addresses in bech32 and the public keys
+	// in base64.
+	AddressEncodingBech32 AddressEncoding = "bech32"
+	// AddressEncodingHex encodes the addresses and public keys in lowercase
+	// hex prefixed with 0x.
+	AddressEncodingHex AddressEncoding = "hex"
+	// AddressEncodingBase64 encodes the addresses and public keys in standard
+	// base64.
+	AddressEncodingBase64 AddressEncoding = "base64"
+)
+
+// ParseAddressEncoding parses an address encoding, case insensitively. An
+// empty string is the bech32 encoding.
+func ParseAddressEncoding(s string) (AddressEncoding, error) {
+	switch enc := AddressEncoding(strings.ToLower(strings.TrimSpace(s))); enc {
+	case "", AddressEncodingBech32:
+		return AddressEncodingBech32, nil
+	case AddressEncodingHex, AddressEncodingBase64:
+		return enc, nil
+	default:
+		return "", fmt.Errorf("unsupported address encoding %q, expected one of %s, %s or %s",
+			s, AddressEncodingBech32, AddressEncodingHex, AddressEncodingBase64)
+	}
+}
+
+func (e AddressEncoding) encode(bz []byte) string {
+	switch e {
+	case AddressEncodingHex:
+		return "0x" + hex.EncodeToString(bz)
+	case AddressEncodingBase64:
+		return base64.StdEncoding.EncodeToString(bz)
+	default:
+		panic(fmt.Sprintf("unexpected address encoding %q", string(e)))
+	}
+}
+
+// AddressEncoder re-encodes the addresses and public keys of JSON documents
+// produced by the JSON codecs. Addresses are the bech32 strings with one of the
+// configured human readable prefixes, and public keys are the keys of the Any
+// objects whose type URL ends with "PubKey". The order of the object fields is
+// preserved.
+type AddressEncoder struct {
+	encoding AddressEncoding
+	prefixes map[string]bool
+}
+
+// NewAddressEncoder returns an AddressEncoder re-encoding the addresses with
+// the given bech32 prefixes.
+func NewAddressEncoder(encoding AddressEncoding, bech32Prefixes ...string) AddressEncoder {
+	prefixes := make(map[string]bool, len(bech32Prefixes))
+	for _, prefix := range bech32Prefixes {
+		prefixes[prefix] = true
+	}
+
+	return AddressEncoder{encoding: encoding, prefixes: prefixes}
+}
+
+// ReencodeJSON re-encodes the addresses and public keys of a JSON document.
+// The document is returned unchanged for the bech32 encoding, and compacted
+// otherwise.
+func (e AddressEncoder) ReencodeJSON(bz []byte) ([]byte, error) {
+	if e.encoding == AddressEncodingBech32 {
+		return bz, nil
+	}
+
+	buf := new(bytes.Buffer)
+	if err := e.reencode(buf, bz); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (e AddressEncoder) reencode(buf *bytes.Buffer, raw json.RawMessage) error {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return fmt.Errorf("unexpected end of JSON input")
+	}
+
+	switch raw[0] {
+	case '{':
+		return e.reencodeObject(buf, raw)
+
+	case '[':
+		var elems []json.RawMessage
+		if err := json.Unmarshal(raw, &elems); err != nil {
+			return err
+		}
+
+		buf.WriteByte('[')
+		for i, elem := range elems {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := e.reencode(buf, elem); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+
+	case '"':
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return err
+		}
+
+		if hrp, addr, err := bech32.DecodeAndConvert(s); err == nil && e.prefixes[hrp] {
+			s = e.encoding.encode(addr)
+		}
+
+		return writeJSON(buf, s)
+
+	default:
+		return json.Compact(buf, raw)
+	}
+}
+
+func (e AddressEncoder) reencodeObject(buf *bytes.Buffer, raw json.RawMessage) error {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	var (
+		keys   []string
+		values []json.RawMessage
+	)
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+
+		keys = append(keys, token.(string))
+		values = append(values, value)
+	}
+
+	// the public keys are Any objects, e.g. /cosmos.crypto.secp256k1.PubKey
+	isPubKey := false
+	if len(keys) > 0 && keys[0] == "@type" {
+		var typeURL string
+		isPubKey = json.Unmarshal(values[0], &typeURL) == nil && strings.HasSuffix(typeURL, "PubKey")
+	}
+
+	buf.WriteByte('{')
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := writeJSON(buf, key); err != nil {
+			return err
+		}
+		buf.WriteByte(':')
+
+		var pubKey []byte
+		if isPubKey && key == "key" && json.Unmarshal(values[i], &pubKey) == nil {
+			if err := writeJSON(buf, e.encoding.encode(pubKey)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := e.reencode(buf, values[i]); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte('}')
+
+	return nil
+}
+
+// writeJSON writes the JSON encoding of a value without escaping HTML
+// characters, like the JSON codecs.
+func writeJSON(buf *bytes.Buffer, v interface{}) error {
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+
+	// drop the newline appended by the encoder
+	buf.Truncate(buf.Len() - 1)
+	return nil
+}
@@ -0,0 +1,78 @@
+package codec_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+func TestParseAddressEncoding(t *testing.T) {
+	for s, expected := range map[string]codec.AddressEncoding{
+		"":       codec.AddressEncodingBech32,
+		"bech32": codec.AddressEncodingBech32,
+		" HEX ":  codec.AddressEncodingHex,
+		"base64": codec.AddressEncodingBase64,
+	} {
+		enc, err := codec.ParseAddressEncoding(s)
+		require.NoError(t, err)
+		require.Equal(t, expected, enc)
+	}
+
+	_, err := codec.ParseAddressEncoding("base58")
+	require.Error(t, err)
+}
+
+func TestAddressEncoderReencodeJSON(t *testing.T) {
+	addr := []byte{0x01, 0x02, 0x03, 0xab}
+	accAddr, err := bech32.ConvertAndEncode("cosmos", addr)
+	require.NoError(t, err)
+	valAddr, err := bech32.ConvertAndEncode("cosmosvaloper", addr)
+	require.NoError(t, err)
+	otherAddr, err := bech32.ConvertAndEncode("osmo", addr)
+	require.NoError(t, err)
+	pubKey := []byte{0x02, 0xff}
+
+	doc := fmt.Sprintf(`{
+  "address": "%s",
+  "pub_key": {"@type": "/cosmos.crypto.secp256k1.PubKey", "key": "%s"},
+  "validators": ["%s", "%s"],
+  "other": {"key": "%s", "amount": 10.50, "denom": "<stake>"}
+}`, accAddr, base64.StdEncoding.EncodeToString(pubKey), valAddr, otherAddr, base64.StdEncoding.EncodeToString(pubKey))
+
+	testCases := []struct {
+		encoding codec.AddressEncoding
+		expected string
+	}{
+		{
+			codec.AddressEncodingHex,
+			fmt.Sprintf(`{"address":"0x010203ab","pub_key":{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"0x02ff"},"validators":["0x010203ab","%s"],"other":{"key":"Av8=","amount":10.50,"denom":"<stake>"}}`, otherAddr),
+		},
+		{
+			codec.AddressEncodingBase64,
+			fmt.Sprintf(`{"address":"AQIDqw==","pub_key":{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"Av8="},"validators":["AQIDqw==","%s"],"other":{"key":"Av8=","amount":10.50,"denom":"<stake>"}}`, otherAddr),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(string(tc.encoding), func(t *testing.T) {
+			encoder := codec.NewAddressEncoder(tc.encoding, "cosmos", "cosmosvaloper")
+			bz, err := encoder.ReencodeJSON([]byte(doc))
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, string(bz))
+		})
+	}
+
+	// the document is unchanged for the bech32 encoding
+	bz, err := codec.NewAddressEncoder(codec.AddressEncodingBech32, "cosmos").ReencodeJSON([]byte(doc))
+	require.NoError(t, err)
+	require.Equal(t, doc, string(bz))
+
+	_, err = codec.NewAddressEncoder(codec.AddressEncodingHex, "cosmos").ReencodeJSON([]byte(`{"address": `))
+	require.Error(t, err)
+}
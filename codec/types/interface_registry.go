@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/armon/go-metrics"
 	"github.com/gogo/protobuf/jsonpb"
-
 	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // AnyUnpacker is an interface which allows safely unpacking types packed
@@ -193,6 +196,11 @@ func (registry *interfaceRegistry) ListImplementations(ifaceName string) []strin
 	return keys
 }
 
+// UnpackAny unpacks the value in any to the interface pointer passed in as
+// iface. The type URL of any must be registered as an implementation of that
+// interface, including when any already caches a value, so that an Any field
+// only ever holds one of the types allowed for the interface it declares.
+// Rejected Anys are counted by the codec_any_rejected metric.
 func (registry *interfaceRegistry) UnpackAny(any *Any, iface interface{}) error {
 	// here we gracefully handle the case in which `any` itself is `nil`, which may occur in message decoding
 	if any == nil {
@@ -200,6 +208,11 @@ func (registry *interfaceRegistry) UnpackAny(any *Any, iface interface{}) error
 	}
 
 	if any.TypeUrl == "" {
+		// a value without a type URL can't be checked against the interface
+		if len(any.Value) != 0 {
+			return rejectAny(iface, "empty_type_url", "Any with an empty type URL holds a value")
+		}
+
 		// if TypeUrl is empty return nil because without it we can't actually unpack anything
 		return nil
 	}
@@ -211,22 +224,26 @@ func (registry *interfaceRegistry) UnpackAny(any *Any, iface interface{}) error
 
 	rt := rv.Elem().Type()
 
-	cachedValue := any.cachedValue
-	if cachedValue != nil {
-		if reflect.TypeOf(cachedValue).AssignableTo(rt) {
-			rv.Elem().Set(reflect.ValueOf(cachedValue))
-			return nil
-		}
-	}
-
 	imap, found := registry.interfaceImpls[rt]
 	if !found {
-		return fmt.Errorf("no registered implementations of type %+v", rt)
+		return rejectAny(iface, "unregistered_interface", "no registered implementations of type %+v", rt)
 	}
 
 	typ, found := imap[any.TypeUrl]
 	if !found {
-		return fmt.Errorf("no concrete type registered for type URL %s against interface %T", any.TypeUrl, iface)
+		return rejectAny(iface, "unregistered_type_url", "no concrete type registered for type URL %s against interface %T", any.TypeUrl, iface)
+	}
+
+	cachedValue := any.cachedValue
+	if cachedValue != nil {
+		// the cached value must be of the type registered for the type URL,
+		// not of any type assignable to the interface
+		if reflect.TypeOf(cachedValue) != typ {
+			return rejectAny(iface, "cached_type_mismatch", "cached value of type %T doesn't match the type %s registered for type URL %s", cachedValue, typ, any.TypeUrl)
+		}
+
+		rv.Elem().Set(reflect.ValueOf(cachedValue))
+		return nil
 	}
 
 	msg, ok := reflect.New(typ.Elem()).Interface().(proto.Message)
@@ -276,3 +293,18 @@ func UnpackInterfaces(x interface{}, unpacker AnyUnpacker) error {
 	}
 	return nil
 }
+
+// rejectAny records the rejection of an Any unpacked to iface for the given
+// reason and returns the corresponding error.
+func rejectAny(iface interface{}, reason string, format string, args ...interface{}) error {
+	telemetry.IncrCounterWithLabels(
+		[]string{"codec", "any", "rejected"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("interface", fmt.Sprintf("%T", iface)),
+			telemetry.NewLabel("reason", reason),
+		},
+	)
+
+	return sdkerrors.Wrapf(sdkerrors.ErrUnregisteredAnyType, format, args...)
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestAnyPackUnpack(t *testing.T) {
@@ -88,6 +90,45 @@ func TestRegister(t *testing.T) {
 	)
 }
 
+func TestUnpackAnyAllowlist(t *testing.T) {
+	registry := types.NewInterfaceRegistry()
+	registry.RegisterInterface("Animal", (*testdata.Animal)(nil))
+	registry.RegisterImplementations((*testdata.Animal)(nil), &testdata.Dog{})
+
+	cat, err := types.NewAnyWithValue(&testdata.Cat{Moniker: "Garfield"})
+	require.NoError(t, err)
+	fakeDog, err := types.NewAnyWithValue(&FakeDog{})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		any  *types.Any
+	}{
+		{"unregistered type URL", &types.Any{TypeUrl: cat.TypeUrl, Value: cat.Value}},
+		// a cached value is only accepted if its type URL is registered for the
+		// interface, even if it implements the interface
+		{"cached value of an unregistered type URL", cat},
+		{"cached value of another type than registered", fakeDog},
+		{"empty type URL with a value", &types.Any{Value: cat.Value}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var animal testdata.Animal
+			err := registry.UnpackAny(tc.any, &animal)
+			require.ErrorIs(t, err, sdkerrors.ErrUnregisteredAnyType)
+			require.Nil(t, animal)
+		})
+	}
+
+	// the type URL must be registered for the interface it is unpacked to,
+	// not any other one
+	var msg sdk.Msg
+	err = registry.UnpackAny(&types.Any{TypeUrl: "/testdata.Dog"}, &msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnregisteredAnyType)
+}
+
 func TestUnpackInterfaces(t *testing.T) {
 	registry := testdata.NewTestInterfaceRegistry()
 
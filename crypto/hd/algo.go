@@ -3,6 +3,7 @@ package hd
 import (
 	bip39 "github.com/cosmos/go-bip39"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
@@ -20,11 +21,21 @@ const (
 	Ed25519Type = PubKeyType("ed25519")
 	// Sr25519Type represents the Sr25519Type signature system.
 	Sr25519Type = PubKeyType("sr25519")
+	// EthSecp256k1Type uses the Ethereum secp256k1 ECDSA parameters, with
+	// Keccak-256 based addresses and EIP-191 signatures.
+	EthSecp256k1Type = PubKeyType("eth_secp256k1")
 )
 
+// EthCoinType is the BIP44 coin type of Ether, used by Ethereum wallets to derive
+// their keys.
+const EthCoinType = 60
+
 var (
 	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1 = secp256k1Algo{}
+	// EthSecp256k1 uses the Ethereum secp256k1 ECDSA parameters. It is not
+	// supported by default and must be registered in the keyring options.
+	EthSecp256k1 = ethSecp256k1Algo{}
 )
 
 type DeriveFn func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error)
@@ -69,3 +80,27 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: bzArr}
 	}
 }
+
+type ethSecp256k1Algo struct {
+}
+
+func (s ethSecp256k1Algo) Name() PubKeyType {
+	return EthSecp256k1Type
+}
+
+// Derive derives and returns the Ethereum secp256k1 private key for the given
+// seed and HD path. The derivation is the same as for secp256k1, Ethereum
+// wallets using the coin type EthCoinType.
+func (s ethSecp256k1Algo) Derive() DeriveFn {
+	return Secp256k1.Derive()
+}
+
+// Generate generates an Ethereum secp256k1 private key from the given bytes.
+func (s ethSecp256k1Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		var bzArr = make([]byte, ethsecp256k1.PrivKeySize)
+		copy(bzArr, bz)
+
+		return &ethsecp256k1.PrivKey{Key: bzArr}
+	}
+}
@@ -196,8 +196,10 @@ type multiInfo struct {
 
 // NewMultiInfo creates a new multiInfo instance
 func NewMultiInfo(name string, pub cryptotypes.PubKey) (Info, error) {
-	if _, ok := pub.(*multisig.LegacyAminoPubKey); !ok {
-		return nil, fmt.Errorf("MultiInfo supports only multisig.LegacyAminoPubKey, got  %T", pub)
+	switch pub.(type) {
+	case *multisig.LegacyAminoPubKey, *multisig.WeightedPubKey:
+	default:
+		return nil, fmt.Errorf("MultiInfo supports only multisig.LegacyAminoPubKey and multisig.WeightedPubKey, got  %T", pub)
 	}
 	return &multiInfo{
 		Name:   name,
@@ -237,9 +239,7 @@ func (i multiInfo) GetPath() (*hd.BIP44Params, error) {
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (i multiInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
-	multiPK := i.PubKey.(*multisig.LegacyAminoPubKey)
-
-	return codectypes.UnpackInterfaces(multiPK, unpacker)
+	return codectypes.UnpackInterfaces(i.PubKey, unpacker)
 }
 
 // encoding info
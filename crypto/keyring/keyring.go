@@ -2,6 +2,7 @@ package keyring
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -120,6 +121,10 @@ type Importer interface {
 
 	// ImportPubKey imports ASCII armored public keys.
 	ImportPubKey(uid string, armor string) error
+
+	// ImportPrivKeyHex imports a hex encoded raw private key of the given
+	// signing algorithm, such as an Ethereum private key.
+	ImportPrivKeyHex(uid, privKey, algoStr string) (Info, error)
 }
 
 // LegacyInfoImporter is implemented by key stores that support import of Info types.
@@ -313,6 +318,34 @@ func (ks keystore) ImportPrivKey(uid, armor, passphrase string) error {
 	return nil
 }
 
+func (ks keystore) ImportPrivKeyHex(uid, privKey, algoStr string) (Info, error) {
+	if _, err := ks.Key(uid); err == nil {
+		return nil, fmt.Errorf("cannot overwrite key: %s", uid)
+	}
+
+	algo, err := NewSigningAlgoFromString(algoStr, ks.options.SupportedAlgos)
+	if err != nil {
+		return nil, errors.Wrap(ErrUnsupportedSigningAlgo, err.Error())
+	}
+
+	bz, err := hex.DecodeString(strings.TrimPrefix(privKey, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode private key")
+	}
+
+	priv := algo.Generate()(bz)
+	if !bytes.Equal(priv.Bytes(), bz) {
+		return nil, fmt.Errorf("invalid %s private key size: %d", algo.Name(), len(bz))
+	}
+
+	address := sdk.AccAddress(priv.PubKey().Address())
+	if _, err := ks.KeyByAddress(address); err == nil {
+		return nil, fmt.Errorf("account with address %s already exists in keyring, delete the key first if you want to recreate it", address)
+	}
+
+	return ks.writeLocalKey(uid, priv, algo.Name())
+}
+
 func (ks keystore) ImportPubKey(uid string, armor string) error {
 	if _, err := ks.Key(uid); err == nil {
 		return fmt.Errorf("cannot overwrite key: %s", uid)
@@ -619,6 +619,47 @@ func TestInMemoryExportImportPrivKey(t *testing.T) {
 	require.True(t, priv1.GetPubKey().Equals(priv2.GetPubKey()))
 }
 
+func TestInMemoryImportPrivKeyHex(t *testing.T) {
+	// Ethereum private key and address
+	privKey := "0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+	ethAddr := "2c7536e3605d9c16a7a3d7b1898e529396a65c23"
+
+	// the eth_secp256k1 algorithm is not supported by default
+	kb := NewInMemory()
+	_, err := kb.ImportPrivKeyHex("eth", privKey, string(hd.EthSecp256k1Type))
+	require.ErrorIs(t, err, ErrUnsupportedSigningAlgo)
+
+	kb = NewInMemory(func(options *Options) {
+		options.SupportedAlgos = SigningAlgoList{hd.Secp256k1, hd.EthSecp256k1}
+	})
+
+	info, err := kb.ImportPrivKeyHex("eth", privKey, string(hd.EthSecp256k1Type))
+	require.NoError(t, err)
+	require.Equal(t, "eth", info.GetName())
+	require.Equal(t, hd.EthSecp256k1Type, info.GetAlgo())
+	require.Equal(t, ethAddr, hex.EncodeToString(info.GetAddress()))
+
+	// the key signs EIP-191 messages
+	msg := []byte("Some data")
+	sig, pub, err := kb.Sign("eth", msg)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// the same private key imported as secp256k1 has another address
+	info, err = kb.ImportPrivKeyHex("cosmos", privKey, string(hd.Secp256k1Type))
+	require.NoError(t, err)
+	require.NotEqual(t, ethAddr, hex.EncodeToString(info.GetAddress()))
+
+	_, err = kb.ImportPrivKeyHex("eth", privKey, string(hd.EthSecp256k1Type))
+	require.Error(t, err)
+	_, err = kb.ImportPrivKeyHex("eth2", privKey, string(hd.EthSecp256k1Type))
+	require.Error(t, err)
+	_, err = kb.ImportPrivKeyHex("invalid", "zz", string(hd.EthSecp256k1Type))
+	require.Error(t, err)
+	_, err = kb.ImportPrivKeyHex("short", "4c0883a6", string(hd.EthSecp256k1Type))
+	require.Error(t, err)
+}
+
 func TestInMemoryExportImportPubKey(t *testing.T) {
 	// make the storage with reasonable defaults
 	cstore := NewInMemory()
@@ -964,6 +1005,34 @@ func TestAltKeyring_SaveMultisig(t *testing.T) {
 	require.Len(t, list, 3)
 }
 
+func TestAltKeyring_SaveWeightedMultisig(t *testing.T) {
+	keyring, err := New(t.Name(), BackendTest, t.TempDir(), nil)
+	require.NoError(t, err)
+
+	mnemonic1, _, err := keyring.NewMnemonic("key1", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	mnemonic2, _, err := keyring.NewMnemonic("key2", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	key := "weighted"
+	pub := multisig.NewWeightedPubKey(
+		2,
+		[]types.PubKey{
+			&secp256k1.PubKey{Key: mnemonic1.GetPubKey().Bytes()},
+			&secp256k1.PubKey{Key: mnemonic2.GetPubKey().Bytes()},
+		},
+		[]uint32{2, 1},
+	)
+
+	_, err = keyring.SaveMultisig(key, pub)
+	require.NoError(t, err)
+
+	info, err := keyring.Key(key)
+	require.NoError(t, err)
+	require.True(t, pub.Equals(info.GetPubKey()))
+	require.Equal(t, TypeMulti, info.GetType())
+}
+
 func TestAltKeyring_Sign(t *testing.T) {
 	keyring, err := New(t.Name(), BackendTest, t.TempDir(), nil)
 	require.NoError(t, err)
@@ -0,0 +1,284 @@
+// Package ethsecp256k1 implements Ethereum compatible secp256k1 keys, allowing
+// users to reuse their Ethereum private keys. The keys share the secp256k1 curve
+// with the secp256k1 keys, but derive Ethereum addresses from Keccak-256 hashes
+// and sign EIP-191 personal messages.
+package ethsecp256k1
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+	"github.com/tendermint/tendermint/crypto"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ cryptotypes.PrivKey = &PrivKey{}
+var _ codec.AminoMarshaler = &PrivKey{}
+
+const (
+	PrivKeySize = 32
+	keyType     = "eth_secp256k1"
+	PrivKeyName = "cosmos/PrivKeyEthSecp256k1"
+	PubKeyName  = "cosmos/PubKeyEthSecp256k1"
+
+	// SignatureSize is the size of the signatures, of the form R || S || V as
+	// produced by Ethereum wallets.
+	SignatureSize = 65
+)
+
+// eip191Prefix is the prefix of the EIP-191 personal messages (version 0x45).
+const eip191Prefix = "\x19Ethereum Signed Message:\n"
+
+// used to reject malleable signatures
+var secp256k1halfN = new(big.Int).Rsh(secp256k1.S256().N, 1)
+
+// Keccak256 returns the Keccak-256 hash of the given data, as used by Ethereum.
+func Keccak256(data ...[]byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	for _, bz := range data {
+		hasher.Write(bz) // does not error
+	}
+	return hasher.Sum(nil)
+}
+
+// EIP191Hash returns the hash signed for msg, i.e. the Keccak-256 hash of msg
+// wrapped as an EIP-191 personal message, as signed by Ethereum wallets through
+// personal_sign.
+func EIP191Hash(msg []byte) []byte {
+	return Keccak256([]byte(eip191Prefix+strconv.Itoa(len(msg))), msg)
+}
+
+// Bytes returns the byte representation of the Private Key.
+func (privKey *PrivKey) Bytes() []byte {
+	return privKey.Key
+}
+
+// PubKey performs the point-scalar multiplication from the privKey on the
+// generator point to get the pubkey.
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	_, pubkeyObject := secp256k1.PrivKeyFromBytes(secp256k1.S256(), privKey.Key)
+	pk := pubkeyObject.SerializeCompressed()
+	return &PubKey{Key: pk}
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+func (privKey *PrivKey) Type() string {
+	return keyType
+}
+
+// Sign creates a recoverable ECDSA signature on curve Secp256k1 of the EIP-191
+// hash of msg. The returned signature is of the form R || S || V (in lower-S
+// form), V being the recovery ID (0 or 1).
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	priv, _ := secp256k1.PrivKeyFromBytes(secp256k1.S256(), privKey.Key)
+	sig, err := secp256k1.SignCompact(secp256k1.S256(), priv, EIP191Hash(msg), false)
+	if err != nil {
+		return nil, err
+	}
+
+	// convert from the V || R || S form with V = 27 + recovery ID
+	return append(sig[1:], sig[0]-27), nil
+}
+
+// MarshalAmino overrides Amino binary marshalling.
+func (privKey PrivKey) MarshalAmino() ([]byte, error) {
+	return privKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PrivKeySize {
+		return fmt.Errorf("invalid privkey size")
+	}
+	privKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (privKey PrivKey) MarshalAminoJSON() ([]byte, error) {
+	return privKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return privKey.UnmarshalAmino(bz)
+}
+
+// GenPrivKey generates a new Ethereum secp256k1 private key. It uses OS
+// randomness to generate the private key.
+func GenPrivKey() *PrivKey {
+	priv, err := secp256k1.NewPrivateKey(secp256k1.S256())
+	if err != nil {
+		panic(err)
+	}
+
+	key := make([]byte, PrivKeySize)
+	d := priv.D.Bytes()
+	copy(key[PrivKeySize-len(d):], d)
+
+	return &PrivKey{Key: key}
+}
+
+// PrivKeyFromBytes returns the Ethereum secp256k1 private key of the given raw
+// bytes, e.g. an Ethereum private key decoded from hex.
+func PrivKeyFromBytes(bz []byte) (*PrivKey, error) {
+	if len(bz) != PrivKeySize {
+		return nil, fmt.Errorf("invalid privkey size: expected %d, got %d", PrivKeySize, len(bz))
+	}
+
+	d := new(big.Int).SetBytes(bz)
+	if d.Sign() == 0 || d.Cmp(secp256k1.S256().N) >= 0 {
+		return nil, fmt.Errorf("invalid privkey: out of the curve order")
+	}
+
+	return &PrivKey{Key: append([]byte(nil), bz...)}, nil
+}
+
+//-------------------------------------
+
+var _ cryptotypes.PubKey = &PubKey{}
+var _ codec.AminoMarshaler = &PubKey{}
+
+// PubKeySize is comprised of 32 bytes for one field element
+// (the x-coordinate), plus one byte for the parity of the y-coordinate.
+const PubKeySize = 33
+
+// Address returns an Ethereum style address: the last 20 bytes of the
+// Keccak-256 hash of the uncompressed pubkey (without its 0x04 prefix).
+func (pubKey *PubKey) Address() crypto.Address {
+	if len(pubKey.Key) != PubKeySize {
+		panic("length of pubkey is incorrect")
+	}
+
+	pub, err := secp256k1.ParsePubKey(pubKey.Key, secp256k1.S256())
+	if err != nil {
+		panic(err)
+	}
+
+	return crypto.Address(Keccak256(pub.SerializeUncompressed()[1:])[12:])
+}
+
+// Bytes returns the pubkey byte format.
+func (pubKey *PubKey) Bytes() []byte {
+	return pubKey.Key
+}
+
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("PubKeyEthSecp256k1{%X}", pubKey.Key)
+}
+
+func (pubKey *PubKey) Type() string {
+	return keyType
+}
+
+func (pubKey *PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// VerifySignature verifies a signature of the form R || S || V, or R || S, of
+// the EIP-191 hash of msg. It rejects signatures which are not in lower-S form.
+func (pubKey *PubKey) VerifySignature(msg []byte, sig []byte) bool {
+	if len(sig) != SignatureSize && len(sig) != SignatureSize-1 {
+		return false
+	}
+
+	pub, err := secp256k1.ParsePubKey(pubKey.Key, secp256k1.S256())
+	if err != nil {
+		return false
+	}
+
+	signature := &secp256k1.Signature{
+		R: new(big.Int).SetBytes(sig[:32]),
+		S: new(big.Int).SetBytes(sig[32:64]),
+	}
+	// Reject malleable signatures.
+	if signature.S.Cmp(secp256k1halfN) > 0 {
+		return false
+	}
+
+	return signature.Verify(EIP191Hash(msg), pub)
+}
+
+// MarshalAmino overrides Amino binary marshalling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	return pubKey.Key.Unmarshal(bz)
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
+
+// ethPK is the compressed form of an Ethereum secp256k1 pubkey, which is
+// checked to be a point of the curve when decoded.
+type ethPK []byte
+
+// Size implements proto.Marshaler interface
+func (pk ethPK) Size() int {
+	return len(pk)
+}
+
+// MarshalTo implements proto.Marshaler interface
+func (pk ethPK) MarshalTo(dAtA []byte) (int, error) {
+	return copy(dAtA, pk), nil
+}
+
+// Marshal implements proto.Marshaler interface
+func (pk ethPK) Marshal() ([]byte, error) {
+	return append([]byte{}, pk...), nil
+}
+
+// Unmarshal implements proto.Marshaler interface. It rejects the keys which
+// are not the compressed form of a point of the secp256k1 curve.
+func (pk *ethPK) Unmarshal(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return errors.Wrap(errors.ErrInvalidPubKey, "invalid pubkey size")
+	}
+	if _, err := secp256k1.ParsePubKey(bz, secp256k1.S256()); err != nil {
+		return errors.Wrap(errors.ErrInvalidPubKey, err.Error())
+	}
+	*pk = append((*pk)[:0], bz...)
+
+	return nil
+}
+
+// MarshalJSON encodes the key as a base64 string, as the protobuf JSON
+// encoding of bytes.
+func (pk ethPK) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]byte(pk))
+}
+
+// UnmarshalJSON decodes a key encoded by MarshalJSON.
+func (pk *ethPK) UnmarshalJSON(bz []byte) error {
+	var key []byte
+	if err := json.Unmarshal(bz, &key); err != nil {
+		return err
+	}
+
+	return pk.Unmarshal(key)
+}
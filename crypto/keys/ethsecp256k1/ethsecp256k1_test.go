@@ -0,0 +1,160 @@
+package ethsecp256k1_test
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+// test vector of the web3.js documentation of web3.eth.accounts.sign
+const (
+	testPrivKey = "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+	testAddress = "2c7536e3605d9c16a7a3d7b1898e529396a65c23"
+	testMsg     = "Some data"
+	testHash    = "1da44b586eb0729ff70a73c326926f6ed5a25f5b056e7f47fbc6e58d86871655"
+	testSig     = "b91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a029"
+	testSigV    = 1 // 0x1c - 27
+)
+
+func TestPubKeyAddress(t *testing.T) {
+	bz, err := hex.DecodeString(testPrivKey)
+	require.NoError(t, err)
+
+	privKey, err := ethsecp256k1.PrivKeyFromBytes(bz)
+	require.NoError(t, err)
+	require.Equal(t, testAddress, hex.EncodeToString(privKey.PubKey().Address()))
+}
+
+func TestEIP191Sign(t *testing.T) {
+	bz, err := hex.DecodeString(testPrivKey)
+	require.NoError(t, err)
+	privKey, err := ethsecp256k1.PrivKeyFromBytes(bz)
+	require.NoError(t, err)
+
+	require.Equal(t, testHash, hex.EncodeToString(ethsecp256k1.EIP191Hash([]byte(testMsg))))
+
+	sig, err := privKey.Sign([]byte(testMsg))
+	require.NoError(t, err)
+	require.Len(t, sig, ethsecp256k1.SignatureSize)
+	require.Equal(t, testSig, hex.EncodeToString(sig[:64]))
+	require.Equal(t, byte(testSigV), sig[64])
+
+	pubKey := privKey.PubKey()
+	require.True(t, pubKey.VerifySignature([]byte(testMsg), sig))
+	require.True(t, pubKey.VerifySignature([]byte(testMsg), sig[:64]))
+	require.False(t, pubKey.VerifySignature([]byte("Other data"), sig))
+	require.False(t, pubKey.VerifySignature([]byte(testMsg), sig[:63]))
+}
+
+func TestSignAndValidate(t *testing.T) {
+	privKey := ethsecp256k1.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	msg := crypto.CRandBytes(1000)
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	// mutate the signature, just one bit
+	sig[7] ^= byte(0x01)
+	require.False(t, pubKey.VerifySignature(msg, sig))
+
+	// a secp256k1 key with the same bytes signs and verifies differently
+	secpKey := &secp256k1.PrivKey{Key: privKey.Key}
+	require.False(t, privKey.Equals(secpKey))
+	secpSig, err := secpKey.Sign(msg)
+	require.NoError(t, err)
+	require.False(t, pubKey.VerifySignature(msg, secpSig))
+	require.NotEqual(t, secpKey.PubKey().Address(), pubKey.Address())
+}
+
+func TestPrivKeyFromBytes(t *testing.T) {
+	_, err := ethsecp256k1.PrivKeyFromBytes(make([]byte, 31))
+	require.Error(t, err)
+
+	_, err = ethsecp256k1.PrivKeyFromBytes(make([]byte, ethsecp256k1.PrivKeySize))
+	require.Error(t, err)
+}
+
+func TestMarshalAmino(t *testing.T) {
+	aminoCdc := codec.NewLegacyAmino()
+	privKey := ethsecp256k1.GenPrivKey()
+
+	testCases := []struct {
+		desc      string
+		msg       codec.AminoMarshaler
+		typ       interface{}
+		expBinary []byte
+		expJSON   string
+	}{
+		{
+			"eth_secp256k1 private key",
+			privKey,
+			&ethsecp256k1.PrivKey{},
+			append([]byte{32}, privKey.Bytes()...), // Length-prefixed.
+			"\"" + base64.StdEncoding.EncodeToString(privKey.Bytes()) + "\"",
+		},
+		{
+			"eth_secp256k1 public key",
+			privKey.PubKey().(*ethsecp256k1.PubKey),
+			&ethsecp256k1.PubKey{},
+			append([]byte{33}, privKey.PubKey().Bytes()...), // Length-prefixed.
+			"\"" + base64.StdEncoding.EncodeToString(privKey.PubKey().Bytes()) + "\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			// Do a round trip of encoding/decoding binary.
+			bz, err := aminoCdc.Marshal(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expBinary, bz)
+
+			err = aminoCdc.Unmarshal(bz, tc.typ)
+			require.NoError(t, err)
+			require.Equal(t, tc.msg, tc.typ)
+
+			// Do a round trip of encoding/decoding JSON.
+			bz, err = aminoCdc.MarshalJSON(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expJSON, string(bz))
+
+			err = aminoCdc.UnmarshalJSON(bz, tc.typ)
+			require.NoError(t, err)
+			require.Equal(t, tc.msg, tc.typ)
+		})
+	}
+}
+
+func TestUnmarshalOffCurvePubKey(t *testing.T) {
+	aminoCdc := codec.NewLegacyAmino()
+
+	// the x-coordinate 5 has no matching y-coordinate on the curve
+	offCurve := make([]byte, ethsecp256k1.PubKeySize)
+	offCurve[0], offCurve[32] = 0x02, 0x05
+
+	bz, err := (&ethsecp256k1.PubKey{Key: offCurve}).Marshal()
+	require.NoError(t, err)
+	require.Error(t, (&ethsecp256k1.PubKey{}).Unmarshal(bz))
+	require.Error(t, (&ethsecp256k1.PubKey{}).UnmarshalAmino(offCurve))
+
+	bz, err = aminoCdc.Marshal(&ethsecp256k1.PubKey{Key: offCurve})
+	require.NoError(t, err)
+	require.Error(t, aminoCdc.Unmarshal(bz, &ethsecp256k1.PubKey{}))
+
+	// the keys on the curve are decoded
+	pubKey := ethsecp256k1.GenPrivKey().PubKey().(*ethsecp256k1.PubKey)
+	bz, err = pubKey.Marshal()
+	require.NoError(t, err)
+	var decoded ethsecp256k1.PubKey
+	require.NoError(t, decoded.Unmarshal(bz))
+	require.True(t, pubKey.Equals(&decoded))
+	require.Equal(t, pubKey.Address(), decoded.Address())
+}
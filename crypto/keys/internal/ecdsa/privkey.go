@@ -61,6 +61,27 @@ func GenPrivKey(curve elliptic.Curve) (PrivKey, error) {
 	return PrivKey{*key}, nil
 }
 
+// GenPrivKeyFromSecret hashes the secret with SHA2, and uses that 32 byte
+// output to create the private key, which is a valid field element as:
+//
+// c = sha256(secret)
+// k = (c mod (n − 1)) + 1, where n = curve order.
+//
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(curve elliptic.Curve, secret []byte) PrivKey {
+	secHash := sha256.Sum256(secret)
+	one := big.NewInt(1)
+	d := new(big.Int).SetBytes(secHash[:])
+	d.Mod(d, new(big.Int).Sub(curve.Params().N, one))
+	d.Add(d, one)
+
+	key := ecdsa.PrivateKey{D: d}
+	key.Curve = curve
+	key.X, key.Y = curve.ScalarBaseMult(d.Bytes())
+	return PrivKey{key}
+}
+
 type PrivKey struct {
 	ecdsa.PrivateKey
 }
@@ -12,7 +12,8 @@ import (
 // TODO: Figure out API for others to either add their own pubkey types, or
 // to make verify / marshal accept a AminoCdc.
 const (
-	PubKeyAminoRoute = "tendermint/PubKeyMultisigThreshold"
+	PubKeyAminoRoute         = "tendermint/PubKeyMultisigThreshold"
+	WeightedPubKeyAminoRoute = "cosmos-sdk/PubKeyWeightedMultisig"
 )
 
 //nolint
@@ -30,4 +31,6 @@ func init() {
 		secp256k1.PubKeyName, nil)
 	AminoCdc.RegisterConcrete(&LegacyAminoPubKey{},
 		PubKeyAminoRoute, nil)
+	AminoCdc.RegisterConcrete(&WeightedPubKey{},
+		WeightedPubKeyAminoRoute, nil)
 }
@@ -65,32 +65,121 @@ func (m *LegacyAminoPubKey) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LegacyAminoPubKey proto.InternalMessageInfo
 
+// WeightedPubKey specifies a public key type which nests multiple weighted
+// public keys and a threshold: a multisignature is valid once the total weight
+// of its signers reaches the threshold.
+type WeightedPubKey struct {
+	Threshold uint32           `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty" yaml:"threshold"`
+	Members   []WeightedMember `protobuf:"bytes,2,rep,name=members,proto3" json:"members" yaml:"members"`
+}
+
+func (m *WeightedPubKey) Reset()         { *m = WeightedPubKey{} }
+func (m *WeightedPubKey) String() string { return proto.CompactTextString(m) }
+func (*WeightedPubKey) ProtoMessage()    {}
+func (*WeightedPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b57537e097d47d, []int{1}
+}
+func (m *WeightedPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedPubKey.Merge(m, src)
+}
+func (m *WeightedPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedPubKey proto.InternalMessageInfo
+
+// WeightedMember is a public key of a WeightedPubKey along with its weight.
+type WeightedMember struct {
+	PubKey *types.Any `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" yaml:"pubkey"`
+	Weight uint32     `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty" yaml:"weight"`
+}
+
+func (m *WeightedMember) Reset()         { *m = WeightedMember{} }
+func (m *WeightedMember) String() string { return proto.CompactTextString(m) }
+func (*WeightedMember) ProtoMessage()    {}
+func (*WeightedMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b57537e097d47d, []int{2}
+}
+func (m *WeightedMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedMember.Merge(m, src)
+}
+func (m *WeightedMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedMember proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*LegacyAminoPubKey)(nil), "cosmos.crypto.multisig.LegacyAminoPubKey")
+	proto.RegisterType((*WeightedPubKey)(nil), "cosmos.crypto.multisig.WeightedPubKey")
+	proto.RegisterType((*WeightedMember)(nil), "cosmos.crypto.multisig.WeightedMember")
 }
 
 func init() { proto.RegisterFile("cosmos/crypto/multisig/keys.proto", fileDescriptor_46b57537e097d47d) }
 
 var fileDescriptor_46b57537e097d47d = []byte{
-	// 287 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2e, 0xaa, 0x2c, 0x28, 0xc9, 0xd7, 0xcf, 0x2d, 0xcd, 0x29, 0xc9, 0x2c,
-	0xce, 0x4c, 0xd7, 0xcf, 0x4e, 0xad, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83,
-	0x28, 0xd1, 0x83, 0x28, 0xd1, 0x83, 0x29, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1,
-	0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x24, 0xd3, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4,
-	0xd2, 0x34, 0xfd, 0xc4, 0xbc, 0x4a, 0x88, 0x94, 0xd2, 0x62, 0x46, 0x2e, 0x41, 0x9f, 0xd4, 0xf4,
-	0xc4, 0xe4, 0x4a, 0xc7, 0xdc, 0xcc, 0xbc, 0xfc, 0x80, 0xd2, 0x24, 0xef, 0xd4, 0x4a, 0x21, 0x23,
-	0x2e, 0xce, 0x92, 0x8c, 0xa2, 0xd4, 0xe2, 0x8c, 0xfc, 0x9c, 0x14, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x5e, 0x27, 0x91, 0x4f, 0xf7, 0xe4, 0x05, 0x2a, 0x13, 0x73, 0x73, 0xac, 0x94, 0xe0, 0x52, 0x4a,
-	0x41, 0x08, 0x65, 0x42, 0x21, 0x5c, 0xdc, 0x05, 0xa5, 0x49, 0x39, 0x99, 0xc9, 0xf1, 0x20, 0x77,
-	0x4a, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x89, 0xe8, 0x41, 0xac, 0xd6, 0x83, 0x59, 0xad, 0xe7,
-	0x98, 0x57, 0xe9, 0x24, 0xfb, 0xe8, 0x9e, 0x3c, 0x3b, 0xc4, 0xaa, 0xe2, 0x4f, 0xf7, 0xe4, 0xf9,
-	0x20, 0xc6, 0x16, 0x94, 0x26, 0x81, 0x74, 0x2a, 0x05, 0x71, 0x41, 0xcc, 0x01, 0xc9, 0x5a, 0xb1,
-	0x74, 0x2c, 0x90, 0x67, 0x70, 0xf2, 0x3e, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07,
-	0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86,
-	0x28, 0xc3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x58, 0xb0, 0x81,
-	0x29, 0xdd, 0xe2, 0x94, 0x6c, 0x58, 0x08, 0x82, 0x8c, 0x85, 0x07, 0x63, 0x12, 0x1b, 0xd8, 0x2d,
-	0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x76, 0x6e, 0xbf, 0x3c, 0x67, 0x01, 0x00, 0x00,
+	// 397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xcd, 0x4e, 0xfa, 0x40,
+	0x14, 0xc5, 0x5b, 0xfe, 0xff, 0x40, 0x18, 0x02, 0x91, 0x86, 0x10, 0xc4, 0xd8, 0xe2, 0x2c, 0x0c,
+	0x2e, 0x9c, 0x46, 0xdc, 0xb1, 0xa3, 0x5b, 0x34, 0x21, 0x8d, 0x89, 0xc6, 0x8d, 0xa1, 0x65, 0x9c,
+	0x36, 0xb4, 0x4c, 0xd3, 0x8f, 0x98, 0xbe, 0x81, 0x4b, 0x13, 0x5f, 0xc0, 0x44, 0x1f, 0x86, 0x25,
+	0x4b, 0x57, 0x8d, 0x29, 0x6f, 0xc0, 0x13, 0x98, 0xce, 0x50, 0xca, 0x42, 0x57, 0xae, 0xfa, 0x71,
+	0xcf, 0xdc, 0xf3, 0xbb, 0x67, 0x2e, 0x38, 0x31, 0x69, 0xe0, 0xd2, 0x40, 0x35, 0xfd, 0xd8, 0x0b,
+	0xa9, 0xea, 0x46, 0x4e, 0x68, 0x07, 0x36, 0x51, 0xe7, 0x38, 0x0e, 0x90, 0xe7, 0xd3, 0x90, 0x4a,
+	0x6d, 0x2e, 0x41, 0x5c, 0x82, 0x72, 0x49, 0xb7, 0x45, 0x28, 0xa1, 0x4c, 0xa2, 0x66, 0x6f, 0x5c,
+	0xdd, 0x3d, 0x24, 0x94, 0x12, 0x07, 0xab, 0xec, 0xcb, 0x88, 0x1e, 0xd5, 0xe9, 0x22, 0xe6, 0x25,
+	0xf8, 0x2e, 0x82, 0xe6, 0x15, 0x26, 0x53, 0x33, 0x1e, 0xb9, 0xf6, 0x82, 0x4e, 0x22, 0x63, 0x8c,
+	0x63, 0x69, 0x00, 0xaa, 0xa1, 0xe5, 0xe3, 0xc0, 0xa2, 0xce, 0xac, 0x23, 0xf6, 0xc4, 0x7e, 0x5d,
+	0x6b, 0x6d, 0x12, 0xe5, 0x20, 0x9e, 0xba, 0xce, 0x10, 0xee, 0x4a, 0x50, 0x2f, 0x64, 0xd2, 0x0d,
+	0xa8, 0x79, 0x91, 0xe1, 0xd8, 0xe6, 0x43, 0xc6, 0xd9, 0x29, 0xf5, 0xfe, 0xf5, 0x6b, 0x83, 0x16,
+	0xe2, 0xd6, 0x28, 0xb7, 0x46, 0xa3, 0x45, 0xac, 0x1d, 0xa7, 0x89, 0x52, 0xe1, 0x56, 0xc1, 0x26,
+	0x51, 0x1a, 0xbc, 0xad, 0x17, 0x19, 0xd9, 0x49, 0xa8, 0x03, 0xde, 0x27, 0xab, 0x0e, 0xff, 0x3f,
+	0xbf, 0x29, 0x02, 0xfc, 0x10, 0x41, 0xe3, 0x16, 0xdb, 0xc4, 0x0a, 0xf1, 0xec, 0x0f, 0x88, 0x77,
+	0xa0, 0xe2, 0x62, 0xd7, 0xc0, 0x7e, 0x8e, 0x77, 0x8a, 0x7e, 0xce, 0x11, 0xe5, 0x66, 0xd7, 0x4c,
+	0xae, 0xb5, 0x97, 0x89, 0x22, 0x14, 0xa4, 0xdb, 0x26, 0x50, 0xcf, 0xdb, 0x6d, 0x31, 0x5f, 0xf7,
+	0x30, 0xf9, 0x49, 0x69, 0x02, 0x40, 0x91, 0x0a, 0xe3, 0xfc, 0x2d, 0x94, 0xa3, 0x34, 0x51, 0xca,
+	0x7c, 0xb8, 0x4d, 0xa2, 0xd4, 0xf7, 0x33, 0x81, 0x7a, 0x75, 0x17, 0x89, 0x74, 0x06, 0xca, 0x4f,
+	0xcc, 0xa3, 0x53, 0x62, 0x53, 0x37, 0x0b, 0x35, 0xff, 0x0f, 0xf5, 0xad, 0x80, 0x53, 0x69, 0xe3,
+	0x65, 0x2a, 0x8b, 0xab, 0x54, 0x16, 0xbf, 0x52, 0x59, 0x7c, 0x59, 0xcb, 0xc2, 0x6a, 0x2d, 0x0b,
+	0x9f, 0x6b, 0x59, 0xb8, 0xbf, 0x20, 0x76, 0x68, 0x45, 0x06, 0x32, 0xa9, 0xab, 0xe6, 0x3b, 0xc7,
+	0x1e, 0xe7, 0xc1, 0x6c, 0x9e, 0xaf, 0x5f, 0x76, 0x27, 0xbb, 0x1d, 0x34, 0xca, 0x8c, 0xf9, 0xf2,
+	0x3b, 0x00, 0x00, 0xff, 0xff, 0xba, 0x9b, 0x0a, 0x97, 0xa4, 0x02, 0x00, 0x00,
 }
 
 func (m *LegacyAminoPubKey) Marshal() (dAtA []byte, err error) {
@@ -135,6 +224,88 @@ func (m *LegacyAminoPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WeightedPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeys(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightedMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintKeys(dAtA []byte, offset int, v uint64) int {
 	offset -= sovKeys(v)
 	base := offset
@@ -164,6 +335,40 @@ func (m *LegacyAminoPubKey) Size() (n int) {
 	return n
 }
 
+func (m *WeightedPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovKeys(uint64(m.Threshold))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WeightedMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovKeys(uint64(m.Weight))
+	}
+	return n
+}
+
 func sovKeys(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -273,6 +478,214 @@ func (m *LegacyAminoPubKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WeightedPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, WeightedMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &types.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipKeys(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,211 @@
+package multisig
+
+import (
+	fmt "fmt"
+
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _ multisigtypes.PubKey = &WeightedPubKey{}
+var _ types.UnpackInterfacesMessage = &WeightedPubKey{}
+
+// NewWeightedPubKey returns a new WeightedPubKey, where the i-th public key
+// has the i-th weight.
+// Panics if threshold <= 0, if the number of weights differs from the number
+// of public keys, if a weight is 0 or if the weights sum up to less than the
+// threshold.
+func NewWeightedPubKey(threshold uint32, pubKeys []cryptotypes.PubKey, weights []uint32) *WeightedPubKey {
+	if threshold == 0 {
+		panic("weighted multisignature: threshold == 0")
+	}
+	if len(pubKeys) != len(weights) {
+		panic("weighted multisignature: len(pubKeys) != len(weights)")
+	}
+	anyPubKeys, err := packPubKeys(pubKeys)
+	if err != nil {
+		panic(err)
+	}
+
+	pk := &WeightedPubKey{Threshold: threshold, Members: make([]WeightedMember, len(pubKeys))}
+	for i := range pubKeys {
+		pk.Members[i] = WeightedMember{PubKey: anyPubKeys[i], Weight: weights[i]}
+	}
+	if err := pk.ValidateWeights(); err != nil {
+		panic(err)
+	}
+
+	return pk
+}
+
+// ValidateWeights returns an error if the threshold is 0, if a member has a
+// null weight or if the total weight of the members is less than the
+// threshold, i.e. if the key could never sign.
+func (m *WeightedPubKey) ValidateWeights() error {
+	if m.Threshold == 0 {
+		return fmt.Errorf("weighted multisignature: threshold == 0")
+	}
+
+	var total uint64
+	for i, member := range m.Members {
+		if member.Weight == 0 {
+			return fmt.Errorf("weighted multisignature: weight of member %d == 0", i)
+		}
+		total += uint64(member.Weight)
+	}
+	if total < uint64(m.Threshold) {
+		return fmt.Errorf("weighted multisignature: total weight %d < threshold %d", total, m.Threshold)
+	}
+
+	return nil
+}
+
+// Address implements cryptotypes.PubKey Address method
+func (m *WeightedPubKey) Address() cryptotypes.Address {
+	return tmcrypto.AddressHash(m.Bytes())
+}
+
+// Bytes returns the amino encoded version of the WeightedPubKey
+func (m *WeightedPubKey) Bytes() []byte {
+	return AminoCdc.MustMarshal(m)
+}
+
+// VerifyMultisignature implements the multisigtypes.PubKey VerifyMultisignature
+// method. The signatures must be added in an order corresponding to the members
+// order in WeightedPubKey, and the multisignature is valid if the weights of the
+// members which signed sum up to at least the threshold.
+func (m *WeightedPubKey) VerifyMultisignature(getSignBytes multisigtypes.GetSignBytesFunc, sig *signing.MultiSignatureData) error {
+	// a key with a null threshold would accept an empty multisignature
+	if err := m.ValidateWeights(); err != nil {
+		return err
+	}
+
+	bitarray := sig.BitArray
+	sigs := sig.Signatures
+	size := bitarray.Count()
+	pubKeys := m.GetPubKeys()
+	// ensure bit array is the correct size
+	if len(pubKeys) != size {
+		return fmt.Errorf("bit array size is incorrect, expecting: %d", len(pubKeys))
+	}
+	// ensure there is a signature for each bit set
+	if len(sigs) != bitarray.NumTrueBitsBefore(size) {
+		return fmt.Errorf("signature size is incorrect %d", len(sigs))
+	}
+
+	// index in the list of signatures which we are concerned with.
+	sigIndex := 0
+	var weight uint64
+	for i := 0; i < size; i++ {
+		if !bitarray.GetIndex(i) {
+			continue
+		}
+
+		switch si := sigs[sigIndex].(type) {
+		case *signing.SingleSignatureData:
+			msg, err := getSignBytes(si.SignMode)
+			if err != nil {
+				return err
+			}
+			if !pubKeys[i].VerifySignature(msg, si.Signature) {
+				return fmt.Errorf("unable to verify signature at index %d", i)
+			}
+		case *signing.MultiSignatureData:
+			nestedMultisigPk, ok := pubKeys[i].(multisigtypes.PubKey)
+			if !ok {
+				return fmt.Errorf("unable to parse pubkey of index %d", i)
+			}
+			if err := nestedMultisigPk.VerifyMultisignature(getSignBytes, si); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("improper signature data type for index %d", sigIndex)
+		}
+
+		weight += uint64(m.Members[i].Weight)
+		sigIndex++
+	}
+
+	if weight < uint64(m.Threshold) {
+		return fmt.Errorf("not enough weight signed, have %d, expected %d", weight, m.Threshold)
+	}
+
+	return nil
+}
+
+// VerifySignature implements cryptotypes.PubKey VerifySignature method,
+// it panics because it can't handle MultiSignatureData
+func (m *WeightedPubKey) VerifySignature(msg []byte, sig []byte) bool {
+	panic("not implemented")
+}
+
+// GetPubKeys implements the PubKey.GetPubKeys method
+func (m *WeightedPubKey) GetPubKeys() []cryptotypes.PubKey {
+	if m != nil {
+		pubKeys := make([]cryptotypes.PubKey, len(m.Members))
+		for i := 0; i < len(m.Members); i++ {
+			pubKeys[i] = m.Members[i].PubKey.GetCachedValue().(cryptotypes.PubKey)
+		}
+		return pubKeys
+	}
+
+	return nil
+}
+
+// GetWeights returns the weights of the public keys, in the order of GetPubKeys
+func (m *WeightedPubKey) GetWeights() []uint32 {
+	weights := make([]uint32, len(m.Members))
+	for i, member := range m.Members {
+		weights[i] = member.Weight
+	}
+
+	return weights
+}
+
+// Equals returns true if m and other are both WeightedPubKeys with the same
+// threshold, and the same members with the same weights in the same order.
+func (m *WeightedPubKey) Equals(key cryptotypes.PubKey) bool {
+	otherKey, ok := key.(*WeightedPubKey)
+	if !ok {
+		return false
+	}
+	pubKeys := m.GetPubKeys()
+	otherPubKeys := otherKey.GetPubKeys()
+	if m.Threshold != otherKey.Threshold || len(pubKeys) != len(otherPubKeys) {
+		return false
+	}
+
+	for i := 0; i < len(pubKeys); i++ {
+		if m.Members[i].Weight != otherKey.Members[i].Weight || !pubKeys[i].Equals(otherPubKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetThreshold implements the PubKey.GetThreshold method. It returns the total
+// weight the signers must reach, rather than a number of signatures.
+func (m *WeightedPubKey) GetThreshold() uint {
+	return uint(m.Threshold)
+}
+
+// Type returns multisig type
+func (m *WeightedPubKey) Type() string {
+	return "PubKeyWeightedMultisig"
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *WeightedPubKey) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, member := range m.Members {
+		var pk cryptotypes.PubKey
+		err := unpacker.UnpackAny(member.PubKey, &pk)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package multisig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestNewWeightedPubKey(t *testing.T) {
+	pubKeys := generatePubKeys(3)
+
+	require.NotPanics(t, func() { kmultisig.NewWeightedPubKey(4, pubKeys, []uint32{2, 1, 1}) })
+	require.Panics(t, func() { kmultisig.NewWeightedPubKey(0, pubKeys, []uint32{2, 1, 1}) }, "null threshold")
+	require.Panics(t, func() { kmultisig.NewWeightedPubKey(2, pubKeys, []uint32{2, 1}) }, "missing weight")
+	require.Panics(t, func() { kmultisig.NewWeightedPubKey(2, pubKeys, []uint32{2, 0, 1}) }, "null weight")
+	require.Panics(t, func() { kmultisig.NewWeightedPubKey(5, pubKeys, []uint32{2, 1, 1}) }, "unreachable threshold")
+}
+
+func TestWeightedEquals(t *testing.T) {
+	pubKeys := generatePubKeys(2)
+	multisigKey := kmultisig.NewWeightedPubKey(2, pubKeys, []uint32{2, 1})
+
+	testCases := []struct {
+		msg      string
+		other    cryptotypes.PubKey
+		expectEq bool
+	}{
+		{"same key", kmultisig.NewWeightedPubKey(2, pubKeys, []uint32{2, 1}), true},
+		{"different threshold", kmultisig.NewWeightedPubKey(3, pubKeys, []uint32{2, 1}), false},
+		{"different weights", kmultisig.NewWeightedPubKey(2, pubKeys, []uint32{1, 2}), false},
+		{"different pub keys", kmultisig.NewWeightedPubKey(2, generatePubKeys(2), []uint32{2, 1}), false},
+		{"legacy multisig key", kmultisig.NewLegacyAminoPubKey(2, pubKeys), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			require.Equal(t, tc.expectEq, multisigKey.Equals(tc.other))
+		})
+	}
+
+	require.NotEqual(t, multisigKey.Address(), kmultisig.NewLegacyAminoPubKey(2, pubKeys).Address())
+}
+
+func TestWeightedVerifyMultisignature(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	signBytesFn := func(mode signing.SignMode) ([]byte, error) { return msg, nil }
+	pubKeys, sigs := generatePubKeysAndSignatures(3, msg)
+	pk := kmultisig.NewWeightedPubKey(3, pubKeys, []uint32{2, 1, 1})
+
+	multisignature := func(signers ...int) *signing.MultiSignatureData {
+		sig := multisig.NewMultisig(len(pubKeys))
+		for _, i := range signers {
+			require.NoError(t, multisig.AddSignatureFromPubKey(sig, sigs[i], pubKeys[i], pubKeys))
+		}
+		return sig
+	}
+
+	testCases := []struct {
+		msg        string
+		sig        *signing.MultiSignatureData
+		expectPass bool
+	}{
+		{"signers reaching the threshold", multisignature(0, 1), true},
+		{"all the members", multisignature(0, 1, 2), true},
+		{"signers below the threshold", multisignature(1, 2), false},
+		{"heaviest signer alone", multisignature(0), false},
+		{"no signer", multisignature(), false},
+		{"wrong size for sig bit array", multisig.NewMultisig(2), false},
+		{
+			"invalid signature",
+			func() *signing.MultiSignatureData {
+				sig := multisignature(0, 1)
+				sig.Signatures[1] = sigs[2]
+				return sig
+			}(),
+			false,
+		},
+		{
+			"missing signature",
+			func() *signing.MultiSignatureData {
+				sig := multisignature(0, 1)
+				sig.Signatures = sig.Signatures[:1]
+				return sig
+			}(),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			err := pk.VerifyMultisignature(signBytesFn, tc.sig)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	// a key with a null threshold never verifies, even an empty multisignature
+	invalidPk := &kmultisig.WeightedPubKey{Members: pk.Members}
+	require.Error(t, invalidPk.VerifyMultisignature(signBytesFn, multisignature()))
+}
+
+func TestWeightedNestedMultisignature(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	signBytesFn := func(mode signing.SignMode) ([]byte, error) { return msg, nil }
+	nestedPk, nestedSig := generateNestedMultiSignature(3, msg)
+	pubKeys, sigs := generatePubKeysAndSignatures(1, msg)
+	members := []cryptotypes.PubKey{nestedPk, pubKeys[0]}
+	pk := kmultisig.NewWeightedPubKey(2, members, []uint32{2, 1})
+
+	sig := multisig.NewMultisig(len(members))
+	require.NoError(t, multisig.AddSignatureFromPubKey(sig, nestedSig, nestedPk, members))
+	require.NoError(t, pk.VerifyMultisignature(signBytesFn, sig))
+
+	sig = multisig.NewMultisig(len(members))
+	require.NoError(t, multisig.AddSignatureFromPubKey(sig, sigs[0], pubKeys[0], members))
+	require.Error(t, pk.VerifyMultisignature(signBytesFn, sig))
+}
+
+func TestWeightedAminoBinary(t *testing.T) {
+	msig := kmultisig.NewWeightedPubKey(2, generatePubKeys(2), []uint32{2, 1})
+
+	// Do a round-trip key->bytes->key.
+	bz, err := legacy.Cdc.Marshal(msig)
+	require.NoError(t, err)
+	var newMsig cryptotypes.PubKey
+	err = legacy.Cdc.Unmarshal(bz, &newMsig)
+	require.NoError(t, err)
+	require.Equal(t, msig.Threshold, newMsig.(*kmultisig.WeightedPubKey).Threshold)
+
+	// the nested keys are only unpacked when unmarshaling into the concrete type
+	newMsig = &kmultisig.WeightedPubKey{}
+	require.NoError(t, legacy.Cdc.Unmarshal(bz, newMsig))
+	require.True(t, msig.Equals(newMsig))
+	require.Equal(t, msig.Address(), newMsig.Address())
+}
+
+func TestWeightedAminoJSON(t *testing.T) {
+	msig := kmultisig.NewWeightedPubKey(2, generatePubKeys(2), []uint32{2, 1})
+
+	bz, err := legacy.Cdc.MarshalJSON(msig)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), kmultisig.WeightedPubKeyAminoRoute)
+
+	newMsig := &kmultisig.WeightedPubKey{}
+	require.NoError(t, legacy.Cdc.UnmarshalJSON(bz, newMsig))
+	require.True(t, msig.Equals(newMsig))
+}
+
+func TestWeightedProtoMarshalJSON(t *testing.T) {
+	require := require.New(t)
+	msig := kmultisig.NewWeightedPubKey(2, generatePubKeys(3), []uint32{1, 1, 1})
+
+	registry := types.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	bz, err := cdc.MarshalInterfaceJSON(msig)
+	require.NoError(err)
+
+	var pk2 cryptotypes.PubKey
+	err = cdc.UnmarshalInterfaceJSON(bz, &pk2)
+	require.NoError(err)
+	require.True(pk2.Equals(msig))
+
+	// weighted multisig keys can be stored in the keyring
+	info, err := keyring.NewMultiInfo("my weighted multisig", msig)
+	require.NoError(err)
+	ko, err := keyring.MkAccKeyOutput(info)
+	require.NoError(err)
+	require.Equal(ko.PubKey, string(bz))
+}
@@ -11,6 +11,14 @@ func GenPrivKey() (*PrivKey, error) {
 	return &PrivKey{&ecdsaSK{key}}, err
 }
 
+// GenPrivKeyFromSecret hashes the secret with SHA2, and uses that 32 byte
+// output to create a secp256r1 private key deterministically.
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(secret []byte) *PrivKey {
+	return &PrivKey{&ecdsaSK{ecdsa.GenPrivKeyFromSecret(secp256r1, secret)}}
+}
+
 // PubKey implements SDK PrivKey interface.
 func (m *PrivKey) PubKey() cryptotypes.PubKey {
 	return &PubKey{&ecdsaPK{m.Secret.PubKey()}}
@@ -113,3 +113,27 @@ func (suite *SKSuite) TestSize() {
 	var nilPk *ecdsaSK
 	require.Equal(0, nilPk.Size(), "nil value must have zero size")
 }
+
+func (suite *SKSuite) TestGenPrivKeyFromSecret() {
+	require := suite.Require()
+
+	sk := GenPrivKeyFromSecret([]byte("mySecret"))
+	require.True(sk.Equals(GenPrivKeyFromSecret([]byte("mySecret"))), "the key must be deterministic")
+	require.False(sk.Equals(GenPrivKeyFromSecret([]byte("myOtherSecret"))))
+
+	// the key is a valid field element which signs verifiable messages
+	for _, secret := range [][]byte{{}, {0}, []byte("mySecret")} {
+		sk := GenPrivKeyFromSecret(secret)
+		require.Positive(sk.Secret.D.Sign())
+		require.Negative(sk.Secret.D.Cmp(secp256r1.Params().N))
+
+		msg := []byte("message")
+		sig, err := sk.Sign(msg)
+		require.NoError(err)
+		require.True(sk.PubKey().VerifySignature(msg, sig))
+
+		var secret2 ecdsaSK
+		require.NoError(secret2.Unmarshal(sk.Bytes()))
+		require.True(sk.Equals(&PrivKey{&secret2}))
+	}
+}
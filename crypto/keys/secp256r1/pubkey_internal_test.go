@@ -105,7 +105,7 @@ func (suite *PKSuite) TestMarshalProto() {
 	require.NoError(err)
 	var pkI cryptotypes.PubKey
 	err = cdc.UnmarshalInterface(bz, &pkI)
-	require.EqualError(err, "no registered implementations of type types.PubKey")
+	require.EqualError(err, "no registered implementations of type types.PubKey: type not registered for interface")
 
 	RegisterInterfaces(registry)
 	require.NoError(cdc.UnmarshalInterface(bz, &pkI))
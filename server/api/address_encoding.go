@@ -0,0 +1,79 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddressEncodingHeader is the request header selecting the encoding of the
+// addresses and public keys of the gRPC-gateway JSON responses, see
+// codec.AddressEncoding. The responses are not changed without header.
+const AddressEncodingHeader = "X-Cosmos-Address-Encoding"
+
+// addressEncodingResponseWriter buffers a response to re-encode its addresses.
+type addressEncodingResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *addressEncodingResponseWriter) Header() http.Header { return w.header }
+
+func (w *addressEncodingResponseWriter) Write(bz []byte) (int, error) { return w.body.Write(bz) }
+
+func (w *addressEncodingResponseWriter) WriteHeader(status int) { w.status = status }
+
+// NewAddressEncodingHandler wraps a handler returning JSON responses, and
+// re-encodes the account, validator and consensus addresses and the public
+// keys of its responses in the encoding selected by the AddressEncodingHeader
+// request header.
+func NewAddressEncodingHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encoding, err := codec.ParseAddressEncoding(r.Header.Get(AddressEncodingHeader))
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if encoding == codec.AddressEncodingBech32 {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		rw := &addressEncodingResponseWriter{header: w.Header(), status: http.StatusOK}
+		h.ServeHTTP(rw, r)
+
+		body := rw.body.Bytes()
+		if strings.HasPrefix(rw.header.Get("Content-Type"), "application/json") && json.Valid(body) {
+			config := sdk.GetConfig()
+			encoder := codec.NewAddressEncoder(
+				encoding,
+				config.GetBech32AccountAddrPrefix(),
+				config.GetBech32ValidatorAddrPrefix(),
+				config.GetBech32ConsensusAddrPrefix(),
+			)
+
+			reencoded, err := encoder.ReencodeJSON(body)
+			if err != nil {
+				writeErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+
+			indented := new(bytes.Buffer)
+			if err := json.Indent(indented, reencoded, "", "  "); err != nil {
+				writeErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			body = indented.Bytes()
+		}
+
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		w.WriteHeader(rw.status)
+		_, _ = w.Write(body)
+	})
+}
@@ -110,7 +110,7 @@ func (s *Server) Start(cfg config.Config) error {
 	var h http.Handler = s.Router
 
 	if cfg.API.EnableUnsafeCORS {
-		allowAllCORS := handlers.CORS(handlers.AllowedHeaders([]string{"Content-Type"}))
+		allowAllCORS := handlers.CORS(handlers.AllowedHeaders([]string{"Content-Type", AddressEncodingHeader}))
 		return tmrpcserver.Serve(s.listener, allowAllCORS(h), s.logger, tmCfg)
 	}
 
@@ -124,7 +124,7 @@ func (s *Server) Close() error {
 }
 
 func (s *Server) registerGRPCGatewayRoutes() {
-	s.Router.PathPrefix("/").Handler(s.GRPCGatewayRouter)
+	s.Router.PathPrefix("/").Handler(NewAddressEncodingHandler(s.GRPCGatewayRouter))
 }
 
 func (s *Server) registerMetrics() {
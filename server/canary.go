@@ -0,0 +1,138 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+const (
+	// canaryHashWindow is the number of recent heights for which the app hashes
+	// of the node and of the canary are kept for comparison.
+	canaryHashWindow = 100
+
+	// canaryPollTimeout is the timeout of a single query to the canary.
+	canaryPollTimeout = 5 * time.Second
+)
+
+// CanaryHaltTrigger compares the app hashes committed by the node with the app
+// hashes reported by a trusted canary node, halting the node on the first
+// mismatch. The canary is polled asynchronously after each commit so that a slow
+// or unreachable canary never delays consensus; a mismatch is therefore reported
+// on the commit following its detection.
+type CanaryHaltTrigger struct {
+	logger log.Logger
+	client rpcclient.ABCIClient
+
+	mtx      sync.Mutex
+	polling  bool
+	local    map[int64][]byte
+	remote   map[int64][]byte
+	mismatch error
+}
+
+// NewCanaryHaltTrigger returns a CanaryHaltTrigger querying the canary node at
+// the given Tendermint RPC endpoint.
+func NewCanaryHaltTrigger(logger log.Logger, endpoint string) (*CanaryHaltTrigger, error) {
+	client, err := rpchttp.New(endpoint, "/websocket")
+	if err != nil {
+		return nil, fmt.Errorf("invalid canary endpoint %s: %w", endpoint, err)
+	}
+
+	return newCanaryHaltTrigger(logger, client), nil
+}
+
+func newCanaryHaltTrigger(logger log.Logger, client rpcclient.ABCIClient) *CanaryHaltTrigger {
+	return &CanaryHaltTrigger{
+		logger: logger.With("module", "canary"),
+		client: client,
+		local:  make(map[int64][]byte),
+		remote: make(map[int64][]byte),
+	}
+}
+
+// HaltTrigger returns the trigger to register on the BaseApp.
+func (c *CanaryHaltTrigger) HaltTrigger() baseapp.HaltTrigger {
+	return c.check
+}
+
+// check records the app hash committed by the node at height, compares it with
+// the app hash of the canary if known and schedules a poll of the canary.
+func (c *CanaryHaltTrigger) check(height int64, appHash []byte) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.local[height] = append([]byte(nil), appHash...)
+	prune(c.local, height)
+	c.compare(height)
+
+	if c.mismatch != nil {
+		return c.mismatch
+	}
+
+	if !c.polling {
+		c.polling = true
+		go c.poll()
+	}
+
+	return nil
+}
+
+// poll queries the latest height and app hash of the canary and compares it
+// with the app hash committed by the node at the same height, if any.
+func (c *CanaryHaltTrigger) poll() {
+	ctx, cancel := context.WithTimeout(context.Background(), canaryPollTimeout)
+	defer cancel()
+
+	res, err := c.client.ABCIInfo(ctx)
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.polling = false
+	if err != nil {
+		c.logger.Error("failed to query canary", "err", err)
+		return
+	}
+
+	height := res.Response.LastBlockHeight
+	c.remote[height] = res.Response.LastBlockAppHash
+	prune(c.remote, height)
+	c.compare(height)
+}
+
+// compare records a mismatch if both the node and the canary reported an app
+// hash at height and they differ. It must be called with the lock held.
+func (c *CanaryHaltTrigger) compare(height int64) {
+	local, ok := c.local[height]
+	if !ok {
+		return
+	}
+
+	remote, ok := c.remote[height]
+	if !ok {
+		return
+	}
+
+	if c.mismatch == nil && !bytes.Equal(local, remote) {
+		c.mismatch = fmt.Errorf("app hash mismatch with canary at height %d: expected %X, got %X", height, remote, local)
+		c.logger.Error("app hash mismatch with canary", "height", height, "expected", fmt.Sprintf("%X", remote), "got", fmt.Sprintf("%X", local))
+	}
+}
+
+// prune removes the app hashes older than canaryHashWindow heights from latest.
+func prune(hashes map[int64][]byte, latest int64) {
+	for height := range hashes {
+		if height <= latest-canaryHashWindow {
+			delete(hashes, height)
+		}
+	}
+}
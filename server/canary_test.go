@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+type mockCanaryClient struct {
+	rpcclient.ABCIClient
+
+	res *ctypes.ResultABCIInfo
+	err error
+}
+
+func (m mockCanaryClient) ABCIInfo(context.Context) (*ctypes.ResultABCIInfo, error) {
+	return m.res, m.err
+}
+
+func canaryInfo(height int64, appHash []byte) *ctypes.ResultABCIInfo {
+	return &ctypes.ResultABCIInfo{Response: abci.ResponseInfo{LastBlockHeight: height, LastBlockAppHash: appHash}}
+}
+
+func TestCanaryHaltTrigger(t *testing.T) {
+	client := &mockCanaryClient{}
+	canary := newCanaryHaltTrigger(log.NewNopLogger(), client)
+	// prevent check from polling in the background, polls are run explicitly
+	canary.polling = true
+
+	// canary behind the node
+	require.NoError(t, canary.check(10, []byte{0x10}))
+	require.NoError(t, canary.check(11, []byte{0x11}))
+	client.res = canaryInfo(10, []byte{0x10})
+	canary.poll()
+	canary.polling = true
+	require.NoError(t, canary.check(12, []byte{0x12}))
+
+	// unreachable canary
+	client.res, client.err = nil, errors.New("connection refused")
+	canary.poll()
+	canary.polling = true
+	require.NoError(t, canary.check(13, []byte{0x13}))
+
+	// canary ahead of the node, the mismatch is detected on commit
+	client.res, client.err = canaryInfo(14, []byte{0xff}), nil
+	canary.poll()
+	canary.polling = true
+	err := canary.check(14, []byte{0x14})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "height 14")
+
+	// the mismatch keeps halting the node
+	require.Error(t, canary.check(15, []byte{0x15}))
+}
+
+func TestCanaryHaltTriggerMismatchOnPoll(t *testing.T) {
+	client := &mockCanaryClient{}
+	canary := newCanaryHaltTrigger(log.NewNopLogger(), client)
+	canary.polling = true
+
+	require.NoError(t, canary.check(10, []byte{0x10}))
+	client.res = canaryInfo(10, []byte{0xff})
+	canary.poll()
+	canary.polling = true
+
+	// the mismatch detected by the poll is reported on the next commit
+	require.Error(t, canary.check(11, []byte{0x11}))
+}
+
+func TestCanaryHaltTriggerPrune(t *testing.T) {
+	canary := newCanaryHaltTrigger(log.NewNopLogger(), &mockCanaryClient{})
+	canary.polling = true
+
+	for height := int64(1); height <= 2*canaryHashWindow; height++ {
+		require.NoError(t, canary.check(height, []byte{0x01}))
+	}
+	require.Len(t, canary.local, canaryHashWindow)
+}
@@ -47,6 +47,16 @@ type BaseConfig struct {
 	// Note: Commitment of state will be attempted on the corresponding block.
 	HaltTime uint64 `mapstructure:"halt-time"`
 
+	// HaltOnInvariantBreach gracefully halts and shuts down the node, once the
+	// block has been committed, when an invariant asserted at the end of a block
+	// is broken, instead of panicking during block execution.
+	HaltOnInvariantBreach bool `mapstructure:"halt-on-invariant-breach"`
+
+	// HaltCanaryEndpoint contains the Tendermint RPC endpoint of a trusted canary
+	// node. When set, the node gracefully halts and shuts down as soon as an app
+	// hash it committed differs from the app hash of the canary at the same height.
+	HaltCanaryEndpoint string `mapstructure:"halt-canary-endpoint"`
+
 	// MinRetainBlocks defines the minimum block height offset from the current
 	// block being committed, such that blocks past this offset may be pruned
 	// from Tendermint. It is used as part of the process of determining the
@@ -66,6 +76,14 @@ type BaseConfig struct {
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
 
+	// CommitWorkers defines the number of goroutines committing the stores in
+	// parallel on commit. Values below 2 commit the stores serially.
+	CommitWorkers uint `mapstructure:"commit-workers"`
+
+	// BlockReport emits an EventBlockReport at the end of each block with the
+	// aggregate resource usage of the block.
+	BlockReport bool `mapstructure:"block-report"`
+
 	// IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	IndexEvents []string `mapstructure:"index-events"`
@@ -152,9 +170,18 @@ type StateSyncConfig struct {
 	// 0 disables snapshots. Must be a multiple of PruningKeepEvery.
 	SnapshotInterval uint64 `mapstructure:"snapshot-interval"`
 
+	// SnapshotDeltaInterval sets the interval at which delta state sync snapshots are
+	// taken on top of the latest snapshot. 0 disables delta snapshots. Must be a
+	// multiple of PruningKeepEvery.
+	SnapshotDeltaInterval uint64 `mapstructure:"snapshot-delta-interval"`
+
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// PreUpgradeSnapshot takes a state sync snapshot of the state committed at
+	// the block before the height of an upgrade plan.
+	PreUpgradeSnapshot bool `mapstructure:"pre-upgrade-snapshot"`
 }
 
 // Config defines the server's top level configuration
@@ -211,8 +238,11 @@ func DefaultConfig() *Config {
 			IndexEvents:       make([]string, 0),
 		},
 		Telemetry: telemetry.Config{
-			Enabled:      false,
-			GlobalLabels: [][]string{},
+			Enabled:           false,
+			GlobalLabels:      [][]string{},
+			TracingEnabled:    false,
+			TracingEndpoint:   telemetry.DefaultTracingEndpoint,
+			TracingSampleRate: 1,
 		},
 		API: APIConfig{
 			Enable:             false,
@@ -260,6 +290,8 @@ func GetConfig(v *viper.Viper) Config {
 		BaseConfig: BaseConfig{
 			MinGasPrices:      v.GetString("minimum-gas-prices"),
 			InterBlockCache:   v.GetBool("inter-block-cache"),
+			CommitWorkers:     v.GetUint("commit-workers"),
+			BlockReport:       v.GetBool("block-report"),
 			Pruning:           v.GetString("pruning"),
 			PruningKeepRecent: v.GetString("pruning-keep-recent"),
 			PruningKeepEvery:  v.GetString("pruning-keep-every"),
@@ -277,6 +309,10 @@ func GetConfig(v *viper.Viper) Config {
 			EnableServiceLabel:      v.GetBool("telemetry.enable-service-label"),
 			PrometheusRetentionTime: v.GetInt64("telemetry.prometheus-retention-time"),
 			GlobalLabels:            globalLabels,
+			TracingEnabled:          v.GetBool("telemetry.tracing-enabled"),
+			TracingEndpoint:         v.GetString("telemetry.tracing-endpoint"),
+			TracingInsecure:         v.GetBool("telemetry.tracing-insecure"),
+			TracingSampleRate:       v.GetFloat64("telemetry.tracing-sample-rate"),
 		},
 		API: APIConfig{
 			Enable:             v.GetBool("api.enable"),
@@ -306,8 +342,10 @@ func GetConfig(v *viper.Viper) Config {
 			EnableUnsafeCORS: v.GetBool("grpc-web.enable-unsafe-cors"),
 		},
 		StateSync: StateSyncConfig{
-			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
-			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
+			SnapshotInterval:      v.GetUint64("state-sync.snapshot-interval"),
+			SnapshotDeltaInterval: v.GetUint64("state-sync.snapshot-delta-interval"),
+			SnapshotKeepRecent:    v.GetUint32("state-sync.snapshot-keep-recent"),
+			PreUpgradeSnapshot:    v.GetBool("state-sync.pre-upgrade-snapshot"),
 		},
 	}
 }
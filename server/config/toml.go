@@ -44,6 +44,16 @@ halt-height = {{ .BaseConfig.HaltHeight }}
 # Note: Commitment of state will be attempted on the corresponding block.
 halt-time = {{ .BaseConfig.HaltTime }}
 
+# HaltOnInvariantBreach gracefully halts and shuts down the node, once the
+# block has been committed, when an invariant asserted at the end of a block
+# is broken, instead of panicking during block execution.
+halt-on-invariant-breach = {{ .BaseConfig.HaltOnInvariantBreach }}
+
+# HaltCanaryEndpoint contains the Tendermint RPC endpoint of a trusted canary
+# node. When set, the node gracefully halts and shuts down as soon as an app
+# hash it committed differs from the app hash of the canary at the same height.
+halt-canary-endpoint = "{{ .BaseConfig.HaltCanaryEndpoint }}"
+
 # MinRetainBlocks defines the minimum block height offset from the current
 # block being committed, such that all blocks past this offset are pruned
 # from Tendermint. It is used as part of the process of determining the
@@ -63,6 +73,17 @@ min-retain-blocks = {{ .BaseConfig.MinRetainBlocks }}
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
+# CommitWorkers defines the number of goroutines committing the stores in
+# parallel on commit, which reduces commit latency on machines with many cores.
+# Values below 2 commit the stores serially. The resulting app hash is the same.
+commit-workers = {{ .BaseConfig.CommitWorkers }}
+
+# BlockReport emits an EventBlockReport at the end of each block with the total
+# gas used, the transaction count, the failed transaction count and the
+# duration of the end blocker of each module. The durations depend on the
+# node, so the event is not part of consensus.
+block-report = {{ .BaseConfig.BlockReport }}
+
 # IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 # which informs Tendermint what to index. If empty, all events will be indexed.
 #
@@ -105,6 +126,20 @@ global-labels = [{{ range $k, $v := .Telemetry.GlobalLabels }}
   ["{{index $v 0 }}", "{{ index $v 1}}"],{{ end }}
 ]
 
+# TracingEnabled enables exporting OpenTelemetry traces of the tx execution
+# pipeline (ante handlers, Msg handlers and EndBlockers) over OTLP/HTTP.
+tracing-enabled = {{ .Telemetry.TracingEnabled }}
+
+# TracingEndpoint is the host:port of the OTLP/HTTP collector receiving traces,
+# e.g. a Jaeger or Tempo instance.
+tracing-endpoint = "{{ .Telemetry.TracingEndpoint }}"
+
+# TracingInsecure disables TLS when exporting traces.
+tracing-insecure = {{ .Telemetry.TracingInsecure }}
+
+# TracingSampleRate is the fraction, within [0, 1], of traces to sample.
+tracing-sample-rate = {{ .Telemetry.TracingSampleRate }}
+
 ###############################################################################
 ###                           API Configuration                             ###
 ###############################################################################
@@ -199,8 +234,18 @@ enable-unsafe-cors = {{ .GRPCWeb.EnableUnsafeCORS }}
 # taken (0 to disable). Must be a multiple of pruning-keep-every.
 snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
+# snapshot-delta-interval specifies the block interval at which delta snapshots are taken on
+# top of the latest snapshot (0 to disable). Delta snapshots only contain the state changed
+# since the latest snapshot, and are served together with it. Must be a multiple of
+# pruning-keep-every.
+snapshot-delta-interval = {{ .StateSync.SnapshotDeltaInterval }}
+
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+# pre-upgrade-snapshot takes a snapshot of the state committed at the block before the height
+# of an upgrade plan, from which the node can recover if the upgrade fails without a full resync.
+pre-upgrade-snapshot = {{ .StateSync.PreUpgradeSnapshot }}
 `
 
 var configTemplate *template.Template
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/grpc/node"
+)
+
+const (
+	FlagEpochLength = "epoch-length"
+	FlagGRPCAddress = "grpc-address"
+)
+
+// DrainCmd puts the running node into draining mode ahead of maintenance.
+func DrainCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drain",
+		Short: "Drain the running node ahead of maintenance",
+		Long: `Put the node running on this machine into draining mode: it stops accepting
+new transactions into its mempool, finishes the block currently being executed
+and gracefully halts once it has been committed. With --epoch-length, the node
+keeps processing blocks until the end of the current epoch, e.g. the validator
+set epoch of the staking module, before halting.
+
+The node is reached over its gRPC server, which only serves this command to
+clients connecting from the loopback interface.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+
+			address, _ := cmd.Flags().GetString(FlagGRPCAddress)
+			if address == "" {
+				address = serverCtx.Viper.GetString("grpc.address")
+			}
+			if address == "" {
+				address = config.DefaultGRPCAddress
+			}
+
+			epochLength, _ := cmd.Flags().GetUint64(FlagEpochLength)
+
+			conn, err := grpc.Dial(address, grpc.WithInsecure())
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			res, err := node.NewServiceClient(conn).Drain(context.Background(), &node.DrainRequest{EpochLength: epochLength})
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("node is draining and will halt after committing block %d\n", res.HaltHeight)
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagEpochLength, 0, "Halt after committing the block ending the current epoch of this length instead of the current block")
+	cmd.Flags().String(FlagGRPCAddress, "", "The gRPC address of the node, defaults to the one configured in app.toml")
+
+	return cmd
+}
@@ -0,0 +1,57 @@
+package node
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Drainer is implemented by applications which can be drained ahead of
+// maintenance, such as any application embedding a BaseApp.
+type Drainer interface {
+	Drain(epochLength uint64) int64
+}
+
+type drainServer struct {
+	app Drainer
+}
+
+var _ ServiceServer = drainServer{}
+
+// NewDrainServer returns the node Service draining app on request.
+func NewDrainServer(app Drainer) ServiceServer {
+	return drainServer{app: app}
+}
+
+// Drain implements ServiceServer.Drain
+func (s drainServer) Drain(ctx context.Context, req *DrainRequest) (*DrainResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !fromLoopback(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "node can only be drained from the loopback interface")
+	}
+
+	return &DrainResponse{HaltHeight: s.app.Drain(req.EpochLength)}, nil
+}
+
+// fromLoopback returns whether the client of the call carried by ctx is
+// connected from the loopback interface.
+func fromLoopback(ctx context.Context) bool {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return false
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return false
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,57 @@
+package node
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type mockDrainer struct {
+	epochLength uint64
+}
+
+func (m *mockDrainer) Drain(epochLength uint64) int64 {
+	m.epochLength = epochLength
+	return 10
+}
+
+func TestDrain(t *testing.T) {
+	withPeer := func(ip string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50000},
+		})
+	}
+
+	testCases := []struct {
+		name   string
+		ctx    context.Context
+		req    *DrainRequest
+		code   codes.Code
+		halted bool
+	}{
+		{"loopback", withPeer("127.0.0.1"), &DrainRequest{EpochLength: 5}, codes.OK, true},
+		{"loopback ipv6", withPeer("::1"), &DrainRequest{}, codes.OK, true},
+		{"remote", withPeer("10.0.0.1"), &DrainRequest{}, codes.PermissionDenied, false},
+		{"unknown peer", context.Background(), &DrainRequest{}, codes.PermissionDenied, false},
+		{"empty request", withPeer("127.0.0.1"), nil, codes.InvalidArgument, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app := &mockDrainer{}
+			res, err := NewDrainServer(app).Drain(tc.ctx, tc.req)
+			require.Equal(t, tc.code, status.Code(err))
+
+			if tc.halted {
+				require.Equal(t, int64(10), res.HaltHeight)
+				require.Equal(t, tc.req.EpochLength, app.epochLength)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
+	"github.com/cosmos/cosmos-sdk/server/grpc/node"
 	reflection "github.com/cosmos/cosmos-sdk/server/grpc/reflection/v2alpha1"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,6 +19,12 @@ import (
 func StartGRPCServer(clientCtx client.Context, app types.Application, address string) (*grpc.Server, error) {
 	grpcSrv := grpc.NewServer()
 	app.RegisterGRPCServer(clientCtx, grpcSrv)
+	// the node service lets operators drain their node ahead of maintenance; it
+	// is not routed through the app's query router, which is also exposed over
+	// ABCI queries, so that it can only be called from the loopback interface
+	if drainer, ok := app.(node.Drainer); ok {
+		node.RegisterServiceServer(grpcSrv, node.NewDrainServer(drainer))
+	}
 	// reflection allows consumers to build dynamic clients that can write
 	// to any cosmos-sdk application without relying on application packages at compile time
 	err := reflection.Register(grpcSrv, reflection.Config{
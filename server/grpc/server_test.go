@@ -5,6 +5,7 @@ package grpc_test
 import (
 	"context"
 	"fmt"
+	"io"
 	"testing"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -109,6 +111,31 @@ func (s *IntegrationTestSuite) TestGRPCServer_BankBalance() {
 	s.Require().NotEmpty(blockHeight[0]) // blockHeight is []string, first element is block height.
 }
 
+func (s *IntegrationTestSuite) TestGRPCServer_BankTotalSupplyStream() {
+	bankClient := banktypes.NewQueryClient(s.conn)
+	stream, err := bankClient.TotalSupplyStream(
+		metadata.AppendToOutgoingContext(context.Background(), grpctypes.GRPCBlockHeightHeader, "1"),
+		&banktypes.QueryTotalSupplyStreamRequest{DenomPattern: "token$", Pagination: &query.PageRequest{Limit: 1}},
+	)
+	s.Require().NoError(err)
+
+	var supply sdk.Coins
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(len(res.Supply), 1)
+		supply = supply.Add(res.Supply...)
+	}
+
+	s.Require().Len(supply, len(s.network.Validators))
+	for _, val := range s.network.Validators {
+		s.Require().True(supply.AmountOf(fmt.Sprintf("%stoken", val.Moniker)).IsPositive())
+	}
+}
+
 func (s *IntegrationTestSuite) TestGRPCServer_Reflection() {
 	// Test server reflection
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
@@ -0,0 +1,251 @@
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmsync "github.com/tendermint/tendermint/libs/sync"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagOutput    = "output"
+	flagTypedOnly = "typed-only"
+)
+
+// Sources of replayed events.
+const (
+	EventSourceBeginBlock = "begin_block"
+	EventSourceTx         = "tx"
+	EventSourceEndBlock   = "end_block"
+)
+
+// ReplayedEvent is an event emitted while replaying a block.
+type ReplayedEvent struct {
+	Height int64 `json:"height"`
+	// Source is the ABCI method which emitted the event, one of begin_block,
+	// tx and end_block.
+	Source string `json:"source"`
+	// TxIndex and TxHash are set for tx events.
+	TxIndex *int   `json:"tx_index,omitempty"`
+	TxHash  string `json:"tx_hash,omitempty"`
+
+	Type       string          `json:"type"`
+	Attributes []sdk.Attribute `json:"attributes"`
+	// TypedEvent is the JSON encoded proto message of events emitted with
+	// EmitTypedEvent.
+	TypedEvent json.RawMessage `json:"typed_event,omitempty"`
+}
+
+// ReplayEventsCmd replays committed blocks through the app to write their
+// events as JSON lines.
+func ReplayEventsCmd(appReplayer types.AppReplayer, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-events [start-height] [end-height]",
+		Short: "Replay committed blocks to write their events",
+		Long: `Replay the committed blocks of a height range through the app, and write
+their events as JSON lines, decoding typed events. The blocks are executed on
+top of the state of their previous height, which must not be pruned, and are
+never committed. The node must be stopped, and the binary must produce the same
+state transitions as the one which executed the blocks.`,
+		Example: fmt.Sprintf("$ %s replay-events 1000 2000 --output events.jsonl", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			startHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start height: %w", err)
+			}
+			endHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end height: %w", err)
+			}
+			if endHeight < startHeight {
+				return fmt.Errorf("end height %d is lower than start height %d", endHeight, startHeight)
+			}
+
+			genDoc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+			if err != nil {
+				return err
+			}
+			if startHeight <= genDoc.InitialHeight {
+				return fmt.Errorf("start height must be greater than the initial height %d", genDoc.InitialHeight)
+			}
+
+			blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: config})
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+			blockStore := store.NewBlockStore(blockStoreDB)
+			if endHeight > blockStore.Height() {
+				return fmt.Errorf("end height %d is greater than the block store height %d", endHeight, blockStore.Height())
+			}
+
+			stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: config})
+			if err != nil {
+				return err
+			}
+			defer stateDB.Close()
+			stateStore := sm.NewStore(stateDB)
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			out := cmd.OutOrStdout()
+			if output, _ := cmd.Flags().GetString(flagOutput); output != "" {
+				f, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+			w := bufio.NewWriter(out)
+			typedOnly, _ := cmd.Flags().GetBool(flagTypedOnly)
+
+			for height := startHeight; height <= endHeight; height++ {
+				block := blockStore.LoadBlock(height)
+				if block == nil {
+					return fmt.Errorf("block %d not found in block store", height)
+				}
+
+				app, err := appReplayer(serverCtx.Logger, db, nil, height-1, serverCtx.Viper)
+				if err != nil {
+					return fmt.Errorf("error loading app at height %d: %w", height-1, err)
+				}
+
+				events, err := ReplayBlock(app, block, stateStore, genDoc.InitialHeight)
+				if err != nil {
+					return fmt.Errorf("error replaying block %d: %w", height, err)
+				}
+
+				if err := writeReplayedEvents(w, events, typedOnly); err != nil {
+					return err
+				}
+			}
+
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagOutput, "", "Write the events to a file instead of stdout")
+	cmd.Flags().Bool(flagTypedOnly, false, "Only write typed events")
+
+	return cmd
+}
+
+// ReplayBlock executes a block on an app loaded at the previous height, without
+// committing it, and returns the emitted events.
+func ReplayBlock(app abci.Application, block *tmtypes.Block, stateStore sm.Store, initialHeight int64) ([]ReplayedEvent, error) {
+	rApp := &replayApp{Application: app, height: block.Height}
+	appConn := proxy.NewAppConnConsensus(abcicli.NewLocalClient(new(tmsync.Mutex), rApp))
+
+	if _, err := sm.ExecCommitBlock(appConn, block, log.NewNopLogger(), stateStore, initialHeight); err != nil {
+		return nil, err
+	}
+	if rApp.err != nil {
+		return nil, rApp.err
+	}
+
+	return rApp.events, nil
+}
+
+// replayApp records the events of the wrapped app, and skips commits.
+type replayApp struct {
+	abci.Application
+
+	height  int64
+	txIndex int
+	events  []ReplayedEvent
+	err     error
+}
+
+func (app *replayApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	res := app.Application.BeginBlock(req)
+	app.record(EventSourceBeginBlock, nil, "", res.Events)
+	return res
+}
+
+func (app *replayApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	res := app.Application.DeliverTx(req)
+	txIndex := app.txIndex
+	app.txIndex++
+	app.record(EventSourceTx, &txIndex, fmt.Sprintf("%X", tmtypes.Tx(req.Tx).Hash()), res.Events)
+	return res
+}
+
+func (app *replayApp) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
+	res := app.Application.EndBlock(req)
+	app.record(EventSourceEndBlock, nil, "", res.Events)
+	return res
+}
+
+// Commit implements abci.Application. It doesn't commit the replayed block.
+func (app *replayApp) Commit() abci.ResponseCommit {
+	return abci.ResponseCommit{}
+}
+
+func (app *replayApp) record(source string, txIndex *int, txHash string, events []abci.Event) {
+	for _, event := range events {
+		replayed := ReplayedEvent{
+			Height:     app.height,
+			Source:     source,
+			TxIndex:    txIndex,
+			TxHash:     txHash,
+			Type:       event.Type,
+			Attributes: sdk.StringifyEvent(event).Attributes,
+		}
+
+		// events which are not typed events fail to parse
+		if msg, err := sdk.ParseTypedEvent(event); err == nil {
+			bz, err := codec.ProtoMarshalJSON(msg, nil)
+			if err != nil && app.err == nil {
+				app.err = fmt.Errorf("error encoding typed event %s: %w", event.Type, err)
+			}
+			replayed.TypedEvent = bz
+		}
+
+		app.events = append(app.events, replayed)
+	}
+}
+
+func writeReplayedEvents(w io.Writer, events []ReplayedEvent, typedOnly bool) error {
+	enc := json.NewEncoder(w)
+	for _, event := range events {
+		if typedOnly && event.TypedEvent == nil {
+			continue
+		}
+		if err := enc.Encode(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package server_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	sm "github.com/tendermint/tendermint/state"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/mock"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestReplayBlock(t *testing.T) {
+	app, closer, err := mock.SetupApp()
+	if closer != nil {
+		defer closer()
+	}
+	require.NoError(t, err)
+
+	txBytes := mock.NewTx("key", "value").GetSignBytes()
+	block := tmtypes.MakeBlock(1, []tmtypes.Tx{txBytes}, &tmtypes.Commit{}, nil)
+
+	events, err := server.ReplayBlock(app, block, sm.NewStore(dbm.NewMemDB()), 1)
+	require.NoError(t, err)
+	require.NotEmpty(t, events)
+	for _, event := range events {
+		require.Equal(t, int64(1), event.Height)
+		require.Equal(t, server.EventSourceTx, event.Source)
+		require.Equal(t, 0, *event.TxIndex)
+		require.Equal(t, fmt.Sprintf("%X", tmtypes.Tx(txBytes).Hash()), event.TxHash)
+		require.Nil(t, event.TypedEvent)
+	}
+
+	// the replayed block is not committed
+	res := app.Query(abci.RequestQuery{Path: "/store/main/key", Data: []byte("key")})
+	require.Nil(t, res.Value)
+	require.Equal(t, int64(0), app.Info(abci.RequestInfo{}).LastBlockHeight)
+}
+
+// typedEventApp emits a typed event at the end of each block.
+type typedEventApp struct {
+	abci.BaseApplication
+}
+
+func (typedEventApp) EndBlock(abci.RequestEndBlock) abci.ResponseEndBlock {
+	event, err := sdk.TypedEventToEvent(&testdata.Dog{Name: "rex", Size_: "big"})
+	if err != nil {
+		panic(err)
+	}
+	return abci.ResponseEndBlock{Events: sdk.Events{event}.ToABCIEvents()}
+}
+
+func TestReplayBlockTypedEvent(t *testing.T) {
+	block := tmtypes.MakeBlock(1, nil, &tmtypes.Commit{}, nil)
+	events, err := server.ReplayBlock(typedEventApp{}, block, sm.NewStore(dbm.NewMemDB()), 1)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, server.EventSourceEndBlock, events[0].Source)
+	require.Nil(t, events[0].TxIndex)
+	require.Equal(t, "testdata.Dog", events[0].Type)
+	require.JSONEq(t, `{"name":"rex","size":"big"}`, string(events[0].TypedEvent))
+}
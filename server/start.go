@@ -3,6 +3,7 @@ package server
 // DONTCOVER
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
@@ -33,22 +34,28 @@ import (
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 // Tendermint full-node start flags
 const (
-	flagWithTendermint     = "with-tendermint"
-	flagAddress            = "address"
-	flagTransport          = "transport"
-	flagTraceStore         = "trace-store"
-	flagCPUProfile         = "cpu-profile"
-	FlagMinGasPrices       = "minimum-gas-prices"
-	FlagHaltHeight         = "halt-height"
-	FlagHaltTime           = "halt-time"
-	FlagInterBlockCache    = "inter-block-cache"
-	FlagUnsafeSkipUpgrades = "unsafe-skip-upgrades"
-	FlagTrace              = "trace"
-	FlagInvCheckPeriod     = "inv-check-period"
+	flagWithTendermint        = "with-tendermint"
+	flagAddress               = "address"
+	flagTransport             = "transport"
+	flagTraceStore            = "trace-store"
+	flagCPUProfile            = "cpu-profile"
+	FlagMinGasPrices          = "minimum-gas-prices"
+	FlagHaltHeight            = "halt-height"
+	FlagHaltTime              = "halt-time"
+	FlagHaltOnInvariantBreach = "halt-on-invariant-breach"
+	FlagHaltCanaryEndpoint    = "halt-canary-endpoint"
+	FlagInterBlockCache       = "inter-block-cache"
+	FlagCommitWorkers         = "commit-workers"
+	FlagBlockReport           = "block-report"
+	FlagUnsafeSkipUpgrades    = "unsafe-skip-upgrades"
+	FlagTrace                 = "trace"
+	FlagInvCheckPeriod        = "inv-check-period"
+	FlagModuleGraph           = "module-graph"
 
 	FlagPruning           = "pruning"
 	FlagPruningKeepRecent = "pruning-keep-recent"
@@ -68,8 +75,10 @@ const (
 
 // State sync-related flags.
 const (
-	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
-	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotInterval      = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotDeltaInterval = "state-sync.snapshot-delta-interval"
+	FlagStateSyncSnapshotKeepRecent    = "state-sync.snapshot-keep-recent"
+	FlagStateSyncPreUpgradeSnapshot    = "state-sync.pre-upgrade-snapshot"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -146,7 +155,12 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().IntSlice(FlagUnsafeSkipUpgrades, []int{}, "Skip a set of upgrade heights to continue the old binary")
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
+	cmd.Flags().Bool(FlagHaltOnInvariantBreach, false, "Gracefully halt the chain and shutdown the node once the block is committed when an invariant is broken")
+	cmd.Flags().String(FlagModuleGraph, "", "Write the module keeper dependency graph in the Graphviz DOT format to the given file at app start")
+	cmd.Flags().String(FlagHaltCanaryEndpoint, "", "Tendermint RPC endpoint of a trusted canary node; gracefully halt the chain and shutdown the node on an app hash mismatch with the canary")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
+	cmd.Flags().Uint(FlagCommitWorkers, 0, "Number of goroutines committing the stores in parallel (0 or 1 to commit serially)")
+	cmd.Flags().Bool(FlagBlockReport, false, "Emit an event at the end of each block reporting its gas used, tx counts and end blocker durations")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 	cmd.Flags().Bool(FlagTrace, false, "Provide full stack traces for errors in ABCI Log")
 	cmd.Flags().String(FlagPruning, storetypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
@@ -163,7 +177,9 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().String(flagGRPCWebAddress, config.DefaultGRPCWebAddress, "The gRPC-Web server address to listen on")
 
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
+	cmd.Flags().Uint64(FlagStateSyncSnapshotDeltaInterval, 0, "State sync delta snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Bool(FlagStateSyncPreUpgradeSnapshot, false, "Take a state sync snapshot at the block before an upgrade height")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -250,6 +266,11 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		return err
 	}
 
+	shutdownTracing, err := telemetry.NewTracerProvider(config.Telemetry)
+	if err != nil {
+		return err
+	}
+
 	app := appCreator(ctx.Logger, db, traceWriter, ctx.Viper)
 
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
@@ -379,6 +400,11 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			cpuProfileCleanup()
 		}
 
+		// flush the spans buffered by the exporter
+		if err := shutdownTracing(context.Background()); err != nil {
+			ctx.Logger.Error("failed to shut down tracing", "err", err)
+		}
+
 		if apiSrv != nil {
 			_ = apiSrv.Close()
 		}
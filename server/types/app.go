@@ -76,4 +76,8 @@ type (
 	// AppExporter is a function that dumps all app state to
 	// JSON-serializable structure and returns the current validator set.
 	AppExporter func(log.Logger, dbm.DB, io.Writer, int64, bool, []string, AppOptions) (ExportedApp, error)
+
+	// AppReplayer is a function that creates an application loaded at a given
+	// height, on top of which the next block is replayed without committing it.
+	AppReplayer func(log.Logger, dbm.DB, io.Writer, int64, AppOptions) (Application, error)
 )
@@ -2,6 +2,7 @@ package simapp
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
@@ -13,6 +14,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -68,17 +70,30 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle"
+	oraclekeeper "github.com/cosmos/cosmos-sdk/x/oracle/keeper"
+	oraclemodule "github.com/cosmos/cosmos-sdk/x/oracle/module"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	paramsclient "github.com/cosmos/cosmos-sdk/x/params/client"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/cosmos/cosmos-sdk/x/ratelimit"
+	ratelimitkeeper "github.com/cosmos/cosmos-sdk/x/ratelimit/keeper"
+	ratelimitmodule "github.com/cosmos/cosmos-sdk/x/ratelimit/module"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+	schedulerkeeper "github.com/cosmos/cosmos-sdk/x/scheduler/keeper"
+	schedulermodule "github.com/cosmos/cosmos-sdk/x/scheduler/module"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/x/stream"
+	streamclient "github.com/cosmos/cosmos-sdk/x/stream/client"
+	streamkeeper "github.com/cosmos/cosmos-sdk/x/stream/keeper"
+	streammodule "github.com/cosmos/cosmos-sdk/x/stream/module"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
@@ -107,6 +122,7 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
+			streamclient.ProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -116,16 +132,31 @@ var (
 		evidence.AppModuleBasic{},
 		authzmodule.AppModuleBasic{},
 		vesting.AppModuleBasic{},
+		schedulermodule.AppModuleBasic{},
+		streammodule.AppModuleBasic{},
+		oraclemodule.AppModuleBasic{},
+		ratelimitmodule.AppModuleBasic{},
 	)
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
+		authtypes.FeeCollectorName:      nil,
+		distrtypes.ModuleName:           nil,
+		minttypes.ModuleName:            {authtypes.Minter},
+		stakingtypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:             {authtypes.Burner},
+		slashingtypes.InsurancePoolName: nil,
+		scheduler.ModuleName:            nil,
+		stream.ModuleName:               nil,
+	}
+
+	// epochBoundaryContract is the order in which the modules sharing an epoch
+	// boundary must perform their work: the staking queues are flushed before
+	// the queued slashing events are processed, so that the distribution
+	// payouts and then the mint provisions see the final validator set.
+	epochBoundaryContract = []string{
+		stakingtypes.ModuleName, slashingtypes.ModuleName, distrtypes.ModuleName, minttypes.ModuleName,
 	}
 )
 
@@ -167,6 +198,10 @@ type SimApp struct {
 	AuthzKeeper      authzkeeper.Keeper
 	EvidenceKeeper   evidencekeeper.Keeper
 	FeeGrantKeeper   feegrantkeeper.Keeper
+	SchedulerKeeper  schedulerkeeper.Keeper
+	StreamKeeper     streamkeeper.Keeper
+	OracleKeeper     oraclekeeper.Keeper
+	RateLimitKeeper  ratelimitkeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -208,7 +243,8 @@ func NewSimApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, capabilitytypes.StoreKey,
-		authzkeeper.StoreKey,
+		authzkeeper.StoreKey, scheduler.StoreKey, stream.StoreKey, oracle.StoreKey,
+		ratelimit.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	// NOTE: The testingkey is just mounted for testing purposes. Actual applications should
@@ -228,7 +264,10 @@ func NewSimApp(
 		memKeys:           memKeys,
 	}
 
-	app.ParamsKeeper = initParamsKeeper(appCodec, legacyAmino, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
+	app.ParamsKeeper = initParamsKeeper(
+		appCodec, legacyAmino, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 
 	// set the BaseApp's parameter store
 	bApp.SetParamStore(app.ParamsKeeper.Subspace(baseapp.Paramspace).WithKeyTable(paramstypes.ConsensusParamsKeyTable()))
@@ -244,7 +283,14 @@ func NewSimApp(
 	)
 	app.BankKeeper = bankkeeper.NewBaseKeeper(
 		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.ModuleAccountAddrs(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(
+		appCodec, keys[ratelimit.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	// NOTE: the send restriction is shared by the copies of the bank keeper
+	// given to the other keepers
+	app.BankKeeper.AppendSendRestriction(app.RateLimitKeeper.SendRestriction)
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
@@ -257,8 +303,18 @@ func NewSimApp(
 		&stakingKeeper, authtypes.FeeCollectorName, app.ModuleAccountAddrs(),
 	)
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
-		appCodec, keys[slashingtypes.StoreKey], &stakingKeeper, app.GetSubspace(slashingtypes.ModuleName),
+		appCodec, keys[slashingtypes.StoreKey], &stakingKeeper, app.BankKeeper, app.DistrKeeper,
+		app.GetSubspace(slashingtypes.ModuleName),
 	)
+	var monitoredValidators []sdk.ConsAddress
+	for _, addr := range cast.ToStringSlice(appOpts.Get(slashing.FlagMonitoredValidators)) {
+		consAddr, err := sdk.ConsAddressFromBech32(addr)
+		if err != nil {
+			panic(fmt.Errorf("invalid monitored validator %s: %w", addr, err))
+		}
+		monitoredValidators = append(monitoredValidators, consAddr)
+	}
+	app.SlashingKeeper.SetMonitoredValidators(monitoredValidators)
 	app.CrisisKeeper = crisiskeeper.NewKeeper(
 		app.GetSubspace(crisistypes.ModuleName), invCheckPeriod, app.BankKeeper, authtypes.FeeCollectorName,
 	)
@@ -266,34 +322,51 @@ func NewSimApp(
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.AccountKeeper)
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp)
 
-	// register the staking hooks
-	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
-	app.StakingKeeper = *stakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
+	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.msgSvcRouter)
+
+	app.SchedulerKeeper = schedulerkeeper.NewKeeper(
+		appCodec, keys[scheduler.StoreKey], app.msgSvcRouter, app.AccountKeeper, app.BankKeeper,
+		authtypes.FeeCollectorName, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
-	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.msgSvcRouter)
+	app.OracleKeeper = oraclekeeper.NewKeeper(
+		appCodec, keys[oracle.StoreKey], &stakingKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.StreamKeeper = streamkeeper.NewKeeper(
+		appCodec, keys[stream.StoreKey], app.AccountKeeper, app.BankKeeper, app.DistrKeeper,
+	)
 
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
-		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
+		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(stream.RouterKey, streammodule.NewCommunityPoolStreamProposalHandler(app.StreamKeeper))
 	govKeeper := govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
-		&stakingKeeper, govRouter,
+		&stakingKeeper, app.DistrKeeper, govRouter,
+	)
+
+	// register the staking hooks
+	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
+	app.StakingKeeper = *stakingKeeper.SetHooks(
+		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks(), govKeeper.StakingHooks()),
 	)
 
 	app.GovKeeper = *govKeeper.SetHooks(
 		govtypes.NewMultiGovHooks(
-		// register the governance hooks
+			// register the governance hooks
+			app.StakingKeeper.GovHooks(),
 		),
 	)
+	app.UpgradeKeeper.SetGovKeeper(app.GovKeeper)
+	app.UpgradeKeeper.SetPreUpgradeSnapshotter(app.BaseApp)
 
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
-		appCodec, keys[evidencetypes.StoreKey], &app.StakingKeeper, app.SlashingKeeper,
+		appCodec, keys[evidencetypes.StoreKey], app.GetSubspace(evidencetypes.ModuleName), &app.StakingKeeper, app.SlashingKeeper,
 	)
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
@@ -311,7 +384,7 @@ func NewSimApp(
 			app.AccountKeeper, app.StakingKeeper, app.BaseApp.DeliverTx,
 			encodingConfig.TxConfig,
 		),
-		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
+		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts, app.BankKeeper, app.StakingKeeper),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
@@ -326,6 +399,10 @@ func NewSimApp(
 		evidence.NewAppModule(app.EvidenceKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		schedulermodule.NewAppModule(appCodec, app.SchedulerKeeper),
+		streammodule.NewAppModule(appCodec, app.StreamKeeper),
+		oraclemodule.NewAppModule(appCodec, app.OracleKeeper),
+		ratelimitmodule.NewAppModule(appCodec, app.RateLimitKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -335,9 +412,19 @@ func NewSimApp(
 	// NOTE: capability module's beginblocker must come before any modules using capabilities (e.g. IBC)
 	app.mm.SetOrderBeginBlockers(
 		upgradetypes.ModuleName, capabilitytypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
-		evidencetypes.ModuleName, stakingtypes.ModuleName,
+		evidencetypes.ModuleName, oracle.ModuleName, stakingtypes.ModuleName,
+	)
+	// NOTE: auth module's endblocker must come after staking's so that the
+	// accounts of the delegators whose unbonding completed aren't pruned
+	// NOTE: scheduler module's endblocker must come first so that the invariants
+	// are checked, and the validator set is updated, after the scheduled messages
+	app.mm.SetOrderEndBlockers(
+		scheduler.ModuleName, crisistypes.ModuleName, govtypes.ModuleName, oracle.ModuleName, stakingtypes.ModuleName,
+		authtypes.ModuleName,
 	)
-	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName)
+	// NOTE: the epoch boundary hooks are called after all the begin blockers.
+	// Any module added to the order must respect epochBoundaryContract.
+	app.mm.SetOrderEpochBoundary(slashingtypes.ModuleName, distrtypes.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -348,10 +435,32 @@ func NewSimApp(
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
-		feegrant.ModuleName,
+		feegrant.ModuleName, scheduler.ModuleName, stream.ModuleName, oracle.ModuleName,
+		ratelimit.ModuleName, paramstypes.ModuleName,
 	)
 
+	// fail fast on missing keeper wiring or dependency cycles between modules
+	if err := app.mm.ValidateDependencies(); err != nil {
+		panic(err)
+	}
+	// and on keepers holding undeclared keepers, e.g. set with a setter
+	if err := app.mm.ValidateKeeperWiring(app.moduleKeepers()); err != nil {
+		panic(err)
+	}
+	// fail fast on an epoch boundary order breaking the cross-module contract
+	if err := app.mm.ValidateOrderEpochBoundary(epochBoundaryContract...); err != nil {
+		panic(err)
+	}
+	if graphFile := cast.ToString(appOpts.Get(server.FlagModuleGraph)); graphFile != "" {
+		if err := writeModuleGraph(app.mm, graphFile); err != nil {
+			panic(err)
+		}
+	}
+
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
+	if cast.ToBool(appOpts.Get(server.FlagHaltOnInvariantBreach)) {
+		app.CrisisKeeper.SetHaltHandler(app.RequestHalt)
+	}
 	app.mm.RegisterRoutes(app.legacyRouter, app.QueryRouter(), encodingConfig.Amino)
 	app.configurator = module.NewConfigurator(app.appCodec, app.msgSvcRouter, app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
@@ -364,7 +473,7 @@ func NewSimApp(
 	// NOTE: this is not required apps that don't use the simulator for fuzz testing
 	// transactions
 	app.sm = module.NewSimulationManager(
-		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
+		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts, app.BankKeeper, app.StakingKeeper),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
@@ -395,6 +504,13 @@ func NewSimApp(
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		// refuse to run a binary whose modules are ahead of state, unless it
+		// is about to apply a pending upgrade
+		ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
+		if err := app.UpgradeKeeper.CheckModuleVersionDrift(ctx, app.mm.GetVersionMap()); err != nil {
+			tmos.Exit(err.Error())
+		}
 	}
 
 	return app
@@ -424,6 +540,7 @@ func (app *SimApp) setTxHandler(txConfig client.TxConfig, indexEventsStr []strin
 		LegacyRouter:      app.legacyRouter,
 		MsgServiceRouter:  app.msgSvcRouter,
 		LegacyAnteHandler: anteHandler,
+		KVGasConfigKeeper: app.AccountKeeper,
 	})
 	if err != nil {
 		panic(err)
@@ -575,8 +692,8 @@ func GetMaccPerms() map[string][]string {
 }
 
 // initParamsKeeper init params keeper and its subspaces
-func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey sdk.StoreKey) paramskeeper.Keeper {
-	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, key, tkey)
+func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey sdk.StoreKey, authority string) paramskeeper.Keeper {
+	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, key, tkey, authority)
 
 	paramsKeeper.Subspace(authtypes.ModuleName)
 	paramsKeeper.Subspace(banktypes.ModuleName)
@@ -586,6 +703,43 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(slashingtypes.ModuleName)
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govtypes.ParamKeyTable())
 	paramsKeeper.Subspace(crisistypes.ModuleName)
+	paramsKeeper.Subspace(evidencetypes.ModuleName)
 
 	return paramsKeeper
 }
+
+// moduleKeepers returns the keepers of the app by module name.
+func (app *SimApp) moduleKeepers() map[string]interface{} {
+	return map[string]interface{}{
+		authtypes.ModuleName:       app.AccountKeeper,
+		banktypes.ModuleName:       app.BankKeeper,
+		capabilitytypes.ModuleName: app.CapabilityKeeper,
+		stakingtypes.ModuleName:    app.StakingKeeper,
+		slashingtypes.ModuleName:   app.SlashingKeeper,
+		minttypes.ModuleName:       app.MintKeeper,
+		distrtypes.ModuleName:      app.DistrKeeper,
+		govtypes.ModuleName:        app.GovKeeper,
+		crisistypes.ModuleName:     app.CrisisKeeper,
+		upgradetypes.ModuleName:    app.UpgradeKeeper,
+		paramstypes.ModuleName:     app.ParamsKeeper,
+		authz.ModuleName:           app.AuthzKeeper,
+		evidencetypes.ModuleName:   app.EvidenceKeeper,
+		feegrant.ModuleName:        app.FeeGrantKeeper,
+		scheduler.ModuleName:       app.SchedulerKeeper,
+		stream.ModuleName:          app.StreamKeeper,
+		oracle.ModuleName:          app.OracleKeeper,
+		ratelimit.ModuleName:       app.RateLimitKeeper,
+	}
+}
+
+// writeModuleGraph writes the module keeper dependency graph of the given
+// module manager in the Graphviz DOT format to the given file.
+func writeModuleGraph(mm *module.Manager, file string) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return mm.WriteDependencyGraphDOT(f)
+}
@@ -30,10 +30,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/mint"
+	oraclemodule "github.com/cosmos/cosmos-sdk/x/oracle/module"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	ratelimitmodule "github.com/cosmos/cosmos-sdk/x/ratelimit/module"
+	schedulermodule "github.com/cosmos/cosmos-sdk/x/scheduler/module"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	streammodule "github.com/cosmos/cosmos-sdk/x/stream/module"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 func TestSimAppExportAndBlockedAddrs(t *testing.T) {
@@ -68,6 +73,30 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
+// noKeeperDependencies drops the keeper dependencies declared by a module
+type noKeeperDependencies struct {
+	module.AppModule
+}
+
+func (noKeeperDependencies) KeeperDependencies() []string { return nil }
+
+func TestKeeperWiring(t *testing.T) {
+	app := Setup(t, false)
+	require.NoError(t, app.mm.ValidateKeeperWiring(app.moduleKeepers()))
+
+	// the gov keeper set on the upgrade keeper with SetGovKeeper must be
+	// declared by the upgrade module
+	modules := make([]module.AppModule, 0, len(app.mm.Modules))
+	for name, mod := range app.mm.Modules {
+		if name == upgradetypes.ModuleName {
+			mod = noKeeperDependencies{mod}
+		}
+		modules = append(modules, mod)
+	}
+	require.EqualError(t, module.NewManager(modules...).ValidateKeeperWiring(app.moduleKeepers()),
+		"module upgrade keeper holds the keeper of module gov which is not declared in its keeper dependencies")
+}
+
 func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
@@ -189,6 +218,10 @@ func TestRunMigrations(t *testing.T) {
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
 					"capability":   capability.AppModule{}.ConsensusVersion(),
+					"scheduler":    schedulermodule.AppModule{}.ConsensusVersion(),
+					"stream":       streammodule.AppModule{}.ConsensusVersion(),
+					"oracle":       oraclemodule.AppModule{}.ConsensusVersion(),
+					"ratelimit":    ratelimitmodule.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -241,6 +274,10 @@ func TestInitGenesisOnMigration(t *testing.T) {
 			"crisis":       crisis.AppModule{}.ConsensusVersion(),
 			"genutil":      genutil.AppModule{}.ConsensusVersion(),
 			"capability":   capability.AppModule{}.ConsensusVersion(),
+			"scheduler":    schedulermodule.AppModule{}.ConsensusVersion(),
+			"stream":       streammodule.AppModule{}.ConsensusVersion(),
+			"oracle":       oraclemodule.AppModule{}.ConsensusVersion(),
+			"ratelimit":    ratelimitmodule.AppModule{}.ConsensusVersion(),
 		},
 	)
 	require.NoError(t, err)
@@ -3,7 +3,8 @@ package simapp
 import (
 	"flag"
 
-	"github.com/cosmos/cosmos-sdk/types/simulation"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
 // List of available flags for the simulator
@@ -14,6 +15,8 @@ var (
 	FlagExportParamsHeightValue int
 	FlagExportStatePathValue    string
 	FlagExportStatsPathValue    string
+	FlagExportFailurePathValue  string
+	FlagReplayFailureValue      string
 	FlagSeedValue               int64
 	FlagInitialBlockHeightValue int
 	FlagNumBlocksValue          int
@@ -38,6 +41,8 @@ func GetSimulatorFlags() {
 	flag.IntVar(&FlagExportParamsHeightValue, "ExportParamsHeight", 0, "height to which export the randomly generated params")
 	flag.StringVar(&FlagExportStatePathValue, "ExportStatePath", "", "custom file path to save the exported app state JSON")
 	flag.StringVar(&FlagExportStatsPathValue, "ExportStatsPath", "", "custom file path to save the exported simulation statistics JSON")
+	flag.StringVar(&FlagExportFailurePathValue, "ExportFailurePath", "", "custom file path to save a replayable record of a failed simulation run JSON")
+	flag.StringVar(&FlagReplayFailureValue, "ReplayFailure", "", "replay the failed simulation run recorded at the given path, overriding the other config flags")
 	flag.Int64Var(&FlagSeedValue, "Seed", 42, "simulation random seed")
 	flag.IntVar(&FlagInitialBlockHeightValue, "InitialBlockHeight", 1, "initial block to start the simulation")
 	flag.IntVar(&FlagNumBlocksValue, "NumBlocks", 500, "number of new blocks to simulate from the initial block height")
@@ -55,14 +60,25 @@ func GetSimulatorFlags() {
 }
 
 // NewConfigFromFlags creates a simulation from the retrieved values of the flags.
-func NewConfigFromFlags() simulation.Config {
-	return simulation.Config{
+// When a failed simulation run is replayed, the config is the one replaying it.
+func NewConfigFromFlags() simtypes.Config {
+	if FlagReplayFailureValue != "" {
+		failure, err := simulation.LoadFailure(FlagReplayFailureValue)
+		if err != nil {
+			panic(err)
+		}
+
+		return failure.ReplayConfig()
+	}
+
+	return simtypes.Config{
 		GenesisFile:        FlagGenesisFileValue,
 		ParamsFile:         FlagParamsFileValue,
 		ExportParamsPath:   FlagExportParamsPathValue,
 		ExportParamsHeight: FlagExportParamsHeightValue,
 		ExportStatePath:    FlagExportStatePathValue,
 		ExportStatsPath:    FlagExportStatsPathValue,
+		ExportFailurePath:  FlagExportFailurePathValue,
 		Seed:               FlagSeedValue,
 		InitialBlockHeight: FlagInitialBlockHeightValue,
 		NumBlocks:          FlagNumBlocksValue,
@@ -98,6 +98,33 @@ func (m *mockSnapshotter) Snapshot(height uint64, format uint32) (<-chan io.Read
 	return ch, nil
 }
 
+type mockDeltaSnapshotter struct {
+	mockSnapshotter
+	deltaChunks [][]byte
+	baseChunks  [][]byte
+}
+
+func (m *mockDeltaSnapshotter) RestoreDelta(
+	baseHeight, height uint64, format uint32, deltaChunks, baseChunks <-chan io.ReadCloser, ready chan<- struct{},
+) error {
+	if format != types.CurrentDeltaFormat {
+		return types.ErrUnknownFormat
+	}
+	if ready != nil {
+		close(ready)
+	}
+	m.deltaChunks = readChunks(deltaChunks)
+	m.baseChunks = readChunks(baseChunks)
+	return nil
+}
+
+func (m *mockDeltaSnapshotter) SnapshotDelta(baseHeight, height uint64, format uint32) (<-chan io.ReadCloser, error) {
+	if format != types.CurrentDeltaFormat {
+		return nil, types.ErrUnknownFormat
+	}
+	return makeChunks(m.deltaChunks), nil
+}
+
 // setupBusyManager creates a manager with an empty store that is busy creating a snapshot at height 1.
 // The snapshot will complete when the returned closer is called.
 func setupBusyManager(t *testing.T) *snapshots.Manager {
@@ -49,9 +49,11 @@ type Manager struct {
 	mtx                sync.Mutex
 	operation          operation
 	chRestore          chan<- io.ReadCloser
+	chRestoreBase      chan<- io.ReadCloser // base snapshot chunks, when restoring a delta snapshot
 	chRestoreDone      <-chan restoreDone
 	restoreChunkHashes [][]byte
 	restoreChunkIndex  uint32
+	restoreDeltaChunks uint32
 }
 
 // NewManager creates a new manager.
@@ -95,9 +97,14 @@ func (m *Manager) endLocked() {
 		close(m.chRestore)
 		m.chRestore = nil
 	}
+	if m.chRestoreBase != nil {
+		close(m.chRestoreBase)
+		m.chRestoreBase = nil
+	}
 	m.chRestoreDone = nil
 	m.restoreChunkHashes = nil
 	m.restoreChunkIndex = 0
+	m.restoreDeltaChunks = 0
 }
 
 // Create creates a snapshot and returns its metadata.
@@ -127,6 +134,48 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 	return m.store.Save(height, types.CurrentFormat, chunks)
 }
 
+// CreateDelta creates a delta snapshot on top of the latest full snapshot and returns its
+// metadata. The snapshot target must implement types.DeltaSnapshotter.
+func (m *Manager) CreateDelta(height uint64) (*types.Snapshot, error) {
+	if m == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "no snapshot store configured")
+	}
+	target, ok := m.target.(types.DeltaSnapshotter)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "snapshot target does not support delta snapshots")
+	}
+	err := m.begin(opSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	defer m.end()
+
+	snapshots, err := m.store.List()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to examine latest snapshot")
+	}
+	if len(snapshots) > 0 && snapshots[0].Height >= height {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrConflict,
+			"a more recent snapshot already exists at height %v", snapshots[0].Height)
+	}
+	var base *types.Snapshot
+	for _, snapshot := range snapshots {
+		if snapshot.Format == types.CurrentFormat {
+			base = snapshot
+			break
+		}
+	}
+	if base == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, "no base snapshot found for delta snapshot")
+	}
+
+	chunks, err := target.SnapshotDelta(base.Height, height, types.CurrentDeltaFormat)
+	if err != nil {
+		return nil, err
+	}
+	return m.store.SaveDelta(height, types.CurrentDeltaFormat, base, chunks)
+}
+
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
 func (m *Manager) List() ([]*types.Snapshot, error) {
 	return m.store.List()
@@ -168,6 +217,24 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 			uint32(len(snapshot.Metadata.ChunkHashes)),
 			snapshot.Chunks)
 	}
+	var deltaTarget types.DeltaSnapshotter
+	if snapshot.Metadata.BaseHeight > 0 {
+		if snapshot.Metadata.DeltaChunks == 0 || snapshot.Metadata.DeltaChunks >= snapshot.Chunks {
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v delta chunks, but %v chunks",
+				snapshot.Metadata.DeltaChunks, snapshot.Chunks)
+		}
+		if snapshot.Metadata.BaseHeight >= snapshot.Height {
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata, "base height %v is not below snapshot height %v",
+				snapshot.Metadata.BaseHeight, snapshot.Height)
+		}
+		if snapshot.Metadata.BaseFormat != types.CurrentFormat {
+			return sdkerrors.Wrapf(types.ErrUnknownFormat, "base format %v", snapshot.Metadata.BaseFormat)
+		}
+		var ok bool
+		if deltaTarget, ok = m.target.(types.DeltaSnapshotter); !ok {
+			return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v", snapshot.Format)
+		}
+	}
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 	err := m.beginLocked(opRestore)
@@ -176,11 +243,22 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	}
 
 	// Start an asynchronous snapshot restoration, passing chunks and completion status via channels.
+	// Delta snapshots pass their delta chunks and base snapshot chunks via separate channels.
 	chChunks := make(chan io.ReadCloser, chunkBufferSize)
+	var chBaseChunks chan io.ReadCloser
+	if deltaTarget != nil {
+		chBaseChunks = make(chan io.ReadCloser, chunkBufferSize)
+	}
 	chReady := make(chan struct{}, 1)
 	chDone := make(chan restoreDone, 1)
 	go func() {
-		err := m.target.Restore(snapshot.Height, snapshot.Format, chChunks, chReady)
+		var err error
+		if deltaTarget != nil {
+			err = deltaTarget.RestoreDelta(snapshot.Metadata.BaseHeight, snapshot.Height, snapshot.Format,
+				chChunks, chBaseChunks, chReady)
+		} else {
+			err = m.target.Restore(snapshot.Height, snapshot.Format, chChunks, chReady)
+		}
 		chDone <- restoreDone{
 			complete: err == nil,
 			err:      err,
@@ -200,6 +278,8 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	}
 
 	m.chRestore = chChunks
+	m.chRestoreBase = chBaseChunks
+	m.restoreDeltaChunks = snapshot.Metadata.DeltaChunks
 	m.chRestoreDone = chDone
 	m.restoreChunkHashes = snapshot.Metadata.ChunkHashes
 	m.restoreChunkIndex = 0
@@ -242,6 +322,13 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	m.chRestore <- ioutil.NopCloser(bytes.NewReader(chunk))
 	m.restoreChunkIndex++
 
+	// Switch to the base snapshot chunks after the delta chunks of a delta snapshot.
+	if m.chRestoreBase != nil && m.restoreChunkIndex == m.restoreDeltaChunks {
+		close(m.chRestore)
+		m.chRestore = m.chRestoreBase
+		m.chRestoreBase = nil
+	}
+
 	if int(m.restoreChunkIndex) >= len(m.restoreChunkHashes) {
 		close(m.chRestore)
 		m.chRestore = nil
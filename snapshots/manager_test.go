@@ -95,6 +95,40 @@ func TestManager_Take(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestManager_TakeDelta(t *testing.T) {
+	store := setupStore(t)
+	snapshotter := &mockDeltaSnapshotter{deltaChunks: [][]byte{{1, 2, 3}}}
+	manager := snapshots.NewManager(store, snapshotter)
+
+	// targets without delta snapshot support should error
+	_, err := snapshots.NewManager(store, &mockSnapshotter{}).CreateDelta(5)
+	require.Error(t, err)
+
+	// creating a delta snapshot at a lower height than the latest should error
+	_, err = manager.CreateDelta(3)
+	require.Error(t, err)
+
+	// creating a delta snapshot should use the latest snapshot in the current format as base
+	snapshot, err := manager.CreateDelta(5)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, snapshot.Height)
+	assert.Equal(t, types.CurrentDeltaFormat, snapshot.Format)
+	assert.EqualValues(t, 3, snapshot.Chunks)
+	assert.EqualValues(t, 2, snapshot.Metadata.BaseHeight)
+	assert.Equal(t, types.CurrentFormat, snapshot.Metadata.BaseFormat)
+	assert.EqualValues(t, 1, snapshot.Metadata.DeltaChunks)
+
+	_, chunks, err := store.Load(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{{1, 2, 3}, {2, 1, 0}, {2, 1, 1}}, readChunks(chunks))
+
+	// creating a delta snapshot without a base snapshot should error
+	require.NoError(t, store.Delete(1, types.CurrentFormat))
+	require.NoError(t, store.Delete(2, types.CurrentFormat))
+	_, err = manager.CreateDelta(6)
+	require.Error(t, err)
+}
+
 func TestManager_Prune(t *testing.T) {
 	store := setupStore(t)
 	manager := snapshots.NewManager(store, nil)
@@ -207,3 +241,57 @@ func TestManager_Restore(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestManager_RestoreDelta(t *testing.T) {
+	store := setupStore(t)
+	target := &mockDeltaSnapshotter{}
+	manager := snapshots.NewManager(store, target)
+
+	chunks := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+	snapshot := types.Snapshot{
+		Height: 5,
+		Format: types.CurrentDeltaFormat,
+		Hash:   []byte{1, 2, 3},
+		Chunks: 3,
+		Metadata: types.Metadata{
+			ChunkHashes: checksums(chunks),
+			BaseHeight:  2,
+			BaseFormat:  types.CurrentFormat,
+			DeltaChunks: 1,
+		},
+	}
+
+	// Restore errors on invalid delta metadata
+	invalid := snapshot
+	invalid.Metadata.DeltaChunks = 3
+	require.Error(t, manager.Restore(invalid))
+
+	invalid = snapshot
+	invalid.Metadata.BaseHeight = 5
+	require.Error(t, manager.Restore(invalid))
+
+	invalid = snapshot
+	invalid.Metadata.BaseFormat = 9
+	require.True(t, errors.Is(manager.Restore(invalid), types.ErrUnknownFormat))
+
+	// Restore errors on targets without delta snapshot support
+	err := snapshots.NewManager(store, &mockSnapshotter{}).Restore(snapshot)
+	require.True(t, errors.Is(err, types.ErrUnknownFormat))
+
+	// Feeding the chunks should pass the delta and base chunks separately
+	require.NoError(t, manager.Restore(snapshot))
+	for i, chunk := range chunks {
+		done, err := manager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		assert.Equal(t, i == len(chunks)-1, done)
+	}
+	assert.Equal(t, chunks[:1], target.deltaChunks)
+	assert.Equal(t, chunks[1:], target.baseChunks)
+
+	// Another restore should be possible after completion
+	require.NoError(t, manager.Restore(snapshot))
+}
@@ -143,7 +143,7 @@ func (s *Store) Load(height uint64, format uint32) (*types.Snapshot, <-chan io.R
 		for i := uint32(0); i < snapshot.Chunks; i++ {
 			pr, pw := io.Pipe()
 			ch <- pr
-			chunk, err := s.loadChunkFile(height, format, i)
+			chunk, err := s.loadChunkFile(locateChunk(snapshot, i))
 			if err != nil {
 				pw.CloseWithError(err)
 				return
@@ -168,18 +168,38 @@ func (s *Store) LoadChunk(height uint64, format uint32, chunk uint32) (io.ReadCl
 	path := s.pathChunk(height, format, chunk)
 	file, err := os.Open(path)
 	if os.IsNotExist(err) {
-		return nil, nil
+		// the chunks of the base snapshot of a delta snapshot are stored with the base snapshot
+		snapshot, err := s.Get(height, format)
+		if err != nil || snapshot == nil || snapshot.Metadata.BaseHeight == 0 || chunk >= snapshot.Chunks {
+			return nil, err
+		}
+		height, format, chunk = locateChunk(snapshot, chunk)
+		file, err = os.Open(s.pathChunk(height, format, chunk))
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return file, err
 	}
 	return file, err
 }
 
+// locateChunk returns the height, format and index under which a chunk of the given snapshot is
+// stored, resolving the chunks of the base snapshot of delta snapshots.
+func locateChunk(snapshot *types.Snapshot, chunk uint32) (uint64, uint32, uint32) {
+	if snapshot.Metadata.BaseHeight > 0 && chunk >= snapshot.Metadata.DeltaChunks {
+		return snapshot.Metadata.BaseHeight, snapshot.Metadata.BaseFormat, chunk - snapshot.Metadata.DeltaChunks
+	}
+	return snapshot.Height, snapshot.Format, chunk
+}
+
 // loadChunkFile loads a chunk from disk, and errors if it does not exist.
 func (s *Store) loadChunkFile(height uint64, format uint32, chunk uint32) (io.ReadCloser, error) {
 	path := s.pathChunk(height, format, chunk)
 	return os.Open(path)
 }
 
-// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained.
+// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained,
+// along with the base snapshots of retained delta snapshots.
 func (s *Store) Prune(retain uint32) (uint64, error) {
 	iter, err := s.db.ReverseIterator(encodeKey(0, 0), encodeKey(uint64(math.MaxUint64), math.MaxUint32))
 	if err != nil {
@@ -190,13 +210,23 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 	pruned := uint64(0)
 	prunedHeights := make(map[uint64]bool)
 	skip := make(map[uint64]bool)
+	bases := make(map[uint64]bool)
 	for ; iter.Valid(); iter.Next() {
 		height, format, err := decodeKey(iter.Key())
 		if err != nil {
 			return 0, sdkerrors.Wrap(err, "failed to prune snapshots")
 		}
-		if skip[height] || uint32(len(skip)) < retain {
-			skip[height] = true
+		if skip[height] || bases[height] || uint32(len(skip)) < retain {
+			if !bases[height] {
+				skip[height] = true
+			}
+			snapshot := &types.Snapshot{}
+			if err := proto.Unmarshal(iter.Value(), snapshot); err != nil {
+				return 0, sdkerrors.Wrap(err, "failed to decode snapshot info")
+			}
+			if snapshot.Metadata.BaseHeight > 0 {
+				bases[snapshot.Metadata.BaseHeight] = true
+			}
 			continue
 		}
 		err = s.Delete(height, format)
@@ -222,6 +252,41 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 // Save saves a snapshot to disk, returning it.
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	snapshot, err := s.save(height, format, chunks)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, s.saveSnapshot(snapshot)
+}
+
+// SaveDelta saves a delta snapshot to disk, returning it. The given chunks are the delta chunks,
+// which are followed by the chunks of the given base snapshot in the returned snapshot.
+func (s *Store) SaveDelta(
+	height uint64, format uint32, base *types.Snapshot, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	if base == nil || base.Height >= height || base.Metadata.BaseHeight > 0 {
+		DrainChunks(chunks)
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "invalid base snapshot for delta snapshot at height %v", height)
+	}
+	snapshot, err := s.save(height, format, chunks)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.Metadata.BaseHeight = base.Height
+	snapshot.Metadata.BaseFormat = base.Format
+	snapshot.Metadata.DeltaChunks = snapshot.Chunks
+	snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, base.Metadata.ChunkHashes...)
+	snapshot.Chunks += base.Chunks
+	hash := sha256.Sum256(append(snapshot.Hash, base.Hash...))
+	snapshot.Hash = hash[:]
+	return snapshot, s.saveSnapshot(snapshot)
+}
+
+// save saves snapshot chunks to disk, returning the snapshot without saving its metadata.
+func (s *Store) save(
+	height uint64, format uint32, chunks <-chan io.ReadCloser,
 ) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
 	if height == 0 {
@@ -290,7 +355,7 @@ func (s *Store) Save(
 	}
 	snapshot.Chunks = index
 	snapshot.Hash = snapshotHasher.Sum(nil)
-	return snapshot, s.saveSnapshot(snapshot)
+	return snapshot, nil
 }
 
 // saveSnapshot saves snapshot metadata to the database.
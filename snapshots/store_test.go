@@ -267,6 +267,71 @@ func TestStore_Prune(t *testing.T) {
 	assert.Empty(t, snapshots)
 }
 
+func TestStore_SaveDelta(t *testing.T) {
+	store := setupStore(t)
+	base, err := store.Get(2, 1)
+	require.NoError(t, err)
+
+	// Saving a delta snapshot on a later or missing base snapshot should error
+	_, err = store.SaveDelta(2, 2, base, makeChunks([][]byte{{4, 2, 0}}))
+	require.Error(t, err)
+	_, err = store.SaveDelta(4, 2, nil, makeChunks([][]byte{{4, 2, 0}}))
+	require.Error(t, err)
+
+	// Saving a delta snapshot should append the chunks of the base snapshot
+	snapshot, err := store.SaveDelta(4, 2, base, makeChunks([][]byte{{4, 2, 0}}))
+	require.NoError(t, err)
+	deltaHash := hash([][]byte{{4, 2, 0}})
+	assert.Equal(t, &types.Snapshot{
+		Height: 4,
+		Format: 2,
+		Chunks: 3,
+		Hash:   hash([][]byte{append(deltaHash, base.Hash...)}),
+		Metadata: types.Metadata{
+			ChunkHashes: checksums([][]byte{{4, 2, 0}, {2, 1, 0}, {2, 1, 1}}),
+			BaseHeight:  2,
+			BaseFormat:  1,
+			DeltaChunks: 1,
+		},
+	}, snapshot)
+	loaded, err := store.Get(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, loaded)
+
+	// Saving a delta snapshot on a delta snapshot should error
+	_, err = store.SaveDelta(5, 2, snapshot, makeChunks([][]byte{{5, 2, 0}}))
+	require.Error(t, err)
+
+	// Loading the chunks should return the chunks of the base snapshot after the delta chunks
+	_, chunks, err := store.Load(4, 2)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{{4, 2, 0}, {2, 1, 0}, {2, 1, 1}}, readChunks(chunks))
+
+	chunk, err := store.LoadChunk(4, 2, 2)
+	require.NoError(t, err)
+	require.NotNil(t, chunk)
+	body, err := ioutil.ReadAll(chunk)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{2, 1, 1}, body)
+	require.NoError(t, chunk.Close())
+
+	chunk, err = store.LoadChunk(4, 2, 3)
+	require.NoError(t, err)
+	assert.Nil(t, chunk)
+
+	// Pruning should retain the base snapshot of retained delta snapshots
+	pruned, err := store.Prune(1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, pruned)
+
+	snapshots, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 3)
+	assert.Equal(t, snapshot, snapshots[0])
+	assert.EqualValues(t, 2, snapshots[1].Height)
+	assert.EqualValues(t, 2, snapshots[2].Height)
+}
+
 func TestStore_Save(t *testing.T) {
 	store := setupStore(t)
 	// Saving a snapshot should work
@@ -4,3 +4,9 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 1
+
+// CurrentDeltaFormat is the currently used format for delta snapshots, containing the changes
+// between the state at the height of a base snapshot in CurrentFormat and a later height. Like
+// CurrentFormat, it must be bumped when the binary delta snapshot output changes, and the two
+// formats must never be equal.
+const CurrentDeltaFormat uint32 = 2
@@ -103,6 +103,12 @@ func (m *Snapshot) GetMetadata() Metadata {
 // Metadata contains SDK-specific snapshot metadata.
 type Metadata struct {
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// base_height and base_format identify the base snapshot of a delta
+	// snapshot. The chunks of a delta snapshot are the delta_chunks delta chunks
+	// followed by the chunks of the base snapshot.
+	BaseHeight  uint64 `protobuf:"varint,2,opt,name=base_height,json=baseHeight,proto3" json:"base_height,omitempty"`
+	BaseFormat  uint32 `protobuf:"varint,3,opt,name=base_format,json=baseFormat,proto3" json:"base_format,omitempty"`
+	DeltaChunks uint32 `protobuf:"varint,4,opt,name=delta_chunks,json=deltaChunks,proto3" json:"delta_chunks,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -145,6 +151,27 @@ func (m *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (m *Metadata) GetBaseHeight() uint64 {
+	if m != nil {
+		return m.BaseHeight
+	}
+	return 0
+}
+
+func (m *Metadata) GetBaseFormat() uint32 {
+	if m != nil {
+		return m.BaseFormat
+	}
+	return 0
+}
+
+func (m *Metadata) GetDeltaChunks() uint32 {
+	if m != nil {
+		return m.DeltaChunks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Snapshot)(nil), "cosmos.base.snapshots.v1beta1.Snapshot")
 	proto.RegisterType((*Metadata)(nil), "cosmos.base.snapshots.v1beta1.Metadata")
@@ -155,25 +182,28 @@ func init() {
 }
 
 var fileDescriptor_dd7a3c9b0a19e1ee = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x86, 0x63, 0x1a, 0xaa, 0xc8, 0x0d, 0x8b, 0x85, 0x90, 0x85, 0x84, 0x09, 0x5d, 0xc8, 0xd0,
-	0x3a, 0x2a, 0xdc, 0xa0, 0x03, 0x82, 0x81, 0x25, 0x6c, 0x2c, 0xc8, 0x49, 0x4d, 0x5c, 0x55, 0xa9,
-	0xa3, 0x3e, 0x17, 0x89, 0x5b, 0x70, 0x15, 0x6e, 0xd1, 0xb1, 0x23, 0x13, 0x42, 0xc9, 0x45, 0x50,
-	0x1c, 0x13, 0x31, 0x75, 0xca, 0xfb, 0xbf, 0x7c, 0x4f, 0xcf, 0xfa, 0xf1, 0x24, 0xd7, 0x50, 0x6a,
-	0x48, 0x32, 0x01, 0x32, 0x81, 0xb5, 0xa8, 0x40, 0x69, 0x03, 0xc9, 0xdb, 0x2c, 0x93, 0x46, 0xcc,
-	0x7a, 0xc2, 0xab, 0x8d, 0x36, 0x9a, 0x5c, 0x74, 0x36, 0x6f, 0x6d, 0xde, 0xdb, 0xdc, 0xd9, 0xe7,
-	0xa7, 0x85, 0x2e, 0xb4, 0x35, 0x93, 0x76, 0xea, 0x96, 0xc6, 0x9f, 0x08, 0x07, 0x4f, 0xce, 0x25,
-	0x67, 0x78, 0xa8, 0xe4, 0xb2, 0x50, 0x86, 0xa2, 0x08, 0xc5, 0x7e, 0xea, 0x52, 0xcb, 0x5f, 0xf5,
-	0xa6, 0x14, 0x86, 0x1e, 0x45, 0x28, 0x3e, 0x49, 0x5d, 0x6a, 0x79, 0xae, 0xb6, 0xeb, 0x15, 0xd0,
-	0x41, 0xc7, 0xbb, 0x44, 0x08, 0xf6, 0x95, 0x00, 0x45, 0xfd, 0x08, 0xc5, 0x61, 0x6a, 0x67, 0xf2,
-	0x80, 0x83, 0x52, 0x1a, 0xb1, 0x10, 0x46, 0xd0, 0xe3, 0x08, 0xc5, 0xa3, 0x9b, 0x6b, 0x7e, 0xf0,
-	0xc1, 0xfc, 0xd1, 0xe9, 0x73, 0x7f, 0xf7, 0x7d, 0xe9, 0xa5, 0xfd, 0xfa, 0x78, 0x8a, 0x83, 0xbf,
-	0x7f, 0xe4, 0x0a, 0x87, 0xf6, 0xe8, 0x4b, 0x7b, 0x44, 0x02, 0x45, 0xd1, 0x20, 0x0e, 0xd3, 0x91,
-	0x65, 0xf7, 0x16, 0xcd, 0xef, 0x76, 0x35, 0x43, 0xfb, 0x9a, 0xa1, 0x9f, 0x9a, 0xa1, 0x8f, 0x86,
-	0x79, 0xfb, 0x86, 0x79, 0x5f, 0x0d, 0xf3, 0x9e, 0x27, 0xc5, 0xd2, 0xa8, 0x6d, 0xc6, 0x73, 0x5d,
-	0x26, 0xae, 0xea, 0xee, 0x33, 0x85, 0xc5, 0xea, 0x5f, 0xe1, 0xe6, 0xbd, 0x92, 0x90, 0x0d, 0x6d,
-	0x63, 0xb7, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb8, 0x89, 0x2e, 0x8f, 0x96, 0x01, 0x00, 0x00,
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x4f, 0x4e, 0xc2, 0x40,
+	0x14, 0x87, 0x3b, 0x50, 0x09, 0x99, 0xd6, 0xcd, 0xc4, 0x98, 0x89, 0x89, 0x43, 0x61, 0x63, 0x17,
+	0x38, 0x0d, 0x7a, 0x03, 0x4c, 0x08, 0x2e, 0xdc, 0xd4, 0x9d, 0x1b, 0x32, 0x85, 0xb1, 0x43, 0xb0,
+	0x0c, 0x61, 0x06, 0x13, 0x6f, 0x61, 0xbc, 0x89, 0xb7, 0x60, 0xc9, 0xd2, 0x95, 0x31, 0x70, 0x11,
+	0x33, 0x7f, 0x68, 0x5c, 0xb9, 0xea, 0x7b, 0x5f, 0xbf, 0x97, 0x37, 0xbf, 0x3c, 0xd8, 0x9f, 0x4a,
+	0x55, 0x49, 0x95, 0x15, 0x4c, 0xf1, 0x4c, 0x2d, 0xd9, 0x4a, 0x09, 0xa9, 0x55, 0xf6, 0x3a, 0x28,
+	0xb8, 0x66, 0x83, 0x9a, 0xd0, 0xd5, 0x5a, 0x6a, 0x89, 0x2e, 0x9d, 0x4d, 0x8d, 0x4d, 0x6b, 0x9b,
+	0x7a, 0xfb, 0xe2, 0xac, 0x94, 0xa5, 0xb4, 0x66, 0x66, 0x2a, 0x37, 0xd4, 0xfb, 0x04, 0xb0, 0xfd,
+	0xe8, 0x5d, 0x74, 0x0e, 0x5b, 0x82, 0xcf, 0x4b, 0xa1, 0x31, 0x48, 0x40, 0x1a, 0xe6, 0xbe, 0x33,
+	0xfc, 0x59, 0xae, 0x2b, 0xa6, 0x71, 0x23, 0x01, 0xe9, 0x69, 0xee, 0x3b, 0xc3, 0xa7, 0x62, 0xb3,
+	0x5c, 0x28, 0xdc, 0x74, 0xdc, 0x75, 0x08, 0xc1, 0x50, 0x30, 0x25, 0x70, 0x98, 0x80, 0x34, 0xce,
+	0x6d, 0x8d, 0xee, 0x61, 0xbb, 0xe2, 0x9a, 0xcd, 0x98, 0x66, 0xf8, 0x24, 0x01, 0x69, 0x74, 0x73,
+	0x45, 0xff, 0x7d, 0x30, 0x7d, 0xf0, 0xfa, 0x30, 0xdc, 0x7e, 0x77, 0x82, 0xbc, 0x1e, 0xef, 0x7d,
+	0x00, 0xd8, 0x3e, 0xfe, 0x44, 0x5d, 0x18, 0xdb, 0xad, 0x13, 0xb3, 0x85, 0x2b, 0x0c, 0x92, 0x66,
+	0x1a, 0xe7, 0x91, 0x65, 0x63, 0x8b, 0x50, 0x07, 0x46, 0x66, 0xc5, 0xc4, 0x67, 0x6b, 0xd8, 0x6c,
+	0xd0, 0xa0, 0xb1, 0xcb, 0x77, 0x14, 0x7c, 0x48, 0x17, 0xc6, 0x0a, 0x23, 0x17, 0xb4, 0x0b, 0xe3,
+	0x19, 0x7f, 0xd1, 0x6c, 0xe2, 0xe3, 0x86, 0xd6, 0x88, 0x2c, 0xbb, 0xb3, 0x68, 0x38, 0xda, 0xee,
+	0x09, 0xd8, 0xed, 0x09, 0xf8, 0xd9, 0x13, 0xf0, 0x7e, 0x20, 0xc1, 0xee, 0x40, 0x82, 0xaf, 0x03,
+	0x09, 0x9e, 0xfa, 0xe5, 0x5c, 0x8b, 0x4d, 0x41, 0xa7, 0xb2, 0xca, 0xfc, 0x41, 0xdd, 0xe7, 0x5a,
+	0xcd, 0x16, 0x7f, 0xce, 0xaa, 0xdf, 0x56, 0x5c, 0x15, 0x2d, 0x7b, 0x97, 0xdb, 0xdf, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0xe9, 0xca, 0xb4, 0x15, 0xfc, 0x01, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -251,6 +281,21 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DeltaChunks != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.DeltaChunks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BaseFormat != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.BaseFormat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BaseHeight != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.BaseHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.ChunkHashes) > 0 {
 		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ChunkHashes[iNdEx])
@@ -310,6 +355,15 @@ func (m *Metadata) Size() (n int) {
 			n += 1 + l + sovSnapshot(uint64(l))
 		}
 	}
+	if m.BaseHeight != 0 {
+		n += 1 + sovSnapshot(uint64(m.BaseHeight))
+	}
+	if m.BaseFormat != 0 {
+		n += 1 + sovSnapshot(uint64(m.BaseFormat))
+	}
+	if m.DeltaChunks != 0 {
+		n += 1 + sovSnapshot(uint64(m.DeltaChunks))
+	}
 	return n
 }
 
@@ -554,6 +608,63 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
 			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseHeight", wireType)
+			}
+			m.BaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFormat", wireType)
+			}
+			m.BaseFormat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFormat |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeltaChunks", wireType)
+			}
+			m.DeltaChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeltaChunks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
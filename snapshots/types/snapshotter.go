@@ -14,3 +14,21 @@ type Snapshotter interface {
 	// restorer is ready to accept chunks.
 	Restore(height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{}) error
 }
+
+// DeltaSnapshotter is a Snapshotter that can also create and restore delta snapshots, containing
+// the changes of the state between the height of a base snapshot and a later height.
+type DeltaSnapshotter interface {
+	Snapshotter
+
+	// SnapshotDelta creates a delta snapshot of the changes between baseHeight and height,
+	// returning a channel of delta chunk readers.
+	SnapshotDelta(baseHeight, height uint64, format uint32) (<-chan io.ReadCloser, error)
+
+	// RestoreDelta restores the state at height from the delta chunk readers followed by the
+	// chunk readers of the base snapshot at baseHeight. The delta chunks channel is closed
+	// before base chunks are given. If the ready channel is non-nil, it returns a ready signal
+	// (by being closed) once the restorer is ready to accept chunks.
+	RestoreDelta(
+		baseHeight, height uint64, format uint32, deltaChunks, baseChunks <-chan io.ReadCloser, ready chan<- struct{},
+	) error
+}
@@ -34,7 +34,10 @@ type Store struct {
 	parent        types.KVStore
 }
 
-var _ types.CacheKVStore = (*Store)(nil)
+var (
+	_ types.CacheKVStore = (*Store)(nil)
+	_ types.BatchWriter  = (*Store)(nil)
+)
 
 // NewStore creates a new Store object
 func NewStore(parent types.KVStore) *Store {
@@ -96,6 +99,23 @@ func (store *Store) Delete(key []byte) {
 	store.setCacheValue(key, nil, true, true)
 }
 
+// WriteBatch implements types.BatchWriter, caching the writes and deletes of
+// the batch under a single lock of the store.
+func (store *Store) WriteBatch(ops []types.BatchOp) {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+
+	for _, op := range ops {
+		types.AssertValidKey(op.Key)
+		if op.Delete {
+			store.setCacheValue(op.Key, nil, true, true)
+		} else {
+			types.AssertValidValue(op.Value)
+			store.setCacheValue(op.Key, op.Value, false, true)
+		}
+	}
+}
+
 // Implements Cachetypes.KVStore.
 func (store *Store) Write() {
 	store.mtx.Lock()
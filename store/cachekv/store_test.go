@@ -64,6 +64,27 @@ func TestCacheKVStore(t *testing.T) {
 	require.Empty(t, mem.Get(keyFmt(1)), "Expected `key1` to be empty")
 }
 
+func TestCacheKVStoreWriteBatch(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	mem.Set(keyFmt(1), valFmt(1))
+	st := cachekv.NewStore(mem)
+
+	batch := types.NewKVStoreBatch(st)
+	batch.Set(keyFmt(2), valFmt(2))
+	batch.Delete(keyFmt(1))
+	batch.Write()
+
+	// the batch is cached until the store is written
+	require.Empty(t, st.Get(keyFmt(1)))
+	require.Equal(t, valFmt(2), st.Get(keyFmt(2)))
+	require.Equal(t, valFmt(1), mem.Get(keyFmt(1)))
+	require.Empty(t, mem.Get(keyFmt(2)))
+
+	st.Write()
+	require.Empty(t, mem.Get(keyFmt(1)))
+	require.Equal(t, valFmt(2), mem.Get(keyFmt(2)))
+}
+
 func TestCacheKVStoreNoNilSet(t *testing.T) {
 	mem := dbadapter.Store{DB: dbm.NewMemDB()}
 	st := cachekv.NewStore(mem)
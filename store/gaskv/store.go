@@ -8,7 +8,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
-var _ types.KVStore = &Store{}
+var (
+	_ types.KVStore     = &Store{}
+	_ types.BatchWriter = &Store{}
+)
 
 // Store applies gas tracking to an underlying KVStore. It implements the
 // KVStore interface.
@@ -66,9 +69,60 @@ func (gs *Store) Delete(key []byte) {
 	defer telemetry.MeasureSince(time.Now(), "store", "gaskv", "delete")
 	// charge gas to prevent certain attack vectors even though space is being freed
 	gs.gasMeter.ConsumeGas(gs.gasConfig.DeleteCost, types.GasDeleteDesc)
+	if gs.gasConfig.DeleteRefundPerByte > 0 {
+		// credit a refund for the freed space, which is only refunded once the
+		// transaction succeeds
+		if meter, ok := gs.gasMeter.(types.RefundableGasMeter); ok {
+			meter.AddGasRefund(gs.gasConfig.DeleteRefundPerByte * types.Gas(len(gs.parent.Get(key))))
+		}
+	}
 	gs.parent.Delete(key)
 }
 
+// WriteBatch implements the BatchWriter interface. The flat write and delete
+// costs are charged once per batch containing writes, respectively deletes,
+// while the per byte write cost and delete refund apply to each op as they do
+// for single writes and deletes.
+func (gs *Store) WriteBatch(ops []types.BatchOp) {
+	defer telemetry.MeasureSince(time.Now(), "store", "gaskv", "write_batch")
+
+	var (
+		writes, deletes bool
+		writtenBytes    types.Gas
+		deletedBytes    types.Gas
+	)
+	for _, op := range ops {
+		types.AssertValidKey(op.Key)
+		if op.Delete {
+			deletes = true
+			if gs.gasConfig.DeleteRefundPerByte > 0 {
+				deletedBytes += types.Gas(len(gs.parent.Get(op.Key)))
+			}
+			continue
+		}
+
+		types.AssertValidValue(op.Value)
+		writes = true
+		writtenBytes += types.Gas(len(op.Value))
+	}
+
+	if writes {
+		gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostFlat, types.GasWriteCostFlatDesc)
+		// TODO overflow-safe math?
+		gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostPerByte*writtenBytes, types.GasWritePerByteDesc)
+	}
+	if deletes {
+		gs.gasMeter.ConsumeGas(gs.gasConfig.DeleteCost, types.GasDeleteDesc)
+		if deletedBytes > 0 {
+			if meter, ok := gs.gasMeter.(types.RefundableGasMeter); ok {
+				meter.AddGasRefund(gs.gasConfig.DeleteRefundPerByte * deletedBytes)
+			}
+		}
+	}
+
+	types.WriteBatch(gs.parent, ops)
+}
+
 // Iterator implements the KVStore interface. It returns an iterator which
 // incurs a flat gas cost for seeking to the first key/value pair and a variable
 // gas cost based on the current value's length if the iterator is valid.
@@ -39,6 +39,51 @@ func TestGasKVStoreBasic(t *testing.T) {
 	require.Equal(t, meter.GasConsumed(), types.Gas(6429))
 }
 
+func TestGasKVStoreDeleteRefund(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	meter := types.NewGasMeter(10000)
+	config := types.KVGasConfig()
+	config.DeleteRefundPerByte = 10
+	st := gaskv.NewStore(mem, meter, config)
+
+	st.Set(keyFmt(1), valFmt(1))
+	consumed := meter.GasConsumed()
+	st.Delete(keyFmt(1))
+	st.Delete(keyFmt(2))
+	require.Equal(t, consumed+2*config.DeleteCost, meter.GasConsumed())
+	require.Equal(t, types.Gas(10*len(valFmt(1))), meter.(types.RefundableGasMeter).GasRefund())
+}
+
+func TestGasKVStoreWriteBatch(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	meter := types.NewGasMeter(100000)
+	config := types.KVGasConfig()
+	config.DeleteRefundPerByte = 10
+	st := gaskv.NewStore(mem, meter, config)
+	st.Set(keyFmt(1), valFmt(1))
+	consumed := meter.GasConsumed()
+
+	// the flat write and delete costs are charged once per batch
+	batch := types.NewKVStoreBatch(st)
+	batch.Set(keyFmt(2), valFmt(2))
+	batch.Set(keyFmt(3), valFmt(3))
+	batch.Delete(keyFmt(1))
+	batch.Delete(keyFmt(4))
+	batch.Write()
+
+	writeCost := config.WriteCostFlat + config.WriteCostPerByte*types.Gas(len(valFmt(2))+len(valFmt(3)))
+	require.Equal(t, consumed+writeCost+config.DeleteCost, meter.GasConsumed())
+	require.Equal(t, types.Gas(10*len(valFmt(1))), meter.(types.RefundableGasMeter).GasRefund())
+	require.Empty(t, mem.Get(keyFmt(1)))
+	require.Equal(t, valFmt(2), mem.Get(keyFmt(2)))
+	require.Equal(t, valFmt(3), mem.Get(keyFmt(3)))
+
+	// an empty batch costs nothing
+	consumed = meter.GasConsumed()
+	batch.Write()
+	require.Equal(t, consumed, meter.GasConsumed())
+}
+
 func TestGasKVStoreIterator(t *testing.T) {
 	mem := dbadapter.Store{DB: dbm.NewMemDB()}
 	meter := types.NewGasMeter(10000)
@@ -11,7 +11,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
-var _ types.KVStore = Store{}
+var (
+	_ types.KVStore     = Store{}
+	_ types.BatchWriter = Store{}
+)
 
 // Store is similar with tendermint/tendermint/libs/db/prefix_db
 // both gives access only to the limited subset of the store
@@ -86,6 +89,17 @@ func (s Store) Delete(key []byte) {
 	s.parent.Delete(s.key(key))
 }
 
+// WriteBatch implements types.BatchWriter, prefixing the keys of the batch
+// before writing it to the parent store.
+func (s Store) WriteBatch(ops []types.BatchOp) {
+	prefixed := make([]types.BatchOp, len(ops))
+	for i, op := range ops {
+		prefixed[i] = types.BatchOp{Key: s.key(op.Key), Value: op.Value, Delete: op.Delete}
+	}
+
+	types.WriteBatch(s.parent, prefixed)
+}
+
 // Implements KVStore
 // Check https://github.com/tendermint/tendermint/blob/master/libs/db/prefix_db.go#L106
 func (s Store) Iterator(start, end []byte) types.Iterator {
@@ -104,6 +104,26 @@ func TestPrefixKVStoreNoNilSet(t *testing.T) {
 	require.Panics(t, func() { gasStore.Set([]byte("key"), nil) }, "setting a nil value should panic")
 }
 
+func TestPrefixStoreWriteBatch(t *testing.T) {
+	meter := types.NewGasMeter(100000000)
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	gasStore := gaskv.NewStore(mem, meter, types.KVGasConfig())
+	prefixStore := NewStore(gasStore, bz("prefix"))
+	prefixStore.Set(bz("key1"), bz("value1"))
+
+	batch := types.NewKVStoreBatch(prefixStore)
+	batch.Set(bz("key2"), bz("value2"))
+	batch.Delete(bz("key1"))
+	consumed := meter.GasConsumed()
+	batch.Write()
+
+	// the keys are prefixed and the batch is written to the gas store at once
+	require.Empty(t, mem.Get(bz("prefixkey1")))
+	require.Equal(t, bz("value2"), mem.Get(bz("prefixkey2")))
+	config := types.KVGasConfig()
+	require.Equal(t, consumed+config.WriteCostFlat+config.WriteCostPerByte*types.Gas(len("value2"))+config.DeleteCost, meter.GasConsumed())
+}
+
 func TestPrefixStoreIterate(t *testing.T) {
 	db := dbm.NewMemDB()
 	baseStore := dbadapter.Store{DB: db}
@@ -0,0 +1,337 @@
+package rootmulti
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"math"
+
+	iavltree "github.com/cosmos/iavl"
+	protoio "github.com/gogo/protobuf/io"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ snapshottypes.DeltaSnapshotter = (*Store)(nil)
+
+// SnapshotDelta implements snapshottypes.DeltaSnapshotter. A delta snapshot has the same layout
+// as a snapshot, except that every maximal IAVL subtree that is unchanged since baseHeight, i.e.
+// whose root node version is at most baseHeight, is replaced by a SnapshotIAVLRefItem. Since IAVL
+// nodes are immutable, such subtrees are identical in the state at baseHeight and can be taken
+// from the base snapshot on restore.
+func (rs *Store) SnapshotDelta(baseHeight, height uint64, format uint32) (<-chan io.ReadCloser, error) {
+	if format != snapshottypes.CurrentDeltaFormat {
+		return nil, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	if baseHeight == 0 || baseHeight >= height {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic,
+			"invalid delta snapshot base height %v for height %v", baseHeight, height)
+	}
+	if height > uint64(rs.LastCommitID().Version) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
+	}
+
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return nil, err
+	}
+
+	return streamSnapshot(func(protoWriter protoio.WriteCloser) error {
+		for _, store := range stores {
+			// Unchanged nodes are kept pending until a changed node is exported. Since the
+			// version of a node is at least the version of its children, the children of an
+			// unchanged inner node are the last two pending references, which it replaces.
+			var pending []*types.SnapshotIAVLRefItem
+			flush := func() error {
+				for _, ref := range pending {
+					err := protoWriter.WriteMsg(&types.SnapshotItem{
+						Item: &types.SnapshotItem_IAVLRef{IAVLRef: ref},
+					})
+					if err != nil {
+						return err
+					}
+				}
+				pending = pending[:0]
+				return nil
+			}
+
+			err := writeSnapshotStore(protoWriter, store, int64(height), func(node *iavltree.ExportNode) error {
+				if node.Version > int64(baseHeight) {
+					if err := flush(); err != nil {
+						return err
+					}
+					return protoWriter.WriteMsg(&types.SnapshotItem{
+						Item: &types.SnapshotItem_IAVL{
+							IAVL: &types.SnapshotIAVLItem{
+								Key:     node.Key,
+								Value:   node.Value,
+								Height:  int32(node.Height),
+								Version: node.Version,
+							},
+						},
+					})
+				}
+
+				ref := &types.SnapshotIAVLRefItem{
+					Key:     node.Key,
+					Version: node.Version,
+					Height:  int32(node.Height),
+					MinKey:  node.Key,
+					MaxKey:  node.Key,
+				}
+				if node.Height > 0 {
+					n := len(pending)
+					if n < 2 {
+						return sdkerrors.Wrapf(sdkerrors.ErrLogic,
+							"unchanged IAVL node %X has changed children", node.Key)
+					}
+					ref.MinKey = pending[n-2].MinKey
+					ref.MaxKey = pending[n-1].MaxKey
+					pending = pending[:n-2]
+				}
+				pending = append(pending, ref)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}), nil
+}
+
+// RestoreDelta implements snapshottypes.DeltaSnapshotter. The delta snapshot is read into memory,
+// then the nodes of every store are imported in export order, taking the nodes of referenced
+// subtrees from the base snapshot stream.
+func (rs *Store) RestoreDelta(
+	baseHeight, height uint64, format uint32, deltaChunks, baseChunks <-chan io.ReadCloser, ready chan<- struct{},
+) error {
+	if format != snapshottypes.CurrentDeltaFormat {
+		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	if baseHeight == 0 || baseHeight >= height {
+		return sdkerrors.Wrapf(snapshottypes.ErrInvalidMetadata,
+			"invalid delta snapshot base height %v for height %v", baseHeight, height)
+	}
+	if height > uint64(math.MaxInt64) {
+		return sdkerrors.Wrapf(snapshottypes.ErrInvalidMetadata,
+			"snapshot height %v cannot exceed %v", height, int64(math.MaxInt64))
+	}
+
+	// Signal readiness. Must be done before the readers below are set up, since the zlib
+	// reader reads from the stream on initialization, potentially causing deadlocks.
+	if ready != nil {
+		close(ready)
+	}
+
+	delta, err := readDeltaSnapshot(deltaChunks)
+	if err != nil {
+		return err
+	}
+
+	base, err := newSnapshotItemReader(baseChunks)
+	if err != nil {
+		return err
+	}
+	defer base.Close()
+
+	for _, deltaStore := range delta {
+		store, ok := rs.getStoreByName(deltaStore.name).(*iavl.Store)
+		if !ok || store == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", deltaStore.name)
+		}
+		importer, err := store.Import(int64(height))
+		if err != nil {
+			return sdkerrors.Wrap(err, "import failed")
+		}
+		defer importer.Close()
+
+		seeked := false
+		for _, item := range deltaStore.items {
+			switch item := item.Item.(type) {
+			case *types.SnapshotItem_IAVL:
+				node, err := exportNodeFromItem(item.IAVL)
+				if err != nil {
+					return err
+				}
+				if err := importer.Add(node); err != nil {
+					return sdkerrors.Wrap(err, "IAVL node import failed")
+				}
+
+			case *types.SnapshotItem_IAVLRef:
+				if !seeked {
+					if err := base.seekStore(deltaStore.name); err != nil {
+						return err
+					}
+					seeked = true
+				}
+				if err := base.importSubtree(item.IAVLRef, importer); err != nil {
+					return err
+				}
+
+			default:
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown delta snapshot item %T", item)
+			}
+		}
+
+		if err := importer.Commit(); err != nil {
+			return sdkerrors.Wrap(err, "IAVL commit failed")
+		}
+		importer.Close()
+	}
+
+	// Consume the remaining base snapshot, so that all of its chunks are verified and read.
+	for {
+		if _, err := base.next(); err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+
+	flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{})
+	return rs.LoadLatestVersion()
+}
+
+// deltaSnapshotStore contains the items of a store in a delta snapshot.
+type deltaSnapshotStore struct {
+	name  string
+	items []*types.SnapshotItem
+}
+
+// readDeltaSnapshot reads all the items of a delta snapshot, by store.
+func readDeltaSnapshot(chunks <-chan io.ReadCloser) ([]deltaSnapshotStore, error) {
+	reader, err := newSnapshotItemReader(chunks)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	var stores []deltaSnapshotStore
+	for {
+		item, err := reader.next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		if store := item.GetStore(); store != nil {
+			if len(stores) > 0 && store.Name <= stores[len(stores)-1].name {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "delta snapshot store %q is out of order", store.Name)
+			}
+			stores = append(stores, deltaSnapshotStore{name: store.Name})
+			continue
+		}
+		if len(stores) == 0 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL node item before store item")
+		}
+		stores[len(stores)-1].items = append(stores[len(stores)-1].items, item)
+	}
+	return stores, nil
+}
+
+// snapshotItemReader reads the items of a snapshot stream.
+type snapshotItemReader struct {
+	chunkReader *snapshots.ChunkReader
+	zReader     io.ReadCloser
+	protoReader protoio.ReadCloser
+}
+
+// newSnapshotItemReader sets up a restore stream pipeline:
+// chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> SnapshotItem
+func newSnapshotItemReader(chunks <-chan io.ReadCloser) (*snapshotItemReader, error) {
+	chunkReader := snapshots.NewChunkReader(chunks)
+	zReader, err := zlib.NewReader(chunkReader)
+	if err != nil {
+		chunkReader.Close()
+		return nil, sdkerrors.Wrap(err, "zlib failure")
+	}
+	return &snapshotItemReader{
+		chunkReader: chunkReader,
+		zReader:     zReader,
+		protoReader: protoio.NewDelimitedReader(zReader, snapshotMaxItemSize),
+	}, nil
+}
+
+// next reads the next item, returning io.EOF at the end of the stream.
+func (r *snapshotItemReader) next() (*types.SnapshotItem, error) {
+	item := &types.SnapshotItem{}
+	err := r.protoReader.ReadMsg(item)
+	if err == io.EOF {
+		return nil, err
+	} else if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid protobuf message")
+	}
+	return item, nil
+}
+
+// seekStore skips items until after the SnapshotStore item of the given store.
+func (r *snapshotItemReader) seekStore(name string) error {
+	for {
+		item, err := r.next()
+		if err == io.EOF {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "store %q not found in base snapshot", name)
+		} else if err != nil {
+			return err
+		}
+		store := item.GetStore()
+		if store == nil {
+			continue
+		}
+		if store.Name == name {
+			return nil
+		}
+		if store.Name > name {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "store %q not found in base snapshot", name)
+		}
+	}
+}
+
+// importSubtree imports the nodes of the referenced subtree from the current store of the base
+// snapshot. The nodes of the subtree are the nodes preceding its root in export order whose keys
+// are within the key range of the subtree, while preceding nodes outside of the subtree have
+// lower keys.
+func (r *snapshotItemReader) importSubtree(ref *types.SnapshotIAVLRefItem, importer *iavltree.Importer) error {
+	for {
+		item, err := r.next()
+		if err == io.EOF {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "IAVL subtree %X not found in base snapshot", ref.Key)
+		} else if err != nil {
+			return err
+		}
+		iavlItem := item.GetIAVL()
+		if iavlItem == nil || bytes.Compare(iavlItem.Key, ref.MaxKey) > 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "IAVL subtree %X not found in base snapshot", ref.Key)
+		}
+		if bytes.Compare(iavlItem.Key, ref.MinKey) < 0 {
+			continue
+		}
+
+		node, err := exportNodeFromItem(iavlItem)
+		if err != nil {
+			return err
+		}
+		if err := importer.Add(node); err != nil {
+			return sdkerrors.Wrap(err, "IAVL node import failed")
+		}
+
+		if bytes.Equal(iavlItem.Key, ref.Key) && iavlItem.Version == ref.Version && iavlItem.Height == ref.Height {
+			return nil
+		}
+	}
+}
+
+// Close closes the stream pipeline.
+func (r *snapshotItemReader) Close() error {
+	r.protoReader.Close()
+	r.zReader.Close()
+	return r.chunkReader.Close()
+}
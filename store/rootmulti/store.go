@@ -9,6 +9,7 @@ import (
 	"math"
 	"sort"
 	"strings"
+	"sync"
 
 	iavltree "github.com/cosmos/iavl"
 	protoio "github.com/gogo/protobuf/io"
@@ -52,6 +53,7 @@ type Store struct {
 	stores         map[types.StoreKey]types.CommitKVStore
 	keysByName     map[string]types.StoreKey
 	lazyLoading    bool
+	commitWorkers  int
 	pruneHeights   []int64
 	initialVersion int64
 	removalMap     map[types.StoreKey]bool
@@ -103,6 +105,13 @@ func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
 }
 
+// SetCommitWorkers sets the number of goroutines committing the sub-stores in
+// parallel on Commit. Values below 2 commit the sub-stores serially. The
+// resulting commit info is identical either way.
+func (rs *Store) SetCommitWorkers(workers int) {
+	rs.commitWorkers = workers
+}
+
 // GetStoreType implements Store.
 func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -369,7 +378,7 @@ func (rs *Store) Commit() types.CommitID {
 		previousHeight = rs.lastCommitInfo.GetVersion()
 		version = previousHeight + 1
 	}
-	rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap)
+	rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap, rs.commitWorkers)
 
 	// remove remnants of removed stores
 	for sk := range rs.removalMap {
@@ -647,11 +656,33 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
 	}
 
-	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return nil, err
 	}
+
+	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
+	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
+	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
+	// are demarcated by new SnapshotStore items.
+	return streamSnapshot(func(protoWriter protoio.WriteCloser) error {
+		for _, store := range stores {
+			if err := writeSnapshotStore(protoWriter, store, int64(height), nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	}), nil
+}
+
+// namedStore is an IAVL store along with its name, as included in snapshots.
+type namedStore struct {
+	*iavl.Store
+	name string
+}
+
+// snapshotStores returns the stores to snapshot sorted by name. Only IAVL stores are supported.
+func (rs *Store) snapshotStores() ([]namedStore, error) {
 	stores := []namedStore{}
 	for key := range rs.stores {
 		switch store := rs.GetCommitKVStore(key).(type) {
@@ -668,8 +699,12 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 	sort.Slice(stores, func(i, j int) bool {
 		return strings.Compare(stores[i].name, stores[j].name) == -1
 	})
+	return stores, nil
+}
 
-	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
+// streamSnapshot spawns a goroutine writing snapshot items with the given function and returns
+// a channel passing the io.ReadClosers of the resulting snapshot chunks.
+func streamSnapshot(write func(protoio.WriteCloser) error) <-chan io.ReadCloser {
 	ch := make(chan io.ReadCloser)
 	go func() {
 		// Set up a stream pipeline to serialize snapshot nodes:
@@ -699,57 +734,62 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 			}
 		}()
 
-		// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
-		// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
-		// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
-		// are demarcated by new SnapshotStore items.
-		for _, store := range stores {
-			exporter, err := store.Export(int64(height))
-			if err != nil {
-				chunkWriter.CloseWithError(err)
-				return
-			}
-			defer exporter.Close()
+		if err := write(protoWriter); err != nil {
+			chunkWriter.CloseWithError(err)
+		}
+	}()
+
+	return ch
+}
+
+// writeSnapshotStore writes a SnapshotStore item followed by the exported nodes of the store at
+// the given height. If writeNode is non-nil, it is called to write each exported node instead.
+func writeSnapshotStore(
+	protoWriter protoio.WriteCloser, store namedStore, height int64,
+	writeNode func(*iavltree.ExportNode) error,
+) error {
+	exporter, err := store.Export(height)
+	if err != nil {
+		return err
+	}
+	defer exporter.Close()
+	err = protoWriter.WriteMsg(&types.SnapshotItem{
+		Item: &types.SnapshotItem_Store{
+			Store: &types.SnapshotStoreItem{
+				Name: store.name,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		node, err := exporter.Next()
+		if err == iavltree.ExportDone {
+			break
+		} else if err != nil {
+			return err
+		}
+		if writeNode != nil {
+			err = writeNode(node)
+		} else {
 			err = protoWriter.WriteMsg(&types.SnapshotItem{
-				Item: &types.SnapshotItem_Store{
-					Store: &types.SnapshotStoreItem{
-						Name: store.name,
+				Item: &types.SnapshotItem_IAVL{
+					IAVL: &types.SnapshotIAVLItem{
+						Key:     node.Key,
+						Value:   node.Value,
+						Height:  int32(node.Height),
+						Version: node.Version,
 					},
 				},
 			})
-			if err != nil {
-				chunkWriter.CloseWithError(err)
-				return
-			}
-
-			for {
-				node, err := exporter.Next()
-				if err == iavltree.ExportDone {
-					break
-				} else if err != nil {
-					chunkWriter.CloseWithError(err)
-					return
-				}
-				err = protoWriter.WriteMsg(&types.SnapshotItem{
-					Item: &types.SnapshotItem_IAVL{
-						IAVL: &types.SnapshotIAVLItem{
-							Key:     node.Key,
-							Value:   node.Value,
-							Height:  int32(node.Height),
-							Version: node.Version,
-						},
-					},
-				})
-				if err != nil {
-					chunkWriter.CloseWithError(err)
-					return
-				}
-			}
-			exporter.Close()
 		}
-	}()
-
-	return ch, nil
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Restore implements snapshottypes.Snapshotter.
@@ -821,25 +861,11 @@ func (rs *Store) Restore(
 			if importer == nil {
 				return sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL node item before store item")
 			}
-			if item.IAVL.Height > math.MaxInt8 {
-				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
-			}
-			node := &iavltree.ExportNode{
-				Key:     item.IAVL.Key,
-				Value:   item.IAVL.Value,
-				Height:  int8(item.IAVL.Height),
-				Version: item.IAVL.Version,
-			}
-			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
-			}
-			if node.Height == 0 && node.Value == nil {
-				node.Value = []byte{}
+			node, err := exportNodeFromItem(item.IAVL)
+			if err != nil {
+				return err
 			}
-			err := importer.Add(node)
+			err = importer.Add(node)
 			if err != nil {
 				return sdkerrors.Wrap(err, "IAVL node import failed")
 			}
@@ -861,6 +887,29 @@ func (rs *Store) Restore(
 	return rs.LoadLatestVersion()
 }
 
+// exportNodeFromItem converts a snapshot IAVL item to an IAVL export node.
+func exportNodeFromItem(item *types.SnapshotIAVLItem) (*iavltree.ExportNode, error) {
+	if item.Height > math.MaxInt8 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
+			item.Height, math.MaxInt8)
+	}
+	node := &iavltree.ExportNode{
+		Key:     item.Key,
+		Value:   item.Value,
+		Height:  int8(item.Height),
+		Version: item.Version,
+	}
+	// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 && node.Value == nil {
+		node.Value = []byte{}
+	}
+	return node, nil
+}
+
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
 	var db dbm.DB
 
@@ -963,22 +1012,40 @@ func getLatestVersion(db dbm.DB) int64 {
 }
 
 // Commits each store and returns a new commitInfo.
-func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore, removalMap map[types.StoreKey]bool) *types.CommitInfo {
-	storeInfos := make([]types.StoreInfo, 0, len(storeMap))
-
-	for key, store := range storeMap {
-		commitID := store.Commit()
+//
+// If workers is above 1, the stores are committed in parallel by that many
+// goroutines, each hashing and persisting its stores independently. The store
+// infos are assembled in store name order once all stores are committed, so
+// the result does not depend on the order in which the stores complete.
+func commitStores(
+	version int64, storeMap map[types.StoreKey]types.CommitKVStore, removalMap map[types.StoreKey]bool, workers int,
+) *types.CommitInfo {
+	keys := make([]types.StoreKey, 0, len(storeMap))
+	for key := range storeMap {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name() < keys[j].Name()
+	})
 
-		if store.GetStoreType() == types.StoreTypeTransient {
-			continue
+	commitIDs := make([]types.CommitID, len(keys))
+	if workers < 2 {
+		for i, key := range keys {
+			commitIDs[i] = storeMap[key].Commit()
 		}
+	} else {
+		commitStoresParallel(keys, storeMap, commitIDs, workers)
+	}
 
-		if !removalMap[key] {
-			si := types.StoreInfo{}
-			si.Name = key.Name()
-			si.CommitId = commitID
-			storeInfos = append(storeInfos, si)
+	storeInfos := make([]types.StoreInfo, 0, len(keys))
+	for i, key := range keys {
+		if storeMap[key].GetStoreType() == types.StoreTypeTransient || removalMap[key] {
+			continue
 		}
+		storeInfos = append(storeInfos, types.StoreInfo{
+			Name:     key.Name(),
+			CommitId: commitIDs[i],
+		})
 	}
 
 	return &types.CommitInfo{
@@ -987,6 +1054,45 @@ func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore
 	}
 }
 
+// commitStoresParallel commits the given stores using the given number of
+// goroutines, writing the commit ID of each store to the same index of
+// commitIDs. A panic while committing a store is re-raised in the calling
+// goroutine once all workers are done.
+func commitStoresParallel(
+	keys []types.StoreKey, storeMap map[types.StoreKey]types.CommitKVStore, commitIDs []types.CommitID, workers int,
+) {
+	indexes := make(chan int, len(keys))
+	for i := range keys {
+		indexes <- i
+	}
+	close(indexes)
+
+	var (
+		wg        sync.WaitGroup
+		panicOnce sync.Once
+		panicVal  interface{}
+	)
+	for w := 0; w < workers && w < len(keys); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					panicOnce.Do(func() { panicVal = r })
+				}
+			}()
+			for i := range indexes {
+				commitIDs[i] = storeMap[keys[i]].Commit()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if panicVal != nil {
+		panic(panicVal)
+	}
+}
+
 // Gets commitInfo from disk.
 func getCommitInfo(db dbm.DB, ver int64) (*types.CommitInfo, error) {
 	cInfoKey := fmt.Sprintf(commitInfoKeyFmt, ver)
@@ -667,6 +667,118 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+func TestMultistoreSnapshotRestoreDelta(t *testing.T) {
+	source := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 3, 1000)
+	baseVersion := uint64(source.LastCommitID().Version)
+
+	// change, delete and add some keys in two of the stores, leaving the third unchanged
+	for i, name := range []string{"store0", "store1"} {
+		store := source.getStoreByName(name).(types.KVStore)
+		for k := uint64(0); k < 1000; k += 97 {
+			key := make([]byte, 8)
+			binary.BigEndian.PutUint64(key, k+uint64(i))
+			store.Set(key, []byte(fmt.Sprintf("changed %v", k)))
+		}
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, 500)
+		store.Delete(key)
+		store.Set([]byte("new key"), []byte("new value"))
+	}
+	source.Commit()
+	version := uint64(source.LastCommitID().Version)
+
+	_, err := source.SnapshotDelta(baseVersion, version, snapshottypes.CurrentFormat)
+	require.Error(t, err)
+	_, err = source.SnapshotDelta(version, version, snapshottypes.CurrentDeltaFormat)
+	require.Error(t, err)
+
+	// the delta snapshot only contains the changed parts of the trees
+	snapshotSize := func(chunks <-chan io.ReadCloser, err error) int {
+		require.NoError(t, err)
+		size := 0
+		for chunk := range chunks {
+			bz, err := ioutil.ReadAll(chunk)
+			require.NoError(t, err)
+			size += len(bz)
+		}
+		return size
+	}
+	require.Less(t, 10*snapshotSize(source.SnapshotDelta(baseVersion, version, snapshottypes.CurrentDeltaFormat)),
+		snapshotSize(source.Snapshot(version, snapshottypes.CurrentFormat)))
+
+	deltaChunks, err := source.SnapshotDelta(baseVersion, version, snapshottypes.CurrentDeltaFormat)
+	require.NoError(t, err)
+	baseChunks, err := source.Snapshot(baseVersion, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+
+	target := NewStore(dbm.NewMemDB())
+	for key := range source.stores {
+		target.MountStoreWithDB(types.NewKVStoreKey(key.Name()), types.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, target.LoadLatestVersion())
+	ready := make(chan struct{})
+	err = target.RestoreDelta(baseVersion, version, snapshottypes.CurrentDeltaFormat, deltaChunks, baseChunks, ready)
+	require.NoError(t, err)
+	assert.EqualValues(t, struct{}{}, <-ready)
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for key, sourceStore := range source.stores {
+		targetStore := target.getStoreByName(key.Name()).(types.CommitKVStore)
+		assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+	}
+}
+
+func TestMultistoreCommitParallel(t *testing.T) {
+	serialDB, parallelDB := dbm.NewMemDB(), dbm.NewMemDB()
+	serial := newMultiStoreWithMixedMounts(serialDB)
+	parallel := newMultiStoreWithMixedMounts(parallelDB)
+	parallel.SetCommitWorkers(3)
+
+	for version := int64(1); version <= 5; version++ {
+		for _, store := range []*Store{serial, parallel} {
+			for i, name := range []string{"iavl1", "iavl2", "iavl3", "trans1"} {
+				kv := store.getStoreByName(name).(types.KVStore)
+				for k := int64(0); k < version*10; k++ {
+					kv.Set([]byte(fmt.Sprintf("key%v", k)), []byte(fmt.Sprintf("value%v-%v-%v", version, i, k)))
+				}
+			}
+		}
+
+		serialID := serial.Commit()
+		parallelID := parallel.Commit()
+		require.Equal(t, serialID, parallelID)
+		require.Equal(t, version, parallelID.Version)
+		require.Equal(t, serial.lastCommitInfo, parallel.lastCommitInfo)
+
+		serialInfo, err := getCommitInfo(serialDB, version)
+		require.NoError(t, err)
+		parallelInfo, err := getCommitInfo(parallelDB, version)
+		require.NoError(t, err)
+		require.Equal(t, serialInfo, parallelInfo)
+	}
+}
+
+type panickingCommitStore struct {
+	types.CommitKVStore
+}
+
+func (panickingCommitStore) Commit() types.CommitID {
+	panic("commit failed")
+}
+
+func TestCommitStoresParallelPanic(t *testing.T) {
+	store := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	storeMap := map[types.StoreKey]types.CommitKVStore{}
+	for key, kv := range store.stores {
+		storeMap[key] = kv
+	}
+	storeMap[types.NewKVStoreKey("panic")] = panickingCommitStore{}
+
+	require.PanicsWithValue(t, "commit failed", func() {
+		commitStores(1, storeMap, nil, 3)
+	})
+}
+
 func TestSetInitialVersion(t *testing.T) {
 	db := dbm.NewMemDB()
 	multi := newMultiStoreWithMounts(db, types.PruneNothing)
@@ -0,0 +1,76 @@
+package types
+
+// BatchOp is a write, or a delete if Delete is set, staged in a KVStoreBatch.
+type BatchOp struct {
+	Key    []byte
+	Value  []byte
+	Delete bool
+}
+
+// BatchWriter is an optional extension of a KVStore applying a batch of
+// staged writes and deletes at once, e.g. to charge their gas per batch or to
+// lock the store once for the whole batch. The ops are applied in order.
+type BatchWriter interface {
+	WriteBatch(ops []BatchOp)
+}
+
+// WriteBatch applies a batch of writes and deletes to a KVStore with its
+// BatchWriter implementation if it has one, or one by one otherwise.
+func WriteBatch(store KVStore, ops []BatchOp) {
+	if bw, ok := store.(BatchWriter); ok {
+		bw.WriteBatch(ops)
+		return
+	}
+
+	for _, op := range ops {
+		if op.Delete {
+			store.Delete(op.Key)
+		} else {
+			store.Set(op.Key, op.Value)
+		}
+	}
+}
+
+// KVStoreBatch stages writes and deletes to a KVStore, which are only applied
+// when the batch is written, letting the hot loops of the keepers, e.g. the
+// pruning of the entries under a prefix, flush them once. The staged ops are
+// not visible to the reads of the store until the batch is written.
+type KVStoreBatch struct {
+	store KVStore
+	ops   []BatchOp
+}
+
+// NewKVStoreBatch returns a new empty batch of writes and deletes to the given
+// store.
+func NewKVStoreBatch(store KVStore) *KVStoreBatch {
+	return &KVStoreBatch{store: store}
+}
+
+// Set stages the write of a value. It panics if the key or value is invalid.
+func (b *KVStoreBatch) Set(key, value []byte) {
+	AssertValidKey(key)
+	AssertValidValue(value)
+	b.ops = append(b.ops, BatchOp{Key: key, Value: value})
+}
+
+// Delete stages the delete of a key. It panics if the key is invalid.
+func (b *KVStoreBatch) Delete(key []byte) {
+	AssertValidKey(key)
+	b.ops = append(b.ops, BatchOp{Key: key, Delete: true})
+}
+
+// Len returns the number of staged writes and deletes.
+func (b *KVStoreBatch) Len() int {
+	return len(b.ops)
+}
+
+// Write applies the staged writes and deletes to the store in the order they
+// were staged, and empties the batch so that it can be reused.
+func (b *KVStoreBatch) Write() {
+	if len(b.ops) == 0 {
+		return
+	}
+
+	WriteBatch(b.store, b.ops)
+	b.ops = nil
+}
@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestKVStoreBatch(t *testing.T) {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	store.Set([]byte("a"), []byte("1"))
+
+	batch := types.NewKVStoreBatch(store)
+	batch.Set([]byte("b"), []byte("2"))
+	batch.Delete([]byte("a"))
+	batch.Set([]byte("c"), []byte("3"))
+	batch.Delete([]byte("c"))
+	require.Equal(t, 4, batch.Len())
+
+	// the staged ops are not visible until the batch is written
+	require.Equal(t, []byte("1"), store.Get([]byte("a")))
+	require.Nil(t, store.Get([]byte("b")))
+
+	// and are applied in the order they were staged
+	batch.Write()
+	require.Equal(t, 0, batch.Len())
+	require.Nil(t, store.Get([]byte("a")))
+	require.Equal(t, []byte("2"), store.Get([]byte("b")))
+	require.Nil(t, store.Get([]byte("c")))
+
+	// the batch can be reused once written
+	batch.Set([]byte("a"), []byte("4"))
+	batch.Write()
+	require.Equal(t, []byte("4"), store.Get([]byte("a")))
+
+	require.Panics(t, func() { batch.Set(nil, []byte("value")) })
+	require.Panics(t, func() { batch.Set([]byte("key"), nil) })
+	require.Panics(t, func() { batch.Delete([]byte{}) })
+}
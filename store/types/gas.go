@@ -15,6 +15,7 @@ const (
 	GasReadCostFlatDesc     = "ReadFlat"
 	GasHasDesc              = "Has"
 	GasDeleteDesc           = "Delete"
+	GasDeleteRefundDesc     = "DeleteRefund"
 )
 
 // Gas measured by the SDK
@@ -50,9 +51,18 @@ type GasMeter interface {
 	String() string
 }
 
+// RefundableGasMeter is a GasMeter tracking gas refunds, which are credited
+// when state is deleted but only refunded once the transaction succeeds.
+type RefundableGasMeter interface {
+	GasMeter
+	AddGasRefund(amount Gas)
+	GasRefund() Gas
+}
+
 type basicGasMeter struct {
 	limit    Gas
 	consumed Gas
+	refund   Gas
 }
 
 // NewGasMeter returns a reference to a new basicGasMeter.
@@ -102,6 +112,15 @@ func addUint64Overflow(a, b uint64) (uint64, bool) {
 	return a + b, false
 }
 
+// addUint64Saturating adds two uint64 integers, returning math.MaxUint64 on overflow.
+func addUint64Saturating(a, b uint64) uint64 {
+	sum, overflow := addUint64Overflow(a, b)
+	if overflow {
+		return math.MaxUint64
+	}
+	return sum
+}
+
 // ConsumeGas adds the given amount of gas to the gas consumed and panics if it overflows the limit or out of gas.
 func (g *basicGasMeter) ConsumeGas(amount Gas, descriptor string) {
 	var overflow bool
@@ -130,6 +149,17 @@ func (g *basicGasMeter) RefundGas(amount Gas, descriptor string) {
 	g.consumed -= amount
 }
 
+// AddGasRefund credits the given amount of gas to the gas refund, saturating
+// on overflow.
+func (g *basicGasMeter) AddGasRefund(amount Gas) {
+	g.refund = addUint64Saturating(g.refund, amount)
+}
+
+// GasRefund returns the gas credited to the gas refund.
+func (g *basicGasMeter) GasRefund() Gas {
+	return g.refund
+}
+
 // IsPastLimit returns true if gas consumed is past limit, otherwise it returns false.
 func (g *basicGasMeter) IsPastLimit() bool {
 	return g.consumed > g.limit
@@ -147,6 +177,7 @@ func (g *basicGasMeter) String() string {
 
 type infiniteGasMeter struct {
 	consumed Gas
+	refund   Gas
 }
 
 // NewInfiniteGasMeter returns a new gas meter without a limit.
@@ -201,6 +232,17 @@ func (g *infiniteGasMeter) RefundGas(amount Gas, descriptor string) {
 	g.consumed -= amount
 }
 
+// AddGasRefund credits the given amount of gas to the gas refund, saturating
+// on overflow.
+func (g *infiniteGasMeter) AddGasRefund(amount Gas) {
+	g.refund = addUint64Saturating(g.refund, amount)
+}
+
+// GasRefund returns the gas credited to the gas refund.
+func (g *infiniteGasMeter) GasRefund() Gas {
+	return g.refund
+}
+
 // IsPastLimit returns false since the gas limit is not confined.
 func (g *infiniteGasMeter) IsPastLimit() bool {
 	return false
@@ -225,6 +267,10 @@ type GasConfig struct {
 	WriteCostFlat    Gas
 	WriteCostPerByte Gas
 	IterNextCostFlat Gas
+
+	// DeleteRefundPerByte is the gas credited to the gas refund of a
+	// RefundableGasMeter per byte of deleted value. 0 disables refunds.
+	DeleteRefundPerByte Gas
 }
 
 // KVGasConfig returns a default gas config for KVStores.
@@ -82,6 +82,24 @@ func TestGasMeter(t *testing.T) {
 	}
 }
 
+func TestGasMeterRefund(t *testing.T) {
+	t.Parallel()
+	for _, meter := range []GasMeter{NewGasMeter(100), NewInfiniteGasMeter()} {
+		refundable, ok := meter.(RefundableGasMeter)
+		require.True(t, ok)
+		require.Equal(t, Gas(0), refundable.GasRefund())
+
+		meter.ConsumeGas(50, "consume 50")
+		refundable.AddGasRefund(10)
+		refundable.AddGasRefund(20)
+		require.Equal(t, Gas(30), refundable.GasRefund())
+		require.Equal(t, Gas(50), meter.GasConsumed(), "refunds are not applied to the consumed gas")
+
+		refundable.AddGasRefund(math.MaxUint64)
+		require.Equal(t, Gas(math.MaxUint64), refundable.GasRefund())
+	}
+}
+
 func TestAddUint64Overflow(t *testing.T) {
 	t.Parallel()
 	testCases := []struct {
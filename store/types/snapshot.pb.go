@@ -30,6 +30,7 @@ type SnapshotItem struct {
 	// Types that are valid to be assigned to Item:
 	//	*SnapshotItem_Store
 	//	*SnapshotItem_IAVL
+	//	*SnapshotItem_IAVLRef
 	Item isSnapshotItem_Item `protobuf_oneof:"item"`
 }
 
@@ -78,9 +79,13 @@ type SnapshotItem_Store struct {
 type SnapshotItem_IAVL struct {
 	IAVL *SnapshotIAVLItem `protobuf:"bytes,2,opt,name=iavl,proto3,oneof" json:"iavl,omitempty"`
 }
+type SnapshotItem_IAVLRef struct {
+	IAVLRef *SnapshotIAVLRefItem `protobuf:"bytes,3,opt,name=iavl_ref,json=iavlRef,proto3,oneof" json:"iavl_ref,omitempty"`
+}
 
-func (*SnapshotItem_Store) isSnapshotItem_Item() {}
-func (*SnapshotItem_IAVL) isSnapshotItem_Item()  {}
+func (*SnapshotItem_Store) isSnapshotItem_Item()   {}
+func (*SnapshotItem_IAVL) isSnapshotItem_Item()    {}
+func (*SnapshotItem_IAVLRef) isSnapshotItem_Item() {}
 
 func (m *SnapshotItem) GetItem() isSnapshotItem_Item {
 	if m != nil {
@@ -103,11 +108,19 @@ func (m *SnapshotItem) GetIAVL() *SnapshotIAVLItem {
 	return nil
 }
 
+func (m *SnapshotItem) GetIAVLRef() *SnapshotIAVLRefItem {
+	if x, ok := m.GetItem().(*SnapshotItem_IAVLRef); ok {
+		return x.IAVLRef
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*SnapshotItem_Store)(nil),
 		(*SnapshotItem_IAVL)(nil),
+		(*SnapshotItem_IAVLRef)(nil),
 	}
 }
 
@@ -225,10 +238,89 @@ func (m *SnapshotIAVLItem) GetHeight() int32 {
 	return 0
 }
 
+// SnapshotIAVLRefItem references an IAVL subtree that is unchanged since the
+// base height of a delta snapshot, by its root node and the range of its keys.
+type SnapshotIAVLRefItem struct {
+	Key     []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Height  int32  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	MinKey  []byte `protobuf:"bytes,4,opt,name=min_key,json=minKey,proto3" json:"min_key,omitempty"`
+	MaxKey  []byte `protobuf:"bytes,5,opt,name=max_key,json=maxKey,proto3" json:"max_key,omitempty"`
+}
+
+func (m *SnapshotIAVLRefItem) Reset()         { *m = SnapshotIAVLRefItem{} }
+func (m *SnapshotIAVLRefItem) String() string { return proto.CompactTextString(m) }
+func (*SnapshotIAVLRefItem) ProtoMessage()    {}
+func (*SnapshotIAVLRefItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c55879db4cc4502, []int{3}
+}
+func (m *SnapshotIAVLRefItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotIAVLRefItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotIAVLRefItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotIAVLRefItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotIAVLRefItem.Merge(m, src)
+}
+func (m *SnapshotIAVLRefItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotIAVLRefItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotIAVLRefItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotIAVLRefItem proto.InternalMessageInfo
+
+func (m *SnapshotIAVLRefItem) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *SnapshotIAVLRefItem) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SnapshotIAVLRefItem) GetHeight() int32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SnapshotIAVLRefItem) GetMinKey() []byte {
+	if m != nil {
+		return m.MinKey
+	}
+	return nil
+}
+
+func (m *SnapshotIAVLRefItem) GetMaxKey() []byte {
+	if m != nil {
+		return m.MaxKey
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SnapshotItem)(nil), "cosmos.base.store.v1beta1.SnapshotItem")
 	proto.RegisterType((*SnapshotStoreItem)(nil), "cosmos.base.store.v1beta1.SnapshotStoreItem")
 	proto.RegisterType((*SnapshotIAVLItem)(nil), "cosmos.base.store.v1beta1.SnapshotIAVLItem")
+	proto.RegisterType((*SnapshotIAVLRefItem)(nil), "cosmos.base.store.v1beta1.SnapshotIAVLRefItem")
 }
 
 func init() {
@@ -236,28 +328,33 @@ func init() {
 }
 
 var fileDescriptor_9c55879db4cc4502 = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x4a, 0xc3, 0x30,
-	0x18, 0xc7, 0x1b, 0xd7, 0x4d, 0xfd, 0xdc, 0x61, 0x86, 0x21, 0xd5, 0x43, 0x1d, 0xbb, 0x58, 0x50,
-	0x13, 0xa6, 0x4f, 0x60, 0xf1, 0xb0, 0xa1, 0xa7, 0x0c, 0x3c, 0x78, 0x4b, 0x67, 0x68, 0xcb, 0xd6,
-	0x65, 0x2c, 0x59, 0x61, 0x6f, 0xe1, 0x6b, 0xf8, 0x26, 0x1e, 0x77, 0xf4, 0x24, 0xd2, 0xbd, 0x88,
-	0x24, 0xe9, 0x2e, 0x8a, 0xe0, 0xa9, 0xdf, 0xbf, 0xfc, 0xfe, 0xbf, 0x7c, 0xf0, 0x41, 0x34, 0x91,
-	0xaa, 0x90, 0x8a, 0x26, 0x5c, 0x09, 0xaa, 0xb4, 0x5c, 0x0a, 0x5a, 0x0e, 0x12, 0xa1, 0xf9, 0x80,
-	0xaa, 0x39, 0x5f, 0xa8, 0x4c, 0x6a, 0xb2, 0x58, 0x4a, 0x2d, 0xf1, 0xa9, 0x23, 0x89, 0x21, 0x89,
-	0x25, 0x49, 0x4d, 0x9e, 0x75, 0x53, 0x99, 0x4a, 0x4b, 0x51, 0x33, 0xb9, 0x42, 0xff, 0x0d, 0x41,
-	0x7b, 0x5c, 0x3b, 0x46, 0x5a, 0x14, 0xf8, 0x1e, 0x9a, 0xb6, 0x17, 0xa0, 0x1e, 0x8a, 0x8e, 0x6e,
-	0xae, 0xc8, 0x9f, 0x46, 0xb2, 0xeb, 0x8d, 0xcd, 0x5f, 0x53, 0x1e, 0x7a, 0xcc, 0x95, 0xf1, 0x03,
-	0xf8, 0x39, 0x2f, 0x67, 0xc1, 0x9e, 0x95, 0x5c, 0xfe, 0x43, 0x32, 0xba, 0x7b, 0x7a, 0x34, 0x8e,
-	0xf8, 0xa0, 0xfa, 0x3c, 0xf7, 0x4d, 0x1a, 0x7a, 0xcc, 0x4a, 0xe2, 0x16, 0xf8, 0xb9, 0x16, 0x45,
-	0xff, 0x02, 0x8e, 0x7f, 0x3d, 0x89, 0x31, 0xf8, 0x73, 0x5e, 0xb8, 0x75, 0x0f, 0x99, 0x9d, 0xfb,
-	0x33, 0xe8, 0xfc, 0xd4, 0xe2, 0x0e, 0x34, 0xa6, 0x62, 0x6d, 0xb1, 0x36, 0x33, 0x23, 0xee, 0x42,
-	0xb3, 0xe4, 0xb3, 0x95, 0xb0, 0x4b, 0xb6, 0x99, 0x0b, 0x38, 0x80, 0xfd, 0x52, 0x2c, 0x55, 0x2e,
-	0xe7, 0x41, 0xa3, 0x87, 0xa2, 0x06, 0xdb, 0x45, 0x7c, 0x02, 0xad, 0x4c, 0xe4, 0x69, 0xa6, 0x03,
-	0xbf, 0x87, 0xa2, 0x26, 0xab, 0x53, 0x1c, 0xbf, 0x57, 0x21, 0xda, 0x54, 0x21, 0xfa, 0xaa, 0x42,
-	0xf4, 0xba, 0x0d, 0xbd, 0xcd, 0x36, 0xf4, 0x3e, 0xb6, 0xa1, 0xf7, 0x1c, 0xa5, 0xb9, 0xce, 0x56,
-	0x09, 0x99, 0xc8, 0x82, 0xd6, 0x27, 0x74, 0x9f, 0x6b, 0xf5, 0x32, 0xad, 0x0f, 0xa9, 0xd7, 0x0b,
-	0xa1, 0x92, 0x96, 0xbd, 0xc6, 0xed, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x75, 0x87, 0x24, 0x7b,
-	0xea, 0x01, 0x00, 0x00,
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0xae, 0xd3, 0x30,
+	0x14, 0xc6, 0x93, 0xe6, 0xdf, 0xc5, 0xb7, 0xc3, 0xc5, 0x5c, 0x41, 0x60, 0xc8, 0xad, 0xb2, 0x10,
+	0x09, 0x70, 0x54, 0x78, 0x02, 0x22, 0x86, 0x56, 0x65, 0x72, 0x25, 0x84, 0x58, 0x2a, 0xa7, 0xb8,
+	0x49, 0xd4, 0x26, 0xae, 0x62, 0x37, 0x6a, 0x66, 0x46, 0x16, 0x1e, 0x8b, 0xb1, 0x23, 0x53, 0x85,
+	0xd2, 0x17, 0x41, 0x76, 0x52, 0xa9, 0xe2, 0xb6, 0x52, 0x27, 0x9f, 0xe3, 0xf3, 0x7d, 0xbf, 0xe4,
+	0xb3, 0x0e, 0x08, 0xe6, 0x8c, 0xe7, 0x8c, 0x87, 0x31, 0xe1, 0x34, 0xe4, 0x82, 0x95, 0x34, 0xac,
+	0x86, 0x31, 0x15, 0x64, 0x18, 0xf2, 0x82, 0xac, 0x79, 0xca, 0x04, 0x5a, 0x97, 0x4c, 0x30, 0xf8,
+	0xb2, 0x55, 0x22, 0xa9, 0x44, 0x4a, 0x89, 0x3a, 0xe5, 0xab, 0xfb, 0x84, 0x25, 0x4c, 0xa9, 0x42,
+	0x59, 0xb5, 0x06, 0xff, 0x47, 0x0f, 0xf4, 0xa7, 0x1d, 0x63, 0x2c, 0x68, 0x0e, 0x3f, 0x01, 0x4b,
+	0xf9, 0x5c, 0x7d, 0xa0, 0x07, 0xb7, 0xef, 0xdf, 0xa2, 0x8b, 0x44, 0x74, 0xf4, 0x4d, 0xe5, 0xad,
+	0x34, 0x8f, 0x34, 0xdc, 0x9a, 0xe1, 0x04, 0x98, 0x19, 0xa9, 0x56, 0x6e, 0x4f, 0x41, 0xde, 0x5c,
+	0x01, 0x19, 0x7f, 0xfc, 0xf2, 0x59, 0x32, 0xa2, 0x9b, 0x66, 0xff, 0x60, 0xca, 0x6e, 0xa4, 0x61,
+	0x05, 0x81, 0x5f, 0xc1, 0x8d, 0x3c, 0x67, 0x25, 0x5d, 0xb8, 0x86, 0x02, 0xa2, 0x2b, 0x81, 0x98,
+	0x2e, 0x14, 0xf3, 0xb6, 0xd9, 0x3f, 0x38, 0xdd, 0xc5, 0x48, 0xc3, 0x8e, 0xc4, 0x61, 0xba, 0x88,
+	0x6c, 0x60, 0x66, 0x82, 0xe6, 0xfe, 0x6b, 0xf0, 0xf4, 0x51, 0x18, 0x08, 0x81, 0x59, 0x90, 0xbc,
+	0x7d, 0x88, 0x27, 0x58, 0xd5, 0xfe, 0x0a, 0xdc, 0xfd, 0xff, 0xc3, 0xf0, 0x0e, 0x18, 0x4b, 0x5a,
+	0x2b, 0x59, 0x1f, 0xcb, 0x12, 0xde, 0x03, 0xab, 0x22, 0xab, 0x0d, 0x55, 0xf1, 0xfb, 0xb8, 0x6d,
+	0xa0, 0x0b, 0x9c, 0x8a, 0x96, 0x3c, 0x63, 0x85, 0x4a, 0x61, 0xe0, 0x63, 0x0b, 0x9f, 0x03, 0x3b,
+	0xa5, 0x59, 0x92, 0x0a, 0xd7, 0x1c, 0xe8, 0x81, 0x85, 0xbb, 0xce, 0xff, 0xa9, 0x83, 0x67, 0x67,
+	0xe2, 0x9c, 0xf9, 0xe2, 0x09, 0xbb, 0x77, 0x89, 0x6d, 0x9c, 0xb2, 0xe1, 0x0b, 0xe0, 0xe4, 0x59,
+	0x31, 0x93, 0x1c, 0x53, 0x71, 0xec, 0x3c, 0x2b, 0x26, 0xb4, 0x56, 0x03, 0xb2, 0x55, 0x03, 0xab,
+	0x1b, 0x90, 0xed, 0x84, 0xd6, 0x51, 0xf4, 0xbb, 0xf1, 0xf4, 0x5d, 0xe3, 0xe9, 0x7f, 0x1b, 0x4f,
+	0xff, 0x75, 0xf0, 0xb4, 0xdd, 0xc1, 0xd3, 0xfe, 0x1c, 0x3c, 0xed, 0x5b, 0x90, 0x64, 0x22, 0xdd,
+	0xc4, 0x68, 0xce, 0xf2, 0xb0, 0x5b, 0xd5, 0xf6, 0x78, 0xc7, 0xbf, 0x2f, 0xbb, 0x85, 0x15, 0xf5,
+	0x9a, 0xf2, 0xd8, 0x56, 0x5b, 0xf7, 0xe1, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x0e, 0x5e,
+	0x53, 0xd2, 0x02, 0x00, 0x00,
 }
 
 func (m *SnapshotItem) Marshal() (dAtA []byte, err error) {
@@ -334,6 +431,27 @@ func (m *SnapshotItem_IAVL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *SnapshotItem_IAVLRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotItem_IAVLRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IAVLRef != nil {
+		{
+			size, err := m.IAVLRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *SnapshotStoreItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -411,6 +529,60 @@ func (m *SnapshotIAVLItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SnapshotIAVLRefItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotIAVLRefItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotIAVLRefItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxKey) > 0 {
+		i -= len(m.MaxKey)
+		copy(dAtA[i:], m.MaxKey)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.MaxKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MinKey) > 0 {
+		i -= len(m.MinKey)
+		copy(dAtA[i:], m.MinKey)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.MinKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSnapshot(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSnapshot(v)
 	base := offset
@@ -458,6 +630,18 @@ func (m *SnapshotItem_IAVL) Size() (n int) {
 	}
 	return n
 }
+func (m *SnapshotItem_IAVLRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IAVLRef != nil {
+		l = m.IAVLRef.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
 func (m *SnapshotStoreItem) Size() (n int) {
 	if m == nil {
 		return 0
@@ -494,6 +678,33 @@ func (m *SnapshotIAVLItem) Size() (n int) {
 	return n
 }
 
+func (m *SnapshotIAVLRefItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovSnapshot(uint64(m.Version))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSnapshot(uint64(m.Height))
+	}
+	l = len(m.MinKey)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	l = len(m.MaxKey)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+
 func sovSnapshot(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -599,6 +810,41 @@ func (m *SnapshotItem) Unmarshal(dAtA []byte) error {
 			}
 			m.Item = &SnapshotItem_IAVL{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IAVLRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SnapshotIAVLRefItem{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &SnapshotItem_IAVLRef{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -858,6 +1104,196 @@ func (m *SnapshotIAVLItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SnapshotIAVLRefItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotIAVLRefItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotIAVLRefItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinKey = append(m.MinKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.MinKey == nil {
+				m.MinKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxKey = append(m.MaxKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.MaxKey == nil {
+				m.MaxKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSnapshot(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -52,6 +52,20 @@ type Config struct {
 	// Example:
 	// [["chain_id", "cosmoshub-1"]]
 	GlobalLabels [][]string `mapstructure:"global-labels"`
+
+	// TracingEnabled enables exporting OpenTelemetry traces of the tx execution
+	// pipeline (ante handlers, Msg handlers and EndBlockers) over OTLP/HTTP.
+	TracingEnabled bool `mapstructure:"tracing-enabled"`
+
+	// TracingEndpoint is the host:port of the OTLP/HTTP collector receiving
+	// traces, e.g. a Jaeger or Tempo instance.
+	TracingEndpoint string `mapstructure:"tracing-endpoint"`
+
+	// TracingInsecure disables TLS when exporting traces.
+	TracingInsecure bool `mapstructure:"tracing-insecure"`
+
+	// TracingSampleRate is the fraction, within [0, 1], of traces to sample.
+	TracingSampleRate float64 `mapstructure:"tracing-sample-rate"`
 }
 
 // Metrics defines a wrapper around application telemetry functionality. It allows
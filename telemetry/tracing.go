@@ -0,0 +1,136 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation name under which all spans of the tx
+// execution pipeline are recorded.
+const tracerName = "github.com/cosmos/cosmos-sdk"
+
+// Common span name and attribute key constants
+const (
+	SpanNameDeliverTx       = "deliver_tx"
+	SpanNameAnteHandler     = "ante_handler"
+	SpanNameMsgHandler      = "msg_handler"
+	SpanNameBeginBlock      = "begin_block"
+	SpanNameBeginBlocker    = "begin_blocker"
+	SpanNameEndBlock        = "end_block"
+	SpanNameEndBlocker      = "end_blocker"
+	SpanNameEpochBoundary   = "epoch_boundary"
+	SpanNameProposalHandler = "proposal_handler"
+
+	TraceAttrHeight  = "block.height"
+	TraceAttrTxHash  = "tx.hash"
+	TraceAttrModule  = MetricLabelNameModule
+	TraceAttrMsgType = "msg.type"
+	TraceAttrMsgIdx  = "msg.index"
+)
+
+// DefaultTracingEndpoint is the OTLP/HTTP endpoint used when tracing is enabled
+// without an explicit endpoint. Both Jaeger and Tempo accept OTLP on this port.
+const DefaultTracingEndpoint = "localhost:4318"
+
+// NewTracerProvider creates an OTLP/HTTP trace exporter as configured by the
+// operator and registers it as the global tracer provider. It returns a
+// function flushing and stopping the exporter, which must be called on
+// shutdown. When tracing is disabled, the global no-op tracer provider is left
+// in place and the returned function does nothing.
+func NewTracerProvider(cfg Config) (func(context.Context) error, error) {
+	if !cfg.TracingEnabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	if cfg.TracingSampleRate < 0 || cfg.TracingSampleRate > 1 {
+		return nil, fmt.Errorf("tracing sample rate must be within [0, 1]; got %v", cfg.TracingSampleRate)
+	}
+
+	endpoint := cfg.TracingEndpoint
+	if endpoint == "" {
+		endpoint = DefaultTracingEndpoint
+	}
+
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(endpoint)}
+	if cfg.TracingInsecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+
+	exporter, err := otlptracehttp.New(context.Background(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceName := cfg.ServiceName
+	if serviceName == "" {
+		serviceName = "cosmos-sdk"
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(serviceName),
+		)),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.TracingSampleRate))),
+	)
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
+
+// StartSpan starts a span with the given name and attributes as a child of the
+// span carried by ctx, if any. Spans are no-ops unless a tracer provider was
+// registered with NewTracerProvider. A nil ctx, as carried by a zero
+// sdk.Context, starts a root span.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records err on the span, if non-nil, and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// HeightAttr returns the span attribute correlating a span with a block height.
+func HeightAttr(height int64) attribute.KeyValue {
+	return attribute.Int64(TraceAttrHeight, height)
+}
+
+// TxHashAttr returns the span attribute correlating a span with a transaction,
+// given the raw transaction bytes. The hash is the one indexed by Tendermint.
+func TxHashAttr(txBytes []byte) attribute.KeyValue {
+	return attribute.String(TraceAttrTxHash, fmt.Sprintf("%X", tmhash.Sum(txBytes)))
+}
+
+// ModuleAttr returns the span attribute naming the module executing a span.
+func ModuleAttr(module string) attribute.KeyValue {
+	return attribute.String(TraceAttrModule, module)
+}
+
+// MsgAttrs returns the span attributes identifying a message within a tx.
+func MsgAttrs(msgType string, index int) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String(TraceAttrMsgType, msgType),
+		attribute.Int(TraceAttrMsgIdx, index),
+	}
+}
@@ -0,0 +1,55 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestNewTracerProvider_Disabled(t *testing.T) {
+	shutdown, err := NewTracerProvider(Config{TracingEnabled: false})
+	require.NoError(t, err)
+	require.NoError(t, shutdown(context.Background()))
+}
+
+func TestNewTracerProvider_InvalidSampleRate(t *testing.T) {
+	_, err := NewTracerProvider(Config{TracingEnabled: true, TracingSampleRate: 1.5})
+	require.Error(t, err)
+}
+
+func TestStartSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	txBytes := []byte("tx")
+	ctx, txSpan := StartSpan(context.Background(), SpanNameDeliverTx, HeightAttr(10), TxHashAttr(txBytes))
+	_, msgSpan := StartSpan(ctx, SpanNameMsgHandler, MsgAttrs("/cosmos.bank.v1beta1.MsgSend", 0)...)
+	EndSpan(msgSpan, errors.New("insufficient funds"))
+	EndSpan(txSpan, nil)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	msg, tx := spans[0], spans[1]
+	require.Equal(t, SpanNameMsgHandler, msg.Name())
+	require.Equal(t, SpanNameDeliverTx, tx.Name())
+
+	// the msg handler span is a child of the tx span
+	require.Equal(t, tx.SpanContext().TraceID(), msg.SpanContext().TraceID())
+	require.Equal(t, tx.SpanContext().SpanID(), msg.Parent().SpanID())
+
+	require.Equal(t, codes.Error, msg.Status().Code)
+	require.Equal(t, codes.Unset, tx.Status().Code)
+	require.Contains(t, msg.Attributes(), attribute.String(TraceAttrMsgType, "/cosmos.bank.v1beta1.MsgSend"))
+	require.Contains(t, tx.Attributes(), attribute.Int64(TraceAttrHeight, 10))
+	require.Contains(t, tx.Attributes(), attribute.String(TraceAttrTxHash, "1B5B9CCB3E8D006A5230DE9BDA23FF91EDC794D4F56410560830B418528E446C"))
+}
@@ -0,0 +1,322 @@
+// Package chaos implements store wrappers injecting faults into the store
+// operations of keepers under test: write failures, iterator order checks and
+// latency. They also count the operations performed, to assert the gas charged
+// for them.
+package chaos
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// ErrInjectedWriteFailure is the value the write configured to fail panics
+	// with.
+	ErrInjectedWriteFailure = errors.New("chaos: injected write failure")
+
+	// ErrIteratorOrder is the error wrapped by the value an iterator panics with
+	// when it yields a key out of order or out of its domain.
+	ErrIteratorOrder = errors.New("chaos: iterator out of order")
+)
+
+// Config defines the faults injected into the stores wrapped by an Injector.
+type Config struct {
+	// FailWriteAt, when positive, makes the FailWriteAt-th write (Set or Delete)
+	// across all the wrapped stores panic with ErrInjectedWriteFailure instead
+	// of being applied. Other writes are applied.
+	FailWriteAt uint64
+
+	// CheckIteratorOrder makes iterators panic with an error wrapping
+	// ErrIteratorOrder when they yield a key which does not strictly follow the
+	// previous one in their iteration order or which is out of their domain.
+	CheckIteratorOrder bool
+
+	// Latency delays every operation on the wrapped stores, including each
+	// iterator step.
+	Latency time.Duration
+}
+
+// Stats counts the operations performed on the stores wrapped by an Injector.
+type Stats struct {
+	Reads         uint64 // Get calls
+	ReadBytes     uint64 // bytes of the values returned by Get
+	Has           uint64 // Has calls
+	Writes        uint64 // Set calls
+	WriteBytes    uint64 // bytes of the values written by Set
+	Deletes       uint64 // Delete calls
+	IterSeeks     uint64 // valid iterator positions at creation and moved from by Next
+	IterSeekBytes uint64 // bytes of the values at these iterator positions
+}
+
+// Gas returns the gas a gas KVStore configured with cfg charges for the
+// operations counted by s. Comparing it with the gas consumed by a context
+// exposes gas consumed outside of store accesses. Delete refunds, which read
+// the deleted value, are not accounted for.
+func (s Stats) Gas(cfg types.GasConfig) types.Gas {
+	return s.Reads*cfg.ReadCostFlat + s.ReadBytes*cfg.ReadCostPerByte +
+		s.Has*cfg.HasCost +
+		s.Writes*cfg.WriteCostFlat + s.WriteBytes*cfg.WriteCostPerByte +
+		s.Deletes*cfg.DeleteCost +
+		s.IterSeeks*cfg.IterNextCostFlat + s.IterSeekBytes*cfg.ReadCostPerByte
+}
+
+// Injector injects the faults of its Config into the stores it wraps, keeping
+// a single write count and Stats across them. It is meant for tests exercising
+// the error paths of keepers, e.g. a proposal handler failing halfway through
+// its writes to a cache context which must then be discarded.
+type Injector struct {
+	cfg Config
+
+	mtx    sync.Mutex
+	writes uint64
+	stats  Stats
+}
+
+// NewInjector returns an Injector injecting the faults defined by cfg.
+func NewInjector(cfg Config) *Injector {
+	return &Injector{cfg: cfg}
+}
+
+// Stats returns the operations counted since the Injector was created or
+// last reset.
+func (in *Injector) Stats() Stats {
+	in.mtx.Lock()
+	defer in.mtx.Unlock()
+
+	return in.stats
+}
+
+// Reset resets the write count and the Stats.
+func (in *Injector) Reset() {
+	in.mtx.Lock()
+	defer in.mtx.Unlock()
+
+	in.writes = 0
+	in.stats = Stats{}
+}
+
+// KVStore wraps parent.
+func (in *Injector) KVStore(parent types.KVStore) types.KVStore {
+	return &kvStore{parent: parent, in: in}
+}
+
+// MultiStore wraps the KVStores of parent with the given keys, or all of them
+// if no key is given. The stores of the cache multi-stores branched from the
+// returned multi-store are wrapped as well.
+func (in *Injector) MultiStore(parent types.MultiStore, keys ...types.StoreKey) types.MultiStore {
+	return &multiStore{MultiStore: parent, in: in, keys: keys}
+}
+
+// Context returns ctx with its multi-store wrapped as with MultiStore, so that
+// keepers called with it access the wrapped stores.
+func (in *Injector) Context(ctx sdk.Context, keys ...types.StoreKey) sdk.Context {
+	return ctx.WithMultiStore(in.MultiStore(ctx.MultiStore(), keys...))
+}
+
+func (in *Injector) record(update func(*Stats)) {
+	if in.cfg.Latency > 0 {
+		time.Sleep(in.cfg.Latency)
+	}
+
+	in.mtx.Lock()
+	defer in.mtx.Unlock()
+
+	update(&in.stats)
+}
+
+// write counts a write, panicking if it is the one configured to fail.
+func (in *Injector) write(update func(*Stats)) {
+	in.mtx.Lock()
+	in.writes++
+	fail := in.writes == in.cfg.FailWriteAt
+	in.mtx.Unlock()
+
+	if fail {
+		panic(ErrInjectedWriteFailure)
+	}
+
+	in.record(update)
+}
+
+var _ types.KVStore = &kvStore{}
+
+type kvStore struct {
+	parent types.KVStore
+	in     *Injector
+}
+
+func (s *kvStore) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+func (s *kvStore) Get(key []byte) []byte {
+	value := s.parent.Get(key)
+	s.in.record(func(stats *Stats) {
+		stats.Reads++
+		stats.ReadBytes += uint64(len(value))
+	})
+
+	return value
+}
+
+func (s *kvStore) Has(key []byte) bool {
+	s.in.record(func(stats *Stats) { stats.Has++ })
+	return s.parent.Has(key)
+}
+
+func (s *kvStore) Set(key, value []byte) {
+	s.in.write(func(stats *Stats) {
+		stats.Writes++
+		stats.WriteBytes += uint64(len(value))
+	})
+	s.parent.Set(key, value)
+}
+
+func (s *kvStore) Delete(key []byte) {
+	s.in.write(func(stats *Stats) { stats.Deletes++ })
+	s.parent.Delete(key)
+}
+
+func (s *kvStore) Iterator(start, end []byte) types.Iterator {
+	return s.in.newIterator(s.parent.Iterator(start, end), start, end, true)
+}
+
+func (s *kvStore) ReverseIterator(start, end []byte) types.Iterator {
+	return s.in.newIterator(s.parent.ReverseIterator(start, end), start, end, false)
+}
+
+func (s *kvStore) CacheWrap() types.CacheWrap {
+	panic("cannot CacheWrap a chaos KVStore")
+}
+
+func (s *kvStore) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	panic("cannot CacheWrapWithTrace a chaos KVStore")
+}
+
+func (s *kvStore) CacheWrapWithListeners(_ types.StoreKey, _ []types.WriteListener) types.CacheWrap {
+	panic("cannot CacheWrapWithListeners a chaos KVStore")
+}
+
+type iterator struct {
+	types.Iterator
+
+	in         *Injector
+	start, end []byte
+	ascending  bool
+	prev       []byte
+}
+
+func (in *Injector) newIterator(parent types.Iterator, start, end []byte, ascending bool) types.Iterator {
+	it := &iterator{Iterator: parent, in: in, start: start, end: end, ascending: ascending}
+	if it.Valid() {
+		it.seek()
+		it.check()
+	}
+
+	return it
+}
+
+// Next records the position the iterator moves from, as a gas KVStore charges
+// for it, and checks the position it moves to.
+func (it *iterator) Next() {
+	if it.Valid() {
+		it.seek()
+	}
+
+	it.Iterator.Next()
+	if it.Valid() {
+		it.check()
+	}
+}
+
+// seek records the current position of the iterator.
+func (it *iterator) seek() {
+	value := it.Value()
+	it.in.record(func(stats *Stats) {
+		stats.IterSeeks++
+		stats.IterSeekBytes += uint64(len(value))
+	})
+}
+
+// check checks the current key of the iterator against the previous one and
+// the domain of the iterator.
+func (it *iterator) check() {
+	if !it.in.cfg.CheckIteratorOrder {
+		return
+	}
+
+	key := it.Key()
+	if (it.start != nil && bytes.Compare(key, it.start) < 0) || (it.end != nil && bytes.Compare(key, it.end) >= 0) {
+		panic(fmt.Errorf("%w: key %X out of domain [%X, %X)", ErrIteratorOrder, key, it.start, it.end))
+	}
+
+	if it.prev != nil {
+		cmp := bytes.Compare(it.prev, key)
+		if (it.ascending && cmp >= 0) || (!it.ascending && cmp <= 0) {
+			panic(fmt.Errorf("%w: key %X yielded after %X", ErrIteratorOrder, key, it.prev))
+		}
+	}
+
+	it.prev = append(it.prev[:0], key...)
+}
+
+type multiStore struct {
+	types.MultiStore
+
+	in   *Injector
+	keys []types.StoreKey
+}
+
+func (ms *multiStore) GetKVStore(key types.StoreKey) types.KVStore {
+	return wrapKVStore(ms.in, ms.keys, key, ms.MultiStore.GetKVStore(key))
+}
+
+func (ms *multiStore) CacheMultiStore() types.CacheMultiStore {
+	return ms.wrapCacheMultiStore(ms.MultiStore.CacheMultiStore())
+}
+
+func (ms *multiStore) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	cms, err := ms.MultiStore.CacheMultiStoreWithVersion(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return ms.wrapCacheMultiStore(cms), nil
+}
+
+func (ms *multiStore) wrapCacheMultiStore(cms types.CacheMultiStore) types.CacheMultiStore {
+	return &cacheMultiStore{multiStore: multiStore{MultiStore: cms, in: ms.in, keys: ms.keys}, parent: cms}
+}
+
+type cacheMultiStore struct {
+	multiStore
+
+	parent types.CacheMultiStore
+}
+
+func (cms *cacheMultiStore) Write() {
+	cms.parent.Write()
+}
+
+// wrapKVStore wraps the store with the given key if it is one of keys or if
+// keys is empty.
+func wrapKVStore(in *Injector, keys []types.StoreKey, key types.StoreKey, store types.KVStore) types.KVStore {
+	if len(keys) == 0 {
+		return in.KVStore(store)
+	}
+
+	for _, k := range keys {
+		if k == key {
+			return in.KVStore(store)
+		}
+	}
+
+	return store
+}
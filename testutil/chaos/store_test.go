@@ -0,0 +1,114 @@
+package chaos_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/chaos"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFailWriteAtRollsBackCacheContext(t *testing.T) {
+	key := sdk.NewKVStoreKey("test")
+	in := chaos.NewInjector(chaos.Config{FailWriteAt: 3})
+	ctx := in.Context(testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test")))
+
+	ctx.KVStore(key).Set([]byte("a"), []byte("1"))
+
+	// the handler fails on its second write to the cache context, which must
+	// then be discarded
+	cacheCtx, writeCache := ctx.CacheContext()
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = r.(error)
+			}
+		}()
+
+		store := cacheCtx.KVStore(key)
+		store.Set([]byte("b"), []byte("2"))
+		store.Set([]byte("c"), []byte("3"))
+		writeCache()
+		return nil
+	}()
+	require.True(t, errors.Is(err, chaos.ErrInjectedWriteFailure))
+
+	store := ctx.KVStore(key)
+	require.Equal(t, []byte("1"), store.Get([]byte("a")))
+	require.False(t, store.Has([]byte("b")))
+	require.False(t, store.Has([]byte("c")))
+
+	// later writes are applied
+	store.Set([]byte("c"), []byte("3"))
+	require.Equal(t, []byte("3"), store.Get([]byte("c")))
+}
+
+func TestStatsGas(t *testing.T) {
+	key := sdk.NewKVStoreKey("test")
+	in := chaos.NewInjector(chaos.Config{})
+	ctx := in.Context(testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test"))).
+		WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	store := ctx.KVStore(key)
+	store.Set([]byte("a"), []byte("value"))
+	store.Set([]byte("b"), []byte("other value"))
+	store.Get([]byte("a"))
+	store.Get([]byte("missing"))
+	store.Has([]byte("b"))
+	store.Delete([]byte("b"))
+
+	it := store.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+	}
+	require.NoError(t, it.Close())
+
+	stats := in.Stats()
+	require.Equal(t, chaos.Stats{
+		Reads: 2, ReadBytes: 5, Has: 1, Writes: 2, WriteBytes: 16, Deletes: 1, IterSeeks: 2, IterSeekBytes: 10,
+	}, stats)
+	require.Equal(t, ctx.GasMeter().GasConsumed(), stats.Gas(ctx.KVGasConfig()))
+
+	in.Reset()
+	require.Equal(t, chaos.Stats{}, in.Stats())
+}
+
+// unorderedStore returns reverse iterators as ascending ones.
+type unorderedStore struct {
+	types.KVStore
+}
+
+func (s unorderedStore) Iterator(start, end []byte) types.Iterator {
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+func TestCheckIteratorOrder(t *testing.T) {
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	parent.Set([]byte("a"), []byte("1"))
+	parent.Set([]byte("b"), []byte("2"))
+
+	store := chaos.NewInjector(chaos.Config{CheckIteratorOrder: true}).KVStore(parent)
+	it := store.ReverseIterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+	}
+	require.NoError(t, it.Close())
+
+	store = chaos.NewInjector(chaos.Config{CheckIteratorOrder: true}).KVStore(unorderedStore{parent})
+	it = store.Iterator(nil, nil)
+	require.PanicsWithError(t, "chaos: iterator out of order: key 61 yielded after 62", it.Next)
+}
+
+func TestLatency(t *testing.T) {
+	store := chaos.NewInjector(chaos.Config{Latency: 5 * time.Millisecond}).KVStore(dbadapter.Store{DB: dbm.NewMemDB()})
+
+	start := time.Now()
+	store.Set([]byte("a"), []byte("1"))
+	store.Get([]byte("a"))
+	require.GreaterOrEqual(t, int64(time.Since(start)), int64(10*time.Millisecond))
+}
@@ -0,0 +1,120 @@
+package testdata
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// KeyTestPubAddrFromSeed generates a secp256k1 keypair deterministically from
+// a seed, so that tests don't depend on randomly generated keys.
+func KeyTestPubAddrFromSeed(seed string) (cryptotypes.PrivKey, cryptotypes.PubKey, sdk.AccAddress) {
+	return keyPubAddr(secp256k1.GenPrivKeyFromSecret([]byte(seed)))
+}
+
+// KeyTestPubAddrSecp256R1FromSeed generates a secp256r1 keypair
+// deterministically from a seed.
+func KeyTestPubAddrSecp256R1FromSeed(seed string) (cryptotypes.PrivKey, cryptotypes.PubKey, sdk.AccAddress) {
+	return keyPubAddr(secp256r1.GenPrivKeyFromSecret([]byte(seed)))
+}
+
+// KeyTestPubAddrEd25519FromSeed generates an ed25519 keypair deterministically
+// from a seed.
+func KeyTestPubAddrEd25519FromSeed(seed string) (cryptotypes.PrivKey, cryptotypes.PubKey, sdk.AccAddress) {
+	return keyPubAddr(ed25519.GenPrivKeyFromSecret([]byte(seed)))
+}
+
+func keyPubAddr(key cryptotypes.PrivKey) (cryptotypes.PrivKey, cryptotypes.PubKey, sdk.AccAddress) {
+	pub := key.PubKey()
+	addr := sdk.AccAddress(pub.Address())
+	return key, pub, addr
+}
+
+// AddressVector is a canonical test vector of the keypair generated from a
+// seed, with its address and the bech32 encodings of the address under the
+// default prefixes, independently of the prefixes configured in sdk.Config.
+type AddressVector struct {
+	KeyType  string // type of the key, as returned by its Type method
+	Seed     string
+	PubKey   string // hex encoded public key bytes
+	Address  string // hex encoded address bytes
+	AccAddr  string // bech32 account address
+	ValAddr  string // bech32 validator operator address
+	ConsAddr string // bech32 consensus node address
+}
+
+// KeyPair generates the keypair of the vector from its seed.
+func (v AddressVector) KeyPair() (cryptotypes.PrivKey, cryptotypes.PubKey, sdk.AccAddress) {
+	switch v.KeyType {
+	case "secp256r1":
+		return KeyTestPubAddrSecp256R1FromSeed(v.Seed)
+	case "ed25519":
+		return KeyTestPubAddrEd25519FromSeed(v.Seed)
+	default:
+		return KeyTestPubAddrFromSeed(v.Seed)
+	}
+}
+
+// AddressVectors returns the canonical address test vectors of the keypairs
+// generated with KeyTestPubAddrFromSeed, KeyTestPubAddrSecp256R1FromSeed and
+// KeyTestPubAddrEd25519FromSeed.
+func AddressVectors() []AddressVector {
+	return []AddressVector{
+		{
+			KeyType:  "secp256k1",
+			Seed:     "alice",
+			PubKey:   "02326914ebfc3ac89858b70f8d041cc3406aba3ef22990ab32a949e8e8711b9a3f",
+			Address:  "3d55e0cdc98d250b385408b633039de8aa36a439",
+			AccAddr:  "cosmos18427pnwf35jskwz5pzmrxquaaz4rdfpe0t4hm9",
+			ValAddr:  "cosmosvaloper18427pnwf35jskwz5pzmrxquaaz4rdfpe2lpzhk",
+			ConsAddr: "cosmosvalcons18427pnwf35jskwz5pzmrxquaaz4rdfpe7vj7mh",
+		},
+		{
+			KeyType:  "secp256k1",
+			Seed:     "bob",
+			PubKey:   "02803dad60c81a8edea80da08b2828536a21745566c04b41cfe0d77c21407f4cc2",
+			Address:  "0607f2889ede47c0a6d705a017e4107f2afdb602",
+			AccAddr:  "cosmos1qcrl9zy7merupfkhqksp0eqs0u40mdszf04lqf",
+			ValAddr:  "cosmosvaloper1qcrl9zy7merupfkhqksp0eqs0u40mdszvmp2v6",
+			ConsAddr: "cosmosvalcons1qcrl9zy7merupfkhqksp0eqs0u40mdszcgjkqm",
+		},
+		{
+			KeyType:  "secp256r1",
+			Seed:     "alice",
+			PubKey:   "02fead1b7f9f4e4b0b18c5c699a9fb0896ad3ead3583771ea654ef4e4e1e69a3f2",
+			Address:  "e5e49faa28a85d0e820ac79916f871984e46ea83b5f6bef2314aab0272982adb",
+			AccAddr:  "cosmos1uhjfl23g4pwsaqs2c7v3d7r3np8yd65rkhmtau33f24syu5c9tdsew4xe0",
+			ValAddr:  "cosmosvaloper1uhjfl23g4pwsaqs2c7v3d7r3np8yd65rkhmtau33f24syu5c9tdsl8jjtp",
+			ConsAddr: "cosmosvalcons1uhjfl23g4pwsaqs2c7v3d7r3np8yd65rkhmtau33f24syu5c9tds7fnfay",
+		},
+		{
+			KeyType:  "secp256r1",
+			Seed:     "bob",
+			PubKey:   "03fea251b269a1d74cd5849938c7d1e5bae7edf706a46fe99065f0b7dcd1f453c3",
+			Address:  "9cc96a279be023024039415207e4284f5d772d06b203bc0610b51f901c291493",
+			AccAddr:  "cosmos1nnyk5fumuq3syspeg9fq0epgfawhwtgxkgpmcpssk50eq8pfzjfs62cg0g",
+			ValAddr:  "cosmosvaloper1nnyk5fumuq3syspeg9fq0epgfawhwtgxkgpmcpssk50eq8pfzjfsurluax",
+			ConsAddr: "cosmosvalcons1nnyk5fumuq3syspeg9fq0epgfawhwtgxkgpmcpssk50eq8pfzjfsad78tr",
+		},
+		{
+			KeyType:  "ed25519",
+			Seed:     "alice",
+			PubKey:   "d5bf4a3fcce717b0388bcc2749ebc148ad9969b23f45ee1b605fd58778576ac4",
+			Address:  "1c0c490f1b5528d8173c5de46d131160e4b2c0c3",
+			AccAddr:  "cosmos1rsxyjrcm255ds9euthjx6yc3vrjt9sxr8fx0vw",
+			ValAddr:  "cosmosvaloper1rsxyjrcm255ds9euthjx6yc3vrjt9sxrzaj6qa",
+			ConsAddr: "cosmosvalcons1rsxyjrcm255ds9euthjx6yc3vrjt9sxrkwpxvu",
+		},
+		{
+			KeyType:  "ed25519",
+			Seed:     "bob",
+			PubKey:   "ecc1b58727f3f12b3194881a9ecb9de0b28ce7b207230d8e930fe1bce75e256c",
+			Address:  "34fec43c7fcab9aef3b3cf8aba855e41ee69ca3a",
+			AccAddr:  "cosmos1xnlvg0rle2u6auane79t4p27g8hxnj36w3muth",
+			ValAddr:  "cosmosvaloper1xnlvg0rle2u6auane79t4p27g8hxnj36t90f8y",
+			ConsAddr: "cosmosvalcons1xnlvg0rle2u6auane79t4p27g8hxnj36lku4t9",
+		},
+	}
+}
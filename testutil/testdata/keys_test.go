@@ -0,0 +1,41 @@
+package testdata_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+func TestAddressVectors(t *testing.T) {
+	for _, v := range testdata.AddressVectors() {
+		v := v
+		t.Run(v.KeyType+"/"+v.Seed, func(t *testing.T) {
+			key, pub, addr := v.KeyPair()
+			require.Equal(t, v.KeyType, key.Type())
+			require.True(t, pub.Equals(key.PubKey()))
+			require.Equal(t, v.PubKey, hex.EncodeToString(pub.Bytes()))
+			require.Equal(t, v.Address, hex.EncodeToString(addr))
+
+			for prefix, expected := range map[string]string{
+				sdk.Bech32PrefixAccAddr:  v.AccAddr,
+				sdk.Bech32PrefixValAddr:  v.ValAddr,
+				sdk.Bech32PrefixConsAddr: v.ConsAddr,
+			} {
+				bech, err := bech32.ConvertAndEncode(prefix, addr)
+				require.NoError(t, err)
+				require.Equal(t, expected, bech)
+			}
+
+			// the keys are deterministic but differ between seeds
+			key2, _, _ := v.KeyPair()
+			require.True(t, key.Equals(key2))
+			other, _, _ := testdata.AddressVector{KeyType: v.KeyType, Seed: v.Seed + "x"}.KeyPair()
+			require.False(t, key.Equals(other))
+		})
+	}
+}
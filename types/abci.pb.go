@@ -591,6 +591,145 @@ func (m *SearchTxsResult) GetTxs() []*TxResponse {
 	return nil
 }
 
+// EventBlockReport is emitted at the end of each block, when the block report
+// is enabled, with the aggregate resource usage of the block.
+type EventBlockReport struct {
+	// height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// gas_used is the total gas used by the transactions of the block.
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// tx_count is the number of transactions of the block.
+	TxCount uint64 `protobuf:"varint,3,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// failed_tx_count is the number of transactions of the block which failed.
+	FailedTxCount uint64 `protobuf:"varint,4,opt,name=failed_tx_count,json=failedTxCount,proto3" json:"failed_tx_count,omitempty"`
+	// end_block_durations are the durations of the end blockers of the modules,
+	// in the order they were called.
+	EndBlockDurations []ModuleDuration `protobuf:"bytes,5,rep,name=end_block_durations,json=endBlockDurations,proto3" json:"end_block_durations"`
+}
+
+func (m *EventBlockReport) Reset()         { *m = EventBlockReport{} }
+func (m *EventBlockReport) String() string { return proto.CompactTextString(m) }
+func (*EventBlockReport) ProtoMessage()    {}
+func (*EventBlockReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e37629bc7eb0df8, []int{10}
+}
+func (m *EventBlockReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlockReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlockReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlockReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlockReport.Merge(m, src)
+}
+func (m *EventBlockReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlockReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlockReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlockReport proto.InternalMessageInfo
+
+func (m *EventBlockReport) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EventBlockReport) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *EventBlockReport) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *EventBlockReport) GetFailedTxCount() uint64 {
+	if m != nil {
+		return m.FailedTxCount
+	}
+	return 0
+}
+
+func (m *EventBlockReport) GetEndBlockDurations() []ModuleDuration {
+	if m != nil {
+		return m.EndBlockDurations
+	}
+	return nil
+}
+
+// ModuleDuration is the time a module took to run a step of the block.
+type ModuleDuration struct {
+	// module is the name of the module.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// duration_ns is the duration in nanoseconds.
+	DurationNs int64 `protobuf:"varint,2,opt,name=duration_ns,json=durationNs,proto3" json:"duration_ns,omitempty"`
+}
+
+func (m *ModuleDuration) Reset()         { *m = ModuleDuration{} }
+func (m *ModuleDuration) String() string { return proto.CompactTextString(m) }
+func (*ModuleDuration) ProtoMessage()    {}
+func (*ModuleDuration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e37629bc7eb0df8, []int{11}
+}
+func (m *ModuleDuration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleDuration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleDuration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleDuration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleDuration.Merge(m, src)
+}
+func (m *ModuleDuration) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleDuration) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleDuration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleDuration proto.InternalMessageInfo
+
+func (m *ModuleDuration) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ModuleDuration) GetDurationNs() int64 {
+	if m != nil {
+		return m.DurationNs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*TxResponse)(nil), "cosmos.base.abci.v1beta1.TxResponse")
 	proto.RegisterType((*ABCIMessageLog)(nil), "cosmos.base.abci.v1beta1.ABCIMessageLog")
@@ -602,6 +741,8 @@ func init() {
 	proto.RegisterType((*MsgData)(nil), "cosmos.base.abci.v1beta1.MsgData")
 	proto.RegisterType((*TxMsgData)(nil), "cosmos.base.abci.v1beta1.TxMsgData")
 	proto.RegisterType((*SearchTxsResult)(nil), "cosmos.base.abci.v1beta1.SearchTxsResult")
+	proto.RegisterType((*EventBlockReport)(nil), "cosmos.base.abci.v1beta1.EventBlockReport")
+	proto.RegisterType((*ModuleDuration)(nil), "cosmos.base.abci.v1beta1.ModuleDuration")
 }
 
 func init() {
@@ -609,65 +750,73 @@ func init() {
 }
 
 var fileDescriptor_4e37629bc7eb0df8 = []byte{
-	// 922 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0x31, 0x73, 0x1b, 0x45,
-	0x14, 0xd6, 0x49, 0xca, 0xc9, 0x7a, 0x72, 0x30, 0x2c, 0x26, 0x39, 0x27, 0xa0, 0x13, 0xe7, 0x64,
-	0x46, 0x0d, 0xa7, 0x89, 0x13, 0x18, 0xc6, 0x05, 0x43, 0x2e, 0x10, 0xe2, 0x99, 0x84, 0x62, 0xad,
-	0x0c, 0x33, 0x34, 0x9a, 0x95, 0xb4, 0x59, 0x1d, 0xd1, 0xdd, 0x6a, 0x6e, 0x57, 0xb6, 0xd4, 0x51,
-	0x52, 0x52, 0xa5, 0xa0, 0xa2, 0xe6, 0x97, 0xa4, 0xc3, 0x65, 0x0a, 0x46, 0x80, 0xdd, 0xa5, 0xf4,
-	0x2f, 0x60, 0xf6, 0xed, 0x59, 0x3a, 0x93, 0x91, 0x2b, 0xed, 0xfb, 0xde, 0xdb, 0xb7, 0x6f, 0xbf,
-	0xef, 0xd3, 0x1e, 0xec, 0x0e, 0xa4, 0x4a, 0xa4, 0xea, 0xf4, 0x99, 0xe2, 0x1d, 0xd6, 0x1f, 0xc4,
-	0x9d, 0xa3, 0x7b, 0x7d, 0xae, 0xd9, 0x3d, 0x0c, 0xc2, 0x49, 0x26, 0xb5, 0x24, 0x9e, 0x2d, 0x0a,
-	0x4d, 0x51, 0x88, 0x78, 0x5e, 0x74, 0x6b, 0x5b, 0x48, 0x21, 0xb1, 0xa8, 0x63, 0x56, 0xb6, 0xfe,
-	0xd6, 0x6d, 0xcd, 0xd3, 0x21, 0xcf, 0x92, 0x38, 0xd5, 0xb6, 0xa7, 0x9e, 0x4f, 0xb8, 0xca, 0x93,
-	0x3b, 0x42, 0x4a, 0x31, 0xe6, 0x1d, 0x8c, 0xfa, 0xd3, 0x17, 0x1d, 0x96, 0xce, 0x6d, 0x2a, 0x78,
-	0x55, 0x01, 0xe8, 0xce, 0x28, 0x57, 0x13, 0x99, 0x2a, 0x4e, 0x6e, 0x80, 0x3b, 0xe2, 0xb1, 0x18,
-	0x69, 0xcf, 0x69, 0x39, 0xed, 0x0a, 0xcd, 0x23, 0x12, 0x80, 0xab, 0x67, 0x23, 0xa6, 0x46, 0x5e,
-	0xb9, 0xe5, 0xb4, 0xeb, 0x11, 0x9c, 0x2e, 0x7c, 0xb7, 0x3b, 0x7b, 0xc2, 0xd4, 0x88, 0xe6, 0x19,
-	0xf2, 0x31, 0xd4, 0x07, 0x72, 0xc8, 0xd5, 0x84, 0x0d, 0xb8, 0x57, 0x31, 0x65, 0x74, 0x05, 0x10,
-	0x02, 0x55, 0x13, 0x78, 0xd5, 0x96, 0xd3, 0xbe, 0x4e, 0x71, 0x6d, 0xb0, 0x21, 0xd3, 0xcc, 0xbb,
-	0x86, 0xc5, 0xb8, 0x26, 0x37, 0xa1, 0x96, 0xb1, 0xe3, 0xde, 0x58, 0x0a, 0xcf, 0x45, 0xd8, 0xcd,
-	0xd8, 0xf1, 0x53, 0x29, 0xc8, 0x73, 0xa8, 0x8e, 0xa5, 0x50, 0x5e, 0xad, 0x55, 0x69, 0x37, 0xf6,
-	0xda, 0xe1, 0x3a, 0x82, 0xc2, 0x87, 0xd1, 0xa3, 0x83, 0x67, 0x5c, 0x29, 0x26, 0xf8, 0x53, 0x29,
-	0xa2, 0x9b, 0xaf, 0x17, 0x7e, 0xe9, 0x8f, 0xbf, 0xfd, 0xad, 0xcb, 0xb8, 0xa2, 0xd8, 0xce, 0xcc,
-	0x10, 0xa7, 0x2f, 0xa4, 0xb7, 0x61, 0x67, 0x30, 0x6b, 0xf2, 0x09, 0x80, 0x60, 0xaa, 0x77, 0xcc,
-	0x52, 0xcd, 0x87, 0x5e, 0x1d, 0x99, 0xa8, 0x0b, 0xa6, 0x7e, 0x40, 0x80, 0xec, 0xc0, 0x86, 0x49,
-	0x4f, 0x15, 0x1f, 0x7a, 0x80, 0xc9, 0x9a, 0x60, 0xea, 0xb9, 0xe2, 0x43, 0x72, 0x07, 0xca, 0x7a,
-	0xe6, 0x35, 0x5a, 0x4e, 0xbb, 0xb1, 0xb7, 0x1d, 0x5a, 0xda, 0xc3, 0x0b, 0xda, 0xc3, 0x87, 0xe9,
-	0x9c, 0x96, 0xf5, 0xcc, 0x30, 0xa5, 0xe3, 0x84, 0x2b, 0xcd, 0x92, 0x89, 0xb7, 0x69, 0x99, 0x5a,
-	0x02, 0xfb, 0xd5, 0x5f, 0x7e, 0xf7, 0x4b, 0xc1, 0x6f, 0x0e, 0xbc, 0x77, 0x79, 0x62, 0x72, 0x1b,
-	0xea, 0x89, 0x12, 0xbd, 0x38, 0x1d, 0xf2, 0x19, 0xea, 0x73, 0x9d, 0x6e, 0x24, 0x4a, 0x1c, 0x98,
-	0x98, 0xbc, 0x0f, 0x15, 0xc3, 0x19, 0xca, 0x43, 0xcd, 0x92, 0x1c, 0x82, 0xcb, 0x8f, 0x78, 0xaa,
-	0x95, 0x57, 0x41, 0xca, 0xee, 0xae, 0xa7, 0xec, 0x50, 0x67, 0x71, 0x2a, 0xbe, 0x35, 0xd5, 0xd1,
-	0x76, 0xce, 0xd7, 0x66, 0x01, 0x54, 0x34, 0x6f, 0xb5, 0x5f, 0xfd, 0xf9, 0xaf, 0x96, 0x13, 0x64,
-	0xd0, 0x28, 0x64, 0x0d, 0x87, 0xc6, 0x6e, 0x38, 0x53, 0x9d, 0xe2, 0x9a, 0x1c, 0x00, 0x30, 0xad,
-	0xb3, 0xb8, 0x3f, 0xd5, 0x5c, 0x79, 0x65, 0x9c, 0x60, 0xf7, 0x0a, 0xd1, 0x2e, 0x6a, 0xa3, 0xaa,
-	0x39, 0x9f, 0x16, 0x36, 0xe7, 0x67, 0xde, 0x87, 0xfa, 0xb2, 0xc8, 0xdc, 0xf6, 0x25, 0x9f, 0xe7,
-	0x07, 0x9a, 0x25, 0xd9, 0x86, 0x6b, 0x47, 0x6c, 0x3c, 0xe5, 0x39, 0x03, 0x36, 0x08, 0x24, 0xd4,
-	0xbe, 0x63, 0xea, 0xc0, 0x88, 0xfa, 0xe0, 0x92, 0xa8, 0x66, 0x67, 0x35, 0xfa, 0xe8, 0x7c, 0xe1,
-	0x7f, 0x30, 0x67, 0xc9, 0x78, 0x3f, 0x58, 0xe5, 0x82, 0xa2, 0xd6, 0x61, 0x41, 0xeb, 0x32, 0xee,
-	0xf9, 0xf0, 0x7c, 0xe1, 0x6f, 0xad, 0xf6, 0x98, 0x4c, 0xb0, 0x34, 0x40, 0xf0, 0x13, 0xb8, 0x94,
-	0xab, 0xe9, 0x58, 0x2f, 0xcd, 0x6d, 0x4e, 0xda, 0xcc, 0xcd, 0xfd, 0xae, 0x48, 0x0f, 0xfe, 0x27,
-	0xd2, 0x8d, 0x70, 0xf5, 0x47, 0xb6, 0x0c, 0x59, 0x55, 0x2c, 0x2b, 0x4b, 0x15, 0xd0, 0x22, 0xaf,
-	0x1c, 0x20, 0x87, 0x71, 0x32, 0x1d, 0x33, 0x1d, 0xcb, 0x74, 0xf9, 0x1f, 0x7e, 0x6c, 0x47, 0x46,
-	0x57, 0x3b, 0xe8, 0xc4, 0x4f, 0xd7, 0xf3, 0x9e, 0xb3, 0x13, 0x6d, 0x98, 0xfe, 0x27, 0x0b, 0xdf,
-	0xc1, 0xab, 0x20, 0x61, 0x5f, 0x82, 0x9b, 0xe1, 0x55, 0x70, 0xde, 0xc6, 0x5e, 0x6b, 0x7d, 0x17,
-	0x7b, 0x65, 0x9a, 0xd7, 0x07, 0x5f, 0x41, 0xed, 0x99, 0x12, 0xdf, 0x98, 0x1b, 0xef, 0x80, 0xb1,
-	0x68, 0xaf, 0x60, 0x8f, 0x5a, 0xa2, 0x44, 0xd7, 0x38, 0xe4, 0x82, 0xa0, 0xf2, 0x8a, 0xa0, 0x5c,
-	0xea, 0x27, 0x50, 0xef, 0xce, 0x2e, 0x3a, 0x7c, 0xbe, 0xe4, 0xb1, 0x72, 0xf5, 0x55, 0xf2, 0x0d,
-	0x97, 0x3a, 0xfd, 0x59, 0x86, 0xad, 0x43, 0xce, 0xb2, 0xc1, 0xa8, 0x3b, 0x53, 0xb9, 0x30, 0x8f,
-	0xa1, 0xa1, 0xa5, 0x66, 0xe3, 0xde, 0x40, 0x4e, 0x53, 0x9d, 0x3b, 0xe1, 0xee, 0xdb, 0x85, 0x5f,
-	0x84, 0xcf, 0x17, 0x3e, 0xb1, 0x22, 0x17, 0xc0, 0x80, 0x02, 0x46, 0x8f, 0x4c, 0x60, 0x1c, 0x67,
-	0x3b, 0xa0, 0x2f, 0xa8, 0x0d, 0x4c, 0xf7, 0x09, 0x13, 0xbc, 0x97, 0x4e, 0x93, 0x3e, 0xcf, 0xf0,
-	0x1d, 0xcc, 0xbb, 0x17, 0xe0, 0x55, 0xf7, 0x02, 0x18, 0x50, 0x30, 0xd1, 0xf7, 0x18, 0x90, 0x08,
-	0x30, 0xea, 0xe1, 0x81, 0xf8, 0x6a, 0x56, 0xa3, 0xdd, 0xb7, 0x0b, 0xbf, 0x80, 0xae, 0xcc, 0xbb,
-	0xc2, 0x02, 0x5a, 0x37, 0x41, 0xd7, 0xac, 0xcd, 0x84, 0xe3, 0x38, 0x89, 0x35, 0x3e, 0xb0, 0x55,
-	0x6a, 0x03, 0xf2, 0x05, 0x54, 0xf4, 0x4c, 0x79, 0x2e, 0xf2, 0x79, 0x67, 0x3d, 0x9f, 0xab, 0xcf,
-	0x02, 0x35, 0x1b, 0x2c, 0xa3, 0xd1, 0xd7, 0x6f, 0xfe, 0x6d, 0x96, 0x5e, 0x9f, 0x36, 0x9d, 0x93,
-	0xd3, 0xa6, 0xf3, 0xcf, 0x69, 0xd3, 0xf9, 0xf5, 0xac, 0x59, 0x3a, 0x39, 0x6b, 0x96, 0xde, 0x9c,
-	0x35, 0x4b, 0x3f, 0x06, 0x22, 0xd6, 0xa3, 0x69, 0x3f, 0x1c, 0xc8, 0xa4, 0x93, 0x7f, 0xe6, 0xec,
-	0xcf, 0x67, 0x6a, 0xf8, 0xd2, 0x7e, 0x93, 0xfa, 0x2e, 0xbe, 0x87, 0xf7, 0xff, 0x0b, 0x00, 0x00,
-	0xff, 0xff, 0xd8, 0xa9, 0x21, 0xb7, 0x08, 0x07, 0x00, 0x00,
+	// 1048 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0xbf, 0x6f, 0xdb, 0xc6,
+	0x17, 0x17, 0x25, 0x45, 0xb2, 0x9e, 0xe2, 0x38, 0x61, 0xfc, 0x4d, 0xe8, 0xe4, 0x5b, 0x51, 0xa5,
+	0x93, 0x42, 0x4b, 0x29, 0xc4, 0x49, 0x8b, 0xc2, 0x43, 0xd1, 0x28, 0x69, 0x1a, 0x03, 0x71, 0x0a,
+	0x9c, 0x15, 0x14, 0xe8, 0x50, 0xe2, 0x24, 0x9e, 0x29, 0xd6, 0x24, 0x4f, 0xe0, 0x1d, 0x6d, 0x7a,
+	0xeb, 0xd8, 0xb1, 0x53, 0x50, 0x74, 0xca, 0xdc, 0xbf, 0x24, 0x5b, 0x3d, 0x66, 0x28, 0xd4, 0xd6,
+	0xde, 0x32, 0x74, 0xf0, 0x5f, 0x50, 0xdc, 0x0f, 0x89, 0x74, 0x0a, 0xa5, 0x93, 0xee, 0x7d, 0xde,
+	0xbb, 0x77, 0xef, 0x7d, 0xde, 0xe7, 0x78, 0x82, 0xcd, 0x31, 0x65, 0x31, 0x65, 0xfd, 0x11, 0x66,
+	0xa4, 0x8f, 0x47, 0xe3, 0xb0, 0x7f, 0x78, 0x6f, 0x44, 0x38, 0xbe, 0x27, 0x0d, 0x77, 0x9a, 0x52,
+	0x4e, 0x4d, 0x4b, 0x05, 0xb9, 0x22, 0xc8, 0x95, 0xb8, 0x0e, 0xba, 0xb5, 0x1e, 0xd0, 0x80, 0xca,
+	0xa0, 0xbe, 0x58, 0xa9, 0xf8, 0x5b, 0xb7, 0x39, 0x49, 0x7c, 0x92, 0xc6, 0x61, 0xc2, 0x55, 0x4e,
+	0x7e, 0x3c, 0x25, 0x4c, 0x3b, 0x37, 0x02, 0x4a, 0x83, 0x88, 0xf4, 0xa5, 0x35, 0xca, 0xf6, 0xfb,
+	0x38, 0x39, 0x56, 0x2e, 0xe7, 0x65, 0x0d, 0x60, 0x98, 0x23, 0xc2, 0xa6, 0x34, 0x61, 0xc4, 0xbc,
+	0x01, 0x8d, 0x09, 0x09, 0x83, 0x09, 0xb7, 0x8c, 0xae, 0xd1, 0xab, 0x21, 0x6d, 0x99, 0x0e, 0x34,
+	0x78, 0x3e, 0xc1, 0x6c, 0x62, 0x55, 0xbb, 0x46, 0xaf, 0x35, 0x80, 0xd3, 0x99, 0xdd, 0x18, 0xe6,
+	0x4f, 0x31, 0x9b, 0x20, 0xed, 0x31, 0xff, 0x0f, 0xad, 0x31, 0xf5, 0x09, 0x9b, 0xe2, 0x31, 0xb1,
+	0x6a, 0x22, 0x0c, 0x15, 0x80, 0x69, 0x42, 0x5d, 0x18, 0x56, 0xbd, 0x6b, 0xf4, 0x56, 0x91, 0x5c,
+	0x0b, 0xcc, 0xc7, 0x1c, 0x5b, 0x97, 0x64, 0xb0, 0x5c, 0x9b, 0x37, 0xa1, 0x99, 0xe2, 0x23, 0x2f,
+	0xa2, 0x81, 0xd5, 0x90, 0x70, 0x23, 0xc5, 0x47, 0xcf, 0x68, 0x60, 0xbe, 0x80, 0x7a, 0x44, 0x03,
+	0x66, 0x35, 0xbb, 0xb5, 0x5e, 0x7b, 0xab, 0xe7, 0x2e, 0x23, 0xc8, 0x7d, 0x38, 0x78, 0xb4, 0xb3,
+	0x4b, 0x18, 0xc3, 0x01, 0x79, 0x46, 0x83, 0xc1, 0xcd, 0xd7, 0x33, 0xbb, 0xf2, 0xeb, 0x1f, 0xf6,
+	0xda, 0x45, 0x9c, 0x21, 0x99, 0x4e, 0xd4, 0x10, 0x26, 0xfb, 0xd4, 0x5a, 0x51, 0x35, 0x88, 0xb5,
+	0xf9, 0x01, 0x40, 0x80, 0x99, 0x77, 0x84, 0x13, 0x4e, 0x7c, 0xab, 0x25, 0x99, 0x68, 0x05, 0x98,
+	0x7d, 0x23, 0x01, 0x73, 0x03, 0x56, 0x84, 0x3b, 0x63, 0xc4, 0xb7, 0x40, 0x3a, 0x9b, 0x01, 0x66,
+	0x2f, 0x18, 0xf1, 0xcd, 0x3b, 0x50, 0xe5, 0xb9, 0xd5, 0xee, 0x1a, 0xbd, 0xf6, 0xd6, 0xba, 0xab,
+	0x68, 0x77, 0xe7, 0xb4, 0xbb, 0x0f, 0x93, 0x63, 0x54, 0xe5, 0xb9, 0x60, 0x8a, 0x87, 0x31, 0x61,
+	0x1c, 0xc7, 0x53, 0xeb, 0xb2, 0x62, 0x6a, 0x01, 0x6c, 0xd7, 0x7f, 0x7c, 0x65, 0x57, 0x9c, 0x5f,
+	0x0c, 0xb8, 0x72, 0xb1, 0x62, 0xf3, 0x36, 0xb4, 0x62, 0x16, 0x78, 0x61, 0xe2, 0x93, 0x5c, 0xce,
+	0x67, 0x15, 0xad, 0xc4, 0x2c, 0xd8, 0x11, 0xb6, 0x79, 0x15, 0x6a, 0x82, 0x33, 0x39, 0x1e, 0x24,
+	0x96, 0xe6, 0x1e, 0x34, 0xc8, 0x21, 0x49, 0x38, 0xb3, 0x6a, 0x92, 0xb2, 0xbb, 0xcb, 0x29, 0xdb,
+	0xe3, 0x69, 0x98, 0x04, 0x5f, 0x8a, 0xe8, 0xc1, 0xba, 0xe6, 0xeb, 0x72, 0x09, 0x64, 0x48, 0xa7,
+	0xda, 0xae, 0xff, 0xf0, 0x7b, 0xd7, 0x70, 0x52, 0x68, 0x97, 0xbc, 0x82, 0x43, 0x21, 0x37, 0x59,
+	0x53, 0x0b, 0xc9, 0xb5, 0xb9, 0x03, 0x80, 0x39, 0x4f, 0xc3, 0x51, 0xc6, 0x09, 0xb3, 0xaa, 0xb2,
+	0x82, 0xcd, 0xf7, 0x0c, 0x6d, 0x1e, 0x3b, 0xa8, 0x8b, 0xf3, 0x51, 0x69, 0xb3, 0x3e, 0xf3, 0x3e,
+	0xb4, 0x16, 0x41, 0xa2, 0xdb, 0x03, 0x72, 0xac, 0x0f, 0x14, 0x4b, 0x73, 0x1d, 0x2e, 0x1d, 0xe2,
+	0x28, 0x23, 0x9a, 0x01, 0x65, 0x38, 0x14, 0x9a, 0x5f, 0x61, 0xb6, 0x23, 0x86, 0xfa, 0xe0, 0xc2,
+	0x50, 0xc5, 0xce, 0xfa, 0xe0, 0x7f, 0xe7, 0x33, 0xfb, 0xda, 0x31, 0x8e, 0xa3, 0x6d, 0xa7, 0xf0,
+	0x39, 0xe5, 0x59, 0xbb, 0xa5, 0x59, 0x57, 0xe5, 0x9e, 0xeb, 0xe7, 0x33, 0x7b, 0xad, 0xd8, 0x23,
+	0x3c, 0xce, 0x42, 0x00, 0xce, 0xf7, 0xd0, 0x40, 0x84, 0x65, 0x11, 0x5f, 0x88, 0x5b, 0x9c, 0x74,
+	0x59, 0x8b, 0xfb, 0xdf, 0x43, 0x7a, 0xf0, 0xce, 0x90, 0x6e, 0xb8, 0xc5, 0x45, 0x56, 0x0c, 0xa9,
+	0xa9, 0x28, 0x56, 0x16, 0x53, 0x90, 0x12, 0x79, 0x69, 0x80, 0xb9, 0x17, 0xc6, 0x59, 0x84, 0x79,
+	0x48, 0x93, 0xc5, 0x1d, 0x7e, 0xa2, 0x4a, 0x96, 0xaa, 0x36, 0xa4, 0x12, 0x3f, 0x5c, 0xce, 0xbb,
+	0x66, 0x67, 0xb0, 0x22, 0xf2, 0x9f, 0xcc, 0x6c, 0x43, 0xb6, 0x22, 0x09, 0xfb, 0x0c, 0x1a, 0xa9,
+	0x6c, 0x45, 0xd6, 0xdb, 0xde, 0xea, 0x2e, 0xcf, 0xa2, 0x5a, 0x46, 0x3a, 0xde, 0xf9, 0x1c, 0x9a,
+	0xbb, 0x2c, 0x78, 0x2c, 0x3a, 0xde, 0x00, 0x21, 0x51, 0xaf, 0x24, 0x8f, 0x66, 0xcc, 0x82, 0xa1,
+	0x50, 0xc8, 0x9c, 0xa0, 0x6a, 0x41, 0x90, 0x1e, 0xf5, 0x53, 0x68, 0x0d, 0xf3, 0x79, 0x86, 0x4f,
+	0x16, 0x3c, 0xd6, 0xde, 0xdf, 0x8a, 0xde, 0x70, 0x21, 0xd3, 0x6f, 0x55, 0x58, 0xdb, 0x23, 0x38,
+	0x1d, 0x4f, 0x86, 0x39, 0xd3, 0x83, 0x79, 0x02, 0x6d, 0x4e, 0x39, 0x8e, 0xbc, 0x31, 0xcd, 0x12,
+	0xae, 0x95, 0x70, 0xf7, 0xed, 0xcc, 0x2e, 0xc3, 0xe7, 0x33, 0xdb, 0x54, 0x43, 0x2e, 0x81, 0x0e,
+	0x02, 0x69, 0x3d, 0x12, 0x86, 0x50, 0x9c, 0xca, 0x20, 0x75, 0x81, 0x94, 0x21, 0xb2, 0x4f, 0x71,
+	0x40, 0xbc, 0x24, 0x8b, 0x47, 0x24, 0xb5, 0x6a, 0x45, 0xf6, 0x12, 0x5c, 0x64, 0x2f, 0x81, 0x0e,
+	0x02, 0x61, 0x3d, 0x97, 0x86, 0x39, 0x00, 0x69, 0x79, 0xf2, 0x40, 0xf9, 0xd5, 0xac, 0x0f, 0x36,
+	0xdf, 0xce, 0xec, 0x12, 0x5a, 0x88, 0xb7, 0xc0, 0x1c, 0xd4, 0x12, 0xc6, 0x50, 0xac, 0x45, 0x85,
+	0x51, 0x18, 0x87, 0x5c, 0x7e, 0x60, 0xeb, 0x48, 0x19, 0xe6, 0xa7, 0x50, 0xe3, 0x39, 0xb3, 0x1a,
+	0x92, 0xcf, 0x3b, 0xcb, 0xf9, 0x2c, 0x9e, 0x05, 0x24, 0x36, 0x68, 0x46, 0xff, 0x36, 0xe0, 0xaa,
+	0x92, 0x64, 0x44, 0xc7, 0x07, 0x88, 0x4c, 0x69, 0xca, 0x97, 0x3e, 0x1b, 0x1b, 0xef, 0xde, 0x9e,
+	0xe2, 0x4b, 0xb9, 0x01, 0x2b, 0x3c, 0xd7, 0x23, 0xa8, 0x29, 0x17, 0xcf, 0x15, 0xb1, 0x1f, 0xc1,
+	0xda, 0x3e, 0x0e, 0x23, 0xe2, 0x7b, 0x8b, 0x08, 0xd9, 0x3f, 0x5a, 0x55, 0xf0, 0x50, 0xc7, 0x7d,
+	0x07, 0xd7, 0x49, 0xe2, 0x7b, 0x23, 0x51, 0x88, 0xe7, 0x67, 0xa9, 0xbc, 0x06, 0xcc, 0xba, 0xf4,
+	0x5f, 0x0f, 0xc4, 0x2e, 0xf5, 0xb3, 0x88, 0x3c, 0xd6, 0x1b, 0xf4, 0xd5, 0xba, 0x46, 0x12, 0x5f,
+	0xb6, 0x34, 0xc7, 0xd9, 0x76, 0xfd, 0xe7, 0x57, 0xb6, 0xe1, 0x7c, 0x0d, 0x57, 0x2e, 0x6e, 0x10,
+	0xdd, 0xc6, 0x12, 0xd1, 0x8a, 0xd6, 0x96, 0x69, 0x43, 0x7b, 0x5e, 0x85, 0x97, 0x30, 0xd9, 0x70,
+	0x0d, 0xc1, 0x1c, 0x7a, 0xae, 0x13, 0x0e, 0xbe, 0x78, 0xf3, 0x57, 0xa7, 0xf2, 0xfa, 0xb4, 0x63,
+	0x9c, 0x9c, 0x76, 0x8c, 0x3f, 0x4f, 0x3b, 0xc6, 0x4f, 0x67, 0x9d, 0xca, 0xc9, 0x59, 0xa7, 0xf2,
+	0xe6, 0xac, 0x53, 0xf9, 0xd6, 0x09, 0x42, 0x3e, 0xc9, 0x46, 0xee, 0x98, 0xc6, 0x7d, 0xfd, 0x47,
+	0x41, 0xfd, 0x7c, 0xcc, 0xfc, 0x03, 0xf5, 0xaa, 0x8f, 0x1a, 0xf2, 0x45, 0xb9, 0xff, 0xcf, 0x00,
+	0x3c, 0x80, 0xb2, 0x9a, 0x4a, 0x08, 0x00, 0x00,
 }
 
 func (m *TxResponse) Marshal() (dAtA []byte, err error) {
@@ -1176,6 +1325,98 @@ func (m *SearchTxsResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBlockReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlockReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlockReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EndBlockDurations) > 0 {
+		for iNdEx := len(m.EndBlockDurations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EndBlockDurations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAbci(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.FailedTxCount != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.FailedTxCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleDuration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleDuration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleDuration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DurationNs != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.DurationNs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAbci(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAbci(v)
 	base := offset
@@ -1415,6 +1656,49 @@ func (m *SearchTxsResult) Size() (n int) {
 	return n
 }
 
+func (m *EventBlockReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovAbci(uint64(m.Height))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovAbci(uint64(m.GasUsed))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovAbci(uint64(m.TxCount))
+	}
+	if m.FailedTxCount != 0 {
+		n += 1 + sovAbci(uint64(m.FailedTxCount))
+	}
+	if len(m.EndBlockDurations) > 0 {
+		for _, e := range m.EndBlockDurations {
+			l = e.Size()
+			n += 1 + l + sovAbci(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleDuration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	if m.DurationNs != 0 {
+		n += 1 + sovAbci(uint64(m.DurationNs))
+	}
+	return n
+}
+
 func sovAbci(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2997,6 +3281,267 @@ func (m *SearchTxsResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventBlockReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAbci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBlockReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBlockReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedTxCount", wireType)
+			}
+			m.FailedTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedTxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlockDurations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndBlockDurations = append(m.EndBlockDurations, ModuleDuration{})
+			if err := m.EndBlockDurations[len(m.EndBlockDurations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAbci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleDuration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAbci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleDuration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleDuration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationNs", wireType)
+			}
+			m.DurationNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAbci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAbci(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
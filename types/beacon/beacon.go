@@ -0,0 +1,185 @@
+// Package beacon provides deterministic pseudo-randomness derived from block
+// entropy, so that modules sampling validators, minting random NFTs, etc. get
+// the same values on every node without rolling their own scheme.
+//
+// A Beacon is seeded with the hash of the current block, or of the previous
+// block before BeginBlock, the block height and a salt, and produces a stream
+// of bytes by hashing the seed with a counter (SHA-256 in counter mode). Every
+// beacon created in a block with the same salt yields the same stream, so each
+// module and purpose must use its own salt, e.g. "nft/mint", and derive
+// sub-beacons with Derive to draw independent values, e.g. one per
+// transaction.
+//
+// Security caveats:
+//
+// - The values are predictable by anyone knowing the block, in particular by
+//   the block proposer before it proposes the block, and by all the nodes
+//   before the transactions of the block are executed. They must not be used
+//   as secrets nor to pick winners that can front-run the draw.
+// - The proposer can bias the values by choosing the transactions, the time or
+//   the other fields of its block, or by not proposing it at all. The beacon is
+//   only suitable when the value drawn is worth less than a block reward.
+//   Lotteries and other high-value draws need a commit-reveal scheme, a
+//   verifiable random function or an external randomness oracle.
+// - The values are only uniform if the block hash is, and they are not
+//   cryptographically secure randomness.
+package beacon
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// domain separates the beacon seeds from the other hashes of block data.
+const domain = "cosmos-sdk/beacon"
+
+// Beacon is a deterministic stream of pseudo-random bytes. It is not safe for
+// concurrent use.
+type Beacon struct {
+	seed    [sha256.Size]byte
+	counter uint64
+	buf     []byte
+}
+
+// New returns the beacon of a block for a salt. It is seeded with the hash of
+// the current block once BeginBlock has been called, and with the hash of the
+// previous block otherwise.
+func New(ctx sdk.Context, salt string) *Beacon {
+	hash := ctx.HeaderHash()
+	if len(hash) == 0 {
+		hash = ctx.BlockHeader().LastBlockId.Hash
+	}
+
+	h := sha256.New()
+	h.Write([]byte(domain))
+	writeLengthPrefixed(h, hash)
+	height := make([]byte, 8)
+	binary.BigEndian.PutUint64(height, uint64(ctx.BlockHeight()))
+	h.Write(height)
+	writeLengthPrefixed(h, []byte(salt))
+
+	return NewFromSeed(h.Sum(nil))
+}
+
+// NewFromSeed returns a beacon seeded with arbitrary entropy, e.g. the outcome
+// of a commit-reveal scheme.
+func NewFromSeed(seed []byte) *Beacon {
+	return &Beacon{seed: sha256.Sum256(seed)}
+}
+
+// Derive returns a beacon independent from this one and from the beacons
+// derived with other labels. It doesn't consume the stream of this beacon.
+func (b *Beacon) Derive(label string) *Beacon {
+	h := sha256.New()
+	h.Write(b.seed[:])
+	writeLengthPrefixed(h, []byte(label))
+
+	return &Beacon{seed: sha256.Sum256(h.Sum(nil))}
+}
+
+// Read implements io.Reader, filling p with the next bytes of the stream. It
+// never fails.
+func (b *Beacon) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if len(b.buf) == 0 {
+			b.refill()
+		}
+
+		copied := copy(p[n:], b.buf)
+		b.buf = b.buf[copied:]
+		n += copied
+	}
+
+	return n, nil
+}
+
+// Uint64 returns the next 8 bytes of the stream as an uint64.
+func (b *Beacon) Uint64() uint64 {
+	bz := make([]byte, 8)
+	_, _ = b.Read(bz)
+	return binary.BigEndian.Uint64(bz)
+}
+
+// Uint64n returns a uniform value in [0, n). It panics if n is zero.
+func (b *Beacon) Uint64n(n uint64) uint64 {
+	if n == 0 {
+		panic("beacon: invalid argument to Uint64n")
+	}
+
+	// reject the values of the incomplete last range to avoid modulo bias
+	limit := ^uint64(0) - (^uint64(0)%n+1)%n
+	for {
+		if v := b.Uint64(); v <= limit {
+			return v % n
+		}
+	}
+}
+
+// Intn returns a uniform value in [0, n). It panics if n is not positive.
+func (b *Beacon) Intn(n int) int {
+	if n <= 0 {
+		panic("beacon: invalid argument to Intn")
+	}
+
+	return int(b.Uint64n(uint64(n)))
+}
+
+// Shuffle pseudo-randomizes the order of n elements with the Fisher-Yates
+// algorithm, swap swapping the elements with indexes i and j.
+func (b *Beacon) Shuffle(n int, swap func(i, j int)) {
+	if n < 0 {
+		panic("beacon: invalid argument to Shuffle")
+	}
+
+	for i := n - 1; i > 0; i-- {
+		swap(i, b.Intn(i+1))
+	}
+}
+
+// WeightedIndex returns an index of weights with a probability proportional to
+// its weight, e.g. to sample a validator by voting power. It returns an error
+// if the weights are all zero or their sum overflows.
+func (b *Beacon) WeightedIndex(weights []uint64) (int, error) {
+	var total uint64
+	for _, w := range weights {
+		if total+w < total {
+			return 0, fmt.Errorf("beacon: sum of the weights overflows")
+		}
+		total += w
+	}
+	if total == 0 {
+		return 0, fmt.Errorf("beacon: weights must not all be zero")
+	}
+
+	v := b.Uint64n(total)
+	for i, w := range weights {
+		if v < w {
+			return i, nil
+		}
+		v -= w
+	}
+
+	panic("beacon: unreachable")
+}
+
+func (b *Beacon) refill() {
+	block := make([]byte, len(b.seed)+8)
+	copy(block, b.seed[:])
+	binary.BigEndian.PutUint64(block[len(b.seed):], b.counter)
+	b.counter++
+
+	sum := sha256.Sum256(block)
+	b.buf = sum[:]
+}
+
+func writeLengthPrefixed(w io.Writer, bz []byte) {
+	length := make([]byte, 8)
+	binary.BigEndian.PutUint64(length, uint64(len(bz)))
+	_, _ = w.Write(length)
+	_, _ = w.Write(bz)
+}
@@ -0,0 +1,120 @@
+package beacon_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/beacon"
+)
+
+func newContext(height int64, hash []byte) sdk.Context {
+	return sdk.Context{}.
+		WithBlockHeader(tmproto.Header{Height: height, LastBlockId: tmproto.BlockID{Hash: []byte("last block hash")}}).
+		WithHeaderHash(hash)
+}
+
+func TestNewIsDeterministic(t *testing.T) {
+	ctx := newContext(10, []byte("block hash"))
+
+	b1, b2 := beacon.New(ctx, "nft/mint"), beacon.New(ctx, "nft/mint")
+	require.Equal(t, b1.Uint64(), b2.Uint64())
+	require.Equal(t, b1.Uint64(), b2.Uint64())
+
+	first := beacon.New(ctx, "nft/mint").Uint64()
+	require.NotEqual(t, first, beacon.New(ctx, "validators/sample").Uint64())
+	require.NotEqual(t, first, beacon.New(newContext(11, []byte("block hash")), "nft/mint").Uint64())
+	require.NotEqual(t, first, beacon.New(newContext(10, []byte("other hash")), "nft/mint").Uint64())
+
+	// the hash of the previous block is used before BeginBlock
+	require.NotEqual(t, first, beacon.New(newContext(10, nil), "nft/mint").Uint64())
+	require.Equal(t,
+		beacon.New(newContext(10, nil), "nft/mint").Uint64(),
+		beacon.New(newContext(10, nil), "nft/mint").Uint64(),
+	)
+}
+
+func TestDerive(t *testing.T) {
+	b := beacon.NewFromSeed([]byte("seed"))
+	d1, d2 := b.Derive("tx/1"), b.Derive("tx/2")
+	require.NotEqual(t, d1.Uint64(), d2.Uint64())
+
+	// deriving doesn't consume the stream of the parent beacon
+	require.Equal(t, beacon.NewFromSeed([]byte("seed")).Uint64(), b.Uint64())
+	require.Equal(t, beacon.NewFromSeed([]byte("seed")).Derive("tx/1").Uint64(), b.Derive("tx/1").Uint64())
+}
+
+func TestRead(t *testing.T) {
+	b1, b2 := beacon.NewFromSeed([]byte("seed")), beacon.NewFromSeed([]byte("seed"))
+
+	// reading in chunks yields the same stream
+	whole := make([]byte, 100)
+	n, err := b1.Read(whole)
+	require.NoError(t, err)
+	require.Equal(t, 100, n)
+
+	var chunks []byte
+	for _, size := range []int{7, 32, 1, 60} {
+		chunk := make([]byte, size)
+		_, err := b2.Read(chunk)
+		require.NoError(t, err)
+		chunks = append(chunks, chunk...)
+	}
+	require.Equal(t, whole, chunks)
+}
+
+func TestIntn(t *testing.T) {
+	b := beacon.NewFromSeed([]byte("seed"))
+
+	counts := make([]int, 4)
+	for i := 0; i < 4000; i++ {
+		v := b.Intn(len(counts))
+		require.True(t, v >= 0 && v < len(counts))
+		counts[v]++
+	}
+	for _, count := range counts {
+		require.InDelta(t, 1000, count, 150)
+	}
+
+	require.Zero(t, b.Intn(1))
+	require.Panics(t, func() { b.Intn(0) })
+	require.Panics(t, func() { b.Uint64n(0) })
+}
+
+func TestShuffle(t *testing.T) {
+	shuffle := func() []int {
+		values := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+		beacon.NewFromSeed([]byte("seed")).Shuffle(len(values), func(i, j int) {
+			values[i], values[j] = values[j], values[i]
+		})
+		return values
+	}
+
+	shuffled := shuffle()
+	require.Equal(t, shuffled, shuffle())
+	require.ElementsMatch(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, shuffled)
+	require.NotEqual(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, shuffled)
+}
+
+func TestWeightedIndex(t *testing.T) {
+	b := beacon.NewFromSeed([]byte("seed"))
+
+	counts := make([]int, 3)
+	for i := 0; i < 4000; i++ {
+		idx, err := b.WeightedIndex([]uint64{1, 0, 3})
+		require.NoError(t, err)
+		counts[idx]++
+	}
+	require.InDelta(t, 1000, counts[0], 150)
+	require.Zero(t, counts[1])
+	require.InDelta(t, 3000, counts[2], 150)
+
+	_, err := b.WeightedIndex([]uint64{0, 0})
+	require.Error(t, err)
+	_, err = b.WeightedIndex(nil)
+	require.Error(t, err)
+	_, err = b.WeightedIndex([]uint64{^uint64(0), 1})
+	require.Error(t, err)
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+type blockReportKey struct{}
+
+// ContextWithBlockReport returns a copy of the context carrying the given
+// block report, in which the durations of the end blockers are recorded with
+// RecordEndBlockDuration.
+func ContextWithBlockReport(ctx Context, report *EventBlockReport) Context {
+	return ctx.WithContext(context.WithValue(ctx.Context(), blockReportKey{}, report))
+}
+
+// RecordEndBlockDuration records the duration of the end blocker of a module in
+// the block report carried by the context. Nothing is recorded if the context
+// carries no block report.
+func RecordEndBlockDuration(ctx Context, module string, d time.Duration) {
+	if ctx.Context() == nil {
+		return
+	}
+	report, ok := ctx.Context().Value(blockReportKey{}).(*EventBlockReport)
+	if !ok || report == nil {
+		return
+	}
+
+	report.EndBlockDurations = append(report.EndBlockDurations, ModuleDuration{
+		Module:     module,
+		DurationNs: d.Nanoseconds(),
+	})
+}
+
+// RecordTx adds the gas used by a delivered transaction to the block report,
+// counting it as failed if its result code is not OK.
+func (r *EventBlockReport) RecordTx(res abci.ResponseDeliverTx) {
+	r.TxCount++
+	if res.GasUsed > 0 {
+		r.GasUsed += uint64(res.GasUsed)
+	}
+	if res.Code != 0 {
+		r.FailedTxCount++
+	}
+}
@@ -0,0 +1,113 @@
+package collections
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IndexerFunc returns the index keys of a value. A value can have any number
+// of index keys, each of which must be at most address.MaxAddrLen bytes long.
+type IndexerFunc func(value codec.ProtoMarshaler) ([][]byte, error)
+
+// Index is a secondary index of a Map. For each index key of each value, it
+// stores an entry keyed by the length prefixed index key followed by the
+// primary key of the value.
+type Index struct {
+	storeKey sdk.StoreKey
+	prefix   []byte
+	indexer  IndexerFunc
+	unique   bool
+}
+
+// NewIndex creates an index stored under the given prefix, which must be
+// passed to NewMap.
+func NewIndex(prefix []byte, indexer IndexerFunc) *Index {
+	return &Index{prefix: prefix, indexer: indexer}
+}
+
+// NewUniqueIndex creates an index stored under the given prefix, which must be
+// passed to NewMap. An index key can only be used by a single value.
+func NewUniqueIndex(prefix []byte, indexer IndexerFunc) *Index {
+	return &Index{prefix: prefix, indexer: indexer, unique: true}
+}
+
+func (i *Index) store(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(i.storeKey), i.prefix)
+}
+
+// entryKey returns the key of the entry of the given index and primary keys.
+func (i *Index) entryKey(indexKey, primaryKey []byte) []byte {
+	return append(address.MustLengthPrefix(indexKey), primaryKey...)
+}
+
+// indexKeys returns the validated index keys of a value.
+func (i *Index) indexKeys(value codec.ProtoMarshaler) ([][]byte, error) {
+	indexKeys, err := i.indexer(value)
+	if err != nil {
+		return nil, err
+	}
+	for _, indexKey := range indexKeys {
+		if len(indexKey) == 0 || len(indexKey) > address.MaxAddrLen {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest,
+				"index key length must be between 1 and %d, got %d", address.MaxAddrLen, len(indexKey))
+		}
+	}
+	return indexKeys, nil
+}
+
+// checkUnique returns an ErrConflict error if the index is unique and any of
+// the given index keys is used by a value other than primaryKey.
+func (i *Index) checkUnique(ctx sdk.Context, primaryKey []byte, indexKeys [][]byte) error {
+	if !i.unique {
+		return nil
+	}
+	for _, indexKey := range indexKeys {
+		var conflict bool
+		i.Iterate(ctx, indexKey, func(key []byte) bool {
+			conflict = !bytes.Equal(key, primaryKey)
+			return conflict
+		})
+		if conflict {
+			return sdkerrors.Wrapf(sdkerrors.ErrConflict, "unique index key %X already exists", indexKey)
+		}
+	}
+	return nil
+}
+
+// Has returns whether any value has the given index key.
+func (i *Index) Has(ctx sdk.Context, indexKey []byte) bool {
+	var found bool
+	i.Iterate(ctx, indexKey, func([]byte) bool {
+		found = true
+		return true
+	})
+	return found
+}
+
+// Iterate calls cb for the primary keys of the values with the given index key
+// in primary key order, stopping when cb returns true.
+func (i *Index) Iterate(ctx sdk.Context, indexKey []byte, cb func(primaryKey []byte) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(i.store(ctx), address.MustLengthPrefix(indexKey))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(iterator.Key()[1+len(indexKey):]) {
+			break
+		}
+	}
+}
+
+// PrimaryKeys returns the primary keys of the values with the given index key.
+func (i *Index) PrimaryKeys(ctx sdk.Context, indexKey []byte) [][]byte {
+	var keys [][]byte
+	i.Iterate(ctx, indexKey, func(key []byte) bool {
+		keys = append(keys, key)
+		return false
+	})
+	return keys
+}
@@ -0,0 +1,216 @@
+// Package collections provides typed storage helpers for keepers: a Map of
+// protobuf values with automatically maintained secondary indexes, and a
+// Sequence of unique identifiers. Maps can be exported to and imported from
+// genesis, rebuilding their indexes on import.
+//
+// A Map stores its values under a store prefix, keyed by the primary key
+// derived from each value. Every Index of a Map stores an entry under its own
+// prefix for each of the index keys of a value, so that the primary keys of
+// the values with a given index key can be iterated without hand-rolled key
+// schemes. Prefixes of the maps, indexes and sequences of a store must not be
+// prefixes of each other.
+package collections
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// KeyFunc returns the primary key of a value.
+type KeyFunc func(value codec.ProtoMarshaler) []byte
+
+// Map is a mapping of primary keys to protobuf values of a single type, which
+// maintains its indexes on writes.
+type Map struct {
+	storeKey sdk.StoreKey
+	prefix   []byte
+	cdc      codec.BinaryCodec
+	newValue func() codec.ProtoMarshaler
+	keyFunc  KeyFunc
+	indexes  []*Index
+}
+
+// NewMap creates a map storing the values returned by newValue under the given
+// prefix, keyed by keyFunc. The given indexes are bound to the map and must not
+// be used by other maps. It panics on empty or overlapping prefixes.
+func NewMap(
+	storeKey sdk.StoreKey, prefix []byte, cdc codec.BinaryCodec,
+	newValue func() codec.ProtoMarshaler, keyFunc KeyFunc, indexes ...*Index,
+) Map {
+	prefixes := [][]byte{prefix}
+	for _, index := range indexes {
+		if index.storeKey != nil {
+			panic("collections: index is already bound to a map")
+		}
+		index.storeKey = storeKey
+		prefixes = append(prefixes, index.prefix)
+	}
+	for i, p := range prefixes {
+		if len(p) == 0 {
+			panic("collections: prefix cannot be empty")
+		}
+		for _, other := range prefixes[i+1:] {
+			if bytes.HasPrefix(p, other) || bytes.HasPrefix(other, p) {
+				panic("collections: prefixes cannot be prefixes of each other")
+			}
+		}
+	}
+
+	return Map{
+		storeKey: storeKey,
+		prefix:   prefix,
+		cdc:      cdc,
+		newValue: newValue,
+		keyFunc:  keyFunc,
+		indexes:  indexes,
+	}
+}
+
+func (m Map) store(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(m.storeKey), m.prefix)
+}
+
+// Has returns whether a value exists for the given primary key.
+func (m Map) Has(ctx sdk.Context, key []byte) bool {
+	return m.store(ctx).Has(key)
+}
+
+// Get unmarshals the value of the given primary key into value, returning an
+// ErrNotFound error if it does not exist.
+func (m Map) Get(ctx sdk.Context, key []byte, value codec.ProtoMarshaler) error {
+	bz := m.store(ctx).Get(key)
+	if bz == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "key %X", key)
+	}
+	return m.cdc.Unmarshal(bz, value)
+}
+
+// Set inserts or updates a value under its primary key, updating the entries
+// of all indexes. It returns an ErrConflict error without writing anything if
+// a unique index key of the value is already used by another value.
+func (m Map) Set(ctx sdk.Context, value codec.ProtoMarshaler) error {
+	key := m.keyFunc(value)
+	if len(key) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "primary key cannot be empty")
+	}
+
+	var oldValue codec.ProtoMarshaler
+	if bz := m.store(ctx).Get(key); bz != nil {
+		oldValue = m.newValue()
+		if err := m.cdc.Unmarshal(bz, oldValue); err != nil {
+			return err
+		}
+	}
+
+	oldIndexKeys := make([][][]byte, len(m.indexes))
+	newIndexKeys := make([][][]byte, len(m.indexes))
+	for i, index := range m.indexes {
+		var err error
+		if oldValue != nil {
+			if oldIndexKeys[i], err = index.indexKeys(oldValue); err != nil {
+				return err
+			}
+		}
+		if newIndexKeys[i], err = index.indexKeys(value); err != nil {
+			return err
+		}
+		if err := index.checkUnique(ctx, key, newIndexKeys[i]); err != nil {
+			return err
+		}
+	}
+
+	bz, err := m.cdc.Marshal(value)
+	if err != nil {
+		return err
+	}
+	m.store(ctx).Set(key, bz)
+
+	for i, index := range m.indexes {
+		for _, indexKey := range oldIndexKeys[i] {
+			if !containsKey(newIndexKeys[i], indexKey) {
+				index.store(ctx).Delete(index.entryKey(indexKey, key))
+			}
+		}
+		for _, indexKey := range newIndexKeys[i] {
+			index.store(ctx).Set(index.entryKey(indexKey, key), []byte{})
+		}
+	}
+	return nil
+}
+
+// Delete removes the value of the given primary key along with its index
+// entries, returning an ErrNotFound error if it does not exist.
+func (m Map) Delete(ctx sdk.Context, key []byte) error {
+	value := m.newValue()
+	if err := m.Get(ctx, key, value); err != nil {
+		return err
+	}
+
+	for _, index := range m.indexes {
+		indexKeys, err := index.indexKeys(value)
+		if err != nil {
+			return err
+		}
+		for _, indexKey := range indexKeys {
+			index.store(ctx).Delete(index.entryKey(indexKey, key))
+		}
+	}
+	m.store(ctx).Delete(key)
+	return nil
+}
+
+// Iterate calls cb for the values in primary key order, stopping when cb
+// returns true.
+func (m Map) Iterate(ctx sdk.Context, cb func(key []byte, value codec.ProtoMarshaler) (stop bool)) error {
+	iterator := m.store(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		value := m.newValue()
+		if err := m.cdc.Unmarshal(iterator.Value(), value); err != nil {
+			return err
+		}
+		if cb(iterator.Key(), value) {
+			break
+		}
+	}
+	return nil
+}
+
+// Export returns all values in primary key order, for genesis export.
+func (m Map) Export(ctx sdk.Context) ([]codec.ProtoMarshaler, error) {
+	var values []codec.ProtoMarshaler
+	err := m.Iterate(ctx, func(_ []byte, value codec.ProtoMarshaler) bool {
+		values = append(values, value)
+		return false
+	})
+	return values, err
+}
+
+// Import sets the given values, building their index entries, for genesis
+// import. It returns an error on duplicate primary keys.
+func (m Map) Import(ctx sdk.Context, values []codec.ProtoMarshaler) error {
+	for _, value := range values {
+		key := m.keyFunc(value)
+		if m.Has(ctx, key) {
+			return sdkerrors.Wrapf(sdkerrors.ErrConflict, "duplicate key %X", key)
+		}
+		if err := m.Set(ctx, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func containsKey(keys [][]byte, key []byte) bool {
+	for _, k := range keys {
+		if bytes.Equal(k, key) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+package collections_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// dogs are keyed by name and indexed by size, and by nickname initial if named "<initial>-...".
+func setupDogs() (sdk.Context, collections.Map, *collections.Index, *collections.Index) {
+	key := sdk.NewKVStoreKey("collections")
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_collections"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	bySize := collections.NewIndex([]byte{0x02}, func(value codec.ProtoMarshaler) ([][]byte, error) {
+		return [][]byte{[]byte(value.(*testdata.Dog).Size_)}, nil
+	})
+	byInitial := collections.NewUniqueIndex([]byte{0x03}, func(value codec.ProtoMarshaler) ([][]byte, error) {
+		name := value.(*testdata.Dog).Name
+		if len(name) < 2 || name[1] != '-' {
+			return nil, nil
+		}
+		return [][]byte{[]byte(name[:1])}, nil
+	})
+	dogs := collections.NewMap(key, []byte{0x01}, cdc,
+		func() codec.ProtoMarshaler { return &testdata.Dog{} },
+		func(value codec.ProtoMarshaler) []byte { return []byte(value.(*testdata.Dog).Name) },
+		bySize, byInitial,
+	)
+	return ctx, dogs, bySize, byInitial
+}
+
+func TestMap(t *testing.T) {
+	ctx, dogs, bySize, byInitial := setupDogs()
+
+	require.False(t, dogs.Has(ctx, []byte("rex")))
+	err := dogs.Get(ctx, []byte("rex"), &testdata.Dog{})
+	require.True(t, sdkerrors.ErrNotFound.Is(err))
+	require.Error(t, dogs.Set(ctx, &testdata.Dog{Size_: "big"}))
+
+	require.NoError(t, dogs.Set(ctx, &testdata.Dog{Name: "rex", Size_: "big"}))
+	require.NoError(t, dogs.Set(ctx, &testdata.Dog{Name: "a-fido", Size_: "small"}))
+	require.NoError(t, dogs.Set(ctx, &testdata.Dog{Name: "b-spot", Size_: "big"}))
+
+	var dog testdata.Dog
+	require.NoError(t, dogs.Get(ctx, []byte("rex"), &dog))
+	require.Equal(t, testdata.Dog{Name: "rex", Size_: "big"}, dog)
+	require.Equal(t, [][]byte{[]byte("b-spot"), []byte("rex")}, bySize.PrimaryKeys(ctx, []byte("big")))
+	require.Equal(t, [][]byte{[]byte("a-fido")}, bySize.PrimaryKeys(ctx, []byte("small")))
+	require.Equal(t, [][]byte{[]byte("b-spot")}, byInitial.PrimaryKeys(ctx, []byte("b")))
+
+	// updating a value moves its index entries
+	require.NoError(t, dogs.Set(ctx, &testdata.Dog{Name: "rex", Size_: "small"}))
+	require.Equal(t, [][]byte{[]byte("b-spot")}, bySize.PrimaryKeys(ctx, []byte("big")))
+	require.Equal(t, [][]byte{[]byte("a-fido"), []byte("rex")}, bySize.PrimaryKeys(ctx, []byte("small")))
+
+	// unique index keys cannot be reused by other values, but can be kept on updates
+	err = dogs.Set(ctx, &testdata.Dog{Name: "b-max", Size_: "big"})
+	require.True(t, sdkerrors.ErrConflict.Is(err))
+	require.False(t, dogs.Has(ctx, []byte("b-max")))
+	require.NoError(t, dogs.Set(ctx, &testdata.Dog{Name: "b-spot", Size_: "small"}))
+	require.False(t, bySize.Has(ctx, []byte("big")))
+
+	// deleting a value removes its index entries
+	require.NoError(t, dogs.Delete(ctx, []byte("b-spot")))
+	require.False(t, dogs.Has(ctx, []byte("b-spot")))
+	require.False(t, byInitial.Has(ctx, []byte("b")))
+	require.Equal(t, [][]byte{[]byte("a-fido"), []byte("rex")}, bySize.PrimaryKeys(ctx, []byte("small")))
+	require.True(t, sdkerrors.ErrNotFound.Is(dogs.Delete(ctx, []byte("b-spot"))))
+
+	var names []string
+	require.NoError(t, dogs.Iterate(ctx, func(key []byte, value codec.ProtoMarshaler) bool {
+		names = append(names, value.(*testdata.Dog).Name)
+		return true
+	}))
+	require.Equal(t, []string{"a-fido"}, names)
+}
+
+func TestMapExportImport(t *testing.T) {
+	ctx, dogs, bySize, _ := setupDogs()
+	require.NoError(t, dogs.Set(ctx, &testdata.Dog{Name: "rex", Size_: "big"}))
+	require.NoError(t, dogs.Set(ctx, &testdata.Dog{Name: "a-fido", Size_: "small"}))
+
+	exported, err := dogs.Export(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []codec.ProtoMarshaler{
+		&testdata.Dog{Name: "a-fido", Size_: "small"},
+		&testdata.Dog{Name: "rex", Size_: "big"},
+	}, exported)
+
+	ctx, dogs, bySize, byInitial := setupDogs()
+	require.NoError(t, dogs.Import(ctx, exported))
+	require.Equal(t, [][]byte{[]byte("rex")}, bySize.PrimaryKeys(ctx, []byte("big")))
+	require.True(t, byInitial.Has(ctx, []byte("a")))
+
+	err = dogs.Import(ctx, exported[:1])
+	require.True(t, sdkerrors.ErrConflict.Is(err))
+}
+
+func TestNewMapPanics(t *testing.T) {
+	key := sdk.NewKVStoreKey("collections")
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	newValue := func() codec.ProtoMarshaler { return &testdata.Dog{} }
+	keyFunc := func(value codec.ProtoMarshaler) []byte { return []byte(value.(*testdata.Dog).Name) }
+	indexer := func(codec.ProtoMarshaler) ([][]byte, error) { return nil, nil }
+
+	require.Panics(t, func() { collections.NewMap(key, nil, cdc, newValue, keyFunc) })
+	require.Panics(t, func() {
+		collections.NewMap(key, []byte{0x01}, cdc, newValue, keyFunc, collections.NewIndex([]byte{0x01, 0x02}, indexer))
+	})
+
+	index := collections.NewIndex([]byte{0x02}, indexer)
+	require.NotPanics(t, func() { collections.NewMap(key, []byte{0x01}, cdc, newValue, keyFunc, index) })
+	require.Panics(t, func() { collections.NewMap(key, []byte{0x03}, cdc, newValue, keyFunc, index) })
+}
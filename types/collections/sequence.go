@@ -0,0 +1,54 @@
+package collections
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Sequence is a persistent counter generating unique, increasing identifiers,
+// starting at 1.
+type Sequence struct {
+	storeKey sdk.StoreKey
+	key      []byte
+}
+
+// NewSequence creates a sequence stored under the given key.
+func NewSequence(storeKey sdk.StoreKey, key []byte) Sequence {
+	if len(key) == 0 {
+		panic("collections: sequence key cannot be empty")
+	}
+	return Sequence{storeKey: storeKey, key: key}
+}
+
+// NextVal increments the sequence and returns its new value.
+func (s Sequence) NextVal(ctx sdk.Context) uint64 {
+	v := s.CurVal(ctx) + 1
+	ctx.KVStore(s.storeKey).Set(s.key, sdk.Uint64ToBigEndian(v))
+	return v
+}
+
+// CurVal returns the current value of the sequence, which is 0 if NextVal was
+// never called.
+func (s Sequence) CurVal(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(s.storeKey).Get(s.key)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// PeekNextVal returns the value NextVal would return, without changing the
+// sequence.
+func (s Sequence) PeekNextVal(ctx sdk.Context) uint64 {
+	return s.CurVal(ctx) + 1
+}
+
+// InitVal sets the current value of an unused sequence, for genesis import.
+func (s Sequence) InitVal(ctx sdk.Context, v uint64) error {
+	store := ctx.KVStore(s.storeKey)
+	if store.Has(s.key) {
+		return sdkerrors.Wrap(sdkerrors.ErrConflict, "sequence already initialized")
+	}
+	store.Set(s.key, sdk.Uint64ToBigEndian(v))
+	return nil
+}
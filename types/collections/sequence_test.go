@@ -0,0 +1,32 @@
+package collections_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/collections"
+)
+
+func TestSequence(t *testing.T) {
+	key := sdk.NewKVStoreKey("collections")
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_collections"))
+	seq := collections.NewSequence(key, []byte{0x01})
+
+	require.EqualValues(t, 0, seq.CurVal(ctx))
+	require.EqualValues(t, 1, seq.PeekNextVal(ctx))
+	require.EqualValues(t, 1, seq.NextVal(ctx))
+	require.EqualValues(t, 2, seq.NextVal(ctx))
+	require.EqualValues(t, 2, seq.CurVal(ctx))
+	require.EqualValues(t, 3, seq.PeekNextVal(ctx))
+	require.Error(t, seq.InitVal(ctx, 10))
+
+	other := collections.NewSequence(key, []byte{0x02})
+	require.NoError(t, other.InitVal(ctx, 10))
+	require.EqualValues(t, 11, other.NextVal(ctx))
+	require.EqualValues(t, 2, seq.CurVal(ctx))
+
+	require.Panics(t, func() { collections.NewSequence(key, nil) })
+}
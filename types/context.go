@@ -33,6 +33,8 @@ type Context struct {
 	voteInfo      []abci.VoteInfo
 	gasMeter      GasMeter
 	blockGasMeter GasMeter
+	kvGasConfig   GasConfig
+	tkvGasConfig  GasConfig
 	checkTx       bool
 	recheckTx     bool // if recheckTx == true, then checkTx must also be true
 	minGasPrice   DecCoins
@@ -44,20 +46,22 @@ type Context struct {
 type Request = Context
 
 // Read-only accessors
-func (c Context) Context() context.Context    { return c.ctx }
-func (c Context) MultiStore() MultiStore      { return c.ms }
-func (c Context) BlockHeight() int64          { return c.header.Height }
-func (c Context) BlockTime() time.Time        { return c.header.Time }
-func (c Context) ChainID() string             { return c.chainID }
-func (c Context) TxBytes() []byte             { return c.txBytes }
-func (c Context) Logger() log.Logger          { return c.logger }
-func (c Context) VoteInfos() []abci.VoteInfo  { return c.voteInfo }
-func (c Context) GasMeter() GasMeter          { return c.gasMeter }
-func (c Context) BlockGasMeter() GasMeter     { return c.blockGasMeter }
-func (c Context) IsCheckTx() bool             { return c.checkTx }
-func (c Context) IsReCheckTx() bool           { return c.recheckTx }
-func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
-func (c Context) EventManager() *EventManager { return c.eventManager }
+func (c Context) Context() context.Context        { return c.ctx }
+func (c Context) MultiStore() MultiStore          { return c.ms }
+func (c Context) BlockHeight() int64              { return c.header.Height }
+func (c Context) BlockTime() time.Time            { return c.header.Time }
+func (c Context) ChainID() string                 { return c.chainID }
+func (c Context) TxBytes() []byte                 { return c.txBytes }
+func (c Context) Logger() log.Logger              { return c.logger }
+func (c Context) VoteInfos() []abci.VoteInfo      { return c.voteInfo }
+func (c Context) GasMeter() GasMeter              { return c.gasMeter }
+func (c Context) BlockGasMeter() GasMeter         { return c.blockGasMeter }
+func (c Context) KVGasConfig() GasConfig          { return c.kvGasConfig }
+func (c Context) TransientKVGasConfig() GasConfig { return c.tkvGasConfig }
+func (c Context) IsCheckTx() bool                 { return c.checkTx }
+func (c Context) IsReCheckTx() bool               { return c.recheckTx }
+func (c Context) MinGasPrices() DecCoins          { return c.minGasPrice }
+func (c Context) EventManager() *EventManager     { return c.eventManager }
 
 // clone the header before returning
 func (c Context) BlockHeader() tmproto.Header {
@@ -88,6 +92,8 @@ func NewContext(ms MultiStore, header tmproto.Header, isCheckTx bool, logger log
 		checkTx:      isCheckTx,
 		logger:       logger,
 		gasMeter:     stypes.NewInfiniteGasMeter(),
+		kvGasConfig:  stypes.KVGasConfig(),
+		tkvGasConfig: stypes.TransientGasConfig(),
 		minGasPrice:  DecCoins{},
 		eventManager: NewEventManager(),
 	}
@@ -174,6 +180,19 @@ func (c Context) WithGasMeter(meter GasMeter) Context {
 	return c
 }
 
+// WithKVGasConfig returns a Context with an updated gas config for KVStores.
+func (c Context) WithKVGasConfig(gasConfig GasConfig) Context {
+	c.kvGasConfig = gasConfig
+	return c
+}
+
+// WithTransientKVGasConfig returns a Context with an updated gas config for
+// transient KVStores.
+func (c Context) WithTransientKVGasConfig(gasConfig GasConfig) Context {
+	c.tkvGasConfig = gasConfig
+	return c
+}
+
 // WithBlockGasMeter returns a Context with an updated block GasMeter
 func (c Context) WithBlockGasMeter(meter GasMeter) Context {
 	c.blockGasMeter = meter
@@ -244,12 +263,12 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.KVGasConfig())
+	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), c.kvGasConfig)
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.TransientGasConfig())
+	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), c.tkvGasConfig)
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
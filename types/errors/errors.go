@@ -147,6 +147,15 @@ var (
 
 	// ErrAppConfig defines an error occurred if min-gas-prices field in BaseConfig is empty.
 	ErrAppConfig = Register(RootCodespace, 40, "error in app.toml")
+
+	// ErrNodeDraining defines an error occurred if a tx is submitted to a node
+	// draining ahead of maintenance.
+	ErrNodeDraining = Register(RootCodespace, 41, "node is draining")
+
+	// ErrUnregisteredAnyType defines an error when a protobuf Any holds a type
+	// which is not registered as an implementation of the interface it is
+	// unpacked to.
+	ErrUnregisteredAnyType = Register(RootCodespace, 42, "type not registered for interface")
 )
 
 // Register returns an error instance that should be used as the base for
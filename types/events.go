@@ -189,6 +189,16 @@ func (e Event) AppendAttributes(attrs ...Attribute) Event {
 	return e
 }
 
+// HasAttribute returns true if the event has an attribute with the given key.
+func (e Event) HasAttribute(key string) bool {
+	for _, attr := range e.Attributes {
+		if string(attr.Key) == key {
+			return true
+		}
+	}
+	return false
+}
+
 // AppendEvent adds an Event to a slice of events.
 func (e Events) AppendEvent(event Event) Events {
 	return append(e, event)
@@ -199,6 +209,22 @@ func (e Events) AppendEvents(events Events) Events {
 	return append(e, events...)
 }
 
+// WithAttributeIfMissing returns a copy of the events with the attribute
+// appended to the events which do not have an attribute with its key yet.
+func (e Events) WithAttributeIfMissing(attr Attribute) Events {
+	res := make(Events, len(e))
+	for i, ev := range e {
+		if !ev.HasAttribute(attr.Key) {
+			attrs := make([]abci.EventAttribute, len(ev.Attributes), len(ev.Attributes)+1)
+			copy(attrs, ev.Attributes)
+			ev.Attributes = append(attrs, attr.ToKVPair())
+		}
+		res[i] = ev
+	}
+
+	return res
+}
+
 // ToABCIEvents converts a slice of Event objects to a slice of abci.Event
 // objects.
 func (e Events) ToABCIEvents() []abci.Event {
@@ -235,6 +261,12 @@ var (
 	AttributeKeyModule = "module"
 	AttributeKeySender = "sender"
 	AttributeKeyAmount = "amount"
+
+	// AttributeKeyMsgIndex and AttributeKeyMsgTypeURL tag the events produced
+	// by the execution of a message with the index of the message in its
+	// transaction and with the type URL of the message.
+	AttributeKeyMsgIndex   = "msg_index"
+	AttributeKeyMsgTypeURL = "msg_type_url"
 )
 
 type (
@@ -43,6 +43,17 @@ func (s *eventsTestSuite) TestAppendAttributes() {
 	s.Require().Equal(e, sdk.NewEvent("transfer", sdk.NewAttribute("sender", "foo"), sdk.NewAttribute("recipient", "bar")))
 }
 
+func (s *eventsTestSuite) TestWithAttributeIfMissing() {
+	tagged := sdk.NewEvent("transfer", sdk.NewAttribute("sender", "foo"), sdk.NewAttribute(sdk.AttributeKeyMsgIndex, "1"))
+	untagged := sdk.NewEvent("transfer", sdk.NewAttribute("sender", "bar"))
+	events := sdk.Events{tagged, untagged}
+
+	res := events.WithAttributeIfMissing(sdk.NewAttribute(sdk.AttributeKeyMsgIndex, "0"))
+	s.Require().Equal(sdk.Events{tagged, untagged.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyMsgIndex, "0"))}, res)
+	// the original events are left untouched
+	s.Require().False(events[1].HasAttribute(sdk.AttributeKeyMsgIndex))
+}
+
 func (s *eventsTestSuite) TestEmptyEvents() {
 	s.Require().Equal(sdk.EmptyEvents(), sdk.Events{})
 }
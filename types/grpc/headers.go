@@ -3,4 +3,8 @@ package grpc
 const (
 	// GRPCBlockHeightHeader is the gRPC header for block height.
 	GRPCBlockHeightHeader = "x-cosmos-block-height"
+
+	// GRPCQuerySignatureHeader is the gRPC header for the base64 encoded
+	// SignedQuery of a query requiring a signature.
+	GRPCQuerySignatureHeader = "x-cosmos-query-signature"
 )
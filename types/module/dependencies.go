@@ -0,0 +1,274 @@
+package module
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// HasKeeperDependencies is implemented by the modules declaring the modules
+// their keeper depends on, i.e. the modules whose keepers implement the
+// expected keepers the keeper is constructed with. Modules without a keeper
+// declare the modules whose keepers they are constructed with. The keepers set
+// after the construction of the keeper, e.g. with a SetXKeeper setter, are
+// dependencies as well. The dependencies are used by the Manager to build the
+// inter-keeper dependency graph of the app.
+type HasKeeperDependencies interface {
+	KeeperDependencies() []string
+}
+
+// DependencyGraph returns the inter-keeper dependency graph of the modules of
+// the manager, mapping the name of each module to the sorted names of the
+// modules it depends on.
+func (m *Manager) DependencyGraph() map[string][]string {
+	graph := make(map[string][]string, len(m.Modules))
+	for name, module := range m.Modules {
+		var deps []string
+		if mod, ok := module.(HasKeeperDependencies); ok {
+			deps = append(deps, mod.KeeperDependencies()...)
+			sort.Strings(deps)
+		}
+		graph[name] = deps
+	}
+
+	return graph
+}
+
+// ValidateDependencies validates the inter-keeper dependency graph of the
+// modules of the manager, returning an error if a module depends on a module
+// missing from the manager, i.e. whose keeper cannot have been wired, or if the
+// dependencies form a cycle.
+func (m *Manager) ValidateDependencies() error {
+	graph := m.DependencyGraph()
+	names := sortedKeys(graph)
+
+	for _, name := range names {
+		for _, dep := range graph[name] {
+			if _, ok := graph[dep]; !ok {
+				return fmt.Errorf("module %s depends on module %s which is not registered in the module manager", name, dep)
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(graph))
+
+	var path []string
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			// report the cycle starting at its first module in the path
+			for i, n := range path {
+				if n == name {
+					return fmt.Errorf("module dependency cycle: %s", strings.Join(append(path[i:], name), " -> "))
+				}
+			}
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range graph[name] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateKeeperWiring validates the inter-keeper dependency graph of the
+// modules of the manager against the actual wiring of their keepers, given by
+// module name. It returns an error if the keeper of a module holds the keeper
+// of a module it doesn't declare a dependency on, be it given to its
+// constructor or set afterwards. The keepers are inspected with reflection,
+// walking the fields of the types of the keeper module and stopping at the
+// types of the other modules: the hooks and the handlers, which are closures,
+// are not dependencies.
+func (m *Manager) ValidateKeeperWiring(keepers map[string]interface{}) error {
+	graph := m.DependencyGraph()
+
+	names := make([]string, 0, len(keepers))
+	modules := make(map[reflect.Type]string, len(keepers))
+	for name, keeper := range keepers {
+		names = append(names, name)
+		modules[indirect(reflect.TypeOf(keeper))] = name
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		deps, ok := graph[name]
+		if !ok {
+			return fmt.Errorf("module %s is not registered in the module manager", name)
+		}
+
+		w := keeperWalker{
+			root:    path.Dir(indirect(reflect.TypeOf(keepers[name])).PkgPath()),
+			modules: modules,
+			seen:    make(map[uintptr]bool),
+			wired:   make(map[string]bool),
+		}
+		w.walk(reflect.ValueOf(keepers[name]), true)
+
+		wired := make([]string, 0, len(w.wired))
+		for dep := range w.wired {
+			wired = append(wired, dep)
+		}
+		sort.Strings(wired)
+
+		for _, dep := range wired {
+			if dep != name && !containsString(deps, dep) {
+				return fmt.Errorf("module %s keeper holds the keeper of module %s which is not declared in its keeper dependencies", name, dep)
+			}
+		}
+	}
+
+	return nil
+}
+
+// keeperWalker collects the keepers of the other modules held by the keeper
+// of a module.
+type keeperWalker struct {
+	// root is the package path of the module, the parent of its keeper package
+	root    string
+	modules map[reflect.Type]string
+	seen    map[uintptr]bool
+	wired   map[string]bool
+}
+
+func (w keeperWalker) walk(v reflect.Value, top bool) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() || w.seen[v.Pointer()] {
+			return
+		}
+		w.seen[v.Pointer()] = true
+		w.walk(v.Elem(), top)
+
+	case reflect.Interface:
+		if !v.IsNil() {
+			w.walk(v.Elem(), top)
+		}
+
+	case reflect.Struct:
+		if !top {
+			if name, ok := w.modules[v.Type()]; ok {
+				w.wired[name] = true
+				return
+			}
+		}
+		if !w.inModule(v.Type()) {
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			w.walk(v.Field(i), false)
+		}
+
+	case reflect.Slice, reflect.Array:
+		if !w.inModule(v.Type()) {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			w.walk(v.Index(i), false)
+		}
+
+	case reflect.Map:
+		if !w.inModule(v.Type()) {
+			return
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			w.walk(iter.Value(), false)
+		}
+	}
+}
+
+// inModule returns whether a type is either unnamed or declared by a package
+// of the module.
+func (w keeperWalker) inModule(t reflect.Type) bool {
+	pkg := t.PkgPath()
+	return pkg == "" || pkg == w.root || strings.HasPrefix(pkg, w.root+"/")
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t
+}
+
+func containsString(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WriteDependencyGraphDOT writes the inter-keeper dependency graph of the
+// modules of the manager in the Graphviz DOT format, with an edge from each
+// module to each module it depends on. The modules missing from the manager
+// are drawn dashed.
+func (m *Manager) WriteDependencyGraphDOT(w io.Writer) error {
+	graph := m.DependencyGraph()
+
+	var b strings.Builder
+	b.WriteString("digraph modules {\n")
+
+	names := sortedKeys(graph)
+	missing := make(map[string][]string)
+	for _, name := range names {
+		fmt.Fprintf(&b, "  %q;\n", name)
+		for _, dep := range graph[name] {
+			if _, ok := graph[dep]; !ok {
+				missing[dep] = nil
+			}
+		}
+	}
+	for _, name := range sortedKeys(missing) {
+		fmt.Fprintf(&b, "  %q [style=dashed];\n", name)
+	}
+
+	for _, name := range names {
+		for _, dep := range graph[name] {
+			fmt.Fprintf(&b, "  %q -> %q;\n", name, dep)
+		}
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func sortedKeys(graph map[string][]string) []string {
+	keys := make([]string, 0, len(graph))
+	for k := range graph {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,140 @@
+package module_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+type dependentAppModule struct {
+	module.AppModule
+
+	name string
+	deps []string
+}
+
+func (am dependentAppModule) Name() string { return am.name }
+
+func (am dependentAppModule) KeeperDependencies() []string { return am.deps }
+
+func newDependentAppModule(name string, deps ...string) module.AppModule {
+	return dependentAppModule{name: name, deps: deps}
+}
+
+func TestManager_ValidateDependencies(t *testing.T) {
+	testCases := []struct {
+		name    string
+		modules []module.AppModule
+		expErr  string
+	}{
+		{
+			"valid graph",
+			[]module.AppModule{
+				newDependentAppModule("auth"),
+				newDependentAppModule("bank", "auth"),
+				newDependentAppModule("staking", "bank", "auth"),
+				newDependentAppModule("distribution", "staking", "bank", "auth"),
+			},
+			"",
+		},
+		{
+			"missing wiring",
+			[]module.AppModule{
+				newDependentAppModule("auth"),
+				newDependentAppModule("staking", "bank", "auth"),
+			},
+			"module staking depends on module bank which is not registered in the module manager",
+		},
+		{
+			"self dependency",
+			[]module.AppModule{
+				newDependentAppModule("auth", "auth"),
+			},
+			"module dependency cycle: auth -> auth",
+		},
+		{
+			"cycle",
+			[]module.AppModule{
+				newDependentAppModule("auth"),
+				newDependentAppModule("gov", "auth", "upgrade"),
+				newDependentAppModule("params", "gov"),
+				newDependentAppModule("upgrade", "params"),
+			},
+			"module dependency cycle: gov -> upgrade -> params -> gov",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := module.NewManager(tc.modules...).ValidateDependencies()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+type (
+	authKeeper struct{}
+
+	bankKeeper struct {
+		ak interface{}
+	}
+
+	stakingKeeper struct {
+		bk        interface{}
+		govKeeper *govKeeper
+	}
+
+	govKeeper struct {
+		sk *stakingKeeper
+	}
+)
+
+func TestManager_ValidateKeeperWiring(t *testing.T) {
+	ak := authKeeper{}
+	bk := bankKeeper{ak: ak}
+	sk := &stakingKeeper{bk: bk}
+	gk := govKeeper{sk: sk}
+
+	keepers := map[string]interface{}{"auth": ak, "bank": bk, "staking": sk, "gov": gk}
+	mm := module.NewManager(
+		newDependentAppModule("auth"),
+		newDependentAppModule("bank", "auth"),
+		newDependentAppModule("staking", "bank"),
+		newDependentAppModule("gov", "staking"),
+	)
+	require.NoError(t, mm.ValidateKeeperWiring(keepers))
+
+	// the keepers set after the construction are dependencies as well
+	sk.govKeeper = &gk
+	require.EqualError(t, mm.ValidateKeeperWiring(keepers),
+		"module staking keeper holds the keeper of module gov which is not declared in its keeper dependencies")
+
+	mm = module.NewManager(newDependentAppModule("bank"))
+	require.EqualError(t, mm.ValidateKeeperWiring(map[string]interface{}{"bank": bk, "staking": sk}),
+		"module staking is not registered in the module manager")
+}
+
+func TestManager_WriteDependencyGraphDOT(t *testing.T) {
+	mm := module.NewManager(
+		newDependentAppModule("staking", "bank", "auth"),
+		newDependentAppModule("auth"),
+	)
+
+	var buf bytes.Buffer
+	require.NoError(t, mm.WriteDependencyGraphDOT(&buf))
+	require.Equal(t, `digraph modules {
+  "auth";
+  "staking";
+  "bank" [style=dashed];
+  "staking" -> "auth";
+  "staking" -> "bank";
+}
+`, buf.String())
+}
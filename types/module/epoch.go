@@ -0,0 +1,109 @@
+package module
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HasEpochBoundary is implemented by the modules performing work at epoch
+// boundaries. The Manager calls OnEpochBoundary at the end of each BeginBlock,
+// after all the begin blockers, in the order set with SetOrderEpochBoundary,
+// so that the work of the modules sharing an epoch boundary runs in a
+// deterministic order. Each module decides from its own params whether the
+// block height is one of its epoch boundaries.
+type HasEpochBoundary interface {
+	OnEpochBoundary(ctx sdk.Context)
+}
+
+// SetOrderEpochBoundary sets the order of the epoch boundary hook calls. By
+// default, the hooks are called in the order the modules were registered with
+// the manager.
+func (m *Manager) SetOrderEpochBoundary(moduleNames ...string) {
+	m.OrderEpochBoundary = moduleNames
+}
+
+// ValidateOrderEpochBoundary validates the order of the epoch boundary hook
+// calls, returning an error if a module of the order is missing from the
+// manager or does not implement HasEpochBoundary, if a module appears twice,
+// or if a module implementing HasEpochBoundary is missing from the order. The
+// modules of the given contract which are part of the order must also appear
+// in the order of the contract, so that an app can assert at startup the
+// ordering other modules rely on.
+func (m *Manager) ValidateOrderEpochBoundary(contract ...string) error {
+	positions := make(map[string]int, len(m.OrderEpochBoundary))
+	for i, name := range m.OrderEpochBoundary {
+		module, ok := m.Modules[name]
+		if !ok {
+			return fmt.Errorf("module %s in the epoch boundary order is not registered in the module manager", name)
+		}
+		if _, ok := module.(HasEpochBoundary); !ok {
+			return fmt.Errorf("module %s in the epoch boundary order does not implement an epoch boundary hook", name)
+		}
+		if _, ok := positions[name]; ok {
+			return fmt.Errorf("module %s appears twice in the epoch boundary order", name)
+		}
+		positions[name] = i
+	}
+
+	for _, name := range sortedModuleNames(m.Modules) {
+		if _, ok := m.Modules[name].(HasEpochBoundary); !ok {
+			continue
+		}
+		if _, ok := positions[name]; !ok {
+			return fmt.Errorf("module %s implements an epoch boundary hook but is missing from the epoch boundary order", name)
+		}
+	}
+
+	prev := ""
+	for _, name := range contract {
+		pos, ok := positions[name]
+		if !ok {
+			continue
+		}
+		if prev != "" && positions[prev] > pos {
+			return fmt.Errorf("module %s must come before module %s in the epoch boundary order", prev, name)
+		}
+		prev = name
+	}
+
+	return nil
+}
+
+// EpochBoundary calls the epoch boundary hooks of the modules in the order set
+// with SetOrderEpochBoundary.
+func (m *Manager) EpochBoundary(ctx sdk.Context) {
+	for _, moduleName := range m.OrderEpochBoundary {
+		module, ok := m.Modules[moduleName].(HasEpochBoundary)
+		if !ok {
+			continue
+		}
+
+		spanCtx, span := telemetry.StartSpan(ctx.Context(), telemetry.SpanNameEpochBoundary, telemetry.ModuleAttr(moduleName))
+		module.OnEpochBoundary(ctx.WithContext(spanCtx))
+		span.End()
+	}
+}
+
+func epochBoundaryModules(modules []AppModule) []string {
+	var names []string
+	for _, module := range modules {
+		if _, ok := module.(HasEpochBoundary); ok {
+			names = append(names, module.Name())
+		}
+	}
+
+	return names
+}
+
+func sortedModuleNames(modules map[string]AppModule) []string {
+	names := make([]string, 0, len(modules))
+	for name := range modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
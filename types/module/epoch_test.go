@@ -0,0 +1,96 @@
+package module_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+type epochAppModule struct {
+	module.AppModule
+
+	name  string
+	calls *[]string
+}
+
+func (am epochAppModule) Name() string { return am.name }
+
+func (am epochAppModule) OnEpochBoundary(sdk.Context) { *am.calls = append(*am.calls, am.name) }
+
+func TestManager_EpochBoundary(t *testing.T) {
+	var calls []string
+	mm := module.NewManager(
+		epochAppModule{name: "distribution", calls: &calls},
+		newDependentAppModule("auth"),
+		epochAppModule{name: "staking", calls: &calls},
+	)
+	require.Equal(t, []string{"distribution", "staking"}, mm.OrderEpochBoundary)
+
+	mm.SetOrderEpochBoundary("staking", "distribution")
+	mm.EpochBoundary(sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger()))
+	require.Equal(t, []string{"staking", "distribution"}, calls)
+}
+
+func TestManager_ValidateOrderEpochBoundary(t *testing.T) {
+	contract := []string{"staking", "slashing", "distribution", "mint"}
+	mm := module.NewManager(
+		epochAppModule{name: "staking"},
+		epochAppModule{name: "distribution"},
+		epochAppModule{name: "mint"},
+		newDependentAppModule("auth"),
+	)
+
+	testCases := []struct {
+		name   string
+		order  []string
+		expErr string
+	}{
+		{
+			"valid order",
+			[]string{"staking", "distribution", "mint"},
+			"",
+		},
+		{
+			"unregistered module",
+			[]string{"staking", "slashing", "distribution", "mint"},
+			"module slashing in the epoch boundary order is not registered in the module manager",
+		},
+		{
+			"module without hook",
+			[]string{"staking", "distribution", "mint", "auth"},
+			"module auth in the epoch boundary order does not implement an epoch boundary hook",
+		},
+		{
+			"duplicate module",
+			[]string{"staking", "distribution", "mint", "staking"},
+			"module staking appears twice in the epoch boundary order",
+		},
+		{
+			"missing module",
+			[]string{"staking", "distribution"},
+			"module mint implements an epoch boundary hook but is missing from the epoch boundary order",
+		},
+		{
+			"contract broken",
+			[]string{"staking", "mint", "distribution"},
+			"module distribution must come before module mint in the epoch boundary order",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mm.SetOrderEpochBoundary(tc.order...)
+			err := mm.ValidateOrderEpochBoundary(contract...)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expErr)
+			}
+		})
+	}
+}
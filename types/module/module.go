@@ -30,6 +30,7 @@ package module
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -39,6 +40,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -228,6 +230,7 @@ type Manager struct {
 	OrderExportGenesis []string
 	OrderBeginBlockers []string
 	OrderEndBlockers   []string
+	OrderEpochBoundary []string
 }
 
 // NewManager creates a new Manager object
@@ -246,6 +249,7 @@ func NewManager(modules ...AppModule) *Manager {
 		OrderExportGenesis: modulesStr,
 		OrderBeginBlockers: modulesStr,
 		OrderEndBlockers:   modulesStr,
+		OrderEpochBoundary: epochBoundaryModules(modules),
 	}
 }
 
@@ -430,16 +434,20 @@ func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM Version
 	return updatedVM, nil
 }
 
-// BeginBlock performs begin block functionality for all modules. It creates a
-// child context with an event manager to aggregate events emitted from all
-// modules.
+// BeginBlock performs begin block functionality for all modules, followed by
+// the epoch boundary hooks. It creates a child context with an event manager to
+// aggregate events emitted from all modules.
 func (m *Manager) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 
 	for _, moduleName := range m.OrderBeginBlockers {
-		m.Modules[moduleName].BeginBlock(ctx, req)
+		spanCtx, span := telemetry.StartSpan(ctx.Context(), telemetry.SpanNameBeginBlocker, telemetry.ModuleAttr(moduleName))
+		m.Modules[moduleName].BeginBlock(ctx.WithContext(spanCtx), req)
+		span.End()
 	}
 
+	m.EpochBoundary(ctx)
+
 	return abci.ResponseBeginBlock{
 		Events: ctx.EventManager().ABCIEvents(),
 	}
@@ -447,13 +455,18 @@ func (m *Manager) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) abci.R
 
 // EndBlock performs end block functionality for all modules. It creates a
 // child context with an event manager to aggregate events emitted from all
-// modules.
+// modules. The duration of each end blocker is recorded in the block report
+// carried by the context, if any.
 func (m *Manager) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	validatorUpdates := []abci.ValidatorUpdate{}
 
 	for _, moduleName := range m.OrderEndBlockers {
-		moduleValUpdates := m.Modules[moduleName].EndBlock(ctx, req)
+		start := time.Now()
+		spanCtx, span := telemetry.StartSpan(ctx.Context(), telemetry.SpanNameEndBlocker, telemetry.ModuleAttr(moduleName))
+		moduleValUpdates := m.Modules[moduleName].EndBlock(ctx.WithContext(spanCtx), req)
+		span.End()
+		sdk.RecordEndBlockDuration(ctx, moduleName, time.Since(start))
 
 		// use these validator updates if provided, the module manager assumes
 		// only one module will update the validator set
@@ -0,0 +1,59 @@
+package query
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = SignedQuery{}
+
+// signerContextKey is the context key of the signer of a signed query.
+type signerContextKey struct{}
+
+// GetSignBytes returns the bytes to sign for the query at path.
+func (q SignedQuery) GetSignBytes(path string) ([]byte, error) {
+	doc := SignedQuerySignDoc{
+		Path:        path,
+		Request:     q.Request,
+		ChainId:     q.ChainId,
+		BlockHeight: q.BlockHeight,
+		BlockHash:   q.BlockHash,
+	}
+
+	return doc.Marshal()
+}
+
+// GetPubKey returns the public key of the signer of the query.
+func (q SignedQuery) GetPubKey() (cryptotypes.PubKey, error) {
+	if q.PublicKey == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrNoSignatures, "missing public key")
+	}
+
+	pk, ok := q.PublicKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "expected %T, got %T", (cryptotypes.PubKey)(nil), q.PublicKey.GetCachedValue())
+	}
+
+	return pk, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (q SignedQuery) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	return unpacker.UnpackAny(q.PublicKey, &pk)
+}
+
+// WithSigner returns a context carrying the address of the signer of a
+// signed query.
+func WithSigner(ctx sdk.Context, signer sdk.AccAddress) sdk.Context {
+	return ctx.WithValue(signerContextKey{}, signer)
+}
+
+// SignerFromContext returns the address of the signer of the signed query
+// being handled, if any.
+func SignerFromContext(ctx sdk.Context) (sdk.AccAddress, bool) {
+	signer, ok := ctx.Value(signerContextKey{}).(sdk.AccAddress)
+	return signer, ok
+}
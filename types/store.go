@@ -24,6 +24,7 @@ type (
 	MultiStorePersistentCache = types.MultiStorePersistentCache
 	KVStore                   = types.KVStore
 	Iterator                  = types.Iterator
+	KVStoreBatch              = types.KVStoreBatch
 )
 
 // StoreDecoderRegistry defines each of the modules store decoders. Used for ImportExport
@@ -52,6 +53,12 @@ func KVStoreReversePrefixIteratorPaginated(kvs KVStore, prefix []byte, page, lim
 	return types.KVStoreReversePrefixIteratorPaginated(kvs, prefix, page, limit)
 }
 
+// NewKVStoreBatch returns a new batch of writes and deletes to a KVStore, which
+// are applied at once when the batch is written.
+func NewKVStoreBatch(kvs KVStore) *KVStoreBatch {
+	return types.NewKVStoreBatch(kvs)
+}
+
 // DiffKVStores compares two KVstores and returns all the key/value pairs
 // that differ from one another. It also skips value comparison for a set of provided prefixes
 func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []kv.Pair) {
@@ -176,9 +183,10 @@ type TraceContext = types.TraceContext
 // --------------------------------------
 
 type (
-	Gas       = types.Gas
-	GasMeter  = types.GasMeter
-	GasConfig = types.GasConfig
+	Gas                = types.Gas
+	GasMeter           = types.GasMeter
+	RefundableGasMeter = types.RefundableGasMeter
+	GasConfig          = types.GasConfig
 )
 
 func NewGasMeter(limit Gas) GasMeter {
@@ -0,0 +1,18 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// EndBlocker prunes the accounts which stayed without balance and activity for
+// the retention period.
+func EndBlocker(ctx sdk.Context, ak keeper.AccountKeeper, bk types.BalanceKeeper, dk types.DelegationKeeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	ak.PruneDustAccounts(ctx, bk, dk)
+}
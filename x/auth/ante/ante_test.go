@@ -332,7 +332,8 @@ func (suite *AnteTestSuite) TestAnteHandlerSequences() {
 	// Same data for every test cases
 	accounts := suite.CreateTestAccounts(3)
 	feeAmount := testdata.NewTestFeeAmount()
-	gasLimit := testdata.NewTestGasLimit()
+	// three signers exceed the default test gas limit
+	gasLimit := 2 * testdata.NewTestGasLimit()
 
 	// Variable data per test case
 	var (
@@ -571,7 +572,7 @@ func (suite *AnteTestSuite) TestAnteHandlerMemoGas() {
 			"tx with memo has enough gas",
 			func() {
 				feeAmount = sdk.NewCoins(sdk.NewInt64Coin("atom", 0))
-				gasLimit = 50000
+				gasLimit = 60000
 				suite.txBuilder.SetMemo(strings.Repeat("0123456789", 10))
 			},
 			false,
@@ -599,7 +600,8 @@ func (suite *AnteTestSuite) TestAnteHandlerMultiSigner() {
 	msg2 := testdata.NewTestMsg(accounts[2].acc.GetAddress(), accounts[0].acc.GetAddress())
 	msg3 := testdata.NewTestMsg(accounts[1].acc.GetAddress(), accounts[2].acc.GetAddress())
 	feeAmount := testdata.NewTestFeeAmount()
-	gasLimit := testdata.NewTestGasLimit()
+	// three signers exceed the default test gas limit
+	gasLimit := 2 * testdata.NewTestGasLimit()
 
 	// Variable data per test case
 	var (
@@ -948,6 +950,8 @@ func TestCountSubkeys(t *testing.T) {
 	multiLevelSubKey2 := kmultisig.NewLegacyAminoPubKey(4, genPubKeys(5))
 	multiLevelMultiKey := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{
 		multiLevelSubKey1, multiLevelSubKey2, secp256k1.GenPrivKey().PubKey()})
+	weightedMultiKey := kmultisig.NewWeightedPubKey(3, []cryptotypes.PubKey{
+		multiLevelSubKey1, singleKey}, []uint32{2, 1})
 	type args struct {
 		pub cryptotypes.PubKey
 	}
@@ -959,6 +963,7 @@ func TestCountSubkeys(t *testing.T) {
 		{"single key", args{singleKey}, 1},
 		{"single level multikey", args{singleLevelMultiKey}, 5},
 		{"multi level multikey", args{multiLevelMultiKey}, 11},
+		{"weighted multikey", args{weightedMultiKey}, 6},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(T *testing.T) {
@@ -981,7 +986,8 @@ func (suite *AnteTestSuite) TestAnteHandlerSigLimitExceeded() {
 	msgs := []sdk.Msg{testdata.NewTestMsg(addrs...)}
 	accNums, accSeqs := []uint64{0, 1, 2, 3, 4, 5, 6, 7}, []uint64{0, 0, 0, 0, 0, 0, 0, 0}
 	feeAmount := testdata.NewTestFeeAmount()
-	gasLimit := testdata.NewTestGasLimit()
+	// the signatures are only rejected once the gas for the other signers is consumed
+	gasLimit := 2 * testdata.NewTestGasLimit()
 
 	testCases := []TestCase{
 		{
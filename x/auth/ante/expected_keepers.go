@@ -1,6 +1,7 @@
 package ante
 
 import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -12,6 +13,7 @@ type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.AccountI
 	SetAccount(ctx sdk.Context, acc types.AccountI)
 	GetModuleAddress(moduleName string) sdk.AccAddress
+	GetPubKeyAlias(ctx sdk.Context, addr sdk.AccAddress) cryptotypes.PubKey
 }
 
 // FeegrantKeeper defines the expected feegrant keeper.
@@ -7,13 +7,14 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
-	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -74,10 +75,18 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSecp256k1Pubkey
 		}
-		// Only make check if simulate=false
-		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
-			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
-				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
+		// Only make check if simulate=false. The accounts with a public key
+		// alias are only authenticated by the aliased public key.
+		if !simulate {
+			if alias := spkd.ak.GetPubKeyAlias(ctx, signers[i]); alias != nil {
+				if !alias.Equals(pk) {
+					return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
+						"pubKey does not match the public key alias of signer address %s with signer index: %d", signers[i], i)
+				}
+			} else if !bytes.Equal(pk.Address(), signers[i]) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
+					"pubKey does not match signer address %s with signer index: %d", signers[i], i)
+			}
 		}
 
 		acc, err := GetSignerAcc(ctx, spkd.ak, signers[i])
@@ -381,6 +390,148 @@ func (vscd ValidateSigCountDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 	return next(ctx, tx, simulate)
 }
 
+// protoTxProvider is implemented by transactions wrapping a protobuf Tx.
+type protoTxProvider interface {
+	GetProtoTx() *txtypes.Tx
+}
+
+// RejectMalformedSignaturesDecorator rejects malleable transactions before any
+// fee is deducted. It returns an error if:
+// - the number of signer infos or signatures doesn't match the number of signers,
+// - two signer infos have the same public key,
+// - a signature, including the ones nested in multisignatures, is included twice.
+// CONTRACT: Tx must implement SigVerifiableTx interface
+type RejectMalformedSignaturesDecorator struct{}
+
+func NewRejectMalformedSignaturesDecorator() RejectMalformedSignaturesDecorator {
+	return RejectMalformedSignaturesDecorator{}
+}
+
+func (rmsd RejectMalformedSignaturesDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a sigTx")
+	}
+
+	signers := sigTx.GetSigners()
+	pubKeys, err := sigTx.GetPubKeys()
+	if err != nil {
+		return ctx, err
+	}
+	if len(pubKeys) != len(signers) {
+		return ctx, sdkerrors.Wrapf(types.ErrSignerInfoCountMismatch, "expected %d, got %d", len(signers), len(pubKeys))
+	}
+	if ptx, ok := tx.(protoTxProvider); ok {
+		if n := len(ptx.GetProtoTx().Signatures); n != len(signers) {
+			return ctx, sdkerrors.Wrapf(types.ErrSignatureCountMismatch, "expected %d, got %d", len(signers), n)
+		}
+	}
+
+	// public keys can be omitted once set on the account
+	for i, pk := range pubKeys {
+		if pk == nil {
+			continue
+		}
+		for _, other := range pubKeys[i+1:] {
+			if other != nil && pk.Equals(other) {
+				return ctx, sdkerrors.Wrapf(types.ErrDuplicateSignerInfo, "public key %s", pk)
+			}
+		}
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+	if len(sigs) != len(signers) {
+		return ctx, sdkerrors.Wrapf(types.ErrSignatureCountMismatch, "expected %d, got %d", len(signers), len(sigs))
+	}
+
+	seen := make(map[string]bool)
+	for _, sig := range sigs {
+		for _, sigBz := range leafSignatures(sig.Data) {
+			// signatures are empty in simulation mode
+			if len(sigBz) == 0 {
+				continue
+			}
+			if seen[string(sigBz)] {
+				return ctx, sdkerrors.Wrapf(types.ErrDuplicateSignature, "signature %X", sigBz)
+			}
+			seen[string(sigBz)] = true
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// UpdateMultisigDecorator requires the MsgUpdateMultisig messages to be signed
+// by all the current members of the multisig account instead of a threshold
+// of them. It must run after RejectMalformedSignaturesDecorator.
+// CONTRACT: Tx must implement SigVerifiableTx interface
+type UpdateMultisigDecorator struct{}
+
+func NewUpdateMultisigDecorator() UpdateMultisigDecorator {
+	return UpdateMultisigDecorator{}
+}
+
+func (umd UpdateMultisigDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// signatures are empty in simulation mode
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a sigTx")
+	}
+
+	var sigs []signing.SignatureV2
+	signers := sigTx.GetSigners()
+	for _, msg := range sigTx.GetMsgs() {
+		update, ok := msg.(*types.MsgUpdateMultisig)
+		if !ok {
+			continue
+		}
+
+		if sigs == nil {
+			var err error
+			if sigs, err = sigTx.GetSignaturesV2(); err != nil {
+				return ctx, err
+			}
+		}
+
+		for i, signer := range signers {
+			if signer.String() != update.Address {
+				continue
+			}
+
+			data, ok := sigs[i].Data.(*signing.MultiSignatureData)
+			if !ok || data.BitArray == nil || data.BitArray.NumTrueBitsBefore(data.BitArray.Count()) != data.BitArray.Count() {
+				return ctx, sdkerrors.Wrapf(types.ErrIncompleteMultisig, "signer %s", update.Address)
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// leafSignatures returns the single signatures of a signature data, which are
+// nested in multisignatures.
+func leafSignatures(data signing.SignatureData) [][]byte {
+	switch data := data.(type) {
+	case *signing.SingleSignatureData:
+		return [][]byte{data.Signature}
+	case *signing.MultiSignatureData:
+		var sigs [][]byte
+		for _, d := range data.Signatures {
+			sigs = append(sigs, leafSignatures(d)...)
+		}
+		return sigs
+	default:
+		return nil
+	}
+}
+
 // DefaultSigVerificationGasConsumer is the default implementation of SignatureVerificationGasConsumer. It consumes gas
 // for signature verification based upon the public key type. The cost is fetched from the given params and is matched
 // by the concrete type.
@@ -417,6 +568,22 @@ func DefaultSigVerificationGasConsumer(
 	}
 }
 
+// EthSecp256k1SigVerificationGasConsumer is a SignatureVerificationGasConsumer
+// which, in addition to the public keys accepted by
+// DefaultSigVerificationGasConsumer, accepts the eth_secp256k1 public keys of
+// the keys imported from Ethereum, at the cost of secp256k1 public keys. Apps
+// supporting such keys set it as the SigGasConsumer of the HandlerOptions.
+func EthSecp256k1SigVerificationGasConsumer(
+	meter sdk.GasMeter, sig signing.SignatureV2, params types.Params,
+) error {
+	if _, ok := sig.PubKey.(*ethsecp256k1.PubKey); ok {
+		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: eth_secp256k1")
+		return nil
+	}
+
+	return DefaultSigVerificationGasConsumer(meter, sig, params)
+}
+
 // ConsumeMultisignatureVerificationGas consumes gas from a GasMeter for verifying a multisig pubkey signature
 func ConsumeMultisignatureVerificationGas(
 	meter sdk.GasMeter, sig *signing.MultiSignatureData, pubkey multisig.PubKey,
@@ -457,7 +624,7 @@ func GetSignerAcc(ctx sdk.Context, ak AccountKeeper, addr sdk.AccAddress) (types
 
 // CountSubKeys counts the total number of keys for a multi-sig public key.
 func CountSubKeys(pub cryptotypes.PubKey) int {
-	v, ok := pub.(*kmultisig.LegacyAminoPubKey)
+	v, ok := pub.(multisig.PubKey)
 	if !ok {
 		return 1
 	}
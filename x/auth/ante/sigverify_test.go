@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
@@ -14,9 +15,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -64,6 +68,43 @@ func (suite *AnteTestSuite) TestSetPubKey() {
 	}
 }
 
+func (suite *AnteTestSuite) TestSetPubKeyAlias() {
+	suite.SetupTest(true) // setup
+	require := suite.Require()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, pub2, _ := testdata.KeyTestPubAddr()
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	alias, err := types.NewPubKeyAlias(addr1, pub2)
+	require.NoError(err)
+	suite.app.AccountKeeper.SetPubKeyAlias(suite.ctx, alias)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewSetPubKeyDecorator(suite.app.AccountKeeper))
+	for _, priv := range []cryptotypes.PrivKey{priv1, priv2} {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID())
+		require.NoError(err)
+
+		_, err = antehandler(suite.ctx, tx, false)
+		if priv == priv1 {
+			// the public key the address is derived from is replaced by the alias
+			require.ErrorIs(err, sdkerrors.ErrInvalidPubKey)
+		} else {
+			require.NoError(err)
+		}
+	}
+
+	pk, err := suite.app.AccountKeeper.GetPubKey(suite.ctx, addr1)
+	require.NoError(err)
+	require.True(pub2.Equals(pk))
+}
+
 func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 	params := types.DefaultParams()
 	msg := []byte{1, 2, 3, 4}
@@ -74,6 +115,7 @@ func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 	pkSet1, sigSet1 := generatePubKeysAndSignatures(5, msg, false)
 	multisigKey1 := kmultisig.NewLegacyAminoPubKey(2, pkSet1)
 	multisignature1 := multisig.NewMultisig(len(pkSet1))
+	weightedKey1 := kmultisig.NewWeightedPubKey(3, pkSet1, []uint32{1, 1, 1, 1, 1})
 	expectedCost1 := expectedGasCostByKeys(pkSet1)
 	for i := 0; i < len(pkSet1); i++ {
 		stdSig := legacytx.StdSignature{PubKey: pkSet1[i], Signature: sigSet1[i]}
@@ -99,6 +141,7 @@ func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
 		{"PubKeySecp256r1", args{sdk.NewInfiniteGasMeter(), nil, skR1.PubKey(), params}, p.SigVerifyCostSecp256r1(), false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
+		{"WeightedMultisig", args{sdk.NewInfiniteGasMeter(), multisignature1, weightedKey1, params}, expectedCost1, false},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
 	for _, tt := range tests {
@@ -118,6 +161,87 @@ func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 	}
 }
 
+func (suite *AnteTestSuite) TestConsumeEthSecp256k1SignatureVerificationGas() {
+	params := types.DefaultParams()
+
+	tests := []struct {
+		name        string
+		pubkey      cryptotypes.PubKey
+		gasConsumed uint64
+		shouldErr   bool
+	}{
+		{"PubKeyEthSecp256k1", ethsecp256k1.GenPrivKey().PubKey(), params.SigVerifyCostSecp256k1, false},
+		{"PubKeySecp256k1", secp256k1.GenPrivKey().PubKey(), params.SigVerifyCostSecp256k1, false},
+		{"PubKeyEd25519", ed25519.GenPrivKey().PubKey(), params.SigVerifyCostED25519, true},
+	}
+	for _, tt := range tests {
+		meter := sdk.NewInfiniteGasMeter()
+		sigV2 := signing.SignatureV2{PubKey: tt.pubkey}
+
+		// eth_secp256k1 public keys are rejected by default
+		if _, ok := tt.pubkey.(*ethsecp256k1.PubKey); ok {
+			suite.Require().Error(ante.DefaultSigVerificationGasConsumer(sdk.NewInfiniteGasMeter(), sigV2, params))
+		}
+
+		err := ante.EthSecp256k1SigVerificationGasConsumer(meter, sigV2, params)
+		if tt.shouldErr {
+			suite.Require().Error(err, tt.name)
+		} else {
+			suite.Require().NoError(err, tt.name)
+			suite.Require().Equal(tt.gasConsumed, meter.GasConsumed(), tt.name)
+		}
+	}
+}
+
+func (suite *AnteTestSuite) TestSigVerificationEthSecp256k1() {
+	suite.SetupTest(true) // setup
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	priv1 := ethsecp256k1.GenPrivKey()
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+	priv2, _, addr2 := testdata.KeyTestPubAddr()
+
+	addrs := []sdk.AccAddress{addr1, addr2}
+	msgs := make([]sdk.Msg, len(addrs))
+	for i, addr := range addrs {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+		suite.Require().NoError(acc.SetAccountNumber(uint64(i)))
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		msgs[i] = testdata.NewTestMsg(addr)
+	}
+
+	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper)
+	svgc := ante.NewSigGasConsumeDecorator(suite.app.AccountKeeper, ante.EthSecp256k1SigVerificationGasConsumer)
+	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svgc, svd)
+
+	testCases := []struct {
+		name      string
+		privs     []cryptotypes.PrivKey
+		shouldErr bool
+	}{
+		{"valid tx", []cryptotypes.PrivKey{priv1, priv2}, false},
+		{"eth key signing as a secp256k1 key", []cryptotypes.PrivKey{&secp256k1.PrivKey{Key: priv1.Key}, priv2}, true},
+	}
+	for _, tc := range testCases {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		suite.Require().NoError(suite.txBuilder.SetMsgs(msgs...))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		tx, err := suite.CreateTestTx(tc.privs, []uint64{0, 1}, []uint64{0, 0}, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+
+		cacheCtx, _ := suite.ctx.CacheContext()
+		_, err = antehandler(cacheCtx, tx, false)
+		if tc.shouldErr {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
 func (suite *AnteTestSuite) TestSigVerification() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
@@ -388,3 +512,206 @@ func (suite *AnteTestSuite) TestIncrementSequenceDecorator() {
 		suite.Require().Equal(tc.expectedSeq, suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetSequence())
 	}
 }
+
+func (suite *AnteTestSuite) TestRejectMalformedSignaturesDecorator() {
+	suite.SetupTest(true) // setup
+	antehandler := sdk.ChainAnteDecorators(ante.NewRejectMalformedSignaturesDecorator())
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, addr2 := testdata.KeyTestPubAddr()
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1, priv2}, []uint64{0, 1}, []uint64{0, 0}
+
+	testCases := []struct {
+		name     string
+		malleate func(sigs []signing.SignatureV2)
+		expErr   error
+	}{
+		{
+			"valid signatures",
+			func(sigs []signing.SignatureV2) {},
+			nil,
+		},
+		{
+			"more signer infos than signers",
+			func(sigs []signing.SignatureV2) {
+				suite.Require().NoError(suite.txBuilder.SetSignatures(append(sigs, sigs[0])...))
+			},
+			types.ErrSignerInfoCountMismatch,
+		},
+		{
+			"more signatures than signers",
+			func(sigs []signing.SignatureV2) {
+				protoTx := suite.txBuilder.GetTx().(interface{ GetProtoTx() *txtypes.Tx }).GetProtoTx()
+				protoTx.Signatures = append(protoTx.Signatures, protoTx.Signatures[0])
+			},
+			types.ErrSignatureCountMismatch,
+		},
+		{
+			"duplicate signer info",
+			func(sigs []signing.SignatureV2) {
+				suite.Require().NoError(suite.txBuilder.SetSignatures(sigs[0], sigs[0]))
+			},
+			types.ErrDuplicateSignerInfo,
+		},
+		{
+			"duplicate signature",
+			func(sigs []signing.SignatureV2) {
+				sigs[1].Data = sigs[0].Data
+				suite.Require().NoError(suite.txBuilder.SetSignatures(sigs...))
+			},
+			types.ErrDuplicateSignature,
+		},
+		{
+			"duplicate signature nested in a multisignature",
+			func(sigs []signing.SignatureV2) {
+				sigs[1].Data = &signing.MultiSignatureData{Signatures: []signing.SignatureData{sigs[0].Data}}
+				suite.Require().NoError(suite.txBuilder.SetSignatures(sigs...))
+			},
+			types.ErrDuplicateSignature,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1, addr2)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+			sigs, err := tx.GetSignaturesV2()
+			suite.Require().NoError(err)
+			tc.malleate(sigs)
+
+			_, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestSigVerificationWeightedMultisig() {
+	suite.SetupTest(true) // setup
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewSetPubKeyDecorator(suite.app.AccountKeeper),
+		ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler()),
+	)
+
+	privs := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	members := []cryptotypes.PubKey{privs[0].PubKey(), privs[1].PubKey(), privs[2].PubKey()}
+	multisigKey := kmultisig.NewWeightedPubKey(3, members, []uint32{3, 1, 1})
+	addr := sdk.AccAddress(multisigKey.Address())
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr))
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr)
+
+	testCases := []struct {
+		name    string
+		signers []int
+		expErr  error
+	}{
+		{"signers reaching the threshold", []int{0}, nil},
+		{"all the members", []int{0, 1, 2}, nil},
+		{"signers below the threshold", []int{1, 2}, sdkerrors.ErrUnauthorized},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			signMode := signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+			signBytes, err := suite.clientCtx.TxConfig.SignModeHandler().GetSignBytes(signMode, authsigning.SignerData{
+				ChainID:       suite.ctx.ChainID(),
+				AccountNumber: acc.GetAccountNumber(),
+				Sequence:      acc.GetSequence(),
+			}, suite.txBuilder.GetTx())
+			suite.Require().NoError(err)
+
+			data := multisig.NewMultisig(len(members))
+			for _, i := range tc.signers {
+				sig, err := privs[i].Sign(signBytes)
+				suite.Require().NoError(err)
+				suite.Require().NoError(multisig.AddSignatureV2(data, signing.SignatureV2{
+					PubKey: members[i],
+					Data:   &signing.SingleSignatureData{SignMode: signMode, Signature: sig},
+				}, members))
+			}
+			suite.Require().NoError(suite.txBuilder.SetSignatures(signing.SignatureV2{
+				PubKey: multisigKey, Data: data, Sequence: acc.GetSequence(),
+			}))
+
+			_, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestUpdateMultisigDecorator() {
+	suite.SetupTest(true) // setup
+	antehandler := sdk.ChainAnteDecorators(ante.NewUpdateMultisigDecorator())
+
+	members := []cryptotypes.PubKey{
+		secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(),
+	}
+	multisigKey := kmultisig.NewLegacyAminoPubKey(2, members)
+	addr := sdk.AccAddress(multisigKey.Address())
+	updateMsg, err := types.NewMsgUpdateMultisig(addr, kmultisig.NewLegacyAminoPubKey(2, members[:2]))
+	suite.Require().NoError(err)
+
+	multisignature := func(signed int) signing.SignatureData {
+		data := multisig.NewMultisig(len(members))
+		for i := 0; i < signed; i++ {
+			data.BitArray.SetIndex(i, true)
+			data.Signatures = append(data.Signatures, &signing.SingleSignatureData{
+				SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: []byte{byte(i)},
+			})
+		}
+		return data
+	}
+
+	testCases := []struct {
+		name   string
+		msg    sdk.Msg
+		data   signing.SignatureData
+		expErr error
+	}{
+		{"all the members signed", updateMsg, multisignature(3), nil},
+		{"threshold of the members signed", updateMsg, multisignature(2), types.ErrIncompleteMultisig},
+		{
+			"single signature",
+			updateMsg,
+			&signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: []byte{1}},
+			types.ErrIncompleteMultisig,
+		},
+		{"threshold of the members signed another message", testdata.NewTestMsg(addr), multisignature(2), nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(tc.msg))
+			suite.Require().NoError(suite.txBuilder.SetSignatures(signing.SignatureV2{PubKey: multisigKey, Data: tc.data}))
+
+			_, err := antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+
+			// signatures are not checked in simulation mode
+			_, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), true)
+			suite.Require().NoError(err)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/auth transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Auth transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewUpdateMultisigCmd(),
+	)
+
+	return txCmd
+}
+
+// NewUpdateMultisigCmd returns a CLI command handler for creating a
+// MsgUpdateMultisig transaction.
+func NewUpdateMultisigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-multisig [new_multisig_key_name]",
+		Short: "Replace the members and threshold of a multisig account, keeping its address",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the multisig public key of the multisig account sending the transaction
+by the one of a multisig key from the keyring. The transaction must be signed by
+all the current members of the multisig account.
+
+Example:
+$ %s keys add newmultisig --multisig=alice,bob,carol --multisig-threshold=2
+$ %s tx auth update-multisig newmultisig --from mymultisig --generate-only > tx.json
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			info, err := clientCtx.Keyring.Key(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgUpdateMultisig(clientCtx.GetFromAddress(), info.GetPubKey())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
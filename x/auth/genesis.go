@@ -24,6 +24,14 @@ func InitGenesis(ctx sdk.Context, ak keeper.AccountKeeper, data types.GenesisSta
 		ak.SetAccount(ctx, acc)
 	}
 
+	for _, pruned := range data.PrunedAccounts {
+		ak.SetPrunedAccount(ctx, pruned)
+	}
+
+	for _, alias := range data.PubKeyAliases {
+		ak.SetPubKeyAlias(ctx, alias)
+	}
+
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
 }
 
@@ -38,5 +46,9 @@ func ExportGenesis(ctx sdk.Context, ak keeper.AccountKeeper) *types.GenesisState
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	genState := types.NewGenesisState(params, genAccounts)
+	genState.PrunedAccounts = ak.GetAllPrunedAccounts(ctx)
+	genState.PubKeyAliases = ak.GetAllPubKeyAliases(ctx)
+
+	return genState
 }
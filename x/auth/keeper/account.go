@@ -16,8 +16,24 @@ func (ak AccountKeeper) NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddre
 	return ak.NewAccount(ctx, acc)
 }
 
-// NewAccount sets the next account number to a given account interface
+// NewAccount sets the next account number to a given account interface. If the
+// account was pruned, its reserved account number and sequence are restored
+// instead and the reservation is removed.
 func (ak AccountKeeper) NewAccount(ctx sdk.Context, acc types.AccountI) types.AccountI {
+	if addr := acc.GetAddress(); addr != nil {
+		if pruned, found := ak.GetPrunedAccount(ctx, addr); found {
+			if err := acc.SetAccountNumber(pruned.AccountNumber); err != nil {
+				panic(err)
+			}
+			if err := acc.SetSequence(pruned.Sequence); err != nil {
+				panic(err)
+			}
+
+			ak.RemovePrunedAccount(ctx, addr)
+			return acc
+		}
+	}
+
 	if err := acc.SetAccountNumber(ak.GetNextAccountNumber(ctx)); err != nil {
 		panic(err)
 	}
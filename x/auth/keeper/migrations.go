@@ -4,6 +4,7 @@ import (
 	"github.com/gogo/protobuf/grpc"
 
 	v043 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v043"
+	v044 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v044"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -41,3 +42,9 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 
 	return iterErr
 }
+
+// Migrate2to3 migrates x/auth params from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	v044.MigrateParams(ctx, m.keeper.paramSubspace)
+	return nil
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type msgServer struct {
+	AccountKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the auth MsgServer interface
+// for the provided AccountKeeper.
+func NewMsgServerImpl(keeper AccountKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) UpdateMultisig(goCtx context.Context, msg *types.MsgUpdateMultisig) (*types.MsgUpdateMultisigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, ok := msg.NewPubkey.GetCachedValue().(*multisig.LegacyAminoPubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "expected %T, got %T", (*multisig.LegacyAminoPubKey)(nil), msg.NewPubkey.GetCachedValue())
+	}
+
+	if err := k.AccountKeeper.UpdateMultisig(ctx, addr, pubKey); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address),
+		),
+	)
+
+	return &types.MsgUpdateMultisigResponse{}, nil
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -15,3 +16,10 @@ func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	ak.paramSubspace.GetParamSet(ctx, &params)
 	return
 }
+
+// GetKVGasConfig gets the KVStore gas config from the auth module's parameters.
+func (ak AccountKeeper) GetKVGasConfig(ctx sdk.Context) storetypes.GasConfig {
+	var kvGasConfig types.KVGasConfig
+	ak.paramSubspace.Get(ctx, types.KeyKVGasConfig, &kvGasConfig)
+	return kvGasConfig.GasConfig()
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetDustAccount gets the dust mark of an account.
+func (ak AccountKeeper) GetDustAccount(ctx sdk.Context, addr sdk.AccAddress) (dust types.DustAccount, found bool) {
+	store := ctx.KVStore(ak.key)
+
+	bz := store.Get(types.DustAccountKey(addr))
+	if bz == nil {
+		return dust, false
+	}
+
+	ak.cdc.MustUnmarshal(bz, &dust)
+	return dust, true
+}
+
+// SetDustAccount marks an account without balance.
+func (ak AccountKeeper) SetDustAccount(ctx sdk.Context, dust types.DustAccount) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.DustAccountKey(dust.GetAddress()), ak.cdc.MustMarshal(&dust))
+}
+
+// RemoveDustAccount removes the dust mark of an account.
+func (ak AccountKeeper) RemoveDustAccount(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(ak.key)
+
+	key := types.DustAccountKey(addr)
+	if store.Has(key) {
+		store.Delete(key)
+	}
+}
+
+// GetPrunedAccount gets the reservation of a pruned account.
+func (ak AccountKeeper) GetPrunedAccount(ctx sdk.Context, addr sdk.AccAddress) (pruned types.PrunedAccount, found bool) {
+	store := ctx.KVStore(ak.key)
+
+	bz := store.Get(types.PrunedAccountKey(addr))
+	if bz == nil {
+		return pruned, false
+	}
+
+	ak.cdc.MustUnmarshal(bz, &pruned)
+	return pruned, true
+}
+
+// RemovePrunedAccount removes the reservation of a pruned account.
+func (ak AccountKeeper) RemovePrunedAccount(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(ak.key)
+	store.Delete(types.PrunedAccountKey(addr))
+}
+
+// SetPrunedAccount sets the reservation of a pruned account.
+func (ak AccountKeeper) SetPrunedAccount(ctx sdk.Context, pruned types.PrunedAccount) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.PrunedAccountKey(pruned.GetAddress()), ak.cdc.MustMarshal(&pruned))
+}
+
+// GetAllPrunedAccounts returns the reservations of all the pruned accounts.
+func (ak AccountKeeper) GetAllPrunedAccounts(ctx sdk.Context) (prunedAccounts []types.PrunedAccount) {
+	store := ctx.KVStore(ak.key)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PrunedAccountKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pruned types.PrunedAccount
+		ak.cdc.MustUnmarshal(iterator.Value(), &pruned)
+		prunedAccounts = append(prunedAccounts, pruned)
+	}
+
+	return prunedAccounts
+}
+
+// PruneAccount removes an account from the store and reserves its account
+// number and sequence, which are restored if the account is created again.
+func (ak AccountKeeper) PruneAccount(ctx sdk.Context, acc types.AccountI) {
+	ak.RemoveAccount(ctx, acc)
+	ak.RemoveDustAccount(ctx, acc.GetAddress())
+	ak.SetPrunedAccount(ctx, types.NewPrunedAccount(acc))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePruneAccount,
+			sdk.NewAttribute(types.AttributeKeyAddress, acc.GetAddress().String()),
+		),
+	)
+}
+
+// PruneDustAccounts examines the next PruneDustAccountsPerBlock accounts in
+// address order, resuming after the last account examined in the previous
+// block and wrapping around once all the accounts were examined. Base accounts
+// without balance nor delegations are marked as dust, and pruned once they
+// stayed so without signing any transaction for DustAccountRetentionBlocks
+// blocks. Module and vesting accounts are never pruned.
+func (ak AccountKeeper) PruneDustAccounts(ctx sdk.Context, bk types.BalanceKeeper, dk types.DelegationKeeper) {
+	params := ak.GetParams(ctx)
+	if params.PruneDustAccountsPerBlock == 0 {
+		return
+	}
+
+	accounts := ak.nextDustAccountCandidates(ctx, params.PruneDustAccountsPerBlock)
+	for _, acc := range accounts {
+		addr := acc.GetAddress()
+
+		_, isBaseAccount := acc.(*types.BaseAccount)
+		if !isBaseAccount || !bk.GetAllBalances(ctx, addr).IsZero() || dk.HasDelegations(ctx, addr) {
+			ak.RemoveDustAccount(ctx, addr)
+			continue
+		}
+
+		// a transaction signed by the account since it was marked resets the mark
+		dust, found := ak.GetDustAccount(ctx, addr)
+		if !found || dust.Sequence != acc.GetSequence() {
+			ak.SetDustAccount(ctx, types.NewDustAccount(addr, acc.GetSequence(), ctx.BlockHeight()))
+			continue
+		}
+
+		if uint64(ctx.BlockHeight()-dust.Height) >= params.DustAccountRetentionBlocks {
+			ak.PruneAccount(ctx, acc)
+		}
+	}
+}
+
+// nextDustAccountCandidates returns at most limit accounts following the
+// address of the last account examined, and moves the cursor past them.
+func (ak AccountKeeper) nextDustAccountCandidates(ctx sdk.Context, limit uint64) []types.AccountI {
+	store := ctx.KVStore(ak.key)
+
+	start := types.AddressStoreKeyPrefix
+	if cursor := store.Get(types.DustAccountCursorKey); cursor != nil {
+		// the smallest key following the key of the cursor
+		start = append(types.AddressStoreKey(cursor), 0x00)
+	}
+
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(types.AddressStoreKeyPrefix))
+	defer iterator.Close()
+
+	accounts := make([]types.AccountI, 0, limit)
+	for ; iterator.Valid() && uint64(len(accounts)) < limit; iterator.Next() {
+		accounts = append(accounts, ak.decodeAccount(iterator.Value()))
+	}
+
+	if uint64(len(accounts)) < limit {
+		// all the accounts were examined, start over in the next block
+		store.Delete(types.DustAccountCursorKey)
+	} else {
+		store.Set(types.DustAccountCursorKey, accounts[len(accounts)-1].GetAddress())
+	}
+
+	return accounts
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+)
+
+func TestPruneDustAccounts(t *testing.T) {
+	app, ctx := createTestApp(t, false)
+	ak := app.AccountKeeper
+
+	funded := sdk.AccAddress([]byte("funded--------------"))
+	dust := sdk.AccAddress([]byte("dust----------------"))
+	active := sdk.AccAddress([]byte("active--------------"))
+	for _, addr := range []sdk.AccAddress{funded, dust, active} {
+		ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, addr))
+	}
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, funded, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+
+	dustAcc := ak.GetAccount(ctx, dust)
+	require.NoError(t, dustAcc.SetSequence(5))
+	ak.SetAccount(ctx, dustAcc)
+
+	params := types.DefaultParams()
+	params.DustAccountRetentionBlocks = 2
+	ak.SetParams(ctx, params)
+
+	prune := func(height int64) {
+		ctx = ctx.WithBlockHeight(height)
+		ak.PruneDustAccounts(ctx, app.BankKeeper, app.StakingKeeper)
+	}
+
+	// nothing is marked while the pruning is disabled
+	prune(1)
+	_, found := ak.GetDustAccount(ctx, dust)
+	require.False(t, found)
+
+	params.PruneDustAccountsPerBlock = types.MaxPruneDustAccountsPerBlock
+	ak.SetParams(ctx, params)
+
+	prune(2)
+	_, found = ak.GetDustAccount(ctx, funded)
+	require.False(t, found)
+	mark, found := ak.GetDustAccount(ctx, dust)
+	require.True(t, found)
+	require.Equal(t, types.NewDustAccount(dust, 5, 2), mark)
+	_, found = ak.GetDustAccount(ctx, active)
+	require.True(t, found)
+
+	// module accounts are never marked
+	_, found = ak.GetDustAccount(ctx, ak.GetModuleAddress(types.FeeCollectorName))
+	require.False(t, found)
+
+	// a transaction signed by the account resets its mark
+	activeAcc := ak.GetAccount(ctx, active)
+	require.NoError(t, activeAcc.SetSequence(1))
+	ak.SetAccount(ctx, activeAcc)
+
+	prune(3)
+	require.True(t, ak.HasAccount(ctx, dust))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	prune(4)
+	require.False(t, ak.HasAccount(ctx, dust))
+	require.True(t, ak.HasAccount(ctx, active))
+	require.True(t, ak.HasAccount(ctx, funded))
+	_, found = ak.GetDustAccount(ctx, dust)
+	require.False(t, found)
+	require.Equal(t, []types.PrunedAccount{types.NewPrunedAccount(dustAcc)}, ak.GetAllPrunedAccounts(ctx))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypePruneAccount, events[0].Type)
+
+	// the account number and sequence are restored when the account is created
+	// again
+	acc := ak.NewAccountWithAddress(ctx, dust)
+	require.Equal(t, dustAcc.GetAccountNumber(), acc.GetAccountNumber())
+	require.Equal(t, uint64(5), acc.GetSequence())
+	require.Empty(t, ak.GetAllPrunedAccounts(ctx))
+}
+
+func TestPruneDustAccountsIncrementally(t *testing.T) {
+	app, ctx := createTestApp(t, false)
+	ak := app.AccountKeeper
+
+	params := types.DefaultParams()
+	params.PruneDustAccountsPerBlock = 1
+	ak.SetParams(ctx, params)
+
+	dust := sdk.AccAddress([]byte("dust----------------"))
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, dust))
+
+	// a single account is examined per block in address order, so the dust
+	// account is marked once all the accounts before it were examined
+	accounts := ak.GetAllAccounts(ctx)
+	dustIndex := -1
+	for i, acc := range accounts {
+		if acc.GetAddress().Equals(dust) {
+			dustIndex = i
+		}
+	}
+	require.NotEqual(t, -1, dustIndex)
+
+	for i := range accounts {
+		ak.PruneDustAccounts(ctx.WithBlockHeight(int64(i+1)), app.BankKeeper, app.StakingKeeper)
+
+		_, found := ak.GetDustAccount(ctx, dust)
+		require.Equal(t, i >= dustIndex, found)
+	}
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetPubKeyAlias returns the public key authenticating an account whose
+// address isn't derived from it, or nil if the account has no alias.
+func (ak AccountKeeper) GetPubKeyAlias(ctx sdk.Context, addr sdk.AccAddress) cryptotypes.PubKey {
+	store := ctx.KVStore(ak.key)
+	bz := store.Get(types.PubKeyAliasKey(addr))
+	if bz == nil {
+		return nil
+	}
+
+	var alias types.PubKeyAlias
+	ak.cdc.MustUnmarshal(bz, &alias)
+	return alias.GetPubKey()
+}
+
+// SetPubKeyAlias sets the public key alias of an account.
+func (ak AccountKeeper) SetPubKeyAlias(ctx sdk.Context, alias types.PubKeyAlias) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.PubKeyAliasKey(alias.GetAddress()), ak.cdc.MustMarshal(&alias))
+}
+
+// RemovePubKeyAlias removes the public key alias of an account.
+func (ak AccountKeeper) RemovePubKeyAlias(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(ak.key)
+	store.Delete(types.PubKeyAliasKey(addr))
+}
+
+// GetAllPubKeyAliases returns all the public key aliases.
+func (ak AccountKeeper) GetAllPubKeyAliases(ctx sdk.Context) (aliases []types.PubKeyAlias) {
+	store := ctx.KVStore(ak.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.PubKeyAliasKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var alias types.PubKeyAlias
+		ak.cdc.MustUnmarshal(iterator.Value(), &alias)
+		aliases = append(aliases, alias)
+	}
+
+	return aliases
+}
+
+// UpdateMultisig replaces the multisig public key of an account, keeping its
+// address. The new public key is recorded as the alias of the account, unless
+// the account address is derived from it, so that the account can still be
+// authenticated once pruned.
+func (ak AccountKeeper) UpdateMultisig(ctx sdk.Context, addr sdk.AccAddress, pubKey *multisig.LegacyAminoPubKey) error {
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+
+	oldPubKey, ok := acc.GetPubKey().(*multisig.LegacyAminoPubKey)
+	if !ok {
+		return sdkerrors.Wrap(types.ErrNotMultisigAccount, addr.String())
+	}
+	if oldPubKey.Equals(pubKey) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "public key is unchanged")
+	}
+
+	if sigLimit := ak.GetParams(ctx).TxSigLimit; uint64(len(pubKey.GetPubKeys())) > sigLimit {
+		return sdkerrors.Wrapf(sdkerrors.ErrTooManySignatures, "multisig has %d members, limit is %d", len(pubKey.GetPubKeys()), sigLimit)
+	}
+
+	if bytes.Equal(pubKey.Address(), addr) {
+		ak.RemovePubKeyAlias(ctx, addr)
+	} else {
+		alias, err := types.NewPubKeyAlias(addr, pubKey)
+		if err != nil {
+			return err
+		}
+		ak.SetPubKeyAlias(ctx, alias)
+	}
+
+	if err := acc.SetPubKey(pubKey); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	ak.SetAccount(ctx, acc)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateMultisig,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyThreshold, fmt.Sprintf("%d", pubKey.Threshold)),
+			sdk.NewAttribute(types.AttributeKeyMembers, fmt.Sprintf("%d", len(pubKey.PubKeys))),
+		),
+	)
+
+	return nil
+}
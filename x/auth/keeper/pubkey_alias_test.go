@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestUpdateMultisig(t *testing.T) {
+	app, ctx := createTestApp(t, false)
+	ak := app.AccountKeeper
+
+	members := make([]cryptotypes.PubKey, 4)
+	for i := range members {
+		members[i] = secp256k1.GenPrivKey().PubKey()
+	}
+	oldPubKey := multisig.NewLegacyAminoPubKey(2, members[:3])
+	newPubKey := multisig.NewLegacyAminoPubKey(3, members[1:])
+
+	addr := sdk.AccAddress(oldPubKey.Address())
+	acc := ak.NewAccountWithAddress(ctx, addr)
+	require.NoError(t, acc.SetPubKey(oldPubKey))
+	ak.SetAccount(ctx, acc)
+
+	// single key accounts can't be updated
+	single := secp256k1.GenPrivKey().PubKey()
+	singleAcc := ak.NewAccountWithAddress(ctx, sdk.AccAddress(single.Address()))
+	require.NoError(t, singleAcc.SetPubKey(single))
+	ak.SetAccount(ctx, singleAcc)
+	err := ak.UpdateMultisig(ctx, singleAcc.GetAddress(), newPubKey)
+	require.ErrorIs(t, err, types.ErrNotMultisigAccount)
+
+	err = ak.UpdateMultisig(ctx, addr, oldPubKey)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+
+	// the new public key is aliased to the account address
+	require.NoError(t, ak.UpdateMultisig(ctx, addr, newPubKey))
+	require.True(t, newPubKey.Equals(ak.GetAccount(ctx, addr).GetPubKey()))
+	require.True(t, newPubKey.Equals(ak.GetPubKeyAlias(ctx, addr)))
+	require.Len(t, ak.GetAllPubKeyAliases(ctx), 1)
+
+	// the alias outlives the pruning of the account
+	ak.PruneAccount(ctx, ak.GetAccount(ctx, addr))
+	require.True(t, newPubKey.Equals(ak.GetPubKeyAlias(ctx, addr)))
+
+	// restoring the public key the address is derived from removes the alias
+	acc = ak.NewAccountWithAddress(ctx, addr)
+	require.NoError(t, acc.SetPubKey(newPubKey))
+	ak.SetAccount(ctx, acc)
+	require.NoError(t, ak.UpdateMultisig(ctx, addr, oldPubKey))
+	require.Nil(t, ak.GetPubKeyAlias(ctx, addr))
+	require.Empty(t, ak.GetAllPubKeyAliases(ctx))
+
+	// the members are limited by the signature limit
+	params := ak.GetParams(ctx)
+	params.TxSigLimit = 3
+	ak.SetParams(ctx, params)
+	err = ak.UpdateMultisig(ctx, addr, multisig.NewLegacyAminoPubKey(2, members))
+	require.ErrorIs(t, err, sdkerrors.ErrTooManySignatures)
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"context"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// MaxGasRefundQuotient bounds the gas refunded at the end of a transaction for
+// deleted state to the gas consumed divided by this quotient.
+const MaxGasRefundQuotient = 2
+
+// KVGasConfigKeeper defines the expected keeper providing the KVStore gas
+// config, which is implemented by the auth module's AccountKeeper.
+type KVGasConfigKeeper interface {
+	GetKVGasConfig(ctx sdk.Context) storetypes.GasConfig
+}
+
+type kvGasTxHandler struct {
+	gk   KVGasConfigKeeper
+	next tx.Handler
+}
+
+// NewKVGasTxMiddleware defines a middleware that sets the KVStore gas config of
+// the sdk.Context from the auth module parameters, and refunds the gas credited
+// for deleted state once the transaction succeeds, up to the gas consumed
+// divided by MaxGasRefundQuotient. It must be inside the Gas middleware, and
+// outside of all middlewares accessing stores.
+func NewKVGasTxMiddleware(gk KVGasConfigKeeper) tx.Middleware {
+	return func(txh tx.Handler) tx.Handler {
+		return kvGasTxHandler{gk: gk, next: txh}
+	}
+}
+
+var _ tx.Handler = kvGasTxHandler{}
+
+// CheckTx implements tx.Handler.CheckTx.
+func (txh kvGasTxHandler) CheckTx(ctx context.Context, tx sdk.Tx, req abci.RequestCheckTx) (abci.ResponseCheckTx, error) {
+	sdkCtx := txh.kvGasContext(sdk.UnwrapSDKContext(ctx))
+	res, err := txh.next.CheckTx(sdk.WrapSDKContext(sdkCtx), tx, req)
+	if err == nil {
+		refundGas(sdkCtx.GasMeter())
+	}
+
+	return res, err
+}
+
+// DeliverTx implements tx.Handler.DeliverTx.
+func (txh kvGasTxHandler) DeliverTx(ctx context.Context, tx sdk.Tx, req abci.RequestDeliverTx) (abci.ResponseDeliverTx, error) {
+	sdkCtx := txh.kvGasContext(sdk.UnwrapSDKContext(ctx))
+	res, err := txh.next.DeliverTx(sdk.WrapSDKContext(sdkCtx), tx, req)
+	if err == nil {
+		refundGas(sdkCtx.GasMeter())
+	}
+
+	return res, err
+}
+
+// SimulateTx implements tx.Handler.SimulateTx method.
+func (txh kvGasTxHandler) SimulateTx(ctx context.Context, sdkTx sdk.Tx, req tx.RequestSimulateTx) (tx.ResponseSimulateTx, error) {
+	sdkCtx := txh.kvGasContext(sdk.UnwrapSDKContext(ctx))
+	res, err := txh.next.SimulateTx(sdk.WrapSDKContext(sdkCtx), sdkTx, req)
+	if err == nil {
+		refundGas(sdkCtx.GasMeter())
+	}
+
+	return res, err
+}
+
+// kvGasContext returns a new context with the KVStore gas config set from the
+// auth module parameters, which are read without consuming gas.
+func (txh kvGasTxHandler) kvGasContext(ctx sdk.Context) sdk.Context {
+	kvGasConfig := txh.gk.GetKVGasConfig(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+	return ctx.WithKVGasConfig(kvGasConfig)
+}
+
+// refundGas refunds the gas credited to a RefundableGasMeter, up to the gas
+// consumed divided by MaxGasRefundQuotient.
+func refundGas(meter sdk.GasMeter) {
+	refundable, ok := meter.(sdk.RefundableGasMeter)
+	if !ok {
+		return
+	}
+
+	refund := refundable.GasRefund()
+	if max := meter.GasConsumed() / MaxGasRefundQuotient; refund > max {
+		refund = max
+	}
+	if refund > 0 {
+		meter.RefundGas(refund, storetypes.GasDeleteRefundDesc)
+	}
+}
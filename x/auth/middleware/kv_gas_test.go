@@ -0,0 +1,79 @@
+package middleware_test
+
+import (
+	"context"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (s *MWTestSuite) TestKVGasRefund() {
+	sdkTx, _, ctx, _ := s.setupGasTx()
+	key := s.app.GetKey(authtypes.StoreKey)
+	deletedKey := []byte("deleted")
+
+	params := authtypes.DefaultParams()
+	params.KVGasConfig.DeleteRefundPerByte = 10
+	s.app.AccountKeeper.SetParams(ctx, params)
+	deleteCost := params.KVGasConfig.DeleteCost
+
+	testcases := []struct {
+		name       string
+		valueLen   int
+		err        error
+		expGasUsed uint64
+	}{
+		{"refund below cap", 20, nil, deleteCost - 200},
+		{"refund capped to half of the consumed gas", 1000, nil, deleteCost / middleware.MaxGasRefundQuotient},
+		{"no refund on failure", 20, sdkerrors.ErrUnauthorized, deleteCost},
+	}
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			ctx.KVStore(key).Set(deletedKey, make([]byte, tc.valueLen))
+			txHandler := middleware.ComposeMiddlewares(
+				deleteTxHandler{key: key, deletedKey: deletedKey, err: tc.err},
+				middleware.GasTxMiddleware,
+				middleware.NewKVGasTxMiddleware(s.app.AccountKeeper),
+			)
+
+			res, err := txHandler.DeliverTx(sdk.WrapSDKContext(ctx), sdkTx, abci.RequestDeliverTx{})
+			s.Require().ErrorIs(err, tc.err)
+			s.Require().Equal(tc.expGasUsed, uint64(res.GasUsed))
+		})
+	}
+
+	// without the middleware, the default KVStore gas config is used
+	ctx.KVStore(key).Set(deletedKey, make([]byte, 20))
+	txHandler := middleware.ComposeMiddlewares(deleteTxHandler{key: key, deletedKey: deletedKey}, middleware.GasTxMiddleware)
+	res, err := txHandler.DeliverTx(sdk.WrapSDKContext(ctx), sdkTx, abci.RequestDeliverTx{})
+	s.Require().NoError(err)
+	s.Require().Equal(storetypes.KVGasConfig().DeleteCost, uint64(res.GasUsed))
+}
+
+// deleteTxHandler is a test middleware that deletes a key from a store.
+type deleteTxHandler struct {
+	key        sdk.StoreKey
+	deletedKey []byte
+	err        error
+}
+
+var _ tx.Handler = deleteTxHandler{}
+
+func (txh deleteTxHandler) CheckTx(ctx context.Context, _ sdk.Tx, _ abci.RequestCheckTx) (abci.ResponseCheckTx, error) {
+	sdk.UnwrapSDKContext(ctx).KVStore(txh.key).Delete(txh.deletedKey)
+	return abci.ResponseCheckTx{}, txh.err
+}
+func (txh deleteTxHandler) SimulateTx(ctx context.Context, _ sdk.Tx, _ tx.RequestSimulateTx) (tx.ResponseSimulateTx, error) {
+	sdk.UnwrapSDKContext(ctx).KVStore(txh.key).Delete(txh.deletedKey)
+	return tx.ResponseSimulateTx{}, txh.err
+}
+func (txh deleteTxHandler) DeliverTx(ctx context.Context, _ sdk.Tx, _ abci.RequestDeliverTx) (abci.ResponseDeliverTx, error) {
+	sdk.UnwrapSDKContext(ctx).KVStore(txh.key).Delete(txh.deletedKey)
+	return abci.ResponseDeliverTx{}, txh.err
+}
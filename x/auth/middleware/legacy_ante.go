@@ -5,6 +5,7 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
@@ -78,7 +79,9 @@ func (txh legacyAnteTxHandler) runAnte(ctx context.Context, tx sdk.Tx, txBytes [
 	// performance benefits, but it'll be more difficult to get right.
 	anteCtx, msCache := cacheTxContext(sdkCtx, txBytes)
 	anteCtx = anteCtx.WithEventManager(sdk.NewEventManager())
-	newCtx, err := txh.anteHandler(anteCtx, tx, isSimulate)
+	spanCtx, span := telemetry.StartSpan(anteCtx.Context(), telemetry.SpanNameAnteHandler)
+	newCtx, err := txh.anteHandler(anteCtx.WithContext(spanCtx), tx, isSimulate)
+	telemetry.EndSpan(span, err)
 	if err != nil {
 		return sdk.Context{}, err
 	}
@@ -90,7 +93,10 @@ func (txh legacyAnteTxHandler) runAnte(ctx context.Context, tx sdk.Tx, txBytes [
 		// Also, in the case of the tx aborting, we need to track gas consumed via
 		// the instantiated gas meter in the AnteHandler, so we update the context
 		// prior to returning.
-		sdkCtx = newCtx.WithMultiStore(ms)
+		//
+		// The ante handler span has ended, so the tx span is restored as the
+		// parent of the Msg handler spans.
+		sdkCtx = newCtx.WithMultiStore(ms).WithContext(sdkCtx.Context())
 	}
 
 	msCache.Write()
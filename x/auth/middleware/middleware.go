@@ -36,13 +36,17 @@ type TxHandlerOptions struct {
 	MsgServiceRouter *MsgServiceRouter
 
 	LegacyAnteHandler sdk.AnteHandler
+
+	// KVGasConfigKeeper provides the KVStore gas config, usually the auth
+	// module's AccountKeeper. If nil, the default KVStore gas config is used
+	// and no gas is refunded for deleted state.
+	KVGasConfigKeeper KVGasConfigKeeper
 }
 
 // NewDefaultTxHandler defines a TxHandler middleware stacks that should work
 // for most applications.
 func NewDefaultTxHandler(options TxHandlerOptions) (tx.Handler, error) {
-	return ComposeMiddlewares(
-		NewRunMsgsTxHandler(options.MsgServiceRouter, options.LegacyRouter),
+	middlewares := []tx.Middleware{
 		// Set a new GasMeter on sdk.Context.
 		//
 		// Make sure the Gas middleware is outside of all other middlewares
@@ -52,11 +56,24 @@ func NewDefaultTxHandler(options TxHandlerOptions) (tx.Handler, error) {
 		// Recover from panics. Panics outside of this middleware won't be
 		// caught, be careful!
 		RecoveryTxMiddleware,
+	}
+	if options.KVGasConfigKeeper != nil {
+		// Set the KVStore gas config and refund gas for deleted state. Make
+		// sure this middleware is inside the Recovery middleware, so that
+		// refunds are reflected in GasInfo and block gas.
+		middlewares = append(middlewares, NewKVGasTxMiddleware(options.KVGasConfigKeeper))
+	}
+	middlewares = append(middlewares,
 		// Choose which events to index in Tendermint. Make sure no events are
 		// emitted outside of this middleware.
 		NewIndexEventsTxMiddleware(options.IndexEvents),
 		// Temporary middleware to bundle antehandlers.
 		// TODO Remove in https://github.com/cosmos/cosmos-sdk/issues/9585.
 		newLegacyAnteMiddleware(options.LegacyAnteHandler),
+	)
+
+	return ComposeMiddlewares(
+		NewRunMsgsTxHandler(options.MsgServiceRouter, options.LegacyRouter),
+		middlewares...,
 	), nil
 }
@@ -125,7 +125,18 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "Expecting proto.Message, got %T", resMsg)
 			}
 
-			return sdk.WrapServiceResult(ctx, resMsg, err)
+			result, err := sdk.WrapServiceResult(ctx, resMsg, err)
+			if err != nil {
+				return nil, err
+			}
+
+			// tag the events with the type URL of the message, except the
+			// events of nested messages which are tagged with their own
+			result.Events = result.GetEvents().
+				WithAttributeIfMissing(sdk.NewAttribute(sdk.AttributeKeyMsgTypeURL, requestTypeName)).
+				ToABCIEvents()
+
+			return result, nil
 		}
 	}
 }
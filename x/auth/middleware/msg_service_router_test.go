@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -83,10 +84,11 @@ func TestMsgService(t *testing.T) {
 	_ = app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
 
 	msg := testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	msg2 := testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Rex"}}
 	txBuilder := encCfg.TxConfig.NewTxBuilder()
 	txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
 	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
-	err = txBuilder.SetMsgs(&msg)
+	err = txBuilder.SetMsgs(&msg, &msg2)
 	require.NoError(t, err)
 
 	// First round: we gather all the signer infos. We use the "set empty
@@ -121,4 +123,19 @@ func TestMsgService(t *testing.T) {
 	require.NoError(t, err)
 	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
 	require.Equal(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
+
+	// the events of each message are tagged with its index and type URL
+	var msgIndexes []string
+	for _, event := range res.Events {
+		if event.Type != sdk.EventTypeMessage {
+			continue
+		}
+		attrs := map[string]string{}
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		require.Equal(t, sdk.MsgTypeURL(&msg), attrs[sdk.AttributeKeyMsgTypeURL])
+		msgIndexes = append(msgIndexes, attrs[sdk.AttributeKeyMsgIndex])
+	}
+	require.Equal(t, []string{"0", "1"}, msgIndexes)
 }
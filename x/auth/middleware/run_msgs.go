@@ -3,12 +3,14 @@ package middleware
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
@@ -90,9 +92,11 @@ func (txh runMsgsTxHandler) runMsgs(sdkCtx sdk.Context, msgs []sdk.Msg, txBytes
 			err          error
 		)
 
+		spanCtx, span := telemetry.StartSpan(runMsgCtx.Context(), telemetry.SpanNameMsgHandler, telemetry.MsgAttrs(sdk.MsgTypeURL(msg), i)...)
+
 		if handler := txh.msgServiceRouter.Handler(msg); handler != nil {
 			// ADR 031 request type routing
-			msgResult, err = handler(runMsgCtx, msg)
+			msgResult, err = handler(runMsgCtx.WithContext(spanCtx), msg)
 			eventMsgName = sdk.MsgTypeURL(msg)
 		} else if legacyMsg, ok := msg.(legacytx.LegacyMsg); ok {
 			// legacy sdk.Msg routing
@@ -104,14 +108,17 @@ func (txh runMsgsTxHandler) runMsgs(sdkCtx sdk.Context, msgs []sdk.Msg, txBytes
 			eventMsgName = legacyMsg.Type()
 			handler := txh.legacyRouter.Route(sdkCtx, msgRoute)
 			if handler == nil {
+				span.End()
 				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
 			}
 
-			msgResult, err = handler(sdkCtx, msg)
+			msgResult, err = handler(sdkCtx.WithContext(spanCtx), msg)
 		} else {
+			span.End()
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "can't route message %+v", msg)
 		}
 
+		telemetry.EndSpan(span, err)
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
@@ -121,6 +128,12 @@ func (txh runMsgsTxHandler) runMsgs(sdkCtx sdk.Context, msgs []sdk.Msg, txBytes
 		}
 		msgEvents = msgEvents.AppendEvents(msgResult.GetEvents())
 
+		// tag every event of the message with its index and type URL, so that
+		// clients can attribute the events of multi-message transactions
+		msgEvents = msgEvents.
+			WithAttributeIfMissing(sdk.NewAttribute(sdk.AttributeKeyMsgTypeURL, sdk.MsgTypeURL(msg))).
+			WithAttributeIfMissing(sdk.NewAttribute(sdk.AttributeKeyMsgIndex, strconv.Itoa(i)))
+
 		// append message events, data and logs
 		//
 		// Note: Each message result's data must be length-prefixed in order to
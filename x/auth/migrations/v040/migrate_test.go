@@ -233,12 +233,26 @@ func TestMigrate(t *testing.T) {
     }
   ],
   "params": {
+    "dust_account_retention_blocks": "0",
+    "kv_gas_config": {
+      "delete_cost": "0",
+      "delete_refund_per_byte": "0",
+      "has_cost": "0",
+      "iter_next_cost_flat": "0",
+      "read_cost_flat": "0",
+      "read_cost_per_byte": "0",
+      "write_cost_flat": "0",
+      "write_cost_per_byte": "0"
+    },
     "max_memo_characters": "10",
+    "prune_dust_accounts_per_block": "0",
     "sig_verify_cost_ed25519": "40",
     "sig_verify_cost_secp256k1": "50",
     "tx_sig_limit": "20",
     "tx_size_cost_per_byte": "30"
-  }
+  },
+  "pruned_accounts": [],
+  "pub_key_aliases": []
 }`
 
 	bz, err := clientCtx.Codec.MarshalJSON(migrated)
@@ -0,0 +1,24 @@
+package v044
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateParams performs in-place params migrations from v0.43 to v0.44. The
+// migration includes:
+//
+// - Set the KVStore gas config parameter to its default value.
+// - Set the dust account pruning parameters to their default values, which
+//   leave the pruning disabled.
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) {
+	kvGasConfig := types.DefaultKVGasConfig()
+	paramSpace.Set(ctx, types.KeyKVGasConfig, &kvGasConfig)
+
+	pruneDustAccountsPerBlock := types.DefaultPruneDustAccountsPerBlock
+	paramSpace.Set(ctx, types.KeyPruneDustAccountsPerBlock, &pruneDustAccountsPerBlock)
+
+	dustAccountRetentionBlocks := types.DefaultDustAccountRetentionBlocks
+	paramSpace.Set(ctx, types.KeyDustAccountRetentionBlocks, &dustAccountRetentionBlocks)
+}
@@ -0,0 +1,31 @@
+package v044_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	v044 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v044"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// params stored before the KVStore gas config and the dust account pruning
+	// were added
+	paramSpace := app.GetSubspace(types.ModuleName)
+	params := types.DefaultParams()
+	store := ctx.KVStore(app.GetKey(paramtypes.StoreKey))
+	for _, key := range [][]byte{types.KeyKVGasConfig, types.KeyPruneDustAccountsPerBlock, types.KeyDustAccountRetentionBlocks} {
+		store.Delete(append([]byte(types.ModuleName+"/"), key...))
+		require.False(t, paramSpace.Has(ctx, key))
+	}
+
+	v044.MigrateParams(ctx, paramSpace)
+	require.Equal(t, params, app.AccountKeeper.GetParams(ctx))
+}
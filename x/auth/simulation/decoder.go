@@ -7,6 +7,7 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -34,6 +35,27 @@ func NewDecodeStore(ak AuthUnmarshaler) func(kvA, kvB kv.Pair) string {
 
 			return fmt.Sprintf("%v\n%v", accA, accB)
 
+		case bytes.Equal(kvA.Key[:1], types.DustAccountKeyPrefix):
+			var dustA, dustB types.DustAccount
+			ak.GetCodec().MustUnmarshal(kvA.Value, &dustA)
+			ak.GetCodec().MustUnmarshal(kvB.Value, &dustB)
+
+			return fmt.Sprintf("%v\n%v", dustA, dustB)
+
+		case bytes.Equal(kvA.Key[:1], types.PrunedAccountKeyPrefix):
+			var prunedA, prunedB types.PrunedAccount
+			ak.GetCodec().MustUnmarshal(kvA.Value, &prunedA)
+			ak.GetCodec().MustUnmarshal(kvB.Value, &prunedB)
+
+			return fmt.Sprintf("%v\n%v", prunedA, prunedB)
+
+		case bytes.Equal(kvA.Key[:1], types.PubKeyAliasKeyPrefix):
+			var aliasA, aliasB types.PubKeyAlias
+			ak.GetCodec().MustUnmarshal(kvA.Value, &aliasA)
+			ak.GetCodec().MustUnmarshal(kvB.Value, &aliasB)
+
+			return fmt.Sprintf("%v\n%v", aliasA, aliasB)
+
 		case bytes.Equal(kvA.Key, types.GlobalAccountNumberKey):
 			var globalAccNumberA, globalAccNumberB gogotypes.UInt64Value
 			ak.GetCodec().MustUnmarshal(kvA.Value, &globalAccNumberA)
@@ -41,6 +63,9 @@ func NewDecodeStore(ak AuthUnmarshaler) func(kvA, kvB kv.Pair) string {
 
 			return fmt.Sprintf("GlobalAccNumberA: %d\nGlobalAccNumberB: %d", globalAccNumberA, globalAccNumberB)
 
+		case bytes.Equal(kvA.Key, types.DustAccountCursorKey):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
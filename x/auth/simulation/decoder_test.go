@@ -30,6 +30,10 @@ func TestDecodeStore(t *testing.T) {
 	require.NoError(t, err)
 
 	globalAccNumber := gogotypes.UInt64Value{Value: 10}
+	dust := types.NewDustAccount(delAddr1, 1, 10)
+	pruned := types.NewPrunedAccount(acc)
+	alias, err := types.NewPubKeyAlias(delAddr1, delPk1)
+	require.NoError(t, err)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -37,10 +41,26 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.AddressStoreKey(delAddr1),
 				Value: accBz,
 			},
+			{
+				Key:   types.DustAccountKey(delAddr1),
+				Value: cdc.MustMarshal(&dust),
+			},
+			{
+				Key:   types.PrunedAccountKey(delAddr1),
+				Value: cdc.MustMarshal(&pruned),
+			},
+			{
+				Key:   types.PubKeyAliasKey(delAddr1),
+				Value: cdc.MustMarshal(&alias),
+			},
 			{
 				Key:   types.GlobalAccountNumberKey,
 				Value: cdc.MustMarshal(&globalAccNumber),
 			},
+			{
+				Key:   types.DustAccountCursorKey,
+				Value: delAddr1,
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -52,7 +72,11 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Account", fmt.Sprintf("%v\n%v", acc, acc)},
+		{"DustAccount", fmt.Sprintf("%v\n%v", dust, dust)},
+		{"PrunedAccount", fmt.Sprintf("%v\n%v", pruned, pruned)},
+		{"PubKeyAlias", fmt.Sprintf("%v\n%v", alias, alias)},
 		{"GlobalAccNumber", fmt.Sprintf("GlobalAccNumberA: %d\nGlobalAccNumberB: %d", globalAccNumber, globalAccNumber)},
+		{"DustAccountCursor", fmt.Sprintf("%v\n%v", delAddr1, delAddr1)},
 		{"other", ""},
 	}
 
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDustAccount creates a new DustAccount instance.
+//nolint:interfacer
+func NewDustAccount(addr sdk.AccAddress, sequence uint64, height int64) DustAccount {
+	return DustAccount{
+		Address:  addr.String(),
+		Sequence: sequence,
+		Height:   height,
+	}
+}
+
+// GetAddress returns the address of the account without balance.
+func (d DustAccount) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(d.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	return addr
+}
+
+// NewPrunedAccount creates a new PrunedAccount instance reserving the account
+// number and sequence of an account.
+func NewPrunedAccount(acc AccountI) PrunedAccount {
+	return PrunedAccount{
+		Address:       acc.GetAddress().String(),
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      acc.GetSequence(),
+	}
+}
+
+// GetAddress returns the address of the pruned account.
+func (p PrunedAccount) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	return addr
+}
+
+// ValidatePrunedAccounts validates the reservations of the pruned accounts and
+// checks that their addresses are neither duplicated nor used by an account.
+func ValidatePrunedAccounts(prunedAccounts []PrunedAccount, accounts GenesisAccounts) error {
+	addrMap := make(map[string]bool, len(accounts)+len(prunedAccounts))
+	for _, acc := range accounts {
+		addrMap[acc.GetAddress().String()] = true
+	}
+
+	for _, pruned := range prunedAccounts {
+		if _, err := sdk.AccAddressFromBech32(pruned.Address); err != nil {
+			return fmt.Errorf("invalid pruned account address %s: %w", pruned.Address, err)
+		}
+		if addrMap[pruned.Address] {
+			return fmt.Errorf("duplicate pruned account found in genesis state; address: %s", pruned.Address)
+		}
+
+		addrMap[pruned.Address] = true
+	}
+
+	return nil
+}
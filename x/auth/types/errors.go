@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/auth module sentinel errors
+var (
+	ErrSignerInfoCountMismatch = sdkerrors.Register(ModuleName, 2, "number of signer infos does not match number of signers")
+	ErrSignatureCountMismatch  = sdkerrors.Register(ModuleName, 3, "number of signatures does not match number of signers")
+	ErrDuplicateSignerInfo     = sdkerrors.Register(ModuleName, 4, "duplicate signer info")
+	ErrDuplicateSignature      = sdkerrors.Register(ModuleName, 5, "duplicate signature")
+	ErrNotMultisigAccount      = sdkerrors.Register(ModuleName, 6, "account is not a multisig account")
+	ErrPubKeyAliased           = sdkerrors.Register(ModuleName, 7, "public key already authenticates another account")
+	ErrIncompleteMultisig      = sdkerrors.Register(ModuleName, 8, "multisignature is not signed by all the members")
+)
@@ -0,0 +1,13 @@
+package types
+
+// auth module event types
+const (
+	EventTypePruneAccount   = "prune_account"
+	EventTypeUpdateMultisig = "update_multisig"
+
+	AttributeKeyAddress   = "address"
+	AttributeKeyThreshold = "threshold"
+	AttributeKeyMembers   = "members"
+
+	AttributeValueCategory = ModuleName
+)
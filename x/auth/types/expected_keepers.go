@@ -8,3 +8,15 @@ import (
 type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
+
+// BalanceKeeper defines the bank methods used to find accounts without
+// balance (noalias)
+type BalanceKeeper interface {
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// DelegationKeeper defines the staking methods used to keep the accounts
+// which delegated their balance from being pruned (noalias)
+type DelegationKeeper interface {
+	HasDelegations(ctx sdk.Context, delegator sdk.AccAddress) bool
+}
@@ -38,6 +38,11 @@ func (g GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, alias := range g.PubKeyAliases {
+		if err := alias.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -70,7 +75,15 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	if err := ValidateGenAccounts(genAccs); err != nil {
+		return err
+	}
+
+	if err := ValidatePrunedAccounts(data.PrunedAccounts, genAccs); err != nil {
+		return err
+	}
+
+	return ValidatePubKeyAliases(data.PubKeyAliases)
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
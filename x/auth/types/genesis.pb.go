@@ -30,6 +30,11 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*types.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// pruned_accounts are the reservations of the pruned accounts.
+	PrunedAccounts []PrunedAccount `protobuf:"bytes,3,rep,name=pruned_accounts,json=prunedAccounts,proto3" json:"pruned_accounts" yaml:"pruned_accounts"`
+	// pub_key_aliases are the public keys authenticating the accounts whose
+	// address isn't derived from them.
+	PubKeyAliases []PubKeyAlias `protobuf:"bytes,4,rep,name=pub_key_aliases,json=pubKeyAliases,proto3" json:"pub_key_aliases" yaml:"pub_key_aliases"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +84,20 @@ func (m *GenesisState) GetAccounts() []*types.Any {
 	return nil
 }
 
+func (m *GenesisState) GetPrunedAccounts() []PrunedAccount {
+	if m != nil {
+		return m.PrunedAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPubKeyAliases() []PubKeyAlias {
+	if m != nil {
+		return m.PubKeyAliases
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
 }
@@ -86,23 +105,29 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x82, 0xe5, 0x95,
-	0xaa, 0xb9, 0x78, 0xdc, 0x21, 0xd6, 0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x59, 0x72, 0xb1, 0x15,
-	0x24, 0x16, 0x25, 0xe6, 0x16, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x49, 0xeb, 0x61, 0x71,
-	0x86, 0x5e, 0x00, 0x58, 0x89, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x0d, 0x42, 0x06,
-	0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc,
-	0x46, 0x22, 0x7a, 0x10, 0xe7, 0xea, 0xc1, 0x9c, 0xab, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57, 0xe5,
-	0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
-	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99, 0x25,
-	0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x1f, 0x40, 0x28, 0xdd, 0xe2, 0x94, 0x6c,
-	0xfd, 0x0a, 0x88, 0x77, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x86, 0x1b, 0x03, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xac, 0xe2, 0xe7, 0xb6, 0x53, 0x01, 0x00, 0x00,
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x4f, 0x4f, 0xc2, 0x30,
+	0x18, 0x87, 0x37, 0x20, 0xc4, 0x0c, 0x95, 0x64, 0x12, 0x83, 0x98, 0x14, 0xdc, 0x09, 0x0f, 0xb6,
+	0x82, 0x27, 0xbd, 0x81, 0x07, 0x0f, 0x5e, 0xcc, 0xbc, 0x79, 0x21, 0xdd, 0xa8, 0x83, 0xc0, 0xd6,
+	0x86, 0xb6, 0xc6, 0x7e, 0x0b, 0x3f, 0x16, 0x47, 0xe2, 0xc9, 0x13, 0x31, 0xec, 0x1b, 0xf8, 0x09,
+	0xcc, 0xda, 0x89, 0xff, 0x76, 0xea, 0x9b, 0xf6, 0x79, 0xdf, 0xe7, 0x97, 0xbe, 0xce, 0x49, 0x48,
+	0x79, 0x4c, 0x39, 0xc2, 0x52, 0x4c, 0xd0, 0x53, 0x2f, 0x20, 0x02, 0xf7, 0x50, 0x44, 0x12, 0xc2,
+	0xa7, 0x1c, 0xb2, 0x05, 0x15, 0xd4, 0x3d, 0x30, 0x08, 0xcc, 0x10, 0x98, 0x23, 0xad, 0xa3, 0x88,
+	0xd2, 0x68, 0x4e, 0x90, 0x46, 0x02, 0xf9, 0x88, 0x70, 0xa2, 0x0c, 0xdf, 0x6a, 0x44, 0x34, 0xa2,
+	0xba, 0x44, 0x59, 0x95, 0xdf, 0x82, 0x22, 0x91, 0x1e, 0xa9, 0xdf, 0xbd, 0xd7, 0x92, 0xb3, 0x7b,
+	0x63, 0xbc, 0xf7, 0x02, 0x0b, 0xe2, 0x5e, 0x3a, 0x55, 0x86, 0x17, 0x38, 0xe6, 0x4d, 0xbb, 0x63,
+	0x77, 0x6b, 0xfd, 0x63, 0x58, 0x90, 0x03, 0xde, 0x69, 0x64, 0x58, 0x59, 0xae, 0xdb, 0x96, 0x9f,
+	0x37, 0xb8, 0xe7, 0xce, 0x0e, 0x0e, 0x43, 0x2a, 0x13, 0xc1, 0x9b, 0xa5, 0x4e, 0xb9, 0x5b, 0xeb,
+	0x37, 0xa0, 0xc9, 0x0b, 0xbf, 0xf2, 0xc2, 0x41, 0xa2, 0xfc, 0x2d, 0xe5, 0xce, 0x9c, 0x3a, 0x5b,
+	0xc8, 0x84, 0x8c, 0x47, 0xdb, 0xc6, 0xb2, 0x6e, 0xf4, 0x8a, 0xad, 0x9a, 0x1d, 0x18, 0x74, 0x08,
+	0x32, 0xf9, 0xc7, 0xba, 0x7d, 0xa8, 0x70, 0x3c, 0xbf, 0xf2, 0xfe, 0x0c, 0xf2, 0xfc, 0x7d, 0xf6,
+	0x13, 0xe7, 0xee, 0xc4, 0xa9, 0x33, 0x19, 0x8c, 0x66, 0x44, 0x8d, 0xf0, 0x7c, 0x8a, 0x39, 0xe1,
+	0xcd, 0x8a, 0x96, 0x75, 0x8a, 0x65, 0x32, 0xb8, 0x25, 0x6a, 0x90, 0x91, 0xff, 0x54, 0xbf, 0xc7,
+	0x78, 0xfe, 0x1e, 0xfb, 0x86, 0x09, 0x1f, 0x5e, 0x2f, 0x37, 0xc0, 0x5e, 0x6d, 0x80, 0xfd, 0xbe,
+	0x01, 0xf6, 0x4b, 0x0a, 0xac, 0x55, 0x0a, 0xac, 0xb7, 0x14, 0x58, 0x0f, 0xa7, 0xd1, 0x54, 0x4c,
+	0x64, 0x00, 0x43, 0x1a, 0xa3, 0x7c, 0x33, 0xe6, 0x38, 0xe3, 0xe3, 0x19, 0x7a, 0x36, 0x6b, 0x12,
+	0x8a, 0x11, 0x1e, 0x54, 0xf5, 0x9f, 0x5d, 0x7c, 0x06, 0x00, 0x00, 0xff, 0xff, 0x1e, 0xd7, 0x72,
+	0xaf, 0x2b, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +150,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PubKeyAliases) > 0 {
+		for iNdEx := len(m.PubKeyAliases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubKeyAliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PrunedAccounts) > 0 {
+		for iNdEx := len(m.PrunedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrunedAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -177,6 +230,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PrunedAccounts) > 0 {
+		for _, e := range m.PrunedAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PubKeyAliases) > 0 {
+		for _, e := range m.PubKeyAliases {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -282,6 +347,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrunedAccounts = append(m.PrunedAccounts, PrunedAccount{})
+			if err := m.PrunedAccounts[len(m.PrunedAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyAliases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyAliases = append(m.PubKeyAliases, PubKeyAlias{})
+			if err := m.PubKeyAliases[len(m.PubKeyAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -50,6 +50,31 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	require.Error(t, types.ValidateGenAccounts(genAccs))
 }
 
+func TestValidatePrunedAccounts(t *testing.T) {
+	acc1 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr1))
+	acc2 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr2))
+	genAccs := types.GenesisAccounts{acc1}
+
+	require.NoError(t, types.ValidatePrunedAccounts([]types.PrunedAccount{types.NewPrunedAccount(acc2)}, genAccs))
+	require.Error(t, types.ValidatePrunedAccounts([]types.PrunedAccount{types.NewPrunedAccount(acc1)}, genAccs))
+	require.Error(t, types.ValidatePrunedAccounts([]types.PrunedAccount{types.NewPrunedAccount(acc2), types.NewPrunedAccount(acc2)}, genAccs))
+	require.Error(t, types.ValidatePrunedAccounts([]types.PrunedAccount{{Address: "invalid"}}, genAccs))
+}
+
+func TestValidatePubKeyAliases(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	alias, err := types.NewPubKeyAlias(sdk.AccAddress(addr1), pubKey)
+	require.NoError(t, err)
+	derived, err := types.NewPubKeyAlias(sdk.AccAddress(pubKey.Address()), pubKey)
+	require.NoError(t, err)
+
+	require.NoError(t, types.ValidatePubKeyAliases([]types.PubKeyAlias{alias}))
+	require.Error(t, types.ValidatePubKeyAliases([]types.PubKeyAlias{alias, alias}))
+	require.Error(t, types.ValidatePubKeyAliases([]types.PubKeyAlias{derived}))
+	require.Error(t, types.ValidatePubKeyAliases([]types.PubKeyAlias{{Address: sdk.AccAddress(addr2).String()}}))
+	require.Error(t, types.ValidatePubKeyAliases([]types.PubKeyAlias{{Address: "invalid"}}))
+}
+
 func TestGenesisAccountIterator(t *testing.T) {
 	acc1 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr1))
 	acc2 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr2))
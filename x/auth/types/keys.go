@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -16,17 +17,49 @@ const (
 
 	// QuerierRoute is the querier route for auth
 	QuerierRoute = ModuleName
+
+	// RouterKey is the message route for auth
+	RouterKey = ModuleName
 )
 
 var (
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
 
+	// DustAccountKeyPrefix prefix for the marks of the accounts without balance
+	DustAccountKeyPrefix = []byte{0x02}
+
+	// PrunedAccountKeyPrefix prefix for the reservations of the pruned accounts
+	PrunedAccountKeyPrefix = []byte{0x03}
+
+	// PubKeyAliasKeyPrefix prefix for the public keys authenticating the
+	// accounts whose address isn't derived from them
+	PubKeyAliasKeyPrefix = []byte{0x04}
+
 	// param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
+
+	// DustAccountCursorKey is the key of the address of the last account
+	// examined for pruning
+	DustAccountCursorKey = []byte("dustAccountCursor")
 )
 
 // AddressStoreKey turn an address to key used to get it from the account store
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// DustAccountKey returns the key of the dust mark of an account.
+func DustAccountKey(addr sdk.AccAddress) []byte {
+	return append(DustAccountKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// PrunedAccountKey returns the key of the reservation of a pruned account.
+func PrunedAccountKey(addr sdk.AccAddress) []byte {
+	return append(PrunedAccountKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// PubKeyAliasKey returns the key of the public key alias of an account.
+func PubKeyAliasKey(addr sdk.AccAddress) []byte {
+	return append(PubKeyAliasKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
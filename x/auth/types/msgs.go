@@ -0,0 +1,84 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+// auth message types
+const (
+	TypeMsgUpdateMultisig = "update_multisig"
+)
+
+var (
+	_ sdk.Msg                            = &MsgUpdateMultisig{}
+	_ legacytx.LegacyMsg                 = &MsgUpdateMultisig{}
+	_ codectypes.UnpackInterfacesMessage = (*MsgUpdateMultisig)(nil)
+)
+
+// NewMsgUpdateMultisig creates a new MsgUpdateMultisig instance.
+//
+//nolint:interfacer
+func NewMsgUpdateMultisig(addr sdk.AccAddress, pubKey cryptotypes.PubKey) (*MsgUpdateMultisig, error) {
+	var pkAny *codectypes.Any
+	if pubKey != nil {
+		var err error
+		if pkAny, err = codectypes.NewAnyWithValue(pubKey); err != nil {
+			return nil, err
+		}
+	}
+	return &MsgUpdateMultisig{
+		Address:   addr.String(),
+		NewPubkey: pkAny,
+	}, nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateMultisig) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateMultisig) Type() string { return TypeMsgUpdateMultisig }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateMultisig) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateMultisig) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateMultisig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if msg.NewPubkey == nil {
+		return sdkerrors.ErrInvalidPubKey.Wrap("empty public key")
+	}
+
+	pubKey, ok := msg.NewPubkey.GetCachedValue().(*multisig.LegacyAminoPubKey)
+	if !ok {
+		return sdkerrors.ErrInvalidPubKey.Wrapf("expected %T, got %T", (*multisig.LegacyAminoPubKey)(nil), msg.NewPubkey.GetCachedValue())
+	}
+
+	if pubKey.Threshold == 0 || int(pubKey.Threshold) > len(pubKey.PubKeys) {
+		return sdkerrors.ErrInvalidPubKey.Wrapf("invalid threshold %d for %d members", pubKey.Threshold, len(pubKey.PubKeys))
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgUpdateMultisig) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubkey, &pubKey)
+}
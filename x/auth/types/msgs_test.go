@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMsgUpdateMultisigValidateBasic(t *testing.T) {
+	members := []cryptotypes.PubKey{secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()}
+	addr := sdk.AccAddress(addr1)
+
+	testCases := []struct {
+		name   string
+		addr   sdk.AccAddress
+		pubKey cryptotypes.PubKey
+		expErr bool
+	}{
+		{"valid multisig", addr, multisig.NewLegacyAminoPubKey(2, members), false},
+		{"empty address", nil, multisig.NewLegacyAminoPubKey(2, members), true},
+		{"empty public key", addr, nil, true},
+		{"single public key", addr, members[0], true},
+		{"threshold above the members", addr, &multisig.LegacyAminoPubKey{Threshold: 3, PubKeys: multisig.NewLegacyAminoPubKey(2, members).PubKeys}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := types.NewMsgUpdateMultisig(tc.addr, tc.pubKey)
+			require.NoError(t, err)
+
+			if tc.expErr {
+				require.Error(t, msg.ValidateBasic())
+			} else {
+				require.NoError(t, msg.ValidateBasic())
+				require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+				require.NotPanics(t, func() { msg.GetSignBytes() })
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	yaml "gopkg.in/yaml.v2"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -15,29 +16,52 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+
+	DefaultPruneDustAccountsPerBlock  uint64 = 0
+	DefaultDustAccountRetentionBlocks uint64 = 100_800
+)
+
+// Parameter bounds, which keep governance from setting gas costs that make
+// transactions free or impossible to execute.
+const (
+	MaxSigVerifyCost      uint64 = 100_000_000
+	MaxKVGasCostFlat      uint64 = 1_000_000
+	MaxKVGasCostPerByte   uint64 = 10_000
+	MinKVGasCostFlat      uint64 = 1
+	MinKVWriteCostPerByte uint64 = 1
+
+	// MaxPruneDustAccountsPerBlock bounds the work of the end blocker.
+	MaxPruneDustAccountsPerBlock uint64 = 10_000
 )
 
 // Parameter keys
 var (
-	KeyMaxMemoCharacters      = []byte("MaxMemoCharacters")
-	KeyTxSigLimit             = []byte("TxSigLimit")
-	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
-	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
-	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeyMaxMemoCharacters          = []byte("MaxMemoCharacters")
+	KeyTxSigLimit                 = []byte("TxSigLimit")
+	KeyTxSizeCostPerByte          = []byte("TxSizeCostPerByte")
+	KeySigVerifyCostED25519       = []byte("SigVerifyCostED25519")
+	KeySigVerifyCostSecp256k1     = []byte("SigVerifyCostSecp256k1")
+	KeyKVGasConfig                = []byte("KVGasConfig")
+	KeyPruneDustAccountsPerBlock  = []byte("PruneDustAccountsPerBlock")
+	KeyDustAccountRetentionBlocks = []byte("DustAccountRetentionBlocks")
 )
 
 var _ paramtypes.ParamSet = &Params{}
 
-// NewParams creates a new Params object
+// NewParams creates a new Params object with the default KVStore gas config
+// and dust account pruning.
 func NewParams(
 	maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64,
 ) Params {
 	return Params{
-		MaxMemoCharacters:      maxMemoCharacters,
-		TxSigLimit:             txSigLimit,
-		TxSizeCostPerByte:      txSizeCostPerByte,
-		SigVerifyCostED25519:   sigVerifyCostED25519,
-		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
+		MaxMemoCharacters:          maxMemoCharacters,
+		TxSigLimit:                 txSigLimit,
+		TxSizeCostPerByte:          txSizeCostPerByte,
+		SigVerifyCostED25519:       sigVerifyCostED25519,
+		SigVerifyCostSecp256k1:     sigVerifyCostSecp256k1,
+		KVGasConfig:                DefaultKVGasConfig(),
+		PruneDustAccountsPerBlock:  DefaultPruneDustAccountsPerBlock,
+		DustAccountRetentionBlocks: DefaultDustAccountRetentionBlocks,
 	}
 }
 
@@ -55,20 +79,95 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyTxSizeCostPerByte, &p.TxSizeCostPerByte, validateTxSizeCostPerByte),
 		paramtypes.NewParamSetPair(KeySigVerifyCostED25519, &p.SigVerifyCostED25519, validateSigVerifyCostED25519),
 		paramtypes.NewParamSetPair(KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1, validateSigVerifyCostSecp256k1),
+		paramtypes.NewParamSetPair(KeyKVGasConfig, &p.KVGasConfig, validateKVGasConfig),
+		paramtypes.NewParamSetPair(KeyPruneDustAccountsPerBlock, &p.PruneDustAccountsPerBlock, validatePruneDustAccountsPerBlock),
+		paramtypes.NewParamSetPair(KeyDustAccountRetentionBlocks, &p.DustAccountRetentionBlocks, validateDustAccountRetentionBlocks),
 	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
 	return Params{
-		MaxMemoCharacters:      DefaultMaxMemoCharacters,
-		TxSigLimit:             DefaultTxSigLimit,
-		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
-		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
-		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		MaxMemoCharacters:          DefaultMaxMemoCharacters,
+		TxSigLimit:                 DefaultTxSigLimit,
+		TxSizeCostPerByte:          DefaultTxSizeCostPerByte,
+		SigVerifyCostED25519:       DefaultSigVerifyCostED25519,
+		SigVerifyCostSecp256k1:     DefaultSigVerifyCostSecp256k1,
+		KVGasConfig:                DefaultKVGasConfig(),
+		PruneDustAccountsPerBlock:  DefaultPruneDustAccountsPerBlock,
+		DustAccountRetentionBlocks: DefaultDustAccountRetentionBlocks,
 	}
 }
 
+// DefaultKVGasConfig returns the default KVStore gas config, matching
+// storetypes.KVGasConfig without gas refunds.
+func DefaultKVGasConfig() KVGasConfig {
+	return NewKVGasConfig(storetypes.KVGasConfig())
+}
+
+// NewKVGasConfig creates a KVGasConfig parameter from a KVStore gas config.
+func NewKVGasConfig(gasConfig storetypes.GasConfig) KVGasConfig {
+	return KVGasConfig{
+		HasCost:             gasConfig.HasCost,
+		DeleteCost:          gasConfig.DeleteCost,
+		ReadCostFlat:        gasConfig.ReadCostFlat,
+		ReadCostPerByte:     gasConfig.ReadCostPerByte,
+		WriteCostFlat:       gasConfig.WriteCostFlat,
+		WriteCostPerByte:    gasConfig.WriteCostPerByte,
+		IterNextCostFlat:    gasConfig.IterNextCostFlat,
+		DeleteRefundPerByte: gasConfig.DeleteRefundPerByte,
+	}
+}
+
+// GasConfig returns the KVStore gas config of the parameter.
+func (c KVGasConfig) GasConfig() storetypes.GasConfig {
+	return storetypes.GasConfig{
+		HasCost:             c.HasCost,
+		DeleteCost:          c.DeleteCost,
+		ReadCostFlat:        c.ReadCostFlat,
+		ReadCostPerByte:     c.ReadCostPerByte,
+		WriteCostFlat:       c.WriteCostFlat,
+		WriteCostPerByte:    c.WriteCostPerByte,
+		IterNextCostFlat:    c.IterNextCostFlat,
+		DeleteRefundPerByte: c.DeleteRefundPerByte,
+	}
+}
+
+// Validate checks that the gas costs are within their bounds.
+func (c KVGasConfig) Validate() error {
+	flatCosts := []struct {
+		name string
+		cost uint64
+	}{
+		{"has cost", c.HasCost},
+		{"delete cost", c.DeleteCost},
+		{"read cost flat", c.ReadCostFlat},
+		{"write cost flat", c.WriteCostFlat},
+		{"iterator next cost flat", c.IterNextCostFlat},
+	}
+	for _, fc := range flatCosts {
+		if fc.cost < MinKVGasCostFlat || fc.cost > MaxKVGasCostFlat {
+			return fmt.Errorf("invalid KVStore %s: %d, must be between %d and %d",
+				fc.name, fc.cost, MinKVGasCostFlat, MaxKVGasCostFlat)
+		}
+	}
+
+	if c.ReadCostPerByte > MaxKVGasCostPerByte {
+		return fmt.Errorf("invalid KVStore read cost per byte: %d, must be at most %d",
+			c.ReadCostPerByte, MaxKVGasCostPerByte)
+	}
+	if c.WriteCostPerByte < MinKVWriteCostPerByte || c.WriteCostPerByte > MaxKVGasCostPerByte {
+		return fmt.Errorf("invalid KVStore write cost per byte: %d, must be between %d and %d",
+			c.WriteCostPerByte, MinKVWriteCostPerByte, MaxKVGasCostPerByte)
+	}
+	if c.DeleteRefundPerByte > c.WriteCostPerByte {
+		return fmt.Errorf("invalid KVStore delete refund per byte: %d, cannot exceed write cost per byte %d",
+			c.DeleteRefundPerByte, c.WriteCostPerByte)
+	}
+
+	return nil
+}
+
 // SigVerifyCostSecp256r1 returns gas fee of secp256r1 signature verification.
 // Set by benchmarking current implementation:
 //     BenchmarkSig/secp256k1     4334   277167 ns/op   4128 B/op   79 allocs/op
@@ -104,7 +203,7 @@ func validateSigVerifyCostED25519(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if v == 0 {
+	if v == 0 || v > MaxSigVerifyCost {
 		return fmt.Errorf("invalid ED25519 signature verification cost: %d", v)
 	}
 
@@ -117,13 +216,48 @@ func validateSigVerifyCostSecp256k1(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if v == 0 {
+	if v == 0 || v > MaxSigVerifyCost {
 		return fmt.Errorf("invalid SECK256k1 signature verification cost: %d", v)
 	}
 
 	return nil
 }
 
+func validateKVGasConfig(i interface{}) error {
+	v, ok := i.(KVGasConfig)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+func validatePruneDustAccountsPerBlock(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxPruneDustAccountsPerBlock {
+		return fmt.Errorf("invalid dust accounts pruned per block: %d, must be at most %d", v, MaxPruneDustAccountsPerBlock)
+	}
+
+	return nil
+}
+
+func validateDustAccountRetentionBlocks(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid dust account retention blocks: %d", v)
+	}
+
+	return nil
+}
+
 func validateMaxMemoCharacters(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -167,6 +301,15 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := validateKVGasConfig(p.KVGasConfig); err != nil {
+		return err
+	}
+	if err := validatePruneDustAccountsPerBlock(p.PruneDustAccountsPerBlock); err != nil {
+		return err
+	}
+	if err := validateDustAccountRetentionBlocks(p.DustAccountRetentionBlocks); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -35,6 +35,18 @@ func TestParams_Validate(t *testing.T) {
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid tx size cost per byte: 0")},
+		{"SECK256k1 signature verification cost too high", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+			types.DefaultSigVerifyCostED25519, types.MaxSigVerifyCost+1), fmt.Errorf("invalid SECK256k1 signature verification cost: %d", types.MaxSigVerifyCost+1)},
+		{"too many dust accounts pruned per block", func() types.Params {
+			p := types.DefaultParams()
+			p.PruneDustAccountsPerBlock = types.MaxPruneDustAccountsPerBlock + 1
+			return p
+		}(), fmt.Errorf("invalid dust accounts pruned per block: %d, must be at most %d", types.MaxPruneDustAccountsPerBlock+1, types.MaxPruneDustAccountsPerBlock)},
+		{"invalid dust account retention blocks", func() types.Params {
+			p := types.DefaultParams()
+			p.DustAccountRetentionBlocks = 0
+			return p
+		}(), fmt.Errorf("invalid dust account retention blocks: 0")},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -48,3 +60,35 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestKVGasConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		malleate  func(c *types.KVGasConfig)
+		expectErr bool
+	}{
+		{"default config", func(c *types.KVGasConfig) {}, false},
+		{"zero flat cost", func(c *types.KVGasConfig) { c.HasCost = 0 }, true},
+		{"flat cost too high", func(c *types.KVGasConfig) { c.WriteCostFlat = types.MaxKVGasCostFlat + 1 }, true},
+		{"zero read cost per byte", func(c *types.KVGasConfig) { c.ReadCostPerByte = 0 }, false},
+		{"read cost per byte too high", func(c *types.KVGasConfig) { c.ReadCostPerByte = types.MaxKVGasCostPerByte + 1 }, true},
+		{"zero write cost per byte", func(c *types.KVGasConfig) { c.WriteCostPerByte = 0 }, true},
+		{"refund equal to write cost", func(c *types.KVGasConfig) { c.DeleteRefundPerByte = c.WriteCostPerByte }, false},
+		{"refund greater than write cost", func(c *types.KVGasConfig) { c.DeleteRefundPerByte = c.WriteCostPerByte + 1 }, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			c := types.DefaultKVGasConfig()
+			tt.malleate(&c)
+
+			err := c.Validate()
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c, types.NewKVGasConfig(c.GasConfig()))
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = PubKeyAlias{}
+
+// NewPubKeyAlias creates a new PubKeyAlias instance.
+//
+//nolint:interfacer
+func NewPubKeyAlias(addr sdk.AccAddress, pubKey cryptotypes.PubKey) (PubKeyAlias, error) {
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return PubKeyAlias{}, err
+	}
+
+	return PubKeyAlias{
+		Address: addr.String(),
+		PubKey:  pkAny,
+	}, nil
+}
+
+// GetAddress returns the address of the account.
+func (a PubKeyAlias) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(a.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	return addr
+}
+
+// GetPubKey returns the aliased public key, or nil if it isn't unpacked.
+func (a PubKeyAlias) GetPubKey() cryptotypes.PubKey {
+	if a.PubKey == nil {
+		return nil
+	}
+
+	pubKey, _ := a.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	return pubKey
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a PubKeyAlias) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(a.PubKey, &pubKey)
+}
+
+// ValidatePubKeyAliases validates the public key aliases of a genesis state:
+// the addresses must be unique and must not be derived from the aliased
+// public keys.
+func ValidatePubKeyAliases(aliases []PubKeyAlias) error {
+	addrMap := make(map[string]bool, len(aliases))
+	for _, alias := range aliases {
+		addr, err := sdk.AccAddressFromBech32(alias.Address)
+		if err != nil {
+			return fmt.Errorf("invalid public key alias address %s: %w", alias.Address, err)
+		}
+		if addrMap[alias.Address] {
+			return fmt.Errorf("duplicate public key alias found in genesis state; address: %s", alias.Address)
+		}
+
+		pubKey := alias.GetPubKey()
+		if pubKey == nil {
+			return fmt.Errorf("missing public key in alias of %s", alias.Address)
+		}
+		if bytes.Equal(pubKey.Address(), addr) {
+			return fmt.Errorf("public key alias of %s derives its address", alias.Address)
+		}
+
+		addrMap[alias.Address] = true
+	}
+
+	return nil
+}
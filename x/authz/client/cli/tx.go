@@ -26,6 +26,7 @@ const (
 	FlagExpiration        = "expiration"
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
+	FlagAtomic            = "atomic"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -219,7 +220,8 @@ func NewCmdExecAuthorization() *cobra.Command {
 Example:
  $ %s tx %s exec tx.json --from grantee
  $ %s tx bank send <granter> <recipient> --from <granter> --chain-id <chain-id> --generate-only > tx.json && %s tx %s exec tx.json --from grantee
-			`, version.AppName, authz.ModuleName, version.AppName, version.AppName, authz.ModuleName),
+ $ %s tx %s exec tx.json --from grantee --atomic=false
+			`, version.AppName, authz.ModuleName, version.AppName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -240,11 +242,18 @@ Example:
 			}
 			msg := authz.NewMsgExec(grantee, theTx.GetMsgs())
 
+			atomic, err := cmd.Flags().GetBool(FlagAtomic)
+			if err != nil {
+				return err
+			}
+			msg.NonAtomic = !atomic
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool(FlagAtomic, true, "Fail the execution as a whole if any message fails, rather than reporting the result of each message")
 
 	return cmd
 }
@@ -75,56 +75,95 @@ func (k Keeper) update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccA
 func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
 	var results = make([][]byte, len(msgs))
 	for i, msg := range msgs {
-		signers := msg.GetSigners()
-		if len(signers) != 1 {
-			return nil, sdkerrors.ErrInvalidRequest.Wrap("authorization can be given to msg with only one signer")
-		}
-		granter := signers[0]
-
-		// if granter != grantee then check authorization.Accept, otherwise we implicitly accept.
-		if !granter.Equals(grantee) {
-			authorization, _ := k.GetCleanAuthorization(ctx, grantee, granter, sdk.MsgTypeURL(msg))
-			if authorization == nil {
-				return nil, sdkerrors.ErrUnauthorized.Wrap("authorization not found")
-			}
-			resp, err := authorization.Accept(ctx, msg)
-			if err != nil {
-				return nil, err
-			}
-			if resp.Delete {
-				err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
-			} else if resp.Updated != nil {
-				err = k.update(ctx, grantee, granter, resp.Updated)
-			}
-			if err != nil {
-				return nil, err
-			}
-			if !resp.Accept {
-				return nil, sdkerrors.ErrUnauthorized
-			}
+		data, err := k.dispatchAction(ctx, grantee, msg)
+		if err != nil {
+			return nil, err
 		}
+		results[i] = data
+	}
 
-		handler := k.router.Handler(msg)
-		if handler == nil {
-			return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
-		}
+	return results, nil
+}
 
-		msgResp, err := handler(ctx, msg)
+// DispatchActionsNonAtomic attempts to execute the provided messages via
+// authorization grants from the message signer to the grantee, each one
+// independently of the others. The state changes of a message failing are
+// reverted, its authorization left untouched, and its error reported in its
+// result, while the execution carries on with the next messages.
+func (k Keeper) DispatchActionsNonAtomic(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, []authz.MsgExecResult) {
+	var (
+		results    = make([][]byte, len(msgs))
+		msgResults = make([]authz.MsgExecResult, len(msgs))
+	)
+	for i, msg := range msgs {
+		cacheCtx, writeCache := ctx.CacheContext()
+		data, err := k.dispatchAction(cacheCtx, grantee, msg)
 		if err != nil {
-			return nil, sdkerrors.Wrapf(err, "failed to execute message; message %v", msg)
+			codespace, code, log := sdkerrors.ABCIInfo(err, false)
+			msgResults[i] = authz.MsgExecResult{Codespace: codespace, Code: code, Log: log}
+			continue
 		}
-		results[i] = msgResp.Data
 
-		// emit the events from the dispatched actions
-		events := msgResp.Events
-		sdkEvents := make([]sdk.Event, 0, len(events))
-		for i := 0; i < len(events); i++ {
-			sdkEvents = append(sdkEvents, sdk.Event(events[i]))
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		results[i] = data
+		msgResults[i] = authz.MsgExecResult{Success: true, Data: data}
+	}
+
+	return results, msgResults
+}
+
+// dispatchAction executes the provided message via the authorization grant
+// from the message signer to the grantee.
+func (k Keeper) dispatchAction(ctx sdk.Context, grantee sdk.AccAddress, msg sdk.Msg) ([]byte, error) {
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("authorization can be given to msg with only one signer")
+	}
+	granter := signers[0]
+
+	// if granter != grantee then check authorization.Accept, otherwise we implicitly accept.
+	if !granter.Equals(grantee) {
+		authorization, _ := k.GetCleanAuthorization(ctx, grantee, granter, sdk.MsgTypeURL(msg))
+		if authorization == nil {
+			return nil, sdkerrors.ErrUnauthorized.Wrap("authorization not found")
+		}
+		resp, err := authorization.Accept(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Delete {
+			err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
+		} else if resp.Updated != nil {
+			err = k.update(ctx, grantee, granter, resp.Updated)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !resp.Accept {
+			return nil, sdkerrors.ErrUnauthorized
 		}
-		ctx.EventManager().EmitEvents(sdkEvents)
 	}
 
-	return results, nil
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
+	}
+
+	msgResp, err := handler(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to execute message; message %v", msg)
+	}
+
+	// emit the events from the dispatched actions
+	events := msgResp.Events
+	sdkEvents := make([]sdk.Event, 0, len(events))
+	for i := 0; i < len(events); i++ {
+		sdkEvents = append(sdkEvents, sdk.Event(events[i]))
+	}
+	ctx.EventManager().EmitEvents(sdkEvents)
+
+	return msgResp.Data, nil
 }
 
 // SaveGrant method grants the provided authorization to the grantee on the granter's account
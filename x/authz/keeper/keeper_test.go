@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -248,6 +249,55 @@ func (s *TestSuite) TestDispatchedEvents() {
 	}
 }
 
+func (s *TestSuite) TestDispatchActionsNonAtomic() {
+	require := s.Require()
+	app, addrs := s.app, s.addrs
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+	require.NoError(testutil.FundAccount(app.BankKeeper, s.ctx, granterAddr, sdk.NewCoins(sdk.NewInt64Coin("steak", 10000))))
+	now := s.ctx.BlockHeader().Time
+
+	err := app.AuthzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, &banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 20))}, now.Add(time.Hour))
+	require.NoError(err)
+
+	send := func(from sdk.AccAddress, amount int64) sdk.Msg {
+		return &banktypes.MsgSend{
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("steak", amount)),
+			FromAddress: from.String(),
+			ToAddress:   recipientAddr.String(),
+		}
+	}
+	msgs := []sdk.Msg{
+		send(granterAddr, 15),
+		// over the remaining spend limit
+		send(granterAddr, 10),
+		// no authorization from the recipient
+		send(recipientAddr, 1),
+		send(granterAddr, 5),
+	}
+
+	before := app.BankKeeper.GetBalance(s.ctx, recipientAddr, "steak")
+	results, msgResults := app.AuthzKeeper.DispatchActionsNonAtomic(s.ctx, granteeAddr, msgs)
+	require.Len(results, len(msgs))
+	require.Len(msgResults, len(msgs))
+
+	require.True(msgResults[0].Success)
+	require.False(msgResults[1].Success)
+	require.Equal(sdkerrors.ErrInsufficientFunds.ABCICode(), msgResults[1].Code)
+	require.Equal(sdkerrors.ErrInsufficientFunds.Codespace(), msgResults[1].Codespace)
+	require.Nil(results[1])
+	require.False(msgResults[2].Success)
+	require.Equal(sdkerrors.ErrUnauthorized.ABCICode(), msgResults[2].Code)
+	require.True(msgResults[3].Success)
+
+	// only the successful messages were executed, using up the authorization
+	after := app.BankKeeper.GetBalance(s.ctx, recipientAddr, "steak")
+	require.Equal(int64(20), after.Sub(before).Amount.Int64())
+	authorization, _ := app.AuthzKeeper.GetCleanAuthorization(s.ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	require.Nil(authorization)
+}
+
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
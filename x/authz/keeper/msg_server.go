@@ -70,6 +70,10 @@ func (k Keeper) Exec(goCtx context.Context, msg *authz.MsgExec) (*authz.MsgExecR
 	if err != nil {
 		return nil, err
 	}
+	if msg.NonAtomic {
+		results, msgResults := k.DispatchActionsNonAtomic(ctx, grantee, msgs)
+		return &authz.MsgExecResponse{Results: results, MsgResults: msgResults}, nil
+	}
 	results, err := k.DispatchActions(ctx, grantee, msgs)
 	if err != nil {
 		return nil, err
@@ -76,6 +76,9 @@ var xxx_messageInfo_MsgGrant proto.InternalMessageInfo
 // MsgExecResponse defines the Msg/MsgExecResponse response type.
 type MsgExecResponse struct {
 	Results [][]byte `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// msg_results holds the outcome of each message of a non-atomic MsgExec, in
+	// the order of the messages. It is empty for an atomic MsgExec.
+	MsgResults []MsgExecResult `protobuf:"bytes,2,rep,name=msg_results,json=msgResults,proto3" json:"msg_results"`
 }
 
 func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
@@ -111,6 +114,53 @@ func (m *MsgExecResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExecResponse proto.InternalMessageInfo
 
+// MsgExecResult is the outcome of the execution of a message of a non-atomic
+// MsgExec.
+type MsgExecResult struct {
+	// success is true if the message was executed.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// data is the response data of the message, if it was executed.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// codespace, code and log describe the error the message failed with, if it
+	// was not executed.
+	Codespace string `protobuf:"bytes,3,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Code      uint32 `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	Log       string `protobuf:"bytes,5,opt,name=log,proto3" json:"log,omitempty"`
+}
+
+func (m *MsgExecResult) Reset()         { *m = MsgExecResult{} }
+func (m *MsgExecResult) String() string { return proto.CompactTextString(m) }
+func (*MsgExecResult) ProtoMessage()    {}
+func (*MsgExecResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{2}
+}
+func (m *MsgExecResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecResult.Merge(m, src)
+}
+func (m *MsgExecResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecResult proto.InternalMessageInfo
+
 // MsgExec attempts to execute the provided messages using
 // authorizations granted to the grantee. Each message should have only
 // one signer corresponding to the granter of the authorization.
@@ -120,13 +170,18 @@ type MsgExec struct {
 	// The x/authz will try to find a grant matching (msg.signers[0], grantee, MsgTypeURL(msg))
 	// triple and validate it.
 	Msgs []*types.Any `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// non_atomic, if true, executes the messages independently of each other: a
+	// message failing is reverted and reported in the response, and the
+	// execution carries on with the next messages. By default the MsgExec fails
+	// as a whole if any of its messages fails.
+	NonAtomic bool `protobuf:"varint,3,opt,name=non_atomic,json=nonAtomic,proto3" json:"non_atomic,omitempty"`
 }
 
 func (m *MsgExec) Reset()         { *m = MsgExec{} }
 func (m *MsgExec) String() string { return proto.CompactTextString(m) }
 func (*MsgExec) ProtoMessage()    {}
 func (*MsgExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ceddab7d8589ad1, []int{2}
+	return fileDescriptor_3ceddab7d8589ad1, []int{3}
 }
 func (m *MsgExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -163,7 +218,7 @@ func (m *MsgGrantResponse) Reset()         { *m = MsgGrantResponse{} }
 func (m *MsgGrantResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGrantResponse) ProtoMessage()    {}
 func (*MsgGrantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ceddab7d8589ad1, []int{3}
+	return fileDescriptor_3ceddab7d8589ad1, []int{4}
 }
 func (m *MsgGrantResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,7 +259,7 @@ func (m *MsgRevoke) Reset()         { *m = MsgRevoke{} }
 func (m *MsgRevoke) String() string { return proto.CompactTextString(m) }
 func (*MsgRevoke) ProtoMessage()    {}
 func (*MsgRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ceddab7d8589ad1, []int{4}
+	return fileDescriptor_3ceddab7d8589ad1, []int{5}
 }
 func (m *MsgRevoke) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,7 +296,7 @@ func (m *MsgRevokeResponse) Reset()         { *m = MsgRevokeResponse{} }
 func (m *MsgRevokeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeResponse) ProtoMessage()    {}
 func (*MsgRevokeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ceddab7d8589ad1, []int{5}
+	return fileDescriptor_3ceddab7d8589ad1, []int{6}
 }
 func (m *MsgRevokeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -273,6 +328,7 @@ var xxx_messageInfo_MsgRevokeResponse proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*MsgGrant)(nil), "cosmos.authz.v1beta1.MsgGrant")
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.authz.v1beta1.MsgExecResponse")
+	proto.RegisterType((*MsgExecResult)(nil), "cosmos.authz.v1beta1.MsgExecResult")
 	proto.RegisterType((*MsgExec)(nil), "cosmos.authz.v1beta1.MsgExec")
 	proto.RegisterType((*MsgGrantResponse)(nil), "cosmos.authz.v1beta1.MsgGrantResponse")
 	proto.RegisterType((*MsgRevoke)(nil), "cosmos.authz.v1beta1.MsgRevoke")
@@ -282,38 +338,44 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/tx.proto", fileDescriptor_3ceddab7d8589ad1) }
 
 var fileDescriptor_3ceddab7d8589ad1 = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xb3, 0x4d, 0xda, 0x92, 0x6d, 0x25, 0xc0, 0xe4, 0xe0, 0x1a, 0xea, 0x58, 0xe6, 0x5f,
-	0x24, 0xe8, 0x5a, 0x0d, 0x07, 0xce, 0x8d, 0x84, 0x90, 0x10, 0x16, 0x92, 0x05, 0x17, 0x2e, 0xd1,
-	0x3a, 0x5d, 0x36, 0x56, 0x62, 0xaf, 0xe5, 0x59, 0x97, 0xa4, 0x4f, 0xc1, 0xc3, 0xf0, 0x10, 0x11,
-	0xa7, 0x1e, 0x39, 0x21, 0x48, 0x5e, 0x82, 0x23, 0xf2, 0xee, 0x3a, 0x14, 0x94, 0x16, 0x89, 0x53,
-	0x76, 0xe6, 0xfb, 0x65, 0xe6, 0xf3, 0xe7, 0x35, 0x3e, 0x1c, 0x09, 0x48, 0x05, 0x04, 0xb4, 0x94,
-	0xe3, 0xf3, 0xe0, 0xec, 0x38, 0x66, 0x92, 0x1e, 0x07, 0x72, 0x46, 0xf2, 0x42, 0x48, 0x61, 0x75,
-	0xb4, 0x4c, 0x94, 0x4c, 0x8c, 0xec, 0x1c, 0xe8, 0xee, 0x50, 0x31, 0x81, 0x41, 0x54, 0xe1, 0x74,
-	0xb8, 0xe0, 0x42, 0xf7, 0xab, 0x93, 0xe9, 0x76, 0xb9, 0x10, 0x7c, 0xca, 0x02, 0x55, 0xc5, 0xe5,
-	0x87, 0x40, 0x26, 0x29, 0x03, 0x49, 0xd3, 0xdc, 0x00, 0x07, 0x7f, 0x03, 0x34, 0x9b, 0x1b, 0xe9,
-	0xbe, 0x71, 0x18, 0x53, 0x60, 0x01, 0x8d, 0x47, 0xc9, 0xda, 0x65, 0x55, 0x18, 0xc8, 0xdb, 0xf8,
-	0x18, 0xda, 0xb5, 0x22, 0xfc, 0x8f, 0xf8, 0x46, 0x08, 0xfc, 0x65, 0x41, 0x33, 0x69, 0xd9, 0x78,
-	0x97, 0x57, 0x07, 0x56, 0xd8, 0xc8, 0x43, 0xbd, 0x76, 0x54, 0x97, 0xbf, 0x15, 0x66, 0x6f, 0x5d,
-	0x56, 0x98, 0xf5, 0x1c, 0x6f, 0xab, 0xa3, 0xdd, 0xf4, 0x50, 0x6f, 0xaf, 0x7f, 0x97, 0x6c, 0x4a,
-	0x86, 0xa8, 0xf9, 0x83, 0xd6, 0xe2, 0x5b, 0xb7, 0x11, 0x69, 0xde, 0x7f, 0x82, 0x6f, 0x86, 0xc0,
-	0x5f, 0xcc, 0xd8, 0x28, 0x62, 0x90, 0x8b, 0x0c, 0x58, 0xb5, 0xa5, 0x60, 0x50, 0x4e, 0x25, 0xd8,
-	0xc8, 0x6b, 0xf6, 0xf6, 0xa3, 0xba, 0xf4, 0x05, 0xde, 0x35, 0xf0, 0x65, 0x2b, 0xe8, 0x4f, 0x2b,
-	0xaf, 0x70, 0x2b, 0x05, 0x0e, 0xf6, 0x96, 0xd7, 0xec, 0xed, 0xf5, 0x3b, 0x44, 0x67, 0x47, 0xea,
-	0xec, 0xc8, 0x49, 0x36, 0x1f, 0x78, 0x5f, 0x3e, 0x1f, 0xdd, 0x83, 0xd3, 0x09, 0x09, 0x81, 0x3f,
-	0xf5, 0xb4, 0xc9, 0x93, 0x52, 0x8e, 0x45, 0x91, 0x9c, 0x53, 0x99, 0x88, 0x2c, 0x52, 0x33, 0x7c,
-	0x0b, 0xdf, 0xaa, 0x63, 0xa9, 0xed, 0xf9, 0x14, 0xb7, 0x43, 0xe0, 0x11, 0x3b, 0x13, 0x13, 0xf6,
-	0x5f, 0x59, 0x79, 0x78, 0x3f, 0x05, 0x3e, 0x94, 0xf3, 0x9c, 0x0d, 0xcb, 0x62, 0xaa, 0x22, 0x6b,
-	0x47, 0x38, 0x05, 0xfe, 0x76, 0x9e, 0xb3, 0x77, 0xc5, 0xd4, 0xbf, 0x83, 0x6f, 0xaf, 0x57, 0xd4,
-	0x7b, 0xfb, 0x3f, 0x11, 0x6e, 0x86, 0xc0, 0xad, 0x37, 0x78, 0x5b, 0xbf, 0x27, 0x77, 0x73, 0xc8,
-	0xb5, 0x61, 0xe7, 0xd1, 0xf5, 0xfa, 0x3a, 0xef, 0xd7, 0xb8, 0xa5, 0x22, 0x3d, 0xbc, 0x92, 0xaf,
-	0x64, 0xe7, 0xe1, 0xb5, 0xf2, 0x7a, 0x5a, 0x84, 0x77, 0x4c, 0x36, 0xdd, 0x2b, 0xff, 0xa0, 0x01,
-	0xe7, 0xf1, 0x3f, 0x80, 0x7a, 0xe6, 0x60, 0xb0, 0xf8, 0xe1, 0x36, 0x16, 0x4b, 0x17, 0x5d, 0x2c,
-	0x5d, 0xf4, 0x7d, 0xe9, 0xa2, 0x4f, 0x2b, 0xb7, 0x71, 0xb1, 0x72, 0x1b, 0x5f, 0x57, 0x6e, 0xe3,
-	0xfd, 0x03, 0x9e, 0xc8, 0x71, 0x19, 0x93, 0x91, 0x48, 0xcd, 0xd7, 0x66, 0x7e, 0x8e, 0xe0, 0x74,
-	0x12, 0xcc, 0xf4, 0x3d, 0x8f, 0x77, 0xd4, 0x05, 0x78, 0xf6, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xe3,
-	0x02, 0xf3, 0xbe, 0xd3, 0x03, 0x00, 0x00,
+	// 592 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0xcd, 0x34, 0xe9, 0x23, 0xd3, 0x56, 0x14, 0xd3, 0x85, 0x1b, 0x5a, 0xc7, 0x72, 0x79, 0x64,
+	0x41, 0x6d, 0x35, 0x2c, 0x58, 0x27, 0x12, 0x42, 0xaa, 0x88, 0x90, 0x2c, 0xd8, 0xb0, 0x89, 0xc6,
+	0xce, 0x30, 0xb1, 0x62, 0x7b, 0x2c, 0xcf, 0xb8, 0x4d, 0xba, 0xe3, 0x07, 0x10, 0x1f, 0xc3, 0x47,
+	0x44, 0xac, 0xba, 0x64, 0x85, 0x20, 0xf9, 0x09, 0x96, 0x68, 0x1e, 0x4e, 0x5a, 0x94, 0xb6, 0x12,
+	0xab, 0xdc, 0x7b, 0xcf, 0x99, 0xfb, 0x3c, 0x31, 0x3c, 0x0a, 0x29, 0x4b, 0x28, 0xf3, 0x50, 0xc1,
+	0x87, 0x97, 0xde, 0xf9, 0x69, 0x80, 0x39, 0x3a, 0xf5, 0xf8, 0xd8, 0xcd, 0x72, 0xca, 0xa9, 0xb1,
+	0xaf, 0x60, 0x57, 0xc2, 0xae, 0x86, 0x1b, 0x07, 0x2a, 0xda, 0x97, 0x1c, 0x4f, 0x53, 0xa4, 0xd3,
+	0xd8, 0x27, 0x94, 0x50, 0x15, 0x17, 0x96, 0x8e, 0x36, 0x09, 0xa5, 0x24, 0xc6, 0x9e, 0xf4, 0x82,
+	0xe2, 0x93, 0xc7, 0xa3, 0x04, 0x33, 0x8e, 0x92, 0x4c, 0x13, 0x0e, 0xfe, 0x25, 0xa0, 0x74, 0xa2,
+	0xa1, 0x63, 0xdd, 0x61, 0x80, 0x18, 0xf6, 0x50, 0x10, 0x46, 0x8b, 0x2e, 0x85, 0xa3, 0x49, 0xf6,
+	0xca, 0x31, 0x54, 0xd7, 0x92, 0xe1, 0x5c, 0xc0, 0xad, 0x1e, 0x23, 0x6f, 0x72, 0x94, 0x72, 0xc3,
+	0x84, 0x9b, 0x44, 0x18, 0x38, 0x37, 0x81, 0x0d, 0x5a, 0x75, 0xbf, 0x74, 0x97, 0x08, 0x36, 0xd7,
+	0xae, 0x23, 0xd8, 0x78, 0x05, 0xd7, 0xa5, 0x69, 0x56, 0x6d, 0xd0, 0xda, 0x6e, 0x3f, 0x76, 0x57,
+	0x6d, 0xc6, 0x95, 0xf9, 0xbb, 0xb5, 0xe9, 0xcf, 0x66, 0xc5, 0x57, 0x7c, 0xe7, 0x02, 0x3e, 0xe8,
+	0x31, 0xf2, 0x7a, 0x8c, 0x43, 0x1f, 0xb3, 0x8c, 0xa6, 0x0c, 0x8b, 0x2a, 0x39, 0x66, 0x45, 0xcc,
+	0x99, 0x09, 0xec, 0x6a, 0x6b, 0xc7, 0x2f, 0x5d, 0xe3, 0x0c, 0x6e, 0x27, 0x8c, 0xf4, 0x4b, 0x74,
+	0xcd, 0xae, 0xb6, 0xb6, 0xdb, 0xc7, 0xab, 0x6b, 0x2d, 0xb3, 0x16, 0x71, 0x59, 0x13, 0x26, 0x8c,
+	0xa8, 0x00, 0x73, 0x3e, 0x03, 0xb8, 0x7b, 0x83, 0x23, 0xea, 0xb2, 0x22, 0x0c, 0x31, 0x63, 0x72,
+	0xee, 0x2d, 0xbf, 0x74, 0x0d, 0x03, 0xd6, 0x06, 0x88, 0x23, 0x39, 0xf4, 0x8e, 0x2f, 0x6d, 0xe3,
+	0x10, 0xd6, 0x43, 0x3a, 0xc0, 0x2c, 0x43, 0x21, 0x96, 0x53, 0xd7, 0xfd, 0x65, 0x40, 0xbc, 0x10,
+	0x8e, 0x59, 0xb3, 0x41, 0x6b, 0xd7, 0x97, 0xb6, 0xb1, 0x07, 0xab, 0x31, 0x25, 0xe6, 0xba, 0xe4,
+	0x0a, 0xd3, 0xf9, 0x02, 0xe0, 0xa6, 0xee, 0xe1, 0xfa, 0x6e, 0xc1, 0xcd, 0xdd, 0x9e, 0xc1, 0x5a,
+	0xc2, 0x48, 0x39, 0xee, 0xbe, 0xab, 0xc4, 0xe0, 0x96, 0x62, 0x70, 0x3b, 0xe9, 0xa4, 0x6b, 0x7f,
+	0xff, 0x76, 0x72, 0xc8, 0x06, 0x23, 0x31, 0xf6, 0x0b, 0x5b, 0x6d, 0xa2, 0x53, 0xf0, 0x21, 0xcd,
+	0xa3, 0x4b, 0xc4, 0x23, 0x9a, 0xfa, 0x32, 0x87, 0x71, 0x04, 0x61, 0x4a, 0xd3, 0x3e, 0xe2, 0x34,
+	0x89, 0x42, 0xd9, 0xf6, 0x96, 0x5f, 0x4f, 0x69, 0xda, 0x91, 0x01, 0xc7, 0x80, 0x7b, 0xa5, 0x0c,
+	0xca, 0x73, 0x38, 0x08, 0xd6, 0x7b, 0x62, 0x6d, 0xe7, 0x74, 0x84, 0xff, 0x4b, 0x1b, 0x36, 0xdc,
+	0x11, 0x57, 0xe3, 0x93, 0x0c, 0xf7, 0x8b, 0x3c, 0xd6, 0xcb, 0x12, 0xb7, 0x78, 0x3f, 0xc9, 0xf0,
+	0x87, 0x3c, 0x76, 0x1e, 0xc1, 0x87, 0x8b, 0x12, 0x65, 0xdd, 0xf6, 0x1f, 0x00, 0xab, 0x3d, 0x46,
+	0x8c, 0x77, 0x70, 0x5d, 0xe9, 0xd2, 0xba, 0xf5, 0xd0, 0x12, 0x6f, 0x3c, 0xbb, 0x1b, 0x5f, 0xe8,
+	0xeb, 0x2d, 0xac, 0xc9, 0x8d, 0x1f, 0xdd, 0x29, 0x9c, 0xc6, 0xd3, 0xfb, 0x74, 0xa5, 0xb2, 0xf9,
+	0x70, 0x43, 0xef, 0xa6, 0x79, 0xeb, 0x03, 0x45, 0x68, 0x3c, 0xbf, 0x87, 0x50, 0xe6, 0xec, 0x76,
+	0xa7, 0xbf, 0xad, 0xca, 0x74, 0x66, 0x81, 0xab, 0x99, 0x05, 0x7e, 0xcd, 0x2c, 0xf0, 0x75, 0x6e,
+	0x55, 0xae, 0xe6, 0x56, 0xe5, 0xc7, 0xdc, 0xaa, 0x7c, 0x7c, 0x42, 0x22, 0x3e, 0x2c, 0x02, 0x37,
+	0xa4, 0x89, 0xfe, 0xba, 0xe8, 0x9f, 0x13, 0x36, 0x18, 0x79, 0x63, 0xf5, 0xbf, 0x0e, 0x36, 0xa4,
+	0x3e, 0x5e, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x88, 0x5b, 0x4f, 0xe1, 0xc3, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -553,6 +615,20 @@ func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgResults) > 0 {
+		for iNdEx := len(m.MsgResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Results) > 0 {
 		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Results[iNdEx])
@@ -565,6 +641,65 @@ func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgExecResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Log) > 0 {
+		i -= len(m.Log)
+		copy(dAtA[i:], m.Log)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Log)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Code != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgExec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -585,6 +720,16 @@ func (m *MsgExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NonAtomic {
+		i--
+		if m.NonAtomic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Msgs) > 0 {
 		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -741,6 +886,39 @@ func (m *MsgExecResponse) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if len(m.MsgResults) > 0 {
+		for _, e := range m.MsgResults {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovTx(uint64(m.Code))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -760,6 +938,9 @@ func (m *MsgExec) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.NonAtomic {
+		n += 2
+	}
 	return n
 }
 
@@ -1016,6 +1197,227 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
 			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResults = append(m.MsgResults, MsgExecResult{})
+			if err := m.MsgResults[len(m.MsgResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Log = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1132,6 +1534,26 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonAtomic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NonAtomic = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
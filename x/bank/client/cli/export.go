@@ -0,0 +1,247 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	FlagFormat = "format"
+
+	ExportFormatJSON = "json"
+	ExportFormatCSV  = "csv"
+)
+
+// AccountBalance defines the balance of an account in a balances export.
+// Module is the name of the module account, or empty for other accounts.
+type AccountBalance struct {
+	Address string    `json:"address"`
+	Module  string    `json:"module,omitempty"`
+	Coins   sdk.Coins `json:"coins"`
+}
+
+// BalancesExport defines the export of all the account balances at a given
+// height, meant for proof of reserves and reconciliation jobs. The balances are
+// sorted by address and include the module accounts, which are additionally
+// broken down by module along with the total supply.
+type BalancesExport struct {
+	Height         int64            `json:"height"`
+	Balances       []AccountBalance `json:"balances"`
+	ModuleAccounts []AccountBalance `json:"module_accounts"`
+	Supply         sdk.Coins        `json:"supply"`
+}
+
+// GetCmdExportBalances returns the command exporting all the account balances
+// at a given height.
+func GetCmdExportBalances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-balances",
+		Short: "Export the balances of all the accounts at a given height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export the balances of all the accounts, the balances of the module accounts
+broken down by module and the total supply, as of the state committed at a given height
+(the latest height if omitted), in a canonical JSON or CSV format for proof of reserves and
+reconciliation jobs. All the queries are run against the same committed version, so that
+the export is consistent even if the queried node keeps committing blocks.
+
+The CSV format contains one row per account and denomination, with the columns
+address, module, denom and amount, sorted by address and denomination.
+
+Example:
+  $ %s query %s export-balances --height=1000
+  $ %s query %s export-balances --height=1000 --format=csv
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			format, err := cmd.Flags().GetString(FlagFormat)
+			if err != nil {
+				return err
+			}
+			if format != ExportFormatJSON && format != ExportFormatCSV {
+				return fmt.Errorf("invalid export format %s, expected %s or %s", format, ExportFormatJSON, ExportFormatCSV)
+			}
+
+			// pin all the queries to the same committed version
+			if clientCtx.Height == 0 {
+				height, err := rpc.GetChainHeight(clientCtx)
+				if err != nil {
+					return err
+				}
+				clientCtx = clientCtx.WithHeight(height)
+			}
+
+			export, err := exportBalances(cmd.Context(), clientCtx)
+			if err != nil {
+				return err
+			}
+
+			var bz []byte
+			if format == ExportFormatCSV {
+				bz, err = export.CSV()
+			} else {
+				bz, err = json.MarshalIndent(export, "", "  ")
+			}
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	cmd.Flags().String(FlagFormat, ExportFormatJSON, "The export format (json|csv)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// exportBalances queries the balances of all the accounts and the total supply
+// at the height of clientCtx.
+func exportBalances(ctx context.Context, clientCtx client.Context) (BalancesExport, error) {
+	authClient := authtypes.NewQueryClient(clientCtx)
+	bankClient := types.NewQueryClient(clientCtx)
+
+	export := BalancesExport{
+		Height:         clientCtx.Height,
+		Balances:       []AccountBalance{},
+		ModuleAccounts: []AccountBalance{},
+	}
+
+	var pageReq *query.PageRequest
+	for {
+		res, err := authClient.Accounts(ctx, &authtypes.QueryAccountsRequest{Pagination: pageReq})
+		if err != nil {
+			return BalancesExport{}, err
+		}
+
+		for _, any := range res.Accounts {
+			var acc authtypes.AccountI
+			if err := clientCtx.InterfaceRegistry.UnpackAny(any, &acc); err != nil {
+				return BalancesExport{}, err
+			}
+
+			balance := AccountBalance{Address: acc.GetAddress().String()}
+			if macc, ok := acc.(authtypes.ModuleAccountI); ok {
+				balance.Module = macc.GetName()
+			}
+
+			balance.Coins, err = queryAllBalances(ctx, bankClient, balance.Address)
+			if err != nil {
+				return BalancesExport{}, err
+			}
+
+			if balance.Module != "" {
+				export.ModuleAccounts = append(export.ModuleAccounts, balance)
+			}
+			if !balance.Coins.IsZero() {
+				export.Balances = append(export.Balances, balance)
+			}
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+
+	supply, err := queryTotalSupply(ctx, bankClient)
+	if err != nil {
+		return BalancesExport{}, err
+	}
+	export.Supply = supply
+
+	sort.Slice(export.Balances, func(i, j int) bool {
+		return export.Balances[i].Address < export.Balances[j].Address
+	})
+	sort.Slice(export.ModuleAccounts, func(i, j int) bool {
+		return export.ModuleAccounts[i].Module < export.ModuleAccounts[j].Module
+	})
+
+	return export, nil
+}
+
+// CSV returns the balances of the export in CSV, with one row per account and
+// denomination.
+func (e BalancesExport) CSV() ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"address", "module", "denom", "amount"}); err != nil {
+		return nil, err
+	}
+
+	for _, balance := range e.Balances {
+		for _, coin := range balance.Coins {
+			if err := w.Write([]string{balance.Address, balance.Module, coin.Denom, coin.Amount.String()}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func queryAllBalances(ctx context.Context, queryClient types.QueryClient, address string) (sdk.Coins, error) {
+	coins := sdk.NewCoins()
+
+	var pageReq *query.PageRequest
+	for {
+		res, err := queryClient.AllBalances(ctx, &types.QueryAllBalancesRequest{Address: address, Pagination: pageReq})
+		if err != nil {
+			return nil, err
+		}
+		coins = coins.Add(res.Balances...)
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return coins, nil
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
+
+func queryTotalSupply(ctx context.Context, queryClient types.QueryClient) (sdk.Coins, error) {
+	coins := sdk.NewCoins()
+
+	var pageReq *query.PageRequest
+	for {
+		res, err := queryClient.TotalSupply(ctx, &types.QueryTotalSupplyRequest{Pagination: pageReq})
+		if err != nil {
+			return nil, err
+		}
+		coins = coins.Add(res.Supply...)
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return coins, nil
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
@@ -14,7 +14,8 @@ import (
 )
 
 const (
-	FlagDenom = "denom"
+	FlagDenom        = "denom"
+	FlagDenomPattern = "denom-pattern"
 )
 
 // GetQueryCmd returns the parent command for all x/bank CLi query commands. The
@@ -33,6 +34,7 @@ func GetQueryCmd() *cobra.Command {
 		GetBalancesCmd(),
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
+		GetCmdExportBalances(),
 	)
 
 	return cmd
@@ -168,8 +170,11 @@ Example:
 
 To query for the total supply of a specific coin denomination use:
   $ %s query %s total --denom=[denom]
+
+To query for the total supply of the denominations matching a regular expression use:
+  $ %s query %s total --denom-pattern='^ibc/'
 `,
-				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName, version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -181,6 +186,10 @@ To query for the total supply of a specific coin denomination use:
 			if err != nil {
 				return err
 			}
+			denomPattern, err := cmd.Flags().GetString(FlagDenomPattern)
+			if err != nil {
+				return err
+			}
 
 			queryClient := types.NewQueryClient(clientCtx)
 			ctx := cmd.Context()
@@ -190,7 +199,7 @@ To query for the total supply of a specific coin denomination use:
 				return err
 			}
 			if denom == "" {
-				res, err := queryClient.TotalSupply(ctx, &types.QueryTotalSupplyRequest{Pagination: pageReq})
+				res, err := queryClient.TotalSupply(ctx, &types.QueryTotalSupplyRequest{Pagination: pageReq, DenomPattern: denomPattern})
 				if err != nil {
 					return err
 				}
@@ -208,7 +217,9 @@ To query for the total supply of a specific coin denomination use:
 	}
 
 	cmd.Flags().String(FlagDenom, "", "The specific balance denomination to query for")
+	cmd.Flags().String(FlagDenomPattern, "", "A regular expression the denominations must match")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "total")
 
 	return cmd
 }
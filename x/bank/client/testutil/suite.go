@@ -1,6 +1,9 @@
 package testutil
 
 import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
 
 	"github.com/gogo/protobuf/proto"
@@ -362,6 +365,60 @@ func (s *IntegrationTestSuite) TestGetCmdQueryDenomsMetadata() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdExportBalances() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdExportBalances(), []string{
+		fmt.Sprintf("--%s=1", flags.FlagHeight),
+	})
+	s.Require().NoError(err)
+
+	var export cli.BalancesExport
+	s.Require().NoError(json.Unmarshal(out.Bytes(), &export))
+	s.Require().Equal(int64(1), export.Height)
+
+	// the balances are sorted by address and add up to the total supply
+	total := sdk.NewCoins()
+	var valBalance sdk.Coins
+	for i, balance := range export.Balances {
+		if i > 0 {
+			s.Require().True(export.Balances[i-1].Address < balance.Address)
+		}
+		if balance.Address == val.Address.String() {
+			valBalance = balance.Coins
+		}
+		total = total.Add(balance.Coins...)
+	}
+	s.Require().Equal(export.Supply, total)
+	s.Require().Equal(s.cfg.AccountTokens, valBalance.AmountOf(fmt.Sprintf("%stoken", val.Moniker)))
+
+	modules := make(map[string]sdk.Coins)
+	for _, macc := range export.ModuleAccounts {
+		modules[macc.Module] = macc.Coins
+	}
+	s.Require().Contains(modules, "bonded_tokens_pool")
+	s.Require().True(modules["bonded_tokens_pool"].IsAllPositive())
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdExportBalances(), []string{
+		fmt.Sprintf("--%s=1", flags.FlagHeight),
+		fmt.Sprintf("--%s=%s", cli.FlagFormat, cli.ExportFormatCSV),
+	})
+	s.Require().NoError(err)
+
+	records, err := csv.NewReader(bytes.NewReader(out.Bytes())).ReadAll()
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"address", "module", "denom", "amount"}, records[0])
+	s.Require().Contains(records, []string{
+		val.Address.String(), "", fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens.String(),
+	})
+
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdExportBalances(), []string{
+		fmt.Sprintf("--%s=xml", cli.FlagFormat),
+	})
+	s.Require().Error(err)
+}
+
 func (s *IntegrationTestSuite) TestNewSendTxCmdGenOnly() {
 	val := s.network.Validators[0]
 
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"regexp"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -77,8 +78,17 @@ func (k BaseKeeper) AllBalances(ctx context.Context, req *types.QueryAllBalances
 
 // TotalSupply implements the Query/TotalSupply gRPC method
 func (k BaseKeeper) TotalSupply(ctx context.Context, req *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	denomPattern, err := compileDenomPattern(req.DenomPattern)
+	if err != nil {
+		return nil, err
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	totalSupply, pageRes, err := k.GetPaginatedTotalSupply(sdkCtx, req.Pagination)
+	totalSupply, pageRes, err := k.GetFilteredPaginatedTotalSupply(sdkCtx, denomPattern, req.Pagination)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -86,6 +96,42 @@ func (k BaseKeeper) TotalSupply(ctx context.Context, req *types.QueryTotalSupply
 	return &types.QueryTotalSupplyResponse{Supply: totalSupply, Pagination: pageRes}, nil
 }
 
+// TotalSupplyStream implements the Query/TotalSupplyStream gRPC method
+func (k BaseKeeper) TotalSupplyStream(req *types.QueryTotalSupplyStreamRequest, stream types.Query_TotalSupplyStreamServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	denomPattern, err := compileDenomPattern(req.DenomPattern)
+	if err != nil {
+		return err
+	}
+
+	pagination := req.Pagination
+	if pagination == nil {
+		pagination = &query.PageRequest{}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(stream.Context())
+	for {
+		supply, pageRes, err := k.GetFilteredPaginatedTotalSupply(sdkCtx, denomPattern, pagination)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		if err := stream.Send(&types.QueryTotalSupplyStreamResponse{Supply: supply, Pagination: pageRes}); err != nil {
+			return err
+		}
+
+		if len(pageRes.NextKey) == 0 {
+			return nil
+		}
+
+		// the following pages are requested by key, with the same page size
+		pagination = &query.PageRequest{Key: pageRes.NextKey, Limit: pagination.Limit}
+	}
+}
+
 // SupplyOf implements the Query/SupplyOf gRPC method
 func (k BaseKeeper) SupplyOf(c context.Context, req *types.QuerySupplyOfRequest) (*types.QuerySupplyOfResponse, error) {
 	if req == nil {
@@ -210,3 +256,18 @@ func (k BaseKeeper) DenomOwners(
 
 	return &types.QueryDenomOwnersResponse{DenomOwners: denomOwners, Pagination: pageRes}, nil
 }
+
+// compileDenomPattern compiles an optional denom regular expression, it
+// returns nil if the pattern is empty.
+func compileDenomPattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+
+	denomPattern, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom pattern: %s", err)
+	}
+
+	return denomPattern, nil
+}
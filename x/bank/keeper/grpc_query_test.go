@@ -4,6 +4,8 @@ import (
 	gocontext "context"
 	"fmt"
 
+	"google.golang.org/grpc"
+
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -99,6 +101,63 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupply() {
 	suite.Require().Equal(expectedTotalSupply, res.Supply)
 }
 
+func (suite *IntegrationTestSuite) TestQueryTotalSupplyDenomPattern() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	supply := sdk.NewCoins(
+		sdk.NewInt64Coin("ibc/A", 1), sdk.NewInt64Coin("ibc/B", 2), sdk.NewInt64Coin("test", 3),
+	)
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, supply))
+
+	res, err := queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{
+		DenomPattern: "^ibc/",
+		Pagination:   &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ibc/A", 1)), res.Supply)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	_, err = queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{DenomPattern: "["})
+	suite.Require().Error(err)
+}
+
+func (suite *IntegrationTestSuite) TestQueryTotalSupplyStream() {
+	app, ctx := suite.app, suite.ctx
+	supply := sdk.NewCoins(
+		sdk.NewInt64Coin("ibc/A", 1), sdk.NewInt64Coin("ibc/B", 2), sdk.NewInt64Coin("ibc/C", 3), sdk.NewInt64Coin("test", 4),
+	)
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, supply))
+
+	stream := &totalSupplyStream{ctx: sdk.WrapSDKContext(ctx)}
+	err := app.BankKeeper.TotalSupplyStream(&types.QueryTotalSupplyStreamRequest{
+		DenomPattern: "^ibc/",
+		Pagination:   &query.PageRequest{Limit: 2},
+	}, stream)
+	suite.Require().NoError(err)
+
+	suite.Require().Len(stream.responses, 2)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ibc/A", 1), sdk.NewInt64Coin("ibc/B", 2)), stream.responses[0].Supply)
+	suite.Require().NotEmpty(stream.responses[0].Pagination.NextKey)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ibc/C", 3)), stream.responses[1].Supply)
+	suite.Require().Empty(stream.responses[1].Pagination.NextKey)
+
+	err = app.BankKeeper.TotalSupplyStream(&types.QueryTotalSupplyStreamRequest{DenomPattern: "["}, stream)
+	suite.Require().Error(err)
+}
+
+// totalSupplyStream records the responses of a Query/TotalSupplyStream call.
+type totalSupplyStream struct {
+	grpc.ServerStream
+	ctx       gocontext.Context
+	responses []*types.QueryTotalSupplyStreamResponse
+}
+
+func (s *totalSupplyStream) Context() gocontext.Context { return s.ctx }
+
+func (s *totalSupplyStream) Send(res *types.QueryTotalSupplyStreamResponse) error {
+	s.responses = append(s.responses, res)
+	return nil
+}
+
 func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
 	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
 
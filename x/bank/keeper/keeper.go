@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -33,15 +34,19 @@ type Keeper interface {
 
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToModuleWithPurpose(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins, purpose string) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnAccountCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error
 
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	GetAuthority() string
+
 	types.QueryServer
 }
 
@@ -53,25 +58,40 @@ type BaseKeeper struct {
 	cdc        codec.BinaryCodec
 	storeKey   sdk.StoreKey
 	paramSpace paramtypes.Subspace
+	authority  string
 }
 
 // GetPaginatedTotalSupply queries for the supply, ignoring 0 coins, with a given pagination
 func (k BaseKeeper) GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
+	return k.GetFilteredPaginatedTotalSupply(ctx, nil, pagination)
+}
+
+// GetFilteredPaginatedTotalSupply queries for the supply of the denoms matching
+// denomPattern, ignoring 0 coins, with a given pagination. A nil denomPattern
+// matches all the denoms.
+func (k BaseKeeper) GetFilteredPaginatedTotalSupply(ctx sdk.Context, denomPattern *regexp.Regexp, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
 	store := ctx.KVStore(k.storeKey)
 	supplyStore := prefix.NewStore(store, types.SupplyKey)
 
 	supply := sdk.NewCoins()
 
-	pageRes, err := query.Paginate(supplyStore, pagination, func(key, value []byte) error {
-		var amount sdk.Int
-		err := amount.Unmarshal(value)
-		if err != nil {
-			return fmt.Errorf("unable to convert amount string to Int %v", err)
+	pageRes, err := query.FilteredPaginate(supplyStore, pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		if denomPattern != nil && !denomPattern.Match(key) {
+			return false, nil
 		}
 
-		// `Add` omits the 0 coins addition to the `supply`.
-		supply = supply.Add(sdk.NewCoin(string(key), amount))
-		return nil
+		if accumulate {
+			var amount sdk.Int
+			err := amount.Unmarshal(value)
+			if err != nil {
+				return false, fmt.Errorf("unable to convert amount string to Int %v", err)
+			}
+
+			// `Add` omits the 0 coins addition to the `supply`.
+			supply = supply.Add(sdk.NewCoin(string(key), amount))
+		}
+
+		return true, nil
 	})
 
 	if err != nil {
@@ -86,13 +106,16 @@ func (k BaseKeeper) GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.P
 // store and fetch module parameters. The BaseKeeper also accepts a
 // blocklist map. This blocklist describes the set of addresses that are not allowed
 // to receive funds through direct and explicit actions, for example, by using a MsgSend or
-// by using a SendCoinsFromModuleToAccount execution.
+// by using a SendCoinsFromModuleToAccount execution. The authority is the
+// address allowed to burn the coins held by module accounts, usually the
+// governance module account.
 func NewBaseKeeper(
 	cdc codec.BinaryCodec,
 	storeKey sdk.StoreKey,
 	ak types.AccountKeeper,
 	paramSpace paramtypes.Subspace,
 	blockedAddrs map[string]bool,
+	authority string,
 ) BaseKeeper {
 
 	// set KeyTable if it has not already been set
@@ -106,6 +129,7 @@ func NewBaseKeeper(
 		cdc:            cdc,
 		storeKey:       storeKey,
 		paramSpace:     paramSpace,
+		authority:      authority,
 	}
 }
 
@@ -309,6 +333,30 @@ func (k BaseKeeper) SendCoinsFromModuleToModule(
 	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
 }
 
+// SendCoinsFromModuleToModuleWithPurpose transfers coins from a ModuleAccount
+// to another like SendCoinsFromModuleToModule, and emits an EventModuleTransfer
+// recording the purpose of the transfer, so that the flows between modules can
+// be classified from the events. The purpose can't be blank.
+// It will panic if either module account does not exist.
+func (k BaseKeeper) SendCoinsFromModuleToModuleWithPurpose(
+	ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins, purpose string,
+) error {
+	if err := types.ValidateModuleTransferPurpose(purpose); err != nil {
+		return err
+	}
+
+	if err := k.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventModuleTransfer{
+		SenderModule:    senderModule,
+		RecipientModule: recipientModule,
+		Amount:          amt,
+		Purpose:         purpose,
+	})
+}
+
 // SendCoinsFromAccountToModule transfers coins from an AccAddress to a ModuleAccount.
 // It will panic if the module account does not exist.
 func (k BaseKeeper) SendCoinsFromAccountToModule(
@@ -407,7 +455,32 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to burn tokens", moduleName))
 	}
 
-	err := k.subUnlockedCoins(ctx, acc.GetAddress(), amounts)
+	if err := k.burnCoins(ctx, acc.GetAddress(), amounts); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("burned tokens from module account", "amount", amounts.String(), "from", moduleName)
+
+	return nil
+}
+
+// BurnAccountCoins burns coins from the unlocked balance of an account, which
+// may be a module account without burner permission.
+func (k BaseKeeper) BurnAccountCoins(ctx sdk.Context, addr sdk.AccAddress, amounts sdk.Coins) error {
+	if err := k.burnCoins(ctx, addr, amounts); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("burned tokens from account", "amount", amounts.String(), "from", addr.String())
+
+	return nil
+}
+
+// burnCoins deletes coins from the balance of an account and the supply.
+func (k BaseKeeper) burnCoins(ctx sdk.Context, addr sdk.AccAddress, amounts sdk.Coins) error {
+	err := k.subUnlockedCoins(ctx, addr, amounts)
 	if err != nil {
 		return err
 	}
@@ -418,17 +491,20 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 		k.setSupply(ctx, supply)
 	}
 
-	logger := k.Logger(ctx)
-	logger.Info("burned tokens from module account", "amount", amounts.String(), "from", moduleName)
-
 	// emit burn event
 	ctx.EventManager().EmitEvent(
-		types.NewCoinBurnEvent(acc.GetAddress(), amounts),
+		types.NewCoinBurnEvent(addr, amounts),
 	)
 
 	return nil
 }
 
+// GetAuthority returns the address of the bank authority, which may burn the
+// coins held by module accounts.
+func (k BaseKeeper) GetAuthority() string {
+	return k.authority
+}
+
 // setSupply sets the supply for the given coin
 func (k BaseKeeper) setSupply(ctx sdk.Context, coin sdk.Coin) {
 	intBytes, err := coin.Amount.Marshal()
@@ -1,9 +1,11 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -12,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -20,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -87,7 +91,7 @@ func (suite *IntegrationTestSuite) initKeepersWithmAccPerms(blockedAddrs map[str
 	)
 	keeper := keeper.NewBaseKeeper(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), blockedAddrs,
+		app.GetSubspace(types.ModuleName), blockedAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	return authKeeper, keeper
@@ -206,6 +210,44 @@ func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
 	suite.Require().Equal(initCoins, getCoinsByName(ctx, keeper, authKeeper, authtypes.Burner))
 }
 
+func (suite *IntegrationTestSuite) TestSendCoinsFromModuleToModuleWithPurpose() {
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+
+	authKeeper, keeper := suite.initKeepersWithmAccPerms(make(map[string]bool))
+	suite.Require().NoError(keeper.MintCoins(ctx, minttypes.ModuleName, initCoins))
+	authKeeper.SetModuleAccount(ctx, burnerAcc)
+
+	suite.Require().ErrorIs(
+		keeper.SendCoinsFromModuleToModuleWithPurpose(ctx, minttypes.ModuleName, authtypes.Burner, initCoins, " "),
+		types.ErrInvalidModuleTransferPurpose,
+	)
+	suite.Require().Equal(initCoins, getCoinsByName(ctx, keeper, authKeeper, minttypes.ModuleName))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(
+		keeper.SendCoinsFromModuleToModuleWithPurpose(ctx, minttypes.ModuleName, authtypes.Burner, initCoins, types.ModuleTransferPurposeBurnStaging),
+	)
+	suite.Require().Equal(sdk.NewCoins().String(), getCoinsByName(ctx, keeper, authKeeper, minttypes.ModuleName).String())
+	suite.Require().Equal(initCoins, getCoinsByName(ctx, keeper, authKeeper, authtypes.Burner))
+
+	// the transfer is recorded in a typed event along with its purpose
+	var transfers []*types.EventModuleTransfer
+	for _, e := range ctx.EventManager().ABCIEvents() {
+		if e.Type != proto.MessageName(&types.EventModuleTransfer{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(e)
+		suite.Require().NoError(err)
+		transfers = append(transfers, msg.(*types.EventModuleTransfer))
+	}
+	suite.Require().Equal([]*types.EventModuleTransfer{{
+		SenderModule:    minttypes.ModuleName,
+		RecipientModule: authtypes.Burner,
+		Amount:          initCoins,
+		Purpose:         types.ModuleTransferPurposeBurnStaging,
+	}}, transfers)
+}
+
 func (suite *IntegrationTestSuite) TestSupply_MintCoins() {
 	ctx := suite.ctx
 
@@ -302,6 +344,56 @@ func (suite *IntegrationTestSuite) TestSupply_BurnCoins() {
 	suite.Require().Equal(supplyAfterInflation.Sub(initCoins), supplyAfterBurn)
 }
 
+func (suite *IntegrationTestSuite) TestMsgBurn() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+
+	coins := sdk.NewCoins(newFooCoin(50))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr, coins))
+	supplyBefore := app.BankKeeper.GetSupply(ctx, fooDenom)
+
+	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr, sdk.NewCoins(newFooCoin(100))))
+	suite.Require().Error(err, "insufficient coins")
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr, sdk.NewCoins(newFooCoin(20))))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(30)), app.BankKeeper.GetAllBalances(ctx, addr))
+	suite.Require().Equal(supplyBefore.SubAmount(sdk.NewInt(20)), app.BankKeeper.GetSupply(ctx, fooDenom))
+
+	var burnEvents int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeCoinBurn {
+			burnEvents++
+		}
+	}
+	suite.Require().Equal(1, burnEvents)
+}
+
+func (suite *IntegrationTestSuite) TestMsgBurnModuleCoins() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+	authority, err := sdk.AccAddressFromBech32(app.BankKeeper.GetAuthority())
+	suite.Require().NoError(err)
+
+	// the holder module account has no burner permission
+	holderAddr := authtypes.NewModuleAddress(holder)
+	coins := sdk.NewCoins(newFooCoin(50))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, holderAddr, coins))
+	supplyBefore := app.BankKeeper.GetSupply(ctx, fooDenom)
+
+	notAuthority := sdk.AccAddress([]byte("addr1_______________"))
+	_, err = msgServer.BurnModuleCoins(sdk.WrapSDKContext(ctx), types.NewMsgBurnModuleCoins(notAuthority, holder, coins))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.BurnModuleCoins(sdk.WrapSDKContext(ctx), types.NewMsgBurnModuleCoins(authority, holder, sdk.NewCoins(newFooCoin(20))))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(30)), app.BankKeeper.GetAllBalances(ctx, holderAddr))
+	suite.Require().Equal(supplyBefore.SubAmount(sdk.NewInt(20)), app.BankKeeper.GetSupply(ctx, fooDenom))
+}
+
 func (suite *IntegrationTestSuite) TestSendCoinsNewAccount() {
 	app, ctx := suite.app, suite.ctx
 	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
@@ -453,6 +545,56 @@ func (suite *IntegrationTestSuite) TestSendCoins() {
 	suite.Require().Equal(newBarCoin(25), coins[0], "expected only bar coins in the account balance, got: %v", coins)
 }
 
+func (suite *IntegrationTestSuite) TestSendRestriction() {
+	ctx := suite.ctx
+	_, keeper := suite.initKeepersWithmAccPerms(make(map[string]bool))
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	suite.Require().NoError(keeper.MintCoins(ctx, minttypes.ModuleName, initCoins))
+	suite.Require().NoError(keeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr1, initCoins))
+
+	var calls []sdk.AccAddress
+	errRestricted := errors.New("restricted")
+	restrictFrom := func(restricted sdk.AccAddress) types.SendRestrictionFn {
+		return func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) error {
+			calls = append(calls, toAddr)
+			if fromAddr.Equals(restricted) {
+				return errRestricted
+			}
+			return nil
+		}
+	}
+
+	// the restrictions are shared by the copies of the keeper, and consulted in
+	// order
+	copied := keeper
+	copied.AppendSendRestriction(restrictFrom(addr2))
+	keeper.AppendSendRestriction(restrictFrom(nil))
+
+	sendAmt := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	suite.Require().NoError(keeper.SendCoins(ctx, addr1, addr2, sendAmt))
+	suite.Require().Equal([]sdk.AccAddress{addr2, addr2}, calls)
+
+	calls = nil
+	suite.Require().ErrorIs(keeper.SendCoins(ctx, addr2, addr1, sendAmt), errRestricted)
+	suite.Require().Len(calls, 1)
+	suite.Require().Equal(sendAmt, keeper.GetAllBalances(ctx, addr2))
+
+	// the recipient is empty for the inputs of a multi-send
+	calls = nil
+	inputs := []types.Input{types.NewInput(addr2, sendAmt)}
+	outputs := []types.Output{types.NewOutput(addr1, sendAmt)}
+	suite.Require().ErrorIs(keeper.InputOutputCoins(ctx, inputs, outputs), errRestricted)
+	suite.Require().Equal([]sdk.AccAddress{nil}, calls)
+
+	// the module sends are restricted too
+	suite.Require().NoError(keeper.MintCoins(ctx, minttypes.ModuleName, initCoins))
+	calls = nil
+	suite.Require().NoError(keeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.Burner, initCoins))
+	suite.Require().Len(calls, 2)
+}
+
 func (suite *IntegrationTestSuite) TestValidateBalance() {
 	app, ctx := suite.app, suite.ctx
 	now := tmtime.Now()
@@ -1047,7 +1189,7 @@ func (suite *IntegrationTestSuite) TestBalanceTrackingEvents() {
 	)
 
 	suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil)
+		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	// set account with multiple permissions
 	suite.app.AccountKeeper.SetModuleAccount(suite.ctx, multiPermAcc)
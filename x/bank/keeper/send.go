@@ -26,6 +26,8 @@ type SendKeeper interface {
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
 	BlockedAddr(addr sdk.AccAddress) bool
+
+	AppendSendRestriction(restriction types.SendRestrictionFn)
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -42,6 +44,15 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	// the restrictions consulted before sends, shared by the copies of the
+	// keeper so that they can be appended once the keeper is passed to other
+	// modules
+	sendRestriction *sendRestriction
+}
+
+type sendRestriction struct {
+	fn types.SendRestrictionFn
 }
 
 func NewBaseSendKeeper(
@@ -49,12 +60,13 @@ func NewBaseSendKeeper(
 ) BaseSendKeeper {
 
 	return BaseSendKeeper{
-		BaseViewKeeper: NewBaseViewKeeper(cdc, storeKey, ak),
-		cdc:            cdc,
-		ak:             ak,
-		storeKey:       storeKey,
-		paramSpace:     paramSpace,
-		blockedAddrs:   blockedAddrs,
+		BaseViewKeeper:  NewBaseViewKeeper(cdc, storeKey, ak),
+		cdc:             cdc,
+		ak:              ak,
+		storeKey:        storeKey,
+		paramSpace:      paramSpace,
+		blockedAddrs:    blockedAddrs,
+		sendRestriction: &sendRestriction{},
 	}
 }
 
@@ -69,6 +81,22 @@ func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
+// AppendSendRestriction adds a restriction consulted before coins are sent,
+// after the restrictions already added. It affects all the copies of the
+// keeper.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.fn = k.sendRestriction.fn.Then(restriction)
+}
+
+// checkSendRestriction returns the error of the send restrictions, if any.
+func (k BaseSendKeeper) checkSendRestriction(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.sendRestriction.fn == nil {
+		return nil
+	}
+
+	return k.sendRestriction.fn(ctx, fromAddr, toAddr, amt)
+}
+
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
 // inputs and outputs don't lineup or if any single transfer of tokens fails.
@@ -85,6 +113,10 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 			return err
 		}
 
+		if err := k.checkSendRestriction(ctx, inAddress, nil, in.Coins); err != nil {
+			return err
+		}
+
 		err = k.subUnlockedCoins(ctx, inAddress, in.Coins)
 		if err != nil {
 			return err
@@ -131,8 +163,13 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// An error is returned upon failure, or if a send restriction rejects the
+// transfer.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.checkSendRestriction(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
 	err := k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
@@ -432,6 +432,78 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
+// EventModuleTransfer is emitted on a transfer of coins from a module account
+// to another, with the purpose of the transfer given by the sending module.
+type EventModuleTransfer struct {
+	SenderModule    string                                   `protobuf:"bytes,1,opt,name=sender_module,json=senderModule,proto3" json:"sender_module,omitempty"`
+	RecipientModule string                                   `protobuf:"bytes,2,opt,name=recipient_module,json=recipientModule,proto3" json:"recipient_module,omitempty"`
+	Amount          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// purpose classifies the transfer, e.g. fee_distribution, deposit_refund or
+	// burn_staging.
+	Purpose string `protobuf:"bytes,4,opt,name=purpose,proto3" json:"purpose,omitempty"`
+}
+
+func (m *EventModuleTransfer) Reset()         { *m = EventModuleTransfer{} }
+func (m *EventModuleTransfer) String() string { return proto.CompactTextString(m) }
+func (*EventModuleTransfer) ProtoMessage()    {}
+func (*EventModuleTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *EventModuleTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventModuleTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventModuleTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventModuleTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventModuleTransfer.Merge(m, src)
+}
+func (m *EventModuleTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventModuleTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventModuleTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventModuleTransfer proto.InternalMessageInfo
+
+func (m *EventModuleTransfer) GetSenderModule() string {
+	if m != nil {
+		return m.SenderModule
+	}
+	return ""
+}
+
+func (m *EventModuleTransfer) GetRecipientModule() string {
+	if m != nil {
+		return m.RecipientModule
+	}
+	return ""
+}
+
+func (m *EventModuleTransfer) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *EventModuleTransfer) GetPurpose() string {
+	if m != nil {
+		return m.Purpose
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -440,53 +512,58 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*EventModuleTransfer)(nil), "cosmos.bank.v1beta1.EventModuleTransfer")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 644 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xc1, 0x6b, 0x13, 0x4f,
-	0x14, 0xce, 0x34, 0x4d, 0xb2, 0x9d, 0xfc, 0x7e, 0x97, 0xb1, 0xc8, 0xb6, 0xe0, 0x6e, 0x5c, 0x50,
-	0x52, 0xb1, 0x49, 0xab, 0x9e, 0x82, 0x20, 0xa4, 0x8a, 0x46, 0x10, 0x65, 0x4b, 0x11, 0xf4, 0x10,
-	0x26, 0x99, 0x69, 0x32, 0x74, 0x77, 0x66, 0xd9, 0x99, 0x2d, 0xcd, 0x7f, 0x20, 0x1e, 0xd4, 0xa3,
-	0xc7, 0x9e, 0x3d, 0x0b, 0xfe, 0x0b, 0x3d, 0x16, 0xbd, 0x78, 0x8a, 0x92, 0x5e, 0x3c, 0xf7, 0x2f,
-	0x90, 0x99, 0xd9, 0xa4, 0x29, 0x54, 0xf1, 0xe0, 0xc1, 0x53, 0xde, 0xf7, 0xde, 0xf7, 0xbe, 0xf7,
-	0xf1, 0xe6, 0x6d, 0xa0, 0xd7, 0x17, 0x32, 0x16, 0xb2, 0xd9, 0xc3, 0x7c, 0xaf, 0xb9, 0xbf, 0xd9,
-	0xa3, 0x0a, 0x6f, 0x1a, 0xd0, 0x48, 0x52, 0xa1, 0x04, 0xba, 0x64, 0xeb, 0x0d, 0x93, 0xca, 0xeb,
-	0xab, 0xcb, 0x03, 0x31, 0x10, 0xa6, 0xde, 0xd4, 0x91, 0xa5, 0xae, 0xae, 0x58, 0x6a, 0xd7, 0x16,
-	0xf2, 0x3e, 0x5b, 0x3a, 0x9b, 0x22, 0xe9, 0x6c, 0x4a, 0x5f, 0x30, 0x6e, 0xeb, 0xc1, 0x17, 0x00,
-	0xcb, 0xcf, 0x70, 0x8a, 0x63, 0x89, 0x76, 0xe1, 0x7f, 0x92, 0x72, 0xd2, 0xa5, 0x1c, 0xf7, 0x22,
-	0x4a, 0x5c, 0x50, 0x2b, 0xd6, 0xab, 0xb7, 0x6a, 0x8d, 0x0b, 0x7c, 0x34, 0xb6, 0x29, 0x27, 0x0f,
-	0x2c, 0xaf, 0x7d, 0xf5, 0x74, 0xec, 0x5f, 0x19, 0xe1, 0x38, 0x6a, 0x05, 0xf3, 0xfd, 0x37, 0x45,
-	0xcc, 0x14, 0x8d, 0x13, 0x35, 0x0a, 0xc2, 0xaa, 0x3c, 0xe3, 0xa3, 0x97, 0x70, 0x99, 0xd0, 0x5d,
-	0x9c, 0x45, 0xaa, 0x7b, 0x6e, 0xde, 0x42, 0x0d, 0xd4, 0x9d, 0xf6, 0xda, 0xe9, 0xd8, 0xbf, 0x66,
-	0xd5, 0x2e, 0x62, 0xcd, 0xab, 0xa2, 0x9c, 0x30, 0x67, 0xa6, 0xb5, 0xf8, 0xfe, 0xd0, 0x2f, 0x04,
-	0x0f, 0x61, 0x75, 0x2e, 0x89, 0x96, 0x61, 0x89, 0x50, 0x2e, 0x62, 0x17, 0xd4, 0x40, 0x7d, 0x29,
-	0xb4, 0x00, 0xb9, 0xb0, 0x72, 0x6e, 0x74, 0x38, 0x85, 0x2d, 0x47, 0x8b, 0xfc, 0x38, 0xf4, 0x41,
-	0xf0, 0x06, 0xc0, 0x52, 0x87, 0x27, 0x99, 0xd2, 0x6c, 0x4c, 0x48, 0x4a, 0xa5, 0xcc, 0x55, 0xa6,
-	0x10, 0x61, 0x58, 0xd2, 0x0b, 0x95, 0xee, 0x82, 0x59, 0xd8, 0xca, 0xd9, 0xc2, 0x24, 0x9d, 0x2d,
-	0x6c, 0x4b, 0x30, 0xde, 0xde, 0x38, 0x1a, 0xfb, 0x85, 0x0f, 0xdf, 0xfc, 0xfa, 0x80, 0xa9, 0x61,
-	0xd6, 0x6b, 0xf4, 0x45, 0x9c, 0xbf, 0x56, 0xfe, 0xb3, 0x2e, 0xc9, 0x5e, 0x53, 0x8d, 0x12, 0x2a,
-	0x4d, 0x83, 0x0c, 0xad, 0x72, 0xcb, 0x79, 0x65, 0x0d, 0x15, 0x82, 0xb7, 0x00, 0x96, 0x9f, 0x66,
-	0xea, 0x1f, 0x72, 0xf4, 0x09, 0xc0, 0xf2, 0x76, 0x96, 0x24, 0xd1, 0x48, 0xcf, 0x55, 0x42, 0xe1,
-	0x28, 0x3f, 0x9d, 0xbf, 0x3b, 0xd7, 0x28, 0xb7, 0x1e, 0xe7, 0x73, 0xc1, 0xe7, 0x8f, 0xeb, 0x77,
-	0x6f, 0xfc, 0xb6, 0xfb, 0xc0, 0x7e, 0x5a, 0x31, 0x1b, 0xa4, 0x58, 0x31, 0xc1, 0x65, 0x73, 0x7f,
-	0xe3, 0xce, 0x46, 0xc3, 0x7a, 0xed, 0xb8, 0x20, 0x78, 0x0e, 0x97, 0xee, 0xeb, 0x4b, 0xd8, 0xe1,
-	0x4c, 0xfd, 0xe2, 0x46, 0x56, 0xa1, 0x43, 0x0f, 0x12, 0xc1, 0x29, 0x57, 0xe6, 0x48, 0xfe, 0x0f,
-	0x67, 0xd8, 0xec, 0x3f, 0x62, 0x58, 0x52, 0xe9, 0x16, 0x6b, 0x45, 0xb3, 0x7f, 0x0b, 0x83, 0xd7,
-	0x0b, 0xd0, 0x79, 0x42, 0x15, 0x26, 0x58, 0x61, 0x54, 0x83, 0x55, 0x42, 0x65, 0x3f, 0x65, 0x89,
-	0x36, 0x91, 0xcb, 0xcf, 0xa7, 0xd0, 0x3d, 0xcd, 0xe0, 0x22, 0xee, 0x66, 0x9c, 0xa9, 0xe9, 0xa3,
-	0x79, 0x17, 0x7e, 0x77, 0x33, 0xbf, 0x21, 0x24, 0xd3, 0x50, 0x22, 0x04, 0x17, 0xf5, 0x8a, 0xdd,
-	0xa2, 0xd1, 0x36, 0xb1, 0x76, 0x47, 0x98, 0x4c, 0x22, 0x3c, 0x72, 0x17, 0xed, 0x75, 0xe4, 0x50,
-	0xb3, 0x39, 0x8e, 0xa9, 0x5b, 0xb2, 0x6c, 0x1d, 0xa3, 0xcb, 0xb0, 0x2c, 0x47, 0x71, 0x4f, 0x44,
-	0x6e, 0xd9, 0x64, 0x73, 0x84, 0x56, 0x60, 0x31, 0x4b, 0x99, 0x5b, 0xd1, 0xc9, 0x76, 0x65, 0x32,
-	0xf6, 0x8b, 0x3b, 0x61, 0x27, 0xd4, 0x39, 0x74, 0x1d, 0x3a, 0x59, 0xca, 0xba, 0x43, 0x2c, 0x87,
-	0xae, 0x63, 0xea, 0xd5, 0xc9, 0xd8, 0xaf, 0xec, 0x84, 0x9d, 0x47, 0x58, 0x0e, 0xc3, 0x4a, 0x96,
-	0x32, 0x1d, 0xb4, 0xb7, 0x8e, 0x26, 0x1e, 0x38, 0x9e, 0x78, 0xe0, 0xfb, 0xc4, 0x03, 0xef, 0x4e,
-	0xbc, 0xc2, 0xf1, 0x89, 0x57, 0xf8, 0x7a, 0xe2, 0x15, 0x5e, 0xac, 0xfd, 0xc9, 0xf3, 0x99, 0x1b,
-	0xe8, 0x95, 0xcd, 0xbf, 0xd5, 0xed, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xba, 0x6c, 0x6b,
-	0x35, 0x05, 0x00, 0x00,
+	// 720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcf, 0x6b, 0x13, 0x4d,
+	0x18, 0xce, 0x24, 0xcd, 0x8f, 0x4e, 0x5a, 0xbe, 0x8f, 0x69, 0xf9, 0xd8, 0x16, 0xbe, 0xdd, 0xb8,
+	0xa2, 0xa4, 0x62, 0x93, 0x56, 0x3d, 0x05, 0x41, 0x48, 0x2d, 0x1a, 0xa1, 0x28, 0x5b, 0x8b, 0xa0,
+	0x87, 0x30, 0xc9, 0x4e, 0x93, 0xa1, 0xbb, 0x33, 0xcb, 0xce, 0x6c, 0x69, 0xfe, 0x03, 0xf1, 0xa0,
+	0x1e, 0x3d, 0xf6, 0xec, 0x59, 0xf0, 0x5f, 0xe8, 0xb1, 0xe8, 0xc5, 0x53, 0x94, 0xd4, 0x83, 0xe7,
+	0xfe, 0x05, 0x32, 0x33, 0x9b, 0x34, 0x85, 0x2a, 0x1e, 0x14, 0x3c, 0xe5, 0x7d, 0xde, 0xf7, 0x79,
+	0x9f, 0xf7, 0xe5, 0xd9, 0x77, 0x02, 0xed, 0x2e, 0x17, 0x21, 0x17, 0xf5, 0x0e, 0x66, 0x7b, 0xf5,
+	0xfd, 0xf5, 0x0e, 0x91, 0x78, 0x5d, 0x83, 0x5a, 0x14, 0x73, 0xc9, 0xd1, 0x82, 0xa9, 0xd7, 0x74,
+	0x2a, 0xad, 0x2f, 0x2f, 0xf6, 0x78, 0x8f, 0xeb, 0x7a, 0x5d, 0x45, 0x86, 0xba, 0xbc, 0x64, 0xa8,
+	0x6d, 0x53, 0x48, 0xfb, 0x4c, 0xe9, 0x6c, 0x8a, 0x20, 0x93, 0x29, 0x5d, 0x4e, 0x99, 0xa9, 0xbb,
+	0x1f, 0x01, 0x2c, 0x3c, 0xc2, 0x31, 0x0e, 0x05, 0xda, 0x85, 0x73, 0x82, 0x30, 0xbf, 0x4d, 0x18,
+	0xee, 0x04, 0xc4, 0xb7, 0x40, 0x25, 0x57, 0x2d, 0xdf, 0xa8, 0xd4, 0x2e, 0xd8, 0xa3, 0xb6, 0x4d,
+	0x98, 0xbf, 0x69, 0x78, 0xcd, 0x4b, 0xa7, 0x43, 0xe7, 0xff, 0x01, 0x0e, 0x83, 0x86, 0x3b, 0xdd,
+	0x7f, 0x9d, 0x87, 0x54, 0x92, 0x30, 0x92, 0x03, 0xd7, 0x2b, 0x8b, 0x33, 0x3e, 0x7a, 0x06, 0x17,
+	0x7d, 0xb2, 0x8b, 0x93, 0x40, 0xb6, 0xcf, 0xcd, 0xcb, 0x56, 0x40, 0xb5, 0xd4, 0x5c, 0x39, 0x1d,
+	0x3a, 0x57, 0x8c, 0xda, 0x45, 0xac, 0x69, 0x55, 0x94, 0x12, 0xa6, 0x96, 0x69, 0xcc, 0xbc, 0x39,
+	0x74, 0x32, 0xee, 0x3d, 0x58, 0x9e, 0x4a, 0xa2, 0x45, 0x98, 0xf7, 0x09, 0xe3, 0xa1, 0x05, 0x2a,
+	0xa0, 0x3a, 0xeb, 0x19, 0x80, 0x2c, 0x58, 0x3c, 0x37, 0xda, 0x1b, 0xc3, 0x46, 0x49, 0x89, 0x7c,
+	0x3b, 0x74, 0x80, 0xfb, 0x12, 0xc0, 0x7c, 0x8b, 0x45, 0x89, 0x54, 0x6c, 0xec, 0xfb, 0x31, 0x11,
+	0x22, 0x55, 0x19, 0x43, 0x84, 0x61, 0x5e, 0x19, 0x2a, 0xac, 0xac, 0x36, 0x6c, 0xe9, 0xcc, 0x30,
+	0x41, 0x26, 0x86, 0x6d, 0x70, 0xca, 0x9a, 0x6b, 0x47, 0x43, 0x27, 0xf3, 0xf6, 0xb3, 0x53, 0xed,
+	0x51, 0xd9, 0x4f, 0x3a, 0xb5, 0x2e, 0x0f, 0xd3, 0xaf, 0x95, 0xfe, 0xac, 0x0a, 0x7f, 0xaf, 0x2e,
+	0x07, 0x11, 0x11, 0xba, 0x41, 0x78, 0x46, 0xb9, 0x51, 0x7a, 0x6e, 0x16, 0xca, 0xb8, 0xaf, 0x00,
+	0x2c, 0x3c, 0x4c, 0xe4, 0x5f, 0xb4, 0xd1, 0x7b, 0x00, 0x0b, 0xdb, 0x49, 0x14, 0x05, 0x03, 0x35,
+	0x57, 0x72, 0x89, 0x03, 0x0b, 0xfc, 0x81, 0xb9, 0x5a, 0xb9, 0xf1, 0x20, 0x9d, 0x0b, 0x3e, 0xbc,
+	0x5b, 0xbd, 0x7d, 0xed, 0xa7, 0xdd, 0x07, 0xe6, 0x69, 0x85, 0xb4, 0x17, 0x63, 0x49, 0x39, 0x13,
+	0xf5, 0xfd, 0xb5, 0x5b, 0x6b, 0x35, 0xb3, 0x6b, 0xcb, 0x02, 0xee, 0x13, 0x38, 0x7b, 0x57, 0x5d,
+	0xc2, 0x0e, 0xa3, 0xf2, 0x07, 0x37, 0xb2, 0x0c, 0x4b, 0xe4, 0x20, 0xe2, 0x8c, 0x30, 0xa9, 0x8f,
+	0x64, 0xde, 0x9b, 0x60, 0xed, 0x7f, 0x40, 0xb1, 0x20, 0xc2, 0xca, 0x55, 0x72, 0xda, 0x7f, 0x03,
+	0xdd, 0x17, 0x59, 0x58, 0xda, 0x22, 0x12, 0xfb, 0x58, 0x62, 0x54, 0x81, 0x65, 0x9f, 0x88, 0x6e,
+	0x4c, 0x23, 0xb5, 0x44, 0x2a, 0x3f, 0x9d, 0x42, 0x77, 0x14, 0x83, 0xf1, 0xb0, 0x9d, 0x30, 0x2a,
+	0xc7, 0x1f, 0xcd, 0xbe, 0xf0, 0xdd, 0x4d, 0xf6, 0xf5, 0xa0, 0x3f, 0x0e, 0x05, 0x42, 0x70, 0x46,
+	0x59, 0x6c, 0xe5, 0xb4, 0xb6, 0x8e, 0xd5, 0x76, 0x3e, 0x15, 0x51, 0x80, 0x07, 0xd6, 0x8c, 0xb9,
+	0x8e, 0x14, 0x2a, 0x36, 0xc3, 0x21, 0xb1, 0xf2, 0x86, 0xad, 0x62, 0xf4, 0x1f, 0x2c, 0x88, 0x41,
+	0xd8, 0xe1, 0x81, 0x55, 0xd0, 0xd9, 0x14, 0xa1, 0x25, 0x98, 0x4b, 0x62, 0x6a, 0x15, 0x55, 0xb2,
+	0x59, 0x1c, 0x0d, 0x9d, 0xdc, 0x8e, 0xd7, 0xf2, 0x54, 0x0e, 0x5d, 0x85, 0xa5, 0x24, 0xa6, 0xed,
+	0x3e, 0x16, 0x7d, 0xab, 0xa4, 0xeb, 0xe5, 0xd1, 0xd0, 0x29, 0xee, 0x78, 0xad, 0xfb, 0x58, 0xf4,
+	0xbd, 0x62, 0x12, 0x53, 0x15, 0xb8, 0x5f, 0x01, 0x5c, 0xd8, 0xdc, 0x27, 0x4c, 0x6e, 0x71, 0x3f,
+	0x09, 0xc8, 0xe3, 0x18, 0x33, 0xb1, 0x4b, 0x62, 0x74, 0x19, 0xce, 0xab, 0x87, 0x4d, 0xe2, 0x76,
+	0xa8, 0x0b, 0xa9, 0x33, 0x73, 0x26, 0x69, 0xc8, 0x68, 0x05, 0xfe, 0x1b, 0x93, 0x2e, 0x8d, 0x28,
+	0x61, 0x72, 0xcc, 0xcb, 0x6a, 0xde, 0x3f, 0x93, 0x7c, 0x4a, 0xed, 0xc2, 0x02, 0x0e, 0x79, 0xc2,
+	0xa4, 0x95, 0xfb, 0xfd, 0xd7, 0x97, 0x4a, 0x2b, 0x57, 0xa3, 0x24, 0x8e, 0xb8, 0x20, 0x63, 0x57,
+	0x53, 0xd8, 0xdc, 0x38, 0x1a, 0xd9, 0xe0, 0x78, 0x64, 0x83, 0x2f, 0x23, 0x1b, 0xbc, 0x3e, 0xb1,
+	0x33, 0xc7, 0x27, 0x76, 0xe6, 0xd3, 0x89, 0x9d, 0x79, 0xba, 0xf2, 0x2b, 0x57, 0xaa, 0x87, 0x75,
+	0x0a, 0xfa, 0x4f, 0xf9, 0xe6, 0xf7, 0x01, 0x00, 0x73, 0x49, 0x64, 0x07, 0x1c, 0x06, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -887,6 +964,64 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventModuleTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventModuleTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventModuleTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Purpose) > 0 {
+		i -= len(m.Purpose)
+		copy(dAtA[i:], m.Purpose)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Purpose)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RecipientModule) > 0 {
+		i -= len(m.RecipientModule)
+		copy(dAtA[i:], m.RecipientModule)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.RecipientModule)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderModule) > 0 {
+		i -= len(m.SenderModule)
+		copy(dAtA[i:], m.SenderModule)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.SenderModule)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -1050,6 +1185,33 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *EventModuleTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderModule)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.RecipientModule)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	l = len(m.Purpose)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2019,6 +2181,186 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventModuleTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventModuleTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventModuleTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderModule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientModule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purpose = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
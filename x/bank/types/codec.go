@@ -14,12 +14,16 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSend{}, "cosmos-sdk/MsgSend", nil)
 	cdc.RegisterConcrete(&MsgMultiSend{}, "cosmos-sdk/MsgMultiSend", nil)
+	cdc.RegisterConcrete(&MsgBurn{}, "cosmos-sdk/MsgBurn", nil)
+	cdc.RegisterConcrete(&MsgBurnModuleCoins{}, "cosmos-sdk/MsgBurnModuleCoins", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSend{},
 		&MsgMultiSend{},
+		&MsgBurn{},
+		&MsgBurnModuleCoins{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Purposes of the transfers between module accounts recorded in
+// EventModuleTransfer. Modules may use their own purposes for the flows not
+// covered here.
+const (
+	// ModuleTransferPurposeFeeDistribution is the purpose of the transfer of
+	// the collected fees to the module distributing them.
+	ModuleTransferPurposeFeeDistribution = "fee_distribution"
+	// ModuleTransferPurposeDepositRefund is the purpose of the refund of a
+	// deposit made by a module account.
+	ModuleTransferPurposeDepositRefund = "deposit_refund"
+	// ModuleTransferPurposeBurnStaging is the purpose of the transfer of coins
+	// to a module account which burns them.
+	ModuleTransferPurposeBurnStaging = "burn_staging"
+)
+
+// ValidateModuleTransferPurpose returns an error if the purpose of a transfer
+// between module accounts is blank.
+func ValidateModuleTransferPurpose(purpose string) error {
+	if strings.TrimSpace(purpose) == "" {
+		return sdkerrors.Wrap(ErrInvalidModuleTransferPurpose, "purpose cannot be blank")
+	}
+
+	return nil
+}
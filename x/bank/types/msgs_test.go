@@ -252,3 +252,66 @@ func TestMsgSendGetSigners(t *testing.T) {
 	require.Equal(t, 1, len(res))
 	require.True(t, from.Equals(res[0]))
 }
+
+func TestMsgBurnValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from________________"))
+	addrEmpty := sdk.AccAddress([]byte(""))
+
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	atom0 := sdk.NewCoins(sdk.NewInt64Coin("atom", 0))
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *MsgBurn
+	}{
+		{"", NewMsgBurn(addr1, atom123)},
+		{": invalid coins", NewMsgBurn(addr1, atom0)},
+		{"invalid from address: empty address string is not allowed: invalid address", NewMsgBurn(addrEmpty, atom123)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+
+	msg := NewMsgBurn(addr1, atom123)
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, "burn", msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+}
+
+func TestMsgBurnModuleCoinsValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________"))
+	addrEmpty := sdk.AccAddress([]byte(""))
+
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	atom0 := sdk.NewCoins(sdk.NewInt64Coin("atom", 0))
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *MsgBurnModuleCoins
+	}{
+		{"", NewMsgBurnModuleCoins(authority, "distribution", atom123)},
+		{": invalid coins", NewMsgBurnModuleCoins(authority, "distribution", atom0)},
+		{"module name cannot be blank: invalid request", NewMsgBurnModuleCoins(authority, " ", atom123)},
+		{"invalid authority address: empty address string is not allowed: invalid address", NewMsgBurnModuleCoins(addrEmpty, "distribution", atom123)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+
+	msg := NewMsgBurnModuleCoins(authority, "distribution", atom123)
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, "burn_module_coins", msg.Type())
+	require.Equal(t, []sdk.AccAddress{authority}, msg.GetSigners())
+}
@@ -221,6 +221,8 @@ func (m *QueryAllBalancesResponse) GetPagination() *query.PageResponse {
 type QueryTotalSupplyRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// denom_pattern is an optional regular expression the denoms must match.
+	DenomPattern string `protobuf:"bytes,2,opt,name=denom_pattern,json=denomPattern,proto3" json:"denom_pattern,omitempty"`
 }
 
 func (m *QueryTotalSupplyRequest) Reset()         { *m = QueryTotalSupplyRequest{} }
@@ -312,6 +314,120 @@ func (m *QueryTotalSupplyResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryTotalSupplyStreamRequest is the request type for the
+// Query/TotalSupplyStream RPC method.
+type QueryTotalSupplyStreamRequest struct {
+	// pagination defines an optional pagination for the first page streamed,
+	// its limit is the page size of all the pages streamed.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// denom_pattern is an optional regular expression the denoms must match.
+	DenomPattern string `protobuf:"bytes,2,opt,name=denom_pattern,json=denomPattern,proto3" json:"denom_pattern,omitempty"`
+}
+
+func (m *QueryTotalSupplyStreamRequest) Reset()         { *m = QueryTotalSupplyStreamRequest{} }
+func (m *QueryTotalSupplyStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalSupplyStreamRequest) ProtoMessage()    {}
+func (*QueryTotalSupplyStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{6}
+}
+func (m *QueryTotalSupplyStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalSupplyStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalSupplyStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalSupplyStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalSupplyStreamRequest.Merge(m, src)
+}
+func (m *QueryTotalSupplyStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalSupplyStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalSupplyStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalSupplyStreamRequest proto.InternalMessageInfo
+
+func (m *QueryTotalSupplyStreamRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryTotalSupplyStreamRequest) GetDenomPattern() string {
+	if m != nil {
+		return m.DenomPattern
+	}
+	return ""
+}
+
+// QueryTotalSupplyStreamResponse is the response type for the
+// Query/TotalSupplyStream RPC method, one per page streamed.
+type QueryTotalSupplyStreamResponse struct {
+	// supply is the supply of the coins in the page
+	Supply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=supply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"supply"`
+	// pagination defines the pagination of the page, its next_key resumes the
+	// stream after the page.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTotalSupplyStreamResponse) Reset()         { *m = QueryTotalSupplyStreamResponse{} }
+func (m *QueryTotalSupplyStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalSupplyStreamResponse) ProtoMessage()    {}
+func (*QueryTotalSupplyStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{7}
+}
+func (m *QueryTotalSupplyStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalSupplyStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalSupplyStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalSupplyStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalSupplyStreamResponse.Merge(m, src)
+}
+func (m *QueryTotalSupplyStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalSupplyStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalSupplyStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalSupplyStreamResponse proto.InternalMessageInfo
+
+func (m *QueryTotalSupplyStreamResponse) GetSupply() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Supply
+	}
+	return nil
+}
+
+func (m *QueryTotalSupplyStreamResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QuerySupplyOfRequest is the request type for the Query/SupplyOf RPC method.
 type QuerySupplyOfRequest struct {
 	// denom is the coin denom to query balances for.
@@ -322,7 +438,7 @@ func (m *QuerySupplyOfRequest) Reset()         { *m = QuerySupplyOfRequest{} }
 func (m *QuerySupplyOfRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySupplyOfRequest) ProtoMessage()    {}
 func (*QuerySupplyOfRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{6}
+	return fileDescriptor_9c6fc1939682df13, []int{8}
 }
 func (m *QuerySupplyOfRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -368,7 +484,7 @@ func (m *QuerySupplyOfResponse) Reset()         { *m = QuerySupplyOfResponse{} }
 func (m *QuerySupplyOfResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySupplyOfResponse) ProtoMessage()    {}
 func (*QuerySupplyOfResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{7}
+	return fileDescriptor_9c6fc1939682df13, []int{9}
 }
 func (m *QuerySupplyOfResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -412,7 +528,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{8}
+	return fileDescriptor_9c6fc1939682df13, []int{10}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -450,7 +566,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{9}
+	return fileDescriptor_9c6fc1939682df13, []int{11}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -496,7 +612,7 @@ func (m *QueryDenomsMetadataRequest) Reset()         { *m = QueryDenomsMetadataR
 func (m *QueryDenomsMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomsMetadataRequest) ProtoMessage()    {}
 func (*QueryDenomsMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{10}
+	return fileDescriptor_9c6fc1939682df13, []int{12}
 }
 func (m *QueryDenomsMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -545,7 +661,7 @@ func (m *QueryDenomsMetadataResponse) Reset()         { *m = QueryDenomsMetadata
 func (m *QueryDenomsMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomsMetadataResponse) ProtoMessage()    {}
 func (*QueryDenomsMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{11}
+	return fileDescriptor_9c6fc1939682df13, []int{13}
 }
 func (m *QueryDenomsMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -598,7 +714,7 @@ func (m *QueryDenomMetadataRequest) Reset()         { *m = QueryDenomMetadataReq
 func (m *QueryDenomMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomMetadataRequest) ProtoMessage()    {}
 func (*QueryDenomMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{12}
+	return fileDescriptor_9c6fc1939682df13, []int{14}
 }
 func (m *QueryDenomMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,7 +761,7 @@ func (m *QueryDenomMetadataResponse) Reset()         { *m = QueryDenomMetadataRe
 func (m *QueryDenomMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomMetadataResponse) ProtoMessage()    {}
 func (*QueryDenomMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{13}
+	return fileDescriptor_9c6fc1939682df13, []int{15}
 }
 func (m *QueryDenomMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,7 +811,7 @@ func (m *QueryDenomOwnersRequest) Reset()         { *m = QueryDenomOwnersRequest
 func (m *QueryDenomOwnersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomOwnersRequest) ProtoMessage()    {}
 func (*QueryDenomOwnersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{14}
+	return fileDescriptor_9c6fc1939682df13, []int{16}
 }
 func (m *QueryDenomOwnersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -752,7 +868,7 @@ func (m *DenomOwner) Reset()         { *m = DenomOwner{} }
 func (m *DenomOwner) String() string { return proto.CompactTextString(m) }
 func (*DenomOwner) ProtoMessage()    {}
 func (*DenomOwner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{15}
+	return fileDescriptor_9c6fc1939682df13, []int{17}
 }
 func (m *DenomOwner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -806,7 +922,7 @@ func (m *QueryDenomOwnersResponse) Reset()         { *m = QueryDenomOwnersRespon
 func (m *QueryDenomOwnersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomOwnersResponse) ProtoMessage()    {}
 func (*QueryDenomOwnersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{16}
+	return fileDescriptor_9c6fc1939682df13, []int{18}
 }
 func (m *QueryDenomOwnersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,6 +972,8 @@ func init() {
 	proto.RegisterType((*QueryAllBalancesResponse)(nil), "cosmos.bank.v1beta1.QueryAllBalancesResponse")
 	proto.RegisterType((*QueryTotalSupplyRequest)(nil), "cosmos.bank.v1beta1.QueryTotalSupplyRequest")
 	proto.RegisterType((*QueryTotalSupplyResponse)(nil), "cosmos.bank.v1beta1.QueryTotalSupplyResponse")
+	proto.RegisterType((*QueryTotalSupplyStreamRequest)(nil), "cosmos.bank.v1beta1.QueryTotalSupplyStreamRequest")
+	proto.RegisterType((*QueryTotalSupplyStreamResponse)(nil), "cosmos.bank.v1beta1.QueryTotalSupplyStreamResponse")
 	proto.RegisterType((*QuerySupplyOfRequest)(nil), "cosmos.bank.v1beta1.QuerySupplyOfRequest")
 	proto.RegisterType((*QuerySupplyOfResponse)(nil), "cosmos.bank.v1beta1.QuerySupplyOfResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.bank.v1beta1.QueryParamsRequest")
@@ -872,65 +990,69 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/query.proto", fileDescriptor_9c6fc1939682df13) }
 
 var fileDescriptor_9c6fc1939682df13 = []byte{
-	// 917 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x4f, 0x3b, 0x45,
-	0x18, 0xee, 0xa0, 0x94, 0xf2, 0x56, 0x3d, 0x0c, 0x35, 0x96, 0x45, 0x5a, 0xb3, 0x28, 0xb4, 0x50,
-	0x76, 0x29, 0x98, 0x10, 0xbc, 0x18, 0x8a, 0xd1, 0x83, 0x31, 0xd4, 0xea, 0xc9, 0xc4, 0x90, 0x69,
-	0xbb, 0xae, 0x0d, 0xed, 0xce, 0xd2, 0xd9, 0x8a, 0x84, 0x90, 0x18, 0x13, 0x13, 0x4f, 0x6a, 0xe2,
-	0xc1, 0x83, 0x31, 0xc1, 0x8b, 0x46, 0x3f, 0x09, 0x07, 0x0f, 0x44, 0x2f, 0x9e, 0xd4, 0x80, 0x07,
-	0x3f, 0x86, 0xe9, 0xfc, 0xd9, 0xee, 0xb6, 0xdb, 0xed, 0x1e, 0xfa, 0x3b, 0xd1, 0x9d, 0x7d, 0xff,
-	0x3c, 0xcf, 0x33, 0x33, 0xef, 0xb3, 0x40, 0xb1, 0x45, 0x59, 0x8f, 0x32, 0xb3, 0x49, 0x9c, 0x73,
-	0xf3, 0x93, 0x6a, 0xd3, 0xf2, 0x48, 0xd5, 0xbc, 0x18, 0x58, 0xfd, 0x2b, 0xc3, 0xed, 0x53, 0x8f,
-	0xe2, 0x15, 0x11, 0x60, 0x0c, 0x03, 0x0c, 0x19, 0xa0, 0x6d, 0xfb, 0x59, 0xcc, 0x12, 0xd1, 0x7e,
-	0xae, 0x4b, 0xec, 0x8e, 0x43, 0xbc, 0x0e, 0x75, 0x44, 0x01, 0x2d, 0x67, 0x53, 0x9b, 0xf2, 0x9f,
-	0xe6, 0xf0, 0x97, 0x5c, 0x7d, 0xd1, 0xa6, 0xd4, 0xee, 0x5a, 0x26, 0x71, 0x3b, 0x26, 0x71, 0x1c,
-	0xea, 0xf1, 0x14, 0x26, 0xdf, 0x16, 0x82, 0xf5, 0x55, 0xe5, 0x16, 0xed, 0x38, 0x13, 0xef, 0x03,
-	0xa8, 0x39, 0x42, 0xfe, 0x5e, 0x3f, 0x85, 0x95, 0x77, 0x87, 0xa8, 0x6a, 0xa4, 0x4b, 0x9c, 0x96,
-	0xd5, 0xb0, 0x2e, 0x06, 0x16, 0xf3, 0x70, 0x1e, 0x96, 0x48, 0xbb, 0xdd, 0xb7, 0x18, 0xcb, 0xa3,
-	0x97, 0x50, 0x69, 0xb9, 0xa1, 0x1e, 0x71, 0x0e, 0x16, 0xdb, 0x96, 0x43, 0x7b, 0xf9, 0x05, 0xbe,
-	0x2e, 0x1e, 0x5e, 0xcb, 0x7c, 0x79, 0x5b, 0x4c, 0xfd, 0x77, 0x5b, 0x4c, 0xe9, 0x6f, 0x43, 0x2e,
-	0x5c, 0x90, 0xb9, 0xd4, 0x61, 0x16, 0x3e, 0x80, 0xa5, 0xa6, 0x58, 0xe2, 0x15, 0xb3, 0xfb, 0xab,
-	0x86, 0xaf, 0x17, 0xb3, 0x94, 0x5e, 0xc6, 0x09, 0xed, 0x38, 0x0d, 0x15, 0xa9, 0x7f, 0x81, 0xe0,
-	0x05, 0x5e, 0xed, 0xb8, 0xdb, 0x95, 0x05, 0xd9, 0x6c, 0x88, 0x6f, 0x02, 0x8c, 0xb4, 0xe5, 0x38,
-	0xb3, 0xfb, 0x9b, 0xa1, 0x6e, 0x62, 0xdb, 0x54, 0xcf, 0x3a, 0xb1, 0x15, 0xf1, 0x46, 0x20, 0x33,
-	0x40, 0xea, 0x37, 0x04, 0xf9, 0x49, 0x1c, 0x92, 0x99, 0x0d, 0x19, 0x89, 0x77, 0x88, 0xe4, 0xa9,
-	0x58, 0x6a, 0xb5, 0xbd, 0xbb, 0xbf, 0x8a, 0xa9, 0x5f, 0xff, 0x2e, 0x96, 0xec, 0x8e, 0xf7, 0xf1,
-	0xa0, 0x69, 0xb4, 0x68, 0xcf, 0x94, 0x5b, 0x24, 0xfe, 0xec, 0xb2, 0xf6, 0xb9, 0xe9, 0x5d, 0xb9,
-	0x16, 0xe3, 0x09, 0xac, 0xe1, 0x17, 0xc7, 0x6f, 0x45, 0xf0, 0xda, 0x9a, 0xc9, 0x4b, 0xa0, 0x0c,
-	0x12, 0xd3, 0xcf, 0xa5, 0xaa, 0xef, 0x53, 0x8f, 0x74, 0xdf, 0x1b, 0xb8, 0x6e, 0xf7, 0x4a, 0xa9,
-	0x1a, 0xd6, 0x0e, 0xcd, 0x41, 0xbb, 0x3b, 0xa5, 0x5d, 0xa8, 0x9b, 0xd4, 0xae, 0x05, 0x69, 0xc6,
-	0x57, 0x9e, 0x84, 0x72, 0xb2, 0xf4, 0xfc, 0x74, 0xab, 0xc8, 0xb3, 0x2d, 0x48, 0x9c, 0x7e, 0xa4,
-	0x44, 0xf3, 0xef, 0x04, 0x0a, 0xdc, 0x09, 0xbd, 0x0e, 0xcf, 0x8f, 0x45, 0x4b, 0xd2, 0x87, 0x90,
-	0x26, 0x3d, 0x3a, 0x70, 0xbc, 0x99, 0x37, 0xa1, 0xf6, 0xf4, 0x90, 0x74, 0x43, 0x86, 0xeb, 0x39,
-	0xc0, 0xbc, 0x62, 0x9d, 0xf4, 0x49, 0x4f, 0x5d, 0x04, 0xbd, 0x2e, 0xaf, 0xb0, 0x5a, 0x95, 0x5d,
-	0x8e, 0x20, 0xed, 0xf2, 0x15, 0xd9, 0x65, 0xcd, 0x88, 0x98, 0x4f, 0x86, 0x48, 0x52, 0x7d, 0x44,
-	0x82, 0xde, 0x06, 0x8d, 0x57, 0x7c, 0x63, 0xc8, 0x83, 0xbd, 0x63, 0x79, 0xa4, 0x4d, 0x3c, 0x32,
-	0xe7, 0x23, 0xa2, 0xff, 0x82, 0x60, 0x2d, 0xb2, 0x8d, 0x24, 0x70, 0x0c, 0xcb, 0x3d, 0xb9, 0xa6,
-	0x2e, 0xd6, 0x7a, 0x24, 0x07, 0x95, 0x29, 0x59, 0x8c, 0xb2, 0xe6, 0xb7, 0xf3, 0x55, 0x58, 0x1d,
-	0x41, 0x1d, 0x17, 0x24, 0x7a, 0xfb, 0x3f, 0x0c, 0x8a, 0x38, 0x41, 0xee, 0x75, 0xc8, 0x28, 0x98,
-	0x52, 0xc2, 0x44, 0xdc, 0xfc, 0x24, 0xfd, 0x52, 0xde, 0x61, 0x5e, 0xfe, 0xf4, 0xd2, 0xb1, 0xfa,
-	0x2c, 0x16, 0xcf, 0xbc, 0xa6, 0xa2, 0x4e, 0x00, 0x46, 0x3d, 0x63, 0xa6, 0xf0, 0xd1, 0x68, 0xe0,
-	0x2f, 0x24, 0x3b, 0xe6, 0xfe, 0xd8, 0xff, 0x59, 0x8d, 0x8c, 0x10, 0x39, 0xa9, 0x5c, 0x0d, 0x9e,
-	0xe1, 0x84, 0xce, 0x28, 0x5f, 0x97, 0x27, 0xa3, 0x18, 0xa9, 0xde, 0x28, 0xbf, 0x91, 0x6d, 0x8f,
-	0x6a, 0xcd, 0xed, 0x5c, 0xec, 0xff, 0xbe, 0x0c, 0x8b, 0x1c, 0x29, 0xfe, 0x0e, 0xc1, 0x92, 0xb4,
-	0x06, 0x5c, 0x8a, 0x04, 0x13, 0xe1, 0xb3, 0x5a, 0x39, 0x41, 0xa4, 0x68, 0xab, 0x1f, 0x7e, 0xfe,
-	0xc7, 0xbf, 0xdf, 0x2e, 0x54, 0xb1, 0x69, 0x46, 0x5b, 0xba, 0x30, 0x09, 0xf3, 0x5a, 0xea, 0x7f,
-	0x63, 0x5e, 0x73, 0xc6, 0x37, 0xf8, 0x7b, 0x04, 0xd9, 0x80, 0x6f, 0xe1, 0xca, 0xf4, 0x9e, 0x93,
-	0x36, 0xab, 0xed, 0x26, 0x8c, 0x96, 0x28, 0x4d, 0x8e, 0xb2, 0x8c, 0xb7, 0x12, 0xa2, 0xc4, 0x5f,
-	0x23, 0xc8, 0x06, 0x9c, 0x21, 0x0e, 0xdd, 0xa4, 0x5d, 0xc5, 0xa1, 0x8b, 0xb0, 0x1b, 0x7d, 0x83,
-	0xa3, 0x5b, 0xc7, 0x6b, 0x91, 0xe8, 0xa4, 0x5d, 0x7c, 0x85, 0x20, 0xa3, 0x66, 0x36, 0x8e, 0xd9,
-	0xa0, 0x31, 0x17, 0xd0, 0xb6, 0x93, 0x84, 0x4a, 0x20, 0x3b, 0x1c, 0xc8, 0x2b, 0x78, 0x23, 0x06,
-	0x88, 0xbf, 0x81, 0x9f, 0x21, 0x48, 0x8b, 0x39, 0x8d, 0xb7, 0xa6, 0xf7, 0x08, 0x99, 0x82, 0x56,
-	0x9a, 0x1d, 0x98, 0x48, 0x13, 0xe1, 0x08, 0xf8, 0x27, 0x04, 0xcf, 0x86, 0x06, 0x19, 0x36, 0xa6,
-	0x37, 0x88, 0x1a, 0x92, 0x9a, 0x99, 0x38, 0x5e, 0xe2, 0x7a, 0x95, 0xe3, 0x32, 0x70, 0x25, 0x12,
-	0x17, 0x97, 0x86, 0x9d, 0xa9, 0x71, 0xe8, 0x6b, 0xf5, 0x23, 0x82, 0xe7, 0xc2, 0x7e, 0x82, 0x67,
-	0x75, 0x1e, 0x37, 0x38, 0x6d, 0x2f, 0x79, 0x82, 0xc4, 0x5a, 0xe1, 0x58, 0x37, 0xf1, 0xcb, 0x49,
-	0xb0, 0xe2, 0x1f, 0x10, 0x64, 0x03, 0x93, 0x2d, 0xee, 0xc8, 0x4f, 0x4e, 0xf7, 0xb8, 0x23, 0x1f,
-	0x31, 0x2e, 0xf5, 0x2a, 0x87, 0xb6, 0x83, 0xcb, 0xd3, 0xa1, 0xc9, 0x49, 0xaa, 0x34, 0xac, 0x9d,
-	0xdc, 0x3d, 0x14, 0xd0, 0xfd, 0x43, 0x01, 0xfd, 0xf3, 0x50, 0x40, 0xdf, 0x3c, 0x16, 0x52, 0xf7,
-	0x8f, 0x85, 0xd4, 0x9f, 0x8f, 0x85, 0xd4, 0x07, 0xe5, 0xd8, 0x6f, 0xaf, 0x4f, 0x45, 0x6d, 0xfe,
-	0x09, 0xd6, 0x4c, 0xf3, 0xff, 0x2f, 0x0e, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x75, 0x2d, 0x5e,
-	0xd9, 0x37, 0x0d, 0x00, 0x00,
+	// 989 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x8b, 0x1b, 0x55,
+	0x1c, 0xcf, 0x5b, 0x6d, 0x36, 0xfd, 0xc6, 0x0a, 0xbe, 0x46, 0x4c, 0x67, 0xdd, 0x89, 0xcc, 0x6a,
+	0x37, 0x69, 0xd3, 0x99, 0x4d, 0x56, 0x28, 0xf5, 0x22, 0x4d, 0x45, 0x0f, 0x22, 0x1b, 0x53, 0x4f,
+	0x82, 0x2c, 0x2f, 0xc9, 0x73, 0x0c, 0x4d, 0xe6, 0x4d, 0xf3, 0x26, 0xd6, 0xa5, 0x54, 0x44, 0x10,
+	0x3c, 0x88, 0x16, 0x3c, 0x78, 0x10, 0xa1, 0x5e, 0x14, 0xfd, 0x4b, 0xf6, 0x20, 0x52, 0xf4, 0xe2,
+	0x49, 0x65, 0xd7, 0x83, 0x7f, 0x86, 0xe4, 0xfd, 0x98, 0xcc, 0x24, 0x93, 0xc9, 0x28, 0x11, 0xf1,
+	0xb4, 0xc9, 0x77, 0xbe, 0x3f, 0x3e, 0x9f, 0xcf, 0xbc, 0x7c, 0x3f, 0x6f, 0xa1, 0xd2, 0x63, 0x7c,
+	0xc4, 0xb8, 0xd3, 0x25, 0xde, 0x2d, 0xe7, 0xdd, 0x46, 0x97, 0x06, 0xa4, 0xe1, 0xdc, 0x9e, 0xd0,
+	0xf1, 0x91, 0xed, 0x8f, 0x59, 0xc0, 0xf0, 0x79, 0x99, 0x60, 0x4f, 0x13, 0x6c, 0x95, 0x60, 0x5c,
+	0x0a, 0xab, 0x38, 0x95, 0xd9, 0x61, 0xad, 0x4f, 0xdc, 0x81, 0x47, 0x82, 0x01, 0xf3, 0x64, 0x03,
+	0xa3, 0xe4, 0x32, 0x97, 0x89, 0x8f, 0xce, 0xf4, 0x93, 0x8a, 0x3e, 0xed, 0x32, 0xe6, 0x0e, 0xa9,
+	0x43, 0xfc, 0x81, 0x43, 0x3c, 0x8f, 0x05, 0xa2, 0x84, 0xab, 0xa7, 0x66, 0xb4, 0xbf, 0xee, 0xdc,
+	0x63, 0x03, 0x6f, 0xe1, 0x79, 0x04, 0xb5, 0x40, 0x28, 0x9e, 0x5b, 0x07, 0x70, 0xfe, 0xf5, 0x29,
+	0xaa, 0x16, 0x19, 0x12, 0xaf, 0x47, 0x3b, 0xf4, 0xf6, 0x84, 0xf2, 0x00, 0x97, 0x61, 0x93, 0xf4,
+	0xfb, 0x63, 0xca, 0x79, 0x19, 0x3d, 0x83, 0xaa, 0x67, 0x3b, 0xfa, 0x2b, 0x2e, 0xc1, 0x99, 0x3e,
+	0xf5, 0xd8, 0xa8, 0xbc, 0x21, 0xe2, 0xf2, 0xcb, 0x0b, 0x85, 0x8f, 0x1f, 0x54, 0x72, 0x7f, 0x3e,
+	0xa8, 0xe4, 0xac, 0x57, 0xa1, 0x14, 0x6f, 0xc8, 0x7d, 0xe6, 0x71, 0x8a, 0xf7, 0x61, 0xb3, 0x2b,
+	0x43, 0xa2, 0x63, 0xb1, 0x79, 0xc1, 0x0e, 0xf5, 0xe2, 0x54, 0xeb, 0x65, 0xdf, 0x60, 0x03, 0xaf,
+	0xa3, 0x33, 0xad, 0x8f, 0x10, 0x3c, 0x25, 0xba, 0x5d, 0x1f, 0x0e, 0x55, 0x43, 0xbe, 0x1a, 0xe2,
+	0xcb, 0x00, 0x33, 0x6d, 0x05, 0xce, 0x62, 0xf3, 0x62, 0x6c, 0x9a, 0x7c, 0x6d, 0x7a, 0x66, 0x9b,
+	0xb8, 0x9a, 0x78, 0x27, 0x52, 0x19, 0x21, 0xf5, 0x03, 0x82, 0xf2, 0x22, 0x0e, 0xc5, 0xcc, 0x85,
+	0x82, 0xc2, 0x3b, 0x45, 0xf2, 0x48, 0x2a, 0xb5, 0xd6, 0xde, 0xf1, 0xaf, 0x95, 0xdc, 0xf7, 0xbf,
+	0x55, 0xaa, 0xee, 0x20, 0x78, 0x67, 0xd2, 0xb5, 0x7b, 0x6c, 0xe4, 0xa8, 0x57, 0x24, 0xff, 0x5c,
+	0xe1, 0xfd, 0x5b, 0x4e, 0x70, 0xe4, 0x53, 0x2e, 0x0a, 0x78, 0x27, 0x6c, 0x8e, 0x5f, 0x49, 0xe0,
+	0xb5, 0xbb, 0x92, 0x97, 0x44, 0x19, 0x25, 0x66, 0xdd, 0xd7, 0xb2, 0xbe, 0xc1, 0x02, 0x32, 0xbc,
+	0x39, 0xf1, 0xfd, 0xe1, 0x91, 0x96, 0x35, 0x2e, 0x1e, 0xfa, 0xa7, 0xe2, 0xe1, 0x1d, 0x38, 0x27,
+	0x8e, 0xc6, 0xa1, 0x4f, 0x82, 0x80, 0x8e, 0x3d, 0x75, 0x5e, 0x1e, 0x13, 0xc1, 0xb6, 0x8c, 0x45,
+	0x14, 0x3e, 0xd6, 0x0a, 0xc7, 0x20, 0x29, 0x85, 0x7b, 0x90, 0xe7, 0x22, 0xf2, 0x6f, 0xe8, 0xab,
+	0x5a, 0xaf, 0x4f, 0xdd, 0x4f, 0x10, 0x6c, 0xcf, 0x53, 0xb9, 0x19, 0x8c, 0x29, 0x19, 0xfd, 0x17,
+	0x1a, 0x5b, 0x3f, 0x22, 0x30, 0x97, 0xc1, 0xf9, 0x5f, 0xea, 0x5b, 0x57, 0x1b, 0x46, 0x52, 0x39,
+	0x78, 0x5b, 0xab, 0x1a, 0x6e, 0x26, 0x14, 0xd9, 0x4c, 0x56, 0x1b, 0x9e, 0x9c, 0xcb, 0x56, 0xa4,
+	0xaf, 0x42, 0x9e, 0x8c, 0xd8, 0xc4, 0x0b, 0x56, 0xee, 0xa3, 0xd6, 0xa3, 0x53, 0xd2, 0x1d, 0x95,
+	0x6e, 0x95, 0x00, 0x8b, 0x8e, 0x6d, 0x32, 0x26, 0x23, 0xbd, 0x8e, 0xac, 0xb6, 0x5a, 0xa4, 0x3a,
+	0xaa, 0xa6, 0x5c, 0x83, 0xbc, 0x2f, 0x22, 0x6a, 0xca, 0x96, 0x9d, 0xe0, 0x12, 0xb6, 0x2c, 0xd2,
+	0x73, 0x64, 0x81, 0xd5, 0x07, 0x43, 0x74, 0x7c, 0x69, 0xca, 0x83, 0xbf, 0x46, 0x03, 0xd2, 0x27,
+	0x01, 0x59, 0xf3, 0x19, 0xb2, 0xbe, 0x43, 0xb0, 0x95, 0x38, 0x46, 0x11, 0xb8, 0x0e, 0x67, 0x47,
+	0x2a, 0xa6, 0xd7, 0xdb, 0x76, 0x22, 0x07, 0x5d, 0xa9, 0x58, 0xcc, 0xaa, 0xd6, 0xf7, 0xe6, 0x1b,
+	0x70, 0x61, 0x06, 0x75, 0x5e, 0x90, 0xe4, 0xd7, 0xff, 0x56, 0x54, 0xc4, 0x05, 0x72, 0x2f, 0x42,
+	0x41, 0xc3, 0x54, 0x12, 0x66, 0xe2, 0x16, 0x16, 0x59, 0x77, 0xd4, 0x22, 0x15, 0xed, 0x0f, 0xee,
+	0x78, 0x74, 0xcc, 0x53, 0xf1, 0xac, 0xcb, 0x9b, 0x2c, 0x02, 0x30, 0x9b, 0x99, 0xe2, 0x85, 0xd7,
+	0x66, 0xb6, 0xbb, 0x91, 0xed, 0x98, 0x87, 0xe6, 0xfb, 0xad, 0x5e, 0xc9, 0x31, 0x72, 0x4a, 0xb9,
+	0x16, 0xc8, 0x2d, 0x73, 0xc8, 0x44, 0x5c, 0x9d, 0x8c, 0x4a, 0xa2, 0x7a, 0xb3, 0xfa, 0x4e, 0xb1,
+	0x3f, 0xeb, 0xb5, 0xb6, 0x73, 0xd1, 0xfc, 0x09, 0xe0, 0x8c, 0x40, 0x8a, 0xbf, 0x40, 0xb0, 0xa9,
+	0x0c, 0x1a, 0x57, 0x13, 0xc1, 0x24, 0xdc, 0x76, 0x8c, 0x5a, 0x86, 0x4c, 0x39, 0xd6, 0xba, 0xfa,
+	0xe1, 0xcf, 0x7f, 0x7c, 0xbe, 0xd1, 0xc0, 0x8e, 0x93, 0x7c, 0xb1, 0x92, 0x56, 0xed, 0xdc, 0x55,
+	0xfa, 0xdf, 0x73, 0xee, 0x0a, 0xc6, 0xf7, 0xf0, 0x97, 0x08, 0x8a, 0x91, 0xdb, 0x03, 0xae, 0x2f,
+	0x9f, 0xb9, 0x78, 0xd9, 0x31, 0xae, 0x64, 0xcc, 0x56, 0x28, 0x1d, 0x81, 0xb2, 0x86, 0x77, 0x33,
+	0xa2, 0xc4, 0x9f, 0x21, 0x28, 0x46, 0xfc, 0x21, 0x0d, 0xdd, 0xe2, 0x9d, 0x21, 0x0d, 0x5d, 0x82,
+	0x9d, 0x5b, 0x3b, 0x02, 0xdd, 0x36, 0xde, 0x4a, 0x44, 0xa7, 0xec, 0xe2, 0x7d, 0x78, 0x62, 0xc1,
+	0xb0, 0x70, 0x33, 0xd3, 0xa0, 0x98, 0xd9, 0x1a, 0xfb, 0x7f, 0xab, 0x46, 0x42, 0xdc, 0x43, 0xf8,
+	0x53, 0x04, 0x05, 0xed, 0x19, 0x38, 0xe5, 0x80, 0xcc, 0xb9, 0x90, 0x71, 0x29, 0x4b, 0xaa, 0x12,
+	0xe2, 0xb2, 0x10, 0xe2, 0x39, 0xbc, 0x93, 0x22, 0x44, 0x78, 0x80, 0x3e, 0x40, 0x90, 0x97, 0x3e,
+	0x81, 0x77, 0x97, 0xcf, 0x88, 0x99, 0x92, 0x51, 0x5d, 0x9d, 0x98, 0xe9, 0x9d, 0x48, 0x47, 0xc2,
+	0xdf, 0x20, 0x38, 0x17, 0x5b, 0xa4, 0xd8, 0x5e, 0x3e, 0x20, 0x69, 0x49, 0x1b, 0x4e, 0xe6, 0x7c,
+	0x85, 0xeb, 0x79, 0x81, 0xcb, 0xc6, 0xf5, 0x44, 0x5c, 0x42, 0x1a, 0x7e, 0xa8, 0xd7, 0x71, 0xa8,
+	0xd5, 0xd7, 0x08, 0x1e, 0x8f, 0xfb, 0x19, 0x5e, 0x35, 0x79, 0xde, 0x60, 0x8d, 0xbd, 0xec, 0x05,
+	0x0a, 0x6b, 0x5d, 0x60, 0xbd, 0x88, 0x9f, 0xcd, 0x82, 0x15, 0x7f, 0x85, 0xa0, 0x18, 0xd9, 0xac,
+	0x69, 0x3f, 0xb9, 0x45, 0x77, 0x49, 0xfb, 0xc9, 0x25, 0xac, 0x6b, 0xab, 0x21, 0xa0, 0x5d, 0xc6,
+	0xb5, 0xe5, 0xd0, 0xd4, 0x26, 0xd7, 0x1a, 0xb6, 0x6e, 0x1c, 0x9f, 0x98, 0xe8, 0xe1, 0x89, 0x89,
+	0x7e, 0x3f, 0x31, 0xd1, 0xfd, 0x53, 0x33, 0xf7, 0xf0, 0xd4, 0xcc, 0xfd, 0x72, 0x6a, 0xe6, 0xde,
+	0xac, 0xa5, 0xde, 0xfd, 0xde, 0x93, 0xbd, 0xc5, 0x15, 0xb0, 0x9b, 0x17, 0xff, 0x65, 0xee, 0xff,
+	0x15, 0x00, 0x00, 0xff, 0xff, 0x54, 0x1f, 0x12, 0x7c, 0x3d, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -951,6 +1073,10 @@ type QueryClient interface {
 	AllBalances(ctx context.Context, in *QueryAllBalancesRequest, opts ...grpc.CallOption) (*QueryAllBalancesResponse, error)
 	// TotalSupply queries the total supply of all coins.
 	TotalSupply(ctx context.Context, in *QueryTotalSupplyRequest, opts ...grpc.CallOption) (*QueryTotalSupplyResponse, error)
+	// TotalSupplyStream streams the total supply of all coins, one page per
+	// message. It is only served by the gRPC server, as it can't be routed
+	// through ABCI queries.
+	TotalSupplyStream(ctx context.Context, in *QueryTotalSupplyStreamRequest, opts ...grpc.CallOption) (Query_TotalSupplyStreamClient, error)
 	// SupplyOf queries the supply of a single coin.
 	SupplyOf(ctx context.Context, in *QuerySupplyOfRequest, opts ...grpc.CallOption) (*QuerySupplyOfResponse, error)
 	// Params queries the parameters of x/bank module.
@@ -1000,6 +1126,38 @@ func (c *queryClient) TotalSupply(ctx context.Context, in *QueryTotalSupplyReque
 	return out, nil
 }
 
+func (c *queryClient) TotalSupplyStream(ctx context.Context, in *QueryTotalSupplyStreamRequest, opts ...grpc.CallOption) (Query_TotalSupplyStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/cosmos.bank.v1beta1.Query/TotalSupplyStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryTotalSupplyStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_TotalSupplyStreamClient interface {
+	Recv() (*QueryTotalSupplyStreamResponse, error)
+	grpc.ClientStream
+}
+
+type queryTotalSupplyStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryTotalSupplyStreamClient) Recv() (*QueryTotalSupplyStreamResponse, error) {
+	m := new(QueryTotalSupplyStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *queryClient) SupplyOf(ctx context.Context, in *QuerySupplyOfRequest, opts ...grpc.CallOption) (*QuerySupplyOfResponse, error) {
 	out := new(QuerySupplyOfResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/SupplyOf", in, out, opts...)
@@ -1053,6 +1211,10 @@ type QueryServer interface {
 	AllBalances(context.Context, *QueryAllBalancesRequest) (*QueryAllBalancesResponse, error)
 	// TotalSupply queries the total supply of all coins.
 	TotalSupply(context.Context, *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error)
+	// TotalSupplyStream streams the total supply of all coins, one page per
+	// message. It is only served by the gRPC server, as it can't be routed
+	// through ABCI queries.
+	TotalSupplyStream(*QueryTotalSupplyStreamRequest, Query_TotalSupplyStreamServer) error
 	// SupplyOf queries the supply of a single coin.
 	SupplyOf(context.Context, *QuerySupplyOfRequest) (*QuerySupplyOfResponse, error)
 	// Params queries the parameters of x/bank module.
@@ -1080,6 +1242,9 @@ func (*UnimplementedQueryServer) AllBalances(ctx context.Context, req *QueryAllB
 func (*UnimplementedQueryServer) TotalSupply(ctx context.Context, req *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalSupply not implemented")
 }
+func (*UnimplementedQueryServer) TotalSupplyStream(req *QueryTotalSupplyStreamRequest, srv Query_TotalSupplyStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method TotalSupplyStream not implemented")
+}
 func (*UnimplementedQueryServer) SupplyOf(ctx context.Context, req *QuerySupplyOfRequest) (*QuerySupplyOfResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SupplyOf not implemented")
 }
@@ -1154,6 +1319,27 @@ func _Query_TotalSupply_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TotalSupplyStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryTotalSupplyStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).TotalSupplyStream(m, &queryTotalSupplyStreamServer{stream})
+}
+
+type Query_TotalSupplyStreamServer interface {
+	Send(*QueryTotalSupplyStreamResponse) error
+	grpc.ServerStream
+}
+
+type queryTotalSupplyStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryTotalSupplyStreamServer) Send(m *QueryTotalSupplyStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Query_SupplyOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySupplyOfRequest)
 	if err := dec(in); err != nil {
@@ -1281,7 +1467,13 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Query_DenomOwners_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TotalSupplyStream",
+			Handler:       _Query_TotalSupplyStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
 }
 
@@ -1468,6 +1660,13 @@ func (m *QueryTotalSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomPattern) > 0 {
+		i -= len(m.DenomPattern)
+		copy(dAtA[i:], m.DenomPattern)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomPattern)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1532,6 +1731,97 @@ func (m *QueryTotalSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTotalSupplyStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalSupplyStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalSupplyStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomPattern) > 0 {
+		i -= len(m.DenomPattern)
+		copy(dAtA[i:], m.DenomPattern)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomPattern)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalSupplyStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalSupplyStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalSupplyStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Supply) > 0 {
+		for iNdEx := len(m.Supply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Supply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QuerySupplyOfRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2016,6 +2306,10 @@ func (m *QueryTotalSupplyRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.DenomPattern)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2038,6 +2332,42 @@ func (m *QueryTotalSupplyResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTotalSupplyStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DenomPattern)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalSupplyStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Supply) > 0 {
+		for _, e := range m.Supply {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QuerySupplyOfRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2698,6 +3028,38 @@ func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2839,6 +3201,244 @@ func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTotalSupplyStreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalSupplyStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalSupplyStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalSupplyStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalSupplyStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalSupplyStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supply = append(m.Supply, types.Coin{})
+			if err := m.Supply[len(m.Supply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QuerySupplyOfRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendRestrictionFn is consulted before coins are sent between accounts, and
+// aborts the send if it returns an error. The recipient is empty for the
+// inputs of a multi-send, as the inputs aren't matched with the outputs.
+//
+// The restrictions are not consulted when coins are minted, burnt, delegated
+// or undelegated.
+type SendRestrictionFn func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+
+// Then returns a SendRestrictionFn consulting this restriction and then the
+// second one, if this one doesn't return an error. A nil restriction is
+// skipped.
+func (r SendRestrictionFn) Then(second SendRestrictionFn) SendRestrictionFn {
+	if r == nil {
+		return second
+	}
+	if second == nil {
+		return r
+	}
+
+	return func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+		if err := r(ctx, fromAddr, toAddr, amt); err != nil {
+			return err
+		}
+
+		return second(ctx, fromAddr, toAddr, amt)
+	}
+}
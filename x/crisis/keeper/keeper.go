@@ -20,6 +20,10 @@ type Keeper struct {
 	supplyKeeper types.SupplyKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	// haltHandler, if set, is called instead of panicking when an invariant
+	// asserted at the end of a block is broken
+	haltHandler func(reason string)
 }
 
 // NewKeeper creates a new Keeper object
@@ -42,6 +46,14 @@ func NewKeeper(
 	}
 }
 
+// SetHaltHandler sets the handler called with the reason of the breach when an
+// invariant asserted at the end of a block is broken, instead of panicking.
+// This allows the node to halt gracefully once the block is committed, e.g.
+// through BaseApp.RequestHalt.
+func (k *Keeper) SetHaltHandler(handler func(reason string)) {
+	k.haltHandler = handler
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -68,7 +80,8 @@ func (k Keeper) Invariants() []sdk.Invariant {
 }
 
 // AssertInvariants asserts all registered invariants. If any invariant fails,
-// the method panics.
+// the method panics, unless a halt handler is set in which case the handler is
+// called with the reason of the breach.
 func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	logger := k.Logger(ctx)
 
@@ -78,6 +91,12 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	for i, ir := range invarRoutes {
 		logger.Info("asserting crisis invariants", "inv", fmt.Sprint(i, "/", n), "name", ir.FullRoute())
 		if res, stop := ir.Invar(ctx); stop {
+			if k.haltHandler != nil {
+				logger.Error("invariant broken; requesting node halt", "name", ir.FullRoute(), "height", ctx.BlockHeight())
+				k.haltHandler(fmt.Sprintf("invariant %s broken: %s", ir.FullRoute(), res))
+				return
+			}
+
 			// TODO: Include app name as part of context to allow for this to be
 			// variable.
 			panic(fmt.Errorf("invariant broken: %s\n"+
@@ -44,3 +44,24 @@ func TestAssertInvariants(t *testing.T) {
 	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
 }
+
+func TestAssertInvariantsHaltHandler(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	ctx := app.NewContext(true, tmproto.Header{})
+
+	var reasons []string
+	app.CrisisKeeper.SetHaltHandler(func(reason string) { reasons = append(reasons, reason) })
+
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute1", func(sdk.Context) (string, bool) { return "", false })
+	require.NotPanics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
+	require.Empty(t, reasons)
+
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "broken", true })
+	require.NotPanics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
+	require.Len(t, reasons, 1)
+	require.Contains(t, reasons[0], "testModule/testRoute2")
+	require.Contains(t, reasons[0], "broken")
+}
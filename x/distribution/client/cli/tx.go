@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -20,6 +21,8 @@ import (
 var (
 	FlagCommission       = "commission"
 	FlagMaxMessagesPerTx = "max-msgs"
+	FlagStartValidator   = "start-validator"
+	FlagLimit            = "limit"
 )
 
 const (
@@ -39,8 +42,10 @@ func NewTxCmd() *cobra.Command {
 	distTxCmd.AddCommand(
 		NewWithdrawRewardsCmd(),
 		NewWithdrawAllRewardsCmd(),
+		NewWithdrawRewardsBatchCmd(),
 		NewSetWithdrawAddrCmd(),
 		NewFundCommunityPoolCmd(),
+		NewSetAutoRestakeCommissionCmd(),
 	)
 
 	return distTxCmd
@@ -183,6 +188,56 @@ $ %[1]s tx distribution withdraw-all-rewards --from mykey
 	return cmd
 }
 
+func NewWithdrawRewardsBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-rewards-batch",
+		Short: "withdraw delegations rewards for a delegator from a batch of validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw rewards for a single delegator from at most --%[2]s validators,
+starting with the validator given by --%[3]s, in a single message. The
+response of the transaction contains the validator to start the next batch with,
+if any. Unlike withdraw-all-rewards, this command can be generated offline.
+
+Example:
+$ %[1]s tx distribution withdraw-rewards-batch --from mykey --%[2]s 50
+$ %[1]s tx distribution withdraw-rewards-batch --from mykey --%[2]s 50 --%[3]s cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, FlagLimit, FlagStartValidator,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+
+			var startValAddr sdk.ValAddress
+			if start, _ := cmd.Flags().GetString(FlagStartValidator); start != "" {
+				startValAddr, err = sdk.ValAddressFromBech32(start)
+				if err != nil {
+					return err
+				}
+			}
+
+			limit, err := cmd.Flags().GetUint32(FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawAllDelegatorRewards(delAddr, startValAddr, limit)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagStartValidator, "", "Validator to start withdrawing rewards from (defaults to the first validator)")
+	cmd.Flags().Uint32(FlagLimit, types.MaxWithdrawAllDelegatorRewardsLimit, "Maximum number of validators to withdraw rewards from")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewSetWithdrawAddrCmd() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
 
@@ -257,6 +312,44 @@ $ %s tx distribution fund-community-pool 100uatom --from mykey
 	return cmd
 }
 
+func NewSetAutoRestakeCommissionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-restake-commission [true|false]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Enable or disable the automatic restake of the validator commission as self-bond",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable the automatic restake of the commission of the validator
+operated by the sender. When enabled, the commission accumulated in the bond denom
+is delegated to the validator from its operator account at the end of each
+commission restake epoch.
+
+Example:
+$ %s tx distribution set-auto-restake-commission true --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoRestakeCommission(sdk.ValAddress(clientCtx.GetFromAddress()), enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
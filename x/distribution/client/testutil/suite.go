@@ -74,14 +74,17 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.010000000000000000","bonus_proposer_reward":"0.040000000000000000","withdraw_addr_enabled":true}`,
+			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.010000000000000000","bonus_proposer_reward":"0.040000000000000000","withdraw_addr_enabled":true,"gov_absentee_reward_penalty":"0.000000000000000000","fee_burn_percentage":"0.000000000000000000","commission_restake_epoch_length":"100"}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
 			`base_proposer_reward: "0.010000000000000000"
 bonus_proposer_reward: "0.040000000000000000"
+commission_restake_epoch_length: "100"
 community_tax: "0.020000000000000000"
+fee_burn_percentage: "0.000000000000000000"
+gov_absentee_reward_penalty: "0.000000000000000000"
 withdraw_addr_enabled: true`,
 		},
 	}
@@ -3,9 +3,12 @@ package keeper
 import (
 	"fmt"
 
+	metrics "github.com/armon/go-metrics"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -25,14 +28,19 @@ func (k Keeper) AllocateTokens(
 	// (and distributed to the previous proposer)
 	feeCollector := k.authKeeper.GetModuleAccount(ctx, k.feeCollectorName)
 	feesCollectedInt := k.bankKeeper.GetAllBalances(ctx, feeCollector.GetAddress())
-	feesCollected := sdk.NewDecCoinsFromCoins(feesCollectedInt...)
 
 	// transfer collected fees to the distribution module account
-	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, feesCollectedInt)
+	err := k.bankKeeper.SendCoinsFromModuleToModuleWithPurpose(
+		ctx, k.feeCollectorName, types.ModuleName, feesCollectedInt, banktypes.ModuleTransferPurposeFeeDistribution,
+	)
 	if err != nil {
 		panic(err)
 	}
 
+	// burn a share of the collected fees, only the rest is distributed
+	feesCollectedInt = feesCollectedInt.Sub(k.burnFees(ctx, feesCollectedInt))
+	feesCollected := sdk.NewDecCoinsFromCoins(feesCollectedInt...)
+
 	// temporary workaround to keep CanWithdrawInvariant happy
 	// general discussions here: https://github.com/cosmos/cosmos-sdk/issues/2906#issuecomment-441867634
 	feePool := k.GetFeePool(ctx)
@@ -82,6 +90,7 @@ func (k Keeper) AllocateTokens(
 	// calculate fraction allocated to validators
 	communityTax := k.GetCommunityTax(ctx)
 	voteMultiplier := sdk.OneDec().Sub(proposerMultiplier).Sub(communityTax)
+	govAbsenteePenalty := k.GetGovAbsenteeRewardPenalty(ctx)
 
 	// allocate tokens proportionally to voting power
 	// TODO consider parallelizing later, ref https://github.com/cosmos/cosmos-sdk/pull/3099#discussion_r246276376
@@ -92,6 +101,13 @@ func (k Keeper) AllocateTokens(
 		// ref https://github.com/cosmos/cosmos-sdk/issues/2525#issuecomment-430838701
 		powerFraction := sdk.NewDec(vote.Validator.Power).QuoTruncate(sdk.NewDec(totalPreviousPower))
 		reward := feesCollected.MulDecTruncate(voteMultiplier).MulDecTruncate(powerFraction)
+
+		// validators absent from governance forfeit part of their reward,
+		// which stays in the remaining tokens funding the community pool
+		if govAbsenteePenalty.IsPositive() && k.stakingKeeper.IsGovAbsentee(ctx, validator.GetOperator()) {
+			reward = reward.Sub(reward.MulDecTruncate(govAbsenteePenalty))
+		}
+
 		k.AllocateTokensToValidator(ctx, validator, reward)
 		remaining = remaining.Sub(reward)
 	}
@@ -101,6 +117,47 @@ func (k Keeper) AllocateTokens(
 	k.SetFeePool(ctx, feePool)
 }
 
+// burnFees burns the fee burn percentage of the collected fees, rounded down,
+// from the distribution module account and returns the burned coins.
+func (k Keeper) burnFees(ctx sdk.Context, fees sdk.Coins) sdk.Coins {
+	burnPercentage := k.GetFeeBurnPercentage(ctx)
+	if !burnPercentage.IsPositive() {
+		return sdk.NewCoins()
+	}
+
+	burned := sdk.NewCoins()
+	for _, fee := range fees {
+		burned = burned.Add(sdk.NewCoin(fee.Denom, fee.Amount.ToDec().MulTruncate(burnPercentage).TruncateInt()))
+	}
+	if burned.IsZero() {
+		return burned
+	}
+
+	// the distribution module account needs no burner permission
+	if err := k.bankKeeper.BurnAccountCoins(ctx, k.authKeeper.GetModuleAddress(types.ModuleName), burned); err != nil {
+		panic(err)
+	}
+
+	for _, coin := range burned {
+		if coin.Amount.IsInt64() {
+			telemetry.IncrCounterWithLabels(
+				[]string{types.ModuleName, "fees", "burned"},
+				float32(coin.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", coin.Denom)},
+			)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnFees,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, burned.String()),
+		),
+	)
+
+	return burned
+}
+
 // AllocateTokensToValidator allocate tokens to a particular validator, splitting according to commission
 func (k Keeper) AllocateTokensToValidator(ctx sdk.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) {
 	// split tokens between validator and delegators according to commission
@@ -188,3 +188,99 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.True(t, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[1]).Rewards.IsValid())
 	require.True(t, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[2]).Rewards.IsValid())
 }
+
+func TestAllocateTokensToGovAbsentee(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1234))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create two validators with 0% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	tstaking.CreateValidator(valAddrs[1], valConsPk2, sdk.NewInt(100), true)
+
+	// flag the first validator as absent from governance, and withhold half
+	// of its rewards
+	stakingParams := app.StakingKeeper.GetParams(ctx)
+	stakingParams.MaxMissedGovProposals = 1
+	app.StakingKeeper.SetParams(ctx, stakingParams)
+	app.StakingKeeper.SetGovParticipation(ctx, stakingtypes.NewGovParticipation(valAddrs[0], 1))
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.GovAbsenteeRewardPenalty = sdk.NewDecWithPrec(5, 1)
+	app.DistrKeeper.SetParams(ctx, params)
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	feeCollector := app.AccountKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, feeCollector.GetName(), fees))
+
+	votes := []abci.VoteInfo{
+		{
+			Validator:       abci.Validator{Address: valConsPk1.Address(), Power: 100},
+			SignedLastBlock: true,
+		},
+		{
+			Validator:       abci.Validator{Address: valConsPk2.Address(), Power: 100},
+			SignedLastBlock: true,
+		},
+	}
+	app.DistrKeeper.AllocateTokens(ctx, 200, 200, valConsAddr2, votes)
+
+	// half of staking.proportional for the absent validator = 0.5 * (0.5 * 93%) * 100 = 23.25
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(2325, 2)}}, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[0]).Rewards)
+	// proposer reward + staking.proportional for second proposer = (5 % + 0.5 * (93%)) * 100 = 51.5
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(515, 1)}}, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[1]).Rewards)
+	// community tax + withheld rewards = 2 + 23.25
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(2525, 2)}}, app.DistrKeeper.GetFeePool(ctx).CommunityPool)
+}
+
+func TestAllocateTokensBurnFees(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1234))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create two validators with 0% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	tstaking.CreateValidator(valAddrs[1], valConsPk2, sdk.NewInt(100), true)
+
+	// burn a fifth of the collected fees
+	params := app.DistrKeeper.GetParams(ctx)
+	params.FeeBurnPercentage = sdk.NewDecWithPrec(2, 1)
+	app.DistrKeeper.SetParams(ctx, params)
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	feeCollector := app.AccountKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, feeCollector.GetName(), fees))
+	supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+
+	votes := []abci.VoteInfo{
+		{
+			Validator:       abci.Validator{Address: valConsPk1.Address(), Power: 100},
+			SignedLastBlock: true,
+		},
+		{
+			Validator:       abci.Validator{Address: valConsPk2.Address(), Power: 100},
+			SignedLastBlock: true,
+		},
+	}
+	app.DistrKeeper.AllocateTokens(ctx, 200, 200, valConsAddr2, votes)
+
+	// the burned fees are removed from the supply, the rest is distributed
+	require.Equal(t, supply.SubAmount(sdk.NewInt(20)), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.Equal(t, sdk.NewInt(80), app.BankKeeper.GetBalance(ctx, distrAcc.GetAddress(), sdk.DefaultBondDenom).Amount)
+
+	// staking.proportional for the first validator = 0.5 * 93% * 80 = 37.2
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(372, 1)}}, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[0]).Rewards)
+	// proposer reward + staking.proportional for second proposer = (5 % + 0.5 * (93%)) * 80 = 41.2
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(412, 1)}}, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[1]).Rewards)
+	// community tax = 2% * 80 = 1.6
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(16, 1)}}, app.DistrKeeper.GetFeePool(ctx).CommunityPool)
+}
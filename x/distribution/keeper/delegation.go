@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -164,20 +165,40 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val stakingtypes.Vali
 	// truncate coins, return remainder to community pool
 	coins, remainder := rewards.TruncateDecimal()
 
+	// the rewards of a locked delegation are boosted from the community pool
+	feePool := k.GetFeePool(ctx)
+	bonus := k.lockupRewardBonus(ctx, del, coins, feePool.CommunityPool)
+	coins = coins.Add(bonus...)
+
+	// the reward adjuster, if any, may tax the rewards or add a bonus to them
+	withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
+	tax, adjustmentBonus := k.adjustDelegationRewards(ctx, del, withdrawAddr, coins)
+	coins = coins.Sub(tax)
+
 	// add coins to user account
 	if !coins.IsZero() {
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
 		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
 		if err != nil {
 			return nil, err
 		}
 	}
+	coins = coins.Add(adjustmentBonus...)
+
+	if !bonus.IsZero() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLockupRewardBonus,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, bonus.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, del.GetValidatorAddr().String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, del.GetDelegatorAddr().String()),
+			),
+		)
+	}
 
 	// update the outstanding rewards and the community pool only if the
 	// transaction was successful
 	k.SetValidatorOutstandingRewards(ctx, del.GetValidatorAddr(), types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(rewards)})
-	feePool := k.GetFeePool(ctx)
-	feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
+	feePool.CommunityPool = feePool.CommunityPool.Add(remainder...).Sub(sdk.NewDecCoinsFromCoins(bonus...))
 	k.SetFeePool(ctx, feePool)
 
 	// decrement reference count of starting period
@@ -190,3 +211,116 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val stakingtypes.Vali
 
 	return coins, nil
 }
+
+// adjustDelegationRewards applies the tax and the bonus of the reward
+// adjuster, if one is set, to the rewards withdrawn from a delegation, and
+// returns them. The tax is sent to the account of the reward adjuster, and the
+// bonus is paid from it to the withdraw address. A failed adjustment is
+// reported by an event and leaves the rewards unadjusted, so that it can't
+// fail the withdrawals triggered outside of transactions, e.g. when a lockup
+// ends.
+func (k Keeper) adjustDelegationRewards(ctx sdk.Context, del stakingtypes.DelegationI, withdrawAddr sdk.AccAddress, rewards sdk.Coins) (tax, bonus sdk.Coins) {
+	if k.rewardAdjuster == nil || rewards.IsZero() {
+		return nil, nil
+	}
+
+	// adjust in a branch of the state which is only written on success, so
+	// that a failed adjustment leaves no transfer behind
+	cacheCtx, writeCache := ctx.CacheContext()
+	tax, bonus, err := k.rewardAdjustment(cacheCtx, del, rewards)
+	if err == nil {
+		err = k.applyRewardAdjustment(cacheCtx, del, withdrawAddr, tax, bonus)
+	}
+	if err != nil {
+		k.Logger(ctx).Error(
+			"failed to adjust delegation rewards",
+			"delegator", del.GetDelegatorAddr().String(),
+			"validator", del.GetValidatorAddr().String(),
+			"err", err,
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRewardAdjustment,
+				sdk.NewAttribute(types.AttributeKeyValidator, del.GetValidatorAddr().String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, del.GetDelegatorAddr().String()),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+		return nil, nil
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return tax, bonus
+}
+
+// rewardAdjustment returns the tax and the bonus the reward adjuster applies
+// to the rewards withdrawn from a delegation.
+func (k Keeper) rewardAdjustment(ctx sdk.Context, del stakingtypes.DelegationI, rewards sdk.Coins) (tax, bonus sdk.Coins, err error) {
+	tax, bonus, err = k.rewardAdjuster.AdjustDelegationRewards(ctx, del.GetDelegatorAddr(), del.GetValidatorAddr(), rewards)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !tax.IsValid() || !bonus.IsValid() {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidRewardAdjustment, "invalid tax %s or bonus %s", tax, bonus)
+	}
+	if !tax.IsAllLTE(rewards) {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidRewardAdjustment, "tax %s exceeds rewards %s", tax, rewards)
+	}
+
+	return tax, bonus, nil
+}
+
+// applyRewardAdjustment sends the tax on the rewards withdrawn from a
+// delegation from the distribution module account to the account of the reward
+// adjuster, and the bonus from the latter to the withdraw address. It fails if
+// the account of the reward adjuster can't pay the bonus.
+func (k Keeper) applyRewardAdjustment(ctx sdk.Context, del stakingtypes.DelegationI, withdrawAddr sdk.AccAddress, tax, bonus sdk.Coins) error {
+	if tax.IsZero() && bonus.IsZero() {
+		return nil
+	}
+
+	if !tax.IsZero() {
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.rewardAdjusterAccount, tax)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !bonus.IsZero() {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, k.rewardAdjusterAccount, withdrawAddr, bonus)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardAdjustment,
+			sdk.NewAttribute(types.AttributeKeyTax, tax.String()),
+			sdk.NewAttribute(types.AttributeKeyBonus, bonus.String()),
+			sdk.NewAttribute(types.AttributeKeyAccount, k.authKeeper.GetModuleAddress(k.rewardAdjusterAccount).String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, del.GetValidatorAddr().String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, del.GetDelegatorAddr().String()),
+		),
+	)
+
+	return nil
+}
+
+// lockupRewardBonus returns the bonus added to the rewards of a delegation by
+// the reward multiplier of its lockup. The bonus is paid from the community
+// pool, and is capped by its funds.
+func (k Keeper) lockupRewardBonus(ctx sdk.Context, del stakingtypes.DelegationI, rewards sdk.Coins, communityPool sdk.DecCoins) sdk.Coins {
+	multiplier := k.stakingKeeper.DelegationRewardMultiplier(ctx, del.GetDelegatorAddr(), del.GetValidatorAddr())
+	if rewards.IsZero() || multiplier.LTE(sdk.OneDec()) {
+		return nil
+	}
+
+	bonus := sdk.NewDecCoinsFromCoins(rewards...).MulDecTruncate(multiplier.Sub(sdk.OneDec())).Intersect(communityPool)
+	coins, _ := bonus.TruncateDecimal()
+
+	return coins
+}
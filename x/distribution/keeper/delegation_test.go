@@ -1,15 +1,23 @@
 package keeper_test
 
 import (
+	"bytes"
+	"errors"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -340,6 +348,316 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	)
 }
 
+func TestWithdrawLockedDelegationRewards(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	balancePower := int64(1000)
+	balanceTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, balancePower)
+	addr := simapp.AddTestAddrs(app, ctx, 1, balanceTokens)
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 50% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	valTokens := tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// lock the self delegation with a 1.5 reward multiplier
+	params := app.StakingKeeper.GetParams(ctx)
+	params.LockupTiers = []stakingtypes.LockupTier{stakingtypes.NewLockupTier(time.Hour, sdk.NewDecWithPrec(15, 1))}
+	app.StakingKeeper.SetParams(ctx, params)
+	_, err := app.StakingKeeper.LockDelegation(ctx, sdk.AccAddress(valAddrs[0]), valAddrs[0], time.Hour)
+	require.NoError(t, err)
+
+	// fund the community pool, which pays the bonus
+	pool := app.StakingKeeper.TokensFromConsensusPower(ctx, 100)
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, pool)), addr[0]))
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	// withdraw rewards, boosted by half from the community pool
+	rewards, err := app.DistrKeeper.WithdrawDelegationRewards(ctx, sdk.AccAddress(valAddrs[0]), valAddrs[0])
+	require.NoError(t, err)
+	bonus := initial.QuoRaw(4)
+	require.Equal(t, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2).Add(bonus))}, rewards)
+
+	exp := balanceTokens.Sub(valTokens).Sub(pool).Add(initial.QuoRaw(2)).Add(bonus)
+	require.Equal(t,
+		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, exp)},
+		app.BankKeeper.GetAllBalances(ctx, sdk.AccAddress(valAddrs[0])),
+	)
+	require.Equal(t,
+		sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, pool.Sub(bonus))},
+		app.DistrKeeper.GetFeePoolCommunityCoins(ctx),
+	)
+}
+
+// mockRewardAdjuster taxes the withdrawn rewards by a rate and adds a fixed
+// bonus to them, or fails with its error if set.
+type mockRewardAdjuster struct {
+	taxRate sdk.Dec
+	bonus   sdk.Coins
+	err     error
+}
+
+func (a mockRewardAdjuster) AdjustDelegationRewards(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, rewards sdk.Coins) (sdk.Coins, sdk.Coins, error) {
+	if a.err != nil {
+		return nil, nil, a.err
+	}
+
+	tax, _ := sdk.NewDecCoinsFromCoins(rewards...).MulDecTruncate(a.taxRate).TruncateDecimal()
+	return tax, a.bonus, nil
+}
+
+// rewardAdjustmentError returns the error attribute of the reward_adjustment
+// event emitted, if any.
+func rewardAdjustmentError(ctx sdk.Context) string {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeRewardAdjustment {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyError {
+				return string(attr.Value)
+			}
+		}
+	}
+
+	return ""
+}
+
+func TestWithdrawAdjustedDelegationRewards(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	balancePower := int64(1000)
+	balanceTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, balancePower)
+	addr := simapp.AddTestAddrs(app, ctx, 1, balanceTokens)
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 50% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	valTokens := tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// tax the rewards by 20% and add a bonus paid from the mint module account
+	adjusterBonus := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, minttypes.ModuleName, adjusterBonus))
+	adjuster := &mockRewardAdjuster{taxRate: sdk.NewDecWithPrec(2, 1), bonus: adjusterBonus}
+	app.DistrKeeper.SetRewardAdjuster(adjuster, minttypes.ModuleName)
+	require.Panics(t, func() { app.DistrKeeper.SetRewardAdjuster(adjuster, minttypes.ModuleName) })
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	// withdraw rewards, taxed and credited with the bonus
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	rewards, err := app.DistrKeeper.WithdrawDelegationRewards(ctx, sdk.AccAddress(valAddrs[0]), valAddrs[0])
+	require.NoError(t, err)
+	tax := initial.QuoRaw(2).QuoRaw(5)
+	net := initial.QuoRaw(2).Sub(tax).Add(adjusterBonus.AmountOf(sdk.DefaultBondDenom))
+	require.Equal(t, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, net)}, rewards)
+
+	require.Equal(t,
+		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens.Sub(valTokens).Add(net))},
+		app.BankKeeper.GetAllBalances(ctx, sdk.AccAddress(valAddrs[0])),
+	)
+	mintAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	require.Equal(t,
+		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, tax)},
+		app.BankKeeper.GetAllBalances(ctx, mintAddr),
+	)
+
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeRewardAdjustment {
+			continue
+		}
+		found = true
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case types.AttributeKeyTax:
+				require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, tax).String(), string(attr.Value))
+			case types.AttributeKeyBonus:
+				require.Equal(t, adjusterBonus.String(), string(attr.Value))
+			case types.AttributeKeyAccount:
+				require.Equal(t, mintAddr.String(), string(attr.Value))
+			}
+		}
+	}
+	require.True(t, found)
+
+	// a tax exceeding the rewards, or a bonus the account can't pay, leaves
+	// the rewards unadjusted
+	for _, adjustment := range []mockRewardAdjuster{
+		{taxRate: sdk.NewDec(2)},
+		{taxRate: sdk.ZeroDec(), bonus: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tax.AddRaw(1)))},
+	} {
+		*adjuster = adjustment
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+		app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+		rewards, err = app.DistrKeeper.WithdrawDelegationRewards(ctx, sdk.AccAddress(valAddrs[0]), valAddrs[0])
+		require.NoError(t, err)
+		require.Equal(t, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))}, rewards)
+		require.NotEmpty(t, rewardAdjustmentError(ctx))
+		require.Equal(t,
+			sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, tax)},
+			app.BankKeeper.GetAllBalances(ctx, mintAddr),
+		)
+	}
+}
+
+func TestUnlockDelegationWithFailingRewardAdjuster(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	balancePower := int64(1000)
+	balanceTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, balancePower)
+	addr := simapp.AddTestAddrs(app, ctx, 1, balanceTokens)
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 50% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	valTokens := tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// lock the self delegation with a 1.5 reward multiplier
+	params := app.StakingKeeper.GetParams(ctx)
+	params.LockupTiers = []stakingtypes.LockupTier{stakingtypes.NewLockupTier(time.Hour, sdk.NewDecWithPrec(15, 1))}
+	app.StakingKeeper.SetParams(ctx, params)
+	endTime, err := app.StakingKeeper.LockDelegation(ctx, sdk.AccAddress(valAddrs[0]), valAddrs[0], time.Hour)
+	require.NoError(t, err)
+
+	// fund the community pool, which pays the bonus
+	pool := app.StakingKeeper.TokensFromConsensusPower(ctx, 100)
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, pool)), addr[0]))
+
+	// the reward adjuster fails, the staking hooks being set with a keeper
+	// using it
+	distrKeeper := app.DistrKeeper
+	distrKeeper.SetRewardAdjuster(mockRewardAdjuster{err: errors.New("adjuster failure")}, minttypes.ModuleName)
+	stakingKeeper := stakingkeeper.NewKeeper(
+		app.AppCodec(), app.GetKey(stakingtypes.StoreKey), app.AccountKeeper, app.BankKeeper,
+		app.GetSubspace(stakingtypes.ModuleName),
+	)
+	stakingKeeper.SetHooks(distrKeeper.Hooks())
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	// the lockup ends without halting the chain, and the rewards boosted by
+	// half from the community pool are withdrawn unadjusted
+	ctx = ctx.WithBlockTime(endTime).WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() { staking.EndBlocker(ctx, stakingKeeper) })
+	require.Empty(t, app.StakingKeeper.GetAllDelegationLockups(ctx))
+	require.Equal(t, "adjuster failure", rewardAdjustmentError(ctx))
+
+	exp := balanceTokens.Sub(valTokens).Sub(pool).Add(initial.QuoRaw(2)).Add(initial.QuoRaw(4))
+	require.Equal(t,
+		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, exp)},
+		app.BankKeeper.GetAllBalances(ctx, sdk.AccAddress(valAddrs[0])),
+	)
+}
+
+func TestWithdrawAllDelegationRewards(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs[:3])
+	sort.Slice(valAddrs, func(i, j int) bool { return bytes.Compare(valAddrs[i], valAddrs[j]) < 0 })
+	delAddr := addrs[3]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000000)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create three validators and delegate to each of them
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	for i, pk := range []cryptotypes.PubKey{valConsPk1, valConsPk2, valConsPk3} {
+		tstaking.CreateValidatorWithValPower(valAddrs[i], pk, 100, true)
+		tstaking.DelegateWithPower(delAddr, valAddrs[i], 100)
+	}
+
+	// end block to bond validators
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards to each validator
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
+	for _, valAddr := range valAddrs {
+		app.DistrKeeper.AllocateTokensToValidator(ctx, app.StakingKeeper.Validator(ctx, valAddr), tokens)
+	}
+
+	balance := app.BankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom)
+
+	// first batch withdraws from the first two validators
+	amount, next, err := app.DistrKeeper.WithdrawAllDelegationRewards(ctx, delAddr, nil, 2)
+	require.NoError(t, err)
+	require.Equal(t, valAddrs[2], next)
+	require.Equal(t, balance.Add(amount[0]), app.BankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom))
+
+	for i, valAddr := range valAddrs {
+		val := app.StakingKeeper.Validator(ctx, valAddr)
+		del := app.StakingKeeper.Delegation(ctx, delAddr, valAddr)
+		endingPeriod := app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
+		rewards := app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+		require.Equal(t, i == 2, !rewards.IsZero(), "validator %d", i)
+	}
+
+	// second batch withdraws from the remaining validator
+	balance = app.BankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom)
+	amount, next, err = app.DistrKeeper.WithdrawAllDelegationRewards(ctx, delAddr, next, 2)
+	require.NoError(t, err)
+	require.Empty(t, next)
+	require.Equal(t, balance.Add(amount[0]), app.BankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom))
+}
+
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -28,3 +28,39 @@ func (k Keeper) DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receive
 	k.SetFeePool(ctx, feePool)
 	return nil
 }
+
+// DistributeFromFeePoolToModule distributes funds from the distribution module
+// account to a module account, e.g. to escrow a grant from the community pool.
+// An error is returned if the amount exceeds the community pool balance.
+func (k Keeper) DistributeFromFeePoolToModule(ctx sdk.Context, amount sdk.Coins, recipientModule string) error {
+	feePool := k.GetFeePool(ctx)
+
+	newPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(amount...))
+	if negative {
+		return types.ErrBadDistribution
+	}
+
+	feePool.CommunityPool = newPool
+
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipientModule, amount)
+	if err != nil {
+		return err
+	}
+
+	k.SetFeePool(ctx, feePool)
+	return nil
+}
+
+// FundCommunityPoolFromModule allows a module account to directly fund the
+// community pool, e.g. to return the unspent funds of a grant.
+func (k Keeper) FundCommunityPoolFromModule(ctx sdk.Context, amount sdk.Coins, senderModule string) error {
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...)
+	k.SetFeePool(ctx, feePool)
+
+	return nil
+}
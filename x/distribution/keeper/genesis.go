@@ -85,6 +85,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, val := range data.AutoRestakeValidators {
+		valAddr, err := sdk.ValAddressFromBech32(val)
+		if err != nil {
+			panic(err)
+		}
+		k.SetValidatorAutoRestake(ctx, valAddr, true)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -190,5 +197,13 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	restakes := make([]string, 0)
+	k.IterateValidatorAutoRestakes(ctx, func(val sdk.ValAddress) (stop bool) {
+		restakes = append(restakes, val.String())
+		return false
+	})
+
+	genState := types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	genState.AutoRestakeValidators = restakes
+	return genState
 }
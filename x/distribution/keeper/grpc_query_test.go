@@ -75,6 +75,11 @@ func (suite *KeeperTestSuite) TestGRPCParams() {
 					BaseProposerReward:  sdk.NewDecWithPrec(2, 1),
 					BonusProposerReward: sdk.NewDecWithPrec(1, 1),
 					WithdrawAddrEnabled: true,
+
+					GovAbsenteeRewardPenalty: sdk.NewDecWithPrec(5, 1),
+					FeeBurnPercentage:        sdk.NewDecWithPrec(1, 1),
+
+					CommissionRestakeEpochLength: 10,
 				}
 
 				app.DistrKeeper.SetParams(ctx, params)
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -75,6 +76,9 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 	// clear current rewards
 	h.k.DeleteValidatorCurrentRewards(ctx, valAddr)
 
+	// clear commission auto-restake flag
+	h.k.SetValidatorAutoRestake(ctx, valAddr, false)
+
 	return nil
 }
 
@@ -119,3 +123,9 @@ func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _
 func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
 	return nil
 }
+func (h Hooks) AfterConsPubKeyRotated(_ sdk.Context, _ sdk.ValAddress, _, _ cryptotypes.PubKey) error {
+	return nil
+}
+func (h Hooks) BeforeSlashedTokensBurned(_ sdk.Context, _ string, amount sdk.Coins) (sdk.Coins, error) {
+	return amount, nil
+}
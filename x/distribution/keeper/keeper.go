@@ -1,15 +1,18 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Keeper of the distribution store
@@ -24,6 +27,9 @@ type Keeper struct {
 	blockedAddrs map[string]bool
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	rewardAdjuster        types.RewardAdjuster
+	rewardAdjusterAccount string // name of the ModuleAccount of the reward adjuster
 }
 
 // NewKeeper creates a new distribution Keeper instance
@@ -60,6 +66,25 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
+// SetRewardAdjuster sets the adjuster of the rewards withdrawn from
+// delegations, whose taxes are sent to, and bonuses paid from, the module
+// account with the given name. It must be set before the distribution hooks
+// are registered with the staking keeper, as they hold a copy of the keeper.
+func (k *Keeper) SetRewardAdjuster(a types.RewardAdjuster, moduleName string) *Keeper {
+	if k.rewardAdjuster != nil {
+		panic("cannot set reward adjuster twice")
+	}
+
+	if addr := k.authKeeper.GetModuleAddress(moduleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", moduleName))
+	}
+
+	k.rewardAdjuster = a
+	k.rewardAdjusterAccount = moduleName
+
+	return k
+}
+
 // SetWithdrawAddr sets a new address that will receive the rewards upon withdrawal
 func (k Keeper) SetWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, withdrawAddr sdk.AccAddress) error {
 	if k.blockedAddrs[withdrawAddr.String()] {
@@ -120,6 +145,55 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	return rewards, nil
 }
 
+// WithdrawAllDelegationRewards withdraws the rewards of a delegator from at
+// most limit of its validators, in store order, starting with startValAddr.
+// It returns the total withdrawn rewards along with the validator to start the
+// next batch with, which is empty once all the validators have been processed.
+func (k Keeper) WithdrawAllDelegationRewards(
+	ctx sdk.Context, delAddr sdk.AccAddress, startValAddr sdk.ValAddress, limit uint32,
+) (sdk.Coins, sdk.ValAddress, error) {
+	if limit == 0 || limit > types.MaxWithdrawAllDelegatorRewardsLimit {
+		limit = types.MaxWithdrawAllDelegatorRewardsLimit
+	}
+
+	// delegations are iterated by length prefixed validator address
+	var startKey []byte
+	if !startValAddr.Empty() {
+		startKey = address.MustLengthPrefix(startValAddr)
+	}
+
+	var (
+		valAddrs []sdk.ValAddress
+		next     sdk.ValAddress
+	)
+	k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+		valAddr := del.GetValidatorAddr()
+		if startKey != nil && bytes.Compare(address.MustLengthPrefix(valAddr), startKey) < 0 {
+			return false
+		}
+
+		if uint32(len(valAddrs)) == limit {
+			next = valAddr
+			return true
+		}
+
+		valAddrs = append(valAddrs, valAddr)
+		return false
+	})
+
+	total := sdk.NewCoins()
+	for _, valAddr := range valAddrs {
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		total = total.Add(rewards...)
+	}
+
+	return total, next, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
@@ -41,3 +41,24 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyWithdrawAddrEnabled, &enabled)
 	return enabled
 }
+
+// GetGovAbsenteeRewardPenalty returns the current distribution share of the
+// rewards withheld from the validators absent from governance.
+func (k Keeper) GetGovAbsenteeRewardPenalty(ctx sdk.Context) (percent sdk.Dec) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyGovAbsenteeRewardPenalty, &percent)
+	return percent
+}
+
+// GetFeeBurnPercentage returns the current distribution share of the collected
+// fees which is burned.
+func (k Keeper) GetFeeBurnPercentage(ctx sdk.Context) (percent sdk.Dec) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyFeeBurnPercentage, &percent)
+	return percent
+}
+
+// GetCommissionRestakeEpochLength returns the current number of blocks between
+// the automatic restakes of the commission of the validators which opted in.
+func (k Keeper) GetCommissionRestakeEpochLength(ctx sdk.Context) (epochLength uint64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyCommissionRestakeEpochLength, &epochLength)
+	return epochLength
+}
@@ -131,6 +131,11 @@ func TestQueries(t *testing.T) {
 		BaseProposerReward:  sdk.NewDecWithPrec(2, 1),
 		BonusProposerReward: sdk.NewDecWithPrec(1, 1),
 		WithdrawAddrEnabled: true,
+
+		GovAbsenteeRewardPenalty: sdk.NewDecWithPrec(5, 1),
+		FeeBurnPercentage:        sdk.NewDecWithPrec(1, 1),
+
+		CommissionRestakeEpochLength: 10,
 	}
 
 	app.DistrKeeper.SetParams(ctx, params)
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetValidatorAutoRestake opts a validator in or out of the automatic restake
+// of its commission as self-bond.
+func (k Keeper) SetValidatorAutoRestake(ctx sdk.Context, val sdk.ValAddress, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !enabled {
+		store.Delete(types.GetValidatorAutoRestakeKey(val))
+		return
+	}
+
+	store.Set(types.GetValidatorAutoRestakeKey(val), []byte{0x01})
+}
+
+// IsValidatorAutoRestake returns whether a validator opted in the automatic
+// restake of its commission.
+func (k Keeper) IsValidatorAutoRestake(ctx sdk.Context, val sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetValidatorAutoRestakeKey(val))
+}
+
+// IterateValidatorAutoRestakes iterates over the validators which opted in the
+// automatic restake of their commission.
+func (k Keeper) IterateValidatorAutoRestakes(ctx sdk.Context, handler func(val sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorAutoRestakePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(types.GetValidatorAutoRestakeAddress(iter.Key())) {
+			break
+		}
+	}
+}
+
+// RestakeCommissions restakes the commission of the validators which opted in
+// when the block height is a multiple of the commission restake epoch length.
+// A validator whose restake fails keeps its commission, and the failure is
+// reported in the restake_commission event.
+func (k Keeper) RestakeCommissions(ctx sdk.Context) {
+	epochLength := k.GetCommissionRestakeEpochLength(ctx)
+	if epochLength == 0 || ctx.BlockHeight()%int64(epochLength) != 0 {
+		return
+	}
+
+	var vals []sdk.ValAddress
+	k.IterateValidatorAutoRestakes(ctx, func(val sdk.ValAddress) bool {
+		vals = append(vals, val)
+		return false
+	})
+
+	for _, val := range vals {
+		// restake in a branch of the state which is only written on success,
+		// so that a failed restake leaves the commission untouched
+		cacheCtx, writeCache := ctx.CacheContext()
+		restaked, err := k.RestakeCommission(cacheCtx, val)
+		if err != nil {
+			k.Logger(ctx).Error("failed to restake validator commission", "validator", val.String(), "err", err)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeRestakeCommission,
+					sdk.NewAttribute(types.AttributeKeyValidator, val.String()),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+			continue
+		}
+
+		if restaked.IsZero() {
+			continue
+		}
+
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRestakeCommission,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, restaked.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, val.String()),
+			),
+		)
+	}
+}
+
+// RestakeCommission delegates the integral bond denom part of the accumulated
+// commission of a validator to itself, from its operator account. The
+// commission in other denoms is left to be withdrawn. It returns the restaked
+// amount, which is zero if there is nothing to restake.
+func (k Keeper) RestakeCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coin, error) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	restaked := sdk.NewCoin(bondDenom, sdk.ZeroInt())
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return restaked, types.ErrNoValidatorExists
+	}
+
+	accumCommission := k.GetValidatorAccumulatedCommission(ctx, valAddr)
+	restaked.Amount = accumCommission.Commission.AmountOf(bondDenom).TruncateInt()
+	if restaked.IsZero() {
+		return restaked, nil
+	}
+
+	// subtract the restaked amount from the commission and the outstanding
+	// rewards, as a commission withdrawal does
+	withdrawn := sdk.NewDecCoinsFromCoins(restaked)
+	k.SetValidatorAccumulatedCommission(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: accumCommission.Commission.Sub(withdrawn)})
+	outstanding := k.GetValidatorOutstandingRewards(ctx, valAddr).Rewards
+	k.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(withdrawn)})
+
+	// the commission is paid to the operator account rather than to its
+	// withdraw address, as it is self-bonded from the operator account
+	operator := sdk.AccAddress(valAddr)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, operator, sdk.NewCoins(restaked)); err != nil {
+		return restaked, err
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, operator, restaked.Amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return restaked, err
+	}
+
+	return restaked, nil
+}
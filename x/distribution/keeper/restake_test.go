@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestSetValidatorAutoRestake(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1234))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	require.False(t, app.DistrKeeper.IsValidatorAutoRestake(ctx, valAddrs[0]))
+
+	app.DistrKeeper.SetValidatorAutoRestake(ctx, valAddrs[0], true)
+	require.True(t, app.DistrKeeper.IsValidatorAutoRestake(ctx, valAddrs[0]))
+	require.False(t, app.DistrKeeper.IsValidatorAutoRestake(ctx, valAddrs[1]))
+
+	var vals []sdk.ValAddress
+	app.DistrKeeper.IterateValidatorAutoRestakes(ctx, func(val sdk.ValAddress) bool {
+		vals = append(vals, val)
+		return false
+	})
+	require.Equal(t, []sdk.ValAddress{valAddrs[0]}, vals)
+
+	app.DistrKeeper.SetValidatorAutoRestake(ctx, valAddrs[0], false)
+	require.False(t, app.DistrKeeper.IsValidatorAutoRestake(ctx, valAddrs[0]))
+}
+
+func TestRestakeCommissions(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 9})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1234))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.CommissionRestakeEpochLength = 10
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// create two validators with 50% commission, only the first one restakes
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	tstaking.CreateValidator(valAddrs[1], valConsPk2, sdk.NewInt(100), true)
+	app.DistrKeeper.SetValidatorAutoRestake(ctx, valAddrs[0], true)
+
+	// allocate tokens to both validators
+	tokens := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(11)), sdk.NewCoin("mytoken", sdk.NewInt(4)))
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), tokens.Add(tokens...)))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+	for _, valAddr := range valAddrs {
+		app.DistrKeeper.AllocateTokensToValidator(ctx, app.StakingKeeper.Validator(ctx, valAddr), sdk.NewDecCoinsFromCoins(tokens...))
+	}
+
+	// nothing is restaked outside of the end of an epoch
+	app.DistrKeeper.RestakeCommissions(ctx)
+	val, _ := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.Equal(t, sdk.NewInt(100), val.Tokens)
+
+	// the integral bond denom commission is restaked at the end of an epoch
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	app.DistrKeeper.RestakeCommissions(ctx)
+
+	val, _ = app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.Equal(t, sdk.NewInt(105), val.Tokens)
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(105), delegation.Shares)
+
+	expRemainder := sdk.DecCoins{
+		sdk.NewDecCoinFromDec("mytoken", sdk.NewDec(2)),
+		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(5, 1)),
+	}
+	require.Equal(t, expRemainder, app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission)
+
+	// the validator which did not opt in keeps its commission
+	val, _ = app.StakingKeeper.GetValidator(ctx, valAddrs[1])
+	require.Equal(t, sdk.NewInt(100), val.Tokens)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(tokens...).QuoDec(sdk.NewDec(2)), app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[1]).Commission)
+
+	var restaked bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeRestakeCommission {
+			restaked = true
+			require.Equal(t, valAddrs[0].String(), string(event.Attributes[1].Value))
+			require.Equal(t, "5stake", string(event.Attributes[0].Value))
+		}
+	}
+	require.True(t, restaked)
+}
@@ -9,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -18,6 +19,11 @@ const (
 	BaseProposerReward  = "base_proposer_reward"
 	BonusProposerReward = "bonus_proposer_reward"
 	WithdrawEnabled     = "withdraw_enabled"
+
+	GovAbsenteeRewardPenalty = "gov_absentee_reward_penalty"
+	FeeBurnPercentage        = "fee_burn_percentage"
+
+	CommissionRestakeEpochLength = "commission_restake_epoch_length"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -40,6 +46,21 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenGovAbsenteeRewardPenalty randomized GovAbsenteeRewardPenalty
+func GenGovAbsenteeRewardPenalty(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 2)
+}
+
+// GenFeeBurnPercentage randomized FeeBurnPercentage
+func GenFeeBurnPercentage(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(51)), 2)
+}
+
+// GenCommissionRestakeEpochLength randomized CommissionRestakeEpochLength
+func GenCommissionRestakeEpochLength(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1, 100))
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -66,6 +87,24 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var govAbsenteeRewardPenalty sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, GovAbsenteeRewardPenalty, &govAbsenteeRewardPenalty, simState.Rand,
+		func(r *rand.Rand) { govAbsenteeRewardPenalty = GenGovAbsenteeRewardPenalty(r) },
+	)
+
+	var feeBurnPercentage sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FeeBurnPercentage, &feeBurnPercentage, simState.Rand,
+		func(r *rand.Rand) { feeBurnPercentage = GenFeeBurnPercentage(r) },
+	)
+
+	var commissionRestakeEpochLength uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CommissionRestakeEpochLength, &commissionRestakeEpochLength, simState.Rand,
+		func(r *rand.Rand) { commissionRestakeEpochLength = GenCommissionRestakeEpochLength(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
@@ -73,6 +112,11 @@ func RandomizedGenState(simState *module.SimulationState) {
 			BaseProposerReward:  baseProposerReward,
 			BonusProposerReward: bonusProposerReward,
 			WithdrawAddrEnabled: withdrawEnabled,
+
+			GovAbsenteeRewardPenalty: govAbsenteeRewardPenalty,
+			FeeBurnPercentage:        feeBurnPercentage,
+
+			CommissionRestakeEpochLength: commissionRestakeEpochLength,
 		},
 	}
 
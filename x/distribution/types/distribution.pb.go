@@ -31,6 +31,17 @@ type Params struct {
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward" yaml:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward" yaml:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty" yaml:"withdraw_addr_enabled"`
+	// gov_absentee_reward_penalty is the share of the rewards of the validators
+	// flagged as absent from governance by the staking module which is credited
+	// to the community pool instead.
+	GovAbsenteeRewardPenalty github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=gov_absentee_reward_penalty,json=govAbsenteeRewardPenalty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gov_absentee_reward_penalty" yaml:"gov_absentee_reward_penalty"`
+	// fee_burn_percentage is the share of the collected fees which is burned
+	// instead of being distributed.
+	FeeBurnPercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=fee_burn_percentage,json=feeBurnPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_burn_percentage" yaml:"fee_burn_percentage"`
+	// commission_restake_epoch_length is the number of blocks between the
+	// automatic restakes of the commission of the validators which opted in. Zero
+	// disables the automatic restake.
+	CommissionRestakeEpochLength uint64 `protobuf:"varint,7,opt,name=commission_restake_epoch_length,json=commissionRestakeEpochLength,proto3" json:"commission_restake_epoch_length,omitempty" yaml:"commission_restake_epoch_length"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -72,6 +83,13 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetCommissionRestakeEpochLength() uint64 {
+	if m != nil {
+		return m.CommissionRestakeEpochLength
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -625,76 +643,85 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1102 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x24, 0x8e, 0x93, 0x4e, 0xf3, 0xab, 0x13, 0x27, 0x71, 0x93, 0xe0, 0x8d, 0x46, 0x6a,
-	0x15, 0x04, 0x75, 0x9a, 0xf6, 0x82, 0x72, 0x40, 0x8a, 0x9d, 0x44, 0x14, 0x01, 0x8d, 0xb6, 0x01,
-	0x24, 0x2e, 0xd6, 0x78, 0x77, 0x62, 0x8f, 0x62, 0xef, 0x2c, 0x33, 0x63, 0x27, 0x39, 0x20, 0x24,
-	0x4e, 0x5c, 0x10, 0x20, 0x2e, 0x1c, 0x00, 0xe5, 0xc8, 0xaf, 0x3f, 0xa4, 0xc7, 0xde, 0x40, 0x20,
-	0x2d, 0x28, 0x11, 0x12, 0xe2, 0xe8, 0x1b, 0x37, 0xb4, 0x3b, 0xb3, 0xbb, 0xb6, 0x6b, 0xaa, 0xb8,
-	0x52, 0x4f, 0xf6, 0x7e, 0xf3, 0xe6, 0xbd, 0xef, 0xbd, 0xf7, 0xed, 0x7b, 0x0b, 0x4b, 0x0e, 0x97,
-	0x2d, 0x2e, 0x37, 0x5d, 0x26, 0x95, 0x60, 0xb5, 0xb6, 0x62, 0xdc, 0xdb, 0xec, 0x6c, 0xd5, 0xa8,
-	0x22, 0x5b, 0x7d, 0x60, 0xc9, 0x17, 0x5c, 0x71, 0xb4, 0xaa, 0xed, 0x4b, 0x7d, 0x47, 0xc6, 0x7e,
-	0x25, 0x5f, 0xe7, 0x75, 0x1e, 0xd9, 0x6d, 0x86, 0xff, 0xf4, 0x95, 0x95, 0xa2, 0x09, 0x51, 0x23,
-	0x92, 0x26, 0xae, 0x1d, 0xce, 0x8c, 0x4b, 0xfc, 0xcb, 0x38, 0xcc, 0x1d, 0x10, 0x41, 0x5a, 0x12,
-	0x1d, 0xc3, 0x19, 0x87, 0xb7, 0x5a, 0x6d, 0x8f, 0xa9, 0xb3, 0xaa, 0x22, 0xa7, 0x05, 0xb0, 0x0e,
-	0x36, 0xae, 0x95, 0xf7, 0x1f, 0x07, 0x56, 0xe6, 0xb7, 0xc0, 0xba, 0x5d, 0x67, 0xaa, 0xd1, 0xae,
-	0x95, 0x1c, 0xde, 0xda, 0x34, 0x4e, 0xf5, 0xcf, 0x1d, 0xe9, 0x1e, 0x6f, 0xaa, 0x33, 0x9f, 0xca,
-	0xd2, 0x2e, 0x75, 0xba, 0x81, 0x95, 0x3f, 0x23, 0xad, 0xe6, 0x36, 0xee, 0x73, 0x86, 0xed, 0xe9,
-	0xe4, 0xf9, 0x90, 0x9c, 0xa2, 0x8f, 0x61, 0x3e, 0xa4, 0x54, 0xf5, 0x05, 0xf7, 0xb9, 0xa4, 0xa2,
-	0x2a, 0xe8, 0x09, 0x11, 0x6e, 0x61, 0x2c, 0x8a, 0xf9, 0xf6, 0xc8, 0x31, 0x57, 0x75, 0xcc, 0x61,
-	0x3e, 0xb1, 0x8d, 0x42, 0xf8, 0xc0, 0xa0, 0x76, 0x04, 0xa2, 0x4f, 0x00, 0x5c, 0xac, 0x71, 0xaf,
-	0x2d, 0x9f, 0xa2, 0x30, 0x1e, 0x51, 0x78, 0x67, 0x64, 0x0a, 0x6b, 0x86, 0xc2, 0x30, 0xa7, 0xd8,
-	0x5e, 0x88, 0xf0, 0x01, 0x12, 0x87, 0x70, 0xf1, 0x84, 0xa9, 0x86, 0x2b, 0xc8, 0x49, 0x95, 0xb8,
-	0xae, 0xa8, 0x52, 0x8f, 0xd4, 0x9a, 0xd4, 0x2d, 0x64, 0xd7, 0xc1, 0xc6, 0x54, 0x79, 0x3d, 0xf5,
-	0x3a, 0xd4, 0x0c, 0xdb, 0x0b, 0x31, 0xbe, 0xe3, 0xba, 0x62, 0x4f, 0xa3, 0xdb, 0xd9, 0xaf, 0xcf,
-	0xad, 0x0c, 0xfe, 0x7c, 0x0c, 0xae, 0xbc, 0x47, 0x9a, 0xcc, 0x25, 0x8a, 0x8b, 0x37, 0x98, 0x54,
-	0x5c, 0x30, 0x87, 0x34, 0x75, 0x64, 0x89, 0x7e, 0x02, 0x70, 0xd9, 0x69, 0xb7, 0xda, 0x4d, 0xa2,
-	0x58, 0x87, 0x1a, 0x9a, 0x55, 0x41, 0x14, 0xe3, 0x05, 0xb0, 0x3e, 0xbe, 0x71, 0xfd, 0xde, 0x9a,
-	0x91, 0x67, 0x29, 0xac, 0x5e, 0x2c, 0xb3, 0x30, 0xd7, 0x0a, 0x67, 0x5e, 0xf9, 0xdd, 0xb0, 0x3e,
-	0xdd, 0xc0, 0x2a, 0x9a, 0x66, 0x0f, 0x77, 0x85, 0x7f, 0xfc, 0xc3, 0x7a, 0xe5, 0x6a, 0x15, 0x0c,
-	0xbd, 0x4a, 0x7b, 0x31, 0x75, 0xa4, 0x99, 0xda, 0xa1, 0x1b, 0x54, 0x81, 0x73, 0x82, 0x1e, 0x51,
-	0x41, 0x3d, 0x87, 0x56, 0x1d, 0xde, 0xf6, 0x54, 0xa4, 0x94, 0x99, 0xf2, 0x4a, 0x37, 0xb0, 0x96,
-	0x34, 0x85, 0x01, 0x03, 0x6c, 0xcf, 0x26, 0x48, 0x25, 0x02, 0xbe, 0x03, 0x70, 0x39, 0xa9, 0x48,
-	0xa5, 0x2d, 0x04, 0xf5, 0x54, 0x5c, 0x8e, 0x63, 0x38, 0xa9, 0x79, 0xcb, 0x2b, 0x65, 0x7f, 0x3f,
-	0xcc, 0x7e, 0xd4, 0xdc, 0xe2, 0x08, 0x68, 0x09, 0xe6, 0x7c, 0x2a, 0x18, 0xd7, 0x72, 0xcf, 0xda,
-	0xe6, 0x09, 0x7f, 0x05, 0x60, 0x31, 0x21, 0xb8, 0xe3, 0x98, 0x52, 0x50, 0xb7, 0xc2, 0x5b, 0x2d,
-	0x26, 0x25, 0xe3, 0x1e, 0xfa, 0x10, 0x42, 0x27, 0x79, 0x7a, 0x71, 0x54, 0x7b, 0x82, 0xe0, 0x6f,
-	0x00, 0x5c, 0x4d, 0x58, 0x3d, 0x6c, 0x2b, 0xa9, 0x88, 0xe7, 0x32, 0xaf, 0x1e, 0x97, 0xee, 0xa3,
-	0xd1, 0x4a, 0xb7, 0x67, 0x84, 0x33, 0x1b, 0x77, 0x2d, 0xba, 0x8a, 0x9f, 0xb7, 0x98, 0xf8, 0x07,
-	0x00, 0x17, 0x12, 0x7a, 0x8f, 0x9a, 0x44, 0x36, 0xf6, 0x3a, 0xd4, 0x53, 0x68, 0x1f, 0xce, 0x77,
-	0x62, 0xb8, 0x6a, 0xca, 0x1d, 0x4e, 0xb4, 0x6c, 0x79, 0xb5, 0x1b, 0x58, 0xcb, 0x3a, 0xfa, 0xa0,
-	0x05, 0xb6, 0xe7, 0x12, 0xe8, 0x20, 0x42, 0xd0, 0x9b, 0x70, 0xea, 0x48, 0x10, 0x27, 0x9c, 0xb5,
-	0x66, 0x3a, 0x95, 0x46, 0x1b, 0x0d, 0x76, 0x72, 0x1f, 0xff, 0x0c, 0x60, 0x7e, 0x08, 0x57, 0x89,
-	0x3e, 0x03, 0x70, 0x29, 0xe5, 0x22, 0xc3, 0x93, 0x2a, 0x8d, 0x8e, 0x4c, 0x4d, 0xef, 0x96, 0x9e,
-	0x31, 0xfb, 0x4b, 0x43, 0x7c, 0x96, 0x6f, 0x99, 0x3a, 0xbf, 0x34, 0x98, 0x69, 0xaf, 0x77, 0x6c,
-	0xe7, 0x3b, 0x43, 0xf8, 0x98, 0x11, 0xf2, 0x2d, 0x80, 0x93, 0xfb, 0x94, 0x1e, 0x70, 0xde, 0x44,
-	0x5f, 0x02, 0x38, 0x9b, 0x4e, 0x74, 0x9f, 0xf3, 0xe6, 0x95, 0xba, 0xfd, 0x96, 0x61, 0xb1, 0x38,
-	0xb8, 0x13, 0x42, 0x0f, 0x23, 0x37, 0x3d, 0x5d, 0x50, 0x21, 0x27, 0xfc, 0x17, 0x80, 0x2b, 0x95,
-	0x5e, 0xe4, 0x91, 0x4f, 0x3d, 0x57, 0xcf, 0x58, 0xd2, 0x44, 0x79, 0x38, 0xa1, 0x98, 0x6a, 0x52,
-	0xbd, 0xc8, 0x6c, 0xfd, 0x80, 0xd6, 0xe1, 0x75, 0x97, 0x4a, 0x47, 0x30, 0x3f, 0x6d, 0xa9, 0xdd,
-	0x0b, 0xa1, 0x35, 0x78, 0x4d, 0x50, 0x87, 0xf9, 0x8c, 0x7a, 0x4a, 0x6f, 0x03, 0x3b, 0x05, 0x90,
-	0x03, 0x73, 0xa4, 0x15, 0x4d, 0xa0, 0x6c, 0x94, 0xff, 0xcd, 0xa1, 0xf9, 0x47, 0xc9, 0xdf, 0x35,
-	0xaf, 0xde, 0xc6, 0x15, 0x72, 0xd4, 0x09, 0x1a, 0xd7, 0xdb, 0xd3, 0x9f, 0x9e, 0x5b, 0x99, 0xb0,
-	0x07, 0x7f, 0x87, 0x7d, 0xf8, 0x17, 0xc0, 0xc5, 0x5d, 0xda, 0xa4, 0xf5, 0xa8, 0x4d, 0x8a, 0x08,
-	0xc5, 0xbc, 0xfa, 0x03, 0xef, 0x28, 0x9a, 0x8b, 0xbe, 0xa0, 0x1d, 0xc6, 0xc3, 0x95, 0xd3, 0xab,
-	0xf1, 0x9e, 0xb9, 0x38, 0x60, 0x80, 0xed, 0xd9, 0x18, 0x31, 0x0a, 0x3f, 0x84, 0x13, 0x52, 0x91,
-	0x63, 0x6a, 0xe4, 0xfd, 0xfa, 0xc8, 0x9b, 0x6f, 0x5a, 0x07, 0x8a, 0x9c, 0x60, 0x5b, 0x3b, 0x43,
-	0x7b, 0x30, 0xd7, 0xa0, 0xac, 0xde, 0xd0, 0x25, 0xcc, 0x96, 0xef, 0xfc, 0x13, 0x58, 0x73, 0x8e,
-	0xa0, 0xe1, 0x3c, 0xf7, 0xaa, 0xfa, 0x28, 0x25, 0x39, 0x70, 0x80, 0x6d, 0x73, 0x19, 0xff, 0x0e,
-	0xe0, 0x4d, 0x93, 0x3b, 0xe3, 0x5e, 0x52, 0x05, 0xb3, 0x40, 0x1f, 0xc0, 0x1b, 0xa9, 0xb0, 0xc3,
-	0xd5, 0x48, 0xa5, 0x34, 0xdf, 0x2d, 0x6b, 0xdd, 0xc0, 0x2a, 0x0c, 0x6a, 0xdf, 0x98, 0x60, 0x3b,
-	0x9d, 0x0d, 0x3b, 0x1a, 0x42, 0x0c, 0xe6, 0x92, 0x6f, 0x90, 0x17, 0x34, 0x55, 0x4d, 0x80, 0xed,
-	0x29, 0xd3, 0x5d, 0x80, 0xcf, 0xc7, 0xe0, 0xad, 0xff, 0x57, 0xf0, 0xfb, 0x4c, 0x35, 0x76, 0xa9,
-	0xcf, 0x25, 0x53, 0xe8, 0x76, 0x9f, 0x98, 0xcb, 0xf3, 0x69, 0xd9, 0x23, 0x18, 0xc7, 0xf2, 0x7e,
-	0x6d, 0x88, 0xbc, 0xcb, 0x4b, 0xdd, 0xc0, 0x42, 0xda, 0xba, 0xe7, 0x10, 0xf7, 0xcb, 0xfe, 0xde,
-	0x53, 0xb2, 0x2f, 0xe7, 0xbb, 0x81, 0x35, 0x1f, 0xcf, 0x69, 0x73, 0x84, 0x7b, 0x5f, 0x86, 0x97,
-	0x7b, 0x5e, 0x86, 0xf0, 0xc2, 0x8d, 0x6e, 0x60, 0xcd, 0xe8, 0x0b, 0x1a, 0xc7, 0xb1, 0xa4, 0xd1,
-	0xab, 0x70, 0xd2, 0xd5, 0xb9, 0x14, 0x26, 0x22, 0x5b, 0x94, 0x2e, 0x01, 0x73, 0x80, 0xed, 0xd8,
-	0x24, 0x2d, 0x51, 0xf9, 0xe1, 0xf7, 0x17, 0x45, 0xf0, 0xf8, 0xa2, 0x08, 0x9e, 0x5c, 0x14, 0xc1,
-	0x9f, 0x17, 0x45, 0xf0, 0xc5, 0x65, 0x31, 0xf3, 0xe4, 0xb2, 0x98, 0xf9, 0xf5, 0xb2, 0x98, 0xf9,
-	0x60, 0xeb, 0x99, 0xf5, 0x3f, 0xed, 0xff, 0xb4, 0x8e, 0xda, 0x51, 0xcb, 0x45, 0x5f, 0xbe, 0xf7,
-	0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x45, 0x1d, 0xb8, 0x42, 0x7e, 0x0b, 0x00, 0x00,
+	// 1233 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xb4, 0xa9, 0xd3, 0x4e, 0xdb, 0xb4, 0x9d, 0x38, 0xa9, 0x9b, 0xe4, 0xeb, 0x8d, 0x46,
+	0x6a, 0x95, 0x2f, 0x50, 0xa7, 0x3f, 0x2e, 0x28, 0x07, 0xa4, 0x38, 0x4d, 0x45, 0x51, 0xa1, 0xd1,
+	0x36, 0x80, 0xc4, 0x65, 0x35, 0xde, 0x9d, 0xd8, 0xa3, 0xac, 0x67, 0xb6, 0x33, 0x63, 0xb7, 0x91,
+	0x40, 0x48, 0x9c, 0xb8, 0x20, 0x40, 0xbd, 0x70, 0x00, 0xd4, 0x23, 0xbf, 0xfe, 0x90, 0x1e, 0x7b,
+	0x44, 0x20, 0x2d, 0x28, 0x15, 0x12, 0xe2, 0xe8, 0x1b, 0x37, 0xb4, 0x33, 0xe3, 0x5d, 0xdb, 0x35,
+	0x51, 0x8c, 0xd4, 0x53, 0xb2, 0x9f, 0xf7, 0xe6, 0xbd, 0xcf, 0xfb, 0x31, 0xef, 0x8d, 0x61, 0x2d,
+	0x14, 0xaa, 0x2d, 0xd4, 0x5a, 0xc4, 0x94, 0x96, 0xac, 0xd1, 0xd1, 0x4c, 0xf0, 0xb5, 0xee, 0xf5,
+	0x06, 0xd5, 0xe4, 0xfa, 0x10, 0x58, 0x4b, 0xa4, 0xd0, 0x02, 0x2d, 0x59, 0xfd, 0xda, 0x90, 0xc8,
+	0xe9, 0x2f, 0x96, 0x9b, 0xa2, 0x29, 0x8c, 0xde, 0x5a, 0xf6, 0x9f, 0x3d, 0xb2, 0x58, 0x75, 0x2e,
+	0x1a, 0x44, 0xd1, 0xdc, 0x74, 0x28, 0x98, 0x33, 0x89, 0xd3, 0x12, 0x2c, 0x6d, 0x13, 0x49, 0xda,
+	0x0a, 0xed, 0xc1, 0xb3, 0xa1, 0x68, 0xb7, 0x3b, 0x9c, 0xe9, 0xfd, 0x40, 0x93, 0x47, 0x15, 0xb0,
+	0x02, 0x56, 0x4f, 0xd5, 0x6f, 0x3f, 0x4d, 0xbd, 0xa9, 0x5f, 0x52, 0xef, 0x4a, 0x93, 0xe9, 0x56,
+	0xa7, 0x51, 0x0b, 0x45, 0x7b, 0xcd, 0x19, 0xb5, 0x7f, 0xae, 0xaa, 0x68, 0x6f, 0x4d, 0xef, 0x27,
+	0x54, 0xd5, 0x6e, 0xd1, 0xb0, 0x97, 0x7a, 0xe5, 0x7d, 0xd2, 0x8e, 0xd7, 0xf1, 0x90, 0x31, 0xec,
+	0x9f, 0xc9, 0xbf, 0x77, 0xc8, 0x23, 0xf4, 0x31, 0x2c, 0x67, 0x94, 0x82, 0x44, 0x8a, 0x44, 0x28,
+	0x2a, 0x03, 0x49, 0x1f, 0x12, 0x19, 0x55, 0x8e, 0x19, 0x9f, 0x6f, 0x4f, 0xec, 0x73, 0xc9, 0xfa,
+	0x1c, 0x67, 0x13, 0xfb, 0x28, 0x83, 0xb7, 0x1d, 0xea, 0x1b, 0x10, 0x7d, 0x02, 0xe0, 0x7c, 0x43,
+	0xf0, 0x8e, 0x7a, 0x81, 0xc2, 0x71, 0x43, 0xe1, 0x9d, 0x89, 0x29, 0x2c, 0x3b, 0x0a, 0xe3, 0x8c,
+	0x62, 0x7f, 0xce, 0xe0, 0x23, 0x24, 0x76, 0xe0, 0xfc, 0x43, 0xa6, 0x5b, 0x91, 0x24, 0x0f, 0x03,
+	0x12, 0x45, 0x32, 0xa0, 0x9c, 0x34, 0x62, 0x1a, 0x55, 0xa6, 0x57, 0xc0, 0xea, 0xc9, 0xfa, 0x4a,
+	0x61, 0x75, 0xac, 0x1a, 0xf6, 0xe7, 0xfa, 0xf8, 0x46, 0x14, 0xc9, 0x2d, 0x8b, 0xa2, 0xc7, 0x00,
+	0x2e, 0x35, 0x45, 0x37, 0x20, 0x0d, 0x45, 0xb9, 0xa6, 0xd4, 0x71, 0x08, 0x12, 0xca, 0x49, 0xac,
+	0xf7, 0x2b, 0x27, 0x4c, 0x80, 0x3b, 0x13, 0x07, 0x88, 0x2d, 0x95, 0x43, 0x4c, 0x63, 0xbf, 0xd2,
+	0x14, 0xdd, 0x0d, 0x27, 0xb4, 0x41, 0x6e, 0x5b, 0x11, 0xfa, 0x10, 0xce, 0xed, 0x52, 0x1a, 0x34,
+	0x3a, 0x92, 0x07, 0x09, 0x95, 0x21, 0xe5, 0x9a, 0x34, 0x69, 0xa5, 0x64, 0xc8, 0xdc, 0x9d, 0x98,
+	0xcc, 0xa2, 0x25, 0x33, 0xc6, 0x24, 0xf6, 0x2f, 0xec, 0x52, 0x5a, 0xef, 0x48, 0xbe, 0x9d, 0x63,
+	0xe8, 0x01, 0xf4, 0xb2, 0xfe, 0x63, 0x4a, 0x31, 0xc1, 0x03, 0x49, 0x95, 0x26, 0x7b, 0x34, 0xa0,
+	0x89, 0x08, 0x5b, 0x41, 0x4c, 0x79, 0x53, 0xb7, 0x2a, 0x33, 0x2b, 0x60, 0x75, 0xba, 0xfe, 0x4a,
+	0x2f, 0xf5, 0xae, 0x14, 0x0d, 0x7c, 0xc8, 0x01, 0xec, 0x2f, 0x17, 0x1a, 0xbe, 0x55, 0xd8, 0xca,
+	0xe4, 0x77, 0x8d, 0x78, 0x7d, 0xfa, 0xab, 0x27, 0xde, 0x14, 0xfe, 0xfc, 0x18, 0x5c, 0x7c, 0x8f,
+	0xc4, 0x2c, 0x22, 0x5a, 0xc8, 0x37, 0x99, 0xd2, 0x42, 0xb2, 0x90, 0xc4, 0x36, 0x37, 0x0a, 0xfd,
+	0x08, 0xe0, 0xc5, 0xb0, 0xd3, 0xee, 0xc4, 0x44, 0xb3, 0x6e, 0x9e, 0x4e, 0x49, 0x34, 0x13, 0x15,
+	0xb0, 0x72, 0x7c, 0xf5, 0xf4, 0x8d, 0x65, 0x37, 0x25, 0x6a, 0x59, 0x13, 0xf7, 0x6f, 0x7b, 0x96,
+	0x84, 0x4d, 0xc1, 0x78, 0xfd, 0xdd, 0x2c, 0x71, 0xbd, 0xd4, 0xab, 0x3a, 0xca, 0xe3, 0x4d, 0xe1,
+	0x1f, 0x7e, 0xf3, 0x5e, 0x3d, 0x5a, 0x6a, 0x33, 0xab, 0xca, 0x9f, 0x2f, 0x0c, 0x59, 0xa6, 0x7e,
+	0x66, 0x06, 0x6d, 0xc2, 0x73, 0x92, 0xee, 0x52, 0x49, 0x79, 0x48, 0x83, 0x50, 0x74, 0xb8, 0x36,
+	0x17, 0xf6, 0x6c, 0x7d, 0xb1, 0x97, 0x7a, 0x0b, 0x96, 0xc2, 0x88, 0x02, 0xf6, 0x67, 0x73, 0x64,
+	0xd3, 0x00, 0xdf, 0x02, 0x78, 0x31, 0xcf, 0xc8, 0x66, 0x47, 0x4a, 0xca, 0x75, 0x3f, 0x1d, 0x7b,
+	0x70, 0xc6, 0xf2, 0x56, 0x47, 0x8a, 0xfe, 0x66, 0x16, 0xfd, 0xa4, 0xb1, 0xf5, 0x3d, 0xa0, 0x05,
+	0x58, 0x4a, 0xa8, 0x64, 0xc2, 0x4e, 0x9d, 0x69, 0xdf, 0x7d, 0xe1, 0xc7, 0x00, 0x56, 0x73, 0x82,
+	0x1b, 0xa1, 0x4b, 0x05, 0x8d, 0x36, 0xf3, 0x6a, 0xa3, 0x07, 0x10, 0x16, 0xb5, 0x7f, 0x79, 0x54,
+	0x07, 0x9c, 0xe0, 0xaf, 0x01, 0x5c, 0xca, 0x59, 0xdd, 0xeb, 0x68, 0xa5, 0x09, 0x8f, 0x18, 0x6f,
+	0xf6, 0x53, 0xf7, 0xd1, 0x64, 0xa9, 0xdb, 0x72, 0x8d, 0x33, 0xdb, 0xaf, 0x9a, 0x39, 0x8a, 0xff,
+	0x6b, 0x32, 0xf1, 0xf7, 0x00, 0xce, 0xe5, 0xf4, 0xee, 0xc7, 0x44, 0xb5, 0xb6, 0xba, 0x94, 0x6b,
+	0x74, 0x1b, 0x9e, 0xef, 0xf6, 0xe1, 0xc0, 0xa5, 0x1b, 0x98, 0x9b, 0xb6, 0xd4, 0x4b, 0xbd, 0x8b,
+	0xd6, 0xfb, 0xa8, 0x06, 0xf6, 0xcf, 0xe5, 0xd0, 0xb6, 0x41, 0xd0, 0x5b, 0xf0, 0xe4, 0xae, 0x24,
+	0x61, 0xb6, 0xf2, 0xdc, 0x92, 0xa8, 0x4d, 0x36, 0x33, 0xfc, 0xfc, 0x3c, 0xfe, 0x09, 0xc0, 0xf2,
+	0x18, 0xae, 0x0a, 0x7d, 0x06, 0xe0, 0x42, 0xc1, 0x45, 0x65, 0x92, 0x80, 0x1a, 0x91, 0xcb, 0xe9,
+	0xb5, 0xda, 0x21, 0x2b, 0xb8, 0x36, 0xc6, 0x66, 0xfd, 0xb2, 0xcb, 0xf3, 0xff, 0x46, 0x23, 0x1d,
+	0xb4, 0x8e, 0xfd, 0x72, 0x77, 0x0c, 0x1f, 0x37, 0x42, 0xbe, 0x01, 0x70, 0xe6, 0x36, 0xa5, 0xdb,
+	0x42, 0xc4, 0xe8, 0x4b, 0x00, 0x67, 0x8b, 0xc5, 0x9a, 0x08, 0x11, 0x1f, 0xa9, 0xda, 0x77, 0x1d,
+	0x8b, 0xf9, 0xd1, 0xd5, 0x9c, 0x59, 0x98, 0xb8, 0xe8, 0xc5, 0x3b, 0x21, 0xe3, 0x84, 0xff, 0x00,
+	0x70, 0x71, 0x73, 0x10, 0xb9, 0x9f, 0x50, 0x1e, 0xd9, 0x55, 0x47, 0x62, 0x54, 0x86, 0x27, 0x34,
+	0xd3, 0x31, 0xb5, 0xef, 0x09, 0xdf, 0x7e, 0xa0, 0x15, 0x78, 0x3a, 0xa2, 0x2a, 0x94, 0x2c, 0x29,
+	0x4a, 0xea, 0x0f, 0x42, 0x68, 0x19, 0x9e, 0x92, 0x34, 0x64, 0x09, 0xa3, 0x5c, 0xdb, 0xa5, 0xec,
+	0x17, 0x00, 0x0a, 0x61, 0x89, 0xb4, 0xcd, 0x04, 0x9a, 0x36, 0xf1, 0x5f, 0x1a, 0x1b, 0xbf, 0x09,
+	0xfe, 0x9a, 0xbb, 0x7a, 0xab, 0x47, 0x88, 0xd1, 0x06, 0xe8, 0x4c, 0xaf, 0x9f, 0xf9, 0xf4, 0x89,
+	0x37, 0x95, 0xd5, 0xe0, 0xcf, 0xac, 0x0e, 0x7f, 0x03, 0x38, 0x7f, 0x8b, 0xc6, 0xb4, 0x69, 0xca,
+	0xa4, 0x89, 0xd4, 0x8c, 0x37, 0xef, 0xf0, 0x5d, 0x33, 0x17, 0x13, 0x49, 0xbb, 0x4c, 0x64, 0x9b,
+	0x7f, 0xb0, 0xc7, 0x07, 0xe6, 0xe2, 0x88, 0x02, 0xf6, 0x67, 0xfb, 0x88, 0xeb, 0xf0, 0x1d, 0x78,
+	0xc2, 0xec, 0x10, 0xd7, 0xde, 0x6f, 0x4c, 0xbc, 0x12, 0xcf, 0x58, 0x47, 0xc6, 0x08, 0xf6, 0xad,
+	0x31, 0xb4, 0x05, 0x4b, 0x2d, 0xca, 0x9a, 0x2d, 0x9b, 0xc2, 0xe9, 0xfa, 0xd5, 0xbf, 0x52, 0xef,
+	0x5c, 0x28, 0x69, 0x36, 0xcf, 0x79, 0x60, 0x45, 0x05, 0xc9, 0x11, 0x01, 0xf6, 0xdd, 0x61, 0xfc,
+	0x2b, 0x80, 0x97, 0x5c, 0xec, 0x4c, 0xf0, 0x3c, 0x0b, 0xee, 0x1d, 0x73, 0x07, 0x5e, 0x28, 0x1a,
+	0x3b, 0x7b, 0xa1, 0x50, 0xa5, 0xdc, 0xf3, 0x71, 0xb9, 0x97, 0x7a, 0x95, 0xd1, 0xde, 0x77, 0x2a,
+	0xd8, 0x2f, 0x66, 0xc3, 0x86, 0x85, 0x10, 0x83, 0xa5, 0xfc, 0x29, 0xf8, 0x92, 0xa6, 0xaa, 0x73,
+	0xb0, 0x7e, 0xd2, 0x55, 0x17, 0xe0, 0x27, 0xc7, 0xe0, 0xe5, 0x7f, 0xef, 0xe0, 0xf7, 0x99, 0x6e,
+	0xdd, 0xa2, 0x89, 0x50, 0x4c, 0xa3, 0x2b, 0x43, 0xcd, 0x5c, 0x3f, 0x5f, 0xa4, 0xdd, 0xc0, 0xb8,
+	0xdf, 0xde, 0xaf, 0x8f, 0x69, 0xef, 0xfa, 0x42, 0x2f, 0xf5, 0x90, 0xd5, 0x1e, 0x10, 0xe2, 0xe1,
+	0xb6, 0xbf, 0xf1, 0x42, 0xdb, 0xd7, 0xcb, 0xbd, 0xd4, 0x3b, 0xdf, 0x9f, 0xd3, 0x4e, 0x84, 0x07,
+	0x2f, 0xc3, 0xff, 0x07, 0x2e, 0x43, 0x76, 0xe0, 0x42, 0x2f, 0xf5, 0xce, 0xda, 0x03, 0x16, 0xc7,
+	0xfd, 0x96, 0x46, 0xaf, 0xc1, 0x99, 0xc8, 0xc6, 0xe2, 0xde, 0x81, 0xa8, 0x58, 0x02, 0x4e, 0x80,
+	0xfd, 0xbe, 0x4a, 0x91, 0xa2, 0xfa, 0xbd, 0xef, 0x0e, 0xaa, 0xe0, 0xe9, 0x41, 0x15, 0x3c, 0x3b,
+	0xa8, 0x82, 0xdf, 0x0f, 0xaa, 0xe0, 0x8b, 0xe7, 0xd5, 0xa9, 0x67, 0xcf, 0xab, 0x53, 0x3f, 0x3f,
+	0xaf, 0x4e, 0x7d, 0x70, 0xfd, 0xd0, 0xfc, 0x3f, 0x1a, 0xfe, 0x85, 0x63, 0xca, 0xd1, 0x28, 0x99,
+	0x1f, 0x20, 0x37, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xea, 0x1b, 0xb6, 0x1e, 0x05, 0x0d, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -728,6 +755,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if !this.GovAbsenteeRewardPenalty.Equal(that1.GovAbsenteeRewardPenalty) {
+		return false
+	}
+	if !this.FeeBurnPercentage.Equal(that1.FeeBurnPercentage) {
+		return false
+	}
+	if this.CommissionRestakeEpochLength != that1.CommissionRestakeEpochLength {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1055,6 +1091,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CommissionRestakeEpochLength != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.CommissionRestakeEpochLength))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.FeeBurnPercentage.Size()
+		i -= size
+		if _, err := m.FeeBurnPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.GovAbsenteeRewardPenalty.Size()
+		i -= size
+		if _, err := m.GovAbsenteeRewardPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1597,6 +1658,13 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	l = m.GovAbsenteeRewardPenalty.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.FeeBurnPercentage.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.CommissionRestakeEpochLength != 0 {
+		n += 1 + sovDistribution(uint64(m.CommissionRestakeEpochLength))
+	}
 	return n
 }
 
@@ -1959,6 +2027,93 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovAbsenteeRewardPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GovAbsenteeRewardPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRestakeEpochLength", wireType)
+			}
+			m.CommissionRestakeEpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommissionRestakeEpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -8,9 +8,20 @@ const (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeBurnFees           = "burn_fees"
+	EventTypeSetAutoRestake     = "set_auto_restake_commission"
+	EventTypeRestakeCommission  = "restake_commission"
+	EventTypeLockupRewardBonus  = "lockup_reward_bonus"
+	EventTypeRewardAdjustment   = "reward_adjustment"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyError           = "error"
+	AttributeKeyTax             = "tax"
+	AttributeKeyBonus           = "bonus"
+	AttributeKeyAccount         = "account"
 
 	AttributeValueCategory = ModuleName
 )
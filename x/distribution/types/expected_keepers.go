@@ -25,8 +25,10 @@ type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule string, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToModuleWithPurpose(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins, purpose string) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnAccountCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error
 }
 
 // StakingKeeper expected staking keeper (noalias)
@@ -58,6 +60,13 @@ type StakingKeeper interface {
 	// MaxValidators returns the maximum amount of bonded validators
 	MaxValidators(sdk.Context) uint32
 
+	// IsGovAbsentee returns whether a validator is flagged as absent from governance
+	IsGovAbsentee(sdk.Context, sdk.ValAddress) bool
+
+	// DelegationRewardMultiplier returns the multiplier of the rewards of a
+	// delegation, above one while the delegation is locked
+	DelegationRewardMultiplier(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.Dec
+
 	IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation stakingtypes.DelegationI) (stop bool))
 
@@ -65,6 +74,13 @@ type StakingKeeper interface {
 	GetLastValidatorPower(ctx sdk.Context, valAddr sdk.ValAddress) int64
 
 	GetAllSDKDelegations(ctx sdk.Context) []stakingtypes.Delegation
+
+	// BondDenom, GetValidator and Delegate are used to restake the commission
+	// of the validators as self-bond
+	BondDenom(ctx sdk.Context) string
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int, tokenSrc stakingtypes.BondStatus,
+		validator stakingtypes.Validator, subtractAccount bool) (newShares sdk.Dec, err error)
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -77,3 +93,13 @@ type StakingHooks interface {
 	AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress)
 	BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec)
 }
+
+// RewardAdjuster applies a tax or a bonus to the rewards withdrawn from a
+// delegation (e.g. a protocol revenue share or a loyalty bonus). The tax is sent
+// to, and the bonus paid from, the module account the adjuster is set with.
+type RewardAdjuster interface {
+	// AdjustDelegationRewards returns the tax deducted from the rewards
+	// withdrawn from the delegation, which must not exceed them, and the bonus
+	// added to them. An error leaves the rewards unadjusted.
+	AdjustDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, rewards sdk.Coins) (tax, bonus sdk.Coins, err error)
+}
@@ -38,6 +38,7 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoRestakeValidators:           []string{},
 	}
 }
 
@@ -46,5 +47,10 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	for _, val := range gs.AutoRestakeValidators {
+		if _, err := sdk.ValAddressFromBech32(val); err != nil {
+			return err
+		}
+	}
 	return gs.FeePool.ValidateGenesis()
 }
@@ -42,6 +42,8 @@ const (
 // - 0x07<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCurrentCommission
 //
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<valAddrLen (1 Byte)><valAddr_Bytes>: []byte{0x01}
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -53,6 +55,7 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	ValidatorAutoRestakePrefix           = []byte{0x09} // key for the validators restaking their commission automatically
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -137,6 +140,20 @@ func GetValidatorAccumulatedCommissionAddress(key []byte) (valAddr sdk.ValAddres
 	return sdk.ValAddress(addr)
 }
 
+// GetValidatorAutoRestakeAddress creates the address from a validator's auto
+// restake key.
+func GetValidatorAutoRestakeAddress(key []byte) (valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0x09<valAddrLen (1 Byte)><valAddr_Bytes>
+
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+	kv.AssertKeyLength(addr, int(key[1]))
+
+	return sdk.ValAddress(addr)
+}
+
 // GetValidatorSlashEventAddressHeight creates the height from a validator's slash event key.
 func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, height uint64) {
 	// key is in the format:
@@ -213,3 +230,9 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetValidatorAutoRestakeKey creates the key for a validator restaking its
+// commission automatically.
+func GetValidatorAutoRestakeKey(v sdk.ValAddress) []byte {
+	return append(ValidatorAutoRestakePrefix, address.MustLengthPrefix(v.Bytes())...)
+}
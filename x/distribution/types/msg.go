@@ -9,12 +9,18 @@ import (
 const (
 	TypeMsgSetWithdrawAddress          = "set_withdraw_address"
 	TypeMsgWithdrawDelegatorReward     = "withdraw_delegator_reward"
+	TypeMsgWithdrawAllDelegatorRewards = "withdraw_all_delegator_rewards"
 	TypeMsgWithdrawValidatorCommission = "withdraw_validator_commission"
 	TypeMsgFundCommunityPool           = "fund_community_pool"
+	TypeMsgSetAutoRestakeCommission    = "set_auto_restake_commission"
 )
 
+// MaxWithdrawAllDelegatorRewardsLimit is the maximum number of validators
+// rewards can be withdrawn from by a single MsgWithdrawAllDelegatorRewards.
+const MaxWithdrawAllDelegatorRewardsLimit = 50
+
 // Verify interface at compile time
-var _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
+var _, _, _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawAllDelegatorRewards{}, &MsgWithdrawValidatorCommission{}, &MsgSetAutoRestakeCommission{}
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
 	return &MsgSetWithdrawAddress{
@@ -83,6 +89,52 @@ func (msg MsgWithdrawDelegatorReward) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgWithdrawAllDelegatorRewards returns a new MsgWithdrawAllDelegatorRewards
+// withdrawing the rewards of at most limit validators, starting with
+// startValAddr. An empty startValAddr starts with the first validator.
+func NewMsgWithdrawAllDelegatorRewards(delAddr sdk.AccAddress, startValAddr sdk.ValAddress, limit uint32) *MsgWithdrawAllDelegatorRewards {
+	msg := &MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress: delAddr.String(),
+		Limit:            limit,
+	}
+	if !startValAddr.Empty() {
+		msg.StartValidatorAddress = startValAddr.String()
+	}
+
+	return msg
+}
+
+func (msg MsgWithdrawAllDelegatorRewards) Route() string { return ModuleName }
+func (msg MsgWithdrawAllDelegatorRewards) Type() string  { return TypeMsgWithdrawAllDelegatorRewards }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgWithdrawAllDelegatorRewards) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgWithdrawAllDelegatorRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgWithdrawAllDelegatorRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if msg.StartValidatorAddress != "" {
+		if _, err := sdk.ValAddressFromBech32(msg.StartValidatorAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid start validator address: %s", err)
+		}
+	}
+	if msg.Limit > MaxWithdrawAllDelegatorRewardsLimit {
+		return ErrInvalidWithdrawLimit.Wrapf("limit %d exceeds maximum %d", msg.Limit, MaxWithdrawAllDelegatorRewardsLimit)
+	}
+	return nil
+}
+
 func NewMsgWithdrawValidatorCommission(valAddr sdk.ValAddress) *MsgWithdrawValidatorCommission {
 	return &MsgWithdrawValidatorCommission{
 		ValidatorAddress: valAddr.String(),
@@ -112,6 +164,36 @@ func (msg MsgWithdrawValidatorCommission) ValidateBasic() error {
 	return nil
 }
 
+func NewMsgSetAutoRestakeCommission(valAddr sdk.ValAddress, enabled bool) *MsgSetAutoRestakeCommission {
+	return &MsgSetAutoRestakeCommission{
+		ValidatorAddress: valAddr.String(),
+		Enabled:          enabled,
+	}
+}
+
+func (msg MsgSetAutoRestakeCommission) Route() string { return ModuleName }
+func (msg MsgSetAutoRestakeCommission) Type() string  { return TypeMsgSetAutoRestakeCommission }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSetAutoRestakeCommission) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetAutoRestakeCommission) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgSetAutoRestakeCommission) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	return nil
+}
+
 // NewMsgFundCommunityPool returns a new MsgFundCommunityPool with a sender and
 // a funding amount.
 func NewMsgFundCommunityPool(amount sdk.Coins, depositor sdk.AccAddress) *MsgFundCommunityPool {
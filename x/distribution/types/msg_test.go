@@ -54,6 +54,30 @@ func TestMsgWithdrawDelegatorReward(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgWithdrawAllDelegatorRewards
+func TestMsgWithdrawAllDelegatorRewards(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		startValAddr  sdk.ValAddress
+		limit         uint32
+		expectPass    bool
+	}{
+		{delAddr1, valAddr1, 10, true},
+		{delAddr1, emptyValAddr, 0, true},
+		{delAddr1, emptyValAddr, MaxWithdrawAllDelegatorRewardsLimit, true},
+		{delAddr1, valAddr1, MaxWithdrawAllDelegatorRewardsLimit + 1, false},
+		{emptyDelAddr, valAddr1, 10, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgWithdrawAllDelegatorRewards(tc.delegatorAddr, tc.startValAddr, tc.limit)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgWithdrawValidatorCommission
 func TestMsgWithdrawValidatorCommission(t *testing.T) {
 	tests := []struct {
@@ -93,3 +117,24 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgSetAutoRestakeCommission
+func TestMsgSetAutoRestakeCommission(t *testing.T) {
+	tests := []struct {
+		validatorAddr sdk.ValAddress
+		enabled       bool
+		expectPass    bool
+	}{
+		{valAddr1, true, true},
+		{valAddr1, false, true},
+		{emptyValAddr, true, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetAutoRestakeCommission(tc.validatorAddr, tc.enabled)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -15,6 +15,11 @@ var (
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+
+	ParamStoreKeyGovAbsenteeRewardPenalty = []byte("govabsenteerewardpenalty")
+	ParamStoreKeyFeeBurnPercentage        = []byte("feeburnpercentage")
+
+	ParamStoreKeyCommissionRestakeEpochLength = []byte("commissionrestakeepochlength")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -29,6 +34,12 @@ func DefaultParams() Params {
 		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled: true,
+		// validators absent from governance are only flagged by default
+		GovAbsenteeRewardPenalty: sdk.ZeroDec(),
+		// all the collected fees are distributed by default
+		FeeBurnPercentage: sdk.ZeroDec(),
+		// the validators which opted in restake their commission every 100 blocks
+		CommissionRestakeEpochLength: 100,
 	}
 }
 
@@ -44,6 +55,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyGovAbsenteeRewardPenalty, &p.GovAbsenteeRewardPenalty, validateGovAbsenteeRewardPenalty),
+		paramtypes.NewParamSetPair(ParamStoreKeyFeeBurnPercentage, &p.FeeBurnPercentage, validateFeeBurnPercentage),
+		paramtypes.NewParamSetPair(ParamStoreKeyCommissionRestakeEpochLength, &p.CommissionRestakeEpochLength, validateCommissionRestakeEpochLength),
 	}
 }
 
@@ -69,6 +83,16 @@ func (p Params) ValidateBasic() error {
 			"sum of base, bonus proposer rewards, and community tax cannot be greater than one: %s", v,
 		)
 	}
+	if p.GovAbsenteeRewardPenalty.IsNil() || p.GovAbsenteeRewardPenalty.IsNegative() || p.GovAbsenteeRewardPenalty.GT(sdk.OneDec()) {
+		return fmt.Errorf(
+			"gov absentee reward penalty should be non-negative and less than one: %s", p.GovAbsenteeRewardPenalty,
+		)
+	}
+	if p.FeeBurnPercentage.IsNil() || p.FeeBurnPercentage.IsNegative() || p.FeeBurnPercentage.GT(sdk.OneDec()) {
+		return fmt.Errorf(
+			"fee burn percentage should be non-negative and less than one: %s", p.FeeBurnPercentage,
+		)
+	}
 
 	return nil
 }
@@ -138,3 +162,50 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateGovAbsenteeRewardPenalty(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("gov absentee reward penalty must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("gov absentee reward penalty must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("gov absentee reward penalty too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateFeeBurnPercentage(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("fee burn percentage must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("fee burn percentage must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee burn percentage too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateCommissionRestakeEpochLength(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
@@ -40,6 +42,110 @@ $ %s query %s --page=2 --limit=50
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "evidence")
 
+	cmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdExportPrunableEvidence(),
+	)
+
+	return cmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current evidence parameters",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdExportPrunableEvidence implements the command exporting the evidence
+// old enough to be pruned to a file.
+func GetCmdExportPrunableEvidence() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-prunable [output-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Export the evidence old enough to be pruned to a JSON file",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export all the evidence whose infraction is old enough under the retention
+parameters for the evidence to be pruned, so that it can be archived before it
+is deleted. The evidence is written to the output file in JSON, in the order in
+which it is pruned, along with the prune height it was queried at.
+
+Example:
+$ %s query %s export-prunable evidence-archive.json
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			// pin all the queries to the same committed version
+			if clientCtx.Height == 0 {
+				height, err := rpc.GetChainHeight(clientCtx)
+				if err != nil {
+					return err
+				}
+				clientCtx = clientCtx.WithHeight(height)
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			export := &types.QueryPrunableEvidenceResponse{}
+			pageReq := &query.PageRequest{}
+			for {
+				res, err := queryClient.PrunableEvidence(
+					cmd.Context(),
+					&types.QueryPrunableEvidenceRequest{Pagination: pageReq},
+				)
+				if err != nil {
+					return err
+				}
+
+				export.Evidence = append(export.Evidence, res.Evidence...)
+				export.PruneHeight = res.PruneHeight
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+			}
+
+			bz, err := clientCtx.Codec.MarshalJSON(export)
+			if err != nil {
+				return err
+			}
+
+			if err := ioutil.WriteFile(args[0], bz, 0o600); err != nil {
+				return err
+			}
+
+			cmd.Printf("exported %d evidence prunable at height %d to %s\n", len(export.Evidence), export.PruneHeight, args[0])
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
 	return cmd
 }
 
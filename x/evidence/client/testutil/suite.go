@@ -1,13 +1,18 @@
 package testutil
 
 import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"strings"
 
 	"github.com/stretchr/testify/suite"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
 
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	"github.com/cosmos/cosmos-sdk/x/evidence/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
 type IntegrationTestSuite struct {
@@ -75,3 +80,27 @@ func (s *IntegrationTestSuite) TestGetQueryCmd() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
+	val := s.network.Validators[0]
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryParams(), []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+	s.Require().JSONEq(`{"retention_blocks":"0","max_pruned_per_block":"100"}`, out.String())
+}
+
+func (s *IntegrationTestSuite) TestGetCmdExportPrunableEvidence() {
+	val := s.network.Validators[0]
+	outputFile := filepath.Join(s.T().TempDir(), "evidence.json")
+
+	_, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdExportPrunableEvidence(), []string{outputFile})
+	s.Require().NoError(err)
+
+	bz, err := ioutil.ReadFile(outputFile)
+	s.Require().NoError(err)
+
+	var export types.QueryPrunableEvidenceResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(bz, &export))
+	s.Require().Empty(export.Evidence)
+	s.Require().Zero(export.PruneHeight)
+}
@@ -86,3 +86,60 @@ func (k Keeper) AllEvidence(c context.Context, req *types.QueryAllEvidenceReques
 
 	return &types.QueryAllEvidenceResponse{Evidence: evidence, Pagination: pageRes}, nil
 }
+
+// PrunableEvidence implements the Query/PrunableEvidence gRPC method
+func (k Keeper) PrunableEvidence(c context.Context, req *types.QueryPrunableEvidenceRequest) (*types.QueryPrunableEvidenceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pruneHeight := k.PruneHeight(ctx)
+
+	var evidence []*codectypes.Any
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.KeyPrefixEvidenceByHeight)
+
+	pageRes, err := query.FilteredPaginate(indexStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		height, hash := types.SplitEvidenceByHeightKey(append(types.KeyPrefixEvidenceByHeight, key...))
+		if pruneHeight == 0 || height > pruneHeight {
+			return false, nil
+		}
+
+		if accumulate {
+			result, found := k.GetEvidence(ctx, hash)
+			if !found {
+				return false, status.Errorf(codes.Internal, "evidence %X indexed by height not found", hash)
+			}
+
+			msg, ok := result.(proto.Message)
+			if !ok {
+				return false, status.Errorf(codes.Internal, "can't protomarshal %T", msg)
+			}
+
+			evidenceAny, err := codectypes.NewAnyWithValue(msg)
+			if err != nil {
+				return false, err
+			}
+			evidence = append(evidence, evidenceAny)
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return &types.QueryPrunableEvidenceResponse{}, err
+	}
+
+	return &types.QueryPrunableEvidenceResponse{Evidence: evidence, PruneHeight: pruneHeight, Pagination: pageRes}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
@@ -70,6 +70,14 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		return
 	}
 
+	// The validator may have rotated its consensus key since the infraction.
+	// The signing info of its current consensus key is authoritative, so that
+	// the validator is punished once no matter which key it equivocated with.
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		panic(err)
+	}
+
 	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
 		panic(fmt.Sprintf("expected signing info for validator %s but not found", consAddr))
 	}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -75,6 +76,53 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign() {
 	suite.Len(evidences, 1)
 }
 
+func (suite *KeeperTestSuite) TestHandleDoubleSign_RotatedConsPubKey() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1)
+	suite.populateValidators(ctx)
+
+	power := int64(100)
+	operatorAddr, val := valAddresses[0], pubkeys[0]
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, val, power, true)
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+
+	// handle a signature to set signing info
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), selfDelegation.Int64(), true)
+
+	// rotate the consensus key of the validator
+	newPk := ed25519.GenPrivKey().PubKey()
+	validator, _ := suite.app.StakingKeeper.GetValidator(ctx, operatorAddr)
+	suite.Require().NoError(suite.app.StakingKeeper.RotateConsPubKey(ctx, validator, newPk))
+
+	// double sign with the old key
+	oldTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	evidence := &types.Equivocation{
+		Height:           0,
+		Time:             time.Unix(0, 0),
+		Power:            power,
+		ConsensusAddress: sdk.ConsAddress(val.Address()).String(),
+	}
+	suite.app.EvidenceKeeper.HandleEquivocationEvidence(ctx, evidence)
+
+	// the validator is jailed and its current key is tombstoned
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(newPk.Address())))
+
+	newTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	suite.True(newTokens.LT(oldTokens))
+
+	// evidence of the same validator with the new key is ignored
+	evidence = &types.Equivocation{
+		Height:           1,
+		Time:             time.Unix(1, 0),
+		Power:            power,
+		ConsensusAddress: sdk.ConsAddress(newPk.Address()).String(),
+	}
+	suite.app.EvidenceKeeper.HandleEquivocationEvidence(ctx, evidence)
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens().Equal(newTokens))
+}
+
 func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
 	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Now())
 	suite.populateValidators(ctx)
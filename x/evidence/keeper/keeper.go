@@ -12,6 +12,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Keeper defines the evidence module's keeper. The keeper is responsible for
@@ -20,19 +21,25 @@ import (
 type Keeper struct {
 	cdc            codec.BinaryCodec
 	storeKey       sdk.StoreKey
+	paramSpace     paramtypes.Subspace
 	router         types.Router
 	stakingKeeper  types.StakingKeeper
 	slashingKeeper types.SlashingKeeper
 }
 
 func NewKeeper(
-	cdc codec.BinaryCodec, storeKey sdk.StoreKey, stakingKeeper types.StakingKeeper,
-	slashingKeeper types.SlashingKeeper,
+	cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace,
+	stakingKeeper types.StakingKeeper, slashingKeeper types.SlashingKeeper,
 ) *Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
 
 	return &Keeper{
 		cdc:            cdc,
 		storeKey:       storeKey,
+		paramSpace:     paramSpace,
 		stakingKeeper:  stakingKeeper,
 		slashingKeeper: slashingKeeper,
 	}
@@ -99,10 +106,20 @@ func (k Keeper) SubmitEvidence(ctx sdk.Context, evidence exported.Evidence) erro
 	return nil
 }
 
-// SetEvidence sets Evidence by hash in the module's KVStore.
+// SetEvidence sets Evidence by hash in the module's KVStore, and indexes it by
+// infraction height for pruning.
 func (k Keeper) SetEvidence(ctx sdk.Context, evidence exported.Evidence) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEvidence)
-	store.Set(evidence.Hash(), k.MustMarshalEvidence(evidence))
+	store := ctx.KVStore(k.storeKey)
+	prefix.NewStore(store, types.KeyPrefixEvidence).Set(evidence.Hash(), k.MustMarshalEvidence(evidence))
+	store.Set(types.EvidenceByHeightKey(evidence.GetHeight(), evidence.Hash()), []byte{})
+}
+
+// DeleteEvidence deletes Evidence and its entry in the index by infraction
+// height from the module's KVStore.
+func (k Keeper) DeleteEvidence(ctx sdk.Context, evidence exported.Evidence) {
+	store := ctx.KVStore(k.storeKey)
+	prefix.NewStore(store, types.KeyPrefixEvidence).Delete(evidence.Hash())
+	store.Delete(types.EvidenceByHeightKey(evidence.GetHeight(), evidence.Hash()))
 }
 
 // GetEvidence retrieves Evidence by hash if it exists. If no Evidence exists for
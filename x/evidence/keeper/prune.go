@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+// PruneHeight returns the height at or below which the infractions of evidence
+// are old enough for the evidence to be pruned, or zero if no evidence is
+// pruned. Evidence is kept for the RetentionBlocks param, and at least for the
+// MaxAgeNumBlocks consensus evidence param so that it is not handled twice.
+func (k Keeper) PruneHeight(ctx sdk.Context) int64 {
+	retention := k.GetParams(ctx).RetentionBlocks
+	if retention == 0 {
+		return 0
+	}
+
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Evidence != nil && cp.Evidence.MaxAgeNumBlocks > 0 {
+		if maxAge := uint64(cp.Evidence.MaxAgeNumBlocks); maxAge > retention {
+			retention = maxAge
+		}
+	}
+
+	if retention >= uint64(ctx.BlockHeight()) {
+		return 0
+	}
+
+	return ctx.BlockHeight() - int64(retention)
+}
+
+// PruneEvidence deletes the evidence whose infraction is at or below the prune
+// height, by increasing infraction height and up to the MaxPrunedPerBlock
+// param, so that the evidence accumulated before the RetentionBlocks param is
+// set is pruned over several blocks. It returns the number of pruned evidence.
+func (k Keeper) PruneEvidence(ctx sdk.Context) (pruned int) {
+	pruneHeight := k.PruneHeight(ctx)
+	if pruneHeight == 0 {
+		return 0
+	}
+
+	var hashes [][]byte
+	maxPruned := k.GetParams(ctx).MaxPrunedPerBlock
+	k.IteratePrunableEvidenceHashes(ctx, pruneHeight, func(hash []byte) bool {
+		hashes = append(hashes, append([]byte{}, hash...))
+		return uint64(len(hashes)) >= maxPruned
+	})
+
+	for _, hash := range hashes {
+		evidence, found := k.GetEvidence(ctx, hash)
+		if !found {
+			panic("evidence indexed by height not found")
+		}
+		k.DeleteEvidence(ctx, evidence)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePruneEvidence,
+				sdk.NewAttribute(types.AttributeKeyEvidenceHash, evidence.Hash().String()),
+			),
+		)
+	}
+
+	return len(hashes)
+}
+
+// IteratePrunableEvidenceHashes iterates over the hashes of the evidence whose
+// infraction is at or below the given height, by increasing infraction
+// height. If the cb returns true, the iterator will close and stop.
+func (k Keeper) IteratePrunableEvidenceHashes(ctx sdk.Context, pruneHeight int64, cb func(hash []byte) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixEvidenceByHeight, types.EvidenceByHeightKey(pruneHeight+1, nil))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, hash := types.SplitEvidenceByHeightKey(iterator.Key())
+
+		if cb(hash) {
+			break
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+func (suite *KeeperTestSuite) setEvidenceAtHeights(ctx sdk.Context, heights ...int64) []exported.Evidence {
+	evidence := make([]exported.Evidence, len(heights))
+
+	for i, height := range heights {
+		pk := ed25519.GenPrivKey()
+
+		evidence[i] = &types.Equivocation{
+			Height:           height,
+			Power:            100,
+			Time:             time.Now().UTC(),
+			ConsensusAddress: sdk.ConsAddress(pk.PubKey().Address().Bytes()).String(),
+		}
+		suite.app.EvidenceKeeper.SetEvidence(ctx, evidence[i])
+	}
+
+	return evidence
+}
+
+func (suite *KeeperTestSuite) TestPruneHeight() {
+	testCases := []struct {
+		msg       string
+		retention uint64
+		maxAge    int64
+		height    int64
+		expected  int64
+	}{
+		{"pruning disabled", 0, 0, 100, 0},
+		{"retention", 10, 0, 100, 90},
+		{"max age longer than retention", 10, 50, 100, 50},
+		{"retention longer than max age", 60, 50, 100, 40},
+		{"retention longer than chain", 100, 0, 100, 0},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+
+			ctx := suite.ctx.WithBlockHeight(tc.height).WithConsensusParams(&abci.ConsensusParams{
+				Evidence: &tmproto.EvidenceParams{MaxAgeNumBlocks: tc.maxAge},
+			})
+			suite.app.EvidenceKeeper.SetParams(ctx, types.NewParams(tc.retention, types.DefaultMaxPrunedPerBlock))
+
+			suite.Require().Equal(tc.expected, suite.app.EvidenceKeeper.PruneHeight(ctx))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneEvidence() {
+	ctx := suite.ctx.WithBlockHeight(100)
+	evidence := suite.setEvidenceAtHeights(ctx, 95, 10, 30, 20, 91)
+
+	// pruning is disabled by default
+	suite.Require().Zero(suite.app.EvidenceKeeper.PruneEvidence(ctx))
+	suite.Require().Len(suite.app.EvidenceKeeper.GetAllEvidence(ctx), 5)
+
+	suite.app.EvidenceKeeper.SetParams(ctx, types.NewParams(10, 2))
+
+	// the oldest evidence is pruned first, up to the max pruned per block
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().Equal(2, suite.app.EvidenceKeeper.PruneEvidence(ctx))
+	suite.Require().Len(ctx.EventManager().Events(), 2)
+	for _, e := range []exported.Evidence{evidence[1], evidence[3]} {
+		_, found := suite.app.EvidenceKeeper.GetEvidence(ctx, e.Hash())
+		suite.Require().False(found)
+	}
+
+	suite.Require().Equal(1, suite.app.EvidenceKeeper.PruneEvidence(ctx))
+	_, found := suite.app.EvidenceKeeper.GetEvidence(ctx, evidence[2].Hash())
+	suite.Require().False(found)
+
+	// the evidence within the retention period is kept
+	suite.Require().Zero(suite.app.EvidenceKeeper.PruneEvidence(ctx))
+	suite.Require().ElementsMatch(
+		[]exported.Evidence{evidence[0], evidence[4]},
+		suite.app.EvidenceKeeper.GetAllEvidence(ctx),
+	)
+
+	suite.Require().Equal(1, suite.app.EvidenceKeeper.PruneEvidence(ctx.WithBlockHeight(101)))
+	suite.Require().Equal(1, suite.app.EvidenceKeeper.PruneEvidence(ctx.WithBlockHeight(106)))
+	suite.Require().Empty(suite.app.EvidenceKeeper.GetAllEvidence(ctx))
+}
+
+func (suite *KeeperTestSuite) TestQueryPrunableEvidence() {
+	ctx := suite.ctx.WithBlockHeight(100)
+	evidence := suite.setEvidenceAtHeights(ctx, 95, 10, 30, 20, 90)
+	suite.app.EvidenceKeeper.SetParams(ctx, types.NewParams(20, types.DefaultMaxPrunedPerBlock))
+	suite.queryClient = suite.newQueryClient(ctx)
+
+	res, err := suite.queryClient.PrunableEvidence(sdk.WrapSDKContext(ctx), &types.QueryPrunableEvidenceRequest{
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(80), res.PruneHeight)
+	suite.Require().Len(res.Evidence, 2)
+	suite.Require().NotNil(res.Pagination.NextKey)
+	for i, e := range []exported.Evidence{evidence[1], evidence[3]} {
+		var evi exported.Evidence
+		suite.Require().NoError(suite.app.InterfaceRegistry().UnpackAny(res.Evidence[i], &evi))
+		suite.Require().Equal(e.Hash(), evi.Hash())
+	}
+
+	res, err = suite.queryClient.PrunableEvidence(sdk.WrapSDKContext(ctx), &types.QueryPrunableEvidenceRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Evidence, 1)
+	var evi exported.Evidence
+	suite.Require().NoError(suite.app.InterfaceRegistry().UnpackAny(res.Evidence[0], &evi))
+	suite.Require().Equal(evidence[2].Hash(), evi.Hash())
+	suite.Require().Nil(res.Pagination.NextKey)
+}
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	params := types.NewParams(20, 10)
+	suite.app.EvidenceKeeper.SetParams(suite.ctx, params)
+
+	res, err := suite.queryClient.Params(sdk.WrapSDKContext(suite.ctx), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, res.Params)
+}
@@ -0,0 +1,38 @@
+package v044
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.44. The
+// migration includes:
+//
+// - Index the stored evidence by infraction height, for pruning.
+// - Set the evidence params to their default values, which keep evidence
+//   forever.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec, paramSpace paramtypes.Subspace) error {
+	store := ctx.KVStore(storeKey)
+	evidenceStore := prefix.NewStore(store, types.KeyPrefixEvidence)
+
+	iterator := evidenceStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var evidence exported.Evidence
+		if err := cdc.UnmarshalInterface(iterator.Value(), &evidence); err != nil {
+			return err
+		}
+
+		store.Set(types.EvidenceByHeightKey(evidence.GetHeight(), iterator.Key()), []byte{})
+	}
+
+	params := types.DefaultParams()
+	paramSpace.SetParamSet(ctx, &params)
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package v044_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v044 "github.com/cosmos/cosmos-sdk/x/evidence/migrations/v044"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 100})
+	storeKey := app.GetKey(types.StoreKey)
+	store := ctx.KVStore(storeKey)
+
+	// evidence stored before it was indexed by height
+	var evidence []*types.Equivocation
+	for _, height := range []int64{30, 10, 20} {
+		e := &types.Equivocation{
+			Height:           height,
+			Power:            100,
+			Time:             time.Now().UTC(),
+			ConsensusAddress: sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+		}
+		bz, err := app.AppCodec().MarshalInterface(e)
+		require.NoError(t, err)
+		store.Set(append(types.KeyPrefixEvidence, e.Hash()...), bz)
+		evidence = append(evidence, e)
+	}
+
+	require.NoError(t, v044.MigrateStore(ctx, storeKey, app.AppCodec(), app.GetSubspace(types.ModuleName)))
+	require.Equal(t, types.DefaultParams(), app.EvidenceKeeper.GetParams(ctx))
+
+	var hashes [][]byte
+	app.EvidenceKeeper.IteratePrunableEvidenceHashes(ctx, 20, func(hash []byte) bool {
+		hashes = append(hashes, hash)
+		return false
+	})
+	require.Equal(t, [][]byte{evidence[1].Hash(), evidence[2].Hash()}, hashes)
+}
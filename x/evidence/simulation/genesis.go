@@ -14,13 +14,27 @@ import (
 )
 
 // Simulation parameter constants
-const evidence = "evidence"
+const (
+	evidence          = "evidence"
+	retentionBlocks   = "retention_blocks"
+	maxPrunedPerBlock = "max_pruned_per_block"
+)
 
 // GenEvidences returns an empty slice of evidences.
 func GenEvidences(_ *rand.Rand, _ []simtypes.Account) []exported.Evidence {
 	return []exported.Evidence{}
 }
 
+// GenRetentionBlocks randomized RetentionBlocks
+func GenRetentionBlocks(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 0, 1000))
+}
+
+// GenMaxPrunedPerBlock randomized MaxPrunedPerBlock
+func GenMaxPrunedPerBlock(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 100))
+}
+
 // RandomizedGenState generates a random GenesisState for evidence
 func RandomizedGenState(simState *module.SimulationState) {
 	var ev []exported.Evidence
@@ -30,7 +44,19 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { ev = GenEvidences(r, simState.Accounts) },
 	)
 
-	evidenceGenesis := types.NewGenesisState(ev)
+	var retention uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, retentionBlocks, &retention, simState.Rand,
+		func(r *rand.Rand) { retention = GenRetentionBlocks(r) },
+	)
+
+	var maxPruned uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxPrunedPerBlock, &maxPruned, simState.Rand,
+		func(r *rand.Rand) { maxPruned = GenMaxPrunedPerBlock(r) },
+	)
+
+	evidenceGenesis := types.NewGenesisState(types.NewParams(retention, maxPruned), ev)
 
 	bz, err := json.MarshalIndent(&evidenceGenesis, "", " ")
 	if err != nil {
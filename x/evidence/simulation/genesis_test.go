@@ -40,4 +40,7 @@ func TestRandomizedGenState(t *testing.T) {
 	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &evidenceGenesis)
 
 	require.Len(t, evidenceGenesis.Evidence, 0)
+	require.NoError(t, evidenceGenesis.Params.Validate())
+	require.Equal(t, uint64(540), evidenceGenesis.Params.RetentionBlocks)
+	require.Equal(t, uint64(95), evidenceGenesis.Params.MaxPrunedPerBlock)
 }
@@ -3,6 +3,7 @@ package types
 // evidence module events
 const (
 	EventTypeSubmitEvidence = "submit_evidence"
+	EventTypePruneEvidence  = "prune_evidence"
 
 	AttributeValueCategory   = "evidence"
 	AttributeKeyEvidenceHash = "evidence_hash"
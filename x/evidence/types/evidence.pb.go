@@ -68,8 +68,67 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// Params defines the parameters for the evidence module.
+type Params struct {
+	// retention_blocks is the number of blocks processed evidence is kept for
+	// after the height of the infraction, before being pruned. Evidence is never
+	// pruned before it is too old to be handled under the consensus evidence
+	// parameters. Zero keeps evidence forever.
+	RetentionBlocks uint64 `protobuf:"varint,1,opt,name=retention_blocks,json=retentionBlocks,proto3" json:"retention_blocks,omitempty" yaml:"retention_blocks"`
+	// max_pruned_per_block is the maximum number of evidence pruned in a block.
+	MaxPrunedPerBlock uint64 `protobuf:"varint,2,opt,name=max_pruned_per_block,json=maxPrunedPerBlock,proto3" json:"max_pruned_per_block,omitempty" yaml:"max_pruned_per_block"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRetentionBlocks() uint64 {
+	if m != nil {
+		return m.RetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPrunedPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunedPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*Params)(nil), "cosmos.evidence.v1beta1.Params")
 }
 
 func init() {
@@ -77,30 +136,62 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x3f, 0x4f, 0x02, 0x31,
-	0x18, 0xc6, 0x5b, 0x41, 0xa2, 0x27, 0x83, 0x5e, 0x88, 0x5e, 0x88, 0x69, 0x09, 0x83, 0x61, 0xe1,
-	0x1a, 0x74, 0x31, 0x6c, 0x92, 0x38, 0x18, 0x37, 0xe2, 0xe4, 0x62, 0xee, 0x4f, 0x2d, 0x8d, 0xdc,
-	0xbd, 0x27, 0xed, 0xa1, 0x7c, 0x03, 0x47, 0x46, 0x47, 0x46, 0x3f, 0x0a, 0x9b, 0x8c, 0x4e, 0x68,
-	0x8e, 0xc5, 0xd9, 0x4f, 0x60, 0xb8, 0x02, 0x0e, 0x4e, 0xed, 0xf3, 0xe4, 0xf7, 0xfe, 0x92, 0xf7,
-	0xb5, 0x4e, 0x02, 0x50, 0x11, 0x28, 0xc6, 0x87, 0x32, 0xe4, 0x71, 0xc0, 0xd9, 0xb0, 0xe5, 0x73,
-	0xed, 0xb5, 0x36, 0x85, 0x9b, 0x0c, 0x40, 0x83, 0x7d, 0x64, 0x38, 0x77, 0x53, 0xaf, 0xb8, 0x6a,
-	0x45, 0x80, 0x80, 0x9c, 0x61, 0xcb, 0x9f, 0xc1, 0xab, 0x54, 0x00, 0x88, 0x3e, 0x67, 0x79, 0xf2,
-	0xd3, 0x7b, 0xa6, 0x65, 0xc4, 0x95, 0xf6, 0xa2, 0xc4, 0x00, 0xf5, 0x77, 0x6c, 0x95, 0x2f, 0x1f,
-	0x53, 0x39, 0x84, 0xc0, 0xd3, 0x12, 0x62, 0xfb, 0xd0, 0x2a, 0xf5, 0xb8, 0x14, 0x3d, 0xed, 0xe0,
-	0x1a, 0x6e, 0x14, 0xba, 0xab, 0x64, 0x9f, 0x5b, 0xc5, 0xe5, 0xac, 0xb3, 0x55, 0xc3, 0x8d, 0xbd,
-	0xd3, 0xaa, 0x6b, 0xc4, 0xee, 0x5a, 0xec, 0xde, 0xac, 0xc5, 0x9d, 0x9d, 0xe9, 0x9c, 0xa2, 0xf1,
-	0x27, 0xc5, 0xdd, 0x7c, 0xc2, 0xae, 0x58, 0xdb, 0x09, 0x3c, 0xf1, 0x81, 0x53, 0xc8, 0x85, 0x26,
-	0xd8, 0x57, 0xd6, 0x41, 0x00, 0xb1, 0xe2, 0xb1, 0x4a, 0xd5, 0x9d, 0x17, 0x86, 0x03, 0xae, 0x94,
-	0x53, 0xac, 0xe1, 0xc6, 0x6e, 0xe7, 0xf8, 0x67, 0x4e, 0x9d, 0x91, 0x17, 0xf5, 0xdb, 0xf5, 0x7f,
-	0x48, 0xbd, 0xbb, 0xbf, 0xe9, 0x2e, 0x4c, 0xd5, 0x2e, 0xbf, 0x4c, 0x28, 0x7a, 0x9d, 0x50, 0xf4,
-	0x3d, 0xa1, 0xa8, 0x73, 0xfd, 0x96, 0x11, 0x3c, 0xcd, 0x08, 0x9e, 0x65, 0x04, 0x7f, 0x65, 0x04,
-	0x8f, 0x17, 0x04, 0xcd, 0x16, 0x04, 0x7d, 0x2c, 0x08, 0xba, 0x6d, 0x0a, 0xa9, 0x7b, 0xa9, 0xef,
-	0x06, 0x10, 0xb1, 0xd5, 0xc9, 0xcd, 0xd3, 0x54, 0xe1, 0x03, 0x7b, 0xfe, 0xbb, 0xbf, 0x1e, 0x25,
-	0x5c, 0xf9, 0xa5, 0x7c, 0xbf, 0xb3, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x61, 0x33, 0x3a, 0x69,
-	0x9f, 0x01, 0x00, 0x00,
+	// 412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x34, 0x44, 0x70, 0x54, 0xa2, 0xb5, 0x22, 0x6a, 0xa5, 0xc8, 0x17, 0x79, 0x40,
+	0x59, 0x6a, 0xab, 0xb0, 0xa0, 0x6e, 0x58, 0x02, 0x09, 0xb1, 0x58, 0x16, 0x13, 0x8b, 0x75, 0xb6,
+	0x1f, 0x8e, 0xd5, 0x9c, 0xcf, 0xdc, 0x9d, 0x43, 0xfa, 0x0d, 0x18, 0x3b, 0x32, 0x46, 0x4c, 0x7c,
+	0x94, 0x6e, 0x74, 0x64, 0x32, 0xc8, 0x59, 0x98, 0xf3, 0x09, 0x50, 0xee, 0x12, 0x23, 0x01, 0x93,
+	0xfd, 0x7e, 0xfa, 0xbd, 0xff, 0xe9, 0xdd, 0x3d, 0xfc, 0x24, 0xe3, 0x92, 0x71, 0x19, 0xc0, 0xa2,
+	0xcc, 0xa1, 0xca, 0x20, 0x58, 0x9c, 0xa7, 0xa0, 0xe8, 0x79, 0x0f, 0xfc, 0x5a, 0x70, 0xc5, 0xed,
+	0x13, 0xe3, 0xf9, 0x3d, 0xde, 0x79, 0xe3, 0x51, 0xc1, 0x0b, 0xae, 0x9d, 0x60, 0xfb, 0x67, 0xf4,
+	0x31, 0x29, 0x38, 0x2f, 0xe6, 0x10, 0xe8, 0x2a, 0x6d, 0xde, 0x07, 0xaa, 0x64, 0x20, 0x15, 0x65,
+	0xb5, 0x11, 0xbc, 0x6f, 0x08, 0x1f, 0xbe, 0xfc, 0xd0, 0x94, 0x0b, 0x9e, 0x51, 0x55, 0xf2, 0xca,
+	0x7e, 0x84, 0x87, 0x33, 0x28, 0x8b, 0x99, 0x72, 0xd0, 0x04, 0x4d, 0x0f, 0xe2, 0x5d, 0x65, 0x3f,
+	0xc7, 0x83, 0x6d, 0xaf, 0x73, 0x67, 0x82, 0xa6, 0x0f, 0x9e, 0x8e, 0x7d, 0x13, 0xec, 0xef, 0x83,
+	0xfd, 0xb7, 0xfb, 0xe0, 0xf0, 0xde, 0x4d, 0x4b, 0xac, 0xeb, 0x1f, 0x04, 0xc5, 0xba, 0xc3, 0x1e,
+	0xe1, 0xbb, 0x35, 0xff, 0x08, 0xc2, 0x39, 0xd0, 0x81, 0xa6, 0xb0, 0x5f, 0xe3, 0xe3, 0x8c, 0x57,
+	0x12, 0x2a, 0xd9, 0xc8, 0x84, 0xe6, 0xb9, 0x00, 0x29, 0x9d, 0xc1, 0x04, 0x4d, 0xef, 0x87, 0x8f,
+	0x37, 0x2d, 0x71, 0xae, 0x28, 0x9b, 0x5f, 0x78, 0xff, 0x28, 0x5e, 0x7c, 0xd4, 0xb3, 0x17, 0x06,
+	0x5d, 0x1c, 0x7e, 0x5a, 0x11, 0xeb, 0xf3, 0x8a, 0x58, 0xbf, 0x56, 0xc4, 0xf2, 0xbe, 0x20, 0x3c,
+	0x8c, 0xa8, 0xa0, 0x4c, 0xda, 0xaf, 0xf0, 0x91, 0x00, 0x05, 0xd5, 0x76, 0xb0, 0x24, 0x9d, 0xf3,
+	0xec, 0x52, 0xea, 0xa9, 0x06, 0xe1, 0xe9, 0xa6, 0x25, 0x27, 0xe6, 0x88, 0xbf, 0x0d, 0x2f, 0x7e,
+	0xd8, 0xa3, 0x50, 0x13, 0x3b, 0xc2, 0x23, 0x46, 0x97, 0x49, 0x2d, 0x9a, 0x0a, 0xf2, 0xa4, 0x06,
+	0x61, 0x54, 0x7d, 0x17, 0x83, 0x90, 0x6c, 0x5a, 0x72, 0x6a, 0xb2, 0xfe, 0x67, 0x79, 0xf1, 0x31,
+	0xa3, 0xcb, 0x48, 0xd3, 0x08, 0x84, 0x8e, 0x0c, 0xdf, 0x7c, 0xed, 0x5c, 0x74, 0xd3, 0xb9, 0xe8,
+	0xb6, 0x73, 0xd1, 0xcf, 0xce, 0x45, 0xd7, 0x6b, 0xd7, 0xba, 0x5d, 0xbb, 0xd6, 0xf7, 0xb5, 0x6b,
+	0xbd, 0x3b, 0x2b, 0x4a, 0x35, 0x6b, 0x52, 0x3f, 0xe3, 0x2c, 0xd8, 0xed, 0x85, 0xf9, 0x9c, 0xc9,
+	0xfc, 0x32, 0x58, 0xfe, 0x59, 0x12, 0x75, 0x55, 0x83, 0x4c, 0x87, 0xfa, 0x11, 0x9e, 0xfd, 0x0e,
+	0x00, 0x00, 0xff, 0xff, 0xa5, 0x66, 0x3a, 0xcc, 0x44, 0x02, 0x00, 0x00,
 }
 
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RetentionBlocks != that1.RetentionBlocks {
+		return false
+	}
+	if this.MaxPrunedPerBlock != that1.MaxPrunedPerBlock {
+		return false
+	}
+	return true
+}
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -149,6 +240,39 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPrunedPerBlock != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.MaxPrunedPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RetentionBlocks != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.RetentionBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -181,6 +305,21 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RetentionBlocks != 0 {
+		n += 1 + sovEvidence(uint64(m.RetentionBlocks))
+	}
+	if m.MaxPrunedPerBlock != 0 {
+		n += 1 + sovEvidence(uint64(m.MaxPrunedPerBlock))
+	}
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -340,6 +479,94 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionBlocks", wireType)
+			}
+			m.RetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedPerBlock", wireType)
+			}
+			m.MaxPrunedPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunedPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "evidence"
@@ -16,5 +21,19 @@ const (
 
 // KVStore key prefixes
 var (
-	KeyPrefixEvidence = []byte{0x00}
+	KeyPrefixEvidence         = []byte{0x00}
+	KeyPrefixEvidenceByHeight = []byte{0x01}
 )
+
+// EvidenceByHeightKey returns the key of the entry of evidence in the index of
+// evidence by infraction height: 0x01 | height (8 bytes) | hash
+func EvidenceByHeightKey(height int64, hash []byte) []byte {
+	return append(append(KeyPrefixEvidenceByHeight, sdk.Uint64ToBigEndian(uint64(height))...), hash...)
+}
+
+// SplitEvidenceByHeightKey returns the infraction height and the hash of
+// evidence from its key in the index of evidence by infraction height.
+func SplitEvidenceByHeightKey(key []byte) (height int64, hash []byte) {
+	kv.AssertKeyAtLeastLength(key, 10)
+	return int64(sdk.BigEndianToUint64(key[1:9])), key[9:]
+}
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"fmt"
 	"time"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // DONTCOVER
@@ -9,3 +12,76 @@ import (
 // DoubleSignJailEndTime period ends at Max Time supported by Amino
 // (Dec 31, 9999 - 23:59:59 GMT).
 var DoubleSignJailEndTime = time.Unix(253402300799, 0)
+
+// Default parameter values
+const (
+	DefaultRetentionBlocks   uint64 = 0
+	DefaultMaxPrunedPerBlock uint64 = 100
+)
+
+// Parameter store keys
+var (
+	KeyRetentionBlocks   = []byte("RetentionBlocks")
+	KeyMaxPrunedPerBlock = []byte("MaxPrunedPerBlock")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the parameter key table for the evidence module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(retentionBlocks, maxPrunedPerBlock uint64) Params {
+	return Params{
+		RetentionBlocks:   retentionBlocks,
+		MaxPrunedPerBlock: maxPrunedPerBlock,
+	}
+}
+
+// DefaultParams returns the default evidence module parameters, which keep
+// evidence forever.
+func DefaultParams() Params {
+	return NewParams(DefaultRetentionBlocks, DefaultMaxPrunedPerBlock)
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value
+// pairs of evidence module's parameters.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRetentionBlocks, &p.RetentionBlocks, validateRetentionBlocks),
+		paramtypes.NewParamSetPair(KeyMaxPrunedPerBlock, &p.MaxPrunedPerBlock, validateMaxPrunedPerBlock),
+	}
+}
+
+// Validate performs basic validation on evidence parameters.
+func (p Params) Validate() error {
+	if err := validateRetentionBlocks(p.RetentionBlocks); err != nil {
+		return err
+	}
+
+	return validateMaxPrunedPerBlock(p.MaxPrunedPerBlock)
+}
+
+func validateRetentionBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxPrunedPerBlock(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max pruned evidence per block must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -22,6 +22,8 @@ const (
 	FlagPeriodLimit = "period-limit"
 	FlagSpendLimit  = "spend-limit"
 	FlagAllowedMsgs = "allowed-messages"
+
+	FlagMaxRegrantDepth = "max-regrant-depth"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -38,6 +40,8 @@ func GetTxCmd() *cobra.Command {
 	feegrantTxCmd.AddCommand(
 		NewCmdFeeGrant(),
 		NewCmdRevokeFeegrant(),
+		NewCmdRegrantFeegrant(),
+		NewCmdRevokeRegrant(),
 	)
 
 	return feegrantTxCmd
@@ -76,89 +80,201 @@ Examples:
 			}
 
 			granter := clientCtx.GetFromAddress()
-			sl, err := cmd.Flags().GetString(FlagSpendLimit)
+			grant, err := getAllowance(cmd, args)
 			if err != nil {
 				return err
 			}
 
-			// if `FlagSpendLimit` isn't set, limit will be nil
-			limit, err := sdk.ParseCoinsNormalized(sl)
+			maxRegrantDepth, err := cmd.Flags().GetUint32(FlagMaxRegrantDepth)
 			if err != nil {
 				return err
 			}
 
-			exp, err := cmd.Flags().GetString(FlagExpiration)
+			msg, err := feegrant.NewMsgGrantAllowance(grant, granter, grantee)
 			if err != nil {
 				return err
 			}
+			msg.MaxRegrantDepth = maxRegrantDepth
 
-			basic := feegrant.BasicAllowance{
-				SpendLimit: limit,
-			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 
-			var expiresAtTime time.Time
-			if exp != "" {
-				expiresAtTime, err = time.Parse(time.RFC3339, exp)
-				if err != nil {
-					return err
-				}
-				basic.Expiration = &expiresAtTime
-			}
+	flags.AddTxFlagsToCmd(cmd)
+	addAllowanceFlags(cmd)
+	cmd.Flags().Uint32(FlagMaxRegrantDepth, 0, "The number of levels of re-grants the grantee may create from the allowance")
+
+	return cmd
+}
 
-			var grant feegrant.FeeAllowanceI
-			grant = &basic
+// NewCmdRevokeFeegrant returns a CLI command handler for creating a MsgRevokeAllowance transaction.
+func NewCmdRevokeFeegrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [granter] [grantee]",
+		Short: "revoke fee-grant",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`revoke fee grant from a granter to a grantee. Note, the'--from' flag is
+			ignored as it is implied from [granter].
 
-			periodClock, err := cmd.Flags().GetInt64(FlagPeriod)
+Example:
+ $ %s tx %s revoke cosmos1skj.. cosmos1skj..
+			`, version.AppName, feegrant.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			periodLimitVal, err := cmd.Flags().GetString(FlagPeriodLimit)
+			grantee, err := sdk.AccAddressFromBech32(args[1])
 			if err != nil {
 				return err
 			}
 
-			// Check any of period or periodLimit flags set, If set consider it as periodic fee allowance.
-			if periodClock > 0 || periodLimitVal != "" {
-				periodLimit, err := sdk.ParseCoinsNormalized(periodLimitVal)
-				if err != nil {
-					return err
-				}
+			msg := feegrant.NewMsgRevokeAllowance(clientCtx.GetFromAddress(), grantee)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// getAllowance builds the fee allowance described by the allowance flags of cmd.
+func getAllowance(cmd *cobra.Command, args []string) (feegrant.FeeAllowanceI, error) {
+	sl, err := cmd.Flags().GetString(FlagSpendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// if `FlagSpendLimit` isn't set, limit will be nil
+	limit, err := sdk.ParseCoinsNormalized(sl)
+	if err != nil {
+		return nil, err
+	}
+
+	exp, err := cmd.Flags().GetString(FlagExpiration)
+	if err != nil {
+		return nil, err
+	}
+
+	basic := feegrant.BasicAllowance{
+		SpendLimit: limit,
+	}
 
-				if periodClock > 0 && periodLimit != nil {
-					periodReset := getPeriodReset(periodClock)
-					if exp != "" && periodReset.Sub(expiresAtTime) > 0 {
-						return fmt.Errorf("period(%d) cannot reset after expiration(%v)", periodClock, exp)
-					}
+	var expiresAtTime time.Time
+	if exp != "" {
+		expiresAtTime, err = time.Parse(time.RFC3339, exp)
+		if err != nil {
+			return nil, err
+		}
+		basic.Expiration = &expiresAtTime
+	}
+
+	var grant feegrant.FeeAllowanceI
+	grant = &basic
+
+	periodClock, err := cmd.Flags().GetInt64(FlagPeriod)
+	if err != nil {
+		return nil, err
+	}
 
-					periodic := feegrant.PeriodicAllowance{
-						Basic:            basic,
-						Period:           getPeriod(periodClock),
-						PeriodReset:      getPeriodReset(periodClock),
-						PeriodSpendLimit: periodLimit,
-						PeriodCanSpend:   periodLimit,
-					}
+	periodLimitVal, err := cmd.Flags().GetString(FlagPeriodLimit)
+	if err != nil {
+		return nil, err
+	}
 
-					grant = &periodic
+	// Check any of period or periodLimit flags set, If set consider it as periodic fee allowance.
+	if periodClock > 0 || periodLimitVal != "" {
+		periodLimit, err := sdk.ParseCoinsNormalized(periodLimitVal)
+		if err != nil {
+			return nil, err
+		}
+
+		if periodClock > 0 && periodLimit != nil {
+			periodReset := getPeriodReset(periodClock)
+			if exp != "" && periodReset.Sub(expiresAtTime) > 0 {
+				return nil, fmt.Errorf("period(%d) cannot reset after expiration(%v)", periodClock, exp)
+			}
 
-				} else {
-					return fmt.Errorf("invalid number of args %d", len(args))
-				}
+			periodic := feegrant.PeriodicAllowance{
+				Basic:            basic,
+				Period:           getPeriod(periodClock),
+				PeriodReset:      getPeriodReset(periodClock),
+				PeriodSpendLimit: periodLimit,
+				PeriodCanSpend:   periodLimit,
 			}
 
-			allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+			grant = &periodic
+
+		} else {
+			return nil, fmt.Errorf("invalid number of args %d", len(args))
+		}
+	}
+
+	allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(allowedMsgs) > 0 {
+		grant, err = feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return grant, nil
+}
+
+// NewCmdRegrantFeegrant returns a CLI command handler for creating a MsgRegrantAllowance transaction.
+func NewCmdRegrantFeegrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "regrant [granter] [regranter_key_or_address] [grantee]",
+		Short: "Re-grant a portion of a fee allowance to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Re-grant a portion of your fee allowance on the account of [granter] to [grantee],
+if your allowance permits it. The fees used by [grantee] are also deducted from your
+allowance. Note, the'--from' flag is ignored as it is implied from [regranter].
+
+Examples:
+%s tx %s regrant cosmos1skjw... cosmos1skjw... cosmos1skjw... --spend-limit 10stake --expiration 2022-01-30T15:04:05Z
+				`, version.AppName, feegrant.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[1])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
-			if len(allowedMsgs) > 0 {
-				grant, err = feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
-				if err != nil {
-					return err
-				}
+			grantee, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
 			}
 
-			msg, err := feegrant.NewMsgGrantAllowance(grant, granter, grantee)
+			grant, err := getAllowance(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			maxRegrantDepth, err := cmd.Flags().GetUint32(FlagMaxRegrantDepth)
+			if err != nil {
+				return err
+			}
+
+			msg, err := feegrant.NewMsgRegrantAllowance(grant, granter, clientCtx.GetFromAddress(), grantee, maxRegrantDepth)
 			if err != nil {
 				return err
 			}
@@ -168,42 +284,45 @@ Examples:
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
-	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of allowed messages for fee allowance")
-	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
-	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
-	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration in which period_spend_limit coins can be spent before that allowance is reset")
-	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	addAllowanceFlags(cmd)
+	cmd.Flags().Uint32(FlagMaxRegrantDepth, 0, "The number of levels of re-grants the grantee may create from the allowance")
 
 	return cmd
 }
 
-// NewCmdRevokeFeegrant returns a CLI command handler for creating a MsgRevokeAllowance transaction.
-func NewCmdRevokeFeegrant() *cobra.Command {
+// NewCmdRevokeRegrant returns a CLI command handler for creating a MsgRevokeRegrant transaction.
+func NewCmdRevokeRegrant() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "revoke [granter] [grantee]",
-		Short: "revoke fee-grant",
+		Use:   "revoke-regrant [granter] [regranter] [grantee]",
+		Short: "revoke re-granted fee-grant",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`revoke fee grant from a granter to a grantee. Note, the'--from' flag is
-			ignored as it is implied from [granter].
+			fmt.Sprintf(`revoke a fee grant re-granted by a regranter to a grantee on the account of
+			a granter, along with the grants re-granted from it. Note, the'--from' flag is
+			ignored as it is implied from [regranter].
 
 Example:
- $ %s tx %s revoke cosmos1skj.. cosmos1skj..
+ $ %s tx %s revoke-regrant cosmos1skj.. cosmos1skj.. cosmos1skj..
 			`, version.AppName, feegrant.ModuleName),
 		),
-		Args: cobra.ExactArgs(2),
+		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cmd.Flags().Set(flags.FlagFrom, args[0])
+			cmd.Flags().Set(flags.FlagFrom, args[1])
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			grantee, err := sdk.AccAddressFromBech32(args[1])
+			granter, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
-			msg := feegrant.NewMsgRevokeAllowance(clientCtx.GetFromAddress(), grantee)
+			grantee, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := feegrant.NewMsgRevokeRegrant(granter, clientCtx.GetFromAddress(), grantee)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
@@ -213,6 +332,15 @@ Example:
 	return cmd
 }
 
+// addAllowanceFlags adds the flags describing a fee allowance to cmd.
+func addAllowanceFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of allowed messages for fee allowance")
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
+	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
+	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration in which period_spend_limit coins can be spent before that allowance is reset")
+	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+}
+
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
 }
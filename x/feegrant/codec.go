@@ -11,6 +11,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgGrantAllowance{},
 		&MsgRevokeAllowance{},
+		&MsgRegrantAllowance{},
+		&MsgRevokeRegrant{},
 	)
 
 	registry.RegisterInterface(
@@ -22,4 +22,8 @@ var (
 	ErrNoMessages = sdkerrors.Register(DefaultCodespace, 6, "allowed messages are empty")
 	// ErrMessageNotAllowed error if message is not allowed
 	ErrMessageNotAllowed = sdkerrors.Register(DefaultCodespace, 7, "message not allowed")
+	// ErrRegrantNotAllowed error if the allowance of the regranter may not be re-granted
+	ErrRegrantNotAllowed = sdkerrors.Register(DefaultCodespace, 8, "re-grant not allowed")
+	// ErrInvalidRegrantDepth error if the re-grant depth of an allowance exceeds its limit
+	ErrInvalidRegrantDepth = sdkerrors.Register(DefaultCodespace, 9, "invalid re-grant depth")
 )
@@ -226,6 +226,13 @@ type Grant struct {
 	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	// allowance can be any of basic and filtered fee allowance.
 	Allowance *types1.Any `protobuf:"bytes,3,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// regranter is the grantee of the parent grant from the same granter which
+	// re-granted a portion of its allowance to the grantee, and is empty for a
+	// grant made by the granter directly.
+	Regranter string `protobuf:"bytes,4,opt,name=regranter,proto3" json:"regranter,omitempty"`
+	// max_regrant_depth is the number of levels of re-grants the grantee may
+	// create below this grant, zero if it may not re-grant.
+	MaxRegrantDepth uint32 `protobuf:"varint,5,opt,name=max_regrant_depth,json=maxRegrantDepth,proto3" json:"max_regrant_depth,omitempty"`
 }
 
 func (m *Grant) Reset()         { *m = Grant{} }
@@ -282,6 +289,20 @@ func (m *Grant) GetAllowance() *types1.Any {
 	return nil
 }
 
+func (m *Grant) GetRegranter() string {
+	if m != nil {
+		return m.Regranter
+	}
+	return ""
+}
+
+func (m *Grant) GetMaxRegrantDepth() uint32 {
+	if m != nil {
+		return m.MaxRegrantDepth
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
@@ -294,43 +315,45 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 562 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0x8f, 0x9b, 0xa4, 0x90, 0x0b, 0x94, 0xc6, 0x14, 0xe1, 0x64, 0x70, 0xa2, 0x0e, 0x34, 0x0c,
-	0xb5, 0x69, 0xd9, 0xca, 0x42, 0x1c, 0xa0, 0x42, 0xa2, 0x12, 0x32, 0x4c, 0x2c, 0xd1, 0xd9, 0x79,
-	0x35, 0x27, 0x6c, 0x9f, 0xe5, 0xbb, 0x40, 0xb3, 0x32, 0x31, 0x76, 0x64, 0x42, 0xcc, 0xcc, 0x7c,
-	0x88, 0x8a, 0xa9, 0x82, 0x85, 0x89, 0xa2, 0xe4, 0x8b, 0x20, 0xdf, 0x9d, 0x9d, 0x90, 0xf0, 0x47,
-	0xaa, 0x3a, 0xc5, 0x77, 0xef, 0xfd, 0xfe, 0xbd, 0x77, 0x0a, 0xba, 0xe5, 0x53, 0x16, 0x51, 0x66,
-	0x1f, 0x02, 0x04, 0x29, 0x8e, 0xb9, 0xfd, 0x7a, 0xc7, 0x03, 0x8e, 0x77, 0x8a, 0x0b, 0x2b, 0x49,
-	0x29, 0xa7, 0xfa, 0x4d, 0xd9, 0x67, 0x15, 0xd7, 0xaa, 0xaf, 0xb5, 0x11, 0xd0, 0x80, 0x8a, 0x1e,
-	0x3b, 0xfb, 0x92, 0xed, 0xad, 0x66, 0x40, 0x69, 0x10, 0x82, 0x2d, 0x4e, 0xde, 0xe8, 0xd0, 0xc6,
-	0xf1, 0x38, 0x2f, 0x49, 0xa6, 0x81, 0xc4, 0x28, 0x5a, 0x59, 0x32, 0x95, 0x19, 0x0f, 0x33, 0x28,
-	0x8c, 0xf8, 0x94, 0xc4, 0xaa, 0xde, 0x5e, 0x64, 0xe5, 0x24, 0x02, 0xc6, 0x71, 0x94, 0xe4, 0x04,
-	0x8b, 0x0d, 0xc3, 0x51, 0x8a, 0x39, 0xa1, 0x8a, 0x60, 0xf3, 0x9b, 0x86, 0xd6, 0x1c, 0xcc, 0x88,
-	0xdf, 0x0b, 0x43, 0xfa, 0x06, 0xc7, 0x3e, 0xe8, 0x21, 0xaa, 0xb3, 0x04, 0xe2, 0xe1, 0x20, 0x24,
-	0x11, 0xe1, 0x86, 0xd6, 0x29, 0x77, 0xeb, 0xbb, 0x4d, 0x4b, 0xf9, 0xca, 0x9c, 0xe4, 0x51, 0xad,
-	0x3e, 0x25, 0xb1, 0x73, 0xe7, 0xe4, 0x47, 0xbb, 0xf4, 0xe9, 0xac, 0xdd, 0x0d, 0x08, 0x7f, 0x39,
-	0xf2, 0x2c, 0x9f, 0x46, 0x2a, 0x84, 0xfa, 0xd9, 0x66, 0xc3, 0x57, 0x36, 0x1f, 0x27, 0xc0, 0x04,
-	0x80, 0xb9, 0x48, 0xf0, 0x3f, 0xc9, 0xe8, 0xf5, 0xfb, 0x08, 0xc1, 0x51, 0x42, 0xa4, 0x29, 0x63,
-	0xa5, 0xa3, 0x75, 0xeb, 0xbb, 0x2d, 0x4b, 0xba, 0xb6, 0x72, 0xd7, 0xd6, 0xf3, 0x3c, 0x96, 0x53,
-	0x39, 0x3e, 0x6b, 0x6b, 0xee, 0x1c, 0x66, 0xaf, 0xf1, 0xf5, 0xf3, 0xf6, 0xd5, 0x47, 0x00, 0x45,
-	0x82, 0xc7, 0x9b, 0xd3, 0x32, 0x6a, 0x3c, 0x85, 0x94, 0xd0, 0xe1, 0x7c, 0xb0, 0x3e, 0xaa, 0x7a,
-	0x59, 0x54, 0x43, 0x13, 0x2a, 0x5b, 0xd6, 0x5f, 0x36, 0x68, 0xfd, 0x3e, 0x10, 0xa7, 0x92, 0x05,
-	0x74, 0x25, 0x56, 0xbf, 0x87, 0x56, 0x13, 0xc1, 0xac, 0xbc, 0x36, 0x97, 0xbc, 0x3e, 0x50, 0x13,
-	0x76, 0x2e, 0x67, 0xb8, 0xf7, 0x99, 0x5d, 0x05, 0xd1, 0xc7, 0x48, 0x97, 0x5f, 0x83, 0xf9, 0x09,
-	0x97, 0x2f, 0x7e, 0xc2, 0xeb, 0x52, 0xe6, 0xd9, 0x6c, 0xce, 0x23, 0xa4, 0xee, 0x06, 0x3e, 0x8e,
-	0xa5, 0xbc, 0x51, 0xb9, 0x78, 0xe1, 0x35, 0x29, 0xd2, 0xc7, 0xb1, 0xd0, 0xd6, 0xf7, 0xd1, 0x15,
-	0x25, 0x9b, 0x02, 0x03, 0x6e, 0x54, 0xff, 0xbb, 0x60, 0x31, 0x35, 0xb1, 0xe4, 0xba, 0x44, 0xba,
-	0x19, 0xf0, 0x4f, 0x5b, 0xfe, 0xa0, 0xa1, 0xeb, 0xe2, 0x08, 0xc3, 0x03, 0x16, 0xcc, 0xf6, 0xfc,
-	0x10, 0xd5, 0x70, 0x7e, 0x50, 0xbb, 0xde, 0x58, 0x12, 0xec, 0xc5, 0x63, 0xa7, 0xf1, 0x65, 0x91,
-	0xd3, 0x9d, 0x21, 0xf5, 0xdb, 0x68, 0x1d, 0x4b, 0xf6, 0x41, 0x04, 0x8c, 0xe1, 0x00, 0x98, 0xb1,
-	0xd2, 0x29, 0x77, 0x6b, 0xee, 0x35, 0x75, 0x7f, 0xa0, 0xae, 0xf7, 0x6e, 0xbc, 0xfb, 0xd8, 0x2e,
-	0x2d, 0x1b, 0x7c, 0xab, 0xa1, 0xea, 0x7e, 0xf6, 0xb2, 0x74, 0x03, 0x5d, 0x12, 0x4f, 0x0c, 0x52,
-	0x61, 0xa8, 0xe6, 0xe6, 0xc7, 0x59, 0x05, 0xc4, 0x83, 0x2a, 0x2a, 0x0b, 0x31, 0xca, 0xe7, 0x8d,
-	0xe1, 0xf4, 0x4e, 0x26, 0xa6, 0x76, 0x3a, 0x31, 0xb5, 0x9f, 0x13, 0x53, 0x3b, 0x9e, 0x9a, 0xa5,
-	0xd3, 0xa9, 0x59, 0xfa, 0x3e, 0x35, 0x4b, 0x2f, 0xb6, 0xfe, 0xb9, 0xd5, 0xa3, 0xe2, 0x0f, 0xcf,
-	0x5b, 0x15, 0x72, 0x77, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x08, 0x9a, 0x3d, 0x1b, 0x05,
-	0x00, 0x00,
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3d, 0x6f, 0xd3, 0x4e,
+	0x18, 0x8f, 0x9b, 0xb4, 0xff, 0x7f, 0x2e, 0xf4, 0x25, 0xa6, 0x08, 0x27, 0x42, 0x4e, 0xd4, 0x81,
+	0x06, 0xa4, 0xda, 0xb4, 0x6c, 0x65, 0x21, 0x4e, 0xa1, 0x42, 0xa2, 0x12, 0x32, 0x4c, 0x2c, 0xd6,
+	0xd9, 0x7e, 0xea, 0x5a, 0xc4, 0x3e, 0xcb, 0x77, 0x81, 0xe4, 0x1b, 0x30, 0x76, 0x64, 0x42, 0xcc,
+	0xcc, 0x7c, 0x88, 0x0a, 0x31, 0x54, 0xb0, 0x30, 0x51, 0x94, 0x7c, 0x11, 0xe4, 0xbb, 0xb3, 0x13,
+	0x12, 0x5e, 0x24, 0xd4, 0x29, 0xbe, 0xe7, 0x9e, 0xdf, 0xdb, 0xf3, 0x9c, 0x82, 0x6e, 0x7a, 0x84,
+	0x46, 0x84, 0x9a, 0xc7, 0x00, 0x41, 0x8a, 0x63, 0x66, 0xbe, 0xdc, 0x75, 0x81, 0xe1, 0xdd, 0xa2,
+	0x60, 0x24, 0x29, 0x61, 0x44, 0xbd, 0x2e, 0xfa, 0x8c, 0xa2, 0x2c, 0xfb, 0x9a, 0x9b, 0x01, 0x09,
+	0x08, 0xef, 0x31, 0xb3, 0x2f, 0xd1, 0xde, 0x6c, 0x04, 0x84, 0x04, 0x7d, 0x30, 0xf9, 0xc9, 0x1d,
+	0x1c, 0x9b, 0x38, 0x1e, 0xe5, 0x57, 0x82, 0xc9, 0x11, 0x18, 0x49, 0x2b, 0xae, 0x74, 0x69, 0xc6,
+	0xc5, 0x14, 0x0a, 0x23, 0x1e, 0x09, 0x63, 0x79, 0xdf, 0x9a, 0x67, 0x65, 0x61, 0x04, 0x94, 0xe1,
+	0x28, 0xc9, 0x09, 0xe6, 0x1b, 0xfc, 0x41, 0x8a, 0x59, 0x48, 0x24, 0xc1, 0xd6, 0x17, 0x05, 0xad,
+	0x59, 0x98, 0x86, 0x5e, 0xb7, 0xdf, 0x27, 0xaf, 0x70, 0xec, 0x81, 0xda, 0x47, 0x35, 0x9a, 0x40,
+	0xec, 0x3b, 0xfd, 0x30, 0x0a, 0x99, 0xa6, 0xb4, 0xcb, 0x9d, 0xda, 0x5e, 0xc3, 0x90, 0xbe, 0x32,
+	0x27, 0x79, 0x54, 0xa3, 0x47, 0xc2, 0xd8, 0xba, 0x73, 0xf6, 0xad, 0x55, 0x7a, 0x7f, 0xd1, 0xea,
+	0x04, 0x21, 0x3b, 0x19, 0xb8, 0x86, 0x47, 0x22, 0x19, 0x42, 0xfe, 0xec, 0x50, 0xff, 0x85, 0xc9,
+	0x46, 0x09, 0x50, 0x0e, 0xa0, 0x36, 0xe2, 0xfc, 0x8f, 0x33, 0x7a, 0xf5, 0x3e, 0x42, 0x30, 0x4c,
+	0x42, 0x61, 0x4a, 0x5b, 0x6a, 0x2b, 0x9d, 0xda, 0x5e, 0xd3, 0x10, 0xae, 0x8d, 0xdc, 0xb5, 0xf1,
+	0x2c, 0x8f, 0x65, 0x55, 0x4e, 0x2f, 0x5a, 0x8a, 0x3d, 0x83, 0xd9, 0xaf, 0x7f, 0xfe, 0xb0, 0xb3,
+	0xfa, 0x10, 0xa0, 0x48, 0xf0, 0x68, 0x6b, 0x52, 0x46, 0xf5, 0x27, 0x90, 0x86, 0xc4, 0x9f, 0x0d,
+	0xd6, 0x43, 0xcb, 0x6e, 0x16, 0x55, 0x53, 0xb8, 0xca, 0xb6, 0xf1, 0x9b, 0x0d, 0x1a, 0x3f, 0x0f,
+	0xc4, 0xaa, 0x64, 0x01, 0x6d, 0x81, 0x55, 0xef, 0xa1, 0x95, 0x84, 0x33, 0x4b, 0xaf, 0x8d, 0x05,
+	0xaf, 0x07, 0x72, 0xc2, 0xd6, 0xff, 0x19, 0xee, 0x4d, 0x66, 0x57, 0x42, 0xd4, 0x11, 0x52, 0xc5,
+	0x97, 0x33, 0x3b, 0xe1, 0xf2, 0xe5, 0x4f, 0x78, 0x43, 0xc8, 0x3c, 0x9d, 0xce, 0x79, 0x80, 0x64,
+	0xcd, 0xf1, 0x70, 0x2c, 0xe4, 0xb5, 0xca, 0xe5, 0x0b, 0xaf, 0x09, 0x91, 0x1e, 0x8e, 0xb9, 0xb6,
+	0x7a, 0x88, 0xae, 0x48, 0xd9, 0x14, 0x28, 0x30, 0x6d, 0xf9, 0xaf, 0x0b, 0xe6, 0x53, 0xe3, 0x4b,
+	0xae, 0x09, 0xa4, 0x9d, 0x01, 0x7f, 0xb5, 0xe5, 0xb7, 0x0a, 0xba, 0xca, 0x8f, 0xe0, 0x1f, 0xd1,
+	0x60, 0xba, 0xe7, 0x07, 0xa8, 0x8a, 0xf3, 0x83, 0xdc, 0xf5, 0xe6, 0x82, 0x60, 0x37, 0x1e, 0x59,
+	0xf5, 0x8f, 0xf3, 0x9c, 0xf6, 0x14, 0xa9, 0xde, 0x42, 0x1b, 0x58, 0xb0, 0x3b, 0x11, 0x50, 0x8a,
+	0x03, 0xa0, 0xda, 0x52, 0xbb, 0xdc, 0xa9, 0xda, 0xeb, 0xb2, 0x7e, 0x24, 0xcb, 0xfb, 0xd7, 0x5e,
+	0xbf, 0x6b, 0x95, 0x16, 0x0d, 0x7e, 0x52, 0xd0, 0xf2, 0x61, 0xf6, 0xb2, 0x54, 0x0d, 0xfd, 0xc7,
+	0x9f, 0x18, 0xa4, 0xdc, 0x50, 0xd5, 0xce, 0x8f, 0xd3, 0x1b, 0xe0, 0x0f, 0xaa, 0xb8, 0x99, 0x8b,
+	0x51, 0xfe, 0xe7, 0x18, 0x37, 0x50, 0x35, 0x85, 0x5c, 0xbc, 0xc2, 0x25, 0xa6, 0x05, 0xf5, 0x36,
+	0xaa, 0x47, 0x78, 0xe8, 0xc8, 0x82, 0xe3, 0x43, 0xc2, 0x4e, 0xf8, 0x92, 0x56, 0xed, 0xf5, 0x08,
+	0x0f, 0x6d, 0x51, 0x3f, 0xc8, 0xca, 0x56, 0xf7, 0x6c, 0xac, 0x2b, 0xe7, 0x63, 0x5d, 0xf9, 0x3e,
+	0xd6, 0x95, 0xd3, 0x89, 0x5e, 0x3a, 0x9f, 0xe8, 0xa5, 0xaf, 0x13, 0xbd, 0xf4, 0x7c, 0xfb, 0x8f,
+	0xef, 0x63, 0x58, 0xfc, 0x75, 0xba, 0x2b, 0xdc, 0xf8, 0xdd, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff,
+	0xc1, 0xf2, 0x7c, 0x00, 0x65, 0x05, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -521,6 +544,18 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxRegrantDepth != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.MaxRegrantDepth))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Regranter) > 0 {
+		i -= len(m.Regranter)
+		copy(dAtA[i:], m.Regranter)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Regranter)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Allowance != nil {
 		{
 			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
@@ -644,6 +679,13 @@ func (m *Grant) Size() (n int) {
 		l = m.Allowance.Size()
 		n += 1 + l + sovFeegrant(uint64(l))
 	}
+	l = len(m.Regranter)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if m.MaxRegrantDepth != 0 {
+		n += 1 + sovFeegrant(uint64(m.MaxRegrantDepth))
+	}
 	return n
 }
 
@@ -1237,6 +1279,57 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regranter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regranter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRegrantDepth", wireType)
+			}
+			m.MaxRegrantDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRegrantDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeegrant(dAtA[iNdEx:])
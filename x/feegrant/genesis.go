@@ -24,6 +24,9 @@ func ValidateGenesis(data GenesisState) error {
 		if err != nil {
 			return err
 		}
+		if err := f.validateRegrant(); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -12,6 +12,10 @@ var (
 	_ types.UnpackInterfacesMessage = &Grant{}
 )
 
+// MaxRegrantDepth is the maximum number of levels of re-grants below a grant
+// made by a granter directly.
+const MaxRegrantDepth = 3
+
 // NewGrant creates a new FeeAllowanceGrant.
 //nolint:interfacer
 func NewGrant(granter, grantee sdk.AccAddress, feeAllowance FeeAllowanceI) (Grant, error) {
@@ -44,6 +48,9 @@ func (a Grant) ValidateBasic() error {
 	if a.Grantee == a.Granter {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
 	}
+	if err := a.validateRegrant(); err != nil {
+		return err
+	}
 
 	f, err := a.GetGrant()
 	if err != nil {
@@ -53,6 +60,31 @@ func (a Grant) ValidateBasic() error {
 	return f.ValidateBasic()
 }
 
+// IsRegrant returns true if the allowance was re-granted from the allowance of
+// another grantee of the granter.
+func (a Grant) IsRegrant() bool {
+	return a.Regranter != ""
+}
+
+// validateRegrant validates the re-grant fields of the grant.
+func (a Grant) validateRegrant() error {
+	if a.MaxRegrantDepth > MaxRegrantDepth {
+		return sdkerrors.Wrapf(ErrInvalidRegrantDepth, "max re-grant depth %d exceeds %d", a.MaxRegrantDepth, MaxRegrantDepth)
+	}
+	if !a.IsRegrant() {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(a.Regranter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid regranter address: %s", err)
+	}
+	if a.Regranter == a.Granter || a.Regranter == a.Grantee {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "regranter must differ from the granter and the grantee")
+	}
+
+	return nil
+}
+
 // GetGrant unpacks allowance
 func (a Grant) GetGrant() (FeeAllowanceI, error) {
 	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
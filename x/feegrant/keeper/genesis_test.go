@@ -64,6 +64,9 @@ func (suite *GenesisTestSuite) TestImportExportGenesis() {
 func (suite *GenesisTestSuite) TestInitGenesis() {
 	any, err := codectypes.NewAnyWithValue(&testdata.Dog{})
 	suite.Require().NoError(err)
+	basic, err := codectypes.NewAnyWithValue(&feegrant.BasicAllowance{})
+	suite.Require().NoError(err)
+	regranterAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 
 	testCases := []struct {
 		name          string
@@ -97,6 +100,35 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				},
 			},
 		},
+		{
+			"re-grant without parent",
+			[]feegrant.Grant{
+				{
+					Granter:   granterAddr.String(),
+					Grantee:   granteeAddr.String(),
+					Allowance: basic,
+					Regranter: regranterAddr.String(),
+				},
+			},
+		},
+		{
+			"re-grant depth not lower than parent",
+			[]feegrant.Grant{
+				{
+					Granter:         granterAddr.String(),
+					Grantee:         regranterAddr.String(),
+					Allowance:       basic,
+					MaxRegrantDepth: 1,
+				},
+				{
+					Granter:         granterAddr.String(),
+					Grantee:         granteeAddr.String(),
+					Allowance:       basic,
+					Regranter:       regranterAddr.String(),
+					MaxRegrantDepth: 1,
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -38,27 +38,102 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 // GrantAllowance creates a new grant
 func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+	return k.grantAllowance(ctx, granter, grantee, feeAllowance, 0)
+}
 
-	// create the account if it is not in account state
-	granteeAcc := k.authKeeper.GetAccount(ctx, grantee)
-	if granteeAcc == nil {
-		granteeAcc = k.authKeeper.NewAccountWithAddress(ctx, grantee)
-		k.authKeeper.SetAccount(ctx, granteeAcc)
+// grantAllowance creates a new grant which the grantee may re-grant down to
+// maxRegrantDepth levels
+func (k Keeper) grantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI, maxRegrantDepth uint32) error {
+	grant, err := feegrant.NewGrant(granter, grantee, feeAllowance)
+	if err != nil {
+		return err
+	}
+	grant.MaxRegrantDepth = maxRegrantDepth
+
+	return k.setGrant(ctx, grant)
+}
+
+// RegrantAllowance creates a new grant from the allowance of the regranter on
+// the account of the granter. The fees used by the grantee are also deducted
+// from the allowance of the regranter, which bounds the re-granted allowance.
+func (k Keeper) RegrantAllowance(
+	ctx sdk.Context, granter, regranter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI, maxRegrantDepth uint32,
+) error {
+	parent, err := k.getGrant(ctx, granter, regranter)
+	if err != nil {
+		return err
+	}
+
+	if parent.MaxRegrantDepth == 0 {
+		return sdkerrors.Wrap(feegrant.ErrRegrantNotAllowed, "allowance of the regranter may not be re-granted")
+	}
+	if maxRegrantDepth >= parent.MaxRegrantDepth {
+		return sdkerrors.Wrapf(feegrant.ErrInvalidRegrantDepth, "max re-grant depth %d must be lower than %d", maxRegrantDepth, parent.MaxRegrantDepth)
+	}
+
+	// Checking for duplicate entry
+	if f, _ := k.getGrant(ctx, granter, grantee); f != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee allowance already exists")
 	}
 
-	store := ctx.KVStore(k.storeKey)
-	key := feegrant.FeeAllowanceKey(granter, grantee)
 	grant, err := feegrant.NewGrant(granter, grantee, feeAllowance)
 	if err != nil {
 		return err
 	}
+	grant.Regranter = regranter.String()
+	grant.MaxRegrantDepth = maxRegrantDepth
+
+	return k.setGrant(ctx, grant)
+}
+
+// RevokeRegrant removes an existing grant re-granted by the regranter, along
+// with the grants re-granted from it
+func (k Keeper) RevokeRegrant(ctx sdk.Context, granter, regranter, grantee sdk.AccAddress) error {
+	grant, err := k.getGrant(ctx, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	if grant.Regranter != regranter.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "fee-grant was not re-granted by %s", regranter)
+	}
+
+	return k.revokeAllowance(ctx, granter, grantee)
+}
+
+// setGrant stores the grant, creating the grantee account if it does not exist
+func (k Keeper) setGrant(ctx sdk.Context, grant feegrant.Grant) error {
+	granter, err := sdk.AccAddressFromBech32(grant.Granter)
+	if err != nil {
+		return err
+	}
+	grantee, err := sdk.AccAddressFromBech32(grant.Grantee)
+	if err != nil {
+		return err
+	}
+
+	// create the account if it is not in account state
+	granteeAcc := k.authKeeper.GetAccount(ctx, grantee)
+	if granteeAcc == nil {
+		granteeAcc = k.authKeeper.NewAccountWithAddress(ctx, grantee)
+		k.authKeeper.SetAccount(ctx, granteeAcc)
+	}
 
 	bz, err := k.cdc.Marshal(&grant)
 	if err != nil {
 		return err
 	}
 
-	store.Set(key, bz)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(feegrant.FeeAllowanceKey(granter, grantee), bz)
+
+	if grant.IsRegrant() {
+		regranter, err := sdk.AccAddressFromBech32(grant.Regranter)
+		if err != nil {
+			return err
+		}
+		store.Set(feegrant.RegrantKey(granter, regranter, grantee), []byte{})
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -71,9 +146,10 @@ func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress,
 	return nil
 }
 
-// revokeAllowance removes an existing grant
+// revokeAllowance removes an existing grant, along with the grants re-granted
+// from it
 func (k Keeper) revokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
-	_, err := k.getGrant(ctx, granter, grantee)
+	grant, err := k.getGrant(ctx, granter, grantee)
 	if err != nil {
 		return err
 	}
@@ -82,6 +158,14 @@ func (k Keeper) revokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress
 	key := feegrant.FeeAllowanceKey(granter, grantee)
 	store.Delete(key)
 
+	if grant.IsRegrant() {
+		regranter, err := sdk.AccAddressFromBech32(grant.Regranter)
+		if err != nil {
+			return err
+		}
+		store.Delete(feegrant.RegrantKey(granter, regranter, grantee))
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			feegrant.EventTypeRevokeFeeGrant,
@@ -89,9 +173,33 @@ func (k Keeper) revokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress
 			sdk.NewAttribute(feegrant.AttributeKeyGrantee, grantee.String()),
 		),
 	)
+
+	// cascade the revocation to the grants re-granted by the grantee
+	for _, regrantee := range k.getRegrantees(ctx, granter, grantee) {
+		if err := k.revokeAllowance(ctx, granter, regrantee); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// getRegrantees returns the grantees of the allowances re-granted by the
+// regranter on the account of the granter
+func (k Keeper) getRegrantees(ctx sdk.Context, granter, regranter sdk.AccAddress) []sdk.AccAddress {
+	prefix := feegrant.RegrantsPrefix(granter, regranter)
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+
+	var grantees []sdk.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		// skip the length prefix of the grantee address
+		grantees = append(grantees, sdk.AccAddress(iter.Key()[len(prefix)+1:]))
+	}
+
+	return grantees
+}
+
 // GetAllowance returns the allowance between the granter and grantee.
 // If there is none, it returns nil, nil.
 // Returns an error on parsing issues
@@ -143,7 +251,8 @@ func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, cb func(grant feegrant.
 	return nil
 }
 
-// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee
+// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee.
+// If the allowance was re-granted, the fee is also deducted from the allowances it was re-granted from.
 func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
 	f, err := k.getGrant(ctx, granter, grantee)
 	if err != nil {
@@ -166,7 +275,7 @@ func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress,
 
 		emitUseGrantEvent(ctx, granter.String(), grantee.String())
 
-		return nil
+		return k.useRegrantedFees(ctx, granter, f, fee, msgs)
 	}
 
 	if err != nil {
@@ -176,7 +285,32 @@ func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress,
 	emitUseGrantEvent(ctx, granter.String(), grantee.String())
 
 	// if fee allowance is accepted, store the updated state of the allowance
-	return k.GrantAllowance(ctx, granter, grantee, grant)
+	updated, err := feegrant.NewGrant(granter, grantee, grant)
+	if err != nil {
+		return err
+	}
+	updated.Regranter = f.Regranter
+	updated.MaxRegrantDepth = f.MaxRegrantDepth
+
+	if err := k.setGrant(ctx, updated); err != nil {
+		return err
+	}
+
+	return k.useRegrantedFees(ctx, granter, f, fee, msgs)
+}
+
+// useRegrantedFees deducts the fee from the allowance the grant was re-granted from, if any
+func (k Keeper) useRegrantedFees(ctx sdk.Context, granter sdk.AccAddress, grant *feegrant.Grant, fee sdk.Coins, msgs []sdk.Msg) error {
+	if !grant.IsRegrant() {
+		return nil
+	}
+
+	regranter, err := sdk.AccAddressFromBech32(grant.Regranter)
+	if err != nil {
+		return err
+	}
+
+	return k.UseGrantedFees(ctx, granter, regranter, fee, msgs)
 }
 
 func emitUseGrantEvent(ctx sdk.Context, granter, grantee string) {
@@ -192,25 +326,45 @@ func emitUseGrantEvent(ctx sdk.Context, granter, grantee string) {
 // InitGenesis will initialize the keeper from a *previously validated* GenesisState
 func (k Keeper) InitGenesis(ctx sdk.Context, data *feegrant.GenesisState) error {
 	for _, f := range data.Allowances {
-		granter, err := sdk.AccAddressFromBech32(f.Granter)
-		if err != nil {
+		if _, err := sdk.AccAddressFromBech32(f.Granter); err != nil {
 			return err
 		}
-		grantee, err := sdk.AccAddressFromBech32(f.Grantee)
-		if err != nil {
+		if _, err := sdk.AccAddressFromBech32(f.Grantee); err != nil {
+			return err
+		}
+		if _, err := f.GetGrant(); err != nil {
 			return err
 		}
 
-		grant, err := f.GetGrant()
-		if err != nil {
+		if err := k.setGrant(ctx, f); err != nil {
 			return err
 		}
+	}
+
+	// every re-granted allowance must have the allowance it was re-granted from
+	for _, f := range data.Allowances {
+		if !f.IsRegrant() {
+			continue
+		}
 
-		err = k.GrantAllowance(ctx, granter, grantee, grant)
+		granter, err := sdk.AccAddressFromBech32(f.Granter)
 		if err != nil {
 			return err
 		}
+		regranter, err := sdk.AccAddressFromBech32(f.Regranter)
+		if err != nil {
+			return err
+		}
+
+		parent, err := k.getGrant(ctx, granter, regranter)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "re-granted allowance of %s", f.Grantee)
+		}
+		if f.MaxRegrantDepth >= parent.MaxRegrantDepth {
+			return sdkerrors.Wrapf(feegrant.ErrInvalidRegrantDepth, "re-granted allowance of %s", f.Grantee)
+		}
 	}
+
 	return nil
 }
 
@@ -259,3 +259,147 @@ func (suite *KeeperTestSuite) TestIterateGrants() {
 	})
 
 }
+
+func (suite *KeeperTestSuite) TestRegrantAllowance() {
+	exp := suite.sdkCtx.BlockTime().AddDate(1, 0, 0)
+	allowance := &feegrant.BasicAllowance{
+		SpendLimit: suite.atom,
+		Expiration: &exp,
+	}
+	granter, regranter, grantee, other := suite.addrs[0], suite.addrs[1], suite.addrs[2], suite.addrs[3]
+
+	// a grant without re-grant depth may not be re-granted
+	err := suite.keeper.GrantAllowance(suite.sdkCtx, granter, regranter, allowance)
+	suite.Require().NoError(err)
+	err = suite.keeper.RegrantAllowance(suite.sdkCtx, granter, regranter, grantee, allowance, 0)
+	suite.Require().ErrorIs(err, feegrant.ErrRegrantNotAllowed)
+
+	_, err = suite.msgSrvr.RevokeAllowance(suite.ctx, &feegrant.MsgRevokeAllowance{Granter: granter.String(), Grantee: regranter.String()})
+	suite.Require().NoError(err)
+
+	msg, err := feegrant.NewMsgGrantAllowance(allowance, granter, regranter)
+	suite.Require().NoError(err)
+	msg.MaxRegrantDepth = 2
+	_, err = suite.msgSrvr.GrantAllowance(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	// the re-grant depth must decrease along the chain
+	err = suite.keeper.RegrantAllowance(suite.sdkCtx, granter, regranter, grantee, allowance, 2)
+	suite.Require().ErrorIs(err, feegrant.ErrInvalidRegrantDepth)
+
+	regrant, err := feegrant.NewMsgRegrantAllowance(allowance, granter, regranter, grantee, 1)
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.RegrantAllowance(suite.ctx, regrant)
+	suite.Require().NoError(err)
+
+	loaded, err := suite.keeper.GetAllowance(suite.sdkCtx, granter, grantee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(allowance, loaded)
+
+	// a grant may not be re-granted twice
+	_, err = suite.msgSrvr.RegrantAllowance(suite.ctx, regrant)
+	suite.Require().Error(err)
+
+	// the re-grantee may re-grant once more, down to depth zero
+	err = suite.keeper.RegrantAllowance(suite.sdkCtx, granter, grantee, other, allowance, 1)
+	suite.Require().ErrorIs(err, feegrant.ErrInvalidRegrantDepth)
+	err = suite.keeper.RegrantAllowance(suite.sdkCtx, granter, grantee, other, allowance, 0)
+	suite.Require().NoError(err)
+
+	// only the regranter may revoke a re-grant
+	_, err = suite.msgSrvr.RevokeRegrant(suite.ctx, &feegrant.MsgRevokeRegrant{
+		Granter: granter.String(), Regranter: regranter.String(), Grantee: other.String(),
+	})
+	suite.Require().Error(err)
+
+	// revoking a re-grant revokes the allowances re-granted from it
+	_, err = suite.msgSrvr.RevokeRegrant(suite.ctx, &feegrant.MsgRevokeRegrant{
+		Granter: granter.String(), Regranter: regranter.String(), Grantee: grantee.String(),
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.GetAllowance(suite.sdkCtx, granter, grantee)
+	suite.Require().Error(err)
+	_, err = suite.keeper.GetAllowance(suite.sdkCtx, granter, other)
+	suite.Require().Error(err)
+	_, err = suite.keeper.GetAllowance(suite.sdkCtx, granter, regranter)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestRevokeAllowanceCascade() {
+	exp := suite.sdkCtx.BlockTime().AddDate(1, 0, 0)
+	allowance := &feegrant.BasicAllowance{
+		SpendLimit: suite.atom,
+		Expiration: &exp,
+	}
+	granter := suite.addrs[0]
+
+	msg, err := feegrant.NewMsgGrantAllowance(allowance, granter, suite.addrs[1])
+	suite.Require().NoError(err)
+	msg.MaxRegrantDepth = feegrant.MaxRegrantDepth
+	_, err = suite.msgSrvr.GrantAllowance(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	err = suite.keeper.RegrantAllowance(suite.sdkCtx, granter, suite.addrs[1], suite.addrs[2], allowance, 1)
+	suite.Require().NoError(err)
+	err = suite.keeper.RegrantAllowance(suite.sdkCtx, granter, suite.addrs[2], suite.addrs[3], allowance, 0)
+	suite.Require().NoError(err)
+
+	// revoking the root grant revokes the whole chain
+	_, err = suite.msgSrvr.RevokeAllowance(suite.ctx, &feegrant.MsgRevokeAllowance{Granter: granter.String(), Grantee: suite.addrs[1].String()})
+	suite.Require().NoError(err)
+
+	for _, grantee := range suite.addrs[1:] {
+		_, err = suite.keeper.GetAllowance(suite.sdkCtx, granter, grantee)
+		suite.Require().Error(err)
+	}
+
+	genesis, err := suite.keeper.ExportGenesis(suite.sdkCtx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(genesis.Allowances)
+}
+
+func (suite *KeeperTestSuite) TestUseRegrantedFee() {
+	exp := suite.sdkCtx.BlockTime().AddDate(1, 0, 0)
+	granter, regranter, grantee := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+
+	msg, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+		Expiration: &exp,
+	}, granter, regranter)
+	suite.Require().NoError(err)
+	msg.MaxRegrantDepth = 1
+	_, err = suite.msgSrvr.GrantAllowance(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	// the re-granted allowance exceeds the one of the regranter
+	err = suite.keeper.RegrantAllowance(suite.sdkCtx, granter, regranter, grantee, &feegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 500)),
+	}, 0)
+	suite.Require().NoError(err)
+
+	// the fee is deducted from both allowances
+	err = suite.keeper.UseGrantedFees(suite.sdkCtx, granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("atom", 40)), []sdk.Msg{})
+	suite.Require().NoError(err)
+
+	loaded, err := suite.keeper.GetAllowance(suite.sdkCtx, granter, grantee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 460)), loaded.(*feegrant.BasicAllowance).SpendLimit)
+	loaded, err = suite.keeper.GetAllowance(suite.sdkCtx, granter, regranter)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 60)), loaded.(*feegrant.BasicAllowance).SpendLimit)
+
+	// the re-granted allowance is bounded by the one of the regranter
+	cacheCtx, _ := suite.sdkCtx.CacheContext()
+	err = suite.keeper.UseGrantedFees(cacheCtx, granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), []sdk.Msg{})
+	suite.Require().Error(err)
+
+	// exhausting the allowance of the regranter revokes the re-granted allowance
+	err = suite.keeper.UseGrantedFees(suite.sdkCtx, granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("atom", 60)), []sdk.Msg{})
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.GetAllowance(suite.sdkCtx, granter, regranter)
+	suite.Require().Error(err)
+	_, err = suite.keeper.GetAllowance(suite.sdkCtx, granter, grantee)
+	suite.Require().Error(err)
+}
@@ -47,7 +47,7 @@ func (k msgServer) GrantAllowance(goCtx context.Context, msg *feegrant.MsgGrantA
 		return nil, err
 	}
 
-	err = k.Keeper.GrantAllowance(ctx, granter, grantee, allowance)
+	err = k.Keeper.grantAllowance(ctx, granter, grantee, allowance, msg.MaxRegrantDepth)
 	if err != nil {
 		return nil, err
 	}
@@ -76,3 +76,62 @@ func (k msgServer) RevokeAllowance(goCtx context.Context, msg *feegrant.MsgRevok
 
 	return &feegrant.MsgRevokeAllowanceResponse{}, nil
 }
+
+// RegrantAllowance re-grants a portion of the regranter's allowance to the grantee.
+func (k msgServer) RegrantAllowance(goCtx context.Context, msg *feegrant.MsgRegrantAllowance) (*feegrant.MsgRegrantAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	regranter, err := sdk.AccAddressFromBech32(msg.Regranter)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, err := msg.GetFeeAllowanceI()
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.RegrantAllowance(ctx, granter, regranter, grantee, allowance, msg.MaxRegrantDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgRegrantAllowanceResponse{}, nil
+}
+
+// RevokeRegrant revokes a fee allowance re-granted by the regranter to the grantee.
+func (k msgServer) RevokeRegrant(goCtx context.Context, msg *feegrant.MsgRevokeRegrant) (*feegrant.MsgRevokeRegrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	regranter, err := sdk.AccAddressFromBech32(msg.Regranter)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.RevokeRegrant(ctx, granter, regranter, grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgRevokeRegrantResponse{}, nil
+}
@@ -22,6 +22,10 @@ const (
 var (
 	// FeeAllowanceKeyPrefix is the set of the kvstore for fee allowance data
 	FeeAllowanceKeyPrefix = []byte{0x00}
+
+	// RegrantKeyPrefix is the prefix of the index of the allowances re-granted
+	// from the allowance of each grantee
+	RegrantKeyPrefix = []byte{0x01}
 )
 
 // FeeAllowanceKey is the canonical key to store a grant from granter to grantee
@@ -34,3 +38,16 @@ func FeeAllowanceKey(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
 func FeeAllowancePrefixByGrantee(grantee sdk.AccAddress) []byte {
 	return append(FeeAllowanceKeyPrefix, address.MustLengthPrefix(grantee.Bytes())...)
 }
+
+// RegrantKey is the key indexing the allowance re-granted to grantee by
+// regranter on the account of granter.
+func RegrantKey(granter, regranter, grantee sdk.AccAddress) []byte {
+	return append(RegrantsPrefix(granter, regranter), address.MustLengthPrefix(grantee.Bytes())...)
+}
+
+// RegrantsPrefix returns a prefix to scan for all the allowances re-granted by
+// regranter on the account of granter.
+func RegrantsPrefix(granter, regranter sdk.AccAddress) []byte {
+	key := append(RegrantKeyPrefix, address.MustLengthPrefix(granter.Bytes())...)
+	return append(key, address.MustLengthPrefix(regranter.Bytes())...)
+}
@@ -13,8 +13,11 @@ import (
 var (
 	_, _ sdk.Msg            = &MsgGrantAllowance{}, &MsgRevokeAllowance{}
 	_, _ legacytx.LegacyMsg = &MsgGrantAllowance{}, &MsgRevokeAllowance{} // For amino support.
+	_, _ sdk.Msg            = &MsgRegrantAllowance{}, &MsgRevokeRegrant{}
+	_, _ legacytx.LegacyMsg = &MsgRegrantAllowance{}, &MsgRevokeRegrant{} // For amino support.
 
 	_ types.UnpackInterfacesMessage = &MsgGrantAllowance{}
+	_ types.UnpackInterfacesMessage = &MsgRegrantAllowance{}
 )
 
 // NewMsgGrantAllowance creates a new MsgGrantAllowance.
@@ -47,6 +50,9 @@ func (msg MsgGrantAllowance) ValidateBasic() error {
 	if msg.Grantee == msg.Granter {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
 	}
+	if msg.MaxRegrantDepth > MaxRegrantDepth {
+		return sdkerrors.Wrapf(ErrInvalidRegrantDepth, "max re-grant depth %d exceeds %d", msg.MaxRegrantDepth, MaxRegrantDepth)
+	}
 	allowance, err := msg.GetFeeAllowanceI()
 	if err != nil {
 		return err
@@ -135,3 +141,135 @@ func (msg MsgRevokeAllowance) Route() string {
 func (msg MsgRevokeAllowance) GetSignBytes() []byte {
 	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
 }
+
+// NewMsgRegrantAllowance creates a new MsgRegrantAllowance.
+//nolint:interfacer
+func NewMsgRegrantAllowance(
+	feeAllowance FeeAllowanceI, granter, regranter, grantee sdk.AccAddress, maxRegrantDepth uint32,
+) (*MsgRegrantAllowance, error) {
+	msg, ok := feeAllowance.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgRegrantAllowance{
+		Granter:         granter.String(),
+		Regranter:       regranter.String(),
+		Grantee:         grantee.String(),
+		Allowance:       any,
+		MaxRegrantDepth: maxRegrantDepth,
+	}, nil
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRegrantAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Regranter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid regranter address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+	if msg.Grantee == msg.Granter || msg.Grantee == msg.Regranter || msg.Regranter == msg.Granter {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "granter, regranter and grantee must be different")
+	}
+	if msg.MaxRegrantDepth >= MaxRegrantDepth {
+		return sdkerrors.Wrapf(ErrInvalidRegrantDepth, "max re-grant depth %d must be lower than %d", msg.MaxRegrantDepth, MaxRegrantDepth)
+	}
+	allowance, err := msg.GetFeeAllowanceI()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// GetSigners gets the regranter account associated with an allowance
+func (msg MsgRegrantAllowance) GetSigners() []sdk.AccAddress {
+	regranter, _ := sdk.AccAddressFromBech32(msg.Regranter)
+	return []sdk.AccAddress{regranter}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRegrantAllowance) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRegrantAllowance) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRegrantAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// GetFeeAllowanceI returns unpacked FeeAllowance
+func (msg MsgRegrantAllowance) GetFeeAllowanceI() (FeeAllowanceI, error) {
+	allowance, ok := msg.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRegrantAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(msg.Allowance, &allowance)
+}
+
+// NewMsgRevokeRegrant returns a message to revoke a fee allowance re-granted
+// by a regranter to a grantee on the account of a granter
+//nolint:interfacer
+func NewMsgRevokeRegrant(granter, regranter, grantee sdk.AccAddress) MsgRevokeRegrant {
+	return MsgRevokeRegrant{Granter: granter.String(), Regranter: regranter.String(), Grantee: grantee.String()}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevokeRegrant) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Regranter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid regranter address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+	if msg.Grantee == msg.Granter || msg.Grantee == msg.Regranter || msg.Regranter == msg.Granter {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "addresses must be different")
+	}
+
+	return nil
+}
+
+// GetSigners gets the regranter address associated with an Allowance
+// to revoke.
+func (msg MsgRevokeRegrant) GetSigners() []sdk.AccAddress {
+	regranter, _ := sdk.AccAddressFromBech32(msg.Regranter)
+	return []sdk.AccAddress{regranter}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRevokeRegrant) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRevokeRegrant) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRevokeRegrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
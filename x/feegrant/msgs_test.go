@@ -13,7 +13,9 @@ import (
 )
 
 func TestMsgGrantAllowance(t *testing.T) {
-	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	registry := codectypes.NewInterfaceRegistry()
+	feegrant.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
 	addr, _ := sdk.AccAddressFromBech32("cosmos1aeuqja06474dfrj7uqsvukm6rael982kk89mqr")
 	addr2, _ := sdk.AccAddressFromBech32("cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl")
 	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
@@ -132,3 +134,125 @@ func TestMsgRevokeAllowance(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgRegrantAllowance(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	feegrant.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	addr, _ := sdk.AccAddressFromBech32("cosmos1aeuqja06474dfrj7uqsvukm6rael982kk89mqr")
+	addr2, _ := sdk.AccAddressFromBech32("cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl")
+	addr3 := sdk.AccAddress("regranter___________")
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	threeHours := time.Now().Add(3 * time.Hour)
+	basic := &feegrant.BasicAllowance{
+		SpendLimit: atom,
+		Expiration: &threeHours,
+	}
+
+	cases := map[string]struct {
+		granter   sdk.AccAddress
+		regranter sdk.AccAddress
+		grantee   sdk.AccAddress
+		depth     uint32
+		valid     bool
+	}{
+		"valid": {
+			granter:   addr2,
+			regranter: addr3,
+			grantee:   addr,
+			depth:     feegrant.MaxRegrantDepth - 1,
+			valid:     true,
+		},
+		"no regranter": {
+			granter:   addr2,
+			regranter: sdk.AccAddress{},
+			grantee:   addr,
+			valid:     false,
+		},
+		"grantee == regranter": {
+			granter:   addr2,
+			regranter: addr,
+			grantee:   addr,
+			valid:     false,
+		},
+		"regranter == granter": {
+			granter:   addr2,
+			regranter: addr2,
+			grantee:   addr,
+			valid:     false,
+		},
+		"depth too high": {
+			granter:   addr2,
+			regranter: addr3,
+			grantee:   addr,
+			depth:     feegrant.MaxRegrantDepth,
+			valid:     false,
+		},
+	}
+
+	for _, tc := range cases {
+		msg, err := feegrant.NewMsgRegrantAllowance(basic, tc.granter, tc.regranter, tc.grantee, tc.depth)
+		require.NoError(t, err)
+		err = msg.ValidateBasic()
+
+		if tc.valid {
+			require.NoError(t, err)
+
+			addrSlice := msg.GetSigners()
+			require.True(t, tc.regranter.Equals(addrSlice[0]))
+
+			allowance, err := msg.GetFeeAllowanceI()
+			require.NoError(t, err)
+			require.Equal(t, basic, allowance)
+
+			err = msg.UnpackInterfaces(cdc)
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestMsgRevokeRegrant(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32("cosmos1aeuqja06474dfrj7uqsvukm6rael982kk89mqr")
+	addr2, _ := sdk.AccAddressFromBech32("cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl")
+	addr3 := sdk.AccAddress("regranter___________")
+
+	cases := map[string]struct {
+		granter   sdk.AccAddress
+		regranter sdk.AccAddress
+		grantee   sdk.AccAddress
+		valid     bool
+	}{
+		"valid": {
+			granter:   addr2,
+			regranter: addr3,
+			grantee:   addr,
+			valid:     true,
+		},
+		"no granter": {
+			granter:   sdk.AccAddress{},
+			regranter: addr3,
+			grantee:   addr,
+			valid:     false,
+		},
+		"grantee == regranter": {
+			granter:   addr2,
+			regranter: addr,
+			grantee:   addr,
+			valid:     false,
+		},
+	}
+
+	for _, tc := range cases {
+		msg := feegrant.NewMsgRevokeRegrant(tc.granter, tc.regranter, tc.grantee)
+		err := msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err)
+			addrSlice := msg.GetSigners()
+			require.True(t, tc.regranter.Equals(addrSlice[0]))
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
+
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
@@ -16,99 +18,415 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 
 	logger := keeper.Logger(ctx)
 
-	// delete dead proposals from store and burn theirs deposits. A proposal is dead when it's inactive and didn't get enough deposit on time to get into voting phase.
+	// delete dead proposals from store and burn or refund theirs deposits. A proposal is dead when it's inactive and didn't get enough deposit on time to get into voting phase.
 	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
 		keeper.DeleteProposal(ctx, proposal.ProposalId)
-		keeper.DeleteAndBurnDeposits(ctx, proposal.ProposalId)
+		burnReason := keeper.SettleDeposits(ctx, proposal.ProposalId, types.BurnReasonDropped)
 
 		// called when proposal become inactive
 		keeper.AfterProposalFailedMinDeposit(ctx, proposal.ProposalId)
 
+		emitProposalEvent(ctx, keeper, &types.EventProposalDropped{
+			ProposalId:   proposal.ProposalId,
+			TotalDeposit: proposal.TotalDeposit,
+			BurnReason:   string(burnReason),
+		})
+
+		logger.Info(
+			"proposal did not meet minimum deposit; deleted",
+			"proposal", proposal.ProposalId,
+			"title", proposal.GetTitle(),
+			"min_deposit", keeper.GetDepositParams(ctx).MinDeposit.String(),
+			"total_deposit", proposal.TotalDeposit.String(),
+			"burn_reason", burnReason,
+		)
+
+		return false
+	})
+
+	// take the participation checkpoint of the active proposals whose voting
+	// periods end within the quorum extension period, against which their
+	// participation is compared to decide whether to extend them
+	if extension := keeper.GetVotingParams(ctx).QuorumExtensionPeriod; extension > 0 {
+		keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time.Add(extension), func(proposal types.Proposal) bool {
+			keeper.CheckpointParticipation(ctx, proposal)
+			return false
+		})
+	}
+
+	// tally the emergency proposals whose Yes votes of the validators reached
+	// the emergency threshold of the bonded validator power right away, ending
+	// their voting periods early
+	keeper.IterateEmergencyProposalQueue(ctx, func(proposal types.Proposal) bool {
+		reached, support := keeper.EmergencySupermajorityReached(ctx, proposal)
+		if !reached {
+			return false
+		}
+
+		keeper.RemoveFromEmergencyProposalQueue(ctx, proposal.ProposalId)
+		keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+		proposal.VotingEndTime = ctx.BlockHeader().Time
+
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
-				types.EventTypeInactiveProposal,
+				types.EventTypeEmergencyFastTrack,
 				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
-				sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueProposalDropped),
+				sdk.NewAttribute(types.AttributeKeyValidatorSupport, support.String()),
 			),
 		)
 
 		logger.Info(
-			"proposal did not meet minimum deposit; deleted",
+			"emergency proposal reached the validator supermajority; voting period ended",
 			"proposal", proposal.ProposalId,
 			"title", proposal.GetTitle(),
-			"min_deposit", keeper.GetDepositParams(ctx).MinDeposit.String(),
-			"total_deposit", proposal.TotalDeposit.String(),
+			"validator_support", support.String(),
 		)
 
+		tallyProposal(ctx, keeper, proposal)
 		return false
 	})
 
 	// fetch active proposals whose voting periods have ended (are passed the block time)
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
-		var tagValue, logMsg string
+		// move a private proposal to its reveal period, at the end of which
+		// its revealed votes are tallied
+		if keeper.StartRevealPeriod(ctx, proposal) {
+			logger.Info(
+				"private proposal voting period ended; reveal period started",
+				"proposal", proposal.ProposalId,
+				"title", proposal.GetTitle(),
+			)
+
+			return false
+		}
 
-		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+		// give the proposal a second chance to reach quorum if enabled
+		if keeper.ExtendVotingPeriod(ctx, proposal) {
+			logger.Info(
+				"proposal did not reach quorum; voting period extended",
+				"proposal", proposal.ProposalId,
+				"title", proposal.GetTitle(),
+			)
 
-		if burnDeposits {
-			keeper.DeleteAndBurnDeposits(ctx, proposal.ProposalId)
+			return false
+		}
+
+		// give an expedited proposal which did not pass with the elevated
+		// quorum and threshold a regular voting period
+		if keeper.FallBackExpeditedProposal(ctx, proposal) {
+			logger.Info(
+				"expedited proposal did not pass; falling back to a regular voting period",
+				"proposal", proposal.ProposalId,
+				"title", proposal.GetTitle(),
+			)
+
+			return false
+		}
+
+		keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+		keeper.RemoveFromEmergencyProposalQueue(ctx, proposal.ProposalId)
+		tallyProposal(ctx, keeper, proposal)
+		return false
+	})
+
+	// fetch private proposals whose reveal periods have ended, burning the
+	// deposits of the unrevealed vote commitments before tallying them
+	keeper.IterateRevealProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
+		burned := keeper.BurnUnrevealedVoteCommitments(ctx, proposal.ProposalId)
+		if !burned.IsZero() {
+			logger.Info(
+				"burned the deposits of unrevealed vote commitments",
+				"proposal", proposal.ProposalId,
+				"burned_deposit", burned.String(),
+			)
+		}
+
+		keeper.RemoveFromRevealProposalQueue(ctx, proposal.ProposalId, proposal.RevealEndTime)
+		tallyProposal(ctx, keeper, proposal)
+		return false
+	})
+
+	// fetch optimistic proposals whose challenge windows have ended, which pass
+	// unless vetoed
+	keeper.IterateOptimisticProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
+		var (
+			result, logMsg string
+			burnReason     types.DepositBurnReason
+			execErr        error
+		)
+
+		vetoed, tallyResults := keeper.TallyOptimistic(ctx, proposal)
+
+		if vetoed {
+			burnReason = keeper.SettleDeposits(ctx, proposal.ProposalId, types.BurnReasonVeto)
+			proposal.Status = types.StatusRejected
+			result = types.AttributeValueProposalVetoed
+			logMsg = "vetoed"
 		} else {
 			keeper.RefundAndDeleteDeposits(ctx, proposal.ProposalId)
+			result, logMsg, execErr = passProposal(ctx, keeper, &proposal)
 		}
 
+		proposal.FinalTallyResult = tallyResults
+
+		keeper.SetProposal(ctx, proposal)
+		keeper.DeleteVotingPowerSnapshot(ctx, proposal.ProposalId)
+		keeper.RemoveFromOptimisticProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+		if proposal.Status != types.StatusScheduled {
+			keeper.InsertFinalizedProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+		}
+
+		keeper.AfterProposalVotingPeriodEnded(ctx, proposal.ProposalId, keeper.VoterFilter(ctx, proposal))
+
+		logger.Info(
+			"optimistic proposal challenge window ended",
+			"proposal", proposal.ProposalId,
+			"title", proposal.GetTitle(),
+			"result", logMsg,
+		)
+
+		emitProposalOutcome(ctx, keeper, proposal, result, burnReason, execErr)
+		return false
+	})
+
+	// fetch council proposals whose voting periods have ended, which pass with
+	// a simple majority of the council
+	keeper.IterateCouncilProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
+		var (
+			result, logMsg string
+			execErr        error
+		)
+
+		passes, tallyResults := keeper.TallyCouncil(ctx, proposal)
+
+		keeper.RefundAndDeleteDeposits(ctx, proposal.ProposalId)
 		if passes {
-			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
-			cacheCtx, writeCache := ctx.CacheContext()
-
-			// The proposal handler may execute state mutating logic depending
-			// on the proposal content. If the handler fails, no state mutation
-			// is written and the error message is logged.
-			err := handler(cacheCtx, proposal.GetContent())
-			if err == nil {
-				proposal.Status = types.StatusPassed
-				tagValue = types.AttributeValueProposalPassed
-				logMsg = "passed"
-
-				// The cached context is created with a new EventManager. However, since
-				// the proposal handler execution was successful, we want to track/keep
-				// any events emitted, so we re-emit to "merge" the events into the
-				// original Context's EventManager.
-				ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
-
-				// write state to the underlying multi-store
-				writeCache()
-			} else {
-				proposal.Status = types.StatusFailed
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed, but failed on execution: %s", err)
-			}
+			result, logMsg, execErr = passProposal(ctx, keeper, &proposal)
 		} else {
 			proposal.Status = types.StatusRejected
-			tagValue = types.AttributeValueProposalRejected
+			result = types.AttributeValueProposalRejected
 			logMsg = "rejected"
 		}
 
 		proposal.FinalTallyResult = tallyResults
 
 		keeper.SetProposal(ctx, proposal)
-		keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+		keeper.RemoveFromCouncilProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+		if proposal.Status != types.StatusScheduled {
+			keeper.InsertFinalizedProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+		}
 
-		// when proposal become active
-		keeper.AfterProposalVotingPeriodEnded(ctx, proposal.ProposalId)
+		keeper.AfterProposalVotingPeriodEnded(ctx, proposal.ProposalId, keeper.VoterFilter(ctx, proposal))
 
 		logger.Info(
-			"proposal tallied",
+			"council proposal voting period ended",
 			"proposal", proposal.ProposalId,
 			"title", proposal.GetTitle(),
 			"result", logMsg,
 		)
 
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeActiveProposal,
-				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
-				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
-			),
+		emitProposalOutcome(ctx, keeper, proposal, result, types.BurnReasonNone, execErr)
+		return false
+	})
+
+	// execute the passed proposals whose execution delay has elapsed
+	keeper.IterateExecutionQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
+		keeper.RemoveFromExecutionQueue(ctx, proposal.ProposalId, proposal.ExecutionTime)
+		result, logMsg, execErr := executeProposalAfterDependencies(ctx, keeper, &proposal)
+
+		keeper.SetProposal(ctx, proposal)
+		if proposal.Status == types.StatusScheduled {
+			logger.Info(
+				"scheduled proposal held until its dependencies are executed",
+				"proposal", proposal.ProposalId,
+				"title", proposal.GetTitle(),
+			)
+
+			return false
+		}
+		keeper.InsertFinalizedProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+		logger.Info(
+			"scheduled proposal executed",
+			"proposal", proposal.ProposalId,
+			"title", proposal.GetTitle(),
+			"result", logMsg,
 		)
+
+		emitProposalOutcome(ctx, keeper, proposal, result, types.BurnReasonNone, execErr)
 		return false
 	})
+
+	// execute the held proposals whose dependencies have all been executed,
+	// or fail those with a dependency which never will be. Proposals only
+	// depend on earlier ones, so that a chain of dependencies resolves within
+	// a single iteration by ascending proposal ID.
+	keeper.IterateDependencyQueue(ctx, func(proposal types.Proposal) bool {
+		if ready, err := keeper.CheckProposalDependencies(ctx, proposal); !ready && err == nil {
+			return false
+		}
+
+		keeper.RemoveFromDependencyQueue(ctx, proposal.ProposalId)
+		result, logMsg, execErr := executeProposalAfterDependencies(ctx, keeper, &proposal)
+
+		keeper.SetProposal(ctx, proposal)
+		keeper.InsertFinalizedProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+		logger.Info(
+			"held proposal executed",
+			"proposal", proposal.ProposalId,
+			"title", proposal.GetTitle(),
+			"result", logMsg,
+		)
+
+		emitProposalOutcome(ctx, keeper, proposal, result, types.BurnReasonNone, execErr)
+		return false
+	})
+
+	// move the proposals finalized before the archive retention period to the
+	// archive store to keep the proposal store small
+	keeper.ArchiveProposals(ctx)
+}
+
+// tallyProposal tallies a proposal whose voting, or for a private proposal
+// reveal, period has ended, then passes or rejects it and settles its
+// deposits.
+func tallyProposal(ctx sdk.Context, keeper keeper.Keeper, proposal types.Proposal) {
+	var (
+		result, logMsg string
+		passes         bool
+		burnReason     types.DepositBurnReason
+		tallyResults   types.TallyResult
+		execErr        error
+	)
+	if proposal.IsMultipleChoice() {
+		passes, burnReason, proposal.ChoiceTallyResults, proposal.WinningChoice = keeper.TallyChoices(ctx, proposal)
+		tallyResults = types.EmptyTallyResult()
+	} else {
+		passes, burnReason, tallyResults = keeper.Tally(ctx, proposal)
+	}
+
+	burnReason = keeper.SettleDeposits(ctx, proposal.ProposalId, burnReason)
+
+	if passes {
+		result, logMsg, execErr = passProposal(ctx, keeper, &proposal)
+	} else {
+		proposal.Status = types.StatusRejected
+		result = types.AttributeValueProposalRejected
+		logMsg = "rejected"
+	}
+
+	proposal.FinalTallyResult = tallyResults
+
+	keeper.SetProposal(ctx, proposal)
+	keeper.DeleteVotingPowerSnapshot(ctx, proposal.ProposalId)
+	if proposal.Status != types.StatusScheduled {
+		keeper.InsertFinalizedProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+	}
+
+	// when proposal become active
+	keeper.AfterProposalVotingPeriodEnded(ctx, proposal.ProposalId, keeper.VoterFilter(ctx, proposal))
+
+	keeper.Logger(ctx).Info(
+		"proposal tallied",
+		"proposal", proposal.ProposalId,
+		"title", proposal.GetTitle(),
+		"result", logMsg,
+		"burn_reason", burnReason,
+	)
+
+	emitProposalOutcome(ctx, keeper, proposal, result, burnReason, execErr)
+}
+
+// emitProposalOutcome emits an EventProposalPassed for a passed or scheduled
+// proposal and an EventProposalFailed otherwise, carrying the given result,
+// the reason why the deposits are burned if they are and, for a proposal which
+// failed on execution, the execution error.
+func emitProposalOutcome(
+	ctx sdk.Context, keeper keeper.Keeper, proposal types.Proposal, result string, burnReason types.DepositBurnReason, execErr error,
+) {
+	if proposal.Status == types.StatusPassed || proposal.Status == types.StatusScheduled {
+		emitProposalEvent(ctx, keeper, &types.EventProposalPassed{
+			ProposalId:       proposal.ProposalId,
+			Result:           result,
+			FinalTallyResult: proposal.FinalTallyResult,
+			WinningChoice:    proposal.WinningChoice,
+		})
+		return
+	}
+
+	event := &types.EventProposalFailed{
+		ProposalId:       proposal.ProposalId,
+		Result:           result,
+		FinalTallyResult: proposal.FinalTallyResult,
+		BurnReason:       string(burnReason),
+	}
+	if execErr != nil {
+		event.ExecutionError = execErr.Error()
+	}
+	emitProposalEvent(ctx, keeper, event)
+}
+
+// emitProposalEvent emits a typed proposal event, logging the error of an
+// event which cannot be encoded rather than halting the chain.
+func emitProposalEvent(ctx sdk.Context, keeper keeper.Keeper, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		keeper.Logger(ctx).Error("failed to emit proposal event", "event", proto.MessageName(event), "err", err)
+	}
+}
+
+// passProposal executes the content of a passed proposal or, if the execution
+// delay is enabled, schedules its execution. The content of an emergency
+// proposal is executed without delay. It returns the proposal result, log
+// message and execution error.
+func passProposal(ctx sdk.Context, keeper keeper.Keeper, proposal *types.Proposal) (result, logMsg string, err error) {
+	executionDelay := keeper.GetVotingParams(ctx).ExecutionDelay
+	if executionDelay <= 0 || proposal.IsEmergency {
+		return executeProposalAfterDependencies(ctx, keeper, proposal)
+	}
+
+	// schedule the execution of the proposal content after the execution
+	// delay, letting the accounts which disagree with it exit before it takes
+	// effect
+	proposal.Status = types.StatusScheduled
+	proposal.ExecutionTime = ctx.BlockHeader().Time.Add(executionDelay)
+	keeper.InsertExecutionQueue(ctx, proposal.ProposalId, proposal.ExecutionTime)
+
+	return types.AttributeValueProposalScheduled, fmt.Sprintf("passed, execution scheduled at %s", proposal.ExecutionTime), nil
+}
+
+// executeProposalAfterDependencies executes the content of a passed proposal
+// whose dependencies have all been executed. While some of them are pending,
+// the proposal is scheduled without execution time and held in the dependency
+// queue; if one of them will never be executed, the proposal fails without
+// executing its content. It returns the proposal result, log message and
+// execution error.
+func executeProposalAfterDependencies(ctx sdk.Context, keeper keeper.Keeper, proposal *types.Proposal) (result, logMsg string, err error) {
+	ready, err := keeper.CheckProposalDependencies(ctx, *proposal)
+	switch {
+	case err != nil:
+		proposal.Status = types.StatusFailed
+		keeper.AfterProposalExecuted(ctx, proposal.ProposalId, false, err.Error())
+
+		return types.AttributeValueProposalFailed, fmt.Sprintf("passed, but failed on execution: %s", err), err
+	case !ready:
+		proposal.Status = types.StatusScheduled
+		proposal.ExecutionTime = time.Time{}
+		keeper.InsertDependencyQueue(ctx, proposal.ProposalId)
+
+		return types.AttributeValueProposalHeld, "passed, execution held until its dependencies are executed", nil
+	default:
+		return executeProposal(ctx, keeper, proposal)
+	}
+}
+
+// executeProposal executes the content of a passed proposal and sets its
+// status to passed or, if the execution fails, to failed. It returns the
+// proposal result, log message and execution error.
+func executeProposal(ctx sdk.Context, keeper keeper.Keeper, proposal *types.Proposal) (result, logMsg string, err error) {
+	if err := keeper.RunProposalExecution(ctx, proposal); err != nil {
+		return types.AttributeValueProposalFailed, fmt.Sprintf("passed, but failed on execution: %s", err), err
+	}
+
+	return types.AttributeValueProposalPassed, "passed", nil
 }
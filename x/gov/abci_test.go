@@ -1,18 +1,25 @@
 package gov_test
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -64,11 +71,76 @@ func TestTickExpiredDepositPeriod(t *testing.T) {
 	require.True(t, inactiveQueue.Valid())
 	inactiveQueue.Close()
 
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	gov.EndBlocker(ctx, app.GovKeeper)
 
 	inactiveQueue = app.GovKeeper.InactiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
 	require.False(t, inactiveQueue.Valid())
 	inactiveQueue.Close()
+
+	events := typedEvents(t, ctx, &types.EventProposalDropped{})
+	require.Len(t, events, 1)
+	require.Equal(t, &types.EventProposalDropped{
+		ProposalId:   1,
+		TotalDeposit: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)),
+		BurnReason:   string(types.BurnReasonDropped),
+	}, events[0])
+}
+
+func TestTickExpiredDepositPeriodRefund(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 1, valTokens)
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	depositParams.BurnProposalDepositPrevote = false
+	app.GovKeeper.SetDepositParams(ctx, depositParams)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))
+	newProposalMsg, err := types.NewMsgSubmitProposal(
+		types.ContentFromProposalType("test", "test", types.ProposalTypeText), deposit, addrs[0],
+	)
+	require.NoError(t, err)
+
+	balance := app.BankKeeper.GetAllBalances(ctx, addrs[0])
+	res, err := keeper.NewMsgServerImpl(app.GovKeeper).SubmitProposal(sdk.WrapSDKContext(ctx), newProposalMsg)
+	require.NoError(t, err)
+	require.Equal(t, balance.Sub(deposit), app.BankKeeper.GetAllBalances(ctx, addrs[0]))
+
+	// the deposit of the dropped proposal is refunded rather than burned
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(depositParams.MaxDepositPeriod)).WithEventManager(sdk.NewEventManager())
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	_, ok := app.GovKeeper.GetProposal(ctx, res.ProposalId)
+	require.False(t, ok)
+	require.Empty(t, app.GovKeeper.GetDeposits(ctx, res.ProposalId))
+	require.Equal(t, balance, app.BankKeeper.GetAllBalances(ctx, addrs[0]))
+
+	events := typedEvents(t, ctx, &types.EventProposalDropped{})
+	require.Len(t, events, 1)
+	require.Equal(t, &types.EventProposalDropped{
+		ProposalId:   res.ProposalId,
+		TotalDeposit: deposit,
+	}, events[0])
+}
+
+// typedEvents returns the typed events of the same type as the given event
+// emitted on the event manager of the context.
+func typedEvents(t *testing.T, ctx sdk.Context, eventType proto.Message) []proto.Message {
+	t.Helper()
+
+	var events []proto.Message
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != proto.MessageName(eventType) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		events = append(events, msg)
+	}
+
+	return events
 }
 
 func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
@@ -365,6 +437,799 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	gov.EndBlocker(ctx, app.GovKeeper)
 }
 
+func TestEndBlockerProposalExecutionOutOfGas(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 1, valTokens)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.ExecutionGasLimit = 100
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	submit := func() uint64 {
+		content := paramproposal.NewParameterChangeProposal("Test", "description", []paramproposal.ParamChange{
+			{Subspace: stakingtypes.ModuleName, Key: string(stakingtypes.KeyMaxValidators), Value: "1"},
+		})
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, content)
+		require.NoError(t, err)
+
+		_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], app.GovKeeper.GetDepositParams(ctx).MinDeposit)
+		require.NoError(t, err)
+		require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+
+		return proposal.ProposalId
+	}
+
+	// the execution of the parameter change runs out of gas
+	proposalID := submit()
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(votingParams.VotingPeriod)).WithEventManager(sdk.NewEventManager())
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusFailed, proposal.Status)
+	require.NotEqual(t, uint32(1), app.StakingKeeper.MaxValidators(ctx))
+
+	var oogEvent sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeExecutionOutOfGas {
+			oogEvent = event
+		}
+	}
+	require.Len(t, oogEvent.Attributes, 3)
+	require.Equal(t, fmt.Sprintf("%d", proposalID), string(oogEvent.Attributes[0].Value))
+	gasUsed, err := strconv.ParseUint(string(oogEvent.Attributes[1].Value), 10, 64)
+	require.NoError(t, err)
+	require.Greater(t, gasUsed, uint64(100))
+	require.Equal(t, "100", string(oogEvent.Attributes[2].Value))
+
+	events := typedEvents(t, ctx, &types.EventProposalFailed{})
+	require.Len(t, events, 1)
+	failed := events[0].(*types.EventProposalFailed)
+	require.Equal(t, proposalID, failed.ProposalId)
+	require.Equal(t, types.AttributeValueProposalFailed, failed.Result)
+	require.Contains(t, failed.ExecutionError, "out of gas")
+
+	// the parameter change is executed once the limit is lifted
+	votingParams.ExecutionGasLimit = 0
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+	proposalID = submit()
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(votingParams.VotingPeriod))
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.Equal(t, uint32(1), app.StakingKeeper.MaxValidators(ctx))
+}
+
+func TestEndBlockerRetryProposalExecution(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	retryWindow := 24 * time.Hour
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.ExecutionGasLimit = 100
+	votingParams.ExecutionRetryWindow = retryWindow
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	submit := func(content types.Content, dependsOn ...uint64) uint64 {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, content)
+		require.NoError(t, err)
+		proposal.DependsOn = dependsOn
+		app.GovKeeper.SetProposal(ctx, proposal)
+
+		_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], app.GovKeeper.GetDepositParams(ctx).MinDeposit)
+		require.NoError(t, err)
+		require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+
+		return proposal.ProposalId
+	}
+
+	// the execution of the parameter change runs out of gas, opening its
+	// retry window, and the proposal depending on it is held
+	proposalID := submit(paramproposal.NewParameterChangeProposal("Test", "description", []paramproposal.ParamChange{
+		{Subspace: stakingtypes.ModuleName, Key: string(stakingtypes.KeyMaxValidators), Value: "1"},
+	}))
+	dependent := submit(TestProposal, proposalID)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(votingParams.VotingPeriod))
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusFailed, proposal.Status)
+	require.Equal(t, uint32(1), proposal.ExecutionAttempts)
+	require.Equal(t, ctx.BlockTime().Add(retryWindow), proposal.RetryEndTime)
+	proposal, ok = app.GovKeeper.GetProposal(ctx, dependent)
+	require.True(t, ok)
+	require.Equal(t, types.StatusScheduled, proposal.Status)
+
+	// only the gov module account can retry the execution
+	govMsgSvr := keeper.NewMsgServerImpl(app.GovKeeper)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	_, err := govMsgSvr.RetryProposalExecution(sdk.WrapSDKContext(ctx), types.NewMsgRetryProposalExecution(addrs[1], proposalID))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, uint32(1), proposal.ExecutionAttempts)
+
+	// a retry failing again keeps the retry window
+	govAccount := authtypes.NewModuleAddress(types.ModuleName)
+	msg := types.NewMsgRetryProposalExecution(govAccount, proposalID)
+	res, err := govMsgSvr.RetryProposalExecution(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, types.StatusFailed, res.Status)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, uint32(2), proposal.ExecutionAttempts)
+	require.Equal(t, ctx.BlockTime().Add(retryWindow-time.Hour), proposal.RetryEndTime)
+	require.NotEqual(t, uint32(1), app.StakingKeeper.MaxValidators(ctx))
+
+	// the parameter change is executed by a retry once the limit is lifted
+	votingParams.ExecutionGasLimit = 0
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err = govMsgSvr.RetryProposalExecution(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, types.StatusPassed, res.Status)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.Equal(t, uint32(3), proposal.ExecutionAttempts)
+	require.Equal(t, uint32(1), app.StakingKeeper.MaxValidators(ctx))
+	events := typedEvents(t, ctx, &types.EventProposalPassed{})
+	require.Len(t, events, 1)
+	require.Equal(t, proposalID, events[0].(*types.EventProposalPassed).ProposalId)
+
+	// a passed proposal cannot be retried
+	_, err = govMsgSvr.RetryProposalExecution(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrExecutionNotRetryable)
+
+	// and the held proposal is executed in the following block
+	gov.EndBlocker(ctx, app.GovKeeper)
+	proposal, ok = app.GovKeeper.GetProposal(ctx, dependent)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+
+	// the execution cannot be retried once the retry window closed
+	votingParams.ExecutionGasLimit = 100
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+	proposalID = submit(paramproposal.NewParameterChangeProposal("Test", "description", []paramproposal.ParamChange{
+		{Subspace: stakingtypes.ModuleName, Key: string(stakingtypes.KeyMaxValidators), Value: "2"},
+	}))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(votingParams.VotingPeriod))
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(retryWindow))
+	_, err = govMsgSvr.RetryProposalExecution(sdk.WrapSDKContext(ctx), types.NewMsgRetryProposalExecution(govAccount, proposalID))
+	require.ErrorIs(t, err, types.ErrExecutionNotRetryable)
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, uint32(1), proposal.ExecutionAttempts)
+}
+
+func TestEndBlockerScheduledProposalExecution(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 1, valTokens)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	delay := 6 * time.Hour
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.ExecutionDelay = delay
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	content := paramproposal.NewParameterChangeProposal("Test", "description", []paramproposal.ParamChange{
+		{Subspace: stakingtypes.ModuleName, Key: string(stakingtypes.KeyMaxValidators), Value: "1"},
+	})
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, content)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalId
+
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, addrs[0], app.GovKeeper.GetDepositParams(ctx).MinDeposit)
+	require.NoError(t, err)
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+
+	// the passed proposal is scheduled for execution after the delay
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(votingParams.VotingPeriod))
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusScheduled, proposal.Status)
+	require.Equal(t, ctx.BlockTime().Add(delay), proposal.ExecutionTime)
+	require.NotEqual(t, uint32(1), app.StakingKeeper.MaxValidators(ctx))
+
+	executionQueue := app.GovKeeper.ExecutionQueueIterator(ctx, proposal.ExecutionTime)
+	require.True(t, executionQueue.Valid())
+	executionQueue.Close()
+
+	ctx = ctx.WithBlockTime(proposal.ExecutionTime.Add(-time.Second))
+	gov.EndBlocker(ctx, app.GovKeeper)
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusScheduled, proposal.Status)
+
+	// the proposal is executed once the delay elapsed
+	ctx = ctx.WithBlockTime(proposal.ExecutionTime).WithEventManager(sdk.NewEventManager())
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.Equal(t, uint32(1), app.StakingKeeper.MaxValidators(ctx))
+	events := typedEvents(t, ctx, &types.EventProposalPassed{})
+	require.Len(t, events, 1)
+	passed := events[0].(*types.EventProposalPassed)
+	require.Equal(t, proposalID, passed.ProposalId)
+	require.Equal(t, types.AttributeValueProposalPassed, passed.Result)
+	require.True(t, proposal.FinalTallyResult.Equals(passed.FinalTallyResult))
+
+	executionQueue = app.GovKeeper.ExecutionQueueIterator(ctx, proposal.ExecutionTime)
+	require.False(t, executionQueue.Valid())
+	executionQueue.Close()
+}
+
+func TestEndBlockerProposalDependencies(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 5, valTokens)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	submit := func(content types.Content, dependsOn ...uint64) uint64 {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, content)
+		require.NoError(t, err)
+		require.NoError(t, app.GovKeeper.ValidateProposalDependencies(ctx, proposal.ProposalId, dependsOn))
+		proposal.DependsOn = dependsOn
+		app.GovKeeper.SetProposal(ctx, proposal)
+		return proposal.ProposalId
+	}
+	activate := func(proposalID uint64, vote bool) {
+		_, err := app.GovKeeper.AddDeposit(ctx, proposalID, addrs[proposalID], app.GovKeeper.GetDepositParams(ctx).MinDeposit)
+		require.NoError(t, err)
+		if vote {
+			require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+		}
+	}
+
+	first := submit(paramproposal.NewParameterChangeProposal("Test", "description", []paramproposal.ParamChange{
+		{Subspace: stakingtypes.ModuleName, Key: string(stakingtypes.KeyMaxValidators), Value: "1"},
+	}))
+	second := submit(paramproposal.NewParameterChangeProposal("Test", "description", []paramproposal.ParamChange{
+		{Subspace: stakingtypes.ModuleName, Key: string(stakingtypes.KeyHistoricalEntries), Value: "5"},
+	}), first)
+	rejected := submit(TestProposal)
+	dependent := submit(TestProposal, rejected)
+
+	// proposals can only depend on earlier proposals
+	require.ErrorIs(t, app.GovKeeper.ValidateProposalDependencies(ctx, first, []uint64{second}), types.ErrInvalidDependency)
+	require.ErrorIs(t, app.GovKeeper.ValidateProposalDependencies(ctx, dependent+1, []uint64{dependent + 1}), types.ErrInvalidDependency)
+
+	// the dependent proposals pass before the proposals they depend on
+	activate(second, true)
+	activate(dependent, true)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	activate(first, true)
+	activate(rejected, false)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod - time.Hour)).WithEventManager(sdk.NewEventManager())
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	// and are held until the proposals they depend on are executed
+	for _, proposalID := range []uint64{second, dependent} {
+		proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+		require.True(t, ok)
+		require.Equal(t, types.StatusScheduled, proposal.Status)
+		require.True(t, proposal.ExecutionTime.IsZero())
+	}
+	require.NotEqual(t, uint32(5), app.StakingKeeper.HistoricalEntries(ctx))
+	events := typedEvents(t, ctx, &types.EventProposalPassed{})
+	require.Len(t, events, 2)
+	require.Equal(t, types.AttributeValueProposalHeld, events[0].(*types.EventProposalPassed).Result)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	// the proposal whose dependency passed is executed in the same block
+	proposal, ok := app.GovKeeper.GetProposal(ctx, first)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	proposal, ok = app.GovKeeper.GetProposal(ctx, second)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.Equal(t, uint32(1), app.StakingKeeper.MaxValidators(ctx))
+	require.Equal(t, uint32(5), app.StakingKeeper.HistoricalEntries(ctx))
+
+	// while the proposal whose dependency was rejected fails
+	proposal, ok = app.GovKeeper.GetProposal(ctx, rejected)
+	require.True(t, ok)
+	require.Equal(t, types.StatusRejected, proposal.Status)
+	proposal, ok = app.GovKeeper.GetProposal(ctx, dependent)
+	require.True(t, ok)
+	require.Equal(t, types.StatusFailed, proposal.Status)
+
+	var dependencyFailed bool
+	for _, event := range typedEvents(t, ctx, &types.EventProposalFailed{}) {
+		failed := event.(*types.EventProposalFailed)
+		if failed.ProposalId == dependent {
+			dependencyFailed = strings.Contains(failed.ExecutionError, types.ErrDependencyFailed.Error())
+		}
+	}
+	require.True(t, dependencyFailed)
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(app.GetKey(types.StoreKey)), types.DependencyQueuePrefix)
+	require.False(t, iterator.Valid())
+	iterator.Close()
+
+	// a rejected proposal cannot be depended on
+	require.ErrorIs(t, app.GovKeeper.ValidateProposalDependencies(ctx, dependent+1, []uint64{rejected}), types.ErrInvalidDependency)
+}
+
+func TestEndBlockerOptimisticProposal(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])}, []int64{10, 10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	challengeWindow := 12 * time.Hour
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.OptimisticVotingPeriod = challengeWindow
+	votingParams.OptimisticAuthorizedAddresses = []string{addrs[0].String()}
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	submit := func(key []byte, value string) uint64 {
+		content := paramproposal.NewParameterChangeProposal("Test", "description", []paramproposal.ParamChange{
+			{Subspace: stakingtypes.ModuleName, Key: string(key), Value: value},
+		})
+		proposal, err := app.GovKeeper.SubmitOptimisticProposal(ctx, content, addrs[0])
+		require.NoError(t, err)
+		require.True(t, proposal.IsOptimistic)
+
+		_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], app.GovKeeper.GetDepositParams(ctx).MinDeposit)
+		require.NoError(t, err)
+		return proposal.ProposalId
+	}
+
+	passingID := submit(stakingtypes.KeyMaxEntries, "1")
+	vetoedID := submit(stakingtypes.KeyMaxValidators, "1")
+
+	// the proposals are in the optimistic queue for the challenge window
+	proposal, ok := app.GovKeeper.GetProposal(ctx, vetoedID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, ctx.BlockTime().Add(challengeWindow), proposal.VotingEndTime)
+	activeQueue := app.GovKeeper.ActiveProposalQueueIterator(ctx, proposal.VotingEndTime)
+	require.False(t, activeQueue.Valid())
+	activeQueue.Close()
+
+	// Yes and No votes don't count, but a veto by more than the optimistic
+	// veto threshold of the bonded stake rejects the proposal
+	require.NoError(t, app.GovKeeper.AddVote(ctx, passingID, addrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, vetoedID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, vetoedID, addrs[1], types.NewNonSplitVoteOption(types.OptionNoWithVeto)))
+
+	ctx = ctx.WithBlockTime(proposal.VotingEndTime).WithEventManager(sdk.NewEventManager())
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, passingID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.Equal(t, uint32(1), app.StakingKeeper.MaxEntries(ctx))
+	events := typedEvents(t, ctx, &types.EventProposalPassed{})
+	require.Len(t, events, 1)
+	passed := events[0].(*types.EventProposalPassed)
+	require.Equal(t, passingID, passed.ProposalId)
+	require.Equal(t, types.AttributeValueProposalPassed, passed.Result)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, vetoedID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusRejected, proposal.Status)
+	require.False(t, proposal.FinalTallyResult.NoWithVeto.IsZero())
+	require.NotEqual(t, uint32(1), app.StakingKeeper.MaxValidators(ctx))
+	require.Empty(t, app.GovKeeper.GetDeposits(ctx, vetoedID))
+	events = typedEvents(t, ctx, &types.EventProposalFailed{})
+	require.Len(t, events, 1)
+	vetoed := events[0].(*types.EventProposalFailed)
+	require.Equal(t, vetoedID, vetoed.ProposalId)
+	require.Equal(t, types.AttributeValueProposalVetoed, vetoed.Result)
+	require.True(t, proposal.FinalTallyResult.Equals(vetoed.FinalTallyResult))
+	require.Empty(t, vetoed.ExecutionError)
+	require.Equal(t, string(types.BurnReasonVeto), vetoed.BurnReason)
+
+	optimisticQueue := app.GovKeeper.OptimisticProposalQueueIterator(ctx, proposal.VotingEndTime)
+	require.False(t, optimisticQueue.Valid())
+	optimisticQueue.Close()
+}
+
+func TestEndBlockerCouncilProposal(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 4, valTokens)
+
+	votingPeriod := time.Hour
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.CouncilVotingPeriod = votingPeriod
+	votingParams.CouncilMembers = []string{addrs[0].String(), addrs[1].String(), addrs[2].String()}
+	votingParams.CouncilProposalTypes = []string{"/cosmos.params.v1beta1.ParameterChangeProposal"}
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	// a council member and a non council member are validators
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	councilVal, otherVal := sdk.ValAddress(addrs[2]), sdk.ValAddress(addrs[3])
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{councilVal, otherVal}, []int64{10, 10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	submit := func(key []byte, value string) uint64 {
+		content := paramproposal.NewParameterChangeProposal("Test", "description", []paramproposal.ParamChange{
+			{Subspace: stakingtypes.ModuleName, Key: string(key), Value: value},
+		})
+		proposal, err := app.GovKeeper.SubmitCouncilProposal(ctx, content, addrs[0])
+		require.NoError(t, err)
+		require.True(t, proposal.IsCouncil)
+		return proposal.ProposalId
+	}
+
+	passingID := submit(stakingtypes.KeyMaxEntries, "1")
+	rejectedID := submit(stakingtypes.KeyMaxValidators, "1")
+
+	// the proposals are in the council queue, not in the active one
+	proposal, ok := app.GovKeeper.GetProposal(ctx, rejectedID)
+	require.True(t, ok)
+	require.Equal(t, ctx.BlockTime().Add(votingPeriod), proposal.VotingEndTime)
+	activeQueue := app.GovKeeper.ActiveProposalQueueIterator(ctx, proposal.VotingEndTime)
+	require.False(t, activeQueue.Valid())
+	activeQueue.Close()
+
+	// two of the three members pass a proposal, regardless of their stake,
+	// while a single Yes vote doesn't
+	require.NoError(t, app.GovKeeper.AddVote(ctx, passingID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, passingID, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, rejectedID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, rejectedID, addrs[1], types.NewNonSplitVoteOption(types.OptionAbstain)))
+	require.Error(t, app.GovKeeper.AddVote(ctx, rejectedID, addrs[3], types.NewNonSplitVoteOption(types.OptionYes)))
+
+	ctx = ctx.WithBlockTime(proposal.VotingEndTime).WithEventManager(sdk.NewEventManager())
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, passingID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.Equal(t, sdk.NewInt(2), proposal.FinalTallyResult.Yes)
+	require.Equal(t, uint32(1), app.StakingKeeper.MaxEntries(ctx))
+	events := typedEvents(t, ctx, &types.EventProposalPassed{})
+	require.Len(t, events, 1)
+	require.Equal(t, passingID, events[0].(*types.EventProposalPassed).ProposalId)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, rejectedID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusRejected, proposal.Status)
+	require.NotEqual(t, uint32(1), app.StakingKeeper.MaxValidators(ctx))
+	events = typedEvents(t, ctx, &types.EventProposalFailed{})
+	require.Len(t, events, 1)
+	rejected := events[0].(*types.EventProposalFailed)
+	require.Equal(t, rejectedID, rejected.ProposalId)
+	require.Equal(t, types.AttributeValueProposalRejected, rejected.Result)
+
+	councilQueue := app.GovKeeper.CouncilProposalQueueIterator(ctx, proposal.VotingEndTime)
+	require.False(t, councilQueue.Valid())
+	councilQueue.Close()
+
+	// the council member missed both proposals, while the validator which is
+	// not allowed to vote on them did not miss any
+	require.Equal(t, uint32(2), app.StakingKeeper.GetGovParticipation(ctx, councilVal).MissedProposals)
+	require.Zero(t, app.StakingKeeper.GetGovParticipation(ctx, otherVal).MissedProposals)
+}
+
+func TestEndBlockerMultipleChoiceProposal(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 3, valTokens)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1]), sdk.ValAddress(addrs[2])}, []int64{10, 10, 5})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	newChoice := func(title, maxEntries string) types.ProposalChoice {
+		var content types.Content
+		if maxEntries != "" {
+			content = paramproposal.NewParameterChangeProposal(title, "description", []paramproposal.ParamChange{
+				{Subspace: stakingtypes.ModuleName, Key: string(stakingtypes.KeyMaxEntries), Value: maxEntries},
+			})
+		}
+		choice, err := types.NewProposalChoice(title, content)
+		require.NoError(t, err)
+		return choice
+	}
+	choices := []types.ProposalChoice{newChoice("Keep", ""), newChoice("Two", "2"), newChoice("Three", "3")}
+
+	submit := func() uint64 {
+		proposal, err := app.GovKeeper.SubmitMultipleChoiceProposal(ctx, TestProposal, choices)
+		require.NoError(t, err)
+		require.True(t, proposal.IsMultipleChoice())
+
+		_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], app.GovKeeper.GetDepositParams(ctx).MinDeposit)
+		require.NoError(t, err)
+		return proposal.ProposalId
+	}
+
+	passingID := submit()
+	tiedID := submit()
+
+	// multiple-choice proposals are only voted on with choice votes
+	require.ErrorIs(t, app.GovKeeper.AddVote(ctx, passingID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)), types.ErrInvalidVote)
+	require.ErrorIs(t, app.GovKeeper.AddChoiceVote(ctx, passingID, addrs[0], 3), types.ErrInvalidVote)
+
+	require.NoError(t, app.GovKeeper.AddChoiceVote(ctx, passingID, addrs[0], 2))
+	require.NoError(t, app.GovKeeper.AddChoiceVote(ctx, passingID, addrs[1], 2))
+	require.NoError(t, app.GovKeeper.AddChoiceVote(ctx, passingID, addrs[2], 1))
+	require.NoError(t, app.GovKeeper.AddChoiceVote(ctx, tiedID, addrs[0], 0))
+	require.NoError(t, app.GovKeeper.AddChoiceVote(ctx, tiedID, addrs[1], 1))
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, passingID)
+	require.True(t, ok)
+	ctx = ctx.WithBlockTime(proposal.VotingEndTime).WithEventManager(sdk.NewEventManager())
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, passingID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.Equal(t, uint32(2), proposal.WinningChoice)
+	require.Equal(t, []sdk.Int{
+		sdk.ZeroInt(),
+		sdk.TokensFromConsensusPower(5, sdk.DefaultPowerReduction),
+		sdk.TokensFromConsensusPower(20, sdk.DefaultPowerReduction),
+	}, proposal.ChoiceTallyResults)
+	require.Equal(t, uint32(3), app.StakingKeeper.MaxEntries(ctx))
+	require.Empty(t, app.GovKeeper.GetChoiceVotes(ctx, passingID))
+	events := typedEvents(t, ctx, &types.EventProposalPassed{})
+	require.Len(t, events, 1)
+	passed := events[0].(*types.EventProposalPassed)
+	require.Equal(t, passingID, passed.ProposalId)
+	require.Equal(t, types.AttributeValueProposalPassed, passed.Result)
+	require.Equal(t, uint32(2), passed.WinningChoice)
+
+	// a tie between the leading choices rejects the proposal
+	proposal, ok = app.GovKeeper.GetProposal(ctx, tiedID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusRejected, proposal.Status)
+	require.Equal(t, uint32(0), proposal.WinningChoice)
+}
+
+func TestEndBlockerEmergencyProposal(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 3, valTokens)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1]), sdk.ValAddress(addrs[2])}, []int64{10, 10, 5})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	content := paramproposal.NewParameterChangeProposal("Test", "description", []paramproposal.ParamChange{
+		{Subspace: stakingtypes.ModuleName, Key: string(stakingtypes.KeyMaxEntries), Value: "1"},
+	})
+
+	// emergency proposals are disabled with a zero emergency threshold
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+	tallyParams.EmergencyThreshold = sdk.ZeroDec()
+	app.GovKeeper.SetTallyParams(ctx, tallyParams)
+	_, err := app.GovKeeper.SubmitEmergencyProposal(ctx, content)
+	require.ErrorIs(t, err, types.ErrEmergencyDisabled)
+
+	tallyParams.EmergencyThreshold = types.DefaultEmergencyThreshold
+	app.GovKeeper.SetTallyParams(ctx, tallyParams)
+
+	// the execution delay does not apply to emergency proposals
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.ExecutionDelay = time.Hour
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	proposal, err := app.GovKeeper.SubmitEmergencyProposal(ctx, content)
+	require.NoError(t, err)
+	require.True(t, proposal.IsEmergency)
+	proposalID := proposal.ProposalId
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, addrs[0], app.GovKeeper.GetDepositParams(ctx).MinDeposit)
+	require.NoError(t, err)
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+	votingEndTime := proposal.VotingEndTime
+
+	// a validator holding less than the emergency threshold of the bonded
+	// validator power doesn't fast-track the proposal
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	reached, _ := app.GovKeeper.EmergencySupermajorityReached(ctx, proposal)
+	require.False(t, reached)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute)).WithEventManager(sdk.NewEventManager())
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+	require.NotEqual(t, uint32(1), app.StakingKeeper.MaxEntries(ctx))
+
+	// a supermajority of the validators fast-tracks the proposal, which is
+	// tallied and executed at the end of the block
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
+	reached, support := app.GovKeeper.EmergencySupermajorityReached(ctx, proposal)
+	require.True(t, reached)
+	require.True(t, support.GTE(types.DefaultEmergencyThreshold))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute)).WithEventManager(sdk.NewEventManager())
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.Equal(t, ctx.BlockTime(), proposal.VotingEndTime)
+	require.Equal(t, uint32(1), app.StakingKeeper.MaxEntries(ctx))
+
+	var fastTracked bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeEmergencyFastTrack {
+			fastTracked = true
+		}
+	}
+	require.True(t, fastTracked)
+	events := typedEvents(t, ctx, &types.EventProposalPassed{})
+	require.Len(t, events, 1)
+	require.Equal(t, proposalID, events[0].(*types.EventProposalPassed).ProposalId)
+
+	// the proposal left the active and emergency proposal queues
+	activeQueue := app.GovKeeper.ActiveProposalQueueIterator(ctx, votingEndTime)
+	require.False(t, activeQueue.Valid())
+	activeQueue.Close()
+	app.GovKeeper.IterateEmergencyProposalQueue(ctx, func(proposal types.Proposal) bool {
+		t.Fatalf("proposal %d left in the emergency proposal queue", proposal.ProposalId)
+		return true
+	})
+}
+
+func TestEndBlockerPrivateProposal(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 3, valTokens)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1]), sdk.ValAddress(addrs[2])}, []int64{10, 10, 5})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// private proposals are disabled without a reveal period
+	_, err := app.GovKeeper.SubmitPrivateProposal(ctx, TestProposal)
+	require.ErrorIs(t, err, types.ErrPrivateDisabled)
+
+	revealPeriod := 12 * time.Hour
+	commitmentDeposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.RevealPeriod = revealPeriod
+	votingParams.VoteCommitmentDeposit = commitmentDeposit
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	proposal, err := app.GovKeeper.SubmitPrivateProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	require.True(t, proposal.IsPrivate)
+	proposalID := proposal.ProposalId
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, addrs[0], app.GovKeeper.GetDepositParams(ctx).MinDeposit)
+	require.NoError(t, err)
+
+	yes := types.NewNonSplitVoteOption(types.OptionYes)
+	no := types.NewNonSplitVoteOption(types.OptionNo)
+
+	// private proposals are only voted on with vote commitments
+	require.ErrorIs(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], yes), types.ErrInvalidVote)
+
+	balances := make([]sdk.Coins, len(addrs))
+	for i, addr := range addrs {
+		balances[i] = app.BankKeeper.GetAllBalances(ctx, addr)
+	}
+
+	require.NoError(t, app.GovKeeper.CommitVote(ctx, proposalID, addrs[0], types.VoteCommitmentHash(proposalID, addrs[0], yes, "salt0")))
+	require.NoError(t, app.GovKeeper.CommitVote(ctx, proposalID, addrs[1], types.VoteCommitmentHash(proposalID, addrs[1], no, "salt1")))
+	require.NoError(t, app.GovKeeper.CommitVote(ctx, proposalID, addrs[2], types.VoteCommitmentHash(proposalID, addrs[2], no, "salt2")))
+
+	// recommitting replaces the commitment without escrowing another deposit
+	require.NoError(t, app.GovKeeper.CommitVote(ctx, proposalID, addrs[1], types.VoteCommitmentHash(proposalID, addrs[1], yes, "salt1")))
+	require.Equal(t, balances[1].Sub(commitmentDeposit), app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+	require.Len(t, app.GovKeeper.GetVoteCommitments(ctx, proposalID), 3)
+
+	// votes are revealed after the voting period
+	require.ErrorIs(t, app.GovKeeper.RevealVote(ctx, proposalID, addrs[0], yes, "salt0"), types.ErrInactiveProposal)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	ctx = ctx.WithBlockTime(proposal.VotingEndTime).WithEventManager(sdk.NewEventManager())
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusRevealPeriod, proposal.Status)
+	require.Equal(t, proposal.VotingEndTime.Add(revealPeriod), proposal.RevealEndTime)
+	activeQueue := app.GovKeeper.ActiveProposalQueueIterator(ctx, proposal.VotingEndTime)
+	require.False(t, activeQueue.Valid())
+	activeQueue.Close()
+
+	require.ErrorIs(t, app.GovKeeper.CommitVote(ctx, proposalID, addrs[0], types.VoteCommitmentHash(proposalID, addrs[0], no, "salt0")), types.ErrInactiveProposal)
+	require.ErrorIs(t, app.GovKeeper.RevealVote(ctx, proposalID, addrs[0], yes, "wrong"), types.ErrInvalidVoteReveal)
+	require.ErrorIs(t, app.GovKeeper.RevealVote(ctx, proposalID, addrs[1], no, "salt1"), types.ErrInvalidVoteReveal)
+
+	require.NoError(t, app.GovKeeper.RevealVote(ctx, proposalID, addrs[0], yes, "salt0"))
+	require.NoError(t, app.GovKeeper.RevealVote(ctx, proposalID, addrs[1], yes, "salt1"))
+	require.Equal(t, balances[0], app.BankKeeper.GetAllBalances(ctx, addrs[0]))
+	require.Equal(t, balances[1], app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+	_, found := app.GovKeeper.GetVoteCommitment(ctx, proposalID, addrs[0])
+	require.False(t, found)
+	vote, found := app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
+	require.True(t, found)
+	require.Equal(t, []types.WeightedVoteOption(yes), vote.Options)
+
+	// the deposit of the unrevealed vote commitment is burned before the
+	// revealed votes are tallied
+	ctx = ctx.WithBlockTime(proposal.RevealEndTime).WithEventManager(sdk.NewEventManager())
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.Equal(t, sdk.TokensFromConsensusPower(20, sdk.DefaultPowerReduction), proposal.FinalTallyResult.Yes)
+	require.True(t, proposal.FinalTallyResult.No.IsZero())
+	require.Empty(t, app.GovKeeper.GetVoteCommitments(ctx, proposalID))
+	require.Equal(t, balances[2].Sub(commitmentDeposit), app.BankKeeper.GetAllBalances(ctx, addrs[2]))
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeUnrevealedVote,
+		sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		sdk.NewAttribute(types.AttributeKeyVoter, addrs[2].String()),
+		sdk.NewAttribute(types.AttributeKeyBurnedDeposit, commitmentDeposit.String()),
+	))
+
+	revealQueue := app.GovKeeper.RevealProposalQueueIterator(ctx, proposal.RevealEndTime)
+	require.False(t, revealQueue.Valid())
+	revealQueue.Close()
+}
+
 func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sdk.Context, addrs []sdk.ValAddress, powerAmt []int64) {
 	require.True(t, len(addrs) <= len(pubkeys), "Not enough pubkeys specified at top of file.")
 
@@ -380,3 +1245,169 @@ func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sd
 		require.NotNil(t, res)
 	}
 }
+
+func TestTickVotingPeriodQuorumExtension(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 3, valTokens)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1]), sdk.ValAddress(addrs[2])}, []int64{10, 10, 5})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	extension := time.Hour
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.QuorumExtensionPeriod = extension
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	submit := func() types.Proposal {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+
+		_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], app.GovKeeper.GetDepositParams(ctx).MinDeposit)
+		require.NoError(t, err)
+
+		proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+		require.True(t, ok)
+		return proposal
+	}
+
+	rising := submit()
+	flat := submit()
+	silent := submit()
+	votingEndTime := rising.VotingEndTime
+
+	// the vote on the flat proposal is cast before the participation checkpoint
+	require.NoError(t, app.GovKeeper.AddVote(ctx, flat.ProposalId, addrs[2], types.NewNonSplitVoteOption(types.OptionYes)))
+
+	ctx = ctx.WithBlockTime(votingEndTime.Add(-extension))
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	for _, proposalID := range []uint64{rising.ProposalId, flat.ProposalId, silent.ProposalId} {
+		snapshot, found := app.GovKeeper.GetVotingPowerSnapshot(ctx, proposalID)
+		require.True(t, found)
+		require.NotNil(t, snapshot.CheckpointVotingPower)
+	}
+
+	// the vote on the rising proposal is cast after the participation checkpoint
+	require.NoError(t, app.GovKeeper.AddVote(ctx, rising.ProposalId, addrs[2], types.NewNonSplitVoteOption(types.OptionYes)))
+
+	// none of the proposals reached quorum, but only the participation in the
+	// rising proposal has risen since the checkpoint, so only its voting period
+	// is extended
+	ctx = ctx.WithBlockTime(votingEndTime).WithEventManager(sdk.NewEventManager())
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, rising.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+	require.True(t, proposal.VotingPeriodExtended)
+	require.Equal(t, votingEndTime.Add(extension), proposal.VotingEndTime)
+
+	extended := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeVotingPeriodExtended {
+			extended++
+		}
+	}
+	require.Equal(t, 1, extended)
+
+	for _, proposalID := range []uint64{flat.ProposalId, silent.ProposalId} {
+		proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+		require.True(t, ok)
+		require.Equal(t, types.StatusRejected, proposal.Status)
+		require.False(t, proposal.VotingPeriodExtended)
+	}
+
+	activeQueue := app.GovKeeper.ActiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+	require.False(t, activeQueue.Valid())
+	activeQueue.Close()
+
+	// the proposal is tallied at the end of the extended voting period
+	ctx = ctx.WithBlockTime(proposal.VotingEndTime)
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, rising.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusRejected, proposal.Status)
+	require.Equal(t, votingEndTime.Add(extension), proposal.VotingEndTime)
+}
+
+func TestTickExpeditedProposal(t *testing.T) {
+	testCases := []struct {
+		name               string
+		expeditedThreshold sdk.Dec
+		expFallBack        bool
+	}{
+		{"passes with the expedited threshold", types.DefaultExpeditedThreshold, false},
+		{"falls back to a regular voting period", sdk.OneDec(), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 1, valTokens)
+
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			tallyParams := app.GovKeeper.GetTallyParams(ctx)
+			tallyParams.ExpeditedThreshold = tc.expeditedThreshold
+			app.GovKeeper.SetTallyParams(ctx, tallyParams)
+			votingParams := app.GovKeeper.GetVotingParams(ctx)
+
+			proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+			msg, err := types.NewMsgSubmitProposal(TestProposal, proposalCoins, addrs[0])
+			require.NoError(t, err)
+			msg.SetIsExpedited(true)
+
+			govMsgSvr := keeper.NewMsgServerImpl(app.GovKeeper)
+			res, err := govMsgSvr.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+			require.NoError(t, err)
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, res.ProposalId)
+			require.True(t, ok)
+			require.True(t, proposal.IsExpedited)
+			require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+			require.Equal(t, proposal.VotingStartTime.Add(votingParams.ExpeditedVotingPeriod), proposal.VotingEndTime)
+
+			err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+			require.NoError(t, err)
+
+			ctx = ctx.WithBlockTime(proposal.VotingEndTime)
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+			if !tc.expFallBack {
+				require.Equal(t, types.StatusPassed, proposal.Status)
+				return
+			}
+
+			// the proposal is moved to the regular track and keeps its votes
+			require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+			require.False(t, proposal.IsExpedited)
+			require.Equal(t, proposal.VotingStartTime.Add(votingParams.VotingPeriod), proposal.VotingEndTime)
+			_, found := app.GovKeeper.GetVote(ctx, proposal.ProposalId, addrs[0])
+			require.True(t, found)
+
+			// the proposal is tallied with the regular threshold at the end of
+			// the regular voting period
+			ctx = ctx.WithBlockTime(proposal.VotingEndTime)
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+			require.Equal(t, types.StatusPassed, proposal.Status)
+		})
+	}
+}
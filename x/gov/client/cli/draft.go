@@ -0,0 +1,196 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// contentInterfaceName is the name under which the proposal Content interface
+// is registered in the interface registry.
+const contentInterfaceName = "cosmos.gov.v1beta1.Content"
+
+// draftProposal defines the JSON file drafted by the draft-proposal command and
+// submitted by the submit-draft command.
+type draftProposal struct {
+	Content     json.RawMessage `json:"content"`
+	Deposit     string          `json:"deposit"`
+	IsExpedited bool            `json:"is_expedited"`
+}
+
+// contentTypeURLs returns the sorted type URLs of the registered proposal
+// contents.
+func contentTypeURLs(registry codectypes.InterfaceRegistry) []string {
+	typeURLs := registry.ListImplementations(contentInterfaceName)
+	sort.Strings(typeURLs)
+	return typeURLs
+}
+
+// resolveContentType returns the type URL of the registered proposal content
+// designated by name, which is either its type URL, with or without the leading
+// slash, or the case-insensitive name of its message, e.g. TextProposal.
+func resolveContentType(registry codectypes.InterfaceRegistry, name string) (string, error) {
+	typeURLs := contentTypeURLs(registry)
+
+	var matches []string
+	for _, typeURL := range typeURLs {
+		if typeURL == name || typeURL == "/"+name {
+			return typeURL, nil
+		}
+
+		if strings.EqualFold(typeURL[strings.LastIndex(typeURL, ".")+1:], name) {
+			matches = append(matches, typeURL)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("unknown proposal type %s, expected one of: %s", name, strings.Join(typeURLs, ", "))
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("ambiguous proposal type %s, matching: %s", name, strings.Join(matches, ", "))
+	}
+}
+
+// draftProposalJSON returns the indented JSON skeleton of a proposal of the
+// given content type URL. Nested messages are set, and repeated messages are
+// given an element, so that the skeleton shows every field to fill.
+func draftProposalJSON(cdc codec.JSONCodec, registry codectypes.InterfaceRegistry, typeURL string) ([]byte, error) {
+	msg, err := registry.Resolve(typeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	fillSkeleton(reflect.ValueOf(msg).Elem())
+
+	content, err := cdc.MarshalInterfaceJSON(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(draftProposal{Content: content})
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	if err := json.Indent(&out, bz, "", "  "); err != nil {
+		return nil, err
+	}
+
+	return out.Bytes(), nil
+}
+
+// fillSkeleton recursively sets the nil message fields of the message struct v
+// and appends an element to its empty repeated message fields.
+func fillSkeleton(v reflect.Value) {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if !field.CanSet() {
+			continue
+		}
+
+		switch {
+		case field.Kind() == reflect.Ptr && isMessageStruct(field.Type().Elem()):
+			if field.IsNil() {
+				field.Set(reflect.New(field.Type().Elem()))
+			}
+			fillSkeleton(field.Elem())
+
+		case field.Kind() == reflect.Struct && isMessageStruct(field.Type()):
+			fillSkeleton(field)
+
+		case field.Kind() == reflect.Slice && field.Len() == 0:
+			elemType := field.Type().Elem()
+			switch {
+			case elemType.Kind() == reflect.Ptr && isMessageStruct(elemType.Elem()):
+				elem := reflect.New(elemType.Elem())
+				fillSkeleton(elem.Elem())
+				field.Set(reflect.Append(field, elem))
+
+			case elemType.Kind() == reflect.Struct && isMessageStruct(elemType):
+				elem := reflect.New(elemType).Elem()
+				fillSkeleton(elem)
+				field.Set(reflect.Append(field, elem))
+			}
+		}
+	}
+}
+
+// isMessageStruct returns whether t is the struct of a protobuf message other
+// than Any, whose content is resolved from its type URL.
+func isMessageStruct(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct &&
+		t != reflect.TypeOf(codectypes.Any{}) &&
+		reflect.PtrTo(t).Implements(reflect.TypeOf((*proto.Message)(nil)).Elem())
+}
+
+// parseDraftProposal decodes and validates a draft proposal file, reporting
+// the offending field on error.
+func parseDraftProposal(cdc codec.JSONCodec, registry codectypes.InterfaceRegistry, bz []byte) (types.Content, sdk.Coins, bool, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.DisallowUnknownFields()
+
+	var draft draftProposal
+	if err := dec.Decode(&draft); err != nil {
+		return nil, nil, false, fmt.Errorf("invalid draft proposal: %w", err)
+	}
+
+	if len(draft.Content) == 0 || string(draft.Content) == "null" {
+		return nil, nil, false, fmt.Errorf("content: missing proposal content")
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(draft.Content, &fields); err != nil {
+		return nil, nil, false, fmt.Errorf("content: %w", err)
+	}
+
+	typeURLs := contentTypeURLs(registry)
+	var typeURL string
+	if err := json.Unmarshal(fields["@type"], &typeURL); err != nil || typeURL == "" {
+		return nil, nil, false, fmt.Errorf("content: missing @type, expected one of: %s", strings.Join(typeURLs, ", "))
+	}
+	if i := sort.SearchStrings(typeURLs, typeURL); i == len(typeURLs) || typeURLs[i] != typeURL {
+		return nil, nil, false, fmt.Errorf("content.@type: unknown proposal type %s, expected one of: %s", typeURL, strings.Join(typeURLs, ", "))
+	}
+
+	// decode the fields of the content into its concrete message, so that an
+	// unknown or malformed field is reported against the proposal type
+	msg, err := registry.Resolve(typeURL)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("content.@type: %w", err)
+	}
+
+	delete(fields, "@type")
+	contentBz, err := json.Marshal(fields)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("content: %w", err)
+	}
+
+	if err := cdc.UnmarshalJSON(contentBz, msg); err != nil {
+		return nil, nil, false, fmt.Errorf("content (%s): %w", typeURL, err)
+	}
+
+	content := msg.(types.Content)
+	if err := content.ValidateBasic(); err != nil {
+		return nil, nil, false, fmt.Errorf("content (%s): %s", typeURL, err)
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(draft.Deposit)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("deposit: %w", err)
+	}
+
+	return content, deposit, draft.IsExpedited, nil
+}
@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+func makeDraftCodec() (codec.Codec, codectypes.InterfaceRegistry) {
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	paramproposal.RegisterInterfaces(registry)
+
+	return codec.NewProtoCodec(registry), registry
+}
+
+func TestResolveContentType(t *testing.T) {
+	_, registry := makeDraftCodec()
+
+	for _, name := range []string{"/cosmos.gov.v1beta1.TextProposal", "cosmos.gov.v1beta1.TextProposal", "TextProposal", "textproposal"} {
+		typeURL, err := resolveContentType(registry, name)
+		require.NoError(t, err, name)
+		require.Equal(t, "/cosmos.gov.v1beta1.TextProposal", typeURL)
+	}
+
+	_, err := resolveContentType(registry, "SoftwareUpgradeProposal")
+	require.EqualError(t, err, "unknown proposal type SoftwareUpgradeProposal, expected one of: /cosmos.gov.v1beta1.TextProposal, /cosmos.params.v1beta1.ParameterChangeProposal")
+}
+
+func TestDraftProposal(t *testing.T) {
+	cdc, registry := makeDraftCodec()
+
+	bz, err := draftProposalJSON(cdc, registry, "/cosmos.params.v1beta1.ParameterChangeProposal")
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+  "content": {
+    "@type": "/cosmos.params.v1beta1.ParameterChangeProposal",
+    "title": "",
+    "description": "",
+    "changes": [{"subspace": "", "key": "", "value": ""}]
+  },
+  "deposit": "",
+  "is_expedited": false
+}`, string(bz))
+
+	// a filled draft is submitted as is
+	var draft draftProposal
+	require.NoError(t, json.Unmarshal(bz, &draft))
+	draft.Content = json.RawMessage(`{
+  "@type": "/cosmos.params.v1beta1.ParameterChangeProposal",
+  "title": "Raise max validators",
+  "description": "Raise max validators to 150",
+  "changes": [{"subspace": "staking", "key": "MaxValidators", "value": "150"}]
+}`)
+	draft.Deposit = "10stake"
+	draft.IsExpedited = true
+	bz, err = json.Marshal(draft)
+	require.NoError(t, err)
+
+	content, deposit, isExpedited, err := parseDraftProposal(cdc, registry, bz)
+	require.NoError(t, err)
+	require.Equal(t, paramproposal.NewParameterChangeProposal(
+		"Raise max validators", "Raise max validators to 150",
+		[]paramproposal.ParamChange{paramproposal.NewParamChange("staking", "MaxValidators", "150")},
+	), content)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), deposit)
+	require.True(t, isExpedited)
+}
+
+func TestParseDraftProposalErrors(t *testing.T) {
+	cdc, registry := makeDraftCodec()
+
+	testCases := []struct {
+		name  string
+		draft string
+		err   string
+	}{
+		{
+			"unknown field",
+			`{"content": {"@type": "/cosmos.gov.v1beta1.TextProposal", "title": "t", "description": "d"}, "deposit": "10stake", "expedited": true}`,
+			`invalid draft proposal: json: unknown field "expedited"`,
+		},
+		{
+			"missing content",
+			`{"deposit": "10stake"}`,
+			"content: missing proposal content",
+		},
+		{
+			"missing type",
+			`{"content": {"title": "t", "description": "d"}, "deposit": "10stake"}`,
+			"content: missing @type, expected one of: /cosmos.gov.v1beta1.TextProposal, /cosmos.params.v1beta1.ParameterChangeProposal",
+		},
+		{
+			"unknown type",
+			`{"content": {"@type": "/cosmos.bank.v1beta1.MsgSend"}, "deposit": "10stake"}`,
+			"content.@type: unknown proposal type /cosmos.bank.v1beta1.MsgSend, expected one of: /cosmos.gov.v1beta1.TextProposal, /cosmos.params.v1beta1.ParameterChangeProposal",
+		},
+		{
+			"unknown content field",
+			`{"content": {"@type": "/cosmos.gov.v1beta1.TextProposal", "titel": "t", "description": "d"}, "deposit": "10stake"}`,
+			`content (/cosmos.gov.v1beta1.TextProposal): unknown field "titel" in types.TextProposal`,
+		},
+		{
+			"invalid content",
+			`{"content": {"@type": "/cosmos.gov.v1beta1.TextProposal", "title": "", "description": "d"}, "deposit": "10stake"}`,
+			"content (/cosmos.gov.v1beta1.TextProposal): proposal title cannot be blank: invalid proposal content",
+		},
+		{
+			"invalid deposit",
+			`{"content": {"@type": "/cosmos.gov.v1beta1.TextProposal", "title": "t", "description": "d"}, "deposit": "ten stake"}`,
+			"deposit: invalid decimal coin expression: ten stake",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, _, err := parseDraftProposal(cdc, registry, []byte(tc.draft))
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"strings"
 
 	"github.com/spf13/pflag"
 
@@ -42,3 +43,28 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 
 	return proposal, nil
 }
+
+// parseTemplateVars parses the repeated --var name=value flags of the
+// submit-from-template command.
+func parseTemplateVars(fs *pflag.FlagSet) (map[string]string, error) {
+	rawVars, err := fs.GetStringArray(FlagVar)
+	if err != nil {
+		return nil, err
+	}
+
+	vars := make(map[string]string, len(rawVars))
+	for _, raw := range rawVars {
+		kv := strings.SplitN(raw, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("invalid template variable %q, expected name=value", raw)
+		}
+
+		if _, ok := vars[kv[0]]; ok {
+			return nil, fmt.Errorf("duplicate template variable %s", kv[0])
+		}
+
+		vars[kv[0]] = kv[1]
+	}
+
+	return vars, nil
+}
@@ -67,3 +67,26 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	err = badJSON.Close()
 	require.Nil(t, err, "unexpected error")
 }
+
+func TestParseTemplateVars(t *testing.T) {
+	fs := NewCmdSubmitProposalFromTemplate().Flags()
+
+	vars, err := parseTemplateVars(fs)
+	require.NoError(t, err)
+	require.Empty(t, vars)
+
+	require.NoError(t, fs.Set(FlagVar, "title=My proposal"))
+	require.NoError(t, fs.Set(FlagVar, "expr=a=b"))
+	vars, err = parseTemplateVars(fs)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"title": "My proposal", "expr": "a=b"}, vars)
+
+	require.NoError(t, fs.Set(FlagVar, "title=again"))
+	_, err = parseTemplateVars(fs)
+	require.Error(t, err)
+
+	fs = NewCmdSubmitProposalFromTemplate().Flags()
+	require.NoError(t, fs.Set(FlagVar, "novalue"))
+	_, err = parseTemplateVars(fs)
+	require.Error(t, err)
+}
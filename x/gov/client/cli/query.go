@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
 	"strconv"
 	"strings"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 	gcutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -29,14 +31,27 @@ func GetQueryCmd() *cobra.Command {
 	govQueryCmd.AddCommand(
 		GetCmdQueryProposal(),
 		GetCmdQueryProposals(),
+		GetCmdQueryArchivedProposal(),
+		GetCmdQueryPendingExecutions(),
 		GetCmdQueryVote(),
 		GetCmdQueryVotes(),
+		GetCmdQueryVoteReceipt(),
+		GetCmdQueryDiscussionAnchors(),
+		GetCmdQueryChoiceVotes(),
+		GetCmdQueryChoiceTally(),
 		GetCmdQueryParam(),
 		GetCmdQueryParams(),
 		GetCmdQueryProposer(),
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryLiveTally(),
+		GetCmdQueryValidatorParticipation(),
+		GetCmdQuerySimulateProposal(),
+		GetCmdQueryProposalTemplate(),
+		GetCmdQueryProposalTemplates(),
+		GetCmdQueryGovernor(),
+		GetCmdQueryGovernanceDelegations(),
 	)
 
 	return govQueryCmd
@@ -89,6 +104,98 @@ $ %s query gov proposal 1
 	return cmd
 }
 
+// GetCmdQueryArchivedProposal implements the query archived proposal command.
+func GetCmdQueryArchivedProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archived-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query details of a single archived proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details for a finalized proposal moved to the archive store once
+its archive retention period elapsed.
+
+Example:
+$ %s query gov archived-proposal 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.ArchivedProposal(
+				cmd.Context(),
+				&types.QueryArchivedProposalRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Proposal)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPendingExecutions implements the query pending executions command.
+func GetCmdQueryPendingExecutions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-executions",
+		Args:  cobra.NoArgs,
+		Short: "Query the passed proposals scheduled for execution",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the passed proposals whose execution is scheduled after the execution
+delay, in the order of their execution time.
+
+Example:
+$ %s query gov pending-executions
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingExecutions(
+				cmd.Context(),
+				&types.QueryPendingExecutionsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "pending executions")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryProposals implements a query proposals command. Command to Get a
 // Proposal Information.
 func GetCmdQueryProposals() *cobra.Command {
@@ -101,7 +208,7 @@ func GetCmdQueryProposals() *cobra.Command {
 Example:
 $ %s query gov proposals --depositor cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 $ %s query gov proposals --voter cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
-$ %s query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected)
+$ %s query gov proposals --status (DepositPeriod|VotingPeriod|RevealPeriod|Passed|Rejected|Scheduled)
 $ %s query gov proposals --page=2 --limit=100
 `,
 				version.AppName, version.AppName, version.AppName, version.AppName,
@@ -170,7 +277,7 @@ $ %s query gov proposals --page=2 --limit=100
 
 	cmd.Flags().String(flagDepositor, "", "(optional) filter by proposals deposited on by depositor")
 	cmd.Flags().String(flagVoter, "", "(optional) filter by proposals voted on by voted")
-	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected")
+	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected/scheduled")
 	flags.AddPaginationFlagsToCmd(cmd, "proposals")
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -292,7 +399,7 @@ $ %[1]s query gov votes 1 --page=2 --limit=100
 			}
 
 			propStatus := proposalRes.GetProposal().Status
-			if !(propStatus == types.StatusVotingPeriod || propStatus == types.StatusDepositPeriod) {
+			if !(propStatus == types.StatusVotingPeriod || propStatus == types.StatusDepositPeriod || propStatus == types.StatusRevealPeriod) {
 				page, _ := cmd.Flags().GetInt(flags.FlagPage)
 				limit, _ := cmd.Flags().GetInt(flags.FlagLimit)
 
@@ -511,6 +618,347 @@ $ %s query gov tally 1
 	return cmd
 }
 
+// GetCmdQueryLiveTally implements the query live tally command.
+func GetCmdQueryLiveTally() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "live-tally [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the current tally of a proposal in its voting period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current tally of the votes on a proposal in its voting period.
+If a pagination flag is set, only the voting power contributed by the requested
+page of the votes is tallied, so that the tally of a proposal with many votes
+can be computed by adding up the tallies of its pages.
+
+Example:
+$ %s query gov live-tally 1
+$ %s query gov live-tally 1 --limit=1000 --page=2
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// the whole tally is computed unless a page of the votes is requested
+			var pageReq *query.PageRequest
+			for _, flag := range []string{flags.FlagPageKey, flags.FlagOffset, flags.FlagLimit, flags.FlagPage} {
+				if cmd.Flags().Changed(flag) {
+					pageReq, err = client.ReadPageRequest(cmd.Flags())
+					if err != nil {
+						return err
+					}
+					break
+				}
+			}
+
+			res, err := queryClient.LiveTally(
+				cmd.Context(),
+				&types.QueryLiveTallyRequest{ProposalId: proposalID, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "live tally")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryVoteReceipt implements the query vote receipt command.
+func GetCmdQueryVoteReceipt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-receipt [proposal-id] [voter-addr]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the vote receipt of a voter on a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the receipt recording the participation of a voter in the vote on a
+proposal, issued at its first vote when vote receipts are enabled.
+
+Example:
+$ %s query gov vote-receipt 1 cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.VoteReceipt(
+				cmd.Context(),
+				&types.QueryVoteReceiptRequest{ProposalId: proposalID, Voter: args[1]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Receipt)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDiscussionAnchors implements the query discussion anchors command.
+func GetCmdQueryDiscussionAnchors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "discussion-anchors [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the discussion anchors of a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the content hashes of off-chain discussions anchored on a proposal by
+its proposer and voters.
+
+Example:
+$ %s query gov discussion-anchors 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DiscussionAnchors(
+				cmd.Context(),
+				&types.QueryDiscussionAnchorsRequest{ProposalId: proposalID, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "discussion anchors")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryChoiceVotes implements the query choice votes command.
+func GetCmdQueryChoiceVotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "choice-votes [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the choice votes of a multiple-choice proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the choice votes cast on a multiple-choice proposal in its voting period.
+
+Example:
+$ %s query gov choice-votes 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ChoiceVotes(
+				cmd.Context(),
+				&types.QueryChoiceVotesRequest{ProposalId: proposalID, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "choice votes")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryChoiceTally implements the query choice tally command.
+func GetCmdQueryChoiceTally() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "choice-tally [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the voting power of each choice of a multiple-choice proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the voting power of each choice of a multiple-choice proposal, by
+choice index. The final results are returned once the voting period ended.
+
+Example:
+$ %s query gov choice-tally 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.ChoiceTally(
+				cmd.Context(),
+				&types.QueryChoiceTallyRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySimulateProposal implements the command to simulate the
+// execution of a proposal.
+func GetCmdQuerySimulateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-proposal [proposal-id]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Simulate the execution of a proposal in voting period or of a draft proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Simulate the execution of a proposal in voting period as if it passed,
+against the current state of the queried node, which isn't modified. The events
+and gas used by the execution are returned, or the error it fails with. You can
+find the proposal-id by running "%s query gov proposals".
+
+A proposal drafted with the draft-proposal command can be simulated before it is
+submitted with the --draft flag instead of a proposal-id.
+
+Example:
+$ %s query gov simulate-proposal 1
+$ %s query gov simulate-proposal --draft draft_proposal.json
+`,
+				version.AppName, version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			draftFile, err := cmd.Flags().GetString(FlagDraft)
+			if err != nil {
+				return err
+			}
+
+			var req *types.QuerySimulateProposalRequest
+			switch {
+			case draftFile != "" && len(args) > 0:
+				return fmt.Errorf("--%s flag provided alongside a proposal-id", FlagDraft)
+
+			case draftFile != "":
+				bz, err := ioutil.ReadFile(draftFile)
+				if err != nil {
+					return err
+				}
+
+				content, _, _, err := parseDraftProposal(clientCtx.Codec, clientCtx.InterfaceRegistry, bz)
+				if err != nil {
+					return fmt.Errorf("failed to parse draft proposal %s: %w", draftFile, err)
+				}
+
+				req, err = types.NewQuerySimulateContentRequest(content)
+				if err != nil {
+					return err
+				}
+
+			case len(args) > 0:
+				// validate that the proposal id is a uint
+				proposalID, err := strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+				}
+
+				req = &types.QuerySimulateProposalRequest{ProposalId: proposalID}
+
+			default:
+				return fmt.Errorf("either a proposal-id or the --%s flag must be provided", FlagDraft)
+			}
+
+			res, err := queryClient.SimulateProposal(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagDraft, "", "Draft proposal file to simulate instead of a proposal in voting period")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -668,3 +1116,221 @@ $ %s query gov proposer 1
 
 	return cmd
 }
+
+// GetCmdQueryProposalTemplate implements the query proposal template command.
+func GetCmdQueryProposalTemplate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "template [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a proposal template by name",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a governance approved proposal template by its name.
+
+Example:
+$ %s query gov template community-spend
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProposalTemplate(
+				cmd.Context(),
+				&types.QueryProposalTemplateRequest{Name: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Template)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProposalTemplates implements the query proposal templates command.
+func GetCmdQueryProposalTemplates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "templates",
+		Args:  cobra.NoArgs,
+		Short: "Query all proposal templates",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all governance approved proposal templates.
+
+Example:
+$ %s query gov templates
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProposalTemplates(
+				cmd.Context(),
+				&types.QueryProposalTemplatesRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGovernor implements the query governor command.
+func GetCmdQueryGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "governor [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the governor of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the governor an account delegated its governance voting power to.
+
+Example:
+$ %s query gov governor cosmos1...
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Governor(
+				cmd.Context(),
+				&types.QueryGovernorRequest{DelegatorAddress: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGovernanceDelegations implements the query governance delegations
+// command.
+func GetCmdQueryGovernanceDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "governance-delegations [governor-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the accounts a governor votes on behalf of",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the governance delegations to a governor, i.e. the accounts which
+delegated their governance voting power to it.
+
+Example:
+$ %s query gov governance-delegations cosmos1...
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GovernanceDelegations(
+				cmd.Context(),
+				&types.QueryGovernanceDelegationsRequest{GovernorAddress: args[0], Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "governance delegations")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorParticipation implements the query validator
+// participation command.
+func GetCmdQueryValidatorParticipation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-participation [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the participation of the bonded validators in the vote on a finalized proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query which of the validators bonded at the final tally of a proposal voted on
+it, along with their bonded tokens and the voting power they voted with, or would
+have voted with if they didn't vote.
+
+Example:
+$ %s query gov validator-participation 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorParticipation(
+				cmd.Context(),
+				&types.QueryValidatorParticipationRequest{ProposalId: proposalID, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "validator participation")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
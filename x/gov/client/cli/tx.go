@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"strconv"
 	"strings"
 
@@ -26,6 +28,17 @@ const (
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
 	FlagProposal     = "proposal"
+	FlagVar          = "var"
+	FlagExpedited    = "expedited"
+	FlagOptimistic   = "optimistic"
+	FlagPrivate      = "private"
+	FlagCouncil      = "council"
+	FlagEmergency    = "emergency"
+	FlagChoices      = "choices"
+	FlagDependsOn    = "depends-on"
+	FlagURI          = "uri"
+	FlagDraft        = "draft"
+	FlagMetadata     = "metadata"
 )
 
 type proposal struct {
@@ -69,6 +82,17 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 		NewCmdDeposit(),
 		NewCmdVote(),
 		NewCmdWeightedVote(),
+		NewCmdVoteOption(),
+		NewCmdCommitVote(),
+		NewCmdRevealVote(),
+		NewCmdSetGovernor(),
+		NewCmdRemoveGovernor(),
+		NewCmdCancelProposal(),
+		NewCmdRetryProposalExecution(),
+		NewCmdAnchorDiscussion(),
+		NewCmdSubmitProposalFromTemplate(),
+		NewCmdDraftProposal(),
+		NewCmdSubmitDraftProposal(),
 		cmdSubmitProp,
 	)
 
@@ -99,8 +123,38 @@ Where proposal.json contains:
 Which is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+
+Pass --expedited to submit the proposal on the expedited track, with a shorter
+voting period and a higher quorum and threshold. An expedited proposal which
+does not pass by the end of its voting period falls back to a regular one.
+
+Pass --optimistic to submit the proposal on the optimistic track, on which it
+passes at the end of a challenge window unless enough stake vetoes it. Only the
+authorized addresses of the voting params can submit optimistic proposals.
+
+Pass --private to submit a private proposal, voted on with vote commitments
+made with "%s tx gov commit-vote" and revealed with "%s tx gov reveal-vote" in
+a reveal period following the voting period.
+
+Pass --council to submit the proposal on the council track, on which only the
+council members of the voting params vote and a simple majority of the council
+passes it. Only the council members can submit council proposals, whose content
+type must be one of the council proposal types.
+
+Pass --emergency to submit the proposal on the emergency track, on which it is
+tallied and executed at the end of the first block in which the validators
+voting Yes with their operator accounts hold the emergency threshold of the
+bonded validator power, without waiting for the end of its voting period.
+
+Pass --choices with a comma-separated list of choice titles to submit a
+multiple-choice proposal, voted on with "%s tx gov vote-option". The choices
+given this way don't change the state when they win.
+
+Pass --depends-on with a comma-separated list of earlier proposal IDs to only
+execute the proposal once they have all passed and been executed. Until then,
+the passed proposal is held, and it fails if one of them is rejected or fails.
 `,
-				version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -126,6 +180,63 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 				return fmt.Errorf("invalid message: %w", err)
 			}
 
+			isExpedited, err := cmd.Flags().GetBool(FlagExpedited)
+			if err != nil {
+				return err
+			}
+			msg.SetIsExpedited(isExpedited)
+
+			isOptimistic, err := cmd.Flags().GetBool(FlagOptimistic)
+			if err != nil {
+				return err
+			}
+			msg.SetIsOptimistic(isOptimistic)
+
+			isPrivate, err := cmd.Flags().GetBool(FlagPrivate)
+			if err != nil {
+				return err
+			}
+			msg.SetIsPrivate(isPrivate)
+
+			isCouncil, err := cmd.Flags().GetBool(FlagCouncil)
+			if err != nil {
+				return err
+			}
+			msg.SetIsCouncil(isCouncil)
+
+			isEmergency, err := cmd.Flags().GetBool(FlagEmergency)
+			if err != nil {
+				return err
+			}
+			msg.SetIsEmergency(isEmergency)
+
+			choiceTitles, err := cmd.Flags().GetStringSlice(FlagChoices)
+			if err != nil {
+				return err
+			}
+			if len(choiceTitles) > 0 {
+				choices := make([]types.ProposalChoice, len(choiceTitles))
+				for i, title := range choiceTitles {
+					choices[i], err = types.NewProposalChoice(strings.TrimSpace(title), nil)
+					if err != nil {
+						return err
+					}
+				}
+				msg.SetChoices(choices)
+			}
+
+			dependsOn, err := cmd.Flags().GetUintSlice(FlagDependsOn)
+			if err != nil {
+				return err
+			}
+			if len(dependsOn) > 0 {
+				proposalIDs := make([]uint64, len(dependsOn))
+				for i, proposalID := range dependsOn {
+					proposalIDs[i] = uint64(proposalID)
+				}
+				msg.SetDependsOn(proposalIDs)
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -135,6 +246,198 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(FlagProposalType, "", "The proposal Type")
 	cmd.Flags().String(FlagDeposit, "", "The proposal deposit")
 	cmd.Flags().String(FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().Bool(FlagExpedited, false, "Submit the proposal on the expedited track, with a shorter voting period and a higher quorum and threshold")
+	cmd.Flags().Bool(FlagOptimistic, false, "Submit the proposal on the optimistic track, passing at the end of a challenge window unless vetoed")
+	cmd.Flags().Bool(FlagPrivate, false, "Submit a private proposal, voted on with vote commitments revealed after the voting period")
+	cmd.Flags().Bool(FlagCouncil, false, "Submit the proposal on the council track, voted on by the council members only")
+	cmd.Flags().Bool(FlagEmergency, false, "Submit the proposal on the emergency track, tallied as soon as a supermajority of the validators votes Yes")
+	cmd.Flags().StringSlice(FlagChoices, nil, "Comma-separated titles of the choices of a multiple-choice proposal")
+	cmd.Flags().UintSlice(FlagDependsOn, nil, "Comma-separated IDs of the earlier proposals which must be executed before the proposal")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitProposalFromTemplate implements submitting a proposal built from
+// a governance approved proposal template.
+func NewCmdSubmitProposalFromTemplate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-from-template [template-name] [deposit]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal built from a registered proposal template along with an initial deposit",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal built from a registered proposal template along with
+an initial deposit. Every placeholder of the template must be given a value
+through a --var flag. You can find the available templates and their
+placeholders by running "%s query gov templates".
+
+Example:
+$ %s tx gov submit-from-template community-spend 10stake \
+	--var title="Fund the explorer" --var description="..." \
+	--var recipient=cosmos1... --var amount=1000 --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProposalTemplate(
+				cmd.Context(),
+				&types.QueryProposalTemplateRequest{Name: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			vars, err := parseTemplateVars(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			bz, err := res.Template.Render(vars)
+			if err != nil {
+				return err
+			}
+
+			var content types.Content
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON(bz, &content); err != nil {
+				return fmt.Errorf("failed to decode proposal content of template %s: %w", args[0], err)
+			}
+
+			msg, err := types.NewMsgSubmitProposal(content, amount, clientCtx.GetFromAddress())
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+
+			isExpedited, err := cmd.Flags().GetBool(FlagExpedited)
+			if err != nil {
+				return err
+			}
+			msg.SetIsExpedited(isExpedited)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringArray(FlagVar, []string{}, "Template placeholder value in the form name=value (can be repeated)")
+	cmd.Flags().Bool(FlagExpedited, false, "Submit the proposal on the expedited track, with a shorter voting period and a higher quorum and threshold")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdDraftProposal implements drafting a proposal file to be submitted with
+// the submit-draft command.
+func NewCmdDraftProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "draft-proposal [proposal-type]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Draft a proposal JSON file for any registered proposal type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Print the JSON skeleton of a proposal of the given type, listing every field
+of its content, to be filled and submitted with the submit-draft command. The
+proposal type is either the type URL of the proposal content or its message
+name. Without a proposal type, the registered proposal types are listed.
+
+Example:
+$ %s tx gov draft-proposal ParameterChangeProposal > draft_proposal.json
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 0 {
+				return clientCtx.PrintString(strings.Join(contentTypeURLs(clientCtx.InterfaceRegistry), "\n") + "\n")
+			}
+
+			typeURL, err := resolveContentType(clientCtx.InterfaceRegistry, args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := draftProposalJSON(clientCtx.Codec, clientCtx.InterfaceRegistry, typeURL)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(string(bz) + "\n")
+		},
+	}
+
+	return cmd
+}
+
+// NewCmdSubmitDraftProposal implements submitting a proposal drafted with the
+// draft-proposal command.
+func NewCmdSubmitDraftProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-draft [draft-proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal drafted with the draft-proposal command",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal drafted with the draft-proposal command. The draft is
+validated against the schema of its proposal type before submission, and any
+unknown, malformed or invalid field is reported.
+
+Example:
+$ %s tx gov submit-draft draft_proposal.json --from mykey
+
+Where draft_proposal.json contains:
+
+{
+  "content": {
+    "@type": "/cosmos.gov.v1beta1.TextProposal",
+    "title": "Test Proposal",
+    "description": "My awesome proposal"
+  },
+  "deposit": "10test",
+  "is_expedited": false
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			content, deposit, isExpedited, err := parseDraftProposal(clientCtx.Codec, clientCtx.InterfaceRegistry, bz)
+			if err != nil {
+				return fmt.Errorf("failed to parse draft proposal %s: %w", args[0], err)
+			}
+
+			msg, err := types.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+			msg.SetIsExpedited(isExpedited)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -188,6 +491,144 @@ $ %s tx gov deposit 1 10stake --from mykey
 	return cmd
 }
 
+// NewCmdCancelProposal implements the command to cancel a proposal.
+func NewCmdCancelProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a proposal before its voting period ends",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a proposal you submitted while it is in its deposit or voting
+period. A fraction of the deposits, set by the cancel_burn_ratio deposit
+parameter, is burned and the rest is refunded to the depositors.
+
+Example:
+$ %s tx gov cancel-proposal 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := types.NewMsgCancelProposal(clientCtx.GetFromAddress(), proposalID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdRetryProposalExecution implements the command to retry the execution
+// of a passed proposal which failed on execution.
+func NewCmdRetryProposalExecution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry-execution [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Retry the execution of a passed proposal which failed on execution",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute again the content of a passed proposal whose execution failed, e.g.
+because of a transient condition of the module handling it. The execution can
+be retried by the gov module account until the retry window, set by the
+execution_retry_window voting parameter, closes.
+
+Example:
+$ %s tx gov retry-execution 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := types.NewMsgRetryProposalExecution(clientCtx.GetFromAddress(), proposalID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdAnchorDiscussion implements the command to anchor the content hash of
+// a discussion of a proposal.
+func NewCmdAnchorDiscussion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "anchor-discussion [proposal-id] [hash]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Anchor the content hash of an off-chain discussion of a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Anchor the hex encoded SHA-256 hash of an off-chain discussion of a proposal,
+e.g. of a forum thread snapshot, so that its integrity can be verified later.
+Only the proposer and the voters of a proposal in its deposit or voting period
+can anchor a discussion.
+
+Example:
+$ %s tx gov anchor-discussion 1 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 --uri="https://forum.example.com/t/1" --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			hash, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("hash %s is not a valid hex string: %w", args[1], err)
+			}
+
+			uri, err := cmd.Flags().GetString(FlagURI)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAnchorDiscussion(clientCtx.GetFromAddress(), proposalID, hash, uri)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagURI, "", "URI locating the discussion content")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCmdVote implements creating a new vote command.
 func NewCmdVote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -200,8 +641,9 @@ find the proposal-id by running "%s query gov proposals".
 
 Example:
 $ %s tx gov vote 1 yes --from mykey
+$ %s tx gov vote 1 no --metadata="the upgrade isn't audited yet" --from mykey
 `,
-				version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -224,8 +666,60 @@ $ %s tx gov vote 1 yes --from mykey
 				return err
 			}
 
+			metadata, err := cmd.Flags().GetString(FlagMetadata)
+			if err != nil {
+				return err
+			}
+
 			// Build vote message and run basic validation
 			msg := types.NewMsgVote(from, proposalID, byteVoteOption)
+			msg.Metadata = metadata
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "Rationale of the vote, stored with it")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdVoteOption implements creating a new choice vote command.
+func NewCmdVoteOption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-option [proposal-id] [option-index]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for a choice of an active multiple-choice proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for a choice of an active multiple-choice proposal,
+given by its index in the proposal choices. You can find the choices of a
+proposal by running "%s query gov proposal [proposal-id]".
+
+Example:
+$ %s tx gov vote-option 1 2 --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			optionIndex, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("option-index %s not a valid int, please input a valid option-index", args[1])
+			}
+
+			msg := types.NewMsgVoteOption(clientCtx.GetFromAddress(), proposalID, uint32(optionIndex))
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -273,8 +767,193 @@ $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from
 				return err
 			}
 
+			metadata, err := cmd.Flags().GetString(FlagMetadata)
+			if err != nil {
+				return err
+			}
+
 			// Build vote message and run basic validation
 			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			msg.Metadata = metadata
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "Rationale of the vote, stored with it")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdCommitVote implements committing to a vote on a private proposal.
+func NewCmdCommitVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-vote [proposal-id] [options] [salt]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Commit to a vote on a private proposal in its voting period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit the commitment to a vote on a private proposal in its voting
+period, which must be revealed with the same options and salt in the reveal
+period following the voting period. Only the hash of the options and salt is
+sent; keep the salt secret until the vote is revealed. The options are a vote
+option or weighted vote options. The vote commitment deposit of the voting
+params is escrowed with the first commitment and burned if the vote is not
+revealed.
+
+Example:
+$ %s tx gov commit-vote 1 yes my-secret-salt --from mykey
+$ %s tx gov commit-vote 1 yes=0.6,no=0.4 my-secret-salt --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Get voter address
+			from := clientCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+			if err := types.ValidateWeightedVoteOptions(options); err != nil {
+				return err
+			}
+			if err := types.ValidateVoteSalt(args[2]); err != nil {
+				return err
+			}
+
+			commitment := types.VoteCommitmentHash(proposalID, from, options, args[2])
+			msg := types.NewMsgCommitVote(from, proposalID, commitment)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdRevealVote implements revealing a committed vote on a private proposal.
+func NewCmdRevealVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-vote [proposal-id] [options] [salt]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Reveal a committed vote on a private proposal in its reveal period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reveal the vote committed to with "%s tx gov commit-vote" on a private
+proposal in its reveal period, with the same options and salt. The vote is
+cast and the vote commitment deposit refunded.
+
+Example:
+$ %s tx gov reveal-vote 1 yes my-secret-salt --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Get voter address
+			from := clientCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealVote(from, proposalID, options, args[2])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSetGovernor implements delegating the governance voting power of an
+// account to a governor.
+func NewCmdSetGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-governor [governor-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delegate your governance voting power to a governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Delegate your governance voting power to a governor, replacing your current
+governor if any. Your stake doesn't move: the votes of the governor count for
+you instead of the votes of your validators, unless you vote yourself.
+
+Example:
+$ %s tx gov set-governor cosmos1... --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			governor, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetGovernor(clientCtx.GetFromAddress(), governor)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdRemoveGovernor implements taking back the governance voting power
+// delegated to a governor.
+func NewCmdRemoveGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-governor",
+		Args:  cobra.NoArgs,
+		Short: "Take back the governance voting power delegated to your governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Take back the governance voting power delegated to your governor, so that you
+inherit the votes of your validators again.
+
+Example:
+$ %s tx gov remove-governor --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveGovernor(clientCtx.GetFromAddress())
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -1,6 +1,8 @@
 package testutil
 
 import (
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -90,22 +92,34 @@ func (s *IntegrationTestSuite) TestCmdParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"voting_params":{"voting_period":"172800000000000"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000"}}`,
+			`{"voting_params":{"voting_period":"172800000000000","expedited_voting_period":"86400000000000"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","expedited_quorum":"0.500000000000000000","expedited_threshold":"0.667000000000000000","optimistic_veto_threshold":"0.100000000000000000","voting_power_cap":"0.000000000000000000","max_conviction_multiplier":"1.000000000000000000","emergency_threshold":"0.667000000000000000"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000","cancel_burn_ratio":"0.500000000000000000","min_initial_deposit_ratio":"0.000000000000000000","burn_vote_veto":true,"burn_vote_quorum":true,"burn_proposal_deposit_prevote":true}}`,
 		},
 		{
 			"text output",
 			[]string{},
 			`
 deposit_params:
+  burn_proposal_deposit_prevote: true
+  burn_vote_quorum: true
+  burn_vote_veto: true
+  cancel_burn_ratio: "0.500000000000000000"
   max_deposit_period: "172800000000000"
   min_deposit:
   - amount: "10000000"
     denom: stake
+  min_initial_deposit_ratio: "0.000000000000000000"
 tally_params:
+  emergency_threshold: "0.667000000000000000"
+  expedited_quorum: "0.500000000000000000"
+  expedited_threshold: "0.667000000000000000"
+  max_conviction_multiplier: "1.000000000000000000"
+  optimistic_veto_threshold: "0.100000000000000000"
   quorum: "0.334000000000000000"
   threshold: "0.500000000000000000"
   veto_threshold: "0.334000000000000000"
+  voting_power_cap: "0.000000000000000000"
 voting_params:
+  expedited_voting_period: "86400000000000"
   voting_period: "172800000000000"
 	`,
 		},
@@ -139,7 +153,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"voting",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"voting_period":"172800000000000"}`,
+			`{"voting_period":"172800000000000","expedited_voting_period":"86400000000000"}`,
 		},
 		{
 			"tally params",
@@ -147,7 +161,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"tallying",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"}`,
+			`{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","expedited_quorum":"0.500000000000000000","expedited_threshold":"0.667000000000000000","optimistic_veto_threshold":"0.100000000000000000","voting_power_cap":"0.000000000000000000","max_conviction_multiplier":"1.000000000000000000","emergency_threshold":"0.667000000000000000"}`,
 		},
 		{
 			"deposit params",
@@ -155,7 +169,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"deposit",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000"}`,
+			`{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000","cancel_burn_ratio":"0.500000000000000000","min_initial_deposit_ratio":"0.000000000000000000","burn_vote_veto":true,"burn_vote_quorum":true,"burn_proposal_deposit_prevote":true}`,
 		},
 	}
 
@@ -367,6 +381,89 @@ func (s *IntegrationTestSuite) TestNewCmdSubmitProposal() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewCmdSubmitDraftProposal() {
+	val := s.network.Validators[0]
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewCmdDraftProposal(), []string{"TextProposal"})
+	s.Require().NoError(err)
+
+	var draft map[string]interface{}
+	s.Require().NoError(json.Unmarshal(out.Bytes(), &draft))
+	s.Require().Equal(map[string]interface{}{
+		"@type":       "/cosmos.gov.v1beta1.TextProposal",
+		"title":       "",
+		"description": "",
+	}, draft["content"])
+
+	draft["content"] = map[string]interface{}{
+		"@type":       "/cosmos.gov.v1beta1.TextProposal",
+		"title":       "Text Proposal",
+		"description": "Hello, World!",
+	}
+	draft["deposit"] = sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5431)).String()
+	bz, err := json.Marshal(draft)
+	s.Require().NoError(err)
+	validDraftFile := testutil.WriteToNewTempFile(s.T(), string(bz))
+
+	invalidDraftFile := testutil.WriteToNewTempFile(s.T(), `{
+  "content": {
+    "@type": "/cosmos.gov.v1beta1.TextProposal",
+    "titel": "Text Proposal",
+    "description": "Hello, World!"
+  },
+  "deposit": "5431stake"
+}`)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		{
+			"invalid draft",
+			[]string{
+				invalidDraftFile.Name(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, 0, nil,
+		},
+		{
+			"valid transaction",
+			[]string{
+				validDraftFile.Name(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0, &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdSubmitDraftProposal()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestCmdGetProposal() {
 	val := s.network.Validators[0]
 
@@ -771,6 +868,192 @@ func (s *IntegrationTestSuite) TestCmdQueryVote() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewCmdCancelProposal() {
+	val := s.network.Validators[0]
+
+	// create a proposal to cancel
+	out, err := MsgSubmitProposal(val.ClientCtx, val.Address.String(),
+		"Text Proposal to Cancel", "Where is the title!?", types.ProposalTypeText,
+		fmt.Sprintf("--%s=%s", cli.FlagDeposit, sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5431)).String()))
+	s.Require().NoError(err)
+
+	var submitResp sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &submitResp), out.String())
+	s.Require().Equal(uint32(0), submitResp.Code, out.String())
+
+	var proposalID string
+	for _, event := range submitResp.Logs[0].Events {
+		for _, attr := range event.Attributes {
+			if event.Type == types.EventTypeSubmitProposal && attr.Key == types.AttributeKeyProposalID {
+				proposalID = attr.Value
+			}
+		}
+	}
+	s.Require().NotEmpty(proposalID)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+	}{
+		{
+			"invalid proposal id",
+			[]string{
+				"abc",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			},
+			true, 0,
+		},
+		{
+			"valid cancellation",
+			[]string{
+				proposalID,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0,
+		},
+		{
+			"canceled proposal",
+			[]string{
+				proposalID,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, types.ErrUnknownProposal.ABCICode(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdCancelProposal()
+			clientCtx := val.ClientCtx
+			var txResp sdk.TxResponse
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewCmdAnchorDiscussion() {
+	val := s.network.Validators[0]
+
+	// create a proposal to anchor a discussion of
+	out, err := MsgSubmitProposal(val.ClientCtx, val.Address.String(),
+		"Text Proposal to Discuss", "Where is the title!?", types.ProposalTypeText,
+		fmt.Sprintf("--%s=%s", cli.FlagDeposit, sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5431)).String()))
+	s.Require().NoError(err)
+
+	var submitResp sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &submitResp), out.String())
+	s.Require().Equal(uint32(0), submitResp.Code, out.String())
+
+	var proposalID string
+	for _, event := range submitResp.Logs[0].Events {
+		for _, attr := range event.Attributes {
+			if event.Type == types.EventTypeSubmitProposal && attr.Key == types.AttributeKeyProposalID {
+				proposalID = attr.Value
+			}
+		}
+	}
+	s.Require().NotEmpty(proposalID)
+
+	hash := strings.Repeat("ab", types.DiscussionAnchorHashLength)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+	}{
+		{
+			"invalid proposal id",
+			[]string{
+				"abc", hash,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			},
+			true, 0,
+		},
+		{
+			"invalid hash",
+			[]string{
+				proposalID, "abcd",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+			},
+			true, 0,
+		},
+		{
+			"unknown proposal",
+			[]string{
+				"1000", hash,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, types.ErrUnknownProposal.ABCICode(),
+		},
+		{
+			"valid anchor",
+			[]string{
+				proposalID, hash,
+				fmt.Sprintf("--%s=%s", cli.FlagURI, "https://forum.example.com/t/1"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdAnchorDiscussion()
+			clientCtx := val.ClientCtx
+			var txResp sdk.TxResponse
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryDiscussionAnchors(), []string{
+		proposalID,
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+
+	var anchorsResp types.QueryDiscussionAnchorsResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &anchorsResp), out.String())
+	s.Require().Len(anchorsResp.Anchors, 1)
+	s.Require().Equal(val.Address.String(), anchorsResp.Anchors[0].Author)
+	s.Require().Equal(hash, hex.EncodeToString(anchorsResp.Anchors[0].Hash))
+	s.Require().Equal("https://forum.example.com/t/1", anchorsResp.Anchors[0].Uri)
+}
+
 func (s *IntegrationTestSuite) TestNewCmdVote() {
 	val := s.network.Validators[0]
 
@@ -134,6 +134,7 @@ func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVot
 						Voter:      voteMsg.Voter,
 						ProposalId: params.ProposalID,
 						Options:    types.NewNonSplitVoteOption(voteMsg.Option),
+						Metadata:   voteMsg.Metadata,
 					})
 				}
 
@@ -142,6 +143,7 @@ func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVot
 						Voter:      voteWeightedMsg.Voter,
 						ProposalId: params.ProposalID,
 						Options:    voteWeightedMsg.Options,
+						Metadata:   voteWeightedMsg.Metadata,
 					})
 				}
 			}
@@ -209,6 +211,7 @@ func QueryVoteByTxQuery(clientCtx client.Context, params types.QueryVoteParams)
 					Voter:      voteMsg.Voter,
 					ProposalId: params.ProposalID,
 					Options:    types.NewNonSplitVoteOption(voteMsg.Option),
+					Metadata:   voteMsg.Metadata,
 				}
 			}
 
@@ -217,6 +220,7 @@ func QueryVoteByTxQuery(clientCtx client.Context, params types.QueryVoteParams)
 					Voter:      voteWeightedMsg.Voter,
 					ProposalId: params.ProposalID,
 					Options:    voteWeightedMsg.Options,
+					Metadata:   voteWeightedMsg.Metadata,
 				}
 			}
 
@@ -62,6 +62,10 @@ func NormalizeProposalStatus(status string) string {
 		return types.StatusPassed.String()
 	case "Rejected", "rejected":
 		return types.StatusRejected.String()
+	case "Scheduled", "scheduled":
+		return types.StatusScheduled.String()
+	case "RevealPeriod", "reveal_period":
+		return types.StatusRevealPeriod.String()
 	default:
 		return status
 	}
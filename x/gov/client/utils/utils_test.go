@@ -75,6 +75,10 @@ func TestNormalizeProposalStatus(t *testing.T) {
 		{"Passed", args{"Passed"}, "PROPOSAL_STATUS_PASSED"},
 		{"Rejected", args{"Rejected"}, "PROPOSAL_STATUS_REJECTED"},
 		{"rejected", args{"rejected"}, "PROPOSAL_STATUS_REJECTED"},
+		{"Scheduled", args{"Scheduled"}, "PROPOSAL_STATUS_SCHEDULED"},
+		{"scheduled", args{"scheduled"}, "PROPOSAL_STATUS_SCHEDULED"},
+		{"RevealPeriod", args{"RevealPeriod"}, "PROPOSAL_STATUS_REVEAL_PERIOD"},
+		{"reveal_period", args{"reveal_period"}, "PROPOSAL_STATUS_REVEAL_PERIOD"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -14,6 +14,7 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 	k.SetDepositParams(ctx, data.DepositParams)
 	k.SetVotingParams(ctx, data.VotingParams)
 	k.SetTallyParams(ctx, data.TallyParams)
+	k.SetProposalTemplates(ctx, data.ProposalTemplates)
 
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
@@ -31,16 +32,75 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		k.SetVote(ctx, vote)
 	}
 
+	for _, vote := range data.ChoiceVotes {
+		k.SetChoiceVote(ctx, vote)
+	}
+
+	for _, commitment := range data.VoteCommitments {
+		k.SetVoteCommitment(ctx, commitment)
+		totalDeposits = totalDeposits.Add(commitment.Deposit...)
+	}
+
+	for _, receipt := range data.VoteReceipts {
+		k.SetVoteReceipt(ctx, receipt)
+	}
+
+	for _, anchor := range data.DiscussionAnchors {
+		k.SetDiscussionAnchor(ctx, anchor)
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case types.StatusDepositPeriod:
 			k.InsertInactiveProposalQueue(ctx, proposal.ProposalId, proposal.DepositEndTime)
 		case types.StatusVotingPeriod:
-			k.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+			switch {
+			case proposal.IsOptimistic:
+				k.InsertOptimisticProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+			case proposal.IsCouncil:
+				k.InsertCouncilProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+			default:
+				k.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+			}
+			if proposal.IsEmergency {
+				k.InsertEmergencyProposalQueue(ctx, proposal.ProposalId)
+			}
+		case types.StatusRevealPeriod:
+			k.InsertRevealProposalQueue(ctx, proposal.ProposalId, proposal.RevealEndTime)
+		case types.StatusPassed, types.StatusRejected, types.StatusFailed:
+			k.InsertFinalizedProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+		case types.StatusScheduled:
+			// a scheduled proposal without execution time is held until the
+			// proposals it depends on are executed
+			if proposal.ExecutionTime.IsZero() {
+				k.InsertDependencyQueue(ctx, proposal.ProposalId)
+			} else {
+				k.InsertExecutionQueue(ctx, proposal.ProposalId, proposal.ExecutionTime)
+			}
 		}
 		k.SetProposal(ctx, proposal)
 	}
 
+	for _, proposal := range data.ArchivedProposals {
+		k.SetArchivedProposal(ctx, proposal)
+	}
+
+	for _, delegation := range data.GovernanceDelegations {
+		k.SetGovernanceDelegation(ctx, delegation)
+	}
+
+	for _, snapshot := range data.VotingPowerSnapshots {
+		k.SetVotingPowerSnapshot(ctx, snapshot)
+	}
+
+	for _, participation := range data.ValidatorParticipations {
+		k.SetValidatorParticipation(ctx, participation)
+	}
+
+	for _, bonding := range data.DelegationBondings {
+		k.SetDelegationBonding(ctx, bonding)
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -59,6 +119,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	depositParams := k.GetDepositParams(ctx)
 	votingParams := k.GetVotingParams(ctx)
 	tallyParams := k.GetTallyParams(ctx)
+	proposalTemplates := k.GetProposalTemplates(ctx)
 	proposals := k.GetProposals(ctx)
 
 	var proposalsDeposits types.Deposits
@@ -72,12 +133,22 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 
 	return &types.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		DepositParams:      depositParams,
-		VotingParams:       votingParams,
-		TallyParams:        tallyParams,
+		StartingProposalId:      startingProposalID,
+		Deposits:                proposalsDeposits,
+		Votes:                   proposalsVotes,
+		Proposals:               proposals,
+		DepositParams:           depositParams,
+		VotingParams:            votingParams,
+		TallyParams:             tallyParams,
+		ProposalTemplates:       proposalTemplates,
+		VoteReceipts:            k.GetAllVoteReceipts(ctx),
+		ArchivedProposals:       k.GetArchivedProposals(ctx),
+		DiscussionAnchors:       k.GetAllDiscussionAnchors(ctx),
+		ChoiceVotes:             k.GetAllChoiceVotes(ctx),
+		VoteCommitments:         k.GetAllVoteCommitments(ctx),
+		GovernanceDelegations:   k.GetAllGovernanceDelegations(ctx),
+		VotingPowerSnapshots:    k.GetAllVotingPowerSnapshots(ctx),
+		ValidatorParticipations: k.GetAllValidatorParticipations(ctx),
+		DelegationBondings:      k.GetAllDelegationBondings(ctx),
 	}
 }
@@ -52,6 +52,27 @@ func TestImportExportQueues(t *testing.T) {
 	require.True(t, proposal1.Status == types.StatusDepositPeriod)
 	require.True(t, proposal2.Status == types.StatusVotingPeriod)
 
+	receipt := types.NewVoteReceipt(proposalID2, addrs[1], 1)
+	app.GovKeeper.SetVoteReceipt(ctx, receipt)
+
+	anchor := types.NewDiscussionAnchor(proposalID2, addrs[0], make([]byte, types.DiscussionAnchorHashLength), "https://forum.example.com/t/2", 1)
+	app.GovKeeper.SetDiscussionAnchor(ctx, anchor)
+
+	commitment := types.NewVoteCommitment(proposalID2, addrs[1], make([]byte, 32), nil)
+	app.GovKeeper.SetVoteCommitment(ctx, commitment)
+
+	require.NoError(t, app.GovKeeper.SetGovernor(ctx, addrs[0], addrs[1]))
+
+	snapshot, found := app.GovKeeper.GetVotingPowerSnapshot(ctx, proposalID2)
+	require.True(t, found)
+
+	// archive a third, finalized proposal
+	proposal3, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	require.NoError(t, err)
+	app.GovKeeper.RemoveFromInactiveProposalQueue(ctx, proposal3.ProposalId, proposal3.DepositEndTime)
+	proposal3.Status = types.StatusPassed
+	app.GovKeeper.ArchiveProposal(ctx, proposal3)
+
 	authGenState := auth.ExportGenesis(ctx, app.AccountKeeper)
 	bankGenState := app.BankKeeper.ExportGenesis(ctx)
 
@@ -98,6 +119,16 @@ func TestImportExportQueues(t *testing.T) {
 	require.True(t, proposal1.Status == types.StatusDepositPeriod)
 	require.True(t, proposal2.Status == types.StatusVotingPeriod)
 
+	require.Equal(t, []types.VoteReceipt{receipt}, app2.GovKeeper.GetAllVoteReceipts(ctx2))
+	require.Equal(t, []types.DiscussionAnchor{anchor}, app2.GovKeeper.GetAllDiscussionAnchors(ctx2))
+	require.Equal(t, []types.VoteCommitment{commitment}, app2.GovKeeper.GetAllVoteCommitments(ctx2))
+	require.Equal(t, []types.GovernanceDelegation{types.NewGovernanceDelegation(addrs[0], addrs[1])}, app2.GovKeeper.GetAllGovernanceDelegations(ctx2))
+	require.Equal(t, []types.VotingPowerSnapshot{snapshot}, app2.GovKeeper.GetAllVotingPowerSnapshots(ctx2))
+
+	archived, ok := app2.GovKeeper.GetArchivedProposal(ctx2, proposal3.ProposalId)
+	require.True(t, ok)
+	require.True(t, proposal3.Equal(archived))
+
 	macc := app2.GovKeeper.GetGovernanceAccount(ctx2)
 	require.Equal(t, app2.GovKeeper.GetDepositParams(ctx2).MinDeposit, app2.BankKeeper.GetAllBalances(ctx2, macc.GetAddress()))
 
@@ -110,6 +141,9 @@ func TestImportExportQueues(t *testing.T) {
 	proposal2, ok = app2.GovKeeper.GetProposal(ctx2, proposalID2)
 	require.True(t, ok)
 	require.True(t, proposal2.Status == types.StatusRejected)
+
+	// the voting power snapshot is pruned once the proposal is tallied
+	require.Empty(t, app2.GovKeeper.GetAllVotingPowerSnapshots(ctx2))
 }
 
 func TestImportExportQueues_ErrorUnconsistentState(t *testing.T) {
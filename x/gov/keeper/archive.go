@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// InsertFinalizedProposalQueue inserts a ProposalID into the finalized proposal
+// queue at its voting endTime
+func (keeper Keeper) InsertFinalizedProposalQueue(ctx sdk.Context, proposalID uint64, endTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := types.GetProposalIDBytes(proposalID)
+	store.Set(types.FinalizedProposalQueueKey(proposalID, endTime), bz)
+}
+
+// RemoveFromFinalizedProposalQueue removes a proposalID from the Finalized Proposal Queue
+func (keeper Keeper) RemoveFromFinalizedProposalQueue(ctx sdk.Context, proposalID uint64, endTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.FinalizedProposalQueueKey(proposalID, endTime))
+}
+
+// IterateFinalizedProposalsQueue iterates over the proposals in the finalized
+// proposal queue whose voting period ended by endTime and performs a callback
+// function
+func (keeper Keeper) IterateFinalizedProposalsQueue(ctx sdk.Context, endTime time.Time, cb func(proposal types.Proposal) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(types.FinalizedProposalQueuePrefix, sdk.PrefixEndBytes(types.FinalizedProposalByTimeKey(endTime)))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, _ := types.SplitFinalizedProposalQueueKey(iterator.Key())
+		proposal, found := keeper.GetProposal(ctx, proposalID)
+		if !found {
+			panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+		}
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+// ArchiveProposals moves the finalized proposals whose voting period ended
+// more than the archive retention period ago to the archive store, or deletes
+// them if the archive prune param is set, keeping those whose execution can
+// still be retried. At most the archive batch size of
+// proposals are processed per call, the others being left to the following
+// blocks. It is a no-op if the archive retention period is zero.
+func (keeper Keeper) ArchiveProposals(ctx sdk.Context) {
+	votingParams := keeper.GetVotingParams(ctx)
+	if votingParams.ArchiveRetentionPeriod <= 0 {
+		return
+	}
+
+	// collect the proposals first as the queue is mutated by the archival
+	var proposals []types.Proposal
+	keeper.IterateFinalizedProposalsQueue(ctx, ctx.BlockHeader().Time.Add(-votingParams.ArchiveRetentionPeriod), func(proposal types.Proposal) bool {
+		// keep the proposals whose execution can still be retried
+		if proposal.ExecutionRetryable(ctx.BlockHeader().Time) {
+			return false
+		}
+
+		proposals = append(proposals, proposal)
+		return votingParams.ArchiveBatchSize > 0 && uint64(len(proposals)) >= votingParams.ArchiveBatchSize
+	})
+
+	for _, proposal := range proposals {
+		eventType := types.EventTypeArchiveProposal
+		if votingParams.ArchivePrune {
+			keeper.PruneProposal(ctx, proposal)
+			eventType = types.EventTypePruneProposal
+		} else {
+			keeper.ArchiveProposal(ctx, proposal)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				eventType,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+			),
+		)
+	}
+}
+
+// ArchiveProposal moves a finalized proposal from the proposal store to the
+// archive store, where it is kept compressed and out of the proposal
+// iterations.
+func (keeper Keeper) ArchiveProposal(ctx sdk.Context, proposal types.Proposal) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	keeper.RemoveFromFinalizedProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+	store.Delete(types.ProposalKey(proposal.ProposalId))
+	keeper.SetArchivedProposal(ctx, proposal)
+}
+
+// PruneProposal deletes a finalized proposal from the proposal store, along
+// with the participation of the validators in its vote, without archiving it.
+func (keeper Keeper) PruneProposal(ctx sdk.Context, proposal types.Proposal) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	keeper.RemoveFromFinalizedProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+	store.Delete(types.ProposalKey(proposal.ProposalId))
+	keeper.DeleteValidatorParticipations(ctx, proposal.ProposalId)
+}
+
+// GetArchivedProposal gets an archived proposal from the archive store by
+// ProposalID
+func (keeper Keeper) GetArchivedProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	bz := store.Get(types.ArchivedProposalKey(proposalID))
+	if bz == nil {
+		return types.Proposal{}, false
+	}
+
+	var proposal types.Proposal
+	keeper.mustUnmarshalArchivedProposal(bz, &proposal)
+
+	return proposal, true
+}
+
+// SetArchivedProposal sets a proposal to the archive store
+func (keeper Keeper) SetArchivedProposal(ctx sdk.Context, proposal types.Proposal) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(keeper.MustMarshalProposal(proposal)); err != nil {
+		panic(err)
+	}
+	if err := zw.Close(); err != nil {
+		panic(err)
+	}
+
+	store.Set(types.ArchivedProposalKey(proposal.ProposalId), buf.Bytes())
+}
+
+// IterateArchivedProposals iterates over all the archived proposals and
+// performs a callback function
+func (keeper Keeper) IterateArchivedProposals(ctx sdk.Context, cb func(proposal types.Proposal) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ArchivedProposalsKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.Proposal
+		keeper.mustUnmarshalArchivedProposal(iterator.Value(), &proposal)
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+// GetArchivedProposals returns all the archived proposals from store
+func (keeper Keeper) GetArchivedProposals(ctx sdk.Context) (proposals types.Proposals) {
+	keeper.IterateArchivedProposals(ctx, func(proposal types.Proposal) bool {
+		proposals = append(proposals, proposal)
+		return false
+	})
+	return
+}
+
+func (keeper Keeper) mustUnmarshalArchivedProposal(bz []byte, proposal *types.Proposal) {
+	zr, err := gzip.NewReader(bytes.NewReader(bz))
+	if err != nil {
+		panic(err)
+	}
+
+	bz, err = ioutil.ReadAll(zr)
+	if err != nil {
+		panic(err)
+	}
+
+	keeper.MustUnmarshalProposal(bz, proposal)
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestArchiveProposals(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	finalize := func(endTime time.Time) types.Proposal {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+		app.GovKeeper.RemoveFromInactiveProposalQueue(ctx, proposal.ProposalId, proposal.DepositEndTime)
+		proposal.Status = types.StatusPassed
+		proposal.VotingEndTime = endTime
+		app.GovKeeper.SetProposal(ctx, proposal)
+		app.GovKeeper.InsertFinalizedProposalQueue(ctx, proposal.ProposalId, endTime)
+		return proposal
+	}
+
+	now := ctx.BlockHeader().Time
+	oldProposal := finalize(now.Add(-2 * time.Hour))
+	recentProposal := finalize(now.Add(-30 * time.Minute))
+
+	// no archival while the archive retention period is zero
+	app.GovKeeper.ArchiveProposals(ctx)
+	require.Len(t, app.GovKeeper.GetProposals(ctx), 2)
+	require.Empty(t, app.GovKeeper.GetArchivedProposals(ctx))
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.ArchiveRetentionPeriod = time.Hour
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	app.GovKeeper.ArchiveProposals(ctx)
+
+	_, found := app.GovKeeper.GetProposal(ctx, oldProposal.ProposalId)
+	require.False(t, found)
+	archived, found := app.GovKeeper.GetArchivedProposal(ctx, oldProposal.ProposalId)
+	require.True(t, found)
+	require.True(t, oldProposal.Equal(archived))
+
+	_, found = app.GovKeeper.GetProposal(ctx, recentProposal.ProposalId)
+	require.True(t, found)
+	_, found = app.GovKeeper.GetArchivedProposal(ctx, recentProposal.ProposalId)
+	require.False(t, found)
+
+	require.Len(t, app.GovKeeper.GetProposals(ctx), 1)
+	require.Len(t, app.GovKeeper.GetArchivedProposals(ctx), 1)
+
+	// the recent proposal is archived once its retention period elapsed
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	app.GovKeeper.ArchiveProposals(ctx)
+	require.Empty(t, app.GovKeeper.GetProposals(ctx))
+	require.Len(t, app.GovKeeper.GetArchivedProposals(ctx), 2)
+
+	// archived proposal ids are not reused
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	require.Equal(t, recentProposal.ProposalId+1, proposal.ProposalId)
+}
+
+func TestArchiveProposalsBatch(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	now := ctx.BlockHeader().Time
+
+	for i := 0; i < 5; i++ {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+		app.GovKeeper.RemoveFromInactiveProposalQueue(ctx, proposal.ProposalId, proposal.DepositEndTime)
+		proposal.Status = types.StatusRejected
+		proposal.VotingEndTime = now.Add(-2 * time.Hour).Add(time.Duration(i) * time.Minute)
+		app.GovKeeper.SetProposal(ctx, proposal)
+		app.GovKeeper.InsertFinalizedProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+	}
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.ArchiveRetentionPeriod = time.Hour
+	votingParams.ArchiveBatchSize = 2
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	// the oldest proposals are archived first, two per block
+	app.GovKeeper.ArchiveProposals(ctx)
+	require.Len(t, app.GovKeeper.GetProposals(ctx), 3)
+	archived := app.GovKeeper.GetArchivedProposals(ctx)
+	require.Len(t, archived, 2)
+	require.Equal(t, uint64(1), archived[0].ProposalId)
+	require.Equal(t, uint64(2), archived[1].ProposalId)
+
+	// the proposals left over are pruned once the archive prune param is set
+	votingParams.ArchivePrune = true
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	app.GovKeeper.ArchiveProposals(ctx)
+	require.Len(t, app.GovKeeper.GetProposals(ctx), 1)
+	require.Len(t, app.GovKeeper.GetArchivedProposals(ctx), 2)
+	_, found := app.GovKeeper.GetProposal(ctx, 3)
+	require.False(t, found)
+	_, found = app.GovKeeper.GetArchivedProposal(ctx, 3)
+	require.False(t, found)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	require.Equal(t, types.EventTypePruneProposal, events[0].Type)
+
+	app.GovKeeper.ArchiveProposals(ctx)
+	require.Empty(t, app.GovKeeper.GetProposals(ctx))
+	require.Len(t, app.GovKeeper.GetArchivedProposals(ctx), 2)
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// AddChoiceVote adds a vote for one of the choices of a multiple-choice
+// proposal
+func (keeper Keeper) AddChoiceVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, choice uint32) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	if proposal.Status != types.StatusVotingPeriod {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+	if !proposal.IsMultipleChoice() {
+		return sdkerrors.Wrapf(types.ErrInvalidVote, "proposal %d is not a multiple-choice proposal", proposalID)
+	}
+	if int(choice) >= len(proposal.Choices) {
+		return sdkerrors.Wrapf(types.ErrInvalidVote, "proposal %d has no choice %d", proposalID, choice)
+	}
+	if keeper.InValidatorVotingPeriod(ctx, proposal) && keeper.sk.Validator(ctx, sdk.ValAddress(voterAddr)) == nil {
+		return sdkerrors.Wrapf(types.ErrValidatorVotingPeriod, "%d ends at %s", proposalID, proposal.ValidatorVotingEndTime)
+	}
+
+	keeper.SetChoiceVote(ctx, types.NewChoiceVote(proposalID, voterAddr, choice))
+
+	// called after a vote on a proposal is cast
+	keeper.AfterProposalVote(ctx, proposalID, voterAddr)
+
+	keeper.issueVoteReceipt(ctx, proposalID, voterAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalVote,
+			sdk.NewAttribute(types.AttributeKeyChoice, fmt.Sprintf("%d", choice)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return nil
+}
+
+// GetAllChoiceVotes returns all the choice votes from the store
+func (keeper Keeper) GetAllChoiceVotes(ctx sdk.Context) (votes []types.ChoiceVote) {
+	keeper.IterateAllChoiceVotes(ctx, func(vote types.ChoiceVote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+	return
+}
+
+// GetChoiceVotes returns all the choice votes from a proposal
+func (keeper Keeper) GetChoiceVotes(ctx sdk.Context, proposalID uint64) (votes []types.ChoiceVote) {
+	keeper.IterateChoiceVotes(ctx, proposalID, func(vote types.ChoiceVote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+	return
+}
+
+// GetChoiceVote gets the choice vote from an address on a specific proposal
+func (keeper Keeper) GetChoiceVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) (vote types.ChoiceVote, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ChoiceVoteKey(proposalID, voterAddr))
+	if bz == nil {
+		return vote, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &vote)
+	return vote, true
+}
+
+// SetChoiceVote sets a ChoiceVote to the gov store
+func (keeper Keeper) SetChoiceVote(ctx sdk.Context, vote types.ChoiceVote) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&vote)
+	addr, err := sdk.AccAddressFromBech32(vote.Voter)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.ChoiceVoteKey(vote.ProposalId, addr), bz)
+}
+
+// IterateAllChoiceVotes iterates over the all the stored choice votes and
+// performs a callback function
+func (keeper Keeper) IterateAllChoiceVotes(ctx sdk.Context, cb func(vote types.ChoiceVote) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChoiceVotesKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.ChoiceVote
+		keeper.cdc.MustUnmarshal(iterator.Value(), &vote)
+
+		if cb(vote) {
+			break
+		}
+	}
+}
+
+// IterateChoiceVotes iterates over the all the choice votes of a proposal and
+// performs a callback function
+func (keeper Keeper) IterateChoiceVotes(ctx sdk.Context, proposalID uint64, cb func(vote types.ChoiceVote) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChoiceVotesKey(proposalID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.ChoiceVote
+		keeper.cdc.MustUnmarshal(iterator.Value(), &vote)
+
+		if cb(vote) {
+			break
+		}
+	}
+}
+
+// deleteChoiceVote deletes a choice vote from a given proposalID and voter
+// from the store
+func (keeper Keeper) deleteChoiceVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.ChoiceVoteKey(proposalID, voterAddr))
+}
+
+// deleteChoiceVotes deletes all the choice votes on a specific proposal
+func (keeper Keeper) deleteChoiceVotes(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChoiceVotesKey(proposalID))
+
+	batch := sdk.NewKVStoreBatch(store)
+	for ; iterator.Valid(); iterator.Next() {
+		batch.Delete(iterator.Key())
+	}
+	iterator.Close()
+
+	batch.Write()
+}
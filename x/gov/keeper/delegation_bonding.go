@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetDelegationBonding gets the bonding of a delegation from the store
+func (keeper Keeper) GetDelegationBonding(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+) (bonding types.DelegationBonding, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.DelegationBondingKey(delAddr, valAddr))
+	if bz == nil {
+		return bonding, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &bonding)
+	return bonding, true
+}
+
+// SetDelegationBonding sets the bonding of a delegation in the store
+func (keeper Keeper) SetDelegationBonding(ctx sdk.Context, bonding types.DelegationBonding) {
+	delAddr, err := sdk.AccAddressFromBech32(bonding.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(bonding.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&bonding)
+	store.Set(types.DelegationBondingKey(delAddr, valAddr), bz)
+}
+
+// DeleteDelegationBonding deletes the bonding of a delegation from the store
+func (keeper Keeper) DeleteDelegationBonding(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.DelegationBondingKey(delAddr, valAddr))
+}
+
+// IterateDelegationBondings iterates over the bondings of all the delegations
+// and performs a callback function
+func (keeper Keeper) IterateDelegationBondings(ctx sdk.Context, cb func(bonding types.DelegationBonding) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DelegationBondingsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bonding types.DelegationBonding
+		keeper.cdc.MustUnmarshal(iterator.Value(), &bonding)
+
+		if cb(bonding) {
+			break
+		}
+	}
+}
+
+// GetAllDelegationBondings returns the bondings of all the delegations from
+// the store
+func (keeper Keeper) GetAllDelegationBondings(ctx sdk.Context) (bondings []types.DelegationBonding) {
+	keeper.IterateDelegationBondings(ctx, func(bonding types.DelegationBonding) bool {
+		bondings = append(bondings, bonding)
+		return false
+	})
+	return
+}
+
+// UpdateDelegationBonding updates the bonding of a delegation to its current
+// shares. A new delegation is bonded since the current block time, and the
+// shares added to a delegation bring the time it is bonded since forward in
+// proportion to the added shares, so that its conviction can't be bought by
+// adding stake to an old delegation.
+func (keeper Keeper) UpdateDelegationBonding(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) {
+	bonding, found := keeper.GetDelegationBonding(ctx, delAddr, valAddr)
+	if !found {
+		keeper.SetDelegationBonding(ctx, types.NewDelegationBonding(delAddr, valAddr, ctx.BlockTime(), shares))
+		return
+	}
+
+	if shares.GT(bonding.Shares) {
+		if elapsed := ctx.BlockTime().Sub(bonding.BondedSince); elapsed > 0 {
+			// the time bonded since, averaged over the shares
+			added := shares.Sub(bonding.Shares)
+			shift := added.MulInt64(int64(elapsed)).Quo(shares).TruncateInt64()
+			bonding.BondedSince = bonding.BondedSince.Add(time.Duration(shift))
+		}
+	}
+
+	bonding.Shares = shares
+	keeper.SetDelegationBonding(ctx, bonding)
+}
+
+// InitDelegationBondings records the delegations without a bonding as bonded
+// since the current block time.
+func (keeper Keeper) InitDelegationBondings(ctx sdk.Context) {
+	keeper.sk.IterateAllDelegations(ctx, func(delegation stakingtypes.Delegation) (stop bool) {
+		delAddr := delegation.GetDelegatorAddr()
+		valAddr := delegation.GetValidatorAddr()
+		if _, found := keeper.GetDelegationBonding(ctx, delAddr, valAddr); !found {
+			keeper.SetDelegationBonding(ctx, types.NewDelegationBonding(delAddr, valAddr, ctx.BlockTime(), delegation.Shares))
+		}
+		return false
+	})
+}
+
+// convictionMultiplier returns the conviction multiplier of the voting power
+// of a delegation at the current block time. A delegation without a bonding
+// is bonded since the current block time.
+func (keeper Keeper) convictionMultiplier(
+	ctx sdk.Context, tallyParams types.TallyParams, delAddrStr, valAddrStr string,
+) sdk.Dec {
+	delAddr, err := sdk.AccAddressFromBech32(delAddrStr)
+	if err != nil {
+		panic(err)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+	if err != nil {
+		panic(err)
+	}
+
+	bonding, found := keeper.GetDelegationBonding(ctx, delAddr, valAddr)
+	if !found {
+		return sdk.OneDec()
+	}
+
+	return tallyParams.ConvictionMultiplier(ctx.BlockTime().Sub(bonding.BondedSince))
+}
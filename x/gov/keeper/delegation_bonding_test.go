@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestDelegationBondings(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+	delegator, valAddr := addrs[0], sdk.ValAddress(addrs[1])
+	validator, err := stakingtypes.NewValidator(valAddr, simapp.CreateTestPubKeys(1)[0], stakingtypes.Description{})
+	require.NoError(t, err)
+	app.StakingKeeper.SetValidator(ctx, validator)
+	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
+	app.StakingKeeper.SetNewValidatorByPowerIndex(ctx, validator)
+	require.NoError(t, app.StakingKeeper.AfterValidatorCreated(ctx, valAddr))
+	tokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+
+	// a new delegation is bonded since now
+	shares, err := app.StakingKeeper.Delegate(ctx, delegator, tokens, stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+	bonding, found := app.GovKeeper.GetDelegationBonding(ctx, delegator, valAddr)
+	require.True(t, found)
+	require.Equal(t, now, bonding.BondedSince)
+	require.Equal(t, shares, bonding.Shares)
+
+	// doubling the delegation halves the time it has been bonded for
+	ctx = ctx.WithBlockTime(now.Add(100 * time.Second))
+	validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+	_, err = app.StakingKeeper.Delegate(ctx, delegator, tokens, stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+	bonding, found = app.GovKeeper.GetDelegationBonding(ctx, delegator, valAddr)
+	require.True(t, found)
+	require.Equal(t, now.Add(50*time.Second), bonding.BondedSince)
+	require.Equal(t, shares.MulInt64(2), bonding.Shares)
+
+	// unbonding part of the delegation keeps the time it is bonded since
+	ctx = ctx.WithBlockTime(now.Add(200 * time.Second))
+	_, err = app.StakingKeeper.Undelegate(ctx, delegator, valAddr, shares)
+	require.NoError(t, err)
+	bonding, found = app.GovKeeper.GetDelegationBonding(ctx, delegator, valAddr)
+	require.True(t, found)
+	require.Equal(t, now.Add(50*time.Second), bonding.BondedSince)
+	require.Equal(t, shares, bonding.Shares)
+
+	// the bonding is deleted with the delegation
+	_, err = app.StakingKeeper.Undelegate(ctx, delegator, valAddr, shares)
+	require.NoError(t, err)
+	_, found = app.GovKeeper.GetDelegationBonding(ctx, delegator, valAddr)
+	require.False(t, found)
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// ValidateProposalDependencies checks that the proposals a proposal depends on
+// were submitted before it and can still be executed, i.e. they are neither
+// rejected nor failed, unless their execution can still be retried, and are
+// still in the proposal or archive store.
+func (keeper Keeper) ValidateProposalDependencies(ctx sdk.Context, proposalID uint64, dependsOn []uint64) error {
+	if err := types.ValidateProposalDependencies(dependsOn); err != nil {
+		return err
+	}
+
+	for _, dependencyID := range dependsOn {
+		if dependencyID >= proposalID {
+			return sdkerrors.Wrapf(types.ErrInvalidDependency, "proposal %d can only depend on earlier proposals, got %d", proposalID, dependencyID)
+		}
+
+		dependency, found := keeper.GetProposalOrArchived(ctx, dependencyID)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrInvalidDependency, "proposal %d does not exist", dependencyID)
+		}
+		if dependency.Status == types.StatusRejected ||
+			(dependency.Status == types.StatusFailed && !dependency.ExecutionRetryable(ctx.BlockHeader().Time)) {
+			return sdkerrors.Wrapf(types.ErrInvalidDependency, "proposal %d is %s", dependencyID, dependency.Status)
+		}
+	}
+
+	return nil
+}
+
+// CheckProposalDependencies returns whether the proposals a proposal depends
+// on have all passed and been executed, a dependency which failed on execution
+// being pending while its execution can be retried. It returns an
+// ErrDependencyFailed error if one of them never will, because it was
+// rejected, failed on execution, or was removed from the store after being
+// dropped or canceled.
+func (keeper Keeper) CheckProposalDependencies(ctx sdk.Context, proposal types.Proposal) (ready bool, err error) {
+	ready = true
+	for _, dependencyID := range proposal.DependsOn {
+		dependency, found := keeper.GetProposalOrArchived(ctx, dependencyID)
+		switch {
+		case !found:
+			return false, sdkerrors.Wrapf(types.ErrDependencyFailed, "proposal %d does not exist", dependencyID)
+		case dependency.ExecutionRetryable(ctx.BlockHeader().Time):
+			ready = false
+		case dependency.Status == types.StatusRejected || dependency.Status == types.StatusFailed:
+			return false, sdkerrors.Wrapf(types.ErrDependencyFailed, "proposal %d is %s", dependencyID, dependency.Status)
+		case dependency.Status != types.StatusPassed:
+			ready = false
+		}
+	}
+
+	return ready, nil
+}
+
+// GetProposalOrArchived gets a proposal from the proposal store or, if it was
+// archived, from the archive store. It doesn't find the proposals pruned, nor
+// the ones deleted after being dropped or canceled.
+func (keeper Keeper) GetProposalOrArchived(ctx sdk.Context, proposalID uint64) (types.Proposal, bool) {
+	if proposal, found := keeper.GetProposal(ctx, proposalID); found {
+		return proposal, true
+	}
+
+	return keeper.GetArchivedProposal(ctx, proposalID)
+}
+
+// InsertDependencyQueue inserts a ProposalID into the dependency queue, where
+// it waits for the proposals it depends on to be executed
+func (keeper Keeper) InsertDependencyQueue(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := types.GetProposalIDBytes(proposalID)
+	store.Set(types.DependencyQueueKey(proposalID), bz)
+}
+
+// RemoveFromDependencyQueue removes a proposalID from the Dependency Queue
+func (keeper Keeper) RemoveFromDependencyQueue(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.DependencyQueueKey(proposalID))
+}
+
+// IterateDependencyQueue iterates over the proposals in the dependency queue
+// by ascending proposal ID and performs a callback function
+func (keeper Keeper) IterateDependencyQueue(ctx sdk.Context, cb func(proposal types.Proposal) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DependencyQueuePrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID := types.SplitProposalKey(iterator.Key())
+		proposal, found := keeper.GetProposal(ctx, proposalID)
+		if !found {
+			panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+		}
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
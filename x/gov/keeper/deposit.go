@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -108,6 +110,18 @@ func (keeper Keeper) IterateDeposits(ctx sdk.Context, proposalID uint64, cb func
 	}
 }
 
+// ValidateInitialDeposit checks that the initial deposit of a proposal covers
+// the MinInitialDepositRatio fraction of the minimum deposit, so proposals can't
+// sit in the deposit period with a dust deposit.
+func (keeper Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins) error {
+	minInitialDeposit := keeper.GetDepositParams(ctx).MinInitialDeposit()
+	if !initialDeposit.IsAllGTE(minInitialDeposit) {
+		return sdkerrors.Wrapf(types.ErrMinInitialDeposit, "was (%s), need (%s)", initialDeposit, minInitialDeposit)
+	}
+
+	return nil
+}
+
 // AddDeposit adds or updates a deposit of a specific depositor on a specific proposal
 // Activates voting period when appropriate
 func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress, depositAmount sdk.Coins) (bool, error) {
@@ -166,6 +180,44 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	return activatedVotingPeriod, nil
 }
 
+// ChargeSubmissionFee charges the non-refundable proposal submission fee to the
+// proposer, and either burns it or credits it to the community pool according
+// to the deposit params.
+func (keeper Keeper) ChargeSubmissionFee(ctx sdk.Context, proposerAddr sdk.AccAddress) (sdk.Coins, error) {
+	depositParams := keeper.GetDepositParams(ctx)
+	fee := depositParams.SubmissionFee
+	if fee.IsZero() {
+		return fee, nil
+	}
+
+	if depositParams.BurnSubmissionFee {
+		if err := keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, proposerAddr, types.ModuleName, fee); err != nil {
+			return nil, err
+		}
+		if err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, fee); err != nil {
+			return nil, err
+		}
+	} else if err := keeper.distrKeeper.FundCommunityPool(ctx, fee, proposerAddr); err != nil {
+		return nil, err
+	}
+
+	return fee, nil
+}
+
+// SettleDeposits deletes all the deposits on a specific proposal, burning them
+// if the deposit params burn the deposits for the given reason and refunding
+// them otherwise. It returns the reason if the deposits are burned, or
+// BurnReasonNone if they are refunded.
+func (keeper Keeper) SettleDeposits(ctx sdk.Context, proposalID uint64, reason types.DepositBurnReason) types.DepositBurnReason {
+	reason = keeper.depositBurnReason(ctx, reason)
+	if reason == types.BurnReasonNone {
+		keeper.RefundAndDeleteDeposits(ctx, proposalID)
+	} else {
+		keeper.DeleteAndBurnDeposits(ctx, proposalID)
+	}
+	return reason
+}
+
 // RefundAndDeleteDeposits refunds and deletes all the deposits on a specific proposal.
 func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -176,12 +228,64 @@ func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64)
 			panic(err)
 		}
 
-		err = keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, deposit.Amount)
+		err = keeper.refundDeposit(ctx, depositor, deposit.Amount)
+		if err != nil {
+			panic(err)
+		}
+
+		store.Delete(types.DepositKey(proposalID, depositor))
+		return false
+	})
+}
+
+// BurnAndRefundDeposits burns the given fraction of each deposit on a specific
+// proposal, refunds the rest to its depositor and deletes it. It returns the
+// total burned and refunded amounts.
+func (keeper Keeper) BurnAndRefundDeposits(ctx sdk.Context, proposalID uint64, burnRatio sdk.Dec) (burned, refunded sdk.Coins) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
+		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
 		if err != nil {
 			panic(err)
 		}
 
+		var burn sdk.Coins
+		for _, coin := range deposit.Amount {
+			burn = burn.Add(sdk.NewCoin(coin.Denom, burnRatio.MulInt(coin.Amount).TruncateInt()))
+		}
+		refund := deposit.Amount.Sub(burn)
+
+		if !burn.IsZero() {
+			if err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, burn); err != nil {
+				panic(err)
+			}
+		}
+		if !refund.IsZero() {
+			if err := keeper.refundDeposit(ctx, depositor, refund); err != nil {
+				panic(err)
+			}
+		}
+
+		burned = burned.Add(burn...)
+		refunded = refunded.Add(refund...)
+
 		store.Delete(types.DepositKey(proposalID, depositor))
 		return false
 	})
+
+	return burned, refunded
+}
+
+// refundDeposit sends back a deposit to its depositor. The deposits of module
+// accounts, which may be blocked from receiving funds from accounts, are sent
+// back from module to module.
+func (keeper Keeper) refundDeposit(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coins) error {
+	if moduleAcc, ok := keeper.authKeeper.GetAccount(ctx, depositor).(authtypes.ModuleAccountI); ok {
+		return keeper.bankKeeper.SendCoinsFromModuleToModuleWithPurpose(
+			ctx, types.ModuleName, moduleAcc.GetName(), amount, banktypes.ModuleTransferPurposeDepositRefund,
+		)
+	}
+
+	return keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, amount)
 }
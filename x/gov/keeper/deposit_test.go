@@ -9,6 +9,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestDeposits(t *testing.T) {
@@ -112,3 +115,92 @@ func TestDeposits(t *testing.T) {
 	require.Len(t, deposits, 0)
 	require.Equal(t, addr0Initial.Sub(fourStake), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 }
+
+func TestSubmissionFee(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.GovKeeper)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000000))
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000))
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	depositParams.SubmissionFee = fee
+	app.GovKeeper.SetDepositParams(ctx, depositParams)
+
+	initialBalance := app.BankKeeper.GetAllBalances(ctx, addrs[0])
+	initialPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	msg, err := types.NewMsgSubmitProposal(TestProposal, deposit, addrs[0])
+	require.NoError(t, err)
+	res, err := msgServer.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	// the fee is credited to the community pool, and is not part of the deposit
+	require.Equal(t, initialBalance.Sub(fee).Sub(deposit), app.BankKeeper.GetAllBalances(ctx, addrs[0]))
+	require.Equal(t, initialPool.Add(sdk.NewDecCoinsFromCoins(fee...)...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+	proposal, ok := app.GovKeeper.GetProposal(ctx, res.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, deposit, proposal.TotalDeposit)
+
+	// the fee is burned if the deposit params burn it
+	depositParams.BurnSubmissionFee = true
+	app.GovKeeper.SetDepositParams(ctx, depositParams)
+	initialBalance = app.BankKeeper.GetAllBalances(ctx, addrs[0])
+	initialSupply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+	res, err = msgServer.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, initialBalance.Sub(fee).Sub(deposit), app.BankKeeper.GetAllBalances(ctx, addrs[0]))
+	require.Equal(t, initialPool.Add(sdk.NewDecCoinsFromCoins(fee...)...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+	require.Equal(t, initialSupply.Sub(fee[0]), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+	proposal, ok = app.GovKeeper.GetProposal(ctx, res.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, deposit, proposal.TotalDeposit)
+
+	// the submission fails if the proposer cannot pay the fee
+	depositParams.SubmissionFee = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000000))
+	app.GovKeeper.SetDepositParams(ctx, depositParams)
+	_, err = msgServer.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+}
+
+func TestMinInitialDeposit(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.GovKeeper)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000000))
+
+	// a quarter of the minimum deposit must be deposited on submission
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	depositParams.MinInitialDepositRatio = sdk.NewDecWithPrec(25, 2)
+	app.GovKeeper.SetDepositParams(ctx, depositParams)
+	minInitialDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, types.DefaultMinDepositTokens.QuoRaw(4)))
+	require.Equal(t, minInitialDeposit, depositParams.MinInitialDeposit())
+
+	initialBalance := app.BankKeeper.GetAllBalances(ctx, addrs[0])
+
+	// a dust deposit is rejected
+	msg, err := types.NewMsgSubmitProposal(TestProposal, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)), addrs[0])
+	require.NoError(t, err)
+	_, err = msgServer.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrMinInitialDeposit)
+	require.Equal(t, initialBalance, app.BankKeeper.GetAllBalances(ctx, addrs[0]))
+
+	// a deposit in another denom does not count
+	msg, err = types.NewMsgSubmitProposal(TestProposal, sdk.NewCoins(sdk.NewCoin("atom", minInitialDeposit.AmountOf(sdk.DefaultBondDenom))), addrs[0])
+	require.NoError(t, err)
+	_, err = msgServer.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrMinInitialDeposit)
+
+	// the minimum initial deposit is enough to submit the proposal
+	msg, err = types.NewMsgSubmitProposal(TestProposal, minInitialDeposit, addrs[0])
+	require.NoError(t, err)
+	res, err := msgServer.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	proposal, ok := app.GovKeeper.GetProposal(ctx, res.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusDepositPeriod, proposal.Status)
+	require.Equal(t, minInitialDeposit, proposal.TotalDeposit)
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// AnchorDiscussion anchors the content hash of an off-chain discussion of a
+// proposal in its deposit or voting period. Only the proposer of the proposal
+// and the accounts that voted on it can anchor a discussion. Anchoring the
+// same hash twice overwrites the previous anchor.
+func (keeper Keeper) AnchorDiscussion(ctx sdk.Context, proposalID uint64, author sdk.AccAddress, hash []byte, uri string) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	if proposal.Status != types.StatusDepositPeriod && proposal.Status != types.StatusVotingPeriod {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	if proposal.Proposer != author.String() {
+		_, voted := keeper.GetVote(ctx, proposalID, author)
+		if proposal.IsMultipleChoice() {
+			_, voted = keeper.GetChoiceVote(ctx, proposalID, author)
+		}
+		if !voted {
+			return sdkerrors.Wrapf(types.ErrUnauthorizedAnchor, "%s", author)
+		}
+	}
+
+	if err := types.ValidateDiscussionAnchor(hash, uri); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	keeper.SetDiscussionAnchor(ctx, types.NewDiscussionAnchor(proposalID, author, hash, uri, ctx.BlockHeight()))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDiscussionAnchor,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyAuthor, author.String()),
+			sdk.NewAttribute(types.AttributeKeyHash, hex.EncodeToString(hash)),
+		),
+	)
+
+	return nil
+}
+
+// GetDiscussionAnchor gets the discussion anchor of a hash by an author on a
+// specific proposal
+func (keeper Keeper) GetDiscussionAnchor(ctx sdk.Context, proposalID uint64, authorAddr sdk.AccAddress, hash []byte) (anchor types.DiscussionAnchor, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.DiscussionAnchorKey(proposalID, authorAddr, hash))
+	if bz == nil {
+		return anchor, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &anchor)
+	return anchor, true
+}
+
+// SetDiscussionAnchor sets a discussion anchor in the store
+func (keeper Keeper) SetDiscussionAnchor(ctx sdk.Context, anchor types.DiscussionAnchor) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&anchor)
+	addr, err := sdk.AccAddressFromBech32(anchor.Author)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.DiscussionAnchorKey(anchor.ProposalId, addr, anchor.Hash), bz)
+}
+
+// IterateDiscussionAnchors iterates over all the discussion anchors of a
+// proposal and performs a callback function
+func (keeper Keeper) IterateDiscussionAnchors(ctx sdk.Context, proposalID uint64, cb func(anchor types.DiscussionAnchor) (stop bool)) {
+	keeper.iterateDiscussionAnchors(ctx, types.DiscussionAnchorsKey(proposalID), cb)
+}
+
+// IterateAllDiscussionAnchors iterates over all the stored discussion anchors
+// and performs a callback function
+func (keeper Keeper) IterateAllDiscussionAnchors(ctx sdk.Context, cb func(anchor types.DiscussionAnchor) (stop bool)) {
+	keeper.iterateDiscussionAnchors(ctx, types.DiscussionAnchorsKeyPrefix, cb)
+}
+
+func (keeper Keeper) iterateDiscussionAnchors(ctx sdk.Context, prefix []byte, cb func(anchor types.DiscussionAnchor) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var anchor types.DiscussionAnchor
+		keeper.cdc.MustUnmarshal(iterator.Value(), &anchor)
+
+		if cb(anchor) {
+			break
+		}
+	}
+}
+
+// GetDiscussionAnchors returns all the discussion anchors of a proposal
+func (keeper Keeper) GetDiscussionAnchors(ctx sdk.Context, proposalID uint64) (anchors []types.DiscussionAnchor) {
+	keeper.IterateDiscussionAnchors(ctx, proposalID, func(anchor types.DiscussionAnchor) bool {
+		anchors = append(anchors, anchor)
+		return false
+	})
+	return
+}
+
+// GetAllDiscussionAnchors returns all the discussion anchors from the store
+func (keeper Keeper) GetAllDiscussionAnchors(ctx sdk.Context) (anchors []types.DiscussionAnchor) {
+	keeper.IterateAllDiscussionAnchors(ctx, func(anchor types.DiscussionAnchor) bool {
+		anchors = append(anchors, anchor)
+		return false
+	})
+	return
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestDiscussionAnchors(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+	proposer, voter, other := addrs[0], addrs[1], addrs[2]
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposal.Proposer = proposer.String()
+	app.GovKeeper.SetProposal(ctx, proposal)
+	proposalID := proposal.ProposalId
+
+	snapshot := sha256.Sum256([]byte("forum thread snapshot"))
+	hash := snapshot[:]
+
+	// only the proposer and the voters can anchor a discussion
+	err = app.GovKeeper.AnchorDiscussion(ctx, proposalID, voter, hash, "")
+	require.ErrorIs(t, err, types.ErrUnauthorizedAnchor)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, app.GovKeeper.AnchorDiscussion(ctx, proposalID, proposer, hash, "https://forum.example.com/t/1"))
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeDiscussionAnchor,
+		sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		sdk.NewAttribute(types.AttributeKeyAuthor, proposer.String()),
+		sdk.NewAttribute(types.AttributeKeyHash, hex.EncodeToString(hash)),
+	))
+
+	anchor, found := app.GovKeeper.GetDiscussionAnchor(ctx, proposalID, proposer, hash)
+	require.True(t, found)
+	require.Equal(t, types.NewDiscussionAnchor(proposalID, proposer, hash, "https://forum.example.com/t/1", 10), anchor)
+
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, voter, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AnchorDiscussion(ctx, proposalID, voter, hash, ""))
+	require.ErrorIs(t, app.GovKeeper.AnchorDiscussion(ctx, proposalID, other, hash, ""), types.ErrUnauthorizedAnchor)
+
+	// the hash must be a SHA-256 hash
+	err = app.GovKeeper.AnchorDiscussion(ctx, proposalID, voter, hash[:16], "")
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	anchors := app.GovKeeper.GetDiscussionAnchors(ctx, proposalID)
+	require.Len(t, anchors, 2)
+	require.ElementsMatch(t, []string{proposer.String(), voter.String()}, []string{anchors[0].Author, anchors[1].Author})
+	require.Equal(t, anchors, app.GovKeeper.GetAllDiscussionAnchors(ctx))
+
+	// anchors are kept once the proposal is finalized, but no new one can be
+	// attached
+	proposal.Status = types.StatusPassed
+	app.GovKeeper.SetProposal(ctx, proposal)
+	require.ErrorIs(t, app.GovKeeper.AnchorDiscussion(ctx, proposalID, proposer, hash, ""), types.ErrInactiveProposal)
+	require.Len(t, app.GovKeeper.GetDiscussionAnchors(ctx, proposalID), 2)
+
+	require.ErrorIs(t, app.GovKeeper.AnchorDiscussion(ctx, proposalID+1, proposer, hash, ""), types.ErrUnknownProposal)
+}
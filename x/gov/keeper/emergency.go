@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// SubmitEmergencyProposal creates a new proposal given a content on the
+// emergency track. Once in its voting period, it is tallied at the end of the
+// first block in which the validators voting Yes with their operator accounts
+// hold the emergency threshold of the bonded validator power, and its content
+// is then executed without execution delay. Otherwise it is tallied as a
+// regular proposal at the end of its voting period. It fails if emergency
+// proposals are disabled.
+func (keeper Keeper) SubmitEmergencyProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
+	if !keeper.GetTallyParams(ctx).EmergencyTrack() {
+		return types.Proposal{}, types.ErrEmergencyDisabled
+	}
+
+	proposal, err := keeper.submitProposal(ctx, content, nil, false, false, false)
+	if err != nil {
+		return types.Proposal{}, err
+	}
+
+	proposal.IsEmergency = true
+	keeper.SetProposal(ctx, proposal)
+
+	return proposal, nil
+}
+
+// EmergencySupport returns the fraction of the bonded validator power a
+// proposal is tallied with which voted Yes, the vote of each validator being
+// cast by its operator account. The governance delegations and the votes of
+// the delegators are not taken into account.
+func (keeper Keeper) EmergencySupport(ctx sdk.Context, proposal types.Proposal) sdk.Dec {
+	validators, totalBonded, _ := keeper.tallyValidators(ctx, proposal.ProposalId)
+	if !totalBonded.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	support := sdk.ZeroDec()
+	for _, val := range validators {
+		vote, found := keeper.GetVote(ctx, proposal.ProposalId, sdk.AccAddress(val.Address))
+		if !found {
+			continue
+		}
+
+		for _, option := range vote.Options {
+			if option.Option == types.OptionYes {
+				support = support.Add(option.Weight.MulInt(val.BondedTokens))
+			}
+		}
+	}
+
+	return support.QuoInt(totalBonded)
+}
+
+// EmergencySupermajorityReached returns whether the validators voting Yes on
+// an emergency proposal hold the emergency threshold of the bonded validator
+// power, along with their fraction of it. It is never reached while the
+// emergency track is disabled.
+func (keeper Keeper) EmergencySupermajorityReached(ctx sdk.Context, proposal types.Proposal) (reached bool, support sdk.Dec) {
+	tallyParams := keeper.GetTallyParams(ctx)
+	if !tallyParams.EmergencyTrack() {
+		return false, sdk.ZeroDec()
+	}
+
+	support = keeper.EmergencySupport(ctx, proposal)
+	return support.GTE(tallyParams.EmergencyThreshold), support
+}
+
+// InsertEmergencyProposalQueue inserts a ProposalID into the emergency
+// proposal queue, where its validator support is checked at the end of every
+// block of its voting period
+func (keeper Keeper) InsertEmergencyProposalQueue(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := types.GetProposalIDBytes(proposalID)
+	store.Set(types.EmergencyProposalQueueKey(proposalID), bz)
+}
+
+// RemoveFromEmergencyProposalQueue removes a proposalID from the Emergency
+// Proposal Queue
+func (keeper Keeper) RemoveFromEmergencyProposalQueue(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.EmergencyProposalQueueKey(proposalID))
+}
+
+// IterateEmergencyProposalQueue iterates over the proposals in the emergency
+// proposal queue by ascending proposal ID and performs a callback function
+func (keeper Keeper) IterateEmergencyProposalQueue(ctx sdk.Context, cb func(proposal types.Proposal) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.EmergencyProposalQueuePrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID := types.SplitProposalKey(iterator.Key())
+		proposal, found := keeper.GetProposal(ctx, proposalID)
+		if !found {
+			panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+		}
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// RunProposalExecution executes the content of a passed proposal in a cached
+// context, writing its state changes and events only if it succeeds, and sets
+// the proposal status to passed or, if the execution fails, to failed. Every
+// run increments the execution attempts of the proposal, and the first failed
+// run opens the execution retry window if the ExecutionRetryWindow voting param
+// is set. It returns the execution error.
+func (keeper Keeper) RunProposalExecution(ctx sdk.Context, proposal *types.Proposal) error {
+	cacheCtx, writeCache := ctx.WithGasMeter(keeper.ExecutionGasMeter(ctx)).CacheContext()
+
+	// The proposal handler may execute state mutating logic depending
+	// on the proposal content. If the handler fails, including by
+	// running out of gas, no state mutation is written and the error
+	// message is logged.
+	spanCtx, span := telemetry.StartSpan(
+		cacheCtx.Context(), telemetry.SpanNameProposalHandler,
+		attribute.Int64("proposal.id", int64(proposal.ProposalId)),
+		attribute.String("proposal.route", proposal.ProposalRoute()),
+	)
+	err := keeper.ExecuteProposal(cacheCtx.WithContext(spanCtx), *proposal)
+	telemetry.EndSpan(span, err)
+
+	proposal.ExecutionAttempts++
+	if err == nil {
+		proposal.Status = types.StatusPassed
+
+		// The cached context is created with a new EventManager. However, since
+		// the proposal handler execution was successful, we want to track/keep
+		// any events emitted, so we re-emit to "merge" the events into the
+		// original Context's EventManager.
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		// write state to the underlying multi-store
+		writeCache()
+	} else {
+		proposal.Status = types.StatusFailed
+
+		retryWindow := keeper.GetVotingParams(ctx).ExecutionRetryWindow
+		if retryWindow > 0 && proposal.RetryEndTime.IsZero() {
+			proposal.RetryEndTime = ctx.BlockHeader().Time.Add(retryWindow)
+		}
+
+		if errors.Is(err, sdkerrors.ErrOutOfGas) {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeExecutionOutOfGas,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+					sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", cacheCtx.GasMeter().GasConsumed())),
+					sdk.NewAttribute(types.AttributeKeyGasLimit, fmt.Sprintf("%d", cacheCtx.GasMeter().Limit())),
+				),
+			)
+		}
+	}
+
+	// called after the execution, successful or not, of the proposal content
+	var errMsg string
+	if err != nil {
+		errMsg = err.Error()
+	}
+	keeper.AfterProposalExecuted(ctx, proposal.ProposalId, err == nil, errMsg)
+
+	return err
+}
+
+// RetryProposalExecution executes again the content of a passed proposal which
+// failed on execution, as long as its execution retry window is open. The
+// proposal and its execution attempts are stored whether the execution
+// succeeds or fails again, the returned proposal carrying the outcome; an
+// error is only returned if the execution cannot be retried.
+func (keeper Keeper) RetryProposalExecution(ctx sdk.Context, proposalID uint64) (types.Proposal, error) {
+	proposal, found := keeper.GetProposal(ctx, proposalID)
+	if !found {
+		return types.Proposal{}, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	if !proposal.ExecutionRetryable(ctx.BlockHeader().Time) {
+		if proposal.Status != types.StatusFailed {
+			return types.Proposal{}, sdkerrors.Wrapf(types.ErrExecutionNotRetryable, "proposal %d is %s", proposalID, proposal.Status)
+		}
+		return types.Proposal{}, sdkerrors.Wrapf(types.ErrExecutionNotRetryable, "retry window of proposal %d is closed", proposalID)
+	}
+
+	execErr := keeper.RunProposalExecution(ctx, &proposal)
+	keeper.SetProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRetryExecution,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyExecutionAttempts, fmt.Sprintf("%d", proposal.ExecutionAttempts)),
+			sdk.NewAttribute(types.AttributeKeyRetryEndTime, proposal.RetryEndTime.Format(time.RFC3339Nano)),
+		),
+	)
+
+	// emit the outcome of the retried execution as the EndBlocker does
+	var (
+		event  proto.Message
+		logMsg string
+	)
+	if execErr == nil {
+		event = &types.EventProposalPassed{
+			ProposalId:       proposal.ProposalId,
+			Result:           types.AttributeValueProposalPassed,
+			FinalTallyResult: proposal.FinalTallyResult,
+			WinningChoice:    proposal.WinningChoice,
+		}
+		logMsg = "passed"
+	} else {
+		event = &types.EventProposalFailed{
+			ProposalId:       proposal.ProposalId,
+			Result:           types.AttributeValueProposalFailed,
+			FinalTallyResult: proposal.FinalTallyResult,
+			ExecutionError:   execErr.Error(),
+		}
+		logMsg = fmt.Sprintf("failed on execution: %s", execErr)
+	}
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		return types.Proposal{}, err
+	}
+
+	keeper.Logger(ctx).Info(
+		"proposal execution retried",
+		"proposal", proposal.ProposalId,
+		"attempts", proposal.ExecutionAttempts,
+		"result", logMsg,
+	)
+
+	return proposal, nil
+}
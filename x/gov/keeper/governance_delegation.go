@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// SetGovernor delegates the governance voting power of an account to a
+// governor, replacing its current governor if any. The stake of the account is
+// left untouched: in Tally, the votes of the governor count for the account
+// instead of the votes of its validators, unless the account votes itself.
+func (keeper Keeper) SetGovernor(ctx sdk.Context, delegatorAddr, governorAddr sdk.AccAddress) error {
+	delegation := types.NewGovernanceDelegation(delegatorAddr, governorAddr)
+	if err := delegation.Validate(); err != nil {
+		return err
+	}
+
+	if current, found := keeper.GetGovernanceDelegation(ctx, delegatorAddr); found {
+		keeper.deleteGovernanceDelegation(ctx, current)
+	}
+	keeper.SetGovernanceDelegation(ctx, delegation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetGovernor,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegation.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyGovernor, delegation.GovernorAddress),
+		),
+	)
+
+	return nil
+}
+
+// RemoveGovernor takes back the governance voting power an account delegated
+// to its governor, so that it inherits the votes of its validators again.
+func (keeper Keeper) RemoveGovernor(ctx sdk.Context, delegatorAddr sdk.AccAddress) error {
+	delegation, found := keeper.GetGovernanceDelegation(ctx, delegatorAddr)
+	if !found {
+		return sdkerrors.Wrap(types.ErrNoGovernor, delegatorAddr.String())
+	}
+	keeper.deleteGovernanceDelegation(ctx, delegation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveGovernor,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegation.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyGovernor, delegation.GovernorAddress),
+		),
+	)
+
+	return nil
+}
+
+// GetGovernanceDelegation gets the governance delegation of an account
+func (keeper Keeper) GetGovernanceDelegation(ctx sdk.Context, delegatorAddr sdk.AccAddress) (delegation types.GovernanceDelegation, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GovernanceDelegationKey(delegatorAddr))
+	if bz == nil {
+		return delegation, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &delegation)
+	return delegation, true
+}
+
+// SetGovernanceDelegation sets a GovernanceDelegation to the gov store, along
+// with its entry in the index by governor
+func (keeper Keeper) SetGovernanceDelegation(ctx sdk.Context, delegation types.GovernanceDelegation) {
+	delegatorAddr, governorAddr := mustGovernanceDelegationAddresses(delegation)
+
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&delegation)
+	store.Set(types.GovernanceDelegationKey(delegatorAddr), bz)
+	store.Set(types.GovernanceDelegationByGovernorKey(governorAddr, delegatorAddr), []byte{})
+}
+
+// GetAllGovernanceDelegations returns all the governance delegations from the
+// store
+func (keeper Keeper) GetAllGovernanceDelegations(ctx sdk.Context) (delegations []types.GovernanceDelegation) {
+	keeper.IterateAllGovernanceDelegations(ctx, func(delegation types.GovernanceDelegation) bool {
+		delegations = append(delegations, delegation)
+		return false
+	})
+	return
+}
+
+// IterateAllGovernanceDelegations iterates over the all the stored governance
+// delegations and performs a callback function
+func (keeper Keeper) IterateAllGovernanceDelegations(ctx sdk.Context, cb func(delegation types.GovernanceDelegation) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GovernanceDelegationsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.GovernanceDelegation
+		keeper.cdc.MustUnmarshal(iterator.Value(), &delegation)
+
+		if cb(delegation) {
+			break
+		}
+	}
+}
+
+// IterateGovernanceDelegationsByGovernor iterates over the accounts which
+// delegated their governance voting power to a governor and performs a
+// callback function
+func (keeper Keeper) IterateGovernanceDelegationsByGovernor(ctx sdk.Context, governorAddr sdk.AccAddress, cb func(delegatorAddr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	prefix := types.GovernanceDelegationsByGovernorKey(governorAddr)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// skip the length prefix of the delegator address
+		if cb(sdk.AccAddress(iterator.Key()[len(prefix)+1:])) {
+			break
+		}
+	}
+}
+
+func (keeper Keeper) deleteGovernanceDelegation(ctx sdk.Context, delegation types.GovernanceDelegation) {
+	delegatorAddr, governorAddr := mustGovernanceDelegationAddresses(delegation)
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GovernanceDelegationKey(delegatorAddr))
+	store.Delete(types.GovernanceDelegationByGovernorKey(governorAddr, delegatorAddr))
+}
+
+func mustGovernanceDelegationAddresses(delegation types.GovernanceDelegation) (delegatorAddr, governorAddr sdk.AccAddress) {
+	delegatorAddr, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	governorAddr, err = sdk.AccAddressFromBech32(delegation.GovernorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return delegatorAddr, governorAddr
+}
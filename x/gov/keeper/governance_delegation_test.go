@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestGovernanceDelegations(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+	delegator, governor1, governor2 := addrs[0], addrs[1], addrs[2]
+
+	governed := func(governor sdk.AccAddress) (delegators []sdk.AccAddress) {
+		app.GovKeeper.IterateGovernanceDelegationsByGovernor(ctx, governor, func(delegator sdk.AccAddress) bool {
+			delegators = append(delegators, delegator)
+			return false
+		})
+		return delegators
+	}
+
+	require.ErrorIs(t, app.GovKeeper.SetGovernor(ctx, delegator, delegator), types.ErrInvalidGovernor)
+	require.ErrorIs(t, app.GovKeeper.RemoveGovernor(ctx, delegator), types.ErrNoGovernor)
+
+	require.NoError(t, app.GovKeeper.SetGovernor(ctx, delegator, governor1))
+	delegation, found := app.GovKeeper.GetGovernanceDelegation(ctx, delegator)
+	require.True(t, found)
+	require.Equal(t, types.NewGovernanceDelegation(delegator, governor1), delegation)
+	require.Equal(t, []sdk.AccAddress{delegator}, governed(governor1))
+
+	// setting another governor replaces the current one
+	require.NoError(t, app.GovKeeper.SetGovernor(ctx, delegator, governor2))
+	require.Empty(t, governed(governor1))
+	require.Equal(t, []sdk.AccAddress{delegator}, governed(governor2))
+	require.Equal(t, []types.GovernanceDelegation{types.NewGovernanceDelegation(delegator, governor2)}, app.GovKeeper.GetAllGovernanceDelegations(ctx))
+
+	require.NoError(t, app.GovKeeper.RemoveGovernor(ctx, delegator))
+	_, found = app.GovKeeper.GetGovernanceDelegation(ctx, delegator)
+	require.False(t, found)
+	require.Empty(t, governed(governor2))
+	require.Empty(t, app.GovKeeper.GetAllGovernanceDelegations(ctx))
+}
@@ -6,8 +6,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -155,6 +157,188 @@ func (q Keeper) Votes(c context.Context, req *types.QueryVotesRequest) (*types.Q
 	return &types.QueryVotesResponse{Votes: votes, Pagination: pageRes}, nil
 }
 
+// VoteReceipt returns the vote receipt of a voter on a proposal
+func (q Keeper) VoteReceipt(c context.Context, req *types.QueryVoteReceiptRequest) (*types.QueryVoteReceiptResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	receipt, found := q.GetVoteReceipt(ctx, req.ProposalId, voter)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "vote receipt of %s for proposal %d doesn't exist", req.Voter, req.ProposalId)
+	}
+
+	return &types.QueryVoteReceiptResponse{Receipt: receipt}, nil
+}
+
+// DiscussionAnchors returns all the discussion anchors of a proposal
+func (q Keeper) DiscussionAnchors(c context.Context, req *types.QueryDiscussionAnchorsRequest) (*types.QueryDiscussionAnchorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	var anchors []types.DiscussionAnchor
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	anchorsStore := prefix.NewStore(store, types.DiscussionAnchorsKey(req.ProposalId))
+
+	pageRes, err := query.Paginate(anchorsStore, req.Pagination, func(key []byte, value []byte) error {
+		var anchor types.DiscussionAnchor
+		if err := q.cdc.Unmarshal(value, &anchor); err != nil {
+			return err
+		}
+
+		anchors = append(anchors, anchor)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDiscussionAnchorsResponse{Anchors: anchors, Pagination: pageRes}, nil
+}
+
+// ChoiceVotes returns the choice votes of a multiple-choice proposal
+func (q Keeper) ChoiceVotes(c context.Context, req *types.QueryChoiceVotesRequest) (*types.QueryChoiceVotesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	var votes []types.ChoiceVote
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	votesStore := prefix.NewStore(store, types.ChoiceVotesKey(req.ProposalId))
+
+	pageRes, err := query.Paginate(votesStore, req.Pagination, func(key []byte, value []byte) error {
+		var vote types.ChoiceVote
+		if err := q.cdc.Unmarshal(value, &vote); err != nil {
+			return err
+		}
+
+		votes = append(votes, vote)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryChoiceVotesResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
+// ChoiceTally returns the voting power of each choice of a multiple-choice
+// proposal
+func (q Keeper) ChoiceTally(c context.Context, req *types.QueryChoiceTallyRequest) (*types.QueryChoiceTallyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, ok := q.GetProposal(ctx, req.ProposalId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+
+	if !proposal.IsMultipleChoice() {
+		return nil, status.Errorf(codes.InvalidArgument, "proposal %d is not a multiple-choice proposal", req.ProposalId)
+	}
+
+	var results []sdk.Int
+
+	switch proposal.Status {
+	case types.StatusDepositPeriod:
+		results = make([]sdk.Int, len(proposal.Choices))
+		for i := range results {
+			results[i] = sdk.ZeroInt()
+		}
+
+	case types.StatusVotingPeriod:
+		_, _, results, _ = q.TallyChoices(ctx, proposal)
+
+	default:
+		results = proposal.ChoiceTallyResults
+	}
+
+	return &types.QueryChoiceTallyResponse{Results: results}, nil
+}
+
+// PendingExecutions returns the passed proposals scheduled for execution
+func (q Keeper) PendingExecutions(c context.Context, req *types.QueryPendingExecutionsRequest) (*types.QueryPendingExecutionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var proposals types.Proposals
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	queueStore := prefix.NewStore(store, types.ExecutionQueuePrefix)
+
+	pageRes, err := query.Paginate(queueStore, req.Pagination, func(key []byte, value []byte) error {
+		proposalID := types.GetProposalIDFromBytes(value)
+		proposal, found := q.GetProposal(ctx, proposalID)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+		}
+
+		proposals = append(proposals, proposal)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingExecutionsResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+// ArchivedProposal returns a proposal moved to the archive store
+func (q Keeper) ArchivedProposal(c context.Context, req *types.QueryArchivedProposalRequest) (*types.QueryArchivedProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, found := q.GetArchivedProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "archived proposal %d doesn't exist", req.ProposalId)
+	}
+
+	return &types.QueryArchivedProposalResponse{Proposal: proposal}, nil
+}
+
 // Params queries all params
 func (q Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
@@ -258,7 +442,11 @@ func (q Keeper) TallyResult(c context.Context, req *types.QueryTallyResultReques
 
 	proposal, ok := q.GetProposal(ctx, req.ProposalId)
 	if !ok {
-		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+		// the final tally of an archived proposal is kept in the archive store
+		proposal, ok = q.GetArchivedProposal(ctx, req.ProposalId)
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+		}
 	}
 
 	var tallyResult types.TallyResult
@@ -267,7 +455,8 @@ func (q Keeper) TallyResult(c context.Context, req *types.QueryTallyResultReques
 	case proposal.Status == types.StatusDepositPeriod:
 		tallyResult = types.EmptyTallyResult()
 
-	case proposal.Status == types.StatusPassed || proposal.Status == types.StatusRejected:
+	case proposal.Status == types.StatusPassed || proposal.Status == types.StatusRejected ||
+		proposal.Status == types.StatusFailed:
 		tallyResult = proposal.FinalTallyResult
 
 	default:
@@ -277,3 +466,261 @@ func (q Keeper) TallyResult(c context.Context, req *types.QueryTallyResultReques
 
 	return &types.QueryTallyResultResponse{Tally: tallyResult}, nil
 }
+
+// SimulateProposal simulates the execution of a proposal in its voting period,
+// or of the content of a proposal which isn't submitted yet
+func (q Keeper) SimulateProposal(c context.Context, req *types.QuerySimulateProposalRequest) (*types.QuerySimulateProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Content != nil {
+		if req.ProposalId != 0 {
+			return nil, status.Error(codes.InvalidArgument, "proposal id and content can not be both set")
+		}
+
+		return q.simulateContent(sdk.UnwrapSDKContext(c), req.Content)
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, ok := q.GetProposal(ctx, req.ProposalId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+
+	if proposal.Status != types.StatusVotingPeriod {
+		return nil, status.Errorf(codes.InvalidArgument, "proposal %d is not in voting period", req.ProposalId)
+	}
+
+	events, gasUsed, err := q.SimulateProposalExecution(ctx, proposal)
+
+	res := &types.QuerySimulateProposalResponse{Events: events.ToABCIEvents(), GasUsed: gasUsed}
+	if err != nil {
+		res.Error = err.Error()
+	}
+
+	return res, nil
+}
+
+// simulateContent simulates the execution of the content of a proposal which
+// isn't submitted yet, checking that it would be accepted on submission
+func (q Keeper) simulateContent(ctx sdk.Context, any *codectypes.Any) (*types.QuerySimulateProposalResponse, error) {
+	content, ok := any.GetCachedValue().(types.Content)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is not a proposal content", any.TypeUrl)
+	}
+
+	if err := content.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if !q.router.HasRoute(content.ProposalRoute()) {
+		return nil, status.Errorf(codes.InvalidArgument, "no handler exists for proposal route %s", content.ProposalRoute())
+	}
+
+	events, gasUsed, err := q.SimulateContentExecution(ctx, content)
+
+	res := &types.QuerySimulateProposalResponse{Events: events.ToABCIEvents(), GasUsed: gasUsed}
+	if err != nil {
+		res.Error = err.Error()
+	}
+
+	return res, nil
+}
+
+// ProposalTemplate queries a single proposal template by name
+func (q Keeper) ProposalTemplate(c context.Context, req *types.QueryProposalTemplateRequest) (*types.QueryProposalTemplateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty template name")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	template, found := q.GetProposalTemplate(ctx, req.Name)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "proposal template %s doesn't exist", req.Name)
+	}
+
+	return &types.QueryProposalTemplateResponse{Template: template}, nil
+}
+
+// ProposalTemplates queries all registered proposal templates
+func (q Keeper) ProposalTemplates(c context.Context, req *types.QueryProposalTemplatesRequest) (*types.QueryProposalTemplatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryProposalTemplatesResponse{Templates: q.GetProposalTemplates(ctx)}, nil
+}
+
+// Governor queries the governor an account delegated its governance voting
+// power to
+func (q Keeper) Governor(c context.Context, req *types.QueryGovernorRequest) (*types.QueryGovernorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delegator, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegation, found := q.GetGovernanceDelegation(ctx, delegator)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no governor for %s", req.DelegatorAddress)
+	}
+
+	return &types.QueryGovernorResponse{GovernorAddress: delegation.GovernorAddress}, nil
+}
+
+// GovernanceDelegations queries the governance delegations to a governor
+func (q Keeper) GovernanceDelegations(c context.Context, req *types.QueryGovernanceDelegationsRequest) (*types.QueryGovernanceDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GovernorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty governor address")
+	}
+
+	governor, err := sdk.AccAddressFromBech32(req.GovernorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	var delegations []types.GovernanceDelegation
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	delegationsStore := prefix.NewStore(store, types.GovernanceDelegationsByGovernorKey(governor))
+
+	pageRes, err := query.Paginate(delegationsStore, req.Pagination, func(key []byte, value []byte) error {
+		// skip the length prefix of the delegator address
+		delegations = append(delegations, types.NewGovernanceDelegation(sdk.AccAddress(key[1:]), governor))
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGovernanceDelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
+
+// LiveTally returns the current tally of a proposal in its voting period. If
+// pagination is set, only the voting power contributed by the requested page of
+// its votes is tallied, so that the tally of a proposal with many votes can be
+// computed across several queries by adding up the tallies of its pages.
+func (q Keeper) LiveTally(c context.Context, req *types.QueryLiveTallyRequest) (*types.QueryLiveTallyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, ok := q.GetProposal(ctx, req.ProposalId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+
+	if proposal.Status != types.StatusVotingPeriod {
+		return nil, status.Errorf(codes.InvalidArgument, "proposal %d is not in voting period", req.ProposalId)
+	}
+
+	if proposal.IsMultipleChoice() {
+		return nil, status.Errorf(codes.InvalidArgument, "proposal %d is a multiple-choice proposal, query its choice tally instead", req.ProposalId)
+	}
+
+	if req.Pagination == nil {
+		results, _, _ := q.tallyVotes(ctx, proposal, false)
+		return &types.QueryLiveTallyResponse{Tally: types.NewTallyResultFromMap(results)}, nil
+	}
+
+	var votes types.Votes
+	store := ctx.KVStore(q.storeKey)
+	votesStore := prefix.NewStore(store, types.VotesKey(req.ProposalId))
+
+	pageRes, err := query.Paginate(votesStore, req.Pagination, func(key []byte, value []byte) error {
+		var vote types.Vote
+		if err := q.cdc.Unmarshal(value, &vote); err != nil {
+			return err
+		}
+
+		votes = append(votes, vote)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	results := q.tallyVotesPage(ctx, proposal, votes)
+	return &types.QueryLiveTallyResponse{Tally: types.NewTallyResultFromMap(results), Pagination: pageRes}, nil
+}
+
+// ValidatorParticipation queries the participation of the bonded validators in
+// the vote on a finalized proposal
+func (q Keeper) ValidatorParticipation(c context.Context, req *types.QueryValidatorParticipationRequest) (*types.QueryValidatorParticipationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, ok := q.GetProposal(ctx, req.ProposalId)
+	if !ok {
+		proposal, ok = q.GetArchivedProposal(ctx, req.ProposalId)
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+		}
+	}
+
+	switch proposal.Status {
+	case types.StatusDepositPeriod, types.StatusVotingPeriod, types.StatusRevealPeriod:
+		return nil, status.Errorf(codes.InvalidArgument, "proposal %d is not finalized", req.ProposalId)
+	}
+
+	var participations []types.ValidatorParticipation
+	store := ctx.KVStore(q.storeKey)
+	participationsStore := prefix.NewStore(store, types.ValidatorParticipationsKey(req.ProposalId))
+
+	pageRes, err := query.Paginate(participationsStore, req.Pagination, func(key []byte, value []byte) error {
+		var participation types.ValidatorParticipation
+		if err := q.cdc.Unmarshal(value, &participation); err != nil {
+			return err
+		}
+
+		participations = append(participations, participation)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorParticipationResponse{Participations: participations, Pagination: pageRes}, nil
+}
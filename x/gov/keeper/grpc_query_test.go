@@ -4,11 +4,17 @@ import (
 	gocontext "context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
@@ -442,6 +448,18 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 		expRes *types.QueryParamsResponse
 	)
 
+	zeroTallyParams := types.TallyParams{
+		Quorum:                  sdk.NewDec(0),
+		Threshold:               sdk.NewDec(0),
+		VetoThreshold:           sdk.NewDec(0),
+		ExpeditedQuorum:         sdk.NewDec(0),
+		ExpeditedThreshold:      sdk.NewDec(0),
+		OptimisticVetoThreshold: sdk.NewDec(0),
+		VotingPowerCap:          sdk.NewDec(0),
+		MaxConvictionMultiplier: sdk.NewDec(0),
+		EmergencyThreshold:      sdk.NewDec(0),
+	}
+
 	testCases := []struct {
 		msg      string
 		malleate func()
@@ -460,7 +478,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamDeposit}
 				expRes = &types.QueryParamsResponse{
 					DepositParams: types.DefaultDepositParams(),
-					TallyParams:   types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
+					TallyParams:   zeroTallyParams,
 				}
 			},
 			true,
@@ -470,8 +488,9 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 			func() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamVoting}
 				expRes = &types.QueryParamsResponse{
-					VotingParams: types.DefaultVotingParams(),
-					TallyParams:  types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
+					VotingParams:  types.DefaultVotingParams(),
+					DepositParams: types.DepositParams{CancelBurnRatio: sdk.NewDec(0), MinInitialDepositRatio: sdk.NewDec(0)},
+					TallyParams:   zeroTallyParams,
 				}
 			},
 			true,
@@ -481,7 +500,8 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 			func() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamTallying}
 				expRes = &types.QueryParamsResponse{
-					TallyParams: types.DefaultTallyParams(),
+					DepositParams: types.DepositParams{CancelBurnRatio: sdk.NewDec(0), MinInitialDepositRatio: sdk.NewDec(0)},
+					TallyParams:   types.DefaultTallyParams(),
 				}
 			},
 			true,
@@ -798,6 +818,21 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 			},
 			true,
 		},
+		{
+			"request final tally of an archived proposal",
+			func() {
+				proposal.FinalTallyResult = types.NewTallyResult(sdk.NewInt(7), sdk.NewInt(1), sdk.ZeroInt(), sdk.ZeroInt())
+				app.GovKeeper.SetProposal(ctx, proposal)
+				app.GovKeeper.ArchiveProposal(ctx, proposal)
+
+				req = &types.QueryTallyResultRequest{ProposalId: proposal.ProposalId}
+
+				expRes = &types.QueryTallyResultResponse{
+					Tally: proposal.FinalTallyResult,
+				}
+			},
+			true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -816,3 +851,473 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryLiveTally() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	_, err := queryClient.LiveTally(gocontext.Background(), &types.QueryLiveTallyRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.LiveTally(gocontext.Background(), &types.QueryLiveTallyRequest{ProposalId: 1})
+	suite.Require().Error(err)
+
+	addrs, valAddrs := createValidators(suite.T(), ctx, app, []int64{10, 10, 10})
+	val1, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	suite.Require().True(found)
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[3], app.StakingKeeper.TokensFromConsensusPower(ctx, 10), stakingtypes.Unbonded, val1, true)
+	suite.Require().NoError(err)
+	val1, _ = app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[4], app.StakingKeeper.TokensFromConsensusPower(ctx, 5), stakingtypes.Unbonded, val1, true)
+	suite.Require().NoError(err)
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// addrs[3] follows the vote of the second validator
+	suite.Require().NoError(app.GovKeeper.SetGovernor(ctx, addrs[3], addrs[1]))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	suite.Require().NoError(err)
+
+	// a proposal in its deposit period has no live tally
+	_, err = queryClient.LiveTally(gocontext.Background(), &types.QueryLiveTallyRequest{ProposalId: proposal.ProposalId})
+	suite.Require().Error(err)
+
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
+	suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[4], types.NewNonSplitVoteOption(types.OptionAbstain)))
+
+	expTally := types.NewTallyResult(
+		app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
+		app.StakingKeeper.TokensFromConsensusPower(ctx, 5),
+		app.StakingKeeper.TokensFromConsensusPower(ctx, 20),
+		sdk.ZeroInt(),
+	)
+
+	// the tallies of the pages of the votes add up to the whole tally, with or
+	// without a voting power snapshot
+	for _, snapshot := range []bool{false, true} {
+		if snapshot {
+			app.GovKeeper.SnapshotVotingPower(ctx, proposal.ProposalId)
+		}
+
+		res, err := queryClient.LiveTally(gocontext.Background(), &types.QueryLiveTallyRequest{ProposalId: proposal.ProposalId})
+		suite.Require().NoError(err)
+		suite.Require().Equal(expTally, res.Tally)
+		suite.Require().Nil(res.Pagination)
+
+		pageTally := types.EmptyTallyResult()
+		pageReq := &query.PageRequest{Limit: 1}
+		for pages := 0; ; pages++ {
+			suite.Require().Less(pages, 3)
+
+			res, err = queryClient.LiveTally(gocontext.Background(), &types.QueryLiveTallyRequest{ProposalId: proposal.ProposalId, Pagination: pageReq})
+			suite.Require().NoError(err)
+
+			pageTally = types.NewTallyResult(
+				pageTally.Yes.Add(res.Tally.Yes),
+				pageTally.Abstain.Add(res.Tally.Abstain),
+				pageTally.No.Add(res.Tally.No),
+				pageTally.NoWithVeto.Add(res.Tally.NoWithVeto),
+			)
+
+			if res.Pagination.NextKey == nil {
+				break
+			}
+			pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}
+		}
+		suite.Require().Equal(expTally, pageTally)
+	}
+
+	// the votes are left in the store
+	suite.Require().Len(app.GovKeeper.GetVotes(ctx, proposal.ProposalId), 3)
+
+	choiceA, err := types.NewProposalChoice("A", nil)
+	suite.Require().NoError(err)
+	choiceB, err := types.NewProposalChoice("B", nil)
+	suite.Require().NoError(err)
+	choiceProposal, err := app.GovKeeper.SubmitMultipleChoiceProposal(ctx, TestProposal, []types.ProposalChoice{choiceA, choiceB})
+	suite.Require().NoError(err)
+	choiceProposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, choiceProposal)
+
+	// a multiple-choice proposal is tallied by choice
+	_, err = queryClient.LiveTally(gocontext.Background(), &types.QueryLiveTallyRequest{ProposalId: choiceProposal.ProposalId})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuerySimulateProposal() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	_, err := queryClient.SimulateProposal(gocontext.Background(), &types.QuerySimulateProposalRequest{})
+	suite.Require().Error(err)
+	_, err = queryClient.SimulateProposal(gocontext.Background(), &types.QuerySimulateProposalRequest{ProposalId: 1})
+	suite.Require().Error(err)
+
+	suite.Require().NoError(app.DistrKeeper.FundCommunityPool(ctx, amount, addrs[0]))
+	balance := app.BankKeeper.GetAllBalances(ctx, addrs[1])
+	content := distrtypes.NewCommunityPoolSpendProposal("title", "description", addrs[1], amount)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, content)
+	suite.Require().NoError(err)
+
+	// the proposal must be in voting period
+	req := &types.QuerySimulateProposalRequest{ProposalId: proposal.ProposalId}
+	_, err = queryClient.SimulateProposal(gocontext.Background(), req)
+	suite.Require().Error(err)
+
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	res, err := queryClient.SimulateProposal(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Error)
+	suite.Require().Positive(res.GasUsed)
+
+	var transferred bool
+	for _, event := range res.Events {
+		transferred = transferred || event.Type == banktypes.EventTypeTransfer
+	}
+	suite.Require().True(transferred)
+
+	// the state isn't modified
+	suite.Require().Equal(balance, app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+	suite.Require().Equal(sdk.NewDecCoinsFromCoins(amount...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	// the execution fails when it exceeds the execution gas limit
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.ExecutionGasLimit = res.GasUsed - 1
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+	res, err = queryClient.SimulateProposal(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Contains(res.Error, sdkerrors.ErrOutOfGas.Error())
+	suite.Require().Empty(res.Events)
+	votingParams.ExecutionGasLimit = 0
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	// the execution fails once the community pool is spent
+	suite.Require().NoError(app.DistrKeeper.DistributeFromFeePool(ctx, amount, addrs[0]))
+	res, err = queryClient.SimulateProposal(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Contains(res.Error, "community pool does not have sufficient coins")
+	suite.Require().Empty(res.Events)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuerySimulateProposalContent() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	suite.Require().NoError(app.DistrKeeper.FundCommunityPool(ctx, amount, addrs[0]))
+	balance := app.BankKeeper.GetAllBalances(ctx, addrs[1])
+	proposalID, err := app.GovKeeper.GetProposalID(ctx)
+	suite.Require().NoError(err)
+
+	req, err := types.NewQuerySimulateContentRequest(distrtypes.NewCommunityPoolSpendProposal("title", "description", addrs[1], amount))
+	suite.Require().NoError(err)
+
+	res, err := queryClient.SimulateProposal(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Error)
+	suite.Require().Positive(res.GasUsed)
+
+	var transferred bool
+	for _, event := range res.Events {
+		transferred = transferred || event.Type == banktypes.EventTypeTransfer
+	}
+	suite.Require().True(transferred)
+
+	// the state isn't modified and no proposal is submitted
+	suite.Require().Equal(balance, app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+	suite.Require().Equal(sdk.NewDecCoinsFromCoins(amount...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+	nextProposalID, err := app.GovKeeper.GetProposalID(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(proposalID, nextProposalID)
+
+	// a proposal id can't be set along with a content
+	_, err = queryClient.SimulateProposal(gocontext.Background(), &types.QuerySimulateProposalRequest{ProposalId: 1, Content: req.Content})
+	suite.Require().Error(err)
+
+	// an invalid content is rejected
+	invalidReq, err := types.NewQuerySimulateContentRequest(distrtypes.NewCommunityPoolSpendProposal("", "description", addrs[1], amount))
+	suite.Require().NoError(err)
+	_, err = queryClient.SimulateProposal(gocontext.Background(), invalidReq)
+	suite.Require().Error(err)
+
+	// the execution fails once the community pool is spent
+	suite.Require().NoError(app.DistrKeeper.DistributeFromFeePool(ctx, amount, addrs[0]))
+	res, err = queryClient.SimulateProposal(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Contains(res.Error, "community pool does not have sufficient coins")
+	suite.Require().Empty(res.Events)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryProposalTemplates() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	res, err := queryClient.ProposalTemplates(gocontext.Background(), &types.QueryProposalTemplatesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Templates)
+
+	templates := []types.ProposalTemplate{
+		types.NewProposalTemplate("text", "signaling proposal", `{"@type":"/cosmos.gov.v1beta1.TextProposal","title":"{{title}}","description":"{{description}}"}`),
+	}
+	app.GovKeeper.SetProposalTemplates(ctx, templates)
+
+	res, err = queryClient.ProposalTemplates(gocontext.Background(), &types.QueryProposalTemplatesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(templates, res.Templates)
+
+	_, err = queryClient.ProposalTemplate(gocontext.Background(), &types.QueryProposalTemplateRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.ProposalTemplate(gocontext.Background(), &types.QueryProposalTemplateRequest{Name: "unknown"})
+	suite.Require().Error(err)
+
+	templateRes, err := queryClient.ProposalTemplate(gocontext.Background(), &types.QueryProposalTemplateRequest{Name: "text"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(templates[0], templateRes.Template)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryDiscussionAnchors() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	_, err := queryClient.DiscussionAnchors(gocontext.Background(), &types.QueryDiscussionAnchorsRequest{})
+	suite.Require().Error(err)
+
+	res, err := queryClient.DiscussionAnchors(gocontext.Background(), &types.QueryDiscussionAnchorsRequest{ProposalId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Anchors)
+
+	anchors := []types.DiscussionAnchor{
+		types.NewDiscussionAnchor(1, suite.addrs[0], make([]byte, types.DiscussionAnchorHashLength), "https://forum.example.com/t/1", 1),
+		types.NewDiscussionAnchor(1, suite.addrs[0], []byte("0123456789abcdef0123456789abcdef"), "", 2),
+		types.NewDiscussionAnchor(2, suite.addrs[1], make([]byte, types.DiscussionAnchorHashLength), "", 3),
+	}
+	for _, anchor := range anchors {
+		app.GovKeeper.SetDiscussionAnchor(ctx, anchor)
+	}
+
+	res, err = queryClient.DiscussionAnchors(gocontext.Background(), &types.QueryDiscussionAnchorsRequest{ProposalId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(anchors[:2], res.Anchors)
+
+	res, err = queryClient.DiscussionAnchors(gocontext.Background(), &types.QueryDiscussionAnchorsRequest{
+		ProposalId: 1,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(anchors[:1], res.Anchors)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryChoiceVotes() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	_, err := queryClient.ChoiceVotes(gocontext.Background(), &types.QueryChoiceVotesRequest{})
+	suite.Require().Error(err)
+
+	res, err := queryClient.ChoiceVotes(gocontext.Background(), &types.QueryChoiceVotesRequest{ProposalId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Votes)
+
+	votes := []types.ChoiceVote{
+		types.NewChoiceVote(1, suite.addrs[0], 1),
+		types.NewChoiceVote(1, suite.addrs[1], 0),
+		types.NewChoiceVote(2, suite.addrs[0], 2),
+	}
+	for _, vote := range votes {
+		app.GovKeeper.SetChoiceVote(ctx, vote)
+	}
+
+	res, err = queryClient.ChoiceVotes(gocontext.Background(), &types.QueryChoiceVotesRequest{ProposalId: 1})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(votes[:2], res.Votes)
+
+	res, err = queryClient.ChoiceVotes(gocontext.Background(), &types.QueryChoiceVotesRequest{
+		ProposalId: 1,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Votes, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryChoiceTally() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	_, err := queryClient.ChoiceTally(gocontext.Background(), &types.QueryChoiceTallyRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.ChoiceTally(gocontext.Background(), &types.QueryChoiceTallyRequest{ProposalId: 1})
+	suite.Require().Error(err)
+
+	// a regular proposal has no choice tally
+	regular, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	suite.Require().NoError(err)
+	_, err = queryClient.ChoiceTally(gocontext.Background(), &types.QueryChoiceTallyRequest{ProposalId: regular.ProposalId})
+	suite.Require().Error(err)
+
+	choiceA, err := types.NewProposalChoice("A", nil)
+	suite.Require().NoError(err)
+	choiceB, err := types.NewProposalChoice("B", nil)
+	suite.Require().NoError(err)
+	proposal, err := app.GovKeeper.SubmitMultipleChoiceProposal(ctx, TestProposal, []types.ProposalChoice{choiceA, choiceB})
+	suite.Require().NoError(err)
+
+	res, err := queryClient.ChoiceTally(gocontext.Background(), &types.QueryChoiceTallyRequest{ProposalId: proposal.ProposalId})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.Int{sdk.ZeroInt(), sdk.ZeroInt()}, res.Results)
+
+	// the final results are returned once the voting period ended
+	proposal.Status = types.StatusPassed
+	proposal.ChoiceTallyResults = []sdk.Int{sdk.NewInt(10), sdk.NewInt(20)}
+	proposal.WinningChoice = 1
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	res, err = queryClient.ChoiceTally(gocontext.Background(), &types.QueryChoiceTallyRequest{ProposalId: proposal.ProposalId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(proposal.ChoiceTallyResults, res.Results)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryPendingExecutions() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	res, err := queryClient.PendingExecutions(gocontext.Background(), &types.QueryPendingExecutionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Proposals)
+
+	// schedule two proposals, the second one to be executed first
+	var proposals types.Proposals
+	for i := 0; i < 2; i++ {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+		suite.Require().NoError(err)
+		proposal.Status = types.StatusScheduled
+		proposal.ExecutionTime = ctx.BlockTime().Add(time.Duration(2-i) * time.Hour)
+		app.GovKeeper.SetProposal(ctx, proposal)
+		app.GovKeeper.InsertExecutionQueue(ctx, proposal.ProposalId, proposal.ExecutionTime)
+		proposals = append(proposals, proposal)
+	}
+
+	res, err = queryClient.PendingExecutions(gocontext.Background(), &types.QueryPendingExecutionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Proposals, 2)
+	suite.Require().True(proposals[1].Equal(res.Proposals[0]))
+	suite.Require().True(proposals[0].Equal(res.Proposals[1]))
+
+	res, err = queryClient.PendingExecutions(gocontext.Background(), &types.QueryPendingExecutionsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Proposals, 1)
+	suite.Require().Equal(proposals[1].ProposalId, res.Proposals[0].ProposalId)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryGovernanceDelegations() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 5, sdk.NewInt(30000000))
+
+	_, err := queryClient.Governor(gocontext.Background(), &types.QueryGovernorRequest{})
+	suite.Require().Error(err)
+	_, err = queryClient.Governor(gocontext.Background(), &types.QueryGovernorRequest{DelegatorAddress: addrs[0].String()})
+	suite.Require().Error(err)
+	_, err = queryClient.GovernanceDelegations(gocontext.Background(), &types.QueryGovernanceDelegationsRequest{})
+	suite.Require().Error(err)
+
+	suite.Require().NoError(app.GovKeeper.SetGovernor(ctx, addrs[0], addrs[2]))
+	suite.Require().NoError(app.GovKeeper.SetGovernor(ctx, addrs[1], addrs[2]))
+	suite.Require().NoError(app.GovKeeper.SetGovernor(ctx, addrs[3], addrs[4]))
+
+	governor, err := queryClient.Governor(gocontext.Background(), &types.QueryGovernorRequest{DelegatorAddress: addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(addrs[2].String(), governor.GovernorAddress)
+
+	res, err := queryClient.GovernanceDelegations(gocontext.Background(), &types.QueryGovernanceDelegationsRequest{GovernorAddress: addrs[2].String()})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]types.GovernanceDelegation{
+		types.NewGovernanceDelegation(addrs[0], addrs[2]),
+		types.NewGovernanceDelegation(addrs[1], addrs[2]),
+	}, res.Delegations)
+
+	res, err = queryClient.GovernanceDelegations(gocontext.Background(), &types.QueryGovernanceDelegationsRequest{
+		GovernorAddress: addrs[2].String(),
+		Pagination:      &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Delegations, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryValidatorParticipation() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	_, err := queryClient.ValidatorParticipation(gocontext.Background(), &types.QueryValidatorParticipationRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.ValidatorParticipation(gocontext.Background(), &types.QueryValidatorParticipationRequest{ProposalId: 1})
+	suite.Require().Error(err)
+
+	addrs, valAddrs := createValidators(suite.T(), ctx, app, []int64{10, 10, 10})
+	val1, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	suite.Require().True(found)
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[3], app.StakingKeeper.TokensFromConsensusPower(ctx, 5), stakingtypes.Unbonded, val1, true)
+	suite.Require().NoError(err)
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	suite.Require().NoError(err)
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[2], types.NewNonSplitVoteOption(types.OptionNo)))
+	suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[3], types.NewNonSplitVoteOption(types.OptionNo)))
+
+	// the participation of the validators is only reported once the proposal
+	// is finalized
+	_, err = queryClient.ValidatorParticipation(gocontext.Background(), &types.QueryValidatorParticipationRequest{ProposalId: proposal.ProposalId})
+	suite.Require().Error(err)
+
+	_, _, _ = app.GovKeeper.Tally(ctx, proposal)
+	proposal.Status = types.StatusRejected
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	res, err := queryClient.ValidatorParticipation(gocontext.Background(), &types.QueryValidatorParticipationRequest{ProposalId: proposal.ProposalId})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Participations, 3)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	// the delegation of addrs[3] is deducted from the voting power of the first
+	// validator, while the second validator keeps the voting power it didn't
+	// vote with
+	power := sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 10))
+	expected := map[string]types.ValidatorParticipation{
+		valAddrs[0].String(): types.NewValidatorParticipation(proposal.ProposalId, valAddrs[0], true, app.StakingKeeper.TokensFromConsensusPower(ctx, 15), power),
+		valAddrs[1].String(): types.NewValidatorParticipation(proposal.ProposalId, valAddrs[1], false, app.StakingKeeper.TokensFromConsensusPower(ctx, 10), power),
+		valAddrs[2].String(): types.NewValidatorParticipation(proposal.ProposalId, valAddrs[2], true, app.StakingKeeper.TokensFromConsensusPower(ctx, 10), power),
+	}
+	for _, participation := range res.Participations {
+		exp, ok := expected[participation.ValidatorAddress]
+		suite.Require().True(ok)
+		suite.Require().Equal(exp.Voted, participation.Voted)
+		suite.Require().True(exp.BondedTokens.Equal(participation.BondedTokens))
+		suite.Require().True(exp.VotingPower.Equal(participation.VotingPower), participation.String())
+	}
+
+	res, err = queryClient.ValidatorParticipation(gocontext.Background(), &types.QueryValidatorParticipationRequest{
+		ProposalId: proposal.ProposalId,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Participations, 2)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	// the participation of the validators is deleted along with a pruned proposal
+	app.GovKeeper.PruneProposal(ctx, proposal)
+	_, found = app.GovKeeper.GetValidatorParticipation(ctx, proposal.ProposalId, valAddrs[0])
+	suite.Require().False(found)
+}
@@ -37,8 +37,22 @@ func (keeper Keeper) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID u
 }
 
 // AfterProposalVotingPeriodEnded - call hook if registered
-func (keeper Keeper) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {
+func (keeper Keeper) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64, canVote types.VoterFilter) {
 	if keeper.hooks != nil {
-		keeper.hooks.AfterProposalVotingPeriodEnded(ctx, proposalID)
+		keeper.hooks.AfterProposalVotingPeriodEnded(ctx, proposalID, canVote)
+	}
+}
+
+// AfterProposalCanceled - call hook if registered
+func (keeper Keeper) AfterProposalCanceled(ctx sdk.Context, proposalID uint64) {
+	if keeper.hooks != nil {
+		keeper.hooks.AfterProposalCanceled(ctx, proposalID)
+	}
+}
+
+// AfterProposalExecuted - call hook if registered
+func (keeper Keeper) AfterProposalExecuted(ctx sdk.Context, proposalID uint64, success bool, err string) {
+	if keeper.hooks != nil {
+		keeper.hooks.AfterProposalExecuted(ctx, proposalID, success, err)
 	}
 }
@@ -12,6 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var _ types.GovHooks = &MockGovHooksReceiver{}
@@ -23,6 +25,11 @@ type MockGovHooksReceiver struct {
 	AfterProposalVoteValid              bool
 	AfterProposalFailedMinDepositValid  bool
 	AfterProposalVotingPeriodEndedValid bool
+	AfterProposalCanceledValid          bool
+	AfterProposalExecutedValid          bool
+
+	ExecutionSuccess bool
+	ExecutionErr     string
 }
 
 func (h *MockGovHooksReceiver) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {
@@ -39,9 +46,17 @@ func (h *MockGovHooksReceiver) AfterProposalVote(ctx sdk.Context, proposalID uin
 func (h *MockGovHooksReceiver) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64) {
 	h.AfterProposalFailedMinDepositValid = true
 }
-func (h *MockGovHooksReceiver) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {
+func (h *MockGovHooksReceiver) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64, canVote types.VoterFilter) {
 	h.AfterProposalVotingPeriodEndedValid = true
 }
+func (h *MockGovHooksReceiver) AfterProposalCanceled(ctx sdk.Context, proposalID uint64) {
+	h.AfterProposalCanceledValid = true
+}
+func (h *MockGovHooksReceiver) AfterProposalExecuted(ctx sdk.Context, proposalID uint64, success bool, err string) {
+	h.AfterProposalExecutedValid = true
+	h.ExecutionSuccess = success
+	h.ExecutionErr = err
+}
 
 func TestHooks(t *testing.T) {
 	app := simapp.Setup(t, false)
@@ -61,6 +76,7 @@ func TestHooks(t *testing.T) {
 	require.False(t, govHooksReceiver.AfterProposalVoteValid)
 	require.False(t, govHooksReceiver.AfterProposalFailedMinDepositValid)
 	require.False(t, govHooksReceiver.AfterProposalVotingPeriodEndedValid)
+	require.False(t, govHooksReceiver.AfterProposalCanceledValid)
 
 	tp := TestProposal
 	_, err := app.GovKeeper.SubmitProposal(ctx, tp)
@@ -91,4 +107,54 @@ func TestHooks(t *testing.T) {
 	ctx = ctx.WithBlockHeader(newHeader)
 	gov.EndBlocker(ctx, app.GovKeeper)
 	require.True(t, govHooksReceiver.AfterProposalVotingPeriodEndedValid)
+
+	p3, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	p3.Proposer = addrs[0].String()
+	app.GovKeeper.SetProposal(ctx, p3)
+
+	require.NoError(t, app.GovKeeper.CancelProposal(ctx, p3.ProposalId, addrs[0]))
+	require.True(t, govHooksReceiver.AfterProposalCanceledValid)
+}
+
+func TestAfterProposalExecutedHook(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs, _ := createValidators(t, ctx, app, []int64{10, 1, 1})
+
+	govHooksReceiver := MockGovHooksReceiver{}
+	keeper.UnsafeSetHooks(
+		&app.GovKeeper, types.NewMultiGovHooks(&govHooksReceiver),
+	)
+
+	execute := func() {
+		content := paramproposal.NewParameterChangeProposal("Test", "description", []paramproposal.ParamChange{
+			{Subspace: stakingtypes.ModuleName, Key: string(stakingtypes.KeyMaxValidators), Value: "1"},
+		})
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, content)
+		require.NoError(t, err)
+		_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], app.GovKeeper.GetDepositParams(ctx).MinDeposit)
+		require.NoError(t, err)
+		require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod))
+		gov.EndBlocker(ctx, app.GovKeeper)
+	}
+
+	// the execution runs out of gas
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.ExecutionGasLimit = 100
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	execute()
+	require.True(t, govHooksReceiver.AfterProposalExecutedValid)
+	require.False(t, govHooksReceiver.ExecutionSuccess)
+	require.Contains(t, govHooksReceiver.ExecutionErr, "out of gas")
+
+	votingParams.ExecutionGasLimit = 0
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	execute()
+	require.True(t, govHooksReceiver.ExecutionSuccess)
+	require.Empty(t, govHooksReceiver.ExecutionErr)
 }
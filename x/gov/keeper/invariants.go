@@ -22,7 +22,7 @@ func AllInvariants(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 }
 
 // ModuleAccountInvariant checks that the module account coins reflects the sum of
-// deposit amounts held on store
+// deposit amounts held on store, including the deposits of vote commitments
 func ModuleAccountInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		var expectedDeposits sdk.Coins
@@ -32,6 +32,11 @@ func ModuleAccountInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 			return false
 		})
 
+		keeper.IterateAllVoteCommitments(ctx, func(commitment types.VoteCommitment) bool {
+			expectedDeposits = expectedDeposits.Add(commitment.Deposit...)
+			return false
+		})
+
 		macc := keeper.GetGovernanceAccount(ctx)
 		balances := bk.GetAllBalances(ctx, macc.GetAddress())
 		broken := !balances.IsEqual(expectedDeposits)
@@ -23,9 +23,15 @@ type Keeper struct {
 	// The reference to the DelegationSet and ValidatorSet to get information about validators and delegators
 	sk types.StakingKeeper
 
+	// The reference to the distribution keeper crediting the submission fees to the community pool
+	distrKeeper types.DistributionKeeper
+
 	// GovHooks
 	hooks types.GovHooks
 
+	// The issuer of the participation records of the voters, optional
+	receiptIssuer types.VoteReceiptIssuer
+
 	// The (unexposed) keys used to access the stores from the Context.
 	storeKey sdk.StoreKey
 
@@ -45,7 +51,8 @@ type Keeper struct {
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace types.ParamSubspace,
-	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper, rtr types.Router,
+	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper,
+	distrKeeper types.DistributionKeeper, rtr types.Router,
 ) Keeper {
 
 	// ensure governance module account is set
@@ -59,13 +66,14 @@ func NewKeeper(
 	rtr.Seal()
 
 	return Keeper{
-		storeKey:   key,
-		paramSpace: paramSpace,
-		authKeeper: authKeeper,
-		bankKeeper: bankKeeper,
-		sk:         sk,
-		cdc:        cdc,
-		router:     rtr,
+		storeKey:    key,
+		paramSpace:  paramSpace,
+		authKeeper:  authKeeper,
+		bankKeeper:  bankKeeper,
+		sk:          sk,
+		distrKeeper: distrKeeper,
+		cdc:         cdc,
+		router:      rtr,
 	}
 }
 
@@ -80,6 +88,18 @@ func (keeper *Keeper) SetHooks(gh types.GovHooks) *Keeper {
 	return keeper
 }
 
+// SetVoteReceiptIssuer sets the issuer of the participation records of the
+// voters, called for each vote receipt when vote receipts are enabled
+func (keeper *Keeper) SetVoteReceiptIssuer(issuer types.VoteReceiptIssuer) *Keeper {
+	if keeper.receiptIssuer != nil {
+		panic("cannot set vote receipt issuer twice")
+	}
+
+	keeper.receiptIssuer = issuer
+
+	return keeper
+}
+
 // Logger returns a module-specific logger.
 func (keeper Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -123,6 +143,58 @@ func (keeper Keeper) RemoveFromInactiveProposalQueue(ctx sdk.Context, proposalID
 	store.Delete(types.InactiveProposalQueueKey(proposalID, endTime))
 }
 
+// InsertExecutionQueue inserts a ProposalID into the execution queue at executionTime
+func (keeper Keeper) InsertExecutionQueue(ctx sdk.Context, proposalID uint64, executionTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := types.GetProposalIDBytes(proposalID)
+	store.Set(types.ExecutionQueueKey(proposalID, executionTime), bz)
+}
+
+// RemoveFromExecutionQueue removes a proposalID from the Execution Queue
+func (keeper Keeper) RemoveFromExecutionQueue(ctx sdk.Context, proposalID uint64, executionTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.ExecutionQueueKey(proposalID, executionTime))
+}
+
+// InsertOptimisticProposalQueue inserts a ProposalID into the optimistic proposal queue at endTime
+func (keeper Keeper) InsertOptimisticProposalQueue(ctx sdk.Context, proposalID uint64, endTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := types.GetProposalIDBytes(proposalID)
+	store.Set(types.OptimisticProposalQueueKey(proposalID, endTime), bz)
+}
+
+// RemoveFromOptimisticProposalQueue removes a proposalID from the Optimistic Proposal Queue
+func (keeper Keeper) RemoveFromOptimisticProposalQueue(ctx sdk.Context, proposalID uint64, endTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.OptimisticProposalQueueKey(proposalID, endTime))
+}
+
+// InsertRevealProposalQueue inserts a ProposalID into the reveal proposal queue at endTime
+func (keeper Keeper) InsertRevealProposalQueue(ctx sdk.Context, proposalID uint64, endTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := types.GetProposalIDBytes(proposalID)
+	store.Set(types.RevealProposalQueueKey(proposalID, endTime), bz)
+}
+
+// RemoveFromRevealProposalQueue removes a proposalID from the Reveal Proposal Queue
+func (keeper Keeper) RemoveFromRevealProposalQueue(ctx sdk.Context, proposalID uint64, endTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.RevealProposalQueueKey(proposalID, endTime))
+}
+
+// InsertCouncilProposalQueue inserts a ProposalID into the council proposal queue at endTime
+func (keeper Keeper) InsertCouncilProposalQueue(ctx sdk.Context, proposalID uint64, endTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := types.GetProposalIDBytes(proposalID)
+	store.Set(types.CouncilProposalQueueKey(proposalID, endTime), bz)
+}
+
+// RemoveFromCouncilProposalQueue removes a proposalID from the Council Proposal Queue
+func (keeper Keeper) RemoveFromCouncilProposalQueue(ctx sdk.Context, proposalID uint64, endTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.CouncilProposalQueueKey(proposalID, endTime))
+}
+
 // Iterators
 
 // IterateActiveProposalsQueue iterates over the proposals in the active proposal queue
@@ -163,6 +235,82 @@ func (keeper Keeper) IterateInactiveProposalsQueue(ctx sdk.Context, endTime time
 	}
 }
 
+// IterateExecutionQueue iterates over the proposals in the execution queue
+// and performs a callback function
+func (keeper Keeper) IterateExecutionQueue(ctx sdk.Context, executionTime time.Time, cb func(proposal types.Proposal) (stop bool)) {
+	iterator := keeper.ExecutionQueueIterator(ctx, executionTime)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, _ := types.SplitExecutionQueueKey(iterator.Key())
+		proposal, found := keeper.GetProposal(ctx, proposalID)
+		if !found {
+			panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+		}
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+// IterateOptimisticProposalsQueue iterates over the proposals in the optimistic
+// proposal queue and performs a callback function
+func (keeper Keeper) IterateOptimisticProposalsQueue(ctx sdk.Context, endTime time.Time, cb func(proposal types.Proposal) (stop bool)) {
+	iterator := keeper.OptimisticProposalQueueIterator(ctx, endTime)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, _ := types.SplitOptimisticProposalQueueKey(iterator.Key())
+		proposal, found := keeper.GetProposal(ctx, proposalID)
+		if !found {
+			panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+		}
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+// IterateRevealProposalsQueue iterates over the proposals in the reveal
+// proposal queue and performs a callback function
+func (keeper Keeper) IterateRevealProposalsQueue(ctx sdk.Context, endTime time.Time, cb func(proposal types.Proposal) (stop bool)) {
+	iterator := keeper.RevealProposalQueueIterator(ctx, endTime)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, _ := types.SplitRevealProposalQueueKey(iterator.Key())
+		proposal, found := keeper.GetProposal(ctx, proposalID)
+		if !found {
+			panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+		}
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+// IterateCouncilProposalsQueue iterates over the proposals in the council
+// proposal queue and performs a callback function
+func (keeper Keeper) IterateCouncilProposalsQueue(ctx sdk.Context, endTime time.Time, cb func(proposal types.Proposal) (stop bool)) {
+	iterator := keeper.CouncilProposalQueueIterator(ctx, endTime)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, _ := types.SplitCouncilProposalQueueKey(iterator.Key())
+		proposal, found := keeper.GetProposal(ctx, proposalID)
+		if !found {
+			panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+		}
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
 // ActiveProposalQueueIterator returns an sdk.Iterator for all the proposals in the Active Queue that expire by endTime
 func (keeper Keeper) ActiveProposalQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(keeper.storeKey)
@@ -174,3 +322,27 @@ func (keeper Keeper) InactiveProposalQueueIterator(ctx sdk.Context, endTime time
 	store := ctx.KVStore(keeper.storeKey)
 	return store.Iterator(types.InactiveProposalQueuePrefix, sdk.PrefixEndBytes(types.InactiveProposalByTimeKey(endTime)))
 }
+
+// ExecutionQueueIterator returns an sdk.Iterator for all the proposals in the Execution Queue that are scheduled by executionTime
+func (keeper Keeper) ExecutionQueueIterator(ctx sdk.Context, executionTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Iterator(types.ExecutionQueuePrefix, sdk.PrefixEndBytes(types.ExecutionQueueByTimeKey(executionTime)))
+}
+
+// OptimisticProposalQueueIterator returns an sdk.Iterator for all the proposals in the Optimistic Queue whose challenge window ends by endTime
+func (keeper Keeper) OptimisticProposalQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Iterator(types.OptimisticProposalQueuePrefix, sdk.PrefixEndBytes(types.OptimisticProposalByTimeKey(endTime)))
+}
+
+// RevealProposalQueueIterator returns an sdk.Iterator for all the proposals in the Reveal Queue whose reveal period ends by endTime
+func (keeper Keeper) RevealProposalQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Iterator(types.RevealProposalQueuePrefix, sdk.PrefixEndBytes(types.RevealProposalByTimeKey(endTime)))
+}
+
+// CouncilProposalQueueIterator returns an sdk.Iterator for all the proposals in the Council Queue whose voting period ends by endTime
+func (keeper Keeper) CouncilProposalQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Iterator(types.CouncilProposalQueuePrefix, sdk.PrefixEndBytes(types.CouncilProposalByTimeKey(endTime)))
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v043 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v043"
+	v044 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v044"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -19,3 +20,53 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v043.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates x/gov params from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	v044.MigrateParams(ctx, m.keeper.paramSpace)
+	return nil
+}
+
+// Migrate3to4 migrates x/gov params from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	v044.MigrateDepositParams(ctx, m.keeper.paramSpace)
+	return nil
+}
+
+// Migrate4to5 migrates x/gov params from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	v044.MigrateTallyParams(ctx, m.keeper.paramSpace)
+	return nil
+}
+
+// Migrate5to6 migrates x/gov params from version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	v044.MigrateInitialDepositParams(ctx, m.keeper.paramSpace)
+	return nil
+}
+
+// Migrate6to7 migrates x/gov params from version 6 to 7.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	v044.MigrateBurnDepositParams(ctx, m.keeper.paramSpace)
+	return nil
+}
+
+// Migrate7to8 migrates x/gov params from version 7 to 8.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	v044.MigrateVotingPowerParams(ctx, m.keeper.paramSpace)
+	return nil
+}
+
+// Migrate8to9 migrates x/gov params from version 8 to 9, and records the
+// existing delegations as bonded since the migration.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	v044.MigrateConvictionParams(ctx, m.keeper.paramSpace)
+	m.keeper.InitDelegationBondings(ctx)
+	return nil
+}
+
+// Migrate9to10 migrates x/gov params from version 9 to 10.
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	v044.MigrateEmergencyParams(ctx, m.keeper.paramSpace)
+	return nil
+}
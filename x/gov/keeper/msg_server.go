@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -26,13 +27,48 @@ var _ types.MsgServer = msgServer{}
 
 func (k msgServer) SubmitProposal(goCtx context.Context, msg *types.MsgSubmitProposal) (*types.MsgSubmitProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	proposal, err := k.Keeper.SubmitProposal(ctx, msg.GetContent())
+	if err := k.Keeper.ValidateInitialDeposit(ctx, msg.GetInitialDeposit()); err != nil {
+		return nil, err
+	}
+
+	fee, err := k.Keeper.ChargeSubmissionFee(ctx, msg.GetProposer())
+	if err != nil {
+		return nil, err
+	}
+
+	var proposal types.Proposal
+	switch {
+	case msg.GetIsExpedited():
+		proposal, err = k.Keeper.SubmitExpeditedProposal(ctx, msg.GetContent())
+	case msg.GetIsOptimistic():
+		proposal, err = k.Keeper.SubmitOptimisticProposal(ctx, msg.GetContent(), msg.GetProposer())
+	case msg.GetIsPrivate():
+		proposal, err = k.Keeper.SubmitPrivateProposal(ctx, msg.GetContent())
+	case msg.GetIsCouncil():
+		proposal, err = k.Keeper.SubmitCouncilProposal(ctx, msg.GetContent(), msg.GetProposer())
+	case msg.GetIsEmergency():
+		proposal, err = k.Keeper.SubmitEmergencyProposal(ctx, msg.GetContent())
+	case len(msg.GetChoices()) > 0:
+		proposal, err = k.Keeper.SubmitMultipleChoiceProposal(ctx, msg.GetContent(), msg.GetChoices())
+	default:
+		proposal, err = k.Keeper.SubmitProposal(ctx, msg.GetContent())
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	if err := k.Keeper.ValidateProposalDependencies(ctx, proposal.ProposalId, msg.GetDependsOn()); err != nil {
+		return nil, err
+	}
+
 	defer telemetry.IncrCounter(1, types.ModuleName, "proposal")
 
+	// record the proposer, which may cancel the proposal, and the proposals
+	// whose execution the proposal waits for
+	proposal.Proposer = msg.Proposer
+	proposal.DependsOn = msg.GetDependsOn()
+	k.Keeper.SetProposal(ctx, proposal)
+
 	votingStarted, err := k.Keeper.AddDeposit(ctx, proposal.ProposalId, msg.GetProposer(), msg.GetInitialDeposit())
 	if err != nil {
 		return nil, err
@@ -47,6 +83,24 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *types.MsgSubmitPro
 	)
 
 	submitEvent := sdk.NewEvent(types.EventTypeSubmitProposal, sdk.NewAttribute(types.AttributeKeyProposalType, msg.GetContent().ProposalType()))
+	if !fee.IsZero() {
+		submitEvent = submitEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeySubmissionFee, fee.String()))
+	}
+	if proposal.IsExpedited {
+		submitEvent = submitEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeyIsExpedited, "true"))
+	}
+	if proposal.IsOptimistic {
+		submitEvent = submitEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeyIsOptimistic, "true"))
+	}
+	if proposal.IsPrivate {
+		submitEvent = submitEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeyIsPrivate, "true"))
+	}
+	if proposal.IsCouncil {
+		submitEvent = submitEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeyIsCouncil, "true"))
+	}
+	if proposal.IsEmergency {
+		submitEvent = submitEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeyIsEmergency, "true"))
+	}
 	if votingStarted {
 		submitEvent = submitEvent.AppendAttributes(
 			sdk.NewAttribute(types.AttributeKeyVotingPeriodStart, fmt.Sprintf("%d", proposal.ProposalId)),
@@ -65,7 +119,7 @@ func (k msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVo
 	if accErr != nil {
 		return nil, accErr
 	}
-	err := k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, types.NewNonSplitVoteOption(msg.Option))
+	err := k.Keeper.AddVoteWithMetadata(ctx, msg.ProposalId, accAddr, types.NewNonSplitVoteOption(msg.Option), msg.Metadata)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +149,7 @@ func (k msgServer) VoteWeighted(goCtx context.Context, msg *types.MsgVoteWeighte
 	if accErr != nil {
 		return nil, accErr
 	}
-	err := k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, msg.Options)
+	err := k.Keeper.AddVoteWithMetadata(ctx, msg.ProposalId, accAddr, msg.Options, msg.Metadata)
 	if err != nil {
 		return nil, err
 	}
@@ -157,3 +211,204 @@ func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 
 	return &types.MsgDepositResponse{}, nil
 }
+
+func (k msgServer) CancelProposal(goCtx context.Context, msg *types.MsgCancelProposal) (*types.MsgCancelProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	accAddr, err := sdk.AccAddressFromBech32(msg.Proposer)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.CancelProposal(ctx, msg.ProposalId, accAddr); err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounter(1, types.ModuleName, "cancel_proposal")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer),
+		),
+	)
+
+	return &types.MsgCancelProposalResponse{}, nil
+}
+
+func (k msgServer) RetryProposalExecution(goCtx context.Context, msg *types.MsgRetryProposalExecution) (*types.MsgRetryProposalExecutionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := sdk.AccAddressFromBech32(msg.Executor); err != nil {
+		return nil, err
+	}
+	// only governance can retry the execution of the content it approved
+	if authority := k.authKeeper.GetModuleAddress(types.ModuleName).String(); msg.Executor != authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected gov module account %s, got %s", authority, msg.Executor)
+	}
+	proposal, err := k.Keeper.RetryProposalExecution(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounter(1, types.ModuleName, "retry_proposal_execution")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Executor),
+		),
+	)
+
+	return &types.MsgRetryProposalExecutionResponse{Status: proposal.Status}, nil
+}
+
+func (k msgServer) AnchorDiscussion(goCtx context.Context, msg *types.MsgAnchorDiscussion) (*types.MsgAnchorDiscussionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	accAddr, err := sdk.AccAddressFromBech32(msg.Author)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.AnchorDiscussion(ctx, msg.ProposalId, accAddr, msg.Hash, msg.Uri); err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounter(1, types.ModuleName, "anchor_discussion")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Author),
+		),
+	)
+
+	return &types.MsgAnchorDiscussionResponse{}, nil
+}
+
+func (k msgServer) VoteOption(goCtx context.Context, msg *types.MsgVoteOption) (*types.MsgVoteOptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	accAddr, accErr := sdk.AccAddressFromBech32(msg.Voter)
+	if accErr != nil {
+		return nil, accErr
+	}
+	err := k.Keeper.AddChoiceVote(ctx, msg.ProposalId, accAddr, msg.OptionIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "vote"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("proposal_id", strconv.Itoa(int(msg.ProposalId))),
+		},
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter),
+		),
+	)
+
+	return &types.MsgVoteOptionResponse{}, nil
+}
+
+func (k msgServer) CommitVote(goCtx context.Context, msg *types.MsgCommitVote) (*types.MsgCommitVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	accAddr, accErr := sdk.AccAddressFromBech32(msg.Voter)
+	if accErr != nil {
+		return nil, accErr
+	}
+	err := k.Keeper.CommitVote(ctx, msg.ProposalId, accAddr, msg.Commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter),
+		),
+	)
+
+	return &types.MsgCommitVoteResponse{}, nil
+}
+
+func (k msgServer) RevealVote(goCtx context.Context, msg *types.MsgRevealVote) (*types.MsgRevealVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	accAddr, accErr := sdk.AccAddressFromBech32(msg.Voter)
+	if accErr != nil {
+		return nil, accErr
+	}
+	err := k.Keeper.RevealVote(ctx, msg.ProposalId, accAddr, msg.Options, msg.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "vote"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("proposal_id", strconv.Itoa(int(msg.ProposalId))),
+		},
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter),
+		),
+	)
+
+	return &types.MsgRevealVoteResponse{}, nil
+}
+
+func (k msgServer) SetGovernor(goCtx context.Context, msg *types.MsgSetGovernor) (*types.MsgSetGovernorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	delegatorAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	governorAddr, err := sdk.AccAddressFromBech32(msg.GovernorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.SetGovernor(ctx, delegatorAddr, governorAddr); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	)
+
+	return &types.MsgSetGovernorResponse{}, nil
+}
+
+func (k msgServer) RemoveGovernor(goCtx context.Context, msg *types.MsgRemoveGovernor) (*types.MsgRemoveGovernorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	delegatorAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.RemoveGovernor(ctx, delegatorAddr); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	)
+
+	return &types.MsgRemoveGovernorResponse{}, nil
+}
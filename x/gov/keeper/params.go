@@ -26,6 +26,26 @@ func (keeper Keeper) GetTallyParams(ctx sdk.Context) types.TallyParams {
 	return tallyParams
 }
 
+// GetProposalTemplates returns the registered proposal templates from the
+// global param store
+func (keeper Keeper) GetProposalTemplates(ctx sdk.Context) []types.ProposalTemplate {
+	var templates []types.ProposalTemplate
+	keeper.paramSpace.GetIfExists(ctx, types.ParamStoreKeyProposalTemplates, &templates)
+	return templates
+}
+
+// GetProposalTemplate returns the proposal template registered under the
+// given name
+func (keeper Keeper) GetProposalTemplate(ctx sdk.Context, name string) (types.ProposalTemplate, bool) {
+	for _, t := range keeper.GetProposalTemplates(ctx) {
+		if t.Name == name {
+			return t, true
+		}
+	}
+
+	return types.ProposalTemplate{}, false
+}
+
 // SetDepositParams sets DepositParams to the global param store
 func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams types.DepositParams) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &depositParams)
@@ -40,3 +60,8 @@ func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams types.VotingP
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams types.TallyParams) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
 }
+
+// SetProposalTemplates sets the proposal templates to the global param store
+func (keeper Keeper) SetProposalTemplates(ctx sdk.Context, templates []types.ProposalTemplate) {
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeyProposalTemplates, &templates)
+}
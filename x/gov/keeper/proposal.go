@@ -2,6 +2,9 @@ package keeper
 
 import (
 	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,17 +14,158 @@ import (
 
 // SubmitProposal create new proposal given a content
 func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
-	if !keeper.router.HasRoute(content.ProposalRoute()) {
-		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
+	return keeper.submitProposal(ctx, content, nil, false, false, false)
+}
+
+// SubmitMultipleChoiceProposal creates a new multiple-choice proposal given a
+// content describing it and its choices. It is voted on with choice votes and
+// executes the content of the winning choice if it passes.
+func (keeper Keeper) SubmitMultipleChoiceProposal(ctx sdk.Context, content types.Content, choices []types.ProposalChoice) (types.Proposal, error) {
+	if err := types.ValidateProposalChoices(choices); err != nil {
+		return types.Proposal{}, err
 	}
 
-	// Execute the proposal content in a new context branch (with branched store)
-	// to validate the actual parameter changes before the proposal proceeds
-	// through the governance process. State is not persisted.
-	cacheCtx, _ := ctx.CacheContext()
-	handler := keeper.router.GetRoute(content.ProposalRoute())
-	if err := handler(cacheCtx, content); err != nil {
-		return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
+	return keeper.submitProposal(ctx, content, choices, false, false, false)
+}
+
+// SubmitExpeditedProposal creates a new proposal given a content on the
+// expedited track, which has a shorter voting period and a higher quorum and
+// threshold. It fails if expedited proposals are disabled.
+func (keeper Keeper) SubmitExpeditedProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
+	if keeper.GetVotingParams(ctx).ExpeditedVotingPeriod <= 0 {
+		return types.Proposal{}, types.ErrExpeditedDisabled
+	}
+
+	return keeper.submitProposal(ctx, content, nil, true, false, false)
+}
+
+// SubmitOptimisticProposal creates a new proposal given a content on the
+// optimistic track, which passes at the end of a challenge window unless it is
+// vetoed. It fails if optimistic proposals are disabled or if the proposer is
+// not one of the optimistic authorized addresses.
+func (keeper Keeper) SubmitOptimisticProposal(ctx sdk.Context, content types.Content, proposer sdk.AccAddress) (types.Proposal, error) {
+	votingParams := keeper.GetVotingParams(ctx)
+	if votingParams.OptimisticVotingPeriod <= 0 {
+		return types.Proposal{}, types.ErrOptimisticDisabled
+	}
+
+	if !votingParams.IsOptimisticAuthorized(proposer) {
+		return types.Proposal{}, sdkerrors.Wrapf(types.ErrUnauthorizedOptimistic, "%s", proposer)
+	}
+
+	return keeper.submitProposal(ctx, content, nil, false, true, false)
+}
+
+// SubmitCouncilProposal creates a new proposal given a content on the council
+// track, on which only the council members vote and a simple majority of the
+// council passes the proposal. Its voting period starts right away, without
+// waiting for deposits. It fails if council proposals are disabled, if the
+// proposer is not a council member or if the council cannot pass proposals
+// with the type of the content.
+func (keeper Keeper) SubmitCouncilProposal(ctx sdk.Context, content types.Content, proposer sdk.AccAddress) (types.Proposal, error) {
+	votingParams := keeper.GetVotingParams(ctx)
+	if votingParams.CouncilVotingPeriod <= 0 {
+		return types.Proposal{}, types.ErrCouncilDisabled
+	}
+
+	if !votingParams.IsCouncilMember(proposer) {
+		return types.Proposal{}, sdkerrors.Wrapf(types.ErrNotCouncilMember, "%s", proposer)
+	}
+
+	msg, ok := content.(proto.Message)
+	if !ok {
+		return types.Proposal{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "%T does not implement proto.Message", content)
+	}
+	if typeURL := "/" + proto.MessageName(msg); !votingParams.IsCouncilProposalType(typeURL) {
+		return types.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidCouncilProposal, "%s", typeURL)
+	}
+
+	proposal, err := keeper.submitProposal(ctx, content, nil, false, false, false)
+	if err != nil {
+		return types.Proposal{}, err
+	}
+
+	proposal.IsCouncil = true
+	keeper.ActivateVotingPeriod(ctx, proposal)
+
+	proposal, _ = keeper.GetProposal(ctx, proposal.ProposalId)
+	return proposal, nil
+}
+
+// SubmitPrivateProposal creates a new private proposal given a content. It is
+// voted on with vote commitments which are revealed in a reveal period
+// following the voting period, before the proposal is tallied. It fails if
+// private proposals are disabled.
+func (keeper Keeper) SubmitPrivateProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
+	if keeper.GetVotingParams(ctx).RevealPeriod <= 0 {
+		return types.Proposal{}, types.ErrPrivateDisabled
+	}
+
+	return keeper.submitProposal(ctx, content, nil, false, false, true)
+}
+
+// SubmitModuleProposal creates a new proposal given a content on behalf of a
+// module, letting other modules submit proposals programmatically. The module
+// account is the proposer: it pays the submission fee, if any, and the initial
+// deposit, which is refunded to it like any other deposit. The proposal is
+// only submitted if the initial deposit meets the minimum initial deposit.
+func (keeper Keeper) SubmitModuleProposal(ctx sdk.Context, moduleName string, content types.Content, initialDeposit sdk.Coins) (types.Proposal, error) {
+	if keeper.authKeeper.GetModuleAddress(moduleName) == nil {
+		return types.Proposal{}, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleName)
+	}
+	proposer := keeper.authKeeper.GetModuleAccount(ctx, moduleName).GetAddress()
+
+	if err := keeper.ValidateInitialDeposit(ctx, initialDeposit); err != nil {
+		return types.Proposal{}, err
+	}
+
+	// submit the proposal in a cache context so that a failing deposit does
+	// not leave behind a charged fee or a proposal without deposit
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	if _, err := keeper.ChargeSubmissionFee(cacheCtx, proposer); err != nil {
+		return types.Proposal{}, err
+	}
+
+	proposal, err := keeper.SubmitProposal(cacheCtx, content)
+	if err != nil {
+		return types.Proposal{}, err
+	}
+
+	proposal.Proposer = proposer.String()
+	keeper.SetProposal(cacheCtx, proposal)
+
+	if _, err := keeper.AddDeposit(cacheCtx, proposal.ProposalId, proposer, initialDeposit); err != nil {
+		return types.Proposal{}, err
+	}
+
+	writeCache()
+
+	// tell the proposer module in the submission event
+	events := cacheCtx.EventManager().Events()
+	for i, event := range events {
+		if event.Type == types.EventTypeSubmitProposal {
+			events[i] = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyProposerModule, moduleName))
+		}
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	// the deposit may have started the voting period
+	proposal, _ = keeper.GetProposal(ctx, proposal.ProposalId)
+	return proposal, nil
+}
+
+func (keeper Keeper) submitProposal(ctx sdk.Context, content types.Content, choices []types.ProposalChoice, isExpedited, isOptimistic, isPrivate bool) (types.Proposal, error) {
+	if err := keeper.validateContent(ctx, content); err != nil {
+		return types.Proposal{}, err
+	}
+
+	for _, choice := range choices {
+		if choiceContent := choice.GetContent(); choiceContent != nil {
+			if err := keeper.validateContent(ctx, choiceContent); err != nil {
+				return types.Proposal{}, err
+			}
+		}
 	}
 
 	proposalID, err := keeper.GetProposalID(ctx)
@@ -36,6 +180,10 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	if err != nil {
 		return types.Proposal{}, err
 	}
+	proposal.IsExpedited = isExpedited
+	proposal.IsOptimistic = isOptimistic
+	proposal.IsPrivate = isPrivate
+	proposal.Choices = choices
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
@@ -54,6 +202,25 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	return proposal, nil
 }
 
+// validateContent checks that a handler is registered for the route of a
+// proposal content and that the content executes successfully.
+func (keeper Keeper) validateContent(ctx sdk.Context, content types.Content) error {
+	if !keeper.router.HasRoute(content.ProposalRoute()) {
+		return sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
+	}
+
+	// Execute the proposal content in a new context branch (with branched store)
+	// to validate the actual parameter changes before the proposal proceeds
+	// through the governance process. State is not persisted.
+	cacheCtx, _ := ctx.CacheContext()
+	handler := keeper.router.GetRoute(content.ProposalRoute())
+	if err := handler(cacheCtx, content); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
+	}
+
+	return nil
+}
+
 // GetProposal get proposal from store by ProposalID
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, bool) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -87,9 +254,54 @@ func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
 	}
 	keeper.RemoveFromInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
 	keeper.RemoveFromActiveProposalQueue(ctx, proposalID, proposal.VotingEndTime)
+	keeper.RemoveFromOptimisticProposalQueue(ctx, proposalID, proposal.VotingEndTime)
+	keeper.RemoveFromRevealProposalQueue(ctx, proposalID, proposal.RevealEndTime)
+	keeper.RemoveFromCouncilProposalQueue(ctx, proposalID, proposal.VotingEndTime)
+	keeper.RemoveFromEmergencyProposalQueue(ctx, proposalID)
 	store.Delete(types.ProposalKey(proposalID))
 }
 
+// CancelProposal cancels a proposal in its deposit or voting period on behalf
+// of its proposer. The CancelBurnRatio fraction of each deposit is burned and
+// the rest is refunded to its depositor. The proposal is deleted along with
+// its votes and voting power snapshot, and the deposits of its vote
+// commitments are refunded.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	if proposal.Status != types.StatusDepositPeriod && proposal.Status != types.StatusVotingPeriod {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	if proposal.Proposer == "" || proposal.Proposer != proposer.String() {
+		return sdkerrors.Wrapf(types.ErrUnauthorizedCancel, "%s is not the proposer of proposal %d", proposer, proposalID)
+	}
+
+	burned, refunded := keeper.BurnAndRefundDeposits(ctx, proposalID, keeper.GetDepositParams(ctx).CancelBurnRatio)
+	keeper.deleteVotes(ctx, proposalID)
+	keeper.deleteChoiceVotes(ctx, proposalID)
+	keeper.RefundAndDeleteVoteCommitments(ctx, proposalID)
+	keeper.DeleteVotingPowerSnapshot(ctx, proposalID)
+	keeper.DeleteProposal(ctx, proposalID)
+
+	// called when the proposal is canceled, however it may not be active
+	keeper.AfterProposalCanceled(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyBurnedDeposit, burned.String()),
+			sdk.NewAttribute(types.AttributeKeyRefundedDeposit, refunded.String()),
+		),
+	)
+
+	return nil
+}
+
 // IterateProposals iterates over the all the proposals and performs a callback function
 func (keeper Keeper) IterateProposals(ctx sdk.Context, cb func(proposal types.Proposal) (stop bool)) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -183,15 +395,209 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 	store.Set(types.ProposalIDKey, types.GetProposalIDBytes(proposalID))
 }
 
+// ActivateVotingPeriod starts the voting period of a proposal and snapshots the
+// voting power it is tallied with. An optimistic proposal is put in the
+// optimistic proposal queue for its challenge window, in which all accounts can
+// veto it; it falls back to the regular track if optimistic proposals have been
+// disabled since its submission. A council proposal is put in the council
+// proposal queue and is tallied with the votes of the council members, so no
+// voting power is snapshotted for it. An emergency proposal is also put in the
+// emergency proposal queue, in which its validator support is checked at the
+// end of every block.
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingParams := keeper.GetVotingParams(ctx)
+	if proposal.IsOptimistic && votingParams.OptimisticVotingPeriod <= 0 {
+		proposal.IsOptimistic = false
+	}
+
+	votingPeriod := votingParams.VotingPeriod
+	switch {
+	case proposal.IsExpedited:
+		votingPeriod = votingParams.ExpeditedVotingPeriod
+	case proposal.IsOptimistic:
+		votingPeriod = votingParams.OptimisticVotingPeriod
+	case proposal.IsCouncil:
+		votingPeriod = votingParams.CouncilVotingPeriod
+	}
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
+	if votingParams.ValidatorVotingPeriod > 0 && !proposal.IsOptimistic && !proposal.IsCouncil {
+		proposal.ValidatorVotingEndTime = proposal.VotingStartTime.Add(votingParams.ValidatorVotingPeriod)
+	}
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
+	if !proposal.IsCouncil {
+		keeper.SnapshotVotingPower(ctx, proposal.ProposalId)
+	}
 
 	keeper.RemoveFromInactiveProposalQueue(ctx, proposal.ProposalId, proposal.DepositEndTime)
+	switch {
+	case proposal.IsOptimistic:
+		keeper.InsertOptimisticProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+	case proposal.IsCouncil:
+		keeper.InsertCouncilProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+	default:
+		keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+	}
+	if proposal.IsEmergency {
+		keeper.InsertEmergencyProposalQueue(ctx, proposal.ProposalId)
+	}
+}
+
+// ExtendVotingPeriod extends the voting period of a proposal by the quorum
+// extension period if quorum has not been reached by its voting end time but
+// the participation is rising, that is if the voting power which participated
+// in the vote exceeds that of its participation checkpoint, taken one quorum
+// extension period before. Without a checkpoint, any participation counts as
+// rising. A proposal's voting period is extended at most once and only if the
+// quorum extension period is positive. Expedited proposals are not extended, as
+// they fall back to a regular voting period instead. It returns true if the
+// voting period has been extended.
+func (keeper Keeper) ExtendVotingPeriod(ctx sdk.Context, proposal types.Proposal) bool {
+	extension := keeper.GetVotingParams(ctx).QuorumExtensionPeriod
+	if extension <= 0 || proposal.IsExpedited || proposal.VotingPeriodExtended {
+		return false
+	}
+
+	totalVotingPower, totalBonded := keeper.participation(ctx, proposal)
+	quorum, _ := keeper.GetTallyParams(ctx).QuorumAndThreshold(proposal.IsExpedited)
+	if quorumReached(totalVotingPower, totalBonded, quorum) {
+		return false
+	}
+
+	checkpointVotingPower := keeper.checkpointVotingPower(ctx, proposal.ProposalId)
+	if !totalVotingPower.GT(checkpointVotingPower) {
+		return false
+	}
+
+	keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+	proposal.VotingEndTime = proposal.VotingEndTime.Add(extension)
+	proposal.VotingPeriodExtended = true
+	keeper.SetProposal(ctx, proposal)
+
+	keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVotingPeriodExtended,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyVotingPeriodEnd, proposal.VotingEndTime.Format(time.RFC3339Nano)),
+			sdk.NewAttribute(types.AttributeKeyCheckpointVotingPower, checkpointVotingPower.String()),
+			sdk.NewAttribute(types.AttributeKeyVotingPower, totalVotingPower.String()),
+		),
+	)
+
+	return true
+}
+
+// FallBackExpeditedProposal moves an expedited proposal which does not pass
+// with the expedited quorum and threshold by its voting end time to the regular
+// track: its voting period is extended to the regular voting period counted
+// from its voting start time, and the votes cast so far are kept. It returns
+// true if the proposal fell back.
+func (keeper Keeper) FallBackExpeditedProposal(ctx sdk.Context, proposal types.Proposal) bool {
+	if !proposal.IsExpedited {
+		return false
+	}
+
+	results, totalVotingPower, totalBonded := keeper.tallyVotes(ctx, proposal, false)
+	if passes, _ := keeper.tallyOutcome(ctx, proposal, results, totalVotingPower, totalBonded); passes {
+		return false
+	}
+
+	keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+	proposal.IsExpedited = false
+	proposal.VotingEndTime = proposal.VotingStartTime.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+	keeper.SetProposal(ctx, proposal)
+
 	keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExpeditedFallback,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyVotingPeriodEnd, proposal.VotingEndTime.Format(time.RFC3339Nano)),
+		),
+	)
+
+	return true
+}
+
+// ExecutionGasMeter returns the gas meter the content of a passed proposal is
+// executed with, limited to the ExecutionGasLimit voting param if it is set.
+func (keeper Keeper) ExecutionGasMeter(ctx sdk.Context) sdk.GasMeter {
+	if limit := keeper.GetVotingParams(ctx).ExecutionGasLimit; limit > 0 {
+		return sdk.NewGasMeter(limit)
+	}
+
+	return sdk.NewInfiniteGasMeter()
+}
+
+// ExecuteProposal executes the content of a passed proposal with its handler.
+// Running out of gas makes the execution fail with an error wrapping
+// sdkerrors.ErrOutOfGas rather than panicking.
+func (keeper Keeper) ExecuteProposal(ctx sdk.Context, proposal types.Proposal) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %s", oog.Descriptor)
+		}
+	}()
+
+	// the winning choice of a multiple-choice proposal may not change the state
+	content := proposal.ExecutableContent()
+	if content == nil {
+		return nil
+	}
+
+	handler := keeper.router.GetRoute(content.ProposalRoute())
+	return handler(ctx, content)
+}
+
+// SimulateProposalExecution executes the content of a proposal as if it passed at the
+// end of its voting period, in a branch of the state which is discarded. It
+// returns the events emitted and the gas consumed by the execution, or the
+// error the execution fails with, a panic of the handler being returned as an
+// error. The events of a failed execution are discarded, as in the EndBlocker.
+// The execution is limited to the ExecutionGasLimit voting param.
+func (keeper Keeper) SimulateProposalExecution(ctx sdk.Context, proposal types.Proposal) (events sdk.Events, gasUsed uint64, err error) {
+	cacheCtx, _ := ctx.WithBlockTime(proposal.VotingEndTime).WithGasMeter(keeper.ExecutionGasMeter(ctx)).CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			events, gasUsed, err = nil, cacheCtx.GasMeter().GasConsumed(), fmt.Errorf("proposal execution panicked: %v", r)
+		}
+	}()
+
+	if err := keeper.ExecuteProposal(cacheCtx, proposal); err != nil {
+		return nil, cacheCtx.GasMeter().GasConsumed(), err
+	}
+
+	return cacheCtx.EventManager().Events(), cacheCtx.GasMeter().GasConsumed(), nil
+}
+
+// SimulateContentExecution executes the content of a proposal which isn't
+// submitted yet as SimulateProposalExecution does, as if the proposal entered
+// its voting period at the current block time and passed at the end of a
+// regular voting period.
+func (keeper Keeper) SimulateContentExecution(ctx sdk.Context, content types.Content) (events sdk.Events, gasUsed uint64, err error) {
+	now := ctx.BlockHeader().Time
+	proposal, err := types.NewProposal(content, 0, now, now)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	proposal.VotingStartTime = now
+	proposal.VotingEndTime = now.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+	proposal.Status = types.StatusVotingPeriod
+
+	return keeper.SimulateProposalExecution(ctx, proposal)
 }
 
 func (keeper Keeper) MarshalProposal(proposal types.Proposal) ([]byte, error) {
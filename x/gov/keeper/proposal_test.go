@@ -7,7 +7,11 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
@@ -44,6 +48,81 @@ func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	activeIterator.Close()
 }
 
+func (suite *KeeperTestSuite) TestExtendVotingPeriod() {
+	addrs, _ := createValidators(suite.T(), suite.ctx, suite.app, []int64{2, 2, 10})
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal)
+	suite.Require().NoError(err)
+
+	suite.app.GovKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+	proposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposal.ProposalId)
+	suite.Require().True(ok)
+	votingEndTime := proposal.VotingEndTime
+
+	extension := 2 * time.Hour
+	votingParams := suite.app.GovKeeper.GetVotingParams(suite.ctx)
+	votingParams.QuorumExtensionPeriod = extension
+	suite.app.GovKeeper.SetVotingParams(suite.ctx, votingParams)
+
+	// the voting period is not extended without any participation
+	suite.Require().False(suite.app.GovKeeper.ExtendVotingPeriod(suite.ctx, proposal))
+
+	// the voting period is not extended if the participation hasn't risen since
+	// the checkpoint
+	suite.Require().NoError(suite.app.GovKeeper.AddVote(suite.ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	suite.Require().True(suite.app.GovKeeper.CheckpointParticipation(suite.ctx, proposal))
+	suite.Require().False(suite.app.GovKeeper.ExtendVotingPeriod(suite.ctx, proposal))
+
+	// disabled by default
+	votingParams.QuorumExtensionPeriod = 0
+	suite.app.GovKeeper.SetVotingParams(suite.ctx, votingParams)
+	suite.Require().NoError(suite.app.GovKeeper.AddVote(suite.ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
+	suite.Require().False(suite.app.GovKeeper.QuorumReached(suite.ctx, proposal))
+	suite.Require().False(suite.app.GovKeeper.ExtendVotingPeriod(suite.ctx, proposal))
+
+	votingParams.QuorumExtensionPeriod = extension
+	suite.app.GovKeeper.SetVotingParams(suite.ctx, votingParams)
+	suite.Require().True(suite.app.GovKeeper.ExtendVotingPeriod(suite.ctx, proposal))
+
+	proposal, ok = suite.app.GovKeeper.GetProposal(suite.ctx, proposal.ProposalId)
+	suite.Require().True(ok)
+	suite.Require().True(proposal.VotingPeriodExtended)
+	suite.Require().Equal(votingEndTime.Add(extension), proposal.VotingEndTime)
+
+	activeIterator := suite.app.GovKeeper.ActiveProposalQueueIterator(suite.ctx, votingEndTime)
+	suite.Require().False(activeIterator.Valid())
+	activeIterator.Close()
+
+	activeIterator = suite.app.GovKeeper.ActiveProposalQueueIterator(suite.ctx, proposal.VotingEndTime)
+	suite.Require().True(activeIterator.Valid())
+	suite.Require().Equal(proposal.ProposalId, types.GetProposalIDFromBytes(activeIterator.Value()))
+	activeIterator.Close()
+
+	// the voting period is only extended once
+	suite.Require().False(suite.app.GovKeeper.ExtendVotingPeriod(suite.ctx, proposal))
+
+	// without a checkpoint, any participation counts as rising
+	proposal, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal)
+	suite.Require().NoError(err)
+	suite.app.GovKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+
+	suite.Require().NoError(suite.app.GovKeeper.AddVote(suite.ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	proposal, ok = suite.app.GovKeeper.GetProposal(suite.ctx, proposal.ProposalId)
+	suite.Require().True(ok)
+	suite.Require().True(suite.app.GovKeeper.ExtendVotingPeriod(suite.ctx, proposal))
+
+	// the voting period is not extended if quorum has been reached, with the
+	// stake bonded when the voting period started
+	proposal, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal)
+	suite.Require().NoError(err)
+	suite.app.GovKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+
+	suite.Require().NoError(suite.app.GovKeeper.AddVote(suite.ctx, proposal.ProposalId, addrs[2], types.NewNonSplitVoteOption(types.OptionYes)))
+	proposal, ok = suite.app.GovKeeper.GetProposal(suite.ctx, proposal.ProposalId)
+	suite.Require().True(ok)
+	suite.Require().True(suite.app.GovKeeper.QuorumReached(suite.ctx, proposal))
+	suite.Require().False(suite.app.GovKeeper.ExtendVotingPeriod(suite.ctx, proposal))
+}
+
 type invalidProposalRoute struct{ types.TextProposal }
 
 func (invalidProposalRoute) ProposalRoute() string { return "nonexistingroute" }
@@ -61,12 +140,225 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 		{&types.TextProposal{Title: "title", Description: strings.Repeat("1234567890", 1000)}, nil},
 		// error only when invalid route
 		{&invalidProposalRoute{}, types.ErrNoProposalHandlerExists},
+		// or when the proposal would fail on execution
+		{proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+			proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "-"),
+		}), types.ErrInvalidProposalContent},
+		{proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+			proposal.NewParamChange(stakingtypes.ModuleName, "UnknownKey", "1"),
+		}), types.ErrInvalidProposalContent},
 	}
 
 	for i, tc := range testCases {
 		_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tc.content)
-		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
+		suite.Require().True(errors.Is(err, tc.expectedErr), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
+	}
+}
+
+func (suite *KeeperTestSuite) TestSubmitExpeditedProposal() {
+	proposal, err := suite.app.GovKeeper.SubmitExpeditedProposal(suite.ctx, TestProposal)
+	suite.Require().NoError(err)
+	suite.Require().True(proposal.IsExpedited)
+
+	suite.app.GovKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+	proposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposal.ProposalId)
+	suite.Require().True(ok)
+	votingParams := suite.app.GovKeeper.GetVotingParams(suite.ctx)
+	suite.Require().Equal(proposal.VotingStartTime.Add(votingParams.ExpeditedVotingPeriod), proposal.VotingEndTime)
+
+	// expedited proposals are disabled by a zero expedited voting period
+	votingParams.ExpeditedVotingPeriod = 0
+	suite.app.GovKeeper.SetVotingParams(suite.ctx, votingParams)
+	_, err = suite.app.GovKeeper.SubmitExpeditedProposal(suite.ctx, TestProposal)
+	suite.Require().ErrorIs(err, types.ErrExpeditedDisabled)
+}
+
+func (suite *KeeperTestSuite) TestSubmitOptimisticProposal() {
+	authority := suite.addrs[0]
+
+	// optimistic proposals are disabled by default
+	_, err := suite.app.GovKeeper.SubmitOptimisticProposal(suite.ctx, TestProposal, authority)
+	suite.Require().ErrorIs(err, types.ErrOptimisticDisabled)
+
+	votingParams := suite.app.GovKeeper.GetVotingParams(suite.ctx)
+	votingParams.OptimisticVotingPeriod = time.Hour
+	votingParams.OptimisticAuthorizedAddresses = []string{authority.String()}
+	suite.app.GovKeeper.SetVotingParams(suite.ctx, votingParams)
+
+	_, err = suite.app.GovKeeper.SubmitOptimisticProposal(suite.ctx, TestProposal, suite.addrs[1])
+	suite.Require().ErrorIs(err, types.ErrUnauthorizedOptimistic)
+
+	proposal, err := suite.app.GovKeeper.SubmitOptimisticProposal(suite.ctx, TestProposal, authority)
+	suite.Require().NoError(err)
+	suite.Require().True(proposal.IsOptimistic)
+
+	suite.app.GovKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+	proposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposal.ProposalId)
+	suite.Require().True(ok)
+	suite.Require().Equal(proposal.VotingStartTime.Add(time.Hour), proposal.VotingEndTime)
+
+	// the proposal is removed from the optimistic queue when deleted
+	suite.app.GovKeeper.DeleteProposal(suite.ctx, proposal.ProposalId)
+	optimisticQueue := suite.app.GovKeeper.OptimisticProposalQueueIterator(suite.ctx, proposal.VotingEndTime)
+	suite.Require().False(optimisticQueue.Valid())
+	optimisticQueue.Close()
+}
+
+func (suite *KeeperTestSuite) TestSubmitCouncilProposal() {
+	member := suite.addrs[0]
+
+	// council proposals are disabled by default
+	_, err := suite.app.GovKeeper.SubmitCouncilProposal(suite.ctx, TestProposal, member)
+	suite.Require().ErrorIs(err, types.ErrCouncilDisabled)
+
+	votingParams := suite.app.GovKeeper.GetVotingParams(suite.ctx)
+	votingParams.CouncilVotingPeriod = time.Hour
+	votingParams.CouncilMembers = []string{member.String()}
+	suite.app.GovKeeper.SetVotingParams(suite.ctx, votingParams)
+
+	_, err = suite.app.GovKeeper.SubmitCouncilProposal(suite.ctx, TestProposal, suite.addrs[1])
+	suite.Require().ErrorIs(err, types.ErrNotCouncilMember)
+
+	_, err = suite.app.GovKeeper.SubmitCouncilProposal(suite.ctx, TestProposal, member)
+	suite.Require().ErrorIs(err, types.ErrInvalidCouncilProposal)
+
+	votingParams.CouncilProposalTypes = []string{"/cosmos.gov.v1beta1.TextProposal"}
+	suite.app.GovKeeper.SetVotingParams(suite.ctx, votingParams)
+
+	// the voting period starts right away
+	proposal, err := suite.app.GovKeeper.SubmitCouncilProposal(suite.ctx, TestProposal, member)
+	suite.Require().NoError(err)
+	suite.Require().True(proposal.IsCouncil)
+	suite.Require().Equal(types.StatusVotingPeriod, proposal.Status)
+	suite.Require().Equal(proposal.VotingStartTime.Add(time.Hour), proposal.VotingEndTime)
+
+	// only the council members can vote
+	err = suite.app.GovKeeper.AddVote(suite.ctx, proposal.ProposalId, suite.addrs[1], types.NewNonSplitVoteOption(types.OptionYes))
+	suite.Require().ErrorIs(err, types.ErrNotCouncilMember)
+	suite.Require().NoError(suite.app.GovKeeper.AddVote(suite.ctx, proposal.ProposalId, member, types.NewNonSplitVoteOption(types.OptionYes)))
+
+	councilQueue := suite.app.GovKeeper.CouncilProposalQueueIterator(suite.ctx, proposal.VotingEndTime)
+	suite.Require().True(councilQueue.Valid())
+	councilQueue.Close()
+
+	// the proposal is removed from the council queue when deleted
+	suite.app.GovKeeper.DeleteProposal(suite.ctx, proposal.ProposalId)
+	councilQueue = suite.app.GovKeeper.CouncilProposalQueueIterator(suite.ctx, proposal.VotingEndTime)
+	suite.Require().False(councilQueue.Valid())
+	councilQueue.Close()
+}
+
+func (suite *KeeperTestSuite) TestSubmitModuleProposal() {
+	ctx := suite.ctx
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, deposit.Add(deposit...)))
+	moduleBalance := suite.app.BankKeeper.GetAllBalances(ctx, moduleAddr)
+
+	_, err := suite.app.GovKeeper.SubmitModuleProposal(ctx, "unknown", TestProposal, deposit)
+	suite.Require().Error(err)
+
+	// the submission fails without side effects if the deposit can't be paid
+	_, err = suite.app.GovKeeper.SubmitModuleProposal(ctx, minttypes.ModuleName, TestProposal, moduleBalance.Add(deposit...))
+	suite.Require().Error(err)
+	suite.Require().Empty(suite.app.GovKeeper.GetProposals(ctx))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	proposal, err := suite.app.GovKeeper.SubmitModuleProposal(ctx, minttypes.ModuleName, TestProposal, deposit)
+	suite.Require().NoError(err)
+	suite.Require().Equal(moduleAddr.String(), proposal.Proposer)
+
+	// a single submission event tells the proposer module
+	var submitEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSubmitProposal {
+			submitEvents = append(submitEvents, event)
+		}
+	}
+	suite.Require().Len(submitEvents, 1)
+	suite.Require().Equal(types.AttributeKeyProposerModule, string(submitEvents[0].Attributes[1].Key))
+	suite.Require().Equal(minttypes.ModuleName, string(submitEvents[0].Attributes[1].Value))
+	suite.Require().Equal(types.StatusDepositPeriod, proposal.Status)
+	suite.Require().Equal(deposit, proposal.TotalDeposit)
+	suite.Require().Equal(moduleBalance.Sub(deposit), suite.app.BankKeeper.GetAllBalances(ctx, moduleAddr))
+
+	// the deposit is refunded to the module account
+	suite.app.GovKeeper.RefundAndDeleteDeposits(ctx, proposal.ProposalId)
+	suite.Require().Equal(moduleBalance, suite.app.BankKeeper.GetAllBalances(ctx, moduleAddr))
+}
+
+func (suite *KeeperTestSuite) TestCancelProposal() {
+	ctx := suite.ctx
+	msgServer := keeper.NewMsgServerImpl(suite.app.GovKeeper)
+	proposer, depositor := suite.addrs[0], suite.addrs[1]
+
+	submit := func(deposit int64) uint64 {
+		msg, err := types.NewMsgSubmitProposal(TestProposal, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, deposit)), proposer)
+		suite.Require().NoError(err)
+		res, err := msgServer.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+		suite.Require().NoError(err)
+		return res.ProposalId
+	}
+	cancel := func(proposalID uint64, addr sdk.AccAddress) error {
+		_, err := msgServer.CancelProposal(sdk.WrapSDKContext(ctx), types.NewMsgCancelProposal(addr, proposalID))
+		return err
 	}
+
+	// proposal in voting period, with a deposit from another account and a vote
+	votingID := submit(6000000)
+	_, err := suite.app.GovKeeper.AddDeposit(ctx, votingID, depositor, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 4000001)))
+	suite.Require().NoError(err)
+	proposal, ok := suite.app.GovKeeper.GetProposal(ctx, votingID)
+	suite.Require().True(ok)
+	suite.Require().Equal(types.StatusVotingPeriod, proposal.Status)
+	suite.Require().Equal(proposer.String(), proposal.Proposer)
+	suite.Require().NoError(suite.app.GovKeeper.AddVote(ctx, votingID, depositor, types.NewNonSplitVoteOption(types.OptionYes)))
+
+	suite.Require().ErrorIs(cancel(votingID, depositor), types.ErrUnauthorizedCancel)
+
+	proposerBalance := suite.app.BankKeeper.GetBalance(ctx, proposer, sdk.DefaultBondDenom)
+	depositorBalance := suite.app.BankKeeper.GetBalance(ctx, depositor, sdk.DefaultBondDenom)
+	supply := suite.app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(cancel(votingID, proposer))
+
+	// half of each deposit is burned, rounded down, and the rest is refunded
+	suite.Require().Equal(proposerBalance.AddAmount(sdk.NewInt(3000000)), suite.app.BankKeeper.GetBalance(ctx, proposer, sdk.DefaultBondDenom))
+	suite.Require().Equal(depositorBalance.AddAmount(sdk.NewInt(2000001)), suite.app.BankKeeper.GetBalance(ctx, depositor, sdk.DefaultBondDenom))
+	suite.Require().Equal(supply.SubAmount(sdk.NewInt(5000000)), suite.app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+
+	_, ok = suite.app.GovKeeper.GetProposal(ctx, votingID)
+	suite.Require().False(ok)
+	suite.Require().Empty(suite.app.GovKeeper.GetDeposits(ctx, votingID))
+	suite.Require().Empty(suite.app.GovKeeper.GetVotes(ctx, votingID))
+	activeIterator := suite.app.GovKeeper.ActiveProposalQueueIterator(ctx, proposal.VotingEndTime)
+	suite.Require().False(activeIterator.Valid())
+	activeIterator.Close()
+
+	suite.Require().Contains(ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeCancelProposal,
+		sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", votingID)),
+		sdk.NewAttribute(types.AttributeKeyBurnedDeposit, "5000000stake"),
+		sdk.NewAttribute(types.AttributeKeyRefundedDeposit, "5000001stake"),
+	))
+
+	suite.Require().ErrorIs(cancel(votingID, proposer), types.ErrUnknownProposal)
+
+	// proposal in deposit period
+	depositID := submit(1000)
+	proposal, ok = suite.app.GovKeeper.GetProposal(ctx, depositID)
+	suite.Require().True(ok)
+	suite.Require().Equal(types.StatusDepositPeriod, proposal.Status)
+	suite.Require().NoError(cancel(depositID, proposer))
+	inactiveIterator := suite.app.GovKeeper.InactiveProposalQueueIterator(ctx, proposal.DepositEndTime)
+	suite.Require().False(inactiveIterator.Valid())
+	inactiveIterator.Close()
+
+	// proposal submitted before the proposer was recorded
+	proposal, err = suite.app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(cancel(proposal.ProposalId, proposer), types.ErrUnauthorizedCancel)
 }
 
 func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
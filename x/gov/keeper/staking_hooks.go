@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingHooks wraps the gov keeper to record since when the delegations have
+// been bonded, for conviction voting.
+type StakingHooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = StakingHooks{}
+
+// StakingHooks returns the staking hooks of the gov keeper.
+func (keeper Keeper) StakingHooks() StakingHooks { return StakingHooks{keeper} }
+
+// AfterDelegationModified updates the bonding of the delegation to its new
+// shares.
+func (h StakingHooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	delegation := h.k.sk.Delegation(ctx, delAddr, valAddr)
+	if delegation == nil {
+		return nil
+	}
+
+	h.k.UpdateDelegationBonding(ctx, delAddr, valAddr, delegation.GetShares())
+	return nil
+}
+
+// BeforeDelegationRemoved deletes the bonding of the delegation.
+func (h StakingHooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.k.DeleteDelegationBonding(ctx, delAddr, valAddr)
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) error   { return nil }
+func (h StakingHooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error { return nil }
+func (h StakingHooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+func (h StakingHooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+func (h StakingHooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+func (h StakingHooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+func (h StakingHooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+func (h StakingHooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec) error {
+	return nil
+}
+func (h StakingHooks) AfterConsPubKeyRotated(_ sdk.Context, _ sdk.ValAddress, _, _ cryptotypes.PubKey) error {
+	return nil
+}
+func (h StakingHooks) BeforeSlashedTokensBurned(_ sdk.Context, _ string, amount sdk.Coins) (sdk.Coins, error) {
+	return amount, nil
+}
@@ -9,117 +9,586 @@ import (
 // TODO: Break into several smaller functions for clarity
 
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
-func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes bool, burnDeposits bool, tallyResults types.TallyResult) {
+// voters. Expedited proposals are tallied with the expedited quorum and threshold. It returns the
+// reason why the deposits of the proposal are burned, or BurnReasonNone if they are refunded,
+// according to the deposit params.
+func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes bool, burnReason types.DepositBurnReason, tallyResults types.TallyResult) {
+	results, totalVotingPower, totalBonded := keeper.tallyVotes(ctx, proposal, true)
+	passes, burnReason = keeper.tallyOutcome(ctx, proposal, results, totalVotingPower, totalBonded)
+	return passes, keeper.depositBurnReason(ctx, burnReason), types.NewTallyResultFromMap(results)
+}
+
+// TallyOptimistic iterates over the votes of an optimistic proposal and returns
+// whether it is vetoed, which is the case if the voting power of the NoWithVeto
+// votes exceeds the optimistic veto threshold of the total bonded tokens. The
+// other vote options don't count toward the outcome.
+func (keeper Keeper) TallyOptimistic(ctx sdk.Context, proposal types.Proposal) (vetoed bool, tallyResults types.TallyResult) {
+	results, _, totalBonded := keeper.tallyVotes(ctx, proposal, true)
+	tallyResults = types.NewTallyResultFromMap(results)
+
+	if totalBonded.IsZero() {
+		return false, tallyResults
+	}
+
+	vetoThreshold := keeper.GetTallyParams(ctx).OptimisticVetoThreshold
+	return results[types.OptionNoWithVeto].Quo(totalBonded.ToDec()).GT(vetoThreshold), tallyResults
+}
+
+// TallyCouncil iterates over the votes of a council proposal and returns
+// whether it passes, which is the case if a simple majority of the current
+// council members voted Yes. Each member's vote counts once, split according
+// to its weights; the votes of the accounts which are no longer members don't
+// count.
+func (keeper Keeper) TallyCouncil(ctx sdk.Context, proposal types.Proposal) (passes bool, tallyResults types.TallyResult) {
+	votingParams := keeper.GetVotingParams(ctx)
 	results := make(map[types.VoteOption]sdk.Dec)
 	results[types.OptionYes] = sdk.ZeroDec()
 	results[types.OptionAbstain] = sdk.ZeroDec()
 	results[types.OptionNo] = sdk.ZeroDec()
 	results[types.OptionNoWithVeto] = sdk.ZeroDec()
 
-	totalVotingPower := sdk.ZeroDec()
-	currValidators := make(map[string]types.ValidatorGovInfo)
-
-	// fetch all the bonded validators, insert them into currValidators
-	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
-		currValidators[validator.GetOperator().String()] = types.NewValidatorGovInfo(
-			validator.GetOperator(),
-			validator.GetBondedTokens(),
-			validator.GetDelegatorShares(),
-			sdk.ZeroDec(),
-			types.WeightedVoteOptions{},
-		)
-
+	var votes types.Votes
+	keeper.IterateVotes(ctx, proposal.ProposalId, func(vote types.Vote) bool {
+		votes = append(votes, vote)
 		return false
 	})
 
-	keeper.IterateVotes(ctx, proposal.ProposalId, func(vote types.Vote) bool {
-		// if validator, just record it in the map
+	for _, vote := range votes {
 		voter, err := sdk.AccAddressFromBech32(vote.Voter)
-
 		if err != nil {
 			panic(err)
 		}
 
-		valAddrStr := sdk.ValAddress(voter.Bytes()).String()
-		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Options
-			currValidators[valAddrStr] = val
+		if votingParams.IsCouncilMember(voter) {
+			for _, option := range vote.Options {
+				results[option.Option] = results[option.Option].Add(option.Weight)
+			}
 		}
 
-		// iterate over all delegations from voter, deduct from any delegated-to validators
-		keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
-			valAddrStr := delegation.GetValidatorAddr().String()
+		keeper.deleteVote(ctx, vote.ProposalId, voter)
+	}
 
-			if val, ok := currValidators[valAddrStr]; ok {
-				// There is no need to handle the special case that validator address equal to voter address.
-				// Because voter's voting power will tally again even if there will deduct voter's voting power from validator.
-				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-				currValidators[valAddrStr] = val
+	tallyResults = types.NewTallyResultFromMap(results)
 
-				// delegation shares * bonded / total shares
-				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+	// If there is no council member, the proposal fails
+	members := len(votingParams.CouncilMembers)
+	if members == 0 {
+		return false, tallyResults
+	}
 
-				for _, option := range vote.Options {
-					subPower := votingPower.Mul(option.Weight)
-					results[option.Option] = results[option.Option].Add(subPower)
-				}
-				totalVotingPower = totalVotingPower.Add(votingPower)
-			}
+	return results[types.OptionYes].MulInt64(2).GT(sdk.NewDec(int64(members))), tallyResults
+}
 
-			return false
-		})
+// TallyChoices iterates over the choice votes of a multiple-choice proposal and
+// returns whether it passes, the reason why its deposits are burned if they
+// are, the voting power per choice and the winning choice. The proposal passes with the choice which
+// got the most voting power if the quorum is reached, unless several choices
+// are tied.
+func (keeper Keeper) TallyChoices(ctx sdk.Context, proposal types.Proposal) (passes bool, burnReason types.DepositBurnReason, results []sdk.Int, winningChoice uint32) {
+	powers, totalVotingPower, totalBonded := keeper.tallyChoiceVotes(ctx, proposal, true)
 
-		keeper.deleteVote(ctx, vote.ProposalId, voter)
-		return false
-	})
+	results = make([]sdk.Int, len(powers))
+	for i, power := range powers {
+		results[i] = power.TruncateInt()
+	}
 
-	// iterate over the validators again to tally their voting power
-	for _, val := range currValidators {
-		if len(val.Vote) == 0 {
-			continue
-		}
+	// If there is no staked coins, the proposal fails
+	if totalBonded.IsZero() {
+		return false, types.BurnReasonNone, results, 0
+	}
 
-		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
-		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+	// If there is not enough quorum of votes, the proposal fails
+	quorum, _ := keeper.GetTallyParams(ctx).QuorumAndThreshold(false)
+	if !quorumReached(totalVotingPower, totalBonded, quorum) {
+		return false, keeper.depositBurnReason(ctx, types.BurnReasonQuorum), results, 0
+	}
 
-		for _, option := range val.Vote {
-			subPower := votingPower.Mul(option.Weight)
-			results[option.Option] = results[option.Option].Add(subPower)
+	tied := false
+	for i, power := range powers {
+		switch {
+		case power.GT(powers[winningChoice]):
+			winningChoice, tied = uint32(i), false
+		case i != int(winningChoice) && power.Equal(powers[winningChoice]):
+			tied = true
 		}
-		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
+	// If several choices got the most voting power, the proposal fails
+	if tied {
+		return false, types.BurnReasonNone, results, 0
+	}
+
+	return true, types.BurnReasonNone, results, winningChoice
+}
+
+// tallyOutcome returns whether a proposal passes and the reason why its
+// deposits would be burned, regardless of the deposit params, given the voting
+// power per vote option, the total voting power and the total bonded tokens.
+func (keeper Keeper) tallyOutcome(
+	ctx sdk.Context, proposal types.Proposal, results map[types.VoteOption]sdk.Dec, totalVotingPower sdk.Dec, totalBonded sdk.Int,
+) (passes bool, burnReason types.DepositBurnReason) {
 	tallyParams := keeper.GetTallyParams(ctx)
-	tallyResults = types.NewTallyResultFromMap(results)
+	quorum, threshold := tallyParams.QuorumAndThreshold(proposal.IsExpedited)
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no staked coins, the proposal fails
-	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
-		return false, false, tallyResults
+	if totalBonded.IsZero() {
+		return false, types.BurnReasonNone
 	}
 
 	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(keeper.sk.TotalBondedTokens(ctx).ToDec())
-	if percentVoting.LT(tallyParams.Quorum) {
-		return false, true, tallyResults
+	if !quorumReached(totalVotingPower, totalBonded, quorum) {
+		return false, types.BurnReasonQuorum
 	}
 
 	// If no one votes (everyone abstains), proposal fails
 	if totalVotingPower.Sub(results[types.OptionAbstain]).Equal(sdk.ZeroDec()) {
-		return false, false, tallyResults
+		return false, types.BurnReasonNone
 	}
 
 	// If more than 1/3 of voters veto, proposal fails
 	if results[types.OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.VetoThreshold) {
-		return false, true, tallyResults
+		return false, types.BurnReasonVeto
 	}
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(tallyParams.Threshold) {
-		return true, false, tallyResults
+	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(threshold) {
+		return true, types.BurnReasonNone
 	}
 
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
-	return false, false, tallyResults
+	return false, types.BurnReasonNone
+}
+
+// depositBurnReason returns the given reason if the deposit params burn the
+// deposits of a proposal for it, or BurnReasonNone if they are refunded.
+func (keeper Keeper) depositBurnReason(ctx sdk.Context, reason types.DepositBurnReason) types.DepositBurnReason {
+	if !keeper.GetDepositParams(ctx).BurnsDeposits(reason) {
+		return types.BurnReasonNone
+	}
+	return reason
+}
+
+// QuorumReached returns whether the votes cast on a proposal so far reach the
+// quorum. Unlike Tally, it leaves the votes in the store.
+func (keeper Keeper) QuorumReached(ctx sdk.Context, proposal types.Proposal) bool {
+	totalVotingPower, totalBonded := keeper.participation(ctx, proposal)
+	quorum, _ := keeper.GetTallyParams(ctx).QuorumAndThreshold(proposal.IsExpedited)
+	return quorumReached(totalVotingPower, totalBonded, quorum)
+}
+
+// participation returns the voting power which participated in the vote on a
+// proposal so far along with the total bonded tokens, leaving the votes in the
+// store.
+func (keeper Keeper) participation(ctx sdk.Context, proposal types.Proposal) (totalVotingPower sdk.Dec, totalBonded sdk.Int) {
+	if proposal.IsMultipleChoice() {
+		_, totalVotingPower, totalBonded = keeper.tallyChoiceVotes(ctx, proposal, false)
+	} else {
+		_, totalVotingPower, totalBonded = keeper.tallyVotes(ctx, proposal, false)
+	}
+	return totalVotingPower, totalBonded
+}
+
+// quorumReached returns whether the given voting power reaches the quorum
+// with respect to the total bonded tokens.
+func quorumReached(totalVotingPower sdk.Dec, totalBonded sdk.Int, quorum sdk.Dec) bool {
+	if totalBonded.IsZero() {
+		return false
+	}
+
+	percentVoting := totalVotingPower.Quo(totalBonded.ToDec())
+	return percentVoting.GTE(quorum)
+}
+
+// tallyVotes iterates over the votes of a proposal and returns the voting power
+// per vote option along with the total voting power that participated and the
+// total bonded tokens, from the voting power snapshot of the proposal if it has
+// one. The accounts which didn't vote follow the vote of their governor if it
+// voted, and inherit the votes of their validators otherwise. Votes are removed
+// from the store after being tallied if deleteVotes is set.
+func (keeper Keeper) tallyVotes(
+	ctx sdk.Context, proposal types.Proposal, deleteVotes bool,
+) (results map[types.VoteOption]sdk.Dec, totalVotingPower sdk.Dec, totalBonded sdk.Int) {
+	results = make(map[types.VoteOption]sdk.Dec)
+	results[types.OptionYes] = sdk.ZeroDec()
+	results[types.OptionAbstain] = sdk.ZeroDec()
+	results[types.OptionNo] = sdk.ZeroDec()
+	results[types.OptionNoWithVeto] = sdk.ZeroDec()
+
+	tally := keeper.newVoteTally(ctx, proposal.ProposalId)
+
+	var votes types.Votes
+	voted := make(map[string]bool)
+	keeper.IterateVotes(ctx, proposal.ProposalId, func(vote types.Vote) bool {
+		votes = append(votes, vote)
+		voted[vote.Voter] = true
+		return false
+	})
+	hasVoted := func(delegator sdk.AccAddress) bool { return voted[delegator.String()] }
+
+	for _, vote := range votes {
+		voter, err := sdk.AccAddressFromBech32(vote.Voter)
+		if err != nil {
+			panic(err)
+		}
+
+		tally.tallyVote(voter, hasVoted, creditVoteOptions(results, vote.Options))
+
+		if deleteVotes {
+			keeper.deleteVote(ctx, vote.ProposalId, voter)
+		}
+	}
+
+	tally.tallyValidatorVotes(deleteVotes)
+
+	return results, tally.totalVotingPower, tally.totalBonded
+}
+
+// creditVoteOptions returns the callback crediting voting power to the given
+// weighted vote options in the results of a tally.
+func creditVoteOptions(results map[types.VoteOption]sdk.Dec, options types.WeightedVoteOptions) func(votingPower sdk.Dec) {
+	return func(votingPower sdk.Dec) {
+		for _, option := range options {
+			subPower := votingPower.Mul(option.Weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
+	}
+}
+
+// voteTally tallies the votes cast on a proposal against the validators and
+// the voting power transform the proposal is tallied with. The voting power
+// counted with a vote is credited to the results through the callback the vote
+// is tallied with, e.g. to its weighted vote options or to its choice.
+type voteTally struct {
+	keeper      Keeper
+	ctx         sdk.Context
+	proposalID  uint64
+	snapshotted bool
+	validators  map[string]types.ValidatorGovInfo
+	transform   *votingPowerTransform
+
+	totalVotingPower sdk.Dec
+	totalBonded      sdk.Int
+
+	// the self-delegation shares of the validators which voted, only needed to
+	// report the participation of the validators
+	selfShares map[string]sdk.Dec
+	// the accounts whose delegations are tallied with their vote or their
+	// governor's, which the validators don't inherit
+	counted map[string]bool
+	// the credit callbacks of the votes of the validators by operator address
+	validatorVotes map[string]func(votingPower sdk.Dec)
+}
+
+// newVoteTally returns the tally of the votes of a proposal, against the
+// voting power snapshot of the proposal if it has one.
+func (keeper Keeper) newVoteTally(ctx sdk.Context, proposalID uint64) *voteTally {
+	// fetch the validators the proposal is tallied with
+	validators, totalBonded, snapshotted := keeper.tallyValidators(ctx, proposalID)
+
+	// transform the voting power of the accounts if the tally params say so,
+	// against their total transformed voting power
+	transform := keeper.votingPowerTransform(ctx, proposalID, validators, snapshotted)
+	if transform != nil {
+		totalBonded = transform.totalBonded()
+	}
+
+	return &voteTally{
+		keeper:           keeper,
+		ctx:              ctx,
+		proposalID:       proposalID,
+		snapshotted:      snapshotted,
+		validators:       validators,
+		transform:        transform,
+		totalVotingPower: sdk.ZeroDec(),
+		totalBonded:      totalBonded,
+		selfShares:       make(map[string]sdk.Dec),
+		counted:          make(map[string]bool),
+		validatorVotes:   make(map[string]func(votingPower sdk.Dec)),
+	}
+}
+
+// tallyVote credits with the given callback the voting power of the
+// delegations of a voter, and of the accounts which delegated their governance
+// voting power to it and didn't vote according to hasVoted. The callback of the
+// vote of a validator also credits the voting power it keeps, once tallied by
+// tallyValidatorVotes.
+func (t *voteTally) tallyVote(voter sdk.AccAddress, hasVoted func(delegator sdk.AccAddress) bool, credit func(votingPower sdk.Dec)) {
+	// if validator, just record it in the map
+	valAddrStr := sdk.ValAddress(voter.Bytes()).String()
+	if _, ok := t.validators[valAddrStr]; ok {
+		t.validatorVotes[valAddrStr] = credit
+	}
+
+	t.tallyDelegations(voter, credit)
+
+	// the accounts which delegated their governance voting power to the
+	// voter and didn't vote follow its vote instead of their validators'
+	t.keeper.IterateGovernanceDelegationsByGovernor(t.ctx, voter, func(delegator sdk.AccAddress) (stop bool) {
+		if !hasVoted(delegator) {
+			t.tallyDelegations(delegator, credit)
+		}
+		return false
+	})
+}
+
+// tallyDelegations credits the voting power of the delegations of an account
+// with the given callback and deducts it from the delegated-to validators.
+func (t *voteTally) tallyDelegations(voter sdk.AccAddress, credit func(votingPower sdk.Dec)) {
+	t.counted[voter.String()] = true
+
+	// iterate over all delegations from voter, deduct from any delegated-to validators
+	t.keeper.iterateTallyDelegations(t.ctx, t.proposalID, t.snapshotted, voter, func(valAddrStr string, shares sdk.Dec) {
+		if val, ok := t.validators[valAddrStr]; ok {
+			// There is no need to handle the special case that validator address equal to voter address.
+			// Because voter's voting power will tally again even if there will deduct voter's voting power from validator.
+			val.DelegatorDeductions = val.DelegatorDeductions.Add(shares)
+			t.validators[valAddrStr] = val
+			if valAddrStr == sdk.ValAddress(voter).String() {
+				t.selfShares[valAddrStr] = shares
+			}
+
+			// delegation shares * bonded / total shares
+			votingPower := shares.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+			if t.transform != nil {
+				votingPower = t.transform.votingPower(voter.String(), valAddrStr, votingPower)
+			}
+
+			credit(votingPower)
+			t.totalVotingPower = t.totalVotingPower.Add(votingPower)
+		}
+	})
+}
+
+// tallyValidatorVotes credits the voting power the validators which voted keep
+// once the delegations of the accounts counted with a vote are deducted, with
+// the callbacks of their votes. It must be called once the votes of all the
+// accounts are tallied. The participation of the validators in the tally is
+// recorded if recordParticipation is set.
+func (t *voteTally) tallyValidatorVotes(recordParticipation bool) {
+	counted := func(delAddrStr string) bool { return t.counted[delAddrStr] }
+
+	// iterate over the validators again to tally their voting power
+	for valAddrStr, val := range t.validators {
+		credit, voted := t.validatorVotes[valAddrStr]
+		if recordParticipation {
+			t.keeper.recordValidatorParticipation(t.ctx, t.proposalID, val, voted, t.selfShares[valAddrStr])
+		}
+
+		if !voted {
+			continue
+		}
+
+		votingPower := t.validatorVotingPower(valAddrStr, val.DelegatorDeductions, counted)
+		credit(votingPower)
+		t.totalVotingPower = t.totalVotingPower.Add(votingPower)
+	}
+}
+
+// validatorVotingPower returns the voting power a validator keeps once the
+// given delegator shares of the accounts counted with a vote are deducted.
+func (t *voteTally) validatorVotingPower(valAddrStr string, deductions sdk.Dec, counted func(delAddrStr string) bool) sdk.Dec {
+	if t.transform != nil {
+		return t.transform.inheritedVotingPower(valAddrStr, counted)
+	}
+
+	val := t.validators[valAddrStr]
+	sharesAfterDeductions := val.DelegatorShares.Sub(deductions)
+	return sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+}
+
+// recordValidatorParticipation records the participation of a bonded validator
+// in the final tally of a proposal. The voting power of the validator is that
+// of its self-delegation and of the delegations which followed its vote, or
+// which it left uncast if it didn't vote.
+func (keeper Keeper) recordValidatorParticipation(
+	ctx sdk.Context, proposalID uint64, val types.ValidatorGovInfo, voted bool, selfShares sdk.Dec,
+) {
+	votingPower := sdk.ZeroDec()
+	if val.DelegatorShares.IsPositive() {
+		shares := val.DelegatorShares.Sub(val.DelegatorDeductions)
+		if !selfShares.IsNil() {
+			shares = shares.Add(selfShares)
+		}
+		votingPower = shares.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+	}
+
+	keeper.SetValidatorParticipation(ctx, types.NewValidatorParticipation(
+		proposalID, val.Address, voted, val.BondedTokens, votingPower,
+	))
+}
+
+// tallyVotesPage returns the voting power per vote option contributed by a
+// page of the votes of a proposal, leaving the votes in the store. The
+// contributions of all the pages of the votes add up to the results of
+// tallyVotes: a page counts the voting power of the delegations of its voters
+// and of the accounts following them as governor which didn't vote, along with
+// the voting power its validator voters keep once the delegations of all the
+// accounts counted with a vote are deducted.
+func (keeper Keeper) tallyVotesPage(ctx sdk.Context, proposal types.Proposal, votes types.Votes) map[types.VoteOption]sdk.Dec {
+	results := make(map[types.VoteOption]sdk.Dec)
+	results[types.OptionYes] = sdk.ZeroDec()
+	results[types.OptionAbstain] = sdk.ZeroDec()
+	results[types.OptionNo] = sdk.ZeroDec()
+	results[types.OptionNoWithVeto] = sdk.ZeroDec()
+
+	tally := keeper.newVoteTally(ctx, proposal.ProposalId)
+
+	store := ctx.KVStore(keeper.storeKey)
+	hasVoted := func(delegator sdk.AccAddress) bool {
+		return store.Has(types.VoteKey(proposal.ProposalId, delegator))
+	}
+
+	// the deductions are only needed if validators voted in the page
+	var deductions map[string]sdk.Dec
+	counted := keeper.tallyCounted(ctx, proposal.ProposalId)
+
+	for _, vote := range votes {
+		voter, err := sdk.AccAddressFromBech32(vote.Voter)
+		if err != nil {
+			panic(err)
+		}
+
+		credit := creditVoteOptions(results, vote.Options)
+		tally.tallyVote(voter, hasVoted, credit)
+
+		valAddrStr := sdk.ValAddress(voter.Bytes()).String()
+		if _, ok := tally.validators[valAddrStr]; !ok {
+			continue
+		}
+
+		// the voting power of a validator voter is deducted the delegations of
+		// all the accounts counted with a vote, not only those of the page
+		deduction := sdk.ZeroDec()
+		if tally.transform == nil {
+			if deductions == nil {
+				deductions = keeper.tallyDeductions(ctx, proposal.ProposalId, tally.validators, tally.snapshotted, counted)
+			}
+			if d, ok := deductions[valAddrStr]; ok {
+				deduction = d
+			}
+		}
+
+		credit(tally.validatorVotingPower(valAddrStr, deduction, counted))
+	}
+
+	return results
+}
+
+// tallyCounted returns a function reporting whether the delegations of an
+// account are tallied with a vote, which is the case if the account or its
+// governor voted on the proposal.
+func (keeper Keeper) tallyCounted(ctx sdk.Context, proposalID uint64) func(delAddrStr string) bool {
+	store := ctx.KVStore(keeper.storeKey)
+	counted := make(map[string]bool)
+
+	return func(delAddrStr string) bool {
+		isCounted, ok := counted[delAddrStr]
+		if ok {
+			return isCounted
+		}
+
+		delAddr, err := sdk.AccAddressFromBech32(delAddrStr)
+		if err != nil {
+			panic(err)
+		}
+
+		isCounted = store.Has(types.VoteKey(proposalID, delAddr))
+		if delegation, found := keeper.GetGovernanceDelegation(ctx, delAddr); !isCounted && found {
+			_, governorAddr := mustGovernanceDelegationAddresses(delegation)
+			isCounted = store.Has(types.VoteKey(proposalID, governorAddr))
+		}
+		counted[delAddrStr] = isCounted
+
+		return isCounted
+	}
+}
+
+// tallyDeductions returns by validator operator address the delegator shares
+// tallyVotes deducts from the voting power of the validators a proposal is
+// tallied with, which are those of the delegations of the counted accounts.
+func (keeper Keeper) tallyDeductions(
+	ctx sdk.Context, proposalID uint64, validators map[string]types.ValidatorGovInfo, snapshotted bool, counted func(delAddrStr string) bool,
+) map[string]sdk.Dec {
+	store := ctx.KVStore(keeper.storeKey)
+	deductions := make(map[string]sdk.Dec)
+
+	deduct := func(delAddrStr, valAddrStr string, shares sdk.Dec) {
+		if _, ok := validators[valAddrStr]; !ok {
+			return
+		}
+
+		if !counted(delAddrStr) {
+			return
+		}
+
+		if deduction, ok := deductions[valAddrStr]; ok {
+			shares = shares.Add(deduction)
+		}
+		deductions[valAddrStr] = shares
+	}
+
+	if !snapshotted {
+		keeper.sk.IterateAllDelegations(ctx, func(delegation stakingtypes.Delegation) (stop bool) {
+			deduct(delegation.DelegatorAddress, delegation.ValidatorAddress, delegation.Shares)
+			return false
+		})
+		return deductions
+	}
+
+	iterator := sdk.KVStorePrefixIterator(store, types.SnapshotDelegationsKey(proposalID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var del types.DelegationVotingPower
+		keeper.cdc.MustUnmarshal(iterator.Value(), &del)
+		deduct(del.DelegatorAddress, del.ValidatorAddress, del.Shares)
+	}
+
+	return deductions
+}
+
+// tallyChoiceVotes iterates over the choice votes of a multiple-choice proposal
+// and returns the voting power per choice along with the total voting power
+// that participated and the total bonded tokens, as in tallyVotes. Delegators
+// who didn't vote follow the choice of their governor or inherit the choice of
+// their validators. Choice votes are removed from the store after being tallied
+// if deleteVotes is set.
+func (keeper Keeper) tallyChoiceVotes(
+	ctx sdk.Context, proposal types.Proposal, deleteVotes bool,
+) (results []sdk.Dec, totalVotingPower sdk.Dec, totalBonded sdk.Int) {
+	results = make([]sdk.Dec, len(proposal.Choices))
+	for i := range results {
+		results[i] = sdk.ZeroDec()
+	}
+
+	tally := keeper.newVoteTally(ctx, proposal.ProposalId)
+
+	var votes []types.ChoiceVote
+	voted := make(map[string]bool)
+	keeper.IterateChoiceVotes(ctx, proposal.ProposalId, func(vote types.ChoiceVote) bool {
+		votes = append(votes, vote)
+		voted[vote.Voter] = true
+		return false
+	})
+	hasVoted := func(delegator sdk.AccAddress) bool { return voted[delegator.String()] }
+
+	for _, vote := range votes {
+		voter, err := sdk.AccAddressFromBech32(vote.Voter)
+		if err != nil {
+			panic(err)
+		}
+
+		choice := vote.Choice
+		tally.tallyVote(voter, hasVoted, func(votingPower sdk.Dec) {
+			results[choice] = results[choice].Add(votingPower)
+		})
+
+		if deleteVotes {
+			keeper.deleteChoiceVote(ctx, vote.ProposalId, voter)
+		}
+	}
+
+	tally.tallyValidatorVotes(deleteVotes)
+
+	return results, tally.totalVotingPower, tally.totalBonded
 }
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -28,10 +29,10 @@ func TestTallyNoOneVotes(t *testing.T) {
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnReason, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.False(t, passes)
-	require.True(t, burnDeposits)
+	require.Equal(t, types.BurnReasonQuorum, burnReason)
 	require.True(t, tallyResults.Equals(types.EmptyTallyResult()))
 }
 
@@ -55,9 +56,9 @@ func TestTallyNoQuorum(t *testing.T) {
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, _ := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnReason, _ := app.GovKeeper.Tally(ctx, proposal)
 	require.False(t, passes)
-	require.True(t, burnDeposits)
+	require.Equal(t, types.BurnReasonQuorum, burnReason)
 }
 
 func TestTallyOnlyValidatorsAllYes(t *testing.T) {
@@ -79,10 +80,10 @@ func TestTallyOnlyValidatorsAllYes(t *testing.T) {
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnReason, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.True(t, passes)
-	require.False(t, burnDeposits)
+	require.Equal(t, types.BurnReasonNone, burnReason)
 	require.False(t, tallyResults.Equals(types.EmptyTallyResult()))
 }
 
@@ -104,10 +105,10 @@ func TestTallyOnlyValidators51No(t *testing.T) {
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, _ := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnReason, _ := app.GovKeeper.Tally(ctx, proposal)
 
 	require.False(t, passes)
-	require.False(t, burnDeposits)
+	require.Equal(t, types.BurnReasonNone, burnReason)
 }
 
 func TestTallyOnlyValidators51Yes(t *testing.T) {
@@ -128,10 +129,10 @@ func TestTallyOnlyValidators51Yes(t *testing.T) {
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnReason, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.True(t, passes)
-	require.False(t, burnDeposits)
+	require.Equal(t, types.BurnReasonNone, burnReason)
 	require.False(t, tallyResults.Equals(types.EmptyTallyResult()))
 }
 
@@ -154,13 +155,42 @@ func TestTallyOnlyValidatorsVetoed(t *testing.T) {
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnReason, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.False(t, passes)
-	require.True(t, burnDeposits)
+	require.Equal(t, types.BurnReasonVeto, burnReason)
 	require.False(t, tallyResults.Equals(types.EmptyTallyResult()))
 }
 
+func TestTallyVetoedBurnDisabled(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	valAccAddrs, _ := createValidators(t, ctx, app, []int64{6, 6, 7})
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	depositParams.BurnVoteVeto = false
+	app.GovKeeper.SetDepositParams(ctx, depositParams)
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalId
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[2], types.NewNonSplitVoteOption(types.OptionNoWithVeto)))
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnReason, _ := app.GovKeeper.Tally(ctx, proposal)
+
+	require.False(t, passes)
+	require.Equal(t, types.BurnReasonNone, burnReason)
+}
+
 func TestTallyOnlyValidatorsAbstainPasses(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -180,10 +210,10 @@ func TestTallyOnlyValidatorsAbstainPasses(t *testing.T) {
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnReason, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.True(t, passes)
-	require.False(t, burnDeposits)
+	require.Equal(t, types.BurnReasonNone, burnReason)
 	require.False(t, tallyResults.Equals(types.EmptyTallyResult()))
 }
 
@@ -206,10 +236,10 @@ func TestTallyOnlyValidatorsAbstainFails(t *testing.T) {
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnReason, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.False(t, passes)
-	require.False(t, burnDeposits)
+	require.Equal(t, types.BurnReasonNone, burnReason)
 	require.False(t, tallyResults.Equals(types.EmptyTallyResult()))
 }
 
@@ -232,10 +262,10 @@ func TestTallyOnlyValidatorsNonVoter(t *testing.T) {
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnReason, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.False(t, passes)
-	require.False(t, burnDeposits)
+	require.Equal(t, types.BurnReasonNone, burnReason)
 	require.False(t, tallyResults.Equals(types.EmptyTallyResult()))
 }
 
@@ -268,10 +298,10 @@ func TestTallyDelgatorOverride(t *testing.T) {
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnReason, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.False(t, passes)
-	require.False(t, burnDeposits)
+	require.Equal(t, types.BurnReasonNone, burnReason)
 	require.False(t, tallyResults.Equals(types.EmptyTallyResult()))
 }
 
@@ -303,10 +333,10 @@ func TestTallyDelgatorInherit(t *testing.T) {
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnReason, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.True(t, passes)
-	require.False(t, burnDeposits)
+	require.Equal(t, types.BurnReasonNone, burnReason)
 	require.False(t, tallyResults.Equals(types.EmptyTallyResult()))
 }
 
@@ -343,10 +373,10 @@ func TestTallyDelgatorMultipleOverride(t *testing.T) {
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnReason, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.False(t, passes)
-	require.False(t, burnDeposits)
+	require.Equal(t, types.BurnReasonNone, burnReason)
 	require.False(t, tallyResults.Equals(types.EmptyTallyResult()))
 }
 
@@ -384,10 +414,10 @@ func TestTallyDelgatorMultipleInherit(t *testing.T) {
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnReason, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.False(t, passes)
-	require.False(t, burnDeposits)
+	require.Equal(t, types.BurnReasonNone, burnReason)
 	require.False(t, tallyResults.Equals(types.EmptyTallyResult()))
 }
 
@@ -427,10 +457,10 @@ func TestTallyJailedValidator(t *testing.T) {
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnReason, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.True(t, passes)
-	require.False(t, burnDeposits)
+	require.Equal(t, types.BurnReasonNone, burnReason)
 	require.False(t, tallyResults.Equals(types.EmptyTallyResult()))
 }
 
@@ -460,10 +490,10 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnReason, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.True(t, passes)
-	require.False(t, burnDeposits)
+	require.Equal(t, types.BurnReasonNone, burnReason)
 
 	expectedYes := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
 	expectedAbstain := app.StakingKeeper.TokensFromConsensusPower(ctx, 0)
@@ -473,3 +503,368 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	require.True(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallyValidatorsWeightedVotes(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, _ := createValidators(t, ctx, app, []int64{10, 10, 10})
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalId
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.WeightedVoteOptions{
+		types.WeightedVoteOption{Option: types.OptionYes, Weight: sdk.NewDecWithPrec(6, 1)},
+		types.WeightedVoteOption{Option: types.OptionNo, Weight: sdk.NewDecWithPrec(4, 1)},
+	}))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.WeightedVoteOptions{
+		types.WeightedVoteOption{Option: types.OptionAbstain, Weight: sdk.NewDecWithPrec(5, 1)},
+		types.WeightedVoteOption{Option: types.OptionNoWithVeto, Weight: sdk.NewDecWithPrec(5, 1)},
+	}))
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnReason, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+
+	require.True(t, passes)
+	require.Equal(t, types.BurnReasonNone, burnReason)
+
+	expectedYes := app.StakingKeeper.TokensFromConsensusPower(ctx, 16)
+	expectedAbstain := app.StakingKeeper.TokensFromConsensusPower(ctx, 5)
+	expectedNo := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
+	expectedNoWithVeto := app.StakingKeeper.TokensFromConsensusPower(ctx, 5)
+	expectedTallyResult := types.NewTallyResult(expectedYes, expectedAbstain, expectedNo, expectedNoWithVeto)
+
+	require.True(t, tallyResults.Equals(expectedTallyResult))
+}
+
+func TestTallyDelegatorWeightedOverride(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{10, 10, 10})
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	val1, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[4], delTokens, stakingtypes.Unbonded, val1, true)
+	require.NoError(t, err)
+
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalId
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	// the delegator splits its own voting power, which is deducted from the
+	// voting power of its validator
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[4], types.WeightedVoteOptions{
+		types.WeightedVoteOption{Option: types.OptionYes, Weight: sdk.NewDecWithPrec(5, 1)},
+		types.WeightedVoteOption{Option: types.OptionNo, Weight: sdk.NewDecWithPrec(5, 1)},
+	}))
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnReason, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+
+	require.True(t, passes)
+	require.Equal(t, types.BurnReasonNone, burnReason)
+
+	expectedYes := app.StakingKeeper.TokensFromConsensusPower(ctx, 25)
+	expectedAbstain := app.StakingKeeper.TokensFromConsensusPower(ctx, 0)
+	expectedNo := app.StakingKeeper.TokensFromConsensusPower(ctx, 15)
+	expectedNoWithVeto := app.StakingKeeper.TokensFromConsensusPower(ctx, 0)
+	expectedTallyResult := types.NewTallyResult(expectedYes, expectedAbstain, expectedNo, expectedNoWithVeto)
+
+	require.True(t, tallyResults.Equals(expectedTallyResult))
+}
+
+func TestTallyDelegatorGovernor(t *testing.T) {
+	testCases := []struct {
+		name            string
+		delegatorVote   types.WeightedVoteOptions
+		governorVotes   bool
+		expectedYes     int64
+		expectedAbstain int64
+		expectedNo      int64
+	}{
+		{
+			// the delegator follows the vote of its governor instead of its validator
+			name:          "governor votes",
+			governorVotes: true,
+			expectedYes:   20,
+			expectedNo:    10,
+		},
+		{
+			// the delegator inherits the vote of its validator
+			name:        "governor doesn't vote",
+			expectedYes: 30,
+		},
+		{
+			// the vote of the delegator overrides the vote of its governor
+			name:            "delegator votes",
+			delegatorVote:   types.NewNonSplitVoteOption(types.OptionAbstain),
+			governorVotes:   true,
+			expectedYes:     20,
+			expectedAbstain: 10,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+			addrs, valAddrs := createValidators(t, ctx, app, []int64{10, 10, 10})
+			delegator, governor := addrs[3], addrs[4]
+
+			val1, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+			require.True(t, found)
+			_, err := app.StakingKeeper.Delegate(ctx, delegator, app.StakingKeeper.TokensFromConsensusPower(ctx, 10), stakingtypes.Unbonded, val1, true)
+			require.NoError(t, err)
+
+			_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+			require.NoError(t, app.GovKeeper.SetGovernor(ctx, delegator, governor))
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+			require.NoError(t, err)
+			proposalID := proposal.ProposalId
+			proposal.Status = types.StatusVotingPeriod
+			app.GovKeeper.SetProposal(ctx, proposal)
+
+			require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+			require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
+			if tc.governorVotes {
+				require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, governor, types.NewNonSplitVoteOption(types.OptionNo)))
+			}
+			if tc.delegatorVote != nil {
+				require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, delegator, tc.delegatorVote))
+			}
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			_, _, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+
+			expectedTallyResult := types.NewTallyResult(
+				app.StakingKeeper.TokensFromConsensusPower(ctx, tc.expectedYes),
+				app.StakingKeeper.TokensFromConsensusPower(ctx, tc.expectedAbstain),
+				app.StakingKeeper.TokensFromConsensusPower(ctx, tc.expectedNo),
+				app.StakingKeeper.TokensFromConsensusPower(ctx, 0),
+			)
+			require.True(t, tallyResults.Equals(expectedTallyResult), tallyResults.String())
+		})
+	}
+}
+
+func TestTallyVotingPowerSnapshot(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 5, 5})
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalId
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	// stake bonded after the voting period started doesn't count
+	val1, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[4], app.StakingKeeper.TokensFromConsensusPower(ctx, 30), stakingtypes.Unbonded, val1, true)
+	require.NoError(t, err)
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[4], types.NewNonSplitVoteOption(types.OptionNo)))
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.True(t, app.GovKeeper.QuorumReached(ctx, proposal))
+	passes, burnReason, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+
+	require.True(t, passes)
+	require.Equal(t, types.BurnReasonNone, burnReason)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 10), tallyResults.Yes)
+	require.True(t, tallyResults.No.IsZero())
+
+	// without the snapshot, the proposal is tallied with the stake bonded now
+	app.GovKeeper.DeleteVotingPowerSnapshot(ctx, proposalID)
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[4], types.NewNonSplitVoteOption(types.OptionNo)))
+	passes, _, tallyResults = app.GovKeeper.Tally(ctx, proposal)
+
+	require.False(t, passes)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 30), tallyResults.No)
+}
+
+func TestTallyQuadraticVoting(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 6, 7})
+
+	val3, found := app.StakingKeeper.GetValidator(ctx, valAddrs[2])
+	require.True(t, found)
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[3], app.StakingKeeper.TokensFromConsensusPower(ctx, 30), stakingtypes.Unbonded, val3, true)
+	require.NoError(t, err)
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+	tallyParams.QuadraticVoting = true
+	app.GovKeeper.SetTallyParams(ctx, tallyParams)
+
+	sqrtPower := func(power int64) sdk.Dec {
+		root, err := app.StakingKeeper.TokensFromConsensusPower(ctx, power).ToDec().ApproxSqrt()
+		require.NoError(t, err)
+		return root
+	}
+
+	// the whale outvotes the validators with its voting power, but not with its
+	// square root
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[2], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[3], types.NewNonSplitVoteOption(types.OptionYes)))
+
+	passes, burnReason, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	require.False(t, passes)
+	require.Equal(t, types.BurnReasonNone, burnReason)
+	require.Equal(t, sqrtPower(30).TruncateInt(), tallyResults.Yes)
+	require.Equal(t, sqrtPower(5).Add(sqrtPower(6)).Add(sqrtPower(7)).TruncateInt(), tallyResults.No)
+
+	// the delegator inherits the vote of its validator with the square root of
+	// its voting power
+	proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[2], types.NewNonSplitVoteOption(types.OptionYes)))
+
+	passes, burnReason, tallyResults = app.GovKeeper.Tally(ctx, proposal)
+	require.True(t, passes)
+	require.Equal(t, types.BurnReasonNone, burnReason)
+	require.Equal(t, sqrtPower(7).Add(sqrtPower(30)).TruncateInt(), tallyResults.Yes)
+	require.Equal(t, sqrtPower(5).Add(sqrtPower(6)).TruncateInt(), tallyResults.No)
+}
+
+func TestTallyVotingPowerCap(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 6, 7})
+
+	val3, found := app.StakingKeeper.GetValidator(ctx, valAddrs[2])
+	require.True(t, found)
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[3], app.StakingKeeper.TokensFromConsensusPower(ctx, 30), stakingtypes.Unbonded, val3, true)
+	require.NoError(t, err)
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// cap the voting power of the accounts to 10
+	powerCap := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	supply := app.BankKeeper.GetSupply(ctx, app.StakingKeeper.BondDenom(ctx))
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+	tallyParams.VotingPowerCap = powerCap.ToDec().QuoInt(supply.Amount)
+	app.GovKeeper.SetTallyParams(ctx, tallyParams)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[2], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[3], types.NewNonSplitVoteOption(types.OptionYes)))
+
+	passes, burnReason, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	require.False(t, passes)
+	require.Equal(t, types.BurnReasonNone, burnReason)
+	require.True(t, tallyResults.Yes.Sub(powerCap).Abs().LTE(sdk.OneInt()), tallyResults.String())
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 18), tallyResults.No)
+}
+
+func TestTallyConvictionVoting(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 6, 7})
+
+	val3, found := app.StakingKeeper.GetValidator(ctx, valAddrs[2])
+	require.True(t, found)
+	shares, err := app.StakingKeeper.Delegate(ctx, addrs[3], app.StakingKeeper.TokensFromConsensusPower(ctx, 15), stakingtypes.Unbonded, val3, true)
+	require.NoError(t, err)
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// the voting power of the delegator bonded for the whole conviction period
+	// is doubled, that of the validators bonded now is not
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+	tallyParams.ConvictionPeriod = 30 * 24 * time.Hour
+	tallyParams.MaxConvictionMultiplier = sdk.NewDec(2)
+	app.GovKeeper.SetTallyParams(ctx, tallyParams)
+	app.GovKeeper.SetDelegationBonding(ctx, types.NewDelegationBonding(addrs[3], valAddrs[2], now.Add(-tallyParams.ConvictionPeriod), shares))
+	for i, valAddr := range valAddrs[:3] {
+		delegation, found := app.StakingKeeper.GetDelegation(ctx, addrs[i], valAddr)
+		require.True(t, found)
+		app.GovKeeper.SetDelegationBonding(ctx, types.NewDelegationBonding(addrs[i], valAddr, now, delegation.Shares))
+	}
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[2], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[3], types.NewNonSplitVoteOption(types.OptionYes)))
+
+	passes, burnReason, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	require.True(t, passes)
+	require.Equal(t, types.BurnReasonNone, burnReason)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 30), tallyResults.Yes)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 18), tallyResults.No)
+
+	// the delegator bonded for half the conviction period inherits the vote of
+	// its validator with one and a half times its voting power
+	app.GovKeeper.SetDelegationBonding(ctx, types.NewDelegationBonding(addrs[3], valAddrs[2], now.Add(-tallyParams.ConvictionPeriod/2), shares))
+
+	proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[2], types.NewNonSplitVoteOption(types.OptionYes)))
+
+	passes, burnReason, tallyResults = app.GovKeeper.Tally(ctx, proposal)
+	require.True(t, passes)
+	require.Equal(t, types.BurnReasonNone, burnReason)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 7).Add(app.StakingKeeper.TokensFromConsensusPower(ctx, 45).QuoRaw(2)), tallyResults.Yes)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 11), tallyResults.No)
+}
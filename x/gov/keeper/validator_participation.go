@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// GetValidatorParticipation gets the participation of a validator in the vote
+// on a specific finalized proposal
+func (keeper Keeper) GetValidatorParticipation(
+	ctx sdk.Context, proposalID uint64, valAddr sdk.ValAddress,
+) (participation types.ValidatorParticipation, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ValidatorParticipationKey(proposalID, valAddr))
+	if bz == nil {
+		return participation, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &participation)
+	return participation, true
+}
+
+// SetValidatorParticipation sets the participation of a validator in the vote
+// on a proposal in the store
+func (keeper Keeper) SetValidatorParticipation(ctx sdk.Context, participation types.ValidatorParticipation) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&participation)
+	valAddr, err := sdk.ValAddressFromBech32(participation.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.ValidatorParticipationKey(participation.ProposalId, valAddr), bz)
+}
+
+// DeleteValidatorParticipations deletes the participation of all the
+// validators in the vote on a proposal
+func (keeper Keeper) DeleteValidatorParticipations(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorParticipationsKey(proposalID))
+
+	batch := sdk.NewKVStoreBatch(store)
+	for ; iterator.Valid(); iterator.Next() {
+		batch.Delete(iterator.Key())
+	}
+	iterator.Close()
+
+	batch.Write()
+}
+
+// IterateValidatorParticipations iterates over the participation of the
+// validators in the vote on a proposal and performs a callback function
+func (keeper Keeper) IterateValidatorParticipations(
+	ctx sdk.Context, proposalID uint64, cb func(participation types.ValidatorParticipation) (stop bool),
+) {
+	keeper.iterateValidatorParticipations(ctx, types.ValidatorParticipationsKey(proposalID), cb)
+}
+
+// IterateAllValidatorParticipations iterates over all the stored validator
+// participations and performs a callback function
+func (keeper Keeper) IterateAllValidatorParticipations(ctx sdk.Context, cb func(participation types.ValidatorParticipation) (stop bool)) {
+	keeper.iterateValidatorParticipations(ctx, types.ValidatorParticipationsKeyPrefix, cb)
+}
+
+func (keeper Keeper) iterateValidatorParticipations(
+	ctx sdk.Context, prefix []byte, cb func(participation types.ValidatorParticipation) (stop bool),
+) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var participation types.ValidatorParticipation
+		keeper.cdc.MustUnmarshal(iterator.Value(), &participation)
+
+		if cb(participation) {
+			break
+		}
+	}
+}
+
+// GetAllValidatorParticipations returns all the validator participations from
+// the store
+func (keeper Keeper) GetAllValidatorParticipations(ctx sdk.Context) (participations []types.ValidatorParticipation) {
+	keeper.IterateAllValidatorParticipations(ctx, func(participation types.ValidatorParticipation) bool {
+		participations = append(participations, participation)
+		return false
+	})
+	return
+}
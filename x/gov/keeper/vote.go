@@ -10,6 +10,12 @@ import (
 
 // AddVote adds a vote on a specific proposal
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions) error {
+	return keeper.AddVoteWithMetadata(ctx, proposalID, voterAddr, options, "")
+}
+
+// AddVoteWithMetadata adds a vote on a specific proposal, with the rationale
+// metadata given by the voter, which is stored with the vote.
+func (keeper Keeper) AddVoteWithMetadata(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions, metadata string) error {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
@@ -17,19 +23,61 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 	if proposal.Status != types.StatusVotingPeriod {
 		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
+	if proposal.IsMultipleChoice() {
+		return sdkerrors.Wrapf(types.ErrInvalidVote, "proposal %d is a multiple-choice proposal", proposalID)
+	}
+	if proposal.IsPrivate {
+		return sdkerrors.Wrapf(types.ErrInvalidVote, "proposal %d is a private proposal voted on with vote commitments", proposalID)
+	}
+	if keeper.isRestrictedVoter(ctx, proposal, voterAddr) {
+		return sdkerrors.Wrapf(types.ErrNotCouncilMember, "%s", voterAddr)
+	}
+	if keeper.InValidatorVotingPeriod(ctx, proposal) && keeper.sk.Validator(ctx, sdk.ValAddress(voterAddr)) == nil {
+		return sdkerrors.Wrapf(types.ErrValidatorVotingPeriod, "%d ends at %s", proposalID, proposal.ValidatorVotingEndTime)
+	}
 
 	for _, option := range options {
 		if !types.ValidWeightedVoteOption(option) {
 			return sdkerrors.Wrap(types.ErrInvalidVote, option.String())
 		}
 	}
+	if err := types.ValidateVoteMetadata(metadata); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidVote, err.Error())
+	}
+
+	keeper.castVote(ctx, proposalID, voterAddr, options, metadata)
+	return nil
+}
+
+// VoterFilter returns the filter of the accounts allowed to vote on a
+// proposal, i.e. the council members on a council proposal and any account
+// otherwise. It is given to the hooks once the voting period of the proposal
+// ended.
+func (keeper Keeper) VoterFilter(ctx sdk.Context, proposal types.Proposal) types.VoterFilter {
+	if !proposal.IsCouncil {
+		return func(sdk.AccAddress) bool { return true }
+	}
+
+	votingParams := keeper.GetVotingParams(ctx)
+	return votingParams.IsCouncilMember
+}
 
+func (keeper Keeper) isRestrictedVoter(ctx sdk.Context, proposal types.Proposal, voterAddr sdk.AccAddress) bool {
+	return proposal.IsCouncil && !keeper.GetVotingParams(ctx).IsCouncilMember(voterAddr)
+}
+
+// castVote sets the vote of a voter on a proposal, calls the vote hook and
+// issues the vote receipt.
+func (keeper Keeper) castVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions, metadata string) {
 	vote := types.NewVote(proposalID, voterAddr, options)
+	vote.Metadata = metadata
 	keeper.SetVote(ctx, vote)
 
 	// called after a vote on a proposal is cast
 	keeper.AfterProposalVote(ctx, proposalID, voterAddr)
 
+	keeper.issueVoteReceipt(ctx, proposalID, voterAddr)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
@@ -37,8 +85,12 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 		),
 	)
+}
 
-	return nil
+// InValidatorVotingPeriod returns whether a proposal is in the initial window of
+// its voting period in which only validators can vote.
+func (keeper Keeper) InValidatorVotingPeriod(ctx sdk.Context, proposal types.Proposal) bool {
+	return proposal.Status == types.StatusVotingPeriod && ctx.BlockTime().Before(proposal.ValidatorVotingEndTime)
 }
 
 // GetAllVotes returns all the votes from the store
@@ -131,6 +183,20 @@ func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sd
 	store.Delete(types.VoteKey(proposalID, voterAddr))
 }
 
+// deleteVotes deletes all the votes on a specific proposal
+func (keeper Keeper) deleteVotes(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VotesKey(proposalID))
+
+	batch := sdk.NewKVStoreBatch(store)
+	for ; iterator.Valid(); iterator.Next() {
+		batch.Delete(iterator.Key())
+	}
+	iterator.Close()
+
+	batch.Write()
+}
+
 // populateLegacyOption adds graceful fallback of deprecated `Option` field, in case
 // there's only 1 VoteOption.
 func populateLegacyOption(vote *types.Vote) {
@@ -0,0 +1,262 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// CommitVote adds or replaces the commitment of a voter to a vote on a private
+// proposal in its voting period. The VoteCommitmentDeposit is escrowed with
+// the first commitment of the voter; it is refunded when the vote is revealed
+// and burned if it is not.
+func (keeper Keeper) CommitVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, commitment []byte) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	if proposal.Status != types.StatusVotingPeriod {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+	if !proposal.IsPrivate {
+		return sdkerrors.Wrapf(types.ErrInvalidVote, "proposal %d is not a private proposal", proposalID)
+	}
+	if keeper.InValidatorVotingPeriod(ctx, proposal) && keeper.sk.Validator(ctx, sdk.ValAddress(voterAddr)) == nil {
+		return sdkerrors.Wrapf(types.ErrValidatorVotingPeriod, "%d ends at %s", proposalID, proposal.ValidatorVotingEndTime)
+	}
+	if err := types.ValidateVoteCommitment(commitment); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidVote, err.Error())
+	}
+
+	voteCommitment, found := keeper.GetVoteCommitment(ctx, proposalID, voterAddr)
+	if !found {
+		deposit := keeper.GetVotingParams(ctx).VoteCommitmentDeposit
+		if !deposit.IsZero() {
+			if err := keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, voterAddr, types.ModuleName, deposit); err != nil {
+				return err
+			}
+		}
+		voteCommitment = types.NewVoteCommitment(proposalID, voterAddr, nil, deposit)
+	}
+	voteCommitment.Commitment = commitment
+	keeper.SetVoteCommitment(ctx, voteCommitment)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommitVote,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyCommitment, hex.EncodeToString(commitment)),
+		),
+	)
+
+	return nil
+}
+
+// RevealVote reveals the vote a voter committed to on a private proposal in
+// its reveal period. The vote is cast if it matches the commitment, which is
+// then deleted and its deposit refunded.
+func (keeper Keeper) RevealVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions, salt string) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	if proposal.Status != types.StatusRevealPeriod {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "proposal %d is not in its reveal period", proposalID)
+	}
+
+	voteCommitment, found := keeper.GetVoteCommitment(ctx, proposalID, voterAddr)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidVoteReveal, "no vote commitment of %s on proposal %d", voterAddr, proposalID)
+	}
+	if !bytes.Equal(voteCommitment.Commitment, types.VoteCommitmentHash(proposalID, voterAddr, options, salt)) {
+		return sdkerrors.Wrapf(types.ErrInvalidVoteReveal, "proposal %d", proposalID)
+	}
+
+	for _, option := range options {
+		if !types.ValidWeightedVoteOption(option) {
+			return sdkerrors.Wrap(types.ErrInvalidVote, option.String())
+		}
+	}
+
+	if !voteCommitment.Deposit.IsZero() {
+		if err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, voterAddr, voteCommitment.Deposit); err != nil {
+			return err
+		}
+	}
+	keeper.deleteVoteCommitment(ctx, proposalID, voterAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevealVote,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyRefundedDeposit, voteCommitment.Deposit.String()),
+		),
+	)
+
+	keeper.castVote(ctx, proposalID, voterAddr, options, "")
+	return nil
+}
+
+// StartRevealPeriod moves a private proposal whose voting period has ended to
+// its reveal period, at the end of which it is tallied. It returns true if the
+// proposal is private.
+func (keeper Keeper) StartRevealPeriod(ctx sdk.Context, proposal types.Proposal) bool {
+	if !proposal.IsPrivate {
+		return false
+	}
+
+	keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+	proposal.Status = types.StatusRevealPeriod
+	proposal.RevealEndTime = proposal.VotingEndTime.Add(keeper.GetVotingParams(ctx).RevealPeriod)
+	keeper.SetProposal(ctx, proposal)
+
+	keeper.InsertRevealProposalQueue(ctx, proposal.ProposalId, proposal.RevealEndTime)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevealPeriod,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyRevealPeriodEnd, proposal.RevealEndTime.Format(time.RFC3339Nano)),
+		),
+	)
+
+	return true
+}
+
+// BurnUnrevealedVoteCommitments burns the deposits of the vote commitments of
+// a proposal which were not revealed by the end of its reveal period, and
+// deletes them. It returns the burned amount.
+func (keeper Keeper) BurnUnrevealedVoteCommitments(ctx sdk.Context, proposalID uint64) (burned sdk.Coins) {
+	for _, voteCommitment := range keeper.GetVoteCommitments(ctx, proposalID) {
+		if !voteCommitment.Deposit.IsZero() {
+			if err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, voteCommitment.Deposit); err != nil {
+				panic(err)
+			}
+			burned = burned.Add(voteCommitment.Deposit...)
+		}
+
+		voter, err := sdk.AccAddressFromBech32(voteCommitment.Voter)
+		if err != nil {
+			panic(err)
+		}
+		keeper.deleteVoteCommitment(ctx, proposalID, voter)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUnrevealedVote,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+				sdk.NewAttribute(types.AttributeKeyVoter, voteCommitment.Voter),
+				sdk.NewAttribute(types.AttributeKeyBurnedDeposit, voteCommitment.Deposit.String()),
+			),
+		)
+	}
+
+	return burned
+}
+
+// RefundAndDeleteVoteCommitments refunds the deposits of the vote commitments
+// of a proposal and deletes them.
+func (keeper Keeper) RefundAndDeleteVoteCommitments(ctx sdk.Context, proposalID uint64) {
+	for _, voteCommitment := range keeper.GetVoteCommitments(ctx, proposalID) {
+		voter, err := sdk.AccAddressFromBech32(voteCommitment.Voter)
+		if err != nil {
+			panic(err)
+		}
+
+		if !voteCommitment.Deposit.IsZero() {
+			if err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, voter, voteCommitment.Deposit); err != nil {
+				panic(err)
+			}
+		}
+		keeper.deleteVoteCommitment(ctx, proposalID, voter)
+	}
+}
+
+// GetAllVoteCommitments returns all the vote commitments from the store
+func (keeper Keeper) GetAllVoteCommitments(ctx sdk.Context) (commitments []types.VoteCommitment) {
+	keeper.IterateAllVoteCommitments(ctx, func(commitment types.VoteCommitment) bool {
+		commitments = append(commitments, commitment)
+		return false
+	})
+	return
+}
+
+// GetVoteCommitments returns all the vote commitments on a proposal
+func (keeper Keeper) GetVoteCommitments(ctx sdk.Context, proposalID uint64) (commitments []types.VoteCommitment) {
+	keeper.IterateVoteCommitments(ctx, proposalID, func(commitment types.VoteCommitment) bool {
+		commitments = append(commitments, commitment)
+		return false
+	})
+	return
+}
+
+// GetVoteCommitment gets the vote commitment of an address on a specific
+// proposal
+func (keeper Keeper) GetVoteCommitment(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) (commitment types.VoteCommitment, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.VoteCommitmentKey(proposalID, voterAddr))
+	if bz == nil {
+		return commitment, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &commitment)
+	return commitment, true
+}
+
+// SetVoteCommitment sets a VoteCommitment to the gov store
+func (keeper Keeper) SetVoteCommitment(ctx sdk.Context, commitment types.VoteCommitment) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&commitment)
+	addr, err := sdk.AccAddressFromBech32(commitment.Voter)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.VoteCommitmentKey(commitment.ProposalId, addr), bz)
+}
+
+// IterateAllVoteCommitments iterates over the all the stored vote commitments
+// and performs a callback function
+func (keeper Keeper) IterateAllVoteCommitments(ctx sdk.Context, cb func(commitment types.VoteCommitment) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteCommitmentsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var commitment types.VoteCommitment
+		keeper.cdc.MustUnmarshal(iterator.Value(), &commitment)
+
+		if cb(commitment) {
+			break
+		}
+	}
+}
+
+// IterateVoteCommitments iterates over the all the vote commitments on a
+// proposal and performs a callback function
+func (keeper Keeper) IterateVoteCommitments(ctx sdk.Context, proposalID uint64, cb func(commitment types.VoteCommitment) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteCommitmentsKey(proposalID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var commitment types.VoteCommitment
+		keeper.cdc.MustUnmarshal(iterator.Value(), &commitment)
+
+		if cb(commitment) {
+			break
+		}
+	}
+}
+
+// deleteVoteCommitment deletes a vote commitment from a given proposalID and
+// voter from the store
+func (keeper Keeper) deleteVoteCommitment(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.VoteCommitmentKey(proposalID, voterAddr))
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestVoteCommitments(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+	proposer, voter := addrs[0], addrs[1]
+
+	commitmentDeposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.RevealPeriod = time.Hour
+	votingParams.VoteCommitmentDeposit = commitmentDeposit
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	proposal, err := app.GovKeeper.SubmitPrivateProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposal.Proposer = proposer.String()
+	app.GovKeeper.SetProposal(ctx, proposal)
+	proposalID := proposal.ProposalId
+
+	commitment := types.VoteCommitmentHash(proposalID, voter, types.NewNonSplitVoteOption(types.OptionYes), "salt")
+
+	// commitments are only accepted during the voting period
+	require.ErrorIs(t, app.GovKeeper.CommitVote(ctx, proposalID, voter, commitment), types.ErrInactiveProposal)
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, proposer, app.GovKeeper.GetDepositParams(ctx).MinDeposit)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, app.GovKeeper.CommitVote(ctx, proposalID, voter, commitment[:16]), types.ErrInvalidVote)
+
+	balance := app.BankKeeper.GetAllBalances(ctx, voter)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, app.GovKeeper.CommitVote(ctx, proposalID, voter, commitment))
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeCommitVote,
+		sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		sdk.NewAttribute(types.AttributeKeyCommitment, hex.EncodeToString(commitment)),
+	))
+	require.Equal(t, balance.Sub(commitmentDeposit), app.BankKeeper.GetAllBalances(ctx, voter))
+
+	voteCommitment, found := app.GovKeeper.GetVoteCommitment(ctx, proposalID, voter)
+	require.True(t, found)
+	require.Equal(t, types.NewVoteCommitment(proposalID, voter, commitment, commitmentDeposit), voteCommitment)
+
+	// the escrowed commitment deposits are held by the module account
+	_, broken := keeper.ModuleAccountInvariant(app.GovKeeper, app.BankKeeper)(ctx)
+	require.False(t, broken)
+
+	// canceling the proposal refunds the commitment deposits
+	require.NoError(t, app.GovKeeper.CancelProposal(ctx, proposalID, proposer))
+	require.Equal(t, balance, app.BankKeeper.GetAllBalances(ctx, voter))
+	require.Empty(t, app.GovKeeper.GetAllVoteCommitments(ctx))
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// issueVoteReceipt issues the vote receipt of a voter on a proposal if vote
+// receipts are enabled and the voter has no receipt for the proposal yet. The
+// receipt is stored and passed to the vote receipt issuer atomically: if the
+// issuer fails, the error is logged, the vote is kept, and the receipt is
+// retried at the next vote of the voter.
+func (keeper Keeper) issueVoteReceipt(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+	if !keeper.GetVotingParams(ctx).VoteReceiptsEnabled {
+		return
+	}
+	if _, found := keeper.GetVoteReceipt(ctx, proposalID, voterAddr); found {
+		return
+	}
+
+	receipt := types.NewVoteReceipt(proposalID, voterAddr, ctx.BlockHeight())
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	keeper.SetVoteReceipt(cacheCtx, receipt)
+
+	if keeper.receiptIssuer != nil {
+		if err := keeper.receiptIssuer.IssueVoteReceipt(cacheCtx, receipt); err != nil {
+			keeper.Logger(ctx).Error(
+				"failed to issue vote receipt",
+				"proposal", proposalID,
+				"voter", receipt.Voter,
+				"err", err,
+			)
+
+			return
+		}
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoteReceipt,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyVoter, receipt.Voter),
+		),
+	)
+}
+
+// GetVoteReceipt gets the vote receipt of a voter on a specific proposal
+func (keeper Keeper) GetVoteReceipt(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) (receipt types.VoteReceipt, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.VoteReceiptKey(proposalID, voterAddr))
+	if bz == nil {
+		return receipt, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &receipt)
+	return receipt, true
+}
+
+// SetVoteReceipt sets a vote receipt in the store
+func (keeper Keeper) SetVoteReceipt(ctx sdk.Context, receipt types.VoteReceipt) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&receipt)
+	addr, err := sdk.AccAddressFromBech32(receipt.Voter)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.VoteReceiptKey(receipt.ProposalId, addr), bz)
+}
+
+// IterateAllVoteReceipts iterates over all the stored vote receipts and
+// performs a callback function
+func (keeper Keeper) IterateAllVoteReceipts(ctx sdk.Context, cb func(receipt types.VoteReceipt) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteReceiptsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var receipt types.VoteReceipt
+		keeper.cdc.MustUnmarshal(iterator.Value(), &receipt)
+
+		if cb(receipt) {
+			break
+		}
+	}
+}
+
+// GetAllVoteReceipts returns all the vote receipts from the store
+func (keeper Keeper) GetAllVoteReceipts(ctx sdk.Context) (receipts []types.VoteReceipt) {
+	keeper.IterateAllVoteReceipts(ctx, func(receipt types.VoteReceipt) bool {
+		receipts = append(receipts, receipt)
+		return false
+	})
+	return
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type mockReceiptIssuer struct {
+	receipts []types.VoteReceipt
+	fail     bool
+}
+
+func (m *mockReceiptIssuer) IssueVoteReceipt(_ sdk.Context, receipt types.VoteReceipt) error {
+	if m.fail {
+		return errors.New("issuer failure")
+	}
+
+	m.receipts = append(m.receipts, receipt)
+	return nil
+}
+
+func TestVoteReceipts(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+	issuer := &mockReceiptIssuer{}
+	app.GovKeeper.SetVoteReceiptIssuer(issuer)
+	require.Panics(t, func() { app.GovKeeper.SetVoteReceiptIssuer(issuer) })
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalId
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	// no receipt while vote receipts are disabled
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	_, found := app.GovKeeper.GetVoteReceipt(ctx, proposalID, addrs[0])
+	require.False(t, found)
+	require.Empty(t, issuer.receipts)
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.VoteReceiptsEnabled = true
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionNo)))
+	receipt, found := app.GovKeeper.GetVoteReceipt(ctx, proposalID, addrs[0])
+	require.True(t, found)
+	require.Equal(t, types.NewVoteReceipt(proposalID, addrs[0], 10), receipt)
+	require.Equal(t, []types.VoteReceipt{receipt}, issuer.receipts)
+
+	// the receipt is issued once per voter per proposal
+	require.NoError(t, app.GovKeeper.AddVote(ctx.WithBlockHeight(11), proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.Len(t, issuer.receipts, 1)
+
+	// the vote is kept if the issuer fails, and the receipt retried at the next vote
+	issuer.fail = true
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
+	_, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[1])
+	require.True(t, found)
+	_, found = app.GovKeeper.GetVoteReceipt(ctx, proposalID, addrs[1])
+	require.False(t, found)
+
+	issuer.fail = false
+	require.NoError(t, app.GovKeeper.AddVote(ctx.WithBlockHeight(12), proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
+	receipt, found = app.GovKeeper.GetVoteReceipt(ctx, proposalID, addrs[1])
+	require.True(t, found)
+	require.Equal(t, int64(12), receipt.Height)
+	require.Len(t, app.GovKeeper.GetAllVoteReceipts(ctx), 2)
+}
@@ -1,7 +1,9 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -92,3 +94,83 @@ func TestVotes(t *testing.T) {
 	require.True(t, votes[1].Options[3].Weight.Equal(sdk.NewDecWithPrec(5, 2)))
 	require.Equal(t, types.OptionEmpty, vote.Option)
 }
+
+func TestValidatorVotingPeriod(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	addrs, _ := createValidators(t, ctx, app, []int64{5, 5, 5})
+
+	validatorVotingPeriod := time.Hour
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.ValidatorVotingPeriod = validatorVotingPeriod
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalId
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, proposal.VotingStartTime.Add(validatorVotingPeriod), proposal.ValidatorVotingEndTime)
+	require.True(t, app.GovKeeper.InValidatorVotingPeriod(ctx, proposal))
+
+	// only validators can vote in the validator voting period
+	err = app.GovKeeper.AddVote(ctx, proposalID, addrs[3], types.NewNonSplitVoteOption(types.OptionYes))
+	require.ErrorIs(t, err, types.ErrValidatorVotingPeriod)
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionNo)))
+
+	// all accounts can vote afterwards, with the validator votes visible
+	ctx = ctx.WithBlockTime(proposal.ValidatorVotingEndTime)
+	require.False(t, app.GovKeeper.InValidatorVotingPeriod(ctx, proposal))
+	votes := app.GovKeeper.GetVotes(ctx, proposalID)
+	require.Len(t, votes, 1)
+	require.Equal(t, addrs[0].String(), votes[0].Voter)
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[3], types.NewNonSplitVoteOption(types.OptionYes)))
+
+	// the validator voting period is disabled by default
+	votingParams.ValidatorVotingPeriod = 0
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+	proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.True(t, proposal.ValidatorVotingEndTime.IsZero())
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[4], types.NewNonSplitVoteOption(types.OptionYes)))
+}
+
+func TestVoteMetadata(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalId
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	tooLong := strings.Repeat("a", types.MaxVoteMetadataLength+1)
+	err = app.GovKeeper.AddVoteWithMetadata(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionNo), tooLong)
+	require.ErrorIs(t, err, types.ErrInvalidVote)
+
+	// the metadata is stored with the vote
+	rationale := "the upgrade isn't audited yet"
+	require.NoError(t, app.GovKeeper.AddVoteWithMetadata(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionNo), rationale))
+	vote, found := app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
+	require.True(t, found)
+	require.Equal(t, rationale, vote.Metadata)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
+	vote, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[1])
+	require.True(t, found)
+	require.Empty(t, vote.Metadata)
+
+	// changing the vote replaces its metadata
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	vote, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
+	require.True(t, found)
+	require.Empty(t, vote.Metadata)
+}
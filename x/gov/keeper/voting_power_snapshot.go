@@ -0,0 +1,277 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SnapshotVotingPower snapshots the bonded validators and their delegations
+// when a proposal enters its voting period, so that the proposal is tallied
+// with the stake bonded at that time rather than the stake bonded at tally
+// time. The snapshot is taken without consuming gas, as it iterates over all
+// the delegations regardless of the transaction which activated the proposal.
+func (keeper Keeper) SnapshotVotingPower(ctx sdk.Context, proposalID uint64) {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	snapshot := types.VotingPowerSnapshot{
+		ProposalId:  proposalID,
+		TotalBonded: keeper.sk.TotalBondedTokens(ctx),
+	}
+
+	bonded := make(map[string]bool)
+	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
+		snapshot.Validators = append(snapshot.Validators, types.NewValidatorVotingPower(
+			validator.GetOperator(), validator.GetBondedTokens(), validator.GetDelegatorShares(),
+		))
+		bonded[validator.GetOperator().String()] = true
+
+		return false
+	})
+
+	keeper.sk.IterateAllDelegations(ctx, func(delegation stakingtypes.Delegation) (stop bool) {
+		if bonded[delegation.ValidatorAddress] {
+			snapshot.Delegations = append(snapshot.Delegations, types.DelegationVotingPower{
+				DelegatorAddress: delegation.DelegatorAddress,
+				ValidatorAddress: delegation.ValidatorAddress,
+				Shares:           delegation.Shares,
+			})
+		}
+
+		return false
+	})
+
+	keeper.SetVotingPowerSnapshot(ctx, snapshot)
+}
+
+// GetVotingPowerSnapshot gets the voting power snapshot of a proposal
+func (keeper Keeper) GetVotingPowerSnapshot(ctx sdk.Context, proposalID uint64) (snapshot types.VotingPowerSnapshot, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.VotingPowerSnapshotKey(proposalID))
+	if bz == nil {
+		return snapshot, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &snapshot)
+
+	keeper.iterateSnapshotValidators(ctx, proposalID, func(val types.ValidatorVotingPower) bool {
+		snapshot.Validators = append(snapshot.Validators, val)
+		return false
+	})
+
+	iterator := sdk.KVStorePrefixIterator(store, types.SnapshotDelegationsKey(proposalID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var del types.DelegationVotingPower
+		keeper.cdc.MustUnmarshal(iterator.Value(), &del)
+		snapshot.Delegations = append(snapshot.Delegations, del)
+	}
+
+	return snapshot, true
+}
+
+// SetVotingPowerSnapshot sets a VotingPowerSnapshot to the gov store. Its
+// validators and delegations are stored under their own keys, so that the
+// delegations of a voter can be looked up without reading the whole snapshot.
+// They are written as a single batch.
+func (keeper Keeper) SetVotingPowerSnapshot(ctx sdk.Context, snapshot types.VotingPowerSnapshot) {
+	batch := sdk.NewKVStoreBatch(ctx.KVStore(keeper.storeKey))
+
+	for _, val := range snapshot.Validators {
+		valAddr, err := sdk.ValAddressFromBech32(val.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		batch.Set(types.SnapshotValidatorKey(snapshot.ProposalId, valAddr), keeper.cdc.MustMarshal(&val))
+	}
+
+	for _, del := range snapshot.Delegations {
+		delAddr, err := sdk.AccAddressFromBech32(del.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		valAddr, err := sdk.ValAddressFromBech32(del.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		batch.Set(types.SnapshotDelegationKey(snapshot.ProposalId, delAddr, valAddr), keeper.cdc.MustMarshal(&del))
+	}
+
+	header := types.VotingPowerSnapshot{
+		ProposalId:            snapshot.ProposalId,
+		TotalBonded:           snapshot.TotalBonded,
+		CheckpointVotingPower: snapshot.CheckpointVotingPower,
+	}
+	batch.Set(types.VotingPowerSnapshotKey(snapshot.ProposalId), keeper.cdc.MustMarshal(&header))
+	batch.Write()
+}
+
+// GetAllVotingPowerSnapshots returns all the voting power snapshots from the
+// store
+func (keeper Keeper) GetAllVotingPowerSnapshots(ctx sdk.Context) (snapshots []types.VotingPowerSnapshot) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VotingPowerSnapshotsKeyPrefix)
+
+	var proposalIDs []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		proposalIDs = append(proposalIDs, types.GetProposalIDFromBytes(iterator.Key()[1:]))
+	}
+	iterator.Close()
+
+	for _, proposalID := range proposalIDs {
+		snapshot, _ := keeper.GetVotingPowerSnapshot(ctx, proposalID)
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots
+}
+
+// CheckpointParticipation records in the voting power snapshot of a regular
+// proposal the voting power which participated in the vote so far, against
+// which the participation at its voting end time is compared to decide whether
+// to extend its voting period. The checkpoint is taken once, and not for the
+// proposals without a snapshot. It returns true if the checkpoint has been
+// taken.
+func (keeper Keeper) CheckpointParticipation(ctx sdk.Context, proposal types.Proposal) bool {
+	if proposal.IsExpedited || proposal.IsPrivate || proposal.VotingPeriodExtended {
+		return false
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.VotingPowerSnapshotKey(proposal.ProposalId))
+	if bz == nil {
+		return false
+	}
+
+	var header types.VotingPowerSnapshot
+	keeper.cdc.MustUnmarshal(bz, &header)
+	if header.CheckpointVotingPower != nil {
+		return false
+	}
+
+	totalVotingPower, _ := keeper.participation(ctx, proposal)
+	header.CheckpointVotingPower = &totalVotingPower
+	store.Set(types.VotingPowerSnapshotKey(proposal.ProposalId), keeper.cdc.MustMarshal(&header))
+
+	return true
+}
+
+// checkpointVotingPower returns the voting power recorded by the participation
+// checkpoint of a proposal, or zero if no checkpoint has been taken.
+func (keeper Keeper) checkpointVotingPower(ctx sdk.Context, proposalID uint64) sdk.Dec {
+	bz := ctx.KVStore(keeper.storeKey).Get(types.VotingPowerSnapshotKey(proposalID))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var header types.VotingPowerSnapshot
+	keeper.cdc.MustUnmarshal(bz, &header)
+	if header.CheckpointVotingPower == nil {
+		return sdk.ZeroDec()
+	}
+
+	return *header.CheckpointVotingPower
+}
+
+// DeleteVotingPowerSnapshot deletes the voting power snapshot of a proposal,
+// once the proposal has been tallied
+func (keeper Keeper) DeleteVotingPowerSnapshot(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	batch := sdk.NewKVStoreBatch(store)
+	for _, prefix := range [][]byte{types.SnapshotValidatorsKey(proposalID), types.SnapshotDelegationsKey(proposalID)} {
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			batch.Delete(iterator.Key())
+		}
+		iterator.Close()
+	}
+
+	batch.Delete(types.VotingPowerSnapshotKey(proposalID))
+	batch.Write()
+}
+
+// tallyValidators returns the validators a proposal is tallied with by
+// operator address along with the total bonded tokens: those of the voting
+// power snapshot of the proposal if it has one, or the currently bonded ones
+// for the proposals which entered their voting period before snapshots were
+// taken.
+func (keeper Keeper) tallyValidators(ctx sdk.Context, proposalID uint64) (validators map[string]types.ValidatorGovInfo, totalBonded sdk.Int, snapshotted bool) {
+	validators = make(map[string]types.ValidatorGovInfo)
+
+	bz := ctx.KVStore(keeper.storeKey).Get(types.VotingPowerSnapshotKey(proposalID))
+	if bz == nil {
+		// fetch all the bonded validators
+		keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
+			validators[validator.GetOperator().String()] = types.NewValidatorGovInfo(
+				validator.GetOperator(),
+				validator.GetBondedTokens(),
+				validator.GetDelegatorShares(),
+				sdk.ZeroDec(),
+				types.WeightedVoteOptions{},
+			)
+
+			return false
+		})
+
+		return validators, keeper.sk.TotalBondedTokens(ctx), false
+	}
+
+	var snapshot types.VotingPowerSnapshot
+	keeper.cdc.MustUnmarshal(bz, &snapshot)
+
+	keeper.iterateSnapshotValidators(ctx, proposalID, func(val types.ValidatorVotingPower) bool {
+		valAddr, err := sdk.ValAddressFromBech32(val.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		validators[val.ValidatorAddress] = types.NewValidatorGovInfo(
+			valAddr, val.BondedTokens, val.DelegatorShares, sdk.ZeroDec(), types.WeightedVoteOptions{},
+		)
+
+		return false
+	})
+
+	return validators, snapshot.TotalBonded, true
+}
+
+// iterateTallyDelegations iterates over the delegations of an account a
+// proposal is tallied with, from its voting power snapshot if snapshotted is
+// set, and performs a callback function with the validator operator address
+// and the shares of each delegation
+func (keeper Keeper) iterateTallyDelegations(
+	ctx sdk.Context, proposalID uint64, snapshotted bool, delAddr sdk.AccAddress, cb func(valAddr string, shares sdk.Dec),
+) {
+	if !snapshotted {
+		keeper.sk.IterateDelegations(ctx, delAddr, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+			cb(delegation.GetValidatorAddr().String(), delegation.GetShares())
+			return false
+		})
+		return
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SnapshotDelegatorDelegationsKey(proposalID, delAddr))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var del types.DelegationVotingPower
+		keeper.cdc.MustUnmarshal(iterator.Value(), &del)
+		cb(del.ValidatorAddress, del.Shares)
+	}
+}
+
+func (keeper Keeper) iterateSnapshotValidators(ctx sdk.Context, proposalID uint64, cb func(val types.ValidatorVotingPower) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SnapshotValidatorsKey(proposalID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ValidatorVotingPower
+		keeper.cdc.MustUnmarshal(iterator.Value(), &val)
+
+		if cb(val) {
+			break
+		}
+	}
+}
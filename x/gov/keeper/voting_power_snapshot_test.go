@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestVotingPowerSnapshot(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	createValidators(t, ctx, app, []int64{5, 6, 7})
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	_, found := app.GovKeeper.GetVotingPowerSnapshot(ctx, proposal.ProposalId)
+	require.False(t, found)
+
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	snapshot, found := app.GovKeeper.GetVotingPowerSnapshot(ctx, proposal.ProposalId)
+	require.True(t, found)
+	require.NoError(t, snapshot.Validate())
+	require.Equal(t, proposal.ProposalId, snapshot.ProposalId)
+	require.Equal(t, app.StakingKeeper.TotalBondedTokens(ctx), snapshot.TotalBonded)
+
+	// the snapshot holds the bonded validators, including the genesis
+	// validator, and their delegations
+	bonded := app.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	require.Len(t, snapshot.Validators, len(bonded))
+	for _, val := range snapshot.Validators {
+		valAddr, err := sdk.ValAddressFromBech32(val.ValidatorAddress)
+		require.NoError(t, err)
+		validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.True(t, found)
+		require.Equal(t, validator.GetBondedTokens(), val.BondedTokens)
+		require.Equal(t, validator.GetDelegatorShares(), val.DelegatorShares)
+	}
+	require.Len(t, snapshot.Delegations, len(app.StakingKeeper.GetAllDelegations(ctx)))
+
+	require.Equal(t, []types.VotingPowerSnapshot{snapshot}, app.GovKeeper.GetAllVotingPowerSnapshots(ctx))
+
+	app.GovKeeper.DeleteVotingPowerSnapshot(ctx, proposal.ProposalId)
+	_, found = app.GovKeeper.GetVotingPowerSnapshot(ctx, proposal.ProposalId)
+	require.False(t, found)
+	require.Empty(t, app.GovKeeper.GetAllVotingPowerSnapshots(ctx))
+}
+
+func TestCheckpointParticipation(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, _ := createValidators(t, ctx, app, []int64{5, 6, 7})
+
+	// no checkpoint is taken for the proposals without a snapshot
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	require.False(t, app.GovKeeper.CheckpointParticipation(ctx, proposal))
+
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+
+	snapshot, found := app.GovKeeper.GetVotingPowerSnapshot(ctx, proposal.ProposalId)
+	require.True(t, found)
+	require.Nil(t, snapshot.CheckpointVotingPower)
+
+	require.True(t, app.GovKeeper.CheckpointParticipation(ctx, proposal))
+	snapshot, found = app.GovKeeper.GetVotingPowerSnapshot(ctx, proposal.ProposalId)
+	require.True(t, found)
+	require.NotNil(t, snapshot.CheckpointVotingPower)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 5).ToDec(), *snapshot.CheckpointVotingPower)
+
+	// the checkpoint is taken once, and kept when the snapshot is set again
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.False(t, app.GovKeeper.CheckpointParticipation(ctx, proposal))
+	app.GovKeeper.SetVotingPowerSnapshot(ctx, snapshot)
+	snapshot, found = app.GovKeeper.GetVotingPowerSnapshot(ctx, proposal.ProposalId)
+	require.True(t, found)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 5).ToDec(), *snapshot.CheckpointVotingPower)
+
+	// no checkpoint is taken for expedited proposals
+	proposal.IsExpedited = true
+	require.False(t, app.GovKeeper.CheckpointParticipation(ctx, proposal))
+}
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// delegationVotingPower is the voting power of a delegation a proposal is
+// tallied with.
+type delegationVotingPower struct {
+	delegator   string
+	votingPower sdk.Dec
+}
+
+// votingPowerTransform transforms the voting power of the accounts a proposal
+// is tallied with according to the conviction voting, quadratic voting and
+// voting power cap tally params. The voting power of each delegation is first
+// multiplied by its conviction multiplier, then the cap and the square root
+// apply to the total voting power of each account, so that the voting power of
+// its delegations is scaled alike whether it is cast by the account, its
+// governor, or inherited by the validators.
+type votingPowerTransform struct {
+	// the voting power of the delegations by validator operator address
+	delegations map[string][]delegationVotingPower
+	// the conviction multipliers of the delegations by delegator and validator
+	// operator address, if conviction voting is enabled
+	multipliers map[string]sdk.Dec
+	// the ratio of the transformed to the original voting power by delegator
+	// address
+	scales map[string]sdk.Dec
+	// the sum of the transformed voting power of all the accounts
+	total sdk.Dec
+}
+
+// votingPowerTransform returns the voting power transform a proposal is
+// tallied with, computed from the delegations to the given validators, or nil
+// if the tally params don't transform the voting power. The voting power cap
+// is a fraction of the current total supply of the bond denom, and the
+// conviction multipliers grow with the bonding duration of the delegations at
+// the current block time.
+func (keeper Keeper) votingPowerTransform(
+	ctx sdk.Context, proposalID uint64, validators map[string]types.ValidatorGovInfo, snapshotted bool,
+) *votingPowerTransform {
+	tallyParams := keeper.GetTallyParams(ctx)
+	if !tallyParams.TransformsVotingPower() {
+		return nil
+	}
+
+	powerCap := sdk.ZeroDec()
+	if tallyParams.VotingPowerCap.IsPositive() {
+		supply := keeper.bankKeeper.GetSupply(ctx, keeper.sk.BondDenom(ctx))
+		powerCap = tallyParams.VotingPowerCap.MulInt(supply.Amount)
+	}
+
+	transform := &votingPowerTransform{
+		delegations: make(map[string][]delegationVotingPower),
+		multipliers: make(map[string]sdk.Dec),
+		scales:      make(map[string]sdk.Dec),
+		total:       sdk.ZeroDec(),
+	}
+
+	var delegators []string
+	votingPowers := make(map[string]sdk.Dec)
+	addDelegation := func(delAddrStr, valAddrStr string, shares sdk.Dec) {
+		val, ok := validators[valAddrStr]
+		if !ok {
+			return
+		}
+
+		// delegation shares * bonded / total shares
+		votingPower := shares.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+		transform.delegations[valAddrStr] = append(transform.delegations[valAddrStr], delegationVotingPower{delAddrStr, votingPower})
+
+		if tallyParams.ConvictionVoting() {
+			multiplier := keeper.convictionMultiplier(ctx, tallyParams, delAddrStr, valAddrStr)
+			transform.multipliers[delegationKey(delAddrStr, valAddrStr)] = multiplier
+			votingPower = votingPower.Mul(multiplier)
+		}
+
+		if _, ok := votingPowers[delAddrStr]; !ok {
+			delegators = append(delegators, delAddrStr)
+			votingPowers[delAddrStr] = sdk.ZeroDec()
+		}
+		votingPowers[delAddrStr] = votingPowers[delAddrStr].Add(votingPower)
+	}
+
+	if snapshotted {
+		iterator := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), types.SnapshotDelegationsKey(proposalID))
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			var del types.DelegationVotingPower
+			keeper.cdc.MustUnmarshal(iterator.Value(), &del)
+			addDelegation(del.DelegatorAddress, del.ValidatorAddress, del.Shares)
+		}
+	} else {
+		keeper.sk.IterateAllDelegations(ctx, func(delegation stakingtypes.Delegation) (stop bool) {
+			addDelegation(delegation.DelegatorAddress, delegation.ValidatorAddress, delegation.Shares)
+			return false
+		})
+	}
+
+	for _, delAddrStr := range delegators {
+		votingPower := votingPowers[delAddrStr]
+		transformed := tallyParams.TransformVotingPower(votingPower, powerCap)
+		transform.total = transform.total.Add(transformed)
+		if votingPower.IsPositive() {
+			transform.scales[delAddrStr] = transformed.Quo(votingPower)
+		}
+	}
+
+	return transform
+}
+
+// votingPower returns the transformed voting power of a delegation from the
+// given delegator to the given validator.
+func (t *votingPowerTransform) votingPower(delAddrStr, valAddrStr string, votingPower sdk.Dec) sdk.Dec {
+	scale, ok := t.scales[delAddrStr]
+	if !ok {
+		return sdk.ZeroDec()
+	}
+
+	if multiplier, ok := t.multipliers[delegationKey(delAddrStr, valAddrStr)]; ok {
+		votingPower = votingPower.Mul(multiplier)
+	}
+
+	return votingPower.Mul(scale)
+}
+
+// inheritedVotingPower returns the transformed voting power of the delegations
+// to a validator whose delegators are not counted with a vote of their own or
+// of their governor, which the validator votes with.
+func (t *votingPowerTransform) inheritedVotingPower(valAddrStr string, counted func(delAddrStr string) bool) sdk.Dec {
+	inherited := sdk.ZeroDec()
+	for _, del := range t.delegations[valAddrStr] {
+		if !counted(del.delegator) {
+			inherited = inherited.Add(t.votingPower(del.delegator, valAddrStr, del.votingPower))
+		}
+	}
+
+	return inherited
+}
+
+// totalBonded returns the transformed voting power of all the accounts, which
+// the quorum and the thresholds relative to the bonded tokens are computed
+// against.
+func (t *votingPowerTransform) totalBonded() sdk.Int {
+	return t.total.TruncateInt()
+}
+
+func delegationKey(delAddrStr, valAddrStr string) string {
+	return delAddrStr + "/" + valAddrStr
+}
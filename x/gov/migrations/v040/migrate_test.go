@@ -95,33 +95,64 @@ func TestMigrate(t *testing.T) {
 	// - SoftwareUpgradeProposal has correct JSON.
 	// - ParameterChangeProposal has correct JSON.
 	expected := `{
+	"archived_proposals": [],
+	"choice_votes": [],
+	"delegation_bondings": [],
 	"deposit_params": {
+		"burn_proposal_deposit_prevote": false,
+		"burn_submission_fee": false,
+		"burn_vote_quorum": false,
+		"burn_vote_veto": false,
+		"cancel_burn_ratio": "0",
 		"max_deposit_period": "0s",
-		"min_deposit": []
+		"min_deposit": [],
+		"min_initial_deposit_ratio": "0",
+		"submission_fee": []
 	},
 	"deposits": [],
+	"discussion_anchors": [],
+	"governance_delegations": [],
+	"proposal_templates": [],
 	"proposals": [
 		{
+			"choice_tally_results": [],
+			"choices": [],
 			"content": {
 				"@type": "/cosmos.gov.v1beta1.TextProposal",
 				"description": "bar_text",
 				"title": "foo_text"
 			},
+			"depends_on": [],
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"execution_attempts": 0,
+			"execution_time": "0001-01-01T00:00:00Z",
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
 				"no_with_veto": "0",
 				"yes": "0"
 			},
+			"is_council": false,
+			"is_emergency": false,
+			"is_expedited": false,
+			"is_optimistic": false,
+			"is_private": false,
 			"proposal_id": "0",
+			"proposer": "",
+			"retry_end_time": "0001-01-01T00:00:00Z",
+			"reveal_end_time": "0001-01-01T00:00:00Z",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
 			"total_deposit": [],
+			"validator_voting_end_time": "0001-01-01T00:00:00Z",
 			"voting_end_time": "0001-01-01T00:00:00Z",
-			"voting_start_time": "0001-01-01T00:00:00Z"
+			"voting_period_extended": false,
+			"voting_start_time": "0001-01-01T00:00:00Z",
+			"winning_choice": 0
 		},
 		{
+			"choice_tally_results": [],
+			"choices": [],
 			"content": {
 				"@type": "/cosmos.distribution.v1beta1.CommunityPoolSpendProposal",
 				"amount": [
@@ -134,41 +165,73 @@ func TestMigrate(t *testing.T) {
 				"recipient": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh",
 				"title": "foo_community"
 			},
+			"depends_on": [],
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"execution_attempts": 0,
+			"execution_time": "0001-01-01T00:00:00Z",
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
 				"no_with_veto": "0",
 				"yes": "0"
 			},
+			"is_council": false,
+			"is_emergency": false,
+			"is_expedited": false,
+			"is_optimistic": false,
+			"is_private": false,
 			"proposal_id": "0",
+			"proposer": "",
+			"retry_end_time": "0001-01-01T00:00:00Z",
+			"reveal_end_time": "0001-01-01T00:00:00Z",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
 			"total_deposit": [],
+			"validator_voting_end_time": "0001-01-01T00:00:00Z",
 			"voting_end_time": "0001-01-01T00:00:00Z",
-			"voting_start_time": "0001-01-01T00:00:00Z"
+			"voting_period_extended": false,
+			"voting_start_time": "0001-01-01T00:00:00Z",
+			"winning_choice": 0
 		},
 		{
+			"choice_tally_results": [],
+			"choices": [],
 			"content": {
 				"@type": "/cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal",
 				"description": "bar_cancel_upgrade",
 				"title": "foo_cancel_upgrade"
 			},
+			"depends_on": [],
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"execution_attempts": 0,
+			"execution_time": "0001-01-01T00:00:00Z",
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
 				"no_with_veto": "0",
 				"yes": "0"
 			},
+			"is_council": false,
+			"is_emergency": false,
+			"is_expedited": false,
+			"is_optimistic": false,
+			"is_private": false,
 			"proposal_id": "0",
+			"proposer": "",
+			"retry_end_time": "0001-01-01T00:00:00Z",
+			"reveal_end_time": "0001-01-01T00:00:00Z",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
 			"total_deposit": [],
+			"validator_voting_end_time": "0001-01-01T00:00:00Z",
 			"voting_end_time": "0001-01-01T00:00:00Z",
-			"voting_start_time": "0001-01-01T00:00:00Z"
+			"voting_period_extended": false,
+			"voting_start_time": "0001-01-01T00:00:00Z",
+			"winning_choice": 0
 		},
 		{
+			"choice_tally_results": [],
+			"choices": [],
 			"content": {
 				"@type": "/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal",
 				"description": "bar_software_upgrade",
@@ -176,26 +239,43 @@ func TestMigrate(t *testing.T) {
 					"height": "123",
 					"info": "foo_upgrade_info",
 					"name": "foo_upgrade_name",
+					"preconditions": null,
 					"time": "0001-01-01T00:00:00Z",
 					"upgraded_client_state": null
 				},
 				"title": "foo_software_upgrade"
 			},
+			"depends_on": [],
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"execution_attempts": 0,
+			"execution_time": "0001-01-01T00:00:00Z",
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
 				"no_with_veto": "0",
 				"yes": "0"
 			},
+			"is_council": false,
+			"is_emergency": false,
+			"is_expedited": false,
+			"is_optimistic": false,
+			"is_private": false,
 			"proposal_id": "0",
+			"proposer": "",
+			"retry_end_time": "0001-01-01T00:00:00Z",
+			"reveal_end_time": "0001-01-01T00:00:00Z",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
 			"total_deposit": [],
+			"validator_voting_end_time": "0001-01-01T00:00:00Z",
 			"voting_end_time": "0001-01-01T00:00:00Z",
-			"voting_start_time": "0001-01-01T00:00:00Z"
+			"voting_period_extended": false,
+			"voting_start_time": "0001-01-01T00:00:00Z",
+			"winning_choice": 0
 		},
 		{
+			"choice_tally_results": [],
+			"choices": [],
 			"content": {
 				"@type": "/cosmos.params.v1beta1.ParameterChangeProposal",
 				"changes": [
@@ -208,31 +288,74 @@ func TestMigrate(t *testing.T) {
 				"description": "bar_param_change",
 				"title": "foo_param_change"
 			},
+			"depends_on": [],
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"execution_attempts": 0,
+			"execution_time": "0001-01-01T00:00:00Z",
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
 				"no_with_veto": "0",
 				"yes": "0"
 			},
+			"is_council": false,
+			"is_emergency": false,
+			"is_expedited": false,
+			"is_optimistic": false,
+			"is_private": false,
 			"proposal_id": "0",
+			"proposer": "",
+			"retry_end_time": "0001-01-01T00:00:00Z",
+			"reveal_end_time": "0001-01-01T00:00:00Z",
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
 			"total_deposit": [],
+			"validator_voting_end_time": "0001-01-01T00:00:00Z",
 			"voting_end_time": "0001-01-01T00:00:00Z",
-			"voting_start_time": "0001-01-01T00:00:00Z"
+			"voting_period_extended": false,
+			"voting_start_time": "0001-01-01T00:00:00Z",
+			"winning_choice": 0
 		}
 	],
 	"starting_proposal_id": "0",
 	"tally_params": {
+		"conviction_period": "0s",
+		"emergency_threshold": "0",
+		"expedited_quorum": "0",
+		"expedited_threshold": "0",
+		"max_conviction_multiplier": "0",
+		"optimistic_veto_threshold": "0",
+		"quadratic_voting": false,
 		"quorum": "0",
 		"threshold": "0",
-		"veto_threshold": "0"
+		"veto_threshold": "0",
+		"voting_power_cap": "0"
 	},
+	"validator_participations": [],
+	"vote_commitments": [],
+	"vote_receipts": [],
 	"votes": [],
 	"voting_params": {
+		"archive_batch_size": "0",
+		"archive_prune": false,
+		"archive_retention_period": "0s",
+		"council_members": [],
+		"council_proposal_types": [],
+		"council_voting_period": "0s",
+		"execution_delay": "0s",
+		"execution_gas_limit": "0",
+		"execution_retry_window": "0s",
+		"expedited_voting_period": "0s",
+		"optimistic_authorized_addresses": [],
+		"optimistic_voting_period": "0s",
+		"quorum_extension_period": "0s",
+		"reveal_period": "0s",
+		"validator_voting_period": "0s",
+		"vote_commitment_deposit": [],
+		"vote_receipts_enabled": false,
 		"voting_period": "0s"
-	}
+	},
+	"voting_power_snapshots": []
 }`
 
 	require.Equal(t, expected, string(indentedBz))
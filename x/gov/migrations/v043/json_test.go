@@ -48,20 +48,45 @@ func TestMigrateJSON(t *testing.T) {
 	// Make sure about:
 	// - Votes are all ADR-037 weighted votes with weight 1.
 	expected := `{
+	"archived_proposals": [],
+	"choice_votes": [],
+	"delegation_bondings": [],
 	"deposit_params": {
+		"burn_proposal_deposit_prevote": false,
+		"burn_submission_fee": false,
+		"burn_vote_quorum": false,
+		"burn_vote_veto": false,
+		"cancel_burn_ratio": "0",
 		"max_deposit_period": "0s",
-		"min_deposit": []
+		"min_deposit": [],
+		"min_initial_deposit_ratio": "0",
+		"submission_fee": []
 	},
 	"deposits": [],
+	"discussion_anchors": [],
+	"governance_delegations": [],
+	"proposal_templates": [],
 	"proposals": [],
 	"starting_proposal_id": "0",
 	"tally_params": {
+		"conviction_period": "0s",
+		"emergency_threshold": "0",
+		"expedited_quorum": "0",
+		"expedited_threshold": "0",
+		"max_conviction_multiplier": "0",
+		"optimistic_veto_threshold": "0",
+		"quadratic_voting": false,
 		"quorum": "0",
 		"threshold": "0",
-		"veto_threshold": "0"
+		"veto_threshold": "0",
+		"voting_power_cap": "0"
 	},
+	"validator_participations": [],
+	"vote_commitments": [],
+	"vote_receipts": [],
 	"votes": [
 		{
+			"metadata": "",
 			"option": "VOTE_OPTION_UNSPECIFIED",
 			"options": [
 				{
@@ -73,6 +98,7 @@ func TestMigrateJSON(t *testing.T) {
 			"voter": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"
 		},
 		{
+			"metadata": "",
 			"option": "VOTE_OPTION_UNSPECIFIED",
 			"options": [
 				{
@@ -84,6 +110,7 @@ func TestMigrateJSON(t *testing.T) {
 			"voter": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"
 		},
 		{
+			"metadata": "",
 			"option": "VOTE_OPTION_UNSPECIFIED",
 			"options": [
 				{
@@ -95,6 +122,7 @@ func TestMigrateJSON(t *testing.T) {
 			"voter": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"
 		},
 		{
+			"metadata": "",
 			"option": "VOTE_OPTION_UNSPECIFIED",
 			"options": [
 				{
@@ -106,6 +134,7 @@ func TestMigrateJSON(t *testing.T) {
 			"voter": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"
 		},
 		{
+			"metadata": "",
 			"option": "VOTE_OPTION_UNSPECIFIED",
 			"options": [
 				{
@@ -118,8 +147,26 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"voting_params": {
+		"archive_batch_size": "0",
+		"archive_prune": false,
+		"archive_retention_period": "0s",
+		"council_members": [],
+		"council_proposal_types": [],
+		"council_voting_period": "0s",
+		"execution_delay": "0s",
+		"execution_gas_limit": "0",
+		"execution_retry_window": "0s",
+		"expedited_voting_period": "0s",
+		"optimistic_authorized_addresses": [],
+		"optimistic_voting_period": "0s",
+		"quorum_extension_period": "0s",
+		"reveal_period": "0s",
+		"validator_voting_period": "0s",
+		"vote_commitment_deposit": [],
+		"vote_receipts_enabled": false,
 		"voting_period": "0s"
-	}
+	},
+	"voting_power_snapshots": []
 }`
 
 	fmt.Println(string(indentedBz))
@@ -0,0 +1,117 @@
+package v044
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// MigrateParams performs in-place params migrations from v0.43 to v0.44. The
+// migration includes:
+//
+// - Set the expedited quorum and threshold of the tally params to their default
+//   values, or to the regular quorum and threshold if these are higher.
+//   Expedited proposals stay disabled until the expedited voting period is set.
+func MigrateParams(ctx sdk.Context, paramSpace types.ParamSubspace) {
+	var tallyParams types.TallyParams
+	paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+
+	tallyParams.ExpeditedQuorum = sdk.MaxDec(types.DefaultExpeditedQuorum, tallyParams.Quorum)
+	tallyParams.ExpeditedThreshold = sdk.MaxDec(types.DefaultExpeditedThreshold, tallyParams.Threshold)
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+}
+
+// MigrateDepositParams performs in-place params migrations adding the ratio of
+// the deposits burned on proposal cancellation. The migration includes:
+//
+// - Set the cancel burn ratio of the deposit params to its default value.
+func MigrateDepositParams(ctx sdk.Context, paramSpace types.ParamSubspace) {
+	var depositParams types.DepositParams
+	paramSpace.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+
+	depositParams.CancelBurnRatio = types.DefaultCancelBurnRatio
+	paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+}
+
+// MigrateTallyParams performs in-place params migrations adding the veto
+// threshold of optimistic proposals. The migration includes:
+//
+// - Set the optimistic veto threshold of the tally params to its default
+//   value. Optimistic proposals stay disabled until the optimistic voting
+//   period is set.
+func MigrateTallyParams(ctx sdk.Context, paramSpace types.ParamSubspace) {
+	var tallyParams types.TallyParams
+	paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+
+	tallyParams.OptimisticVetoThreshold = types.DefaultOptimisticVetoThreshold
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+}
+
+// MigrateInitialDepositParams performs in-place params migrations adding the
+// minimum initial deposit ratio. The migration includes:
+//
+// - Set the minimum initial deposit ratio of the deposit params to its default
+//   value, so no initial deposit is required until it is raised.
+func MigrateInitialDepositParams(ctx sdk.Context, paramSpace types.ParamSubspace) {
+	var depositParams types.DepositParams
+	paramSpace.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+
+	depositParams.MinInitialDepositRatio = types.DefaultMinInitialDepositRatio
+	paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+}
+
+// MigrateBurnDepositParams performs in-place params migrations adding the
+// deposit burn policy. The migration includes:
+//
+// - Enable burning the deposits of vetoed, quorum failing and dropped
+//   proposals, which were unconditionally burned until then.
+func MigrateBurnDepositParams(ctx sdk.Context, paramSpace types.ParamSubspace) {
+	var depositParams types.DepositParams
+	paramSpace.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+
+	depositParams.BurnVoteVeto = true
+	depositParams.BurnVoteQuorum = true
+	depositParams.BurnProposalDepositPrevote = true
+	paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+}
+
+// MigrateVotingPowerParams performs in-place params migrations adding the
+// voting power transform of the tally. The migration includes:
+//
+// - Set the voting power cap of the tally params to zero, so the voting power
+//   stays uncapped and quadratic voting disabled until they are enabled.
+func MigrateVotingPowerParams(ctx sdk.Context, paramSpace types.ParamSubspace) {
+	var tallyParams types.TallyParams
+	paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+
+	tallyParams.QuadraticVoting = false
+	tallyParams.VotingPowerCap = sdk.ZeroDec()
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+}
+
+// MigrateConvictionParams performs in-place params migrations adding
+// conviction voting. The migration includes:
+//
+// - Set the conviction period of the tally params to zero and the maximum
+//   conviction multiplier to one, so conviction voting stays disabled until
+//   they are set.
+func MigrateConvictionParams(ctx sdk.Context, paramSpace types.ParamSubspace) {
+	var tallyParams types.TallyParams
+	paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+
+	tallyParams.ConvictionPeriod = 0
+	tallyParams.MaxConvictionMultiplier = sdk.OneDec()
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+}
+
+// MigrateEmergencyParams performs in-place params migrations adding the
+// emergency track. The migration includes:
+//
+// - Set the emergency threshold of the tally params to zero, so emergency
+//   proposals stay disabled until it is set.
+func MigrateEmergencyParams(ctx sdk.Context, paramSpace types.ParamSubspace) {
+	var tallyParams types.TallyParams
+	paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+
+	tallyParams.EmergencyThreshold = sdk.ZeroDec()
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+}
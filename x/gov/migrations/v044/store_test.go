@@ -0,0 +1,177 @@
+package v044_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v044 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v044"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// tally params stored before the expedited quorum and threshold were added
+	store := ctx.KVStore(app.GetKey(paramtypes.StoreKey))
+	store.Set(
+		append([]byte(types.ModuleName+"/"), types.ParamStoreKeyTallyParams...),
+		[]byte(`{"quorum":"0.600000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"}`),
+	)
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+
+	v044.MigrateParams(ctx, app.GetSubspace(types.ModuleName))
+
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), tallyParams.Quorum)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), tallyParams.Threshold)
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), tallyParams.ExpeditedQuorum)
+	require.Equal(t, types.DefaultExpeditedThreshold, tallyParams.ExpeditedThreshold)
+	require.Equal(t, votingParams, app.GovKeeper.GetVotingParams(ctx))
+}
+
+func TestMigrateDepositParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// deposit params stored before the cancel burn ratio was added
+	store := ctx.KVStore(app.GetKey(paramtypes.StoreKey))
+	store.Set(
+		append([]byte(types.ModuleName+"/"), types.ParamStoreKeyDepositParams...),
+		[]byte(`{"min_deposit":[{"denom":"stake","amount":"1000"}],"max_deposit_period":"3600000000000"}`),
+	)
+
+	v044.MigrateDepositParams(ctx, app.GetSubspace(types.ModuleName))
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), depositParams.MinDeposit)
+	require.Equal(t, time.Hour, depositParams.MaxDepositPeriod)
+	require.Equal(t, types.DefaultCancelBurnRatio, depositParams.CancelBurnRatio)
+}
+
+func TestMigrateTallyParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// tally params stored before the optimistic veto threshold was added
+	store := ctx.KVStore(app.GetKey(paramtypes.StoreKey))
+	store.Set(
+		append([]byte(types.ModuleName+"/"), types.ParamStoreKeyTallyParams...),
+		[]byte(`{"quorum":"0.600000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","expedited_quorum":"0.600000000000000000","expedited_threshold":"0.667000000000000000"}`),
+	)
+
+	v044.MigrateTallyParams(ctx, app.GetSubspace(types.ModuleName))
+
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), tallyParams.Quorum)
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), tallyParams.ExpeditedQuorum)
+	require.Equal(t, types.DefaultOptimisticVetoThreshold, tallyParams.OptimisticVetoThreshold)
+}
+
+func TestMigrateInitialDepositParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// deposit params stored before the minimum initial deposit ratio was added
+	store := ctx.KVStore(app.GetKey(paramtypes.StoreKey))
+	store.Set(
+		append([]byte(types.ModuleName+"/"), types.ParamStoreKeyDepositParams...),
+		[]byte(`{"min_deposit":[{"denom":"stake","amount":"1000"}],"max_deposit_period":"3600000000000","cancel_burn_ratio":"0.250000000000000000"}`),
+	)
+
+	v044.MigrateInitialDepositParams(ctx, app.GetSubspace(types.ModuleName))
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), depositParams.MinDeposit)
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), depositParams.CancelBurnRatio)
+	require.Equal(t, types.DefaultMinInitialDepositRatio, depositParams.MinInitialDepositRatio)
+}
+
+func TestMigrateBurnDepositParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// deposit params stored before the deposit burn policy was added
+	store := ctx.KVStore(app.GetKey(paramtypes.StoreKey))
+	store.Set(
+		append([]byte(types.ModuleName+"/"), types.ParamStoreKeyDepositParams...),
+		[]byte(`{"min_deposit":[{"denom":"stake","amount":"1000"}],"max_deposit_period":"3600000000000","cancel_burn_ratio":"0.250000000000000000","min_initial_deposit_ratio":"0.100000000000000000"}`),
+	)
+
+	v044.MigrateBurnDepositParams(ctx, app.GetSubspace(types.ModuleName))
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), depositParams.MinInitialDepositRatio)
+	require.True(t, depositParams.BurnVoteVeto)
+	require.True(t, depositParams.BurnVoteQuorum)
+	require.True(t, depositParams.BurnProposalDepositPrevote)
+}
+
+func TestMigrateVotingPowerParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// tally params stored before the voting power transform was added
+	store := ctx.KVStore(app.GetKey(paramtypes.StoreKey))
+	store.Set(
+		append([]byte(types.ModuleName+"/"), types.ParamStoreKeyTallyParams...),
+		[]byte(`{"quorum":"0.600000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","expedited_quorum":"0.600000000000000000","expedited_threshold":"0.667000000000000000","optimistic_veto_threshold":"0.100000000000000000"}`),
+	)
+
+	v044.MigrateVotingPowerParams(ctx, app.GetSubspace(types.ModuleName))
+
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), tallyParams.Quorum)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), tallyParams.OptimisticVetoThreshold)
+	require.False(t, tallyParams.QuadraticVoting)
+	require.Equal(t, sdk.ZeroDec(), tallyParams.VotingPowerCap)
+}
+
+func TestMigrateConvictionParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// tally params stored before conviction voting was added
+	store := ctx.KVStore(app.GetKey(paramtypes.StoreKey))
+	store.Set(
+		append([]byte(types.ModuleName+"/"), types.ParamStoreKeyTallyParams...),
+		[]byte(`{"quorum":"0.600000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","expedited_quorum":"0.600000000000000000","expedited_threshold":"0.667000000000000000","optimistic_veto_threshold":"0.100000000000000000","quadratic_voting":true,"voting_power_cap":"0.010000000000000000"}`),
+	)
+
+	v044.MigrateConvictionParams(ctx, app.GetSubspace(types.ModuleName))
+
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), tallyParams.Quorum)
+	require.True(t, tallyParams.QuadraticVoting)
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), tallyParams.VotingPowerCap)
+	require.Zero(t, tallyParams.ConvictionPeriod)
+	require.Equal(t, sdk.OneDec(), tallyParams.MaxConvictionMultiplier)
+	require.False(t, tallyParams.ConvictionVoting())
+}
+
+func TestMigrateEmergencyParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// tally params stored before the emergency track was added
+	store := ctx.KVStore(app.GetKey(paramtypes.StoreKey))
+	store.Set(
+		append([]byte(types.ModuleName+"/"), types.ParamStoreKeyTallyParams...),
+		[]byte(`{"quorum":"0.600000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","expedited_quorum":"0.600000000000000000","expedited_threshold":"0.667000000000000000","optimistic_veto_threshold":"0.100000000000000000","voting_power_cap":"0.000000000000000000","conviction_period":"86400000000000","max_conviction_multiplier":"2.000000000000000000"}`),
+	)
+
+	v044.MigrateEmergencyParams(ctx, app.GetSubspace(types.ModuleName))
+
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), tallyParams.Quorum)
+	require.Equal(t, 24*time.Hour, tallyParams.ConvictionPeriod)
+	require.Equal(t, sdk.NewDec(2), tallyParams.MaxConvictionMultiplier)
+	require.Equal(t, sdk.ZeroDec(), tallyParams.EmergencyThreshold)
+	require.False(t, tallyParams.EmergencyTrack())
+}
@@ -30,6 +30,9 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 
 		case bytes.Equal(kvA.Key[:1], types.ActiveProposalQueuePrefix),
 			bytes.Equal(kvA.Key[:1], types.InactiveProposalQueuePrefix),
+			bytes.Equal(kvA.Key[:1], types.OptimisticProposalQueuePrefix),
+			bytes.Equal(kvA.Key[:1], types.RevealProposalQueuePrefix),
+			bytes.Equal(kvA.Key[:1], types.CouncilProposalQueuePrefix),
 			bytes.Equal(kvA.Key[:1], types.ProposalIDKey):
 			proposalIDA := binary.LittleEndian.Uint64(kvA.Value)
 			proposalIDB := binary.LittleEndian.Uint64(kvB.Value)
@@ -47,6 +50,45 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &voteB)
 			return fmt.Sprintf("%v\n%v", voteA, voteB)
 
+		case bytes.Equal(kvA.Key[:1], types.ChoiceVotesKeyPrefix):
+			var voteA, voteB types.ChoiceVote
+			cdc.MustUnmarshal(kvA.Value, &voteA)
+			cdc.MustUnmarshal(kvB.Value, &voteB)
+			return fmt.Sprintf("%v\n%v", voteA, voteB)
+
+		case bytes.Equal(kvA.Key[:1], types.VoteCommitmentsKeyPrefix):
+			var commitmentA, commitmentB types.VoteCommitment
+			cdc.MustUnmarshal(kvA.Value, &commitmentA)
+			cdc.MustUnmarshal(kvB.Value, &commitmentB)
+			return fmt.Sprintf("%v\n%v", commitmentA, commitmentB)
+
+		case bytes.Equal(kvA.Key[:1], types.GovernanceDelegationsKeyPrefix):
+			var delegationA, delegationB types.GovernanceDelegation
+			cdc.MustUnmarshal(kvA.Value, &delegationA)
+			cdc.MustUnmarshal(kvB.Value, &delegationB)
+			return fmt.Sprintf("%v\n%v", delegationA, delegationB)
+
+		case bytes.Equal(kvA.Key[:1], types.GovernanceDelegationsByGovernorKeyPrefix):
+			return fmt.Sprintf("%X\n%X", kvA.Key[1:], kvB.Key[1:])
+
+		case bytes.Equal(kvA.Key[:1], types.VotingPowerSnapshotsKeyPrefix):
+			var snapshotA, snapshotB types.VotingPowerSnapshot
+			cdc.MustUnmarshal(kvA.Value, &snapshotA)
+			cdc.MustUnmarshal(kvB.Value, &snapshotB)
+			return fmt.Sprintf("%v\n%v", snapshotA, snapshotB)
+
+		case bytes.Equal(kvA.Key[:1], types.SnapshotValidatorsKeyPrefix):
+			var validatorA, validatorB types.ValidatorVotingPower
+			cdc.MustUnmarshal(kvA.Value, &validatorA)
+			cdc.MustUnmarshal(kvB.Value, &validatorB)
+			return fmt.Sprintf("%v\n%v", validatorA, validatorB)
+
+		case bytes.Equal(kvA.Key[:1], types.SnapshotDelegationsKeyPrefix):
+			var delegationA, delegationB types.DelegationVotingPower
+			cdc.MustUnmarshal(kvA.Value, &delegationA)
+			cdc.MustUnmarshal(kvB.Value, &delegationB)
+			return fmt.Sprintf("%v\n%v", delegationA, delegationB)
+
 		default:
 			panic(fmt.Sprintf("invalid governance key prefix %X", kvA.Key[:1]))
 		}
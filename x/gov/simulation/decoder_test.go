@@ -19,6 +19,8 @@ import (
 var (
 	delPk1   = ed25519.GenPrivKey().PubKey()
 	delAddr1 = sdk.AccAddress(delPk1.Address())
+	delPk2   = ed25519.GenPrivKey().PubKey()
+	delAddr2 = sdk.AccAddress(delPk2.Address())
 )
 
 func TestDecodeStore(t *testing.T) {
@@ -36,6 +38,13 @@ func TestDecodeStore(t *testing.T) {
 	binary.LittleEndian.PutUint64(proposalIDBz, 1)
 	deposit := types.NewDeposit(1, delAddr1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())))
 	vote := types.NewVote(1, delAddr1, types.NewNonSplitVoteOption(types.OptionYes))
+	choiceVote := types.NewChoiceVote(1, delAddr1, 1)
+	voteCommitment := types.NewVoteCommitment(1, delAddr1, make([]byte, 32), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())))
+	governanceDelegation := types.NewGovernanceDelegation(delAddr1, delAddr2)
+	governanceDelegationByGovernorKey := types.GovernanceDelegationByGovernorKey(delAddr2, delAddr1)
+	votingPowerSnapshot := types.VotingPowerSnapshot{ProposalId: 1, TotalBonded: sdk.NewInt(100)}
+	validatorVotingPower := types.NewValidatorVotingPower(sdk.ValAddress(delAddr2), sdk.NewInt(100), sdk.NewDec(100))
+	delegationVotingPower := types.NewDelegationVotingPower(delAddr1, sdk.ValAddress(delAddr2), sdk.NewDec(10))
 
 	proposalBzA, err := cdc.Marshal(&proposalA)
 	require.NoError(t, err)
@@ -72,6 +81,48 @@ func TestDecodeStore(t *testing.T) {
 			kv.Pair{Key: types.VoteKey(1, delAddr1), Value: cdc.MustMarshal(&vote)},
 			fmt.Sprintf("%v\n%v", vote, vote), false,
 		},
+		{
+			"choice votes",
+			kv.Pair{Key: types.ChoiceVoteKey(1, delAddr1), Value: cdc.MustMarshal(&choiceVote)},
+			kv.Pair{Key: types.ChoiceVoteKey(1, delAddr1), Value: cdc.MustMarshal(&choiceVote)},
+			fmt.Sprintf("%v\n%v", choiceVote, choiceVote), false,
+		},
+		{
+			"vote commitments",
+			kv.Pair{Key: types.VoteCommitmentKey(1, delAddr1), Value: cdc.MustMarshal(&voteCommitment)},
+			kv.Pair{Key: types.VoteCommitmentKey(1, delAddr1), Value: cdc.MustMarshal(&voteCommitment)},
+			fmt.Sprintf("%v\n%v", voteCommitment, voteCommitment), false,
+		},
+		{
+			"governance delegations",
+			kv.Pair{Key: types.GovernanceDelegationKey(delAddr1), Value: cdc.MustMarshal(&governanceDelegation)},
+			kv.Pair{Key: types.GovernanceDelegationKey(delAddr1), Value: cdc.MustMarshal(&governanceDelegation)},
+			fmt.Sprintf("%v\n%v", governanceDelegation, governanceDelegation), false,
+		},
+		{
+			"governance delegations by governor",
+			kv.Pair{Key: governanceDelegationByGovernorKey, Value: []byte{}},
+			kv.Pair{Key: governanceDelegationByGovernorKey, Value: []byte{}},
+			fmt.Sprintf("%X\n%X", governanceDelegationByGovernorKey[1:], governanceDelegationByGovernorKey[1:]), false,
+		},
+		{
+			"voting power snapshots",
+			kv.Pair{Key: types.VotingPowerSnapshotKey(1), Value: cdc.MustMarshal(&votingPowerSnapshot)},
+			kv.Pair{Key: types.VotingPowerSnapshotKey(1), Value: cdc.MustMarshal(&votingPowerSnapshot)},
+			fmt.Sprintf("%v\n%v", votingPowerSnapshot, votingPowerSnapshot), false,
+		},
+		{
+			"snapshot validators",
+			kv.Pair{Key: types.SnapshotValidatorKey(1, sdk.ValAddress(delAddr2)), Value: cdc.MustMarshal(&validatorVotingPower)},
+			kv.Pair{Key: types.SnapshotValidatorKey(1, sdk.ValAddress(delAddr2)), Value: cdc.MustMarshal(&validatorVotingPower)},
+			fmt.Sprintf("%v\n%v", validatorVotingPower, validatorVotingPower), false,
+		},
+		{
+			"snapshot delegations",
+			kv.Pair{Key: types.SnapshotDelegationKey(1, delAddr1, sdk.ValAddress(delAddr2)), Value: cdc.MustMarshal(&delegationVotingPower)},
+			kv.Pair{Key: types.SnapshotDelegationKey(1, delAddr1, sdk.ValAddress(delAddr2)), Value: cdc.MustMarshal(&delegationVotingPower)},
+			fmt.Sprintf("%v\n%v", delegationVotingPower, delegationVotingPower), false,
+		},
 		{
 			"other",
 			kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
@@ -20,6 +20,9 @@ const (
 	subkeyQuorum     = "quorum"
 	subkeyThreshold  = "threshold"
 	subkeyVeto       = "veto"
+
+	subkeyExpeditedQuorum    = "expedited_quorum"
+	subkeyExpeditedThreshold = "expedited_threshold"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -61,6 +64,15 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 					pc[c.key] = c.value.String()
 				}
 
+				// keep the expedited quorum and threshold from being lower
+				// than the regular ones
+				if quorum, ok := pc[subkeyQuorum]; ok {
+					pc[subkeyExpeditedQuorum] = quorum
+				}
+				if threshold, ok := pc[subkeyThreshold]; ok {
+					pc[subkeyExpeditedThreshold] = threshold
+				}
+
 				bz, _ := json.Marshal(pc)
 				return string(bz)
 			},
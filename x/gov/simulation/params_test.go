@@ -21,7 +21,7 @@ func TestParamChanges(t *testing.T) {
 	}{
 		{"gov/votingparams", "votingparams", "{\"voting_period\": \"82639000000000\"}", "gov"},
 		{"gov/depositparams", "depositparams", "{\"max_deposit_period\": \"47332000000000\"}", "gov"},
-		{"gov/tallyparams", "tallyparams", "{\"threshold\":\"0.509000000000000000\"}", "gov"},
+		{"gov/tallyparams", "tallyparams", "{\"expedited_threshold\":\"0.509000000000000000\",\"threshold\":\"0.509000000000000000\"}", "gov"},
 	}
 
 	paramChanges := simulation.ParamChanges(r)
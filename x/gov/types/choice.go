@@ -0,0 +1,116 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MinProposalChoices is the minimum number of choices of a multiple-choice
+	// proposal.
+	MinProposalChoices = 2
+
+	// MaxProposalChoices is the maximum number of choices of a multiple-choice
+	// proposal.
+	MaxProposalChoices = 10
+)
+
+// NewProposalChoice creates a new ProposalChoice instance. The content may be
+// nil for a choice which doesn't change the state.
+func NewProposalChoice(title string, content Content) (ProposalChoice, error) {
+	choice := ProposalChoice{Title: title}
+	if content == nil {
+		return choice, nil
+	}
+
+	msg, ok := content.(proto.Message)
+	if !ok {
+		return ProposalChoice{}, fmt.Errorf("%T does not implement proto.Message", content)
+	}
+
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return ProposalChoice{}, err
+	}
+	choice.Content = any
+
+	return choice, nil
+}
+
+func (c ProposalChoice) String() string {
+	out, _ := yaml.Marshal(c)
+	return string(out)
+}
+
+// GetContent returns the content executed if the choice wins, or nil.
+func (c ProposalChoice) GetContent() Content {
+	if c.Content == nil {
+		return nil
+	}
+
+	content, ok := c.Content.GetCachedValue().(Content)
+	if !ok {
+		return nil
+	}
+	return content
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (c ProposalChoice) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if c.Content == nil {
+		return nil
+	}
+
+	var content Content
+	return unpacker.UnpackAny(c.Content, &content)
+}
+
+// ValidateProposalChoices checks the number of choices of a multiple-choice
+// proposal, their titles and their contents.
+func ValidateProposalChoices(choices []ProposalChoice) error {
+	if len(choices) < MinProposalChoices || len(choices) > MaxProposalChoices {
+		return sdkerrors.Wrapf(ErrInvalidProposalContent, "a multiple-choice proposal must have between %d and %d choices, got %d", MinProposalChoices, MaxProposalChoices, len(choices))
+	}
+
+	for i, choice := range choices {
+		if len(choice.Title) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidProposalContent, "choice %d title cannot be blank", i)
+		}
+		if len(choice.Title) > MaxTitleLength {
+			return sdkerrors.Wrapf(ErrInvalidProposalContent, "choice %d title is longer than max length of %d", i, MaxTitleLength)
+		}
+		if choice.Content == nil {
+			continue
+		}
+
+		content := choice.GetContent()
+		if content == nil {
+			return sdkerrors.Wrapf(ErrInvalidProposalContent, "choice %d has an invalid content", i)
+		}
+		if !IsValidProposalType(content.ProposalType()) {
+			return sdkerrors.Wrap(ErrInvalidProposalType, content.ProposalType())
+		}
+		if err := content.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewChoiceVote creates a new ChoiceVote instance
+//nolint:interfacer
+func NewChoiceVote(proposalID uint64, voter sdk.AccAddress, choice uint32) ChoiceVote {
+	return ChoiceVote{ProposalId: proposalID, Voter: voter.String(), Choice: choice}
+}
+
+func (v ChoiceVote) String() string {
+	out, _ := yaml.Marshal(v)
+	return string(out)
+}
@@ -16,6 +16,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeposit{}, "cosmos-sdk/MsgDeposit", nil)
 	cdc.RegisterConcrete(&MsgVote{}, "cosmos-sdk/MsgVote", nil)
 	cdc.RegisterConcrete(&MsgVoteWeighted{}, "cosmos-sdk/MsgVoteWeighted", nil)
+	cdc.RegisterConcrete(&MsgCancelProposal{}, "cosmos-sdk/MsgCancelProposal", nil)
+	cdc.RegisterConcrete(&MsgAnchorDiscussion{}, "cosmos-sdk/MsgAnchorDiscussion", nil)
+	cdc.RegisterConcrete(&MsgVoteOption{}, "cosmos-sdk/MsgVoteOption", nil)
+	cdc.RegisterConcrete(&MsgCommitVote{}, "cosmos-sdk/MsgCommitVote", nil)
+	cdc.RegisterConcrete(&MsgRevealVote{}, "cosmos-sdk/MsgRevealVote", nil)
+	cdc.RegisterConcrete(&MsgSetGovernor{}, "cosmos-sdk/MsgSetGovernor", nil)
+	cdc.RegisterConcrete(&MsgRemoveGovernor{}, "cosmos-sdk/MsgRemoveGovernor", nil)
+	cdc.RegisterConcrete(&MsgRetryProposalExecution{}, "cosmos-sdk/MsgRetryProposalExecution", nil)
 	cdc.RegisterConcrete(&TextProposal{}, "cosmos-sdk/TextProposal", nil)
 }
 
@@ -25,6 +33,14 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgVote{},
 		&MsgVoteWeighted{},
 		&MsgDeposit{},
+		&MsgCancelProposal{},
+		&MsgAnchorDiscussion{},
+		&MsgVoteOption{},
+		&MsgCommitVote{},
+		&MsgRevealVote{},
+		&MsgSetGovernor{},
+		&MsgRemoveGovernor{},
+		&MsgRetryProposalExecution{},
 	)
 	registry.RegisterInterface(
 		"cosmos.gov.v1beta1.Content",
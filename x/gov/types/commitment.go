@@ -0,0 +1,63 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxVoteSaltLength is the maximum length of the salt a vote on a private
+// proposal is committed with.
+const MaxVoteSaltLength = 128
+
+// NewVoteCommitment creates a new VoteCommitment instance
+//nolint:interfacer
+func NewVoteCommitment(proposalID uint64, voter sdk.AccAddress, commitment []byte, deposit sdk.Coins) VoteCommitment {
+	return VoteCommitment{ProposalId: proposalID, Voter: voter.String(), Commitment: commitment, Deposit: deposit}
+}
+
+func (c VoteCommitment) String() string {
+	out, _ := yaml.Marshal(c)
+	return string(out)
+}
+
+// VoteCommitmentHash returns the commitment to a vote on a private proposal:
+// the SHA-256 hash of the protobuf encoding of the vote followed by the salt.
+// The salt keeps the vote from being guessed from the commitment, so it must
+// be random and kept secret until the vote is revealed.
+//nolint:interfacer
+func VoteCommitmentHash(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions, salt string) []byte {
+	vote := NewVote(proposalID, voter, options)
+	bz, err := vote.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	hash := sha256.Sum256(append(bz, salt...))
+	return hash[:]
+}
+
+// ValidateVoteCommitment checks that a vote commitment is a SHA-256 hash.
+func ValidateVoteCommitment(commitment []byte) error {
+	if len(commitment) != sha256.Size {
+		return fmt.Errorf("vote commitment must be a %d bytes SHA-256 hash, got %d bytes", sha256.Size, len(commitment))
+	}
+
+	return nil
+}
+
+// ValidateVoteSalt checks that the salt of a vote commitment is neither empty
+// nor longer than MaxVoteSaltLength.
+func ValidateVoteSalt(salt string) error {
+	if len(salt) == 0 {
+		return fmt.Errorf("vote salt cannot be empty")
+	}
+	if len(salt) > MaxVoteSaltLength {
+		return fmt.Errorf("vote salt is longer than %d bytes", MaxVoteSaltLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewDelegationBonding creates a new DelegationBonding instance
+//nolint:interfacer
+func NewDelegationBonding(delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondedSince time.Time, shares sdk.Dec) DelegationBonding {
+	return DelegationBonding{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		BondedSince:      bondedSince,
+		Shares:           shares,
+	}
+}
+
+func (b DelegationBonding) String() string {
+	out, _ := yaml.Marshal(b)
+	return string(out)
+}
+
+// Validate checks that the addresses of a delegation bonding are valid and
+// that its shares are not negative.
+func (b DelegationBonding) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(b.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(b.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if b.Shares.IsNil() || b.Shares.IsNegative() {
+		return fmt.Errorf("invalid shares: %s", b.Shares)
+	}
+
+	return nil
+}
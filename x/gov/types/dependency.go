@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxProposalDependencies is the maximum number of proposals a proposal can
+// depend on.
+const MaxProposalDependencies = 10
+
+// ValidateProposalDependencies checks the number of dependencies of a proposal
+// and that they are distinct, valid proposal IDs.
+func ValidateProposalDependencies(dependsOn []uint64) error {
+	if len(dependsOn) > MaxProposalDependencies {
+		return sdkerrors.Wrapf(ErrInvalidDependency, "a proposal can depend on at most %d proposals, got %d", MaxProposalDependencies, len(dependsOn))
+	}
+
+	seen := make(map[uint64]bool, len(dependsOn))
+	for _, proposalID := range dependsOn {
+		if proposalID == 0 {
+			return sdkerrors.Wrap(ErrInvalidDependency, "proposal id cannot be zero")
+		}
+		if seen[proposalID] {
+			return sdkerrors.Wrapf(ErrInvalidDependency, "duplicate dependency on proposal %d", proposalID)
+		}
+		seen[proposalID] = true
+	}
+
+	return nil
+}
@@ -8,6 +8,18 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// DepositBurnReason is the reason why the deposits of a proposal are burned
+// rather than refunded to the depositors.
+type DepositBurnReason string
+
+// Reasons for burning the deposits of a proposal
+const (
+	BurnReasonNone    DepositBurnReason = ""        // deposits are refunded
+	BurnReasonVeto    DepositBurnReason = "veto"    // vetoed
+	BurnReasonQuorum  DepositBurnReason = "quorum"  // didn't reach the quorum
+	BurnReasonDropped DepositBurnReason = "dropped" // didn't meet min deposit
+)
+
 // NewDeposit creates a new Deposit instance
 //nolint:interfacer
 func NewDeposit(proposalID uint64, depositor sdk.AccAddress, amount sdk.Coins) Deposit {
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DiscussionAnchorHashLength is the length of the SHA-256 hash anchored by a
+	// discussion anchor.
+	DiscussionAnchorHashLength = sha256.Size
+
+	// MaxDiscussionAnchorURILength is the maximum length of the uri of a
+	// discussion anchor.
+	MaxDiscussionAnchorURILength = 256
+)
+
+// NewDiscussionAnchor creates a new DiscussionAnchor instance
+//nolint:interfacer
+func NewDiscussionAnchor(proposalID uint64, author sdk.AccAddress, hash []byte, uri string, height int64) DiscussionAnchor {
+	return DiscussionAnchor{ProposalId: proposalID, Author: author.String(), Hash: hash, Uri: uri, Height: height}
+}
+
+func (a DiscussionAnchor) String() string {
+	out, _ := yaml.Marshal(a)
+	return string(out)
+}
+
+// ValidateDiscussionAnchor checks the hash and the uri of a discussion anchor.
+func ValidateDiscussionAnchor(hash []byte, uri string) error {
+	if len(hash) != DiscussionAnchorHashLength {
+		return fmt.Errorf("discussion anchor hash must be %d bytes long, got %d", DiscussionAnchorHashLength, len(hash))
+	}
+	if len(uri) > MaxDiscussionAnchorURILength {
+		return fmt.Errorf("discussion anchor uri must be at most %d characters long, got %d", MaxDiscussionAnchorURILength, len(uri))
+	}
+
+	return nil
+}
@@ -14,4 +14,23 @@ var (
 	ErrInvalidVote             = sdkerrors.Register(ModuleName, 7, "invalid vote option")
 	ErrInvalidGenesis          = sdkerrors.Register(ModuleName, 8, "invalid genesis state")
 	ErrNoProposalHandlerExists = sdkerrors.Register(ModuleName, 9, "no handler exists for proposal type")
+	ErrInvalidProposalTemplate = sdkerrors.Register(ModuleName, 10, "invalid proposal template")
+	ErrValidatorVotingPeriod   = sdkerrors.Register(ModuleName, 11, "only validators can vote during the validator voting period")
+	ErrExpeditedDisabled       = sdkerrors.Register(ModuleName, 12, "expedited proposals are disabled")
+	ErrUnauthorizedCancel      = sdkerrors.Register(ModuleName, 13, "only the proposer can cancel a proposal")
+	ErrUnauthorizedAnchor      = sdkerrors.Register(ModuleName, 14, "only the proposer or a voter can anchor a discussion")
+	ErrOptimisticDisabled      = sdkerrors.Register(ModuleName, 15, "optimistic proposals are disabled")
+	ErrUnauthorizedOptimistic  = sdkerrors.Register(ModuleName, 16, "only the authorized addresses can submit optimistic proposals")
+	ErrPrivateDisabled         = sdkerrors.Register(ModuleName, 17, "private proposals are disabled")
+	ErrInvalidVoteReveal       = sdkerrors.Register(ModuleName, 18, "revealed vote does not match the vote commitment")
+	ErrInvalidGovernor         = sdkerrors.Register(ModuleName, 19, "invalid governor")
+	ErrNoGovernor              = sdkerrors.Register(ModuleName, 20, "no governor")
+	ErrMinInitialDeposit       = sdkerrors.Register(ModuleName, 21, "minimum initial deposit not met")
+	ErrCouncilDisabled         = sdkerrors.Register(ModuleName, 22, "council proposals are disabled")
+	ErrNotCouncilMember        = sdkerrors.Register(ModuleName, 23, "only the council members can submit or vote on council proposals")
+	ErrInvalidCouncilProposal  = sdkerrors.Register(ModuleName, 24, "proposal content type cannot be passed by the council")
+	ErrInvalidDependency       = sdkerrors.Register(ModuleName, 25, "invalid proposal dependency")
+	ErrDependencyFailed        = sdkerrors.Register(ModuleName, 26, "proposal dependency was not executed")
+	ErrExecutionNotRetryable   = sdkerrors.Register(ModuleName, 27, "proposal execution cannot be retried")
+	ErrEmergencyDisabled       = sdkerrors.Register(ModuleName, 28, "emergency proposals are disabled")
 )
@@ -2,20 +2,63 @@ package types
 
 // Governance module event types
 const (
-	EventTypeSubmitProposal   = "submit_proposal"
-	EventTypeProposalDeposit  = "proposal_deposit"
-	EventTypeProposalVote     = "proposal_vote"
-	EventTypeInactiveProposal = "inactive_proposal"
-	EventTypeActiveProposal   = "active_proposal"
+	EventTypeSubmitProposal  = "submit_proposal"
+	EventTypeProposalDeposit = "proposal_deposit"
+	EventTypeProposalVote    = "proposal_vote"
 
-	AttributeKeyProposalResult     = "proposal_result"
-	AttributeKeyOption             = "option"
-	AttributeKeyProposalID         = "proposal_id"
-	AttributeKeyVotingPeriodStart  = "voting_period_start"
-	AttributeValueCategory         = "governance"
-	AttributeValueProposalDropped  = "proposal_dropped"  // didn't meet min deposit
-	AttributeValueProposalPassed   = "proposal_passed"   // met vote quorum
-	AttributeValueProposalRejected = "proposal_rejected" // didn't meet vote quorum
-	AttributeValueProposalFailed   = "proposal_failed"   // error on proposal handler
-	AttributeKeyProposalType       = "proposal_type"
+	EventTypeVotingPeriodExtended = "voting_period_extended"
+	EventTypeVoteReceipt          = "vote_receipt"
+	EventTypeArchiveProposal      = "archive_proposal"
+	EventTypePruneProposal        = "prune_proposal"
+	EventTypeExpeditedFallback    = "expedited_proposal_fallback"
+	EventTypeCancelProposal       = "cancel_proposal"
+	EventTypeExecutionOutOfGas    = "proposal_execution_out_of_gas"
+	EventTypeDiscussionAnchor     = "discussion_anchor"
+	EventTypeCommitVote           = "commit_vote"
+	EventTypeRevealVote           = "reveal_vote"
+	EventTypeRevealPeriod         = "reveal_period"
+	EventTypeUnrevealedVote       = "unrevealed_vote"
+	EventTypeSetGovernor          = "set_governor"
+	EventTypeRemoveGovernor       = "remove_governor"
+	EventTypeRetryExecution       = "retry_proposal_execution"
+	EventTypeEmergencyFastTrack   = "emergency_fast_track"
+
+	AttributeKeyOption                = "option"
+	AttributeKeyProposalID            = "proposal_id"
+	AttributeKeyVotingPeriodStart     = "voting_period_start"
+	AttributeKeyVotingPeriodEnd       = "voting_period_end"
+	AttributeValueCategory            = "governance"
+	AttributeValueProposalDropped     = "proposal_dropped"   // didn't meet min deposit
+	AttributeValueProposalPassed      = "proposal_passed"    // met vote quorum
+	AttributeValueProposalRejected    = "proposal_rejected"  // didn't meet vote quorum
+	AttributeValueProposalFailed      = "proposal_failed"    // error on proposal handler
+	AttributeValueProposalScheduled   = "proposal_scheduled" // passed, execution delayed
+	AttributeValueProposalHeld        = "proposal_held"      // passed, execution waits for its dependencies
+	AttributeValueProposalVetoed      = "proposal_vetoed"    // optimistic proposal vetoed
+	AttributeKeyProposalType          = "proposal_type"
+	AttributeKeySubmissionFee         = "submission_fee"
+	AttributeKeyVoter                 = "voter"
+	AttributeKeyIsExpedited           = "is_expedited"
+	AttributeKeyIsOptimistic          = "is_optimistic"
+	AttributeKeyBurnedDeposit         = "burned_deposit"
+	AttributeKeyRefundedDeposit       = "refunded_deposit"
+	AttributeKeyGasUsed               = "gas_used"
+	AttributeKeyGasLimit              = "gas_limit"
+	AttributeKeyAuthor                = "author"
+	AttributeKeyHash                  = "hash"
+	AttributeKeyExecutionTime         = "execution_time"
+	AttributeKeyChoice                = "choice"
+	AttributeKeyIsPrivate             = "is_private"
+	AttributeKeyIsCouncil             = "is_council"
+	AttributeKeyCommitment            = "commitment"
+	AttributeKeyRevealPeriodEnd       = "reveal_period_end"
+	AttributeKeyDelegator             = "delegator"
+	AttributeKeyGovernor              = "governor"
+	AttributeKeyCheckpointVotingPower = "checkpoint_voting_power"
+	AttributeKeyVotingPower           = "voting_power"
+	AttributeKeyProposerModule        = "proposer_module"
+	AttributeKeyExecutionAttempts     = "execution_attempts"
+	AttributeKeyRetryEndTime          = "retry_end_time"
+	AttributeKeyIsEmergency           = "is_emergency"
+	AttributeKeyValidatorSupport      = "validator_support"
 )
@@ -9,6 +9,7 @@ import (
 // ParamSubspace defines the expected Subspace interface for parameters (noalias)
 type ParamSubspace interface {
 	Get(ctx sdk.Context, key []byte, ptr interface{})
+	GetIfExists(ctx sdk.Context, key []byte, ptr interface{})
 	Set(ctx sdk.Context, key []byte, param interface{})
 }
 
@@ -19,11 +20,15 @@ type StakingKeeper interface {
 		sdk.Context, func(index int64, validator stakingtypes.ValidatorI) (stop bool),
 	)
 
-	TotalBondedTokens(sdk.Context) sdk.Int // total bonded tokens within the validator set
+	Validator(sdk.Context, sdk.ValAddress) stakingtypes.ValidatorI // get a particular validator by operator address
+	TotalBondedTokens(sdk.Context) sdk.Int                         // total bonded tokens within the validator set
+	BondDenom(sdk.Context) string                                  // bondable coin denomination
 	IterateDelegations(
 		ctx sdk.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation stakingtypes.DelegationI) (stop bool),
 	)
+	IterateAllDelegations(ctx sdk.Context, cb func(delegation stakingtypes.Delegation) (stop bool)) // iterate through all the delegations
+	Delegation(sdk.Context, sdk.AccAddress, sdk.ValAddress) stakingtypes.DelegationI                // get a particular delegation
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -43,21 +48,35 @@ type BankKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	LockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModuleWithPurpose(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins, purpose string) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
 
+// DistributionKeeper defines the expected distribution keeper used to credit
+// the proposal submission fees to the community pool (noalias)
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
 // Event Hooks
 // These can be utilized to communicate between a governance keeper and another
 // keepers.
 
+// VoterFilter returns whether an account is allowed to vote on a proposal,
+// e.g. the council members on a council proposal.
+type VoterFilter func(voter sdk.AccAddress) bool
+
 // GovHooks event hooks for governance proposal object (noalias)
 type GovHooks interface {
-	AfterProposalSubmission(ctx sdk.Context, proposalID uint64)                            // Must be called after proposal is submitted
-	AfterProposalDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress) // Must be called after a deposit is made
-	AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress)        // Must be called after a vote on a proposal is cast
-	AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64)                      // Must be called when proposal fails to reach min deposit
-	AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64)                     // Must be called when proposal's finishes it's voting period
+	AfterProposalSubmission(ctx sdk.Context, proposalID uint64)                             // Must be called after proposal is submitted
+	AfterProposalDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress)  // Must be called after a deposit is made
+	AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress)         // Must be called after a vote on a proposal is cast
+	AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64)                       // Must be called when proposal fails to reach min deposit
+	AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64, canVote VoterFilter) // Must be called when proposal's finishes it's voting period
+	AfterProposalCanceled(ctx sdk.Context, proposalID uint64)                               // Must be called when a proposal is canceled by its proposer
+	AfterProposalExecuted(ctx sdk.Context, proposalID uint64, success bool, err string)     // Must be called after the content of a passed proposal is executed
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -34,7 +35,163 @@ func (data GenesisState) Equal(other GenesisState) bool {
 		data.Proposals.Equal(other.Proposals) &&
 		data.DepositParams.Equal(other.DepositParams) &&
 		data.TallyParams.Equal(other.TallyParams) &&
-		data.VotingParams.Equal(other.VotingParams)
+		data.VotingParams.Equal(other.VotingParams) &&
+		proposalTemplatesEqual(data.ProposalTemplates, other.ProposalTemplates) &&
+		voteReceiptsEqual(data.VoteReceipts, other.VoteReceipts) &&
+		data.ArchivedProposals.Equal(other.ArchivedProposals) &&
+		discussionAnchorsEqual(data.DiscussionAnchors, other.DiscussionAnchors) &&
+		choiceVotesEqual(data.ChoiceVotes, other.ChoiceVotes) &&
+		voteCommitmentsEqual(data.VoteCommitments, other.VoteCommitments) &&
+		governanceDelegationsEqual(data.GovernanceDelegations, other.GovernanceDelegations) &&
+		votingPowerSnapshotsEqual(data.VotingPowerSnapshots, other.VotingPowerSnapshots) &&
+		validatorParticipationsEqual(data.ValidatorParticipations, other.ValidatorParticipations) &&
+		delegationBondingsEqual(data.DelegationBondings, other.DelegationBondings)
+}
+
+func delegationBondingsEqual(a, b []DelegationBonding) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].DelegatorAddress != b[i].DelegatorAddress || a[i].ValidatorAddress != b[i].ValidatorAddress ||
+			!a[i].BondedSince.Equal(b[i].BondedSince) || !a[i].Shares.Equal(b[i].Shares) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func validatorParticipationsEqual(a, b []ValidatorParticipation) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].ProposalId != b[i].ProposalId || a[i].ValidatorAddress != b[i].ValidatorAddress ||
+			a[i].Voted != b[i].Voted || !a[i].BondedTokens.Equal(b[i].BondedTokens) ||
+			!a[i].VotingPower.Equal(b[i].VotingPower) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func proposalTemplatesEqual(a, b []ProposalTemplate) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func voteReceiptsEqual(a, b []VoteReceipt) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func discussionAnchorsEqual(a, b []DiscussionAnchor) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].ProposalId != b[i].ProposalId || a[i].Author != b[i].Author ||
+			!bytes.Equal(a[i].Hash, b[i].Hash) || a[i].Uri != b[i].Uri || a[i].Height != b[i].Height {
+			return false
+		}
+	}
+
+	return true
+}
+
+func choiceVotesEqual(a, b []ChoiceVote) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func voteCommitmentsEqual(a, b []VoteCommitment) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].ProposalId != b[i].ProposalId || a[i].Voter != b[i].Voter ||
+			!bytes.Equal(a[i].Commitment, b[i].Commitment) || a[i].Deposit.String() != b[i].Deposit.String() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func governanceDelegationsEqual(a, b []GovernanceDelegation) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func votingPowerSnapshotsEqual(a, b []VotingPowerSnapshot) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].ProposalId != b[i].ProposalId || !a[i].TotalBonded.Equal(b[i].TotalBonded) ||
+			len(a[i].Validators) != len(b[i].Validators) || len(a[i].Delegations) != len(b[i].Delegations) {
+			return false
+		}
+		for j, val := range a[i].Validators {
+			other := b[i].Validators[j]
+			if val.ValidatorAddress != other.ValidatorAddress || !val.BondedTokens.Equal(other.BondedTokens) ||
+				!val.DelegatorShares.Equal(other.DelegatorShares) {
+				return false
+			}
+		}
+		for j, del := range a[i].Delegations {
+			other := b[i].Delegations[j]
+			if del.DelegatorAddress != other.DelegatorAddress || del.ValidatorAddress != other.ValidatorAddress ||
+				!del.Shares.Equal(other.Shares) {
+				return false
+			}
+		}
+	}
+
+	return true
 }
 
 // Empty returns true if a GenesisState is empty
@@ -61,6 +218,113 @@ func ValidateGenesis(data *GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	cancelBurnRatio := data.DepositParams.CancelBurnRatio
+	if cancelBurnRatio.IsNil() || cancelBurnRatio.IsNegative() || cancelBurnRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance cancel burn ratio should be positive and less or equal to one, is %s",
+			cancelBurnRatio.String())
+	}
+
+	minInitialDepositRatio := data.DepositParams.MinInitialDepositRatio
+	if minInitialDepositRatio.IsNil() || minInitialDepositRatio.IsNegative() || minInitialDepositRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance minimum initial deposit ratio should be positive and less or equal to one, is %s",
+			minInitialDepositRatio.String())
+	}
+
+	if err := ValidateProposalTemplates(data.ProposalTemplates); err != nil {
+		return err
+	}
+
+	for _, receipt := range data.VoteReceipts {
+		if _, err := sdk.AccAddressFromBech32(receipt.Voter); err != nil {
+			return fmt.Errorf("invalid voter of vote receipt for proposal %d: %w", receipt.ProposalId, err)
+		}
+	}
+
+	for _, anchor := range data.DiscussionAnchors {
+		if _, err := sdk.AccAddressFromBech32(anchor.Author); err != nil {
+			return fmt.Errorf("invalid author of discussion anchor for proposal %d: %w", anchor.ProposalId, err)
+		}
+		if err := ValidateDiscussionAnchor(anchor.Hash, anchor.Uri); err != nil {
+			return fmt.Errorf("invalid discussion anchor for proposal %d: %w", anchor.ProposalId, err)
+		}
+	}
+
+	for _, vote := range data.ChoiceVotes {
+		if _, err := sdk.AccAddressFromBech32(vote.Voter); err != nil {
+			return fmt.Errorf("invalid voter of choice vote for proposal %d: %w", vote.ProposalId, err)
+		}
+	}
+
+	for _, commitment := range data.VoteCommitments {
+		if _, err := sdk.AccAddressFromBech32(commitment.Voter); err != nil {
+			return fmt.Errorf("invalid voter of vote commitment for proposal %d: %w", commitment.ProposalId, err)
+		}
+		if err := ValidateVoteCommitment(commitment.Commitment); err != nil {
+			return fmt.Errorf("invalid vote commitment for proposal %d: %w", commitment.ProposalId, err)
+		}
+		if !commitment.Deposit.IsValid() {
+			return fmt.Errorf("invalid deposit of vote commitment for proposal %d: %s", commitment.ProposalId, commitment.Deposit)
+		}
+	}
+
+	delegators := make(map[string]bool, len(data.GovernanceDelegations))
+	for _, delegation := range data.GovernanceDelegations {
+		if err := delegation.Validate(); err != nil {
+			return fmt.Errorf("invalid governance delegation of %s: %w", delegation.DelegatorAddress, err)
+		}
+		if delegators[delegation.DelegatorAddress] {
+			return fmt.Errorf("duplicate governance delegation of %s", delegation.DelegatorAddress)
+		}
+		delegators[delegation.DelegatorAddress] = true
+	}
+
+	snapshots := make(map[uint64]bool, len(data.VotingPowerSnapshots))
+	for _, snapshot := range data.VotingPowerSnapshots {
+		if err := snapshot.Validate(); err != nil {
+			return fmt.Errorf("invalid voting power snapshot of proposal %d: %w", snapshot.ProposalId, err)
+		}
+		if snapshots[snapshot.ProposalId] {
+			return fmt.Errorf("duplicate voting power snapshot of proposal %d", snapshot.ProposalId)
+		}
+		snapshots[snapshot.ProposalId] = true
+	}
+
+	for _, participation := range data.ValidatorParticipations {
+		if _, err := sdk.ValAddressFromBech32(participation.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator of validator participation for proposal %d: %w", participation.ProposalId, err)
+		}
+		if participation.BondedTokens.IsNil() || participation.BondedTokens.IsNegative() ||
+			participation.VotingPower.IsNil() || participation.VotingPower.IsNegative() {
+			return fmt.Errorf("invalid voting power of validator participation for proposal %d", participation.ProposalId)
+		}
+	}
+
+	bondings := make(map[string]bool, len(data.DelegationBondings))
+	for _, bonding := range data.DelegationBondings {
+		if err := bonding.Validate(); err != nil {
+			return fmt.Errorf("invalid bonding of delegation from %s to %s: %w", bonding.DelegatorAddress, bonding.ValidatorAddress, err)
+		}
+		key := bonding.DelegatorAddress + "/" + bonding.ValidatorAddress
+		if bondings[key] {
+			return fmt.Errorf("duplicate bonding of delegation from %s to %s", bonding.DelegatorAddress, bonding.ValidatorAddress)
+		}
+		bondings[key] = true
+	}
+
+	proposalIDs := make(map[uint64]bool, len(data.Proposals))
+	for _, p := range data.Proposals {
+		proposalIDs[p.ProposalId] = true
+	}
+	for _, p := range data.ArchivedProposals {
+		if p.Status != StatusPassed && p.Status != StatusRejected && p.Status != StatusFailed {
+			return fmt.Errorf("archived proposal %d is not finalized: %s", p.ProposalId, p.Status)
+		}
+		if proposalIDs[p.ProposalId] {
+			return fmt.Errorf("duplicate proposal id %d in proposals and archived proposals", p.ProposalId)
+		}
+		proposalIDs[p.ProposalId] = true
+	}
+
 	return nil
 }
 
@@ -74,5 +338,11 @@ func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, p := range data.ArchivedProposals {
+		err := p.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -39,6 +39,30 @@ type GenesisState struct {
 	VotingParams VotingParams `protobuf:"bytes,6,opt,name=voting_params,json=votingParams,proto3" json:"voting_params" yaml:"voting_params"`
 	// params defines all the paramaters of related to tally.
 	TallyParams TallyParams `protobuf:"bytes,7,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params" yaml:"tally_params"`
+	// proposal_templates defines the registry of named proposal templates.
+	ProposalTemplates []ProposalTemplate `protobuf:"bytes,8,rep,name=proposal_templates,json=proposalTemplates,proto3" json:"proposal_templates" yaml:"proposal_templates"`
+	// vote_receipts defines all the vote receipts present at genesis.
+	VoteReceipts []VoteReceipt `protobuf:"bytes,9,rep,name=vote_receipts,json=voteReceipts,proto3" json:"vote_receipts" yaml:"vote_receipts"`
+	// archived_proposals defines all the archived proposals present at genesis.
+	ArchivedProposals Proposals `protobuf:"bytes,10,rep,name=archived_proposals,json=archivedProposals,proto3,castrepeated=Proposals" json:"archived_proposals" yaml:"archived_proposals"`
+	// discussion_anchors defines all the discussion anchors present at genesis.
+	DiscussionAnchors []DiscussionAnchor `protobuf:"bytes,11,rep,name=discussion_anchors,json=discussionAnchors,proto3" json:"discussion_anchors" yaml:"discussion_anchors"`
+	// choice_votes defines all the choice votes present at genesis.
+	ChoiceVotes []ChoiceVote `protobuf:"bytes,12,rep,name=choice_votes,json=choiceVotes,proto3" json:"choice_votes" yaml:"choice_votes"`
+	// vote_commitments defines all the vote commitments present at genesis.
+	VoteCommitments []VoteCommitment `protobuf:"bytes,13,rep,name=vote_commitments,json=voteCommitments,proto3" json:"vote_commitments" yaml:"vote_commitments"`
+	// governance_delegations defines all the governance delegations present at
+	// genesis.
+	GovernanceDelegations []GovernanceDelegation `protobuf:"bytes,14,rep,name=governance_delegations,json=governanceDelegations,proto3" json:"governance_delegations" yaml:"governance_delegations"`
+	// voting_power_snapshots defines the voting power snapshots of the proposals
+	// in their voting period at genesis.
+	VotingPowerSnapshots []VotingPowerSnapshot `protobuf:"bytes,15,rep,name=voting_power_snapshots,json=votingPowerSnapshots,proto3" json:"voting_power_snapshots" yaml:"voting_power_snapshots"`
+	// validator_participations defines the participation of the bonded
+	// validators in the vote on the tallied proposals.
+	ValidatorParticipations []ValidatorParticipation `protobuf:"bytes,16,rep,name=validator_participations,json=validatorParticipations,proto3" json:"validator_participations" yaml:"validator_participations"`
+	// delegation_bondings defines since when the delegations have been bonded,
+	// for conviction voting.
+	DelegationBondings []DelegationBonding `protobuf:"bytes,17,rep,name=delegation_bondings,json=delegationBondings,proto3" json:"delegation_bondings" yaml:"delegation_bondings"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -123,6 +147,76 @@ func (m *GenesisState) GetTallyParams() TallyParams {
 	return TallyParams{}
 }
 
+func (m *GenesisState) GetProposalTemplates() []ProposalTemplate {
+	if m != nil {
+		return m.ProposalTemplates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoteReceipts() []VoteReceipt {
+	if m != nil {
+		return m.VoteReceipts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetArchivedProposals() Proposals {
+	if m != nil {
+		return m.ArchivedProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDiscussionAnchors() []DiscussionAnchor {
+	if m != nil {
+		return m.DiscussionAnchors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChoiceVotes() []ChoiceVote {
+	if m != nil {
+		return m.ChoiceVotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoteCommitments() []VoteCommitment {
+	if m != nil {
+		return m.VoteCommitments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovernanceDelegations() []GovernanceDelegation {
+	if m != nil {
+		return m.GovernanceDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVotingPowerSnapshots() []VotingPowerSnapshot {
+	if m != nil {
+		return m.VotingPowerSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorParticipations() []ValidatorParticipation {
+	if m != nil {
+		return m.ValidatorParticipations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegationBondings() []DelegationBonding {
+	if m != nil {
+		return m.DelegationBondings
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1beta1.GenesisState")
 }
@@ -130,34 +224,58 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/genesis.proto", fileDescriptor_43cd825e0fa7a627) }
 
 var fileDescriptor_43cd825e0fa7a627 = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x63, 0x9a, 0x94, 0xf6, 0x92, 0x20, 0x38, 0x82, 0x64, 0x35, 0xc1, 0x36, 0x9e, 0xb2,
-	0x60, 0xab, 0x65, 0x43, 0x62, 0xb1, 0x90, 0x50, 0x07, 0xa4, 0x62, 0x10, 0x03, 0x4b, 0x74, 0x89,
-	0x4f, 0x87, 0x85, 0xdd, 0x67, 0xf9, 0x1d, 0x16, 0xf9, 0x16, 0x7c, 0x0e, 0x3e, 0x49, 0xc7, 0x8e,
-	0x4c, 0x01, 0x25, 0x13, 0x6b, 0x3f, 0x01, 0xf2, 0xdd, 0x19, 0x1c, 0x61, 0x3a, 0x25, 0x7e, 0xf7,
-	0xbf, 0xdf, 0xef, 0xdd, 0xd3, 0x23, 0xde, 0x0a, 0x30, 0x07, 0x0c, 0x05, 0x54, 0x61, 0x75, 0xba,
-	0xe4, 0x92, 0x9d, 0x86, 0x82, 0x5f, 0x72, 0x4c, 0x31, 0x28, 0x4a, 0x90, 0x40, 0xa9, 0x4e, 0x04,
-	0x02, 0xaa, 0xc0, 0x24, 0x4e, 0x26, 0x02, 0x04, 0xa8, 0xe3, 0xb0, 0xfe, 0xa7, 0x93, 0x27, 0xb3,
-	0x2e, 0x16, 0x54, 0xfa, 0xd4, 0xff, 0xd5, 0x27, 0xa3, 0x57, 0x9a, 0xfc, 0x56, 0x32, 0xc9, 0xe9,
-	0x1b, 0x32, 0x41, 0xc9, 0x4a, 0x99, 0x5e, 0x8a, 0x45, 0x51, 0x42, 0x01, 0xc8, 0xb2, 0x45, 0x9a,
-	0xd8, 0x96, 0x67, 0xcd, 0xfb, 0x91, 0x7b, 0xb3, 0x71, 0xa7, 0x6b, 0x96, 0x67, 0xcf, 0xfd, 0xae,
-	0x94, 0x1f, 0xd3, 0xa6, 0x7c, 0x61, 0xaa, 0xe7, 0x09, 0x3d, 0x27, 0x47, 0x09, 0x2f, 0x00, 0x53,
-	0x89, 0xf6, 0x1d, 0xef, 0x60, 0x3e, 0x3c, 0x9b, 0x06, 0xff, 0xb6, 0x1f, 0xbc, 0xd4, 0x99, 0xe8,
-	0xfe, 0xd5, 0xc6, 0xed, 0x7d, 0xfb, 0xe1, 0x1e, 0x99, 0x02, 0xc6, 0x7f, 0xae, 0xd3, 0x17, 0x64,
-	0x50, 0x81, 0xe4, 0x68, 0x1f, 0x28, 0x8e, 0xdd, 0xc5, 0x79, 0x0f, 0x92, 0x47, 0x63, 0x03, 0x19,
-	0xd4, 0x5f, 0x18, 0xeb, 0x5b, 0xf4, 0x35, 0x39, 0x6e, 0xba, 0x45, 0xbb, 0xaf, 0x10, 0xb3, 0x2e,
-	0x44, 0xd3, 0x7c, 0xf4, 0xc0, 0x60, 0x8e, 0x9b, 0x0a, 0xc6, 0x7f, 0x09, 0x54, 0x90, 0x7b, 0xa6,
-	0xb3, 0x45, 0xc1, 0x4a, 0x96, 0xa3, 0x3d, 0xf0, 0xac, 0xf9, 0xf0, 0xec, 0xc9, 0x2d, 0xcf, 0xbb,
-	0x50, 0xc1, 0xe8, 0x71, 0x0d, 0xbe, 0xd9, 0xb8, 0x8f, 0xf4, 0x30, 0xf7, 0x31, 0x7e, 0x3c, 0x4e,
-	0xda, 0x69, 0xba, 0x22, 0xe3, 0x0a, 0xf4, 0xb0, 0xb5, 0xe7, 0x50, 0x79, 0xbc, 0xff, 0x3c, 0xbf,
-	0x1e, 0xbf, 0xd6, 0xcc, 0x8c, 0x66, 0xa2, 0x35, 0x7b, 0x10, 0x3f, 0x1e, 0x55, 0xad, 0x2c, 0x5d,
-	0x90, 0x91, 0x64, 0x59, 0xb6, 0x6e, 0x1c, 0x77, 0x95, 0xc3, 0xed, 0x72, 0xbc, 0xab, 0x73, 0x46,
-	0x31, 0x35, 0x8a, 0x87, 0x5a, 0xd1, 0x46, 0xf8, 0xf1, 0x50, 0xb6, 0x92, 0xd1, 0xd5, 0xd6, 0xb1,
-	0xae, 0xb7, 0x8e, 0xf5, 0x73, 0xeb, 0x58, 0x5f, 0x77, 0x4e, 0xef, 0x7a, 0xe7, 0xf4, 0xbe, 0xef,
-	0x9c, 0xde, 0x87, 0xb9, 0x48, 0xe5, 0xc7, 0xcf, 0xcb, 0x60, 0x05, 0x79, 0x68, 0xd6, 0x55, 0xff,
-	0x3c, 0xc5, 0xe4, 0x53, 0xf8, 0x45, 0xed, 0xae, 0x5c, 0x17, 0x1c, 0x97, 0x87, 0x6a, 0x6d, 0x9f,
-	0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x63, 0x67, 0xf6, 0x22, 0x03, 0x00, 0x00,
+	// 805 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0x4f, 0x6f, 0xdb, 0x36,
+	0x18, 0xc6, 0xad, 0xa5, 0xe9, 0x12, 0xda, 0x4e, 0x63, 0xd6, 0x6d, 0xb5, 0x24, 0xb5, 0x5c, 0x62,
+	0x45, 0x8d, 0x01, 0xb3, 0xd1, 0xee, 0x36, 0x60, 0x87, 0xa9, 0x01, 0x8a, 0x1e, 0x06, 0x64, 0x6c,
+	0xb1, 0xc3, 0x0e, 0x13, 0x68, 0x89, 0x90, 0x89, 0x59, 0xa2, 0xa0, 0x97, 0xd5, 0x96, 0xed, 0x3a,
+	0xec, 0x38, 0xec, 0x5b, 0x0c, 0xd8, 0x27, 0xe9, 0x31, 0xc7, 0x9d, 0xb2, 0x21, 0xf9, 0x06, 0xf9,
+	0x04, 0x83, 0x48, 0x4a, 0xfe, 0x27, 0x07, 0x3d, 0x25, 0xa6, 0x9e, 0xe7, 0xf7, 0xbc, 0x7c, 0xf9,
+	0x52, 0x42, 0xc3, 0x50, 0x42, 0x22, 0x61, 0x12, 0xcb, 0x62, 0x52, 0x3c, 0x9f, 0x72, 0xc5, 0x9e,
+	0x4f, 0x62, 0x9e, 0x72, 0x10, 0x30, 0xce, 0x72, 0xa9, 0x24, 0xc6, 0x46, 0x31, 0x8e, 0x65, 0x31,
+	0xb6, 0x8a, 0xa3, 0x7e, 0x2c, 0x63, 0xa9, 0x1f, 0x4f, 0xca, 0xff, 0x8c, 0xf2, 0xe8, 0xa4, 0x89,
+	0x25, 0x0b, 0xf3, 0x94, 0xfc, 0x75, 0x80, 0x3a, 0xaf, 0x0c, 0xf9, 0x8d, 0x62, 0x8a, 0xe3, 0x6f,
+	0x51, 0x1f, 0x14, 0xcb, 0x95, 0x48, 0xe3, 0x20, 0xcb, 0x65, 0x26, 0x81, 0xcd, 0x03, 0x11, 0xb9,
+	0xce, 0xd0, 0x19, 0xdd, 0xf1, 0xbd, 0x9b, 0x4b, 0xef, 0xf8, 0x9c, 0x25, 0xf3, 0x2f, 0x49, 0x93,
+	0x8a, 0x50, 0x5c, 0x2d, 0x9f, 0xd9, 0xd5, 0xd7, 0x11, 0x7e, 0x8d, 0xf6, 0x22, 0x9e, 0x49, 0x10,
+	0x0a, 0xdc, 0x8f, 0x86, 0x3b, 0xa3, 0xf6, 0x8b, 0xe3, 0xf1, 0x66, 0xf9, 0xe3, 0x53, 0xa3, 0xf1,
+	0x0f, 0xdf, 0x5f, 0x7a, 0xad, 0xbf, 0xff, 0xf5, 0xf6, 0xec, 0x02, 0xd0, 0xda, 0x8e, 0xbf, 0x42,
+	0xbb, 0x85, 0x54, 0x1c, 0xdc, 0x1d, 0xcd, 0x71, 0x9b, 0x38, 0xdf, 0x49, 0xc5, 0xfd, 0xae, 0x85,
+	0xec, 0x96, 0xbf, 0x80, 0x1a, 0x17, 0xfe, 0x06, 0xed, 0x57, 0xd5, 0x82, 0x7b, 0x47, 0x23, 0x4e,
+	0x9a, 0x10, 0x55, 0xf1, 0x7e, 0xcf, 0x62, 0xf6, 0xab, 0x15, 0xa0, 0x0b, 0x02, 0x8e, 0xd1, 0x81,
+	0xad, 0x2c, 0xc8, 0x58, 0xce, 0x12, 0x70, 0x77, 0x87, 0xce, 0xa8, 0xfd, 0xe2, 0xc9, 0x2d, 0xdb,
+	0x3b, 0xd3, 0x42, 0xff, 0x71, 0x09, 0xbe, 0xb9, 0xf4, 0x1e, 0x98, 0x66, 0xae, 0x62, 0x08, 0xed,
+	0x46, 0xcb, 0x6a, 0x1c, 0xa2, 0x6e, 0x21, 0x4d, 0xb3, 0x4d, 0xce, 0x5d, 0x9d, 0x33, 0xdc, 0xb2,
+	0xfd, 0xb2, 0xfd, 0x26, 0xe6, 0xc4, 0xc6, 0xf4, 0x4d, 0xcc, 0x0a, 0x84, 0xd0, 0x4e, 0xb1, 0xa4,
+	0xc5, 0x01, 0xea, 0x28, 0x36, 0x9f, 0x9f, 0x57, 0x19, 0x1f, 0xeb, 0x0c, 0xaf, 0x29, 0xe3, 0x6d,
+	0xa9, 0xb3, 0x11, 0xc7, 0x36, 0xe2, 0xbe, 0x89, 0x58, 0x46, 0x10, 0xda, 0x56, 0x0b, 0x25, 0x2e,
+	0x10, 0xae, 0x67, 0x45, 0xf1, 0x24, 0x9b, 0xb3, 0xf2, 0x24, 0xf7, 0xf4, 0x31, 0x7c, 0x7a, 0xdb,
+	0x31, 0xbc, 0xb5, 0x62, 0xff, 0x89, 0xcd, 0xfa, 0xc4, 0x64, 0x6d, 0xd2, 0x08, 0xed, 0x65, 0x6b,
+	0x26, 0xc0, 0x53, 0xdd, 0x3d, 0x1e, 0xe4, 0x3c, 0xe4, 0x22, 0x53, 0xe0, 0xee, 0x0f, 0x77, 0xb6,
+	0xed, 0xac, 0x1c, 0x17, 0x6a, 0x74, 0x0d, 0xcd, 0x5b, 0x30, 0x4c, 0xf3, 0x2a, 0x29, 0xe0, 0x5f,
+	0x11, 0x66, 0x79, 0x38, 0x13, 0x05, 0x8f, 0x82, 0xc5, 0x88, 0xa1, 0x0f, 0x18, 0xb1, 0xf1, 0xea,
+	0x9e, 0x36, 0x29, 0x64, 0x75, 0xfe, 0x7a, 0x95, 0xa2, 0x5e, 0x2a, 0x1b, 0x1b, 0x09, 0x08, 0xdf,
+	0x01, 0x08, 0x99, 0x06, 0x2c, 0x0d, 0x67, 0x32, 0x07, 0xb7, 0xbd, 0xbd, 0xb1, 0xa7, 0xb5, 0xfa,
+	0x6b, 0x2d, 0x5e, 0x6f, 0xec, 0x26, 0x8d, 0xd0, 0x5e, 0xb4, 0x66, 0x02, 0xfc, 0x03, 0xea, 0x84,
+	0x33, 0x29, 0x42, 0x1e, 0x98, 0x4b, 0xd9, 0xd1, 0x89, 0x83, 0xa6, 0xc4, 0x97, 0x5a, 0xa7, 0xaf,
+	0xe6, 0xda, 0xc0, 0x2c, 0x13, 0x08, 0x6d, 0x87, 0xb5, 0x10, 0x70, 0x8a, 0x0e, 0x75, 0xd3, 0x43,
+	0x99, 0x24, 0x42, 0x25, 0x3c, 0x55, 0xe0, 0x76, 0x75, 0x06, 0xd9, 0x76, 0x76, 0x2f, 0x6b, 0xa9,
+	0xef, 0xd9, 0x9c, 0x47, 0x4b, 0xc7, 0xb7, 0x44, 0x22, 0xf4, 0x5e, 0xb1, 0x62, 0x00, 0xfc, 0xbb,
+	0x83, 0x1e, 0xc6, 0xb2, 0xe0, 0x79, 0xca, 0xd2, 0x90, 0x07, 0x11, 0x9f, 0xf3, 0x98, 0x29, 0x21,
+	0x53, 0x70, 0x0f, 0x74, 0xec, 0xa8, 0x29, 0xf6, 0x55, 0xed, 0x38, 0xad, 0x0d, 0xfe, 0x53, 0x1b,
+	0xfe, 0xd8, 0x84, 0x37, 0x53, 0x09, 0x7d, 0x10, 0x37, 0x98, 0x01, 0xff, 0xe6, 0xa0, 0x87, 0xd5,
+	0x5d, 0x95, 0x3f, 0xf1, 0x3c, 0x80, 0x94, 0x65, 0x30, 0x93, 0x0a, 0xdc, 0x7b, 0xba, 0x90, 0x67,
+	0xb7, 0xdc, 0xfc, 0xd2, 0xf0, 0xc6, 0xea, 0xd7, 0xeb, 0x68, 0x86, 0x12, 0xda, 0x2f, 0x36, 0xbd,
+	0x80, 0xff, 0x70, 0x90, 0x5b, 0xb0, 0xb9, 0x88, 0x98, 0x92, 0x79, 0x79, 0xa7, 0x95, 0x08, 0x45,
+	0x66, 0x3b, 0x72, 0xa8, 0x0b, 0xf9, 0xac, 0xb1, 0x90, 0xca, 0x73, 0xb6, 0x6c, 0xf1, 0x9f, 0xd9,
+	0x5a, 0x3c, 0x5b, 0xcb, 0x16, 0x32, 0xa1, 0x8f, 0x8a, 0x46, 0x00, 0xe0, 0x5f, 0xd0, 0xfd, 0x45,
+	0xfb, 0x82, 0xa9, 0x4c, 0x23, 0x91, 0xc6, 0xe0, 0xf6, 0x74, 0x29, 0x4f, 0x9b, 0xdf, 0xba, 0xf5,
+	0x91, 0x18, 0xb5, 0x4f, 0x6c, 0x15, 0x47, 0xd5, 0x9b, 0x77, 0x83, 0x47, 0x28, 0x8e, 0xd6, 0x6d,
+	0xe0, 0xfb, 0xef, 0xaf, 0x06, 0xce, 0xc5, 0xd5, 0xc0, 0xf9, 0xef, 0x6a, 0xe0, 0xfc, 0x79, 0x3d,
+	0x68, 0x5d, 0x5c, 0x0f, 0x5a, 0xff, 0x5c, 0x0f, 0x5a, 0xdf, 0x8f, 0x62, 0xa1, 0x66, 0xef, 0xa6,
+	0xe3, 0x50, 0x26, 0x13, 0xfb, 0xb1, 0x35, 0x7f, 0x3e, 0x87, 0xe8, 0xc7, 0xc9, 0xcf, 0xfa, 0xcb,
+	0xab, 0xce, 0x33, 0x0e, 0xd3, 0xbb, 0xfa, 0xa3, 0xfb, 0xc5, 0xff, 0x03, 0x00, 0xea, 0xf2, 0xc0,
+	0x0c, 0xe0, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -180,6 +298,150 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegationBondings) > 0 {
+		for iNdEx := len(m.DelegationBondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationBondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ValidatorParticipations) > 0 {
+		for iNdEx := len(m.ValidatorParticipations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorParticipations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.VotingPowerSnapshots) > 0 {
+		for iNdEx := len(m.VotingPowerSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingPowerSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.GovernanceDelegations) > 0 {
+		for iNdEx := len(m.GovernanceDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovernanceDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.VoteCommitments) > 0 {
+		for iNdEx := len(m.VoteCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ChoiceVotes) > 0 {
+		for iNdEx := len(m.ChoiceVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChoiceVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.DiscussionAnchors) > 0 {
+		for iNdEx := len(m.DiscussionAnchors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DiscussionAnchors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ArchivedProposals) > 0 {
+		for iNdEx := len(m.ArchivedProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArchivedProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.VoteReceipts) > 0 {
+		for iNdEx := len(m.VoteReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ProposalTemplates) > 0 {
+		for iNdEx := len(m.ProposalTemplates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -304,6 +566,66 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.TallyParams.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ProposalTemplates) > 0 {
+		for _, e := range m.ProposalTemplates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoteReceipts) > 0 {
+		for _, e := range m.VoteReceipts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ArchivedProposals) > 0 {
+		for _, e := range m.ArchivedProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DiscussionAnchors) > 0 {
+		for _, e := range m.DiscussionAnchors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChoiceVotes) > 0 {
+		for _, e := range m.ChoiceVotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoteCommitments) > 0 {
+		for _, e := range m.VoteCommitments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GovernanceDelegations) > 0 {
+		for _, e := range m.GovernanceDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VotingPowerSnapshots) > 0 {
+		for _, e := range m.VotingPowerSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorParticipations) > 0 {
+		for _, e := range m.ValidatorParticipations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegationBondings) > 0 {
+		for _, e := range m.DelegationBondings {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -562,6 +884,346 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTemplates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalTemplates = append(m.ProposalTemplates, ProposalTemplate{})
+			if err := m.ProposalTemplates[len(m.ProposalTemplates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteReceipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteReceipts = append(m.VoteReceipts, VoteReceipt{})
+			if err := m.VoteReceipts[len(m.VoteReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedProposals = append(m.ArchivedProposals, Proposal{})
+			if err := m.ArchivedProposals[len(m.ArchivedProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscussionAnchors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DiscussionAnchors = append(m.DiscussionAnchors, DiscussionAnchor{})
+			if err := m.DiscussionAnchors[len(m.DiscussionAnchors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChoiceVotes = append(m.ChoiceVotes, ChoiceVote{})
+			if err := m.ChoiceVotes[len(m.ChoiceVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteCommitments = append(m.VoteCommitments, VoteCommitment{})
+			if err := m.VoteCommitments[len(m.VoteCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernanceDelegations = append(m.GovernanceDelegations, GovernanceDelegation{})
+			if err := m.GovernanceDelegations[len(m.GovernanceDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPowerSnapshots = append(m.VotingPowerSnapshots, VotingPowerSnapshot{})
+			if err := m.VotingPowerSnapshots[len(m.VotingPowerSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorParticipations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorParticipations = append(m.ValidatorParticipations, ValidatorParticipation{})
+			if err := m.ValidatorParticipations[len(m.ValidatorParticipations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationBondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationBondings = append(m.DelegationBondings, DelegationBonding{})
+			if err := m.DelegationBondings[len(m.DelegationBondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,35 @@
+package types
+
+import (
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewGovernanceDelegation creates a new GovernanceDelegation instance
+//nolint:interfacer
+func NewGovernanceDelegation(delegator, governor sdk.AccAddress) GovernanceDelegation {
+	return GovernanceDelegation{DelegatorAddress: delegator.String(), GovernorAddress: governor.String()}
+}
+
+func (d GovernanceDelegation) String() string {
+	out, _ := yaml.Marshal(d)
+	return string(out)
+}
+
+// Validate checks that the addresses of a governance delegation are valid and
+// that the delegator doesn't delegate to itself.
+func (d GovernanceDelegation) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(d.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(d.GovernorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+	if d.DelegatorAddress == d.GovernorAddress {
+		return sdkerrors.Wrap(ErrInvalidGovernor, "cannot delegate governance voting power to self")
+	}
+
+	return nil
+}
@@ -35,8 +35,18 @@ func (h MultiGovHooks) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID
 		h[i].AfterProposalFailedMinDeposit(ctx, proposalID)
 	}
 }
-func (h MultiGovHooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {
+func (h MultiGovHooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64, canVote VoterFilter) {
 	for i := range h {
-		h[i].AfterProposalVotingPeriodEnded(ctx, proposalID)
+		h[i].AfterProposalVotingPeriodEnded(ctx, proposalID, canVote)
+	}
+}
+func (h MultiGovHooks) AfterProposalCanceled(ctx sdk.Context, proposalID uint64) {
+	for i := range h {
+		h[i].AfterProposalCanceled(ctx, proposalID)
+	}
+}
+func (h MultiGovHooks) AfterProposalExecuted(ctx sdk.Context, proposalID uint64, success bool, err string) {
+	for i := range h {
+		h[i].AfterProposalExecuted(ctx, proposalID, success, err)
 	}
 }
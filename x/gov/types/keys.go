@@ -34,18 +34,84 @@ const (
 //
 // - 0x03: nextProposalID
 //
+// - 0x04<votingEndTime_Bytes><proposalID_Bytes>: finalizedProposalID
+//
+// - 0x05<executionTime_Bytes><proposalID_Bytes>: scheduledProposalID
+//
+// - 0x06<endTime_Bytes><proposalID_Bytes>: optimisticProposalID
+//
+// - 0x07<revealEndTime_Bytes><proposalID_Bytes>: revealProposalID
+//
+// - 0x08<endTime_Bytes><proposalID_Bytes>: councilProposalID
+//
+// - 0x09<proposalID_Bytes>: dependentProposalID
+//
+// - 0x0a<proposalID_Bytes>: emergencyProposalID
+//
 // - 0x10<proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
+//
+// - 0x30<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: VoteReceipt
+//
+// - 0x40<proposalID_Bytes>: compressed archived Proposal
+//
+// - 0x50<proposalID_Bytes><authorAddrLen (1 Byte)><authorAddr_Bytes><hash_Bytes>: DiscussionAnchor
+//
+// - 0x60<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: ChoiceVote
+//
+// - 0x70<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: VoteCommitment
+//
+// - 0x80<delegatorAddrLen (1 Byte)><delegatorAddr_Bytes>: GovernanceDelegation
+//
+// - 0x81<governorAddrLen (1 Byte)><governorAddr_Bytes><delegatorAddrLen (1 Byte)><delegatorAddr_Bytes>: []byte{}
+//
+// - 0x90<proposalID_Bytes>: VotingPowerSnapshot
+//
+// - 0x91<proposalID_Bytes><validatorAddrLen (1 Byte)><validatorAddr_Bytes>: ValidatorVotingPower
+//
+// - 0x92<proposalID_Bytes><delegatorAddrLen (1 Byte)><delegatorAddr_Bytes><validatorAddrLen (1 Byte)><validatorAddr_Bytes>: DelegationVotingPower
+//
+// - 0xa0<proposalID_Bytes><validatorAddrLen (1 Byte)><validatorAddr_Bytes>: ValidatorParticipation
+//
+// - 0xb0<delegatorAddrLen (1 Byte)><delegatorAddr_Bytes><validatorAddrLen (1 Byte)><validatorAddr_Bytes>: DelegationBonding
 var (
-	ProposalsKeyPrefix          = []byte{0x00}
-	ActiveProposalQueuePrefix   = []byte{0x01}
-	InactiveProposalQueuePrefix = []byte{0x02}
-	ProposalIDKey               = []byte{0x03}
+	ProposalsKeyPrefix            = []byte{0x00}
+	ActiveProposalQueuePrefix     = []byte{0x01}
+	InactiveProposalQueuePrefix   = []byte{0x02}
+	ProposalIDKey                 = []byte{0x03}
+	FinalizedProposalQueuePrefix  = []byte{0x04}
+	ExecutionQueuePrefix          = []byte{0x05}
+	OptimisticProposalQueuePrefix = []byte{0x06}
+	RevealProposalQueuePrefix     = []byte{0x07}
+	CouncilProposalQueuePrefix    = []byte{0x08}
+	DependencyQueuePrefix         = []byte{0x09}
+	EmergencyProposalQueuePrefix  = []byte{0x0a}
 
 	DepositsKeyPrefix = []byte{0x10}
 
 	VotesKeyPrefix = []byte{0x20}
+
+	VoteReceiptsKeyPrefix = []byte{0x30}
+
+	ArchivedProposalsKeyPrefix = []byte{0x40}
+
+	DiscussionAnchorsKeyPrefix = []byte{0x50}
+
+	ChoiceVotesKeyPrefix = []byte{0x60}
+
+	VoteCommitmentsKeyPrefix = []byte{0x70}
+
+	GovernanceDelegationsKeyPrefix           = []byte{0x80}
+	GovernanceDelegationsByGovernorKeyPrefix = []byte{0x81}
+
+	VotingPowerSnapshotsKeyPrefix = []byte{0x90}
+	SnapshotValidatorsKeyPrefix   = []byte{0x91}
+	SnapshotDelegationsKeyPrefix  = []byte{0x92}
+
+	ValidatorParticipationsKeyPrefix = []byte{0xa0}
+
+	DelegationBondingsKeyPrefix = []byte{0xb0}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -87,6 +153,76 @@ func InactiveProposalQueueKey(proposalID uint64, endTime time.Time) []byte {
 	return append(InactiveProposalByTimeKey(endTime), GetProposalIDBytes(proposalID)...)
 }
 
+// FinalizedProposalByTimeKey gets the finalized proposal queue key by voting
+// endTime
+func FinalizedProposalByTimeKey(endTime time.Time) []byte {
+	return append(FinalizedProposalQueuePrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+// FinalizedProposalQueueKey returns the key for a proposalID in the finalizedProposalQueue
+func FinalizedProposalQueueKey(proposalID uint64, endTime time.Time) []byte {
+	return append(FinalizedProposalByTimeKey(endTime), GetProposalIDBytes(proposalID)...)
+}
+
+// ExecutionQueueByTimeKey gets the execution queue key by execution time
+func ExecutionQueueByTimeKey(executionTime time.Time) []byte {
+	return append(ExecutionQueuePrefix, sdk.FormatTimeBytes(executionTime)...)
+}
+
+// ExecutionQueueKey returns the key for a proposalID in the executionQueue
+func ExecutionQueueKey(proposalID uint64, executionTime time.Time) []byte {
+	return append(ExecutionQueueByTimeKey(executionTime), GetProposalIDBytes(proposalID)...)
+}
+
+// OptimisticProposalByTimeKey gets the optimistic proposal queue key by the
+// endTime of the challenge window
+func OptimisticProposalByTimeKey(endTime time.Time) []byte {
+	return append(OptimisticProposalQueuePrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+// OptimisticProposalQueueKey returns the key for a proposalID in the optimisticProposalQueue
+func OptimisticProposalQueueKey(proposalID uint64, endTime time.Time) []byte {
+	return append(OptimisticProposalByTimeKey(endTime), GetProposalIDBytes(proposalID)...)
+}
+
+// RevealProposalByTimeKey gets the reveal proposal queue key by the end time
+// of the reveal period
+func RevealProposalByTimeKey(endTime time.Time) []byte {
+	return append(RevealProposalQueuePrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+// RevealProposalQueueKey returns the key for a proposalID in the revealProposalQueue
+func RevealProposalQueueKey(proposalID uint64, endTime time.Time) []byte {
+	return append(RevealProposalByTimeKey(endTime), GetProposalIDBytes(proposalID)...)
+}
+
+// CouncilProposalByTimeKey gets the council proposal queue key by the end time
+// of the voting period
+func CouncilProposalByTimeKey(endTime time.Time) []byte {
+	return append(CouncilProposalQueuePrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+// CouncilProposalQueueKey returns the key for a proposalID in the councilProposalQueue
+func CouncilProposalQueueKey(proposalID uint64, endTime time.Time) []byte {
+	return append(CouncilProposalByTimeKey(endTime), GetProposalIDBytes(proposalID)...)
+}
+
+// DependencyQueueKey returns the key for a proposalID in the dependencyQueue
+func DependencyQueueKey(proposalID uint64) []byte {
+	return append(DependencyQueuePrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// EmergencyProposalQueueKey returns the key for a proposalID in the
+// emergencyProposalQueue
+func EmergencyProposalQueueKey(proposalID uint64) []byte {
+	return append(EmergencyProposalQueuePrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// ArchivedProposalKey gets a specific archived proposal from the store
+func ArchivedProposalKey(proposalID uint64) []byte {
+	return append(ArchivedProposalsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
 // DepositsKey gets the first part of the deposits key based on the proposalID
 func DepositsKey(proposalID uint64) []byte {
 	return append(DepositsKeyPrefix, GetProposalIDBytes(proposalID)...)
@@ -107,6 +243,124 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
 
+// VoteReceiptsKey gets the first part of the vote receipts key based on the
+// proposalID
+func VoteReceiptsKey(proposalID uint64) []byte {
+	return append(VoteReceiptsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// VoteReceiptKey key of a specific vote receipt from the store
+func VoteReceiptKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
+	return append(VoteReceiptsKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
+}
+
+// DiscussionAnchorsKey gets the first part of the discussion anchors key based
+// on the proposalID
+func DiscussionAnchorsKey(proposalID uint64) []byte {
+	return append(DiscussionAnchorsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// DiscussionAnchorKey key of a specific discussion anchor from the store
+func DiscussionAnchorKey(proposalID uint64, authorAddr sdk.AccAddress, hash []byte) []byte {
+	key := append(DiscussionAnchorsKey(proposalID), address.MustLengthPrefix(authorAddr.Bytes())...)
+	return append(key, hash...)
+}
+
+// ChoiceVotesKey gets the first part of the choice votes key based on the
+// proposalID
+func ChoiceVotesKey(proposalID uint64) []byte {
+	return append(ChoiceVotesKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// ChoiceVoteKey key of a specific choice vote from the store
+func ChoiceVoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
+	return append(ChoiceVotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
+}
+
+// VoteCommitmentsKey gets the first part of the vote commitments key based on
+// the proposalID
+func VoteCommitmentsKey(proposalID uint64) []byte {
+	return append(VoteCommitmentsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// VoteCommitmentKey key of a specific vote commitment from the store
+func VoteCommitmentKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
+	return append(VoteCommitmentsKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
+}
+
+// GovernanceDelegationKey key of the governance delegation of an account from
+// the store
+func GovernanceDelegationKey(delegatorAddr sdk.AccAddress) []byte {
+	return append(GovernanceDelegationsKeyPrefix, address.MustLengthPrefix(delegatorAddr.Bytes())...)
+}
+
+// GovernanceDelegationsByGovernorKey gets the first part of the governance
+// delegations by governor index key based on the governor address
+func GovernanceDelegationsByGovernorKey(governorAddr sdk.AccAddress) []byte {
+	return append(GovernanceDelegationsByGovernorKeyPrefix, address.MustLengthPrefix(governorAddr.Bytes())...)
+}
+
+// GovernanceDelegationByGovernorKey key of the governance delegations by
+// governor index entry of a specific delegation
+func GovernanceDelegationByGovernorKey(governorAddr, delegatorAddr sdk.AccAddress) []byte {
+	return append(GovernanceDelegationsByGovernorKey(governorAddr), address.MustLengthPrefix(delegatorAddr.Bytes())...)
+}
+
+// VotingPowerSnapshotKey key of the voting power snapshot of a proposal from
+// the store
+func VotingPowerSnapshotKey(proposalID uint64) []byte {
+	return append(VotingPowerSnapshotsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// SnapshotValidatorsKey gets the first part of the snapshot validators key
+// based on the proposalID
+func SnapshotValidatorsKey(proposalID uint64) []byte {
+	return append(SnapshotValidatorsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// SnapshotValidatorKey key of the voting power of a specific validator in the
+// voting power snapshot of a proposal
+func SnapshotValidatorKey(proposalID uint64, valAddr sdk.ValAddress) []byte {
+	return append(SnapshotValidatorsKey(proposalID), address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// SnapshotDelegationsKey gets the first part of the snapshot delegations key
+// based on the proposalID
+func SnapshotDelegationsKey(proposalID uint64) []byte {
+	return append(SnapshotDelegationsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// SnapshotDelegatorDelegationsKey gets the first part of the snapshot
+// delegations key based on the proposalID and the delegator address
+func SnapshotDelegatorDelegationsKey(proposalID uint64, delAddr sdk.AccAddress) []byte {
+	return append(SnapshotDelegationsKey(proposalID), address.MustLengthPrefix(delAddr.Bytes())...)
+}
+
+// SnapshotDelegationKey key of the voting power of a specific delegation in the
+// voting power snapshot of a proposal
+func SnapshotDelegationKey(proposalID uint64, delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(SnapshotDelegatorDelegationsKey(proposalID, delAddr), address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// ValidatorParticipationsKey gets the first part of the validator
+// participations key based on the proposalID
+func ValidatorParticipationsKey(proposalID uint64) []byte {
+	return append(ValidatorParticipationsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// ValidatorParticipationKey key of the participation of a specific validator
+// in the vote on a proposal
+func ValidatorParticipationKey(proposalID uint64, valAddr sdk.ValAddress) []byte {
+	return append(ValidatorParticipationsKey(proposalID), address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// DelegationBondingKey key of the bonding of a specific delegation from the
+// store
+func DelegationBondingKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	key := append(DelegationBondingsKeyPrefix, address.MustLengthPrefix(delAddr.Bytes())...)
+	return append(key, address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -126,6 +380,31 @@ func SplitInactiveProposalQueueKey(key []byte) (proposalID uint64, endTime time.
 	return splitKeyWithTime(key)
 }
 
+// SplitFinalizedProposalQueueKey split the finalized proposal key and returns the proposal id and voting endTime
+func SplitFinalizedProposalQueueKey(key []byte) (proposalID uint64, endTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
+// SplitExecutionQueueKey split the execution queue key and returns the proposal id and execution time
+func SplitExecutionQueueKey(key []byte) (proposalID uint64, executionTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
+// SplitOptimisticProposalQueueKey split the optimistic proposal key and returns the proposal id and endTime
+func SplitOptimisticProposalQueueKey(key []byte) (proposalID uint64, endTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
+// SplitRevealProposalQueueKey split the reveal proposal key and returns the proposal id and reveal endTime
+func SplitRevealProposalQueueKey(key []byte) (proposalID uint64, endTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
+// SplitCouncilProposalQueueKey split the council proposal key and returns the proposal id and endTime
+func SplitCouncilProposalQueueKey(key []byte) (proposalID uint64, endTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
 // SplitKeyDeposit split the deposits key and returns the proposal id and depositor address
 func SplitKeyDeposit(key []byte) (proposalID uint64, depositorAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
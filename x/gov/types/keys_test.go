@@ -31,6 +31,17 @@ func TestProposalKeys(t *testing.T) {
 	require.Equal(t, int(proposalID), 3)
 	require.True(t, now.Equal(expTime))
 
+	// key finalized proposal queue
+	key = FinalizedProposalQueueKey(3, now)
+	proposalID, expTime = SplitFinalizedProposalQueueKey(key)
+	require.Equal(t, int(proposalID), 3)
+	require.True(t, now.Equal(expTime))
+
+	// key archived proposal
+	key = ArchivedProposalKey(4)
+	proposalID = SplitProposalKey(key)
+	require.Equal(t, int(proposalID), 4)
+
 	// invalid key
 	require.Panics(t, func() { SplitProposalKey([]byte("test")) })
 	require.Panics(t, func() { SplitInactiveProposalQueueKey([]byte("test")) })
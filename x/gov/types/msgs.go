@@ -14,15 +14,25 @@ import (
 
 // Governance message types and routes
 const (
-	TypeMsgDeposit        = "deposit"
-	TypeMsgVote           = "vote"
-	TypeMsgVoteWeighted   = "weighted_vote"
-	TypeMsgSubmitProposal = "submit_proposal"
+	TypeMsgDeposit          = "deposit"
+	TypeMsgVote             = "vote"
+	TypeMsgVoteWeighted     = "weighted_vote"
+	TypeMsgSubmitProposal   = "submit_proposal"
+	TypeMsgCancelProposal   = "cancel_proposal"
+	TypeMsgAnchorDiscussion = "anchor_discussion"
+	TypeMsgVoteOption       = "vote_option"
+	TypeMsgCommitVote       = "commit_vote"
+	TypeMsgRevealVote       = "reveal_vote"
+	TypeMsgSetGovernor      = "set_governor"
+	TypeMsgRemoveGovernor   = "remove_governor"
+
+	TypeMsgRetryProposalExecution = "retry_proposal_execution"
 )
 
 var (
-	_, _, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}
-	_          types.UnpackInterfacesMessage = &MsgSubmitProposal{}
+	_, _, _, _, _, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgCancelProposal{}, &MsgAnchorDiscussion{}, &MsgVoteOption{}
+	_, _, _, _, _       sdk.Msg                       = &MsgCommitVote{}, &MsgRevealVote{}, &MsgSetGovernor{}, &MsgRemoveGovernor{}, &MsgRetryProposalExecution{}
+	_                   types.UnpackInterfacesMessage = &MsgSubmitProposal{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -54,6 +64,20 @@ func (m *MsgSubmitProposal) GetContent() Content {
 	return content
 }
 
+func (m *MsgSubmitProposal) GetIsExpedited() bool { return m.IsExpedited }
+
+func (m *MsgSubmitProposal) GetIsOptimistic() bool { return m.IsOptimistic }
+
+func (m *MsgSubmitProposal) GetChoices() []ProposalChoice { return m.Choices }
+
+func (m *MsgSubmitProposal) GetIsPrivate() bool { return m.IsPrivate }
+
+func (m *MsgSubmitProposal) GetIsCouncil() bool { return m.IsCouncil }
+
+func (m *MsgSubmitProposal) GetDependsOn() []uint64 { return m.DependsOn }
+
+func (m *MsgSubmitProposal) GetIsEmergency() bool { return m.IsEmergency }
+
 func (m *MsgSubmitProposal) SetInitialDeposit(coins sdk.Coins) {
 	m.InitialDeposit = coins
 }
@@ -62,6 +86,34 @@ func (m *MsgSubmitProposal) SetProposer(address fmt.Stringer) {
 	m.Proposer = address.String()
 }
 
+func (m *MsgSubmitProposal) SetIsExpedited(isExpedited bool) {
+	m.IsExpedited = isExpedited
+}
+
+func (m *MsgSubmitProposal) SetIsOptimistic(isOptimistic bool) {
+	m.IsOptimistic = isOptimistic
+}
+
+func (m *MsgSubmitProposal) SetChoices(choices []ProposalChoice) {
+	m.Choices = choices
+}
+
+func (m *MsgSubmitProposal) SetIsPrivate(isPrivate bool) {
+	m.IsPrivate = isPrivate
+}
+
+func (m *MsgSubmitProposal) SetIsCouncil(isCouncil bool) {
+	m.IsCouncil = isCouncil
+}
+
+func (m *MsgSubmitProposal) SetDependsOn(dependsOn []uint64) {
+	m.DependsOn = dependsOn
+}
+
+func (m *MsgSubmitProposal) SetIsEmergency(isEmergency bool) {
+	m.IsEmergency = isEmergency
+}
+
 func (m *MsgSubmitProposal) SetContent(content Content) error {
 	msg, ok := content.(proto.Message)
 	if !ok {
@@ -92,6 +144,29 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 	if m.InitialDeposit.IsAnyNegative() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.InitialDeposit.String())
 	}
+	if m.IsExpedited && m.IsOptimistic {
+		return sdkerrors.Wrap(ErrInvalidProposalContent, "proposal cannot be both expedited and optimistic")
+	}
+	if len(m.Choices) > 0 {
+		if m.IsExpedited || m.IsOptimistic {
+			return sdkerrors.Wrap(ErrInvalidProposalContent, "multiple-choice proposal cannot be expedited or optimistic")
+		}
+		if err := ValidateProposalChoices(m.Choices); err != nil {
+			return err
+		}
+	}
+	if m.IsPrivate && (m.IsExpedited || m.IsOptimistic || len(m.Choices) > 0) {
+		return sdkerrors.Wrap(ErrInvalidProposalContent, "private proposal cannot be expedited, optimistic or multiple-choice")
+	}
+	if m.IsCouncil && (m.IsExpedited || m.IsOptimistic || len(m.Choices) > 0 || m.IsPrivate) {
+		return sdkerrors.Wrap(ErrInvalidProposalContent, "council proposal cannot be expedited, optimistic, multiple-choice or private")
+	}
+	if m.IsEmergency && (m.IsExpedited || m.IsOptimistic || len(m.Choices) > 0 || m.IsPrivate || m.IsCouncil) {
+		return sdkerrors.Wrap(ErrInvalidProposalContent, "emergency proposal cannot be expedited, optimistic, multiple-choice, private or council")
+	}
+	if err := ValidateProposalDependencies(m.DependsOn); err != nil {
+		return err
+	}
 
 	content := m.GetContent()
 	if content == nil {
@@ -128,7 +203,17 @@ func (m MsgSubmitProposal) String() string {
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (m MsgSubmitProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	var content Content
-	return unpacker.UnpackAny(m.Content, &content)
+	if err := unpacker.UnpackAny(m.Content, &content); err != nil {
+		return err
+	}
+
+	for _, choice := range m.Choices {
+		if err := choice.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // NewMsgDeposit creates a new MsgDeposit instance
@@ -179,7 +264,7 @@ func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
 // NewMsgVote creates a message to cast a vote on an active proposal
 //nolint:interfacer
 func NewMsgVote(voter sdk.AccAddress, proposalID uint64, option VoteOption) *MsgVote {
-	return &MsgVote{proposalID, voter.String(), option}
+	return &MsgVote{proposalID, voter.String(), option, ""}
 }
 
 // Route implements Msg
@@ -196,6 +281,9 @@ func (msg MsgVote) ValidateBasic() error {
 	if !ValidVoteOption(msg.Option) {
 		return sdkerrors.Wrap(ErrInvalidVote, msg.Option.String())
 	}
+	if err := ValidateVoteMetadata(msg.Metadata); err != nil {
+		return sdkerrors.Wrap(ErrInvalidVote, err.Error())
+	}
 
 	return nil
 }
@@ -221,7 +309,7 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 // NewMsgVoteWeighted creates a message to cast a vote on an active proposal
 //nolint:interfacer
 func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) *MsgVoteWeighted {
-	return &MsgVoteWeighted{proposalID, voter.String(), options}
+	return &MsgVoteWeighted{proposalID, voter.String(), options, ""}
 }
 
 // Route implements Msg
@@ -235,48 +323,353 @@ func (msg MsgVoteWeighted) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
 	}
-	if len(msg.Options) == 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, WeightedVoteOptions(msg.Options).String())
+	if err := ValidateVoteMetadata(msg.Metadata); err != nil {
+		return sdkerrors.Wrap(ErrInvalidVote, err.Error())
 	}
 
-	totalWeight := sdk.NewDec(0)
-	usedOptions := make(map[VoteOption]bool)
-	for _, option := range msg.Options {
-		if !ValidWeightedVoteOption(option) {
-			return sdkerrors.Wrap(ErrInvalidVote, option.String())
-		}
-		totalWeight = totalWeight.Add(option.Weight)
-		if usedOptions[option.Option] {
-			return sdkerrors.Wrap(ErrInvalidVote, "Duplicated vote option")
-		}
-		usedOptions[option.Option] = true
+	return ValidateWeightedVoteOptions(msg.Options)
+}
+
+// String implements the Stringer interface
+func (msg MsgVoteWeighted) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{voter}
+}
+
+// NewMsgCancelProposal creates a message to cancel a proposal
+//nolint:interfacer
+func NewMsgCancelProposal(proposer sdk.AccAddress, proposalID uint64) *MsgCancelProposal {
+	return &MsgCancelProposal{proposalID, proposer.String()}
+}
+
+// Route implements Msg
+func (msg MsgCancelProposal) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCancelProposal) Type() string { return TypeMsgCancelProposal }
+
+// ValidateBasic implements Msg
+func (msg MsgCancelProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
 	}
 
-	if totalWeight.GT(sdk.NewDec(1)) {
-		return sdkerrors.Wrap(ErrInvalidVote, "Total weight overflow 1.00")
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgCancelProposal) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(msg.Proposer)
+	return []sdk.AccAddress{proposer}
+}
+
+// NewMsgAnchorDiscussion creates a message to anchor the content hash of a
+// discussion of a proposal
+//nolint:interfacer
+func NewMsgAnchorDiscussion(author sdk.AccAddress, proposalID uint64, hash []byte, uri string) *MsgAnchorDiscussion {
+	return &MsgAnchorDiscussion{proposalID, author.String(), hash, uri}
+}
+
+// Route implements Msg
+func (msg MsgAnchorDiscussion) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgAnchorDiscussion) Type() string { return TypeMsgAnchorDiscussion }
+
+// ValidateBasic implements Msg
+func (msg MsgAnchorDiscussion) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Author); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid author address: %s", err)
+	}
+	if err := ValidateDiscussionAnchor(msg.Hash, msg.Uri); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	if totalWeight.LT(sdk.NewDec(1)) {
-		return sdkerrors.Wrap(ErrInvalidVote, "Total weight lower than 1.00")
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgAnchorDiscussion) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgAnchorDiscussion) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgAnchorDiscussion) GetSigners() []sdk.AccAddress {
+	author, _ := sdk.AccAddressFromBech32(msg.Author)
+	return []sdk.AccAddress{author}
+}
+
+// NewMsgVoteOption creates a message to vote for a choice of a multiple-choice
+// proposal
+//nolint:interfacer
+func NewMsgVoteOption(voter sdk.AccAddress, proposalID uint64, optionIndex uint32) *MsgVoteOption {
+	return &MsgVoteOption{proposalID, voter.String(), optionIndex}
+}
+
+// Route implements Msg
+func (msg MsgVoteOption) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgVoteOption) Type() string { return TypeMsgVoteOption }
+
+// ValidateBasic implements Msg
+func (msg MsgVoteOption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+	if msg.OptionIndex >= MaxProposalChoices {
+		return sdkerrors.Wrapf(ErrInvalidVote, "option index %d", msg.OptionIndex)
 	}
 
 	return nil
 }
 
 // String implements the Stringer interface
-func (msg MsgVoteWeighted) String() string {
+func (msg MsgVoteOption) String() string {
 	out, _ := yaml.Marshal(msg)
 	return string(out)
 }
 
 // GetSignBytes implements Msg
-func (msg MsgVoteWeighted) GetSignBytes() []byte {
+func (msg MsgVoteOption) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(&msg)
 	return sdk.MustSortJSON(bz)
 }
 
 // GetSigners implements Msg
-func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+func (msg MsgVoteOption) GetSigners() []sdk.AccAddress {
 	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
 	return []sdk.AccAddress{voter}
 }
+
+// NewMsgCommitVote creates a message to commit to a vote on a private proposal
+//nolint:interfacer
+func NewMsgCommitVote(voter sdk.AccAddress, proposalID uint64, commitment []byte) *MsgCommitVote {
+	return &MsgCommitVote{proposalID, voter.String(), commitment}
+}
+
+// Route implements Msg
+func (msg MsgCommitVote) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCommitVote) Type() string { return TypeMsgCommitVote }
+
+// ValidateBasic implements Msg
+func (msg MsgCommitVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+	if err := ValidateVoteCommitment(msg.Commitment); err != nil {
+		return sdkerrors.Wrap(ErrInvalidVote, err.Error())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgCommitVote) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCommitVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCommitVote) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{voter}
+}
+
+// NewMsgRevealVote creates a message to reveal a vote committed to on a
+// private proposal
+//nolint:interfacer
+func NewMsgRevealVote(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions, salt string) *MsgRevealVote {
+	return &MsgRevealVote{proposalID, voter.String(), options, salt}
+}
+
+// Route implements Msg
+func (msg MsgRevealVote) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgRevealVote) Type() string { return TypeMsgRevealVote }
+
+// ValidateBasic implements Msg
+func (msg MsgRevealVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+	if err := ValidateVoteSalt(msg.Salt); err != nil {
+		return sdkerrors.Wrap(ErrInvalidVote, err.Error())
+	}
+
+	return ValidateWeightedVoteOptions(msg.Options)
+}
+
+// String implements the Stringer interface
+func (msg MsgRevealVote) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgRevealVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgRevealVote) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{voter}
+}
+
+// NewMsgSetGovernor creates a message to delegate the governance voting power
+// of an account to a governor
+//nolint:interfacer
+func NewMsgSetGovernor(delegator, governor sdk.AccAddress) *MsgSetGovernor {
+	return &MsgSetGovernor{delegator.String(), governor.String()}
+}
+
+// Route implements Msg
+func (msg MsgSetGovernor) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgSetGovernor) Type() string { return TypeMsgSetGovernor }
+
+// ValidateBasic implements Msg
+func (msg MsgSetGovernor) ValidateBasic() error {
+	return GovernanceDelegation{DelegatorAddress: msg.DelegatorAddress, GovernorAddress: msg.GovernorAddress}.Validate()
+}
+
+// String implements the Stringer interface
+func (msg MsgSetGovernor) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgSetGovernor) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgSetGovernor) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// NewMsgRemoveGovernor creates a message to take back the governance voting
+// power delegated to a governor
+//nolint:interfacer
+func NewMsgRemoveGovernor(delegator sdk.AccAddress) *MsgRemoveGovernor {
+	return &MsgRemoveGovernor{delegator.String()}
+}
+
+// Route implements Msg
+func (msg MsgRemoveGovernor) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgRemoveGovernor) Type() string { return TypeMsgRemoveGovernor }
+
+// ValidateBasic implements Msg
+func (msg MsgRemoveGovernor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgRemoveGovernor) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgRemoveGovernor) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgRemoveGovernor) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// NewMsgRetryProposalExecution creates a message to retry the failed execution
+// of a passed proposal
+//nolint:interfacer
+func NewMsgRetryProposalExecution(executor sdk.AccAddress, proposalID uint64) *MsgRetryProposalExecution {
+	return &MsgRetryProposalExecution{proposalID, executor.String()}
+}
+
+// Route implements Msg
+func (msg MsgRetryProposalExecution) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgRetryProposalExecution) Type() string { return TypeMsgRetryProposalExecution }
+
+// ValidateBasic implements Msg
+func (msg MsgRetryProposalExecution) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Executor); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid executor address: %s", err)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgRetryProposalExecution) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgRetryProposalExecution) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgRetryProposalExecution) GetSigners() []sdk.AccAddress {
+	executor, _ := sdk.AccAddressFromBech32(msg.Executor)
+	return []sdk.AccAddress{executor}
+}
@@ -57,6 +57,63 @@ func TestMsgSubmitProposal(t *testing.T) {
 			require.Error(t, msg.ValidateBasic(), "test: %v", i)
 		}
 	}
+
+	// a proposal cannot be both expedited and optimistic
+	msg, err := NewMsgSubmitProposal(NewTextProposal("Test Proposal", "the purpose of this proposal is to test"), coinsPos, addrs[0])
+	require.NoError(t, err)
+	msg.SetIsOptimistic(true)
+	require.NoError(t, msg.ValidateBasic())
+	msg.SetIsExpedited(true)
+	require.Error(t, msg.ValidateBasic())
+
+	// a multiple-choice proposal needs valid choices and cannot be expedited
+	// or optimistic
+	msg, err = NewMsgSubmitProposal(NewTextProposal("Test Proposal", "the purpose of this proposal is to test"), coinsPos, addrs[0])
+	require.NoError(t, err)
+	choiceA, err := NewProposalChoice("A", nil)
+	require.NoError(t, err)
+	choiceB, err := NewProposalChoice("B", NewTextProposal("B", "choice B"))
+	require.NoError(t, err)
+	msg.SetChoices([]ProposalChoice{choiceA})
+	require.Error(t, msg.ValidateBasic())
+	msg.SetChoices([]ProposalChoice{choiceA, {}})
+	require.Error(t, msg.ValidateBasic())
+	msg.SetChoices([]ProposalChoice{choiceA, choiceB})
+	require.NoError(t, msg.ValidateBasic())
+	msg.SetIsExpedited(true)
+	require.Error(t, msg.ValidateBasic())
+
+	// a private proposal cannot be multiple-choice
+	msg.SetIsExpedited(false)
+	msg.SetIsPrivate(true)
+	require.Error(t, msg.ValidateBasic())
+	msg.SetChoices(nil)
+	require.NoError(t, msg.ValidateBasic())
+
+	// a council proposal cannot be on another track
+	msg.SetIsCouncil(true)
+	require.Error(t, msg.ValidateBasic())
+	msg.SetIsPrivate(false)
+	require.NoError(t, msg.ValidateBasic())
+
+	// an emergency proposal cannot be on another track
+	msg.SetIsEmergency(true)
+	require.Error(t, msg.ValidateBasic())
+	msg.SetIsCouncil(false)
+	require.NoError(t, msg.ValidateBasic())
+	msg.SetIsOptimistic(true)
+	require.Error(t, msg.ValidateBasic())
+	msg.SetIsOptimistic(false)
+
+	// a proposal depends on distinct, non-zero proposal IDs
+	msg.SetDependsOn([]uint64{1, 2})
+	require.NoError(t, msg.ValidateBasic())
+	msg.SetDependsOn([]uint64{1, 0})
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidDependency)
+	msg.SetDependsOn([]uint64{1, 1})
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidDependency)
+	msg.SetDependsOn(make([]uint64, MaxProposalDependencies+1))
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidDependency)
 }
 
 func TestMsgDepositGetSignBytes(t *testing.T) {
@@ -118,6 +175,131 @@ func TestMsgVote(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for the metadata of MsgVote and MsgVoteWeighted
+func TestMsgVoteMetadata(t *testing.T) {
+	tests := []struct {
+		metadata   string
+		expectPass bool
+	}{
+		{"", true},
+		{"the upgrade isn't audited yet", true},
+		{strings.Repeat("a", MaxVoteMetadataLength), true},
+		{strings.Repeat("a", MaxVoteMetadataLength+1), false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVote(addrs[0], 1, OptionNo)
+		msg.Metadata = tc.metadata
+		weightedMsg := NewMsgVoteWeighted(addrs[0], 1, NewNonSplitVoteOption(OptionNo))
+		weightedMsg.Metadata = tc.metadata
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.NoError(t, weightedMsg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidVote, "test: %v", i)
+			require.ErrorIs(t, weightedMsg.ValidateBasic(), ErrInvalidVote, "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgCancelProposal
+func TestMsgCancelProposal(t *testing.T) {
+	tests := []struct {
+		proposalID   uint64
+		proposerAddr sdk.AccAddress
+		expectPass   bool
+	}{
+		{1, addrs[0], true},
+		{0, addrs[1], true},
+		{1, sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgCancelProposal(tc.proposerAddr, tc.proposalID)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{tc.proposerAddr}, msg.GetSigners())
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgRetryProposalExecution
+func TestMsgRetryProposalExecution(t *testing.T) {
+	tests := []struct {
+		proposalID   uint64
+		executorAddr sdk.AccAddress
+		expectPass   bool
+	}{
+		{1, addrs[0], true},
+		{0, addrs[1], true},
+		{1, sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgRetryProposalExecution(tc.executorAddr, tc.proposalID)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{tc.executorAddr}, msg.GetSigners())
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgAnchorDiscussion(t *testing.T) {
+	hash := make([]byte, DiscussionAnchorHashLength)
+	tests := []struct {
+		proposalID uint64
+		authorAddr sdk.AccAddress
+		hash       []byte
+		uri        string
+		expectPass bool
+	}{
+		{1, addrs[0], hash, "", true},
+		{1, addrs[0], hash, "https://forum.example.com/t/1", true},
+		{1, sdk.AccAddress{}, hash, "", false},
+		{1, addrs[0], nil, "", false},
+		{1, addrs[0], hash[:20], "", false},
+		{1, addrs[0], hash, strings.Repeat("u", MaxDiscussionAnchorURILength+1), false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgAnchorDiscussion(tc.authorAddr, tc.proposalID, tc.hash, tc.uri)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{tc.authorAddr}, msg.GetSigners())
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgVoteOption(t *testing.T) {
+	tests := []struct {
+		proposalID  uint64
+		voterAddr   sdk.AccAddress
+		optionIndex uint32
+		expectPass  bool
+	}{
+		{1, addrs[0], 0, true},
+		{1, addrs[0], MaxProposalChoices - 1, true},
+		{1, addrs[0], MaxProposalChoices, false},
+		{1, sdk.AccAddress{}, 0, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteOption(tc.voterAddr, tc.proposalID, tc.optionIndex)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{tc.voterAddr}, msg.GetSigners())
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgVoteWeighted
 func TestMsgVoteWeighted(t *testing.T) {
 	tests := []struct {
@@ -162,6 +344,82 @@ func TestMsgVoteWeighted(t *testing.T) {
 	}
 }
 
+func TestMsgCommitVote(t *testing.T) {
+	commitment := VoteCommitmentHash(1, addrs[0], NewNonSplitVoteOption(OptionYes), "salt")
+	tests := []struct {
+		proposalID uint64
+		voterAddr  sdk.AccAddress
+		commitment []byte
+		expectPass bool
+	}{
+		{1, addrs[0], commitment, true},
+		{1, sdk.AccAddress{}, commitment, false},
+		{1, addrs[0], nil, false},
+		{1, addrs[0], commitment[:31], false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgCommitVote(tc.voterAddr, tc.proposalID, tc.commitment)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{tc.voterAddr}, msg.GetSigners())
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgSetGovernor(t *testing.T) {
+	tests := []struct {
+		delegatorAddr, governorAddr sdk.AccAddress
+		expectPass                  bool
+	}{
+		{addrs[0], addrs[1], true},
+		{sdk.AccAddress{}, addrs[1], false},
+		{addrs[0], sdk.AccAddress{}, false},
+		{addrs[0], addrs[0], false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSetGovernor(tc.delegatorAddr, tc.governorAddr)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{tc.delegatorAddr}, msg.GetSigners())
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	require.NoError(t, NewMsgRemoveGovernor(addrs[0]).ValidateBasic())
+	require.Error(t, NewMsgRemoveGovernor(sdk.AccAddress{}).ValidateBasic())
+}
+
+func TestMsgRevealVote(t *testing.T) {
+	tests := []struct {
+		proposalID uint64
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		salt       string
+		expectPass bool
+	}{
+		{1, addrs[0], NewNonSplitVoteOption(OptionYes), "salt", true},
+		{1, sdk.AccAddress{}, NewNonSplitVoteOption(OptionYes), "salt", false},
+		{1, addrs[0], WeightedVoteOptions{}, "salt", false},
+		{1, addrs[0], NewNonSplitVoteOption(OptionYes), "", false},
+		{1, addrs[0], NewNonSplitVoteOption(OptionYes), strings.Repeat("s", MaxVoteSaltLength+1), false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgRevealVote(tc.voterAddr, tc.proposalID, tc.options, tc.salt)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{tc.voterAddr}, msg.GetSigners())
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // this tests that Amino JSON MsgSubmitProposal.GetSignBytes() still works with Content as Any using the ModuleCdc
 func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 	msg, err := NewMsgSubmitProposal(NewTextProposal("test", "abcd"), sdk.NewCoins(), sdk.AccAddress{})
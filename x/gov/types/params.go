@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	yaml "gopkg.in/yaml.v2"
@@ -12,7 +13,8 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
 // Default governance params
@@ -21,6 +23,16 @@ var (
 	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
 	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
 	DefaultVetoThreshold    = sdk.NewDecWithPrec(334, 3)
+
+	DefaultExpeditedQuorum    = sdk.NewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold = sdk.NewDecWithPrec(667, 3)
+
+	DefaultOptimisticVetoThreshold = sdk.NewDecWithPrec(1, 1)
+
+	DefaultEmergencyThreshold = sdk.NewDecWithPrec(667, 3)
+
+	DefaultCancelBurnRatio        = sdk.NewDecWithPrec(5, 1)
+	DefaultMinInitialDepositRatio = sdk.ZeroDec()
 )
 
 // Parameter store key
@@ -28,6 +40,8 @@ var (
 	ParamStoreKeyDepositParams = []byte("depositparams")
 	ParamStoreKeyVotingParams  = []byte("votingparams")
 	ParamStoreKeyTallyParams   = []byte("tallyparams")
+
+	ParamStoreKeyProposalTemplates = []byte("proposaltemplates")
 )
 
 // ParamKeyTable - Key declaration for parameters
@@ -36,14 +50,23 @@ func ParamKeyTable() paramtypes.KeyTable {
 		paramtypes.NewParamSetPair(ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyVotingParams, VotingParams{}, validateVotingParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyTallyParams, TallyParams{}, validateTallyParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyProposalTemplates, []ProposalTemplate{}, validateProposalTemplates),
 	)
 }
 
-// NewDepositParams creates a new DepositParams object
+// NewDepositParams creates a new DepositParams object. The deposits of
+// canceled proposals are burned with the default ratio, no initial deposit is
+// required on proposal submission, and the deposits of vetoed, quorum failing
+// and dropped proposals are burned.
 func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: maxDepositPeriod,
+		MinDeposit:                 minDeposit,
+		MaxDepositPeriod:           maxDepositPeriod,
+		CancelBurnRatio:            DefaultCancelBurnRatio,
+		MinInitialDepositRatio:     DefaultMinInitialDepositRatio,
+		BurnVoteVeto:               true,
+		BurnVoteQuorum:             true,
+		BurnProposalDepositPrevote: true,
 	}
 }
 
@@ -63,7 +86,42 @@ func (dp DepositParams) String() string {
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.SubmissionFee.IsEqual(dp2.SubmissionFee) && dp.CancelBurnRatio.Equal(dp2.CancelBurnRatio) &&
+		dp.MinInitialDepositRatio.Equal(dp2.MinInitialDepositRatio) && dp.BurnVoteVeto == dp2.BurnVoteVeto &&
+		dp.BurnVoteQuorum == dp2.BurnVoteQuorum && dp.BurnProposalDepositPrevote == dp2.BurnProposalDepositPrevote &&
+		dp.BurnSubmissionFee == dp2.BurnSubmissionFee
+}
+
+// BurnsDeposits returns whether the deposits of a proposal are burned for the
+// given reason.
+func (dp DepositParams) BurnsDeposits(reason DepositBurnReason) bool {
+	switch reason {
+	case BurnReasonVeto:
+		return dp.BurnVoteVeto
+	case BurnReasonQuorum:
+		return dp.BurnVoteQuorum
+	case BurnReasonDropped:
+		return dp.BurnProposalDepositPrevote
+	default:
+		return false
+	}
+}
+
+// MinInitialDeposit returns the minimum deposit the proposer must deposit on
+// proposal submission, the MinInitialDepositRatio fraction of MinDeposit.
+func (dp DepositParams) MinInitialDeposit() sdk.Coins {
+	if dp.MinInitialDepositRatio.IsNil() || dp.MinInitialDepositRatio.IsZero() {
+		return sdk.NewCoins()
+	}
+
+	minInitialDeposit := sdk.NewCoins()
+	for _, coin := range dp.MinDeposit {
+		amount := dp.MinInitialDepositRatio.MulInt(coin.Amount).Ceil().TruncateInt()
+		minInitialDeposit = minInitialDeposit.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+
+	return minInitialDeposit
 }
 
 func validateDepositParams(i interface{}) error {
@@ -78,27 +136,116 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod <= 0 {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
+	if !v.SubmissionFee.IsValid() {
+		return fmt.Errorf("invalid submission fee: %s", v.SubmissionFee)
+	}
+	if v.CancelBurnRatio.IsNil() || v.CancelBurnRatio.IsNegative() || v.CancelBurnRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("cancel burn ratio must be between 0 and 1: %s", v.CancelBurnRatio)
+	}
+	if v.MinInitialDepositRatio.IsNil() || v.MinInitialDepositRatio.IsNegative() || v.MinInitialDepositRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("minimum initial deposit ratio must be between 0 and 1: %s", v.MinInitialDepositRatio)
+	}
 
 	return nil
 }
 
-// NewTallyParams creates a new TallyParams object
+// NewTallyParams creates a new TallyParams object. The expedited quorum and
+// threshold are the same as the regular ones, optimistic proposals are vetoed
+// with the default optimistic veto threshold, and emergency proposals are
+// fast-tracked with the default emergency threshold.
 func NewTallyParams(quorum, threshold, vetoThreshold sdk.Dec) TallyParams {
 	return TallyParams{
-		Quorum:        quorum,
-		Threshold:     threshold,
-		VetoThreshold: vetoThreshold,
+		Quorum:                  quorum,
+		Threshold:               threshold,
+		VetoThreshold:           vetoThreshold,
+		ExpeditedQuorum:         quorum,
+		ExpeditedThreshold:      threshold,
+		OptimisticVetoThreshold: DefaultOptimisticVetoThreshold,
+		VotingPowerCap:          sdk.ZeroDec(),
+		MaxConvictionMultiplier: sdk.OneDec(),
+		EmergencyThreshold:      DefaultEmergencyThreshold,
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold)
+	tp := NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold)
+	tp.ExpeditedQuorum = DefaultExpeditedQuorum
+	tp.ExpeditedThreshold = DefaultExpeditedThreshold
+	return tp
 }
 
 // Equal checks equality of TallyParams
 func (tp TallyParams) Equal(other TallyParams) bool {
-	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.VetoThreshold.Equal(other.VetoThreshold)
+	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.VetoThreshold.Equal(other.VetoThreshold) &&
+		tp.ExpeditedQuorum.Equal(other.ExpeditedQuorum) && tp.ExpeditedThreshold.Equal(other.ExpeditedThreshold) &&
+		tp.OptimisticVetoThreshold.Equal(other.OptimisticVetoThreshold) && tp.QuadraticVoting == other.QuadraticVoting &&
+		tp.VotingPowerCap.Equal(other.VotingPowerCap) && tp.ConvictionPeriod == other.ConvictionPeriod &&
+		tp.MaxConvictionMultiplier.Equal(other.MaxConvictionMultiplier) && tp.EmergencyThreshold.Equal(other.EmergencyThreshold)
+}
+
+// QuorumAndThreshold returns the quorum and threshold a proposal is tallied
+// with, which are elevated for expedited proposals.
+func (tp TallyParams) QuorumAndThreshold(isExpedited bool) (quorum, threshold sdk.Dec) {
+	if isExpedited {
+		return tp.ExpeditedQuorum, tp.ExpeditedThreshold
+	}
+
+	return tp.Quorum, tp.Threshold
+}
+
+// EmergencyTrack returns whether emergency proposals can be submitted, which
+// they can unless the emergency threshold is zero.
+func (tp TallyParams) EmergencyTrack() bool {
+	return !tp.EmergencyThreshold.IsNil() && tp.EmergencyThreshold.IsPositive()
+}
+
+// TransformsVotingPower returns whether the voting power of the accounts is
+// transformed when tallying, by conviction voting, quadratic voting or the
+// voting power cap.
+func (tp TallyParams) TransformsVotingPower() bool {
+	return tp.ConvictionVoting() || tp.QuadraticVoting || tp.VotingPowerCap.IsPositive()
+}
+
+// ConvictionVoting returns whether the voting power of the delegations is
+// multiplied by a conviction multiplier growing with their bonding duration.
+func (tp TallyParams) ConvictionVoting() bool {
+	return tp.ConvictionPeriod > 0 && tp.MaxConvictionMultiplier.GT(sdk.OneDec())
+}
+
+// ConvictionMultiplier returns the multiplier of the voting power of a
+// delegation bonded for the given duration: it grows linearly from one when
+// the delegation is bonded to the maximum conviction multiplier when it has
+// been bonded for the conviction period.
+func (tp TallyParams) ConvictionMultiplier(bondedFor time.Duration) sdk.Dec {
+	if !tp.ConvictionVoting() || bondedFor <= 0 {
+		return sdk.OneDec()
+	}
+	if bondedFor >= tp.ConvictionPeriod {
+		return tp.MaxConvictionMultiplier
+	}
+
+	conviction := sdk.NewDec(int64(bondedFor)).QuoInt64(int64(tp.ConvictionPeriod))
+	return sdk.OneDec().Add(tp.MaxConvictionMultiplier.Sub(sdk.OneDec()).Mul(conviction))
+}
+
+// TransformVotingPower returns the voting power an account with the given
+// voting power is tallied with: capped to powerCap if the voting power cap is
+// set, then square rooted if quadratic voting is enabled.
+func (tp TallyParams) TransformVotingPower(votingPower, powerCap sdk.Dec) sdk.Dec {
+	if tp.VotingPowerCap.IsPositive() && votingPower.GT(powerCap) {
+		votingPower = powerCap
+	}
+
+	if tp.QuadraticVoting {
+		root, err := votingPower.ApproxSqrt()
+		if err != nil {
+			panic(err)
+		}
+		votingPower = root
+	}
+
+	return votingPower
 }
 
 // String implements stringer insterface
@@ -131,6 +278,45 @@ func validateTallyParams(i interface{}) error {
 	if v.VetoThreshold.GT(sdk.OneDec()) {
 		return fmt.Errorf("veto threshold too large: %s", v)
 	}
+	if v.ExpeditedQuorum.IsNil() || v.ExpeditedQuorum.LT(v.Quorum) {
+		return fmt.Errorf("expedited quorum must not be lower than the quorum %s: %s", v.Quorum, v.ExpeditedQuorum)
+	}
+	if v.ExpeditedQuorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited quorum too large: %s", v)
+	}
+	if v.ExpeditedThreshold.IsNil() || v.ExpeditedThreshold.LT(v.Threshold) {
+		return fmt.Errorf("expedited threshold must not be lower than the threshold %s: %s", v.Threshold, v.ExpeditedThreshold)
+	}
+	if v.ExpeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited threshold too large: %s", v)
+	}
+	if v.OptimisticVetoThreshold.IsNil() || !v.OptimisticVetoThreshold.IsPositive() {
+		return fmt.Errorf("optimistic veto threshold must be positive: %s", v.OptimisticVetoThreshold)
+	}
+	if v.OptimisticVetoThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("optimistic veto threshold too large: %s", v)
+	}
+	if v.VotingPowerCap.IsNil() || v.VotingPowerCap.IsNegative() {
+		return fmt.Errorf("voting power cap cannot be negative: %s", v.VotingPowerCap)
+	}
+	if v.VotingPowerCap.GT(sdk.OneDec()) {
+		return fmt.Errorf("voting power cap too large: %s", v)
+	}
+	if v.ConvictionPeriod < 0 {
+		return fmt.Errorf("conviction period cannot be negative: %s", v.ConvictionPeriod)
+	}
+	if v.MaxConvictionMultiplier.IsNil() || v.MaxConvictionMultiplier.LT(sdk.OneDec()) {
+		return fmt.Errorf("max conviction multiplier must be at least one: %s", v.MaxConvictionMultiplier)
+	}
+	if v.EmergencyThreshold.IsNil() || v.EmergencyThreshold.IsNegative() {
+		return fmt.Errorf("emergency threshold cannot be negative: %s", v.EmergencyThreshold)
+	}
+	if v.EmergencyThreshold.IsPositive() && v.EmergencyThreshold.MulInt64(3).LTE(sdk.NewDec(2)) {
+		return fmt.Errorf("emergency threshold must be zero or more than 2/3: %s", v.EmergencyThreshold)
+	}
+	if v.EmergencyThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("emergency threshold too large: %s", v)
+	}
 
 	return nil
 }
@@ -144,12 +330,74 @@ func NewVotingParams(votingPeriod time.Duration) VotingParams {
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+	vp := NewVotingParams(DefaultPeriod)
+	vp.ExpeditedVotingPeriod = DefaultExpeditedPeriod
+	return vp
 }
 
 // Equal checks equality of TallyParams
 func (vp VotingParams) Equal(other VotingParams) bool {
-	return vp.VotingPeriod == other.VotingPeriod
+	return vp.VotingPeriod == other.VotingPeriod && vp.QuorumExtensionPeriod == other.QuorumExtensionPeriod &&
+		vp.ValidatorVotingPeriod == other.ValidatorVotingPeriod && vp.VoteReceiptsEnabled == other.VoteReceiptsEnabled &&
+		vp.ArchiveRetentionPeriod == other.ArchiveRetentionPeriod && vp.ExpeditedVotingPeriod == other.ExpeditedVotingPeriod &&
+		vp.ExecutionGasLimit == other.ExecutionGasLimit && vp.ExecutionDelay == other.ExecutionDelay &&
+		vp.ExecutionRetryWindow == other.ExecutionRetryWindow &&
+		vp.OptimisticVotingPeriod == other.OptimisticVotingPeriod &&
+		equalStrings(vp.OptimisticAuthorizedAddresses, other.OptimisticAuthorizedAddresses) &&
+		vp.RevealPeriod == other.RevealPeriod && vp.VoteCommitmentDeposit.String() == other.VoteCommitmentDeposit.String() &&
+		vp.ArchiveBatchSize == other.ArchiveBatchSize && vp.ArchivePrune == other.ArchivePrune &&
+		vp.CouncilVotingPeriod == other.CouncilVotingPeriod &&
+		equalStrings(vp.CouncilMembers, other.CouncilMembers) &&
+		equalStrings(vp.CouncilProposalTypes, other.CouncilProposalTypes)
+}
+
+// IsOptimisticAuthorized returns whether the given address is allowed to
+// submit optimistic proposals.
+func (vp VotingParams) IsOptimisticAuthorized(addr sdk.AccAddress) bool {
+	for _, authorized := range vp.OptimisticAuthorizedAddresses {
+		if authorized == addr.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsCouncilMember returns whether the given address is a member of the
+// council.
+func (vp VotingParams) IsCouncilMember(addr sdk.AccAddress) bool {
+	for _, member := range vp.CouncilMembers {
+		if member == addr.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsCouncilProposalType returns whether the council can pass proposals with
+// contents of the given type URL.
+func (vp VotingParams) IsCouncilProposalType(typeURL string) bool {
+	for _, proposalType := range vp.CouncilProposalTypes {
+		if proposalType == typeURL {
+			return true
+		}
+	}
+
+	return false
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
 }
 
 // String implements stringer interface
@@ -167,10 +415,90 @@ func validateVotingParams(i interface{}) error {
 	if v.VotingPeriod <= 0 {
 		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
 	}
+	if v.QuorumExtensionPeriod < 0 {
+		return fmt.Errorf("quorum extension period cannot be negative: %s", v.QuorumExtensionPeriod)
+	}
+	if v.ValidatorVotingPeriod < 0 {
+		return fmt.Errorf("validator voting period cannot be negative: %s", v.ValidatorVotingPeriod)
+	}
+	if v.ValidatorVotingPeriod >= v.VotingPeriod {
+		return fmt.Errorf("validator voting period %s must be shorter than the voting period %s", v.ValidatorVotingPeriod, v.VotingPeriod)
+	}
+	if v.ArchiveRetentionPeriod < 0 {
+		return fmt.Errorf("archive retention period cannot be negative: %s", v.ArchiveRetentionPeriod)
+	}
+	if v.ExecutionDelay < 0 {
+		return fmt.Errorf("execution delay cannot be negative: %s", v.ExecutionDelay)
+	}
+	if v.ExecutionRetryWindow < 0 {
+		return fmt.Errorf("execution retry window cannot be negative: %s", v.ExecutionRetryWindow)
+	}
+	if v.ExpeditedVotingPeriod < 0 {
+		return fmt.Errorf("expedited voting period cannot be negative: %s", v.ExpeditedVotingPeriod)
+	}
+	if v.ExpeditedVotingPeriod > 0 {
+		if v.ExpeditedVotingPeriod >= v.VotingPeriod {
+			return fmt.Errorf("expedited voting period %s must be shorter than the voting period %s", v.ExpeditedVotingPeriod, v.VotingPeriod)
+		}
+		if v.ValidatorVotingPeriod >= v.ExpeditedVotingPeriod {
+			return fmt.Errorf("validator voting period %s must be shorter than the expedited voting period %s", v.ValidatorVotingPeriod, v.ExpeditedVotingPeriod)
+		}
+	}
+	if v.OptimisticVotingPeriod < 0 {
+		return fmt.Errorf("optimistic voting period cannot be negative: %s", v.OptimisticVotingPeriod)
+	}
+	seen := make(map[string]bool, len(v.OptimisticAuthorizedAddresses))
+	for _, addr := range v.OptimisticAuthorizedAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid optimistic authorized address %s: %w", addr, err)
+		}
+		if seen[addr] {
+			return fmt.Errorf("duplicate optimistic authorized address: %s", addr)
+		}
+		seen[addr] = true
+	}
+	if v.RevealPeriod < 0 {
+		return fmt.Errorf("reveal period cannot be negative: %s", v.RevealPeriod)
+	}
+	if !v.VoteCommitmentDeposit.IsValid() {
+		return fmt.Errorf("invalid vote commitment deposit: %s", v.VoteCommitmentDeposit)
+	}
+	if v.CouncilVotingPeriod < 0 {
+		return fmt.Errorf("council voting period cannot be negative: %s", v.CouncilVotingPeriod)
+	}
+	seenMembers := make(map[string]bool, len(v.CouncilMembers))
+	for _, member := range v.CouncilMembers {
+		if _, err := sdk.AccAddressFromBech32(member); err != nil {
+			return fmt.Errorf("invalid council member %s: %w", member, err)
+		}
+		if seenMembers[member] {
+			return fmt.Errorf("duplicate council member: %s", member)
+		}
+		seenMembers[member] = true
+	}
+	seenTypes := make(map[string]bool, len(v.CouncilProposalTypes))
+	for _, proposalType := range v.CouncilProposalTypes {
+		if strings.TrimSpace(proposalType) == "" {
+			return fmt.Errorf("council proposal type cannot be blank")
+		}
+		if seenTypes[proposalType] {
+			return fmt.Errorf("duplicate council proposal type: %s", proposalType)
+		}
+		seenTypes[proposalType] = true
+	}
 
 	return nil
 }
 
+func validateProposalTemplates(i interface{}) error {
+	v, ok := i.([]ProposalTemplate)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ValidateProposalTemplates(v)
+}
+
 // Params returns all of the governance params
 type Params struct {
 	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
@@ -80,10 +80,47 @@ func (p Proposal) GetTitle() string {
 	return content.GetTitle()
 }
 
+// IsMultipleChoice returns whether the proposal is a multiple-choice proposal,
+// voted on with choice votes.
+func (p Proposal) IsMultipleChoice() bool {
+	return len(p.Choices) > 0
+}
+
+// ExecutableContent returns the content executed if the proposal passes: the
+// content of the winning choice of a multiple-choice proposal, which may be
+// nil, or the content of the proposal otherwise.
+func (p Proposal) ExecutableContent() Content {
+	if !p.IsMultipleChoice() {
+		return p.GetContent()
+	}
+	if int(p.WinningChoice) >= len(p.Choices) {
+		return nil
+	}
+
+	return p.Choices[p.WinningChoice].GetContent()
+}
+
+// ExecutionRetryable returns whether the failed execution of the proposal can
+// be retried at the given block time, before the end of its execution retry
+// window.
+func (p Proposal) ExecutionRetryable(blockTime time.Time) bool {
+	return p.Status == StatusFailed && blockTime.Before(p.RetryEndTime)
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	var content Content
-	return unpacker.UnpackAny(p.Content, &content)
+	if err := unpacker.UnpackAny(p.Content, &content); err != nil {
+		return err
+	}
+
+	for _, choice := range p.Choices {
+		if err := choice.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // Proposals is an array of proposal
@@ -149,7 +186,9 @@ func ValidProposalStatus(status ProposalStatus) bool {
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
 		status == StatusRejected ||
-		status == StatusFailed {
+		status == StatusFailed ||
+		status == StatusScheduled ||
+		status == StatusRevealPeriod {
 		return true
 	}
 	return false
@@ -1,6 +1,11 @@
 package types
 
 import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -100,3 +105,27 @@ func NewQueryProposalsParams(page, limit int, status ProposalStatus, voter, depo
 		ProposalStatus: status,
 	}
 }
+
+var _ codectypes.UnpackInterfacesMessage = QuerySimulateProposalRequest{}
+
+// NewQuerySimulateContentRequest creates a new QuerySimulateProposalRequest
+// simulating the execution of the content of a proposal which isn't submitted
+// yet.
+func NewQuerySimulateContentRequest(content Content) (*QuerySimulateProposalRequest, error) {
+	msg, ok := content.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("can't proto marshal %T", content)
+	}
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QuerySimulateProposalRequest{Content: any}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QuerySimulateProposalRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var content Content
+	return unpacker.UnpackAny(m.Content, &content)
+}
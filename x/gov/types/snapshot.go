@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewValidatorVotingPower creates a new ValidatorVotingPower instance
+//nolint:interfacer
+func NewValidatorVotingPower(validator sdk.ValAddress, bondedTokens sdk.Int, delegatorShares sdk.Dec) ValidatorVotingPower {
+	return ValidatorVotingPower{
+		ValidatorAddress: validator.String(),
+		BondedTokens:     bondedTokens,
+		DelegatorShares:  delegatorShares,
+	}
+}
+
+func (v ValidatorVotingPower) String() string {
+	out, _ := yaml.Marshal(v)
+	return string(out)
+}
+
+// NewDelegationVotingPower creates a new DelegationVotingPower instance
+//nolint:interfacer
+func NewDelegationVotingPower(delegator sdk.AccAddress, validator sdk.ValAddress, shares sdk.Dec) DelegationVotingPower {
+	return DelegationVotingPower{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: validator.String(),
+		Shares:           shares,
+	}
+}
+
+func (d DelegationVotingPower) String() string {
+	out, _ := yaml.Marshal(d)
+	return string(out)
+}
+
+func (s VotingPowerSnapshot) String() string {
+	out, _ := yaml.Marshal(s)
+	return string(out)
+}
+
+// Validate checks that the addresses of a voting power snapshot are valid, that
+// its amounts aren't negative and that its delegations are to its validators.
+func (s VotingPowerSnapshot) Validate() error {
+	if s.TotalBonded.IsNil() || s.TotalBonded.IsNegative() {
+		return fmt.Errorf("invalid total bonded tokens: %s", s.TotalBonded)
+	}
+
+	validators := make(map[string]bool, len(s.Validators))
+	for _, val := range s.Validators {
+		if _, err := sdk.ValAddressFromBech32(val.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator address: %w", err)
+		}
+		if validators[val.ValidatorAddress] {
+			return fmt.Errorf("duplicate validator %s", val.ValidatorAddress)
+		}
+		if val.BondedTokens.IsNil() || val.BondedTokens.IsNegative() {
+			return fmt.Errorf("invalid bonded tokens of validator %s: %s", val.ValidatorAddress, val.BondedTokens)
+		}
+		if val.DelegatorShares.IsNil() || !val.DelegatorShares.IsPositive() {
+			return fmt.Errorf("invalid delegator shares of validator %s: %s", val.ValidatorAddress, val.DelegatorShares)
+		}
+		validators[val.ValidatorAddress] = true
+	}
+
+	for _, del := range s.Delegations {
+		if _, err := sdk.AccAddressFromBech32(del.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid delegator address: %w", err)
+		}
+		if !validators[del.ValidatorAddress] {
+			return fmt.Errorf("delegation of %s to unknown validator %s", del.DelegatorAddress, del.ValidatorAddress)
+		}
+		if del.Shares.IsNil() || del.Shares.IsNegative() {
+			return fmt.Errorf("invalid shares of delegation of %s to %s: %s", del.DelegatorAddress, del.ValidatorAddress, del.Shares)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestVotingPowerSnapshotValidate(t *testing.T) {
+	delAddr := sdk.AccAddress("delegator___________")
+	valAddr := sdk.ValAddress("validator___________")
+	otherValAddr := sdk.ValAddress("other_validator_____")
+
+	validSnapshot := func() VotingPowerSnapshot {
+		return VotingPowerSnapshot{
+			ProposalId:  1,
+			TotalBonded: sdk.NewInt(100),
+			Validators:  []ValidatorVotingPower{NewValidatorVotingPower(valAddr, sdk.NewInt(100), sdk.NewDec(100))},
+			Delegations: []DelegationVotingPower{NewDelegationVotingPower(delAddr, valAddr, sdk.NewDec(10))},
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func(s *VotingPowerSnapshot)
+		expectErr bool
+	}{
+		{"valid", func(s *VotingPowerSnapshot) {}, false},
+		{"negative total bonded", func(s *VotingPowerSnapshot) { s.TotalBonded = sdk.NewInt(-1) }, true},
+		{"invalid validator address", func(s *VotingPowerSnapshot) { s.Validators[0].ValidatorAddress = "invalid" }, true},
+		{"duplicate validator", func(s *VotingPowerSnapshot) { s.Validators = append(s.Validators, s.Validators[0]) }, true},
+		{"zero delegator shares", func(s *VotingPowerSnapshot) { s.Validators[0].DelegatorShares = sdk.ZeroDec() }, true},
+		{"invalid delegator address", func(s *VotingPowerSnapshot) { s.Delegations[0].DelegatorAddress = "invalid" }, true},
+		{"delegation to unknown validator", func(s *VotingPowerSnapshot) { s.Delegations[0].ValidatorAddress = otherValAddr.String() }, true},
+		{"negative delegation shares", func(s *VotingPowerSnapshot) { s.Delegations[0].Shares = sdk.NewDec(-1) }, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			snapshot := validSnapshot()
+			tc.malleate(&snapshot)
+
+			err := snapshot.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxProposalTemplateNameLength defines the maximum length of a proposal
+// template name.
+const MaxProposalTemplateNameLength = 64
+
+var (
+	// proposalTemplateNameRegex defines the allowed format of a template name.
+	proposalTemplateNameRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`)
+
+	// placeholderRegex matches {{name}} variables within a template's content.
+	placeholderRegex = regexp.MustCompile(`{{\s*([a-zA-Z0-9_]+)\s*}}`)
+)
+
+// NewProposalTemplate creates a new ProposalTemplate instance
+func NewProposalTemplate(name, description, content string) ProposalTemplate {
+	return ProposalTemplate{
+		Name:        name,
+		Description: description,
+		Content:     content,
+	}
+}
+
+// String implements stringer interface
+func (t ProposalTemplate) String() string {
+	out, _ := yaml.Marshal(t)
+	return string(out)
+}
+
+// Placeholders returns the sorted, de-duplicated names of all placeholders
+// used in the template's content.
+func (t ProposalTemplate) Placeholders() []string {
+	seen := make(map[string]struct{})
+	names := []string{}
+
+	for _, match := range placeholderRegex.FindAllStringSubmatch(t.Content, -1) {
+		if _, ok := seen[match[1]]; ok {
+			continue
+		}
+
+		seen[match[1]] = struct{}{}
+		names = append(names, match[1])
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// Render substitutes all placeholders of the template with the provided
+// values and returns the resulting JSON encoded proposal content. Values are
+// escaped as JSON string contents, hence placeholders may only be used within
+// JSON strings. An error is returned if a placeholder has no value or if a
+// value is provided for an unknown placeholder.
+func (t ProposalTemplate) Render(vars map[string]string) ([]byte, error) {
+	placeholders := t.Placeholders()
+	known := make(map[string]struct{}, len(placeholders))
+
+	for _, name := range placeholders {
+		known[name] = struct{}{}
+		if _, ok := vars[name]; !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalidProposalTemplate, "missing value for placeholder %s", name)
+		}
+	}
+
+	for name := range vars {
+		if _, ok := known[name]; !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalidProposalTemplate, "unknown placeholder %s", name)
+		}
+	}
+
+	rendered := placeholderRegex.ReplaceAllStringFunc(t.Content, func(match string) string {
+		name := placeholderRegex.FindStringSubmatch(match)[1]
+
+		bz, _ := json.Marshal(vars[name])
+		return string(bz[1 : len(bz)-1])
+	})
+
+	if !json.Valid([]byte(rendered)) {
+		return nil, sdkerrors.Wrap(ErrInvalidProposalTemplate, "rendered content is not valid JSON")
+	}
+
+	return []byte(rendered), nil
+}
+
+// Validate performs a stateless validation of the template.
+func (t ProposalTemplate) Validate() error {
+	if len(t.Name) > MaxProposalTemplateNameLength {
+		return fmt.Errorf("proposal template name is longer than max length of %d", MaxProposalTemplateNameLength)
+	}
+	if !proposalTemplateNameRegex.MatchString(t.Name) {
+		return fmt.Errorf("invalid proposal template name: %q", t.Name)
+	}
+	if len(t.Description) > MaxDescriptionLength {
+		return fmt.Errorf("proposal template description is longer than max length of %d", MaxDescriptionLength)
+	}
+	if strings.TrimSpace(t.Content) == "" {
+		return fmt.Errorf("proposal template %s content cannot be blank", t.Name)
+	}
+
+	// rendering with empty values ensures that the skeleton is valid JSON and
+	// that placeholders only appear within JSON strings
+	vars := make(map[string]string)
+	for _, name := range t.Placeholders() {
+		vars[name] = ""
+	}
+
+	if _, err := t.Render(vars); err != nil {
+		return fmt.Errorf("invalid proposal template %s: %w", t.Name, err)
+	}
+
+	return nil
+}
+
+// ValidateProposalTemplates validates each template and ensures that template
+// names are unique.
+func ValidateProposalTemplates(templates []ProposalTemplate) error {
+	names := make(map[string]struct{}, len(templates))
+
+	for _, t := range templates {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := names[t.Name]; ok {
+			return fmt.Errorf("duplicate proposal template name: %s", t.Name)
+		}
+
+		names[t.Name] = struct{}{}
+	}
+
+	return nil
+}
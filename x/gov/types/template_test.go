@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testTemplateContent = `{
+  "@type": "/cosmos.distribution.v1beta1.CommunityPoolSpendProposal",
+  "title": "{{title}}",
+  "description": "{{ description }}",
+  "recipient": "{{recipient}}",
+  "amount": [{"denom": "stake", "amount": "{{amount}}"}]
+}`
+
+func TestProposalTemplatePlaceholders(t *testing.T) {
+	tmpl := NewProposalTemplate("spend", "community spend", testTemplateContent+`{{title}}`)
+	require.Equal(t, []string{"amount", "description", "recipient", "title"}, tmpl.Placeholders())
+}
+
+func TestProposalTemplateRender(t *testing.T) {
+	tmpl := NewProposalTemplate("spend", "community spend", testTemplateContent)
+	vars := map[string]string{
+		"title":       `Fund "the" explorer`,
+		"description": "line\nbreak",
+		"recipient":   "cosmos1xyz",
+		"amount":      "100",
+	}
+
+	bz, err := tmpl.Render(vars)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"title": "Fund \"the\" explorer"`)
+	require.Contains(t, string(bz), `"description": "line\nbreak"`)
+	require.Contains(t, string(bz), `"amount": "100"`)
+
+	delete(vars, "amount")
+	_, err = tmpl.Render(vars)
+	require.ErrorIs(t, err, ErrInvalidProposalTemplate)
+
+	vars["amount"] = "100"
+	vars["unknown"] = "value"
+	_, err = tmpl.Render(vars)
+	require.ErrorIs(t, err, ErrInvalidProposalTemplate)
+}
+
+func TestValidateProposalTemplates(t *testing.T) {
+	valid := NewProposalTemplate("community-spend", "community spend", testTemplateContent)
+
+	testCases := []struct {
+		name      string
+		templates []ProposalTemplate
+		expErr    bool
+	}{
+		{"empty", nil, false},
+		{"valid", []ProposalTemplate{valid}, false},
+		{"duplicate name", []ProposalTemplate{valid, valid}, true},
+		{"empty name", []ProposalTemplate{NewProposalTemplate("", "", testTemplateContent)}, true},
+		{"invalid name", []ProposalTemplate{NewProposalTemplate("1spend", "", testTemplateContent)}, true},
+		{"blank content", []ProposalTemplate{NewProposalTemplate("spend", "", " ")}, true},
+		{"invalid json", []ProposalTemplate{NewProposalTemplate("spend", "", `{"title":`)}, true},
+		{"placeholder outside string", []ProposalTemplate{NewProposalTemplate("spend", "", `{"count": {{count}}}`)}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateProposalTemplates(tc.templates)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
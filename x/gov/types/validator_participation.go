@@ -0,0 +1,26 @@
+package types
+
+import (
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewValidatorParticipation creates a new ValidatorParticipation instance
+//nolint:interfacer
+func NewValidatorParticipation(
+	proposalID uint64, validator sdk.ValAddress, voted bool, bondedTokens sdk.Int, votingPower sdk.Dec,
+) ValidatorParticipation {
+	return ValidatorParticipation{
+		ProposalId:       proposalID,
+		ValidatorAddress: validator.String(),
+		Voted:            voted,
+		BondedTokens:     bondedTokens,
+		VotingPower:      votingPower,
+	}
+}
+
+func (p ValidatorParticipation) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
@@ -8,8 +8,13 @@ import (
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// MaxVoteMetadataLength is the maximum length of the rationale metadata a
+// voter may attach to its vote.
+const MaxVoteMetadataLength = 256
+
 // NewVote creates a new Vote instance
 //nolint:interfacer
 func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
@@ -21,6 +26,16 @@ func (v Vote) String() string {
 	return string(out)
 }
 
+// ValidateVoteMetadata checks that the rationale metadata of a vote isn't
+// longer than MaxVoteMetadataLength. Empty metadata is valid.
+func ValidateVoteMetadata(metadata string) error {
+	if len(metadata) > MaxVoteMetadataLength {
+		return fmt.Errorf("vote metadata is longer than max length of %d", MaxVoteMetadataLength)
+	}
+
+	return nil
+}
+
 // Votes is a collection of Vote objects
 type Votes []Vote
 
@@ -84,6 +99,37 @@ func ValidWeightedVoteOption(option WeightedVoteOption) bool {
 	return ValidVoteOption(option.Option)
 }
 
+// ValidateWeightedVoteOptions checks that the options of a weighted vote are
+// valid, distinct and weigh 1.00 in total.
+func ValidateWeightedVoteOptions(options WeightedVoteOptions) error {
+	if len(options) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, options.String())
+	}
+
+	totalWeight := sdk.NewDec(0)
+	usedOptions := make(map[VoteOption]bool)
+	for _, option := range options {
+		if !ValidWeightedVoteOption(option) {
+			return sdkerrors.Wrap(ErrInvalidVote, option.String())
+		}
+		totalWeight = totalWeight.Add(option.Weight)
+		if usedOptions[option.Option] {
+			return sdkerrors.Wrap(ErrInvalidVote, "Duplicated vote option")
+		}
+		usedOptions[option.Option] = true
+	}
+
+	if totalWeight.GT(sdk.NewDec(1)) {
+		return sdkerrors.Wrap(ErrInvalidVote, "Total weight overflow 1.00")
+	}
+
+	if totalWeight.LT(sdk.NewDec(1)) {
+		return sdkerrors.Wrap(ErrInvalidVote, "Total weight lower than 1.00")
+	}
+
+	return nil
+}
+
 // VoteOptionFromString returns a VoteOption from a string. It returns an error
 // if the string is invalid.
 func VoteOptionFromString(str string) (VoteOption, error) {
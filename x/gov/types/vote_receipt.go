@@ -0,0 +1,25 @@
+package types
+
+import (
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewVoteReceipt creates a new VoteReceipt instance
+//nolint:interfacer
+func NewVoteReceipt(proposalID uint64, voter sdk.AccAddress, height int64) VoteReceipt {
+	return VoteReceipt{ProposalId: proposalID, Voter: voter.String(), Height: height}
+}
+
+func (r VoteReceipt) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
+
+// VoteReceiptIssuer issues the participation records of the voters, such as
+// NFTs minted to them, when vote receipts are enabled. It is called once per
+// voter per proposal, at the first vote of the voter.
+type VoteReceiptIssuer interface {
+	IssueVoteReceipt(ctx sdk.Context, receipt VoteReceipt) error
+}
@@ -24,6 +24,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryInflation(),
 		GetCmdQueryAnnualProvisions(),
+		GetCmdQueryProvisionHistory(),
 	)
 
 	return mintingQueryCmd
@@ -118,3 +119,37 @@ func GetCmdQueryAnnualProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryProvisionHistory implements a command to return the amounts
+// minted over the recorded epochs of the provision history.
+func GetCmdQueryProvisionHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provision-history",
+		Short: "Query the amounts minted over the recorded epochs of the provision history",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProvisionHistory(cmd.Context(), &types.QueryProvisionHistoryRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "provision history")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
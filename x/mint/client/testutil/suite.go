@@ -67,7 +67,7 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520"}`,
+			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520","provision_epoch_length":"17280","provision_history_length":"365"}`,
 		},
 		{
 			"text output",
@@ -77,7 +77,9 @@ goal_bonded: "0.670000000000000000"
 inflation_max: "1.000000000000000000"
 inflation_min: "1.000000000000000000"
 inflation_rate_change: "0.130000000000000000"
-mint_denom: stake`,
+mint_denom: stake
+provision_epoch_length: "17280"
+provision_history_length: "365"`,
 		},
 	}
 
@@ -162,3 +164,23 @@ func (s *IntegrationTestSuite) TestGetCmdQueryAnnualProvisions() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestGetCmdQueryProvisionHistory() {
+	val := s.network.Validators[0]
+
+	cmd := cli.GetCmdQueryProvisionHistory()
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, []string{
+		fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+
+	var res minttypes.QueryProvisionHistoryResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+
+	// the first block starts the first epoch of the history
+	s.Require().Len(res.Records, 1)
+	s.Require().Equal(int64(1), res.Records[0].StartHeight)
+	s.Require().Equal(int64(1), res.Records[0].EndHeight)
+	s.Require().Equal(s.cfg.BondDenom, res.Records[0].Amount.Denom)
+	s.Require().True(res.Records[0].Amount.IsPositive())
+}
@@ -10,6 +10,9 @@ import (
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, ak types.AccountKeeper, data *types.GenesisState) {
 	keeper.SetMinter(ctx, data.Minter)
 	keeper.SetParams(ctx, data.Params)
+	for _, record := range data.ProvisionHistory {
+		keeper.SetProvisionRecord(ctx, record)
+	}
 	ak.GetModuleAccount(ctx, types.ModuleName)
 }
 
@@ -17,5 +20,7 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, ak types.AccountKeeper,
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	minter := keeper.GetMinter(ctx)
 	params := keeper.GetParams(ctx)
-	return types.NewGenesisState(minter, params)
+	genesis := types.NewGenesisState(minter, params)
+	genesis.ProvisionHistory = keeper.GetProvisionHistory(ctx)
+	return genesis
 }
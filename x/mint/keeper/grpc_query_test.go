@@ -3,6 +3,7 @@ package keeper_test
 import (
 	gocontext "context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -51,6 +53,26 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(annualProvisions.AnnualProvisions, app.MintKeeper.GetMinter(ctx).AnnualProvisions)
 }
 
+func (suite *MintTestSuite) TestGRPCProvisionHistory() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	for height := int64(1); height <= 3; height++ {
+		app.MintKeeper.SetProvisionRecord(ctx, types.NewProvisionRecord(height, time.Unix(height, 0).UTC(), sdk.DefaultBondDenom))
+	}
+
+	res, err := queryClient.ProvisionHistory(gocontext.Background(), &types.QueryProvisionHistoryRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(app.MintKeeper.GetProvisionHistory(ctx), res.Records)
+	suite.Require().Len(res.Records, 3)
+
+	res, err = queryClient.ProvisionHistory(gocontext.Background(), &types.QueryProvisionHistoryRequest{
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Records, 1)
+	suite.Require().Equal(int64(3), res.Records[0].StartHeight)
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
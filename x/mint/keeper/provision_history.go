@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// RecordProvision adds the amount minted in the current block to the record
+// of the current epoch of the provision history. A new record is started once
+// the current one spans ProvisionEpochLength blocks, or when the mint denom
+// changes, and the oldest records beyond ProvisionHistoryLength are pruned.
+// Nothing is recorded if ProvisionEpochLength is zero.
+func (k Keeper) RecordProvision(ctx sdk.Context, minted sdk.Coin) {
+	params := k.GetParams(ctx)
+	if params.ProvisionEpochLength == 0 {
+		return
+	}
+
+	record, found := k.GetLatestProvisionRecord(ctx)
+	if !found || ctx.BlockHeight()-record.StartHeight >= int64(params.ProvisionEpochLength) || record.Amount.Denom != minted.Denom {
+		record = types.NewProvisionRecord(ctx.BlockHeight(), ctx.BlockTime(), minted.Denom)
+		k.SetProvisionRecord(ctx, record)
+		k.PruneProvisionHistory(ctx, params.ProvisionHistoryLength)
+	}
+
+	record.EndHeight = ctx.BlockHeight()
+	record.EndTime = ctx.BlockTime()
+	record.Amount = record.Amount.Add(minted)
+	k.SetProvisionRecord(ctx, record)
+}
+
+// PruneProvisionHistory deletes the records of the provision history but the
+// given number of most recent ones. Nothing is deleted if length is zero.
+func (k Keeper) PruneProvisionHistory(ctx sdk.Context, length uint64) {
+	if length == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.ProvisionHistoryKeyPrefix)
+	defer iterator.Close()
+
+	var (
+		kept  uint64
+		stale [][]byte
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		if kept < length {
+			kept++
+			continue
+		}
+		stale = append(stale, iterator.Key())
+	}
+
+	for _, key := range stale {
+		store.Delete(key)
+	}
+}
+
+// GetLatestProvisionRecord returns the record of the current epoch of the
+// provision history.
+func (k Keeper) GetLatestProvisionRecord(ctx sdk.Context) (record types.ProvisionRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.ProvisionHistoryKeyPrefix)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(iterator.Value(), &record)
+	return record, true
+}
+
+// SetProvisionRecord sets a record of the provision history.
+func (k Keeper) SetProvisionRecord(ctx sdk.Context, record types.ProvisionRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.ProvisionRecordKey(record.StartHeight), bz)
+}
+
+// IterateProvisionHistory iterates over the records of the provision history,
+// oldest first, and performs a callback function.
+func (k Keeper) IterateProvisionHistory(ctx sdk.Context, cb func(record types.ProvisionRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ProvisionHistoryKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ProvisionRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetProvisionHistory returns all the records of the provision history,
+// oldest first.
+func (k Keeper) GetProvisionHistory(ctx sdk.Context) (records []types.ProvisionRecord) {
+	k.IterateProvisionHistory(ctx, func(record types.ProvisionRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
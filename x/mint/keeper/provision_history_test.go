@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRecordProvision(t *testing.T) {
+	app, ctx := createTestApp(t, false)
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.ProvisionEpochLength = 3
+	params.ProvisionHistoryLength = 2
+	app.MintKeeper.SetParams(ctx, params)
+
+	startTime := time.Unix(1000, 0).UTC()
+	mint := func(height int64, amount int64) {
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(startTime.Add(time.Duration(height) * time.Second))
+		app.MintKeeper.RecordProvision(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	// the provisions of the blocks of an epoch are summed in a single record
+	mint(1, 10)
+	mint(2, 20)
+	mint(3, 30)
+	history := app.MintKeeper.GetProvisionHistory(ctx)
+	require.Len(t, history, 1)
+	require.Equal(t, int64(1), history[0].StartHeight)
+	require.Equal(t, int64(3), history[0].EndHeight)
+	require.Equal(t, startTime.Add(time.Second), history[0].StartTime)
+	require.Equal(t, startTime.Add(3*time.Second), history[0].EndTime)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 60), history[0].Amount)
+
+	// a new record is started every ProvisionEpochLength blocks, and the
+	// records beyond ProvisionHistoryLength are pruned
+	mint(4, 40)
+	mint(7, 70)
+	history = app.MintKeeper.GetProvisionHistory(ctx)
+	require.Len(t, history, 2)
+	require.Equal(t, int64(4), history[0].StartHeight)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 40), history[0].Amount)
+	require.Equal(t, int64(7), history[1].StartHeight)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 70), history[1].Amount)
+
+	// a change of mint denom starts a new record
+	ctx = ctx.WithBlockHeight(8)
+	app.MintKeeper.RecordProvision(ctx, sdk.NewInt64Coin("other", 5))
+	latest, found := app.MintKeeper.GetLatestProvisionRecord(ctx)
+	require.True(t, found)
+	require.Equal(t, int64(8), latest.StartHeight)
+	require.Equal(t, sdk.NewInt64Coin("other", 5), latest.Amount)
+
+	// nothing is recorded once the history is disabled
+	params.ProvisionEpochLength = 0
+	app.MintKeeper.SetParams(ctx, params)
+	mint(20, 200)
+	latest, found = app.MintKeeper.GetLatestProvisionRecord(ctx)
+	require.True(t, found)
+	require.Equal(t, int64(8), latest.StartHeight)
+}
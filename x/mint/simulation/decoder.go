@@ -19,6 +19,11 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &minterA)
 			cdc.MustUnmarshal(kvB.Value, &minterB)
 			return fmt.Sprintf("%v\n%v", minterA, minterB)
+		case bytes.HasPrefix(kvA.Key, types.ProvisionHistoryKeyPrefix):
+			var recordA, recordB types.ProvisionRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
 		default:
 			panic(fmt.Sprintf("invalid mint key %X", kvA.Key))
 		}
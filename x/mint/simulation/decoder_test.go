@@ -3,6 +3,7 @@ package simulation_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -18,10 +19,12 @@ func TestDecodeStore(t *testing.T) {
 	dec := simulation.NewDecodeStore(cdc)
 
 	minter := types.NewMinter(sdk.OneDec(), sdk.NewDec(15))
+	record := types.NewProvisionRecord(1, time.Unix(0, 0).UTC(), sdk.DefaultBondDenom)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.MinterKey, Value: cdc.MustMarshal(&minter)},
+			{Key: types.ProvisionRecordKey(1), Value: cdc.MustMarshal(&record)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -30,6 +33,7 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Minter", fmt.Sprintf("%v\n%v", minter, minter)},
+		{"ProvisionRecord", fmt.Sprintf("%v\n%v", record, record)},
 		{"other", ""},
 	}
 
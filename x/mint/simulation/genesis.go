@@ -9,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -19,6 +20,9 @@ const (
 	InflationMax        = "inflation_max"
 	InflationMin        = "inflation_min"
 	GoalBonded          = "goal_bonded"
+
+	ProvisionEpochLength   = "provision_epoch_length"
+	ProvisionHistoryLength = "provision_history_length"
 )
 
 // GenInflation randomized Inflation
@@ -46,6 +50,16 @@ func GenGoalBonded(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(67, 2)
 }
 
+// GenProvisionEpochLength randomized ProvisionEpochLength
+func GenProvisionEpochLength(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 0, 100))
+}
+
+// GenProvisionHistoryLength randomized ProvisionHistoryLength
+func GenProvisionHistoryLength(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 0, 50))
+}
+
 // RandomizedGenState generates a random GenesisState for mint
 func RandomizedGenState(simState *module.SimulationState) {
 	// minter
@@ -80,9 +94,23 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { goalBonded = GenGoalBonded(r) },
 	)
 
+	var provisionEpochLength uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ProvisionEpochLength, &provisionEpochLength, simState.Rand,
+		func(r *rand.Rand) { provisionEpochLength = GenProvisionEpochLength(r) },
+	)
+
+	var provisionHistoryLength uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ProvisionHistoryLength, &provisionHistoryLength, simState.Rand,
+		func(r *rand.Rand) { provisionHistoryLength = GenProvisionHistoryLength(r) },
+	)
+
 	mintDenom := sdk.DefaultBondDenom
 	blocksPerYear := uint64(60 * 60 * 8766 / 5)
 	params := types.NewParams(mintDenom, inflationRateChange, inflationMax, inflationMin, goalBonded, blocksPerYear)
+	params.ProvisionEpochLength = provisionEpochLength
+	params.ProvisionHistoryLength = provisionHistoryLength
 
 	mintGenesis := types.NewGenesisState(types.InitialMinter(inflation), params)
 
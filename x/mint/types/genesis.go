@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(minter Minter, params Params) *GenesisState {
 	return &GenesisState{
@@ -23,5 +25,16 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	var lastStartHeight int64
+	for _, record := range data.ProvisionHistory {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if record.StartHeight <= lastStartHeight {
+			return fmt.Errorf("provision history records must be sorted by start height without duplicates: %d", record.StartHeight)
+		}
+		lastStartHeight = record.StartHeight
+	}
+
 	return ValidateMinter(data.Minter)
 }
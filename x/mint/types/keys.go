@@ -1,7 +1,15 @@
 package types
 
-// MinterKey is the key to use for the keeper store.
-var MinterKey = []byte{0x00}
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+var (
+	// MinterKey is the key to use for the keeper store.
+	MinterKey = []byte{0x00}
+
+	// ProvisionHistoryKeyPrefix is the prefix of the records of the provision
+	// history, keyed by the start height of their epoch.
+	ProvisionHistoryKeyPrefix = []byte{0x01}
+)
 
 const (
 	// module name
@@ -18,3 +26,9 @@ const (
 	QueryInflation        = "inflation"
 	QueryAnnualProvisions = "annual_provisions"
 )
+
+// ProvisionRecordKey returns the key of the provision record of the epoch
+// starting at the given height.
+func ProvisionRecordKey(startHeight int64) []byte {
+	return append(ProvisionHistoryKeyPrefix, sdk.Uint64ToBigEndian(uint64(startHeight))...)
+}
@@ -6,17 +6,22 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -79,6 +84,12 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded" yaml:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty" yaml:"blocks_per_year"`
+	// number of blocks whose provisions are summed in each record of the
+	// provision history, or zero to disable the history
+	ProvisionEpochLength uint64 `protobuf:"varint,7,opt,name=provision_epoch_length,json=provisionEpochLength,proto3" json:"provision_epoch_length,omitempty" yaml:"provision_epoch_length"`
+	// number of most recent records kept in the provision history, or zero to
+	// keep all of them
+	ProvisionHistoryLength uint64 `protobuf:"varint,8,opt,name=provision_history_length,json=provisionHistoryLength,proto3" json:"provision_history_length,omitempty" yaml:"provision_history_length"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -127,43 +138,156 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetProvisionEpochLength() uint64 {
+	if m != nil {
+		return m.ProvisionEpochLength
+	}
+	return 0
+}
+
+func (m *Params) GetProvisionHistoryLength() uint64 {
+	if m != nil {
+		return m.ProvisionHistoryLength
+	}
+	return 0
+}
+
+// ProvisionRecord is the amount minted over an epoch of the provision history.
+type ProvisionRecord struct {
+	// height of the first block of the epoch
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// height of the last block of the epoch recorded so far
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty" yaml:"end_height"`
+	// time of the first block of the epoch
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// time of the last block of the epoch recorded so far
+	EndTime time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// amount minted over the epoch
+	Amount types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *ProvisionRecord) Reset()         { *m = ProvisionRecord{} }
+func (m *ProvisionRecord) String() string { return proto.CompactTextString(m) }
+func (*ProvisionRecord) ProtoMessage()    {}
+func (*ProvisionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *ProvisionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProvisionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProvisionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProvisionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvisionRecord.Merge(m, src)
+}
+func (m *ProvisionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProvisionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvisionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProvisionRecord proto.InternalMessageInfo
+
+func (m *ProvisionRecord) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ProvisionRecord) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *ProvisionRecord) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *ProvisionRecord) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *ProvisionRecord) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*ProvisionRecord)(nil), "cosmos.mint.v1beta1.ProvisionRecord")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 437 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc6, 0x63, 0x28, 0x95, 0x6a, 0x98, 0x00, 0x6f, 0xa0, 0x68, 0x82, 0x64, 0xca, 0x01, 0x8d,
-	0x03, 0x89, 0x26, 0x6e, 0x3b, 0x66, 0x15, 0x07, 0xc4, 0x50, 0xe5, 0x1b, 0x5c, 0xa2, 0x7f, 0x52,
-	0x93, 0x59, 0x8d, 0xed, 0xca, 0xf6, 0x46, 0x7b, 0xe5, 0x09, 0x38, 0x72, 0xe4, 0x2d, 0x78, 0x85,
-	0xdd, 0xd8, 0x11, 0x71, 0xa8, 0x50, 0xfb, 0x06, 0x7b, 0x02, 0x14, 0xbb, 0x6a, 0xa1, 0x20, 0xa4,
-	0x4a, 0x9c, 0x92, 0xef, 0xfb, 0xff, 0xf3, 0xfd, 0xbe, 0x58, 0x32, 0x8e, 0x2a, 0x65, 0x84, 0x32,
-	0x99, 0xe0, 0xd2, 0x66, 0x17, 0x47, 0x25, 0xb3, 0x70, 0xe4, 0x44, 0x3a, 0xd6, 0xca, 0x2a, 0xb2,
-	0xeb, 0xe7, 0xa9, 0xb3, 0x96, 0xf3, 0xfd, 0xbd, 0x5a, 0xd5, 0xca, 0xcd, 0xb3, 0xf6, 0xcd, 0xaf,
-	0x26, 0x5f, 0x11, 0xee, 0x9e, 0x72, 0x69, 0x99, 0x26, 0xaf, 0x70, 0x8f, 0xcb, 0x77, 0x0d, 0x58,
-	0xae, 0x64, 0x88, 0x0e, 0xd0, 0x61, 0x2f, 0x4f, 0x2f, 0x67, 0x71, 0xf0, 0x7d, 0x16, 0x3f, 0xa9,
-	0xb9, 0x3d, 0x3b, 0x2f, 0xd3, 0x4a, 0x89, 0x6c, 0xc9, 0xf6, 0x8f, 0x67, 0x66, 0x38, 0xca, 0xec,
-	0x74, 0xcc, 0x4c, 0xda, 0x67, 0x15, 0x5d, 0x07, 0x90, 0xf7, 0xf8, 0x3e, 0x48, 0x79, 0x0e, 0x4d,
-	0x31, 0xd6, 0xea, 0x82, 0x1b, 0xae, 0xa4, 0x09, 0x6f, 0xb8, 0xd4, 0x97, 0xdb, 0xa5, 0x5e, 0xcf,
-	0xe2, 0x70, 0x0a, 0xa2, 0x39, 0x4e, 0xfe, 0x08, 0x4c, 0xe8, 0x3d, 0xef, 0x0d, 0xd6, 0xd6, 0x97,
-	0x0e, 0xee, 0x0e, 0x40, 0x83, 0x30, 0xe4, 0x31, 0xc6, 0xed, 0x11, 0x14, 0x43, 0x26, 0x95, 0xf0,
-	0xbf, 0x44, 0x7b, 0xad, 0xd3, 0x6f, 0x0d, 0xf2, 0x01, 0xe1, 0x07, 0xab, 0xc2, 0x85, 0x06, 0xcb,
-	0x8a, 0xea, 0x0c, 0x64, 0xcd, 0x96, 0x3d, 0x5f, 0x6f, 0xdd, 0xf3, 0x91, 0xef, 0xf9, 0xd7, 0xd0,
-	0x84, 0xee, 0xae, 0x7c, 0x0a, 0x96, 0x9d, 0x38, 0x97, 0x8c, 0xf0, 0xce, 0x7a, 0x5d, 0xc0, 0x24,
-	0xbc, 0xe9, 0xd8, 0x2f, 0xb6, 0x66, 0xef, 0x6d, 0xb2, 0x05, 0x4c, 0x12, 0x7a, 0x67, 0xa5, 0x4f,
-	0x61, 0xb2, 0x01, 0xe3, 0x32, 0xec, 0xfc, 0x37, 0x18, 0x97, 0xbf, 0xc1, 0xb8, 0x24, 0x0c, 0xdf,
-	0xae, 0x15, 0x34, 0x45, 0xa9, 0xe4, 0x90, 0x0d, 0xc3, 0x5b, 0x0e, 0xd5, 0xdf, 0x1a, 0x45, 0x3c,
-	0xea, 0x97, 0xa8, 0x84, 0xe2, 0x56, 0xe5, 0x4e, 0x90, 0x1c, 0xdf, 0x2d, 0x1b, 0x55, 0x8d, 0x4c,
-	0x31, 0x66, 0xba, 0x98, 0x32, 0xd0, 0x61, 0xf7, 0x00, 0x1d, 0x76, 0xf2, 0xfd, 0xeb, 0x59, 0xfc,
-	0xd0, 0x7f, 0xbc, 0xb1, 0x90, 0xd0, 0x1d, 0xef, 0x0c, 0x98, 0x7e, 0xc3, 0x40, 0x1f, 0x77, 0x3e,
-	0x7d, 0x8e, 0x83, 0xfc, 0xe4, 0x72, 0x1e, 0xa1, 0xab, 0x79, 0x84, 0x7e, 0xcc, 0x23, 0xf4, 0x71,
-	0x11, 0x05, 0x57, 0x8b, 0x28, 0xf8, 0xb6, 0x88, 0x82, 0xb7, 0x4f, 0xff, 0xd9, 0x76, 0xe2, 0x2f,
-	0xa2, 0x2b, 0x5d, 0x76, 0xdd, 0xbd, 0x7a, 0xfe, 0x33, 0x00, 0x00, 0xff, 0xff, 0x34, 0x52, 0xcf,
-	0x87, 0xa4, 0x03, 0x00, 0x00,
+	// 700 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc7, 0x63, 0x08, 0x81, 0x4c, 0x40, 0x5c, 0x86, 0x8f, 0xeb, 0x9b, 0x7b, 0x89, 0xb9, 0xae,
+	0x54, 0xd1, 0x45, 0x1d, 0x41, 0x2b, 0x55, 0x62, 0x69, 0x68, 0x85, 0x2a, 0xa8, 0xd0, 0xa8, 0x52,
+	0x3f, 0xa4, 0xca, 0x9a, 0xd8, 0x83, 0x3d, 0xc2, 0x9e, 0x89, 0xec, 0x09, 0x4d, 0xb6, 0x7d, 0x02,
+	0x96, 0x5d, 0xf6, 0x71, 0xd8, 0x95, 0x65, 0xd5, 0x85, 0x5b, 0xc1, 0xae, 0x9b, 0x4a, 0x79, 0x82,
+	0xca, 0x33, 0x8e, 0x0d, 0x29, 0x6a, 0x15, 0xa9, 0xab, 0xe4, 0xfc, 0xcf, 0x39, 0xbf, 0xff, 0xb1,
+	0x75, 0x7c, 0x40, 0xcb, 0xe5, 0x49, 0xc4, 0x93, 0x76, 0x44, 0x99, 0x68, 0x9f, 0x6e, 0x75, 0x88,
+	0xc0, 0x5b, 0x32, 0xb0, 0xba, 0x31, 0x17, 0x1c, 0x2e, 0xab, 0xbc, 0x25, 0xa5, 0x3c, 0xdf, 0x5c,
+	0xf1, 0xb9, 0xcf, 0x65, 0xbe, 0x9d, 0xfd, 0x53, 0xa5, 0x4d, 0xc3, 0xe7, 0xdc, 0x0f, 0x49, 0x5b,
+	0x46, 0x9d, 0xde, 0x71, 0x5b, 0xd0, 0x88, 0x24, 0x02, 0x47, 0xdd, 0xbc, 0x60, 0xe4, 0xd5, 0xc1,
+	0x09, 0x29, 0xbc, 0x5c, 0x4e, 0x99, 0xca, 0x9b, 0x1f, 0x35, 0x50, 0x3b, 0xa4, 0x4c, 0x90, 0x18,
+	0x1e, 0x80, 0x3a, 0x65, 0xc7, 0x21, 0x16, 0x94, 0x33, 0x5d, 0xdb, 0xd0, 0x36, 0xeb, 0xb6, 0x75,
+	0x9e, 0x1a, 0x95, 0xcf, 0xa9, 0x71, 0xd7, 0xa7, 0x22, 0xe8, 0x75, 0x2c, 0x97, 0x47, 0xed, 0x1c,
+	0xa8, 0x7e, 0xee, 0x27, 0xde, 0x49, 0x5b, 0x0c, 0xba, 0x24, 0xb1, 0xf6, 0x88, 0x8b, 0x4a, 0x00,
+	0x7c, 0x0b, 0x96, 0x30, 0x63, 0x3d, 0x1c, 0x3a, 0xdd, 0x98, 0x9f, 0xd2, 0x84, 0x72, 0x96, 0xe8,
+	0x53, 0x92, 0xfa, 0x74, 0x32, 0xea, 0x30, 0x35, 0xf4, 0x01, 0x8e, 0xc2, 0x1d, 0xf3, 0x27, 0xa0,
+	0x89, 0xfe, 0x52, 0xda, 0x51, 0x29, 0x7d, 0x9f, 0x01, 0xb5, 0x23, 0x1c, 0xe3, 0x28, 0x81, 0xeb,
+	0x00, 0x64, 0xef, 0xd0, 0xf1, 0x08, 0xe3, 0x91, 0x7a, 0x24, 0x54, 0xcf, 0x94, 0xbd, 0x4c, 0x80,
+	0xef, 0x34, 0xb0, 0x5a, 0x0c, 0xec, 0xc4, 0x58, 0x10, 0xc7, 0x0d, 0x30, 0xf3, 0x49, 0x3e, 0xe7,
+	0xb3, 0x89, 0xe7, 0xfc, 0x4f, 0xcd, 0x79, 0x2b, 0xd4, 0x44, 0xcb, 0x85, 0x8e, 0xb0, 0x20, 0xbb,
+	0x52, 0x85, 0x27, 0x60, 0xa1, 0x2c, 0x8f, 0x70, 0x5f, 0x9f, 0x96, 0xde, 0x4f, 0x26, 0xf6, 0x5e,
+	0x19, 0xf7, 0x8e, 0x70, 0xdf, 0x44, 0xf3, 0x45, 0x7c, 0x88, 0xfb, 0x63, 0x66, 0x94, 0xe9, 0xd5,
+	0x3f, 0x66, 0x46, 0xd9, 0x0d, 0x33, 0xca, 0x20, 0x01, 0x0d, 0x9f, 0xe3, 0xd0, 0xe9, 0x70, 0xe6,
+	0x11, 0x4f, 0x9f, 0x91, 0x56, 0x7b, 0x13, 0x5b, 0x41, 0x65, 0x75, 0x0d, 0x65, 0x22, 0x90, 0x45,
+	0xb6, 0x0c, 0xa0, 0x0d, 0x16, 0x3b, 0x21, 0x77, 0x4f, 0x12, 0xa7, 0x4b, 0x62, 0x67, 0x40, 0x70,
+	0xac, 0xd7, 0x36, 0xb4, 0xcd, 0xaa, 0xdd, 0x1c, 0xa6, 0xc6, 0x9a, 0x6a, 0x1e, 0x2b, 0x30, 0xd1,
+	0x82, 0x52, 0x8e, 0x48, 0xfc, 0x8a, 0xe0, 0x18, 0xbe, 0x00, 0x6b, 0xc5, 0x52, 0x39, 0xa4, 0xcb,
+	0xdd, 0xc0, 0x09, 0x09, 0xf3, 0x45, 0xa0, 0xcf, 0x4a, 0xd4, 0xff, 0xc3, 0xd4, 0x58, 0x57, 0xa8,
+	0xdb, 0xeb, 0x4c, 0xb4, 0x52, 0x24, 0x1e, 0x67, 0xfa, 0x81, 0x94, 0xe1, 0x1b, 0xa0, 0x97, 0x0d,
+	0x01, 0x4d, 0x04, 0x8f, 0x07, 0x23, 0xf4, 0x9c, 0x44, 0xdf, 0x19, 0xa6, 0x86, 0x31, 0x8e, 0xbe,
+	0x59, 0x69, 0xa2, 0x72, 0xba, 0x7d, 0x95, 0x51, 0xf8, 0x9d, 0xea, 0xfb, 0x0f, 0x46, 0xc5, 0xfc,
+	0x36, 0x05, 0x16, 0x8b, 0x0f, 0x00, 0x11, 0x97, 0xc7, 0x1e, 0xdc, 0x01, 0xf3, 0x89, 0xc0, 0xb1,
+	0x70, 0x02, 0x42, 0xfd, 0x40, 0xc8, 0xe5, 0x9f, 0xb6, 0xff, 0x1e, 0xa6, 0xc6, 0xb2, 0x32, 0xbb,
+	0x9e, 0x35, 0x51, 0x43, 0x86, 0xfb, 0x32, 0x82, 0x0f, 0x01, 0x20, 0xcc, 0x1b, 0x75, 0x4e, 0xc9,
+	0xce, 0xd5, 0x61, 0x6a, 0x2c, 0xa9, 0xce, 0x32, 0x67, 0xa2, 0x3a, 0x61, 0x5e, 0xde, 0xf5, 0x12,
+	0x00, 0xc5, 0xcc, 0x4e, 0x90, 0xdc, 0xe2, 0xc6, 0x76, 0xd3, 0x52, 0xf7, 0xc9, 0x1a, 0xdd, 0x27,
+	0xeb, 0xf9, 0xe8, 0x3e, 0xd9, 0xeb, 0xd9, 0x26, 0x94, 0xd4, 0xb2, 0xd7, 0x3c, 0xfb, 0x62, 0x68,
+	0xa8, 0x2e, 0x85, 0xac, 0x1c, 0x22, 0x30, 0x97, 0x79, 0x4a, 0x6e, 0xf5, 0xb7, 0xdc, 0x7f, 0x73,
+	0xee, 0x62, 0x39, 0x6d, 0x49, 0x9d, 0x25, 0xcc, 0x93, 0xcc, 0x47, 0xa0, 0x86, 0x23, 0xde, 0x63,
+	0x42, 0xee, 0x65, 0x63, 0xfb, 0x1f, 0x2b, 0x3f, 0xba, 0xd9, 0xa1, 0x1c, 0x1d, 0x5d, 0x6b, 0x97,
+	0x53, 0x66, 0x57, 0x33, 0x20, 0xca, 0xcb, 0xed, 0xdd, 0xf3, 0xcb, 0x96, 0x76, 0x71, 0xd9, 0xd2,
+	0xbe, 0x5e, 0xb6, 0xb4, 0xb3, 0xab, 0x56, 0xe5, 0xe2, 0xaa, 0x55, 0xf9, 0x74, 0xd5, 0xaa, 0xbc,
+	0xbe, 0xf7, 0xcb, 0x95, 0xee, 0xab, 0x73, 0x2f, 0x37, 0xbb, 0x53, 0x93, 0x73, 0x3f, 0xf8, 0x11,
+	0x00, 0x00, 0xff, 0xff, 0xbd, 0x7e, 0x28, 0xaf, 0x0a, 0x06, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -229,6 +353,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProvisionHistoryLength != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ProvisionHistoryLength))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ProvisionEpochLength != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ProvisionEpochLength))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -284,6 +418,65 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProvisionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProvisionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProvisionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMint(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintMint(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if m.EndHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -329,6 +522,33 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	if m.ProvisionEpochLength != 0 {
+		n += 1 + sovMint(uint64(m.ProvisionEpochLength))
+	}
+	if m.ProvisionHistoryLength != 0 {
+		n += 1 + sovMint(uint64(m.ProvisionHistoryLength))
+	}
+	return n
+}
+
+func (m *ProvisionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovMint(uint64(m.EndHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovMint(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -672,6 +892,231 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionEpochLength", wireType)
+			}
+			m.ProvisionEpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProvisionEpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionHistoryLength", wireType)
+			}
+			m.ProvisionHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProvisionHistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProvisionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProvisionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProvisionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -19,6 +19,9 @@ var (
 	KeyInflationMin        = []byte("InflationMin")
 	KeyGoalBonded          = []byte("GoalBonded")
 	KeyBlocksPerYear       = []byte("BlocksPerYear")
+
+	KeyProvisionEpochLength   = []byte("ProvisionEpochLength")
+	KeyProvisionHistoryLength = []byte("ProvisionHistoryLength")
 )
 
 // ParamTable for minting module.
@@ -49,6 +52,9 @@ func DefaultParams() Params {
 		InflationMin:        sdk.NewDecWithPrec(7, 2),
 		GoalBonded:          sdk.NewDecWithPrec(67, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+
+		ProvisionEpochLength:   uint64(60 * 60 * 24 / 5), // a day, assuming 5 second block times
+		ProvisionHistoryLength: 365,
 	}
 }
 
@@ -72,6 +78,12 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateProvisionEpochLength(p.ProvisionEpochLength); err != nil {
+		return err
+	}
+	if err := validateProvisionHistoryLength(p.ProvisionHistoryLength); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -98,6 +110,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflationMin),
 		paramtypes.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
 		paramtypes.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		paramtypes.NewParamSetPair(KeyProvisionEpochLength, &p.ProvisionEpochLength, validateProvisionEpochLength),
+		paramtypes.NewParamSetPair(KeyProvisionHistoryLength, &p.ProvisionHistoryLength, validateProvisionHistoryLength),
 	}
 }
 
@@ -193,3 +207,21 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateProvisionEpochLength(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateProvisionHistoryLength(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewProvisionRecord returns a new record of the provision history for the
+// epoch starting at the given block, with nothing minted yet.
+func NewProvisionRecord(startHeight int64, startTime time.Time, denom string) ProvisionRecord {
+	return ProvisionRecord{
+		StartHeight: startHeight,
+		EndHeight:   startHeight,
+		StartTime:   startTime,
+		EndTime:     startTime,
+		Amount:      sdk.NewCoin(denom, sdk.ZeroInt()),
+	}
+}
+
+// Validate checks that the heights and times of a provision record are
+// ordered and that its amount is valid.
+func (r ProvisionRecord) Validate() error {
+	if r.StartHeight <= 0 {
+		return fmt.Errorf("provision record start height must be positive: %d", r.StartHeight)
+	}
+	if r.EndHeight < r.StartHeight {
+		return fmt.Errorf("provision record end height %d is before its start height %d", r.EndHeight, r.StartHeight)
+	}
+	if r.EndTime.Before(r.StartTime) {
+		return fmt.Errorf("provision record end time %s is before its start time %s", r.EndTime, r.StartTime)
+	}
+	if err := r.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid provision record amount: %w", err)
+	}
+
+	return nil
+}
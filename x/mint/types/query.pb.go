@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -268,6 +269,109 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
+// QueryProvisionHistoryRequest is the request type for the
+// Query/ProvisionHistory RPC method.
+type QueryProvisionHistoryRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProvisionHistoryRequest) Reset()         { *m = QueryProvisionHistoryRequest{} }
+func (m *QueryProvisionHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProvisionHistoryRequest) ProtoMessage()    {}
+func (*QueryProvisionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *QueryProvisionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProvisionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProvisionHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProvisionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProvisionHistoryRequest.Merge(m, src)
+}
+func (m *QueryProvisionHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProvisionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProvisionHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProvisionHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryProvisionHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProvisionHistoryResponse is the response type for the
+// Query/ProvisionHistory RPC method.
+type QueryProvisionHistoryResponse struct {
+	// records are the records of the provision history, oldest first.
+	Records []ProvisionRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProvisionHistoryResponse) Reset()         { *m = QueryProvisionHistoryResponse{} }
+func (m *QueryProvisionHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProvisionHistoryResponse) ProtoMessage()    {}
+func (*QueryProvisionHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{7}
+}
+func (m *QueryProvisionHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProvisionHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProvisionHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProvisionHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProvisionHistoryResponse.Merge(m, src)
+}
+func (m *QueryProvisionHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProvisionHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProvisionHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProvisionHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryProvisionHistoryResponse) GetRecords() []ProvisionRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryProvisionHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
@@ -275,40 +379,50 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryProvisionHistoryRequest)(nil), "cosmos.mint.v1beta1.QueryProvisionHistoryRequest")
+	proto.RegisterType((*QueryProvisionHistoryResponse)(nil), "cosmos.mint.v1beta1.QueryProvisionHistoryResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83, 0x58,
-	0x10, 0xa5, 0x52, 0x32, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0x89,
-	0x79, 0x79, 0xf9, 0x25, 0x89, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x50, 0x59, 0x39, 0x6c, 0x36, 0x81,
-	0x4d, 0x05, 0xcb, 0x2b, 0x89, 0x70, 0x09, 0x05, 0x82, 0xec, 0x0d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d,
-	0x0e, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x51, 0x0a, 0xe0, 0x12, 0x46, 0x11, 0x2d, 0x2e, 0xc8,
-	0xcf, 0x2b, 0x4e, 0x15, 0xb2, 0xe4, 0x62, 0x2b, 0x00, 0x8b, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70,
-	0x1b, 0x49, 0xeb, 0x61, 0x71, 0xa6, 0x1e, 0x44, 0x93, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41,
-	0x50, 0x0d, 0x4a, 0xe2, 0x5c, 0xa2, 0x60, 0x13, 0x3d, 0xf3, 0xd2, 0x72, 0xc0, 0x0e, 0x84, 0x59,
-	0x95, 0xc6, 0x25, 0x86, 0x2e, 0x01, 0xb5, 0xcd, 0x87, 0x8b, 0x33, 0x13, 0x26, 0x08, 0xb6, 0x90,
-	0xc7, 0x49, 0x0f, 0x64, 0xe6, 0xad, 0x7b, 0xf2, 0x6a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x0f, 0x42, 0x28, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x92, 0xca, 0x82,
-	0xd4, 0x62, 0x3d, 0x97, 0xd4, 0xe4, 0x20, 0x84, 0x01, 0x4a, 0x72, 0x5c, 0x32, 0x60, 0x7b, 0x1c,
-	0xf3, 0xf2, 0x4a, 0x13, 0x73, 0x02, 0x8a, 0xf2, 0xcb, 0x32, 0x8b, 0x41, 0xe1, 0x04, 0x73, 0x47,
-	0x0d, 0x97, 0x2c, 0x0e, 0x79, 0xa8, 0x73, 0xa2, 0xb9, 0x04, 0x13, 0xc1, 0x72, 0xf1, 0x05, 0x70,
-	0x49, 0x32, 0x9d, 0x25, 0x90, 0x88, 0x66, 0x89, 0xd1, 0x51, 0x66, 0x2e, 0x56, 0xb0, 0xf5, 0x42,
-	0x0d, 0x8c, 0x5c, 0x6c, 0x90, 0x10, 0x14, 0x52, 0xc7, 0x1a, 0xbc, 0x98, 0xd1, 0x25, 0xa5, 0x41,
-	0x58, 0x21, 0xc4, 0x13, 0x4a, 0xca, 0x4d, 0x97, 0x9f, 0x4c, 0x66, 0x92, 0x15, 0x92, 0xd6, 0xc7,
-	0x96, 0x2e, 0x20, 0x71, 0x25, 0xd4, 0xc3, 0xc8, 0xc5, 0x09, 0x8f, 0x0e, 0x21, 0x2d, 0xdc, 0x86,
-	0xa3, 0x47, 0xa6, 0x94, 0x36, 0x51, 0x6a, 0xa1, 0x6e, 0x51, 0x03, 0xbb, 0x45, 0x41, 0x48, 0x0e,
-	0xab, 0x5b, 0xe0, 0x31, 0x27, 0xb4, 0x92, 0x91, 0x4b, 0x00, 0x3d, 0x56, 0x84, 0x0c, 0x71, 0xdb,
-	0x84, 0x23, 0x86, 0xa5, 0x8c, 0x48, 0xd1, 0x02, 0x75, 0xa3, 0x1e, 0xd8, 0x8d, 0x1a, 0x42, 0x6a,
-	0x58, 0xdd, 0x88, 0x91, 0x1e, 0x9c, 0x9c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1,
-	0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e,
-	0x21, 0x4a, 0x13, 0x6f, 0xda, 0xa8, 0x80, 0x18, 0x0c, 0x4e, 0x22, 0x49, 0x6c, 0xe0, 0xac, 0x69,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xed, 0x9c, 0x7b, 0x26, 0x04, 0x00, 0x00,
+	// 572 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xc7, 0xe3, 0x52, 0x82, 0x7a, 0x65, 0x28, 0xd7, 0xf2, 0x22, 0xb7, 0x75, 0x2a, 0x83, 0xd2,
+	0x50, 0xc4, 0x59, 0x09, 0x13, 0x23, 0xa1, 0xe2, 0x45, 0x62, 0x08, 0x1e, 0x61, 0xa8, 0x2e, 0xe9,
+	0xc5, 0xb5, 0x48, 0x7c, 0xae, 0xef, 0x52, 0x11, 0x89, 0x01, 0x31, 0x33, 0x20, 0xf1, 0x29, 0x58,
+	0xf8, 0x1c, 0x1d, 0x2b, 0xb1, 0x20, 0x86, 0x0a, 0x25, 0x7c, 0x01, 0xbe, 0x01, 0xf2, 0x73, 0x67,
+	0xa7, 0x71, 0xec, 0x04, 0x98, 0x12, 0xdd, 0xf3, 0xf2, 0xff, 0x3d, 0x77, 0xff, 0xc7, 0xa8, 0xd2,
+	0xe1, 0xa2, 0xcf, 0x85, 0xd3, 0xf7, 0x03, 0xe9, 0x9c, 0xd4, 0xdb, 0x4c, 0xd2, 0xba, 0x73, 0x3c,
+	0x60, 0xd1, 0x90, 0x84, 0x11, 0x97, 0x1c, 0xaf, 0xab, 0x04, 0x12, 0x27, 0x10, 0x9d, 0x60, 0x6e,
+	0x78, 0xdc, 0xe3, 0x10, 0x77, 0xe2, 0x7f, 0x2a, 0xd5, 0xdc, 0xf2, 0x38, 0xf7, 0x7a, 0xcc, 0xa1,
+	0xa1, 0xef, 0xd0, 0x20, 0xe0, 0x92, 0x4a, 0x9f, 0x07, 0x42, 0x47, 0xf7, 0xb4, 0x52, 0x9b, 0x0a,
+	0xa6, 0x14, 0x52, 0xbd, 0x90, 0x7a, 0x7e, 0x00, 0xc9, 0x3a, 0xd7, 0xca, 0xa3, 0x02, 0x02, 0x88,
+	0xdb, 0x1b, 0x08, 0xbf, 0x8c, 0x3b, 0xb4, 0x68, 0x44, 0xfb, 0xc2, 0x65, 0xc7, 0x03, 0x26, 0xa4,
+	0xdd, 0x42, 0xeb, 0x53, 0xa7, 0x22, 0xe4, 0x81, 0x60, 0xf8, 0x21, 0x2a, 0x87, 0x70, 0x72, 0xcb,
+	0xd8, 0x31, 0x6a, 0xab, 0x8d, 0x4d, 0x92, 0x33, 0x12, 0x51, 0x45, 0xcd, 0xe5, 0xd3, 0xf3, 0x4a,
+	0xc9, 0xd5, 0x05, 0xf6, 0x4d, 0x74, 0x1d, 0x3a, 0x3e, 0x0f, 0xba, 0x3d, 0xe0, 0x4b, 0xa4, 0xba,
+	0xe8, 0x46, 0x36, 0xa0, 0xd5, 0x5e, 0xa0, 0x15, 0x3f, 0x39, 0x04, 0xc1, 0xab, 0x4d, 0x12, 0xf7,
+	0xfc, 0x71, 0x5e, 0xa9, 0x7a, 0xbe, 0x3c, 0x1a, 0xb4, 0x49, 0x87, 0xf7, 0x1d, 0x3d, 0xa0, 0xfa,
+	0xb9, 0x2f, 0x0e, 0xdf, 0x38, 0x72, 0x18, 0x32, 0x41, 0xf6, 0x59, 0xc7, 0x9d, 0x34, 0xb0, 0x2d,
+	0xb4, 0x05, 0x3a, 0x8f, 0x82, 0x60, 0x40, 0x7b, 0xad, 0x88, 0x9f, 0xf8, 0x22, 0xbe, 0xd3, 0x84,
+	0xe3, 0x1d, 0xda, 0x2e, 0x88, 0x6b, 0x9c, 0xd7, 0xe8, 0x1a, 0x85, 0xd8, 0x41, 0x98, 0x06, 0xff,
+	0x13, 0x6b, 0x8d, 0x66, 0x44, 0xec, 0xae, 0xa6, 0x4b, 0x8f, 0x9e, 0xf9, 0x42, 0xf2, 0x68, 0xa8,
+	0xe9, 0xf0, 0x13, 0x84, 0x26, 0x4f, 0xab, 0x6f, 0xbf, 0x9a, 0xdc, 0x7e, 0xec, 0x03, 0xa2, 0x9c,
+	0x36, 0x79, 0x03, 0x8f, 0xe9, 0x5a, 0xf7, 0x42, 0xa5, 0xfd, 0xd5, 0xd0, 0x63, 0xce, 0x0a, 0xe9,
+	0x31, 0xf7, 0xd1, 0x95, 0x88, 0x75, 0x78, 0x74, 0x18, 0x0f, 0x77, 0xa9, 0xb6, 0xda, 0xb8, 0x93,
+	0xff, 0xc8, 0x49, 0xbd, 0x0b, 0xc9, 0xfa, 0xb5, 0x93, 0x52, 0xfc, 0x74, 0x8a, 0x77, 0x09, 0x78,
+	0x77, 0x17, 0xf2, 0x2a, 0x84, 0x8b, 0xc0, 0x8d, 0xdf, 0xcb, 0xe8, 0x32, 0x00, 0xe3, 0xf7, 0x06,
+	0x2a, 0x2b, 0x6b, 0xe1, 0xdd, 0x5c, 0xa4, 0x59, 0x1f, 0x9b, 0xb5, 0xc5, 0x89, 0x4a, 0xd3, 0xbe,
+	0xfd, 0xe1, 0xdb, 0xaf, 0xcf, 0x4b, 0xdb, 0x78, 0xd3, 0xc9, 0x5b, 0x18, 0x65, 0x62, 0xfc, 0xd1,
+	0x40, 0x2b, 0xa9, 0x4f, 0xf1, 0x5e, 0x71, 0xf3, 0xac, 0xcb, 0xcd, 0x7b, 0x7f, 0x95, 0xab, 0x59,
+	0xaa, 0xc0, 0xb2, 0x83, 0xad, 0x5c, 0x96, 0xd4, 0xd2, 0xf8, 0x8b, 0x81, 0xd6, 0xb2, 0x76, 0xc5,
+	0xf5, 0x62, 0xa5, 0x02, 0xeb, 0x9b, 0x8d, 0x7f, 0x29, 0xd1, 0x8c, 0x04, 0x18, 0x6b, 0xb8, 0x9a,
+	0xcb, 0x38, 0xb3, 0x28, 0xc0, 0x9a, 0xf5, 0xdc, 0x3c, 0xd6, 0x82, 0x45, 0x98, 0xc7, 0x5a, 0x64,
+	0xe9, 0x05, 0xac, 0x29, 0xe4, 0xc1, 0x91, 0xaa, 0x6b, 0x3e, 0x3e, 0x1d, 0x59, 0xc6, 0xd9, 0xc8,
+	0x32, 0x7e, 0x8e, 0x2c, 0xe3, 0xd3, 0xd8, 0x2a, 0x9d, 0x8d, 0xad, 0xd2, 0xf7, 0xb1, 0x55, 0x7a,
+	0x75, 0x77, 0xee, 0x82, 0xbf, 0x55, 0x8d, 0x61, 0xcf, 0xdb, 0x65, 0xf8, 0xbe, 0x3e, 0xf8, 0x13,
+	0x00, 0x00, 0xff, 0xff, 0x58, 0x9b, 0xc4, 0xe3, 0x17, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -329,6 +443,9 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// ProvisionHistory returns the amounts minted over the recorded epochs of
+	// the provision history.
+	ProvisionHistory(ctx context.Context, in *QueryProvisionHistoryRequest, opts ...grpc.CallOption) (*QueryProvisionHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -366,6 +483,15 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) ProvisionHistory(ctx context.Context, in *QueryProvisionHistoryRequest, opts ...grpc.CallOption) (*QueryProvisionHistoryResponse, error) {
+	out := new(QueryProvisionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/ProvisionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -374,6 +500,9 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// ProvisionHistory returns the amounts minted over the recorded epochs of
+	// the provision history.
+	ProvisionHistory(context.Context, *QueryProvisionHistoryRequest) (*QueryProvisionHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -389,6 +518,9 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (*UnimplementedQueryServer) ProvisionHistory(ctx context.Context, req *QueryProvisionHistoryRequest) (*QueryProvisionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProvisionHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -448,6 +580,24 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProvisionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProvisionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProvisionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/ProvisionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProvisionHistory(ctx, req.(*QueryProvisionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -464,6 +614,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "ProvisionHistory",
+			Handler:    _Query_ProvisionHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -637,6 +791,90 @@ func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProvisionHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvisionHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvisionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProvisionHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvisionHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvisionHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -708,6 +946,38 @@ func (m *QueryAnnualProvisionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProvisionHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProvisionHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1113,6 +1383,212 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProvisionHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProvisionHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProvisionHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProvisionHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProvisionHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProvisionHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, ProvisionRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,85 @@
+package oracle
+
+import (
+	"bytes"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BallotVote is the price voted for a denom by a validator, weighted by its
+// voting power.
+type BallotVote struct {
+	Validator sdk.ValAddress
+	Rate      sdk.Dec
+	Power     int64
+}
+
+// Ballot is the set of the votes for a denom in a voting window.
+type Ballot []BallotVote
+
+// Power returns the total voting power of the ballot.
+func (b Ballot) Power() int64 {
+	var power int64
+	for _, v := range b {
+		power += v.Power
+	}
+
+	return power
+}
+
+// WeightedMedian returns the stake-weighted median of the ballot, i.e. the
+// lowest rate such that the votes for lower or equal rates hold at least half
+// of the voting power of the ballot. It returns zero for an empty ballot.
+func (b Ballot) WeightedMedian() sdk.Dec {
+	total := b.Power()
+	if total == 0 {
+		return sdk.ZeroDec()
+	}
+
+	sorted := make(Ballot, len(b))
+	copy(sorted, b)
+	// the votes of the same rate are ordered by validator to be deterministic
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !sorted[i].Rate.Equal(sorted[j].Rate) {
+			return sorted[i].Rate.LT(sorted[j].Rate)
+		}
+		return bytes.Compare(sorted[i].Validator, sorted[j].Validator) < 0
+	})
+
+	var cumulative int64
+	for _, v := range sorted {
+		cumulative += v.Power
+		if cumulative*2 >= total {
+			return v.Rate
+		}
+	}
+
+	return sorted[len(sorted)-1].Rate
+}
+
+// validateExchangeRates checks that the rates are positive and that their
+// denoms are valid and unique.
+func validateExchangeRates(rates []ExchangeRate) error {
+	if len(rates) == 0 {
+		return sdkerrors.Wrap(ErrInvalidExchangeRate, "no exchange rates")
+	}
+
+	seen := make(map[string]bool, len(rates))
+	for _, r := range rates {
+		if err := sdk.ValidateDenom(r.Denom); err != nil {
+			return sdkerrors.Wrap(ErrInvalidExchangeRate, err.Error())
+		}
+		if seen[r.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidExchangeRate, "duplicate denom %s", r.Denom)
+		}
+		seen[r.Denom] = true
+
+		if r.Rate.IsNil() || !r.Rate.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidExchangeRate, "rate of %s must be positive", r.Denom)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package oracle_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle"
+)
+
+func TestWeightedMedian(t *testing.T) {
+	vote := func(addr byte, rate int64, power int64) oracle.BallotVote {
+		return oracle.BallotVote{Validator: sdk.ValAddress{addr}, Rate: sdk.NewDec(rate), Power: power}
+	}
+
+	testCases := []struct {
+		name     string
+		ballot   oracle.Ballot
+		expected int64
+	}{
+		{"empty", nil, 0},
+		{"single vote", oracle.Ballot{vote(1, 7, 1)}, 7},
+		{"equal powers", oracle.Ballot{vote(1, 30, 1), vote(2, 10, 1), vote(3, 20, 1)}, 20},
+		{"even number of votes", oracle.Ballot{vote(1, 10, 1), vote(2, 20, 1)}, 10},
+		{"heavy vote", oracle.Ballot{vote(1, 10, 1), vote(2, 20, 1), vote(3, 100, 5)}, 100},
+		{"zero power votes", oracle.Ballot{vote(1, 10, 0), vote(2, 20, 3), vote(3, 30, 0)}, 20},
+		{"same rates", oracle.Ballot{vote(2, 10, 2), vote(1, 10, 2), vote(3, 50, 3)}, 10},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, sdk.NewDec(tc.expected), tc.ballot.WeightedMedian())
+		})
+	}
+
+	// the ballot is not reordered
+	ballot := oracle.Ballot{vote(1, 30, 1), vote(2, 10, 1)}
+	ballot.WeightedMedian()
+	require.Equal(t, sdk.NewDec(30), ballot[0].Rate)
+}
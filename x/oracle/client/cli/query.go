@@ -0,0 +1,166 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/oracle"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	oracleQueryCmd := &cobra.Command{
+		Use:                        oracle.ModuleName,
+		Short:                      "Querying commands for the oracle module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	oracleQueryCmd.AddCommand(
+		GetCmdQueryPrice(),
+		GetCmdQueryPrices(),
+		GetCmdQueryAggregateVote(),
+		GetCmdQueryMissCounter(),
+		GetCmdQueryParams(),
+	)
+
+	return oracleQueryCmd
+}
+
+// GetCmdQueryPrice returns cmd to query for the price of a denom.
+func GetCmdQueryPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "price [denom]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the aggregated price of a denom",
+		Example: fmt.Sprintf("$ %s query %s price uatom", version.AppName, oracle.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := oracle.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Price(cmd.Context(), &oracle.QueryPriceRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Price)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPrices returns cmd to query for the prices of all the denoms.
+func GetCmdQueryPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "prices",
+		Args:    cobra.NoArgs,
+		Short:   "Query the aggregated prices of all the denoms",
+		Example: fmt.Sprintf("$ %s query %s prices", version.AppName, oracle.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := oracle.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Prices(cmd.Context(), &oracle.QueryPricesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "prices")
+
+	return cmd
+}
+
+// GetCmdQueryAggregateVote returns cmd to query for the vote of a validator in
+// the current voting window.
+func GetCmdQueryAggregateVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "aggregate-vote [validator]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the vote of a validator in the current voting window",
+		Example: fmt.Sprintf("$ %s query %s aggregate-vote [validator]", version.AppName, oracle.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := oracle.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AggregateVote(cmd.Context(), &oracle.QueryAggregateVoteRequest{Validator: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.AggregateVote)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMissCounter returns cmd to query for the number of voting windows
+// a validator missed in the current slash window.
+func GetCmdQueryMissCounter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "miss-counter [validator]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the number of voting windows a validator missed in the current slash window",
+		Example: fmt.Sprintf("$ %s query %s miss-counter [validator]", version.AppName, oracle.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := oracle.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MissCounter(cmd.Context(), &oracle.QueryMissCounterRequest{Validator: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryParams returns cmd to query for the oracle parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Args:    cobra.NoArgs,
+		Short:   "Query the current oracle parameters",
+		Example: fmt.Sprintf("$ %s query %s params", version.AppName, oracle.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := oracle.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &oracle.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
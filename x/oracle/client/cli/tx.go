@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/oracle"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	oracleTxCmd := &cobra.Command{
+		Use:                        oracle.ModuleName,
+		Short:                      "Oracle transactions subcommands",
+		Long:                       "Submit the price votes of a validator",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	oracleTxCmd.AddCommand(
+		NewCmdAggregateExchangeRateVote(),
+	)
+
+	return oracleTxCmd
+}
+
+// NewCmdAggregateExchangeRateVote returns a CLI command handler for creating a
+// MsgAggregateExchangeRateVote transaction.
+func NewCmdAggregateExchangeRateVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [exchange_rates] --from [validator_operator]",
+		Short: "Vote the prices of the whitelisted denoms for the current voting window",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Vote the prices of the whitelisted denoms for the current voting window, as a
+comma separated list of prices followed by their denom. The vote must be signed
+by the operator account of a bonded validator, and replaces its previous vote
+in the window.
+
+Example:
+$ %s tx %s vote 8.8uatom,1700.5ueth --from myvalidator
+`, version.AppName, oracle.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			rates, err := ParseExchangeRates(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := oracle.NewMsgAggregateExchangeRateVote(sdk.ValAddress(clientCtx.GetFromAddress()), rates)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ParseExchangeRates parses a comma separated list of prices followed by their
+// denom, e.g. 8.8uatom,1700.5ueth.
+func ParseExchangeRates(s string) ([]oracle.ExchangeRate, error) {
+	coins, err := sdk.ParseDecCoins(s)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := make([]oracle.ExchangeRate, len(coins))
+	for i, coin := range coins {
+		rates[i] = oracle.ExchangeRate{Denom: coin.Denom, Rate: coin.Amount}
+	}
+
+	return rates, nil
+}
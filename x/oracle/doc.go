@@ -0,0 +1,20 @@
+/*
+Package oracle provides on-chain prices voted by the validators: every voting
+window of VotePeriod blocks, the bonded validators submit the prices of the
+whitelisted denoms with MsgAggregateExchangeRateVote, signed by their operator
+account.
+
+In the last block of every voting window, the price of each denom voted by
+validators holding at least VoteThreshold of the bonded voting power is set to
+the stake-weighted median of their votes, and the votes are cleared. Other
+modules read the prices with Keeper.GetPrice, and clients with the Price and
+Prices queries.
+
+The bonded validators which didn't vote for every whitelisted denom in a
+voting window miss it. At the end of every slash window of SlashWindow blocks,
+a slash event is queued for the validators which voted in less than
+MinValidPerWindow of the voting windows, and the miss counters are reset. The
+queued slash events are applied in the BeginBlocker of the next block: the
+validators are slashed by SlashFraction and jailed.
+*/
+package oracle
@@ -0,0 +1,19 @@
+package oracle
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/oracle module sentinel errors
+var (
+	// ErrNotBondedValidator error if the voter isn't a bonded validator
+	ErrNotBondedValidator = sdkerrors.Register(ModuleName, 2, "not a bonded validator")
+	// ErrUnknownDenom error if the denom isn't whitelisted
+	ErrUnknownDenom = sdkerrors.Register(ModuleName, 3, "denom not whitelisted")
+	// ErrInvalidExchangeRate error if a voted price isn't positive
+	ErrInvalidExchangeRate = sdkerrors.Register(ModuleName, 4, "invalid exchange rate")
+	// ErrPriceNotFound error if the price of a denom was never aggregated
+	ErrPriceNotFound = sdkerrors.Register(ModuleName, 5, "price not found")
+	// ErrVoteNotFound error if the validator didn't vote in the current window
+	ErrVoteNotFound = sdkerrors.Register(ModuleName, 6, "aggregate vote not found")
+)
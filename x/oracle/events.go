@@ -0,0 +1,16 @@
+package oracle
+
+// oracle module events
+const (
+	EventTypeAggregateVote = "aggregate_vote"
+	EventTypePriceUpdate   = "price_update"
+	EventTypeSlash         = "oracle_slash"
+
+	AttributeKeyValidator     = "validator"
+	AttributeKeyExchangeRates = "exchange_rates"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyRate          = "rate"
+	AttributeKeyMisses        = "misses"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,84 @@
+package oracle
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(
+	params Params, prices []Price, votes []AggregateVote, missCounters []MissCounter, slashEvents []SlashEvent,
+) *GenesisState {
+	return &GenesisState{
+		Params:         params,
+		Prices:         prices,
+		AggregateVotes: votes,
+		MissCounters:   missCounters,
+		SlashEvents:    slashEvents,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the oracle module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil, nil, nil, nil)
+}
+
+// ValidateGenesis checks that the parameters, prices and votes are valid, and
+// that there is at most one price per denom and one vote, miss counter and
+// slash event per validator.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	denoms := make(map[string]bool, len(data.Prices))
+	for _, p := range data.Prices {
+		if err := sdk.ValidateDenom(p.Denom); err != nil {
+			return fmt.Errorf("invalid price: %w", err)
+		}
+		if denoms[p.Denom] {
+			return fmt.Errorf("duplicate price of %s", p.Denom)
+		}
+		denoms[p.Denom] = true
+
+		if p.Rate.IsNil() || !p.Rate.IsPositive() {
+			return fmt.Errorf("price of %s must be positive", p.Denom)
+		}
+	}
+
+	if err := validateUniqueValidators(len(data.AggregateVotes), func(i int) string { return data.AggregateVotes[i].Validator }); err != nil {
+		return fmt.Errorf("invalid aggregate votes: %w", err)
+	}
+	for _, v := range data.AggregateVotes {
+		if err := validateExchangeRates(v.ExchangeRates); err != nil {
+			return fmt.Errorf("invalid aggregate vote of %s: %w", v.Validator, err)
+		}
+	}
+
+	if err := validateUniqueValidators(len(data.MissCounters), func(i int) string { return data.MissCounters[i].Validator }); err != nil {
+		return fmt.Errorf("invalid miss counters: %w", err)
+	}
+
+	if err := validateUniqueValidators(len(data.SlashEvents), func(i int) string { return data.SlashEvents[i].Validator }); err != nil {
+		return fmt.Errorf("invalid slash events: %w", err)
+	}
+
+	return nil
+}
+
+func validateUniqueValidators(n int, validator func(i int) string) error {
+	seen := make(map[string]bool, n)
+	for i := 0; i < n; i++ {
+		val := validator(i)
+		if _, err := sdk.ValAddressFromBech32(val); err != nil {
+			return fmt.Errorf("invalid validator address %s: %w", val, err)
+		}
+		if seen[val] {
+			return fmt.Errorf("duplicate validator %s", val)
+		}
+		seen[val] = true
+	}
+
+	return nil
+}
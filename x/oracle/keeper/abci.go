@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// bondedValidator is a validator voting in a voting window.
+type bondedValidator struct {
+	addr  sdk.ValAddress
+	power int64
+}
+
+// EndVotingWindow aggregates the votes of the voting window ending at the
+// current block, increments the miss counters of the validators which didn't
+// vote for every whitelisted denom and clears the votes. At the end of a slash
+// window, it also queues the slashes of the validators which missed too many
+// voting windows and resets the miss counters. It does nothing in the other
+// blocks.
+func (k Keeper) EndVotingWindow(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	height := uint64(ctx.BlockHeight())
+	if height%params.VotePeriod != 0 {
+		return
+	}
+
+	validators, totalPower := k.bondedValidators(ctx)
+	k.tallyVotes(ctx, params, validators, totalPower)
+
+	if height%params.SlashWindow == 0 {
+		k.queueSlashEvents(ctx, params, validators)
+	}
+}
+
+// ApplySlashEvents slashes and jails the validators of the queued slash
+// events, if they are still bonded, and clears the queue.
+func (k Keeper) ApplySlashEvents(ctx sdk.Context) {
+	var events []oracle.SlashEvent
+	k.IterateSlashEvents(ctx, func(event oracle.SlashEvent) bool {
+		events = append(events, event)
+		return false
+	})
+	if len(events) == 0 {
+		return
+	}
+	k.clearPrefix(ctx, oracle.SlashEventKeyPrefix)
+
+	params := k.GetParams(ctx)
+	powerReduction := k.stakingKeeper.PowerReduction(ctx)
+	for _, event := range events {
+		validator := k.stakingKeeper.Validator(ctx, mustValAddress(event.Validator))
+		if validator == nil || !validator.IsBonded() || validator.IsJailed() {
+			continue
+		}
+
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			panic(err)
+		}
+
+		k.stakingKeeper.Slash(ctx, consAddr, event.Height, validator.GetConsensusPower(powerReduction), params.SlashFraction)
+		k.stakingKeeper.Jail(ctx, consAddr)
+
+		k.Logger(ctx).Info("slashed validator missing oracle votes", "validator", event.Validator, "misses", event.Misses)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				oracle.EventTypeSlash,
+				sdk.NewAttribute(oracle.AttributeKeyValidator, event.Validator),
+				sdk.NewAttribute(oracle.AttributeKeyMisses, fmt.Sprintf("%d", event.Misses)),
+			),
+		)
+	}
+}
+
+// bondedValidators returns the bonded validators which aren't jailed, and
+// their total voting power.
+func (k Keeper) bondedValidators(ctx sdk.Context) ([]bondedValidator, int64) {
+	powerReduction := k.stakingKeeper.PowerReduction(ctx)
+
+	var (
+		validators []bondedValidator
+		totalPower int64
+	)
+	k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+		if validator.IsJailed() {
+			return false
+		}
+
+		power := validator.GetConsensusPower(powerReduction)
+		validators = append(validators, bondedValidator{addr: validator.GetOperator(), power: power})
+		totalPower += power
+		return false
+	})
+
+	return validators, totalPower
+}
+
+// tallyVotes sets the price of every whitelisted denom voted by enough voting
+// power, updates the miss counters and clears the votes.
+func (k Keeper) tallyVotes(ctx sdk.Context, params oracle.Params, validators []bondedValidator, totalPower int64) {
+	ballots := make(map[string]oracle.Ballot, len(params.Whitelist))
+	for _, val := range validators {
+		vote, found := k.GetAggregateVote(ctx, val.addr)
+
+		voted := make(map[string]bool, len(vote.ExchangeRates))
+		for _, rate := range vote.ExchangeRates {
+			// the denoms removed from the whitelist during the window are ignored
+			if !params.IsWhitelisted(rate.Denom) {
+				continue
+			}
+
+			voted[rate.Denom] = true
+			ballots[rate.Denom] = append(ballots[rate.Denom], oracle.BallotVote{
+				Validator: val.addr,
+				Rate:      rate.Rate,
+				Power:     val.power,
+			})
+		}
+
+		if !found || len(voted) < len(params.Whitelist) {
+			k.SetMissCounter(ctx, oracle.MissCounter{
+				Validator: val.addr.String(),
+				Misses:    k.GetMissCounter(ctx, val.addr) + 1,
+			})
+		}
+	}
+
+	threshold := params.VoteThreshold.MulInt64(totalPower)
+	for _, denom := range params.Whitelist {
+		ballot := ballots[denom]
+		if len(ballot) == 0 || sdk.NewDec(ballot.Power()).LT(threshold) {
+			continue
+		}
+
+		price := oracle.Price{Denom: denom, Rate: ballot.WeightedMedian(), Height: ctx.BlockHeight()}
+		k.SetPrice(ctx, price)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				oracle.EventTypePriceUpdate,
+				sdk.NewAttribute(oracle.AttributeKeyDenom, denom),
+				sdk.NewAttribute(oracle.AttributeKeyRate, price.Rate.String()),
+			),
+		)
+	}
+
+	k.clearPrefix(ctx, oracle.AggregateVoteKeyPrefix)
+}
+
+// queueSlashEvents queues the slashes of the validators which voted in less
+// than the minimum fraction of the voting windows of the slash window, and
+// resets the miss counters.
+func (k Keeper) queueSlashEvents(ctx sdk.Context, params oracle.Params, validators []bondedValidator) {
+	periods := params.VotePeriodsPerWindow()
+	for _, val := range validators {
+		misses := k.GetMissCounter(ctx, val.addr)
+		if misses > periods {
+			misses = periods
+		}
+
+		valid := sdk.NewDec(int64(periods - misses)).QuoInt64(int64(periods))
+		if valid.LT(params.MinValidPerWindow) {
+			k.SetSlashEvent(ctx, oracle.SlashEvent{
+				Validator: val.addr.String(),
+				Height:    ctx.BlockHeight(),
+				Misses:    misses,
+			})
+		}
+	}
+
+	k.clearPrefix(ctx, oracle.MissCounterKeyPrefix)
+}
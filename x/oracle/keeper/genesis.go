@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle"
+)
+
+// InitGenesis initializes the oracle module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *oracle.GenesisState) error {
+	if err := oracle.ValidateGenesis(*data); err != nil {
+		return err
+	}
+	k.SetParams(ctx, data.Params)
+
+	for _, price := range data.Prices {
+		k.SetPrice(ctx, price)
+	}
+
+	for _, vote := range data.AggregateVotes {
+		k.SetAggregateVote(ctx, vote)
+	}
+
+	for _, counter := range data.MissCounters {
+		k.SetMissCounter(ctx, counter)
+	}
+
+	for _, event := range data.SlashEvents {
+		k.SetSlashEvent(ctx, event)
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the oracle module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) (*oracle.GenesisState, error) {
+	var prices []oracle.Price
+	k.IteratePrices(ctx, func(price oracle.Price) bool {
+		prices = append(prices, price)
+		return false
+	})
+
+	var votes []oracle.AggregateVote
+	k.IterateAggregateVotes(ctx, func(vote oracle.AggregateVote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+
+	var counters []oracle.MissCounter
+	k.IterateMissCounters(ctx, func(counter oracle.MissCounter) bool {
+		counters = append(counters, counter)
+		return false
+	})
+
+	var events []oracle.SlashEvent
+	k.IterateSlashEvents(ctx, func(event oracle.SlashEvent) bool {
+		events = append(events, event)
+		return false
+	})
+
+	return oracle.NewGenesisState(k.GetParams(ctx), prices, votes, counters, events), nil
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/oracle"
+)
+
+var _ oracle.QueryServer = Keeper{}
+
+// Price returns the aggregated price of a denom.
+func (q Keeper) Price(c context.Context, req *oracle.QueryPriceRequest) (*oracle.QueryPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	price, found := q.GetPrice(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "price of %s doesn't exist", req.Denom)
+	}
+
+	return &oracle.QueryPriceResponse{Price: price}, nil
+}
+
+// Prices returns the aggregated prices of all the denoms.
+func (q Keeper) Prices(c context.Context, req *oracle.QueryPricesRequest) (*oracle.QueryPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), oracle.PriceKeyPrefix)
+
+	var prices []oracle.Price
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var price oracle.Price
+		if err := q.cdc.Unmarshal(value, &price); err != nil {
+			return err
+		}
+
+		prices = append(prices, price)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &oracle.QueryPricesResponse{Prices: prices, Pagination: pageRes}, nil
+}
+
+// AggregateVote returns the vote of a validator in the current voting window.
+func (q Keeper) AggregateVote(c context.Context, req *oracle.QueryAggregateVoteRequest) (*oracle.QueryAggregateVoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := sdk.ValAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	vote, found := q.GetAggregateVote(ctx, val)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s didn't vote in the current voting window", req.Validator)
+	}
+
+	return &oracle.QueryAggregateVoteResponse{AggregateVote: vote}, nil
+}
+
+// MissCounter returns the number of voting windows a validator missed in the
+// current slash window.
+func (q Keeper) MissCounter(c context.Context, req *oracle.QueryMissCounterRequest) (*oracle.QueryMissCounterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := sdk.ValAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &oracle.QueryMissCounterResponse{Misses: q.GetMissCounter(ctx, val)}, nil
+}
+
+// Params returns the parameters of the oracle module.
+func (q Keeper) Params(c context.Context, req *oracle.QueryParamsRequest) (*oracle.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &oracle.QueryParamsResponse{Params: q.GetParams(ctx)}, nil
+}
@@ -0,0 +1,251 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/oracle"
+)
+
+// Keeper manages the votes of the validators, the aggregated prices and the
+// miss counters and slash events of the validators.
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeKey      sdk.StoreKey
+	stakingKeeper oracle.StakingKeeper
+
+	// the address capable of updating the parameters, usually the gov module
+	// account
+	authority string
+}
+
+// NewKeeper creates an oracle Keeper
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, sk oracle.StakingKeeper, authority string) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		stakingKeeper: sk,
+		authority:     authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", oracle.ModuleName))
+}
+
+// GetAuthority returns the oracle module authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the parameters of the oracle module.
+func (k Keeper) GetParams(ctx sdk.Context) (params oracle.Params) {
+	bz := ctx.KVStore(k.storeKey).Get(oracle.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the parameters of the oracle module.
+func (k Keeper) SetParams(ctx sdk.Context, params oracle.Params) {
+	ctx.KVStore(k.storeKey).Set(oracle.ParamsKey, k.cdc.MustMarshal(&params))
+}
+
+// GetPrice returns the last aggregated price of a denom. Modules using the
+// price should check its height to discard stale prices, as the price isn't
+// updated in the voting windows without enough votes.
+func (k Keeper) GetPrice(ctx sdk.Context, denom string) (price oracle.Price, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(oracle.PriceKey(denom))
+	if bz == nil {
+		return price, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &price)
+	return price, true
+}
+
+// SetPrice sets the aggregated price of a denom.
+func (k Keeper) SetPrice(ctx sdk.Context, price oracle.Price) {
+	ctx.KVStore(k.storeKey).Set(oracle.PriceKey(price.Denom), k.cdc.MustMarshal(&price))
+}
+
+// IteratePrices iterates over the aggregated prices, in denom order.
+func (k Keeper) IteratePrices(ctx sdk.Context, cb func(price oracle.Price) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), oracle.PriceKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var price oracle.Price
+		k.cdc.MustUnmarshal(iter.Value(), &price)
+		if cb(price) {
+			break
+		}
+	}
+}
+
+// GetAggregateVote returns the vote of a validator in the current voting
+// window.
+func (k Keeper) GetAggregateVote(ctx sdk.Context, val sdk.ValAddress) (vote oracle.AggregateVote, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(oracle.AggregateVoteKey(val))
+	if bz == nil {
+		return vote, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &vote)
+	return vote, true
+}
+
+// SetAggregateVote sets the vote of a validator in the current voting window.
+func (k Keeper) SetAggregateVote(ctx sdk.Context, vote oracle.AggregateVote) {
+	val := mustValAddress(vote.Validator)
+	ctx.KVStore(k.storeKey).Set(oracle.AggregateVoteKey(val), k.cdc.MustMarshal(&vote))
+}
+
+// IterateAggregateVotes iterates over the votes of the current voting window.
+func (k Keeper) IterateAggregateVotes(ctx sdk.Context, cb func(vote oracle.AggregateVote) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), oracle.AggregateVoteKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var vote oracle.AggregateVote
+		k.cdc.MustUnmarshal(iter.Value(), &vote)
+		if cb(vote) {
+			break
+		}
+	}
+}
+
+// GetMissCounter returns the number of voting windows a validator missed in
+// the current slash window.
+func (k Keeper) GetMissCounter(ctx sdk.Context, val sdk.ValAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(oracle.MissCounterKey(val))
+	if bz == nil {
+		return 0
+	}
+
+	var counter oracle.MissCounter
+	k.cdc.MustUnmarshal(bz, &counter)
+	return counter.Misses
+}
+
+// SetMissCounter sets the number of voting windows a validator missed in the
+// current slash window. The counter is deleted if it is zero.
+func (k Keeper) SetMissCounter(ctx sdk.Context, counter oracle.MissCounter) {
+	store := ctx.KVStore(k.storeKey)
+	key := oracle.MissCounterKey(mustValAddress(counter.Validator))
+	if counter.Misses == 0 {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&counter))
+}
+
+// IterateMissCounters iterates over the miss counters of the current slash
+// window.
+func (k Keeper) IterateMissCounters(ctx sdk.Context, cb func(counter oracle.MissCounter) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), oracle.MissCounterKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var counter oracle.MissCounter
+		k.cdc.MustUnmarshal(iter.Value(), &counter)
+		if cb(counter) {
+			break
+		}
+	}
+}
+
+// SetSlashEvent queues the slash of a validator.
+func (k Keeper) SetSlashEvent(ctx sdk.Context, event oracle.SlashEvent) {
+	val := mustValAddress(event.Validator)
+	ctx.KVStore(k.storeKey).Set(oracle.SlashEventKey(val), k.cdc.MustMarshal(&event))
+}
+
+// IterateSlashEvents iterates over the queued slashes.
+func (k Keeper) IterateSlashEvents(ctx sdk.Context, cb func(event oracle.SlashEvent) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), oracle.SlashEventKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var event oracle.SlashEvent
+		k.cdc.MustUnmarshal(iter.Value(), &event)
+		if cb(event) {
+			break
+		}
+	}
+}
+
+// SubmitVote sets the vote of a bonded validator for the current voting
+// window, replacing its previous vote in the window. All the voted denoms
+// must be whitelisted.
+func (k Keeper) SubmitVote(ctx sdk.Context, val sdk.ValAddress, rates []oracle.ExchangeRate) error {
+	validator := k.stakingKeeper.Validator(ctx, val)
+	if validator == nil || !validator.IsBonded() || validator.IsJailed() {
+		return sdkerrors.Wrap(oracle.ErrNotBondedValidator, val.String())
+	}
+
+	params := k.GetParams(ctx)
+	for _, rate := range rates {
+		if !params.IsWhitelisted(rate.Denom) {
+			return sdkerrors.Wrap(oracle.ErrUnknownDenom, rate.Denom)
+		}
+	}
+
+	vote := oracle.AggregateVote{Validator: val.String(), ExchangeRates: rates}
+	k.SetAggregateVote(ctx, vote)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			oracle.EventTypeAggregateVote,
+			sdk.NewAttribute(oracle.AttributeKeyValidator, vote.Validator),
+			sdk.NewAttribute(oracle.AttributeKeyExchangeRates, formatExchangeRates(rates)),
+		),
+	)
+
+	return nil
+}
+
+// clearPrefix deletes all the entries of a prefix of the store.
+func (k Keeper) clearPrefix(ctx sdk.Context, keyPrefix []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func formatExchangeRates(rates []oracle.ExchangeRate) string {
+	strs := make([]string, len(rates))
+	for i, rate := range rates {
+		strs[i] = rate.Rate.String() + rate.Denom
+	}
+
+	return strings.Join(strs, ",")
+}
+
+func mustValAddress(bech32 string) sdk.ValAddress {
+	val, err := sdk.ValAddressFromBech32(bech32)
+	if err != nil {
+		panic(err)
+	}
+
+	return val
+}
@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle"
+	"github.com/cosmos/cosmos-sdk/x/oracle/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app      *simapp.SimApp
+	ctx      sdk.Context
+	valAddrs []sdk.ValAddress
+	keeper   keeper.Keeper
+	msgSrvr  oracle.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(suite.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	// three bonded validators of power 10
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 4, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	pks := simapp.CreateTestPubKeys(3)
+	tstaking := teststaking.NewHelper(suite.T(), ctx, app.StakingKeeper)
+	for i, pk := range pks {
+		tstaking.CreateValidatorWithValPower(valAddrs[i], pk, 10, true)
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.valAddrs = valAddrs
+	suite.keeper = app.OracleKeeper
+	suite.msgSrvr = keeper.NewMsgServerImpl(suite.keeper)
+
+	params := oracle.DefaultParams()
+	params.VotePeriod = 5
+	params.SlashWindow = 10
+	params.Whitelist = []string{"uatom", "ueth"}
+	params.MinValidPerWindow = sdk.NewDecWithPrec(5, 1)
+	params.SlashFraction = sdk.NewDecWithPrec(1, 1)
+	suite.keeper.SetParams(ctx, params)
+}
+
+func rates(atom, eth int64) []oracle.ExchangeRate {
+	var rates []oracle.ExchangeRate
+	if atom > 0 {
+		rates = append(rates, oracle.ExchangeRate{Denom: "uatom", Rate: sdk.NewDec(atom)})
+	}
+	if eth > 0 {
+		rates = append(rates, oracle.ExchangeRate{Denom: "ueth", Rate: sdk.NewDec(eth)})
+	}
+
+	return rates
+}
+
+func (suite *KeeperTestSuite) vote(val sdk.ValAddress, rates []oracle.ExchangeRate) error {
+	_, err := suite.msgSrvr.AggregateExchangeRateVote(sdk.WrapSDKContext(suite.ctx), oracle.NewMsgAggregateExchangeRateVote(val, rates))
+	return err
+}
+
+// endBlock moves to the end of the block at a height.
+func (suite *KeeperTestSuite) endBlock(height int64) {
+	suite.ctx = suite.ctx.WithBlockHeight(height)
+	suite.keeper.EndVotingWindow(suite.ctx)
+}
+
+func (suite *KeeperTestSuite) TestSubmitVote() {
+	// only bonded validators can vote
+	suite.Require().ErrorIs(suite.vote(suite.valAddrs[3], rates(10, 0)), oracle.ErrNotBondedValidator)
+
+	// only whitelisted denoms can be voted
+	err := suite.vote(suite.valAddrs[0], []oracle.ExchangeRate{{Denom: "ubtc", Rate: sdk.OneDec()}})
+	suite.Require().ErrorIs(err, oracle.ErrUnknownDenom)
+
+	// a vote replaces the previous vote of the window
+	suite.Require().NoError(suite.vote(suite.valAddrs[0], rates(10, 100)))
+	suite.Require().NoError(suite.vote(suite.valAddrs[0], rates(11, 0)))
+
+	res, err := suite.keeper.AggregateVote(sdk.WrapSDKContext(suite.ctx), &oracle.QueryAggregateVoteRequest{Validator: suite.valAddrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(rates(11, 0), res.AggregateVote.ExchangeRates)
+
+	_, err = suite.keeper.AggregateVote(sdk.WrapSDKContext(suite.ctx), &oracle.QueryAggregateVoteRequest{Validator: suite.valAddrs[1].String()})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestVotingWindows() {
+	suite.Require().NoError(suite.vote(suite.valAddrs[0], rates(10, 100)))
+	suite.Require().NoError(suite.vote(suite.valAddrs[1], rates(12, 110)))
+	suite.Require().NoError(suite.vote(suite.valAddrs[2], rates(100, 0)))
+
+	// the votes are only aggregated at the end of the window
+	suite.endBlock(4)
+	_, found := suite.keeper.GetPrice(suite.ctx, "uatom")
+	suite.Require().False(found)
+
+	suite.endBlock(5)
+	price, found := suite.keeper.GetPrice(suite.ctx, "uatom")
+	suite.Require().True(found)
+	suite.Require().Equal(oracle.Price{Denom: "uatom", Rate: sdk.NewDec(12), Height: 5}, price)
+	price, found = suite.keeper.GetPrice(suite.ctx, "ueth")
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(100), price.Rate)
+
+	res, err := suite.keeper.Prices(sdk.WrapSDKContext(suite.ctx), &oracle.QueryPricesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Prices, 2)
+
+	// the votes are cleared, and the validators which didn't vote for every
+	// denom missed the window
+	suite.Require().Empty(suite.exportGenesis().AggregateVotes)
+	suite.Require().Zero(suite.keeper.GetMissCounter(suite.ctx, suite.valAddrs[0]))
+	suite.Require().Equal(uint64(1), suite.keeper.GetMissCounter(suite.ctx, suite.valAddrs[2]))
+
+	// prices voted by less than the threshold are not updated
+	suite.Require().NoError(suite.vote(suite.valAddrs[0], rates(20, 200)))
+	suite.endBlock(10)
+	price, _ = suite.keeper.GetPrice(suite.ctx, "uatom")
+	suite.Require().Equal(oracle.Price{Denom: "uatom", Rate: sdk.NewDec(12), Height: 5}, price)
+
+	// the miss counters are reset at the end of the slash window, and the
+	// slashes of the validators which voted in less than half of the windows
+	// are queued
+	suite.Require().Empty(suite.exportGenesis().MissCounters)
+	events := suite.exportGenesis().SlashEvents
+	suite.Require().Equal([]oracle.SlashEvent{{Validator: suite.valAddrs[2].String(), Height: 10, Misses: 2}}, events)
+}
+
+func (suite *KeeperTestSuite) TestApplySlashEvents() {
+	val := suite.app.StakingKeeper.Validator(suite.ctx, suite.valAddrs[2])
+	tokens := val.GetTokens()
+
+	suite.keeper.SetSlashEvent(suite.ctx, oracle.SlashEvent{Validator: suite.valAddrs[2].String(), Height: 1, Misses: 2})
+	suite.ctx = suite.ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	suite.keeper.ApplySlashEvents(suite.ctx)
+
+	val = suite.app.StakingKeeper.Validator(suite.ctx, suite.valAddrs[2])
+	suite.Require().True(val.IsJailed())
+	suite.Require().Equal(tokens.ToDec().Mul(sdk.NewDecWithPrec(9, 1)).TruncateInt(), val.GetTokens())
+	suite.Require().Empty(suite.exportGenesis().SlashEvents)
+
+	var slashEvents int
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == oracle.EventTypeSlash {
+			slashEvents++
+		}
+	}
+	suite.Require().Equal(1, slashEvents)
+
+	// jailed validators can't vote
+	suite.Require().ErrorIs(suite.vote(suite.valAddrs[2], rates(10, 0)), oracle.ErrNotBondedValidator)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	params := oracle.DefaultParams()
+	_, err := suite.msgSrvr.UpdateParams(sdk.WrapSDKContext(suite.ctx), &oracle.MsgUpdateParams{
+		Authority: sdk.AccAddress(suite.valAddrs[0]).String(),
+		Params:    params,
+	})
+	suite.Require().Error(err)
+
+	_, err = suite.msgSrvr.UpdateParams(sdk.WrapSDKContext(suite.ctx), &oracle.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Params:    params,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, suite.keeper.GetParams(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	suite.Require().NoError(suite.vote(suite.valAddrs[0], rates(10, 100)))
+	suite.Require().NoError(suite.vote(suite.valAddrs[1], rates(12, 110)))
+	suite.endBlock(5)
+	suite.Require().NoError(suite.vote(suite.valAddrs[0], rates(11, 0)))
+	suite.keeper.SetSlashEvent(suite.ctx, oracle.SlashEvent{Validator: suite.valAddrs[2].String(), Height: 5, Misses: 1})
+
+	genesis := suite.exportGenesis()
+	suite.Require().NoError(oracle.ValidateGenesis(*genesis))
+	suite.Require().Len(genesis.Prices, 2)
+	suite.Require().Len(genesis.AggregateVotes, 1)
+	suite.Require().Len(genesis.MissCounters, 1)
+	suite.Require().Len(genesis.SlashEvents, 1)
+
+	app := simapp.Setup(suite.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.Require().NoError(app.OracleKeeper.InitGenesis(ctx, genesis))
+
+	exported, err := app.OracleKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genesis, exported)
+}
+
+func (suite *KeeperTestSuite) exportGenesis() *oracle.GenesisState {
+	genesis, err := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	return genesis
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/oracle"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the oracle MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) oracle.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ oracle.MsgServer = msgServer{}
+
+// AggregateExchangeRateVote submits the prices voted by a validator.
+func (k msgServer) AggregateExchangeRateVote(goCtx context.Context, msg *oracle.MsgAggregateExchangeRateVote) (*oracle.MsgAggregateExchangeRateVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SubmitVote(ctx, val, msg.ExchangeRates); err != nil {
+		return nil, err
+	}
+
+	return &oracle.MsgAggregateExchangeRateVoteResponse{}, nil
+}
+
+// UpdateParams updates the parameters of the module.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *oracle.MsgUpdateParams) (*oracle.MsgUpdateParamsResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected authority %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	return &oracle.MsgUpdateParamsResponse{}, nil
+}
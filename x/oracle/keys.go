@@ -0,0 +1,60 @@
+package oracle
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "oracle"
+
+	// StoreKey is the store key string for oracle
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for oracle
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for oracle
+	QuerierRoute = ModuleName
+)
+
+// Keys for oracle store
+// Items are stored with the following key: values
+//
+// - 0x00: Params
+//
+// - 0x01<denom_Bytes>: Price
+//
+// - 0x02<validator_Bytes>: AggregateVote
+//
+// - 0x03<validator_Bytes>: MissCounter
+//
+// - 0x04<validator_Bytes>: SlashEvent
+var (
+	ParamsKey              = []byte{0x00}
+	PriceKeyPrefix         = []byte{0x01}
+	AggregateVoteKeyPrefix = []byte{0x02}
+	MissCounterKeyPrefix   = []byte{0x03}
+	SlashEventKeyPrefix    = []byte{0x04}
+)
+
+// PriceKey returns the key of the price of a denom.
+func PriceKey(denom string) []byte {
+	return append(PriceKeyPrefix, []byte(denom)...)
+}
+
+// AggregateVoteKey returns the key of the vote of a validator.
+func AggregateVoteKey(val sdk.ValAddress) []byte {
+	return append(AggregateVoteKeyPrefix, address.MustLengthPrefix(val)...)
+}
+
+// MissCounterKey returns the key of the miss counter of a validator.
+func MissCounterKey(val sdk.ValAddress) []byte {
+	return append(MissCounterKeyPrefix, address.MustLengthPrefix(val)...)
+}
+
+// SlashEventKey returns the key of the queued slash of a validator.
+func SlashEventKey(val sdk.ValAddress) []byte {
+	return append(SlashEventKeyPrefix, address.MustLengthPrefix(val)...)
+}
@@ -0,0 +1,26 @@
+package module
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle"
+	"github.com/cosmos/cosmos-sdk/x/oracle/keeper"
+)
+
+// BeginBlocker slashes and jails the validators which missed too many votes
+// in the last slash window.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(oracle.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.ApplySlashEvents(ctx)
+}
+
+// EndBlocker aggregates the votes of the voting window ending at the current
+// block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(oracle.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.EndVotingWindow(ctx)
+}
@@ -0,0 +1,82 @@
+package oracle
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgAggregateExchangeRateVote{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgAggregateExchangeRateVote creates a new MsgAggregateExchangeRateVote
+// instance.
+func NewMsgAggregateExchangeRateVote(val sdk.ValAddress, rates []ExchangeRate) *MsgAggregateExchangeRateVote {
+	return &MsgAggregateExchangeRateVote{
+		Validator:     val.String(),
+		ExchangeRates: rates,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAggregateExchangeRateVote) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.Validator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	return validateExchangeRates(msg.ExchangeRates)
+}
+
+// GetSigners implements the sdk.Msg interface. The vote is signed by the
+// operator account of the validator.
+func (msg MsgAggregateExchangeRateVote) GetSigners() []sdk.AccAddress {
+	val, _ := sdk.ValAddressFromBech32(msg.Validator)
+	return []sdk.AccAddress{sdk.AccAddress(val)}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgAggregateExchangeRateVote) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgAggregateExchangeRateVote) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgAggregateExchangeRateVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgUpdateParams) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgUpdateParams) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
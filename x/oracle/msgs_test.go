@@ -0,0 +1,97 @@
+package oracle_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle"
+)
+
+var valAddr = sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+func rate(denom string, r int64) oracle.ExchangeRate {
+	return oracle.ExchangeRate{Denom: denom, Rate: sdk.NewDec(r)}
+}
+
+func TestMsgAggregateExchangeRateVoteValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *oracle.MsgAggregateExchangeRateVote
+		expErr bool
+	}{
+		{"valid", oracle.NewMsgAggregateExchangeRateVote(valAddr, []oracle.ExchangeRate{rate("uatom", 10), rate("ueth", 2)}), false},
+		{"empty validator", oracle.NewMsgAggregateExchangeRateVote(nil, []oracle.ExchangeRate{rate("uatom", 10)}), true},
+		{"no rates", oracle.NewMsgAggregateExchangeRateVote(valAddr, nil), true},
+		{"zero rate", oracle.NewMsgAggregateExchangeRateVote(valAddr, []oracle.ExchangeRate{rate("uatom", 0)}), true},
+		{"negative rate", oracle.NewMsgAggregateExchangeRateVote(valAddr, []oracle.ExchangeRate{rate("uatom", -1)}), true},
+		{"invalid denom", oracle.NewMsgAggregateExchangeRateVote(valAddr, []oracle.ExchangeRate{rate("1", 10)}), true},
+		{"duplicate denom", oracle.NewMsgAggregateExchangeRateVote(valAddr, []oracle.ExchangeRate{rate("uatom", 10), rate("uatom", 1)}), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	msg := oracle.NewMsgAggregateExchangeRateVote(valAddr, nil)
+	require.Equal(t, []sdk.AccAddress{sdk.AccAddress(valAddr)}, msg.GetSigners())
+}
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, oracle.DefaultParams().Validate())
+
+	testCases := []struct {
+		name     string
+		malleate func(p *oracle.Params)
+	}{
+		{"zero vote period", func(p *oracle.Params) { p.VotePeriod = 0 }},
+		{"zero vote threshold", func(p *oracle.Params) { p.VoteThreshold = sdk.ZeroDec() }},
+		{"vote threshold above one", func(p *oracle.Params) { p.VoteThreshold = sdk.NewDec(2) }},
+		{"invalid denom", func(p *oracle.Params) { p.Whitelist = []string{"1"} }},
+		{"duplicate denom", func(p *oracle.Params) { p.Whitelist = []string{"uatom", "uatom"} }},
+		{"slash window not a multiple", func(p *oracle.Params) { p.SlashWindow = p.VotePeriod + 1 }},
+		{"negative min valid per window", func(p *oracle.Params) { p.MinValidPerWindow = sdk.NewDec(-1) }},
+		{"slash fraction above one", func(p *oracle.Params) { p.SlashFraction = sdk.NewDec(2) }},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			p := oracle.DefaultParams()
+			tc.malleate(&p)
+			require.Error(t, p.Validate())
+		})
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, oracle.ValidateGenesis(*oracle.DefaultGenesisState()))
+
+	gs := oracle.DefaultGenesisState()
+	gs.Prices = []oracle.Price{{Denom: "uatom", Rate: sdk.NewDec(1), Height: 1}}
+	gs.AggregateVotes = []oracle.AggregateVote{{Validator: valAddr.String(), ExchangeRates: []oracle.ExchangeRate{rate("uatom", 1)}}}
+	gs.MissCounters = []oracle.MissCounter{{Validator: valAddr.String(), Misses: 1}}
+	gs.SlashEvents = []oracle.SlashEvent{{Validator: valAddr.String(), Height: 10, Misses: 1}}
+	require.NoError(t, oracle.ValidateGenesis(*gs))
+
+	gs.Prices = append(gs.Prices, gs.Prices[0])
+	require.Error(t, oracle.ValidateGenesis(*gs))
+	gs.Prices = gs.Prices[:1]
+
+	gs.MissCounters = append(gs.MissCounters, gs.MissCounters[0])
+	require.Error(t, oracle.ValidateGenesis(*gs))
+	gs.MissCounters = gs.MissCounters[:1]
+
+	gs.SlashEvents[0].Validator = "invalid"
+	require.Error(t, oracle.ValidateGenesis(*gs))
+}
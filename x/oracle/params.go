@@ -0,0 +1,98 @@
+package oracle
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Default parameter values
+const (
+	DefaultVotePeriod  uint64 = 10
+	DefaultSlashWindow uint64 = 10_000
+)
+
+// Default parameter values
+var (
+	DefaultVoteThreshold     = sdk.NewDecWithPrec(5, 1)
+	DefaultMinValidPerWindow = sdk.NewDecWithPrec(5, 2)
+	DefaultSlashFraction     = sdk.NewDecWithPrec(1, 4)
+)
+
+// NewParams creates a new Params instance
+func NewParams(
+	votePeriod uint64, voteThreshold sdk.Dec, whitelist []string, slashWindow uint64, minValidPerWindow, slashFraction sdk.Dec,
+) Params {
+	return Params{
+		VotePeriod:        votePeriod,
+		VoteThreshold:     voteThreshold,
+		Whitelist:         whitelist,
+		SlashWindow:       slashWindow,
+		MinValidPerWindow: minValidPerWindow,
+		SlashFraction:     slashFraction,
+	}
+}
+
+// DefaultParams returns a default set of parameters. No denom is whitelisted.
+func DefaultParams() Params {
+	return NewParams(
+		DefaultVotePeriod, DefaultVoteThreshold, nil, DefaultSlashWindow, DefaultMinValidPerWindow, DefaultSlashFraction,
+	)
+}
+
+// Validate performs basic validation on the parameters.
+func (p Params) Validate() error {
+	if p.VotePeriod == 0 {
+		return fmt.Errorf("vote period must be positive")
+	}
+	if err := validateFraction("vote threshold", p.VoteThreshold); err != nil {
+		return err
+	}
+	if !p.VoteThreshold.IsPositive() {
+		return fmt.Errorf("vote threshold must be positive")
+	}
+
+	seen := make(map[string]bool, len(p.Whitelist))
+	for _, denom := range p.Whitelist {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid whitelisted denom: %w", err)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate whitelisted denom %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	if p.SlashWindow == 0 || p.SlashWindow%p.VotePeriod != 0 {
+		return fmt.Errorf("slash window %d must be a positive multiple of the vote period %d", p.SlashWindow, p.VotePeriod)
+	}
+	if err := validateFraction("min valid per window", p.MinValidPerWindow); err != nil {
+		return err
+	}
+
+	return validateFraction("slash fraction", p.SlashFraction)
+}
+
+// IsWhitelisted returns true if validators vote prices for the denom.
+func (p Params) IsWhitelisted(denom string) bool {
+	for _, d := range p.Whitelist {
+		if d == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// VotePeriodsPerWindow returns the number of voting windows in a slash window.
+func (p Params) VotePeriodsPerWindow() uint64 {
+	return p.SlashWindow / p.VotePeriod
+}
+
+func validateFraction(name string, d sdk.Dec) error {
+	if d.IsNil() || d.IsNegative() || d.GT(sdk.OneDec()) {
+		return fmt.Errorf("%s must be between 0 and 1: %s", name, d)
+	}
+
+	return nil
+}
@@ -19,7 +19,10 @@ func NewQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(NewQuerySubspaceParamsCmd())
+	cmd.AddCommand(
+		NewQuerySubspaceParamsCmd(),
+		NewQueryFrozenParamsCmd(),
+	)
 
 	return cmd
 }
@@ -52,3 +55,31 @@ func NewQuerySubspaceParamsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewQueryFrozenParamsCmd returns a CLI command handler for querying the
+// parameters which have been frozen.
+func NewQueryFrozenParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen",
+		Short: "Query for the parameters which have been frozen and can no longer be changed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := proposal.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FrozenParams(cmd.Context(), &proposal.QueryFrozenParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,19 @@
+package params
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/keeper"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+// InitGenesis freezes the frozen parameters of the genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *proposal.GenesisState) {
+	if err := k.FreezeParams(ctx, data.FrozenParams); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *proposal.GenesisState {
+	return proposal.NewGenesisState(k.GetAllFrozenParams(ctx))
+}
@@ -0,0 +1,33 @@
+package params_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestImportExportGenesis(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	require.Equal(t, proposal.DefaultGenesisState(), params.ExportGenesis(ctx, app.ParamsKeeper))
+
+	genesis := proposal.NewGenesisState([]proposal.FrozenParam{
+		proposal.NewFrozenParam(stakingtypes.ModuleName, string(stakingtypes.KeyBondDenom)),
+		proposal.NewFrozenParam(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators)),
+	})
+	params.InitGenesis(ctx, app.ParamsKeeper, genesis)
+	require.Equal(t, genesis, params.ExportGenesis(ctx, app.ParamsKeeper))
+
+	require.Panics(t, func() {
+		params.InitGenesis(ctx, app.ParamsKeeper, proposal.NewGenesisState([]proposal.FrozenParam{
+			proposal.NewFrozenParam(stakingtypes.ModuleName, "UnknownKey"),
+		}))
+	})
+}
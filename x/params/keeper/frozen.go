@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+// FreezeParams permanently freezes parameters of registered subspaces, so that
+// their current value can no longer be changed. Either all the parameters are
+// frozen or, if one of them is unknown, none of them.
+func (k Keeper) FreezeParams(ctx sdk.Context, params []proposal.FrozenParam) error {
+	spaces := make([]types.Subspace, len(params))
+	for i, p := range params {
+		ss, ok := k.GetSubspace(p.Subspace)
+		if !ok {
+			return sdkerrors.Wrap(proposal.ErrUnknownSubspace, p.Subspace)
+		}
+		spaces[i] = ss
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	for i, p := range params {
+		if err := spaces[i].Freeze(cacheCtx, []byte(p.Key)); err != nil {
+			return sdkerrors.Wrapf(proposal.ErrFreezingParam, "subspace: %s, key: %s, err: %s", p.Subspace, p.Key, err.Error())
+		}
+
+		k.Logger(ctx).Info("parameter frozen", "subspace", p.Subspace, "key", p.Key)
+	}
+	write()
+
+	return nil
+}
+
+// IsFrozen returns true if the parameter of the given subspace has been frozen.
+func (k Keeper) IsFrozen(ctx sdk.Context, subspace, key string) bool {
+	return ctx.KVStore(k.key).Has(types.FrozenParamKey([]byte(subspace), []byte(key)))
+}
+
+// IterateFrozenParams iterates over all the frozen parameters, ordered by
+// subspace and key, and performs a callback function.
+func (k Keeper) IterateFrozenParams(ctx sdk.Context, cb func(param proposal.FrozenParam) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.FrozenParamsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.FrozenParamsKeyPrefix):]
+
+		// subspace names never contain a slash
+		i := bytes.IndexByte(key, '/')
+		if i < 0 {
+			panic("invalid frozen parameter key")
+		}
+
+		if cb(proposal.NewFrozenParam(string(key[:i]), string(key[i+1:]))) {
+			break
+		}
+	}
+}
+
+// GetAllFrozenParams returns all the frozen parameters.
+func (k Keeper) GetAllFrozenParams(ctx sdk.Context) (params []proposal.FrozenParam) {
+	k.IterateFrozenParams(ctx, func(param proposal.FrozenParam) bool {
+		params = append(params, param)
+		return false
+	})
+
+	return params
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/params/keeper"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestFreezeParams() {
+	bondDenom := proposal.NewFrozenParam(stakingtypes.ModuleName, string(stakingtypes.KeyBondDenom))
+	sendEnabled := proposal.NewFrozenParam(banktypes.ModuleName, string(banktypes.KeyDefaultSendEnabled))
+
+	// unknown parameters aren't frozen, and neither are the other ones
+	err := suite.app.ParamsKeeper.FreezeParams(suite.ctx, []proposal.FrozenParam{
+		bondDenom, proposal.NewFrozenParam(stakingtypes.ModuleName, "UnknownKey"),
+	})
+	suite.Require().ErrorIs(err, proposal.ErrFreezingParam)
+	err = suite.app.ParamsKeeper.FreezeParams(suite.ctx, []proposal.FrozenParam{
+		bondDenom, proposal.NewFrozenParam("unknown", string(stakingtypes.KeyBondDenom)),
+	})
+	suite.Require().ErrorIs(err, proposal.ErrUnknownSubspace)
+	suite.Require().False(suite.app.ParamsKeeper.IsFrozen(suite.ctx, bondDenom.Subspace, bondDenom.Key))
+	suite.Require().Empty(suite.app.ParamsKeeper.GetAllFrozenParams(suite.ctx))
+
+	suite.Require().NoError(suite.app.ParamsKeeper.FreezeParams(suite.ctx, []proposal.FrozenParam{bondDenom, sendEnabled}))
+	suite.Require().True(suite.app.ParamsKeeper.IsFrozen(suite.ctx, bondDenom.Subspace, bondDenom.Key))
+	suite.Require().Equal(
+		[]proposal.FrozenParam{sendEnabled, bondDenom},
+		suite.app.ParamsKeeper.GetAllFrozenParams(suite.ctx),
+	)
+
+	// the module keepers can no longer change the frozen parameters
+	params := suite.app.StakingKeeper.GetParams(suite.ctx)
+	params.MaxValidators++
+	suite.Require().NotPanics(func() { suite.app.StakingKeeper.SetParams(suite.ctx, params) })
+	params.BondDenom = "frozen"
+	suite.Require().Panics(func() { suite.app.StakingKeeper.SetParams(suite.ctx, params) })
+	suite.Require().Equal(sdk.DefaultBondDenom, suite.app.StakingKeeper.BondDenom(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestMsgFreezeParams() {
+	msgServer := keeper.NewMsgServerImpl(suite.app.ParamsKeeper)
+	bondDenom := proposal.NewFrozenParam(stakingtypes.ModuleName, string(stakingtypes.KeyBondDenom))
+
+	_, err := msgServer.FreezeParams(sdk.WrapSDKContext(suite.ctx), &proposal.MsgFreezeParams{
+		Authority: sdk.AccAddress("unauthorized________").String(),
+		Params:    []proposal.FrozenParam{bondDenom},
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().False(suite.app.ParamsKeeper.IsFrozen(suite.ctx, bondDenom.Subspace, bondDenom.Key))
+
+	_, err = msgServer.FreezeParams(sdk.WrapSDKContext(suite.ctx), &proposal.MsgFreezeParams{
+		Authority: suite.app.ParamsKeeper.GetAuthority(),
+		Params:    []proposal.FrozenParam{bondDenom},
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.ParamsKeeper.IsFrozen(suite.ctx, bondDenom.Subspace, bondDenom.Key))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryFrozenParams() {
+	res, err := suite.queryClient.FrozenParams(sdk.WrapSDKContext(suite.ctx), &proposal.QueryFrozenParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Params)
+
+	frozen := []proposal.FrozenParam{proposal.NewFrozenParam(stakingtypes.ModuleName, string(stakingtypes.KeyBondDenom))}
+	suite.Require().NoError(suite.app.ParamsKeeper.FreezeParams(suite.ctx, frozen))
+
+	res, err = suite.queryClient.FrozenParams(sdk.WrapSDKContext(suite.ctx), &proposal.QueryFrozenParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(frozen, res.Params)
+}
@@ -67,3 +67,14 @@ func (k Keeper) Subspaces(
 
 	return resp, nil
 }
+
+// FrozenParams implements the gRPC query handler for fetching all the frozen
+// parameters.
+func (k Keeper) FrozenParams(goCtx context.Context, req *proposal.QueryFrozenParamsRequest) (*proposal.QueryFrozenParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &proposal.QueryFrozenParamsResponse{Params: k.GetAllFrozenParams(ctx)}, nil
+}
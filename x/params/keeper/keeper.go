@@ -16,16 +16,21 @@ type Keeper struct {
 	key         sdk.StoreKey
 	tkey        sdk.StoreKey
 	spaces      map[string]*types.Subspace
+
+	// the address capable of freezing parameters, usually the gov module
+	// account
+	authority string
 }
 
 // NewKeeper constructs a params keeper
-func NewKeeper(cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey sdk.StoreKey) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey sdk.StoreKey, authority string) Keeper {
 	return Keeper{
 		cdc:         cdc,
 		legacyAmino: legacyAmino,
 		key:         key,
 		tkey:        tkey,
 		spaces:      make(map[string]*types.Subspace),
+		authority:   authority,
 	}
 }
 
@@ -34,6 +39,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+proposal.ModuleName)
 }
 
+// GetAuthority returns the params module authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Allocate subspace used for keepers
 func (k Keeper) Subspace(s string) types.Subspace {
 	_, ok := k.spaces[s]
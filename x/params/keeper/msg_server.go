@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the params MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) proposal.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ proposal.MsgServer = msgServer{}
+
+// FreezeParams freezes parameters, so that no later proposal can change them.
+func (k msgServer) FreezeParams(goCtx context.Context, msg *proposal.MsgFreezeParams) (*proposal.MsgFreezeParamsResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected authority %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.FreezeParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &proposal.MsgFreezeParamsResponse{}, nil
+}
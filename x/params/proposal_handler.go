@@ -23,13 +23,35 @@ func NewParamChangeProposalHandler(k keeper.Keeper) govtypes.Handler {
 	}
 }
 
-func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p *proposal.ParameterChangeProposal) error {
-	for _, c := range p.Changes {
+// ValidateParameterChanges checks that every change targets a registered
+// subspace and key and that the new value passes the parameter's validation
+// function, without writing anything to state. The first failing change is
+// reported.
+func ValidateParameterChanges(ctx sdk.Context, k keeper.Keeper, changes []proposal.ParamChange) error {
+	for _, c := range changes {
 		ss, ok := k.GetSubspace(c.Subspace)
 		if !ok {
 			return sdkerrors.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
 		}
 
+		if err := ss.ValidateUpdate(ctx, []byte(c.Key), []byte(c.Value)); err != nil {
+			return sdkerrors.Wrapf(proposal.ErrInvalidParameter, "subspace: %s, key: %s, value: %s, err: %s", c.Subspace, c.Key, c.Value, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p *proposal.ParameterChangeProposal) error {
+	// validate all changes up front so that a proposal is either applied in
+	// full or rejected with the reason of the first offending change
+	if err := ValidateParameterChanges(ctx, k, p.Changes); err != nil {
+		return err
+	}
+
+	for _, c := range p.Changes {
+		ss, _ := k.GetSubspace(c.Subspace)
+
 		k.Logger(ctx).Info(
 			fmt.Sprintf("attempt to set new parameter value; key: %s, value: %s", c.Key, c.Value),
 		)
@@ -59,6 +59,33 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 			func() {},
 			true,
 		},
+		{
+			"unknown key",
+			testProposal(proposal.NewParamChange(stakingtypes.ModuleName, "UnknownKey", "1")),
+			func() {},
+			true,
+		},
+		{
+			"unknown subspace",
+			testProposal(proposal.NewParamChange("unknown", string(stakingtypes.KeyMaxValidators), "1")),
+			func() {},
+			true,
+		},
+		{
+			"partially invalid changes are not applied",
+			testProposal(
+				proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "7"),
+				proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxEntries), "-"),
+			),
+			func() {},
+			true,
+		},
+		{
+			"frozen parameter",
+			testProposal(proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxEntries), "1")),
+			func() {},
+			true,
+		},
 		{
 			"omit empty fields",
 			testProposal(proposal.ParamChange{
@@ -69,20 +96,31 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 			func() {
 				depositParams := suite.app.GovKeeper.GetDepositParams(suite.ctx)
 				suite.Require().Equal(govtypes.DepositParams{
-					MinDeposit:       sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(64000000))),
-					MaxDepositPeriod: govtypes.DefaultPeriod,
+					MinDeposit:                 sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(64000000))),
+					MaxDepositPeriod:           govtypes.DefaultPeriod,
+					CancelBurnRatio:            govtypes.DefaultCancelBurnRatio,
+					MinInitialDepositRatio:     govtypes.DefaultMinInitialDepositRatio,
+					BurnVoteVeto:               true,
+					BurnVoteQuorum:             true,
+					BurnProposalDepositPrevote: true,
 				}, depositParams)
 			},
 			false,
 		},
 	}
 
+	suite.Require().NoError(suite.app.ParamsKeeper.FreezeParams(suite.ctx, []proposal.FrozenParam{
+		proposal.NewFrozenParam(stakingtypes.ModuleName, string(stakingtypes.KeyMaxEntries)),
+	}))
+
 	for _, tc := range testCases {
 		tc := tc
 		suite.Run(tc.name, func() {
+			maxVals := suite.app.StakingKeeper.MaxValidators(suite.ctx)
 			err := suite.govHandler(suite.ctx, tc.proposal)
 			if tc.expErr {
 				suite.Require().Error(err)
+				suite.Require().Equal(maxVals, suite.app.StakingKeeper.MaxValidators(suite.ctx))
 			} else {
 				suite.Require().NoError(err)
 				tc.onHandle()
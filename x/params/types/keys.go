@@ -7,3 +7,22 @@ const (
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
 )
+
+// FrozenParamsKeyPrefix prefixes the records of the frozen parameters in the
+// param store. The subspaces are stored under their name, which can't start
+// with this byte.
+var FrozenParamsKeyPrefix = []byte{0x00}
+
+// FrozenParamKey returns the key of the record of a frozen parameter:
+// 0x00 | subspace | / | key
+func FrozenParamKey(subspace, key []byte) []byte {
+	return append(FrozenParamSubspaceKey(subspace), key...)
+}
+
+// FrozenParamSubspaceKey returns the prefix of the records of the frozen
+// parameters of a subspace.
+func FrozenParamSubspaceKey(subspace []byte) []byte {
+	bz := append([]byte{}, FrozenParamsKeyPrefix...)
+	bz = append(bz, subspace...)
+	return append(bz, '/')
+}
@@ -12,4 +12,8 @@ var (
 	ErrEmptySubspace    = sdkerrors.Register(ModuleName, 5, "parameter subspace is empty")
 	ErrEmptyKey         = sdkerrors.Register(ModuleName, 6, "parameter key is empty")
 	ErrEmptyValue       = sdkerrors.Register(ModuleName, 7, "parameter value is empty")
+	ErrInvalidParameter = sdkerrors.Register(ModuleName, 8, "invalid parameter change")
+	ErrEmptyFrozen      = sdkerrors.Register(ModuleName, 9, "parameters to freeze are empty")
+	ErrDuplicateFrozen  = sdkerrors.Register(ModuleName, 10, "duplicate frozen parameter")
+	ErrFreezingParam    = sdkerrors.Register(ModuleName, 11, "failed to freeze parameter")
 )
@@ -0,0 +1,31 @@
+package proposal
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewFrozenParam creates a new FrozenParam instance
+func NewFrozenParam(subspace, key string) FrozenParam {
+	return FrozenParam{subspace, key}
+}
+
+// ValidateFrozenParams performs basic validation checks over a set of
+// FrozenParam. It returns an error if any FrozenParam is empty or if a
+// parameter is listed more than once.
+func ValidateFrozenParams(params []FrozenParam) error {
+	seen := make(map[FrozenParam]bool, len(params))
+	for _, p := range params {
+		if len(p.Subspace) == 0 {
+			return ErrEmptySubspace
+		}
+		if len(p.Key) == 0 {
+			return ErrEmptyKey
+		}
+		if seen[p] {
+			return sdkerrors.Wrapf(ErrDuplicateFrozen, "subspace: %s, key: %s", p.Subspace, p.Key)
+		}
+		seen[p] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package proposal
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(frozenParams []FrozenParam) *GenesisState {
+	return &GenesisState{
+		FrozenParams: frozenParams,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the params module,
+// in which no parameter is frozen.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(nil)
+}
+
+// ValidateGenesis checks that the frozen parameters are neither empty nor
+// duplicated.
+func ValidateGenesis(data GenesisState) error {
+	return ValidateFrozenParams(data.FrozenParams)
+}
@@ -0,0 +1,52 @@
+package proposal
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgFreezeParams{}
+
+// NewMsgFreezeParams creates a new MsgFreezeParams instance
+//nolint:interfacer
+func NewMsgFreezeParams(authority sdk.AccAddress, params []FrozenParam) *MsgFreezeParams {
+	return &MsgFreezeParams{
+		Authority: authority.String(),
+		Params:    params,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgFreezeParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if len(msg.Params) == 0 {
+		return ErrEmptyFrozen
+	}
+
+	return ValidateFrozenParams(msg.Params)
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgFreezeParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgFreezeParams) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgFreezeParams) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgFreezeParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
@@ -0,0 +1,38 @@
+package proposal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgFreezeParamsValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________")
+	param := NewFrozenParam("staking", "BondDenom")
+
+	testCases := []struct {
+		name    string
+		msg     *MsgFreezeParams
+		expPass bool
+	}{
+		{"valid", NewMsgFreezeParams(authority, []FrozenParam{param}), true},
+		{"invalid authority", &MsgFreezeParams{Authority: "invalid", Params: []FrozenParam{param}}, false},
+		{"no params", NewMsgFreezeParams(authority, nil), false},
+		{"empty subspace", NewMsgFreezeParams(authority, []FrozenParam{NewFrozenParam("", "BondDenom")}), false},
+		{"empty key", NewMsgFreezeParams(authority, []FrozenParam{NewFrozenParam("staking", "")}), false},
+		{"duplicate param", NewMsgFreezeParams(authority, []FrozenParam{param, param}), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
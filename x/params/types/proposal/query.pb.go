@@ -268,44 +268,136 @@ func (m *Subspace) GetKeys() []string {
 	return nil
 }
 
+// QueryFrozenParamsRequest is request type for the Query/FrozenParams RPC
+// method.
+type QueryFrozenParamsRequest struct {
+}
+
+func (m *QueryFrozenParamsRequest) Reset()         { *m = QueryFrozenParamsRequest{} }
+func (m *QueryFrozenParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenParamsRequest) ProtoMessage()    {}
+func (*QueryFrozenParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{5}
+}
+func (m *QueryFrozenParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenParamsRequest.Merge(m, src)
+}
+func (m *QueryFrozenParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenParamsRequest proto.InternalMessageInfo
+
+// QueryFrozenParamsResponse is response type for the Query/FrozenParams RPC
+// method.
+type QueryFrozenParamsResponse struct {
+	// params are the frozen parameters.
+	Params []FrozenParam `protobuf:"bytes,1,rep,name=params,proto3" json:"params"`
+}
+
+func (m *QueryFrozenParamsResponse) Reset()         { *m = QueryFrozenParamsResponse{} }
+func (m *QueryFrozenParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenParamsResponse) ProtoMessage()    {}
+func (*QueryFrozenParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{6}
+}
+func (m *QueryFrozenParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenParamsResponse.Merge(m, src)
+}
+func (m *QueryFrozenParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenParamsResponse proto.InternalMessageInfo
+
+func (m *QueryFrozenParamsResponse) GetParams() []FrozenParam {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.params.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.params.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QuerySubspacesRequest)(nil), "cosmos.params.v1beta1.QuerySubspacesRequest")
 	proto.RegisterType((*QuerySubspacesResponse)(nil), "cosmos.params.v1beta1.QuerySubspacesResponse")
 	proto.RegisterType((*Subspace)(nil), "cosmos.params.v1beta1.Subspace")
+	proto.RegisterType((*QueryFrozenParamsRequest)(nil), "cosmos.params.v1beta1.QueryFrozenParamsRequest")
+	proto.RegisterType((*QueryFrozenParamsResponse)(nil), "cosmos.params.v1beta1.QueryFrozenParamsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/params/v1beta1/query.proto", fileDescriptor_2b32979c1792ccc4) }
 
 var fileDescriptor_2b32979c1792ccc4 = []byte{
-	// 415 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4d, 0x8b, 0xd3, 0x40,
-	0x18, 0xce, 0xa4, 0x1f, 0x34, 0xd3, 0x8b, 0x8c, 0x56, 0x43, 0xd0, 0x34, 0x0e, 0x08, 0x51, 0x6c,
-	0x86, 0x56, 0x4f, 0x82, 0x1e, 0xea, 0x5d, 0x34, 0x22, 0x82, 0xb7, 0x49, 0x1d, 0xd2, 0xd2, 0x36,
-	0x33, 0xcd, 0x24, 0x62, 0xae, 0x1e, 0x3c, 0x2f, 0xbb, 0xbf, 0x61, 0xff, 0x4b, 0x8f, 0x85, 0xbd,
-	0xec, 0x69, 0x59, 0xda, 0xfd, 0x21, 0x4b, 0x27, 0x49, 0x97, 0xed, 0xb6, 0xa5, 0xa7, 0xbc, 0x79,
-	0xe7, 0xf9, 0x9a, 0x27, 0x81, 0x2f, 0x07, 0x5c, 0x4e, 0xb9, 0x24, 0x82, 0xc6, 0x74, 0x2a, 0xc9,
-	0x9f, 0x6e, 0xc0, 0x12, 0xda, 0x25, 0xb3, 0x94, 0xc5, 0x99, 0x27, 0x62, 0x9e, 0x70, 0xd4, 0xca,
-	0x21, 0x5e, 0x0e, 0xf1, 0x0a, 0x88, 0xf5, 0x24, 0xe4, 0x21, 0x57, 0x08, 0xb2, 0x9e, 0x72, 0xb0,
-	0xf5, 0x3c, 0xe4, 0x3c, 0x9c, 0x30, 0x42, 0xc5, 0x88, 0xd0, 0x28, 0xe2, 0x09, 0x4d, 0x46, 0x3c,
-	0x92, 0xc5, 0x29, 0xde, 0xed, 0x56, 0x28, 0x2b, 0x0c, 0xee, 0x43, 0xf4, 0x6d, 0xed, 0xfe, 0x55,
-	0x2d, 0x7d, 0x36, 0x4b, 0x99, 0x4c, 0x90, 0x05, 0x1b, 0x32, 0x0d, 0xa4, 0xa0, 0x03, 0x66, 0x02,
-	0x07, 0xb8, 0x86, 0xbf, 0x79, 0x47, 0x8f, 0x60, 0x65, 0xcc, 0x32, 0x53, 0x57, 0xeb, 0xf5, 0x88,
-	0x7f, 0xc0, 0xc7, 0xf7, 0x34, 0xa4, 0xe0, 0x91, 0x64, 0xe8, 0x13, 0xac, 0x29, 0x2b, 0xa5, 0xd0,
-	0xec, 0x61, 0x6f, 0xe7, 0xcd, 0x3c, 0xc5, 0xfa, 0x3c, 0xa4, 0x51, 0xc8, 0xfa, 0xd5, 0xf9, 0x55,
-	0x5b, 0xf3, 0x73, 0x1a, 0x7e, 0x06, 0x5b, 0x4a, 0xf6, 0x7b, 0xe1, 0x5c, 0xa6, 0xc3, 0x3f, 0xe1,
-	0xd3, 0xed, 0x83, 0xc2, 0xf2, 0x23, 0x34, 0xca, 0x9c, 0xd2, 0x04, 0x4e, 0xc5, 0x6d, 0xf6, 0xda,
-	0x7b, 0x6c, 0x4b, 0xb2, 0x7f, 0xc7, 0xc0, 0x1f, 0x60, 0xa3, 0x5c, 0x1f, 0xac, 0x00, 0xc1, 0xea,
-	0x98, 0x65, 0xd2, 0xd4, 0x9d, 0x8a, 0x6b, 0xf8, 0x6a, 0xee, 0x9d, 0xeb, 0xb0, 0xa6, 0x52, 0xa1,
-	0xff, 0x00, 0xd6, 0xf3, 0x2a, 0xd0, 0xeb, 0x3d, 0xe6, 0x0f, 0x2b, 0xb7, 0xde, 0x1c, 0x03, 0xcd,
-	0xaf, 0x89, 0x5f, 0xfd, 0xbb, 0xb8, 0x39, 0xd3, 0xdb, 0xe8, 0x05, 0x39, 0xf4, 0x85, 0xd1, 0x29,
-	0x80, 0xc6, 0xa6, 0x23, 0xf4, 0xf6, 0x90, 0xc1, 0x76, 0xc7, 0x56, 0xe7, 0x48, 0x74, 0x91, 0xc8,
-	0x55, 0x89, 0x30, 0x72, 0xf6, 0x24, 0xda, 0x74, 0xdc, 0xff, 0x32, 0x5f, 0xda, 0x60, 0xb1, 0xb4,
-	0xc1, 0xf5, 0xd2, 0x06, 0x27, 0x2b, 0x5b, 0x5b, 0xac, 0x6c, 0xed, 0x72, 0x65, 0x6b, 0xbf, 0xde,
-	0x87, 0xa3, 0x64, 0x98, 0x06, 0xde, 0x80, 0x4f, 0x4b, 0x95, 0xfc, 0xd1, 0x91, 0xbf, 0xc7, 0xe4,
-	0x6f, 0x29, 0x99, 0x64, 0x82, 0x49, 0x22, 0x62, 0x2e, 0xb8, 0xa4, 0x93, 0xa0, 0xae, 0xfe, 0xe3,
-	0x77, 0xb7, 0x01, 0x00, 0x00, 0xff, 0xff, 0x69, 0xa9, 0xbc, 0x11, 0x5b, 0x03, 0x00, 0x00,
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcf, 0x8a, 0xd3, 0x40,
+	0x18, 0xef, 0xb4, 0xbb, 0x65, 0xfb, 0xad, 0x07, 0x19, 0x5d, 0x8d, 0x41, 0xd3, 0x3a, 0xa8, 0x54,
+	0xd9, 0xcd, 0xb8, 0xd5, 0x93, 0xa0, 0x48, 0x05, 0x8f, 0xa2, 0x11, 0x11, 0x04, 0x91, 0x69, 0x1d,
+	0xb3, 0xa5, 0xdb, 0xcc, 0x6c, 0x26, 0x11, 0xe3, 0xd1, 0x83, 0x67, 0xd1, 0x07, 0xf0, 0x71, 0xdc,
+	0xe3, 0x82, 0x17, 0x4f, 0x22, 0xad, 0x0f, 0x22, 0x9d, 0x99, 0xd4, 0xdd, 0x6e, 0x12, 0x7a, 0xea,
+	0xf4, 0x9b, 0xdf, 0xbf, 0x7c, 0xbf, 0x04, 0xae, 0x0e, 0x85, 0x9a, 0x08, 0x45, 0x25, 0x8b, 0xd9,
+	0x44, 0xd1, 0xf7, 0xbb, 0x03, 0x9e, 0xb0, 0x5d, 0x7a, 0x90, 0xf2, 0x38, 0xf3, 0x65, 0x2c, 0x12,
+	0x81, 0xb7, 0x0c, 0xc4, 0x37, 0x10, 0xdf, 0x42, 0xdc, 0xf3, 0xa1, 0x08, 0x85, 0x46, 0xd0, 0xf9,
+	0xc9, 0x80, 0xdd, 0xcb, 0xa1, 0x10, 0xe1, 0x3e, 0xa7, 0x4c, 0x8e, 0x28, 0x8b, 0x22, 0x91, 0xb0,
+	0x64, 0x24, 0x22, 0x65, 0x6f, 0x49, 0xb1, 0x9b, 0x55, 0xd6, 0x18, 0xd2, 0x07, 0xfc, 0x6c, 0xee,
+	0xfe, 0x54, 0x0f, 0x03, 0x7e, 0x90, 0x72, 0x95, 0x60, 0x17, 0x36, 0x54, 0x3a, 0x50, 0x92, 0x0d,
+	0xb9, 0x83, 0x3a, 0xa8, 0xdb, 0x0a, 0x16, 0xff, 0xf1, 0x59, 0x68, 0x8c, 0x79, 0xe6, 0xd4, 0xf5,
+	0x78, 0x7e, 0x24, 0x2f, 0xe0, 0xdc, 0x09, 0x0d, 0x25, 0x45, 0xa4, 0x38, 0x7e, 0x00, 0xeb, 0xda,
+	0x4a, 0x2b, 0x6c, 0xf6, 0x88, 0x5f, 0xf8, 0x64, 0xbe, 0x66, 0x3d, 0xda, 0x63, 0x51, 0xc8, 0xfb,
+	0x6b, 0x87, 0xbf, 0xdb, 0xb5, 0xc0, 0xd0, 0xc8, 0x45, 0xd8, 0xd2, 0xb2, 0xcf, 0xad, 0x73, 0x9e,
+	0x8e, 0xbc, 0x84, 0x0b, 0xcb, 0x17, 0xd6, 0xf2, 0x3e, 0xb4, 0xf2, 0x9c, 0xca, 0x41, 0x9d, 0x46,
+	0x77, 0xb3, 0xd7, 0x2e, 0xb1, 0xcd, 0xc9, 0xc1, 0x7f, 0x06, 0xb9, 0x07, 0x1b, 0xf9, 0xb8, 0x72,
+	0x05, 0x18, 0xd6, 0xc6, 0x3c, 0x53, 0x4e, 0xbd, 0xd3, 0xe8, 0xb6, 0x02, 0x7d, 0x26, 0x2e, 0x38,
+	0x3a, 0xd4, 0xe3, 0x58, 0x7c, 0xe4, 0xd1, 0x89, 0x75, 0x92, 0xd7, 0x70, 0xa9, 0xe0, 0xce, 0x66,
+	0x7e, 0x08, 0x4d, 0x13, 0xcd, 0x06, 0x2e, 0xdb, 0xd3, 0x31, 0xb2, 0xdd, 0x93, 0xe5, 0xf5, 0x7e,
+	0x34, 0x60, 0x5d, 0xeb, 0xe3, 0xcf, 0x08, 0x9a, 0x46, 0x1e, 0xdf, 0x2c, 0x91, 0x39, 0xdd, 0xb6,
+	0x7b, 0x6b, 0x15, 0xa8, 0x49, 0x4b, 0xae, 0x7f, 0xfa, 0xf9, 0xf7, 0x5b, 0xbd, 0x8d, 0xaf, 0xd0,
+	0xaa, 0x97, 0x0b, 0x7f, 0x45, 0xd0, 0x5a, 0xd4, 0x83, 0xb7, 0xab, 0x0c, 0x96, 0xeb, 0x75, 0x77,
+	0x56, 0x44, 0xdb, 0x44, 0x5d, 0x9d, 0x88, 0xe0, 0x4e, 0x49, 0xa2, 0x45, 0xbd, 0xf8, 0x3b, 0x82,
+	0x33, 0xc7, 0x2b, 0xc0, 0xb4, 0xca, 0xa9, 0xa0, 0x48, 0xf7, 0xf6, 0xea, 0x04, 0x9b, 0x6e, 0x5b,
+	0xa7, 0xbb, 0x81, 0xaf, 0x95, 0xa4, 0x7b, 0xa7, 0x49, 0x6f, 0xcc, 0xb4, 0xff, 0xe4, 0x70, 0xea,
+	0xa1, 0xa3, 0xa9, 0x87, 0xfe, 0x4c, 0x3d, 0xf4, 0x65, 0xe6, 0xd5, 0x8e, 0x66, 0x5e, 0xed, 0xd7,
+	0xcc, 0xab, 0xbd, 0xba, 0x1b, 0x8e, 0x92, 0xbd, 0x74, 0xe0, 0x0f, 0xc5, 0x24, 0x57, 0x32, 0x3f,
+	0x3b, 0xea, 0xed, 0x98, 0x7e, 0xc8, 0x65, 0x93, 0x4c, 0x72, 0x45, 0x65, 0x2c, 0xa4, 0x50, 0x6c,
+	0x7f, 0xd0, 0xd4, 0x1f, 0xf9, 0x9d, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x53, 0x56, 0xe0, 0x00,
+	0x78, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -325,6 +417,8 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Subspaces queries for all registered subspaces and all keys for a subspace.
 	Subspaces(ctx context.Context, in *QuerySubspacesRequest, opts ...grpc.CallOption) (*QuerySubspacesResponse, error)
+	// FrozenParams queries all the parameters which have been frozen.
+	FrozenParams(ctx context.Context, in *QueryFrozenParamsRequest, opts ...grpc.CallOption) (*QueryFrozenParamsResponse, error)
 }
 
 type queryClient struct {
@@ -353,6 +447,15 @@ func (c *queryClient) Subspaces(ctx context.Context, in *QuerySubspacesRequest,
 	return out, nil
 }
 
+func (c *queryClient) FrozenParams(ctx context.Context, in *QueryFrozenParamsRequest, opts ...grpc.CallOption) (*QueryFrozenParamsResponse, error) {
+	out := new(QueryFrozenParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.params.v1beta1.Query/FrozenParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries a specific parameter of a module, given its subspace and
@@ -360,6 +463,8 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Subspaces queries for all registered subspaces and all keys for a subspace.
 	Subspaces(context.Context, *QuerySubspacesRequest) (*QuerySubspacesResponse, error)
+	// FrozenParams queries all the parameters which have been frozen.
+	FrozenParams(context.Context, *QueryFrozenParamsRequest) (*QueryFrozenParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -372,6 +477,9 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) Subspaces(ctx context.Context, req *QuerySubspacesRequest) (*QuerySubspacesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Subspaces not implemented")
 }
+func (*UnimplementedQueryServer) FrozenParams(ctx context.Context, req *QueryFrozenParamsRequest) (*QueryFrozenParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenParams not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -413,6 +521,24 @@ func _Query_Subspaces_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FrozenParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.params.v1beta1.Query/FrozenParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenParams(ctx, req.(*QueryFrozenParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.params.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -425,6 +551,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Subspaces",
 			Handler:    _Query_Subspaces_Handler,
 		},
+		{
+			MethodName: "FrozenParams",
+			Handler:    _Query_FrozenParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/params/v1beta1/query.proto",
@@ -599,6 +729,66 @@ func (m *Subspace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFrozenParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -681,6 +871,30 @@ func (m *Subspace) Size() (n int) {
 	return n
 }
 
+func (m *QueryFrozenParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFrozenParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1132,6 +1346,140 @@ func (m *Subspace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFrozenParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFrozenParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, FrozenParam{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
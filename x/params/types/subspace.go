@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 	"reflect"
 
@@ -181,8 +182,9 @@ func (s Subspace) checkType(key []byte, value interface{}) {
 }
 
 // Set stores a value for given a parameter key assuming the parameter type has
-// been registered. It will panic if the parameter type has not been registered
-// or if the value cannot be encoded. A change record is also set in the Subspace's
+// been registered. It will panic if the parameter type has not been registered,
+// if the value cannot be encoded or if the parameter is frozen and the value
+// differs from the stored one. A change record is also set in the Subspace's
 // transient KVStore to mark the parameter as modified.
 func (s Subspace) Set(ctx sdk.Context, key []byte, value interface{}) {
 	s.checkType(key, value)
@@ -193,6 +195,14 @@ func (s Subspace) Set(ctx sdk.Context, key []byte, value interface{}) {
 		panic(err)
 	}
 
+	// a frozen parameter can still be set to its current value, e.g. by the
+	// SetParamSet of a module updating its other parameters
+	if s.IsFrozen(ctx, key) {
+		if current := store.Get(key); current != nil && !bytes.Equal(current, bz) {
+			panic(fmt.Sprintf("parameter %s is frozen", string(key)))
+		}
+	}
+
 	store.Set(key, bz)
 
 	tstore := s.transientStore(ctx)
@@ -203,31 +213,63 @@ func (s Subspace) Set(ctx sdk.Context, key []byte, value interface{}) {
 // parameter type has been registered. It will panic if the parameter type has
 // not been registered or if the value cannot be encoded. An error is returned
 // if the raw value is not compatible with the registered type for the parameter
-// key or if the new value is invalid as determined by the registered type's
-// validation function.
+// key, if the new value is invalid as determined by the registered type's
+// validation function or if the parameter is frozen.
 func (s Subspace) Update(ctx sdk.Context, key, value []byte) error {
-	attr, ok := s.table.m[string(key)]
-	if !ok {
+	if _, ok := s.table.m[string(key)]; !ok {
 		panic(fmt.Sprintf("parameter %s not registered", string(key)))
 	}
 
-	ty := attr.ty
+	if s.IsFrozen(ctx, key) {
+		return fmt.Errorf("parameter %s is frozen", string(key))
+	}
+
+	dest, err := s.decodeUpdate(ctx, key, value)
+	if err != nil {
+		return err
+	}
+
+	s.Set(ctx, key, dest)
+	return nil
+}
+
+// ValidateUpdate performs the same decoding and validation as Update without
+// persisting the result. In contrast to Update, an unregistered key results in
+// an error instead of a panic, which makes it safe to use when validating
+// untrusted input such as parameter change proposals.
+func (s Subspace) ValidateUpdate(ctx sdk.Context, key, value []byte) error {
+	if _, ok := s.table.m[string(key)]; !ok {
+		return fmt.Errorf("parameter %s not registered", string(key))
+	}
+
+	if s.IsFrozen(ctx, key) {
+		return fmt.Errorf("parameter %s is frozen", string(key))
+	}
+
+	_, err := s.decodeUpdate(ctx, key, value)
+	return err
+}
+
+// decodeUpdate unmarshals the raw JSON value on top of the currently stored
+// parameter value and validates the result. It returns a pointer to the
+// decoded value. The key must be registered in the key table.
+func (s Subspace) decodeUpdate(ctx sdk.Context, key, value []byte) (interface{}, error) {
+	ty := s.table.m[string(key)].ty
 	dest := reflect.New(ty).Interface()
 	s.GetIfExists(ctx, key, dest)
 
 	if err := s.legacyAmino.UnmarshalJSON(value, dest); err != nil {
-		return err
+		return nil, err
 	}
 
 	// destValue contains the dereferenced value of dest so validation function do
 	// not have to operate on pointers.
 	destValue := reflect.Indirect(reflect.ValueOf(dest)).Interface()
 	if err := s.Validate(ctx, key, destValue); err != nil {
-		return err
+		return nil, err
 	}
 
-	s.Set(ctx, key, dest)
-	return nil
+	return dest, nil
 }
 
 // GetParamSet iterates through each ParamSetPair where for each pair, it will
@@ -257,6 +299,22 @@ func (s Subspace) SetParamSet(ctx sdk.Context, ps ParamSet) {
 	}
 }
 
+// Freeze permanently freezes a parameter, so that its current value can no
+// longer be changed by Set, Update or any update path built upon them.
+func (s Subspace) Freeze(ctx sdk.Context, key []byte) error {
+	if _, ok := s.table.m[string(key)]; !ok {
+		return fmt.Errorf("parameter %s not registered", string(key))
+	}
+
+	ctx.KVStore(s.key).Set(FrozenParamKey(s.name, key), []byte{})
+	return nil
+}
+
+// IsFrozen returns true if the parameter has been frozen.
+func (s Subspace) IsFrozen(ctx sdk.Context, key []byte) bool {
+	return ctx.KVStore(s.key).Has(FrozenParamKey(s.name, key))
+}
+
 // Name returns the name of the Subspace.
 func (s Subspace) Name() string {
 	return string(s.name)
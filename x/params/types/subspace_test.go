@@ -177,6 +177,69 @@ func (suite *SubspaceTestSuite) TestUpdate() {
 	suite.Require().Equal(good, v)
 }
 
+func (suite *SubspaceTestSuite) TestValidateUpdate() {
+	suite.Require().NotPanics(func() {
+		suite.Require().Error(suite.ss.ValidateUpdate(suite.ctx, []byte("invalid_key"), nil))
+	})
+
+	t := time.Hour * 48
+	suite.ss.Set(suite.ctx, keyUnbondingTime, t)
+
+	bad := time.Minute * 5
+	bz, err := suite.amino.MarshalJSON(bad)
+	suite.Require().NoError(err)
+	suite.Require().Error(suite.ss.ValidateUpdate(suite.ctx, keyUnbondingTime, bz))
+
+	good := time.Hour * 360
+	bz, err = suite.amino.MarshalJSON(good)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.ss.ValidateUpdate(suite.ctx, keyUnbondingTime, bz))
+
+	// the stored value must be left untouched
+	var v time.Duration
+	suite.ss.Get(suite.ctx, keyUnbondingTime, &v)
+	suite.Require().Equal(t, v)
+}
+
+func (suite *SubspaceTestSuite) TestFreeze() {
+	suite.Require().Error(suite.ss.Freeze(suite.ctx, []byte("invalid_key")))
+
+	t := time.Hour * 48
+	suite.ss.Set(suite.ctx, keyUnbondingTime, t)
+
+	suite.Require().False(suite.ss.IsFrozen(suite.ctx, keyUnbondingTime))
+	suite.Require().NoError(suite.ss.Freeze(suite.ctx, keyUnbondingTime))
+	suite.Require().True(suite.ss.IsFrozen(suite.ctx, keyUnbondingTime))
+	suite.Require().False(suite.ss.IsFrozen(suite.ctx, keyMaxValidators))
+
+	good := time.Hour * 360
+	bz, err := suite.amino.MarshalJSON(good)
+	suite.Require().NoError(err)
+	suite.Require().Error(suite.ss.ValidateUpdate(suite.ctx, keyUnbondingTime, bz))
+	suite.Require().Error(suite.ss.Update(suite.ctx, keyUnbondingTime, bz))
+
+	// a frozen parameter can be set to its current value only
+	suite.Require().NotPanics(func() {
+		suite.ss.Set(suite.ctx, keyUnbondingTime, t)
+	})
+	suite.Require().Panics(func() {
+		suite.ss.Set(suite.ctx, keyUnbondingTime, good)
+	})
+
+	var v time.Duration
+	suite.ss.Get(suite.ctx, keyUnbondingTime, &v)
+	suite.Require().Equal(t, v)
+
+	// a frozen parameter without a value can be set once, e.g. at genesis
+	suite.Require().NoError(suite.ss.Freeze(suite.ctx, keyMaxValidators))
+	suite.Require().NotPanics(func() {
+		suite.ss.Set(suite.ctx, keyMaxValidators, uint16(10))
+	})
+	suite.Require().Panics(func() {
+		suite.ss.Set(suite.ctx, keyMaxValidators, uint16(20))
+	})
+}
+
 func (suite *SubspaceTestSuite) TestGetParamSet() {
 	a := params{
 		UnbondingTime: time.Hour * 48,
@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/ratelimit"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	ratelimitQueryCmd := &cobra.Command{
+		Use:                        ratelimit.ModuleName,
+		Short:                      "Querying commands for the ratelimit module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	ratelimitQueryCmd.AddCommand(
+		GetCmdQueryQuota(),
+		GetCmdQueryQuotas(),
+		GetCmdQueryParams(),
+	)
+
+	return ratelimitQueryCmd
+}
+
+// GetCmdQueryQuota returns cmd to query for the quota of a limited account.
+func GetCmdQueryQuota() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "quota [address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the outflow limit of an account and the amount it can still send in its current period",
+		Example: fmt.Sprintf("$ %s query %s quota cosmos1...", version.AppName, ratelimit.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := ratelimit.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Quota(cmd.Context(), &ratelimit.QueryQuotaRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Quota)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryQuotas returns cmd to query for the quotas of all the limited
+// accounts.
+func GetCmdQueryQuotas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "quotas",
+		Args:    cobra.NoArgs,
+		Short:   "Query the quotas of all the limited accounts",
+		Example: fmt.Sprintf("$ %s query %s quotas", version.AppName, ratelimit.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := ratelimit.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Quotas(cmd.Context(), &ratelimit.QueryQuotasRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryParams returns cmd to query for the ratelimit parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Args:    cobra.NoArgs,
+		Short:   "Query the current ratelimit parameters",
+		Example: fmt.Sprintf("$ %s query %s params", version.AppName, ratelimit.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := ratelimit.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &ratelimit.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,18 @@
+/*
+Package ratelimit caps the amount designated accounts, usually module accounts
+such as the distribution module account holding the community pool or a
+treasury, can send per period.
+
+The limits are the parameters of the module, updated by the authority with
+MsgUpdateParams. The keeper is consulted by the bank keeper through a send
+restriction, see bank's SendRestrictionFn, before every send of coins: the
+sends of a limited account are rejected once the amount of a limited denom
+sent in the current period would exceed its maximum. A period starts with the
+first send of the account after the end of the previous one.
+
+As the restriction applies to all the sends of an account, it also applies to
+the sends done by its module in the BeginBlocker or EndBlocker, which panic if
+they fail. The limits should only be set on accounts whose module tolerates
+failed sends.
+*/
+package ratelimit
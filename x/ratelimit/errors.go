@@ -0,0 +1,11 @@
+package ratelimit
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/ratelimit module sentinel errors
+var (
+	// ErrOutflowLimitExceeded error if a send exceeds the outflow limit of the sender
+	ErrOutflowLimitExceeded = sdkerrors.Register(ModuleName, 2, "outflow limit exceeded")
+)
@@ -0,0 +1,44 @@
+package ratelimit
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, outflows []Outflow) *GenesisState {
+	return &GenesisState{
+		Params:   params,
+		Outflows: outflows,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the ratelimit
+// module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+// ValidateGenesis checks that the parameters are valid, and that the outflows
+// are valid and belong to distinct limited accounts.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(data.Outflows))
+	for _, outflow := range data.Outflows {
+		if _, found := data.Params.GetLimit(outflow.Address); !found {
+			return fmt.Errorf("outflow of %s which isn't limited", outflow.Address)
+		}
+		if seen[outflow.Address] {
+			return fmt.Errorf("duplicate outflow of %s", outflow.Address)
+		}
+		seen[outflow.Address] = true
+
+		if err := outflow.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid outflow of %s: %w", outflow.Address, err)
+		}
+	}
+
+	return nil
+}
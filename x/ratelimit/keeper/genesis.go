@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ratelimit"
+)
+
+// InitGenesis initializes the ratelimit module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *ratelimit.GenesisState) error {
+	if err := ratelimit.ValidateGenesis(*data); err != nil {
+		return err
+	}
+	k.SetParams(ctx, data.Params)
+
+	for _, outflow := range data.Outflows {
+		k.SetOutflow(ctx, outflow)
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the ratelimit module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) (*ratelimit.GenesisState, error) {
+	var outflows []ratelimit.Outflow
+	k.IterateOutflows(ctx, func(outflow ratelimit.Outflow) bool {
+		outflows = append(outflows, outflow)
+		return false
+	})
+
+	return ratelimit.NewGenesisState(k.GetParams(ctx), outflows), nil
+}
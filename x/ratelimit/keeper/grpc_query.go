@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ratelimit"
+)
+
+var _ ratelimit.QueryServer = Keeper{}
+
+// Quota returns the quota of a limited account.
+func (q Keeper) Quota(c context.Context, req *ratelimit.QueryQuotaRequest) (*ratelimit.QueryQuotaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	quota, found := q.GetQuota(ctx, addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "%s isn't limited", req.Address)
+	}
+
+	return &ratelimit.QueryQuotaResponse{Quota: quota}, nil
+}
+
+// Quotas returns the quotas of all the limited accounts.
+func (q Keeper) Quotas(c context.Context, req *ratelimit.QueryQuotasRequest) (*ratelimit.QueryQuotasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &ratelimit.QueryQuotasResponse{Quotas: q.GetQuotas(ctx)}, nil
+}
+
+// Params returns the parameters of the ratelimit module.
+func (q Keeper) Params(c context.Context, req *ratelimit.QueryParamsRequest) (*ratelimit.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &ratelimit.QueryParamsResponse{Params: q.GetParams(ctx)}, nil
+}
@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ratelimit"
+)
+
+// Keeper manages the outflow limits of the accounts and the amounts they sent
+// in their current period.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey sdk.StoreKey
+
+	// the address capable of updating the parameters, usually the gov module
+	// account
+	authority string
+}
+
+// NewKeeper creates a ratelimit Keeper
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, authority string) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		authority: authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", ratelimit.ModuleName))
+}
+
+// GetAuthority returns the ratelimit module authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the parameters of the ratelimit module.
+func (k Keeper) GetParams(ctx sdk.Context) (params ratelimit.Params) {
+	bz := ctx.KVStore(k.storeKey).Get(ratelimit.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the parameters of the ratelimit module, and deletes the
+// outflows of the accounts which are no longer limited.
+func (k Keeper) SetParams(ctx sdk.Context, params ratelimit.Params) {
+	ctx.KVStore(k.storeKey).Set(ratelimit.ParamsKey, k.cdc.MustMarshal(&params))
+
+	var stale []sdk.AccAddress
+	k.IterateOutflows(ctx, func(outflow ratelimit.Outflow) bool {
+		if _, found := params.GetLimit(outflow.Address); !found {
+			stale = append(stale, mustAccAddress(outflow.Address))
+		}
+		return false
+	})
+
+	for _, addr := range stale {
+		k.DeleteOutflow(ctx, addr)
+	}
+}
+
+// GetOutflow returns the amount sent by an account in its current period.
+func (k Keeper) GetOutflow(ctx sdk.Context, addr sdk.AccAddress) (outflow ratelimit.Outflow, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(ratelimit.OutflowKey(addr))
+	if bz == nil {
+		return outflow, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &outflow)
+	return outflow, true
+}
+
+// SetOutflow sets the amount sent by an account in its current period.
+func (k Keeper) SetOutflow(ctx sdk.Context, outflow ratelimit.Outflow) {
+	addr := mustAccAddress(outflow.Address)
+	ctx.KVStore(k.storeKey).Set(ratelimit.OutflowKey(addr), k.cdc.MustMarshal(&outflow))
+}
+
+// DeleteOutflow deletes the outflow of an account.
+func (k Keeper) DeleteOutflow(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(ratelimit.OutflowKey(addr))
+}
+
+// IterateOutflows iterates over the outflows of the limited accounts.
+func (k Keeper) IterateOutflows(ctx sdk.Context, cb func(outflow ratelimit.Outflow) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), ratelimit.OutflowKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var outflow ratelimit.Outflow
+		k.cdc.MustUnmarshal(iter.Value(), &outflow)
+		if cb(outflow) {
+			break
+		}
+	}
+}
+
+// currentOutflow returns the outflow of an account in the period containing
+// the block time, which is empty if the previous period has ended.
+func (k Keeper) currentOutflow(ctx sdk.Context, limit ratelimit.OutflowLimit) ratelimit.Outflow {
+	addr := mustAccAddress(limit.Address)
+
+	outflow, found := k.GetOutflow(ctx, addr)
+	if !found || !ctx.BlockTime().Before(outflow.PeriodStart.Add(limit.Period)) {
+		return ratelimit.Outflow{Address: limit.Address, PeriodStart: ctx.BlockTime()}
+	}
+
+	return outflow
+}
+
+// SendRestriction implements the bank SendRestrictionFn. It rejects the sends
+// of a limited account exceeding the remaining outflow of its current period,
+// and records the others. The denoms without limit aren't restricted.
+func (k Keeper) SendRestriction(ctx sdk.Context, fromAddr, _ sdk.AccAddress, amt sdk.Coins) error {
+	// the restriction applies to every send, including the fee deductions,
+	// so the unlimited accounts must not pay for reading the parameters
+	params := k.GetParams(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+
+	limit, found := params.GetLimit(fromAddr.String())
+	if !found {
+		return nil
+	}
+
+	outflow := k.currentOutflow(ctx, limit)
+	for _, coin := range amt {
+		max := limit.MaxOutflow.AmountOf(coin.Denom)
+		if max.IsZero() {
+			continue
+		}
+
+		sent := outflow.Amount.AmountOf(coin.Denom).Add(coin.Amount)
+		if sent.GT(max) {
+			return sdkerrors.Wrapf(
+				ratelimit.ErrOutflowLimitExceeded, "%s can't send %s, %s%s already sent of %s%s until %s",
+				limit.Address, coin, outflow.Amount.AmountOf(coin.Denom), coin.Denom, max, coin.Denom,
+				outflow.PeriodStart.Add(limit.Period),
+			)
+		}
+
+		outflow.Amount = outflow.Amount.Add(coin)
+	}
+
+	k.SetOutflow(ctx, outflow)
+	return nil
+}
+
+// GetQuota returns the outflow limit of an account, the amount it sent in its
+// current period and the amount it can still send until the period ends.
+func (k Keeper) GetQuota(ctx sdk.Context, addr sdk.AccAddress) (quota ratelimit.Quota, found bool) {
+	limit, found := k.GetParams(ctx).GetLimit(addr.String())
+	if !found {
+		return quota, false
+	}
+
+	return k.quota(ctx, limit), true
+}
+
+// GetQuotas returns the quotas of all the limited accounts.
+func (k Keeper) GetQuotas(ctx sdk.Context) []ratelimit.Quota {
+	params := k.GetParams(ctx)
+
+	quotas := make([]ratelimit.Quota, 0, len(params.Limits))
+	for _, limit := range params.Limits {
+		quotas = append(quotas, k.quota(ctx, limit))
+	}
+
+	return quotas
+}
+
+func (k Keeper) quota(ctx sdk.Context, limit ratelimit.OutflowLimit) ratelimit.Quota {
+	outflow := k.currentOutflow(ctx, limit)
+
+	// the outflow may exceed a limit lowered during the period
+	remaining := sdk.NewCoins()
+	for _, max := range limit.MaxOutflow {
+		if sent := outflow.Amount.AmountOf(max.Denom); sent.LT(max.Amount) {
+			remaining = remaining.Add(sdk.NewCoin(max.Denom, max.Amount.Sub(sent)))
+		}
+	}
+
+	return ratelimit.Quota{
+		Address:    limit.Address,
+		MaxOutflow: limit.MaxOutflow,
+		Outflow:    outflow.Amount,
+		Remaining:  remaining,
+		PeriodEnd:  outflow.PeriodStart.Add(limit.Period),
+	}
+}
+
+func mustAccAddress(bech32 string) sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(bech32)
+	if err != nil {
+		panic(err)
+	}
+
+	return acc
+}
@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/ratelimit"
+	"github.com/cosmos/cosmos-sdk/x/ratelimit/keeper"
+)
+
+var (
+	blockTime = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	period    = 24 * time.Hour
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app     *simapp.SimApp
+	ctx     sdk.Context
+	addrs   []sdk.AccAddress
+	limited sdk.AccAddress
+	keeper  keeper.Keeper
+	msgSrvr ratelimit.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(suite.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: blockTime})
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(1000))
+	suite.limited = app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	suite.keeper = app.RateLimitKeeper
+	suite.msgSrvr = keeper.NewMsgServerImpl(suite.keeper)
+
+	// the distribution module account can send 100stake per day
+	suite.Require().NoError(testutil.FundModuleAccount(app.BankKeeper, ctx, distrtypes.ModuleName, coins(1000)))
+	suite.keeper.SetParams(ctx, ratelimit.NewParams(ratelimit.NewOutflowLimit(suite.limited, period, coins(100))))
+}
+
+func coins(amt int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amt))
+}
+
+func (suite *KeeperTestSuite) send(amt sdk.Coins) error {
+	return suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, distrtypes.ModuleName, suite.addrs[0], amt)
+}
+
+func (suite *KeeperTestSuite) TestSendRestriction() {
+	suite.Require().NoError(suite.send(coins(60)))
+	suite.Require().NoError(suite.send(coins(40)))
+
+	err := suite.send(coins(1))
+	suite.Require().ErrorIs(err, ratelimit.ErrOutflowLimitExceeded)
+	suite.Require().Equal(coins(1100), suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0]))
+
+	// the denoms without limit aren't restricted
+	other := sdk.NewCoins(sdk.NewInt64Coin("other", 500))
+	suite.Require().NoError(testutil.FundModuleAccount(suite.app.BankKeeper, suite.ctx, distrtypes.ModuleName, other))
+	suite.Require().NoError(suite.send(other))
+
+	// the unlimited accounts aren't restricted
+	suite.Require().NoError(suite.app.BankKeeper.SendCoins(suite.ctx, suite.addrs[0], suite.addrs[1], coins(500)))
+
+	// a new period starts after the end of the previous one
+	suite.ctx = suite.ctx.WithBlockTime(blockTime.Add(period - time.Second))
+	suite.Require().ErrorIs(suite.send(coins(1)), ratelimit.ErrOutflowLimitExceeded)
+
+	suite.ctx = suite.ctx.WithBlockTime(blockTime.Add(period))
+	suite.Require().NoError(suite.send(coins(30)))
+
+	outflow, found := suite.keeper.GetOutflow(suite.ctx, suite.limited)
+	suite.Require().True(found)
+	suite.Require().Equal(blockTime.Add(period), outflow.PeriodStart)
+	suite.Require().Equal(coins(30), outflow.Amount)
+
+	// the restriction also applies to multi sends
+	suite.Require().NoError(suite.app.BankKeeper.SendCoins(suite.ctx, suite.addrs[1], suite.limited, coins(100)))
+	err = suite.app.BankKeeper.InputOutputCoins(suite.ctx,
+		[]banktypes.Input{{Address: suite.limited.String(), Coins: coins(80)}},
+		[]banktypes.Output{{Address: suite.addrs[0].String(), Coins: coins(80)}},
+	)
+	suite.Require().ErrorIs(err, ratelimit.ErrOutflowLimitExceeded)
+}
+
+func (suite *KeeperTestSuite) TestQuota() {
+	_, err := suite.keeper.Quota(sdk.WrapSDKContext(suite.ctx), &ratelimit.QueryQuotaRequest{Address: suite.addrs[0].String()})
+	suite.Require().Error(err)
+
+	suite.Require().NoError(suite.send(coins(60)))
+
+	res, err := suite.keeper.Quota(sdk.WrapSDKContext(suite.ctx), &ratelimit.QueryQuotaRequest{Address: suite.limited.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(ratelimit.Quota{
+		Address:    suite.limited.String(),
+		MaxOutflow: coins(100),
+		Outflow:    coins(60),
+		Remaining:  coins(40),
+		PeriodEnd:  blockTime.Add(period),
+	}, res.Quota)
+
+	// lowering the limit during the period leaves nothing to send
+	suite.keeper.SetParams(suite.ctx, ratelimit.NewParams(ratelimit.NewOutflowLimit(suite.limited, period, coins(50))))
+	suite.Require().Equal([]ratelimit.Quota{{
+		Address:    suite.limited.String(),
+		MaxOutflow: coins(50),
+		Outflow:    coins(60),
+		Remaining:  sdk.NewCoins(),
+		PeriodEnd:  blockTime.Add(period),
+	}}, suite.keeper.GetQuotas(suite.ctx))
+
+	// the quota is reset at the end of the period
+	suite.ctx = suite.ctx.WithBlockTime(blockTime.Add(period))
+	quota, found := suite.keeper.GetQuota(suite.ctx, suite.limited)
+	suite.Require().True(found)
+	suite.Require().Equal(coins(50), quota.Remaining)
+	suite.Require().Equal(blockTime.Add(2*period), quota.PeriodEnd)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	suite.Require().NoError(suite.send(coins(60)))
+
+	msg := &ratelimit.MsgUpdateParams{Authority: suite.addrs[0].String(), Params: ratelimit.DefaultParams()}
+	_, err := suite.msgSrvr.UpdateParams(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	msg.Authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err = suite.msgSrvr.UpdateParams(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ratelimit.DefaultParams(), suite.keeper.GetParams(suite.ctx))
+
+	// the outflows of the accounts which are no longer limited are deleted
+	_, found := suite.keeper.GetOutflow(suite.ctx, suite.limited)
+	suite.Require().False(found)
+	suite.Require().NoError(suite.send(coins(500)))
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	suite.Require().NoError(suite.send(coins(60)))
+
+	gs, err := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(gs.Outflows, 1)
+
+	app := simapp.Setup(suite.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: blockTime})
+	suite.Require().NoError(app.RateLimitKeeper.InitGenesis(ctx, gs))
+
+	exported, err := app.RateLimitKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(gs, exported)
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ratelimit"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the ratelimit MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(k Keeper) ratelimit.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ ratelimit.MsgServer = msgServer{}
+
+// UpdateParams updates the parameters of the module.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *ratelimit.MsgUpdateParams) (*ratelimit.MsgUpdateParamsResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected authority %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	return &ratelimit.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,36 @@
+package ratelimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "ratelimit"
+
+	// StoreKey is the store key string for ratelimit
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for ratelimit
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for ratelimit
+	QuerierRoute = ModuleName
+)
+
+// Keys for ratelimit store
+// Items are stored with the following key: values
+//
+// - 0x00: Params
+//
+// - 0x01<address_Bytes>: Outflow
+var (
+	ParamsKey        = []byte{0x00}
+	OutflowKeyPrefix = []byte{0x01}
+)
+
+// OutflowKey returns the key of the outflow of an account.
+func OutflowKey(addr sdk.AccAddress) []byte {
+	return append(OutflowKeyPrefix, address.MustLengthPrefix(addr)...)
+}
@@ -0,0 +1,72 @@
+package ratelimit
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewOutflowLimit creates a new OutflowLimit instance
+func NewOutflowLimit(addr sdk.AccAddress, period time.Duration, maxOutflow sdk.Coins) OutflowLimit {
+	return OutflowLimit{
+		Address:    addr.String(),
+		Period:     period,
+		MaxOutflow: maxOutflow,
+	}
+}
+
+// Validate performs basic validation on the limit.
+func (l OutflowLimit) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(l.Address); err != nil {
+		return fmt.Errorf("invalid limited address %s: %w", l.Address, err)
+	}
+	if l.Period <= 0 {
+		return fmt.Errorf("period of %s must be positive", l.Address)
+	}
+	if err := l.MaxOutflow.Validate(); err != nil {
+		return fmt.Errorf("invalid max outflow of %s: %w", l.Address, err)
+	}
+	if l.MaxOutflow.Empty() {
+		return fmt.Errorf("max outflow of %s must not be empty", l.Address)
+	}
+
+	return nil
+}
+
+// NewParams creates a new Params instance
+func NewParams(limits ...OutflowLimit) Params {
+	return Params{Limits: limits}
+}
+
+// DefaultParams returns a default set of parameters, without limit.
+func DefaultParams() Params {
+	return NewParams()
+}
+
+// Validate performs basic validation on the parameters.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.Limits))
+	for _, limit := range p.Limits {
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+		if seen[limit.Address] {
+			return fmt.Errorf("duplicate limit of %s", limit.Address)
+		}
+		seen[limit.Address] = true
+	}
+
+	return nil
+}
+
+// GetLimit returns the outflow limit of an account.
+func (p Params) GetLimit(addr string) (OutflowLimit, bool) {
+	for _, limit := range p.Limits {
+		if limit.Address == addr {
+			return limit, true
+		}
+	}
+
+	return OutflowLimit{}, false
+}
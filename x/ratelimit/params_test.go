@@ -0,0 +1,68 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ratelimit"
+)
+
+var (
+	addr1 = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr2 = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+)
+
+func coins(amt int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amt))
+}
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params ratelimit.Params
+		expErr bool
+	}{
+		{"default", ratelimit.DefaultParams(), false},
+		{"valid", ratelimit.NewParams(ratelimit.NewOutflowLimit(addr1, time.Hour, coins(10)), ratelimit.NewOutflowLimit(addr2, time.Hour, coins(10))), false},
+		{"invalid address", ratelimit.NewParams(ratelimit.OutflowLimit{Address: "invalid", Period: time.Hour, MaxOutflow: coins(10)}), true},
+		{"zero period", ratelimit.NewParams(ratelimit.NewOutflowLimit(addr1, 0, coins(10))), true},
+		{"no max outflow", ratelimit.NewParams(ratelimit.NewOutflowLimit(addr1, time.Hour, nil)), true},
+		{"invalid max outflow", ratelimit.NewParams(ratelimit.NewOutflowLimit(addr1, time.Hour, sdk.Coins{sdk.Coin{Denom: "1", Amount: sdk.NewInt(10)}})), true},
+		{"duplicate address", ratelimit.NewParams(ratelimit.NewOutflowLimit(addr1, time.Hour, coins(10)), ratelimit.NewOutflowLimit(addr1, time.Hour, coins(20))), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	params := ratelimit.NewParams(ratelimit.NewOutflowLimit(addr1, time.Hour, coins(10)))
+	outflow := ratelimit.Outflow{Address: addr1.String(), PeriodStart: time.Now(), Amount: coins(5)}
+
+	require.NoError(t, ratelimit.ValidateGenesis(*ratelimit.DefaultGenesisState()))
+	require.NoError(t, ratelimit.ValidateGenesis(*ratelimit.NewGenesisState(params, []ratelimit.Outflow{outflow})))
+	require.Error(t, ratelimit.ValidateGenesis(*ratelimit.NewGenesisState(params, []ratelimit.Outflow{outflow, outflow})))
+	require.Error(t, ratelimit.ValidateGenesis(*ratelimit.NewGenesisState(ratelimit.DefaultParams(), []ratelimit.Outflow{outflow})))
+}
+
+func TestMsgUpdateParamsValidateBasic(t *testing.T) {
+	msg := ratelimit.MsgUpdateParams{Authority: addr1.String(), Params: ratelimit.DefaultParams()}
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+
+	msg.Authority = ""
+	require.Error(t, msg.ValidateBasic())
+}
@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	schedulerQueryCmd := &cobra.Command{
+		Use:                        scheduler.ModuleName,
+		Short:                      "Querying commands for the scheduler module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	schedulerQueryCmd.AddCommand(
+		GetCmdQuerySchedule(),
+		GetCmdQuerySchedules(),
+		GetCmdQueryExecutionResults(),
+		GetCmdQueryParams(),
+	)
+
+	return schedulerQueryCmd
+}
+
+// GetCmdQuerySchedule returns cmd to query for a schedule.
+func GetCmdQuerySchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "schedule [schedule_id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query details of a pending schedule",
+		Example: fmt.Sprintf("$ %s query %s schedule 1", version.AppName, scheduler.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := scheduler.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("schedule id %s not a valid uint, please input a valid schedule id", args[0])
+			}
+
+			res, err := queryClient.Schedule(cmd.Context(), &scheduler.QueryScheduleRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Schedule)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySchedules returns cmd to query for the schedules of an owner.
+func GetCmdQuerySchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "schedules [owner]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the pending schedules of an owner",
+		Example: fmt.Sprintf("$ %s query %s schedules [owner]", version.AppName, scheduler.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := scheduler.NewQueryClient(clientCtx)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Schedules(cmd.Context(), &scheduler.QuerySchedulesRequest{
+				Owner:      owner.String(),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "schedules")
+
+	return cmd
+}
+
+// GetCmdQueryExecutionResults returns cmd to query for the execution results
+// of a schedule.
+func GetCmdQueryExecutionResults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "results [schedule_id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the execution results of a schedule",
+		Example: fmt.Sprintf("$ %s query %s results 1", version.AppName, scheduler.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := scheduler.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("schedule id %s not a valid uint, please input a valid schedule id", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ExecutionResults(cmd.Context(), &scheduler.QueryExecutionResultsRequest{
+				ScheduleId: id,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "results")
+
+	return cmd
+}
+
+// GetCmdQueryParams returns cmd to query for the scheduler parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Args:    cobra.NoArgs,
+		Short:   "Query the current scheduler parameters",
+		Example: fmt.Sprintf("$ %s query %s params", version.AppName, scheduler.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := scheduler.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &scheduler.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
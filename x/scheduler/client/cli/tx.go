@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+// flags for scheduler module
+const (
+	FlagHeight         = "height"
+	FlagTime           = "time"
+	FlagIntervalBlocks = "interval-blocks"
+	FlagInterval       = "interval"
+	FlagExecutions     = "executions"
+	FlagExecutionFee   = "execution-fee"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	schedulerTxCmd := &cobra.Command{
+		Use:                        scheduler.ModuleName,
+		Short:                      "Scheduler transactions subcommands",
+		Long:                       "Schedule messages to execute at a future height or time, and cancel schedules",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	schedulerTxCmd.AddCommand(
+		NewCmdSchedule(),
+		NewCmdCancelSchedule(),
+	)
+
+	return schedulerTxCmd
+}
+
+// NewCmdSchedule returns a CLI command handler for creating a MsgSchedule transaction.
+func NewCmdSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule [msg_tx_json_file] --from [owner]",
+		Short: "Schedule the messages of a tx to execute at a future height or time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Schedule the messages of a tx to execute on behalf of the owner at a future
+height or time. The messages must be signed by the owner. Recurring schedules
+are executed every interval until they run out of executions. The execution
+fees of all the executions are escrowed until the messages are executed.
+
+Example:
+ $ %s tx bank send <owner> <recipient> 10stake --generate-only > tx.json
+ $ %s tx %s schedule tx.json --height 1000 --interval-blocks 100 --executions 12 --execution-fee 10stake --from <owner>
+ $ %s tx %s schedule tx.json --time 2030-01-30T15:04:05Z --from <owner>
+`, version.AppName, version.AppName, scheduler.ModuleName, version.AppName, scheduler.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			height, err := cmd.Flags().GetInt64(FlagHeight)
+			if err != nil {
+				return err
+			}
+
+			var t *time.Time
+			if timeStr, _ := cmd.Flags().GetString(FlagTime); timeStr != "" {
+				parsed, err := time.Parse(time.RFC3339, timeStr)
+				if err != nil {
+					return err
+				}
+				t = &parsed
+			}
+
+			intervalBlocks, err := cmd.Flags().GetUint64(FlagIntervalBlocks)
+			if err != nil {
+				return err
+			}
+
+			interval, err := cmd.Flags().GetDuration(FlagInterval)
+			if err != nil {
+				return err
+			}
+
+			executions, err := cmd.Flags().GetUint64(FlagExecutions)
+			if err != nil {
+				return err
+			}
+
+			feeStr, err := cmd.Flags().GetString(FlagExecutionFee)
+			if err != nil {
+				return err
+			}
+
+			fee, err := sdk.ParseCoinsNormalized(feeStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := scheduler.NewMsgSchedule(
+				clientCtx.GetFromAddress(), theTx.GetMsgs(), height, t, intervalBlocks, interval, executions, fee,
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Int64(FlagHeight, 0, "The height of the first execution")
+	cmd.Flags().String(FlagTime, "", "The time of the first execution, in RFC3339 format")
+	cmd.Flags().Uint64(FlagIntervalBlocks, 0, "The number of blocks between two executions of a height triggered schedule")
+	cmd.Flags().Duration(FlagInterval, 0, "The duration between two executions of a time triggered schedule, e.g. 24h")
+	cmd.Flags().Uint64(FlagExecutions, 1, "The number of executions")
+	cmd.Flags().String(FlagExecutionFee, "", "The fee paid on every execution")
+
+	return cmd
+}
+
+// NewCmdCancelSchedule returns a CLI command handler for creating a MsgCancelSchedule transaction.
+func NewCmdCancelSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [schedule_id] --from [owner]",
+		Short: "Cancel a schedule and refund the fees of its remaining executions",
+		Example: fmt.Sprintf(
+			"$ %s tx %s cancel 1 --from mykey", version.AppName, scheduler.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("schedule id %s not a valid uint, please input a valid schedule id", args[0])
+			}
+
+			msg := scheduler.NewMsgCancelSchedule(clientCtx.GetFromAddress(), id)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
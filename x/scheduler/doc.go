@@ -0,0 +1,18 @@
+/*
+Package scheduler provides the deferred execution of messages: an account
+schedules messages to execute on its behalf at a future height or time, once or
+on a recurring basis, with MsgSchedule, and cancels the schedule with
+MsgCancelSchedule.
+
+The execution fees of all the executions are escrowed in the module account
+when the schedule is created. On every execution, one execution fee is paid to
+the fee collector, whatever the outcome of the messages, and the outcome is
+recorded as an ExecutionResult. Cancelling a schedule refunds the fees of the
+remaining executions.
+
+The due schedules are executed in the EndBlocker, in order of height or time
+and then of identifier, up to a maximum number of executions per block. The
+messages of an execution are executed atomically, with a gas limit, and their
+state changes are discarded if any of them fails.
+*/
+package scheduler
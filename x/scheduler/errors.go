@@ -0,0 +1,21 @@
+package scheduler
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/scheduler module sentinel errors
+var (
+	// ErrScheduleNotFound error if the schedule doesn't exist
+	ErrScheduleNotFound = sdkerrors.Register(ModuleName, 2, "schedule not found")
+	// ErrInvalidTrigger error if the height, time or interval of a schedule is invalid
+	ErrInvalidTrigger = sdkerrors.Register(ModuleName, 3, "invalid schedule trigger")
+	// ErrNoMessages error if a schedule has no message
+	ErrNoMessages = sdkerrors.Register(ModuleName, 4, "no messages")
+	// ErrTooManyMessages error if a schedule has more messages than allowed
+	ErrTooManyMessages = sdkerrors.Register(ModuleName, 5, "too many messages")
+	// ErrOwnerNotAllowed error if the owner isn't allowed to create schedules
+	ErrOwnerNotAllowed = sdkerrors.Register(ModuleName, 6, "owner not allowed")
+	// ErrExecutionFeeTooLow error if the execution fee is lower than the minimum
+	ErrExecutionFeeTooLow = sdkerrors.Register(ModuleName, 7, "execution fee too low")
+)
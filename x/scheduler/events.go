@@ -0,0 +1,15 @@
+package scheduler
+
+// scheduler module events
+const (
+	EventTypeSchedule       = "schedule"
+	EventTypeCancelSchedule = "cancel_schedule"
+	EventTypeExecute        = "execute_schedule"
+
+	AttributeKeyScheduleID = "schedule_id"
+	AttributeKeyOwner      = "owner"
+	AttributeKeyExecution  = "execution"
+	AttributeKeySuccess    = "success"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,68 @@
+package scheduler
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, nextScheduleID uint64, schedules []Schedule, results []ExecutionResult) *GenesisState {
+	return &GenesisState{
+		Params:         params,
+		NextScheduleId: nextScheduleID,
+		Schedules:      schedules,
+		Results:        results,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the scheduler
+// module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), 1, nil, nil)
+}
+
+// ValidateGenesis checks that the parameters and the schedules are valid, and
+// that the schedule identifiers are unique and lower than the next one.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	if data.NextScheduleId == 0 {
+		return fmt.Errorf("next schedule id must be positive")
+	}
+
+	ids := make(map[uint64]bool, len(data.Schedules))
+	for _, s := range data.Schedules {
+		if s.Id == 0 || s.Id >= data.NextScheduleId {
+			return fmt.Errorf("schedule id %d must be positive and lower than the next schedule id %d", s.Id, data.NextScheduleId)
+		}
+		if ids[s.Id] {
+			return fmt.Errorf("duplicate schedule id %d", s.Id)
+		}
+		ids[s.Id] = true
+
+		if err := s.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid schedule %d: %w", s.Id, err)
+		}
+	}
+
+	for _, r := range data.Results {
+		if r.ScheduleId == 0 || r.ScheduleId >= data.NextScheduleId || r.Execution == 0 {
+			return fmt.Errorf("invalid execution result %d of schedule %d", r.Execution, r.ScheduleId)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, s := range data.Schedules {
+		if err := s.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+// ExecuteDueSchedules executes the schedules due at the current block, the
+// height triggered ones first, in trigger and then identifier order, up to the
+// maximum number of executions per block. The remaining due schedules stay
+// queued for the next blocks.
+func (k Keeper) ExecuteDueSchedules(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+
+	// collect the identifiers first, the queues are updated by the executions
+	ids := k.dueSchedules(ctx, scheduler.HeightQueueKeyPrefix, scheduler.HeightQueuePrefix(ctx.BlockHeight()+1), params.MaxExecutionsPerBlock)
+	if limit := params.MaxExecutionsPerBlock - uint64(len(ids)); limit > 0 {
+		end := sdk.PrefixEndBytes(scheduler.TimeQueuePrefix(ctx.BlockTime()))
+		ids = append(ids, k.dueSchedules(ctx, scheduler.TimeQueueKeyPrefix, end, limit)...)
+	}
+
+	for _, id := range ids {
+		schedule, found := k.GetSchedule(ctx, id)
+		if !found {
+			return sdkerrors.Wrapf(scheduler.ErrScheduleNotFound, "queued schedule %d", id)
+		}
+		if err := k.execute(ctx, schedule, params.MaxGasPerExecution); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dueSchedules returns the identifiers of the schedules of a queue before end,
+// up to limit.
+func (k Keeper) dueSchedules(ctx sdk.Context, queuePrefix, end []byte, limit uint64) []uint64 {
+	iterator := ctx.KVStore(k.storeKey).Iterator(queuePrefix, end)
+	defer iterator.Close()
+
+	var ids []uint64
+	for ; iterator.Valid() && uint64(len(ids)) < limit; iterator.Next() {
+		ids = append(ids, scheduler.SplitQueueKey(iterator.Key()))
+	}
+
+	return ids
+}
+
+// execute executes the messages of a schedule, records the result, pays the
+// execution fee and queues the next execution, if any.
+func (k Keeper) execute(ctx sdk.Context, schedule scheduler.Schedule, gasLimit uint64) error {
+	k.removeSchedule(ctx, schedule)
+	schedule.Executions++
+	schedule.RemainingExecutions--
+
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+	err := k.dispatchMsgs(cacheCtx, schedule)
+
+	result := scheduler.ExecutionResult{
+		ScheduleId: schedule.Id,
+		Execution:  schedule.Executions,
+		Height:     ctx.BlockHeight(),
+		Time:       ctx.BlockTime(),
+		Success:    err == nil,
+		GasUsed:    cacheCtx.GasMeter().GasConsumedToLimit(),
+	}
+	if err == nil {
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	} else {
+		result.Error = err.Error()
+		k.Logger(ctx).Info("scheduled messages failed", "schedule", schedule.Id, "execution", schedule.Executions, "err", err)
+	}
+	k.SetExecutionResult(ctx, result)
+
+	if !schedule.ExecutionFee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, scheduler.ModuleName, k.feeCollectorName, schedule.ExecutionFee); err != nil {
+			return err
+		}
+	}
+
+	if schedule.RemainingExecutions > 0 {
+		k.SetSchedule(ctx, nextExecution(ctx, schedule))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			scheduler.EventTypeExecute,
+			sdk.NewAttribute(scheduler.AttributeKeyScheduleID, fmt.Sprintf("%d", schedule.Id)),
+			sdk.NewAttribute(scheduler.AttributeKeyExecution, fmt.Sprintf("%d", schedule.Executions)),
+			sdk.NewAttribute(scheduler.AttributeKeySuccess, strconv.FormatBool(result.Success)),
+		),
+	)
+
+	return nil
+}
+
+// dispatchMsgs executes the messages of a schedule on behalf of its owner. The
+// panics, e.g. when running out of gas, are returned as errors.
+func (k Keeper) dispatchMsgs(ctx sdk.Context, schedule scheduler.Schedule) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", oog.Descriptor)
+				return
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "%v", r)
+		}
+	}()
+
+	msgs, err := schedule.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(schedule.Owner)
+	if err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			if !signer.Equals(owner) {
+				return sdkerrors.ErrUnauthorized.Wrapf("msg %d must only be signed by the owner, got signer %s", i, signer)
+			}
+		}
+
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+
+		msgResp, err := handler(ctx, msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to execute message %d", i)
+		}
+
+		// emit the events from the dispatched messages, tagged with the index
+		// of their message in the schedule
+		ctx.EventManager().EmitEvents(
+			msgResp.GetEvents().WithAttributeIfMissing(sdk.NewAttribute(sdk.AttributeKeyMsgIndex, strconv.Itoa(i))),
+		)
+	}
+
+	return nil
+}
+
+// nextExecution returns the schedule triggered at its next execution. The
+// executions delayed past their next trigger are not caught up, the next
+// trigger is then an interval after the current block.
+func nextExecution(ctx sdk.Context, schedule scheduler.Schedule) scheduler.Schedule {
+	if schedule.IsHeightTriggered() {
+		schedule.NextHeight += int64(schedule.IntervalBlocks)
+		if schedule.NextHeight <= ctx.BlockHeight() {
+			schedule.NextHeight = ctx.BlockHeight() + int64(schedule.IntervalBlocks)
+		}
+		return schedule
+	}
+
+	next := schedule.NextTime.Add(schedule.Interval)
+	if !next.After(ctx.BlockTime()) {
+		next = ctx.BlockTime().Add(schedule.Interval).UTC()
+	}
+	schedule.NextTime = &next
+
+	return schedule
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+// InitGenesis initializes the scheduler module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *scheduler.GenesisState) error {
+	// ensure the module account exists, it holds the escrowed fees
+	k.authKeeper.GetModuleAccount(ctx, scheduler.ModuleName)
+
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	k.SetParams(ctx, data.Params)
+	k.SetNextScheduleID(ctx, data.NextScheduleId)
+
+	for _, schedule := range data.Schedules {
+		if _, found := k.GetSchedule(ctx, schedule.Id); found {
+			return fmt.Errorf("duplicate schedule %d", schedule.Id)
+		}
+		k.SetSchedule(ctx, schedule)
+	}
+
+	for _, result := range data.Results {
+		k.SetExecutionResult(ctx, result)
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the scheduler module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) (*scheduler.GenesisState, error) {
+	var schedules []scheduler.Schedule
+	k.IterateSchedules(ctx, func(schedule scheduler.Schedule) bool {
+		schedules = append(schedules, schedule)
+		return false
+	})
+
+	var results []scheduler.ExecutionResult
+	k.IterateExecutionResults(ctx, func(result scheduler.ExecutionResult) bool {
+		results = append(results, result)
+		return false
+	})
+
+	return scheduler.NewGenesisState(k.GetParams(ctx), k.GetNextScheduleID(ctx), schedules, results), nil
+}
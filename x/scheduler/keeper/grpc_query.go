@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+var _ scheduler.QueryServer = Keeper{}
+
+// Schedule returns a pending schedule by its identifier.
+func (q Keeper) Schedule(c context.Context, req *scheduler.QueryScheduleRequest) (*scheduler.QueryScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	schedule, found := q.GetSchedule(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "schedule %d doesn't exist", req.Id)
+	}
+
+	return &scheduler.QueryScheduleResponse{Schedule: &schedule}, nil
+}
+
+// Schedules returns the pending schedules of an owner.
+func (q Keeper) Schedules(c context.Context, req *scheduler.QuerySchedulesRequest) (*scheduler.QuerySchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var schedules []scheduler.Schedule
+	pageRes, err := query.Paginate(q.schedulesByOwnerStore(ctx, owner), req.Pagination, func(key []byte, _ []byte) error {
+		schedule, found := q.GetSchedule(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return status.Errorf(codes.Internal, "schedule %d doesn't exist", sdk.BigEndianToUint64(key))
+		}
+
+		schedules = append(schedules, schedule)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &scheduler.QuerySchedulesResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
+
+// ExecutionResults returns the execution results of a schedule.
+func (q Keeper) ExecutionResults(c context.Context, req *scheduler.QueryExecutionResultsRequest) (*scheduler.QueryExecutionResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), scheduler.ExecutionResultsPrefix(req.ScheduleId))
+
+	var results []scheduler.ExecutionResult
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var result scheduler.ExecutionResult
+		if err := q.cdc.Unmarshal(value, &result); err != nil {
+			return err
+		}
+
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &scheduler.QueryExecutionResultsResponse{Results: results, Pagination: pageRes}, nil
+}
+
+// Params returns the parameters of the scheduler module.
+func (q Keeper) Params(c context.Context, req *scheduler.QueryParamsRequest) (*scheduler.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &scheduler.QueryParamsResponse{Params: q.GetParams(ctx)}, nil
+}
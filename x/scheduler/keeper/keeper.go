@@ -0,0 +1,288 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+// Keeper manages the schedules, their execution queues and their execution
+// results.
+type Keeper struct {
+	cdc              codec.BinaryCodec
+	storeKey         sdk.StoreKey
+	router           *middleware.MsgServiceRouter
+	authKeeper       scheduler.AccountKeeper
+	bankKeeper       scheduler.BankKeeper
+	feeCollectorName string
+
+	// the address capable of updating the parameters and of creating
+	// schedules without execution fee, usually the gov module account
+	authority string
+}
+
+// NewKeeper creates a scheduler Keeper
+func NewKeeper(
+	cdc codec.BinaryCodec, storeKey sdk.StoreKey, router *middleware.MsgServiceRouter,
+	ak scheduler.AccountKeeper, bk scheduler.BankKeeper, feeCollectorName, authority string,
+) Keeper {
+	// ensure the scheduler module account is set
+	if addr := ak.GetModuleAddress(scheduler.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", scheduler.ModuleName))
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         storeKey,
+		router:           router,
+		authKeeper:       ak,
+		bankKeeper:       bk,
+		feeCollectorName: feeCollectorName,
+		authority:        authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", scheduler.ModuleName))
+}
+
+// GetAuthority returns the scheduler module authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the parameters of the scheduler module.
+func (k Keeper) GetParams(ctx sdk.Context) (params scheduler.Params) {
+	bz := ctx.KVStore(k.storeKey).Get(scheduler.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the parameters of the scheduler module.
+func (k Keeper) SetParams(ctx sdk.Context, params scheduler.Params) {
+	ctx.KVStore(k.storeKey).Set(scheduler.ParamsKey, k.cdc.MustMarshal(&params))
+}
+
+// GetNextScheduleID returns the identifier of the next schedule created.
+func (k Keeper) GetNextScheduleID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(scheduler.NextScheduleIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextScheduleID sets the identifier of the next schedule created.
+func (k Keeper) SetNextScheduleID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(scheduler.NextScheduleIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetSchedule returns a pending schedule.
+func (k Keeper) GetSchedule(ctx sdk.Context, id uint64) (schedule scheduler.Schedule, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(scheduler.ScheduleKey(id))
+	if bz == nil {
+		return schedule, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return schedule, true
+}
+
+// SetSchedule sets a schedule and adds it to the queue of its trigger. It
+// must not already be queued.
+func (k Keeper) SetSchedule(ctx sdk.Context, schedule scheduler.Schedule) {
+	owner, err := sdk.AccAddressFromBech32(schedule.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	batch := sdk.NewKVStoreBatch(ctx.KVStore(k.storeKey))
+	batch.Set(scheduler.ScheduleKey(schedule.Id), k.cdc.MustMarshal(&schedule))
+	batch.Set(scheduler.ScheduleByOwnerKey(owner, schedule.Id), []byte{})
+	batch.Set(queueKey(schedule), []byte{})
+	batch.Write()
+}
+
+// removeSchedule removes a schedule and its queue entry.
+func (k Keeper) removeSchedule(ctx sdk.Context, schedule scheduler.Schedule) {
+	owner, err := sdk.AccAddressFromBech32(schedule.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	batch := sdk.NewKVStoreBatch(ctx.KVStore(k.storeKey))
+	batch.Delete(scheduler.ScheduleKey(schedule.Id))
+	batch.Delete(scheduler.ScheduleByOwnerKey(owner, schedule.Id))
+	batch.Delete(queueKey(schedule))
+	batch.Write()
+}
+
+// IterateSchedules iterates over the pending schedules, in identifier order,
+// until cb returns true.
+func (k Keeper) IterateSchedules(ctx sdk.Context, cb func(schedule scheduler.Schedule) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), scheduler.ScheduleKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule scheduler.Schedule
+		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
+		if cb(schedule) {
+			break
+		}
+	}
+}
+
+// SetExecutionResult records the result of an execution.
+func (k Keeper) SetExecutionResult(ctx sdk.Context, result scheduler.ExecutionResult) {
+	key := scheduler.ExecutionResultKey(result.ScheduleId, result.Execution)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&result))
+}
+
+// IterateExecutionResults iterates over the execution results, in schedule
+// and execution order, until cb returns true.
+func (k Keeper) IterateExecutionResults(ctx sdk.Context, cb func(result scheduler.ExecutionResult) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), scheduler.ExecutionResultKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var result scheduler.ExecutionResult
+		k.cdc.MustUnmarshal(iterator.Value(), &result)
+		if cb(result) {
+			break
+		}
+	}
+}
+
+// CreateSchedule checks that the owner is allowed to create the schedule,
+// escrows the fees of all its executions and queues it. It returns the
+// identifier of the schedule.
+func (k Keeper) CreateSchedule(ctx sdk.Context, msg *scheduler.MsgSchedule) (uint64, error) {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return 0, err
+	}
+
+	params := k.GetParams(ctx)
+	if msg.Owner != k.authority {
+		if !params.IsAllowedOwner(msg.Owner) {
+			return 0, sdkerrors.Wrap(scheduler.ErrOwnerNotAllowed, msg.Owner)
+		}
+		if !msg.ExecutionFee.IsAllGTE(params.MinExecutionFee) {
+			return 0, sdkerrors.Wrapf(scheduler.ErrExecutionFeeTooLow, "got %s, expected at least %s", msg.ExecutionFee, params.MinExecutionFee)
+		}
+	}
+	if uint64(len(msg.Msgs)) > params.MaxMsgs {
+		return 0, sdkerrors.Wrapf(scheduler.ErrTooManyMessages, "got %d, expected at most %d", len(msg.Msgs), params.MaxMsgs)
+	}
+	if msg.Time == nil && msg.Height <= ctx.BlockHeight() {
+		return 0, sdkerrors.Wrapf(scheduler.ErrInvalidTrigger, "height %d must be after the current height %d", msg.Height, ctx.BlockHeight())
+	}
+	if msg.Time != nil && !msg.Time.After(ctx.BlockTime()) {
+		return 0, sdkerrors.Wrapf(scheduler.ErrInvalidTrigger, "time %s must be after the current block time %s", msg.Time, ctx.BlockTime())
+	}
+
+	if escrow := multiplyCoins(msg.ExecutionFee, msg.Executions); !escrow.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, scheduler.ModuleName, escrow); err != nil {
+			return 0, err
+		}
+	}
+
+	id := k.GetNextScheduleID(ctx)
+	k.SetNextScheduleID(ctx, id+1)
+
+	var nextTime *time.Time
+	if msg.Time != nil {
+		t := msg.Time.UTC()
+		nextTime = &t
+	}
+
+	k.SetSchedule(ctx, scheduler.Schedule{
+		Id:                  id,
+		Owner:               msg.Owner,
+		Msgs:                msg.Msgs,
+		NextHeight:          msg.Height,
+		NextTime:            nextTime,
+		IntervalBlocks:      msg.IntervalBlocks,
+		Interval:            msg.Interval,
+		RemainingExecutions: msg.Executions,
+		ExecutionFee:        msg.ExecutionFee,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			scheduler.EventTypeSchedule,
+			sdk.NewAttribute(scheduler.AttributeKeyScheduleID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(scheduler.AttributeKeyOwner, msg.Owner),
+		),
+	)
+
+	return id, nil
+}
+
+// CancelSchedule removes a schedule and refunds the escrowed fees of its
+// remaining executions to its owner.
+func (k Keeper) CancelSchedule(ctx sdk.Context, owner sdk.AccAddress, id uint64) error {
+	schedule, found := k.GetSchedule(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(scheduler.ErrScheduleNotFound, "%d", id)
+	}
+	if schedule.Owner != owner.String() {
+		return sdkerrors.ErrUnauthorized.Wrapf("schedule %d is not owned by %s", id, owner)
+	}
+
+	if refund := multiplyCoins(schedule.ExecutionFee, schedule.RemainingExecutions); !refund.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, scheduler.ModuleName, owner, refund); err != nil {
+			return err
+		}
+	}
+
+	k.removeSchedule(ctx, schedule)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			scheduler.EventTypeCancelSchedule,
+			sdk.NewAttribute(scheduler.AttributeKeyScheduleID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(scheduler.AttributeKeyOwner, schedule.Owner),
+		),
+	)
+
+	return nil
+}
+
+// queueKey returns the key of a schedule in the queue of its trigger.
+func queueKey(schedule scheduler.Schedule) []byte {
+	if schedule.IsHeightTriggered() {
+		return scheduler.HeightQueueKey(schedule.NextHeight, schedule.Id)
+	}
+
+	return scheduler.TimeQueueKey(*schedule.NextTime, schedule.Id)
+}
+
+// multiplyCoins returns the coins multiplied by n.
+func multiplyCoins(coins sdk.Coins, n uint64) sdk.Coins {
+	res := sdk.NewCoins()
+	for _, coin := range coins {
+		res = res.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(sdk.NewIntFromUint64(n))))
+	}
+
+	return res
+}
+
+// schedulesByOwnerStore returns the index of the schedules of an owner.
+func (k Keeper) schedulesByOwnerStore(ctx sdk.Context, owner sdk.AccAddress) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), scheduler.SchedulesByOwnerPrefix(owner))
+}
@@ -0,0 +1,350 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/keeper"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app     *simapp.SimApp
+	ctx     sdk.Context
+	addrs   []sdk.AccAddress
+	keeper  keeper.Keeper
+	msgSrvr scheduler.MsgServer
+	fee     sdk.Coins
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(suite.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: time.Unix(1_000_000, 0).UTC()})
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(1000))
+	suite.keeper = app.SchedulerKeeper
+	suite.msgSrvr = keeper.NewMsgServerImpl(suite.keeper)
+	suite.fee = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+}
+
+func (suite *KeeperTestSuite) balance(addr sdk.AccAddress) int64 {
+	return suite.app.BankKeeper.GetBalance(suite.ctx, addr, sdk.DefaultBondDenom).Amount.Int64()
+}
+
+func (suite *KeeperTestSuite) moduleBalance(name string) int64 {
+	return suite.balance(suite.app.AccountKeeper.GetModuleAddress(name))
+}
+
+func (suite *KeeperTestSuite) schedule(msg *scheduler.MsgSchedule) uint64 {
+	res, err := suite.msgSrvr.Schedule(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	return res.Id
+}
+
+// endBlock moves to the end of the block at a height and time.
+func (suite *KeeperTestSuite) endBlock(height int64, t time.Time) {
+	suite.ctx = suite.ctx.WithBlockHeight(height).WithBlockTime(t)
+	suite.Require().NoError(suite.keeper.ExecuteDueSchedules(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) send(amount int64) sdk.Msg {
+	return banktypes.NewMsgSend(suite.addrs[0], suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)))
+}
+
+func (suite *KeeperTestSuite) TestScheduleAndCancel() {
+	require := suite.Require()
+	feeCollectorBalance := suite.moduleBalance(authtypes.FeeCollectorName)
+
+	msg, err := scheduler.NewMsgSchedule(suite.addrs[0], []sdk.Msg{suite.send(1)}, 20, nil, 5, 0, 3, suite.fee)
+	require.NoError(err)
+	id := suite.schedule(msg)
+	require.Equal(uint64(1), id)
+
+	// the fees of all the executions are escrowed
+	require.Equal(int64(970), suite.balance(suite.addrs[0]))
+	require.Equal(int64(30), suite.moduleBalance(scheduler.ModuleName))
+
+	res, err := suite.keeper.Schedules(sdk.WrapSDKContext(suite.ctx), &scheduler.QuerySchedulesRequest{Owner: suite.addrs[0].String()})
+	require.NoError(err)
+	require.Len(res.Schedules, 1)
+	require.Equal(int64(20), res.Schedules[0].NextHeight)
+
+	// only the owner can cancel the schedule
+	_, err = suite.msgSrvr.CancelSchedule(sdk.WrapSDKContext(suite.ctx), scheduler.NewMsgCancelSchedule(suite.addrs[1], id))
+	require.Error(err)
+
+	// cancelling after an execution refunds the remaining executions
+	suite.endBlock(20, suite.ctx.BlockTime())
+	require.Equal(int64(969), suite.balance(suite.addrs[0]))
+	require.Equal(feeCollectorBalance+10, suite.moduleBalance(authtypes.FeeCollectorName))
+
+	_, err = suite.msgSrvr.CancelSchedule(sdk.WrapSDKContext(suite.ctx), scheduler.NewMsgCancelSchedule(suite.addrs[0], id))
+	require.NoError(err)
+	require.Equal(int64(989), suite.balance(suite.addrs[0]))
+	require.Zero(suite.moduleBalance(scheduler.ModuleName))
+
+	_, found := suite.keeper.GetSchedule(suite.ctx, id)
+	require.False(found)
+	_, err = suite.msgSrvr.CancelSchedule(sdk.WrapSDKContext(suite.ctx), scheduler.NewMsgCancelSchedule(suite.addrs[0], id))
+	require.ErrorIs(err, scheduler.ErrScheduleNotFound)
+
+	// nothing is executed after the cancellation
+	suite.endBlock(25, suite.ctx.BlockTime())
+	require.Equal(int64(989), suite.balance(suite.addrs[0]))
+}
+
+func (suite *KeeperTestSuite) TestScheduleChecks() {
+	require := suite.Require()
+
+	// the trigger must be in the future
+	msg, err := scheduler.NewMsgSchedule(suite.addrs[0], []sdk.Msg{suite.send(1)}, 10, nil, 0, 0, 1, nil)
+	require.NoError(err)
+	_, err = suite.msgSrvr.Schedule(sdk.WrapSDKContext(suite.ctx), msg)
+	require.ErrorIs(err, scheduler.ErrInvalidTrigger)
+
+	past := suite.ctx.BlockTime()
+	msg, err = scheduler.NewMsgSchedule(suite.addrs[0], []sdk.Msg{suite.send(1)}, 0, &past, 0, 0, 1, nil)
+	require.NoError(err)
+	_, err = suite.msgSrvr.Schedule(sdk.WrapSDKContext(suite.ctx), msg)
+	require.ErrorIs(err, scheduler.ErrInvalidTrigger)
+
+	// the fees must be escrowed
+	msg, err = scheduler.NewMsgSchedule(suite.addrs[0], []sdk.Msg{suite.send(1)}, 11, nil, 1, 0, 101, suite.fee)
+	require.NoError(err)
+	_, err = suite.msgSrvr.Schedule(sdk.WrapSDKContext(suite.ctx), msg)
+	require.Error(err)
+
+	params := scheduler.DefaultParams()
+	params.AllowedOwners = []string{suite.addrs[1].String()}
+	params.MinExecutionFee = suite.fee
+	params.MaxMsgs = 1
+	suite.keeper.SetParams(suite.ctx, params)
+
+	msg, err = scheduler.NewMsgSchedule(suite.addrs[0], []sdk.Msg{suite.send(1)}, 11, nil, 0, 0, 1, suite.fee)
+	require.NoError(err)
+	_, err = suite.msgSrvr.Schedule(sdk.WrapSDKContext(suite.ctx), msg)
+	require.ErrorIs(err, scheduler.ErrOwnerNotAllowed)
+
+	send := banktypes.NewMsgSend(suite.addrs[1], suite.addrs[0], suite.fee)
+	msg, err = scheduler.NewMsgSchedule(suite.addrs[1], []sdk.Msg{send}, 11, nil, 0, 0, 1, nil)
+	require.NoError(err)
+	_, err = suite.msgSrvr.Schedule(sdk.WrapSDKContext(suite.ctx), msg)
+	require.ErrorIs(err, scheduler.ErrExecutionFeeTooLow)
+
+	msg, err = scheduler.NewMsgSchedule(suite.addrs[1], []sdk.Msg{send, send}, 11, nil, 0, 0, 1, suite.fee)
+	require.NoError(err)
+	_, err = suite.msgSrvr.Schedule(sdk.WrapSDKContext(suite.ctx), msg)
+	require.ErrorIs(err, scheduler.ErrTooManyMessages)
+
+	// the authority is always allowed, without minimum fee
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	require.Equal(authority.String(), suite.keeper.GetAuthority())
+	send = banktypes.NewMsgSend(authority, suite.addrs[0], suite.fee)
+	msg, err = scheduler.NewMsgSchedule(authority, []sdk.Msg{send}, 11, nil, 0, 0, 1, nil)
+	require.NoError(err)
+	suite.schedule(msg)
+}
+
+func (suite *KeeperTestSuite) TestExecuteRecurringHeightSchedule() {
+	require := suite.Require()
+
+	msg, err := scheduler.NewMsgSchedule(suite.addrs[0], []sdk.Msg{suite.send(100)}, 12, nil, 2, 0, 2, suite.fee)
+	require.NoError(err)
+	id := suite.schedule(msg)
+
+	suite.endBlock(11, suite.ctx.BlockTime())
+	require.Equal(int64(980), suite.balance(suite.addrs[0]))
+
+	suite.endBlock(12, suite.ctx.BlockTime())
+	require.Equal(int64(880), suite.balance(suite.addrs[0]))
+	require.Equal(int64(1100), suite.balance(suite.addrs[1]))
+
+	schedule, found := suite.keeper.GetSchedule(suite.ctx, id)
+	require.True(found)
+	require.Equal(int64(14), schedule.NextHeight)
+	require.Equal(uint64(1), schedule.RemainingExecutions)
+	require.Equal(uint64(1), schedule.Executions)
+
+	// the last execution is delayed, the schedule is removed once executed
+	suite.endBlock(20, suite.ctx.BlockTime())
+	require.Equal(int64(780), suite.balance(suite.addrs[0]))
+	_, found = suite.keeper.GetSchedule(suite.ctx, id)
+	require.False(found)
+	require.Zero(suite.moduleBalance(scheduler.ModuleName))
+
+	res, err := suite.keeper.ExecutionResults(sdk.WrapSDKContext(suite.ctx), &scheduler.QueryExecutionResultsRequest{ScheduleId: id})
+	require.NoError(err)
+	require.Len(res.Results, 2)
+	require.True(res.Results[0].Success)
+	require.Equal(int64(12), res.Results[0].Height)
+	require.Equal(uint64(2), res.Results[1].Execution)
+	require.Equal(int64(20), res.Results[1].Height)
+	require.NotZero(res.Results[1].GasUsed)
+}
+
+func (suite *KeeperTestSuite) TestExecuteTimeSchedule() {
+	require := suite.Require()
+	start := suite.ctx.BlockTime()
+
+	at := start.Add(time.Hour)
+	msg, err := scheduler.NewMsgSchedule(suite.addrs[0], []sdk.Msg{suite.send(100)}, 0, &at, 0, time.Hour, 3, nil)
+	require.NoError(err)
+	id := suite.schedule(msg)
+
+	suite.endBlock(11, start.Add(time.Hour-time.Second))
+	require.Equal(int64(1000), suite.balance(suite.addrs[0]))
+
+	suite.endBlock(12, start.Add(time.Hour))
+	require.Equal(int64(900), suite.balance(suite.addrs[0]))
+
+	// executions are not caught up after a long pause
+	suite.endBlock(13, start.Add(5*time.Hour))
+	require.Equal(int64(800), suite.balance(suite.addrs[0]))
+
+	schedule, found := suite.keeper.GetSchedule(suite.ctx, id)
+	require.True(found)
+	require.Equal(start.Add(6*time.Hour), *schedule.NextTime)
+}
+
+func (suite *KeeperTestSuite) TestExecuteFailedSchedule() {
+	require := suite.Require()
+	feeCollectorBalance := suite.moduleBalance(authtypes.FeeCollectorName)
+
+	// the first message succeeds but the second one fails, so both are
+	// reverted and the fee is paid anyway
+	msgs := []sdk.Msg{suite.send(100), suite.send(2000)}
+	msg, err := scheduler.NewMsgSchedule(suite.addrs[0], msgs, 11, nil, 0, 0, 1, suite.fee)
+	require.NoError(err)
+	id := suite.schedule(msg)
+
+	suite.endBlock(11, suite.ctx.BlockTime())
+	require.Equal(int64(990), suite.balance(suite.addrs[0]))
+	require.Equal(int64(1000), suite.balance(suite.addrs[1]))
+	require.Equal(feeCollectorBalance+10, suite.moduleBalance(authtypes.FeeCollectorName))
+
+	res, err := suite.keeper.ExecutionResults(sdk.WrapSDKContext(suite.ctx), &scheduler.QueryExecutionResultsRequest{ScheduleId: id})
+	require.NoError(err)
+	require.Len(res.Results, 1)
+	require.False(res.Results[0].Success)
+	require.Contains(res.Results[0].Error, "insufficient funds")
+
+	// running out of gas fails the execution too
+	params := scheduler.DefaultParams()
+	params.MaxGasPerExecution = 100
+	suite.keeper.SetParams(suite.ctx, params)
+
+	msg, err = scheduler.NewMsgSchedule(suite.addrs[0], []sdk.Msg{suite.send(100)}, 12, nil, 0, 0, 1, nil)
+	require.NoError(err)
+	id = suite.schedule(msg)
+
+	suite.endBlock(12, suite.ctx.BlockTime())
+	require.Equal(int64(990), suite.balance(suite.addrs[0]))
+
+	res, err = suite.keeper.ExecutionResults(sdk.WrapSDKContext(suite.ctx), &scheduler.QueryExecutionResultsRequest{ScheduleId: id})
+	require.NoError(err)
+	require.False(res.Results[0].Success)
+	require.Contains(res.Results[0].Error, "out of gas")
+	require.Equal(uint64(100), res.Results[0].GasUsed)
+}
+
+func (suite *KeeperTestSuite) TestMaxExecutionsPerBlock() {
+	require := suite.Require()
+
+	params := scheduler.DefaultParams()
+	params.MaxExecutionsPerBlock = 2
+	suite.keeper.SetParams(suite.ctx, params)
+
+	at := suite.ctx.BlockTime().Add(time.Minute)
+	for i := 0; i < 2; i++ {
+		msg, err := scheduler.NewMsgSchedule(suite.addrs[0], []sdk.Msg{suite.send(1)}, 0, &at, 0, 0, 1, nil)
+		require.NoError(err)
+		suite.schedule(msg)
+
+		msg, err = scheduler.NewMsgSchedule(suite.addrs[0], []sdk.Msg{suite.send(1)}, 11, nil, 0, 0, 1, nil)
+		require.NoError(err)
+		suite.schedule(msg)
+	}
+
+	// the height triggered schedules are executed first
+	suite.endBlock(11, at)
+	require.Equal(int64(998), suite.balance(suite.addrs[0]))
+	_, found := suite.keeper.GetSchedule(suite.ctx, 1)
+	require.True(found)
+	_, found = suite.keeper.GetSchedule(suite.ctx, 2)
+	require.False(found)
+
+	suite.endBlock(12, at)
+	require.Equal(int64(996), suite.balance(suite.addrs[0]))
+	_, found = suite.keeper.GetSchedule(suite.ctx, 3)
+	require.False(found)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	require := suite.Require()
+
+	params := scheduler.DefaultParams()
+	params.MaxMsgs = 1
+
+	_, err := suite.msgSrvr.UpdateParams(sdk.WrapSDKContext(suite.ctx), &scheduler.MsgUpdateParams{
+		Authority: suite.addrs[0].String(),
+		Params:    params,
+	})
+	require.Error(err)
+
+	_, err = suite.msgSrvr.UpdateParams(sdk.WrapSDKContext(suite.ctx), &scheduler.MsgUpdateParams{
+		Authority: suite.keeper.GetAuthority(),
+		Params:    params,
+	})
+	require.NoError(err)
+	require.Equal(params, suite.keeper.GetParams(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	require := suite.Require()
+
+	at := suite.ctx.BlockTime().Add(time.Hour)
+	msg, err := scheduler.NewMsgSchedule(suite.addrs[0], []sdk.Msg{suite.send(1)}, 0, &at, 0, 0, 1, suite.fee)
+	require.NoError(err)
+	suite.schedule(msg)
+	msg, err = scheduler.NewMsgSchedule(suite.addrs[0], []sdk.Msg{suite.send(1)}, 11, nil, 1, 0, 2, nil)
+	require.NoError(err)
+	suite.schedule(msg)
+	suite.endBlock(11, suite.ctx.BlockTime())
+
+	genesis, err := suite.keeper.ExportGenesis(suite.ctx)
+	require.NoError(err)
+	require.NoError(scheduler.ValidateGenesis(*genesis))
+	require.Equal(uint64(3), genesis.NextScheduleId)
+	require.Len(genesis.Schedules, 2)
+	require.Len(genesis.Results, 1)
+
+	app := simapp.Setup(suite.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	require.NoError(app.SchedulerKeeper.InitGenesis(ctx, genesis))
+
+	imported, err := app.SchedulerKeeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Equal(genesis, imported)
+
+	// the queues are restored
+	ctx = ctx.WithBlockHeight(12)
+	require.NoError(app.SchedulerKeeper.ExecuteDueSchedules(ctx))
+	_, found := app.SchedulerKeeper.GetSchedule(ctx, 2)
+	require.False(found)
+}
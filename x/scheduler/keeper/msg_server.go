@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the scheduler MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(k Keeper) scheduler.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ scheduler.MsgServer = msgServer{}
+
+// Schedule schedules messages on behalf of the owner.
+func (k msgServer) Schedule(goCtx context.Context, msg *scheduler.MsgSchedule) (*scheduler.MsgScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, err := k.CreateSchedule(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &scheduler.MsgScheduleResponse{Id: id}, nil
+}
+
+// CancelSchedule cancels a schedule of the owner.
+func (k msgServer) CancelSchedule(goCtx context.Context, msg *scheduler.MsgCancelSchedule) (*scheduler.MsgCancelScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelSchedule(ctx, owner, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &scheduler.MsgCancelScheduleResponse{}, nil
+}
+
+// UpdateParams updates the parameters of the module.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *scheduler.MsgUpdateParams) (*scheduler.MsgUpdateParamsResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected authority %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	return &scheduler.MsgUpdateParamsResponse{}, nil
+}
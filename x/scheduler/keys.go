@@ -0,0 +1,106 @@
+package scheduler
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "scheduler"
+
+	// StoreKey is the store key string for scheduler
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for scheduler
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for scheduler
+	QuerierRoute = ModuleName
+)
+
+// Keys for scheduler store
+// Items are stored with the following key: values
+//
+// - 0x00: Params
+//
+// - 0x01: nextScheduleID
+//
+// - 0x02<id_Bytes>: Schedule
+//
+// - 0x03<owner_Bytes><id_Bytes>: []byte{}
+//
+// - 0x04<height_Bytes><id_Bytes>: []byte{}
+//
+// - 0x05<time_Bytes><id_Bytes>: []byte{}
+//
+// - 0x06<id_Bytes><execution_Bytes>: ExecutionResult
+var (
+	ParamsKey                = []byte{0x00}
+	NextScheduleIDKey        = []byte{0x01}
+	ScheduleKeyPrefix        = []byte{0x02}
+	ScheduleByOwnerKeyPrefix = []byte{0x03}
+	HeightQueueKeyPrefix     = []byte{0x04}
+	TimeQueueKeyPrefix       = []byte{0x05}
+	ExecutionResultKeyPrefix = []byte{0x06}
+)
+
+// ScheduleKey returns the key of a schedule.
+func ScheduleKey(id uint64) []byte {
+	return append(ScheduleKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// SchedulesByOwnerPrefix returns the prefix of the index of the schedules of
+// an owner.
+func SchedulesByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(ScheduleByOwnerKeyPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// ScheduleByOwnerKey returns the key of a schedule in the index of the
+// schedules of its owner.
+func ScheduleByOwnerKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(SchedulesByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// HeightQueueKey returns the key of a schedule in the queue of the height
+// triggered schedules.
+func HeightQueueKey(height int64, id uint64) []byte {
+	return append(HeightQueuePrefix(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// HeightQueuePrefix returns the prefix of the schedules due at a height in the
+// queue of the height triggered schedules.
+func HeightQueuePrefix(height int64) []byte {
+	return append(HeightQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// TimeQueueKey returns the key of a schedule in the queue of the time
+// triggered schedules.
+func TimeQueueKey(t time.Time, id uint64) []byte {
+	return append(TimeQueuePrefix(t), sdk.Uint64ToBigEndian(id)...)
+}
+
+// TimeQueuePrefix returns the prefix of the schedules due at a time in the
+// queue of the time triggered schedules.
+func TimeQueuePrefix(t time.Time) []byte {
+	return append(TimeQueueKeyPrefix, sdk.FormatTimeBytes(t)...)
+}
+
+// SplitQueueKey returns the identifier of the schedule of a key of one of the
+// queues.
+func SplitQueueKey(key []byte) uint64 {
+	return sdk.BigEndianToUint64(key[len(key)-8:])
+}
+
+// ExecutionResultsPrefix returns the prefix of the execution results of a
+// schedule.
+func ExecutionResultsPrefix(scheduleID uint64) []byte {
+	return append(ExecutionResultKeyPrefix, sdk.Uint64ToBigEndian(scheduleID)...)
+}
+
+// ExecutionResultKey returns the key of an execution result.
+func ExecutionResultKey(scheduleID, execution uint64) []byte {
+	return append(ExecutionResultsPrefix(scheduleID), sdk.Uint64ToBigEndian(execution)...)
+}
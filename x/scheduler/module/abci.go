@@ -0,0 +1,19 @@
+package module
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/keeper"
+)
+
+// EndBlocker executes the schedules due at the current block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(scheduler.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if err := k.ExecuteDueSchedules(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,167 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_, _, _ sdk.Msg            = &MsgSchedule{}, &MsgCancelSchedule{}, &MsgUpdateParams{}
+	_, _, _ legacytx.LegacyMsg = &MsgSchedule{}, &MsgCancelSchedule{}, &MsgUpdateParams{} // For amino support.
+
+	_ types.UnpackInterfacesMessage = MsgSchedule{}
+)
+
+// NewMsgSchedule creates a new MsgSchedule. Exactly one of height and t must be
+// set, with the matching interval for recurring schedules.
+//nolint:interfacer
+func NewMsgSchedule(
+	owner sdk.AccAddress, msgs []sdk.Msg, height int64, t *time.Time,
+	intervalBlocks uint64, interval time.Duration, executions uint64, executionFee sdk.Coins,
+) (*MsgSchedule, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSchedule{
+		Owner:          owner.String(),
+		Msgs:           anys,
+		Height:         height,
+		Time:           t,
+		IntervalBlocks: intervalBlocks,
+		Interval:       interval,
+		Executions:     executions,
+		ExecutionFee:   executionFee,
+	}, nil
+}
+
+// GetMessages returns the unpacked messages to schedule.
+func (msg MsgSchedule) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Msgs, "schedule")
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSchedule) ValidateBasic() error {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	if err := validateTrigger(msg.Height, msg.Time, msg.IntervalBlocks, msg.Interval, msg.Executions); err != nil {
+		return err
+	}
+	if !msg.ExecutionFee.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrap(msg.ExecutionFee.String())
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	return validateMsgs(owner, msgs)
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSchedule) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgSchedule) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgSchedule) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSchedule) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, msg.Msgs)
+}
+
+// NewMsgCancelSchedule creates a new MsgCancelSchedule.
+//nolint:interfacer
+func NewMsgCancelSchedule(owner sdk.AccAddress, id uint64) *MsgCancelSchedule {
+	return &MsgCancelSchedule{
+		Owner: owner.String(),
+		Id:    id,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	if msg.Id == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("schedule id must be positive")
+	}
+
+	return nil
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCancelSchedule) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgCancelSchedule) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgCancelSchedule) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgCancelSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgUpdateParams) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgUpdateParams) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
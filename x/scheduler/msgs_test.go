@@ -0,0 +1,102 @@
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+func TestMsgScheduleValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________")
+	other := sdk.AccAddress("other_______________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	send := banktypes.NewMsgSend(owner, other, coins)
+	now := time.Now()
+
+	cases := map[string]struct {
+		owner          sdk.AccAddress
+		msgs           []sdk.Msg
+		height         int64
+		time           *time.Time
+		intervalBlocks uint64
+		interval       time.Duration
+		executions     uint64
+		fee            sdk.Coins
+		valid          bool
+	}{
+		"one-off height": {owner: owner, msgs: []sdk.Msg{send}, height: 10, executions: 1, fee: coins, valid: true},
+		"recurring height": {
+			owner: owner, msgs: []sdk.Msg{send}, height: 10, intervalBlocks: 5, executions: 3, valid: true,
+		},
+		"recurring time": {
+			owner: owner, msgs: []sdk.Msg{send}, time: &now, interval: time.Hour, executions: 3, valid: true,
+		},
+		"no owner":          {msgs: []sdk.Msg{send}, height: 10, executions: 1},
+		"no messages":       {owner: owner, height: 10, executions: 1},
+		"not owner's msg":   {owner: other, msgs: []sdk.Msg{send}, height: 10, executions: 1},
+		"invalid msg":       {owner: owner, msgs: []sdk.Msg{banktypes.NewMsgSend(owner, other, nil)}, height: 10, executions: 1},
+		"no trigger":        {owner: owner, msgs: []sdk.Msg{send}, executions: 1},
+		"negative height":   {owner: owner, msgs: []sdk.Msg{send}, height: -1, executions: 1},
+		"height and time":   {owner: owner, msgs: []sdk.Msg{send}, height: 10, time: &now, executions: 1},
+		"no executions":     {owner: owner, msgs: []sdk.Msg{send}, height: 10},
+		"no interval":       {owner: owner, msgs: []sdk.Msg{send}, height: 10, executions: 2},
+		"duration interval": {owner: owner, msgs: []sdk.Msg{send}, height: 10, interval: time.Hour, executions: 2},
+		"blocks interval":   {owner: owner, msgs: []sdk.Msg{send}, time: &now, intervalBlocks: 5, executions: 2},
+		"invalid fee": {
+			owner: owner, msgs: []sdk.Msg{send}, height: 10, executions: 1,
+			fee: sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}},
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg, err := scheduler.NewMsgSchedule(tc.owner, tc.msgs, tc.height, tc.time, tc.intervalBlocks, tc.interval, tc.executions, tc.fee)
+			require.NoError(t, err)
+
+			err = msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{tc.owner}, msg.GetSigners())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCancelScheduleValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________")
+
+	require.NoError(t, scheduler.NewMsgCancelSchedule(owner, 1).ValidateBasic())
+	require.Error(t, scheduler.NewMsgCancelSchedule(owner, 0).ValidateBasic())
+	require.Error(t, scheduler.NewMsgCancelSchedule(nil, 1).ValidateBasic())
+}
+
+func TestParamsValidate(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
+
+	require.NoError(t, scheduler.DefaultParams().Validate())
+
+	params := scheduler.DefaultParams()
+	params.AllowedOwners = []string{owner}
+	require.NoError(t, params.Validate())
+	require.True(t, params.IsAllowedOwner(owner))
+	require.False(t, params.IsAllowedOwner(sdk.AccAddress("other_______________").String()))
+
+	params.AllowedOwners = []string{owner, owner}
+	require.Error(t, params.Validate())
+
+	params = scheduler.DefaultParams()
+	params.MaxExecutionsPerBlock = 0
+	require.Error(t, params.Validate())
+
+	params = scheduler.DefaultParams()
+	params.MaxGasPerExecution = 0
+	require.Error(t, params.Validate())
+}
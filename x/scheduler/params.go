@@ -0,0 +1,74 @@
+package scheduler
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Default parameter values
+const (
+	DefaultMaxMsgs               uint64 = 10
+	DefaultMaxExecutionsPerBlock uint64 = 100
+	DefaultMaxGasPerExecution    uint64 = 1_000_000
+)
+
+// NewParams creates a new Params instance
+func NewParams(allowedOwners []string, maxMsgs, maxExecutionsPerBlock, maxGasPerExecution uint64, minExecutionFee sdk.Coins) Params {
+	return Params{
+		AllowedOwners:         allowedOwners,
+		MaxMsgs:               maxMsgs,
+		MaxExecutionsPerBlock: maxExecutionsPerBlock,
+		MaxGasPerExecution:    maxGasPerExecution,
+		MinExecutionFee:       minExecutionFee,
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(nil, DefaultMaxMsgs, DefaultMaxExecutionsPerBlock, DefaultMaxGasPerExecution, nil)
+}
+
+// Validate performs basic validation on the parameters.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.AllowedOwners))
+	for _, owner := range p.AllowedOwners {
+		if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+			return fmt.Errorf("invalid allowed owner %s: %w", owner, err)
+		}
+		if seen[owner] {
+			return fmt.Errorf("duplicate allowed owner %s", owner)
+		}
+		seen[owner] = true
+	}
+	if p.MaxMsgs == 0 {
+		return fmt.Errorf("max msgs must be positive")
+	}
+	if p.MaxExecutionsPerBlock == 0 {
+		return fmt.Errorf("max executions per block must be positive")
+	}
+	if p.MaxGasPerExecution == 0 {
+		return fmt.Errorf("max gas per execution must be positive")
+	}
+	if err := p.MinExecutionFee.Validate(); err != nil {
+		return fmt.Errorf("invalid min execution fee: %w", err)
+	}
+
+	return nil
+}
+
+// IsAllowedOwner returns true if the address is allowed to create schedules,
+// ignoring the authority.
+func (p Params) IsAllowedOwner(owner string) bool {
+	if len(p.AllowedOwners) == 0 {
+		return true
+	}
+
+	for _, allowed := range p.AllowedOwners {
+		if allowed == owner {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,108 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = Schedule{}
+	_ types.UnpackInterfacesMessage = GenesisState{}
+)
+
+// GetMessages returns the unpacked messages of the schedule.
+func (s Schedule) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(s.Msgs, "schedule")
+}
+
+// IsHeightTriggered returns true if the schedule is executed at a height,
+// false if it is executed at a time.
+func (s Schedule) IsHeightTriggered() bool {
+	return s.NextTime == nil
+}
+
+// ValidateBasic performs stateless validation of the schedule.
+func (s Schedule) ValidateBasic() error {
+	owner, err := sdk.AccAddressFromBech32(s.Owner)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	if err := validateTrigger(s.NextHeight, s.NextTime, s.IntervalBlocks, s.Interval, s.RemainingExecutions); err != nil {
+		return err
+	}
+	if !s.ExecutionFee.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrap(s.ExecutionFee.String())
+	}
+
+	msgs, err := s.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	return validateMsgs(owner, msgs)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (s Schedule) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, s.Msgs)
+}
+
+// validateTrigger checks that a schedule is triggered by either a height or a
+// time, and that recurring schedules have an interval of the same kind.
+func validateTrigger(height int64, t *time.Time, intervalBlocks uint64, interval time.Duration, executions uint64) error {
+	switch {
+	case height < 0:
+		return ErrInvalidTrigger.Wrap("height must not be negative")
+	case height == 0 && t == nil:
+		return ErrInvalidTrigger.Wrap("either height or time must be set")
+	case height > 0 && t != nil:
+		return ErrInvalidTrigger.Wrap("height and time must not be both set")
+	case interval < 0:
+		return ErrInvalidTrigger.Wrap("interval must not be negative")
+	case executions == 0:
+		return ErrInvalidTrigger.Wrap("executions must be positive")
+	}
+
+	if t == nil {
+		if interval != 0 {
+			return ErrInvalidTrigger.Wrap("height triggered schedules must use interval blocks")
+		}
+		if executions > 1 && intervalBlocks == 0 {
+			return ErrInvalidTrigger.Wrap("recurring schedules must have a positive interval")
+		}
+	} else {
+		if intervalBlocks != 0 {
+			return ErrInvalidTrigger.Wrap("time triggered schedules must use an interval duration")
+		}
+		if executions > 1 && interval == 0 {
+			return ErrInvalidTrigger.Wrap("recurring schedules must have a positive interval")
+		}
+	}
+
+	return nil
+}
+
+// validateMsgs checks that the messages are valid and only signed by the
+// owner.
+func validateMsgs(owner sdk.AccAddress, msgs []sdk.Msg) error {
+	if len(msgs) == 0 {
+		return ErrNoMessages
+	}
+
+	for i, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			if !signer.Equals(owner) {
+				return sdkerrors.ErrUnauthorized.Wrapf("msg %d must only be signed by the owner, got signer %s", i, signer)
+			}
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "msg %d", i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,1548 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/scheduler/v1beta1/scheduler.proto
+
+package scheduler
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Schedule defines messages executed on behalf of their owner at a future
+// height or time, once or on a recurring basis.
+type Schedule struct {
+	// id is the unique identifier of the schedule.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the account which created the schedule. It must
+	// be the signer of all the messages.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// msgs are the messages executed, in order and atomically, on every
+	// execution.
+	Msgs []*types.Any `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// next_height is the height of the next execution of a height triggered
+	// schedule, zero for time triggered schedules.
+	NextHeight int64 `protobuf:"varint,4,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// next_time is the time of the next execution of a time triggered schedule,
+	// nil for height triggered schedules.
+	NextTime *time.Time `protobuf:"bytes,5,opt,name=next_time,json=nextTime,proto3,stdtime" json:"next_time,omitempty"`
+	// interval_blocks is the number of blocks between two executions of a
+	// recurring height triggered schedule.
+	IntervalBlocks uint64 `protobuf:"varint,6,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// interval is the duration between two executions of a recurring time
+	// triggered schedule.
+	Interval time.Duration `protobuf:"bytes,7,opt,name=interval,proto3,stdduration" json:"interval"`
+	// remaining_executions is the number of executions left.
+	RemainingExecutions uint64 `protobuf:"varint,8,opt,name=remaining_executions,json=remainingExecutions,proto3" json:"remaining_executions,omitempty"`
+	// execution_fee is paid to the fee collector on every execution, from the
+	// fees escrowed when the schedule was created.
+	ExecutionFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=execution_fee,json=executionFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"execution_fee"`
+	// executions is the number of executions done.
+	Executions uint64 `protobuf:"varint,10,opt,name=executions,proto3" json:"executions,omitempty"`
+}
+
+func (m *Schedule) Reset()         { *m = Schedule{} }
+func (m *Schedule) String() string { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()    {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_646a3496f8aa19ec, []int{0}
+}
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
+func (m *Schedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Schedule) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Schedule) GetMsgs() []*types.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+func (m *Schedule) GetNextHeight() int64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
+func (m *Schedule) GetNextTime() *time.Time {
+	if m != nil {
+		return m.NextTime
+	}
+	return nil
+}
+
+func (m *Schedule) GetIntervalBlocks() uint64 {
+	if m != nil {
+		return m.IntervalBlocks
+	}
+	return 0
+}
+
+func (m *Schedule) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *Schedule) GetRemainingExecutions() uint64 {
+	if m != nil {
+		return m.RemainingExecutions
+	}
+	return 0
+}
+
+func (m *Schedule) GetExecutionFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ExecutionFee
+	}
+	return nil
+}
+
+func (m *Schedule) GetExecutions() uint64 {
+	if m != nil {
+		return m.Executions
+	}
+	return 0
+}
+
+// ExecutionResult records the outcome of an execution of a schedule.
+type ExecutionResult struct {
+	// schedule_id is the identifier of the schedule executed.
+	ScheduleId uint64 `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// execution is the sequence number of the execution, starting at 1.
+	Execution uint64 `protobuf:"varint,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	// height is the height of the block in which the messages were executed.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the time of the block in which the messages were executed.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// success is true if all the messages were executed successfully. The
+	// state changes of the messages are discarded otherwise.
+	Success bool `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// error is the error returned by the failing message, if any.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// gas_used is the gas consumed by the messages.
+	GasUsed uint64 `protobuf:"varint,7,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *ExecutionResult) Reset()         { *m = ExecutionResult{} }
+func (m *ExecutionResult) String() string { return proto.CompactTextString(m) }
+func (*ExecutionResult) ProtoMessage()    {}
+func (*ExecutionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_646a3496f8aa19ec, []int{1}
+}
+func (m *ExecutionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionResult.Merge(m, src)
+}
+func (m *ExecutionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionResult proto.InternalMessageInfo
+
+func (m *ExecutionResult) GetScheduleId() uint64 {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return 0
+}
+
+func (m *ExecutionResult) GetExecution() uint64 {
+	if m != nil {
+		return m.Execution
+	}
+	return 0
+}
+
+func (m *ExecutionResult) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ExecutionResult) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *ExecutionResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ExecutionResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ExecutionResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+// Params defines the parameters of the scheduler module.
+type Params struct {
+	// allowed_owners are the addresses allowed to create schedules besides the
+	// authority. Any account can create schedules if it is empty.
+	AllowedOwners []string `protobuf:"bytes,1,rep,name=allowed_owners,json=allowedOwners,proto3" json:"allowed_owners,omitempty"`
+	// max_msgs is the maximum number of messages of a schedule.
+	MaxMsgs uint64 `protobuf:"varint,2,opt,name=max_msgs,json=maxMsgs,proto3" json:"max_msgs,omitempty"`
+	// max_executions_per_block is the maximum number of executions per block.
+	// The due executions exceeding it are delayed to the next blocks.
+	MaxExecutionsPerBlock uint64 `protobuf:"varint,3,opt,name=max_executions_per_block,json=maxExecutionsPerBlock,proto3" json:"max_executions_per_block,omitempty"`
+	// max_gas_per_execution is the gas limit of the messages of an execution.
+	MaxGasPerExecution uint64 `protobuf:"varint,4,opt,name=max_gas_per_execution,json=maxGasPerExecution,proto3" json:"max_gas_per_execution,omitempty"`
+	// min_execution_fee is the minimum fee per execution of the schedules not
+	// owned by the authority.
+	MinExecutionFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=min_execution_fee,json=minExecutionFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_execution_fee"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_646a3496f8aa19ec, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAllowedOwners() []string {
+	if m != nil {
+		return m.AllowedOwners
+	}
+	return nil
+}
+
+func (m *Params) GetMaxMsgs() uint64 {
+	if m != nil {
+		return m.MaxMsgs
+	}
+	return 0
+}
+
+func (m *Params) GetMaxExecutionsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxExecutionsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGasPerExecution() uint64 {
+	if m != nil {
+		return m.MaxGasPerExecution
+	}
+	return 0
+}
+
+func (m *Params) GetMinExecutionFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinExecutionFee
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Schedule)(nil), "cosmos.scheduler.v1beta1.Schedule")
+	proto.RegisterType((*ExecutionResult)(nil), "cosmos.scheduler.v1beta1.ExecutionResult")
+	proto.RegisterType((*Params)(nil), "cosmos.scheduler.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/scheduler/v1beta1/scheduler.proto", fileDescriptor_646a3496f8aa19ec)
+}
+
+var fileDescriptor_646a3496f8aa19ec = []byte{
+	// 699 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0x8e, 0x13, 0x37, 0x1f, 0x17, 0xda, 0x8a, 0x23, 0x20, 0xa7, 0x42, 0x4e, 0x54, 0x09, 0xe1,
+	0xa5, 0x0e, 0x29, 0x42, 0xb0, 0x20, 0x84, 0xa1, 0x7c, 0x0c, 0x15, 0x95, 0x81, 0x85, 0xc5, 0xba,
+	0xd8, 0x57, 0xc7, 0xaa, 0xed, 0x8b, 0x7c, 0x76, 0xeb, 0xfe, 0x8b, 0x8e, 0xfc, 0x06, 0x16, 0x16,
+	0x7e, 0x44, 0xc5, 0xd4, 0x91, 0x89, 0xa2, 0x76, 0x66, 0x65, 0x46, 0xf7, 0xfa, 0x6c, 0x97, 0x56,
+	0x82, 0x85, 0x29, 0x79, 0x9f, 0xf7, 0x79, 0xbf, 0xee, 0x79, 0x64, 0x64, 0xb8, 0x8c, 0x47, 0x8c,
+	0x4f, 0xb8, 0x3b, 0xa7, 0x5e, 0x16, 0xd2, 0x64, 0xb2, 0x3f, 0x9d, 0xd1, 0x94, 0x4c, 0x6b, 0xc4,
+	0x5c, 0x24, 0x2c, 0x65, 0x58, 0x2b, 0x98, 0x66, 0x8d, 0x4b, 0xe6, 0xda, 0xc0, 0x67, 0x3e, 0x03,
+	0xd2, 0x44, 0xfc, 0x2b, 0xf8, 0x6b, 0x43, 0x9f, 0x31, 0x3f, 0xa4, 0x13, 0x88, 0x66, 0xd9, 0xee,
+	0x84, 0xc4, 0x87, 0x32, 0xa5, 0x5f, 0x4e, 0x79, 0x59, 0x42, 0xd2, 0x80, 0xc5, 0x32, 0x3f, 0xba,
+	0x9c, 0x4f, 0x83, 0x88, 0xf2, 0x94, 0x44, 0x8b, 0xb2, 0x77, 0xb1, 0x8b, 0x53, 0x0c, 0x95, 0x8b,
+	0xc9, 0xde, 0xf2, 0xa0, 0x19, 0xe1, 0xb4, 0xba, 0xc5, 0x65, 0x81, 0xec, 0xbd, 0xfe, 0xab, 0x85,
+	0xba, 0x6f, 0xe5, 0x09, 0x78, 0x05, 0x35, 0x03, 0x4f, 0x53, 0xc6, 0x8a, 0xa1, 0xda, 0xcd, 0xc0,
+	0xc3, 0x03, 0xb4, 0xc4, 0x0e, 0x62, 0x9a, 0x68, 0xcd, 0xb1, 0x62, 0xf4, 0xec, 0x22, 0xc0, 0x0f,
+	0x90, 0x1a, 0x71, 0x9f, 0x6b, 0xad, 0x71, 0xcb, 0xe8, 0x6f, 0x0e, 0xcc, 0x62, 0x3b, 0xb3, 0xdc,
+	0xce, 0x7c, 0x1a, 0x1f, 0x5a, 0xfd, 0xaf, 0x5f, 0x36, 0x3a, 0xdc, 0xdb, 0x33, 0xb7, 0xb9, 0x6f,
+	0x03, 0x1d, 0x8f, 0x50, 0x3f, 0xa6, 0x79, 0xea, 0xcc, 0x69, 0xe0, 0xcf, 0x53, 0x4d, 0x1d, 0x2b,
+	0x46, 0xcb, 0x46, 0x02, 0x7a, 0x05, 0x08, 0x7e, 0x8c, 0x7a, 0x40, 0x10, 0xd7, 0x69, 0x4b, 0x63,
+	0xc5, 0xe8, 0x6f, 0xae, 0x5d, 0x69, 0xfe, 0xae, 0x3c, 0xdd, 0x52, 0x8f, 0x4e, 0x47, 0x8a, 0xdd,
+	0x15, 0x25, 0x02, 0xc4, 0x77, 0xd1, 0x6a, 0x10, 0xa7, 0x34, 0xd9, 0x27, 0xa1, 0x33, 0x0b, 0x99,
+	0xbb, 0xc7, 0xb5, 0x36, 0x5c, 0xb2, 0x52, 0xc2, 0x16, 0xa0, 0xf8, 0x09, 0xea, 0x96, 0x88, 0xd6,
+	0x81, 0x31, 0xc3, 0x2b, 0x63, 0x9e, 0x4b, 0x05, 0xac, 0xee, 0xf1, 0xf7, 0x51, 0xe3, 0x23, 0x4c,
+	0x2a, 0x8b, 0xf0, 0x14, 0x0d, 0x12, 0x1a, 0x91, 0x20, 0x0e, 0x62, 0xdf, 0xa1, 0x39, 0x75, 0x33,
+	0x41, 0xe5, 0x5a, 0x17, 0xc6, 0xdd, 0xa8, 0x72, 0x5b, 0x55, 0x0a, 0x2f, 0xd0, 0x72, 0x45, 0x74,
+	0x76, 0x29, 0xd5, 0x7a, 0xf0, 0x78, 0x43, 0x53, 0x8a, 0x25, 0xe4, 0x29, 0x0d, 0x64, 0x3e, 0x63,
+	0x41, 0x6c, 0xdd, 0x13, 0x83, 0x3f, 0x9d, 0x8e, 0x0c, 0x3f, 0x48, 0xe7, 0xd9, 0xcc, 0x74, 0x59,
+	0x24, 0x95, 0x95, 0x3f, 0x1b, 0xdc, 0xdb, 0x9b, 0xa4, 0x87, 0x0b, 0xca, 0xa1, 0x80, 0xdb, 0xd7,
+	0xaa, 0x09, 0x2f, 0x28, 0xc5, 0x3a, 0x42, 0x17, 0x56, 0x43, 0xb0, 0xda, 0x05, 0x64, 0xfd, 0xa7,
+	0x82, 0x56, 0xab, 0x05, 0x6d, 0xca, 0xb3, 0x30, 0x15, 0x12, 0x95, 0x76, 0x76, 0x2a, 0x23, 0xa0,
+	0x12, 0x7a, 0xed, 0xe1, 0xdb, 0xa8, 0x57, 0xb5, 0x00, 0x53, 0xa8, 0x76, 0x0d, 0xe0, 0x5b, 0xa8,
+	0x2d, 0xc5, 0x6d, 0x81, 0xb8, 0x32, 0xc2, 0x8f, 0x90, 0x0a, 0x9a, 0xaa, 0xff, 0xd4, 0x14, 0x5e,
+	0x1b, 0x74, 0x85, 0x0a, 0xac, 0xa1, 0x0e, 0xcf, 0x5c, 0x97, 0x72, 0x0e, 0x86, 0xe8, 0xda, 0x65,
+	0x28, 0xac, 0x49, 0x93, 0x84, 0x25, 0xa0, 0x71, 0xcf, 0x2e, 0x02, 0x3c, 0x44, 0x5d, 0x9f, 0x70,
+	0x27, 0xe3, 0xd4, 0x03, 0x69, 0x55, 0xbb, 0xe3, 0x13, 0xfe, 0x9e, 0x53, 0x6f, 0xfd, 0x73, 0x13,
+	0xb5, 0x77, 0x48, 0x42, 0x22, 0x8e, 0xef, 0xa0, 0x15, 0x12, 0x86, 0xec, 0x80, 0x7a, 0x0e, 0x38,
+	0x9a, 0x6b, 0xca, 0xb8, 0x65, 0xf4, 0xec, 0x65, 0x89, 0xbe, 0x01, 0x50, 0x34, 0x8b, 0x48, 0xee,
+	0x80, 0xd7, 0x8b, 0x5b, 0x3b, 0x11, 0xc9, 0xb7, 0x85, 0x97, 0x1f, 0x22, 0x4d, 0xa4, 0xea, 0xe7,
+	0x74, 0x16, 0x34, 0x29, 0x5c, 0x07, 0xb7, 0xab, 0xf6, 0xcd, 0x88, 0xe4, 0xb5, 0xfe, 0x3b, 0x34,
+	0x01, 0xf3, 0xe1, 0x29, 0x12, 0x09, 0x47, 0x2c, 0x29, 0x2a, 0xea, 0xc7, 0x54, 0xa1, 0x0a, 0x47,
+	0x24, 0x7f, 0x49, 0x04, 0xbd, 0xaa, 0xc5, 0x07, 0xe8, 0x7a, 0x14, 0xc4, 0xce, 0x9f, 0xf6, 0x59,
+	0xfa, 0xff, 0xf6, 0x59, 0x8d, 0x82, 0x78, 0xeb, 0x82, 0x83, 0x2c, 0xeb, 0xf8, 0x4c, 0x57, 0x4e,
+	0xce, 0x74, 0xe5, 0xc7, 0x99, 0xae, 0x1c, 0x9d, 0xeb, 0x8d, 0x93, 0x73, 0xbd, 0xf1, 0xed, 0x5c,
+	0x6f, 0x7c, 0xf8, 0x7b, 0xd3, 0xbc, 0xfe, 0x56, 0xce, 0xda, 0x20, 0xf2, 0xfd, 0xdf, 0x01, 0x00,
+	0x00, 0xff, 0xff, 0x3d, 0x65, 0xcd, 0xec, 0x58, 0x05, 0x00, 0x00,
+}
+
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executions != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ExecutionFee) > 0 {
+		for iNdEx := len(m.ExecutionFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScheduler(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.RemainingExecutions != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.RemainingExecutions))
+		i--
+		dAtA[i] = 0x40
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintScheduler(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.IntervalBlocks != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.IntervalBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NextTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NextTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintScheduler(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScheduler(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintScheduler(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintScheduler(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintScheduler(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Execution != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.Execution))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ScheduleId != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinExecutionFee) > 0 {
+		for iNdEx := len(m.MinExecutionFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinExecutionFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScheduler(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxGasPerExecution != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.MaxGasPerExecution))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxExecutionsPerBlock != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.MaxExecutionsPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxMsgs != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.MaxMsgs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AllowedOwners) > 0 {
+		for iNdEx := len(m.AllowedOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedOwners[iNdEx])
+			copy(dAtA[i:], m.AllowedOwners[iNdEx])
+			i = encodeVarintScheduler(dAtA, i, uint64(len(m.AllowedOwners[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScheduler(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScheduler(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovScheduler(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovScheduler(uint64(l))
+		}
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovScheduler(uint64(m.NextHeight))
+	}
+	if m.NextTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextTime)
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	if m.IntervalBlocks != 0 {
+		n += 1 + sovScheduler(uint64(m.IntervalBlocks))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovScheduler(uint64(l))
+	if m.RemainingExecutions != 0 {
+		n += 1 + sovScheduler(uint64(m.RemainingExecutions))
+	}
+	if len(m.ExecutionFee) > 0 {
+		for _, e := range m.ExecutionFee {
+			l = e.Size()
+			n += 1 + l + sovScheduler(uint64(l))
+		}
+	}
+	if m.Executions != 0 {
+		n += 1 + sovScheduler(uint64(m.Executions))
+	}
+	return n
+}
+
+func (m *ExecutionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduleId != 0 {
+		n += 1 + sovScheduler(uint64(m.ScheduleId))
+	}
+	if m.Execution != 0 {
+		n += 1 + sovScheduler(uint64(m.Execution))
+	}
+	if m.Height != 0 {
+		n += 1 + sovScheduler(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovScheduler(uint64(l))
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovScheduler(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedOwners) > 0 {
+		for _, s := range m.AllowedOwners {
+			l = len(s)
+			n += 1 + l + sovScheduler(uint64(l))
+		}
+	}
+	if m.MaxMsgs != 0 {
+		n += 1 + sovScheduler(uint64(m.MaxMsgs))
+	}
+	if m.MaxExecutionsPerBlock != 0 {
+		n += 1 + sovScheduler(uint64(m.MaxExecutionsPerBlock))
+	}
+	if m.MaxGasPerExecution != 0 {
+		n += 1 + sovScheduler(uint64(m.MaxGasPerExecution))
+	}
+	if len(m.MinExecutionFee) > 0 {
+		for _, e := range m.MinExecutionFee {
+			l = e.Size()
+			n += 1 + l + sovScheduler(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovScheduler(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScheduler(x uint64) (n int) {
+	return sovScheduler(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Schedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextTime == nil {
+				m.NextTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NextTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+			}
+			m.IntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingExecutions", wireType)
+			}
+			m.RemainingExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionFee = append(m.ExecutionFee, types1.Coin{})
+			if err := m.ExecutionFee[len(m.ExecutionFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			m.Execution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Execution |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedOwners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedOwners = append(m.AllowedOwners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgs", wireType)
+			}
+			m.MaxMsgs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutionsPerBlock", wireType)
+			}
+			m.MaxExecutionsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutionsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerExecution", wireType)
+			}
+			m.MaxGasPerExecution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerExecution |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinExecutionFee = append(m.MinExecutionFee, types1.Coin{})
+			if err := m.MinExecutionFee[len(m.MinExecutionFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipScheduler(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowScheduler
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthScheduler
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupScheduler
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthScheduler
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthScheduler        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowScheduler          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupScheduler = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,113 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// Failure is a replayable record of a failed simulation run, exported when
+// the run panics, e.g. on a broken invariant, or an operation fails.
+type Failure struct {
+	// Config is the configuration of the failed run.
+	Config simulation.Config `json:"config"`
+	// Height is the height of the block during which the run failed.
+	Height int64 `json:"height"`
+	// Reason describes the failure.
+	Reason string `json:"reason"`
+	// Operations lists the operations run up to the failure, the last ones
+	// being the failed operation or the block during which the run panicked.
+	Operations []OperationEntry `json:"operations"`
+}
+
+// ReplayConfig returns the configuration replaying the failed run with the
+// same seed up to the block during which it failed, as a deterministic
+// regression test. Exports are disabled so that the replay does not overwrite
+// the exported files of the failed run, including the failure itself.
+func (f Failure) ReplayConfig() simulation.Config {
+	config := f.Config
+	config.NumBlocks = int(f.Height)
+	config.ExportFailurePath = ""
+	config.ExportParamsPath = ""
+	config.ExportStatePath = ""
+	config.ExportStatsPath = ""
+
+	return config
+}
+
+// ExportJSON saves the failure as a JSON file on a given path.
+func (f Failure) ExportJSON(path string) error {
+	bz, err := json.MarshalIndent(f, "", " ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, bz, 0600)
+}
+
+// LoadFailure loads a failure exported by a simulation run from a given path.
+func LoadFailure(path string) (Failure, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Failure{}, err
+	}
+
+	var f Failure
+	if err := json.Unmarshal(bz, &f); err != nil {
+		return Failure{}, fmt.Errorf("failed to decode simulation failure %s: %w", path, err)
+	}
+
+	return f, nil
+}
+
+// failureRecorder records the operations of a simulation run in addition to
+// logging them, so that a failure of the run can be exported.
+type failureRecorder struct {
+	LogWriter
+
+	config     simulation.Config
+	operations []OperationEntry
+}
+
+func newFailureRecorder(logWriter LogWriter, config simulation.Config) *failureRecorder {
+	return &failureRecorder{LogWriter: logWriter, config: config}
+}
+
+// AddEntry implements LogWriter.
+func (fr *failureRecorder) AddEntry(entry OperationEntry) {
+	fr.operations = append(fr.operations, entry)
+	fr.LogWriter.AddEntry(entry)
+}
+
+// exportOnFailure exports the run as a Failure if it panicked or tb failed.
+// It must be deferred, and re-panics after exporting a panicking run.
+func (fr *failureRecorder) exportOnFailure(tb testing.TB, height func() int64) {
+	r := recover()
+	if r == nil && !tb.Failed() {
+		return
+	}
+
+	reason := "operation failed"
+	if r != nil {
+		reason = fmt.Sprintf("panic: %v", r)
+	}
+
+	f := Failure{
+		Config:     fr.config,
+		Height:     height(),
+		Reason:     reason,
+		Operations: fr.operations,
+	}
+	if err := f.ExportJSON(fr.config.ExportFailurePath); err != nil {
+		tb.Logf("failed to export simulation failure: %s", err)
+	} else {
+		tb.Logf("exported simulation failure at block %d to %s", f.Height, fr.config.ExportFailurePath)
+	}
+
+	if r != nil {
+		panic(r)
+	}
+}
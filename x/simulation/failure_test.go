@@ -0,0 +1,58 @@
+package simulation
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestFailureExportOnPanic(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "failure.json")
+	config := simtypes.Config{
+		Seed:              11,
+		NumBlocks:         500,
+		BlockSize:         200,
+		ExportFailurePath: path,
+		ExportStatsPath:   "stats.json",
+	}
+
+	recorder := newFailureRecorder(&DummyLogWriter{}, config)
+	height := int64(1)
+	require.PanicsWithValue(t, "invariant broken", func() {
+		defer recorder.exportOnFailure(t, func() int64 { return height })
+
+		recorder.AddEntry(BeginBlockEntry(1))
+		recorder.AddEntry(EndBlockEntry(1))
+		height++
+		recorder.AddEntry(BeginBlockEntry(2))
+		panic("invariant broken")
+	})
+
+	failure, err := LoadFailure(path)
+	require.NoError(t, err)
+	require.Equal(t, config, failure.Config)
+	require.Equal(t, int64(2), failure.Height)
+	require.Equal(t, "panic: invariant broken", failure.Reason)
+	require.Len(t, failure.Operations, 3)
+	require.Equal(t, BeginBlockEntryKind, failure.Operations[2].EntryKind)
+	require.Equal(t, int64(2), failure.Operations[2].Height)
+
+	// the failed run is replayed up to the failing block, without exports
+	require.Equal(t, simtypes.Config{Seed: 11, NumBlocks: 2, BlockSize: 200}, failure.ReplayConfig())
+}
+
+func TestFailureNotExportedOnSuccess(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "failure.json")
+	recorder := newFailureRecorder(&DummyLogWriter{}, simtypes.Config{ExportFailurePath: path})
+
+	func() {
+		defer recorder.exportOnFailure(t, func() int64 { return 1 })
+		recorder.AddEntry(BeginBlockEntry(1))
+	}()
+
+	_, err := LoadFailure(path)
+	require.Error(t, err)
+}
@@ -62,6 +62,7 @@ func SimulateFromSeed(
 ) (stopEarly bool, exportedParams Params, err error) {
 	// in case we have to end early, don't os.Exit so that we can run cleanup code.
 	testingMode, _, b := getTestingMode(tb)
+	runConfig := config
 
 	fmt.Fprintf(w, "Starting SimulateFromSeed with randomness created with seed %d\n", int(config.Seed))
 	r := rand.New(rand.NewSource(config.Seed))
@@ -132,6 +133,13 @@ func SimulateFromSeed(
 
 	logWriter := NewLogWriter(testingMode)
 
+	// record the operations to export them should the run fail
+	var recorder *failureRecorder
+	if config.ExportFailurePath != "" {
+		recorder = newFailureRecorder(logWriter, runConfig)
+		logWriter = recorder
+	}
+
 	blockSimulator := createBlockSimulator(
 		testingMode, tb, w, params, eventStats.Tally,
 		ops, operationQueue, timeOperationQueue, logWriter, config)
@@ -149,6 +157,12 @@ func SimulateFromSeed(
 		}()
 	}
 
+	// deferred after the log recovery above so that the failure is exported
+	// before re-panicking
+	if recorder != nil {
+		defer recorder.exportOnFailure(tb, func() int64 { return header.Height })
+	}
+
 	// set exported params to the initial state
 	if config.ExportParamsPath != "" && config.ExportParamsHeight == 0 {
 		exportedParams = params
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -27,6 +29,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQueryMissedBlocks(),
+		GetCmdQuerySlashDryRun(),
 	)
 
 	return slashingQueryCmd
@@ -109,6 +113,113 @@ $ <appd> query slashing signing-infos
 	return cmd
 }
 
+// GetCmdQueryMissedBlocks implements the command to query the missed blocks of
+// a validator.
+func GetCmdQueryMissedBlocks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "missed-blocks [validator-conspub]",
+		Short: "Query the blocks missed by a validator within the current signed blocks window",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to find the blocks missed by that validator within the current signed blocks window, with the heights at which they were missed:
+
+$ <appd> query slashing missed-blocks '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"OauFcTKbN5Lx3fJL689cikXBqe+hcp6Y+x0rYUdR9Jk="}'
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			consAddr := sdk.ConsAddress(pk.Address())
+			params := &types.QueryMissedBlocksRequest{ConsAddress: consAddr.String(), Pagination: pageReq}
+			res, err := queryClient.MissedBlocks(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "missed blocks")
+
+	return cmd
+}
+
+// GetCmdQuerySlashDryRun implements the command to compute the stake a
+// hypothetical infraction of a validator would slash.
+func GetCmdQuerySlashDryRun() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-dry-run [validator-conspub] [double-sign|downtime] [infraction-height]",
+		Short: "Query the delegations, unbonding delegations and redelegations a hypothetical infraction would slash",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to compute which delegations, unbonding delegations and redelegations would be slashed, and by how much, for an infraction of that validator at a height, without modifying the state. The slash fraction defaults to the slash fraction parameter of the infraction type:
+
+$ <appd> query slashing slash-dry-run '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"OauFcTKbN5Lx3fJL689cikXBqe+hcp6Y+x0rYUdR9Jk="}' double-sign 1000 --slash-fraction 0.1
+`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			var infraction types.Infraction
+			switch args[1] {
+			case "double-sign":
+				infraction = types.InfractionDoubleSign
+			case "downtime":
+				infraction = types.InfractionDowntime
+			default:
+				return fmt.Errorf("invalid infraction %s, expected double-sign or downtime", args[1])
+			}
+
+			height, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid infraction height %s: %w", args[2], err)
+			}
+
+			fraction, err := cmd.Flags().GetString(FlagSlashFraction)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			consAddr := sdk.ConsAddress(pk.Address())
+			params := &types.QuerySlashDryRunRequest{
+				ConsAddress: consAddr.String(), Infraction: infraction, InfractionHeight: height, SlashFraction: fraction,
+			}
+			res, err := queryClient.SlashDryRun(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagSlashFraction, "", "Slash fraction, the slash fraction parameter of the infraction type if empty")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
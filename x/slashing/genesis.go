@@ -36,10 +36,31 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, stakingKeeper types.Stak
 		}
 		for _, missed := range array.MissedBlocks {
 			keeper.SetValidatorMissedBlockBitArray(ctx, address, missed.Index, missed.Missed)
+			if missed.Missed && missed.Height > 0 {
+				keeper.SetValidatorMissedBlockHeight(ctx, address, missed.Index, missed.Height)
+			}
 		}
 	}
 
 	keeper.SetParams(ctx, data.Params)
+
+	// rebuild the automatic unjail queue from the signing infos of the
+	// validators jailed for downtime
+	if keeper.AutoUnjailEpochLength(ctx) > 0 {
+		for _, info := range data.SigningInfos {
+			if info.ValidatorSigningInfo.Tombstoned || info.ValidatorSigningInfo.JailedUntil.IsZero() {
+				continue
+			}
+			address, err := sdk.ConsAddressFromBech32(info.Address)
+			if err != nil {
+				panic(err)
+			}
+			validator := stakingKeeper.ValidatorByConsAddr(ctx, address)
+			if validator != nil && validator.IsJailed() {
+				keeper.InsertAutoUnjailQueue(ctx, address, info.ValidatorSigningInfo.JailedUntil)
+			}
+		}
+	}
 }
 
 // ExportGenesis writes the current store values
@@ -29,11 +29,15 @@ func TestExportAndInitGenesis(t *testing.T) {
 
 	app.SlashingKeeper.SetValidatorSigningInfo(ctx, sdk.ConsAddress(addrDels[0]), info1)
 	app.SlashingKeeper.SetValidatorSigningInfo(ctx, sdk.ConsAddress(addrDels[1]), info2)
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(addrDels[0]), 2, true)
+	app.SlashingKeeper.SetValidatorMissedBlockHeight(ctx, sdk.ConsAddress(addrDels[0]), 2, 7)
+	missedBlocks := []types.MissedBlock{{Index: 2, Missed: true, Height: 7}}
 	genesisState := slashing.ExportGenesis(ctx, app.SlashingKeeper)
 
 	require.Equal(t, genesisState.Params, testslashing.TestParams())
 	require.Len(t, genesisState.SigningInfos, 2)
 	require.Equal(t, genesisState.SigningInfos[0].ValidatorSigningInfo, info1)
+	require.Equal(t, missedBlocks, genesisState.MissedBlocks[0].MissedBlocks)
 
 	// Tombstone validators after genesis shouldn't effect genesis state
 	app.SlashingKeeper.Tombstone(ctx, sdk.ConsAddress(addrDels[0]))
@@ -56,4 +60,5 @@ func TestExportAndInitGenesis(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, info1, newInfo1)
 	require.Equal(t, info2, newInfo2)
+	require.Equal(t, missedBlocks, app.SlashingKeeper.GetValidatorMissedBlocks(ctx, sdk.ConsAddress(addrDels[0])))
 }
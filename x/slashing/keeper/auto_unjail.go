@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// InsertAutoUnjailQueue inserts a validator jailed for downtime into the
+// automatic unjail queue, to be unjailed at the first epoch boundary after the
+// end of its jail period
+func (k Keeper) InsertAutoUnjailQueue(ctx sdk.Context, consAddr sdk.ConsAddress, jailedUntil time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AutoUnjailQueueKey(consAddr, jailedUntil), []byte{})
+}
+
+// RemoveFromAutoUnjailQueue removes a validator from the automatic unjail queue
+func (k Keeper) RemoveFromAutoUnjailQueue(ctx sdk.Context, consAddr sdk.ConsAddress, jailedUntil time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AutoUnjailQueueKey(consAddr, jailedUntil))
+}
+
+// IterateAutoUnjailQueue iterates over the validators of the automatic unjail
+// queue whose jail period ended by endTime and performs a callback function
+func (k Keeper) IterateAutoUnjailQueue(
+	ctx sdk.Context, endTime time.Time, cb func(consAddr sdk.ConsAddress, jailedUntil time.Time) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.AutoUnjailQueueKeyPrefix, sdk.PrefixEndBytes(types.AutoUnjailQueueByTimeKey(endTime)))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		consAddr, jailedUntil := types.SplitAutoUnjailQueueKey(iterator.Key())
+
+		if cb(consAddr, jailedUntil) {
+			break
+		}
+	}
+}
+
+// ProcessAutoUnjailQueue unjails the validators of the automatic unjail queue
+// whose jail period ended if the block is at an auto unjail epoch boundary. Each attempt
+// is removed from the queue and emits an event with its result: validators
+// which can't be unjailed, e.g. because their self-delegation is below their
+// minimum self-delegation, have to be unjailed with MsgUnjail.
+func (k Keeper) ProcessAutoUnjailQueue(ctx sdk.Context) {
+	epochLength := k.AutoUnjailEpochLength(ctx)
+	if epochLength == 0 || ctx.BlockHeight()%int64(epochLength) != 0 {
+		return
+	}
+
+	type entry struct {
+		consAddr    sdk.ConsAddress
+		jailedUntil time.Time
+	}
+
+	// collect the validators first as the queue is mutated by the unjailing
+	var entries []entry
+	k.IterateAutoUnjailQueue(ctx, ctx.BlockHeader().Time, func(consAddr sdk.ConsAddress, jailedUntil time.Time) bool {
+		entries = append(entries, entry{consAddr, jailedUntil})
+		return false
+	})
+
+	for _, e := range entries {
+		k.RemoveFromAutoUnjailQueue(ctx, e.consAddr, e.jailedUntil)
+		k.autoUnjail(ctx, e.consAddr)
+	}
+}
+
+// autoUnjail attempts to unjail a validator of the automatic unjail queue,
+// skipping the validators which were unjailed with MsgUnjail or jailed again
+// since they were queued.
+func (k Keeper) autoUnjail(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	validator := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil || !validator.IsJailed() {
+		return
+	}
+
+	if info, found := k.GetValidatorSigningInfo(ctx, consAddr); found && ctx.BlockHeader().Time.Before(info.JailedUntil) {
+		return
+	}
+
+	if err := k.Unjail(ctx, validator.GetOperator()); err != nil {
+		k.Logger(ctx).Info("failed to unjail validator automatically", "validator", consAddr.String(), "err", err)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoUnjail,
+				sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyResult, types.AttributeValueFailure),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoUnjail,
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyResult, types.AttributeValueSuccess),
+		),
+	)
+}
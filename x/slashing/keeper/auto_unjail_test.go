@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+func TestProcessAutoUnjailQueue(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := app.SlashingKeeper.GetParams(ctx)
+	params.AutoUnjailEpochLength = 10
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	pks := simapp.CreateTestPubKeys(2)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	power := int64(100)
+	for _, pk := range pks {
+		tstaking.CreateValidatorWithValPower(sdk.ValAddress(pk.Address()), pk, power, true)
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// the validators sign the blocks of a window, then miss enough blocks to be
+	// jailed for downtime
+	window := app.SlashingKeeper.SignedBlocksWindow(ctx)
+	height := int64(0)
+	for ; height < window*2-app.SlashingKeeper.MinSignedPerWindow(ctx)+1; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		for _, pk := range pks {
+			app.SlashingKeeper.HandleValidatorSignature(ctx, pk.Address(), power, height < window)
+		}
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(pks[0].Address()), sdk.ValAddress(pks[1].Address())}
+	consAddrs := []sdk.ConsAddress{sdk.ConsAddress(pks[0].Address()), sdk.ConsAddress(pks[1].Address())}
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddrs[0])
+	require.True(t, found)
+
+	queued := func() (addrs []sdk.ConsAddress) {
+		app.SlashingKeeper.IterateAutoUnjailQueue(ctx, info.JailedUntil, func(consAddr sdk.ConsAddress, _ time.Time) bool {
+			addrs = append(addrs, consAddr)
+			return false
+		})
+		return addrs
+	}
+	require.Len(t, queued(), 2)
+
+	// the second validator can't be unjailed without its self-delegation
+	del, found := app.StakingKeeper.GetDelegation(ctx, sdk.AccAddress(valAddrs[1]), valAddrs[1])
+	require.True(t, found)
+	_, err := app.StakingKeeper.Undelegate(ctx, sdk.AccAddress(valAddrs[1]), valAddrs[1], del.Shares)
+	require.NoError(t, err)
+
+	// the validators are neither unjailed between the epoch boundaries nor
+	// before the end of their jail period
+	epochHeight := (height/10 + 1) * 10
+	ctx = ctx.WithBlockHeight(epochHeight + 1).WithBlockTime(info.JailedUntil.Add(time.Second))
+	app.SlashingKeeper.ProcessAutoUnjailQueue(ctx)
+	require.True(t, app.StakingKeeper.Validator(ctx, valAddrs[0]).IsJailed())
+
+	ctx = ctx.WithBlockHeight(epochHeight).WithBlockTime(info.JailedUntil.Add(-time.Second))
+	app.SlashingKeeper.ProcessAutoUnjailQueue(ctx)
+	require.True(t, app.StakingKeeper.Validator(ctx, valAddrs[0]).IsJailed())
+	require.Len(t, queued(), 2)
+
+	ctx = ctx.WithBlockHeight(epochHeight + 10).WithBlockTime(info.JailedUntil.Add(time.Second)).WithEventManager(sdk.NewEventManager())
+	app.SlashingKeeper.ProcessAutoUnjailQueue(ctx)
+	require.False(t, app.StakingKeeper.Validator(ctx, valAddrs[0]).IsJailed())
+	require.True(t, app.StakingKeeper.Validator(ctx, valAddrs[1]).IsJailed())
+
+	results := make(map[string]string)
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeAutoUnjail {
+			continue
+		}
+		var addr, result string
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case types.AttributeKeyAddress:
+				addr = string(attr.Value)
+			case types.AttributeKeyResult:
+				result = string(attr.Value)
+			}
+		}
+		results[addr] = result
+	}
+	require.Equal(t, map[string]string{
+		consAddrs[0].String(): types.AttributeValueSuccess,
+		consAddrs[1].String(): types.AttributeValueFailure,
+	}, results)
+
+	// the attempts are removed from the queue
+	require.Empty(t, queued())
+}
+
+func TestAutoUnjailDisabled(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	pks := simapp.CreateTestPubKeys(1)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	power := int64(100)
+	tstaking.CreateValidatorWithValPower(sdk.ValAddress(pks[0].Address()), pks[0], power, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// the validators jailed while automatic unjailing is disabled are not queued
+	window := app.SlashingKeeper.SignedBlocksWindow(ctx)
+	for height := int64(0); height < window*2-app.SlashingKeeper.MinSignedPerWindow(ctx)+1; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, pks[0].Address(), power, height < window)
+	}
+	require.True(t, app.StakingKeeper.Validator(ctx, sdk.ValAddress(pks[0].Address())).IsJailed())
+
+	var queued int
+	app.SlashingKeeper.IterateAutoUnjailQueue(ctx, time.Unix(1<<40, 0), func(sdk.ConsAddress, time.Time) bool {
+		queued++
+		return false
+	})
+	require.Zero(t, queued)
+}
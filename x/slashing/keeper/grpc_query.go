@@ -3,11 +3,13 @@ package keeper
 import (
 	"context"
 
+	gogotypes "github.com/gogo/protobuf/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -72,3 +74,78 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+func (k Keeper) MissedBlocks(c context.Context, req *types.QueryMissedBlocksRequest) (*types.QueryMissedBlocksResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.HasValidatorSigningInfo(ctx, consAddr) {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	var missedBlocks []types.MissedBlock
+
+	heightStore := prefix.NewStore(store, types.ValidatorMissedBlockHeightPrefixKey(consAddr))
+	pageRes, err := query.Paginate(heightStore, req.Pagination, func(key []byte, value []byte) error {
+		var height gogotypes.Int64Value
+		if err := k.cdc.Unmarshal(value, &height); err != nil {
+			return err
+		}
+		missedBlock := types.NewMissedBlock(int64(sdk.BigEndianToUint64(key)), true)
+		missedBlock.Height = height.Value
+		missedBlocks = append(missedBlocks, missedBlock)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryMissedBlocksResponse{MissedBlocks: missedBlocks, Pagination: pageRes}, nil
+}
+
+func (k Keeper) SlashDryRun(c context.Context, req *types.QuerySlashDryRunRequest) (*types.QuerySlashDryRunResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var fraction sdk.Dec
+	switch {
+	case req.SlashFraction != "":
+		fraction, err = sdk.NewDecFromStr(req.SlashFraction)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid slash fraction: %s", err)
+		}
+	case req.Infraction == types.InfractionDoubleSign:
+		fraction = k.SlashFractionDoubleSign(ctx)
+	default:
+		fraction = k.SlashFractionDowntime(ctx)
+	}
+
+	res, err := k.DrySlash(ctx, consAddr, req.Infraction, req.InfractionHeight, fraction)
+	switch {
+	case sdkerrors.ErrNotFound.Is(err):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return res, nil
+}
@@ -105,6 +105,40 @@ func (suite *SlashingTestSuite) TestGRPCSigningInfos() {
 	suite.Equal(uint64(2), infoResp.Pagination.Total)
 }
 
+func (suite *SlashingTestSuite) TestGRPCMissedBlocks() {
+	queryClient := suite.queryClient
+	consAddr := sdk.ConsAddress(suite.addrDels[0])
+
+	_, err := queryClient.MissedBlocks(gocontext.Background(), &types.QueryMissedBlocksRequest{ConsAddress: ""})
+	suite.Error(err)
+	_, err = queryClient.MissedBlocks(gocontext.Background(),
+		&types.QueryMissedBlocksRequest{ConsAddress: sdk.ConsAddress([]byte("unknown")).String()})
+	suite.Error(err)
+
+	for index, height := range map[int64]int64{1: 12, 7: 8, 300: 5} {
+		suite.app.SlashingKeeper.SetValidatorMissedBlockBitArray(suite.ctx, consAddr, index, true)
+		suite.app.SlashingKeeper.SetValidatorMissedBlockHeight(suite.ctx, consAddr, index, height)
+	}
+	// blocks missed before heights were recorded are not returned
+	suite.app.SlashingKeeper.SetValidatorMissedBlockBitArray(suite.ctx, consAddr, 2, true)
+
+	res, err := queryClient.MissedBlocks(gocontext.Background(),
+		&types.QueryMissedBlocksRequest{ConsAddress: consAddr.String()})
+	suite.NoError(err)
+	suite.Equal([]types.MissedBlock{
+		{Index: 1, Missed: true, Height: 12},
+		{Index: 7, Missed: true, Height: 8},
+		{Index: 300, Missed: true, Height: 5},
+	}, res.MissedBlocks)
+
+	res, err = queryClient.MissedBlocks(gocontext.Background(),
+		&types.QueryMissedBlocksRequest{ConsAddress: consAddr.String(), Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	suite.NoError(err)
+	suite.Len(res.MissedBlocks, 2)
+	suite.NotNil(res.Pagination.NextKey)
+	suite.Equal(uint64(3), res.Pagination.Total)
+}
+
 func TestSlashingTestSuite(t *testing.T) {
 	suite.Run(t, new(SlashingTestSuite))
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/tendermint/tendermint/crypto"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -44,6 +45,35 @@ func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress)
 	return nil
 }
 
+// AfterConsPubKeyRotated adds the address-pubkey relation of the new consensus
+// key of a validator and carries its signing info and missed blocks over from
+// the old consensus key. The old key's relation and signing info are kept so
+// that the blocks still signed with it and infractions committed with it can
+// be handled.
+func (k Keeper) AfterConsPubKeyRotated(ctx sdk.Context, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	if err := k.AddPubkey(ctx, newPubKey); err != nil {
+		return err
+	}
+
+	oldConsAddr := sdk.ConsAddress(oldPubKey.Address())
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, oldConsAddr)
+	if !found {
+		return nil
+	}
+
+	signingInfo.Address = newConsAddr.String()
+	k.SetValidatorSigningInfo(ctx, newConsAddr, signingInfo)
+
+	k.IterateValidatorMissedBlockBitArray(ctx, oldConsAddr, func(index int64, missed bool) bool {
+		k.SetValidatorMissedBlockBitArray(ctx, newConsAddr, index, missed)
+		return false
+	})
+
+	return nil
+}
+
 // Hooks wrapper struct for slashing keeper
 type Hooks struct {
 	k Keeper
@@ -88,3 +118,17 @@ func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.Va
 	return nil
 }
 func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec) error { return nil }
+
+// Implements sdk.ValidatorHooks
+func (h Hooks) AfterConsPubKeyRotated(ctx sdk.Context, _ sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	return h.k.AfterConsPubKeyRotated(ctx, oldPubKey, newPubKey)
+}
+
+// BeforeSlashedTokensBurned routes the slashed tokens to the destinations set
+// in the params, leaving none to burn to the staking module.
+func (h Hooks) BeforeSlashedTokensBurned(ctx sdk.Context, poolName string, amount sdk.Coins) (sdk.Coins, error) {
+	if err := h.k.HandleSlashedTokens(ctx, poolName, amount); err != nil {
+		return nil, err
+	}
+	return sdk.NewCoins(), nil
+}
@@ -48,6 +48,13 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 		// Array value at this index has not changed, no need to update counter
 	}
 
+	// Record the height of missed blocks, which the bit array doesn't keep
+	if missed {
+		k.SetValidatorMissedBlockHeight(ctx, consAddr, index, height)
+	} else if previous {
+		k.deleteValidatorMissedBlockHeight(ctx, consAddr, index)
+	}
+
 	minSignedPerWindow := k.MinSignedPerWindow(ctx)
 
 	if missed {
@@ -98,6 +105,9 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 			k.sk.Jail(ctx, consAddr)
 
 			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			if k.AutoUnjailEpochLength(ctx) > 0 {
+				k.InsertAutoUnjailQueue(ctx, consAddr, signInfo.JailedUntil)
+			}
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
@@ -16,11 +16,19 @@ type Keeper struct {
 	storeKey   sdk.StoreKey
 	cdc        codec.BinaryCodec
 	sk         types.StakingKeeper
+	bk         types.BankKeeper
+	dk         types.DistributionKeeper
 	paramspace types.ParamSubspace
+
+	// validators whose downtime is exported as telemetry gauges
+	monitoredValidators []sdk.ConsAddress
 }
 
 // NewKeeper creates a slashing keeper
-func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, sk types.StakingKeeper, paramspace types.ParamSubspace) Keeper {
+func NewKeeper(
+	cdc codec.BinaryCodec, key sdk.StoreKey, sk types.StakingKeeper, bk types.BankKeeper, dk types.DistributionKeeper,
+	paramspace types.ParamSubspace,
+) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramspace.HasKeyTable() {
 		paramspace = paramspace.WithKeyTable(types.ParamKeyTable())
@@ -30,6 +38,8 @@ func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, sk types.StakingKeeper,
 		storeKey:   key,
 		cdc:        cdc,
 		sk:         sk,
+		bk:         bk,
+		dk:         dk,
 		paramspace: paramspace,
 	}
 }
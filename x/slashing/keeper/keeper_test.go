@@ -113,6 +113,8 @@ func TestHandleNewValidator(t *testing.T) {
 	require.Equal(t, int64(2), info.IndexOffset)
 	require.Equal(t, int64(1), info.MissedBlocksCounter)
 	require.Equal(t, time.Unix(0, 0).UTC(), info.JailedUntil)
+	require.Zero(t, app.SlashingKeeper.GetValidatorMissedBlockHeight(ctx, sdk.ConsAddress(val.Address()), 0))
+	require.Equal(t, app.SlashingKeeper.SignedBlocksWindow(ctx)+2, app.SlashingKeeper.GetValidatorMissedBlockHeight(ctx, sdk.ConsAddress(val.Address()), 1))
 
 	// validator should be bonded still, should not have been jailed or slashed
 	validator, _ := app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(val))
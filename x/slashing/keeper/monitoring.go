@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	metrics "github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// SetMonitoredValidators sets the validators, by consensus address, whose
+// downtime is exported as telemetry gauges at every BeginBlock. The gauges are
+// node-local: they are only exported by the node configured to monitor the
+// validators, with the Prometheus sink of the telemetry when it is enabled.
+func (k *Keeper) SetMonitoredValidators(consAddrs []sdk.ConsAddress) *Keeper {
+	k.monitoredValidators = consAddrs
+
+	return k
+}
+
+// ExportDowntimeMetrics sets the downtime gauges of the monitored validators
+// from their signing info: the blocks missed in the signed blocks window, the
+// jail and tombstone status, and the number of seconds before a jailed
+// validator can be unjailed. Validators without signing info are skipped.
+func (k Keeper) ExportDowntimeMetrics(ctx sdk.Context) {
+	if len(k.monitoredValidators) == 0 {
+		return
+	}
+
+	telemetry.SetGauge(float32(k.SignedBlocksWindow(ctx)-k.MinSignedPerWindow(ctx)), types.ModuleName, "max_missed_blocks")
+
+	for _, consAddr := range k.monitoredValidators {
+		signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+		if !found {
+			continue
+		}
+
+		labels := []metrics.Label{telemetry.NewLabel("validator", consAddr.String())}
+
+		var jailed, unjailIn float32
+		if validator := k.sk.ValidatorByConsAddr(ctx, consAddr); validator != nil && validator.IsJailed() {
+			jailed = 1
+			if remaining := signInfo.JailedUntil.Sub(ctx.BlockHeader().Time); remaining > 0 {
+				unjailIn = float32(remaining.Seconds())
+			}
+		}
+
+		var tombstoned float32
+		if signInfo.Tombstoned {
+			tombstoned = 1
+		}
+
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, "missed_blocks"}, float32(signInfo.MissedBlocksCounter), labels)
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, "jailed"}, jailed, labels)
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, "tombstoned"}, tombstoned, labels)
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, "unjail_eligible_in_seconds"}, unjailIn, labels)
+	}
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+func TestExportDowntimeMetrics(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("test")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	defer metrics.NewGlobal(cfg, &metrics.BlackholeSink{}) // nolint: errcheck
+
+	app := simapp.Setup(t, false)
+	now := time.Unix(1000, 0).UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(2)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	for i := range valAddrs {
+		tstaking.CreateValidatorWithValPower(valAddrs[i], pks[i], 100, true)
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	consAddr := sdk.ConsAddress(pks[0].Address())
+	otherAddr := sdk.ConsAddress(pks[1].Address())
+
+	// no gauge is exported without monitored validators
+	app.SlashingKeeper.ExportDowntimeMetrics(ctx)
+	require.Empty(t, sink.Data()[0].Gauges)
+
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, types.NewValidatorSigningInfo(consAddr, 0, 0, now.Add(time.Hour), false, 12))
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, otherAddr, types.NewValidatorSigningInfo(otherAddr, 0, 0, time.Unix(0, 0), true, 3))
+	app.StakingKeeper.Jail(ctx, consAddr)
+
+	app.SlashingKeeper.SetMonitoredValidators([]sdk.ConsAddress{consAddr, otherAddr})
+	app.SlashingKeeper.ExportDowntimeMetrics(ctx)
+
+	gauge := func(name string, consAddr sdk.ConsAddress) float32 {
+		for _, g := range sink.Data()[0].Gauges {
+			if g.Name == "test.slashing."+name && len(g.Labels) == 1 && g.Labels[0].Value == consAddr.String() {
+				return g.Value
+			}
+		}
+		require.FailNow(t, "gauge not found", "%s of %s", name, consAddr)
+		return 0
+	}
+
+	require.Equal(t, float32(12), gauge("missed_blocks", consAddr))
+	require.Equal(t, float32(1), gauge("jailed", consAddr))
+	require.Equal(t, float32(0), gauge("tombstoned", consAddr))
+	require.Equal(t, float32(time.Hour.Seconds()), gauge("unjail_eligible_in_seconds", consAddr))
+
+	require.Equal(t, float32(3), gauge("missed_blocks", otherAddr))
+	require.Equal(t, float32(0), gauge("jailed", otherAddr))
+	require.Equal(t, float32(1), gauge("tombstoned", otherAddr))
+	require.Equal(t, float32(0), gauge("unjail_eligible_in_seconds", otherAddr))
+
+	maxMissed := sink.Data()[0].Gauges["test.slashing.max_missed_blocks"]
+	require.Equal(t, float32(app.SlashingKeeper.SignedBlocksWindow(ctx)-app.SlashingKeeper.MinSignedPerWindow(ctx)), maxMissed.Value)
+}
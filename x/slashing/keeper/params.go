@@ -42,6 +42,19 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// SlashDestinations - shares of the slashed tokens sent to each destination
+func (k Keeper) SlashDestinations(ctx sdk.Context) (res types.SlashDestinations) {
+	k.paramspace.Get(ctx, types.KeySlashDestinations, &res)
+	return
+}
+
+// AutoUnjailEpochLength - number of blocks of the epochs at the boundaries of
+// which the validators whose downtime jail period expired are unjailed
+func (k Keeper) AutoUnjailEpochLength(ctx sdk.Context) (res uint64) {
+	k.paramspace.Get(ctx, types.KeyAutoUnjailEpochLength, &res)
+	return
+}
+
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
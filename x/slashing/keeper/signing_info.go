@@ -94,7 +94,11 @@ func (k Keeper) IterateValidatorMissedBlockBitArray(ctx sdk.Context,
 func (k Keeper) GetValidatorMissedBlocks(ctx sdk.Context, address sdk.ConsAddress) []types.MissedBlock {
 	missedBlocks := []types.MissedBlock{}
 	k.IterateValidatorMissedBlockBitArray(ctx, address, func(index int64, missed bool) (stop bool) {
-		missedBlocks = append(missedBlocks, types.NewMissedBlock(index, missed))
+		missedBlock := types.NewMissedBlock(index, missed)
+		if missed {
+			missedBlock.Height = k.GetValidatorMissedBlockHeight(ctx, address, index)
+		}
+		missedBlocks = append(missedBlocks, missedBlock)
 		return false
 	})
 
@@ -147,12 +151,48 @@ func (k Keeper) SetValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.Con
 	store.Set(types.ValidatorMissedBlockBitArrayKey(address, index), bz)
 }
 
-// clearValidatorMissedBlockBitArray deletes every instance of ValidatorMissedBlockBitArray in the store
+// GetValidatorMissedBlockHeight gets the height at which the block at an index
+// of the missed blocks array was missed, or zero if it is not recorded
+func (k Keeper) GetValidatorMissedBlockHeight(ctx sdk.Context, address sdk.ConsAddress, index int64) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ValidatorMissedBlockHeightKey(address, index))
+	if bz == nil {
+		return 0
+	}
+
+	var height gogotypes.Int64Value
+	k.cdc.MustUnmarshal(bz, &height)
+
+	return height.Value
+}
+
+// SetValidatorMissedBlockHeight sets the height at which the block at an index
+// of the missed blocks array was missed
+func (k Keeper) SetValidatorMissedBlockHeight(ctx sdk.Context, address sdk.ConsAddress, index int64, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: height})
+	store.Set(types.ValidatorMissedBlockHeightKey(address, index), bz)
+}
+
+// deleteValidatorMissedBlockHeight deletes the height at which the block at an
+// index of the missed blocks array was missed
+func (k Keeper) deleteValidatorMissedBlockHeight(ctx sdk.Context, address sdk.ConsAddress, index int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ValidatorMissedBlockHeightKey(address, index))
+}
+
+// clearValidatorMissedBlockBitArray deletes every instance of ValidatorMissedBlockBitArray
+// and of the missed block heights in the store
 func (k Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress) {
 	store := ctx.KVStore(k.storeKey)
-	iter := sdk.KVStorePrefixIterator(store, types.ValidatorMissedBlockBitArrayPrefixKey(address))
-	defer iter.Close()
-	for ; iter.Valid(); iter.Next() {
-		store.Delete(iter.Key())
+	for _, prefix := range [][]byte{
+		types.ValidatorMissedBlockBitArrayPrefixKey(address),
+		types.ValidatorMissedBlockHeightPrefixKey(address),
+	} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iter.Valid(); iter.Next() {
+			store.Delete(iter.Key())
+		}
+		iter.Close()
 	}
 }
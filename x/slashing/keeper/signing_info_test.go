@@ -48,6 +48,19 @@ func TestGetSetValidatorMissedBlockBitArray(t *testing.T) {
 	require.True(t, missed) // now should be missed
 }
 
+func TestGetSetValidatorMissedBlockHeight(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	consAddr := sdk.ConsAddress(addrDels[0])
+
+	require.Zero(t, app.SlashingKeeper.GetValidatorMissedBlockHeight(ctx, consAddr, 3)) // not recorded
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 3, true)
+	app.SlashingKeeper.SetValidatorMissedBlockHeight(ctx, consAddr, 3, 42)
+	require.Equal(t, int64(42), app.SlashingKeeper.GetValidatorMissedBlockHeight(ctx, consAddr, 3))
+	require.Equal(t, []types.MissedBlock{{Index: 3, Missed: true, Height: 42}}, app.SlashingKeeper.GetValidatorMissedBlocks(ctx, consAddr))
+}
+
 func TestTombstoned(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// DrySlash slashes a validator for a hypothetical infraction in a branch of
+// the state which is discarded, and returns the amounts lost by each of its
+// delegations, unbonding delegation entries and redelegations. The validator
+// is slashed with its current power, and the stake distribution of the
+// infraction height is derived as in the evidence and liveness handlers.
+func (k Keeper) DrySlash(
+	ctx sdk.Context, consAddr sdk.ConsAddress, infraction types.Infraction, infractionHeight int64, fraction sdk.Dec,
+) (*types.QuerySlashDryRunResponse, error) {
+	var distributionHeight int64
+	switch infraction {
+	case types.InfractionDoubleSign:
+		distributionHeight = infractionHeight - sdk.ValidatorUpdateDelay
+	case types.InfractionDowntime:
+		distributionHeight = infractionHeight - sdk.ValidatorUpdateDelay - 1
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid infraction %s", infraction)
+	}
+
+	if infractionHeight <= 0 || infractionHeight > ctx.BlockHeight() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "infraction height %d must be positive and at most the current height %d", infractionHeight, ctx.BlockHeight())
+	}
+	if fraction.IsNegative() || fraction.GT(sdk.OneDec()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "slash fraction %s must be between 0 and 1", fraction)
+	}
+
+	validator := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "validator %s", consAddr)
+	}
+	if validator.IsUnbonded() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "validator %s is unbonded", consAddr)
+	}
+
+	valAddr := validator.GetOperator()
+	delegations := k.sk.GetValidatorDelegations(ctx, valAddr)
+	ubds := k.sk.GetUnbondingDelegationsFromValidator(ctx, valAddr)
+	reds := k.sk.GetRedelegationsFromSrcValidator(ctx, valAddr)
+
+	delegationsBefore := k.delegationTokens(ctx, delegations)
+	redelegationsBefore := k.delegationTokens(ctx, redelegationTargets(reds))
+
+	// the slash is applied without logging, as it is only simulated
+	cacheCtx, _ := ctx.WithLogger(log.NewNopLogger()).CacheContext()
+	power := validator.GetConsensusPower(k.sk.PowerReduction(ctx))
+	burned := k.sk.Slash(cacheCtx, consAddr, distributionHeight, power, fraction)
+
+	res := &types.QuerySlashDryRunResponse{SlashFraction: fraction, BurnedTokens: burned}
+
+	delegationsAfter := k.delegationTokens(cacheCtx, delegations)
+	for i, del := range delegations {
+		if amount := delegationsBefore[i].Sub(delegationsAfter[i]); amount.IsPositive() {
+			res.Delegations = append(res.Delegations, types.SlashedDelegation{
+				DelegatorAddress: del.DelegatorAddress, ValidatorAddress: del.ValidatorAddress, Amount: amount,
+			})
+		}
+	}
+
+	for _, ubd := range ubds {
+		delAddr, _ := sdk.AccAddressFromBech32(ubd.DelegatorAddress)
+		after, found := k.sk.GetUnbondingDelegation(cacheCtx, delAddr, valAddr)
+		if !found {
+			continue
+		}
+
+		for i, entry := range ubd.Entries {
+			if i >= len(after.Entries) {
+				break
+			}
+			if amount := entry.Balance.Sub(after.Entries[i].Balance); amount.IsPositive() {
+				res.UnbondingDelegations = append(res.UnbondingDelegations, types.SlashedUnbondingDelegationEntry{
+					DelegatorAddress: ubd.DelegatorAddress, CreationHeight: entry.CreationHeight, Amount: amount,
+				})
+			}
+		}
+	}
+
+	targets := redelegationTargets(reds)
+	redelegationsAfter := k.delegationTokens(cacheCtx, targets)
+	for i, del := range targets {
+		if amount := redelegationsBefore[i].Sub(redelegationsAfter[i]); amount.IsPositive() {
+			res.Redelegations = append(res.Redelegations, types.SlashedDelegation{
+				DelegatorAddress: del.DelegatorAddress, ValidatorAddress: del.ValidatorAddress, Amount: amount,
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// delegationTokens returns the tokens of delegations, zero for the delegations
+// which no longer exist.
+func (k Keeper) delegationTokens(ctx sdk.Context, delegations []stakingtypes.Delegation) []sdk.Dec {
+	tokens := make([]sdk.Dec, len(delegations))
+	for i, del := range delegations {
+		tokens[i] = sdk.ZeroDec()
+
+		delAddr, _ := sdk.AccAddressFromBech32(del.DelegatorAddress)
+		valAddr, _ := sdk.ValAddressFromBech32(del.ValidatorAddress)
+		validator := k.sk.Validator(ctx, valAddr)
+		delegation := k.sk.Delegation(ctx, delAddr, valAddr)
+		if validator != nil && delegation != nil {
+			tokens[i] = validator.TokensFromShares(delegation.GetShares())
+		}
+	}
+
+	return tokens
+}
+
+// redelegationTargets returns the delegations to the destination validators of
+// redelegations, slashed with the source validator, without duplicates.
+func redelegationTargets(reds []stakingtypes.Redelegation) []stakingtypes.Delegation {
+	seen := make(map[string]bool)
+
+	var targets []stakingtypes.Delegation
+	for _, red := range reds {
+		key := red.DelegatorAddress + "/" + red.ValidatorDstAddress
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		targets = append(targets, stakingtypes.Delegation{DelegatorAddress: red.DelegatorAddress, ValidatorAddress: red.ValidatorDstAddress})
+	}
+
+	return targets
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/testslashing"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+func TestSlashDryRun(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	app.SlashingKeeper.SetParams(ctx, testslashing.TestParams())
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(2)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(valAddrs[0], pks[0], 100, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], pks[1], 100, true)
+	tstaking.DelegateWithPower(addrDels[2], valAddrs[0], 10)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// unbond and redelegate from the first validator at height 10
+	ctx = ctx.WithBlockHeight(10)
+	_, err := app.StakingKeeper.Undelegate(ctx, addrDels[2], valAddrs[0], app.StakingKeeper.TokensFromConsensusPower(ctx, 2).ToDec())
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.BeginRedelegation(ctx, addrDels[2], valAddrs[0], valAddrs[1], app.StakingKeeper.TokensFromConsensusPower(ctx, 3).ToDec())
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	ctx = ctx.WithBlockHeight(20)
+	consAddr := sdk.ConsAddress(pks[0].Address())
+	tokensBefore := app.StakingKeeper.Validator(ctx, valAddrs[0]).GetTokens()
+
+	// the unbonding and redelegation happened after the stake distribution
+	// height of a double sign at height 11, and are therefore slashed
+	fraction := sdk.NewDecWithPrec(5, 1)
+	res, err := app.SlashingKeeper.DrySlash(ctx, consAddr, types.InfractionDoubleSign, 11, fraction)
+	require.NoError(t, err)
+	require.Equal(t, fraction, res.SlashFraction)
+	// half of the current power, less the slashed unbonding and redelegation
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 50), res.BurnedTokens)
+
+	require.Len(t, res.UnbondingDelegations, 1)
+	require.Equal(t, addrDels[2].String(), res.UnbondingDelegations[0].DelegatorAddress)
+	require.Equal(t, int64(10), res.UnbondingDelegations[0].CreationHeight)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 1), res.UnbondingDelegations[0].Amount)
+
+	require.Len(t, res.Redelegations, 1)
+	require.Equal(t, addrDels[2].String(), res.Redelegations[0].DelegatorAddress)
+	require.Equal(t, valAddrs[1].String(), res.Redelegations[0].ValidatorAddress)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 3).ToDec().Mul(fraction), res.Redelegations[0].Amount)
+
+	require.Len(t, res.Delegations, 2)
+	for _, del := range res.Delegations {
+		require.Equal(t, valAddrs[0].String(), del.ValidatorAddress)
+		require.True(t, del.Amount.IsPositive())
+	}
+
+	// the state is left untouched
+	require.Equal(t, tokensBefore, app.StakingKeeper.Validator(ctx, valAddrs[0]).GetTokens())
+	_, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[2], valAddrs[0])
+	require.True(t, found)
+
+	// a double sign at height 12 has a stake distribution height after the
+	// unbonding and redelegation, which are therefore not slashed
+	res, err = app.SlashingKeeper.DrySlash(ctx, consAddr, types.InfractionDoubleSign, 12, fraction)
+	require.NoError(t, err)
+	require.Empty(t, res.UnbondingDelegations)
+	require.Empty(t, res.Redelegations)
+	require.Len(t, res.Delegations, 2)
+
+	_, err = app.SlashingKeeper.DrySlash(ctx, consAddr, types.InfractionDoubleSign, 21, fraction)
+	require.Error(t, err)
+	_, err = app.SlashingKeeper.DrySlash(ctx, consAddr, types.InfractionDoubleSign, 11, sdk.NewDec(2))
+	require.Error(t, err)
+	_, err = app.SlashingKeeper.DrySlash(ctx, consAddr, types.InfractionEmpty, 11, fraction)
+	require.Error(t, err)
+	_, err = app.SlashingKeeper.DrySlash(ctx, sdk.ConsAddress(addrDels[2]), types.InfractionDoubleSign, 11, fraction)
+	require.Error(t, err)
+
+	// the slash fraction defaults to the parameter of the infraction
+	grpcRes, err := app.SlashingKeeper.SlashDryRun(sdk.WrapSDKContext(ctx), &types.QuerySlashDryRunRequest{
+		ConsAddress: consAddr.String(), Infraction: types.InfractionDowntime, InfractionHeight: 11,
+	})
+	require.NoError(t, err)
+	require.Equal(t, app.SlashingKeeper.SlashFractionDowntime(ctx), grpcRes.SlashFraction)
+}
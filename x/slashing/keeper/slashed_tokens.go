@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// HandleSlashedTokens removes the coins slashed from the given staking pool,
+// which is done in the BeforeSlashedTokensBurned staking hook. It splits the coins slashed from the given staking pool between the
+// destinations set in the params: the community pool, the slashing insurance
+// pool and burning, which gets the remainder of the split.
+func (k Keeper) HandleSlashedTokens(ctx sdk.Context, poolName string, amount sdk.Coins) error {
+	burn, communityPool, insurancePool := k.SlashDestinations(ctx).Split(amount)
+
+	if !communityPool.IsZero() {
+		if err := k.dk.FundCommunityPool(ctx, communityPool, authtypes.NewModuleAddress(poolName)); err != nil {
+			return err
+		}
+		emitSlashedTokensEvent(ctx, types.AttributeValueCommunityPool, communityPool)
+	}
+
+	if !insurancePool.IsZero() {
+		if err := k.bk.SendCoinsFromModuleToModule(ctx, poolName, types.InsurancePoolName, insurancePool); err != nil {
+			return err
+		}
+		emitSlashedTokensEvent(ctx, types.AttributeValueInsurancePool, insurancePool)
+	}
+
+	if !burn.IsZero() {
+		if err := k.bk.BurnCoins(ctx, poolName, burn); err != nil {
+			return err
+		}
+		emitSlashedTokensEvent(ctx, types.AttributeValueBurn, burn)
+	}
+
+	return nil
+}
+
+func emitSlashedTokensEvent(ctx sdk.Context, destination string, amount sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashedTokens,
+			sdk.NewAttribute(types.AttributeKeyDestination, destination),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
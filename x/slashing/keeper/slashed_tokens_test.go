@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/testslashing"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+func TestSlashDestinations(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	params := testslashing.TestParams()
+	params.SlashDestinations = types.NewSlashDestinations(
+		sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(2, 1),
+	)
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], pks[0], 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	supplyBefore := app.BankKeeper.GetSupply(ctx, bondDenom).Amount
+	communityPoolBefore := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(bondDenom)
+
+	// slash a tenth of the stake
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.SlashingKeeper.Slash(ctx, sdk.ConsAddress(pks[0].Address()), sdk.NewDecWithPrec(1, 1), 100, 1)
+	slashed := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+
+	burned := slashed.QuoRaw(2)
+	require.Equal(t, supplyBefore.Sub(burned), app.BankKeeper.GetSupply(ctx, bondDenom).Amount)
+
+	toCommunityPool := slashed.MulRaw(3).QuoRaw(10)
+	require.Equal(t, communityPoolBefore.Add(toCommunityPool.ToDec()), app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(bondDenom))
+
+	toInsurancePool := slashed.MulRaw(2).QuoRaw(10)
+	insurancePool := authtypes.NewModuleAddress(types.InsurancePoolName)
+	require.Equal(t, toInsurancePool, app.BankKeeper.GetBalance(ctx, insurancePool, bondDenom).Amount)
+
+	var destinations []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeSlashedTokens {
+			continue
+		}
+		destinations = append(destinations, string(event.Attributes[0].Value))
+	}
+	require.Equal(t, []string{types.AttributeValueCommunityPool, types.AttributeValueInsurancePool, types.AttributeValueBurn}, destinations)
+}
@@ -57,6 +57,7 @@ func Migrate(oldGenState v039slashing.GenesisState) *v040slashing.GenesisState {
 			DowntimeJailDuration:    oldGenState.Params.DowntimeJailDuration,
 			SlashFractionDoubleSign: oldGenState.Params.SlashFractionDoubleSign,
 			SlashFractionDowntime:   oldGenState.Params.SlashFractionDowntime,
+			SlashDestinations:       v040slashing.DefaultSlashDestinations(),
 		},
 		SigningInfos: newSigningInfos,
 		MissedBlocks: newValidatorMissedBlocks,
@@ -74,10 +74,12 @@ func TestMigrate(t *testing.T) {
       "address": "cosmosvalcons104cjmxkrg8y8lmrp25de02e4zf00zle4mzs685",
       "missed_blocks": [
         {
+          "height": "0",
           "index": "3",
           "missed": true
         },
         {
+          "height": "0",
           "index": "4",
           "missed": true
         }
@@ -87,6 +89,7 @@ func TestMigrate(t *testing.T) {
       "address": "cosmosvalcons10e4c5p6qk0sycy9u6u43t7csmlx9fyadr9yxph",
       "missed_blocks": [
         {
+          "height": "0",
           "index": "2",
           "missed": true
         }
@@ -94,9 +97,15 @@ func TestMigrate(t *testing.T) {
     }
   ],
   "params": {
+    "auto_unjail_epoch_length": "0",
     "downtime_jail_duration": "600s",
     "min_signed_per_window": "0.500000000000000000",
     "signed_blocks_window": "100",
+    "slash_destinations": {
+      "burn": "1.000000000000000000",
+      "community_pool": "0.000000000000000000",
+      "insurance_pool": "0.000000000000000000"
+    },
     "slash_fraction_double_sign": "0.050000000000000000",
     "slash_fraction_downtime": "0.010000000000000000"
   },
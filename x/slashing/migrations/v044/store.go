@@ -0,0 +1,26 @@
+package v044
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// MigrateParams performs in-place params migrations from v0.43 to v0.44. The
+// migration includes:
+//
+// - Set the slash destinations parameter to its default value, which keeps
+//   burning all the slashed tokens.
+func MigrateParams(ctx sdk.Context, paramSpace types.ParamSubspace) {
+	slashDestinations := types.DefaultSlashDestinations()
+	paramSpace.Set(ctx, types.KeySlashDestinations, &slashDestinations)
+}
+
+// MigrateAutoUnjailParams performs in-place params migrations adding the
+// automatic unjailing of the validators. The migration includes:
+//
+// - Set the auto unjail epoch length parameter to its default value, which
+//   keeps automatic unjailing disabled.
+func MigrateAutoUnjailParams(ctx sdk.Context, paramSpace types.ParamSubspace) {
+	epochLength := types.DefaultAutoUnjailEpochLength
+	paramSpace.Set(ctx, types.KeyAutoUnjailEpochLength, &epochLength)
+}
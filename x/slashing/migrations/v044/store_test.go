@@ -0,0 +1,45 @@
+package v044_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v044 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v044"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// params stored before the slash destinations were added
+	paramSpace := app.GetSubspace(types.ModuleName)
+	params := app.SlashingKeeper.GetParams(ctx)
+	store := ctx.KVStore(app.GetKey(paramtypes.StoreKey))
+	store.Delete(append([]byte(types.ModuleName+"/"), types.KeySlashDestinations...))
+	require.False(t, paramSpace.Has(ctx, types.KeySlashDestinations))
+
+	v044.MigrateParams(ctx, paramSpace)
+	require.Equal(t, params, app.SlashingKeeper.GetParams(ctx))
+	require.Equal(t, types.DefaultSlashDestinations(), app.SlashingKeeper.SlashDestinations(ctx))
+}
+
+func TestMigrateAutoUnjailParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// params stored before the auto unjail epoch length was added
+	paramSpace := app.GetSubspace(types.ModuleName)
+	params := app.SlashingKeeper.GetParams(ctx)
+	store := ctx.KVStore(app.GetKey(paramtypes.StoreKey))
+	store.Delete(append([]byte(types.ModuleName+"/"), types.KeyAutoUnjailEpochLength...))
+	require.False(t, paramSpace.Has(ctx, types.KeyAutoUnjailEpochLength))
+
+	v044.MigrateAutoUnjailParams(ctx, paramSpace)
+	require.Equal(t, params, app.SlashingKeeper.GetParams(ctx))
+	require.Equal(t, types.DefaultAutoUnjailEpochLength, app.SlashingKeeper.AutoUnjailEpochLength(ctx))
+}
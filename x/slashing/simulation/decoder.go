@@ -29,6 +29,12 @@ func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &missedB)
 			return fmt.Sprintf("missedA: %v\nmissedB: %v", missedA.Value, missedB.Value)
 
+		case bytes.Equal(kvA.Key[:1], types.ValidatorMissedBlockHeightKeyPrefix):
+			var heightA, heightB gogotypes.Int64Value
+			cdc.MustUnmarshal(kvA.Value, &heightA)
+			cdc.MustUnmarshal(kvB.Value, &heightB)
+			return fmt.Sprintf("heightA: %d\nheightB: %d", heightA.Value, heightB.Value)
+
 		case bytes.Equal(kvA.Key[:1], types.AddrPubkeyRelationKeyPrefix):
 			var pubKeyA, pubKeyB cryptotypes.PubKey
 			if err := cdc.UnmarshalInterface(kvA.Value, &pubKeyA); err != nil {
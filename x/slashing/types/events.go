@@ -5,6 +5,9 @@ const (
 	EventTypeSlash    = "slash"
 	EventTypeLiveness = "liveness"
 
+	EventTypeSlashedTokens = "slashed_tokens"
+	EventTypeAutoUnjail    = "auto_unjail"
+
 	AttributeKeyAddress      = "address"
 	AttributeKeyHeight       = "height"
 	AttributeKeyPower        = "power"
@@ -12,8 +15,17 @@ const (
 	AttributeKeyJailed       = "jailed"
 	AttributeKeyMissedBlocks = "missed_blocks"
 	AttributeKeyBurnedCoins  = "burned_coins"
+	AttributeKeyDestination  = "destination"
+	AttributeKeyAmount       = "amount"
+	AttributeKeyResult       = "result"
+	AttributeKeyError        = "error"
 
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
+	AttributeValueBurn             = "burn"
+	AttributeValueCommunityPool    = "community_pool"
+	AttributeValueInsurancePool    = "insurance_pool"
+	AttributeValueSuccess          = "success"
+	AttributeValueFailure          = "failure"
 	AttributeValueCategory         = ModuleName
 )
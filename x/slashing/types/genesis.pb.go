@@ -200,12 +200,16 @@ func (m *ValidatorMissedBlocks) GetMissedBlocks() []MissedBlock {
 	return nil
 }
 
-// MissedBlock contains height and missed status as boolean.
+// MissedBlock contains the index in the signed blocks window, missed status as
+// boolean and height of a block.
 type MissedBlock struct {
-	// index is the height at which the block was missed.
+	// index is the index of the block in the signed blocks window.
 	Index int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
 	// missed is the missed status.
 	Missed bool `protobuf:"varint,2,opt,name=missed,proto3" json:"missed,omitempty"`
+	// height is the height at which the block was missed. It is zero if the
+	// block was not missed, or was missed before missed heights were recorded.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
 }
 
 func (m *MissedBlock) Reset()         { *m = MissedBlock{} }
@@ -255,6 +259,13 @@ func (m *MissedBlock) GetMissed() bool {
 	return false
 }
 
+func (m *MissedBlock) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.slashing.v1beta1.GenesisState")
 	proto.RegisterType((*SigningInfo)(nil), "cosmos.slashing.v1beta1.SigningInfo")
@@ -267,34 +278,35 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3d, 0x8e, 0xd3, 0x40,
-	0x14, 0xf6, 0x24, 0x10, 0x60, 0x9c, 0x6d, 0x46, 0x66, 0xb1, 0x56, 0xe0, 0xac, 0x2c, 0x16, 0x6d,
-	0x13, 0x5b, 0xbb, 0x74, 0x20, 0x1a, 0x37, 0x2b, 0x0a, 0x24, 0xe4, 0x95, 0x28, 0x68, 0xa2, 0x71,
-	0x3c, 0x3b, 0x19, 0xc5, 0xf6, 0x04, 0x3f, 0x13, 0x25, 0x57, 0xa0, 0xa2, 0xe6, 0x06, 0xf4, 0x1c,
-	0x22, 0x65, 0x4a, 0xaa, 0x08, 0x25, 0x37, 0xe0, 0x04, 0x28, 0x33, 0x0e, 0x71, 0xa2, 0x98, 0x88,
-	0xca, 0x7e, 0xd2, 0xf7, 0xf7, 0xbe, 0xd1, 0xc3, 0x17, 0x7d, 0x09, 0xa9, 0x04, 0x1f, 0x12, 0x0a,
-	0x03, 0x91, 0x71, 0x7f, 0x7c, 0x15, 0xb1, 0x82, 0x5e, 0xf9, 0x9c, 0x65, 0x0c, 0x04, 0x78, 0xa3,
-	0x5c, 0x16, 0x92, 0x3c, 0xd1, 0x30, 0x6f, 0x03, 0xf3, 0x4a, 0xd8, 0x99, 0xc5, 0x25, 0x97, 0x0a,
-	0xe3, 0xaf, 0xff, 0x34, 0xfc, 0xec, 0x45, 0x9d, 0xea, 0x5f, 0xbe, 0xc2, 0xb9, 0xdf, 0x1b, 0xb8,
-	0x7d, 0xa3, 0x8d, 0x6e, 0x0b, 0x5a, 0x30, 0xf2, 0x06, 0xb7, 0x46, 0x34, 0xa7, 0x29, 0xd8, 0xe8,
-	0x1c, 0x5d, 0x9a, 0xd7, 0x1d, 0xaf, 0xc6, 0xd8, 0x7b, 0xaf, 0x60, 0xc1, 0xbd, 0xd9, 0xa2, 0x63,
-	0x84, 0x25, 0x89, 0x70, 0x7c, 0x02, 0x82, 0x67, 0x22, 0xe3, 0x3d, 0x91, 0xdd, 0x49, 0xb0, 0x1b,
-	0xe7, 0xcd, 0x4b, 0xf3, 0xfa, 0x79, 0xad, 0xca, 0xad, 0x46, 0xbf, 0xcd, 0xee, 0x64, 0xf0, 0x74,
-	0x2d, 0xf5, 0x7b, 0xd1, 0xb1, 0xa6, 0x34, 0x4d, 0x5e, 0xb9, 0x3b, 0x42, 0x6e, 0xd8, 0x86, 0x2d,
-	0x14, 0xc8, 0x27, 0x7c, 0x92, 0x0a, 0x00, 0x16, 0xf7, 0xa2, 0x44, 0xf6, 0x87, 0x60, 0x37, 0x95,
-	0x91, 0x57, 0x6b, 0xf4, 0x81, 0x26, 0x22, 0xa6, 0x85, 0xcc, 0xdf, 0x29, 0x5a, 0xa0, 0x58, 0xfb,
-	0x96, 0x3b, 0x92, 0x6e, 0xd8, 0x4e, 0x2b, 0x58, 0xf7, 0x07, 0xc2, 0x66, 0x25, 0x2e, 0xb1, 0xf1,
-	0x03, 0x1a, 0xc7, 0x39, 0x03, 0xdd, 0xd5, 0xa3, 0x70, 0x33, 0x92, 0x2f, 0x08, 0x9f, 0x8e, 0x37,
-	0x7e, 0xbd, 0xea, 0x1e, 0x76, 0x43, 0xb5, 0xda, 0x3d, 0x1e, 0xb3, 0x5a, 0xcc, 0x45, 0x99, 0xf2,
-	0x99, 0x4e, 0x79, 0x58, 0xda, 0x0d, 0xad, 0xf1, 0x01, 0xb2, 0xfb, 0x0d, 0xe1, 0xc7, 0x07, 0x97,
-	0xff, 0xc7, 0x02, 0x7c, 0xbf, 0xdd, 0x63, 0xcf, 0x58, 0xd1, 0xfd, 0xaf, 0x4e, 0x5f, 0x63, 0xb3,
-	0x42, 0x25, 0x16, 0xbe, 0x2f, 0xb2, 0x98, 0x4d, 0x54, 0x9e, 0x66, 0xa8, 0x07, 0x72, 0x8a, 0x5b,
-	0x9a, 0xa4, 0xda, 0x7b, 0x18, 0x96, 0x53, 0x70, 0x33, 0x5b, 0x3a, 0x68, 0xbe, 0x74, 0xd0, 0xaf,
-	0xa5, 0x83, 0xbe, 0xae, 0x1c, 0x63, 0xbe, 0x72, 0x8c, 0x9f, 0x2b, 0xc7, 0xf8, 0xd8, 0xe5, 0xa2,
-	0x18, 0x7c, 0x8e, 0xbc, 0xbe, 0x4c, 0xfd, 0xf2, 0x12, 0xf4, 0xa7, 0x0b, 0xf1, 0xd0, 0x9f, 0x6c,
-	0xcf, 0xa2, 0x98, 0x8e, 0x18, 0x44, 0x2d, 0x75, 0x0c, 0x2f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x03, 0x3c, 0xa8, 0xf6, 0x8c, 0x03, 0x00, 0x00,
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xb1, 0x6e, 0xd3, 0x40,
+	0x1c, 0xc6, 0x7d, 0x31, 0x04, 0x38, 0xa7, 0xcb, 0xc9, 0x14, 0xab, 0x02, 0xa7, 0xb2, 0x28, 0xea,
+	0x12, 0x5b, 0x2d, 0x1b, 0x12, 0x8b, 0x97, 0x8a, 0x01, 0x09, 0x39, 0x12, 0x03, 0x4b, 0x74, 0x8e,
+	0xaf, 0xe7, 0x53, 0x6d, 0x5f, 0xf0, 0xff, 0x88, 0xda, 0x57, 0x60, 0x62, 0xe6, 0x0d, 0xd8, 0x79,
+	0x88, 0x8e, 0x1d, 0x99, 0x2a, 0x94, 0xbc, 0x01, 0x4f, 0x80, 0x72, 0xe7, 0x50, 0xb7, 0x8a, 0x89,
+	0x3a, 0xd9, 0x7f, 0xeb, 0xf7, 0x7d, 0xdf, 0xff, 0x3e, 0xeb, 0xf0, 0xc1, 0x54, 0x42, 0x29, 0x21,
+	0x82, 0x82, 0x42, 0x2e, 0x2a, 0x1e, 0xcd, 0x8f, 0x52, 0xa6, 0xe8, 0x51, 0xc4, 0x59, 0xc5, 0x40,
+	0x40, 0x38, 0xab, 0xa5, 0x92, 0xe4, 0x99, 0xc1, 0xc2, 0x35, 0x16, 0x36, 0xd8, 0x9e, 0xcb, 0x25,
+	0x97, 0x9a, 0x89, 0x56, 0x6f, 0x06, 0xdf, 0x7b, 0xd5, 0xe5, 0xfa, 0x4f, 0xaf, 0xb9, 0xe0, 0x47,
+	0x0f, 0x0f, 0x4e, 0x4c, 0xd0, 0x58, 0x51, 0xc5, 0xc8, 0x5b, 0xdc, 0x9f, 0xd1, 0x9a, 0x96, 0xe0,
+	0xa1, 0x7d, 0x74, 0xe8, 0x1c, 0x0f, 0xc3, 0x8e, 0xe0, 0xf0, 0x83, 0xc6, 0xe2, 0x07, 0x97, 0xd7,
+	0x43, 0x2b, 0x69, 0x44, 0x84, 0xe3, 0x1d, 0x10, 0xbc, 0x12, 0x15, 0x9f, 0x88, 0xea, 0x54, 0x82,
+	0xd7, 0xdb, 0xb7, 0x0f, 0x9d, 0xe3, 0x97, 0x9d, 0x2e, 0x63, 0x43, 0xbf, 0xab, 0x4e, 0x65, 0xfc,
+	0x7c, 0x65, 0xf5, 0xe7, 0x7a, 0xe8, 0x5e, 0xd0, 0xb2, 0x78, 0x13, 0xdc, 0x32, 0x0a, 0x92, 0x01,
+	0xdc, 0xa0, 0x40, 0x3e, 0xe3, 0x9d, 0x52, 0x00, 0xb0, 0x6c, 0x92, 0x16, 0x72, 0x7a, 0x06, 0x9e,
+	0xad, 0x83, 0xc2, 0xce, 0xa0, 0x8f, 0xb4, 0x10, 0x19, 0x55, 0xb2, 0x7e, 0xaf, 0x65, 0xb1, 0x56,
+	0xdd, 0x8d, 0xbc, 0x65, 0x19, 0x24, 0x83, 0xb2, 0xc5, 0x06, 0x3f, 0x11, 0x76, 0x5a, 0xeb, 0x12,
+	0x0f, 0x3f, 0xa2, 0x59, 0x56, 0x33, 0x30, 0x5d, 0x3d, 0x49, 0xd6, 0x23, 0xf9, 0x8a, 0xf0, 0xee,
+	0x7c, 0x9d, 0x37, 0x69, 0x9f, 0xc3, 0xeb, 0xe9, 0x56, 0x47, 0xdb, 0xd7, 0x6c, 0x17, 0x73, 0xd0,
+	0x6c, 0xf9, 0xc2, 0x6c, 0xb9, 0xd9, 0x3a, 0x48, 0xdc, 0xf9, 0x06, 0x71, 0xf0, 0x1d, 0xe1, 0xa7,
+	0x1b, 0x0f, 0xff, 0x9f, 0x03, 0xf0, 0xbb, 0xed, 0x6e, 0xfb, 0x8d, 0x2d, 0xdf, 0x7b, 0x75, 0x3a,
+	0xc6, 0x4e, 0x4b, 0x4a, 0x5c, 0xfc, 0x50, 0x54, 0x19, 0x3b, 0xd7, 0xfb, 0xd8, 0x89, 0x19, 0xc8,
+	0x2e, 0xee, 0x1b, 0x91, 0x6e, 0xef, 0x71, 0xd2, 0x4c, 0xab, 0xef, 0x39, 0x13, 0x3c, 0x57, 0x9e,
+	0xad, 0xf1, 0x66, 0x8a, 0x4f, 0x2e, 0x17, 0x3e, 0xba, 0x5a, 0xf8, 0xe8, 0xf7, 0xc2, 0x47, 0xdf,
+	0x96, 0xbe, 0x75, 0xb5, 0xf4, 0xad, 0x5f, 0x4b, 0xdf, 0xfa, 0x34, 0xe2, 0x42, 0xe5, 0x5f, 0xd2,
+	0x70, 0x2a, 0xcb, 0xa8, 0xb9, 0x21, 0xe6, 0x31, 0x82, 0xec, 0x2c, 0x3a, 0xbf, 0xb9, 0x2e, 0xea,
+	0x62, 0xc6, 0x20, 0xed, 0xeb, 0x4b, 0xf2, 0xfa, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x07,
+	0x2a, 0x29, 0xa4, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -462,6 +474,11 @@ func (m *MissedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Missed {
 		i--
 		if m.Missed {
@@ -560,6 +577,9 @@ func (m *MissedBlock) Size() (n int) {
 	if m.Missed {
 		n += 2
 	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
 	return n
 }
 
@@ -1019,6 +1039,25 @@ func (m *MissedBlock) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Missed = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -20,6 +21,10 @@ const (
 
 	// QuerierRoute is the querier route for slashing
 	QuerierRoute = ModuleName
+
+	// InsurancePoolName is the name of the module account receiving the share
+	// of the slashed tokens destined to the slashing insurance pool
+	InsurancePoolName = "slashing_insurance_pool"
 )
 
 // Keys for slashing store
@@ -30,12 +35,20 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><period_Bytes>: bool
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<consAddrLen (1 Byte)><consAddress_Bytes><index_Bytes>: int64
+//
+// - 0x05<jailedUntil_Bytes><consAddrLen (1 Byte)><consAddress_Bytes>: []byte{}
 var (
 	ValidatorSigningInfoKeyPrefix         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKeyPrefix           = []byte{0x03} // Prefix for address-pubkey relation
+	ValidatorMissedBlockHeightKeyPrefix   = []byte{0x04} // Prefix for missed block heights
+	AutoUnjailQueueKeyPrefix              = []byte{0x05} // Prefix for the automatic unjail queue
 )
 
+var lenTime = len(sdk.FormatTimeBytes(time.Now()))
+
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
 func ValidatorSigningInfoKey(v sdk.ConsAddress) []byte {
 	return append(ValidatorSigningInfoKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
@@ -63,7 +76,46 @@ func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 	return append(ValidatorMissedBlockBitArrayPrefixKey(v), b...)
 }
 
+// ValidatorMissedBlockHeightPrefixKey - stored by *Consensus* address (not operator address)
+func ValidatorMissedBlockHeightPrefixKey(v sdk.ConsAddress) []byte {
+	return append(ValidatorMissedBlockHeightKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// ValidatorMissedBlockHeightKey - stored by *Consensus* address (not operator
+// address), with a big endian index so that heights are iterated by index
+func ValidatorMissedBlockHeightKey(v sdk.ConsAddress, i int64) []byte {
+	return append(ValidatorMissedBlockHeightPrefixKey(v), sdk.Uint64ToBigEndian(uint64(i))...)
+}
+
 // AddrPubkeyRelationKey gets pubkey relation key used to get the pubkey from the address
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// AutoUnjailQueueByTimeKey gets the automatic unjail queue key by the end time
+// of the jail period
+func AutoUnjailQueueByTimeKey(jailedUntil time.Time) []byte {
+	return append(AutoUnjailQueueKeyPrefix, sdk.FormatTimeBytes(jailedUntil)...)
+}
+
+// AutoUnjailQueueKey returns the key of a validator in the automatic unjail
+// queue - stored by *Consensus* address (not operator address)
+func AutoUnjailQueueKey(v sdk.ConsAddress, jailedUntil time.Time) []byte {
+	return append(AutoUnjailQueueByTimeKey(jailedUntil), address.MustLengthPrefix(v.Bytes())...)
+}
+
+// SplitAutoUnjailQueueKey splits the automatic unjail queue key and returns
+// the consensus address of the validator and the end time of its jail period
+func SplitAutoUnjailQueueKey(key []byte) (v sdk.ConsAddress, jailedUntil time.Time) {
+	kv.AssertKeyAtLeastLength(key, 1+lenTime+1)
+
+	jailedUntil, err := sdk.ParseTimeBytes(key[1 : 1+lenTime])
+	if err != nil {
+		panic(err)
+	}
+
+	addrLen := int(key[1+lenTime])
+	kv.AssertKeyLength(key[2+lenTime:], addrLen)
+
+	return sdk.ConsAddress(key[2+lenTime:]), jailedUntil
+}
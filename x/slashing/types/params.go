@@ -12,6 +12,9 @@ import (
 const (
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+
+	// DefaultAutoUnjailEpochLength is zero, which disables automatic unjailing
+	DefaultAutoUnjailEpochLength = uint64(0)
 )
 
 var (
@@ -27,6 +30,8 @@ var (
 	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+	KeySlashDestinations       = []byte("SlashDestinations")
+	KeyAutoUnjailEpochLength   = []byte("AutoUnjailEpochLength")
 )
 
 // ParamKeyTable for slashing module
@@ -46,6 +51,8 @@ func NewParams(
 		DowntimeJailDuration:    downtimeJailDuration,
 		SlashFractionDoubleSign: slashFractionDoubleSign,
 		SlashFractionDowntime:   slashFractionDowntime,
+		SlashDestinations:       DefaultSlashDestinations(),
+		AutoUnjailEpochLength:   DefaultAutoUnjailEpochLength,
 	}
 }
 
@@ -57,6 +64,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 		paramtypes.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
 		paramtypes.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		paramtypes.NewParamSetPair(KeySlashDestinations, &p.SlashDestinations, validateSlashDestinations),
+		paramtypes.NewParamSetPair(KeyAutoUnjailEpochLength, &p.AutoUnjailEpochLength, validateAutoUnjailEpochLength),
 	}
 }
 
@@ -141,3 +150,62 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateSlashDestinations(i interface{}) error {
+	v, ok := i.(SlashDestinations)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+func validateAutoUnjailEpochLength(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// NewSlashDestinations creates a new SlashDestinations object
+func NewSlashDestinations(burn, communityPool, insurancePool sdk.Dec) SlashDestinations {
+	return SlashDestinations{
+		Burn:          burn,
+		CommunityPool: communityPool,
+		InsurancePool: insurancePool,
+	}
+}
+
+// DefaultSlashDestinations returns the slash destinations burning all the
+// slashed tokens
+func DefaultSlashDestinations() SlashDestinations {
+	return NewSlashDestinations(sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroDec())
+}
+
+// Validate checks that the shares of the slash destinations are non-negative
+// and sum up to one.
+func (d SlashDestinations) Validate() error {
+	if d.Burn.IsNil() || d.CommunityPool.IsNil() || d.InsurancePool.IsNil() {
+		return fmt.Errorf("slash destination shares must be set: %s", d)
+	}
+	if d.Burn.IsNegative() || d.CommunityPool.IsNegative() || d.InsurancePool.IsNegative() {
+		return fmt.Errorf("slash destination shares cannot be negative: %s", d)
+	}
+	if !d.Burn.Add(d.CommunityPool).Add(d.InsurancePool).Equal(sdk.OneDec()) {
+		return fmt.Errorf("slash destination shares must sum up to one: %s", d)
+	}
+
+	return nil
+}
+
+// Split splits the slashed coins between the community pool and the insurance
+// pool according to their shares, the remaining coins being burned.
+func (d SlashDestinations) Split(coins sdk.Coins) (burn, communityPool, insurancePool sdk.Coins) {
+	for _, coin := range coins {
+		communityPool = communityPool.Add(sdk.NewCoin(coin.Denom, d.CommunityPool.MulInt(coin.Amount).TruncateInt()))
+		insurancePool = insurancePool.Add(sdk.NewCoin(coin.Denom, d.InsurancePool.MulInt(coin.Amount).TruncateInt()))
+	}
+
+	return coins.Sub(communityPool).Sub(insurancePool), communityPool, insurancePool
+}
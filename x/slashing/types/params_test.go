@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestSlashDestinationsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultSlashDestinations().Validate())
+	require.NoError(t, types.NewSlashDestinations(sdk.ZeroDec(), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1)).Validate())
+
+	require.Error(t, types.SlashDestinations{}.Validate())
+	require.Error(t, types.NewSlashDestinations(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()).Validate())
+	require.Error(t, types.NewSlashDestinations(sdk.OneDec(), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(-5, 1)).Validate())
+}
+
+func TestSlashDestinationsSplit(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 101))
+
+	burn, communityPool, insurancePool := types.DefaultSlashDestinations().Split(coins)
+	require.Equal(t, coins, burn)
+	require.True(t, communityPool.IsZero())
+	require.True(t, insurancePool.IsZero())
+
+	// the truncated remainder of the split is burned
+	d := types.NewSlashDestinations(sdk.ZeroDec(), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1))
+	burn, communityPool, insurancePool = d.Split(coins)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), burn)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), communityPool)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), insurancePool)
+}
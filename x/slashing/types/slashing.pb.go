@@ -29,6 +29,40 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Infraction defines the type of an infraction committed by a validator.
+type Infraction int32
+
+const (
+	// INFRACTION_UNSPECIFIED defines an empty infraction.
+	InfractionEmpty Infraction = 0
+	// INFRACTION_DOUBLE_SIGN defines a validator signing two blocks at the same
+	// height.
+	InfractionDoubleSign Infraction = 1
+	// INFRACTION_DOWNTIME defines a validator missing too many blocks of the
+	// signed blocks window.
+	InfractionDowntime Infraction = 2
+)
+
+var Infraction_name = map[int32]string{
+	0: "INFRACTION_UNSPECIFIED",
+	1: "INFRACTION_DOUBLE_SIGN",
+	2: "INFRACTION_DOWNTIME",
+}
+
+var Infraction_value = map[string]int32{
+	"INFRACTION_UNSPECIFIED": 0,
+	"INFRACTION_DOUBLE_SIGN": 1,
+	"INFRACTION_DOWNTIME":    2,
+}
+
+func (x Infraction) String() string {
+	return proto.EnumName(Infraction_name, int32(x))
+}
+
+func (Infraction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{0}
+}
+
 // ValidatorSigningInfo defines a validator's signing info for monitoring their
 // liveness activity.
 type ValidatorSigningInfo struct {
@@ -130,6 +164,11 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+	SlashDestinations       SlashDestinations                      `protobuf:"bytes,6,opt,name=slash_destinations,json=slashDestinations,proto3" json:"slash_destinations" yaml:"slash_destinations"`
+	// auto_unjail_epoch_length is the number of blocks of the epochs at the
+	// boundaries of which the validators whose downtime jail period expired are
+	// unjailed automatically. Zero disables automatic unjailing.
+	AutoUnjailEpochLength uint64 `protobuf:"varint,7,opt,name=auto_unjail_epoch_length,json=autoUnjailEpochLength,proto3" json:"auto_unjail_epoch_length,omitempty" yaml:"auto_unjail_epoch_length"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -179,9 +218,67 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetSlashDestinations() SlashDestinations {
+	if m != nil {
+		return m.SlashDestinations
+	}
+	return SlashDestinations{}
+}
+
+func (m *Params) GetAutoUnjailEpochLength() uint64 {
+	if m != nil {
+		return m.AutoUnjailEpochLength
+	}
+	return 0
+}
+
+// SlashDestinations defines the shares of the tokens slashed by the staking
+// module which are burned, sent to the community pool and sent to the slashing
+// insurance pool. The shares are non-negative and sum up to one.
+type SlashDestinations struct {
+	Burn          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=burn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn" yaml:"burn"`
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool" yaml:"community_pool"`
+	InsurancePool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=insurance_pool,json=insurancePool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"insurance_pool" yaml:"insurance_pool"`
+}
+
+func (m *SlashDestinations) Reset()         { *m = SlashDestinations{} }
+func (m *SlashDestinations) String() string { return proto.CompactTextString(m) }
+func (*SlashDestinations) ProtoMessage()    {}
+func (*SlashDestinations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *SlashDestinations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashDestinations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashDestinations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashDestinations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashDestinations.Merge(m, src)
+}
+func (m *SlashDestinations) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashDestinations) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashDestinations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashDestinations proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("cosmos.slashing.v1beta1.Infraction", Infraction_name, Infraction_value)
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*SlashDestinations)(nil), "cosmos.slashing.v1beta1.SlashDestinations")
 }
 
 func init() {
@@ -189,47 +286,65 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbd, 0x6f, 0xd3, 0x4e,
-	0x18, 0xce, 0xfd, 0xd2, 0x5f, 0x29, 0x97, 0x4c, 0x6e, 0x4a, 0x4c, 0x00, 0x3b, 0x78, 0xa8, 0xc2,
-	0x50, 0x5b, 0x2d, 0x5b, 0x47, 0x53, 0x21, 0x3e, 0x24, 0x28, 0x6e, 0x01, 0x89, 0x01, 0xeb, 0x9c,
-	0xbb, 0x38, 0x47, 0xed, 0xbb, 0xc8, 0x77, 0xa1, 0x2d, 0x1b, 0x5b, 0xc7, 0x8e, 0x1d, 0x3b, 0xf2,
-	0xa7, 0x74, 0xec, 0x88, 0x18, 0x02, 0x4a, 0x17, 0xe6, 0x6e, 0x6c, 0xc8, 0x77, 0x76, 0x1b, 0xa5,
-	0x29, 0x52, 0xa7, 0xe4, 0x7d, 0xde, 0xe7, 0x7d, 0xee, 0x79, 0x3f, 0x12, 0xb8, 0xdc, 0xe5, 0x22,
-	0xe5, 0xc2, 0x13, 0x09, 0x12, 0x7d, 0xca, 0x62, 0xef, 0xf3, 0x6a, 0x44, 0x24, 0x5a, 0xbd, 0x00,
-	0xdc, 0x41, 0xc6, 0x25, 0x37, 0x9a, 0x9a, 0xe7, 0x5e, 0xc0, 0x05, 0xaf, 0xd5, 0x88, 0x79, 0xcc,
-	0x15, 0xc7, 0xcb, 0xbf, 0x69, 0x7a, 0xcb, 0x8a, 0x39, 0x8f, 0x13, 0xe2, 0xa9, 0x28, 0x1a, 0xf6,
-	0x3c, 0x3c, 0xcc, 0x90, 0xa4, 0x9c, 0x15, 0x79, 0x7b, 0x3a, 0x2f, 0x69, 0x4a, 0x84, 0x44, 0xe9,
-	0x40, 0x13, 0x9c, 0x83, 0x2a, 0x6c, 0xbc, 0x43, 0x09, 0xc5, 0x48, 0xf2, 0x6c, 0x8b, 0xc6, 0x8c,
-	0xb2, 0xf8, 0x39, 0xeb, 0x71, 0xc3, 0x84, 0xb7, 0x10, 0xc6, 0x19, 0x11, 0xc2, 0x04, 0x6d, 0xd0,
-	0xb9, 0x1d, 0x94, 0xa1, 0xb1, 0x0e, 0xeb, 0x42, 0xa2, 0x4c, 0x86, 0x7d, 0x42, 0xe3, 0xbe, 0x34,
-	0xff, 0x6b, 0x83, 0x4e, 0xd5, 0x6f, 0x9e, 0x8f, 0xec, 0xc5, 0x7d, 0x94, 0x26, 0xeb, 0xce, 0x64,
-	0xd6, 0x09, 0x6a, 0x2a, 0x7c, 0xa6, 0xa2, 0xbc, 0x96, 0x32, 0x4c, 0xf6, 0x42, 0xde, 0xeb, 0x09,
-	0x22, 0xcd, 0xea, 0x74, 0xed, 0x64, 0xd6, 0x09, 0x6a, 0x2a, 0x7c, 0xad, 0x22, 0xe3, 0x23, 0xac,
-	0x7f, 0x42, 0x34, 0x21, 0x38, 0x1c, 0x32, 0x49, 0x13, 0x73, 0xae, 0x0d, 0x3a, 0xb5, 0xb5, 0x96,
-	0xab, 0x5b, 0x74, 0xcb, 0x16, 0xdd, 0xed, 0xb2, 0x45, 0xdf, 0x3e, 0x19, 0xd9, 0x95, 0x4b, 0xed,
-	0xc9, 0x6a, 0xe7, 0xf0, 0xa7, 0x0d, 0x82, 0x9a, 0x86, 0xde, 0xe6, 0x88, 0x61, 0x41, 0x28, 0x79,
-	0x1a, 0x09, 0xc9, 0x19, 0xc1, 0xe6, 0xff, 0x6d, 0xd0, 0x59, 0x08, 0x26, 0x10, 0x63, 0x1b, 0x2e,
-	0xa5, 0x54, 0x08, 0x82, 0xc3, 0x28, 0xe1, 0xdd, 0x1d, 0x11, 0x76, 0xf9, 0x90, 0x49, 0x92, 0x99,
-	0xf3, 0xaa, 0x89, 0xf6, 0xf9, 0xc8, 0xbe, 0xaf, 0x1f, 0x9a, 0x49, 0x73, 0x82, 0x45, 0x8d, 0xfb,
-	0x0a, 0x7e, 0xa2, 0xd1, 0xf5, 0x85, 0xa3, 0x63, 0xbb, 0xf2, 0xfb, 0xd8, 0x06, 0xce, 0x9f, 0x39,
-	0x38, 0xbf, 0x89, 0x32, 0x94, 0x0a, 0xe3, 0x0d, 0x6c, 0x08, 0x1a, 0xb3, 0x4b, 0x8d, 0x5d, 0xca,
-	0x30, 0xdf, 0x55, 0x9b, 0xa8, 0xfa, 0xf6, 0xf9, 0xc8, 0xbe, 0x57, 0x8c, 0x7a, 0x06, 0xcb, 0x09,
-	0x0c, 0x0d, 0xeb, 0x87, 0xde, 0x2b, 0xd0, 0xf8, 0x0a, 0x72, 0xfb, 0x2c, 0x2c, 0x2a, 0x06, 0x24,
-	0x2b, 0x45, 0xf3, 0xfd, 0xd5, 0xfd, 0x57, 0xf9, 0xac, 0x7e, 0x8c, 0xec, 0xe5, 0x98, 0xca, 0xfe,
-	0x30, 0x72, 0xbb, 0x3c, 0xf5, 0x8a, 0x9b, 0xd5, 0x1f, 0x2b, 0x02, 0xef, 0x78, 0x72, 0x7f, 0x40,
-	0x84, 0xbb, 0x41, 0xba, 0x93, 0xcd, 0xce, 0x10, 0x75, 0x02, 0x23, 0xa5, 0x6c, 0x4b, 0xc1, 0x9b,
-	0x24, 0x2b, 0x3c, 0x7c, 0x81, 0x77, 0x30, 0xdf, 0x65, 0xf9, 0x0d, 0x86, 0xf9, 0xe4, 0xc3, 0xf2,
-	0x5a, 0xd5, 0x1d, 0xd4, 0xd6, 0xee, 0x5e, 0xd9, 0xe5, 0x46, 0x41, 0xf0, 0x1f, 0x15, 0xab, 0x7c,
-	0xa0, 0x1f, 0x9d, 0x2d, 0xe3, 0x1c, 0xe5, 0x4b, 0x6d, 0x94, 0xc9, 0x17, 0x88, 0x26, 0xa5, 0x80,
-	0x71, 0x08, 0x60, 0x4b, 0xfd, 0xa8, 0xc2, 0x5e, 0x86, 0xba, 0x39, 0x14, 0x62, 0x3e, 0x8c, 0x12,
-	0xa2, 0xcc, 0xab, 0x63, 0xaa, 0xfb, 0x5b, 0x37, 0x1e, 0xc2, 0xc3, 0x62, 0x0f, 0xd7, 0x2a, 0x3b,
-	0x41, 0x53, 0x25, 0x9f, 0x16, 0xb9, 0x0d, 0x95, 0xca, 0x27, 0x63, 0x1c, 0x00, 0xd8, 0xbc, 0x52,
-	0xa8, 0xad, 0xab, 0xf3, 0xab, 0xfb, 0x9b, 0x37, 0xf6, 0x63, 0x5d, 0xe3, 0x47, 0xcb, 0x3a, 0xc1,
-	0xd2, 0x94, 0x19, 0x8d, 0xfb, 0x2f, 0xbf, 0x8d, 0x2d, 0x70, 0x32, 0xb6, 0xc0, 0xe9, 0xd8, 0x02,
-	0xbf, 0xc6, 0x16, 0x38, 0x3c, 0xb3, 0x2a, 0xa7, 0x67, 0x56, 0xe5, 0xfb, 0x99, 0x55, 0xf9, 0xb0,
-	0xf2, 0xcf, 0xe7, 0xf7, 0x2e, 0xff, 0xd4, 0x94, 0x93, 0x68, 0x5e, 0xad, 0xef, 0xf1, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x7b, 0x78, 0xfd, 0x40, 0xf4, 0x04, 0x00, 0x00,
+	// 921 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x5e, 0x27, 0x21, 0x2d, 0xb3, 0x5b, 0x48, 0x27, 0x9b, 0xc4, 0x5d, 0xc0, 0xde, 0x1a, 0xa9,
+	0x5a, 0x2a, 0xd5, 0x56, 0x03, 0xa7, 0x48, 0x1c, 0x70, 0x76, 0x53, 0x16, 0xca, 0x66, 0xeb, 0x4d,
+	0xa8, 0x84, 0x10, 0x96, 0xd7, 0x9e, 0xf5, 0x0e, 0xb5, 0x67, 0x56, 0x9e, 0x31, 0x69, 0x10, 0x17,
+	0x6e, 0x51, 0x4e, 0x39, 0xf6, 0x12, 0xa9, 0x12, 0x17, 0xc4, 0x1f, 0x82, 0x7a, 0xec, 0x05, 0x09,
+	0x71, 0x58, 0x50, 0x72, 0xe1, 0x9c, 0xbf, 0x00, 0x79, 0xc6, 0x9b, 0x75, 0x7e, 0x21, 0x45, 0x3d,
+	0x25, 0xf3, 0x7d, 0xef, 0xfb, 0xde, 0x9b, 0xf7, 0xde, 0x78, 0xc1, 0x3d, 0x9f, 0xb2, 0x98, 0x32,
+	0x8b, 0x45, 0x1e, 0x1b, 0x62, 0x12, 0x5a, 0x3f, 0x3c, 0xec, 0x23, 0xee, 0x3d, 0x3c, 0x05, 0xcc,
+	0x51, 0x42, 0x39, 0x85, 0x2b, 0x32, 0xce, 0x3c, 0x85, 0xf3, 0xb8, 0x5a, 0x35, 0xa4, 0x21, 0x15,
+	0x31, 0x56, 0xf6, 0x9f, 0x0c, 0xaf, 0x69, 0x21, 0xa5, 0x61, 0x84, 0x2c, 0x71, 0xea, 0xa7, 0x03,
+	0x2b, 0x48, 0x13, 0x8f, 0x63, 0x4a, 0x72, 0x5e, 0x3f, 0xcf, 0x73, 0x1c, 0x23, 0xc6, 0xbd, 0x78,
+	0x24, 0x03, 0x8c, 0xbd, 0x59, 0x50, 0xfd, 0xda, 0x8b, 0x70, 0xe0, 0x71, 0x9a, 0xf4, 0x70, 0x48,
+	0x30, 0x09, 0xdb, 0x64, 0x40, 0xa1, 0x0a, 0x6e, 0x78, 0x41, 0x90, 0x20, 0xc6, 0x54, 0xa5, 0xae,
+	0x34, 0xde, 0x76, 0x26, 0x47, 0xb8, 0x06, 0x2a, 0x8c, 0x7b, 0x09, 0x77, 0x87, 0x08, 0x87, 0x43,
+	0xae, 0xce, 0xd4, 0x95, 0xc6, 0xac, 0xbd, 0x72, 0x32, 0xd6, 0x17, 0x77, 0xbd, 0x38, 0x5a, 0x33,
+	0x8a, 0xac, 0xe1, 0x94, 0xc5, 0xf1, 0x73, 0x71, 0xca, 0xb4, 0x98, 0x04, 0xe8, 0xb9, 0x4b, 0x07,
+	0x03, 0x86, 0xb8, 0x3a, 0x7b, 0x5e, 0x5b, 0x64, 0x0d, 0xa7, 0x2c, 0x8e, 0x9b, 0xe2, 0x04, 0xbf,
+	0x03, 0x95, 0xef, 0x3d, 0x1c, 0xa1, 0xc0, 0x4d, 0x09, 0xc7, 0x91, 0x3a, 0x57, 0x57, 0x1a, 0xe5,
+	0xd5, 0x9a, 0x29, 0xaf, 0x68, 0x4e, 0xae, 0x68, 0x6e, 0x4d, 0xae, 0x68, 0xeb, 0xaf, 0xc6, 0x7a,
+	0x69, 0xea, 0x5d, 0x54, 0x1b, 0x07, 0x7f, 0xeb, 0x8a, 0x53, 0x96, 0xd0, 0x76, 0x86, 0x40, 0x0d,
+	0x00, 0x4e, 0xe3, 0x3e, 0xe3, 0x94, 0xa0, 0x40, 0x7d, 0xab, 0xae, 0x34, 0x6e, 0x3a, 0x05, 0x04,
+	0x6e, 0x81, 0xa5, 0x18, 0x33, 0x86, 0x02, 0xb7, 0x1f, 0x51, 0xff, 0x19, 0x73, 0x7d, 0x9a, 0x12,
+	0x8e, 0x12, 0x75, 0x5e, 0x5c, 0xa2, 0x7e, 0x32, 0xd6, 0xdf, 0x97, 0x89, 0x2e, 0x0d, 0x33, 0x9c,
+	0x45, 0x89, 0xdb, 0x02, 0x5e, 0x97, 0xe8, 0xda, 0xcd, 0x17, 0x2f, 0xf5, 0xd2, 0xbf, 0x2f, 0x75,
+	0xc5, 0xf8, 0x63, 0x1e, 0xcc, 0x77, 0xbd, 0xc4, 0x8b, 0x19, 0x7c, 0x02, 0xaa, 0x0c, 0x87, 0x64,
+	0xea, 0xb1, 0x83, 0x49, 0x40, 0x77, 0xc4, 0x24, 0x66, 0x6d, 0xfd, 0x64, 0xac, 0xbf, 0x97, 0xb7,
+	0xfa, 0x92, 0x28, 0xc3, 0x81, 0x12, 0x96, 0x89, 0x9e, 0x0a, 0x10, 0xfe, 0xac, 0x64, 0xe5, 0x13,
+	0x37, 0x57, 0x8c, 0x50, 0x32, 0x31, 0xcd, 0xe6, 0x57, 0xb1, 0x3b, 0x59, 0xaf, 0xfe, 0x1a, 0xeb,
+	0xf7, 0x42, 0xcc, 0x87, 0x69, 0xdf, 0xf4, 0x69, 0x6c, 0xe5, 0x3b, 0x2b, 0xff, 0x3c, 0x60, 0xc1,
+	0x33, 0x8b, 0xef, 0x8e, 0x10, 0x33, 0x9b, 0xc8, 0x2f, 0x5e, 0xf6, 0x12, 0x53, 0xc3, 0x81, 0x31,
+	0x26, 0x3d, 0x01, 0x77, 0x51, 0x92, 0xd7, 0xf0, 0x23, 0x58, 0x0e, 0xe8, 0x0e, 0xc9, 0x76, 0xd0,
+	0xcd, 0x3a, 0xef, 0x4e, 0xb6, 0x55, 0xec, 0x41, 0x79, 0xf5, 0xce, 0x85, 0x59, 0x36, 0xf3, 0x00,
+	0xfb, 0xa3, 0x7c, 0x94, 0x1f, 0xc8, 0xa4, 0x97, 0xdb, 0x18, 0x2f, 0xb2, 0xa1, 0x56, 0x27, 0xe4,
+	0x17, 0x1e, 0x8e, 0x26, 0x06, 0xf0, 0x40, 0x01, 0x35, 0xf1, 0xa8, 0xdc, 0x41, 0xe2, 0xf9, 0x19,
+	0xe4, 0x06, 0x34, 0xed, 0x47, 0x48, 0x14, 0x2f, 0x96, 0xa9, 0x62, 0xf7, 0xae, 0xdd, 0x84, 0xbb,
+	0xf9, 0x1c, 0xae, 0x74, 0x36, 0x9c, 0x15, 0x41, 0x6e, 0xe4, 0x5c, 0x53, 0x50, 0x59, 0x67, 0xe0,
+	0x9e, 0x02, 0x56, 0x2e, 0x08, 0x65, 0xe9, 0x62, 0xfd, 0x2a, 0x76, 0xf7, 0xda, 0xf5, 0x68, 0x57,
+	0xd4, 0x23, 0x6d, 0x0d, 0x67, 0xe9, 0x5c, 0x31, 0x12, 0x87, 0x3f, 0x01, 0x28, 0x25, 0x01, 0x62,
+	0x1c, 0x13, 0xd1, 0x32, 0x26, 0x16, 0xbb, 0xbc, 0x7a, 0xdf, 0xbc, 0xe2, 0x9b, 0x64, 0xf6, 0x32,
+	0xa0, 0x59, 0x50, 0xd8, 0x77, 0xf3, 0x31, 0xdd, 0x29, 0x96, 0x51, 0xf4, 0x34, 0x9c, 0xdb, 0xec,
+	0xbc, 0x0a, 0x7e, 0x0b, 0x54, 0x2f, 0xe5, 0xd4, 0x4d, 0x89, 0x18, 0x27, 0x1a, 0x51, 0x7f, 0xe8,
+	0x46, 0x88, 0x84, 0x7c, 0xa8, 0xde, 0xa8, 0x2b, 0x8d, 0x39, 0xfb, 0xc3, 0x93, 0xb1, 0xae, 0x4b,
+	0xcf, 0xab, 0x22, 0x0d, 0x67, 0x29, 0xa3, 0xb6, 0x05, 0xd3, 0xca, 0x88, 0xc7, 0x12, 0xff, 0x7d,
+	0x06, 0xdc, 0xbe, 0x50, 0x29, 0x7c, 0x02, 0xe6, 0xfa, 0x69, 0x42, 0xc4, 0x93, 0xaa, 0xd8, 0x9f,
+	0x5e, 0xbb, 0xd1, 0x65, 0x59, 0x4d, 0xe6, 0x61, 0x38, 0xc2, 0x0a, 0x12, 0xf0, 0x8e, 0x4f, 0xe3,
+	0x38, 0x25, 0x98, 0xef, 0xba, 0x23, 0x4a, 0xa3, 0xfc, 0x69, 0x3d, 0xba, 0xb6, 0xf9, 0x92, 0x34,
+	0x3f, 0xeb, 0x66, 0x38, 0xb7, 0x4e, 0x81, 0x2e, 0xa5, 0x51, 0x96, 0x0f, 0x13, 0x96, 0x26, 0x1e,
+	0xf1, 0x91, 0xcc, 0x37, 0xfb, 0x66, 0xf9, 0xce, 0xba, 0x19, 0xce, 0xad, 0x53, 0x20, 0xcb, 0x77,
+	0xff, 0x37, 0x05, 0x80, 0x36, 0x99, 0x2c, 0x15, 0xb4, 0xc0, 0x72, 0xbb, 0xb3, 0xe1, 0x7c, 0xb6,
+	0xbe, 0xd5, 0xde, 0xec, 0xb8, 0xdb, 0x9d, 0x5e, 0xb7, 0xb5, 0xde, 0xde, 0x68, 0xb7, 0x9a, 0x0b,
+	0xa5, 0xda, 0xe2, 0xfe, 0x61, 0xfd, 0xdd, 0x69, 0x6c, 0x2b, 0x1e, 0xf1, 0x5d, 0xf8, 0xc9, 0x19,
+	0x41, 0x73, 0x73, 0xdb, 0x7e, 0xdc, 0x72, 0x7b, 0xed, 0x47, 0x9d, 0x05, 0xa5, 0xa6, 0xee, 0x1f,
+	0xd6, 0xab, 0x53, 0x41, 0xe1, 0x95, 0x58, 0x60, 0xf1, 0x8c, 0xea, 0x69, 0x67, 0xab, 0xfd, 0x55,
+	0x6b, 0x61, 0xa6, 0xb6, 0xbc, 0x7f, 0x58, 0x87, 0x45, 0x89, 0xdc, 0xe5, 0xda, 0xdc, 0xde, 0x2f,
+	0x5a, 0xc9, 0xfe, 0xf2, 0xd7, 0x23, 0x4d, 0x79, 0x75, 0xa4, 0x29, 0xaf, 0x8f, 0x34, 0xe5, 0x9f,
+	0x23, 0x4d, 0x39, 0x38, 0xd6, 0x4a, 0xaf, 0x8f, 0xb5, 0xd2, 0x9f, 0xc7, 0x5a, 0xe9, 0x9b, 0x07,
+	0xff, 0xdb, 0x9a, 0xe7, 0xd3, 0x9f, 0x69, 0xd1, 0xa5, 0xfe, 0xbc, 0xf8, 0x20, 0x7d, 0xfc, 0xdf,
+	0x00, 0x51, 0x06, 0x40, 0xa4, 0xc6, 0x07, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -305,6 +420,42 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if !this.SlashDestinations.Equal(&that1.SlashDestinations) {
+		return false
+	}
+	if this.AutoUnjailEpochLength != that1.AutoUnjailEpochLength {
+		return false
+	}
+	return true
+}
+func (this *SlashDestinations) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SlashDestinations)
+	if !ok {
+		that2, ok := that.(SlashDestinations)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Burn.Equal(that1.Burn) {
+		return false
+	}
+	if !this.CommunityPool.Equal(that1.CommunityPool) {
+		return false
+	}
+	if !this.InsurancePool.Equal(that1.InsurancePool) {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -390,6 +541,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoUnjailEpochLength != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.AutoUnjailEpochLength))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.SlashDestinations.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -410,12 +576,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -436,6 +602,59 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashDestinations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashDestinations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashDestinations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InsurancePool.Size()
+		i -= size
+		if _, err := m.InsurancePool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Burn.Size()
+		i -= size
+		if _, err := m.Burn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -491,6 +710,26 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashDestinations.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.AutoUnjailEpochLength != 0 {
+		n += 1 + sovSlashing(uint64(m.AutoUnjailEpochLength))
+	}
+	return n
+}
+
+func (m *SlashDestinations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Burn.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.InsurancePool.Size()
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -872,6 +1111,207 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashDestinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashDestinations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoUnjailEpochLength", wireType)
+			}
+			m.AutoUnjailEpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoUnjailEpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashDestinations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashDestinations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashDestinations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsurancePool", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InsurancePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
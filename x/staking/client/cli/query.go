@@ -39,6 +39,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryHistoricalInfo(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
+		GetCmdQueryValidatorGovParticipation(),
+		GetCmdQueryDelegationLockup(),
+		GetCmdQueryDelegatorLockups(),
 	)
 
 	return stakingQueryCmd
@@ -744,3 +747,159 @@ $ %s query staking params
 
 	return cmd
 }
+
+// GetCmdQueryValidatorGovParticipation implements the validator governance
+// participation query command.
+func GetCmdQueryValidatorGovParticipation() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "gov-participation [validator-addr]",
+		Short: "Query the governance participation of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of consecutive governance proposals a validator missed,
+and whether it is flagged as absent from governance.
+
+Example:
+$ %s query staking gov-participation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorGovParticipationRequest{ValidatorAddr: addr.String()}
+			res, err := queryClient.ValidatorGovParticipation(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDelegationLockup implements the command to query the lockup of a
+// delegation.
+func GetCmdQueryDelegationLockup() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "lockup [delegator-addr] [validator-addr]",
+		Short: "Query the lockup of a delegation based on address and validator address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the lockup of the delegation of an individual delegator on an individual validator.
+
+Example:
+$ %s query staking lockup %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryDelegationLockupRequest{
+				DelegatorAddr: delAddr.String(),
+				ValidatorAddr: valAddr.String(),
+			}
+
+			res, err := queryClient.DelegationLockup(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Lockup)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDelegatorLockups implements the command to query the lockups of
+// the delegations of a delegator.
+func GetCmdQueryDelegatorLockups() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "lockups [delegator-addr]",
+		Short: "Query the lockups of the delegations of one delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the lockups of the delegations of an individual delegator on all validators.
+
+Example:
+$ %s query staking lockups %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryDelegatorLockupsRequest{
+				DelegatorAddr: delAddr.String(),
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.DelegatorLockups(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "lockups")
+
+	return cmd
+}
@@ -0,0 +1,182 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RepairPoolsCmd returns a command that checks the bonded and not bonded pool
+// balances of a genesis file against the staking state and, if they drifted
+// apart, prints a corrected genesis.
+func RepairPoolsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repair-staking-pools [genesis-file]",
+		Short: "Correct drifted bonded and not bonded pool balances of a genesis file",
+		Long: fmt.Sprintf(`Check the bonded and not bonded pool balances of the given genesis file
+against the tokens of the validators and unbonding delegations of the staking
+genesis state. If drift is detected, the pool balances and the total supply of
+the bank genesis state are corrected and the patched genesis is printed to
+STDOUT. This is meant for chains recovering from historical accounting bugs
+with a genesis export.
+
+Example:
+$ %s repair-staking-pools /path/to/genesis.json > /path/to/repaired_genesis.json
+`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.Codec
+
+			genDoc, err := tmtypes.GenesisDocFromFile(args[0])
+			if err != nil {
+				return errors.Wrapf(err, "failed to read genesis doc from file %s", args[0])
+			}
+
+			var appState map[string]json.RawMessage
+			if err := json.Unmarshal(genDoc.AppState, &appState); err != nil {
+				return errors.Wrap(err, "failed to JSON unmarshal genesis state")
+			}
+
+			var (
+				stakingGenState types.GenesisState
+				bankGenState    banktypes.GenesisState
+			)
+
+			if err := cdc.UnmarshalJSON(appState[types.ModuleName], &stakingGenState); err != nil {
+				return errors.Wrap(err, "failed to unmarshal staking genesis state")
+			}
+
+			if err := cdc.UnmarshalJSON(appState[banktypes.ModuleName], &bankGenState); err != nil {
+				return errors.Wrap(err, "failed to unmarshal bank genesis state")
+			}
+
+			drift, err := repairPools(&stakingGenState, &bankGenState)
+			if err != nil {
+				return err
+			}
+
+			if len(drift) == 0 {
+				cmd.PrintErrln("no pool balance drift detected")
+				return nil
+			}
+
+			for _, d := range drift {
+				cmd.PrintErrln(d)
+			}
+
+			appState[banktypes.ModuleName], err = cdc.MarshalJSON(&bankGenState)
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal bank genesis state")
+			}
+
+			genDoc.AppState, err = json.Marshal(appState)
+			if err != nil {
+				return errors.Wrap(err, "failed to JSON marshal genesis state")
+			}
+
+			bz, err := tmjson.Marshal(genDoc)
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal genesis doc")
+			}
+
+			sortedBz, err := sdk.SortJSON(bz)
+			if err != nil {
+				return errors.Wrap(err, "failed to sort JSON genesis doc")
+			}
+
+			cmd.Println(string(sortedBz))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// repairPools sets the bonded and not bonded pool balances of the bank genesis
+// state to the amounts expected from the staking genesis state and adjusts the
+// total supply accordingly. It returns a description of every corrected pool.
+func repairPools(stakingGenState *types.GenesisState, bankGenState *banktypes.GenesisState) ([]string, error) {
+	bondedTokens := sdk.ZeroInt()
+	notBondedTokens := sdk.ZeroInt()
+
+	for _, validator := range stakingGenState.Validators {
+		switch validator.GetStatus() {
+		case types.Bonded:
+			bondedTokens = bondedTokens.Add(validator.GetTokens())
+		case types.Unbonding, types.Unbonded:
+			notBondedTokens = notBondedTokens.Add(validator.GetTokens())
+		default:
+			return nil, fmt.Errorf("invalid validator status: %s", validator.GetStatus())
+		}
+	}
+
+	for _, ubd := range stakingGenState.UnbondingDelegations {
+		for _, entry := range ubd.Entries {
+			notBondedTokens = notBondedTokens.Add(entry.Balance)
+		}
+	}
+
+	bondDenom := stakingGenState.Params.BondDenom
+	expected := []struct {
+		name  string
+		coins sdk.Coins
+	}{
+		{types.BondedPoolName, sdk.NewCoins(sdk.NewCoin(bondDenom, bondedTokens))},
+		{types.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(bondDenom, notBondedTokens))},
+	}
+
+	var drift []string
+	for _, pool := range expected {
+		addr := authtypes.NewModuleAddress(pool.name).String()
+
+		idx := -1
+		for i, balance := range bankGenState.Balances {
+			if balance.Address == addr {
+				idx = i
+				break
+			}
+		}
+
+		var actual sdk.Coins
+		if idx >= 0 {
+			actual = bankGenState.Balances[idx].Coins
+		}
+
+		if actual.IsAllGTE(pool.coins) && pool.coins.IsAllGTE(actual) {
+			continue
+		}
+
+		if !bankGenState.Supply.Empty() {
+			supply, hasNeg := bankGenState.Supply.Add(pool.coins...).SafeSub(actual)
+			if hasNeg {
+				return nil, fmt.Errorf("total supply %s is less than the %s pool balance %s", bankGenState.Supply, pool.name, actual)
+			}
+
+			bankGenState.Supply = supply
+		}
+
+		if idx >= 0 {
+			bankGenState.Balances[idx].Coins = pool.coins
+		} else {
+			bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: addr, Coins: pool.coins})
+		}
+
+		drift = append(drift, fmt.Sprintf("%s pool balance corrected: %s -> %s", pool.name, actual, pool.coins))
+	}
+
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+
+	return drift, nil
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestRepairPools(t *testing.T) {
+	bondedAddr := authtypes.NewModuleAddress(types.BondedPoolName).String()
+	notBondedAddr := authtypes.NewModuleAddress(types.NotBondedPoolName).String()
+	userAddr := sdk.AccAddress("user________________").String()
+
+	stakingGenState := types.DefaultGenesisState()
+	stakingGenState.Validators = []types.Validator{
+		{Status: types.Bonded, Tokens: sdk.NewInt(100)},
+		{Status: types.Unbonded, Tokens: sdk.NewInt(20)},
+	}
+	stakingGenState.UnbondingDelegations = []types.UnbondingDelegation{
+		{Entries: []types.UnbondingDelegationEntry{{Balance: sdk.NewInt(5)}}},
+	}
+
+	bondDenom := stakingGenState.Params.BondDenom
+	coins := func(amt int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amt))
+	}
+
+	// no drift
+	bankGenState := banktypes.DefaultGenesisState()
+	bankGenState.Balances = []banktypes.Balance{
+		{Address: bondedAddr, Coins: coins(100)},
+		{Address: notBondedAddr, Coins: coins(25)},
+		{Address: userAddr, Coins: coins(10)},
+	}
+	bankGenState.Supply = coins(135)
+
+	drift, err := repairPools(stakingGenState, bankGenState)
+	require.NoError(t, err)
+	require.Empty(t, drift)
+
+	// bonded pool is over funded and the not bonded pool is missing
+	bankGenState.Balances = []banktypes.Balance{
+		{Address: bondedAddr, Coins: coins(110)},
+		{Address: userAddr, Coins: coins(10)},
+	}
+	bankGenState.Supply = coins(120)
+
+	drift, err = repairPools(stakingGenState, bankGenState)
+	require.NoError(t, err)
+	require.Len(t, drift, 2)
+	require.Equal(t, coins(135), bankGenState.Supply)
+	require.NoError(t, bankGenState.Validate())
+
+	for _, balance := range bankGenState.Balances {
+		switch balance.Address {
+		case bondedAddr:
+			require.Equal(t, coins(100), balance.Coins)
+		case notBondedAddr:
+			require.Equal(t, coins(25), balance.Coins)
+		default:
+			require.Equal(t, coins(10), balance.Coins)
+		}
+	}
+
+	// total supply does not cover the drifted pool balance
+	bankGenState.Balances = []banktypes.Balance{
+		{Address: bondedAddr, Coins: coins(200)},
+		{Address: notBondedAddr, Coins: coins(25)},
+	}
+	bankGenState.Supply = coins(10)
+	_, err = repairPools(stakingGenState, bankGenState)
+	require.Error(t, err)
+}
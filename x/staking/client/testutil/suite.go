@@ -881,13 +881,15 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 			`bond_denom: stake
 historical_entries: 10000
 max_entries: 7
+max_missed_gov_proposals: 0
 max_validators: 100
-unbonding_time: 1814400s`,
+unbonding_time: 1814400s
+validator_set_epoch_length: "0"`,
 		},
 		{
 			"with json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"unbonding_time":"1814400s","max_validators":100,"max_entries":7,"historical_entries":10000,"bond_denom":"stake"}`,
+			`{"unbonding_time":"1814400s","max_validators":100,"max_entries":7,"historical_entries":10000,"bond_denom":"stake","max_missed_gov_proposals":0,"validator_set_epoch_length":"0"}`,
 		},
 	}
 	for _, tc := range testCases {
@@ -102,6 +102,23 @@ func InitGenesis(
 		}
 	}
 
+	for _, rotation := range data.ConsPubkeyRotations {
+		keeper.SetConsPubKeyRotation(ctx, rotation)
+	}
+
+	for _, participation := range data.GovParticipations {
+		keeper.SetGovParticipation(ctx, participation)
+	}
+
+	for _, vote := range data.ValidatorGovVotes {
+		keeper.SetValidatorGovVote(ctx, vote)
+	}
+
+	for _, lockup := range data.DelegationLockups {
+		keeper.SetDelegationLockup(ctx, lockup)
+		keeper.InsertLockupQueue(ctx, lockup)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -195,6 +212,10 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+		ConsPubkeyRotations:  keeper.GetAllConsPubKeyRotations(ctx),
+		GovParticipations:    keeper.GetAllGovParticipations(ctx),
+		ValidatorGovVotes:    keeper.GetAllValidatorGovVotes(ctx),
+		DelegationLockups:    keeper.GetAllDelegationLockups(ctx),
 	}
 }
 
@@ -230,6 +251,18 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateConsPubKeyRotations(data.ConsPubkeyRotations); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateGovParticipations(data.GovParticipations, data.ValidatorGovVotes); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateDelegationLockups(data.DelegationLockups); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
@@ -270,3 +303,63 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 
 	return nil
 }
+
+func validateGenesisStateConsPubKeyRotations(rotations []types.ConsPubKeyRotation) error {
+	for _, rotation := range rotations {
+		if _, err := sdk.ValAddressFromBech32(rotation.OperatorAddress); err != nil {
+			return err
+		}
+
+		if _, err := rotation.OldConsPubKey(); err != nil {
+			return err
+		}
+
+		if _, err := rotation.NewConsPubKey(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateGenesisStateGovParticipations(participations []types.GovParticipation, votes []types.ValidatorGovVote) error {
+	for _, participation := range participations {
+		if _, err := sdk.ValAddressFromBech32(participation.ValidatorAddress); err != nil {
+			return err
+		}
+	}
+
+	for _, vote := range votes {
+		if _, err := sdk.ValAddressFromBech32(vote.ValidatorAddress); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateGenesisStateDelegationLockups(lockups []types.DelegationLockup) error {
+	seen := make(map[string]bool, len(lockups))
+
+	for _, lockup := range lockups {
+		if _, err := sdk.AccAddressFromBech32(lockup.DelegatorAddress); err != nil {
+			return err
+		}
+
+		if _, err := sdk.ValAddressFromBech32(lockup.ValidatorAddress); err != nil {
+			return err
+		}
+
+		key := lockup.DelegatorAddress + "/" + lockup.ValidatorAddress
+		if seen[key] {
+			return fmt.Errorf("duplicate delegation lockup in genesis state: delegator %s, validator %s", lockup.DelegatorAddress, lockup.ValidatorAddress)
+		}
+		seen[key] = true
+
+		if lockup.RewardMultiplier.IsNil() || lockup.RewardMultiplier.LT(sdk.OneDec()) {
+			return fmt.Errorf("delegation lockup reward multiplier must be at least one: %s", lockup.RewardMultiplier)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmstrings "github.com/tendermint/tendermint/libs/strings"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RotateConsPubKey replaces the consensus public key of a validator. A
+// validator may rotate its key at most once per unbonding period. The old
+// consensus address keeps resolving to the validator until the rotation
+// matures, so that infractions committed with the old key can be punished.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, validator types.Validator, newPubKey cryptotypes.PubKey) error {
+	oldPubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	if oldPubKey.Equals(newPubKey) {
+		return types.ErrSameConsPubKey
+	}
+
+	// the old consensus addresses of rotated keys are still indexed, hence
+	// keys cannot be reused while their rotation has not matured
+	if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(newPubKey)); found {
+		return types.ErrValidatorPubKeyExists
+	}
+
+	cp := ctx.ConsensusParams()
+	if cp != nil && cp.Validator != nil {
+		if !tmstrings.StringInSlice(newPubKey.Type(), cp.Validator.PubKeyTypes) {
+			return sdkerrors.Wrapf(
+				types.ErrValidatorPubKeyTypeNotSupported,
+				"got: %s, expected: %s", newPubKey.Type(), cp.Validator.PubKeyTypes,
+			)
+		}
+	}
+
+	// rotations are kept until they mature, so any remaining rotation of the
+	// validator happened within the last unbonding period
+	if len(k.GetValidatorConsPubKeyRotations(ctx, validator.GetOperator())) > 0 {
+		return types.ErrConsPubKeyRotationLimit
+	}
+
+	rotation, err := types.NewConsPubKeyRotation(
+		validator.GetOperator(), oldPubKey, newPubKey, ctx.BlockHeight(), ctx.BlockHeader().Time,
+	)
+	if err != nil {
+		return err
+	}
+
+	validator.ConsensusPubkey = rotation.NewConsPubkey
+	k.SetValidator(ctx, validator)
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+
+	k.SetConsPubKeyRotation(ctx, rotation)
+
+	return k.AfterConsPubKeyRotated(ctx, validator.GetOperator(), oldPubKey, newPubKey)
+}
+
+// GetConsPubKeyRotation gets the rotation of the consensus public key of a
+// validator away from the given consensus address.
+func (k Keeper) GetConsPubKeyRotation(
+	ctx sdk.Context, operatorAddr sdk.ValAddress, oldConsAddr sdk.ConsAddress,
+) (rotation types.ConsPubKeyRotation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetConsPubKeyRotationKey(operatorAddr, oldConsAddr))
+	if value == nil {
+		return rotation, false
+	}
+
+	return types.MustUnmarshalConsPubKeyRotation(k.cdc, value), true
+}
+
+// SetConsPubKeyRotation sets a consensus public key rotation and indexes the
+// old consensus address of the rotation by the validator.
+func (k Keeper) SetConsPubKeyRotation(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	oldConsAddr, err := rotation.OldConsAddr()
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetConsPubKeyRotationKey(rotation.GetOperator(), oldConsAddr), k.cdc.MustMarshal(&rotation))
+	store.Set(types.GetValidatorByConsAddrKey(oldConsAddr), rotation.GetOperator())
+}
+
+// RemoveConsPubKeyRotation removes a consensus public key rotation along with
+// the index of its old consensus address.
+func (k Keeper) RemoveConsPubKeyRotation(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	oldConsAddr, err := rotation.OldConsAddr()
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetConsPubKeyRotationKey(rotation.GetOperator(), oldConsAddr))
+	store.Delete(types.GetValidatorByConsAddrKey(oldConsAddr))
+}
+
+// IterateConsPubKeyRotations iterates through all the consensus public key
+// rotations. If the cb returns true, the iterator will close and stop.
+func (k Keeper) IterateConsPubKeyRotations(ctx sdk.Context, cb func(types.ConsPubKeyRotation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.MustUnmarshalConsPubKeyRotation(k.cdc, iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetAllConsPubKeyRotations returns all the stored consensus public key
+// rotations.
+func (k Keeper) GetAllConsPubKeyRotations(ctx sdk.Context) (rotations []types.ConsPubKeyRotation) {
+	k.IterateConsPubKeyRotations(ctx, func(rotation types.ConsPubKeyRotation) bool {
+		rotations = append(rotations, rotation)
+		return false
+	})
+
+	return rotations
+}
+
+// GetValidatorConsPubKeyRotations returns the consensus public key rotations
+// of a validator that have not matured yet.
+func (k Keeper) GetValidatorConsPubKeyRotations(ctx sdk.Context, operatorAddr sdk.ValAddress) (rotations []types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetConsPubKeyRotationsKey(operatorAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		rotations = append(rotations, types.MustUnmarshalConsPubKeyRotation(k.cdc, iterator.Value()))
+	}
+
+	return rotations
+}
+
+// RemoveMatureConsPubKeyRotations removes all the consensus public key
+// rotations that happened more than an unbonding period ago.
+func (k Keeper) RemoveMatureConsPubKeyRotations(ctx sdk.Context) {
+	unbondingTime := k.UnbondingTime(ctx)
+
+	var mature []types.ConsPubKeyRotation
+	k.IterateConsPubKeyRotations(ctx, func(rotation types.ConsPubKeyRotation) bool {
+		if rotation.IsMature(ctx.BlockHeight(), ctx.BlockHeader().Time, unbondingTime) {
+			mature = append(mature, rotation)
+		}
+		return false
+	})
+
+	for _, rotation := range mature {
+		k.RemoveConsPubKeyRotation(ctx, rotation)
+	}
+}
+
+// getBlockConsPubKeyRotations returns the consensus public key rotations that
+// happened in the current block, by validator operator address.
+func (k Keeper) getBlockConsPubKeyRotations(ctx sdk.Context) map[string]types.ConsPubKeyRotation {
+	rotations := make(map[string]types.ConsPubKeyRotation)
+	k.IterateConsPubKeyRotations(ctx, func(rotation types.ConsPubKeyRotation) bool {
+		if rotation.Height == ctx.BlockHeight() {
+			rotations[rotation.OperatorAddress] = rotation
+		}
+		return false
+	})
+
+	return rotations
+}
+
+// abciValidatorUpdateZero returns an abci.ValidatorUpdate removing the given
+// consensus public key from the Tendermint validator set.
+func abciValidatorUpdateZero(pk cryptotypes.PubKey) abci.ValidatorUpdate {
+	tmPk, err := cryptocodec.ToTmProtoPublicKey(pk)
+	if err != nil {
+		panic(err)
+	}
+
+	return abci.ValidatorUpdate{
+		PubKey: tmPk,
+		Power:  0,
+	}
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// bootstrapRotationTest creates a bonded validator through the app's staking
+// keeper so that the hooks of the other modules are called.
+func bootstrapRotationTest(t *testing.T) (*simapp.SimApp, sdk.Context, types.Validator) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: time.Now().UTC()})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddr := sdk.ValAddress(addrs[0])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddr, PKs[0], 10, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.IsBonded())
+
+	return app, ctx, validator
+}
+
+func TestRotateConsPubKey(t *testing.T) {
+	app, ctx, validator := bootstrapRotationTest(t)
+
+	oldPk, err := validator.ConsPubKey()
+	require.NoError(t, err)
+	oldConsAddr := sdk.GetConsAddress(oldPk)
+	newPk := PKs[1]
+	newConsAddr := sdk.GetConsAddress(newPk)
+
+	require.ErrorIs(t, app.StakingKeeper.RotateConsPubKey(ctx, validator, oldPk), types.ErrSameConsPubKey)
+	require.NoError(t, app.StakingKeeper.RotateConsPubKey(ctx, validator, newPk))
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, validator.GetOperator())
+	require.True(t, found)
+	pk, err := validator.ConsPubKey()
+	require.NoError(t, err)
+	require.True(t, newPk.Equals(pk))
+
+	// both the old and the new consensus address resolve to the validator
+	for _, consAddr := range []sdk.ConsAddress{oldConsAddr, newConsAddr} {
+		val, found := app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+		require.True(t, found)
+		require.Equal(t, validator.OperatorAddress, val.OperatorAddress)
+	}
+
+	// the signing info has been carried over to the new key
+	signingInfo, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.True(t, found)
+	require.Equal(t, newConsAddr.String(), signingInfo.Address)
+
+	// only one rotation is allowed per unbonding period
+	require.ErrorIs(t, app.StakingKeeper.RotateConsPubKey(ctx, validator, PKs[2]), types.ErrConsPubKeyRotationLimit)
+
+	// Tendermint is told to replace the old key with the new one
+	updates := applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 2)
+	require.Equal(t, zeroPowerUpdate(t, oldPk), updates[0])
+	require.Equal(t, validator.ABCIValidatorUpdate(app.StakingKeeper.PowerReduction(ctx)), updates[1])
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 0)
+
+	require.Len(t, staking.ExportGenesis(ctx, app.StakingKeeper).ConsPubkeyRotations, 1)
+
+	// the rotation matures after an unbonding period
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).
+		WithBlockTime(ctx.BlockTime().Add(app.StakingKeeper.UnbondingTime(ctx)))
+	app.StakingKeeper.BlockValidatorUpdates(ctx)
+
+	require.Empty(t, app.StakingKeeper.GetAllConsPubKeyRotations(ctx))
+	_, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, oldConsAddr)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, newConsAddr)
+	require.True(t, found)
+
+	require.NoError(t, app.StakingKeeper.RotateConsPubKey(ctx, validator, PKs[2]))
+}
+
+func TestRotateConsPubKeyUnbondingValidator(t *testing.T) {
+	app, ctx, validator := bootstrapRotationTest(t)
+
+	oldPk, err := validator.ConsPubKey()
+	require.NoError(t, err)
+
+	require.NoError(t, app.StakingKeeper.RotateConsPubKey(ctx, validator, PKs[1]))
+	app.StakingKeeper.Jail(ctx, sdk.GetConsAddress(PKs[1]))
+
+	// the validator leaves the set under the key Tendermint knows about
+	updates := applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 1)
+	require.Equal(t, zeroPowerUpdate(t, oldPk), updates[0])
+}
+
+func zeroPowerUpdate(t *testing.T, pk cryptotypes.PubKey) abci.ValidatorUpdate {
+	tmPk, err := cryptocodec.ToTmProtoPublicKey(pk)
+	require.NoError(t, err)
+	return abci.ValidatorUpdate{PubKey: tmPk, Power: 0}
+}
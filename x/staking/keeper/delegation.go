@@ -88,6 +88,24 @@ func (k Keeper) GetDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddres
 	return delegations[:i] // trim if the array length < maxRetrieve
 }
 
+// HasDelegations returns whether a delegator has delegations or unbonding
+// delegations.
+func (k Keeper) HasDelegations(ctx sdk.Context, delegator sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, prefix := range [][]byte{types.GetDelegationsKey(delegator), types.GetUBDsKey(delegator)} {
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		valid := iterator.Valid()
+		iterator.Close()
+
+		if valid {
+			return true
+		}
+	}
+
+	return false
+}
+
 // set a delegation
 func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
 	delegatorAddress, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
@@ -113,6 +131,12 @@ func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) e
 
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr()))
+
+	// a removed delegation is no longer locked
+	if lockup, found := k.GetDelegationLockup(ctx, delegatorAddress, delegation.GetValidatorAddr()); found {
+		k.RemoveDelegationLockup(ctx, lockup)
+	}
+
 	return nil
 }
 
@@ -746,6 +770,10 @@ func (k Keeper) Undelegate(
 		return time.Time{}, types.ErrNoDelegatorForAddress
 	}
 
+	if err := k.assertDelegationUnlocked(ctx, delAddr, valAddr); err != nil {
+		return time.Time{}, err
+	}
+
 	if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
 		return time.Time{}, types.ErrMaxUnbondingDelegationEntries
 	}
@@ -816,6 +844,67 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 	return balances, nil
 }
 
+// CancelUnbondingDelegation delegates back to the validator an amount of the
+// tokens of the unbonding delegation entry created at a height, which is
+// removed once all its tokens are delegated back. The tokens are delegated at
+// the current exchange rate of the validator, and the entry must not have
+// completed yet.
+func (k Keeper) CancelUnbondingDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, amount sdk.Int,
+) (newShares sdk.Dec, err error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return newShares, types.ErrNoValidatorFound
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return newShares, types.ErrNoUnbondingDelegation
+	}
+
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight && !entry.IsMature(ctx.BlockHeader().Time) {
+			entryIndex = i
+			break
+		}
+	}
+	if entryIndex == -1 {
+		return newShares, sdkerrors.Wrapf(types.ErrNoUnbondingDelegationEntry, "creation height %d", creationHeight)
+	}
+
+	entry := ubd.Entries[entryIndex]
+	if amount.GT(entry.Balance) {
+		return newShares, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "amount %s exceeds the unbonding delegation entry balance %s", amount, entry.Balance,
+		)
+	}
+
+	// the tokens of the entry are held by the not bonded pool
+	newShares, err = k.Delegate(ctx, delAddr, amount, types.Unbonding, validator, false)
+	if err != nil {
+		return newShares, err
+	}
+
+	if amount.Equal(entry.Balance) {
+		ubd.RemoveEntry(int64(entryIndex))
+	} else {
+		entry.Balance = entry.Balance.Sub(amount)
+		entry.InitialBalance = entry.InitialBalance.Sub(amount)
+		ubd.Entries[entryIndex] = entry
+	}
+
+	// the unbonding queue still references the unbonding delegation, which is
+	// skipped on maturity if it was removed
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return newShares, nil
+}
+
 // begin unbonding / redelegation; create a redelegation record
 func (k Keeper) BeginRedelegation(
 	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec,
@@ -824,6 +913,10 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, types.ErrSelfRedelegation
 	}
 
+	if err := k.assertDelegationUnlocked(ctx, delAddr, valSrcAddr); err != nil {
+		return time.Time{}, err
+	}
+
 	dstValidator, found := k.GetValidator(ctx, valDstAddr)
 	if !found {
 		return time.Time{}, types.ErrBadRedelegationDst
@@ -299,6 +299,85 @@ func TestUnbondingDelegationsMaxEntries(t *testing.T) {
 
 //// test undelegating self delegation from a validator pushing it below MinSelfDelegation
 //// shift it from the bonded to unbonding state and jailed
+func TestCancelUnbondingDelegation(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
+
+	startTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, notBondedPool.GetName(), sdk.NewCoins(sdk.NewCoin(bondDenom, startTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	// create a validator and a delegator to that validator
+	validator := teststaking.NewValidator(t, addrVals[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	require.True(t, validator.IsBonded())
+
+	delegation := types.NewDelegation(addrDels[0], addrVals[0], issuedShares)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+
+	ctx = ctx.WithBlockHeight(10)
+	completionTime, err := app.StakingKeeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewDec(100))
+	require.NoError(t, err)
+
+	oldBonded := app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetBondedPool(ctx).GetAddress(), bondDenom).Amount
+	oldNotBonded := app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom).Amount
+
+	// the entry must exist and hold enough tokens
+	_, err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], 9, sdk.NewInt(40))
+	require.ErrorIs(t, err, types.ErrNoUnbondingDelegationEntry)
+	_, err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], 10, sdk.NewInt(101))
+	require.Error(t, err)
+	_, err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[1], 10, sdk.NewInt(40))
+	require.ErrorIs(t, err, types.ErrNoValidatorFound)
+
+	// partial cancellation
+	newShares, err := app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], 10, sdk.NewInt(40))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(40), newShares)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, sdk.NewInt(60), ubd.Entries[0].Balance)
+	require.Equal(t, sdk.NewInt(60), ubd.Entries[0].InitialBalance)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, issuedShares.Sub(sdk.NewDec(60)), delegation.Shares)
+
+	newBonded := app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetBondedPool(ctx).GetAddress(), bondDenom).Amount
+	newNotBonded := app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom).Amount
+	require.True(sdk.IntEq(t, oldBonded.AddRaw(40), newBonded))
+	require.True(sdk.IntEq(t, oldNotBonded.SubRaw(40), newNotBonded))
+
+	// full cancellation removes the unbonding delegation
+	_, err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], 10, sdk.NewInt(60))
+	require.NoError(t, err)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, issuedShares, delegation.Shares)
+
+	// a matured entry can no longer be cancelled
+	_, err = app.StakingKeeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewDec(100))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(completionTime)
+	_, err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], 10, sdk.NewInt(100))
+	require.ErrorIs(t, err, types.ErrNoUnbondingDelegationEntry)
+
+	// the unbonding queue skips the removed unbonding delegation
+	require.NotPanics(t, func() { app.StakingKeeper.BlockValidatorUpdates(ctx) })
+}
+
 func TestUndelegateSelfDelegationBelowMinSelfDelegation(t *testing.T) {
 	_, app, ctx := createTestInput(t)
 
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// IsConvertibleDenom returns whether a converter into the bond denom is
+// registered for the given denom.
+func (k Keeper) IsConvertibleDenom(denom string) bool {
+	_, ok := k.denomConverters[denom]
+	return ok
+}
+
+// ConvertToBondDenom converts a coin of the delegator into the bond denom with
+// the converter registered for its denom, and returns the amount of bond denom
+// tokens credited to the delegator. Bond denom coins are returned as is. The
+// conversion is applied only if it succeeds, so a failing converter leaves no
+// state behind.
+func (k Keeper) ConvertToBondDenom(ctx sdk.Context, delegator sdk.AccAddress, coin sdk.Coin) (sdk.Int, error) {
+	if coin.Denom == k.BondDenom(ctx) {
+		return coin.Amount, nil
+	}
+
+	converter, ok := k.denomConverters[coin.Denom]
+	if !ok {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrDenomNotConvertible, "%s", coin.Denom)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	amount, err := converter.ConvertToBondDenom(cacheCtx, delegator, coin)
+	if err != nil {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrDenomConversionFailed, "%s: %s", coin, err)
+	}
+	if amount.IsNil() || !amount.IsPositive() {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrDenomConversionFailed, "%s converts to no bond denom tokens", coin)
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return amount, nil
+}
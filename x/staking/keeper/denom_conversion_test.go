@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// unwrapConverter converts a liquid staking derivative into twice as many
+// bond denom tokens, escrowing the derivative in the mint module account.
+type unwrapConverter struct {
+	app       *simapp.SimApp
+	available bool
+}
+
+func (c unwrapConverter) ConvertToBondDenom(ctx sdk.Context, delegator sdk.AccAddress, coin sdk.Coin) (sdk.Int, error) {
+	if err := c.app.BankKeeper.SendCoinsFromAccountToModule(ctx, delegator, minttypes.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return sdk.Int{}, err
+	}
+	if !c.available {
+		return sdk.Int{}, errors.New("conversion paused")
+	}
+
+	amount := coin.Amount.MulRaw(2)
+	bondCoins := sdk.NewCoins(sdk.NewCoin(c.app.StakingKeeper.BondDenom(ctx), amount))
+	if err := c.app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, bondCoins); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := c.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, delegator, bondCoins); err != nil {
+		return sdk.Int{}, err
+	}
+
+	return amount, nil
+}
+
+func TestDelegateConvertedDenom(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddr, delAddr := sdk.ValAddress(addrs[0]), addrs[1]
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddr, PKs[0], 10, true)
+
+	converter := &unwrapConverter{app: app, available: true}
+	app.StakingKeeper.SetDenomConverter(converter, "stkstake")
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	derivative := sdk.NewInt64Coin("stkstake", 1000)
+	require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, delAddr, sdk.NewCoins(derivative.Add(derivative))))
+	bondBalance := app.BankKeeper.GetBalance(ctx, delAddr, app.StakingKeeper.BondDenom(ctx))
+
+	delegate := func(amount sdk.Coin) error {
+		_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, valAddr, amount))
+		return err
+	}
+
+	// the derivative is converted and its bond denom value delegated
+	require.NoError(t, delegate(derivative))
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(2000), validator.TokensFromShares(delegation.Shares).TruncateInt())
+	require.Equal(t, derivative, app.BankKeeper.GetBalance(ctx, delAddr, "stkstake"))
+	require.Equal(t, bondBalance, app.BankKeeper.GetBalance(ctx, delAddr, app.StakingKeeper.BondDenom(ctx)))
+
+	// a failing conversion leaves no state behind
+	converter.available = false
+	err := delegate(derivative)
+	require.ErrorIs(t, err, types.ErrDenomConversionFailed)
+	require.Equal(t, derivative, app.BankKeeper.GetBalance(ctx, delAddr, "stkstake"))
+
+	// denoms without a converter are rejected
+	err = delegate(sdk.NewInt64Coin("other", 1000))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = app.StakingKeeper.ConvertToBondDenom(ctx, delAddr, sdk.NewInt64Coin("other", 1000))
+	require.ErrorIs(t, err, types.ErrDenomNotConvertible)
+
+	require.Panics(t, func() { app.StakingKeeper.SetDenomConverter(converter, "stkstake") })
+}
@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetGovParticipation gets the governance participation of a validator. A
+// validator without participation record didn't miss the last proposals.
+func (k Keeper) GetGovParticipation(ctx sdk.Context, operatorAddr sdk.ValAddress) types.GovParticipation {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetGovParticipationKey(operatorAddr))
+	if value == nil {
+		return types.NewGovParticipation(operatorAddr, 0)
+	}
+
+	return types.MustUnmarshalGovParticipation(k.cdc, value)
+}
+
+// SetGovParticipation sets the governance participation of a validator. The
+// record is deleted once the validator didn't miss the last proposal.
+func (k Keeper) SetGovParticipation(ctx sdk.Context, participation types.GovParticipation) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetGovParticipationKey(participation.GetValidator())
+
+	if participation.MissedProposals == 0 {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&participation))
+}
+
+// RemoveGovParticipation removes the governance participation of a validator.
+func (k Keeper) RemoveGovParticipation(ctx sdk.Context, operatorAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetGovParticipationKey(operatorAddr))
+}
+
+// IterateGovParticipations iterates through the governance participations of
+// the validators which missed the last proposals. If the cb returns true, the
+// iterator will close and stop.
+func (k Keeper) IterateGovParticipations(ctx sdk.Context, cb func(types.GovParticipation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GovParticipationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.MustUnmarshalGovParticipation(k.cdc, iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetAllGovParticipations returns the governance participations of the
+// validators which missed the last proposals.
+func (k Keeper) GetAllGovParticipations(ctx sdk.Context) (participations []types.GovParticipation) {
+	k.IterateGovParticipations(ctx, func(participation types.GovParticipation) bool {
+		participations = append(participations, participation)
+		return false
+	})
+
+	return participations
+}
+
+// IsGovAbsentee returns whether a validator is flagged as absent from
+// governance, i.e. it missed at least MaxMissedGovProposals consecutive
+// proposals.
+func (k Keeper) IsGovAbsentee(ctx sdk.Context, operatorAddr sdk.ValAddress) bool {
+	return k.GetGovParticipation(ctx, operatorAddr).IsAbsent(k.MaxMissedGovProposals(ctx))
+}
+
+// SetValidatorGovVote records that a validator voted on a governance proposal.
+func (k Keeper) SetValidatorGovVote(ctx sdk.Context, vote types.ValidatorGovVote) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorGovVoteKey(vote.ProposalId, vote.GetValidator()), k.cdc.MustMarshal(&vote))
+}
+
+// HasValidatorGovVote returns whether a validator voted on a governance
+// proposal in voting period.
+func (k Keeper) HasValidatorGovVote(ctx sdk.Context, proposalID uint64, operatorAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetValidatorGovVoteKey(proposalID, operatorAddr))
+}
+
+// GetAllValidatorGovVotes returns the validator votes on all the governance
+// proposals in voting period.
+func (k Keeper) GetAllValidatorGovVotes(ctx sdk.Context) (votes []types.ValidatorGovVote) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorGovVoteKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.ValidatorGovVote
+		k.cdc.MustUnmarshal(iterator.Value(), &vote)
+		votes = append(votes, vote)
+	}
+
+	return votes
+}
+
+// removeValidatorGovVotes removes the validator votes on a governance proposal.
+func (k Keeper) removeValidatorGovVotes(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetValidatorGovVotesKey(proposalID))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// updateGovParticipations updates the governance participation of the bonded
+// validators once the voting period of a proposal ended. The validators which
+// voted on the proposal are reset, and the other ones are flagged as absent
+// from governance when they reach MaxMissedGovProposals missed proposals. The
+// validators which are not allowed to vote on the proposal are left untouched.
+func (k Keeper) updateGovParticipations(ctx sdk.Context, proposalID uint64, canVote govtypes.VoterFilter) {
+	maxMissed := k.MaxMissedGovProposals(ctx)
+
+	var participations []types.GovParticipation
+	k.IterateBondedValidatorsByPower(ctx, func(_ int64, validator types.ValidatorI) bool {
+		if !canVote(sdk.AccAddress(validator.GetOperator())) {
+			return false
+		}
+
+		participation := k.GetGovParticipation(ctx, validator.GetOperator())
+		if k.HasValidatorGovVote(ctx, proposalID, validator.GetOperator()) {
+			participation.MissedProposals = 0
+		} else {
+			participation.MissedProposals++
+		}
+		participations = append(participations, participation)
+		return false
+	})
+
+	for _, participation := range participations {
+		k.SetGovParticipation(ctx, participation)
+
+		if maxMissed > 0 && participation.MissedProposals == maxMissed {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeGovAbsentee,
+					sdk.NewAttribute(types.AttributeKeyValidator, participation.ValidatorAddress),
+					sdk.NewAttribute(types.AttributeKeyMissedProposals, strconv.FormatUint(uint64(participation.MissedProposals), 10)),
+				),
+			)
+		}
+	}
+
+	k.removeValidatorGovVotes(ctx, proposalID)
+}
+
+// GovHooks tracks the governance participation of the validators
+type GovHooks struct {
+	k Keeper
+}
+
+var _ govtypes.GovHooks = GovHooks{}
+
+// GovHooks returns the governance hooks tracking the governance participation
+// of the validators.
+func (k Keeper) GovHooks() GovHooks { return GovHooks{k} }
+
+// AfterProposalVote records the votes of the validators, which vote with the
+// account of their operator.
+func (h GovHooks) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+	valAddr := sdk.ValAddress(voterAddr)
+	if _, found := h.k.GetValidator(ctx, valAddr); found {
+		h.k.SetValidatorGovVote(ctx, types.NewValidatorGovVote(proposalID, valAddr))
+	}
+}
+
+// AfterProposalVotingPeriodEnded updates the governance participation of the
+// bonded validators allowed to vote on the proposal.
+func (h GovHooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64, canVote govtypes.VoterFilter) {
+	h.k.updateGovParticipations(ctx, proposalID, canVote)
+}
+
+// AfterProposalCanceled removes the validator votes on the canceled proposal,
+// which is not counted as missed by the validators which did not vote.
+func (h GovHooks) AfterProposalCanceled(ctx sdk.Context, proposalID uint64) {
+	h.k.removeValidatorGovVotes(ctx, proposalID)
+}
+
+func (h GovHooks) AfterProposalSubmission(_ sdk.Context, _ uint64)                 {}
+func (h GovHooks) AfterProposalDeposit(_ sdk.Context, _ uint64, _ sdk.AccAddress)  {}
+func (h GovHooks) AfterProposalFailedMinDeposit(_ sdk.Context, _ uint64)           {}
+func (h GovHooks) AfterProposalExecuted(_ sdk.Context, _ uint64, _ bool, _ string) {}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestGovParticipation(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs[:2])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	for i, valAddr := range valAddrs {
+		tstaking.CreateValidatorWithValPower(valAddr, PKs[i], 10, true)
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MaxMissedGovProposals = 2
+	app.StakingKeeper.SetParams(ctx, params)
+
+	hooks := app.StakingKeeper.GovHooks()
+	allowAll := func(sdk.AccAddress) bool { return true }
+
+	// only the first validator votes on the first proposal, the votes of
+	// delegators are ignored
+	hooks.AfterProposalVote(ctx, 1, sdk.AccAddress(valAddrs[0]))
+	hooks.AfterProposalVote(ctx, 1, addrs[2])
+	require.Len(t, app.StakingKeeper.GetAllValidatorGovVotes(ctx), 1)
+
+	hooks.AfterProposalVotingPeriodEnded(ctx, 1, allowAll)
+	require.Empty(t, app.StakingKeeper.GetAllValidatorGovVotes(ctx))
+	require.Zero(t, app.StakingKeeper.GetGovParticipation(ctx, valAddrs[0]).MissedProposals)
+	require.Equal(t, uint32(1), app.StakingKeeper.GetGovParticipation(ctx, valAddrs[1]).MissedProposals)
+	require.False(t, app.StakingKeeper.IsGovAbsentee(ctx, valAddrs[1]))
+
+	// the second validator is flagged once it missed two proposals in a row
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	hooks.AfterProposalVotingPeriodEnded(ctx, 2, allowAll)
+	require.Equal(t, uint32(1), app.StakingKeeper.GetGovParticipation(ctx, valAddrs[0]).MissedProposals)
+	require.Equal(t, uint32(2), app.StakingKeeper.GetGovParticipation(ctx, valAddrs[1]).MissedProposals)
+	require.False(t, app.StakingKeeper.IsGovAbsentee(ctx, valAddrs[0]))
+	require.True(t, app.StakingKeeper.IsGovAbsentee(ctx, valAddrs[1]))
+	require.Len(t, app.StakingKeeper.GetAllGovParticipations(ctx), 2)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeGovAbsentee, events[0].Type)
+
+	// the flag is disabled with a zero maximum
+	params.MaxMissedGovProposals = 0
+	app.StakingKeeper.SetParams(ctx, params)
+	require.False(t, app.StakingKeeper.IsGovAbsentee(ctx, valAddrs[1]))
+	params.MaxMissedGovProposals = 2
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// voting resets the missed proposals
+	hooks.AfterProposalVote(ctx, 3, sdk.AccAddress(valAddrs[1]))
+	hooks.AfterProposalVotingPeriodEnded(ctx, 3, allowAll)
+	require.Equal(t, uint32(2), app.StakingKeeper.GetGovParticipation(ctx, valAddrs[0]).MissedProposals)
+	require.Zero(t, app.StakingKeeper.GetGovParticipation(ctx, valAddrs[1]).MissedProposals)
+	require.False(t, app.StakingKeeper.IsGovAbsentee(ctx, valAddrs[1]))
+	require.Len(t, app.StakingKeeper.GetAllGovParticipations(ctx), 1)
+
+	// the validators not allowed to vote on a proposal are left untouched
+	onlySecond := func(voter sdk.AccAddress) bool { return voter.Equals(sdk.AccAddress(valAddrs[1])) }
+	hooks.AfterProposalVotingPeriodEnded(ctx, 4, onlySecond)
+	require.Equal(t, uint32(2), app.StakingKeeper.GetGovParticipation(ctx, valAddrs[0]).MissedProposals)
+	require.Equal(t, uint32(1), app.StakingKeeper.GetGovParticipation(ctx, valAddrs[1]).MissedProposals)
+}
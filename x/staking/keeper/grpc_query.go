@@ -470,6 +470,104 @@ func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// ValidatorGovParticipation queries the governance participation of a validator
+func (k Querier) ValidatorGovParticipation(c context.Context, req *types.QueryValidatorGovParticipationRequest) (*types.QueryValidatorGovParticipationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	participation := k.GetGovParticipation(ctx, valAddr)
+
+	return &types.QueryValidatorGovParticipationResponse{
+		GovParticipation: participation,
+		Absent:           participation.IsAbsent(k.MaxMissedGovProposals(ctx)),
+	}, nil
+}
+
+// DelegationLockup queries the lockup of a delegation
+func (k Querier) DelegationLockup(c context.Context, req *types.QueryDelegationLockupRequest) (*types.QueryDelegationLockupResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	lockup, found := k.GetDelegationLockup(ctx, delAddr, valAddr)
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"lockup of delegation with delegator %s not found for validator %s",
+			req.DelegatorAddr, req.ValidatorAddr)
+	}
+
+	return &types.QueryDelegationLockupResponse{Lockup: lockup}, nil
+}
+
+// DelegatorLockups queries the lockups of the delegations of a delegator
+func (k Querier) DelegatorLockups(c context.Context, req *types.QueryDelegatorLockupsRequest) (*types.QueryDelegatorLockupsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var lockups []types.DelegationLockup
+
+	store := ctx.KVStore(k.storeKey)
+	lockupStore := prefix.NewStore(store, types.GetDelegationLockupsKey(delAddr))
+	pageRes, err := query.Paginate(lockupStore, req.Pagination, func(key []byte, value []byte) error {
+		lockup, err := types.UnmarshalDelegationLockup(k.cdc, value)
+		if err != nil {
+			return err
+		}
+		lockups = append(lockups, lockup)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegatorLockupsResponse{Lockups: lockups, Pagination: pageRes}, nil
+}
+
 func queryRedelegation(ctx sdk.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 
 	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
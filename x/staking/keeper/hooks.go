@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -87,3 +88,19 @@ func (k Keeper) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress,
 	}
 	return nil
 }
+
+// AfterConsPubKeyRotated - call hook if registered
+func (k Keeper) AfterConsPubKeyRotated(ctx sdk.Context, valAddr sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	if k.hooks != nil {
+		return k.hooks.AfterConsPubKeyRotated(ctx, valAddr, oldPubKey, newPubKey)
+	}
+	return nil
+}
+
+// BeforeSlashedTokensBurned - call hook if registered
+func (k Keeper) BeforeSlashedTokensBurned(ctx sdk.Context, poolName string, amount sdk.Coins) (sdk.Coins, error) {
+	if k.hooks != nil {
+		return k.hooks.BeforeSlashedTokensBurned(ctx, poolName, amount)
+	}
+	return amount, nil
+}
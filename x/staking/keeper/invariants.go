@@ -18,6 +18,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		PositiveDelegationInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares",
 		DelegatorSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "delegation-pools",
+		DelegationPoolsInvariant(k))
 }
 
 // AllInvariants runs all invariants of the staking module.
@@ -38,7 +40,12 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		return DelegatorSharesInvariant(k)(ctx)
+		res, stop = DelegatorSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return DelegationPoolsInvariant(k)(ctx)
 	}
 }
 
@@ -186,3 +193,58 @@ func DelegatorSharesInvariant(k Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "delegator shares", msg), broken
 	}
 }
+
+// DelegationPoolsInvariant checks that the bonded and notBonded ModuleAccounts
+// pools are fully backed by the tokens of the delegations to bonded and
+// non-bonded validators respectively, plus the balances of all unbonding
+// delegation entries in the case of the notBonded pool.
+func DelegationPoolsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		bonded := sdk.ZeroDec()
+		notBonded := sdk.ZeroDec()
+		bondDenom := k.BondDenom(ctx)
+
+		validators := make(map[string]types.Validator)
+		for _, delegation := range k.GetAllDelegations(ctx) {
+			validator, ok := validators[delegation.ValidatorAddress]
+			if !ok {
+				validator, ok = k.GetValidator(ctx, delegation.GetValidatorAddr())
+				if !ok {
+					panic(fmt.Sprintf("validator record not found for address: %s\n", delegation.ValidatorAddress))
+				}
+				validators[delegation.ValidatorAddress] = validator
+			}
+
+			if validator.DelegatorShares.IsZero() {
+				continue
+			}
+
+			tokens := validator.TokensFromSharesTruncated(delegation.Shares)
+			if validator.IsBonded() {
+				bonded = bonded.Add(tokens)
+			} else {
+				notBonded = notBonded.Add(tokens)
+			}
+		}
+
+		k.IterateUnbondingDelegations(ctx, func(_ int64, ubd types.UnbondingDelegation) bool {
+			for _, entry := range ubd.Entries {
+				notBonded = notBonded.Add(entry.Balance.ToDec())
+			}
+			return false
+		})
+
+		// truncation when converting shares to tokens may cause the sums to be
+		// off by a negligible fraction of a token, hence the rounding
+		poolBonded := k.bankKeeper.GetBalance(ctx, k.GetBondedPool(ctx).GetAddress(), bondDenom)
+		poolNotBonded := k.bankKeeper.GetBalance(ctx, k.GetNotBondedPool(ctx).GetAddress(), bondDenom)
+		broken := !poolBonded.Amount.Equal(bonded.RoundInt()) || !poolNotBonded.Amount.Equal(notBonded.RoundInt())
+
+		return sdk.FormatInvariant(types.ModuleName, "delegation pools", fmt.Sprintf(
+			"\tPool's bonded tokens: %v\n"+
+				"\tsum of tokens delegated to bonded validators: %v\n"+
+				"\tPool's not bonded tokens: %v\n"+
+				"\tsum of tokens delegated to not bonded validators and unbonding: %v\n",
+			poolBonded, bonded, poolNotBonded, notBonded)), broken
+	}
+}
@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestDelegationPoolsInvariant(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	invariant := keeper.DelegationPoolsInvariant(app.StakingKeeper)
+
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// tokens sent to the bonded pool are not backed by any delegation
+	coins := sdk.NewCoins(sdk.NewInt64Coin(app.StakingKeeper.BondDenom(ctx), 1))
+	require.NoError(t, banktestutil.FundModuleAccount(app.BankKeeper, ctx, types.BondedPoolName, coins))
+
+	msg, broken := invariant(ctx)
+	require.True(t, broken, msg)
+}
@@ -25,6 +25,8 @@ type Keeper struct {
 	bankKeeper types.BankKeeper
 	hooks      types.StakingHooks
 	paramstore paramtypes.Subspace
+
+	denomConverters map[string]types.DenomConverter
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -72,6 +74,27 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) *Keeper {
 	return k
 }
 
+// SetDenomConverter registers the converter of the given alternate denoms into
+// the bond denom, whitelisting them for delegation.
+func (k *Keeper) SetDenomConverter(c types.DenomConverter, denoms ...string) *Keeper {
+	if k.denomConverters == nil {
+		k.denomConverters = make(map[string]types.DenomConverter)
+	}
+
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			panic(err)
+		}
+		if _, ok := k.denomConverters[denom]; ok {
+			panic(fmt.Sprintf("cannot set a denom converter for %s twice", denom))
+		}
+
+		k.denomConverters[denom] = c
+	}
+
+	return k
+}
+
 // Load the last total validator power.
 func (k Keeper) GetLastTotalPower(ctx sdk.Context) sdk.Int {
 	store := ctx.KVStore(k.storeKey)
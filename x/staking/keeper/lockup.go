@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetDelegationLockup gets the lockup of a delegation.
+func (k Keeper) GetDelegationLockup(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+) (lockup types.DelegationLockup, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetDelegationLockupKey(delAddr, valAddr))
+	if value == nil {
+		return lockup, false
+	}
+
+	return types.MustUnmarshalDelegationLockup(k.cdc, value), true
+}
+
+// SetDelegationLockup sets the lockup of a delegation.
+func (k Keeper) SetDelegationLockup(ctx sdk.Context, lockup types.DelegationLockup) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDelegationLockupKey(lockup.GetDelegatorAddr(), lockup.GetValidatorAddr()), k.cdc.MustMarshal(&lockup))
+}
+
+// RemoveDelegationLockup removes the lockup of a delegation, along with its
+// entry in the lockups queue.
+func (k Keeper) RemoveDelegationLockup(ctx sdk.Context, lockup types.DelegationLockup) {
+	delAddr, valAddr := lockup.GetDelegatorAddr(), lockup.GetValidatorAddr()
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegationLockupKey(delAddr, valAddr))
+	store.Delete(types.GetLockupQueueKey(lockup.EndTime, delAddr, valAddr))
+}
+
+// GetDelegatorLockups returns the lockups of the delegations of a delegator.
+func (k Keeper) GetDelegatorLockups(ctx sdk.Context, delAddr sdk.AccAddress) (lockups []types.DelegationLockup) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetDelegationLockupsKey(delAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		lockups = append(lockups, types.MustUnmarshalDelegationLockup(k.cdc, iterator.Value()))
+	}
+
+	return lockups
+}
+
+// GetAllDelegationLockups returns the lockups of all the delegations.
+func (k Keeper) GetAllDelegationLockups(ctx sdk.Context) (lockups []types.DelegationLockup) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.DelegationLockupKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		lockups = append(lockups, types.MustUnmarshalDelegationLockup(k.cdc, iterator.Value()))
+	}
+
+	return lockups
+}
+
+// InsertLockupQueue inserts a lockup into the lockups queue, by end time.
+func (k Keeper) InsertLockupQueue(ctx sdk.Context, lockup types.DelegationLockup) {
+	dvPair := types.DVPair{DelegatorAddress: lockup.DelegatorAddress, ValidatorAddress: lockup.ValidatorAddress}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLockupQueueKey(lockup.EndTime, lockup.GetDelegatorAddr(), lockup.GetValidatorAddr()), k.cdc.MustMarshal(&dvPair))
+}
+
+// LockupQueueIterator returns an iterator over the lockups queue entries
+// ending until endTime, inclusively.
+func (k Keeper) LockupQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.LockupQueueKey, sdk.PrefixEndBytes(types.GetLockupQueueTimeKey(endTime)))
+}
+
+// DelegationRewardMultiplier returns the multiplier of the distribution rewards
+// of a delegation, which is the reward multiplier of its lockup while it is
+// locked and one otherwise.
+func (k Keeper) DelegationRewardMultiplier(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.Dec {
+	lockup, found := k.GetDelegationLockup(ctx, delAddr, valAddr)
+	if !found {
+		return sdk.OneDec()
+	}
+
+	return lockup.RewardMultiplier
+}
+
+// LockDelegation locks a delegation for the duration of a lockup tier, and
+// returns the end time of the lockup. A locked delegation can be relocked with
+// any tier ending no earlier than its current lockup.
+//
+// The rewards of the delegation are withdrawn with the previous multiplier
+// before the lockup is set, as the distribution module does when the shares of
+// the delegation are modified.
+func (k Keeper) LockDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, duration time.Duration,
+) (time.Time, error) {
+	tier, found := k.GetParams(ctx).LockupTier(duration)
+	if !found {
+		return time.Time{}, sdkerrors.Wrapf(types.ErrNoLockupTier, "duration %s", duration)
+	}
+
+	if _, found := k.GetDelegation(ctx, delAddr, valAddr); !found {
+		return time.Time{}, types.ErrNoDelegation
+	}
+
+	endTime := ctx.BlockHeader().Time.Add(duration)
+
+	current, locked := k.GetDelegationLockup(ctx, delAddr, valAddr)
+	if locked && endTime.Before(current.EndTime) {
+		return time.Time{}, sdkerrors.Wrapf(types.ErrLockupShortened, "current lockup ends at %s", current.EndTime)
+	}
+
+	if err := k.BeforeDelegationSharesModified(ctx, delAddr, valAddr); err != nil {
+		return time.Time{}, err
+	}
+
+	if locked {
+		k.RemoveDelegationLockup(ctx, current)
+	}
+
+	lockup := types.NewDelegationLockup(delAddr, valAddr, tier, endTime)
+	k.SetDelegationLockup(ctx, lockup)
+	k.InsertLockupQueue(ctx, lockup)
+
+	if err := k.AfterDelegationModified(ctx, delAddr, valAddr); err != nil {
+		return time.Time{}, err
+	}
+
+	return endTime, nil
+}
+
+// UnlockMatureDelegations removes the lockups ending at the latest at the
+// block time. The rewards accrued by the delegations while they were locked
+// are withdrawn with their multiplier before the lockups are removed. If the
+// delegation hooks fail, the error is logged and the lockup is removed without
+// the rewards being withdrawn, so that they are withdrawn later without the
+// multiplier.
+func (k Keeper) UnlockMatureDelegations(ctx sdk.Context) {
+	iterator := k.LockupQueueIterator(ctx, ctx.BlockHeader().Time)
+
+	var dvPairs []types.DVPair
+	for ; iterator.Valid(); iterator.Next() {
+		var dvPair types.DVPair
+		k.cdc.MustUnmarshal(iterator.Value(), &dvPair)
+		dvPairs = append(dvPairs, dvPair)
+	}
+	iterator.Close()
+
+	for _, dvPair := range dvPairs {
+		delAddr, err := sdk.AccAddressFromBech32(dvPair.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		valAddr, err := sdk.ValAddressFromBech32(dvPair.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		lockup, found := k.GetDelegationLockup(ctx, delAddr, valAddr)
+		if !found {
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.unlockDelegation(cacheCtx, lockup, delAddr, valAddr); err != nil {
+			k.Logger(ctx).Error(
+				"failed to withdraw the rewards of an unlocked delegation",
+				"delegator", dvPair.DelegatorAddress,
+				"validator", dvPair.ValidatorAddress,
+				"err", err,
+			)
+			k.RemoveDelegationLockup(ctx, lockup)
+		} else {
+			writeCache()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUnlockDelegation,
+				sdk.NewAttribute(types.AttributeKeyValidator, dvPair.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyDelegator, dvPair.DelegatorAddress),
+			),
+		)
+	}
+}
+
+// unlockDelegation removes the lockup of a delegation, calling the delegation
+// hooks around it if the delegation still exists.
+func (k Keeper) unlockDelegation(ctx sdk.Context, lockup types.DelegationLockup, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	_, delegated := k.GetDelegation(ctx, delAddr, valAddr)
+	if delegated {
+		if err := k.BeforeDelegationSharesModified(ctx, delAddr, valAddr); err != nil {
+			return err
+		}
+	}
+
+	k.RemoveDelegationLockup(ctx, lockup)
+
+	if delegated {
+		return k.AfterDelegationModified(ctx, delAddr, valAddr)
+	}
+
+	return nil
+}
+
+// assertDelegationUnlocked returns an error if a delegation is locked, and
+// can't be unbonded nor redelegated.
+func (k Keeper) assertDelegationUnlocked(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	lockup, found := k.GetDelegationLockup(ctx, delAddr, valAddr)
+	if !found {
+		return nil
+	}
+
+	return sdkerrors.Wrapf(types.ErrDelegationLocked, "locked until %s", lockup.EndTime)
+}
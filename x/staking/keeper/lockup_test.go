@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestLockDelegation(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0).UTC()})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs[:2])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	for i, valAddr := range valAddrs {
+		tstaking.CreateValidatorWithValPower(valAddr, PKs[i], 10, true)
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.LockupTiers = []types.LockupTier{
+		types.NewLockupTier(time.Hour, sdk.NewDecWithPrec(15, 1)),
+		types.NewLockupTier(2*time.Hour, sdk.NewDec(2)),
+	}
+	app.StakingKeeper.SetParams(ctx, params)
+
+	delAddr := addrs[2]
+	tstaking.DelegateWithPower(delAddr, valAddrs[0], 10)
+
+	// only the durations of the lockup tiers are accepted, and only for
+	// existing delegations
+	_, err := app.StakingKeeper.LockDelegation(ctx, delAddr, valAddrs[0], 3*time.Hour)
+	require.ErrorIs(t, err, types.ErrNoLockupTier)
+	_, err = app.StakingKeeper.LockDelegation(ctx, delAddr, valAddrs[1], time.Hour)
+	require.ErrorIs(t, err, types.ErrNoDelegation)
+	require.Equal(t, sdk.OneDec(), app.StakingKeeper.DelegationRewardMultiplier(ctx, delAddr, valAddrs[0]))
+
+	endTime, err := app.StakingKeeper.LockDelegation(ctx, delAddr, valAddrs[0], 2*time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeader().Time.Add(2*time.Hour), endTime)
+	require.Equal(t, sdk.NewDec(2), app.StakingKeeper.DelegationRewardMultiplier(ctx, delAddr, valAddrs[0]))
+	require.Len(t, app.StakingKeeper.GetDelegatorLockups(ctx, delAddr), 1)
+
+	// a locked delegation can neither be unbonded nor redelegated
+	_, err = app.StakingKeeper.Undelegate(ctx, delAddr, valAddrs[0], sdk.NewDec(1))
+	require.ErrorIs(t, err, types.ErrDelegationLocked)
+	_, err = app.StakingKeeper.BeginRedelegation(ctx, delAddr, valAddrs[0], valAddrs[1], sdk.NewDec(1))
+	require.ErrorIs(t, err, types.ErrDelegationLocked)
+
+	// nor relocked for a lockup ending earlier
+	_, err = app.StakingKeeper.LockDelegation(ctx, delAddr, valAddrs[0], time.Hour)
+	require.ErrorIs(t, err, types.ErrLockupShortened)
+
+	// the lockup still holds just before its end time
+	ctx = ctx.WithBlockTime(endTime.Add(-time.Second))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	_, found := app.StakingKeeper.GetDelegationLockup(ctx, delAddr, valAddrs[0])
+	require.True(t, found)
+
+	// and is removed at the end of the block reaching its end time
+	ctx = ctx.WithBlockTime(endTime).WithEventManager(sdk.NewEventManager())
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	_, found = app.StakingKeeper.GetDelegationLockup(ctx, delAddr, valAddrs[0])
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetAllDelegationLockups(ctx))
+	require.Equal(t, sdk.OneDec(), app.StakingKeeper.DelegationRewardMultiplier(ctx, delAddr, valAddrs[0]))
+
+	var unlocked bool
+	for _, event := range ctx.EventManager().Events() {
+		unlocked = unlocked || event.Type == types.EventTypeUnlockDelegation
+	}
+	require.True(t, unlocked)
+
+	_, err = app.StakingKeeper.Undelegate(ctx, delAddr, valAddrs[0], sdk.NewDec(1))
+	require.NoError(t, err)
+}
+
+func TestRemoveDelegationRemovesLockup(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs[:1])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], PKs[0], 10, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.LockupTiers = []types.LockupTier{types.NewLockupTier(time.Hour, sdk.NewDecWithPrec(15, 1))}
+	app.StakingKeeper.SetParams(ctx, params)
+
+	tstaking.DelegateWithPower(addrs[1], valAddrs[0], 10)
+	_, err := app.StakingKeeper.LockDelegation(ctx, addrs[1], valAddrs[0], time.Hour)
+	require.NoError(t, err)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrs[1], valAddrs[0])
+	require.True(t, found)
+	require.NoError(t, app.StakingKeeper.RemoveDelegation(ctx, delegation))
+
+	require.Empty(t, app.StakingKeeper.GetAllDelegationLockups(ctx))
+	iterator := app.StakingKeeper.LockupQueueIterator(ctx, ctx.BlockHeader().Time.Add(time.Hour))
+	defer iterator.Close()
+	require.False(t, iterator.Valid())
+}
+
+func TestUnlockMatureDelegationsHookFailure(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0).UTC()})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs[:1])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], PKs[0], 10, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.LockupTiers = []types.LockupTier{types.NewLockupTier(time.Hour, sdk.NewDecWithPrec(15, 1))}
+	app.StakingKeeper.SetParams(ctx, params)
+
+	tstaking.DelegateWithPower(addrs[1], valAddrs[0], 10)
+	endTime, err := app.StakingKeeper.LockDelegation(ctx, addrs[1], valAddrs[0], time.Hour)
+	require.NoError(t, err)
+
+	// the distribution hook fails to withdraw the rewards without the starting
+	// info of the delegation
+	app.DistrKeeper.DeleteDelegatorStartingInfo(ctx, valAddrs[0], addrs[1])
+
+	// the lockup is still removed, without halting the chain
+	ctx = ctx.WithBlockTime(endTime).WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() { staking.EndBlocker(ctx, app.StakingKeeper) })
+	require.Empty(t, app.StakingKeeper.GetAllDelegationLockups(ctx))
+	require.Equal(t, sdk.OneDec(), app.StakingKeeper.DelegationRewardMultiplier(ctx, addrs[1], valAddrs[0]))
+
+	var unlocked bool
+	for _, event := range ctx.EventManager().Events() {
+		unlocked = unlocked || event.Type == types.EventTypeUnlockDelegation
+	}
+	require.True(t, unlocked)
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"fmt"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ActiveMaxValidators returns the maximum number of bonded validators in
+// effect. When the validator set epoch length is positive, it lags behind the
+// MaxValidators param until the next epoch boundary.
+func (k Keeper) ActiveMaxValidators(ctx sdk.Context) uint32 {
+	if k.ValidatorSetEpochLength(ctx) == 0 {
+		return k.MaxValidators(ctx)
+	}
+
+	if active, found := k.getActiveMaxValidators(ctx); found {
+		return active
+	}
+
+	return k.MaxValidators(ctx)
+}
+
+// UpdateMaxValidators applies a change of the MaxValidators param at the next
+// epoch boundary, announcing it when it is first seen with the validators it
+// would promote to or demote from the bonded set at the current power ranking.
+// It is called in each EndBlock before the validator set updates.
+func (k Keeper) UpdateMaxValidators(ctx sdk.Context) {
+	maxValidators := k.MaxValidators(ctx)
+	epochLength := k.ValidatorSetEpochLength(ctx)
+
+	active, found := k.getActiveMaxValidators(ctx)
+	switch {
+	case !found:
+		k.setActiveMaxValidators(ctx, maxValidators)
+		return
+
+	case active == maxValidators:
+		// nothing to apply, the scheduled change may have been reverted
+		k.deleteScheduledMaxValidators(ctx)
+		return
+
+	case epochLength == 0 || ctx.BlockHeight()%int64(epochLength) == 0:
+		k.setActiveMaxValidators(ctx, maxValidators)
+		k.deleteScheduledMaxValidators(ctx)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeApplyMaxValidators,
+				sdk.NewAttribute(types.AttributeKeyPrevMaxValidators, fmt.Sprintf("%d", active)),
+				sdk.NewAttribute(types.AttributeKeyMaxValidators, fmt.Sprintf("%d", maxValidators)),
+			),
+		)
+		return
+	}
+
+	if scheduled, found := k.getScheduledMaxValidators(ctx); found && scheduled == maxValidators {
+		return
+	}
+	k.setScheduledMaxValidators(ctx, maxValidators)
+
+	height := ctx.BlockHeight()
+	effectiveHeight := height + int64(epochLength) - height%int64(epochLength)
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyPrevMaxValidators, fmt.Sprintf("%d", active)),
+		sdk.NewAttribute(types.AttributeKeyMaxValidators, fmt.Sprintf("%d", maxValidators)),
+		sdk.NewAttribute(types.AttributeKeyEffectiveHeight, fmt.Sprintf("%d", effectiveHeight)),
+	}
+
+	if maxValidators > active {
+		for _, valAddr := range k.rankedValidators(ctx, active, maxValidators) {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyPromoted, valAddr.String()))
+		}
+	} else {
+		for _, valAddr := range k.rankedValidators(ctx, maxValidators, active) {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyDemoted, valAddr.String()))
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeScheduleMaxValidators, attrs...))
+}
+
+// rankedValidators returns, in power ranking order, the validators which can
+// be bonded ranked from the given rank included to the given rank excluded.
+func (k Keeper) rankedValidators(ctx sdk.Context, from, to uint32) (valAddrs []sdk.ValAddress) {
+	iterator := k.ValidatorsPowerStoreIterator(ctx)
+	defer iterator.Close()
+
+	for rank := uint32(0); iterator.Valid() && rank < to; iterator.Next() {
+		valAddr := sdk.ValAddress(iterator.Value())
+		validator := k.mustGetValidator(ctx, valAddr)
+
+		// zero-power validators are not bonded, as in the validator set updates
+		if validator.PotentialConsensusPower(k.PowerReduction(ctx)) == 0 {
+			break
+		}
+
+		if rank >= from {
+			valAddrs = append(valAddrs, valAddr)
+		}
+		rank++
+	}
+
+	return valAddrs
+}
+
+func (k Keeper) getActiveMaxValidators(ctx sdk.Context) (uint32, bool) {
+	return k.getUInt32(ctx, types.ActiveMaxValidatorsKey)
+}
+
+func (k Keeper) setActiveMaxValidators(ctx sdk.Context, maxValidators uint32) {
+	k.setUInt32(ctx, types.ActiveMaxValidatorsKey, maxValidators)
+}
+
+func (k Keeper) getScheduledMaxValidators(ctx sdk.Context) (uint32, bool) {
+	return k.getUInt32(ctx, types.ScheduledMaxValidatorsKey)
+}
+
+func (k Keeper) setScheduledMaxValidators(ctx sdk.Context, maxValidators uint32) {
+	k.setUInt32(ctx, types.ScheduledMaxValidatorsKey, maxValidators)
+}
+
+func (k Keeper) deleteScheduledMaxValidators(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Delete(types.ScheduledMaxValidatorsKey)
+}
+
+func (k Keeper) getUInt32(ctx sdk.Context, key []byte) (uint32, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return 0, false
+	}
+
+	v := gogotypes.UInt32Value{}
+	k.cdc.MustUnmarshal(bz, &v)
+
+	return v.Value, true
+}
+
+func (k Keeper) setUInt32(ctx sdk.Context, key []byte, value uint32) {
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&gogotypes.UInt32Value{Value: value}))
+}
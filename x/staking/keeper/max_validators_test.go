@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestUpdateMaxValidators(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], PKs[0], 20, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], PKs[1], 10, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[2], PKs[2], 5, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	bonded := app.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	require.Len(t, bonded, 3)
+	lowest := bonded[2].GetOperator()
+	require.Equal(t, valAddrs[2], lowest)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorSetEpochLength = 10
+	params.MaxValidators = 2
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// the change is announced with the validators leaving the bonded set
+	ctx = ctx.WithBlockHeight(3).WithEventManager(sdk.NewEventManager())
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Equal(t, types.DefaultMaxValidators, app.StakingKeeper.ActiveMaxValidators(ctx))
+	require.Len(t, app.StakingKeeper.GetBondedValidatorsByPower(ctx), 3)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeScheduleMaxValidators, events[0].Type)
+	require.Contains(t, events[0].Attributes, sdk.NewAttribute(types.AttributeKeyEffectiveHeight, "10").ToKVPair())
+	require.Contains(t, events[0].Attributes, sdk.NewAttribute(types.AttributeKeyDemoted, lowest.String()).ToKVPair())
+
+	// the change is announced only once
+	ctx = ctx.WithBlockHeight(4).WithEventManager(sdk.NewEventManager())
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Empty(t, ctx.EventManager().Events())
+
+	// the change is applied at the epoch boundary
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Equal(t, uint32(2), app.StakingKeeper.ActiveMaxValidators(ctx))
+	require.Len(t, app.StakingKeeper.GetBondedValidatorsByPower(ctx), 2)
+
+	events = ctx.EventManager().Events()
+	require.Equal(t, types.EventTypeApplyMaxValidators, events[0].Type)
+	require.Contains(t, events[0].Attributes, sdk.NewAttribute(types.AttributeKeyPrevMaxValidators, fmt.Sprintf("%d", types.DefaultMaxValidators)).ToKVPair())
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, lowest)
+	require.True(t, found)
+	require.Equal(t, types.Unbonding, validator.GetStatus())
+
+	// without epochs the change is applied at the next block
+	params.ValidatorSetEpochLength = 0
+	params.MaxValidators = 3
+	app.StakingKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(11)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Equal(t, uint32(3), app.StakingKeeper.ActiveMaxValidators(ctx))
+	require.Len(t, app.StakingKeeper.GetBondedValidatorsByPower(ctx), 3)
+}
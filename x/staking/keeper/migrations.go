@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v043 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v043"
+	v044 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v044"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -19,3 +20,9 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v043.MigrateStore(ctx, m.keeper.storeKey)
 }
+
+// Migrate2to3 migrates x/staking params from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	v044.MigrateParams(ctx, m.keeper.paramstore)
+	return nil
+}
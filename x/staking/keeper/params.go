@@ -39,6 +39,27 @@ func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	return
 }
 
+// MaxMissedGovProposals - number of consecutive governance proposals a bonded
+// validator may not vote on before being flagged as absent from governance
+func (k Keeper) MaxMissedGovProposals(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyMaxMissedGovProposals, &res)
+	return
+}
+
+// ValidatorSetEpochLength - number of blocks of the epochs at the boundaries of
+// which a change of the maximum number of bonded validators takes effect
+func (k Keeper) ValidatorSetEpochLength(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyValidatorSetEpochLength, &res)
+	return
+}
+
+// LockupTiers - durations a delegation can be locked for, with the multiplier
+// of its distribution rewards while it is locked
+func (k Keeper) LockupTiers(ctx sdk.Context) (res []types.LockupTier) {
+	k.paramstore.Get(ctx, types.KeyLockupTiers, &res)
+	return
+}
+
 // PowerReduction - is the amount of staking tokens required for 1 unit of consensus-engine power.
 // Currently, this returns a global variable that the app developer can tweak.
 // TODO: we might turn this into an on-chain param:
@@ -55,6 +76,9 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.MaxEntries(ctx),
 		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
+		k.MaxMissedGovProposals(ctx),
+		k.ValidatorSetEpochLength(ctx),
+		k.LockupTiers(ctx),
 	)
 }
 
@@ -32,28 +32,26 @@ func (k Keeper) notBondedTokensToBonded(ctx sdk.Context, tokens sdk.Int) {
 	}
 }
 
-// burnBondedTokens removes coins from the bonded pool module account
-func (k Keeper) burnBondedTokens(ctx sdk.Context, amt sdk.Int) error {
+// removeSlashedTokens removes slashed coins from the given pool module
+// account, handing them to the BeforeSlashedTokensBurned hook and burning the
+// ones it leaves in the pool
+func (k Keeper) removeSlashedTokens(ctx sdk.Context, poolName string, amt sdk.Int) error {
 	if !amt.IsPositive() {
-		// skip as no coins need to be burned
+		// skip as no coins need to be removed
 		return nil
 	}
 
 	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amt))
 
-	return k.bankKeeper.BurnCoins(ctx, types.BondedPoolName, coins)
-}
-
-// burnNotBondedTokens removes coins from the not bonded pool module account
-func (k Keeper) burnNotBondedTokens(ctx sdk.Context, amt sdk.Int) error {
-	if !amt.IsPositive() {
-		// skip as no coins need to be burned
+	coins, err := k.BeforeSlashedTokensBurned(ctx, poolName, coins)
+	if err != nil {
+		return err
+	}
+	if coins.IsZero() {
 		return nil
 	}
 
-	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amt))
-
-	return k.bankKeeper.BurnCoins(ctx, types.NotBondedPoolName, coins)
+	return k.bankKeeper.BurnCoins(ctx, poolName, coins)
 }
 
 // TotalBondedTokens total staking tokens supply which is bonded
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// rebalanceBalance is the stake of a delegator on a validator, and the part of
+// it which must be moved away (positive) or received (negative) to rebalance.
+type rebalanceBalance struct {
+	valAddr sdk.ValAddress
+	excess  sdk.Int
+}
+
+// RebalanceDelegations redistributes the stake of a delegator across target
+// validators in proportion to their weights, which must sum to one. The stake
+// is moved with redelegations from the validators holding more than their
+// target to the ones holding less, so the redelegation limits apply and the
+// rebalancing fails as a whole if one of them is exceeded. Tokens lost to
+// rounding stay on their validator. It returns the latest completion time of
+// the redelegations, which is zero if no stake was moved.
+func (k Keeper) RebalanceDelegations(
+	ctx sdk.Context, delAddr sdk.AccAddress, targets []types.RebalanceTarget,
+) (completionTime time.Time, err error) {
+	delegations := k.GetAllDelegatorDelegations(ctx, delAddr)
+	if len(delegations) == 0 {
+		return completionTime, types.ErrNoDelegation
+	}
+
+	// sources hold more than their target and destinations less, both in a
+	// deterministic order: delegations by validator address, then targets
+	balances := make(map[string]*rebalanceBalance, len(delegations)+len(targets))
+	order := make([]*rebalanceBalance, 0, len(delegations)+len(targets))
+	total := sdk.ZeroInt()
+	for _, delegation := range delegations {
+		valAddr := delegation.GetValidatorAddr()
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			return completionTime, types.ErrNoValidatorFound
+		}
+
+		tokens := validator.TokensFromShares(delegation.Shares).TruncateInt()
+		balance := &rebalanceBalance{valAddr: valAddr, excess: tokens}
+		balances[valAddr.String()] = balance
+		order = append(order, balance)
+		total = total.Add(tokens)
+	}
+
+	for _, target := range targets {
+		valAddr, err := sdk.ValAddressFromBech32(target.ValidatorAddress)
+		if err != nil {
+			return completionTime, err
+		}
+
+		balance, ok := balances[valAddr.String()]
+		if !ok {
+			balance = &rebalanceBalance{valAddr: valAddr, excess: sdk.ZeroInt()}
+			balances[valAddr.String()] = balance
+			order = append(order, balance)
+		}
+		balance.excess = balance.excess.Sub(target.Weight.MulInt(total).TruncateInt())
+	}
+
+	for _, dst := range order {
+		for _, src := range order {
+			if !dst.excess.IsNegative() {
+				break
+			}
+			if !src.excess.IsPositive() {
+				continue
+			}
+
+			amount := sdk.MinInt(src.excess, dst.excess.Neg())
+			shares, err := k.ValidateUnbondAmount(ctx, delAddr, src.valAddr, amount)
+			if err != nil {
+				return completionTime, err
+			}
+
+			redelegationCompletionTime, err := k.BeginRedelegation(ctx, delAddr, src.valAddr, dst.valAddr, shares)
+			if err != nil {
+				return completionTime, sdkerrors.Wrapf(err, "redelegation from %s to %s", src.valAddr, dst.valAddr)
+			}
+			if redelegationCompletionTime.After(completionTime) {
+				completionTime = redelegationCompletionTime
+			}
+
+			src.excess = src.excess.Sub(amount)
+			dst.excess = dst.excess.Add(amount)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeRedelegate,
+					sdk.NewAttribute(types.AttributeKeySrcValidator, src.valAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyDstValidator, dst.valAddr.String()),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(k.BondDenom(ctx), amount).String()),
+					sdk.NewAttribute(types.AttributeKeyCompletionTime, redelegationCompletionTime.Format(time.RFC3339)),
+				),
+			)
+		}
+	}
+
+	return completionTime, nil
+}
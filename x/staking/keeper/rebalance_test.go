@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestRebalanceDelegations(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: time.Now().UTC()})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 4, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs[:3])
+	delAddr := addrs[3]
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	for i, valAddr := range valAddrs {
+		tstaking.CreateValidatorWithValPower(valAddr, PKs[i], 10, true)
+	}
+	tstaking.Delegate(delAddr, valAddrs[0], app.StakingKeeper.TokensFromConsensusPower(ctx, 60))
+	tstaking.Delegate(delAddr, valAddrs[1], app.StakingKeeper.TokensFromConsensusPower(ctx, 30))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	delegatedTokens := func(valAddr sdk.ValAddress) sdk.Int {
+		delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+		if !found {
+			return sdk.ZeroInt()
+		}
+		validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.True(t, found)
+		return validator.TokensFromShares(delegation.Shares).TruncateInt()
+	}
+
+	half := sdk.NewDecWithPrec(5, 1)
+	completionTime, err := app.StakingKeeper.RebalanceDelegations(ctx, delAddr, []types.RebalanceTarget{
+		types.NewRebalanceTarget(valAddrs[1], half),
+		types.NewRebalanceTarget(valAddrs[2], half),
+	})
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(app.StakingKeeper.UnbondingTime(ctx)), completionTime)
+
+	require.True(t, delegatedTokens(valAddrs[0]).IsZero())
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 45), delegatedTokens(valAddrs[1]))
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 45), delegatedTokens(valAddrs[2]))
+
+	// the stake was moved with redelegations
+	redelegations := app.StakingKeeper.GetRedelegations(ctx, delAddr, 10)
+	require.Len(t, redelegations, 2)
+	for _, red := range redelegations {
+		require.Equal(t, valAddrs[0].String(), red.ValidatorSrcAddress)
+	}
+
+	// the redelegation limits apply, so the stake cannot be moved again from
+	// validators with an incoming redelegation
+	_, err = app.StakingKeeper.RebalanceDelegations(ctx, delAddr, []types.RebalanceTarget{
+		types.NewRebalanceTarget(valAddrs[0], sdk.OneDec()),
+	})
+	require.ErrorIs(t, err, types.ErrTransitiveRedelegation)
+
+	// nothing is moved if the stake is already balanced
+	completionTime, err = app.StakingKeeper.RebalanceDelegations(ctx, delAddr, []types.RebalanceTarget{
+		types.NewRebalanceTarget(valAddrs[1], half),
+		types.NewRebalanceTarget(valAddrs[2], half),
+	})
+	require.NoError(t, err)
+	require.True(t, completionTime.IsZero())
+
+	_, err = app.StakingKeeper.RebalanceDelegations(ctx, sdk.AccAddress("nodelegations"), []types.RebalanceTarget{
+		types.NewRebalanceTarget(valAddrs[1], sdk.OneDec()),
+	})
+	require.ErrorIs(t, err, types.ErrNoDelegation)
+}
@@ -118,16 +118,17 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	}
 
 	// Deduct from validator's bonded tokens and update the validator.
-	// Burn the slashed tokens from the pool account and decrease the total supply.
+	// Burn the slashed tokens from the pool account and decrease the total supply,
+	// or hand them to the slashed tokens handler.
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
 
 	switch validator.GetStatus() {
 	case types.Bonded:
-		if err := k.burnBondedTokens(ctx, tokensToBurn); err != nil {
+		if err := k.removeSlashedTokens(ctx, types.BondedPoolName, tokensToBurn); err != nil {
 			panic(err)
 		}
 	case types.Unbonding, types.Unbonded:
-		if err := k.burnNotBondedTokens(ctx, tokensToBurn); err != nil {
+		if err := k.removeSlashedTokens(ctx, types.NotBondedPoolName, tokensToBurn); err != nil {
 			panic(err)
 		}
 	default:
@@ -204,7 +205,7 @@ func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 		k.SetUnbondingDelegation(ctx, unbondingDelegation)
 	}
 
-	if err := k.burnNotBondedTokens(ctx, burnedAmount); err != nil {
+	if err := k.removeSlashedTokens(ctx, types.NotBondedPoolName, burnedAmount); err != nil {
 		panic(err)
 	}
 
@@ -288,11 +289,11 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 		}
 	}
 
-	if err := k.burnBondedTokens(ctx, bondedBurnedAmount); err != nil {
+	if err := k.removeSlashedTokens(ctx, types.BondedPoolName, bondedBurnedAmount); err != nil {
 		panic(err)
 	}
 
-	if err := k.burnNotBondedTokens(ctx, notBondedBurnedAmount); err != nil {
+	if err := k.removeSlashedTokens(ctx, types.NotBondedPoolName, notBondedBurnedAmount); err != nil {
 		panic(err)
 	}
 
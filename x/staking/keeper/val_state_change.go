@@ -15,6 +15,10 @@ import (
 // BlockValidatorUpdates calculates the ValidatorUpdates for the current block
 // Called in each EndBlock
 func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
+	// Apply the change of the maximum number of bonded validators at the epoch
+	// boundary, before the validator set changes are calculated.
+	k.UpdateMaxValidators(ctx)
+
 	// Calculate validator set changes.
 	//
 	// NOTE: ApplyAndReturnValidatorSetUpdates has to come before
@@ -32,6 +36,12 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	// unbond all mature validators from the unbonding queue
 	k.UnbondAllMatureValidators(ctx)
 
+	// stop resolving the old consensus addresses of mature key rotations
+	k.RemoveMatureConsPubKeyRotations(ctx)
+
+	// unlock the delegations whose lockup ended
+	k.UnlockMatureDelegations(ctx)
+
 	// Remove all mature unbonding delegations from the ubd queue.
 	matureUnbonds := k.DequeueAllMatureUBDQueue(ctx, ctx.BlockHeader().Time)
 	for _, dvPair := range matureUnbonds {
@@ -110,8 +120,7 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 // at the previous block height or were removed from the validator set entirely
 // are returned to Tendermint.
 func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []abci.ValidatorUpdate, err error) {
-	params := k.GetParams(ctx)
-	maxValidators := params.MaxValidators
+	maxValidators := k.ActiveMaxValidators(ctx)
 	powerReduction := k.PowerReduction(ctx)
 	totalPower := sdk.ZeroInt()
 	amtFromBondedToNotBonded, amtFromNotBondedToBonded := sdk.ZeroInt(), sdk.ZeroInt()
@@ -124,6 +133,10 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		return nil, err
 	}
 
+	// Tendermint must be told to replace the old consensus keys of validators
+	// which rotated their key in this block.
+	rotations := k.getBlockConsPubKeyRotations(ctx)
+
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
@@ -173,8 +186,18 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		newPower := validator.ConsensusPower(powerReduction)
 		newPowerBytes := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: newPower})
 
-		// update the validator set if power has changed
-		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
+		rotation, rotated := rotations[valAddrStr]
+
+		// update the validator set if power or consensus key has changed
+		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) || rotated {
+			if found && rotated {
+				oldPubKey, err := rotation.OldConsPubKey()
+				if err != nil {
+					return nil, err
+				}
+				updates = append(updates, abciValidatorUpdateZero(oldPubKey))
+			}
+
 			updates = append(updates, validator.ABCIValidatorUpdate(powerReduction))
 
 			k.SetLastValidatorPower(ctx, valAddr, newPower)
@@ -199,7 +222,18 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		}
 		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.GetTokens())
 		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
-		updates = append(updates, validator.ABCIValidatorUpdateZero())
+
+		// Tendermint only knows about the old consensus key of a validator
+		// which rotated its key in this block
+		if rotation, rotated := rotations[validator.OperatorAddress]; rotated {
+			oldPubKey, err := rotation.OldConsPubKey()
+			if err != nil {
+				return nil, err
+			}
+			updates = append(updates, abciValidatorUpdateZero(oldPubKey))
+		} else {
+			updates = append(updates, validator.ABCIValidatorUpdateZero())
+		}
 	}
 
 	// Update the pools based on the recent updates in the validator set:
@@ -173,6 +173,12 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	store.Delete(types.GetValidatorByConsAddrKey(valConsAddr))
 	store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)))
 
+	for _, rotation := range k.GetValidatorConsPubKeyRotations(ctx, address) {
+		k.RemoveConsPubKeyRotation(ctx, rotation)
+	}
+
+	k.RemoveGovParticipation(ctx, address)
+
 	// call hooks
 	k.AfterValidatorRemoved(ctx, valConsAddr, validator.GetOperator())
 }
@@ -214,7 +220,7 @@ func (k Keeper) GetValidators(ctx sdk.Context, maxRetrieve uint32) (validators [
 
 // get the current group of bonded validators sorted by power-rank
 func (k Keeper) GetBondedValidatorsByPower(ctx sdk.Context) []types.Validator {
-	maxValidators := k.MaxValidators(ctx)
+	maxValidators := k.ActiveMaxValidators(ctx)
 	validators := make([]types.Validator, maxValidators)
 
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
@@ -303,7 +309,7 @@ func (k Keeper) GetLastValidators(ctx sdk.Context) (validators []types.Validator
 	store := ctx.KVStore(k.storeKey)
 
 	// add the actual validator power sorted store
-	maxValidators := k.MaxValidators(ctx)
+	maxValidators := k.ActiveMaxValidators(ctx)
 	validators = make([]types.Validator, maxValidators)
 
 	iterator := sdk.KVStorePrefixIterator(store, types.LastValidatorPowerKey)
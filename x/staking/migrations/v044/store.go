@@ -0,0 +1,17 @@
+package v044
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateParams performs in-place params migrations from v0.43 to v0.44. The
+// migration includes:
+//
+// - Set the validator set epoch length parameter to its default value, which
+//   keeps applying MaxValidators changes at the next block.
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) {
+	epochLength := types.DefaultValidatorSetEpochLength
+	paramSpace.Set(ctx, types.KeyValidatorSetEpochLength, &epochLength)
+}
@@ -0,0 +1,29 @@
+package v044_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v044 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v044"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// params stored before the validator set epoch length was added
+	paramSpace := app.GetSubspace(types.ModuleName)
+	params := app.StakingKeeper.GetParams(ctx)
+	store := ctx.KVStore(app.GetKey(paramtypes.StoreKey))
+	store.Delete(append([]byte(types.ModuleName+"/"), types.KeyValidatorSetEpochLength...))
+	require.False(t, paramSpace.Has(ctx, types.KeyValidatorSetEpochLength))
+
+	v044.MigrateParams(ctx, paramSpace)
+	require.Equal(t, params, app.StakingKeeper.GetParams(ctx))
+	require.Equal(t, types.DefaultValidatorSetEpochLength, app.StakingKeeper.ValidatorSetEpochLength(ctx))
+}
@@ -57,6 +57,41 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &redB)
 
 			return fmt.Sprintf("%v\n%v", redA, redB)
+		case bytes.Equal(kvA.Key[:1], types.ConsPubKeyRotationKey):
+			var rotationA, rotationB types.ConsPubKeyRotation
+
+			cdc.MustUnmarshal(kvA.Value, &rotationA)
+			cdc.MustUnmarshal(kvB.Value, &rotationB)
+
+			return fmt.Sprintf("%v\n%v", rotationA, rotationB)
+		case bytes.Equal(kvA.Key[:1], types.GovParticipationKey):
+			var participationA, participationB types.GovParticipation
+
+			cdc.MustUnmarshal(kvA.Value, &participationA)
+			cdc.MustUnmarshal(kvB.Value, &participationB)
+
+			return fmt.Sprintf("%v\n%v", participationA, participationB)
+		case bytes.Equal(kvA.Key[:1], types.ValidatorGovVoteKey):
+			var voteA, voteB types.ValidatorGovVote
+
+			cdc.MustUnmarshal(kvA.Value, &voteA)
+			cdc.MustUnmarshal(kvB.Value, &voteB)
+
+			return fmt.Sprintf("%v\n%v", voteA, voteB)
+		case bytes.Equal(kvA.Key[:1], types.DelegationLockupKey):
+			var lockupA, lockupB types.DelegationLockup
+
+			cdc.MustUnmarshal(kvA.Value, &lockupA)
+			cdc.MustUnmarshal(kvB.Value, &lockupB)
+
+			return fmt.Sprintf("%v\n%v", lockupA, lockupB)
+		case bytes.Equal(kvA.Key[:1], types.LockupQueueKey):
+			var dvPairA, dvPairB types.DVPair
+
+			cdc.MustUnmarshal(kvA.Value, &dvPairA)
+			cdc.MustUnmarshal(kvB.Value, &dvPairB)
+
+			return fmt.Sprintf("%v\n%v", dvPairA, dvPairB)
 		default:
 			panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 		}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = ConsPubKeyRotation{}
+
+// NewConsPubKeyRotation creates a new ConsPubKeyRotation instance.
+//
+//nolint:interfacer
+func NewConsPubKeyRotation(
+	operator sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey, height int64, t time.Time,
+) (ConsPubKeyRotation, error) {
+	oldPkAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return ConsPubKeyRotation{}, err
+	}
+
+	newPkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return ConsPubKeyRotation{}, err
+	}
+
+	return ConsPubKeyRotation{
+		OperatorAddress: operator.String(),
+		OldConsPubkey:   oldPkAny,
+		NewConsPubkey:   newPkAny,
+		Height:          height,
+		Time:            t,
+	}, nil
+}
+
+// MustUnmarshalConsPubKeyRotation unmarshals a consensus public key rotation
+// and panics on error.
+func MustUnmarshalConsPubKeyRotation(cdc codec.BinaryCodec, value []byte) ConsPubKeyRotation {
+	rotation, err := UnmarshalConsPubKeyRotation(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+
+	return rotation
+}
+
+// UnmarshalConsPubKeyRotation unmarshals a consensus public key rotation and
+// returns any error.
+func UnmarshalConsPubKeyRotation(cdc codec.BinaryCodec, value []byte) (rotation ConsPubKeyRotation, err error) {
+	err = cdc.Unmarshal(value, &rotation)
+	return rotation, err
+}
+
+// GetOperator returns the address of the validator that rotated its key.
+func (r ConsPubKeyRotation) GetOperator() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(r.OperatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// OldConsPubKey returns the consensus public key used before the rotation.
+func (r ConsPubKeyRotation) OldConsPubKey() (cryptotypes.PubKey, error) {
+	return consPubKeyFromAny(r.OldConsPubkey)
+}
+
+// NewConsPubKey returns the consensus public key used after the rotation.
+func (r ConsPubKeyRotation) NewConsPubKey() (cryptotypes.PubKey, error) {
+	return consPubKeyFromAny(r.NewConsPubkey)
+}
+
+// OldConsAddr returns the consensus address used before the rotation.
+func (r ConsPubKeyRotation) OldConsAddr() (sdk.ConsAddress, error) {
+	pk, err := r.OldConsPubKey()
+	if err != nil {
+		return nil, err
+	}
+	return sdk.ConsAddress(pk.Address()), nil
+}
+
+// IsMature returns true if the rotation happened more than an unbonding period
+// before the given time and the old key is no longer part of the validator set
+// Tendermint uses at the given height.
+func (r ConsPubKeyRotation) IsMature(height int64, t time.Time, unbondingTime time.Duration) bool {
+	return height > r.Height+sdk.ValidatorUpdateDelay && !r.Time.Add(unbondingTime).After(t)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r ConsPubKeyRotation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	if err := unpacker.UnpackAny(r.OldConsPubkey, &pk); err != nil {
+		return err
+	}
+	return unpacker.UnpackAny(r.NewConsPubkey, &pk)
+}
+
+func consPubKeyFromAny(pkAny *codectypes.Any) (cryptotypes.PubKey, error) {
+	pk, ok := pkAny.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+	return pk, nil
+}
@@ -49,4 +49,14 @@ var (
 	ErrInvalidHistoricalInfo           = sdkerrors.Register(ModuleName, 37, "invalid historical info")
 	ErrNoHistoricalInfo                = sdkerrors.Register(ModuleName, 38, "no historical info found")
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 39, "empty validator public key")
+	ErrConsPubKeyRotationLimit         = sdkerrors.Register(ModuleName, 40, "consensus public key can only be rotated once per unbonding period")
+	ErrSameConsPubKey                  = sdkerrors.Register(ModuleName, 41, "new consensus public key is the same as the current one")
+	ErrInvalidRebalanceTargets         = sdkerrors.Register(ModuleName, 42, "invalid rebalance targets")
+	ErrNoUnbondingDelegationEntry      = sdkerrors.Register(ModuleName, 43, "no unbonding delegation entry found at the creation height")
+	ErrDenomNotConvertible             = sdkerrors.Register(ModuleName, 44, "no converter registered for the denom")
+	ErrDenomConversionFailed           = sdkerrors.Register(ModuleName, 45, "conversion to the bond denom failed")
+	ErrNoLockupTier                    = sdkerrors.Register(ModuleName, 46, "no lockup tier of the duration")
+	ErrDelegationLocked                = sdkerrors.Register(ModuleName, 47, "delegation is locked")
+	ErrLockupShortened                 = sdkerrors.Register(ModuleName, 48, "lockup cannot end before the current lockup of the delegation")
+	ErrNoDelegationLockup              = sdkerrors.Register(ModuleName, 49, "no lockup found for the delegation")
 )
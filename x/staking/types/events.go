@@ -2,13 +2,20 @@ package types
 
 // staking module event types
 const (
-	EventTypeCompleteUnbonding    = "complete_unbonding"
-	EventTypeCompleteRedelegation = "complete_redelegation"
-	EventTypeCreateValidator      = "create_validator"
-	EventTypeEditValidator        = "edit_validator"
-	EventTypeDelegate             = "delegate"
-	EventTypeUnbond               = "unbond"
-	EventTypeRedelegate           = "redelegate"
+	EventTypeCompleteUnbonding         = "complete_unbonding"
+	EventTypeCompleteRedelegation      = "complete_redelegation"
+	EventTypeCreateValidator           = "create_validator"
+	EventTypeEditValidator             = "edit_validator"
+	EventTypeDelegate                  = "delegate"
+	EventTypeUnbond                    = "unbond"
+	EventTypeRedelegate                = "redelegate"
+	EventTypeRotateConsPubKey          = "rotate_cons_pubkey"
+	EventTypeGovAbsentee               = "gov_absentee"
+	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
+	EventTypeScheduleMaxValidators     = "schedule_max_validators"
+	EventTypeApplyMaxValidators        = "apply_max_validators"
+	EventTypeLockDelegation            = "lock_delegation"
+	EventTypeUnlockDelegation          = "unlock_delegation"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -18,5 +25,17 @@ const (
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyOldConsAddress    = "old_consensus_address"
+	AttributeKeyNewConsAddress    = "new_consensus_address"
+	AttributeKeyMissedProposals   = "missed_proposals"
+	AttributeKeyCreationHeight    = "creation_height"
+	AttributeKeyMaxValidators     = "max_validators"
+	AttributeKeyPrevMaxValidators = "previous_max_validators"
+	AttributeKeyEffectiveHeight   = "effective_height"
+	AttributeKeyPromoted          = "promoted_validator"
+	AttributeKeyDemoted           = "demoted_validator"
+	AttributeKeyConvertedFrom     = "converted_from"
+	AttributeKeyLockupEndTime     = "lockup_end_time"
+	AttributeKeyRewardMultiplier  = "reward_multiplier"
 	AttributeValueCategory        = ModuleName
 )
@@ -1,6 +1,7 @@
 package types
 
 import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -87,6 +88,16 @@ type DelegationSet interface {
 // state. The second keeper must implement this interface, which then the
 // staking keeper can call.
 
+// DenomConverter converts coins of whitelisted alternate denoms into the bond
+// denom (e.g. by unwrapping a liquid staking derivative), so that they can be
+// delegated with MsgDelegate.
+type DenomConverter interface {
+	// ConvertToBondDenom must take the given coin from the delegator account
+	// and credit it with the bond denom tokens it converts to, returning their
+	// amount. It must fail if the conversion is unavailable.
+	ConvertToBondDenom(ctx sdk.Context, delegator sdk.AccAddress, coin sdk.Coin) (sdk.Int, error)
+}
+
 // StakingHooks event hooks for staking validator object (noalias)
 type StakingHooks interface {
 	AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error                           // Must be called when a validator is created
@@ -101,4 +112,11 @@ type StakingHooks interface {
 	BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error        // Must be called when a delegation is removed
 	AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error
 	BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error
+	AfterConsPubKeyRotated(ctx sdk.Context, valAddr sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey) error // Must be called when a validator's consensus key is rotated
+
+	// BeforeSlashedTokensBurned must be called before the tokens slashed from
+	// the bonded or not bonded pool are burned. The hook may remove some of
+	// the slashed coins from the pool module account with the given name, and
+	// returns the remaining ones, which are burned.
+	BeforeSlashedTokensBurned(ctx sdk.Context, poolName string, amount sdk.Coins) (sdk.Coins, error)
 }
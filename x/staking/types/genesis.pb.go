@@ -43,6 +43,17 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// cons_pubkey_rotations defines the consensus public key rotations that
+	// happened within the last unbonding period.
+	ConsPubkeyRotations []ConsPubKeyRotation `protobuf:"bytes,9,rep,name=cons_pubkey_rotations,json=consPubkeyRotations,proto3" json:"cons_pubkey_rotations" yaml:"cons_pubkey_rotations"`
+	// gov_participations defines the validators which missed the last
+	// governance proposals.
+	GovParticipations []GovParticipation `protobuf:"bytes,10,rep,name=gov_participations,json=govParticipations,proto3" json:"gov_participations" yaml:"gov_participations"`
+	// validator_gov_votes defines the validator votes on the governance
+	// proposals in voting period.
+	ValidatorGovVotes []ValidatorGovVote `protobuf:"bytes,11,rep,name=validator_gov_votes,json=validatorGovVotes,proto3" json:"validator_gov_votes" yaml:"validator_gov_votes"`
+	// delegation_lockups defines the locked delegations.
+	DelegationLockups []DelegationLockup `protobuf:"bytes,12,rep,name=delegation_lockups,json=delegationLockups,proto3" json:"delegation_lockups" yaml:"delegation_lockups"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -127,6 +138,34 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetConsPubkeyRotations() []ConsPubKeyRotation {
+	if m != nil {
+		return m.ConsPubkeyRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovParticipations() []GovParticipation {
+	if m != nil {
+		return m.GovParticipations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorGovVotes() []ValidatorGovVote {
+	if m != nil {
+		return m.ValidatorGovVotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegationLockups() []DelegationLockup {
+	if m != nil {
+		return m.DelegationLockups
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -178,38 +217,47 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 493 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xc7, 0x7d, 0xa4, 0x49, 0xc3, 0xa5, 0x20, 0x74, 0xa4, 0x60, 0x45, 0xc8, 0x0e, 0x56, 0x84,
-	0x22, 0x5e, 0x6c, 0xb5, 0x6c, 0x15, 0x53, 0x84, 0xa8, 0x8a, 0x10, 0x8a, 0x8e, 0x97, 0x81, 0x25,
-	0xba, 0xd4, 0x27, 0x63, 0xd5, 0xf1, 0x59, 0x7e, 0x2e, 0xa5, 0xdd, 0x11, 0x62, 0xe4, 0x23, 0xf4,
-	0xe3, 0x74, 0xec, 0xc0, 0x80, 0x18, 0x2c, 0x94, 0x2c, 0xcc, 0xfd, 0x04, 0xc8, 0xe7, 0x17, 0x4c,
-	0x52, 0x33, 0x25, 0x77, 0xfa, 0xfd, 0x7f, 0x7f, 0xfb, 0xfc, 0x1c, 0x1e, 0x1c, 0x0a, 0x98, 0x09,
-	0x70, 0x40, 0xb2, 0x23, 0x3f, 0xf4, 0x9c, 0xe3, 0x9d, 0x29, 0x97, 0x6c, 0xc7, 0xf1, 0x78, 0xc8,
-	0xc1, 0x07, 0x3b, 0x8a, 0x85, 0x14, 0xe4, 0x4e, 0x46, 0xd9, 0x39, 0x65, 0xe7, 0x54, 0xaf, 0xeb,
-	0x09, 0x4f, 0x28, 0xc4, 0x49, 0xff, 0x65, 0x74, 0xaf, 0xce, 0x59, 0xa4, 0x15, 0x65, 0x7d, 0x6f,
-	0xe2, 0xad, 0xfd, 0xac, 0xe5, 0x8d, 0x64, 0x92, 0x93, 0x67, 0xb8, 0x15, 0xb1, 0x98, 0xcd, 0x40,
-	0x47, 0x7d, 0x34, 0xec, 0xec, 0x1a, 0xf6, 0xd5, 0xad, 0xf6, 0x58, 0x51, 0xa3, 0x8d, 0xf3, 0xc4,
-	0xd4, 0x68, 0x9e, 0x21, 0x80, 0x6f, 0x05, 0x0c, 0xe4, 0x44, 0x0a, 0xc9, 0x82, 0x49, 0x24, 0x3e,
-	0xf1, 0x58, 0xbf, 0xd6, 0x47, 0xc3, 0xad, 0xd1, 0x41, 0xca, 0xfd, 0x4c, 0xcc, 0x07, 0x9e, 0x2f,
-	0x3f, 0xce, 0xa7, 0xf6, 0xa1, 0x98, 0x39, 0xf9, 0x13, 0x66, 0x3f, 0x4f, 0xc0, 0x3d, 0x72, 0xe4,
-	0x69, 0xc4, 0xc1, 0x3e, 0x08, 0xe5, 0x65, 0x62, 0xde, 0x3d, 0x65, 0xb3, 0x60, 0xcf, 0x5a, 0xf5,
-	0x59, 0xf4, 0x66, 0xba, 0xf5, 0x36, 0xdd, 0x19, 0xa7, 0x1b, 0xe4, 0x33, 0xc2, 0xdb, 0x8a, 0x3a,
-	0x66, 0x81, 0xef, 0x32, 0x29, 0xe2, 0x8c, 0x04, 0xbd, 0xd1, 0x6f, 0x0c, 0x3b, 0xbb, 0x0f, 0xeb,
-	0x5e, 0xe1, 0x15, 0x03, 0xf9, 0xbe, 0xc8, 0x28, 0xd7, 0x68, 0x90, 0x3e, 0xe6, 0x65, 0x62, 0xde,
-	0xab, 0x94, 0xaf, 0x6a, 0x2d, 0x7a, 0x3b, 0x58, 0x4b, 0x02, 0xd9, 0xc7, 0xb8, 0x24, 0x41, 0xdf,
-	0x50, 0xd5, 0xf7, 0xeb, 0xaa, 0xcb, 0x70, 0x7e, 0x80, 0x95, 0x28, 0x79, 0x89, 0x3b, 0x2e, 0x0f,
-	0xb8, 0xc7, 0xa4, 0x2f, 0x42, 0xd0, 0x9b, 0xca, 0x64, 0xd5, 0x99, 0x9e, 0x97, 0x68, 0xae, 0xaa,
-	0x86, 0xc9, 0x17, 0x84, 0xb7, 0xe7, 0xe1, 0x54, 0x84, 0xae, 0x1f, 0x7a, 0x93, 0xaa, 0xb6, 0xa5,
-	0xb4, 0x8f, 0xea, 0xb4, 0xef, 0x8a, 0x50, 0xc5, 0xbf, 0x72, 0x38, 0x57, 0x7a, 0x2d, 0xda, 0x9d,
-	0xaf, 0x47, 0x81, 0x8c, 0xf1, 0x8d, 0x98, 0x57, 0xfb, 0x37, 0x55, 0xff, 0xa0, 0xae, 0x9f, 0x56,
-	0xe0, 0xfc, 0xc5, 0xfe, 0x15, 0x90, 0x1e, 0x6e, 0xf3, 0x93, 0x48, 0xc4, 0x92, 0xbb, 0x7a, 0xbb,
-	0x8f, 0x86, 0x6d, 0x5a, 0xae, 0xad, 0xd7, 0x98, 0xac, 0x7f, 0x5c, 0xa2, 0xe3, 0x4d, 0xe6, 0xba,
-	0x31, 0x87, 0x6c, 0xb8, 0xaf, 0xd3, 0x62, 0x49, 0xba, 0xb8, 0xf9, 0x77, 0x58, 0x1b, 0x34, 0x5b,
-	0xec, 0xb5, 0xbf, 0x9e, 0x99, 0xda, 0xef, 0x33, 0x53, 0x1b, 0xbd, 0x38, 0x5f, 0x18, 0xe8, 0x62,
-	0x61, 0xa0, 0x5f, 0x0b, 0x03, 0x7d, 0x5b, 0x1a, 0xda, 0xc5, 0xd2, 0xd0, 0x7e, 0x2c, 0x0d, 0xed,
-	0xc3, 0xe3, 0xff, 0xce, 0xf3, 0x49, 0x79, 0xfd, 0xd4, 0x64, 0x4f, 0x5b, 0xea, 0xd6, 0x3d, 0xfd,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0xff, 0x85, 0xad, 0xc8, 0xf1, 0x03, 0x00, 0x00,
+	// 638 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x14, 0x85, 0x63, 0xfa, 0x97, 0x4e, 0x0a, 0xa2, 0xd3, 0x16, 0x4c, 0x84, 0x9c, 0xd4, 0xaa, 0x50,
+	0xc4, 0x8f, 0xa3, 0x96, 0x5d, 0xc5, 0x2a, 0x20, 0xa2, 0x42, 0x85, 0xa2, 0x01, 0xba, 0x60, 0x63,
+	0x4d, 0xe2, 0x91, 0xb1, 0xe2, 0x78, 0x2c, 0xdf, 0x89, 0x69, 0x10, 0x4b, 0x84, 0x58, 0xf2, 0x08,
+	0x7d, 0x9c, 0x2e, 0xcb, 0x0e, 0xb1, 0x88, 0x50, 0xbb, 0x61, 0xdd, 0x27, 0x40, 0x1e, 0x3b, 0x8e,
+	0x71, 0xe2, 0xac, 0x92, 0x19, 0x9d, 0xf3, 0x9d, 0x7b, 0x27, 0x37, 0x17, 0xed, 0xf5, 0x38, 0x0c,
+	0x38, 0x34, 0x41, 0xd0, 0xbe, 0xe3, 0xd9, 0xcd, 0x70, 0xbf, 0xcb, 0x04, 0xdd, 0x6f, 0xda, 0xcc,
+	0x63, 0xe0, 0x80, 0xe1, 0x07, 0x5c, 0x70, 0x7c, 0x27, 0x56, 0x19, 0x89, 0xca, 0x48, 0x54, 0xd5,
+	0x6d, 0x9b, 0xdb, 0x5c, 0x4a, 0x9a, 0xd1, 0xb7, 0x58, 0x5d, 0x2d, 0x62, 0x4e, 0xdc, 0x52, 0xa5,
+	0xff, 0x5c, 0x47, 0x1b, 0xed, 0x38, 0xe5, 0xad, 0xa0, 0x82, 0xe1, 0x67, 0x68, 0xd5, 0xa7, 0x01,
+	0x1d, 0x80, 0xaa, 0xd4, 0x95, 0x46, 0xe5, 0x40, 0x33, 0xe6, 0xa7, 0x1a, 0x1d, 0xa9, 0x6a, 0x2d,
+	0x9f, 0x8f, 0x6b, 0x25, 0x92, 0x78, 0x30, 0xa0, 0xdb, 0x2e, 0x05, 0x61, 0x0a, 0x2e, 0xa8, 0x6b,
+	0xfa, 0xfc, 0x13, 0x0b, 0xd4, 0x1b, 0x75, 0xa5, 0xb1, 0xd1, 0x3a, 0x8a, 0x74, 0xbf, 0xc7, 0xb5,
+	0x07, 0xb6, 0x23, 0x3e, 0x0e, 0xbb, 0x46, 0x8f, 0x0f, 0x9a, 0x49, 0x85, 0xf1, 0xc7, 0x13, 0xb0,
+	0xfa, 0x4d, 0x31, 0xf2, 0x19, 0x18, 0x47, 0x9e, 0xb8, 0x1e, 0xd7, 0xee, 0x8e, 0xe8, 0xc0, 0x3d,
+	0xd4, 0xf3, 0x3c, 0x9d, 0xdc, 0x8a, 0xae, 0xde, 0x45, 0x37, 0x9d, 0xe8, 0x02, 0x7f, 0x55, 0xd0,
+	0x8e, 0x54, 0x85, 0xd4, 0x75, 0x2c, 0x2a, 0x78, 0x10, 0x2b, 0x41, 0x5d, 0xaa, 0x2f, 0x35, 0x2a,
+	0x07, 0x0f, 0x8b, 0x5a, 0x38, 0xa6, 0x20, 0x4e, 0x26, 0x1e, 0xc9, 0x6a, 0xed, 0x45, 0x65, 0x5e,
+	0x8f, 0x6b, 0xf7, 0x33, 0xe1, 0x79, 0xac, 0x4e, 0xb6, 0xdc, 0x19, 0x27, 0xe0, 0x36, 0x42, 0xa9,
+	0x12, 0xd4, 0x65, 0x19, 0xbd, 0x5b, 0x14, 0x9d, 0x9a, 0x93, 0x07, 0xcc, 0x58, 0xf1, 0x2b, 0x54,
+	0xb1, 0x98, 0xcb, 0x6c, 0x2a, 0x1c, 0xee, 0x81, 0xba, 0x22, 0x49, 0x7a, 0x11, 0xe9, 0x45, 0x2a,
+	0x4d, 0x50, 0x59, 0x33, 0xfe, 0xa6, 0xa0, 0x9d, 0xa1, 0xd7, 0xe5, 0x9e, 0xe5, 0x78, 0xb6, 0x99,
+	0xc5, 0xae, 0x4a, 0xec, 0xa3, 0x22, 0xec, 0xfb, 0x89, 0x29, 0xc3, 0xcf, 0x3d, 0xce, 0x5c, 0xae,
+	0x4e, 0xb6, 0x87, 0xb3, 0x56, 0xc0, 0x1d, 0x74, 0x33, 0x60, 0xd9, 0xfc, 0x35, 0x99, 0xbf, 0x57,
+	0x94, 0x4f, 0x98, 0x95, 0x6f, 0xec, 0x7f, 0x00, 0xae, 0xa2, 0x32, 0x3b, 0xf5, 0x79, 0x20, 0x98,
+	0xa5, 0x96, 0xeb, 0x4a, 0xa3, 0x4c, 0xd2, 0xb3, 0x1c, 0x89, 0x1e, 0xf7, 0xc0, 0xf4, 0x87, 0xdd,
+	0x3e, 0x1b, 0x99, 0x01, 0x17, 0x49, 0xec, 0xfa, 0xe2, 0x91, 0x78, 0xce, 0x3d, 0xe8, 0x0c, 0xbb,
+	0xaf, 0xd9, 0x88, 0x70, 0x31, 0xb7, 0xeb, 0xb9, 0x58, 0x9d, 0x6c, 0xf5, 0x62, 0x67, 0x7f, 0xea,
+	0x04, 0xfc, 0x19, 0x61, 0x9b, 0x87, 0xa6, 0x4f, 0x03, 0xe1, 0xf4, 0x1c, 0x3f, 0x29, 0x01, 0xc9,
+	0x12, 0x1a, 0x45, 0x25, 0xb4, 0x79, 0xd8, 0xc9, 0x1a, 0x5a, 0xbb, 0x49, 0x01, 0xf7, 0xe2, 0x02,
+	0x66, 0x89, 0x3a, 0xd9, 0xb4, 0x73, 0x26, 0xc0, 0x5f, 0xd0, 0xd6, 0x74, 0x70, 0x23, 0x4f, 0xc8,
+	0x05, 0x03, 0xb5, 0xb2, 0x38, 0x3c, 0x9d, 0xcb, 0x36, 0x0f, 0x4f, 0xb8, 0x60, 0x2d, 0x3d, 0x09,
+	0xaf, 0xc6, 0xe1, 0x73, 0x90, 0x3a, 0xd9, 0x0c, 0x73, 0x2e, 0xd9, 0xf9, 0xf4, 0xb7, 0x32, 0x5d,
+	0xde, 0xeb, 0x0f, 0x7d, 0x50, 0x37, 0x16, 0x87, 0x4f, 0xe7, 0xe5, 0x58, 0x1a, 0xf2, 0x9d, 0xcf,
+	0x12, 0x75, 0xb2, 0x69, 0xe5, 0x4c, 0xa0, 0xbf, 0x41, 0x78, 0xf6, 0x9f, 0x8d, 0x55, 0xb4, 0x46,
+	0x2d, 0x2b, 0x60, 0x10, 0x6f, 0xb6, 0x75, 0x32, 0x39, 0xe2, 0x6d, 0xb4, 0x32, 0xdd, 0x54, 0x4b,
+	0x24, 0x3e, 0x1c, 0x96, 0xbf, 0x9f, 0xd5, 0x4a, 0x7f, 0xcf, 0x6a, 0xa5, 0xd6, 0xcb, 0xf3, 0x4b,
+	0x4d, 0xb9, 0xb8, 0xd4, 0x94, 0x3f, 0x97, 0x9a, 0xf2, 0xe3, 0x4a, 0x2b, 0x5d, 0x5c, 0x69, 0xa5,
+	0x5f, 0x57, 0x5a, 0xe9, 0xc3, 0xe3, 0x85, 0xcb, 0xec, 0x34, 0xdd, 0xbd, 0x72, 0xad, 0x75, 0x57,
+	0xe5, 0xca, 0x7d, 0xfa, 0x6f, 0x00, 0x84, 0x5f, 0x70, 0x30, 0xee, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -232,6 +280,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegationLockups) > 0 {
+		for iNdEx := len(m.DelegationLockups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationLockups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ValidatorGovVotes) > 0 {
+		for iNdEx := len(m.ValidatorGovVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorGovVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.GovParticipations) > 0 {
+		for iNdEx := len(m.GovParticipations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovParticipations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ConsPubkeyRotations) > 0 {
+		for iNdEx := len(m.ConsPubkeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsPubkeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -424,6 +528,30 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.ConsPubkeyRotations) > 0 {
+		for _, e := range m.ConsPubkeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GovParticipations) > 0 {
+		for _, e := range m.GovParticipations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorGovVotes) > 0 {
+		for _, e := range m.ValidatorGovVotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegationLockups) > 0 {
+		for _, e := range m.DelegationLockups {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -734,6 +862,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubkeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsPubkeyRotations = append(m.ConsPubkeyRotations, ConsPubKeyRotation{})
+			if err := m.ConsPubkeyRotations[len(m.ConsPubkeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovParticipations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovParticipations = append(m.GovParticipations, GovParticipation{})
+			if err := m.GovParticipations[len(m.GovParticipations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorGovVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorGovVotes = append(m.ValidatorGovVotes, ValidatorGovVote{})
+			if err := m.ValidatorGovVotes[len(m.ValidatorGovVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationLockups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationLockups = append(m.DelegationLockups, DelegationLockup{})
+			if err := m.DelegationLockups[len(m.DelegationLockups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGovParticipation creates a new GovParticipation instance.
+//
+//nolint:interfacer
+func NewGovParticipation(operator sdk.ValAddress, missedProposals uint32) GovParticipation {
+	return GovParticipation{
+		ValidatorAddress: operator.String(),
+		MissedProposals:  missedProposals,
+	}
+}
+
+// MustUnmarshalGovParticipation unmarshals a governance participation and
+// panics on error.
+func MustUnmarshalGovParticipation(cdc codec.BinaryCodec, value []byte) GovParticipation {
+	participation := GovParticipation{}
+	cdc.MustUnmarshal(value, &participation)
+	return participation
+}
+
+// GetValidator returns the address of the validator of the participation.
+func (p GovParticipation) GetValidator() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(p.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return addr
+}
+
+// IsAbsent returns whether the validator missed at least the maximum number of
+// consecutive governance proposals. A zero maximum disables the flag.
+func (p GovParticipation) IsAbsent(maxMissedProposals uint32) bool {
+	return maxMissedProposals > 0 && p.MissedProposals >= maxMissedProposals
+}
+
+// NewValidatorGovVote creates a new ValidatorGovVote instance.
+//
+//nolint:interfacer
+func NewValidatorGovVote(proposalID uint64, operator sdk.ValAddress) ValidatorGovVote {
+	return ValidatorGovVote{
+		ProposalId:       proposalID,
+		ValidatorAddress: operator.String(),
+	}
+}
+
+// GetValidator returns the address of the validator which voted.
+func (v ValidatorGovVote) GetValidator() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(v.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return addr
+}
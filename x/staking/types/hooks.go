@@ -1,6 +1,7 @@
 package types
 
 import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -94,3 +95,20 @@ func (h MultiStakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.V
 	}
 	return nil
 }
+func (h MultiStakingHooks) AfterConsPubKeyRotated(ctx sdk.Context, valAddr sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	for i := range h {
+		if err := h[i].AfterConsPubKeyRotated(ctx, valAddr, oldPubKey, newPubKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (h MultiStakingHooks) BeforeSlashedTokensBurned(ctx sdk.Context, poolName string, amount sdk.Coins) (sdk.Coins, error) {
+	for i := range h {
+		var err error
+		if amount, err = h[i].BeforeSlashedTokensBurned(ctx, poolName, amount); err != nil {
+			return nil, err
+		}
+	}
+	return amount, nil
+}
@@ -32,6 +32,9 @@ var (
 	LastValidatorPowerKey = []byte{0x11} // prefix for each key to a validator index, for bonded validators
 	LastTotalPowerKey     = []byte{0x12} // prefix for the total power
 
+	ActiveMaxValidatorsKey    = []byte{0x13} // key for the maximum number of bonded validators in effect
+	ScheduledMaxValidatorsKey = []byte{0x14} // key for the announced maximum number of bonded validators taking effect at the next epoch
+
 	ValidatorsKey             = []byte{0x21} // prefix for each key to a validator
 	ValidatorsByConsAddrKey   = []byte{0x22} // prefix for each key to a validator index, by pubkey
 	ValidatorsByPowerIndexKey = []byte{0x23} // prefix for each key to a validator index, sorted by power
@@ -48,6 +51,14 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	ConsPubKeyRotationKey = []byte{0x60} // prefix for the consensus public key rotations
+
+	GovParticipationKey = []byte{0x70} // prefix for the governance participation of validators
+	ValidatorGovVoteKey = []byte{0x71} // prefix for the validator votes on governance proposals in voting period
+
+	DelegationLockupKey = []byte{0x80} // prefix for the lockups of delegations
+	LockupQueueKey      = []byte{0x81} // prefix for the timestamps in lockups queue
 )
 
 // GetValidatorKey creates the key for the validator with address
@@ -347,3 +358,65 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetConsPubKeyRotationKey creates the key for the rotation of the consensus
+// public key of a validator away from the given consensus address.
+// VALUE: staking/ConsPubKeyRotation
+func GetConsPubKeyRotationKey(operatorAddr sdk.ValAddress, oldConsAddr sdk.ConsAddress) []byte {
+	return append(GetConsPubKeyRotationsKey(operatorAddr), address.MustLengthPrefix(oldConsAddr)...)
+}
+
+// GetConsPubKeyRotationsKey creates the prefix for all the consensus public
+// key rotations of a validator.
+func GetConsPubKeyRotationsKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetGovParticipationKey creates the key for the governance participation of
+// a validator.
+// VALUE: staking/GovParticipation
+func GetGovParticipationKey(operatorAddr sdk.ValAddress) []byte {
+	return append(GovParticipationKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorGovVoteKey creates the key for the vote of a validator on a
+// governance proposal.
+// VALUE: staking/ValidatorGovVote
+func GetValidatorGovVoteKey(proposalID uint64, operatorAddr sdk.ValAddress) []byte {
+	return append(GetValidatorGovVotesKey(proposalID), address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorGovVotesKey creates the prefix for all the validator votes on a
+// governance proposal.
+func GetValidatorGovVotesKey(proposalID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, proposalID)
+
+	return append(ValidatorGovVoteKey, bz...)
+}
+
+// GetDelegationLockupKey creates the key for the lockup of a delegation.
+// VALUE: staking/DelegationLockup
+func GetDelegationLockupKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(GetDelegationLockupsKey(delAddr), address.MustLengthPrefix(valAddr)...)
+}
+
+// GetDelegationLockupsKey creates the prefix for the lockups of all the
+// delegations of a delegator.
+func GetDelegationLockupsKey(delAddr sdk.AccAddress) []byte {
+	return append(DelegationLockupKey, address.MustLengthPrefix(delAddr)...)
+}
+
+// GetLockupQueueTimeKey creates the prefix for the lockups ending at a time.
+func GetLockupQueueTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(LockupQueueKey, bz...)
+}
+
+// GetLockupQueueKey creates the key for a lockup in the lockups queue, by
+// end time.
+// VALUE: staking/DVPair
+func GetLockupQueueKey(endTime time.Time, delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	key := append(GetLockupQueueTimeKey(endTime), address.MustLengthPrefix(delAddr)...)
+	return append(key, address.MustLengthPrefix(valAddr)...)
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewLockupTier creates a new LockupTier instance.
+func NewLockupTier(duration time.Duration, rewardMultiplier sdk.Dec) LockupTier {
+	return LockupTier{
+		Duration:         duration,
+		RewardMultiplier: rewardMultiplier,
+	}
+}
+
+// NewDelegationLockup creates a new DelegationLockup instance, locking a
+// delegation until endTime for the given lockup tier.
+//
+//nolint:interfacer
+func NewDelegationLockup(delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress, tier LockupTier, endTime time.Time) DelegationLockup {
+	return DelegationLockup{
+		DelegatorAddress: delegatorAddr.String(),
+		ValidatorAddress: validatorAddr.String(),
+		Duration:         tier.Duration,
+		RewardMultiplier: tier.RewardMultiplier,
+		EndTime:          endTime,
+	}
+}
+
+// MustUnmarshalDelegationLockup unmarshals a delegation lockup and panics on
+// error.
+func MustUnmarshalDelegationLockup(cdc codec.BinaryCodec, value []byte) DelegationLockup {
+	lockup, err := UnmarshalDelegationLockup(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+
+	return lockup
+}
+
+// UnmarshalDelegationLockup unmarshals a delegation lockup.
+func UnmarshalDelegationLockup(cdc codec.BinaryCodec, value []byte) (lockup DelegationLockup, err error) {
+	err = cdc.Unmarshal(value, &lockup)
+	return lockup, err
+}
+
+// GetDelegatorAddr returns the address of the delegator of the locked
+// delegation.
+func (l DelegationLockup) GetDelegatorAddr() sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(l.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return delAddr
+}
+
+// GetValidatorAddr returns the address of the validator of the locked
+// delegation.
+func (l DelegationLockup) GetValidatorAddr() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(l.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return addr
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -117,6 +118,30 @@ func TestMsgEditValidator(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgRotateConsPubKey
+func TestMsgRotateConsPubKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		validatorAddr sdk.ValAddress
+		pubkey        cryptotypes.PubKey
+		expectPass    bool
+	}{
+		{"basic good", valAddr1, pk2, true},
+		{"empty address", emptyAddr, pk2, false},
+		{"empty pubkey", valAddr1, nil, false},
+	}
+
+	for _, tc := range tests {
+		msg, err := types.NewMsgRotateConsPubKey(tc.validatorAddr, tc.pubkey)
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
 // test ValidateBasic for MsgDelegate
 func TestMsgDelegate(t *testing.T) {
 	tests := []struct {
@@ -144,6 +169,36 @@ func TestMsgDelegate(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgRebalanceDelegations
+func TestMsgRebalanceDelegations(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		targets       []types.RebalanceTarget
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), []types.RebalanceTarget{types.NewRebalanceTarget(valAddr2, half), types.NewRebalanceTarget(valAddr3, half)}, true},
+		{"single target", sdk.AccAddress(valAddr1), []types.RebalanceTarget{types.NewRebalanceTarget(valAddr2, sdk.OneDec())}, true},
+		{"empty delegator", sdk.AccAddress(emptyAddr), []types.RebalanceTarget{types.NewRebalanceTarget(valAddr2, sdk.OneDec())}, false},
+		{"no targets", sdk.AccAddress(valAddr1), nil, false},
+		{"empty validator", sdk.AccAddress(valAddr1), []types.RebalanceTarget{types.NewRebalanceTarget(emptyAddr, sdk.OneDec())}, false},
+		{"duplicate validator", sdk.AccAddress(valAddr1), []types.RebalanceTarget{types.NewRebalanceTarget(valAddr2, half), types.NewRebalanceTarget(valAddr2, half)}, false},
+		{"zero weight", sdk.AccAddress(valAddr1), []types.RebalanceTarget{types.NewRebalanceTarget(valAddr2, sdk.OneDec()), types.NewRebalanceTarget(valAddr3, sdk.ZeroDec())}, false},
+		{"nil weight", sdk.AccAddress(valAddr1), []types.RebalanceTarget{{ValidatorAddress: valAddr2.String()}}, false},
+		{"weights below one", sdk.AccAddress(valAddr1), []types.RebalanceTarget{types.NewRebalanceTarget(valAddr2, half)}, false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgRebalanceDelegations(tc.delegatorAddr, tc.targets)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
 // test ValidateBasic for MsgUnbond
 func TestMsgBeginRedelegate(t *testing.T) {
 	tests := []struct {
@@ -197,3 +252,57 @@ func TestMsgUndelegate(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgCancelUnbondingDelegation
+func TestMsgCancelUnbondingDelegation(t *testing.T) {
+	tests := []struct {
+		name           string
+		delegatorAddr  sdk.AccAddress
+		validatorAddr  sdk.ValAddress
+		creationHeight int64
+		amount         sdk.Coin
+		expectPass     bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, 1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), true},
+		{"zero amount", sdk.AccAddress(valAddr1), valAddr2, 1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), false},
+		{"nil amount", sdk.AccAddress(valAddr1), valAddr2, 1, sdk.Coin{}, false},
+		{"zero creation height", sdk.AccAddress(valAddr1), valAddr2, 0, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, 1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty validator", sdk.AccAddress(valAddr1), emptyAddr, 1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgCancelUnbondingDelegation(tc.delegatorAddr, tc.validatorAddr, tc.creationHeight, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+// test ValidateBasic for MsgLockDelegation
+func TestMsgLockDelegation(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		duration      time.Duration
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, time.Hour, true},
+		{"zero duration", sdk.AccAddress(valAddr1), valAddr2, 0, false},
+		{"negative duration", sdk.AccAddress(valAddr1), valAddr2, -time.Hour, false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, time.Hour, false},
+		{"empty validator", sdk.AccAddress(valAddr1), emptyAddr, time.Hour, false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgLockDelegation(tc.delegatorAddr, tc.validatorAddr, tc.duration)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
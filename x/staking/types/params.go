@@ -30,8 +30,21 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultMaxMissedGovProposals is zero, which disables the flagging of the
+	// validators absent from governance.
+	DefaultMaxMissedGovProposals uint32 = 0
+
+	// DefaultValidatorSetEpochLength is zero, which applies the changes of the
+	// maximum number of bonded validators at the end of the block they are made
+	// in.
+	DefaultValidatorSetEpochLength uint64 = 0
 )
 
+// DefaultLockupTiers are no lockup tiers, which disables the lockups of
+// delegations.
+var DefaultLockupTiers []LockupTier
+
 var (
 	KeyUnbondingTime     = []byte("UnbondingTime")
 	KeyMaxValidators     = []byte("MaxValidators")
@@ -39,6 +52,12 @@ var (
 	KeyBondDenom         = []byte("BondDenom")
 	KeyHistoricalEntries = []byte("HistoricalEntries")
 	KeyPowerReduction    = []byte("PowerReduction")
+
+	KeyMaxMissedGovProposals = []byte("MaxMissedGovProposals")
+
+	KeyValidatorSetEpochLength = []byte("ValidatorSetEpochLength")
+
+	KeyLockupTiers = []byte("LockupTiers")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -49,13 +68,19 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string,
+	maxMissedGovProposals uint32, validatorSetEpochLength uint64, lockupTiers []LockupTier,
+) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
+		UnbondingTime:           unbondingTime,
+		MaxValidators:           maxValidators,
+		MaxEntries:              maxEntries,
+		HistoricalEntries:       historicalEntries,
+		BondDenom:               bondDenom,
+		MaxMissedGovProposals:   maxMissedGovProposals,
+		ValidatorSetEpochLength: validatorSetEpochLength,
+		LockupTiers:             lockupTiers,
 	}
 }
 
@@ -67,6 +92,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMaxEntries, &p.MaxEntries, validateMaxEntries),
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
+		paramtypes.NewParamSetPair(KeyMaxMissedGovProposals, &p.MaxMissedGovProposals, validateMaxMissedGovProposals),
+		paramtypes.NewParamSetPair(KeyValidatorSetEpochLength, &p.ValidatorSetEpochLength, validateValidatorSetEpochLength),
+		paramtypes.NewParamSetPair(KeyLockupTiers, &p.LockupTiers, validateLockupTiers),
 	}
 }
 
@@ -78,6 +106,9 @@ func DefaultParams() Params {
 		DefaultMaxEntries,
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
+		DefaultMaxMissedGovProposals,
+		DefaultValidatorSetEpochLength,
+		DefaultLockupTiers,
 	)
 }
 
@@ -125,9 +156,24 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateLockupTiers(p.LockupTiers); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// LockupTier returns the lockup tier of a duration.
+func (p Params) LockupTier(duration time.Duration) (LockupTier, bool) {
+	for _, tier := range p.LockupTiers {
+		if tier.Duration == duration {
+			return tier, true
+		}
+	}
+
+	return LockupTier{}, false
+}
+
 func validateUnbondingTime(i interface{}) error {
 	v, ok := i.(time.Duration)
 	if !ok {
@@ -176,6 +222,15 @@ func validateHistoricalEntries(i interface{}) error {
 	return nil
 }
 
+func validateMaxMissedGovProposals(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 func validateBondDenom(i interface{}) error {
 	v, ok := i.(string)
 	if !ok {
@@ -205,3 +260,37 @@ func ValidatePowerReduction(i interface{}) error {
 
 	return nil
 }
+
+func validateValidatorSetEpochLength(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateLockupTiers(i interface{}) error {
+	v, ok := i.([]LockupTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	durations := make(map[time.Duration]bool, len(v))
+	for _, tier := range v {
+		if tier.Duration <= 0 {
+			return fmt.Errorf("lockup tier duration must be positive: %s", tier.Duration)
+		}
+
+		if durations[tier.Duration] {
+			return fmt.Errorf("duplicate lockup tier duration: %s", tier.Duration)
+		}
+		durations[tier.Duration] = true
+
+		if tier.RewardMultiplier.IsNil() || tier.RewardMultiplier.LT(sdk.OneDec()) {
+			return fmt.Errorf("lockup tier reward multiplier must be at least one: %s", tier.RewardMultiplier)
+		}
+	}
+
+	return nil
+}
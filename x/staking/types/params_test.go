@@ -2,9 +2,11 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -21,3 +23,28 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestValidateLockupTiers(t *testing.T) {
+	params := types.DefaultParams()
+	params.LockupTiers = []types.LockupTier{
+		types.NewLockupTier(time.Hour, sdk.NewDecWithPrec(15, 1)),
+		types.NewLockupTier(2*time.Hour, sdk.NewDec(2)),
+	}
+	require.NoError(t, params.Validate())
+
+	tier, found := params.LockupTier(2 * time.Hour)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(2), tier.RewardMultiplier)
+	_, found = params.LockupTier(3 * time.Hour)
+	require.False(t, found)
+
+	for name, tiers := range map[string][]types.LockupTier{
+		"zero duration":      {types.NewLockupTier(0, sdk.NewDec(2))},
+		"duplicate duration": {types.NewLockupTier(time.Hour, sdk.NewDec(2)), types.NewLockupTier(time.Hour, sdk.NewDec(3))},
+		"nil multiplier":     {{Duration: time.Hour}},
+		"multiplier below 1": {types.NewLockupTier(time.Hour, sdk.NewDecWithPrec(5, 1))},
+	} {
+		params.LockupTiers = tiers
+		require.Error(t, params.Validate(), name)
+	}
+}
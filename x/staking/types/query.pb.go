@@ -1389,6 +1389,299 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryValidatorGovParticipationRequest is request type for the
+// Query/ValidatorGovParticipation RPC method.
+type QueryValidatorGovParticipationRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryValidatorGovParticipationRequest) Reset()         { *m = QueryValidatorGovParticipationRequest{} }
+func (m *QueryValidatorGovParticipationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorGovParticipationRequest) ProtoMessage()    {}
+func (*QueryValidatorGovParticipationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{28}
+}
+func (m *QueryValidatorGovParticipationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorGovParticipationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorGovParticipationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorGovParticipationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorGovParticipationRequest.Merge(m, src)
+}
+func (m *QueryValidatorGovParticipationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorGovParticipationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorGovParticipationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorGovParticipationRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorGovParticipationRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+// QueryValidatorGovParticipationResponse is response type for the
+// Query/ValidatorGovParticipation RPC method.
+type QueryValidatorGovParticipationResponse struct {
+	// gov_participation defines the governance participation of the validator.
+	GovParticipation GovParticipation `protobuf:"bytes,1,opt,name=gov_participation,json=govParticipation,proto3" json:"gov_participation"`
+	// absent is set when the validator missed at least max_missed_gov_proposals
+	// consecutive governance proposals.
+	Absent bool `protobuf:"varint,2,opt,name=absent,proto3" json:"absent,omitempty"`
+}
+
+func (m *QueryValidatorGovParticipationResponse) Reset() {
+	*m = QueryValidatorGovParticipationResponse{}
+}
+func (m *QueryValidatorGovParticipationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorGovParticipationResponse) ProtoMessage()    {}
+func (*QueryValidatorGovParticipationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{29}
+}
+func (m *QueryValidatorGovParticipationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorGovParticipationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorGovParticipationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorGovParticipationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorGovParticipationResponse.Merge(m, src)
+}
+func (m *QueryValidatorGovParticipationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorGovParticipationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorGovParticipationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorGovParticipationResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorGovParticipationResponse) GetGovParticipation() GovParticipation {
+	if m != nil {
+		return m.GovParticipation
+	}
+	return GovParticipation{}
+}
+
+func (m *QueryValidatorGovParticipationResponse) GetAbsent() bool {
+	if m != nil {
+		return m.Absent
+	}
+	return false
+}
+
+// QueryDelegationLockupRequest is request type for the Query/DelegationLockup
+// RPC method.
+type QueryDelegationLockupRequest struct {
+	// delegator_addr defines the delegator address to query for.
+	DelegatorAddr string `protobuf:"bytes,1,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,2,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryDelegationLockupRequest) Reset()         { *m = QueryDelegationLockupRequest{} }
+func (m *QueryDelegationLockupRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationLockupRequest) ProtoMessage()    {}
+func (*QueryDelegationLockupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{30}
+}
+func (m *QueryDelegationLockupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationLockupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationLockupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationLockupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationLockupRequest.Merge(m, src)
+}
+func (m *QueryDelegationLockupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationLockupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationLockupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationLockupRequest proto.InternalMessageInfo
+
+// QueryDelegationLockupResponse is response type for the Query/DelegationLockup
+// RPC method.
+type QueryDelegationLockupResponse struct {
+	// lockup defines the lockup of the delegation.
+	Lockup DelegationLockup `protobuf:"bytes,1,opt,name=lockup,proto3" json:"lockup"`
+}
+
+func (m *QueryDelegationLockupResponse) Reset()         { *m = QueryDelegationLockupResponse{} }
+func (m *QueryDelegationLockupResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationLockupResponse) ProtoMessage()    {}
+func (*QueryDelegationLockupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{31}
+}
+func (m *QueryDelegationLockupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationLockupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationLockupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationLockupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationLockupResponse.Merge(m, src)
+}
+func (m *QueryDelegationLockupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationLockupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationLockupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationLockupResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationLockupResponse) GetLockup() DelegationLockup {
+	if m != nil {
+		return m.Lockup
+	}
+	return DelegationLockup{}
+}
+
+// QueryDelegatorLockupsRequest is request type for the Query/DelegatorLockups
+// RPC method.
+type QueryDelegatorLockupsRequest struct {
+	// delegator_addr defines the delegator address to query for.
+	DelegatorAddr string `protobuf:"bytes,1,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorLockupsRequest) Reset()         { *m = QueryDelegatorLockupsRequest{} }
+func (m *QueryDelegatorLockupsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorLockupsRequest) ProtoMessage()    {}
+func (*QueryDelegatorLockupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{32}
+}
+func (m *QueryDelegatorLockupsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorLockupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorLockupsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorLockupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorLockupsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorLockupsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorLockupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorLockupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorLockupsRequest proto.InternalMessageInfo
+
+// QueryDelegatorLockupsResponse is response type for the Query/DelegatorLockups
+// RPC method.
+type QueryDelegatorLockupsResponse struct {
+	// lockups defines the lockups of the delegations of the delegator.
+	Lockups []DelegationLockup `protobuf:"bytes,1,rep,name=lockups,proto3" json:"lockups"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorLockupsResponse) Reset()         { *m = QueryDelegatorLockupsResponse{} }
+func (m *QueryDelegatorLockupsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorLockupsResponse) ProtoMessage()    {}
+func (*QueryDelegatorLockupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{33}
+}
+func (m *QueryDelegatorLockupsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorLockupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorLockupsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorLockupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorLockupsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorLockupsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorLockupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorLockupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorLockupsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorLockupsResponse) GetLockups() []DelegationLockup {
+	if m != nil {
+		return m.Lockups
+	}
+	return nil
+}
+
+func (m *QueryDelegatorLockupsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryValidatorsRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorsRequest")
 	proto.RegisterType((*QueryValidatorsResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorsResponse")
@@ -1418,6 +1711,12 @@ func init() {
 	proto.RegisterType((*QueryPoolResponse)(nil), "cosmos.staking.v1beta1.QueryPoolResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.staking.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.staking.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryValidatorGovParticipationRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorGovParticipationRequest")
+	proto.RegisterType((*QueryValidatorGovParticipationResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorGovParticipationResponse")
+	proto.RegisterType((*QueryDelegationLockupRequest)(nil), "cosmos.staking.v1beta1.QueryDelegationLockupRequest")
+	proto.RegisterType((*QueryDelegationLockupResponse)(nil), "cosmos.staking.v1beta1.QueryDelegationLockupResponse")
+	proto.RegisterType((*QueryDelegatorLockupsRequest)(nil), "cosmos.staking.v1beta1.QueryDelegatorLockupsRequest")
+	proto.RegisterType((*QueryDelegatorLockupsResponse)(nil), "cosmos.staking.v1beta1.QueryDelegatorLockupsResponse")
 }
 
 func init() {
@@ -1425,89 +1724,103 @@ func init() {
 }
 
 var fileDescriptor_f270127f442bbcd8 = []byte{
-	// 1303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcf, 0x4f, 0x1c, 0x75,
-	0x14, 0xdf, 0x2f, 0x20, 0x91, 0xd7, 0xb4, 0xa9, 0xdf, 0x05, 0xc4, 0x29, 0xee, 0xd2, 0x09, 0x22,
-	0xa5, 0x74, 0x46, 0xa0, 0x52, 0xac, 0x4d, 0x15, 0xac, 0x54, 0xd2, 0x83, 0xb0, 0x46, 0xfc, 0x75,
-	0x20, 0xb3, 0x3b, 0xd3, 0xd9, 0x49, 0x97, 0x99, 0xed, 0xcc, 0x40, 0x40, 0xc2, 0x41, 0x4f, 0x7a,
-	0xd3, 0x78, 0x52, 0x2f, 0x3d, 0x98, 0x98, 0xe8, 0x51, 0xff, 0x01, 0x4f, 0xd6, 0x1b, 0x46, 0x0f,
-	0x7a, 0xa9, 0x06, 0x3c, 0x34, 0x9e, 0xbc, 0x19, 0x6f, 0x66, 0xbf, 0xf3, 0x66, 0x76, 0x86, 0xf9,
-	0xb9, 0xcb, 0x12, 0xd2, 0x13, 0xbb, 0xdf, 0x7d, 0x3f, 0x3e, 0x9f, 0xf7, 0xbe, 0xef, 0x7d, 0xdf,
-	0x0b, 0xc0, 0x57, 0x0c, 0x6b, 0xdd, 0xb0, 0x44, 0xcb, 0x96, 0xee, 0x68, 0xba, 0x2a, 0x6e, 0x4e,
-	0x95, 0x15, 0x5b, 0x9a, 0x12, 0xef, 0x6e, 0x28, 0xe6, 0xb6, 0x50, 0x37, 0x0d, 0xdb, 0xa0, 0x83,
-	0x8e, 0x8c, 0x80, 0x32, 0x02, 0xca, 0x70, 0x13, 0xa8, 0x5b, 0x96, 0x2c, 0xc5, 0x51, 0xf0, 0xd4,
-	0xeb, 0x92, 0xaa, 0xe9, 0x92, 0xad, 0x19, 0xba, 0x63, 0x83, 0xeb, 0x57, 0x0d, 0xd5, 0x60, 0x1f,
-	0xc5, 0xc6, 0x27, 0x3c, 0x1d, 0x56, 0x0d, 0x43, 0xad, 0x29, 0xa2, 0x54, 0xd7, 0x44, 0x49, 0xd7,
-	0x0d, 0x9b, 0xa9, 0x58, 0xf8, 0xeb, 0x68, 0x0c, 0x36, 0x17, 0x07, 0x93, 0xe2, 0xb7, 0x60, 0x70,
-	0xa5, 0xe1, 0x7b, 0x55, 0xaa, 0x69, 0xb2, 0x64, 0x1b, 0xa6, 0x55, 0x52, 0xee, 0x6e, 0x28, 0x96,
-	0x4d, 0x07, 0xa1, 0xd7, 0xb2, 0x25, 0x7b, 0xc3, 0x1a, 0x22, 0x23, 0x64, 0xbc, 0xaf, 0x84, 0xdf,
-	0xe8, 0x22, 0x40, 0x13, 0xdf, 0x50, 0xd7, 0x08, 0x19, 0x3f, 0x35, 0x3d, 0x26, 0x20, 0xc9, 0x06,
-	0x19, 0xc1, 0x61, 0x8f, 0xfe, 0x84, 0x65, 0x49, 0x55, 0xd0, 0x66, 0xc9, 0xa7, 0xc9, 0x7f, 0x4b,
-	0xe0, 0xc9, 0x90, 0x6b, 0xab, 0x6e, 0xe8, 0x96, 0x42, 0x6f, 0x02, 0x6c, 0x7a, 0xa7, 0x43, 0x64,
-	0xa4, 0x7b, 0xfc, 0xd4, 0xf4, 0x79, 0x21, 0x3a, 0x90, 0x82, 0xa7, 0xbf, 0xd0, 0x73, 0xff, 0x41,
-	0x31, 0x57, 0xf2, 0xa9, 0x36, 0x0c, 0x85, 0xc0, 0x3e, 0x9b, 0x0a, 0xd6, 0x41, 0x11, 0x40, 0x7b,
-	0x1d, 0x06, 0x82, 0x60, 0xdd, 0x30, 0x3d, 0x03, 0x67, 0x3c, 0x7f, 0x6b, 0x92, 0x2c, 0x9b, 0x18,
-	0xae, 0xd3, 0xde, 0xe9, 0xbc, 0x2c, 0x9b, 0xfc, 0xda, 0xe1, 0x38, 0x7b, 0x5c, 0x5f, 0x85, 0x3e,
-	0x4f, 0x94, 0xe9, 0xb6, 0x40, 0xb5, 0xa9, 0xc9, 0x7f, 0x4a, 0x60, 0x24, 0xe8, 0xe1, 0x86, 0x52,
-	0x53, 0x54, 0xe7, 0x4a, 0xb4, 0x06, 0xb6, 0x63, 0x29, 0x7e, 0x48, 0xe0, 0x7c, 0x02, 0x26, 0x0c,
-	0xc0, 0xfb, 0xd0, 0x2f, 0x7b, 0xc7, 0x6b, 0x26, 0x1e, 0xbb, 0x69, 0x9f, 0x88, 0x8b, 0x45, 0xd3,
-	0x94, 0x6b, 0x69, 0xe1, 0x5c, 0x23, 0x28, 0xdf, 0xfc, 0x51, 0xcc, 0x87, 0x7f, 0xb3, 0x4a, 0x79,
-	0x39, 0x7c, 0xd8, 0xb9, 0xfb, 0xf1, 0x05, 0x81, 0x0b, 0x41, 0xaa, 0x6f, 0xea, 0x65, 0x43, 0x97,
-	0x35, 0x5d, 0x3d, 0xf9, 0x3c, 0xfc, 0x4e, 0x60, 0x22, 0x0b, 0x38, 0x4c, 0x48, 0x19, 0xf2, 0x1b,
-	0xee, 0xef, 0xa1, 0x7c, 0x5c, 0x8c, 0xcb, 0x47, 0x84, 0x49, 0xbc, 0xa5, 0xd4, 0xb3, 0x76, 0x0c,
-	0x81, 0xaf, 0x63, 0x61, 0xf9, 0x53, 0xee, 0x05, 0x19, 0x53, 0x7e, 0x28, 0xc8, 0xde, 0x29, 0x0b,
-	0x72, 0x38, 0x17, 0x5d, 0x11, 0xb9, 0xb8, 0xfa, 0xf8, 0x47, 0xf7, 0x8a, 0xb9, 0x87, 0xf7, 0x8a,
-	0x39, 0x7e, 0x13, 0xfb, 0x56, 0xf8, 0x92, 0xd1, 0xf7, 0x20, 0x1f, 0x71, 0x95, 0xb1, 0xaa, 0x5b,
-	0xb8, 0xc9, 0x25, 0x1a, 0xbe, 0xac, 0xfc, 0x36, 0x14, 0x99, 0xdf, 0x88, 0x40, 0x1f, 0x37, 0xe5,
-	0x75, 0xec, 0x2d, 0x91, 0xae, 0x91, 0xfb, 0x12, 0xf4, 0x3a, 0x79, 0x46, 0xba, 0x6d, 0x5c, 0x14,
-	0x34, 0xc0, 0x7f, 0xe9, 0xf6, 0xb2, 0x1b, 0x2e, 0xec, 0xe8, 0x1a, 0xca, 0xc2, 0xb5, 0x43, 0x35,
-	0xe4, 0x0b, 0xc6, 0xcf, 0x6e, 0x57, 0x8b, 0x46, 0x87, 0xe1, 0xa8, 0x74, 0xac, 0xab, 0x39, 0xb1,
-	0x39, 0xde, 0xf6, 0xf5, 0x95, 0xdb, 0xbe, 0x3c, 0x4e, 0x29, 0xed, 0xeb, 0x64, 0x42, 0xef, 0x35,
-	0xb2, 0x14, 0x98, 0x8f, 0x62, 0x23, 0xfb, 0x87, 0xc0, 0x53, 0x8c, 0x5b, 0x49, 0x91, 0xdb, 0x0e,
-	0xf9, 0x24, 0x50, 0xcb, 0xac, 0xac, 0x45, 0x56, 0xf7, 0x59, 0xcb, 0xac, 0xac, 0x06, 0xde, 0x97,
-	0x49, 0xa0, 0xb2, 0x65, 0x1f, 0x96, 0xee, 0x76, 0xa4, 0x65, 0xcb, 0x5e, 0x4d, 0x78, 0x8d, 0x7a,
-	0x3a, 0x90, 0xce, 0x3d, 0x02, 0x5c, 0x14, 0x65, 0x4c, 0x9f, 0x06, 0x83, 0xa6, 0x92, 0x50, 0x44,
-	0x93, 0x71, 0x19, 0xf4, 0x9b, 0x3b, 0x54, 0x46, 0x03, 0xa6, 0x72, 0xdc, 0x73, 0x40, 0x31, 0x78,
-	0x43, 0xc3, 0x93, 0xf5, 0x89, 0x95, 0xcf, 0xf7, 0xa1, 0xbe, 0xfa, 0x48, 0xcc, 0xde, 0x5b, 0x50,
-	0x88, 0x41, 0x7d, 0xdc, 0xef, 0x5e, 0x35, 0x36, 0x99, 0x9d, 0x1e, 0xdf, 0x2f, 0x63, 0x25, 0xbc,
-	0xa6, 0x59, 0xb6, 0x61, 0x6a, 0x15, 0xa9, 0xb6, 0xa4, 0xdf, 0x36, 0x7c, 0xbb, 0x58, 0x55, 0xd1,
-	0xd4, 0xaa, 0xcd, 0x3c, 0x74, 0x97, 0xf0, 0x1b, 0xff, 0x0e, 0x9c, 0x8b, 0xd4, 0x42, 0x6c, 0x57,
-	0xa1, 0xa7, 0xaa, 0x59, 0x36, 0xc2, 0x1a, 0x8b, 0x83, 0x75, 0x48, 0x9b, 0xe9, 0xf0, 0x14, 0xce,
-	0x32, 0xd3, 0xcb, 0x86, 0x51, 0x43, 0x18, 0xfc, 0x2d, 0x78, 0xc2, 0x77, 0x86, 0x4e, 0x66, 0xa1,
-	0xa7, 0x6e, 0x18, 0x35, 0x74, 0x32, 0x1c, 0xe7, 0xa4, 0xa1, 0x83, 0xb4, 0x99, 0x3c, 0xdf, 0x0f,
-	0xd4, 0x31, 0x26, 0x99, 0xd2, 0xba, 0x5b, 0x1b, 0xfc, 0x1b, 0x90, 0x0f, 0x9c, 0xa2, 0x93, 0x6b,
-	0xd0, 0x5b, 0x67, 0x27, 0xe8, 0xa6, 0x10, 0xeb, 0x86, 0x49, 0xb9, 0xf3, 0x84, 0xa3, 0x33, 0xfd,
-	0xf7, 0x00, 0x3c, 0xc6, 0xac, 0xd2, 0xcf, 0x09, 0x40, 0xf3, 0xce, 0x53, 0x21, 0xce, 0x4c, 0xf4,
-	0x4e, 0xcc, 0x89, 0x99, 0xe5, 0x71, 0x66, 0x9b, 0xf8, 0xf0, 0x97, 0xbf, 0x3e, 0xeb, 0x1a, 0xa5,
-	0xbc, 0x18, 0xb3, 0x8d, 0xfb, 0xea, 0xe5, 0x6b, 0x02, 0x7d, 0x9e, 0x09, 0x7a, 0x29, 0x9b, 0x2b,
-	0x17, 0x99, 0x90, 0x55, 0x1c, 0x81, 0xbd, 0xc8, 0x80, 0x3d, 0x4f, 0x67, 0xd2, 0x81, 0x89, 0x3b,
-	0xc1, 0xa2, 0xd9, 0xa5, 0xbf, 0x12, 0xe8, 0x8f, 0x5a, 0xe9, 0xe8, 0x5c, 0x36, 0x14, 0xe1, 0x91,
-	0x82, 0x7b, 0xa1, 0x0d, 0x4d, 0xa4, 0x72, 0x93, 0x51, 0x99, 0xa7, 0x2f, 0xb5, 0x41, 0x45, 0xf4,
-	0xbd, 0x3b, 0xf4, 0x3f, 0x02, 0x4f, 0x27, 0x6e, 0x48, 0x74, 0x3e, 0x1b, 0xca, 0x84, 0xd9, 0x89,
-	0x5b, 0x38, 0x8a, 0x09, 0x64, 0xbc, 0xc2, 0x18, 0xdf, 0xa2, 0x4b, 0xed, 0x30, 0x6e, 0x4e, 0x44,
-	0x7e, 0xee, 0x3f, 0x12, 0x80, 0xa6, 0xab, 0x94, 0xc2, 0x08, 0x2d, 0x1e, 0x29, 0x85, 0x11, 0x1e,
-	0x6a, 0xf9, 0xb7, 0x19, 0x85, 0x12, 0x5d, 0x3e, 0x62, 0xd2, 0xc4, 0x9d, 0x60, 0xe3, 0xdf, 0xa5,
-	0xff, 0x12, 0xc8, 0x47, 0x44, 0x8f, 0x5e, 0x49, 0x84, 0x18, 0xbf, 0x54, 0x71, 0x73, 0xad, 0x2b,
-	0x22, 0xc9, 0x75, 0x46, 0x52, 0xa5, 0x4a, 0xa7, 0x49, 0x46, 0x26, 0x91, 0xfe, 0x44, 0xa0, 0x3f,
-	0x6a, 0x27, 0x49, 0x29, 0xcb, 0x84, 0x25, 0x2b, 0xa5, 0x2c, 0x93, 0x16, 0x20, 0xfe, 0x1a, 0x23,
-	0x3f, 0x4b, 0x2f, 0xc7, 0x91, 0x4f, 0xcc, 0x62, 0xa3, 0x16, 0x13, 0x87, 0xfc, 0x94, 0x5a, 0xcc,
-	0xb2, 0xc7, 0xa4, 0xd4, 0x62, 0xa6, 0x1d, 0x23, 0xbd, 0x16, 0x3d, 0x66, 0x19, 0xd3, 0x68, 0xd1,
-	0x1f, 0x08, 0x9c, 0x0e, 0x4c, 0xc4, 0x74, 0x2a, 0x11, 0x68, 0xd4, 0xc2, 0xc0, 0x4d, 0xb7, 0xa2,
-	0x82, 0x5c, 0x96, 0x18, 0x97, 0x57, 0xe8, 0x7c, 0x3b, 0x5c, 0xcc, 0x00, 0xe2, 0x3d, 0x02, 0xf9,
-	0x88, 0x29, 0x33, 0xa5, 0x0a, 0xe3, 0x87, 0x66, 0x6e, 0xae, 0x75, 0x45, 0x64, 0xb5, 0xc8, 0x58,
-	0xbd, 0x4c, 0xaf, 0xb7, 0xc3, 0xca, 0xf7, 0x3e, 0x3f, 0x20, 0x40, 0xc3, 0x7e, 0xe8, 0x6c, 0x8b,
-	0xc0, 0x5c, 0x42, 0x57, 0x5a, 0xd6, 0x43, 0x3e, 0x6f, 0x31, 0x3e, 0x2b, 0xf4, 0xf5, 0xa3, 0xf1,
-	0x09, 0x3f, 0xeb, 0xdf, 0x11, 0x38, 0x13, 0x9c, 0x05, 0x69, 0xf2, 0x2d, 0x8a, 0x1c, 0x56, 0xb9,
-	0x99, 0x96, 0x74, 0x90, 0xd4, 0x1c, 0x23, 0x35, 0x4d, 0x9f, 0x8b, 0x23, 0x55, 0xf5, 0xf4, 0xd6,
-	0x34, 0xfd, 0xb6, 0x21, 0xee, 0x38, 0x23, 0xf0, 0x2e, 0xfd, 0x80, 0x40, 0x4f, 0x63, 0xb8, 0xa4,
-	0xe3, 0x89, 0x7e, 0x7d, 0x73, 0x2c, 0x77, 0x21, 0x83, 0x24, 0xe2, 0x1a, 0x65, 0xb8, 0x0a, 0x74,
-	0x38, 0x0e, 0x57, 0x63, 0x96, 0xa5, 0x1f, 0x13, 0xe8, 0x75, 0x26, 0x4f, 0x3a, 0x91, 0x6c, 0xdb,
-	0x3f, 0xec, 0x72, 0x17, 0x33, 0xc9, 0x22, 0x92, 0x31, 0x86, 0x64, 0x84, 0x16, 0x62, 0x91, 0x38,
-	0xa3, 0xef, 0xe2, 0xfd, 0xfd, 0x02, 0xd9, 0xdb, 0x2f, 0x90, 0x3f, 0xf7, 0x0b, 0xe4, 0x93, 0x83,
-	0x42, 0x6e, 0xef, 0xa0, 0x90, 0xfb, 0xed, 0xa0, 0x90, 0x7b, 0x77, 0x52, 0xd5, 0xec, 0xea, 0x46,
-	0x59, 0xa8, 0x18, 0xeb, 0xae, 0x0d, 0xe7, 0xcf, 0x25, 0x4b, 0xbe, 0x23, 0x6e, 0x79, 0x06, 0xed,
-	0xed, 0xba, 0x62, 0x95, 0x7b, 0xd9, 0x3f, 0x88, 0x66, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x4b,
-	0x6e, 0xeb, 0x7f, 0xe4, 0x1a, 0x00, 0x00,
+	// 1531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xdf, 0x6f, 0x14, 0xd5,
+	0x17, 0xef, 0x85, 0x7e, 0xfb, 0x85, 0x43, 0x20, 0xe5, 0x6e, 0x29, 0x65, 0x28, 0xdb, 0x32, 0x01,
+	0x2c, 0xa5, 0xec, 0x48, 0xf9, 0x55, 0xf9, 0xa5, 0x2d, 0xd0, 0xd2, 0xe0, 0x8f, 0xb2, 0x46, 0x10,
+	0x7d, 0xd8, 0xcc, 0xee, 0x0e, 0xd3, 0x09, 0xdb, 0xbd, 0xcb, 0xcc, 0xb4, 0x01, 0x09, 0x89, 0xfa,
+	0xa4, 0x6f, 0x1a, 0x1f, 0x8c, 0x1a, 0x13, 0x1e, 0x4c, 0x4c, 0xf4, 0x4d, 0xfd, 0x07, 0x4c, 0x4c,
+	0xc4, 0xb7, 0x1a, 0x7d, 0xd0, 0x17, 0x24, 0xe0, 0x03, 0xbe, 0xf9, 0x66, 0x7c, 0x33, 0x7b, 0xe7,
+	0xcc, 0xec, 0xfc, 0xfe, 0xb1, 0xec, 0xa6, 0xe1, 0x89, 0xee, 0x9d, 0x7b, 0xce, 0xf9, 0x7c, 0xce,
+	0xb9, 0xe7, 0xde, 0xfb, 0xb9, 0x80, 0x58, 0x61, 0xc6, 0x12, 0x33, 0x24, 0xc3, 0x94, 0xaf, 0x6b,
+	0x75, 0x55, 0x5a, 0x39, 0x54, 0x56, 0x4c, 0xf9, 0x90, 0x74, 0x63, 0x59, 0xd1, 0x6f, 0x15, 0x1a,
+	0x3a, 0x33, 0x19, 0x1d, 0xb4, 0xe6, 0x14, 0x70, 0x4e, 0x01, 0xe7, 0x08, 0xe3, 0x68, 0x5b, 0x96,
+	0x0d, 0xc5, 0x32, 0x70, 0xcc, 0x1b, 0xb2, 0xaa, 0xd5, 0x65, 0x53, 0x63, 0x75, 0xcb, 0x87, 0x30,
+	0xa0, 0x32, 0x95, 0xf1, 0x3f, 0xa5, 0xe6, 0x5f, 0x38, 0x3a, 0xac, 0x32, 0xa6, 0xd6, 0x14, 0x49,
+	0x6e, 0x68, 0x92, 0x5c, 0xaf, 0x33, 0x93, 0x9b, 0x18, 0xf8, 0x75, 0x4f, 0x04, 0x36, 0x1b, 0x07,
+	0x9f, 0x25, 0xde, 0x84, 0xc1, 0x4b, 0xcd, 0xd8, 0x97, 0xe5, 0x9a, 0x56, 0x95, 0x4d, 0xa6, 0x1b,
+	0x45, 0xe5, 0xc6, 0xb2, 0x62, 0x98, 0x74, 0x10, 0xfa, 0x0c, 0x53, 0x36, 0x97, 0x8d, 0x21, 0x32,
+	0x4a, 0xc6, 0x36, 0x16, 0xf1, 0x17, 0x9d, 0x05, 0x68, 0xe1, 0x1b, 0x5a, 0x37, 0x4a, 0xc6, 0x36,
+	0x4d, 0xee, 0x2b, 0x20, 0xc9, 0x26, 0x99, 0x82, 0xc5, 0x1e, 0xe3, 0x15, 0x16, 0x64, 0x55, 0x41,
+	0x9f, 0x45, 0x97, 0xa5, 0xf8, 0x35, 0x81, 0xed, 0x81, 0xd0, 0x46, 0x83, 0xd5, 0x0d, 0x85, 0xce,
+	0x01, 0xac, 0x38, 0xa3, 0x43, 0x64, 0x74, 0xfd, 0xd8, 0xa6, 0xc9, 0xdd, 0x85, 0xf0, 0x44, 0x16,
+	0x1c, 0xfb, 0x99, 0xde, 0x7b, 0xf7, 0x47, 0x7a, 0x8a, 0x2e, 0xd3, 0xa6, 0xa3, 0x00, 0xd8, 0x67,
+	0x12, 0xc1, 0x5a, 0x28, 0x3c, 0x68, 0xcf, 0xc0, 0x36, 0x2f, 0x58, 0x3b, 0x4d, 0x7b, 0x61, 0x8b,
+	0x13, 0xaf, 0x24, 0x57, 0xab, 0x3a, 0xa6, 0x6b, 0xb3, 0x33, 0x3a, 0x5d, 0xad, 0xea, 0x62, 0xc9,
+	0x9f, 0x67, 0x87, 0xeb, 0x79, 0xd8, 0xe8, 0x4c, 0xe5, 0xb6, 0x19, 0xa8, 0xb6, 0x2c, 0xc5, 0x0f,
+	0x09, 0x8c, 0x7a, 0x23, 0x9c, 0x53, 0x6a, 0x8a, 0x6a, 0x2d, 0x89, 0x6c, 0x60, 0x3b, 0x56, 0xe2,
+	0xc7, 0x04, 0x76, 0xc7, 0x60, 0xc2, 0x04, 0xbc, 0x05, 0x03, 0x55, 0x67, 0xb8, 0xa4, 0xe3, 0xb0,
+	0x5d, 0xf6, 0xf1, 0xa8, 0x5c, 0xb4, 0x5c, 0xd9, 0x9e, 0x66, 0x76, 0x36, 0x93, 0xf2, 0xd5, 0x1f,
+	0x23, 0xb9, 0xe0, 0x37, 0xa3, 0x98, 0xab, 0x06, 0x07, 0x3b, 0xb7, 0x3e, 0x3e, 0x25, 0xb0, 0xdf,
+	0x4b, 0xf5, 0xb5, 0x7a, 0x99, 0xd5, 0xab, 0x5a, 0x5d, 0x5d, 0xfb, 0x3a, 0xfc, 0x4e, 0x60, 0x3c,
+	0x0d, 0x38, 0x2c, 0x48, 0x19, 0x72, 0xcb, 0xf6, 0xf7, 0x40, 0x3d, 0x0e, 0x44, 0xd5, 0x23, 0xc4,
+	0x25, 0xae, 0x52, 0xea, 0x78, 0xeb, 0x42, 0xe2, 0x1b, 0xd8, 0x58, 0xee, 0x92, 0x3b, 0x49, 0xc6,
+	0x92, 0xfb, 0x92, 0xec, 0x8c, 0xf2, 0x24, 0x07, 0x6b, 0xb1, 0x2e, 0xa4, 0x16, 0x27, 0x36, 0xbc,
+	0x77, 0x77, 0xa4, 0xe7, 0xf1, 0xdd, 0x91, 0x1e, 0x71, 0x05, 0xb6, 0x07, 0x22, 0x62, 0xe6, 0xde,
+	0x84, 0x5c, 0xc8, 0x52, 0xc6, 0xae, 0xce, 0xb0, 0x92, 0x8b, 0x34, 0xb8, 0x58, 0xc5, 0x5b, 0x30,
+	0xc2, 0xe3, 0x86, 0x24, 0xba, 0xdb, 0x94, 0x97, 0x60, 0x34, 0x3a, 0x34, 0x72, 0x9f, 0x87, 0x3e,
+	0xab, 0xce, 0x48, 0xb7, 0x8d, 0x85, 0x82, 0x0e, 0xc4, 0xcf, 0xec, 0xbd, 0xec, 0x9c, 0x0d, 0x3b,
+	0xbc, 0x87, 0xd2, 0x70, 0xed, 0x50, 0x0f, 0xb9, 0x92, 0xf1, 0xb3, 0xbd, 0xab, 0x85, 0xa3, 0xc3,
+	0x74, 0x54, 0x3a, 0xb6, 0xab, 0x59, 0xb9, 0xe9, 0xee, 0xf6, 0xf5, 0x85, 0xbd, 0x7d, 0x39, 0x9c,
+	0x12, 0xb6, 0xaf, 0xb5, 0x49, 0xbd, 0xb3, 0x91, 0x25, 0xc0, 0x7c, 0x1a, 0x37, 0xb2, 0xbf, 0x09,
+	0xec, 0xe0, 0xdc, 0x8a, 0x4a, 0xb5, 0xed, 0x94, 0x4f, 0x00, 0x35, 0xf4, 0x4a, 0x29, 0xb4, 0xbb,
+	0xfb, 0x0d, 0xbd, 0x72, 0xd9, 0x73, 0xbe, 0x4c, 0x00, 0xad, 0x1a, 0xa6, 0x7f, 0xf6, 0x7a, 0x6b,
+	0x76, 0xd5, 0x30, 0x2f, 0xc7, 0x9c, 0x46, 0xbd, 0x1d, 0x28, 0xe7, 0x2a, 0x01, 0x21, 0x8c, 0x32,
+	0x96, 0x4f, 0x83, 0x41, 0x5d, 0x89, 0x69, 0xa2, 0x89, 0xa8, 0x0a, 0xba, 0xdd, 0xf9, 0xda, 0x68,
+	0x9b, 0xae, 0x74, 0xfb, 0x1e, 0x30, 0xe2, 0x5d, 0xa1, 0xc1, 0x9b, 0xf5, 0x9a, 0xb5, 0xcf, 0x77,
+	0x81, 0x7d, 0xf5, 0xa9, 0xb8, 0x7b, 0xdf, 0x84, 0x7c, 0x04, 0xea, 0x6e, 0x9f, 0x7b, 0x8b, 0x91,
+	0xc5, 0xec, 0xf4, 0xf5, 0xfd, 0x08, 0x76, 0xc2, 0x05, 0xcd, 0x30, 0x99, 0xae, 0x55, 0xe4, 0xda,
+	0x7c, 0xfd, 0x1a, 0x73, 0x69, 0xb1, 0x45, 0x45, 0x53, 0x17, 0x4d, 0x1e, 0x61, 0x7d, 0x11, 0x7f,
+	0x89, 0x57, 0x61, 0x67, 0xa8, 0x15, 0x62, 0x3b, 0x01, 0xbd, 0x8b, 0x9a, 0x61, 0x0e, 0x11, 0xef,
+	0xda, 0xf1, 0xc3, 0xf2, 0x59, 0x73, 0x1b, 0x91, 0x42, 0x3f, 0x77, 0xbd, 0xc0, 0x58, 0x0d, 0x61,
+	0x88, 0x17, 0x61, 0xab, 0x6b, 0x0c, 0x83, 0x1c, 0x83, 0xde, 0x06, 0x63, 0x35, 0x0c, 0x32, 0x1c,
+	0x15, 0xa4, 0x69, 0x83, 0xb4, 0xf9, 0x7c, 0x71, 0x00, 0xa8, 0xe5, 0x4c, 0xd6, 0xe5, 0x25, 0xbb,
+	0x37, 0xc4, 0x57, 0x21, 0xe7, 0x19, 0xc5, 0x20, 0xa7, 0xa0, 0xaf, 0xc1, 0x47, 0x30, 0x4c, 0x3e,
+	0x32, 0x0c, 0x9f, 0x65, 0xdf, 0x27, 0x2c, 0x1b, 0xf1, 0x65, 0xd8, 0xeb, 0xbd, 0xfe, 0xce, 0xb1,
+	0x95, 0x05, 0x59, 0x37, 0xb5, 0x8a, 0xd6, 0xf0, 0xdf, 0x9f, 0xd2, 0x88, 0xb9, 0xcf, 0x09, 0xec,
+	0x4b, 0x72, 0xe8, 0xdc, 0x08, 0xb7, 0xaa, 0x6c, 0xa5, 0xd4, 0x70, 0x7f, 0x44, 0x0e, 0x63, 0x51,
+	0x1c, 0xfc, 0xce, 0x90, 0x4d, 0xbf, 0xea, 0x1b, 0x6f, 0x2e, 0x0b, 0xb9, 0x6c, 0x28, 0x75, 0x93,
+	0xaf, 0xe3, 0x0d, 0x45, 0xfc, 0x25, 0xae, 0xc0, 0xb0, 0xef, 0x86, 0xfa, 0x22, 0xab, 0x5c, 0x5f,
+	0x6e, 0x74, 0xbb, 0x5d, 0x54, 0xd8, 0x15, 0x11, 0x17, 0xb3, 0x31, 0x0b, 0x7d, 0x35, 0x3e, 0x92,
+	0x94, 0x02, 0xbf, 0x07, 0xbb, 0xa0, 0x96, 0xb5, 0xf8, 0x31, 0xf1, 0x32, 0x64, 0xba, 0x35, 0x6d,
+	0xed, 0xb7, 0xd8, 0x6f, 0x08, 0xec, 0x8a, 0x40, 0x86, 0x39, 0xb8, 0x00, 0xff, 0xb7, 0x58, 0xd8,
+	0x9b, 0x6b, 0xd6, 0x24, 0xd8, 0xe6, 0x1d, 0xdb, 0x60, 0x27, 0xdf, 0xde, 0x09, 0xff, 0xe3, 0xa0,
+	0xe9, 0x27, 0x04, 0xa0, 0x75, 0x26, 0xd0, 0x42, 0x14, 0xb4, 0xf0, 0x37, 0x23, 0x41, 0x4a, 0x3d,
+	0x1f, 0x35, 0xcd, 0xf8, 0xbb, 0xbf, 0xfc, 0xf9, 0xd1, 0xba, 0x3d, 0x54, 0x94, 0x22, 0x5e, 0xab,
+	0x5c, 0xe7, 0xc9, 0x97, 0x04, 0x36, 0x3a, 0x2e, 0xe8, 0xc1, 0x74, 0xa1, 0x6c, 0x64, 0x85, 0xb4,
+	0xd3, 0x11, 0xd8, 0x49, 0x0e, 0xec, 0x28, 0x3d, 0x9c, 0x0c, 0x4c, 0xba, 0xed, 0xed, 0x92, 0x3b,
+	0xf4, 0x57, 0x02, 0x03, 0x61, 0x4f, 0x1e, 0x74, 0x2a, 0x1d, 0x8a, 0xe0, 0x95, 0x5b, 0x78, 0xae,
+	0x0d, 0x4b, 0xa4, 0x32, 0xc7, 0xa9, 0x4c, 0xd3, 0xe7, 0xdb, 0xa0, 0x22, 0xb9, 0xee, 0x65, 0xf4,
+	0x5f, 0x02, 0xbb, 0x62, 0x5f, 0x10, 0xe8, 0x74, 0x3a, 0x94, 0x31, 0xda, 0x42, 0x98, 0x79, 0x12,
+	0x17, 0xc8, 0xf8, 0x12, 0x67, 0x7c, 0x91, 0xce, 0xb7, 0xc3, 0xb8, 0xa5, 0x18, 0xdc, 0xdc, 0x7f,
+	0x24, 0x00, 0xad, 0x50, 0x09, 0x8d, 0x11, 0x10, 0xe6, 0x82, 0x94, 0x7a, 0x3e, 0x52, 0x78, 0x9d,
+	0x53, 0x28, 0xd2, 0x85, 0x27, 0x2c, 0x9a, 0x74, 0xdb, 0xbb, 0x0f, 0xde, 0xa1, 0xff, 0x10, 0xc8,
+	0x85, 0x64, 0x8f, 0x1e, 0x8f, 0x85, 0x18, 0xfd, 0xe8, 0x20, 0x4c, 0x65, 0x37, 0x44, 0x92, 0x4b,
+	0x9c, 0xa4, 0x4a, 0x95, 0x4e, 0x93, 0x0c, 0x2d, 0x22, 0xfd, 0x89, 0xc0, 0x40, 0x98, 0x66, 0x4f,
+	0x68, 0xcb, 0x98, 0x47, 0x88, 0x84, 0xb6, 0x8c, 0x7b, 0x20, 0x10, 0x4f, 0x71, 0xf2, 0xc7, 0xe8,
+	0x91, 0x28, 0xf2, 0xb1, 0x55, 0x6c, 0xf6, 0x62, 0xac, 0x08, 0x4e, 0xe8, 0xc5, 0x34, 0x3a, 0x3f,
+	0xa1, 0x17, 0x53, 0x69, 0xf0, 0xe4, 0x5e, 0x74, 0x98, 0xa5, 0x2c, 0xa3, 0x41, 0xbf, 0x27, 0xb0,
+	0xd9, 0xa3, 0x18, 0xe9, 0xa1, 0x58, 0xa0, 0x61, 0x82, 0x5a, 0x98, 0xcc, 0x62, 0x82, 0x5c, 0xe6,
+	0x39, 0x97, 0xb3, 0x74, 0xba, 0x1d, 0x2e, 0xba, 0x07, 0xf1, 0x2a, 0x81, 0x5c, 0x88, 0x0a, 0x4b,
+	0xe8, 0xc2, 0x68, 0x51, 0x29, 0x4c, 0x65, 0x37, 0x44, 0x56, 0xb3, 0x9c, 0xd5, 0x0b, 0xf4, 0x4c,
+	0x3b, 0xac, 0x5c, 0xe7, 0xf3, 0x7d, 0x02, 0x34, 0x18, 0x87, 0x1e, 0xcb, 0x08, 0xcc, 0x26, 0x74,
+	0x3c, 0xb3, 0x1d, 0xf2, 0xb9, 0xc2, 0xf9, 0x5c, 0xa2, 0xaf, 0x3c, 0x19, 0x9f, 0xe0, 0xb1, 0xfe,
+	0x2d, 0x81, 0x2d, 0x5e, 0xad, 0x44, 0xe3, 0x57, 0x51, 0xa8, 0x98, 0x13, 0x0e, 0x67, 0xb2, 0x41,
+	0x52, 0x53, 0x9c, 0xd4, 0x24, 0x7d, 0x36, 0x8a, 0xd4, 0xa2, 0x63, 0x57, 0xd2, 0xea, 0xd7, 0x98,
+	0x74, 0xdb, 0x92, 0x88, 0x77, 0xe8, 0x3b, 0x04, 0x7a, 0x9b, 0xe2, 0x8b, 0x8e, 0xc5, 0xc6, 0x75,
+	0xe9, 0x3c, 0x61, 0x7f, 0x8a, 0x99, 0x88, 0x6b, 0x0f, 0xc7, 0x95, 0xa7, 0xc3, 0x51, 0xb8, 0x9a,
+	0x5a, 0x8f, 0xbe, 0x4f, 0xa0, 0xcf, 0x52, 0x66, 0x74, 0x3c, 0xde, 0xb7, 0x5b, 0x0c, 0x0a, 0x07,
+	0x52, 0xcd, 0x45, 0x24, 0xfb, 0x38, 0x92, 0x51, 0x9a, 0x8f, 0x44, 0x62, 0x01, 0xf8, 0x8b, 0xc0,
+	0x8e, 0x48, 0xdd, 0x46, 0x4f, 0xa7, 0xbb, 0x7e, 0x44, 0x08, 0x48, 0xe1, 0x4c, 0xbb, 0xe6, 0x48,
+	0xe2, 0x25, 0x4e, 0x62, 0x8e, 0x9e, 0x6f, 0xe7, 0x44, 0x0c, 0x08, 0x4d, 0xfa, 0x80, 0x40, 0xbf,
+	0x5f, 0x45, 0xd0, 0x23, 0x29, 0xef, 0x22, 0x1e, 0xcd, 0x28, 0x1c, 0xcd, 0x68, 0x85, 0x84, 0x4a,
+	0x9c, 0xd0, 0x55, 0x7a, 0xa5, 0xe3, 0x47, 0xbc, 0xa5, 0x82, 0xe8, 0x0f, 0x2d, 0x8a, 0x8e, 0xd6,
+	0x4a, 0x47, 0xd1, 0x2f, 0x1a, 0x85, 0xa3, 0x19, 0xad, 0x90, 0xe2, 0x59, 0x4e, 0xf1, 0x34, 0x3d,
+	0xd9, 0xce, 0x7e, 0x63, 0xb1, 0x30, 0x66, 0x66, 0xef, 0x3d, 0xcc, 0x93, 0xd5, 0x87, 0x79, 0xf2,
+	0xe0, 0x61, 0x9e, 0x7c, 0xf0, 0x28, 0xdf, 0xb3, 0xfa, 0x28, 0xdf, 0xf3, 0xdb, 0xa3, 0x7c, 0xcf,
+	0x1b, 0x13, 0xaa, 0x66, 0x2e, 0x2e, 0x97, 0x0b, 0x15, 0xb6, 0x64, 0x07, 0xb0, 0xfe, 0x39, 0x68,
+	0x54, 0xaf, 0x4b, 0x37, 0x9d, 0x68, 0xe6, 0xad, 0x86, 0x62, 0x94, 0xfb, 0xf8, 0x7f, 0xeb, 0x1f,
+	0xfe, 0x6f, 0x00, 0xd2, 0x2c, 0x5f, 0xf7, 0x9a, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1554,6 +1867,13 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ValidatorGovParticipation queries the governance participation of a
+	// validator.
+	ValidatorGovParticipation(ctx context.Context, in *QueryValidatorGovParticipationRequest, opts ...grpc.CallOption) (*QueryValidatorGovParticipationResponse, error)
+	// DelegationLockup queries the lockup of a delegation.
+	DelegationLockup(ctx context.Context, in *QueryDelegationLockupRequest, opts ...grpc.CallOption) (*QueryDelegationLockupResponse, error)
+	// DelegatorLockups queries the lockups of the delegations of a delegator.
+	DelegatorLockups(ctx context.Context, in *QueryDelegatorLockupsRequest, opts ...grpc.CallOption) (*QueryDelegatorLockupsResponse, error)
 }
 
 type queryClient struct {
@@ -1690,6 +2010,33 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ValidatorGovParticipation(ctx context.Context, in *QueryValidatorGovParticipationRequest, opts ...grpc.CallOption) (*QueryValidatorGovParticipationResponse, error) {
+	out := new(QueryValidatorGovParticipationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/ValidatorGovParticipation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegationLockup(ctx context.Context, in *QueryDelegationLockupRequest, opts ...grpc.CallOption) (*QueryDelegationLockupResponse, error) {
+	out := new(QueryDelegationLockupResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/DelegationLockup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorLockups(ctx context.Context, in *QueryDelegatorLockupsRequest, opts ...grpc.CallOption) (*QueryDelegatorLockupsResponse, error) {
+	out := new(QueryDelegatorLockupsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/DelegatorLockups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Validators queries all validators that match the given status.
@@ -1724,6 +2071,13 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ValidatorGovParticipation queries the governance participation of a
+	// validator.
+	ValidatorGovParticipation(context.Context, *QueryValidatorGovParticipationRequest) (*QueryValidatorGovParticipationResponse, error)
+	// DelegationLockup queries the lockup of a delegation.
+	DelegationLockup(context.Context, *QueryDelegationLockupRequest) (*QueryDelegationLockupResponse, error)
+	// DelegatorLockups queries the lockups of the delegations of a delegator.
+	DelegatorLockups(context.Context, *QueryDelegatorLockupsRequest) (*QueryDelegatorLockupsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1772,6 +2126,15 @@ func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorGovParticipation(ctx context.Context, req *QueryValidatorGovParticipationRequest) (*QueryValidatorGovParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorGovParticipation not implemented")
+}
+func (*UnimplementedQueryServer) DelegationLockup(ctx context.Context, req *QueryDelegationLockupRequest) (*QueryDelegationLockupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationLockup not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorLockups(ctx context.Context, req *QueryDelegatorLockupsRequest) (*QueryDelegatorLockupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorLockups not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2029,6 +2392,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorGovParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorGovParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorGovParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/ValidatorGovParticipation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorGovParticipation(ctx, req.(*QueryValidatorGovParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationLockup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationLockupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationLockup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/DelegationLockup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationLockup(ctx, req.(*QueryDelegationLockupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorLockups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorLockupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorLockups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/DelegatorLockups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorLockups(ctx, req.(*QueryDelegatorLockupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2089,6 +2506,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ValidatorGovParticipation",
+			Handler:    _Query_ValidatorGovParticipation_Handler,
+		},
+		{
+			MethodName: "DelegationLockup",
+			Handler:    _Query_DelegationLockup_Handler,
+		},
+		{
+			MethodName: "DelegatorLockups",
+			Handler:    _Query_DelegatorLockups_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
@@ -3195,20 +3624,254 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryValidatorGovParticipationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryValidatorGovParticipationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorGovParticipationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorGovParticipationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorGovParticipationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorGovParticipationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Absent {
+		i--
+		if m.Absent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.GovParticipation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationLockupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationLockupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationLockupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationLockupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationLockupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationLockupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Lockup.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorLockupsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorLockupsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorLockupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorLockupsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorLockupsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorLockupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lockups) > 0 {
+		for iNdEx := len(m.Lockups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lockups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -3641,34 +4304,125 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryValidatorGovParticipationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryValidatorGovParticipationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GovParticipation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Absent {
+		n += 2
+	}
+	return n
 }
-func (m *QueryValidatorsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
+
+func (m *QueryDelegationLockupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationLockupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Lockup.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegatorLockupsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorLockupsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lockups) > 0 {
+		for _, e := range m.Lockups {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
 		if wireType == 4 {
 			return fmt.Errorf("proto: QueryValidatorsRequest: wiretype end group for non-group")
 		}
@@ -6030,8 +6784,429 @@ func (m *QueryDelegatorValidatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoricalInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoricalInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hist == nil {
+				m.Hist = &HistoricalInfo{}
+			}
+			if err := m.Hist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6053,7 +7228,7 @@ func (m *QueryDelegatorValidatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6076,15 +7251,15 @@ func (m *QueryDelegatorValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6111,7 +7286,7 @@ func (m *QueryDelegatorValidatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6136,7 +7311,7 @@ func (m *QueryDelegatorValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHistoricalInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorGovParticipationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6159,17 +7334,17 @@ func (m *QueryHistoricalInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHistoricalInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorGovParticipationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHistoricalInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorGovParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6179,11 +7354,24 @@ func (m *QueryHistoricalInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6205,7 +7393,7 @@ func (m *QueryHistoricalInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHistoricalInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorGovParticipationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6228,15 +7416,15 @@ func (m *QueryHistoricalInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHistoricalInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorGovParticipationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHistoricalInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorGovParticipationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovParticipation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6263,13 +7451,30 @@ func (m *QueryHistoricalInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hist == nil {
-				m.Hist = &HistoricalInfo{}
-			}
-			if err := m.Hist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GovParticipation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Absent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Absent = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6291,7 +7496,7 @@ func (m *QueryHistoricalInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationLockupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6314,12 +7519,76 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationLockupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationLockupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6341,7 +7610,7 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationLockupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6364,15 +7633,15 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationLockupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationLockupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lockup", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6399,7 +7668,7 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Lockup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6424,7 +7693,7 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorLockupsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6447,12 +7716,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorLockupsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorLockupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6474,7 +7811,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorLockupsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6497,15 +7834,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorLockupsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorLockupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lockups", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6532,7 +7869,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Lockups = append(m.Lockups, DelegationLockup{})
+			if err := m.Lockups[len(m.Lockups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
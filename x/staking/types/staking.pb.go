@@ -844,6 +844,18 @@ type Params struct {
 	HistoricalEntries uint32 `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
 	// bond_denom defines the bondable coin denomination.
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
+	// max_missed_gov_proposals is the number of consecutive governance proposals
+	// a bonded validator may not vote on before being flagged as absent from
+	// governance. Zero disables the flag.
+	MaxMissedGovProposals uint32 `protobuf:"varint,6,opt,name=max_missed_gov_proposals,json=maxMissedGovProposals,proto3" json:"max_missed_gov_proposals,omitempty" yaml:"max_missed_gov_proposals"`
+	// validator_set_epoch_length is the number of blocks of the epochs at the
+	// boundaries of which a change of max_validators takes effect. Zero applies
+	// the changes at the end of the block they are made in.
+	ValidatorSetEpochLength uint64 `protobuf:"varint,7,opt,name=validator_set_epoch_length,json=validatorSetEpochLength,proto3" json:"validator_set_epoch_length,omitempty" yaml:"validator_set_epoch_length"`
+	// lockup_tiers are the durations a delegator may lock a delegation for, in
+	// exchange for a multiplier of its distribution rewards. No tiers disable
+	// the lockups.
+	LockupTiers []LockupTier `protobuf:"bytes,8,rep,name=lockup_tiers,json=lockupTiers,proto3" json:"lockup_tiers" yaml:"lockup_tiers"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -913,6 +925,27 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetMaxMissedGovProposals() uint32 {
+	if m != nil {
+		return m.MaxMissedGovProposals
+	}
+	return 0
+}
+
+func (m *Params) GetValidatorSetEpochLength() uint64 {
+	if m != nil {
+		return m.ValidatorSetEpochLength
+	}
+	return 0
+}
+
+func (m *Params) GetLockupTiers() []LockupTier {
+	if m != nil {
+		return m.LockupTiers
+	}
+	return nil
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1109,6 +1142,231 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// ConsPubKeyRotation records the rotation of a validator's consensus public
+// key. It is kept for an unbonding period after the rotation, during which
+// the old consensus address still resolves to the validator so that
+// infractions committed with the old key can be punished.
+type ConsPubKeyRotation struct {
+	OperatorAddress string      `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
+	OldConsPubkey   *types1.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty" yaml:"old_cons_pubkey"`
+	NewConsPubkey   *types1.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty" yaml:"new_cons_pubkey"`
+	// height is the height at which the rotation happened.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the time at which the rotation happened.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *ConsPubKeyRotation) Reset()         { *m = ConsPubKeyRotation{} }
+func (m *ConsPubKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotation) ProtoMessage()    {}
+func (*ConsPubKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
+}
+func (m *ConsPubKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsPubKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsPubKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsPubKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotation.Merge(m, src)
+}
+func (m *ConsPubKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsPubKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsPubKeyRotation proto.InternalMessageInfo
+
+// GovParticipation tracks the number of consecutive governance proposals whose
+// voting period ended while a validator was bonded and had not voted on them.
+type GovParticipation struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	MissedProposals  uint32 `protobuf:"varint,2,opt,name=missed_proposals,json=missedProposals,proto3" json:"missed_proposals,omitempty" yaml:"missed_proposals"`
+}
+
+func (m *GovParticipation) Reset()         { *m = GovParticipation{} }
+func (m *GovParticipation) String() string { return proto.CompactTextString(m) }
+func (*GovParticipation) ProtoMessage()    {}
+func (*GovParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
+}
+func (m *GovParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovParticipation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovParticipation.Merge(m, src)
+}
+func (m *GovParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovParticipation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovParticipation proto.InternalMessageInfo
+
+// ValidatorGovVote records that a validator voted on a governance proposal
+// whose voting period has not ended yet.
+type ValidatorGovVote struct {
+	ProposalId       uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+}
+
+func (m *ValidatorGovVote) Reset()         { *m = ValidatorGovVote{} }
+func (m *ValidatorGovVote) String() string { return proto.CompactTextString(m) }
+func (*ValidatorGovVote) ProtoMessage()    {}
+func (*ValidatorGovVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
+}
+func (m *ValidatorGovVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorGovVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorGovVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorGovVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorGovVote.Merge(m, src)
+}
+func (m *ValidatorGovVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorGovVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorGovVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorGovVote proto.InternalMessageInfo
+
+// LockupTier defines a duration a delegation can be locked for, and the
+// multiplier applied to the distribution rewards of the delegation while it is
+// locked.
+type LockupTier struct {
+	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
+	// reward_multiplier is the multiplier of the distribution rewards of the
+	// locked delegations, at least one.
+	RewardMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=reward_multiplier,json=rewardMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_multiplier" yaml:"reward_multiplier"`
+}
+
+func (m *LockupTier) Reset()         { *m = LockupTier{} }
+func (m *LockupTier) String() string { return proto.CompactTextString(m) }
+func (*LockupTier) ProtoMessage()    {}
+func (*LockupTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
+}
+func (m *LockupTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockupTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockupTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockupTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockupTier.Merge(m, src)
+}
+func (m *LockupTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockupTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockupTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockupTier proto.InternalMessageInfo
+
+func (m *LockupTier) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// DelegationLockup records that a delegation is locked until end_time: it can
+// be neither unbonded nor redelegated, and its distribution rewards are
+// multiplied by reward_multiplier.
+type DelegationLockup struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// duration is the duration of the lockup tier the delegation is locked for.
+	Duration time.Duration `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration"`
+	// reward_multiplier is the multiplier of the lockup tier when the delegation
+	// was locked.
+	RewardMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=reward_multiplier,json=rewardMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_multiplier" yaml:"reward_multiplier"`
+	// end_time is the time at which the lockup ends.
+	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+}
+
+func (m *DelegationLockup) Reset()         { *m = DelegationLockup{} }
+func (m *DelegationLockup) String() string { return proto.CompactTextString(m) }
+func (*DelegationLockup) ProtoMessage()    {}
+func (*DelegationLockup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
+}
+func (m *DelegationLockup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationLockup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationLockup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationLockup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationLockup.Merge(m, src)
+}
+func (m *DelegationLockup) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationLockup) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationLockup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationLockup proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
@@ -1131,6 +1389,11 @@ func init() {
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
+	proto.RegisterType((*ConsPubKeyRotation)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotation")
+	proto.RegisterType((*GovParticipation)(nil), "cosmos.staking.v1beta1.GovParticipation")
+	proto.RegisterType((*ValidatorGovVote)(nil), "cosmos.staking.v1beta1.ValidatorGovVote")
+	proto.RegisterType((*LockupTier)(nil), "cosmos.staking.v1beta1.LockupTier")
+	proto.RegisterType((*DelegationLockup)(nil), "cosmos.staking.v1beta1.DelegationLockup")
 }
 
 func init() {
@@ -1138,120 +1401,145 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1796 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4d, 0x6c, 0x23, 0x49,
-	0x15, 0x76, 0x3b, 0x5e, 0xc7, 0x7e, 0x4e, 0xe2, 0xa4, 0x26, 0x33, 0xeb, 0x98, 0xc1, 0xed, 0x6d,
-	0x56, 0x4b, 0x40, 0xbb, 0x0e, 0x93, 0x45, 0x8b, 0xc8, 0x05, 0xc6, 0x71, 0x86, 0x58, 0xbb, 0x0c,
-	0xa1, 0x93, 0x09, 0x12, 0xac, 0xb0, 0xca, 0xdd, 0x15, 0xa7, 0x89, 0xbb, 0xdb, 0x74, 0x95, 0x87,
-	0x58, 0xda, 0x03, 0xc7, 0x65, 0x10, 0x62, 0xb9, 0xed, 0x65, 0xa4, 0x91, 0xf6, 0xba, 0x12, 0x17,
-	0xc4, 0x95, 0xeb, 0x02, 0x97, 0xe1, 0x86, 0x10, 0x32, 0x68, 0xe6, 0x82, 0x38, 0x21, 0x1f, 0x10,
-	0x37, 0x50, 0xfd, 0xf4, 0x4f, 0xda, 0xf1, 0xcc, 0x78, 0xb4, 0x87, 0x91, 0xd8, 0x4b, 0xe2, 0x7a,
-	0xf5, 0xde, 0xf7, 0xea, 0xfd, 0xd6, 0xab, 0x86, 0x57, 0x2d, 0x9f, 0xba, 0x3e, 0xdd, 0xa2, 0x0c,
-	0x9f, 0x39, 0x5e, 0x6f, 0xeb, 0xee, 0x8d, 0x2e, 0x61, 0xf8, 0x46, 0xb8, 0x6e, 0x0c, 0x02, 0x9f,
-	0xf9, 0xe8, 0x9a, 0xe4, 0x6a, 0x84, 0x54, 0xc5, 0x55, 0x5d, 0xef, 0xf9, 0x3d, 0x5f, 0xb0, 0x6c,
-	0xf1, 0x5f, 0x92, 0xbb, 0xba, 0xd1, 0xf3, 0xfd, 0x5e, 0x9f, 0x6c, 0x89, 0x55, 0x77, 0x78, 0xb2,
-	0x85, 0xbd, 0x91, 0xda, 0xaa, 0xa5, 0xb7, 0xec, 0x61, 0x80, 0x99, 0xe3, 0x7b, 0x6a, 0x5f, 0x4f,
-	0xef, 0x33, 0xc7, 0x25, 0x94, 0x61, 0x77, 0x10, 0x62, 0xcb, 0x93, 0x74, 0xa4, 0x52, 0x75, 0x2c,
-	0x85, 0xad, 0x4c, 0xe9, 0x62, 0x4a, 0x22, 0x3b, 0x2c, 0xdf, 0x09, 0xb1, 0xaf, 0x33, 0xe2, 0xd9,
-	0x24, 0x70, 0x1d, 0x8f, 0x6d, 0xb1, 0xd1, 0x80, 0x50, 0xf9, 0x57, 0xee, 0x1a, 0x3f, 0xd3, 0x60,
-	0x65, 0xdf, 0xa1, 0xcc, 0x0f, 0x1c, 0x0b, 0xf7, 0xdb, 0xde, 0x89, 0x8f, 0xde, 0x82, 0xfc, 0x29,
-	0xc1, 0x36, 0x09, 0x2a, 0x5a, 0x5d, 0xdb, 0x2c, 0x6d, 0x57, 0x1a, 0x31, 0x42, 0x43, 0xca, 0xee,
-	0x8b, 0xfd, 0x66, 0xee, 0x93, 0xb1, 0x9e, 0x31, 0x15, 0x37, 0xfa, 0x06, 0xe4, 0xef, 0xe2, 0x3e,
-	0x25, 0xac, 0x92, 0xad, 0x2f, 0x6c, 0x96, 0xb6, 0x5f, 0x69, 0x5c, 0xee, 0xbe, 0xc6, 0x31, 0xee,
-	0x3b, 0x36, 0x66, 0x7e, 0x04, 0x20, 0xc5, 0x8c, 0x5f, 0x67, 0xa1, 0xbc, 0xeb, 0xbb, 0xae, 0x43,
-	0xa9, 0xe3, 0x7b, 0x26, 0x66, 0x84, 0xa2, 0x26, 0xe4, 0x02, 0xcc, 0x88, 0x38, 0x4a, 0xb1, 0xd9,
-	0xe0, 0xfc, 0x7f, 0x19, 0xeb, 0xaf, 0xf5, 0x1c, 0x76, 0x3a, 0xec, 0x36, 0x2c, 0xdf, 0x55, 0xce,
-	0x50, 0xff, 0xde, 0xa0, 0xf6, 0x99, 0xb2, 0xaf, 0x45, 0x2c, 0x53, 0xc8, 0xa2, 0x77, 0xa1, 0xe0,
-	0xe2, 0xf3, 0x8e, 0xc0, 0xc9, 0x0a, 0x9c, 0x9b, 0xf3, 0xe1, 0x4c, 0xc6, 0x7a, 0x79, 0x84, 0xdd,
-	0xfe, 0x8e, 0x11, 0xe2, 0x18, 0xe6, 0xa2, 0x8b, 0xcf, 0xf9, 0x11, 0xd1, 0x00, 0xca, 0x9c, 0x6a,
-	0x9d, 0x62, 0xaf, 0x47, 0xa4, 0x92, 0x05, 0xa1, 0x64, 0x7f, 0x6e, 0x25, 0xd7, 0x62, 0x25, 0x09,
-	0x38, 0xc3, 0x5c, 0x76, 0xf1, 0xf9, 0xae, 0x20, 0x70, 0x8d, 0x3b, 0x85, 0x0f, 0x1f, 0xe8, 0x99,
-	0x7f, 0x3c, 0xd0, 0x35, 0xe3, 0x4f, 0x1a, 0x40, 0xec, 0x31, 0xf4, 0x2e, 0xac, 0x5a, 0xd1, 0x4a,
-	0xc8, 0x52, 0x15, 0xc3, 0x2f, 0xce, 0x8a, 0x45, 0xca, 0xdf, 0xcd, 0x02, 0x3f, 0xf4, 0xc3, 0xb1,
-	0xae, 0x99, 0x65, 0x2b, 0x15, 0x8a, 0x1f, 0x40, 0x69, 0x38, 0xb0, 0x31, 0x23, 0x1d, 0x9e, 0x9d,
-	0xc2, 0x93, 0xa5, 0xed, 0x6a, 0x43, 0xa6, 0x6e, 0x23, 0x4c, 0xdd, 0xc6, 0x51, 0x98, 0xba, 0xcd,
-	0x1a, 0xc7, 0x9a, 0x8c, 0x75, 0x24, 0xcd, 0x4a, 0x08, 0x1b, 0x1f, 0xfc, 0x4d, 0xd7, 0x4c, 0x90,
-	0x14, 0x2e, 0x90, 0xb0, 0xe9, 0xf7, 0x1a, 0x94, 0x5a, 0x84, 0x5a, 0x81, 0x33, 0xe0, 0x15, 0x82,
-	0x2a, 0xb0, 0xe8, 0xfa, 0x9e, 0x73, 0xa6, 0xf2, 0xb1, 0x68, 0x86, 0x4b, 0x54, 0x85, 0x82, 0x63,
-	0x13, 0x8f, 0x39, 0x6c, 0x24, 0xe3, 0x6a, 0x46, 0x6b, 0x2e, 0xf5, 0x13, 0xd2, 0xa5, 0x4e, 0x18,
-	0x0d, 0x33, 0x5c, 0xa2, 0x5b, 0xb0, 0x4a, 0x89, 0x35, 0x0c, 0x1c, 0x36, 0xea, 0x58, 0xbe, 0xc7,
-	0xb0, 0xc5, 0x2a, 0x39, 0x11, 0xb0, 0xcf, 0x4d, 0xc6, 0xfa, 0xcb, 0xf2, 0xac, 0x69, 0x0e, 0xc3,
-	0x2c, 0x87, 0xa4, 0x5d, 0x49, 0xe1, 0x1a, 0x6c, 0xc2, 0xb0, 0xd3, 0xa7, 0x95, 0x97, 0xa4, 0x06,
-	0xb5, 0x4c, 0xd8, 0xf2, 0xf1, 0x22, 0x14, 0xa3, 0x6c, 0xe7, 0x9a, 0xfd, 0x01, 0x09, 0xf8, 0xef,
-	0x0e, 0xb6, 0xed, 0x80, 0x50, 0xaa, 0xf2, 0x3a, 0xa1, 0x39, 0xcd, 0x61, 0x98, 0xe5, 0x90, 0x74,
-	0x53, 0x52, 0x10, 0xe3, 0x61, 0xf6, 0x28, 0xf1, 0xe8, 0x90, 0x76, 0x06, 0xc3, 0xee, 0x19, 0x19,
-	0xa9, 0x68, 0xac, 0x4f, 0x45, 0xe3, 0xa6, 0x37, 0x6a, 0xbe, 0x19, 0xa3, 0xa7, 0xe5, 0x8c, 0x3f,
-	0xfc, 0xe6, 0x8d, 0x75, 0x95, 0x1a, 0x56, 0x30, 0x1a, 0x30, 0xbf, 0x71, 0x30, 0xec, 0xbe, 0x4d,
-	0x46, 0x3c, 0xfc, 0x8a, 0xf5, 0x40, 0x70, 0xa2, 0x6b, 0x90, 0xff, 0x11, 0x76, 0xfa, 0xc4, 0x16,
-	0x0e, 0x2d, 0x98, 0x6a, 0x85, 0x76, 0x20, 0x4f, 0x19, 0x66, 0x43, 0x2a, 0xbc, 0xb8, 0xb2, 0x6d,
-	0xcc, 0x4a, 0xb5, 0xa6, 0xef, 0xd9, 0x87, 0x82, 0xd3, 0x54, 0x12, 0xe8, 0x16, 0xe4, 0x99, 0x7f,
-	0x46, 0x3c, 0xe5, 0xc2, 0xb9, 0xea, 0xbb, 0xed, 0x31, 0x53, 0x49, 0x73, 0x8f, 0xd8, 0xa4, 0x4f,
-	0x7a, 0xc2, 0x71, 0xf4, 0x14, 0x07, 0x84, 0x56, 0xf2, 0x02, 0xb1, 0x3d, 0x77, 0x11, 0x2a, 0x4f,
-	0xa5, 0xf1, 0x0c, 0xb3, 0x1c, 0x91, 0x0e, 0x05, 0x05, 0xbd, 0x0d, 0x25, 0x3b, 0x4e, 0xd4, 0xca,
-	0xa2, 0x08, 0xc1, 0x17, 0x66, 0x99, 0x9f, 0xc8, 0x69, 0xd5, 0xf7, 0x92, 0xd2, 0x3c, 0x39, 0x86,
-	0x5e, 0xd7, 0xf7, 0x6c, 0xc7, 0xeb, 0x75, 0x4e, 0x89, 0xd3, 0x3b, 0x65, 0x95, 0x42, 0x5d, 0xdb,
-	0x5c, 0x48, 0x26, 0x47, 0x9a, 0xc3, 0x30, 0xcb, 0x11, 0x69, 0x5f, 0x50, 0x90, 0x0d, 0x2b, 0x31,
-	0x97, 0x28, 0xd4, 0xe2, 0x53, 0x0b, 0xf5, 0x15, 0x55, 0xa8, 0x57, 0xd3, 0x5a, 0xe2, 0x5a, 0x5d,
-	0x8e, 0x88, 0x5c, 0x0c, 0xed, 0x03, 0xc4, 0xed, 0xa1, 0x02, 0x42, 0x83, 0xf1, 0xf4, 0x1e, 0xa3,
-	0x0c, 0x4f, 0xc8, 0xa2, 0xf7, 0xe0, 0x8a, 0xeb, 0x78, 0x1d, 0x4a, 0xfa, 0x27, 0x1d, 0xe5, 0x60,
-	0x0e, 0x59, 0x12, 0xd1, 0x7b, 0x67, 0xbe, 0x7c, 0x98, 0x8c, 0xf5, 0xaa, 0x6a, 0xa1, 0xd3, 0x90,
-	0x86, 0xb9, 0xe6, 0x3a, 0xde, 0x21, 0xe9, 0x9f, 0xb4, 0x22, 0xda, 0xce, 0xd2, 0xfb, 0x0f, 0xf4,
-	0x8c, 0x2a, 0xd7, 0x8c, 0xf1, 0x16, 0x2c, 0x1d, 0xe3, 0xbe, 0x2a, 0x33, 0x42, 0xd1, 0x75, 0x28,
-	0xe2, 0x70, 0x51, 0xd1, 0xea, 0x0b, 0x9b, 0x45, 0x33, 0x26, 0xc8, 0x32, 0xff, 0xe9, 0x5f, 0xeb,
-	0x9a, 0xf1, 0xb1, 0x06, 0xf9, 0xd6, 0xf1, 0x01, 0x76, 0x02, 0xd4, 0x86, 0xb5, 0x38, 0x73, 0x2e,
-	0x16, 0xf9, 0xf5, 0xc9, 0x58, 0xaf, 0xa4, 0x93, 0x2b, 0xaa, 0xf2, 0x38, 0x81, 0xc3, 0x32, 0x6f,
-	0xc3, 0xda, 0xdd, 0xb0, 0x77, 0x44, 0x50, 0xd9, 0x34, 0xd4, 0x14, 0x8b, 0x61, 0xae, 0x46, 0x34,
-	0x05, 0x95, 0x32, 0x73, 0x0f, 0x16, 0xe5, 0x69, 0x29, 0xda, 0x81, 0x97, 0x06, 0xfc, 0x87, 0xb0,
-	0xae, 0xb4, 0x5d, 0x9b, 0x99, 0xbc, 0x82, 0x5f, 0x85, 0x4f, 0x8a, 0x18, 0xbf, 0xca, 0x02, 0xb4,
-	0x8e, 0x8f, 0x8f, 0x02, 0x67, 0xd0, 0x27, 0xec, 0xd3, 0xb4, 0xfc, 0x08, 0xae, 0xc6, 0x66, 0xd1,
-	0xc0, 0x4a, 0x59, 0x5f, 0x9f, 0x8c, 0xf5, 0xeb, 0x69, 0xeb, 0x13, 0x6c, 0x86, 0x79, 0x25, 0xa2,
-	0x1f, 0x06, 0xd6, 0xa5, 0xa8, 0x36, 0x65, 0x11, 0xea, 0xc2, 0x6c, 0xd4, 0x04, 0x5b, 0x12, 0xb5,
-	0x45, 0xd9, 0xe5, 0xae, 0x3d, 0x84, 0x52, 0xec, 0x12, 0x8a, 0x5a, 0x50, 0x60, 0xea, 0xb7, 0xf2,
-	0xb0, 0x31, 0xdb, 0xc3, 0xa1, 0x98, 0xf2, 0x72, 0x24, 0x69, 0xfc, 0x47, 0x03, 0x88, 0x73, 0xf6,
-	0xc5, 0x4c, 0x31, 0xde, 0xca, 0x55, 0xe3, 0x5d, 0x78, 0xae, 0x51, 0x4d, 0x49, 0xa7, 0xfc, 0xf9,
-	0xf3, 0x2c, 0x5c, 0xb9, 0x13, 0x76, 0x9e, 0x17, 0xde, 0x07, 0x07, 0xb0, 0x48, 0x3c, 0x16, 0x38,
-	0xc2, 0x09, 0x3c, 0xda, 0x5f, 0x99, 0x15, 0xed, 0x4b, 0x6c, 0xda, 0xf3, 0x58, 0x30, 0x52, 0xb1,
-	0x0f, 0x61, 0x52, 0xde, 0xf8, 0xe5, 0x02, 0x54, 0x66, 0x49, 0xa2, 0x5d, 0x28, 0x5b, 0x01, 0x11,
-	0x84, 0xf0, 0xfe, 0xd0, 0xc4, 0xfd, 0x51, 0x8d, 0x27, 0xcb, 0x14, 0x83, 0x61, 0xae, 0x84, 0x14,
-	0x75, 0x7b, 0xf4, 0x80, 0x8f, 0x7d, 0x3c, 0xed, 0x38, 0xd7, 0x33, 0xce, 0x79, 0x86, 0xba, 0x3e,
-	0x42, 0x25, 0x17, 0x01, 0xe4, 0xfd, 0xb1, 0x12, 0x53, 0xc5, 0x05, 0xf2, 0x63, 0x28, 0x3b, 0x9e,
-	0xc3, 0x1c, 0xdc, 0xef, 0x74, 0x71, 0x1f, 0x7b, 0xd6, 0xf3, 0x4c, 0xcd, 0xb2, 0xe5, 0x2b, 0xb5,
-	0x29, 0x38, 0xc3, 0x5c, 0x51, 0x94, 0xa6, 0x24, 0xa0, 0x7d, 0x58, 0x0c, 0x55, 0xe5, 0x9e, 0x6b,
-	0xda, 0x08, 0xc5, 0x13, 0x03, 0xde, 0x2f, 0x16, 0x60, 0xcd, 0x24, 0xf6, 0x67, 0xa1, 0x98, 0x2f,
-	0x14, 0xdf, 0x06, 0x90, 0xe5, 0xce, 0x1b, 0xec, 0x73, 0x44, 0x83, 0x37, 0x8c, 0xa2, 0x44, 0x68,
-	0x51, 0x96, 0x88, 0xc7, 0x38, 0x0b, 0x4b, 0xc9, 0x78, 0xfc, 0x9f, 0xde, 0x4a, 0xa8, 0x1d, 0x77,
-	0xa2, 0x9c, 0xe8, 0x44, 0x5f, 0x9a, 0xd5, 0x89, 0xa6, 0xb2, 0xf7, 0xc9, 0x2d, 0xe8, 0xdf, 0x59,
-	0xc8, 0x1f, 0xe0, 0x00, 0xbb, 0x14, 0x59, 0x53, 0x93, 0xa6, 0x7c, 0x6b, 0x6e, 0x4c, 0xe5, 0x67,
-	0x4b, 0x7d, 0xed, 0x78, 0xca, 0xa0, 0xf9, 0xe1, 0x25, 0x83, 0xe6, 0x37, 0x61, 0x85, 0x3f, 0x87,
-	0x23, 0x1b, 0xa5, 0xb7, 0x97, 0x9b, 0x1b, 0x31, 0xca, 0xc5, 0x7d, 0xf9, 0x5a, 0x8e, 0x1e, 0x5d,
-	0x14, 0x7d, 0x0d, 0x4a, 0x9c, 0x23, 0x6e, 0xcc, 0x5c, 0xfc, 0x5a, 0xfc, 0x2c, 0x4d, 0x6c, 0x1a,
-	0x26, 0xb8, 0xf8, 0x7c, 0x4f, 0x2e, 0xd0, 0x3b, 0x80, 0x4e, 0xa3, 0x2f, 0x23, 0x9d, 0xd8, 0x9d,
-	0x5c, 0xfe, 0xf3, 0x93, 0xb1, 0xbe, 0x21, 0xe5, 0xa7, 0x79, 0x0c, 0x73, 0x2d, 0x26, 0x86, 0x68,
-	0x5f, 0x05, 0xe0, 0x76, 0x75, 0x6c, 0xe2, 0xf9, 0xae, 0x7a, 0xee, 0x5c, 0x9d, 0x8c, 0xf5, 0x35,
-	0x89, 0x12, 0xef, 0x19, 0x66, 0x91, 0x2f, 0x5a, 0xfc, 0x77, 0x22, 0xb3, 0x3f, 0xd2, 0x00, 0xc5,
-	0x2d, 0xdf, 0x24, 0x74, 0xc0, 0xdf, 0x67, 0x7c, 0x10, 0x4f, 0x4c, 0xcd, 0xda, 0x93, 0x07, 0xf1,
-	0x58, 0x3e, 0x1c, 0xc4, 0x13, 0x95, 0xf2, 0xf5, 0xb8, 0x3d, 0x66, 0x55, 0x1c, 0x15, 0x4c, 0x17,
-	0x53, 0x92, 0x18, 0xe6, 0x9d, 0x50, 0x7a, 0xaa, 0x1f, 0x66, 0x8c, 0x3f, 0x6a, 0xb0, 0x31, 0x95,
-	0x51, 0xd1, 0x61, 0x7f, 0x08, 0x28, 0x48, 0x6c, 0x0a, 0x7f, 0x8d, 0xd4, 0xa1, 0xe7, 0x4e, 0xd0,
-	0xb5, 0x60, 0xaa, 0xef, 0x7e, 0x7a, 0x1d, 0x3e, 0x27, 0x7c, 0xfe, 0x3b, 0x0d, 0xd6, 0x93, 0xea,
-	0x23, 0x43, 0x6e, 0xc3, 0x52, 0x52, 0xbb, 0x32, 0xe1, 0xd5, 0x67, 0x31, 0x41, 0x9d, 0xfe, 0x82,
-	0x3c, 0xfa, 0x6e, 0x5c, 0xae, 0xf2, 0xdb, 0xd9, 0x8d, 0x67, 0xf6, 0x46, 0x78, 0xa6, 0x74, 0xd9,
-	0xe6, 0x44, 0x3c, 0xfe, 0xab, 0x41, 0xee, 0xc0, 0xf7, 0xfb, 0xc8, 0x87, 0x35, 0xcf, 0x67, 0x1d,
-	0x9e, 0x59, 0xc4, 0xee, 0xa8, 0x47, 0xb7, 0xec, 0x83, 0xbb, 0xf3, 0x39, 0xe9, 0x9f, 0x63, 0x7d,
-	0x1a, 0xca, 0x2c, 0x7b, 0x3e, 0x6b, 0x0a, 0xca, 0x91, 0x7c, 0x92, 0xbf, 0x07, 0xcb, 0x17, 0x95,
-	0xc9, 0x2e, 0xf9, 0xbd, 0xb9, 0x95, 0x5d, 0x84, 0x99, 0x8c, 0xf5, 0xf5, 0xb8, 0x62, 0x22, 0xb2,
-	0x61, 0x2e, 0x75, 0x13, 0xda, 0x77, 0x0a, 0x3c, 0x7e, 0xff, 0x7a, 0xa0, 0x6b, 0x5f, 0xfe, 0xad,
-	0x06, 0x10, 0x7f, 0x79, 0x40, 0xaf, 0xc3, 0xcb, 0xcd, 0xef, 0xdc, 0x6e, 0x75, 0x0e, 0x8f, 0x6e,
-	0x1e, 0xdd, 0x39, 0xec, 0xdc, 0xb9, 0x7d, 0x78, 0xb0, 0xb7, 0xdb, 0xbe, 0xd5, 0xde, 0x6b, 0xad,
-	0x66, 0xaa, 0xe5, 0x7b, 0xf7, 0xeb, 0xa5, 0x3b, 0x1e, 0x1d, 0x10, 0xcb, 0x39, 0x71, 0x88, 0x8d,
-	0x5e, 0x83, 0xf5, 0x8b, 0xdc, 0x7c, 0xb5, 0xd7, 0x5a, 0xd5, 0xaa, 0x4b, 0xf7, 0xee, 0xd7, 0x0b,
-	0x72, 0x16, 0x23, 0x36, 0xda, 0x84, 0xab, 0xd3, 0x7c, 0xed, 0xdb, 0xdf, 0x5a, 0xcd, 0x56, 0x97,
-	0xef, 0xdd, 0xaf, 0x17, 0xa3, 0xa1, 0x0d, 0x19, 0x80, 0x92, 0x9c, 0x0a, 0x6f, 0xa1, 0x0a, 0xf7,
-	0xee, 0xd7, 0xf3, 0xd2, 0x81, 0xd5, 0xdc, 0xfb, 0x1f, 0xd5, 0x32, 0xcd, 0x5b, 0x9f, 0x3c, 0xaa,
-	0x69, 0x0f, 0x1f, 0xd5, 0xb4, 0xbf, 0x3f, 0xaa, 0x69, 0x1f, 0x3c, 0xae, 0x65, 0x1e, 0x3e, 0xae,
-	0x65, 0xfe, 0xfc, 0xb8, 0x96, 0xf9, 0xfe, 0xeb, 0x4f, 0xf4, 0xdd, 0x79, 0xf4, 0x51, 0x5b, 0x78,
-	0xb1, 0x9b, 0x17, 0x6d, 0xf8, 0xcd, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x48, 0x4c, 0x86,
-	0xf3, 0x16, 0x00, 0x00,
+	// 2199 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x39, 0x4b, 0x6c, 0x1c, 0x49,
+	0xd9, 0x6e, 0xcf, 0xc4, 0x1e, 0x7f, 0x63, 0x7b, 0xc6, 0x15, 0x27, 0x99, 0xcc, 0xe6, 0x77, 0x3b,
+	0xbd, 0xfb, 0x2f, 0x01, 0xed, 0x8e, 0x89, 0x17, 0x2d, 0xe0, 0xcb, 0x92, 0xf1, 0x38, 0xb1, 0xd9,
+	0x24, 0x98, 0xb6, 0x63, 0x24, 0x88, 0x68, 0xf5, 0x74, 0x57, 0xc6, 0x8d, 0x7b, 0xba, 0x86, 0xae,
+	0x1a, 0xc7, 0x23, 0xed, 0x81, 0x63, 0x08, 0x42, 0x2c, 0xb7, 0x3d, 0x6c, 0xa4, 0x48, 0x7b, 0x5d,
+	0x69, 0x2f, 0x88, 0x2b, 0x1c, 0x97, 0xc7, 0x21, 0xdc, 0x10, 0x42, 0x03, 0x4a, 0x2e, 0x88, 0x13,
+	0x9a, 0x13, 0x37, 0x50, 0x3d, 0xfa, 0xe1, 0x1e, 0x3b, 0xf1, 0x44, 0x46, 0x8a, 0x04, 0x17, 0xbb,
+	0xeb, 0xab, 0xef, 0x51, 0xdf, 0xfb, 0xab, 0x1a, 0x78, 0xc3, 0x21, 0xb4, 0x4d, 0xe8, 0x12, 0x65,
+	0xf6, 0x9e, 0x17, 0xb4, 0x96, 0xf6, 0xaf, 0x36, 0x31, 0xb3, 0xaf, 0x46, 0xeb, 0x5a, 0x27, 0x24,
+	0x8c, 0xa0, 0xf3, 0x12, 0xab, 0x16, 0x41, 0x15, 0x56, 0x75, 0xbe, 0x45, 0x5a, 0x44, 0xa0, 0x2c,
+	0xf1, 0x2f, 0x89, 0x5d, 0xbd, 0xd8, 0x22, 0xa4, 0xe5, 0xe3, 0x25, 0xb1, 0x6a, 0x76, 0xef, 0x2d,
+	0xd9, 0x41, 0x4f, 0x6d, 0x2d, 0x64, 0xb7, 0xdc, 0x6e, 0x68, 0x33, 0x8f, 0x04, 0x6a, 0x5f, 0xcf,
+	0xee, 0x33, 0xaf, 0x8d, 0x29, 0xb3, 0xdb, 0x9d, 0x88, 0xb7, 0x3c, 0x89, 0x25, 0x85, 0xaa, 0x63,
+	0x29, 0xde, 0x4a, 0x95, 0xa6, 0x4d, 0x71, 0xac, 0x87, 0x43, 0xbc, 0x88, 0xf7, 0x25, 0x86, 0x03,
+	0x17, 0x87, 0x6d, 0x2f, 0x60, 0x4b, 0xac, 0xd7, 0xc1, 0x54, 0xfe, 0x95, 0xbb, 0xc6, 0x8f, 0x35,
+	0x98, 0x5d, 0xf7, 0x28, 0x23, 0xa1, 0xe7, 0xd8, 0xfe, 0x46, 0x70, 0x8f, 0xa0, 0x77, 0x61, 0x62,
+	0x17, 0xdb, 0x2e, 0x0e, 0x2b, 0xda, 0xa2, 0x76, 0xa5, 0xb8, 0x5c, 0xa9, 0x25, 0x1c, 0x6a, 0x92,
+	0x76, 0x5d, 0xec, 0xd7, 0xf3, 0x9f, 0xf7, 0xf5, 0x31, 0x53, 0x61, 0xa3, 0xf7, 0x60, 0x62, 0xdf,
+	0xf6, 0x29, 0x66, 0x95, 0xf1, 0xc5, 0xdc, 0x95, 0xe2, 0xf2, 0xe5, 0xda, 0xd1, 0xe6, 0xab, 0xed,
+	0xd8, 0xbe, 0xe7, 0xda, 0x8c, 0xc4, 0x0c, 0x24, 0x99, 0xf1, 0xd9, 0x38, 0x94, 0x56, 0x49, 0xbb,
+	0xed, 0x51, 0xea, 0x91, 0xc0, 0xb4, 0x19, 0xa6, 0xa8, 0x0e, 0xf9, 0xd0, 0x66, 0x58, 0x1c, 0x65,
+	0xaa, 0x5e, 0xe3, 0xf8, 0x7f, 0xea, 0xeb, 0x6f, 0xb6, 0x3c, 0xb6, 0xdb, 0x6d, 0xd6, 0x1c, 0xd2,
+	0x56, 0xc6, 0x50, 0xff, 0xde, 0xa6, 0xee, 0x9e, 0xd2, 0xaf, 0x81, 0x1d, 0x53, 0xd0, 0xa2, 0xbb,
+	0x50, 0x68, 0xdb, 0x07, 0x96, 0xe0, 0x33, 0x2e, 0xf8, 0x5c, 0x1b, 0x8d, 0xcf, 0xa0, 0xaf, 0x97,
+	0x7a, 0x76, 0xdb, 0x5f, 0x31, 0x22, 0x3e, 0x86, 0x39, 0xd9, 0xb6, 0x0f, 0xf8, 0x11, 0x51, 0x07,
+	0x4a, 0x1c, 0xea, 0xec, 0xda, 0x41, 0x0b, 0x4b, 0x21, 0x39, 0x21, 0x64, 0x7d, 0x64, 0x21, 0xe7,
+	0x13, 0x21, 0x29, 0x76, 0x86, 0x39, 0xd3, 0xb6, 0x0f, 0x56, 0x05, 0x80, 0x4b, 0x5c, 0x29, 0x7c,
+	0xf4, 0x58, 0x1f, 0xfb, 0xdb, 0x63, 0x5d, 0x33, 0xfe, 0xa0, 0x01, 0x24, 0x16, 0x43, 0x77, 0xa1,
+	0xec, 0xc4, 0x2b, 0x41, 0x4b, 0x95, 0x0f, 0xbf, 0x70, 0x9c, 0x2f, 0x32, 0xf6, 0xae, 0x17, 0xf8,
+	0xa1, 0x9f, 0xf4, 0x75, 0xcd, 0x2c, 0x39, 0x19, 0x57, 0x7c, 0x0f, 0x8a, 0xdd, 0x8e, 0x6b, 0x33,
+	0x6c, 0xf1, 0xe8, 0x14, 0x96, 0x2c, 0x2e, 0x57, 0x6b, 0x32, 0x74, 0x6b, 0x51, 0xe8, 0xd6, 0xb6,
+	0xa3, 0xd0, 0xad, 0x2f, 0x70, 0x5e, 0x83, 0xbe, 0x8e, 0xa4, 0x5a, 0x29, 0x62, 0xe3, 0xc3, 0xbf,
+	0xe8, 0x9a, 0x09, 0x12, 0xc2, 0x09, 0x52, 0x3a, 0xfd, 0x46, 0x83, 0x62, 0x03, 0x53, 0x27, 0xf4,
+	0x3a, 0x3c, 0x43, 0x50, 0x05, 0x26, 0xdb, 0x24, 0xf0, 0xf6, 0x54, 0x3c, 0x4e, 0x99, 0xd1, 0x12,
+	0x55, 0xa1, 0xe0, 0xb9, 0x38, 0x60, 0x1e, 0xeb, 0x49, 0xbf, 0x9a, 0xf1, 0x9a, 0x53, 0xdd, 0xc7,
+	0x4d, 0xea, 0x45, 0xde, 0x30, 0xa3, 0x25, 0xba, 0x0e, 0x65, 0x8a, 0x9d, 0x6e, 0xe8, 0xb1, 0x9e,
+	0xe5, 0x90, 0x80, 0xd9, 0x0e, 0xab, 0xe4, 0x85, 0xc3, 0x5e, 0x1b, 0xf4, 0xf5, 0x0b, 0xf2, 0xac,
+	0x59, 0x0c, 0xc3, 0x2c, 0x45, 0xa0, 0x55, 0x09, 0xe1, 0x12, 0x5c, 0xcc, 0x6c, 0xcf, 0xa7, 0x95,
+	0x33, 0x52, 0x82, 0x5a, 0xa6, 0x74, 0xf9, 0x74, 0x12, 0xa6, 0xe2, 0x68, 0xe7, 0x92, 0x49, 0x07,
+	0x87, 0xfc, 0xdb, 0xb2, 0x5d, 0x37, 0xc4, 0x94, 0x56, 0xb4, 0xac, 0xe4, 0x2c, 0x86, 0x61, 0x96,
+	0x22, 0xd0, 0x35, 0x09, 0x41, 0x8c, 0xbb, 0x39, 0xa0, 0x38, 0xa0, 0x5d, 0x6a, 0x75, 0xba, 0xcd,
+	0x3d, 0xdc, 0x53, 0xde, 0x98, 0x1f, 0xf2, 0xc6, 0xb5, 0xa0, 0x57, 0x7f, 0x27, 0xe1, 0x9e, 0xa5,
+	0x33, 0x7e, 0xfb, 0x8b, 0xb7, 0xe7, 0x55, 0x68, 0x38, 0x61, 0xaf, 0xc3, 0x48, 0x6d, 0xb3, 0xdb,
+	0x7c, 0x1f, 0xf7, 0xcc, 0x52, 0x8c, 0xba, 0x29, 0x30, 0xd1, 0x79, 0x98, 0xf8, 0x81, 0xed, 0xf9,
+	0xd8, 0x15, 0x06, 0x2d, 0x98, 0x6a, 0x85, 0x56, 0x60, 0x82, 0x32, 0x9b, 0x75, 0xa9, 0xb0, 0xe2,
+	0xec, 0xb2, 0x71, 0x5c, 0xa8, 0xd5, 0x49, 0xe0, 0x6e, 0x09, 0x4c, 0x53, 0x51, 0xa0, 0xeb, 0x30,
+	0xc1, 0xc8, 0x1e, 0x0e, 0x94, 0x09, 0x47, 0xca, 0xef, 0x8d, 0x80, 0x99, 0x8a, 0x9a, 0x5b, 0xc4,
+	0xc5, 0x3e, 0x6e, 0x09, 0xc3, 0xd1, 0x5d, 0x3b, 0xc4, 0xb4, 0x32, 0x21, 0x38, 0x6e, 0x8c, 0x9c,
+	0x84, 0xca, 0x52, 0x59, 0x7e, 0x86, 0x59, 0x8a, 0x41, 0x5b, 0x02, 0x82, 0xde, 0x87, 0xa2, 0x9b,
+	0x04, 0x6a, 0x65, 0x52, 0xb8, 0xe0, 0xf5, 0xe3, 0xd4, 0x4f, 0xc5, 0xb4, 0xaa, 0x7b, 0x69, 0x6a,
+	0x1e, 0x1c, 0xdd, 0xa0, 0x49, 0x02, 0xd7, 0x0b, 0x5a, 0xd6, 0x2e, 0xf6, 0x5a, 0xbb, 0xac, 0x52,
+	0x58, 0xd4, 0xae, 0xe4, 0xd2, 0xc1, 0x91, 0xc5, 0x30, 0xcc, 0x52, 0x0c, 0x5a, 0x17, 0x10, 0xe4,
+	0xc2, 0x6c, 0x82, 0x25, 0x12, 0x75, 0xea, 0x85, 0x89, 0x7a, 0x59, 0x25, 0xea, 0xb9, 0xac, 0x94,
+	0x24, 0x57, 0x67, 0x62, 0x20, 0x27, 0x43, 0xeb, 0x00, 0x49, 0x79, 0xa8, 0x80, 0x90, 0x60, 0xbc,
+	0xb8, 0xc6, 0x28, 0xc5, 0x53, 0xb4, 0xe8, 0x03, 0x38, 0xdb, 0xf6, 0x02, 0x8b, 0x62, 0xff, 0x9e,
+	0xa5, 0x0c, 0xcc, 0x59, 0x16, 0x85, 0xf7, 0x6e, 0x8e, 0x16, 0x0f, 0x83, 0xbe, 0x5e, 0x55, 0x25,
+	0x74, 0x98, 0xa5, 0x61, 0xce, 0xb5, 0xbd, 0x60, 0x0b, 0xfb, 0xf7, 0x1a, 0x31, 0x6c, 0x65, 0xfa,
+	0xc1, 0x63, 0x7d, 0x4c, 0xa5, 0xeb, 0x98, 0xf1, 0x2e, 0x4c, 0xef, 0xd8, 0xbe, 0x4a, 0x33, 0x4c,
+	0xd1, 0x25, 0x98, 0xb2, 0xa3, 0x45, 0x45, 0x5b, 0xcc, 0x5d, 0x99, 0x32, 0x13, 0x80, 0x4c, 0xf3,
+	0x1f, 0xfd, 0x79, 0x51, 0x33, 0x3e, 0xd5, 0x60, 0xa2, 0xb1, 0xb3, 0x69, 0x7b, 0x21, 0xda, 0x80,
+	0xb9, 0x24, 0x72, 0x0e, 0x27, 0xf9, 0xa5, 0x41, 0x5f, 0xaf, 0x64, 0x83, 0x2b, 0xce, 0xf2, 0x24,
+	0x80, 0xa3, 0x34, 0xdf, 0x80, 0xb9, 0xfd, 0xa8, 0x76, 0xc4, 0xac, 0xc6, 0xb3, 0xac, 0x86, 0x50,
+	0x0c, 0xb3, 0x1c, 0xc3, 0x14, 0xab, 0x8c, 0x9a, 0x6b, 0x30, 0x29, 0x4f, 0x4b, 0xd1, 0x0a, 0x9c,
+	0xe9, 0xf0, 0x0f, 0xa1, 0x5d, 0x71, 0x79, 0xe1, 0xd8, 0xe0, 0x15, 0xf8, 0xca, 0x7d, 0x92, 0xc4,
+	0xf8, 0xf9, 0x38, 0x40, 0x63, 0x67, 0x67, 0x3b, 0xf4, 0x3a, 0x3e, 0x66, 0xa7, 0xa9, 0xf9, 0x36,
+	0x9c, 0x4b, 0xd4, 0xa2, 0xa1, 0x93, 0xd1, 0x7e, 0x71, 0xd0, 0xd7, 0x2f, 0x65, 0xb5, 0x4f, 0xa1,
+	0x19, 0xe6, 0xd9, 0x18, 0xbe, 0x15, 0x3a, 0x47, 0x72, 0x75, 0x29, 0x8b, 0xb9, 0xe6, 0x8e, 0xe7,
+	0x9a, 0x42, 0x4b, 0x73, 0x6d, 0x50, 0x76, 0xb4, 0x69, 0xb7, 0xa0, 0x98, 0x98, 0x84, 0xa2, 0x06,
+	0x14, 0x98, 0xfa, 0x56, 0x16, 0x36, 0x8e, 0xb7, 0x70, 0x44, 0xa6, 0xac, 0x1c, 0x53, 0x1a, 0xff,
+	0xd4, 0x00, 0x92, 0x98, 0x7d, 0x35, 0x43, 0x8c, 0x97, 0x72, 0x55, 0x78, 0x73, 0x2f, 0x35, 0xaa,
+	0x29, 0xea, 0x8c, 0x3d, 0x7f, 0x32, 0x0e, 0x67, 0xef, 0x44, 0x95, 0xe7, 0x95, 0xb7, 0xc1, 0x26,
+	0x4c, 0xe2, 0x80, 0x85, 0x9e, 0x30, 0x02, 0xf7, 0xf6, 0x97, 0x8f, 0xf3, 0xf6, 0x11, 0x3a, 0xad,
+	0x05, 0x2c, 0xec, 0x29, 0xdf, 0x47, 0x6c, 0x32, 0xd6, 0xf8, 0x59, 0x0e, 0x2a, 0xc7, 0x51, 0xa2,
+	0x55, 0x28, 0x39, 0x21, 0x16, 0x80, 0xa8, 0x7f, 0x68, 0xa2, 0x7f, 0x54, 0x93, 0xc9, 0x32, 0x83,
+	0x60, 0x98, 0xb3, 0x11, 0x44, 0x75, 0x8f, 0x16, 0xf0, 0xb1, 0x8f, 0x87, 0x1d, 0xc7, 0x3a, 0xe1,
+	0x9c, 0x67, 0xa8, 0xf6, 0x11, 0x09, 0x39, 0xcc, 0x40, 0xf6, 0x8f, 0xd9, 0x04, 0x2a, 0x1a, 0xc8,
+	0x0f, 0xa1, 0xe4, 0x05, 0x1e, 0xf3, 0x6c, 0xdf, 0x6a, 0xda, 0xbe, 0x1d, 0x38, 0x2f, 0x33, 0x35,
+	0xcb, 0x92, 0xaf, 0xc4, 0x66, 0xd8, 0x19, 0xe6, 0xac, 0x82, 0xd4, 0x25, 0x00, 0xad, 0xc3, 0x64,
+	0x24, 0x2a, 0xff, 0x52, 0xd3, 0x46, 0x44, 0x9e, 0x1a, 0xf0, 0x7e, 0x9a, 0x83, 0x39, 0x13, 0xbb,
+	0xff, 0x73, 0xc5, 0x68, 0xae, 0xb8, 0x05, 0x20, 0xd3, 0x9d, 0x17, 0xd8, 0x4a, 0xfe, 0xa5, 0x0a,
+	0xc6, 0x94, 0xe4, 0xd0, 0xa0, 0x2c, 0xe5, 0x8f, 0xfe, 0x38, 0x4c, 0xa7, 0xfd, 0xf1, 0x5f, 0xda,
+	0x95, 0xd0, 0x46, 0x52, 0x89, 0xf2, 0xa2, 0x12, 0x7d, 0xf1, 0xb8, 0x4a, 0x34, 0x14, 0xbd, 0xcf,
+	0x2f, 0x41, 0x1f, 0x9f, 0x81, 0x89, 0x4d, 0x3b, 0xb4, 0xdb, 0x14, 0x39, 0x43, 0x93, 0xa6, 0xbc,
+	0x6b, 0x5e, 0x1c, 0x8a, 0xcf, 0x86, 0x7a, 0xed, 0x78, 0xc1, 0xa0, 0xf9, 0xd1, 0x11, 0x83, 0xe6,
+	0x37, 0x60, 0x96, 0x5f, 0x87, 0x63, 0x1d, 0xa5, 0xb5, 0x67, 0xea, 0x17, 0x13, 0x2e, 0x87, 0xf7,
+	0xe5, 0x6d, 0x39, 0xbe, 0x74, 0x51, 0xf4, 0x55, 0x28, 0x72, 0x8c, 0xa4, 0x30, 0x73, 0xf2, 0xf3,
+	0xc9, 0xb5, 0x34, 0xb5, 0x69, 0x98, 0xd0, 0xb6, 0x0f, 0xd6, 0xe4, 0x02, 0xdd, 0x04, 0xb4, 0x1b,
+	0xbf, 0x8c, 0x58, 0x89, 0x39, 0x39, 0xfd, 0xff, 0x0d, 0xfa, 0xfa, 0x45, 0x49, 0x3f, 0x8c, 0x63,
+	0x98, 0x73, 0x09, 0x30, 0xe2, 0xf6, 0x15, 0x00, 0xae, 0x97, 0xe5, 0xe2, 0x80, 0xb4, 0xd5, 0x75,
+	0xe7, 0xdc, 0xa0, 0xaf, 0xcf, 0x49, 0x2e, 0xc9, 0x9e, 0x61, 0x4e, 0xf1, 0x45, 0x83, 0x7f, 0xa3,
+	0xbb, 0x50, 0xe1, 0xe7, 0xe3, 0xc3, 0x32, 0x76, 0xad, 0x16, 0xd9, 0xe7, 0x2f, 0x40, 0x1d, 0x42,
+	0x6d, 0x5f, 0x5e, 0x70, 0x66, 0xea, 0xaf, 0x0f, 0xfa, 0xba, 0x9e, 0x68, 0x72, 0x14, 0xa6, 0x61,
+	0x9e, 0x6b, 0xdb, 0x07, 0xb7, 0xc4, 0xce, 0x0d, 0xb2, 0xbf, 0x19, 0xc1, 0x51, 0x13, 0xaa, 0xa9,
+	0x50, 0xc5, 0xcc, 0xc2, 0x1d, 0xe2, 0xec, 0x5a, 0x3e, 0x0e, 0x5a, 0x6c, 0x57, 0xdc, 0x67, 0xf2,
+	0xf5, 0xff, 0x1f, 0xf4, 0xf5, 0xcb, 0x43, 0x61, 0x9d, 0xc1, 0x35, 0xcc, 0x0b, 0x49, 0x6c, 0x63,
+	0xb6, 0xc6, 0xb7, 0x6e, 0x8a, 0x1d, 0xd4, 0x84, 0x69, 0x9f, 0x38, 0x7b, 0xdd, 0x8e, 0xc5, 0x3c,
+	0x1c, 0xd2, 0x4a, 0xe1, 0xf9, 0x63, 0xd0, 0x4d, 0x81, 0xbb, 0xed, 0xe1, 0xb0, 0xfe, 0x9a, 0x0a,
+	0x96, 0xb3, 0x52, 0x7a, 0x9a, 0x8b, 0x61, 0x16, 0xfd, 0x18, 0x31, 0x7d, 0xe1, 0xfe, 0x44, 0x03,
+	0x94, 0x34, 0x46, 0x13, 0xd3, 0x0e, 0x09, 0xa8, 0xb8, 0xae, 0xa4, 0xee, 0x16, 0xda, 0xf3, 0xaf,
+	0x2b, 0x09, 0x7d, 0x74, 0x5d, 0x49, 0x68, 0xd1, 0xd7, 0x93, 0x26, 0x32, 0xae, 0xa2, 0x5d, 0xb1,
+	0x69, 0xda, 0x14, 0xa7, 0xae, 0x3c, 0x5e, 0x44, 0x3d, 0xd4, 0x35, 0xc6, 0x8c, 0xdf, 0x69, 0x70,
+	0x71, 0x28, 0xef, 0xe2, 0xc3, 0x7e, 0x1f, 0x50, 0x98, 0xda, 0x14, 0x51, 0xd5, 0x53, 0x87, 0x1e,
+	0x39, 0x8d, 0xe7, 0xc2, 0xec, 0xc6, 0x29, 0xf6, 0xc1, 0xbc, 0xb0, 0xf9, 0xaf, 0x34, 0x98, 0x4f,
+	0x8b, 0x8f, 0x15, 0xb9, 0x0d, 0xd3, 0x69, 0xe9, 0x4a, 0x85, 0x37, 0x4e, 0xa2, 0x82, 0x3a, 0xfd,
+	0x21, 0x7a, 0xf4, 0xed, 0xa4, 0xa8, 0xc9, 0x17, 0xc6, 0xab, 0x27, 0xb6, 0x46, 0x74, 0xa6, 0x6c,
+	0x71, 0xcb, 0x0b, 0x7f, 0xfc, 0x4b, 0x83, 0xfc, 0x26, 0x21, 0x3e, 0x22, 0x30, 0x17, 0x10, 0x66,
+	0xf1, 0xfc, 0xc3, 0xae, 0xa5, 0x9e, 0x26, 0x64, 0xb7, 0x58, 0x1d, 0xcd, 0x48, 0x7f, 0xef, 0xeb,
+	0xc3, 0xac, 0xcc, 0x52, 0x40, 0x58, 0x5d, 0x40, 0xb6, 0x05, 0x00, 0x7d, 0x00, 0x33, 0x87, 0x85,
+	0xc9, 0x5e, 0xf2, 0x9d, 0x91, 0x85, 0x1d, 0x66, 0x33, 0xe8, 0xeb, 0xf3, 0x49, 0x5d, 0x89, 0xc1,
+	0x86, 0x39, 0xdd, 0x4c, 0x49, 0x5f, 0x29, 0x70, 0xff, 0xfd, 0x83, 0xfb, 0xf0, 0xe3, 0x1c, 0xa0,
+	0x55, 0x12, 0x50, 0xf5, 0xf8, 0x43, 0x98, 0x1d, 0x3d, 0x4a, 0x9c, 0xca, 0x8b, 0x55, 0x07, 0x4a,
+	0xc4, 0x77, 0xf9, 0x63, 0xda, 0x89, 0x1e, 0xac, 0x96, 0x93, 0x51, 0x22, 0x43, 0x76, 0xfc, 0x7b,
+	0xd5, 0x0c, 0xf1, 0x5d, 0xa5, 0x01, 0x7f, 0xad, 0xea, 0x40, 0x29, 0xc0, 0xf7, 0x0f, 0x49, 0xcc,
+	0x9d, 0x4c, 0x62, 0x86, 0xec, 0x39, 0x12, 0x03, 0x7c, 0x3f, 0x25, 0xf1, 0x3c, 0x7f, 0x36, 0x17,
+	0xb3, 0x1e, 0xcf, 0xaa, 0x9c, 0xa9, 0x56, 0xe8, 0x6b, 0x90, 0x17, 0xcd, 0xf1, 0xcc, 0x0b, 0x87,
+	0x37, 0xf1, 0xf6, 0x2a, 0x46, 0x34, 0x41, 0xb1, 0x52, 0x78, 0x10, 0x15, 0x8c, 0xcf, 0x34, 0x28,
+	0xf3, 0xca, 0x6d, 0x87, 0xcc, 0x73, 0xbc, 0x4e, 0x3c, 0xda, 0x0c, 0x5f, 0x5c, 0xb4, 0x97, 0xbc,
+	0xbc, 0x95, 0x55, 0xe3, 0x48, 0xda, 0x8b, 0xec, 0xb3, 0x29, 0x3f, 0x67, 0x31, 0x0c, 0xb3, 0x24,
+	0x41, 0x71, 0x43, 0x49, 0x9d, 0xf8, 0xb1, 0x06, 0xe5, 0xb8, 0x09, 0xdf, 0x20, 0xfb, 0x3b, 0x84,
+	0x61, 0xde, 0x8a, 0x23, 0x6a, 0xcb, 0x73, 0xc5, 0x59, 0xf3, 0xe9, 0x56, 0x9c, 0xda, 0x34, 0x4c,
+	0x88, 0x56, 0x1b, 0xee, 0x69, 0x3e, 0x85, 0x24, 0x47, 0xfc, 0xbd, 0x06, 0x90, 0xb4, 0x1b, 0xf4,
+	0x1e, 0x14, 0xa2, 0x5f, 0x65, 0x5e, 0x3c, 0xc8, 0x08, 0x57, 0x89, 0x79, 0x25, 0x26, 0x42, 0xf7,
+	0x61, 0x2e, 0xc4, 0xf7, 0xed, 0xd0, 0xb5, 0xda, 0x5d, 0x9f, 0x79, 0x1d, 0xdf, 0xc3, 0xa1, 0x3a,
+	0xe4, 0x37, 0x47, 0x7e, 0x85, 0x54, 0x2a, 0x0d, 0x31, 0x34, 0xcc, 0xb2, 0x84, 0xdd, 0x8a, 0x41,
+	0xaa, 0x0c, 0xff, 0x3a, 0x07, 0xe5, 0xa4, 0x75, 0x49, 0xc5, 0x5e, 0xd1, 0x7b, 0x72, 0xda, 0xd4,
+	0xb9, 0x53, 0x33, 0x75, 0xfe, 0x3f, 0x6f, 0x6a, 0x64, 0x42, 0x01, 0x07, 0xae, 0x75, 0xc2, 0x84,
+	0x8e, 0x26, 0x18, 0xf5, 0xe3, 0x51, 0x44, 0x29, 0xaf, 0x61, 0x93, 0x38, 0x70, 0xb7, 0x0f, 0xa5,
+	0xf9, 0x97, 0x7e, 0xa9, 0x01, 0x24, 0xaf, 0xe4, 0xe8, 0x2d, 0xb8, 0x50, 0xff, 0xd6, 0xed, 0x86,
+	0xb5, 0xb5, 0x7d, 0x6d, 0xfb, 0xce, 0x96, 0x75, 0xe7, 0xf6, 0xd6, 0xe6, 0xda, 0xea, 0xc6, 0xf5,
+	0x8d, 0xb5, 0x46, 0x79, 0xac, 0x5a, 0x7a, 0xf8, 0x68, 0xb1, 0x78, 0x27, 0xa0, 0x1d, 0xec, 0x78,
+	0xf7, 0x3c, 0xec, 0xa2, 0x37, 0x61, 0xfe, 0x30, 0x36, 0x5f, 0xad, 0x35, 0xca, 0x5a, 0x75, 0xfa,
+	0xe1, 0xa3, 0xc5, 0x82, 0x7c, 0x37, 0xc0, 0x2e, 0xba, 0x02, 0xe7, 0x86, 0xf1, 0x36, 0x6e, 0xdf,
+	0x28, 0x8f, 0x57, 0x67, 0x1e, 0x3e, 0x5a, 0x9c, 0x8a, 0x1f, 0x18, 0x90, 0x01, 0x28, 0x8d, 0xa9,
+	0xf8, 0xe5, 0xaa, 0xf0, 0xf0, 0xd1, 0xe2, 0x84, 0x6c, 0x63, 0xd5, 0xfc, 0x83, 0x4f, 0x16, 0xc6,
+	0xea, 0xd7, 0x3f, 0x7f, 0xba, 0xa0, 0x3d, 0x79, 0xba, 0xa0, 0xfd, 0xf5, 0xe9, 0x82, 0xf6, 0xe1,
+	0xb3, 0x85, 0xb1, 0x27, 0xcf, 0x16, 0xc6, 0xfe, 0xf8, 0x6c, 0x61, 0xec, 0xbb, 0x6f, 0x3d, 0xd7,
+	0x0d, 0x07, 0xf1, 0x0f, 0xb0, 0xc2, 0x21, 0xcd, 0x09, 0x61, 0xc4, 0x77, 0xfe, 0x3d, 0x00, 0xbb,
+	0x0e, 0x6e, 0x14, 0x9f, 0x1d, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1260,622 +1548,764 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 9834 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x5c, 0xd7,
-		0x75, 0x18, 0xde, 0x7e, 0x00, 0xbb, 0x07, 0x0b, 0x60, 0x71, 0x01, 0x92, 0xcb, 0x25, 0x09, 0x40,
-		0x4f, 0x5f, 0x14, 0x25, 0x81, 0x12, 0x25, 0x52, 0xd2, 0x32, 0xb6, 0xbc, 0x8b, 0x5d, 0x82, 0x10,
-		0xf1, 0xa5, 0x07, 0x80, 0x92, 0x65, 0xa7, 0x3b, 0x0f, 0xbb, 0x17, 0x8b, 0x27, 0xec, 0xbe, 0xf7,
-		0xf4, 0xde, 0x5b, 0x12, 0xa0, 0xed, 0x8e, 0xfc, 0x51, 0xd7, 0x66, 0x26, 0x8d, 0x5d, 0x77, 0x1a,
-		0x5b, 0x36, 0x5d, 0x39, 0x4e, 0xeb, 0xd4, 0x71, 0x1b, 0x3b, 0x76, 0xdd, 0xa6, 0xed, 0x4c, 0xed,
-		0xce, 0xa4, 0xb1, 0xdd, 0x26, 0x63, 0xb7, 0x99, 0x36, 0xcd, 0xa4, 0x74, 0x2a, 0x7b, 0x52, 0xd5,
-		0x75, 0x1b, 0x87, 0x71, 0xdb, 0x74, 0x3c, 0x9d, 0x76, 0xee, 0xd7, 0xfb, 0xda, 0x4f, 0x40, 0xa4,
-		0xed, 0x34, 0xfd, 0x85, 0xbd, 0xe7, 0x9e, 0x73, 0xee, 0x39, 0xe7, 0x9e, 0x7b, 0xee, 0xb9, 0x5f,
-		0x0f, 0xf0, 0x85, 0xf3, 0x30, 0x53, 0x33, 0x8c, 0x5a, 0x1d, 0x9f, 0x36, 0x2d, 0xc3, 0x31, 0x36,
-		0x9b, 0x5b, 0xa7, 0xab, 0xd8, 0xae, 0x58, 0x9a, 0xe9, 0x18, 0xd6, 0x2c, 0x85, 0xa1, 0x31, 0x86,
-		0x31, 0x2b, 0x30, 0xe4, 0x25, 0x18, 0xbf, 0xa0, 0xd5, 0x71, 0xd1, 0x45, 0x5c, 0xc3, 0x0e, 0x7a,
-		0x12, 0x62, 0x5b, 0x5a, 0x1d, 0x67, 0xa4, 0x99, 0xe8, 0xc9, 0xe1, 0x33, 0xf7, 0xcc, 0x86, 0x88,
-		0x66, 0x83, 0x14, 0xab, 0x04, 0xac, 0x50, 0x0a, 0xf9, 0xbb, 0x31, 0x98, 0x68, 0x53, 0x8b, 0x10,
-		0xc4, 0x74, 0xb5, 0x41, 0x38, 0x4a, 0x27, 0x93, 0x0a, 0xfd, 0x8d, 0x32, 0x30, 0x64, 0xaa, 0x95,
-		0x1d, 0xb5, 0x86, 0x33, 0x11, 0x0a, 0x16, 0x45, 0x34, 0x05, 0x50, 0xc5, 0x26, 0xd6, 0xab, 0x58,
-		0xaf, 0xec, 0x65, 0xa2, 0x33, 0xd1, 0x93, 0x49, 0xc5, 0x07, 0x41, 0x0f, 0xc2, 0xb8, 0xd9, 0xdc,
-		0xac, 0x6b, 0x95, 0xb2, 0x0f, 0x0d, 0x66, 0xa2, 0x27, 0xe3, 0x4a, 0x9a, 0x55, 0x14, 0x3d, 0xe4,
-		0xfb, 0x61, 0xec, 0x2a, 0x56, 0x77, 0xfc, 0xa8, 0xc3, 0x14, 0x75, 0x94, 0x80, 0x7d, 0x88, 0x73,
-		0x90, 0x6a, 0x60, 0xdb, 0x56, 0x6b, 0xb8, 0xec, 0xec, 0x99, 0x38, 0x13, 0xa3, 0xda, 0xcf, 0xb4,
-		0x68, 0x1f, 0xd6, 0x7c, 0x98, 0x53, 0xad, 0xef, 0x99, 0x18, 0xe5, 0x21, 0x89, 0xf5, 0x66, 0x83,
-		0x71, 0x88, 0x77, 0xb0, 0x5f, 0x49, 0x6f, 0x36, 0xc2, 0x5c, 0x12, 0x84, 0x8c, 0xb3, 0x18, 0xb2,
-		0xb1, 0x75, 0x45, 0xab, 0xe0, 0xcc, 0x20, 0x65, 0x70, 0x7f, 0x0b, 0x83, 0x35, 0x56, 0x1f, 0xe6,
-		0x21, 0xe8, 0xd0, 0x1c, 0x24, 0xf1, 0xae, 0x83, 0x75, 0x5b, 0x33, 0xf4, 0xcc, 0x10, 0x65, 0x72,
-		0x6f, 0x9b, 0x5e, 0xc4, 0xf5, 0x6a, 0x98, 0x85, 0x47, 0x87, 0xce, 0xc1, 0x90, 0x61, 0x3a, 0x9a,
-		0xa1, 0xdb, 0x99, 0xc4, 0x8c, 0x74, 0x72, 0xf8, 0xcc, 0xf1, 0xb6, 0x8e, 0xb0, 0xc2, 0x70, 0x14,
-		0x81, 0x8c, 0x16, 0x20, 0x6d, 0x1b, 0x4d, 0xab, 0x82, 0xcb, 0x15, 0xa3, 0x8a, 0xcb, 0x9a, 0xbe,
-		0x65, 0x64, 0x92, 0x94, 0xc1, 0x74, 0xab, 0x22, 0x14, 0x71, 0xce, 0xa8, 0xe2, 0x05, 0x7d, 0xcb,
-		0x50, 0x46, 0xed, 0x40, 0x19, 0x1d, 0x86, 0x41, 0x7b, 0x4f, 0x77, 0xd4, 0xdd, 0x4c, 0x8a, 0x7a,
-		0x08, 0x2f, 0xc9, 0xbf, 0x31, 0x08, 0x63, 0xfd, 0xb8, 0xd8, 0x79, 0x88, 0x6f, 0x11, 0x2d, 0x33,
-		0x91, 0xfd, 0xd8, 0x80, 0xd1, 0x04, 0x8d, 0x38, 0x78, 0x40, 0x23, 0xe6, 0x61, 0x58, 0xc7, 0xb6,
-		0x83, 0xab, 0xcc, 0x23, 0xa2, 0x7d, 0xfa, 0x14, 0x30, 0xa2, 0x56, 0x97, 0x8a, 0x1d, 0xc8, 0xa5,
-		0x9e, 0x87, 0x31, 0x57, 0xa4, 0xb2, 0xa5, 0xea, 0x35, 0xe1, 0x9b, 0xa7, 0x7b, 0x49, 0x32, 0x5b,
-		0x12, 0x74, 0x0a, 0x21, 0x53, 0x46, 0x71, 0xa0, 0x8c, 0x8a, 0x00, 0x86, 0x8e, 0x8d, 0xad, 0x72,
-		0x15, 0x57, 0xea, 0x99, 0x44, 0x07, 0x2b, 0xad, 0x10, 0x94, 0x16, 0x2b, 0x19, 0x0c, 0x5a, 0xa9,
-		0xa3, 0xa7, 0x3c, 0x57, 0x1b, 0xea, 0xe0, 0x29, 0x4b, 0x6c, 0x90, 0xb5, 0x78, 0xdb, 0x06, 0x8c,
-		0x5a, 0x98, 0xf8, 0x3d, 0xae, 0x72, 0xcd, 0x92, 0x54, 0x88, 0xd9, 0x9e, 0x9a, 0x29, 0x9c, 0x8c,
-		0x29, 0x36, 0x62, 0xf9, 0x8b, 0xe8, 0x6e, 0x70, 0x01, 0x65, 0xea, 0x56, 0x40, 0xa3, 0x50, 0x4a,
-		0x00, 0x97, 0xd5, 0x06, 0xce, 0x5e, 0x83, 0xd1, 0xa0, 0x79, 0xd0, 0x24, 0xc4, 0x6d, 0x47, 0xb5,
-		0x1c, 0xea, 0x85, 0x71, 0x85, 0x15, 0x50, 0x1a, 0xa2, 0x58, 0xaf, 0xd2, 0x28, 0x17, 0x57, 0xc8,
-		0x4f, 0xf4, 0x16, 0x4f, 0xe1, 0x28, 0x55, 0xf8, 0xbe, 0xd6, 0x1e, 0x0d, 0x70, 0x0e, 0xeb, 0x9d,
-		0x7d, 0x02, 0x46, 0x02, 0x0a, 0xf4, 0xdb, 0xb4, 0xfc, 0x4e, 0x38, 0xd4, 0x96, 0x35, 0x7a, 0x1e,
-		0x26, 0x9b, 0xba, 0xa6, 0x3b, 0xd8, 0x32, 0x2d, 0x4c, 0x3c, 0x96, 0x35, 0x95, 0xf9, 0x4f, 0x43,
-		0x1d, 0x7c, 0x6e, 0xc3, 0x8f, 0xcd, 0xb8, 0x28, 0x13, 0xcd, 0x56, 0xe0, 0xa9, 0x64, 0xe2, 0xf5,
-		0xa1, 0xf4, 0xcb, 0x2f, 0xbf, 0xfc, 0x72, 0x44, 0xfe, 0xea, 0x20, 0x4c, 0xb6, 0x1b, 0x33, 0x6d,
-		0x87, 0xef, 0x61, 0x18, 0xd4, 0x9b, 0x8d, 0x4d, 0x6c, 0x51, 0x23, 0xc5, 0x15, 0x5e, 0x42, 0x79,
-		0x88, 0xd7, 0xd5, 0x4d, 0x5c, 0xcf, 0xc4, 0x66, 0xa4, 0x93, 0xa3, 0x67, 0x1e, 0xec, 0x6b, 0x54,
-		0xce, 0x2e, 0x12, 0x12, 0x85, 0x51, 0xa2, 0x37, 0x43, 0x8c, 0x87, 0x68, 0xc2, 0xe1, 0x54, 0x7f,
-		0x1c, 0xc8, 0x58, 0x52, 0x28, 0x1d, 0x3a, 0x06, 0x49, 0xf2, 0x97, 0xf9, 0xc6, 0x20, 0x95, 0x39,
-		0x41, 0x00, 0xc4, 0x2f, 0x50, 0x16, 0x12, 0x74, 0x98, 0x54, 0xb1, 0x98, 0xda, 0xdc, 0x32, 0x71,
-		0xac, 0x2a, 0xde, 0x52, 0x9b, 0x75, 0xa7, 0x7c, 0x45, 0xad, 0x37, 0x31, 0x75, 0xf8, 0xa4, 0x92,
-		0xe2, 0xc0, 0xcb, 0x04, 0x86, 0xa6, 0x61, 0x98, 0x8d, 0x2a, 0x4d, 0xaf, 0xe2, 0x5d, 0x1a, 0x3d,
-		0xe3, 0x0a, 0x1b, 0x68, 0x0b, 0x04, 0x42, 0x9a, 0x7f, 0xd1, 0x36, 0x74, 0xe1, 0x9a, 0xb4, 0x09,
-		0x02, 0xa0, 0xcd, 0x3f, 0x11, 0x0e, 0xdc, 0x27, 0xda, 0xab, 0xd7, 0x32, 0x96, 0xee, 0x87, 0x31,
-		0x8a, 0xf1, 0x18, 0xef, 0x7a, 0xb5, 0x9e, 0x19, 0x9f, 0x91, 0x4e, 0x26, 0x94, 0x51, 0x06, 0x5e,
-		0xe1, 0x50, 0xf9, 0xcb, 0x11, 0x88, 0xd1, 0xc0, 0x32, 0x06, 0xc3, 0xeb, 0x6f, 0x5d, 0x2d, 0x95,
-		0x8b, 0x2b, 0x1b, 0x85, 0xc5, 0x52, 0x5a, 0x42, 0xa3, 0x00, 0x14, 0x70, 0x61, 0x71, 0x25, 0xbf,
-		0x9e, 0x8e, 0xb8, 0xe5, 0x85, 0xe5, 0xf5, 0x73, 0x8f, 0xa7, 0xa3, 0x2e, 0xc1, 0x06, 0x03, 0xc4,
-		0xfc, 0x08, 0x8f, 0x9d, 0x49, 0xc7, 0x51, 0x1a, 0x52, 0x8c, 0xc1, 0xc2, 0xf3, 0xa5, 0xe2, 0xb9,
-		0xc7, 0xd3, 0x83, 0x41, 0xc8, 0x63, 0x67, 0xd2, 0x43, 0x68, 0x04, 0x92, 0x14, 0x52, 0x58, 0x59,
-		0x59, 0x4c, 0x27, 0x5c, 0x9e, 0x6b, 0xeb, 0xca, 0xc2, 0xf2, 0x7c, 0x3a, 0xe9, 0xf2, 0x9c, 0x57,
-		0x56, 0x36, 0x56, 0xd3, 0xe0, 0x72, 0x58, 0x2a, 0xad, 0xad, 0xe5, 0xe7, 0x4b, 0xe9, 0x61, 0x17,
-		0xa3, 0xf0, 0xd6, 0xf5, 0xd2, 0x5a, 0x3a, 0x15, 0x10, 0xeb, 0xb1, 0x33, 0xe9, 0x11, 0xb7, 0x89,
-		0xd2, 0xf2, 0xc6, 0x52, 0x7a, 0x14, 0x8d, 0xc3, 0x08, 0x6b, 0x42, 0x08, 0x31, 0x16, 0x02, 0x9d,
-		0x7b, 0x3c, 0x9d, 0xf6, 0x04, 0x61, 0x5c, 0xc6, 0x03, 0x80, 0x73, 0x8f, 0xa7, 0x91, 0x3c, 0x07,
-		0x71, 0xea, 0x86, 0x08, 0xc1, 0xe8, 0x62, 0xbe, 0x50, 0x5a, 0x2c, 0xaf, 0xac, 0xae, 0x2f, 0xac,
-		0x2c, 0xe7, 0x17, 0xd3, 0x92, 0x07, 0x53, 0x4a, 0xcf, 0x6e, 0x2c, 0x28, 0xa5, 0x62, 0x3a, 0xe2,
-		0x87, 0xad, 0x96, 0xf2, 0xeb, 0xa5, 0x62, 0x3a, 0x2a, 0x57, 0x60, 0xb2, 0x5d, 0x40, 0x6d, 0x3b,
-		0x84, 0x7c, 0xbe, 0x10, 0xe9, 0xe0, 0x0b, 0x94, 0x57, 0xd8, 0x17, 0xe4, 0xef, 0x44, 0x60, 0xa2,
-		0xcd, 0xa4, 0xd2, 0xb6, 0x91, 0xa7, 0x21, 0xce, 0x7c, 0x99, 0x4d, 0xb3, 0x0f, 0xb4, 0x9d, 0x9d,
-		0xa8, 0x67, 0xb7, 0x4c, 0xb5, 0x94, 0xce, 0x9f, 0x6a, 0x44, 0x3b, 0xa4, 0x1a, 0x84, 0x45, 0x8b,
-		0xc3, 0xfe, 0x6c, 0x4b, 0xf0, 0x67, 0xf3, 0xe3, 0xb9, 0x7e, 0xe6, 0x47, 0x0a, 0xdb, 0xdf, 0x24,
-		0x10, 0x6f, 0x33, 0x09, 0x9c, 0x87, 0xf1, 0x16, 0x46, 0x7d, 0x07, 0xe3, 0xf7, 0x4a, 0x90, 0xe9,
-		0x64, 0x9c, 0x1e, 0x21, 0x31, 0x12, 0x08, 0x89, 0xe7, 0xc3, 0x16, 0xbc, 0xab, 0x73, 0x27, 0xb4,
-		0xf4, 0xf5, 0x67, 0x24, 0x38, 0xdc, 0x3e, 0xa5, 0x6c, 0x2b, 0xc3, 0x9b, 0x61, 0xb0, 0x81, 0x9d,
-		0x6d, 0x43, 0xa4, 0x55, 0xf7, 0xb5, 0x99, 0xac, 0x49, 0x75, 0xb8, 0xb3, 0x39, 0x95, 0x7f, 0xb6,
-		0x8f, 0x76, 0xca, 0x0b, 0x99, 0x34, 0x2d, 0x92, 0x7e, 0x30, 0x02, 0x87, 0xda, 0x32, 0x6f, 0x2b,
-		0xe8, 0x09, 0x00, 0x4d, 0x37, 0x9b, 0x0e, 0x4b, 0x9d, 0x58, 0x24, 0x4e, 0x52, 0x08, 0x0d, 0x5e,
-		0x24, 0xca, 0x36, 0x1d, 0xb7, 0x3e, 0x4a, 0xeb, 0x81, 0x81, 0x28, 0xc2, 0x93, 0x9e, 0xa0, 0x31,
-		0x2a, 0xe8, 0x54, 0x07, 0x4d, 0x5b, 0x1c, 0xf3, 0x11, 0x48, 0x57, 0xea, 0x1a, 0xd6, 0x9d, 0xb2,
-		0xed, 0x58, 0x58, 0x6d, 0x68, 0x7a, 0x8d, 0x4e, 0x35, 0x89, 0x5c, 0x7c, 0x4b, 0xad, 0xdb, 0x58,
-		0x19, 0x63, 0xd5, 0x6b, 0xa2, 0x96, 0x50, 0x50, 0x07, 0xb2, 0x7c, 0x14, 0x83, 0x01, 0x0a, 0x56,
-		0xed, 0x52, 0xc8, 0x1f, 0x4e, 0xc2, 0xb0, 0x2f, 0x01, 0x47, 0x77, 0x41, 0xea, 0x45, 0xf5, 0x8a,
-		0x5a, 0x16, 0x8b, 0x2a, 0x66, 0x89, 0x61, 0x02, 0x5b, 0xe5, 0x0b, 0xab, 0x47, 0x60, 0x92, 0xa2,
-		0x18, 0x4d, 0x07, 0x5b, 0xe5, 0x4a, 0x5d, 0xb5, 0x6d, 0x6a, 0xb4, 0x04, 0x45, 0x45, 0xa4, 0x6e,
-		0x85, 0x54, 0xcd, 0x89, 0x1a, 0x74, 0x16, 0x26, 0x28, 0x45, 0xa3, 0x59, 0x77, 0x34, 0xb3, 0x8e,
-		0xcb, 0x64, 0x99, 0x67, 0xd3, 0x29, 0xc7, 0x95, 0x6c, 0x9c, 0x60, 0x2c, 0x71, 0x04, 0x22, 0x91,
-		0x8d, 0x8a, 0x70, 0x82, 0x92, 0xd5, 0xb0, 0x8e, 0x2d, 0xd5, 0xc1, 0x65, 0xfc, 0x52, 0x53, 0xad,
-		0xdb, 0x65, 0x55, 0xaf, 0x96, 0xb7, 0x55, 0x7b, 0x3b, 0x33, 0x49, 0x18, 0x14, 0x22, 0x19, 0x49,
-		0x39, 0x4a, 0x10, 0xe7, 0x39, 0x5e, 0x89, 0xa2, 0xe5, 0xf5, 0xea, 0x45, 0xd5, 0xde, 0x46, 0x39,
-		0x38, 0x4c, 0xb9, 0xd8, 0x8e, 0xa5, 0xe9, 0xb5, 0x72, 0x65, 0x1b, 0x57, 0x76, 0xca, 0x4d, 0x67,
-		0xeb, 0xc9, 0xcc, 0x31, 0x7f, 0xfb, 0x54, 0xc2, 0x35, 0x8a, 0x33, 0x47, 0x50, 0x36, 0x9c, 0xad,
-		0x27, 0xd1, 0x1a, 0xa4, 0x48, 0x67, 0x34, 0xb4, 0x6b, 0xb8, 0xbc, 0x65, 0x58, 0x74, 0x0e, 0x1d,
-		0x6d, 0x13, 0x9a, 0x7c, 0x16, 0x9c, 0x5d, 0xe1, 0x04, 0x4b, 0x46, 0x15, 0xe7, 0xe2, 0x6b, 0xab,
-		0xa5, 0x52, 0x51, 0x19, 0x16, 0x5c, 0x2e, 0x18, 0x16, 0x71, 0xa8, 0x9a, 0xe1, 0x1a, 0x78, 0x98,
-		0x39, 0x54, 0xcd, 0x10, 0xe6, 0x3d, 0x0b, 0x13, 0x95, 0x0a, 0xd3, 0x59, 0xab, 0x94, 0xf9, 0x62,
-		0xcc, 0xce, 0xa4, 0x03, 0xc6, 0xaa, 0x54, 0xe6, 0x19, 0x02, 0xf7, 0x71, 0x1b, 0x3d, 0x05, 0x87,
-		0x3c, 0x63, 0xf9, 0x09, 0xc7, 0x5b, 0xb4, 0x0c, 0x93, 0x9e, 0x85, 0x09, 0x73, 0xaf, 0x95, 0x10,
-		0x05, 0x5a, 0x34, 0xf7, 0xc2, 0x64, 0x4f, 0xc0, 0xa4, 0xb9, 0x6d, 0xb6, 0xd2, 0x9d, 0xf2, 0xd3,
-		0x21, 0x73, 0xdb, 0x0c, 0x13, 0xde, 0x4b, 0x57, 0xe6, 0x16, 0xae, 0xa8, 0x0e, 0xae, 0x66, 0x8e,
-		0xf8, 0xd1, 0x7d, 0x15, 0x68, 0x16, 0xd2, 0x95, 0x4a, 0x19, 0xeb, 0xea, 0x66, 0x1d, 0x97, 0x55,
-		0x0b, 0xeb, 0xaa, 0x9d, 0x99, 0xa6, 0xc8, 0x31, 0xc7, 0x6a, 0x62, 0x65, 0xb4, 0x52, 0x29, 0xd1,
-		0xca, 0x3c, 0xad, 0x43, 0xa7, 0x60, 0xdc, 0xd8, 0x7c, 0xb1, 0xc2, 0x3c, 0xb2, 0x6c, 0x5a, 0x78,
-		0x4b, 0xdb, 0xcd, 0xdc, 0x43, 0xcd, 0x3b, 0x46, 0x2a, 0xa8, 0x3f, 0xae, 0x52, 0x30, 0x7a, 0x00,
-		0xd2, 0x15, 0x7b, 0x5b, 0xb5, 0x4c, 0x1a, 0x92, 0x6d, 0x53, 0xad, 0xe0, 0xcc, 0xbd, 0x0c, 0x95,
-		0xc1, 0x97, 0x05, 0x98, 0x8c, 0x08, 0xfb, 0xaa, 0xb6, 0xe5, 0x08, 0x8e, 0xf7, 0xb3, 0x11, 0x41,
-		0x61, 0x9c, 0xdb, 0x49, 0x48, 0x13, 0x4b, 0x04, 0x1a, 0x3e, 0x49, 0xd1, 0x46, 0xcd, 0x6d, 0xd3,
-		0xdf, 0xee, 0xdd, 0x30, 0x42, 0x30, 0xbd, 0x46, 0x1f, 0x60, 0x89, 0x9b, 0xb9, 0xed, 0x6b, 0xf1,
-		0x71, 0x38, 0x4c, 0x90, 0x1a, 0xd8, 0x51, 0xab, 0xaa, 0xa3, 0xfa, 0xb0, 0x1f, 0xa2, 0xd8, 0xc4,
-		0xec, 0x4b, 0xbc, 0x32, 0x20, 0xa7, 0xd5, 0xdc, 0xdc, 0x73, 0x1d, 0xeb, 0x61, 0x26, 0x27, 0x81,
-		0x09, 0xd7, 0xba, 0x63, 0xc9, 0xb9, 0x9c, 0x83, 0x94, 0xdf, 0xef, 0x51, 0x12, 0x98, 0xe7, 0xa7,
-		0x25, 0x92, 0x04, 0xcd, 0xad, 0x14, 0x49, 0xfa, 0xf2, 0x42, 0x29, 0x1d, 0x21, 0x69, 0xd4, 0xe2,
-		0xc2, 0x7a, 0xa9, 0xac, 0x6c, 0x2c, 0xaf, 0x2f, 0x2c, 0x95, 0xd2, 0x51, 0x5f, 0x62, 0xff, 0x4c,
-		0x2c, 0x71, 0x5f, 0xfa, 0x7e, 0xf9, 0x5b, 0x11, 0x18, 0x0d, 0xae, 0xd4, 0xd0, 0xcf, 0xc0, 0x11,
-		0xb1, 0xad, 0x62, 0x63, 0xa7, 0x7c, 0x55, 0xb3, 0xe8, 0x80, 0x6c, 0xa8, 0x6c, 0x72, 0x74, 0xfd,
-		0x67, 0x92, 0x63, 0xad, 0x61, 0xe7, 0x39, 0xcd, 0x22, 0xc3, 0xad, 0xa1, 0x3a, 0x68, 0x11, 0xa6,
-		0x75, 0xa3, 0x6c, 0x3b, 0xaa, 0x5e, 0x55, 0xad, 0x6a, 0xd9, 0xdb, 0xd0, 0x2a, 0xab, 0x95, 0x0a,
-		0xb6, 0x6d, 0x83, 0x4d, 0x84, 0x2e, 0x97, 0xe3, 0xba, 0xb1, 0xc6, 0x91, 0xbd, 0x19, 0x22, 0xcf,
-		0x51, 0x43, 0xee, 0x1b, 0xed, 0xe4, 0xbe, 0xc7, 0x20, 0xd9, 0x50, 0xcd, 0x32, 0xd6, 0x1d, 0x6b,
-		0x8f, 0xe6, 0xe7, 0x09, 0x25, 0xd1, 0x50, 0xcd, 0x12, 0x29, 0xff, 0x58, 0x96, 0x49, 0xcf, 0xc4,
-		0x12, 0x89, 0x74, 0xf2, 0x99, 0x58, 0x22, 0x99, 0x06, 0xf9, 0xb5, 0x28, 0xa4, 0xfc, 0xf9, 0x3a,
-		0x59, 0xfe, 0x54, 0xe8, 0x8c, 0x25, 0xd1, 0x98, 0x76, 0x77, 0xd7, 0xec, 0x7e, 0x76, 0x8e, 0x4c,
-		0x65, 0xb9, 0x41, 0x96, 0x1c, 0x2b, 0x8c, 0x92, 0xa4, 0x11, 0xc4, 0xd9, 0x30, 0x4b, 0x46, 0x12,
-		0x0a, 0x2f, 0xa1, 0x79, 0x18, 0x7c, 0xd1, 0xa6, 0xbc, 0x07, 0x29, 0xef, 0x7b, 0xba, 0xf3, 0x7e,
-		0x66, 0x8d, 0x32, 0x4f, 0x3e, 0xb3, 0x56, 0x5e, 0x5e, 0x51, 0x96, 0xf2, 0x8b, 0x0a, 0x27, 0x47,
-		0x47, 0x21, 0x56, 0x57, 0xaf, 0xed, 0x05, 0x27, 0x3d, 0x0a, 0xea, 0xb7, 0x13, 0x8e, 0x42, 0xec,
-		0x2a, 0x56, 0x77, 0x82, 0x53, 0x0d, 0x05, 0xdd, 0xc1, 0xc1, 0x70, 0x1a, 0xe2, 0xd4, 0x5e, 0x08,
-		0x80, 0x5b, 0x2c, 0x3d, 0x80, 0x12, 0x10, 0x9b, 0x5b, 0x51, 0xc8, 0x80, 0x48, 0x43, 0x8a, 0x41,
-		0xcb, 0xab, 0x0b, 0xa5, 0xb9, 0x52, 0x3a, 0x22, 0x9f, 0x85, 0x41, 0x66, 0x04, 0x32, 0x58, 0x5c,
-		0x33, 0xa4, 0x07, 0x78, 0x91, 0xf3, 0x90, 0x44, 0xed, 0xc6, 0x52, 0xa1, 0xa4, 0xa4, 0x23, 0xc1,
-		0xae, 0x8e, 0xa5, 0xe3, 0xb2, 0x0d, 0x29, 0x7f, 0x1e, 0xfe, 0xe3, 0x59, 0x8c, 0x7f, 0x45, 0x82,
-		0x61, 0x5f, 0x5e, 0x4d, 0x12, 0x22, 0xb5, 0x5e, 0x37, 0xae, 0x96, 0xd5, 0xba, 0xa6, 0xda, 0xdc,
-		0x35, 0x80, 0x82, 0xf2, 0x04, 0xd2, 0x6f, 0xd7, 0xfd, 0x98, 0x86, 0x48, 0x3c, 0x3d, 0x28, 0x7f,
-		0x52, 0x82, 0x74, 0x38, 0xb1, 0x0d, 0x89, 0x29, 0xfd, 0x24, 0xc5, 0x94, 0x3f, 0x21, 0xc1, 0x68,
-		0x30, 0x9b, 0x0d, 0x89, 0x77, 0xd7, 0x4f, 0x54, 0xbc, 0x3f, 0x8c, 0xc0, 0x48, 0x20, 0x87, 0xed,
-		0x57, 0xba, 0x97, 0x60, 0x5c, 0xab, 0xe2, 0x86, 0x69, 0x38, 0x58, 0xaf, 0xec, 0x95, 0xeb, 0xf8,
-		0x0a, 0xae, 0x67, 0x64, 0x1a, 0x34, 0x4e, 0x77, 0xcf, 0x92, 0x67, 0x17, 0x3c, 0xba, 0x45, 0x42,
-		0x96, 0x9b, 0x58, 0x28, 0x96, 0x96, 0x56, 0x57, 0xd6, 0x4b, 0xcb, 0x73, 0x6f, 0x2d, 0x6f, 0x2c,
-		0x5f, 0x5a, 0x5e, 0x79, 0x6e, 0x59, 0x49, 0x6b, 0x21, 0xb4, 0x3b, 0x38, 0xec, 0x57, 0x21, 0x1d,
-		0x16, 0x0a, 0x1d, 0x81, 0x76, 0x62, 0xa5, 0x07, 0xd0, 0x04, 0x8c, 0x2d, 0xaf, 0x94, 0xd7, 0x16,
-		0x8a, 0xa5, 0x72, 0xe9, 0xc2, 0x85, 0xd2, 0xdc, 0xfa, 0x1a, 0xdb, 0xf7, 0x70, 0xb1, 0xd7, 0x03,
-		0x03, 0x5c, 0x7e, 0x25, 0x0a, 0x13, 0x6d, 0x24, 0x41, 0x79, 0xbe, 0x62, 0x61, 0x8b, 0xa8, 0x87,
-		0xfb, 0x91, 0x7e, 0x96, 0xe4, 0x0c, 0xab, 0xaa, 0xe5, 0xf0, 0x05, 0xce, 0x03, 0x40, 0xac, 0xa4,
-		0x3b, 0xda, 0x96, 0x86, 0x2d, 0xbe, 0x9f, 0xc4, 0x96, 0x31, 0x63, 0x1e, 0x9c, 0x6d, 0x29, 0x3d,
-		0x04, 0xc8, 0x34, 0x6c, 0xcd, 0xd1, 0xae, 0xe0, 0xb2, 0xa6, 0x8b, 0xcd, 0x27, 0xb2, 0xac, 0x89,
-		0x29, 0x69, 0x51, 0xb3, 0xa0, 0x3b, 0x2e, 0xb6, 0x8e, 0x6b, 0x6a, 0x08, 0x9b, 0x04, 0xf3, 0xa8,
-		0x92, 0x16, 0x35, 0x2e, 0xf6, 0x5d, 0x90, 0xaa, 0x1a, 0x4d, 0x92, 0xeb, 0x31, 0x3c, 0x32, 0x77,
-		0x48, 0xca, 0x30, 0x83, 0xb9, 0x28, 0x3c, 0x8b, 0xf7, 0x76, 0xbd, 0x52, 0xca, 0x30, 0x83, 0x31,
-		0x94, 0xfb, 0x61, 0x4c, 0xad, 0xd5, 0x2c, 0xc2, 0x5c, 0x30, 0x62, 0xeb, 0x92, 0x51, 0x17, 0x4c,
-		0x11, 0xb3, 0xcf, 0x40, 0x42, 0xd8, 0x81, 0x4c, 0xd5, 0xc4, 0x12, 0x65, 0x93, 0x2d, 0xb6, 0x23,
-		0x27, 0x93, 0x4a, 0x42, 0x17, 0x95, 0x77, 0x41, 0x4a, 0xb3, 0xcb, 0xde, 0x26, 0x7e, 0x64, 0x26,
-		0x72, 0x32, 0xa1, 0x0c, 0x6b, 0xb6, 0xbb, 0x01, 0x2a, 0x7f, 0x26, 0x02, 0xa3, 0xc1, 0x43, 0x08,
-		0x54, 0x84, 0x44, 0xdd, 0xa8, 0xa8, 0xd4, 0xb5, 0xd8, 0x09, 0xd8, 0xc9, 0x1e, 0xe7, 0x16, 0xb3,
-		0x8b, 0x1c, 0x5f, 0x71, 0x29, 0xb3, 0xbf, 0x23, 0x41, 0x42, 0x80, 0xd1, 0x61, 0x88, 0x99, 0xaa,
-		0xb3, 0x4d, 0xd9, 0xc5, 0x0b, 0x91, 0xb4, 0xa4, 0xd0, 0x32, 0x81, 0xdb, 0xa6, 0xaa, 0x53, 0x17,
-		0xe0, 0x70, 0x52, 0x26, 0xfd, 0x5a, 0xc7, 0x6a, 0x95, 0x2e, 0x7a, 0x8c, 0x46, 0x03, 0xeb, 0x8e,
-		0x2d, 0xfa, 0x95, 0xc3, 0xe7, 0x38, 0x18, 0x3d, 0x08, 0xe3, 0x8e, 0xa5, 0x6a, 0xf5, 0x00, 0x6e,
-		0x8c, 0xe2, 0xa6, 0x45, 0x85, 0x8b, 0x9c, 0x83, 0xa3, 0x82, 0x6f, 0x15, 0x3b, 0x6a, 0x65, 0x1b,
-		0x57, 0x3d, 0xa2, 0x41, 0xba, 0xb9, 0x71, 0x84, 0x23, 0x14, 0x79, 0xbd, 0xa0, 0x95, 0xbf, 0x25,
-		0xc1, 0xb8, 0x58, 0xa6, 0x55, 0x5d, 0x63, 0x2d, 0x01, 0xa8, 0xba, 0x6e, 0x38, 0x7e, 0x73, 0xb5,
-		0xba, 0x72, 0x0b, 0xdd, 0x6c, 0xde, 0x25, 0x52, 0x7c, 0x0c, 0xb2, 0x0d, 0x00, 0xaf, 0xa6, 0xa3,
-		0xd9, 0xa6, 0x61, 0x98, 0x9f, 0x30, 0xd1, 0x63, 0x4a, 0xb6, 0xb0, 0x07, 0x06, 0x22, 0xeb, 0x39,
-		0x34, 0x09, 0xf1, 0x4d, 0x5c, 0xd3, 0x74, 0xbe, 0x6f, 0xcc, 0x0a, 0x62, 0xfb, 0x25, 0xe6, 0x6e,
-		0xbf, 0x14, 0xfe, 0x32, 0x4c, 0x54, 0x8c, 0x46, 0x58, 0xdc, 0x42, 0x3a, 0xb4, 0xb9, 0x60, 0x5f,
-		0x94, 0x5e, 0x78, 0x98, 0x23, 0xd5, 0x8c, 0xba, 0xaa, 0xd7, 0x66, 0x0d, 0xab, 0xe6, 0x1d, 0xb3,
-		0x92, 0x8c, 0xc7, 0xf6, 0x1d, 0xb6, 0x9a, 0x9b, 0x7f, 0x26, 0x49, 0xbf, 0x14, 0x89, 0xce, 0xaf,
-		0x16, 0x3e, 0x1b, 0xc9, 0xce, 0x33, 0xc2, 0x55, 0x61, 0x0c, 0x05, 0x6f, 0xd5, 0x71, 0x85, 0x28,
-		0x08, 0xdf, 0x7b, 0x10, 0x26, 0x6b, 0x46, 0xcd, 0xa0, 0x9c, 0x4e, 0x93, 0x5f, 0xfc, 0x9c, 0x36,
-		0xe9, 0x42, 0xb3, 0x3d, 0x0f, 0x75, 0x73, 0xcb, 0x30, 0xc1, 0x91, 0xcb, 0xf4, 0xa0, 0x88, 0x2d,
-		0x63, 0x50, 0xd7, 0x3d, 0xb4, 0xcc, 0x17, 0xbe, 0x4b, 0xa7, 0x6f, 0x65, 0x9c, 0x93, 0x92, 0x3a,
-		0xb6, 0xd2, 0xc9, 0x29, 0x70, 0x28, 0xc0, 0x8f, 0x0d, 0x52, 0x6c, 0xf5, 0xe0, 0xf8, 0x9b, 0x9c,
-		0xe3, 0x84, 0x8f, 0xe3, 0x1a, 0x27, 0xcd, 0xcd, 0xc1, 0xc8, 0x7e, 0x78, 0xfd, 0x0b, 0xce, 0x2b,
-		0x85, 0xfd, 0x4c, 0xe6, 0x61, 0x8c, 0x32, 0xa9, 0x34, 0x6d, 0xc7, 0x68, 0xd0, 0x08, 0xd8, 0x9d,
-		0xcd, 0x6f, 0x7d, 0x97, 0x8d, 0x9a, 0x51, 0x42, 0x36, 0xe7, 0x52, 0xe5, 0x72, 0x40, 0xcf, 0xc6,
-		0xaa, 0xb8, 0x52, 0xef, 0xc1, 0xe1, 0x6b, 0x5c, 0x10, 0x17, 0x3f, 0x77, 0x19, 0x26, 0xc9, 0x6f,
-		0x1a, 0xa0, 0xfc, 0x92, 0xf4, 0xde, 0x70, 0xcb, 0x7c, 0xeb, 0xbd, 0x6c, 0x60, 0x4e, 0xb8, 0x0c,
-		0x7c, 0x32, 0xf9, 0x7a, 0xb1, 0x86, 0x1d, 0x07, 0x5b, 0x76, 0x59, 0xad, 0xb7, 0x13, 0xcf, 0xb7,
-		0x63, 0x91, 0xf9, 0xd8, 0xf7, 0x83, 0xbd, 0x38, 0xcf, 0x28, 0xf3, 0xf5, 0x7a, 0x6e, 0x03, 0x8e,
-		0xb4, 0xf1, 0x8a, 0x3e, 0x78, 0xbe, 0xc2, 0x79, 0x4e, 0xb6, 0x78, 0x06, 0x61, 0xbb, 0x0a, 0x02,
-		0xee, 0xf6, 0x65, 0x1f, 0x3c, 0x3f, 0xce, 0x79, 0x22, 0x4e, 0x2b, 0xba, 0x94, 0x70, 0x7c, 0x06,
-		0xc6, 0xaf, 0x60, 0x6b, 0xd3, 0xb0, 0xf9, 0x2e, 0x51, 0x1f, 0xec, 0x3e, 0xc1, 0xd9, 0x8d, 0x71,
-		0x42, 0xba, 0x6d, 0x44, 0x78, 0x3d, 0x05, 0x89, 0x2d, 0xb5, 0x82, 0xfb, 0x60, 0x71, 0x83, 0xb3,
-		0x18, 0x22, 0xf8, 0x84, 0x34, 0x0f, 0xa9, 0x9a, 0xc1, 0xe7, 0xa8, 0xde, 0xe4, 0x9f, 0xe4, 0xe4,
-		0xc3, 0x82, 0x86, 0xb3, 0x30, 0x0d, 0xb3, 0x59, 0x27, 0x13, 0x58, 0x6f, 0x16, 0x7f, 0x4b, 0xb0,
-		0x10, 0x34, 0x9c, 0xc5, 0x3e, 0xcc, 0xfa, 0xaa, 0x60, 0x61, 0xfb, 0xec, 0xf9, 0x34, 0x0c, 0x1b,
-		0x7a, 0x7d, 0xcf, 0xd0, 0xfb, 0x11, 0xe2, 0x53, 0x9c, 0x03, 0x70, 0x12, 0xc2, 0xe0, 0x3c, 0x24,
-		0xfb, 0xed, 0x88, 0xbf, 0xfd, 0x7d, 0x31, 0x3c, 0x44, 0x0f, 0xcc, 0xc3, 0x98, 0x08, 0x50, 0x9a,
-		0xa1, 0xf7, 0xc1, 0xe2, 0xef, 0x70, 0x16, 0xa3, 0x3e, 0x32, 0xae, 0x86, 0x83, 0x6d, 0xa7, 0x86,
-		0xfb, 0x61, 0xf2, 0x19, 0xa1, 0x06, 0x27, 0xe1, 0xa6, 0xdc, 0xc4, 0x7a, 0x65, 0xbb, 0x3f, 0x0e,
-		0xbf, 0x22, 0x4c, 0x29, 0x68, 0x08, 0x8b, 0x39, 0x18, 0x69, 0xa8, 0x96, 0xbd, 0xad, 0xd6, 0xfb,
-		0xea, 0x8e, 0xbf, 0xcb, 0x79, 0xa4, 0x5c, 0x22, 0x6e, 0x91, 0xa6, 0xbe, 0x1f, 0x36, 0x9f, 0x15,
-		0x16, 0xf1, 0x91, 0xf1, 0xa1, 0x67, 0x3b, 0x74, 0x4b, 0x6d, 0x3f, 0xdc, 0x7e, 0x55, 0x0c, 0x3d,
-		0x46, 0xbb, 0xe4, 0xe7, 0x78, 0x1e, 0x92, 0xb6, 0x76, 0xad, 0x2f, 0x36, 0x9f, 0x13, 0x3d, 0x4d,
-		0x09, 0x08, 0xf1, 0x5b, 0xe1, 0x68, 0xdb, 0x69, 0xa2, 0x0f, 0x66, 0x7f, 0x8f, 0x33, 0x3b, 0xdc,
-		0x66, 0xaa, 0xe0, 0x21, 0x61, 0xbf, 0x2c, 0xff, 0xbe, 0x08, 0x09, 0x38, 0xc4, 0x6b, 0x95, 0xac,
-		0x1a, 0x6c, 0x75, 0x6b, 0x7f, 0x56, 0xfb, 0x35, 0x61, 0x35, 0x46, 0x1b, 0xb0, 0xda, 0x3a, 0x1c,
-		0xe6, 0x1c, 0xf7, 0xd7, 0xaf, 0x9f, 0x17, 0x81, 0x95, 0x51, 0x6f, 0x04, 0x7b, 0xf7, 0x6d, 0x90,
-		0x75, 0xcd, 0x29, 0xd2, 0x53, 0xbb, 0xdc, 0x50, 0xcd, 0x3e, 0x38, 0x7f, 0x81, 0x73, 0x16, 0x11,
-		0xdf, 0xcd, 0x6f, 0xed, 0x25, 0xd5, 0x24, 0xcc, 0x9f, 0x87, 0x8c, 0x60, 0xde, 0xd4, 0x2d, 0x5c,
-		0x31, 0x6a, 0xba, 0x76, 0x0d, 0x57, 0xfb, 0x60, 0xfd, 0xeb, 0xa1, 0xae, 0xda, 0xf0, 0x91, 0x13,
-		0xce, 0x0b, 0x90, 0x76, 0x73, 0x95, 0xb2, 0xd6, 0x30, 0x0d, 0xcb, 0xe9, 0xc1, 0xf1, 0x8b, 0xa2,
-		0xa7, 0x5c, 0xba, 0x05, 0x4a, 0x96, 0x2b, 0x01, 0x3b, 0x67, 0xee, 0xd7, 0x25, 0xbf, 0xc4, 0x19,
-		0x8d, 0x78, 0x54, 0x3c, 0x70, 0x54, 0x8c, 0x86, 0xa9, 0x5a, 0xfd, 0xc4, 0xbf, 0x7f, 0x20, 0x02,
-		0x07, 0x27, 0xe1, 0x81, 0x83, 0x64, 0x74, 0x64, 0xb6, 0xef, 0x83, 0xc3, 0x97, 0x45, 0xe0, 0x10,
-		0x34, 0x9c, 0x85, 0x48, 0x18, 0xfa, 0x60, 0xf1, 0x0f, 0x05, 0x0b, 0x41, 0x43, 0x58, 0x3c, 0xeb,
-		0x4d, 0xb4, 0x16, 0xae, 0x69, 0xb6, 0x63, 0xb1, 0xa4, 0xb8, 0x3b, 0xab, 0x7f, 0xf4, 0xfd, 0x60,
-		0x12, 0xa6, 0xf8, 0x48, 0x49, 0x24, 0xe2, 0x9b, 0xac, 0x74, 0xcd, 0xd4, 0x5b, 0xb0, 0xdf, 0x10,
-		0x91, 0xc8, 0x47, 0x46, 0x64, 0xf3, 0x65, 0x88, 0xc4, 0xec, 0x15, 0xb2, 0x52, 0xe8, 0x83, 0xdd,
-		0x3f, 0x0e, 0x09, 0xb7, 0x26, 0x68, 0x09, 0x4f, 0x5f, 0xfe, 0xd3, 0xd4, 0x77, 0xf0, 0x5e, 0x5f,
-		0xde, 0xf9, 0x4f, 0x42, 0xf9, 0xcf, 0x06, 0xa3, 0x64, 0x31, 0x64, 0x2c, 0x94, 0x4f, 0xa1, 0x5e,
-		0xb7, 0x8a, 0x32, 0xef, 0xfe, 0x21, 0xd7, 0x37, 0x98, 0x4e, 0xe5, 0x16, 0x89, 0x93, 0x07, 0x93,
-		0x9e, 0xde, 0xcc, 0xde, 0xfb, 0x43, 0xd7, 0xcf, 0x03, 0x39, 0x4f, 0xee, 0x02, 0x8c, 0x04, 0x12,
-		0x9e, 0xde, 0xac, 0xde, 0xc7, 0x59, 0xa5, 0xfc, 0xf9, 0x4e, 0xee, 0x2c, 0xc4, 0x48, 0xf2, 0xd2,
-		0x9b, 0xfc, 0xaf, 0x70, 0x72, 0x8a, 0x9e, 0x7b, 0x13, 0x24, 0x44, 0xd2, 0xd2, 0x9b, 0xf4, 0xfd,
-		0x9c, 0xd4, 0x25, 0x21, 0xe4, 0x22, 0x61, 0xe9, 0x4d, 0xfe, 0x57, 0x05, 0xb9, 0x20, 0x21, 0xe4,
-		0xfd, 0x9b, 0xf0, 0x2b, 0x3f, 0x17, 0xe3, 0x93, 0x8e, 0xb0, 0xdd, 0x79, 0x18, 0xe2, 0x99, 0x4a,
-		0x6f, 0xea, 0x0f, 0xf2, 0xc6, 0x05, 0x45, 0xee, 0x09, 0x88, 0xf7, 0x69, 0xf0, 0x9f, 0xe7, 0xa4,
-		0x0c, 0x3f, 0x37, 0x07, 0xc3, 0xbe, 0xec, 0xa4, 0x37, 0xf9, 0x5f, 0xe3, 0xe4, 0x7e, 0x2a, 0x22,
-		0x3a, 0xcf, 0x4e, 0x7a, 0x33, 0xf8, 0x05, 0x21, 0x3a, 0xa7, 0x20, 0x66, 0x13, 0x89, 0x49, 0x6f,
-		0xea, 0x0f, 0x09, 0xab, 0x0b, 0x92, 0xdc, 0xd3, 0x90, 0x74, 0x27, 0x9b, 0xde, 0xf4, 0x1f, 0xe6,
-		0xf4, 0x1e, 0x0d, 0xb1, 0x80, 0x6f, 0xb2, 0xeb, 0xcd, 0xe2, 0xaf, 0x0b, 0x0b, 0xf8, 0xa8, 0xc8,
-		0x30, 0x0a, 0x27, 0x30, 0xbd, 0x39, 0x7d, 0x44, 0x0c, 0xa3, 0x50, 0xfe, 0x42, 0x7a, 0x93, 0xc6,
-		0xfc, 0xde, 0x2c, 0xfe, 0x86, 0xe8, 0x4d, 0x8a, 0x4f, 0xc4, 0x08, 0x67, 0x04, 0xbd, 0x79, 0xfc,
-		0xa2, 0x10, 0x23, 0x94, 0x10, 0xe4, 0x56, 0x01, 0xb5, 0x66, 0x03, 0xbd, 0xf9, 0x7d, 0x94, 0xf3,
-		0x1b, 0x6f, 0x49, 0x06, 0x72, 0xcf, 0xc1, 0xe1, 0xf6, 0x99, 0x40, 0x6f, 0xae, 0x1f, 0xfb, 0x61,
-		0x68, 0xed, 0xe6, 0x4f, 0x04, 0x72, 0xeb, 0xde, 0x94, 0xe2, 0xcf, 0x02, 0x7a, 0xb3, 0x7d, 0xe5,
-		0x87, 0xc1, 0xc0, 0xed, 0x4f, 0x02, 0x72, 0x79, 0x00, 0x6f, 0x02, 0xee, 0xcd, 0xeb, 0x13, 0x9c,
-		0x97, 0x8f, 0x88, 0x0c, 0x0d, 0x3e, 0xff, 0xf6, 0xa6, 0xbf, 0x21, 0x86, 0x06, 0xa7, 0x20, 0x43,
-		0x43, 0x4c, 0xbd, 0xbd, 0xa9, 0x3f, 0x29, 0x86, 0x86, 0x20, 0x21, 0x9e, 0xed, 0x9b, 0xdd, 0x7a,
-		0x73, 0xf8, 0x94, 0xf0, 0x6c, 0x1f, 0x55, 0x6e, 0x19, 0xc6, 0x5b, 0x26, 0xc4, 0xde, 0xac, 0x7e,
-		0x89, 0xb3, 0x4a, 0x87, 0xe7, 0x43, 0xff, 0xe4, 0xc5, 0x27, 0xc3, 0xde, 0xdc, 0x3e, 0x1d, 0x9a,
-		0xbc, 0xf8, 0x5c, 0x98, 0x3b, 0x0f, 0x09, 0xbd, 0x59, 0xaf, 0x93, 0xc1, 0x83, 0xba, 0xdf, 0x04,
-		0xcc, 0xfc, 0xe7, 0x1f, 0x71, 0xeb, 0x08, 0x82, 0xdc, 0x59, 0x88, 0xe3, 0xc6, 0x26, 0xae, 0xf6,
-		0xa2, 0xfc, 0xde, 0x8f, 0x44, 0xc0, 0x24, 0xd8, 0xb9, 0xa7, 0x01, 0xd8, 0xd6, 0x08, 0x3d, 0x0c,
-		0xec, 0x41, 0xfb, 0x5f, 0x7e, 0xc4, 0xaf, 0xde, 0x78, 0x24, 0x1e, 0x03, 0x76, 0x91, 0xa7, 0x3b,
-		0x83, 0xef, 0x07, 0x19, 0xd0, 0x1e, 0x79, 0x0a, 0x86, 0x5e, 0xb4, 0x0d, 0xdd, 0x51, 0x6b, 0xbd,
-		0xa8, 0xff, 0x2b, 0xa7, 0x16, 0xf8, 0xc4, 0x60, 0x0d, 0xc3, 0xc2, 0x8e, 0x5a, 0xb3, 0x7b, 0xd1,
-		0xfe, 0x37, 0x4e, 0xeb, 0x12, 0x10, 0xe2, 0x8a, 0x6a, 0x3b, 0xfd, 0xe8, 0xfd, 0xc7, 0x82, 0x58,
-		0x10, 0x10, 0xa1, 0xc9, 0xef, 0x1d, 0xbc, 0xd7, 0x8b, 0xf6, 0x07, 0x42, 0x68, 0x8e, 0x9f, 0x7b,
-		0x13, 0x24, 0xc9, 0x4f, 0x76, 0x9f, 0xae, 0x07, 0xf1, 0x9f, 0x70, 0x62, 0x8f, 0x82, 0xb4, 0x6c,
-		0x3b, 0x55, 0x47, 0xeb, 0x6d, 0xec, 0x5b, 0xbc, 0xa7, 0x05, 0x7e, 0x2e, 0x0f, 0xc3, 0xb6, 0x53,
-		0xad, 0x36, 0x79, 0x7e, 0xda, 0x83, 0xfc, 0x4f, 0x7f, 0xe4, 0x6e, 0x59, 0xb8, 0x34, 0xa4, 0xb7,
-		0xaf, 0xee, 0x38, 0xa6, 0x41, 0x0f, 0x3c, 0x7a, 0x71, 0xf8, 0x21, 0xe7, 0xe0, 0x23, 0xc9, 0xcd,
-		0x41, 0x8a, 0xe8, 0x62, 0x61, 0x13, 0xd3, 0xd3, 0xa9, 0x1e, 0x2c, 0xfe, 0x3b, 0x37, 0x40, 0x80,
-		0xa8, 0xf0, 0xb3, 0x5f, 0x7b, 0x6d, 0x4a, 0xfa, 0xe6, 0x6b, 0x53, 0xd2, 0x1f, 0xbe, 0x36, 0x25,
-		0x7d, 0xe8, 0x3b, 0x53, 0x03, 0xdf, 0xfc, 0xce, 0xd4, 0xc0, 0xef, 0x7d, 0x67, 0x6a, 0xa0, 0xfd,
-		0x2e, 0x31, 0xcc, 0x1b, 0xf3, 0x06, 0xdb, 0x1f, 0x7e, 0x41, 0xae, 0x69, 0xce, 0x76, 0x73, 0x73,
-		0xb6, 0x62, 0x34, 0xe8, 0x36, 0xae, 0xb7, 0x5b, 0xeb, 0x2e, 0x72, 0xe0, 0x3d, 0x51, 0x38, 0x5a,
-		0x31, 0xec, 0x86, 0x61, 0x97, 0xd9, 0x7e, 0x2f, 0x2b, 0xf0, 0x1d, 0xdf, 0x94, 0xbf, 0xaa, 0x8f,
-		0x4d, 0xdf, 0x8b, 0x30, 0x4a, 0x55, 0xa7, 0xdb, 0x5d, 0xd4, 0xdb, 0x7a, 0x06, 0x88, 0xaf, 0xff,
-		0xdb, 0x38, 0xd5, 0x7a, 0xc4, 0x25, 0xa4, 0xa7, 0xf7, 0xeb, 0x30, 0xa9, 0x35, 0xcc, 0x3a, 0xa6,
-		0xdb, 0xfc, 0x65, 0xb7, 0xae, 0x37, 0xbf, 0x6f, 0x70, 0x7e, 0x13, 0x1e, 0xf9, 0x82, 0xa0, 0xce,
-		0x2d, 0xc2, 0xb8, 0x5a, 0xa9, 0x60, 0x33, 0xc0, 0xb2, 0x47, 0xb7, 0x08, 0x01, 0xd3, 0x9c, 0xd2,
-		0xe5, 0x56, 0x78, 0xba, 0x53, 0xd7, 0xbc, 0x70, 0xaf, 0xcf, 0xf2, 0x16, 0xae, 0x61, 0xfd, 0x61,
-		0x1d, 0x3b, 0x57, 0x0d, 0x6b, 0x87, 0x9b, 0xf7, 0x61, 0xd6, 0xd4, 0x20, 0xbb, 0xc1, 0x0c, 0xef,
-		0x8b, 0xc2, 0x14, 0xab, 0x38, 0xbd, 0xa9, 0xda, 0xf8, 0xf4, 0x95, 0x47, 0x37, 0xb1, 0xa3, 0x3e,
-		0x7a, 0xba, 0x62, 0x68, 0x3a, 0xef, 0x89, 0x09, 0xde, 0x2f, 0xa4, 0x7e, 0x96, 0xd7, 0x67, 0xdb,
-		0x6e, 0xd3, 0xcb, 0xf3, 0x10, 0x9b, 0x33, 0x34, 0x1d, 0x4d, 0x42, 0xbc, 0x8a, 0x75, 0xa3, 0xc1,
-		0xef, 0xdc, 0xb1, 0x02, 0xba, 0x1b, 0x06, 0xd5, 0x86, 0xd1, 0xd4, 0x1d, 0x76, 0x42, 0x51, 0x18,
-		0xfe, 0xda, 0xcd, 0xe9, 0x81, 0xdf, 0xbf, 0x39, 0x1d, 0x5d, 0xd0, 0x1d, 0x85, 0x57, 0xe5, 0x62,
-		0xaf, 0xbf, 0x3a, 0x2d, 0xc9, 0xcf, 0xc0, 0x50, 0x11, 0x57, 0x0e, 0xc2, 0xab, 0x88, 0x2b, 0x21,
-		0x5e, 0x0f, 0x40, 0x62, 0x41, 0x77, 0xd8, 0xad, 0xc8, 0x13, 0x10, 0xd5, 0x74, 0x76, 0xd1, 0x26,
-		0xd4, 0x3e, 0x81, 0x13, 0xd4, 0x22, 0xae, 0xb8, 0xa8, 0x55, 0x5c, 0x09, 0xa3, 0x12, 0xf6, 0x04,
-		0x5e, 0x28, 0xfe, 0xde, 0x7f, 0x9c, 0x1a, 0x78, 0xf9, 0xb5, 0xa9, 0x81, 0x8e, 0x3d, 0xe1, 0x1f,
-		0x03, 0xdc, 0xc4, 0xbc, 0x0b, 0xec, 0xea, 0x0e, 0x3b, 0x23, 0x71, 0xbb, 0xe1, 0xb7, 0x07, 0x41,
-		0xe6, 0x38, 0xb6, 0xa3, 0xee, 0x68, 0x7a, 0xcd, 0xed, 0x09, 0xb5, 0xe9, 0x6c, 0x5f, 0xe3, 0x5d,
-		0x71, 0x98, 0x77, 0x05, 0xc7, 0xe9, 0xde, 0x1b, 0xd9, 0xce, 0xa3, 0x2b, 0xdb, 0xa3, 0xcf, 0xe5,
-		0x7f, 0x15, 0x05, 0xb4, 0xe6, 0xa8, 0x3b, 0x38, 0xdf, 0x74, 0xb6, 0x0d, 0x4b, 0xbb, 0xc6, 0x62,
-		0x19, 0x06, 0x68, 0xa8, 0xbb, 0x65, 0xc7, 0xd8, 0xc1, 0xba, 0x4d, 0x4d, 0x33, 0x7c, 0xe6, 0xe8,
-		0x6c, 0x1b, 0xff, 0x98, 0x25, 0x5d, 0x57, 0x78, 0xf0, 0xb3, 0xdf, 0x9e, 0xbe, 0xbf, 0xb7, 0x15,
-		0x28, 0x32, 0x49, 0xae, 0x77, 0xd7, 0x29, 0x63, 0x74, 0x19, 0xd8, 0x25, 0x8b, 0x72, 0x5d, 0xb3,
-		0x1d, 0x7e, 0x4f, 0xfb, 0xec, 0x6c, 0x7b, 0xdd, 0x67, 0x5b, 0xc5, 0x9c, 0xbd, 0xac, 0xd6, 0xb5,
-		0xaa, 0xea, 0x18, 0x96, 0x7d, 0x71, 0x40, 0x49, 0x52, 0x56, 0x8b, 0x9a, 0xed, 0xa0, 0x75, 0x48,
-		0x56, 0xb1, 0xbe, 0xc7, 0xd8, 0x46, 0xdf, 0x18, 0xdb, 0x04, 0xe1, 0x44, 0xb9, 0x3e, 0x0f, 0x48,
-		0xf5, 0xe3, 0x89, 0x87, 0x49, 0xec, 0x7e, 0x65, 0x07, 0xf6, 0x01, 0xce, 0xf4, 0x1d, 0xc5, 0xb8,
-		0x1a, 0x06, 0x65, 0xef, 0x03, 0xf0, 0xda, 0x44, 0x19, 0x18, 0x52, 0xab, 0x55, 0x0b, 0xdb, 0x36,
-		0x3d, 0x00, 0x4c, 0x2a, 0xa2, 0x98, 0x1b, 0xff, 0xd7, 0x5f, 0x7a, 0x78, 0x24, 0xc0, 0xb1, 0x90,
-		0x02, 0xb8, 0xe2, 0x92, 0x9e, 0xfa, 0xa4, 0x04, 0xe3, 0x2d, 0x2d, 0x22, 0x19, 0xa6, 0xf2, 0x1b,
-		0xeb, 0x17, 0x57, 0x94, 0x85, 0x17, 0xf2, 0xeb, 0x0b, 0x2b, 0xcb, 0x65, 0x76, 0xe5, 0x7f, 0x79,
-		0x6d, 0xb5, 0x34, 0xb7, 0x70, 0x61, 0xa1, 0x54, 0x4c, 0x0f, 0xa0, 0x69, 0x38, 0xd6, 0x06, 0xa7,
-		0x58, 0x5a, 0x2c, 0xcd, 0xe7, 0xd7, 0x4b, 0x69, 0x09, 0xdd, 0x05, 0x27, 0xda, 0x32, 0x71, 0x51,
-		0x22, 0x1d, 0x50, 0x94, 0x92, 0x8b, 0x12, 0x2d, 0x5c, 0xe8, 0x38, 0x8a, 0x1e, 0xea, 0xea, 0x3f,
-		0xbb, 0xee, 0x70, 0x09, 0x8e, 0xa7, 0x77, 0x47, 0xe0, 0x68, 0x78, 0xca, 0x50, 0xf5, 0xbd, 0x0e,
-		0xaf, 0x3e, 0x3b, 0x44, 0xb3, 0x8b, 0x10, 0xcd, 0xeb, 0x7b, 0xe8, 0x28, 0xcb, 0xa7, 0xcb, 0x4d,
-		0xab, 0xce, 0x63, 0xd0, 0x10, 0x29, 0x6f, 0x58, 0x75, 0x12, 0x9b, 0xc4, 0x45, 0x7f, 0xe9, 0x64,
-		0x8a, 0xdf, 0xde, 0xcf, 0xa5, 0x3f, 0xfa, 0xea, 0xf4, 0xc0, 0xe7, 0x5f, 0x9d, 0x1e, 0xf8, 0xc1,
-		0xa7, 0xa6, 0x07, 0x5e, 0xfe, 0x83, 0x99, 0x81, 0xc2, 0x4e, 0x58, 0xbd, 0xaf, 0xf4, 0x9c, 0x4d,
-		0x13, 0x79, 0x7d, 0x8f, 0x06, 0xa2, 0x55, 0xe9, 0x85, 0x38, 0x55, 0x4e, 0x1c, 0xa0, 0x4e, 0x85,
-		0x0f, 0x50, 0x9f, 0xc3, 0xf5, 0xfa, 0x25, 0xdd, 0xb8, 0x4a, 0x7b, 0xd5, 0xb3, 0xc1, 0x47, 0x22,
-		0x30, 0xd5, 0x32, 0x6d, 0xf2, 0x0c, 0xa3, 0xd3, 0xf3, 0xd7, 0x1c, 0x24, 0x8a, 0x22, 0x71, 0xc9,
-		0xc0, 0x90, 0x8d, 0x2b, 0x86, 0x5e, 0x65, 0x23, 0x3d, 0xaa, 0x88, 0x22, 0x51, 0x5b, 0x57, 0x75,
-		0xc3, 0xe6, 0x77, 0xee, 0x59, 0xa1, 0xf0, 0x71, 0x69, 0x7f, 0xf9, 0xc2, 0x88, 0x68, 0x49, 0xa8,
-		0xf9, 0x68, 0xcf, 0x23, 0xe5, 0x1d, 0xa2, 0xa5, 0xab, 0x44, 0xe0, 0x58, 0xb9, 0x5f, 0xab, 0xfc,
-		0x62, 0x04, 0xa6, 0xc3, 0x56, 0x21, 0x69, 0x9b, 0xed, 0xa8, 0x0d, 0xb3, 0x93, 0x59, 0xce, 0x43,
-		0x72, 0x5d, 0xe0, 0xec, 0xdb, 0x2e, 0x37, 0xf6, 0x69, 0x97, 0x51, 0xb7, 0x29, 0x61, 0x98, 0x33,
-		0x7d, 0x1a, 0xc6, 0xd5, 0xe3, 0x40, 0x96, 0xf9, 0x6c, 0x0c, 0x4e, 0xd0, 0x47, 0x59, 0x56, 0x43,
-		0xd3, 0x9d, 0xd3, 0x15, 0x6b, 0xcf, 0x74, 0x68, 0xe2, 0x66, 0x6c, 0x71, 0xbb, 0x8c, 0x7b, 0xd5,
-		0xb3, 0xac, 0xba, 0xc3, 0xc8, 0xd9, 0x82, 0xf8, 0x2a, 0xa1, 0x23, 0x16, 0x71, 0x0c, 0x47, 0xad,
-		0x73, 0x4b, 0xb1, 0x02, 0x81, 0xb2, 0x87, 0x5c, 0x11, 0x06, 0xd5, 0xc4, 0x1b, 0xae, 0x3a, 0x56,
-		0xb7, 0xd8, 0x7d, 0xf8, 0x28, 0x1d, 0x50, 0x09, 0x02, 0xa0, 0x57, 0xdf, 0x27, 0x21, 0xae, 0x36,
-		0xd9, 0x55, 0x8e, 0x28, 0x19, 0x69, 0xb4, 0x20, 0x5f, 0x82, 0x21, 0x7e, 0xa0, 0x8c, 0xd2, 0x10,
-		0xdd, 0xc1, 0x7b, 0xb4, 0x9d, 0x94, 0x42, 0x7e, 0xa2, 0x59, 0x88, 0x53, 0xe1, 0xf9, 0x04, 0x92,
-		0x99, 0x6d, 0x91, 0x7e, 0x96, 0x0a, 0xa9, 0x30, 0x34, 0xf9, 0x19, 0x48, 0x14, 0x8d, 0x86, 0xa6,
-		0x1b, 0x41, 0x6e, 0x49, 0xc6, 0x8d, 0xca, 0x6c, 0x36, 0x79, 0xbe, 0xa1, 0xb0, 0x02, 0x3a, 0x0c,
-		0x83, 0xec, 0x7d, 0x04, 0xbf, 0x8e, 0xc2, 0x4b, 0xf2, 0x1c, 0x0c, 0x51, 0xde, 0x2b, 0x26, 0x42,
-		0xfc, 0x65, 0x1d, 0x7f, 0x88, 0x41, 0x53, 0x53, 0xce, 0x3e, 0xe2, 0x09, 0x8b, 0x20, 0x56, 0x55,
-		0x1d, 0x95, 0xeb, 0x4d, 0x7f, 0xcb, 0x6f, 0x86, 0x04, 0x67, 0x62, 0xa3, 0x33, 0x10, 0x35, 0x4c,
-		0x9b, 0x5f, 0x28, 0xc9, 0x76, 0x52, 0x65, 0xc5, 0x2c, 0xc4, 0x48, 0xa6, 0xa2, 0x10, 0xe4, 0x82,
-		0xd2, 0x31, 0xa8, 0x3e, 0xe9, 0x0b, 0xaa, 0xbe, 0x2e, 0xf7, 0xfd, 0x64, 0x5d, 0xda, 0xe2, 0x0e,
-		0xae, 0xb3, 0x7c, 0x2a, 0x02, 0x53, 0xbe, 0xda, 0x2b, 0xd8, 0xb2, 0x35, 0x43, 0xe7, 0xf3, 0x39,
-		0xf3, 0x16, 0xe4, 0x13, 0x92, 0xd7, 0x77, 0x70, 0x97, 0x37, 0x41, 0x34, 0x6f, 0x9a, 0x28, 0x0b,
-		0x09, 0x5a, 0xae, 0x18, 0xcc, 0x5f, 0x62, 0x8a, 0x5b, 0x26, 0x75, 0xb6, 0xb1, 0xe5, 0x5c, 0x55,
-		0x2d, 0xf7, 0x09, 0xa1, 0x28, 0xcb, 0x4f, 0x41, 0x72, 0xce, 0xd0, 0x6d, 0xac, 0xdb, 0x4d, 0x3a,
-		0x06, 0x37, 0xeb, 0x46, 0x65, 0x87, 0x73, 0x60, 0x05, 0x62, 0x70, 0xd5, 0x34, 0x29, 0x65, 0x4c,
-		0x21, 0x3f, 0x59, 0x6e, 0x58, 0x58, 0xeb, 0x68, 0xa2, 0xa7, 0xf6, 0x6f, 0x22, 0xae, 0xa4, 0x6b,
-		0xa3, 0xff, 0x2d, 0xc1, 0xf1, 0xd6, 0x01, 0xb5, 0x83, 0xf7, 0xec, 0xfd, 0x8e, 0xa7, 0xe7, 0x21,
-		0xb9, 0x4a, 0xdf, 0xf1, 0x5f, 0xc2, 0x7b, 0x28, 0x0b, 0x43, 0xb8, 0x7a, 0xe6, 0xec, 0xd9, 0x47,
-		0x9f, 0x62, 0xde, 0x7e, 0x71, 0x40, 0x11, 0x00, 0x34, 0x05, 0x49, 0x1b, 0x57, 0xcc, 0x33, 0x67,
-		0xcf, 0xed, 0x3c, 0xca, 0xdc, 0x8b, 0x64, 0x40, 0x2e, 0x28, 0x97, 0x20, 0x5a, 0xbf, 0xfe, 0xa9,
-		0x69, 0xa9, 0x10, 0x87, 0xa8, 0xdd, 0x6c, 0xdc, 0x51, 0x1f, 0x79, 0x25, 0x0e, 0x33, 0x7e, 0x4a,
-		0x1a, 0xa9, 0xdc, 0xac, 0x84, 0xdb, 0x20, 0xed, 0xb3, 0x01, 0xc5, 0xe8, 0x90, 0xcc, 0x76, 0xb5,
-		0xa4, 0xfc, 0xeb, 0x12, 0xa4, 0xdc, 0x54, 0x69, 0x0d, 0x3b, 0xe8, 0xbc, 0x3f, 0xff, 0xe1, 0xc3,
-		0xe6, 0xd8, 0x6c, 0xb8, 0x2d, 0x2f, 0xa5, 0x53, 0x7c, 0xe8, 0xe8, 0x09, 0xea, 0x88, 0xa6, 0x61,
-		0xf3, 0x67, 0x65, 0x3d, 0x48, 0x5d, 0x64, 0xf4, 0x10, 0x20, 0x1a, 0xe1, 0xca, 0x57, 0x0c, 0x47,
-		0xd3, 0x6b, 0x65, 0xd3, 0xb8, 0xca, 0x1f, 0xeb, 0x46, 0x95, 0x34, 0xad, 0xb9, 0x4c, 0x2b, 0x56,
-		0x09, 0x9c, 0x08, 0x9d, 0x74, 0xb9, 0x04, 0xd3, 0x3b, 0x12, 0x04, 0x44, 0x11, 0x9d, 0x87, 0x21,
-		0xb3, 0xb9, 0x59, 0x16, 0x11, 0x63, 0xf8, 0xcc, 0xf1, 0x76, 0xe3, 0x5f, 0xf8, 0x07, 0x8f, 0x00,
-		0x83, 0x66, 0x73, 0x93, 0x78, 0xcb, 0x5d, 0x90, 0x6a, 0x23, 0xcc, 0xf0, 0x15, 0x4f, 0x0e, 0xfa,
-		0xf9, 0x08, 0xae, 0x41, 0xd9, 0xb4, 0x34, 0xc3, 0xd2, 0x9c, 0x3d, 0x9a, 0xbf, 0x46, 0x95, 0xb4,
-		0xa8, 0x58, 0xe5, 0x70, 0x79, 0x07, 0xc6, 0xd6, 0xe8, 0xfa, 0xd6, 0x93, 0xfc, 0xac, 0x27, 0x9f,
-		0xd4, 0x5b, 0xbe, 0x8e, 0x92, 0x45, 0x5a, 0x24, 0x2b, 0x3c, 0xdb, 0xd1, 0x3b, 0x9f, 0xd8, 0xbf,
-		0x77, 0x06, 0x33, 0xc4, 0x3f, 0x3e, 0x1a, 0x18, 0x9c, 0xcc, 0x39, 0xfd, 0xe1, 0xab, 0x5f, 0xc7,
-		0xec, 0x95, 0x4d, 0x64, 0xbb, 0x4f, 0xaa, 0xd9, 0x1e, 0x61, 0x34, 0xdb, 0x73, 0x08, 0xc9, 0x4f,
-		0xc1, 0xc8, 0xaa, 0x6a, 0x39, 0x6b, 0xd8, 0xb9, 0x88, 0xd5, 0x2a, 0xb6, 0x82, 0xb3, 0xee, 0x88,
-		0x98, 0x75, 0x11, 0xc4, 0xe8, 0xd4, 0xca, 0x66, 0x1d, 0xfa, 0x5b, 0xde, 0x86, 0x18, 0xbd, 0x19,
-		0xea, 0xce, 0xc8, 0x9c, 0x82, 0xcd, 0xc8, 0x24, 0x96, 0xee, 0x39, 0xd8, 0x16, 0xe9, 0x2d, 0x2d,
-		0xa0, 0xc7, 0xc5, 0xbc, 0x1a, 0xed, 0x3e, 0xaf, 0x72, 0x47, 0xe4, 0xb3, 0x6b, 0x1d, 0x86, 0x0a,
-		0x24, 0x14, 0x2f, 0x14, 0x5d, 0x41, 0x24, 0x4f, 0x10, 0xb4, 0x04, 0x63, 0xa6, 0x6a, 0x39, 0xf4,
-		0x49, 0xcc, 0x36, 0xd5, 0x82, 0xfb, 0xfa, 0x74, 0xeb, 0xc8, 0x0b, 0x28, 0xcb, 0x5b, 0x19, 0x31,
-		0xfd, 0x40, 0xf9, 0x8f, 0x62, 0x30, 0xc8, 0x8d, 0xf1, 0x26, 0x18, 0xe2, 0x66, 0xe5, 0xde, 0x79,
-		0x62, 0xb6, 0x75, 0x62, 0x9a, 0x75, 0x27, 0x10, 0xce, 0x4f, 0xd0, 0xa0, 0xfb, 0x20, 0x51, 0xd9,
-		0x56, 0x35, 0xbd, 0xac, 0x55, 0xc5, 0x56, 0xc3, 0x6b, 0x37, 0xa7, 0x87, 0xe6, 0x08, 0x6c, 0xa1,
-		0xa8, 0x0c, 0xd1, 0xca, 0x85, 0x2a, 0xc9, 0x04, 0xb6, 0xb1, 0x56, 0xdb, 0x76, 0xf8, 0x08, 0xe3,
-		0x25, 0xf4, 0x24, 0xc4, 0x88, 0x43, 0xf0, 0x07, 0x93, 0xd9, 0x96, 0x0d, 0x1f, 0x37, 0xd9, 0x2b,
-		0x24, 0x48, 0xc3, 0x1f, 0xfa, 0xf6, 0xb4, 0xa4, 0x50, 0x0a, 0x34, 0x07, 0x23, 0x75, 0xd5, 0x76,
-		0xca, 0x74, 0x06, 0x23, 0xcd, 0xc7, 0xf9, 0x7a, 0xbb, 0xc5, 0x20, 0xdc, 0xb0, 0x5c, 0xf4, 0x61,
-		0x42, 0xc5, 0x40, 0x55, 0x74, 0x12, 0xd2, 0x94, 0x49, 0xc5, 0x68, 0x34, 0x34, 0x87, 0xe5, 0x56,
-		0x83, 0xd4, 0xee, 0xa3, 0x04, 0x3e, 0x47, 0xc1, 0x34, 0xc3, 0x3a, 0x06, 0x49, 0xfa, 0x44, 0x8b,
-		0xa2, 0xb0, 0xeb, 0xc8, 0x09, 0x02, 0xa0, 0x95, 0xf7, 0xc3, 0x98, 0x17, 0x1f, 0x19, 0x4a, 0x82,
-		0x71, 0xf1, 0xc0, 0x14, 0xf1, 0x11, 0x98, 0xd4, 0xf1, 0x2e, 0xbd, 0x20, 0x1d, 0xc0, 0x4e, 0x52,
-		0x6c, 0x44, 0xea, 0x2e, 0x07, 0x29, 0xee, 0x85, 0xd1, 0x8a, 0x30, 0x3e, 0xc3, 0x05, 0x8a, 0x3b,
-		0xe2, 0x42, 0x29, 0xda, 0x51, 0x48, 0xa8, 0xa6, 0xc9, 0x10, 0x86, 0x79, 0x7c, 0x34, 0x4d, 0x5a,
-		0x75, 0x0a, 0xc6, 0xa9, 0x8e, 0x16, 0xb6, 0x9b, 0x75, 0x87, 0x33, 0x49, 0x51, 0x9c, 0x31, 0x52,
-		0xa1, 0x30, 0x38, 0xc5, 0xbd, 0x1b, 0x46, 0xf0, 0x15, 0xad, 0x8a, 0xf5, 0x0a, 0x66, 0x78, 0x23,
-		0x14, 0x2f, 0x25, 0x80, 0x14, 0xe9, 0x01, 0x70, 0xe3, 0x5e, 0x59, 0xc4, 0xe4, 0x51, 0xc6, 0x4f,
-		0xc0, 0xf3, 0x0c, 0x2c, 0x67, 0x20, 0x56, 0x54, 0x1d, 0x95, 0x24, 0x18, 0xce, 0x2e, 0x9b, 0x68,
-		0x52, 0x0a, 0xf9, 0x29, 0xbf, 0x1e, 0x81, 0xd8, 0x65, 0xc3, 0xc1, 0xe8, 0x31, 0x5f, 0x02, 0x38,
-		0xda, 0xce, 0x9f, 0xd7, 0xb4, 0x9a, 0x8e, 0xab, 0x4b, 0x76, 0xcd, 0xf7, 0x3d, 0x05, 0xcf, 0x9d,
-		0x22, 0x01, 0x77, 0x9a, 0x84, 0xb8, 0x65, 0x34, 0xf5, 0xaa, 0xb8, 0xc9, 0x4b, 0x0b, 0xa8, 0x04,
-		0x09, 0xd7, 0x4b, 0x62, 0xbd, 0xbc, 0x64, 0x8c, 0x78, 0x09, 0xf1, 0x61, 0x0e, 0x50, 0x86, 0x36,
-		0xb9, 0xb3, 0x14, 0x20, 0xe9, 0x06, 0x2f, 0xee, 0x6d, 0xfd, 0x39, 0xac, 0x47, 0x46, 0x26, 0x13,
-		0xb7, 0xef, 0x5d, 0xe3, 0x31, 0x8f, 0x4b, 0xbb, 0x15, 0xdc, 0x7a, 0x01, 0xb7, 0xe2, 0xdf, 0x76,
-		0x18, 0xa2, 0x7a, 0x79, 0x6e, 0xc5, 0xbe, 0xef, 0x70, 0x1c, 0x92, 0xb6, 0x56, 0xd3, 0x55, 0xa7,
-		0x69, 0x61, 0xee, 0x79, 0x1e, 0x40, 0xfe, 0x8a, 0x04, 0x83, 0xcc, 0x93, 0x7d, 0x76, 0x93, 0xda,
-		0xdb, 0x2d, 0xd2, 0xc9, 0x6e, 0xd1, 0x83, 0xdb, 0x2d, 0x0f, 0xe0, 0x0a, 0x63, 0xf3, 0x27, 0xf7,
-		0x6d, 0x32, 0x06, 0x26, 0xe2, 0x9a, 0x56, 0xe3, 0x03, 0xd5, 0x47, 0x24, 0xff, 0x07, 0x89, 0x24,
-		0xb1, 0xbc, 0x1e, 0xe5, 0x61, 0x44, 0xc8, 0x55, 0xde, 0xaa, 0xab, 0x35, 0xee, 0x3b, 0x27, 0x3a,
-		0x0a, 0x77, 0xa1, 0xae, 0xd6, 0x94, 0x61, 0x2e, 0x0f, 0x29, 0xb4, 0xef, 0x87, 0x48, 0x87, 0x7e,
-		0x08, 0x74, 0x7c, 0xf4, 0x60, 0x1d, 0x1f, 0xe8, 0xa2, 0x58, 0xb8, 0x8b, 0xbe, 0x18, 0xa1, 0x8b,
-		0x19, 0xd3, 0xb0, 0xd5, 0xfa, 0x8f, 0x63, 0x44, 0x1c, 0x83, 0xa4, 0x69, 0xd4, 0xcb, 0xac, 0x86,
-		0xdd, 0x70, 0x4f, 0x98, 0x46, 0x5d, 0x69, 0xe9, 0xf6, 0xf8, 0x6d, 0x1a, 0x2e, 0x83, 0xb7, 0xc1,
-		0x6a, 0x43, 0x61, 0xab, 0x59, 0x90, 0x62, 0xa6, 0xe0, 0x73, 0xd9, 0x23, 0xc4, 0x06, 0x74, 0x72,
-		0x94, 0x5a, 0xe7, 0x5e, 0x26, 0x36, 0xc3, 0x54, 0x38, 0x1e, 0xa1, 0x60, 0xa1, 0xbf, 0xdd, 0x2a,
-		0xd8, 0xef, 0x96, 0x0a, 0xc7, 0x93, 0xff, 0xa6, 0x04, 0xb0, 0x48, 0x2c, 0x4b, 0xf5, 0x25, 0xb3,
-		0x90, 0x4d, 0x45, 0x28, 0x07, 0x5a, 0x9e, 0xea, 0xd4, 0x69, 0xbc, 0xfd, 0x94, 0xed, 0x97, 0x7b,
-		0x0e, 0x46, 0x3c, 0x67, 0xb4, 0xb1, 0x10, 0x66, 0xaa, 0x4b, 0x56, 0xbd, 0x86, 0x1d, 0x25, 0x75,
-		0xc5, 0x57, 0x92, 0xff, 0xb9, 0x04, 0x49, 0x2a, 0xd3, 0x12, 0x76, 0xd4, 0x40, 0x1f, 0x4a, 0x07,
-		0xef, 0xc3, 0x13, 0x00, 0x8c, 0x8d, 0xad, 0x5d, 0xc3, 0xdc, 0xb3, 0x92, 0x14, 0xb2, 0xa6, 0x5d,
-		0xc3, 0xe8, 0x9c, 0x6b, 0xf0, 0x68, 0x77, 0x83, 0x8b, 0xac, 0x9b, 0x9b, 0xfd, 0x08, 0x0c, 0xd1,
-		0x4f, 0x54, 0xed, 0xda, 0x3c, 0x91, 0x1e, 0xd4, 0x9b, 0x8d, 0xf5, 0x5d, 0x5b, 0x7e, 0x11, 0x86,
-		0xd6, 0x77, 0xd9, 0xde, 0xc8, 0x31, 0x48, 0x5a, 0x86, 0xc1, 0xe7, 0x64, 0x96, 0x0b, 0x25, 0x08,
-		0x80, 0x4e, 0x41, 0x62, 0x3f, 0x20, 0xe2, 0xed, 0x07, 0x78, 0x1b, 0x1a, 0xd1, 0xbe, 0x36, 0x34,
-		0x4e, 0xfd, 0x3b, 0x09, 0x86, 0x7d, 0xf1, 0x01, 0x3d, 0x0a, 0x87, 0x0a, 0x8b, 0x2b, 0x73, 0x97,
-		0xca, 0x0b, 0xc5, 0xf2, 0x85, 0xc5, 0xfc, 0xbc, 0xf7, 0x86, 0x2b, 0x7b, 0xf8, 0xfa, 0x8d, 0x19,
-		0xe4, 0xc3, 0xdd, 0xd0, 0xe9, 0x8e, 0x12, 0x3a, 0x0d, 0x93, 0x41, 0x92, 0x7c, 0x61, 0xad, 0xb4,
-		0xbc, 0x9e, 0x96, 0xb2, 0x87, 0xae, 0xdf, 0x98, 0x19, 0xf7, 0x51, 0xe4, 0x37, 0x6d, 0xac, 0x3b,
-		0xad, 0x04, 0x73, 0x2b, 0x4b, 0x4b, 0x0b, 0xeb, 0xe9, 0x48, 0x0b, 0x01, 0x0f, 0xd8, 0x0f, 0xc0,
-		0x78, 0x90, 0x60, 0x79, 0x61, 0x31, 0x1d, 0xcd, 0xa2, 0xeb, 0x37, 0x66, 0x46, 0x7d, 0xd8, 0xcb,
-		0x5a, 0x3d, 0x9b, 0xf8, 0xc0, 0xa7, 0xa7, 0x06, 0x7e, 0xe5, 0x97, 0xa7, 0x24, 0xa2, 0xd9, 0x48,
-		0x20, 0x46, 0xa0, 0x87, 0xe0, 0xc8, 0xda, 0xc2, 0xfc, 0x72, 0xa9, 0x58, 0x5e, 0x5a, 0x9b, 0x17,
-		0x7b, 0xd0, 0x42, 0xbb, 0xb1, 0xeb, 0x37, 0x66, 0x86, 0xb9, 0x4a, 0x9d, 0xb0, 0x57, 0x95, 0xd2,
-		0xe5, 0x95, 0xf5, 0x52, 0x5a, 0x62, 0xd8, 0xab, 0x16, 0xbe, 0x62, 0x38, 0xec, 0x1b, 0x76, 0x8f,
-		0xc0, 0xd1, 0x36, 0xd8, 0xae, 0x62, 0xe3, 0xd7, 0x6f, 0xcc, 0x8c, 0xac, 0x5a, 0x98, 0x8d, 0x1f,
-		0x4a, 0x31, 0x0b, 0x99, 0x56, 0x8a, 0x95, 0xd5, 0x95, 0xb5, 0xfc, 0x62, 0x7a, 0x26, 0x9b, 0xbe,
-		0x7e, 0x63, 0x26, 0x25, 0x82, 0x21, 0xdd, 0xe8, 0x77, 0x35, 0xbb, 0x93, 0x2b, 0x9e, 0x3f, 0x7d,
-		0x18, 0xee, 0xe9, 0x70, 0xc6, 0x24, 0x4e, 0x27, 0x0e, 0x74, 0xca, 0xd4, 0x71, 0x9f, 0x3d, 0xdb,
-		0x63, 0xfb, 0xb9, 0xf7, 0xd2, 0xe9, 0xe0, 0x27, 0x58, 0xd9, 0xae, 0x8b, 0x3b, 0xf9, 0x83, 0x12,
-		0x8c, 0x5e, 0xd4, 0x6c, 0xc7, 0xb0, 0xb4, 0x8a, 0x5a, 0xa7, 0x2f, 0xb7, 0xce, 0xf5, 0x1b, 0x5b,
-		0x43, 0x43, 0xfd, 0x69, 0x18, 0xbc, 0xa2, 0xd6, 0x59, 0x50, 0x8b, 0xd2, 0x0f, 0xcd, 0x74, 0x38,
-		0xf2, 0x71, 0x43, 0x9b, 0x60, 0xc0, 0xc8, 0xe4, 0x5f, 0x8b, 0xc0, 0x18, 0x1d, 0x0c, 0x36, 0xfb,
-		0x04, 0x19, 0x59, 0x63, 0x15, 0x20, 0x66, 0xa9, 0x0e, 0xdf, 0x34, 0x2c, 0xcc, 0xf2, 0xd3, 0xc7,
-		0xfb, 0xfa, 0x38, 0x4b, 0x2b, 0xe2, 0x8a, 0x42, 0x69, 0xd1, 0xdb, 0x21, 0xd1, 0x50, 0x77, 0xcb,
-		0x94, 0x0f, 0x5b, 0xb9, 0xe4, 0xf7, 0xc7, 0xe7, 0xd6, 0xcd, 0xe9, 0xb1, 0x3d, 0xb5, 0x51, 0xcf,
-		0xc9, 0x82, 0x8f, 0xac, 0x0c, 0x35, 0xd4, 0x5d, 0x22, 0x22, 0x32, 0x61, 0x8c, 0x40, 0x2b, 0xdb,
-		0xaa, 0x5e, 0xc3, 0xac, 0x11, 0xba, 0x05, 0x5a, 0xb8, 0xb8, 0xef, 0x46, 0x0e, 0x7b, 0x8d, 0xf8,
-		0xd8, 0xc9, 0xca, 0x48, 0x43, 0xdd, 0x9d, 0xa3, 0x00, 0xd2, 0x62, 0x2e, 0xf1, 0xd1, 0x57, 0xa7,
-		0x07, 0xe8, 0x89, 0xee, 0xb7, 0x24, 0x00, 0xcf, 0x62, 0xe8, 0xed, 0x90, 0xae, 0xb8, 0x25, 0x4a,
-		0x2b, 0xce, 0x26, 0xef, 0xef, 0xd4, 0x17, 0x21, 0x7b, 0xb3, 0xb9, 0xf9, 0x9b, 0x37, 0xa7, 0x25,
-		0x65, 0xac, 0x12, 0xea, 0x8a, 0xb7, 0xc1, 0x70, 0xd3, 0xac, 0xaa, 0x0e, 0x2e, 0xd3, 0x75, 0x5c,
-		0xa4, 0xe7, 0x3c, 0x3f, 0x45, 0x78, 0xdd, 0xba, 0x39, 0x8d, 0x98, 0x5a, 0x3e, 0x62, 0x99, 0xce,
-		0xfe, 0xc0, 0x20, 0x84, 0xc0, 0xa7, 0xd3, 0xd7, 0x25, 0x18, 0x2e, 0xfa, 0xee, 0x54, 0x66, 0x60,
-		0xa8, 0x61, 0xe8, 0xda, 0x0e, 0xf7, 0xc7, 0xa4, 0x22, 0x8a, 0x28, 0x0b, 0x09, 0xf6, 0x98, 0xd5,
-		0xd9, 0x13, 0x5b, 0xa1, 0xa2, 0x4c, 0xa8, 0xae, 0xe2, 0x4d, 0x5b, 0x13, 0xbd, 0xa1, 0x88, 0x22,
-		0xba, 0x00, 0x69, 0x1b, 0x57, 0x9a, 0x96, 0xe6, 0xec, 0x95, 0x2b, 0x86, 0xee, 0xa8, 0x15, 0x87,
-		0x3d, 0x8b, 0x2c, 0x1c, 0xbb, 0x75, 0x73, 0xfa, 0x08, 0x93, 0x35, 0x8c, 0x21, 0x2b, 0x63, 0x02,
-		0x34, 0xc7, 0x20, 0xa4, 0x85, 0x2a, 0x76, 0x54, 0xad, 0x6e, 0x67, 0xd8, 0xe5, 0x04, 0x51, 0xf4,
-		0xe9, 0xf2, 0xb9, 0x21, 0xff, 0xc6, 0xd6, 0x05, 0x48, 0x1b, 0x26, 0xb6, 0x02, 0x89, 0xa8, 0x14,
-		0x6e, 0x39, 0x8c, 0x21, 0x2b, 0x63, 0x02, 0x24, 0x92, 0x54, 0x87, 0x74, 0xb3, 0x58, 0x28, 0x9a,
-		0xcd, 0x4d, 0x6f, 0x3f, 0x6c, 0xb2, 0xa5, 0x37, 0xf2, 0xfa, 0x5e, 0xe1, 0x31, 0x8f, 0x7b, 0x98,
-		0x4e, 0xfe, 0xc6, 0x97, 0x1e, 0x9e, 0xe4, 0xae, 0xe1, 0xed, 0x4f, 0x5d, 0xc2, 0x7b, 0xa4, 0xfb,
-		0x39, 0xea, 0x2a, 0xc5, 0x24, 0x69, 0xe7, 0x8b, 0xaa, 0x56, 0x17, 0xcf, 0xfb, 0x15, 0x5e, 0x42,
-		0x39, 0x18, 0xb4, 0x1d, 0xd5, 0x69, 0xda, 0xfc, 0xa4, 0x57, 0xee, 0xe4, 0x6a, 0x05, 0x43, 0xaf,
-		0xae, 0x51, 0x4c, 0x85, 0x53, 0xa0, 0x0b, 0x30, 0xc8, 0x8f, 0xd0, 0xe3, 0xfb, 0x1e, 0xdf, 0xf4,
-		0xae, 0x04, 0xa3, 0x26, 0x16, 0xa9, 0xe2, 0x3a, 0xae, 0xb1, 0xb4, 0x6a, 0x5b, 0x25, 0xab, 0x0f,
-		0xfa, 0xed, 0xbd, 0xc2, 0xc2, 0xbe, 0x07, 0x21, 0xb7, 0x54, 0x98, 0x9f, 0xac, 0x8c, 0xb9, 0xa0,
-		0x35, 0x0a, 0x41, 0x97, 0x02, 0x97, 0x7f, 0xf9, 0x07, 0x2a, 0xef, 0xee, 0xa4, 0xbe, 0xcf, 0xa7,
-		0xc5, 0xfe, 0x84, 0xff, 0xea, 0xf0, 0x05, 0x48, 0x37, 0xf5, 0x4d, 0x43, 0xa7, 0x6f, 0x70, 0x79,
-		0x7e, 0x4f, 0xd6, 0x77, 0x51, 0xbf, 0x73, 0x84, 0x31, 0x64, 0x65, 0xcc, 0x05, 0x5d, 0x64, 0xab,
-		0x80, 0x2a, 0x8c, 0x7a, 0x58, 0x74, 0xa0, 0x26, 0x7b, 0x0e, 0xd4, 0xbb, 0xf8, 0x40, 0x3d, 0x14,
-		0x6e, 0xc5, 0x1b, 0xab, 0x23, 0x2e, 0x90, 0x90, 0xa1, 0x8b, 0x00, 0x5e, 0x78, 0xa0, 0xfb, 0x14,
-		0xc3, 0x9d, 0x3b, 0xde, 0x8b, 0x31, 0x62, 0xbd, 0xe7, 0xd1, 0xa2, 0x77, 0xc2, 0x44, 0x43, 0xd3,
-		0xcb, 0x36, 0xae, 0x6f, 0x95, 0xb9, 0x81, 0x09, 0x4b, 0xfa, 0x09, 0xa5, 0xc2, 0xe2, 0xfe, 0xfc,
-		0xe1, 0xd6, 0xcd, 0xe9, 0x2c, 0x0f, 0xa1, 0xad, 0x2c, 0x65, 0x65, 0xbc, 0xa1, 0xe9, 0x6b, 0xb8,
-		0xbe, 0x55, 0x74, 0x61, 0xb9, 0xd4, 0x07, 0x5e, 0x9d, 0x1e, 0xe0, 0xc3, 0x75, 0x40, 0x3e, 0x47,
-		0xf7, 0xce, 0xf9, 0x30, 0xc3, 0x36, 0x59, 0x93, 0xa8, 0xa2, 0xc0, 0xaf, 0x1a, 0x78, 0x00, 0x36,
-		0xcc, 0x5f, 0xfe, 0x83, 0x19, 0x49, 0xfe, 0x9c, 0x04, 0x83, 0xc5, 0xcb, 0xab, 0xaa, 0x66, 0xa1,
-		0x05, 0x18, 0xf7, 0x3c, 0x27, 0x38, 0xc8, 0x8f, 0xdf, 0xba, 0x39, 0x9d, 0x09, 0x3b, 0x97, 0x3b,
-		0xca, 0x3d, 0x07, 0x16, 0xc3, 0x7c, 0xa1, 0xd3, 0xc2, 0x35, 0xc0, 0xaa, 0x05, 0x45, 0x6e, 0x5d,
-		0xd6, 0x86, 0xd4, 0x2c, 0xc1, 0x10, 0x93, 0xd6, 0x46, 0x39, 0x88, 0x9b, 0xe4, 0x07, 0x3f, 0x18,
-		0x98, 0xea, 0xe8, 0xbc, 0x14, 0xdf, 0xdd, 0xc8, 0x24, 0x24, 0xf2, 0x87, 0x23, 0x00, 0xc5, 0xcb,
-		0x97, 0xd7, 0x2d, 0xcd, 0xac, 0x63, 0xe7, 0x76, 0x6a, 0xbe, 0x0e, 0x87, 0x7c, 0xab, 0x24, 0xab,
-		0x12, 0xd2, 0x7e, 0xe6, 0xd6, 0xcd, 0xe9, 0xe3, 0x61, 0xed, 0x7d, 0x68, 0xb2, 0x32, 0xe1, 0xad,
-		0x97, 0xac, 0x4a, 0x5b, 0xae, 0x55, 0xdb, 0x71, 0xb9, 0x46, 0x3b, 0x73, 0xf5, 0xa1, 0xf9, 0xb9,
-		0x16, 0x6d, 0xa7, 0xbd, 0x69, 0xd7, 0x60, 0xd8, 0x33, 0x89, 0x8d, 0x8a, 0x90, 0x70, 0xf8, 0x6f,
-		0x6e, 0x61, 0xb9, 0xb3, 0x85, 0x05, 0x19, 0xb7, 0xb2, 0x4b, 0x29, 0xff, 0x99, 0x04, 0xe0, 0xf9,
-		0xec, 0x4f, 0xa7, 0x8b, 0x91, 0x50, 0xce, 0x03, 0x6f, 0xf4, 0x40, 0xa9, 0x1a, 0xa7, 0x0e, 0xd9,
-		0xf3, 0xe7, 0x22, 0x30, 0xb1, 0x21, 0x22, 0xcf, 0x4f, 0xbd, 0x0d, 0x56, 0x61, 0x08, 0xeb, 0x8e,
-		0xa5, 0x51, 0x23, 0x90, 0xde, 0x7e, 0xa4, 0x53, 0x6f, 0xb7, 0xd1, 0x89, 0x7e, 0x44, 0x4a, 0x6c,
-		0xba, 0x73, 0x36, 0x21, 0x6b, 0xfc, 0x42, 0x14, 0x32, 0x9d, 0x28, 0xd1, 0x1c, 0x8c, 0x55, 0x2c,
-		0xcc, 0x2e, 0x5e, 0xf9, 0x77, 0xfe, 0x0a, 0x59, 0x2f, 0xb3, 0x0c, 0x21, 0xc8, 0xca, 0xa8, 0x80,
-		0xf0, 0xd9, 0xa3, 0x06, 0x24, 0xed, 0x23, 0x6e, 0x47, 0xef, 0x6f, 0xf5, 0x97, 0xe7, 0xc9, 0x7c,
-		0xfa, 0x10, 0x8d, 0x04, 0x19, 0xb0, 0xf9, 0x63, 0xd4, 0x83, 0xd2, 0x09, 0xe4, 0x25, 0x18, 0xd3,
-		0x74, 0xcd, 0xd1, 0xd4, 0x7a, 0x79, 0x53, 0xad, 0xab, 0x7a, 0xe5, 0x20, 0x59, 0x33, 0x0b, 0xf9,
-		0xbc, 0xd9, 0x10, 0x3b, 0x59, 0x19, 0xe5, 0x90, 0x02, 0x03, 0xa0, 0x8b, 0x30, 0x24, 0x9a, 0x8a,
-		0x1d, 0x28, 0xdb, 0x10, 0xe4, 0xbe, 0x04, 0xef, 0xe7, 0xa3, 0x30, 0xae, 0xe0, 0xea, 0xff, 0xef,
-		0x8a, 0xfd, 0x75, 0xc5, 0x12, 0x00, 0x1b, 0xee, 0x24, 0xc0, 0x1e, 0xa0, 0x37, 0x48, 0xc0, 0x48,
-		0x32, 0x0e, 0x45, 0xdb, 0xf1, 0xf5, 0xc7, 0xcd, 0x08, 0xa4, 0xfc, 0xfd, 0xf1, 0x17, 0x74, 0x56,
-		0x42, 0x0b, 0x5e, 0x24, 0x8a, 0xf1, 0x4f, 0xef, 0x76, 0x88, 0x44, 0x2d, 0xde, 0xdb, 0x3d, 0x04,
-		0xfd, 0x8f, 0x08, 0x0c, 0xae, 0xaa, 0x96, 0xda, 0xb0, 0x51, 0xa5, 0x25, 0xd3, 0x14, 0xdb, 0x8f,
-		0x2d, 0x1f, 0x58, 0xe7, 0xbb, 0x1d, 0x3d, 0x12, 0xcd, 0x8f, 0xb6, 0x49, 0x34, 0xdf, 0x02, 0xa3,
-		0x64, 0x39, 0xec, 0xbb, 0xc2, 0x40, 0xac, 0x3d, 0x52, 0x38, 0xea, 0x71, 0x09, 0xd6, 0xb3, 0xd5,
-		0xf2, 0x65, 0xff, 0x1d, 0x86, 0x61, 0x82, 0xe1, 0x05, 0x66, 0x42, 0x7e, 0xd8, 0x5b, 0x96, 0xfa,
-		0x2a, 0x65, 0x05, 0x1a, 0xea, 0x6e, 0x89, 0x15, 0xd0, 0x22, 0xa0, 0x6d, 0x77, 0x67, 0xa4, 0xec,
-		0x99, 0x93, 0xd0, 0x9f, 0xb8, 0x75, 0x73, 0xfa, 0x28, 0xa3, 0x6f, 0xc5, 0x91, 0x95, 0x71, 0x0f,
-		0x28, 0xb8, 0x3d, 0x0e, 0x40, 0xf4, 0x2a, 0xb3, 0x2b, 0xdc, 0x6c, 0xb9, 0x73, 0xe8, 0xd6, 0xcd,
-		0xe9, 0x71, 0xc6, 0xc5, 0xab, 0x93, 0x95, 0x24, 0x29, 0x14, 0xc9, 0x6f, 0x9f, 0x67, 0x7f, 0x5a,
-		0x02, 0xe4, 0x85, 0x7c, 0x05, 0xdb, 0x26, 0x59, 0x9f, 0x91, 0x44, 0xdc, 0x97, 0x35, 0x4b, 0xdd,
-		0x13, 0x71, 0x8f, 0x5e, 0x24, 0xe2, 0xbe, 0x91, 0xf2, 0x94, 0x17, 0x1e, 0x23, 0xbd, 0xee, 0x33,
-		0x73, 0x17, 0x09, 0xc7, 0xc3, 0x01, 0xf9, 0x5f, 0x4a, 0x70, 0xb4, 0xc5, 0xa3, 0x5c, 0x61, 0xff,
-		0x12, 0x20, 0xcb, 0x57, 0xc9, 0xbf, 0xa3, 0xc8, 0x84, 0xde, 0xb7, 0x83, 0x8e, 0x5b, 0x2d, 0x71,
-		0xf7, 0xf6, 0x45, 0x78, 0x76, 0x61, 0xfe, 0x9f, 0x49, 0x30, 0xe9, 0x6f, 0xde, 0x55, 0x64, 0x19,
-		0x52, 0xfe, 0xd6, 0xb9, 0x0a, 0xf7, 0xf4, 0xa3, 0x02, 0x97, 0x3e, 0x40, 0x8f, 0x9e, 0xf5, 0x86,
-		0x2b, 0xdb, 0x3b, 0x7b, 0xb4, 0x6f, 0x6b, 0x08, 0x99, 0xc2, 0xc3, 0x36, 0x46, 0xfb, 0xe3, 0xff,
-		0x48, 0x10, 0x5b, 0x35, 0x8c, 0x3a, 0x32, 0x60, 0x5c, 0x37, 0x9c, 0x32, 0xf1, 0x2c, 0x5c, 0xf5,
-		0xdf, 0x5b, 0x4f, 0x16, 0xe6, 0xf6, 0x67, 0xa4, 0xef, 0xdd, 0x9c, 0x6e, 0x65, 0xa5, 0x8c, 0xe9,
-		0x86, 0x53, 0xa0, 0x10, 0x7e, 0x75, 0xfd, 0x9d, 0x30, 0x12, 0x6c, 0x8c, 0x45, 0xc9, 0xe7, 0xf6,
-		0xdd, 0x58, 0x90, 0xcd, 0xad, 0x9b, 0xd3, 0x93, 0xde, 0x88, 0x71, 0xc1, 0xb2, 0x92, 0xda, 0xf4,
-		0xb5, 0xce, 0xae, 0x77, 0xfd, 0xe0, 0xd5, 0x69, 0xe9, 0xd4, 0x97, 0x25, 0x00, 0x6f, 0xe7, 0x01,
-		0x3d, 0x04, 0x47, 0x0a, 0x2b, 0xcb, 0xc5, 0xf2, 0xda, 0x7a, 0x7e, 0x7d, 0x63, 0x2d, 0x78, 0xc7,
-		0x5b, 0x6c, 0x8f, 0xdb, 0x26, 0xae, 0x68, 0x5b, 0x1a, 0xae, 0xa2, 0xfb, 0x60, 0x32, 0x88, 0x4d,
-		0x4a, 0xa5, 0x62, 0x5a, 0xca, 0xa6, 0xae, 0xdf, 0x98, 0x49, 0xb0, 0x5c, 0x0c, 0x57, 0xd1, 0x49,
-		0x38, 0xd4, 0x8a, 0xb7, 0xb0, 0x3c, 0x9f, 0x8e, 0x64, 0x47, 0xae, 0xdf, 0x98, 0x49, 0xba, 0x49,
-		0x1b, 0x92, 0x01, 0xf9, 0x31, 0x39, 0xbf, 0x68, 0x16, 0xae, 0xdf, 0x98, 0x19, 0x64, 0x06, 0xcc,
-		0xc6, 0x3e, 0xf0, 0xe9, 0xa9, 0x81, 0xdb, 0x7e, 0x13, 0xfc, 0x4f, 0x86, 0x3a, 0xee, 0x7a, 0xd7,
-		0xb0, 0x8e, 0x6d, 0xcd, 0x3e, 0xd0, 0xae, 0x77, 0x5f, 0x3b, 0xe9, 0xf2, 0xef, 0xc6, 0x21, 0x35,
-		0xcf, 0x5a, 0x21, 0x1d, 0x81, 0xd1, 0xcf, 0xc0, 0xa0, 0x49, 0xa7, 0x11, 0xf7, 0x18, 0xad, 0x83,
-		0xc3, 0xb3, 0xc9, 0xc6, 0xbd, 0xcb, 0xc5, 0xa6, 0x1e, 0x9b, 0x5f, 0xe6, 0x60, 0x77, 0xcc, 0xbc,
-		0x5b, 0x53, 0xa9, 0x7d, 0xed, 0xf7, 0xb0, 0x9c, 0x85, 0x6f, 0xad, 0x84, 0xf9, 0xc9, 0xec, 0x5e,
-		0xc8, 0x3a, 0x81, 0xb0, 0xdb, 0x61, 0xef, 0x93, 0xe0, 0x10, 0xc5, 0xf2, 0x26, 0x62, 0x8a, 0x29,
-		0x92, 0xfd, 0x53, 0x9d, 0x54, 0x58, 0x54, 0x6d, 0xef, 0xae, 0x07, 0xbb, 0xcf, 0x75, 0x0f, 0x9f,
-		0x08, 0x8f, 0xfb, 0x1a, 0x0f, 0xb3, 0x95, 0x95, 0x89, 0x7a, 0x0b, 0xa5, 0x8d, 0xe6, 0x03, 0x17,
-		0xfa, 0x62, 0xfb, 0xdb, 0x6a, 0xf7, 0x5f, 0xee, 0x7b, 0x06, 0x86, 0xbd, 0x58, 0x62, 0xf3, 0xff,
-		0xfb, 0xd2, 0xff, 0xdc, 0xe1, 0x27, 0x46, 0xef, 0x97, 0xe0, 0x90, 0x37, 0x9b, 0xfb, 0xd9, 0xb2,
-		0xff, 0x8f, 0xf3, 0xe0, 0x3e, 0x16, 0x42, 0x61, 0xe3, 0xb4, 0xe5, 0x2b, 0x2b, 0x93, 0xcd, 0x56,
-		0x52, 0xb2, 0x04, 0x1b, 0xf1, 0x47, 0x56, 0x3b, 0x23, 0x3e, 0x01, 0xd9, 0x7f, 0x68, 0x0e, 0x32,
-		0x60, 0xff, 0xb3, 0xc3, 0x34, 0x2c, 0x07, 0x57, 0xe9, 0x86, 0x5c, 0x42, 0x71, 0xcb, 0xf2, 0x32,
-		0xa0, 0xd6, 0xce, 0x0d, 0x5f, 0x60, 0xf4, 0xde, 0xa7, 0xa0, 0x49, 0x88, 0xfb, 0xaf, 0xf8, 0xb1,
-		0x42, 0x2e, 0xf1, 0x01, 0x3e, 0x7d, 0xde, 0xf6, 0x31, 0xff, 0xed, 0x08, 0x9c, 0xf2, 0x1f, 0x0f,
-		0xbd, 0xd4, 0xc4, 0xd6, 0x9e, 0x3b, 0x44, 0x4d, 0xb5, 0xa6, 0xe9, 0xfe, 0x57, 0x10, 0x47, 0xfd,
-		0x13, 0x3e, 0xc5, 0x15, 0x76, 0x92, 0x3f, 0x20, 0xc1, 0xf0, 0xaa, 0x5a, 0xc3, 0x0a, 0x7e, 0xa9,
-		0x89, 0x6d, 0xa7, 0xcd, 0x2d, 0xf3, 0xc3, 0x30, 0x68, 0x6c, 0x6d, 0x89, 0x33, 0xed, 0x98, 0xc2,
-		0x4b, 0x44, 0xe7, 0xba, 0xd6, 0xd0, 0xd8, 0x75, 0xb0, 0x98, 0xc2, 0x0a, 0x68, 0x1a, 0x86, 0x2b,
-		0x46, 0x53, 0xe7, 0x43, 0x2e, 0x13, 0x13, 0xdf, 0x5a, 0x69, 0xea, 0x6c, 0xc8, 0x11, 0x23, 0x5a,
-		0xf8, 0x0a, 0xb6, 0x6c, 0xf6, 0x75, 0xc9, 0x84, 0x22, 0x8a, 0xf2, 0xd3, 0x90, 0x62, 0x92, 0xf0,
-		0xc9, 0xf8, 0x28, 0x24, 0xe8, 0x4d, 0x2b, 0x4f, 0x9e, 0x21, 0x52, 0xbe, 0xc4, 0xee, 0xaa, 0x33,
-		0xfe, 0x4c, 0x24, 0x56, 0x28, 0x14, 0x3a, 0x5a, 0xf9, 0x64, 0xef, 0xa8, 0xc1, 0x6c, 0xe8, 0x5a,
-		0xf8, 0x37, 0xe3, 0x70, 0x88, 0x1f, 0xde, 0xa9, 0xa6, 0x76, 0x7a, 0xdb, 0x71, 0xc4, 0xdb, 0x09,
-		0xe0, 0x59, 0xb0, 0x6a, 0x6a, 0xf2, 0x1e, 0xc4, 0x2e, 0x3a, 0x8e, 0x89, 0x4e, 0x41, 0xdc, 0x6a,
-		0xd6, 0xb1, 0xd8, 0x0c, 0x72, 0xb7, 0xeb, 0x55, 0x53, 0x9b, 0x25, 0x08, 0x4a, 0xb3, 0x8e, 0x15,
-		0x86, 0x82, 0x4a, 0x30, 0xbd, 0xd5, 0xac, 0xd7, 0xf7, 0xca, 0x55, 0x4c, 0xff, 0x5d, 0x96, 0xfb,
-		0x0f, 0x27, 0xf0, 0xae, 0xa9, 0x8a, 0xcf, 0x56, 0x12, 0xc3, 0x1c, 0xa7, 0x68, 0x45, 0x8a, 0x25,
-		0xfe, 0xd9, 0x44, 0x49, 0xe0, 0xc8, 0xbf, 0x1f, 0x81, 0x84, 0x60, 0x4d, 0x2f, 0x8f, 0xe3, 0x3a,
-		0xae, 0x38, 0x86, 0x38, 0x4c, 0x71, 0xcb, 0x08, 0x41, 0xb4, 0xc6, 0x3b, 0x2f, 0x79, 0x71, 0x40,
-		0x21, 0x05, 0x02, 0x73, 0xaf, 0xf4, 0x13, 0x98, 0xd9, 0x24, 0xfd, 0x19, 0x33, 0x0d, 0xb1, 0x6a,
-		0xbb, 0x38, 0xa0, 0xd0, 0x12, 0xca, 0xc0, 0x20, 0x19, 0x34, 0x0e, 0xeb, 0x2d, 0x02, 0xe7, 0x65,
-		0x74, 0x18, 0xe2, 0xa6, 0xea, 0x54, 0xd8, 0x6d, 0x3b, 0x52, 0xc1, 0x8a, 0xe8, 0x09, 0x18, 0x64,
-		0xaf, 0xb2, 0xc3, 0xff, 0x8b, 0x86, 0x18, 0x83, 0x7d, 0xfe, 0x8e, 0xc8, 0xbd, 0xaa, 0x3a, 0x0e,
-		0xb6, 0x74, 0xc2, 0x90, 0xa1, 0x23, 0x04, 0xb1, 0x4d, 0xa3, 0xba, 0xc7, 0xff, 0x3f, 0x0e, 0xfd,
-		0xcd, 0xff, 0x21, 0x07, 0xf5, 0x87, 0x32, 0xad, 0x64, 0xff, 0x16, 0x2c, 0x25, 0x80, 0x05, 0x82,
-		0x54, 0x82, 0x09, 0xb5, 0x5a, 0xd5, 0xd8, 0xbf, 0xaa, 0x29, 0x6f, 0x6a, 0x34, 0x78, 0xd8, 0xf4,
-		0x9f, 0xbe, 0x75, 0xea, 0x0b, 0xe4, 0x11, 0x14, 0x38, 0x7e, 0x21, 0x09, 0x43, 0x26, 0x13, 0x4a,
-		0x3e, 0x0f, 0xe3, 0x2d, 0x92, 0x12, 0xf9, 0x76, 0x34, 0xbd, 0x2a, 0xde, 0x39, 0x90, 0xdf, 0x04,
-		0x46, 0x3f, 0x58, 0xc9, 0x8e, 0xa9, 0xe8, 0xef, 0xc2, 0x7b, 0x3a, 0x3f, 0x87, 0x19, 0xf5, 0x3d,
-		0x87, 0x51, 0x4d, 0xad, 0x90, 0xa4, 0xfc, 0xf9, 0x23, 0x98, 0x7c, 0xeb, 0x23, 0x98, 0x1a, 0xd6,
-		0xc5, 0xc4, 0x4c, 0xaa, 0x54, 0x53, 0xb3, 0xa9, 0x3b, 0x7a, 0x1f, 0xd0, 0xb4, 0xcf, 0xfb, 0x7e,
-		0xd3, 0x37, 0x31, 0xb1, 0xf9, 0xfc, 0xea, 0x82, 0xeb, 0xc7, 0x5f, 0x8d, 0xc0, 0x71, 0x9f, 0x1f,
-		0xfb, 0x90, 0x5b, 0xdd, 0x39, 0xdb, 0xde, 0xe3, 0xfb, 0x78, 0x9b, 0x7c, 0x09, 0x62, 0x04, 0x1f,
-		0xf5, 0xf8, 0x77, 0x19, 0x99, 0xcf, 0x7f, 0xe3, 0x9f, 0xca, 0xc1, 0x03, 0xad, 0x40, 0xaf, 0x50,
-		0x26, 0x85, 0xf7, 0xf7, 0x6f, 0xbf, 0xb4, 0xf7, 0xed, 0x50, 0xfb, 0xf6, 0x99, 0x31, 0x6c, 0xc3,
-		0xef, 0x9e, 0xed, 0xf8, 0x76, 0x95, 0x05, 0xd3, 0xee, 0xf9, 0xd5, 0x3e, 0x22, 0x75, 0xa7, 0xa7,
-		0x01, 0xdd, 0x7a, 0xb0, 0xcf, 0x4c, 0x6d, 0x17, 0x0e, 0x3f, 0x4b, 0xda, 0xf6, 0x56, 0xd0, 0x22,
-		0xe4, 0x1f, 0x76, 0x0f, 0xfa, 0x24, 0xfe, 0x3f, 0xf7, 0xc4, 0x21, 0x1e, 0x78, 0xf2, 0xf1, 0xb5,
-		0xe3, 0x7d, 0xb3, 0x1d, 0xa7, 0x92, 0x59, 0xdf, 0x34, 0xa2, 0xf8, 0x28, 0xe5, 0x5f, 0x95, 0xe0,
-		0x48, 0x4b, 0xd3, 0x3c, 0xc6, 0xcf, 0xb7, 0x79, 0xc5, 0x70, 0xa0, 0xa4, 0x67, 0xbe, 0x8d, 0xb0,
-		0xf7, 0xf7, 0x14, 0x96, 0x49, 0x11, 0x90, 0xf6, 0xcd, 0x70, 0x28, 0x28, 0xac, 0x30, 0xd3, 0xbd,
-		0x30, 0x1a, 0xdc, 0x2c, 0xe6, 0xe6, 0x1a, 0x09, 0x6c, 0x17, 0xcb, 0xe5, 0xb0, 0x9d, 0x5d, 0x5d,
-		0x4b, 0x90, 0x74, 0x51, 0x79, 0x76, 0xdc, 0xb7, 0xaa, 0x1e, 0xa5, 0xfc, 0x61, 0x09, 0x66, 0x82,
-		0x2d, 0xf8, 0xf2, 0xa4, 0xfd, 0x09, 0x7b, 0xdb, 0xba, 0xf8, 0x75, 0x09, 0xee, 0xea, 0x22, 0x13,
-		0x37, 0xc0, 0x35, 0x98, 0xf4, 0x6d, 0x12, 0x88, 0x10, 0x2e, 0xba, 0xfd, 0x54, 0xef, 0x0c, 0xd5,
-		0x5d, 0x13, 0x1f, 0x23, 0x46, 0xf9, 0xec, 0xb7, 0xa7, 0x27, 0x5a, 0xeb, 0x6c, 0x65, 0xa2, 0x75,
-		0x61, 0x7f, 0x1b, 0xfd, 0xe3, 0x15, 0x09, 0x1e, 0x08, 0xaa, 0xda, 0x26, 0xd5, 0xfd, 0x49, 0xf5,
-		0xc3, 0xbf, 0x97, 0xe0, 0x54, 0x3f, 0xc2, 0xf1, 0x0e, 0xd9, 0x84, 0x09, 0x2f, 0x09, 0x0f, 0xf7,
-		0xc7, 0xbe, 0x52, 0x7b, 0xe6, 0xa5, 0xc8, 0xe5, 0x76, 0x07, 0x0c, 0x6f, 0xf2, 0x81, 0xe5, 0xef,
-		0x72, 0xd7, 0xc8, 0xc1, 0x8d, 0x5e, 0x61, 0xe4, 0xc0, 0x56, 0x6f, 0x9b, 0xbe, 0x88, 0xb4, 0xe9,
-		0x0b, 0x2f, 0x6b, 0x97, 0xaf, 0xf0, 0xb8, 0xd5, 0x66, 0x7b, 0xee, 0x6d, 0x30, 0xd1, 0xc6, 0x95,
-		0xf9, 0xa8, 0xde, 0x87, 0x27, 0x2b, 0xa8, 0xd5, 0x59, 0xe5, 0x3d, 0x98, 0xa6, 0xed, 0xb6, 0x31,
-		0xf4, 0x9d, 0x56, 0xb9, 0xc1, 0x63, 0x4b, 0xdb, 0xa6, 0xb9, 0xee, 0x0b, 0x30, 0xc8, 0xfa, 0x99,
-		0xab, 0x7b, 0x00, 0x47, 0xe1, 0x0c, 0xe4, 0x8f, 0x8b, 0x58, 0x56, 0x14, 0x62, 0xb7, 0x1f, 0x43,
-		0xfd, 0xe8, 0x7a, 0x9b, 0xc6, 0x90, 0xcf, 0x18, 0xdf, 0x12, 0x51, 0xad, 0xbd, 0x74, 0xdc, 0x1c,
-		0x95, 0xdb, 0x16, 0xd5, 0x98, 0x6d, 0xee, 0x6c, 0xf8, 0xfa, 0x65, 0x11, 0xbe, 0x5c, 0x9d, 0x7a,
-		0x84, 0xaf, 0x9f, 0x8c, 0xe9, 0xdd, 0x40, 0xd6, 0x43, 0xcc, 0x3f, 0x8f, 0x81, 0xec, 0x07, 0x12,
-		0x1c, 0xa5, 0xba, 0xf9, 0xf7, 0x28, 0xf6, 0x6b, 0xf2, 0x87, 0x00, 0xd9, 0x56, 0xa5, 0xdc, 0x76,
-		0x74, 0xa7, 0x6d, 0xab, 0x72, 0x39, 0x30, 0xbf, 0x3c, 0x04, 0xa8, 0x1a, 0xd8, 0x89, 0xa2, 0xd8,
-		0xec, 0x02, 0x5d, 0xba, 0xea, 0xdb, 0xe8, 0x68, 0xd3, 0x9d, 0xb1, 0xdb, 0xd0, 0x9d, 0xdf, 0x94,
-		0x20, 0xdb, 0x4e, 0x65, 0xde, 0x7d, 0x1a, 0x1c, 0x0e, 0x9c, 0x1f, 0x84, 0x7b, 0xf0, 0xa1, 0x7e,
-		0x76, 0x79, 0x42, 0xc3, 0xe8, 0x90, 0x85, 0xef, 0x74, 0x1e, 0x30, 0x1d, 0xf4, 0xd0, 0xd6, 0xcc,
-		0xfa, 0x27, 0x36, 0x7c, 0xbe, 0xd4, 0x12, 0x57, 0xff, 0x5c, 0xe4, 0xde, 0xbb, 0x30, 0xd5, 0x41,
-		0xea, 0x3b, 0x3d, 0xef, 0x6d, 0x77, 0xec, 0xcc, 0xdb, 0x9d, 0xbe, 0x3f, 0xce, 0x47, 0x42, 0xf0,
-		0x72, 0xb6, 0x6f, 0x2d, 0xd6, 0xee, 0x75, 0x97, 0xfc, 0x56, 0x38, 0xd6, 0x96, 0x8a, 0xcb, 0x96,
-		0x83, 0xd8, 0xb6, 0x66, 0x3b, 0x5c, 0xac, 0xfb, 0x3a, 0x89, 0x15, 0xa2, 0xa6, 0x34, 0x32, 0x82,
-		0x34, 0x65, 0xbd, 0x6a, 0x18, 0x75, 0x2e, 0x86, 0x7c, 0x09, 0xc6, 0x7d, 0x30, 0xde, 0xc8, 0x39,
-		0x88, 0x99, 0x06, 0xff, 0x72, 0xc1, 0xf0, 0x99, 0xe3, 0x1d, 0x37, 0xf6, 0x0d, 0xa3, 0xce, 0xd5,
-		0xa6, 0xf8, 0xf2, 0x24, 0x20, 0xc6, 0x8c, 0xee, 0xf1, 0x8b, 0x26, 0xd6, 0x60, 0x22, 0x00, 0xe5,
-		0x8d, 0xbc, 0xa1, 0xf3, 0x83, 0x33, 0xdf, 0x3b, 0x04, 0x71, 0xca, 0x15, 0x7d, 0x4c, 0x0a, 0x7c,
-		0x5a, 0x68, 0xb6, 0x13, 0x9b, 0xf6, 0x6b, 0xe2, 0xec, 0xe9, 0xbe, 0xf1, 0x79, 0xce, 0x76, 0xea,
-		0x3d, 0xff, 0xe6, 0xbb, 0x1f, 0x89, 0xdc, 0x83, 0xe4, 0xd3, 0x1d, 0x56, 0xe3, 0xbe, 0xf1, 0xf2,
-		0x99, 0xc0, 0xb3, 0xf8, 0x87, 0xfb, 0x6b, 0x4a, 0x48, 0x36, 0xdb, 0x2f, 0x3a, 0x17, 0xec, 0x3c,
-		0x15, 0xec, 0x2c, 0x7a, 0xac, 0xb7, 0x60, 0xa7, 0xdf, 0x11, 0x1c, 0x34, 0xef, 0x42, 0xbf, 0x2b,
-		0xc1, 0x64, 0xbb, 0x25, 0x1d, 0x7a, 0xb2, 0x3f, 0x29, 0x5a, 0x53, 0x8a, 0xec, 0x53, 0x07, 0xa0,
-		0xe4, 0xaa, 0xcc, 0x53, 0x55, 0xf2, 0xe8, 0xe9, 0x03, 0xa8, 0x72, 0xda, 0xbf, 0xf5, 0xff, 0xbf,
-		0x24, 0x38, 0xd1, 0x75, 0x85, 0x84, 0xf2, 0xfd, 0x49, 0xd9, 0x25, 0x77, 0xca, 0x16, 0xde, 0x08,
-		0x0b, 0xae, 0xf1, 0xb3, 0x54, 0xe3, 0x4b, 0x68, 0xe1, 0x20, 0x1a, 0xb7, 0x3d, 0x5f, 0x41, 0xbf,
-		0x15, 0xbc, 0x74, 0xd8, 0xdd, 0x9d, 0x5a, 0x16, 0x1e, 0x3d, 0x06, 0x46, 0x6b, 0x52, 0x2b, 0x3f,
-		0x4f, 0x55, 0x50, 0xd0, 0xea, 0x1b, 0xec, 0xb4, 0xd3, 0xef, 0x08, 0x06, 0xfe, 0x77, 0xa1, 0xff,
-		0x29, 0xb5, 0xbf, 0x43, 0xf8, 0x44, 0x57, 0x11, 0x3b, 0x2f, 0xaa, 0xb2, 0x4f, 0xee, 0x9f, 0x90,
-		0x2b, 0xd9, 0xa0, 0x4a, 0xd6, 0x10, 0xbe, 0xdd, 0x4a, 0xb6, 0xed, 0x44, 0xf4, 0x75, 0x09, 0x26,
-		0xdb, 0xad, 0x49, 0x7a, 0x0c, 0xcb, 0x2e, 0x8b, 0xac, 0x1e, 0xc3, 0xb2, 0xdb, 0x02, 0x48, 0xfe,
-		0x19, 0xaa, 0xfc, 0x39, 0xf4, 0x78, 0x27, 0xe5, 0xbb, 0xf6, 0x22, 0x19, 0x8b, 0x5d, 0x93, 0xfc,
-		0x1e, 0x63, 0xb1, 0x9f, 0x75, 0x4c, 0x8f, 0xb1, 0xd8, 0xd7, 0x1a, 0xa3, 0xf7, 0x58, 0x74, 0x35,
-		0xeb, 0xb3, 0x1b, 0x6d, 0xf4, 0x55, 0x09, 0x46, 0x02, 0x19, 0x31, 0x7a, 0xb4, 0xab, 0xa0, 0xed,
-		0x16, 0x0c, 0xd9, 0x33, 0xfb, 0x21, 0xe1, 0xba, 0x2c, 0x50, 0x5d, 0xe6, 0x50, 0xfe, 0x20, 0xba,
-		0x04, 0x8f, 0x51, 0xbf, 0x29, 0xc1, 0x44, 0x9b, 0x2c, 0xb3, 0xc7, 0x28, 0xec, 0x9c, 0x34, 0x67,
-		0x9f, 0xdc, 0x3f, 0x21, 0xd7, 0xea, 0x02, 0xd5, 0xea, 0x2d, 0xe8, 0xcd, 0x07, 0xd1, 0xca, 0x37,
-		0x3f, 0xdf, 0xf4, 0xae, 0x64, 0xf9, 0xda, 0x41, 0xe7, 0xf6, 0x29, 0x98, 0x50, 0xe8, 0x89, 0x7d,
-		0xd3, 0x71, 0x7d, 0x9e, 0xa3, 0xfa, 0x3c, 0x8b, 0x56, 0xde, 0x98, 0x3e, 0xad, 0xd3, 0xfa, 0x17,
-		0x5b, 0x1f, 0x07, 0x76, 0xf7, 0xa2, 0xb6, 0xc9, 0x6a, 0xf6, 0xb1, 0x7d, 0xd1, 0x70, 0xa5, 0x9e,
-		0xa4, 0x4a, 0x9d, 0x41, 0x8f, 0x74, 0x52, 0xca, 0x77, 0xef, 0x4e, 0xd3, 0xb7, 0x8c, 0xd3, 0xef,
-		0x60, 0x29, 0xf0, 0xbb, 0xd0, 0xbb, 0xc5, 0x9d, 0xa7, 0x93, 0x5d, 0xdb, 0xf5, 0xe5, 0xb1, 0xd9,
-		0x07, 0xfa, 0xc0, 0xe4, 0x72, 0xdd, 0x43, 0xe5, 0x9a, 0x42, 0xc7, 0x3b, 0xc9, 0x45, 0x72, 0x59,
-		0xf4, 0x41, 0xc9, 0xbd, 0x26, 0x79, 0xaa, 0x3b, 0x6f, 0x7f, 0xb2, 0x9b, 0x7d, 0xb0, 0x2f, 0x5c,
-		0x2e, 0xc9, 0x7d, 0x54, 0x92, 0x19, 0x34, 0xd5, 0x51, 0x12, 0x96, 0xfa, 0xde, 0xee, 0x4b, 0x05,
-		0xd7, 0x8f, 0xc0, 0x74, 0x87, 0x16, 0x9d, 0xdd, 0x1e, 0x67, 0x5c, 0x5d, 0xde, 0xc8, 0xf6, 0x7c,
-		0x03, 0x7b, 0xbb, 0xbf, 0xed, 0xda, 0xe7, 0x81, 0xd8, 0x6f, 0xc7, 0x00, 0x2d, 0xd9, 0xb5, 0x39,
-		0x0b, 0xb3, 0xff, 0x33, 0xc9, 0x47, 0x79, 0xe8, 0xf1, 0x97, 0xf4, 0x86, 0x1e, 0x7f, 0x2d, 0x05,
-		0x9e, 0x53, 0x45, 0xf6, 0xf7, 0x64, 0xb3, 0xef, 0x37, 0x55, 0xd1, 0x1f, 0xcb, 0x9b, 0xaa, 0xf6,
-		0x57, 0xae, 0x63, 0xb7, 0xef, 0x6d, 0x46, 0xfc, 0xa0, 0xef, 0x53, 0xf8, 0x53, 0xc9, 0xc1, 0x2e,
-		0x4f, 0x25, 0x33, 0x1d, 0xdf, 0x43, 0x72, 0x6a, 0x74, 0x56, 0x7c, 0xe9, 0x74, 0xa8, 0xbf, 0x4b,
-		0xb2, 0xfc, 0x53, 0xa8, 0xde, 0x16, 0xc2, 0x71, 0xc8, 0xb6, 0xba, 0x93, 0x3b, 0xa8, 0x3f, 0x12,
-		0x85, 0xf4, 0x92, 0x5d, 0x2b, 0x55, 0x35, 0xe7, 0x0e, 0xf9, 0xda, 0xd3, 0x9d, 0xdf, 0xbb, 0xa0,
-		0x5b, 0x37, 0xa7, 0x47, 0x99, 0x4d, 0xbb, 0x58, 0xb2, 0x01, 0x63, 0xa1, 0x57, 0xc6, 0xdc, 0xb3,
-		0x8a, 0x07, 0x79, 0xec, 0x1c, 0x62, 0x25, 0xd3, 0xe7, 0x09, 0x3e, 0xff, 0x46, 0xbb, 0xed, 0x9d,
-		0x99, 0x39, 0xd4, 0xc5, 0x3b, 0xf9, 0x38, 0xd0, 0xeb, 0xb3, 0x2c, 0x64, 0xc2, 0x9d, 0xe2, 0xf6,
-		0xd8, 0x1f, 0x49, 0x30, 0xbc, 0x64, 0x8b, 0x54, 0x10, 0xff, 0x94, 0x3e, 0x4d, 0x7a, 0xc2, 0xfd,
-		0x4c, 0x78, 0xb4, 0x3f, 0xbf, 0x15, 0x9f, 0x0e, 0xf7, 0x8c, 0x70, 0x08, 0x26, 0x7c, 0x7a, 0xba,
-		0xfa, 0xff, 0x4e, 0x84, 0xc6, 0xc7, 0x02, 0xae, 0x69, 0xba, 0x9b, 0x45, 0xe2, 0xbf, 0xa8, 0x0f,
-		0x2f, 0x3c, 0x3b, 0xc7, 0x0e, 0x6a, 0xe7, 0x1d, 0x1a, 0x20, 0x42, 0xf6, 0x74, 0x37, 0xbe, 0x96,
-		0x5a, 0x9f, 0x05, 0x49, 0xfb, 0xf8, 0xe2, 0x4e, 0xe8, 0xf1, 0x8f, 0xfc, 0xba, 0x04, 0x23, 0x4b,
-		0x76, 0x6d, 0x43, 0xaf, 0xfe, 0x3f, 0xef, 0xbf, 0x5b, 0x70, 0x28, 0xa0, 0xe9, 0x1d, 0x32, 0xe9,
-		0x99, 0x57, 0x62, 0x10, 0x5d, 0xb2, 0x6b, 0xe8, 0x25, 0x18, 0x0b, 0x27, 0x0d, 0x1d, 0x73, 0xc1,
-		0xd6, 0x19, 0xa1, 0xf3, 0x7a, 0xad, 0xf3, 0xec, 0x81, 0x76, 0x60, 0x24, 0x38, 0x73, 0x9c, 0xec,
-		0xc2, 0x24, 0x80, 0x99, 0x7d, 0xa4, 0x5f, 0x4c, 0xb7, 0xb1, 0xb7, 0x43, 0xc2, 0x0d, 0x7a, 0x77,
-		0x77, 0xa1, 0x16, 0x48, 0x9d, 0xb3, 0xdb, 0x36, 0x61, 0x85, 0x58, 0x2f, 0x1c, 0x52, 0xba, 0x59,
-		0x2f, 0x84, 0xdb, 0xd5, 0x7a, 0x9d, 0x86, 0xd6, 0x26, 0x80, 0x6f, 0x1c, 0xdc, 0xdb, 0x85, 0x83,
-		0x87, 0x96, 0x7d, 0xb8, 0x2f, 0x34, 0xf7, 0xd0, 0xe9, 0x36, 0x27, 0xe3, 0xff, 0x37, 0x00, 0x00,
-		0xff, 0xff, 0xb1, 0x48, 0x2a, 0x05, 0xdd, 0x97, 0x00, 0x00,
+		// 12099 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x90, 0x1c, 0xd7,
+		0x75, 0x18, 0x8c, 0x9e, 0x99, 0x9d, 0xc7, 0xd9, 0x57, 0xef, 0xdd, 0x05, 0x30, 0x18, 0x00, 0xbb,
+		0x60, 0x93, 0x04, 0x41, 0x90, 0x5c, 0x10, 0x4b, 0x02, 0x04, 0x96, 0x92, 0xe8, 0x99, 0x9d, 0xc1,
+		0x62, 0x80, 0x7d, 0xb1, 0x67, 0x17, 0x7c, 0x58, 0xfe, 0xa6, 0x7a, 0x67, 0xee, 0xce, 0x36, 0x31,
+		0xd3, 0xdd, 0x9a, 0xee, 0x59, 0x60, 0x29, 0xdb, 0x45, 0xcb, 0xfa, 0x14, 0x8a, 0x8a, 0x6c, 0x29,
+		0x72, 0xd9, 0x94, 0x6c, 0xc8, 0x92, 0x65, 0x47, 0xb6, 0x2c, 0xbf, 0x15, 0x3f, 0x12, 0x57, 0xc5,
+		0x4e, 0x95, 0x63, 0x4b, 0xb1, 0x5d, 0x52, 0x12, 0x3b, 0x8e, 0x2b, 0x81, 0x12, 0xc9, 0x65, 0x51,
+		0xb2, 0x12, 0x3b, 0x88, 0x52, 0x71, 0x4a, 0x95, 0x52, 0xea, 0xbe, 0xfa, 0x35, 0xcf, 0x5d, 0x01,
+		0x36, 0x1d, 0xe7, 0x0f, 0xb0, 0xf7, 0xdc, 0x73, 0xce, 0x3d, 0xf7, 0xdc, 0x73, 0xcf, 0x39, 0xf7,
+		0xd5, 0x03, 0x5f, 0xd9, 0x84, 0x13, 0x35, 0xd3, 0xac, 0xd5, 0xf1, 0x19, 0xab, 0x69, 0x3a, 0xe6,
+		0x66, 0x6b, 0xeb, 0x4c, 0x15, 0xdb, 0x95, 0xa6, 0x6e, 0x39, 0x66, 0x73, 0x96, 0xc2, 0xd0, 0x38,
+		0xc3, 0x98, 0x15, 0x18, 0xca, 0xe7, 0x24, 0x98, 0xb8, 0xa4, 0xd7, 0x71, 0xde, 0xc5, 0x2c, 0x61,
+		0x07, 0x5d, 0x80, 0xd8, 0x96, 0x5e, 0xc7, 0x69, 0xe9, 0x44, 0xf4, 0xd4, 0xf0, 0xdc, 0x03, 0xb3,
+		0x21, 0xaa, 0xd9, 0x20, 0xc5, 0x1a, 0x01, 0xab, 0x94, 0xe2, 0xf4, 0x3b, 0x93, 0xaf, 0x7c, 0xfd,
+		0x73, 0xdf, 0x92, 0xe4, 0xef, 0x23, 0xff, 0x66, 0x1a, 0xe8, 0x3a, 0x2b, 0xa3, 0x27, 0x67, 0x09,
+		0x9d, 0x4f, 0x9e, 0x9d, 0xb3, 0x04, 0x52, 0x26, 0x44, 0x65, 0x0f, 0x5c, 0xb6, 0xb1, 0x53, 0xc6,
+		0x37, 0x1d, 0x6c, 0xd8, 0xba, 0x69, 0x64, 0x1e, 0xeb, 0x40, 0xd5, 0x26, 0x6d, 0x41, 0xa0, 0x2b,
+		0xaf, 0x0f, 0xc1, 0x64, 0x07, 0xd1, 0x10, 0x82, 0x98, 0xa1, 0x35, 0x48, 0x77, 0xa4, 0x53, 0x29,
+		0x95, 0xfe, 0x8d, 0xd2, 0x90, 0xb0, 0xb4, 0xca, 0x75, 0xad, 0x86, 0xd3, 0x11, 0x0a, 0x16, 0x45,
+		0x34, 0x0d, 0x50, 0xc5, 0x16, 0x36, 0xaa, 0xd8, 0xa8, 0xec, 0xa6, 0xa3, 0x27, 0xa2, 0xa7, 0x52,
+		0xaa, 0x0f, 0x82, 0x1e, 0x81, 0x09, 0xab, 0xb5, 0x59, 0xd7, 0x2b, 0x65, 0x1f, 0x1a, 0x9c, 0x88,
+		0x9e, 0x1a, 0x52, 0x65, 0x56, 0x91, 0xf7, 0x90, 0x1f, 0x82, 0xf1, 0x1b, 0x58, 0xbb, 0xee, 0x47,
+		0x1d, 0xa6, 0xa8, 0x63, 0x04, 0xec, 0x43, 0x5c, 0x80, 0x91, 0x06, 0xb6, 0x6d, 0xad, 0x86, 0xcb,
+		0xce, 0xae, 0x85, 0xd3, 0x31, 0xaa, 0xfa, 0x13, 0x6d, 0xaa, 0x0f, 0xab, 0x7d, 0x98, 0x53, 0xad,
+		0xef, 0x5a, 0x18, 0x65, 0x21, 0x85, 0x8d, 0x56, 0x83, 0x71, 0x18, 0xea, 0x32, 0x78, 0x05, 0xa3,
+		0xd5, 0x08, 0x73, 0x49, 0x12, 0x32, 0xce, 0x22, 0x61, 0xe3, 0xe6, 0x8e, 0x5e, 0xc1, 0xe9, 0x38,
+		0x65, 0xf0, 0x50, 0x1b, 0x83, 0x12, 0xab, 0x0f, 0xf3, 0x10, 0x74, 0x68, 0x01, 0x52, 0xee, 0x10,
+		0xa6, 0x13, 0x94, 0xc9, 0x83, 0x1d, 0x4c, 0x08, 0xd7, 0xab, 0x61, 0x16, 0x1e, 0x1d, 0x3a, 0x0f,
+		0x09, 0xd3, 0x72, 0x74, 0xd3, 0xb0, 0xd3, 0xc9, 0x13, 0xd2, 0xa9, 0xe1, 0xb9, 0x63, 0x1d, 0xad,
+		0x70, 0x95, 0xe1, 0xa8, 0x02, 0x19, 0x15, 0x41, 0xb6, 0xcd, 0x56, 0xb3, 0x82, 0xcb, 0x15, 0xb3,
+		0x8a, 0xcb, 0xba, 0xb1, 0x65, 0xa6, 0x53, 0x94, 0xc1, 0x4c, 0x7b, 0x47, 0x28, 0xe2, 0x82, 0x59,
+		0xc5, 0x45, 0x63, 0xcb, 0x54, 0xc7, 0xec, 0x40, 0x19, 0x1d, 0x82, 0xb8, 0xbd, 0x6b, 0x38, 0xda,
+		0xcd, 0xf4, 0x08, 0xb5, 0x10, 0x5e, 0x42, 0x73, 0x90, 0xc0, 0x55, 0x9d, 0x34, 0x97, 0x1e, 0x3b,
+		0x21, 0x9d, 0x1a, 0x9b, 0x4b, 0xb7, 0xeb, 0x98, 0xd5, 0xab, 0x02, 0x51, 0xf9, 0x8d, 0x38, 0x8c,
+		0x0f, 0x62, 0x96, 0x4f, 0xc3, 0xd0, 0x16, 0xd1, 0x4c, 0x3a, 0xb2, 0x17, 0xbd, 0x31, 0x9a, 0xa0,
+		0xe2, 0xe3, 0xfb, 0x54, 0x7c, 0x16, 0x86, 0x0d, 0x6c, 0x3b, 0xb8, 0xca, 0xac, 0x28, 0x3a, 0xa0,
+		0x1d, 0x02, 0x23, 0x6a, 0x37, 0xc3, 0xd8, 0xbe, 0xcc, 0xf0, 0x79, 0x18, 0x77, 0x45, 0x2a, 0x37,
+		0x35, 0xa3, 0x26, 0xec, 0xf9, 0x4c, 0x3f, 0x49, 0x66, 0x5d, 0x7f, 0xa0, 0x12, 0x32, 0x75, 0x0c,
+		0x07, 0xca, 0x28, 0x0f, 0x60, 0x1a, 0xd8, 0xdc, 0x2a, 0x57, 0x71, 0xa5, 0x9e, 0x4e, 0x76, 0xd1,
+		0xd2, 0x2a, 0x41, 0x69, 0xd3, 0x92, 0xc9, 0xa0, 0x95, 0x3a, 0xba, 0xe8, 0x99, 0x67, 0xa2, 0x8b,
+		0x75, 0x2d, 0xb3, 0x89, 0xd9, 0x66, 0xa1, 0x1b, 0x30, 0xd6, 0xc4, 0x64, 0xae, 0xe0, 0x2a, 0xef,
+		0x59, 0x8a, 0x0a, 0x31, 0xdb, 0xb7, 0x67, 0x2a, 0x27, 0x63, 0x1d, 0x1b, 0x6d, 0xfa, 0x8b, 0xe8,
+		0x7e, 0x70, 0x01, 0x65, 0x6a, 0x56, 0x40, 0x3d, 0xd7, 0x88, 0x00, 0xae, 0x68, 0x0d, 0x9c, 0x79,
+		0x19, 0xc6, 0x82, 0xea, 0x41, 0x53, 0x30, 0x64, 0x3b, 0x5a, 0xd3, 0xa1, 0x56, 0x38, 0xa4, 0xb2,
+		0x02, 0x92, 0x21, 0x8a, 0x8d, 0x2a, 0xf5, 0x8c, 0x43, 0x2a, 0xf9, 0x13, 0x7d, 0x87, 0xd7, 0xe1,
+		0x28, 0xed, 0xf0, 0xc9, 0xf6, 0x11, 0x0d, 0x70, 0x0e, 0xf7, 0x3b, 0xf3, 0x14, 0x8c, 0x06, 0x3a,
+		0x30, 0x68, 0xd3, 0xca, 0xef, 0xc7, 0xe0, 0x60, 0x47, 0xde, 0xe8, 0x79, 0x98, 0x6a, 0x19, 0xba,
+		0xe1, 0xe0, 0xa6, 0xd5, 0xc4, 0xc4, 0x64, 0x59, 0x5b, 0xe9, 0xaf, 0x24, 0xba, 0x18, 0xdd, 0x86,
+		0x1f, 0x9b, 0x71, 0x51, 0x27, 0x5b, 0xed, 0x40, 0xf4, 0x02, 0x0c, 0x13, 0xfb, 0xd0, 0x9a, 0x1a,
+		0x65, 0xc8, 0x66, 0xe3, 0xdc, 0x60, 0x5d, 0x9e, 0xcd, 0x7b, 0x94, 0xb9, 0xe8, 0xab, 0x52, 0x44,
+		0xf5, 0xf3, 0x42, 0x4f, 0x41, 0x72, 0x0b, 0x6b, 0x4e, 0xab, 0x89, 0xed, 0xf4, 0x1c, 0x55, 0xe5,
+		0xd1, 0xf6, 0x49, 0xca, 0x10, 0x4a, 0xd8, 0x51, 0x5d, 0x64, 0xd4, 0x80, 0x91, 0x1d, 0xdc, 0xd4,
+		0xb7, 0xf4, 0x0a, 0x13, 0x2a, 0x4a, 0x9d, 0xcf, 0x85, 0x01, 0x85, 0xba, 0xe6, 0x23, 0x2d, 0x39,
+		0x9a, 0x83, 0xe7, 0x61, 0x63, 0xe5, 0x5a, 0x41, 0x2d, 0x5e, 0x2a, 0x16, 0xf2, 0x4c, 0xcc, 0x00,
+		0xfb, 0xcc, 0x0f, 0x49, 0x30, 0xec, 0xeb, 0x09, 0x71, 0x87, 0x46, 0xab, 0xb1, 0x89, 0x9b, 0x7c,
+		0xbc, 0x78, 0x09, 0x1d, 0x85, 0xd4, 0x56, 0xab, 0x5e, 0x67, 0x46, 0xc7, 0x62, 0x69, 0x92, 0x00,
+		0x88, 0xc1, 0x11, 0x1f, 0xc7, 0xdd, 0x08, 0xf5, 0x71, 0xe4, 0x6f, 0x94, 0x81, 0xa4, 0x30, 0xca,
+		0xf4, 0xd0, 0x09, 0xe9, 0x54, 0x52, 0x75, 0xcb, 0xac, 0xce, 0xc2, 0x9a, 0x83, 0xab, 0xe9, 0xb8,
+		0xa8, 0x63, 0xe5, 0x2b, 0xb1, 0x64, 0x4c, 0x1e, 0x52, 0x9e, 0x84, 0x89, 0xb6, 0xae, 0xa0, 0x71,
+		0x18, 0xce, 0x17, 0x16, 0x96, 0xb2, 0x6a, 0x76, 0xbd, 0xb8, 0xba, 0x22, 0x1f, 0x40, 0x63, 0xe0,
+		0xeb, 0x9d, 0x2c, 0x9d, 0x4e, 0x25, 0xdf, 0x48, 0xc8, 0xaf, 0xbc, 0xf2, 0xca, 0x2b, 0x11, 0xe5,
+		0xb7, 0xe3, 0x30, 0xd5, 0xc9, 0x09, 0x76, 0xf4, 0xc7, 0x5e, 0xa7, 0xa3, 0x81, 0x4e, 0x67, 0x61,
+		0xa8, 0xae, 0x6d, 0xe2, 0x7a, 0x3a, 0x46, 0x07, 0xe1, 0x91, 0x81, 0xdc, 0xec, 0xec, 0x12, 0x21,
+		0x51, 0x19, 0x25, 0x7a, 0x1b, 0x57, 0xcd, 0x10, 0xe5, 0x70, 0x7a, 0x30, 0x0e, 0xc4, 0x39, 0x72,
+		0x35, 0x1e, 0x85, 0x14, 0xf9, 0x9f, 0xe9, 0x3d, 0xce, 0xf4, 0x4e, 0x00, 0x54, 0xef, 0x19, 0x48,
+		0x52, 0xbf, 0x57, 0xc5, 0xee, 0x98, 0x88, 0x32, 0xf1, 0x14, 0x55, 0xbc, 0xa5, 0xb5, 0xea, 0x4e,
+		0x79, 0x47, 0xab, 0xb7, 0x30, 0xf5, 0x60, 0x29, 0x75, 0x84, 0x03, 0xaf, 0x11, 0x18, 0x9a, 0x81,
+		0x61, 0xe6, 0x26, 0x75, 0xa3, 0x8a, 0x6f, 0xd2, 0x10, 0x3a, 0xa4, 0x32, 0xcf, 0x59, 0x24, 0x10,
+		0xd2, 0xfc, 0x4b, 0xb6, 0x69, 0x08, 0x5f, 0x43, 0x9b, 0x20, 0x00, 0xda, 0xfc, 0x53, 0xe1, 0xe8,
+		0x7d, 0xbc, 0x73, 0xf7, 0xda, 0x9c, 0xe3, 0x43, 0x30, 0x4e, 0x31, 0x9e, 0xe0, 0x53, 0x59, 0xab,
+		0xa7, 0x27, 0xa8, 0x19, 0x8c, 0x31, 0xf0, 0x2a, 0x87, 0x2a, 0xbf, 0x1a, 0x81, 0x18, 0x8d, 0x14,
+		0xe3, 0x30, 0xbc, 0xfe, 0xc2, 0x5a, 0xa1, 0x9c, 0x5f, 0xdd, 0xc8, 0x2d, 0x15, 0x64, 0x89, 0x0c,
+		0x3d, 0x05, 0x5c, 0x5a, 0x5a, 0xcd, 0xae, 0xcb, 0x11, 0xb7, 0x5c, 0x5c, 0x59, 0x3f, 0xff, 0xa4,
+		0x1c, 0x75, 0x09, 0x36, 0x18, 0x20, 0xe6, 0x47, 0x78, 0x62, 0x4e, 0x1e, 0x42, 0x32, 0x8c, 0x30,
+		0x06, 0xc5, 0xe7, 0x0b, 0xf9, 0xf3, 0x4f, 0xca, 0xf1, 0x20, 0xe4, 0x89, 0x39, 0x39, 0x81, 0x46,
+		0x21, 0x45, 0x21, 0xb9, 0xd5, 0xd5, 0x25, 0x39, 0xe9, 0xf2, 0x2c, 0xad, 0xab, 0xc5, 0x95, 0x45,
+		0x39, 0xe5, 0xf2, 0x5c, 0x54, 0x57, 0x37, 0xd6, 0x64, 0x70, 0x39, 0x2c, 0x17, 0x4a, 0xa5, 0xec,
+		0x62, 0x41, 0x1e, 0x76, 0x31, 0x72, 0x2f, 0xac, 0x17, 0x4a, 0xf2, 0x48, 0x40, 0xac, 0x27, 0xe6,
+		0xe4, 0x51, 0xb7, 0x89, 0xc2, 0xca, 0xc6, 0xb2, 0x3c, 0x86, 0x26, 0x60, 0x94, 0x35, 0x21, 0x84,
+		0x18, 0x0f, 0x81, 0xce, 0x3f, 0x29, 0xcb, 0x9e, 0x20, 0x8c, 0xcb, 0x44, 0x00, 0x70, 0xfe, 0x49,
+		0x19, 0x29, 0x0b, 0x30, 0x44, 0xcd, 0x10, 0x21, 0x18, 0x5b, 0xca, 0xe6, 0x0a, 0x4b, 0xe5, 0xd5,
+		0x35, 0x32, 0x69, 0xb2, 0x4b, 0xb2, 0xe4, 0xc1, 0xd4, 0xc2, 0x5a, 0x21, 0xbb, 0x5e, 0xc8, 0xcb,
+		0x51, 0x3f, 0xec, 0xd9, 0x8d, 0xa2, 0x5a, 0xc8, 0xcb, 0x11, 0xa5, 0x02, 0x53, 0x9d, 0x22, 0x64,
+		0xc7, 0x29, 0xe4, 0xb3, 0x85, 0x48, 0x17, 0x5b, 0xa0, 0xbc, 0xc2, 0xb6, 0xa0, 0x7c, 0x39, 0x02,
+		0x93, 0x1d, 0xb2, 0x84, 0x8e, 0x8d, 0x3c, 0x03, 0x43, 0xcc, 0x96, 0x99, 0xa7, 0x7e, 0xb8, 0x63,
+		0xba, 0x41, 0x2d, 0xbb, 0x2d, 0x77, 0xa2, 0x74, 0xfe, 0x7c, 0x33, 0xda, 0x25, 0xdf, 0x24, 0x2c,
+		0xda, 0x0c, 0xf6, 0xbb, 0xda, 0xa2, 0x39, 0x4b, 0x78, 0xce, 0x0f, 0x92, 0xf0, 0x50, 0xd8, 0xde,
+		0xa2, 0xfa, 0x50, 0x87, 0xa8, 0xfe, 0x34, 0x4c, 0xb4, 0x31, 0x1a, 0x38, 0xba, 0x7e, 0xbf, 0x04,
+		0xe9, 0x6e, 0xca, 0xe9, 0xe3, 0x12, 0x23, 0x01, 0x97, 0xf8, 0x74, 0x58, 0x83, 0xf7, 0x75, 0x1f,
+		0x84, 0xb6, 0xb1, 0xfe, 0xa4, 0x04, 0x87, 0x3a, 0xaf, 0x2b, 0x3a, 0xca, 0xf0, 0x36, 0x88, 0x37,
+		0xb0, 0xb3, 0x6d, 0x8a, 0x3c, 0xf9, 0x64, 0x87, 0xec, 0x8b, 0x54, 0x87, 0x07, 0x9b, 0x53, 0xf9,
+		0xd3, 0xb7, 0x68, 0xb7, 0xc5, 0x01, 0x93, 0xa6, 0x4d, 0xd2, 0xf7, 0x46, 0xe0, 0x60, 0x47, 0xe6,
+		0x1d, 0x05, 0x3d, 0x0e, 0xa0, 0x1b, 0x56, 0xcb, 0x61, 0xb9, 0x30, 0xf3, 0xc4, 0x29, 0x0a, 0xa1,
+		0xce, 0x8b, 0x78, 0xd9, 0x96, 0xe3, 0xd6, 0xb3, 0x28, 0x09, 0x0c, 0x44, 0x11, 0x2e, 0x78, 0x82,
+		0xc6, 0xa8, 0xa0, 0xd3, 0x5d, 0x7a, 0xda, 0x66, 0x98, 0x8f, 0x83, 0x5c, 0xa9, 0xeb, 0xd8, 0x70,
+		0xca, 0xb6, 0xd3, 0xc4, 0x5a, 0x43, 0x37, 0x6a, 0x2c, 0xda, 0xce, 0x0f, 0x6d, 0x69, 0x75, 0x1b,
+		0xab, 0xe3, 0xac, 0xba, 0x24, 0x6a, 0x09, 0x05, 0x35, 0xa0, 0xa6, 0x8f, 0x22, 0x1e, 0xa0, 0x60,
+		0xd5, 0x2e, 0x85, 0xf2, 0x73, 0x29, 0x18, 0xf6, 0xad, 0xc2, 0xd0, 0x7d, 0x30, 0xf2, 0x92, 0xb6,
+		0xa3, 0x95, 0xc5, 0xca, 0x9a, 0x69, 0x62, 0x98, 0xc0, 0xd6, 0xf8, 0xea, 0xfa, 0x71, 0x98, 0xa2,
+		0x28, 0x66, 0xcb, 0xc1, 0xcd, 0x72, 0xa5, 0xae, 0xd9, 0x36, 0x55, 0x5a, 0x92, 0xa2, 0x22, 0x52,
+		0xb7, 0x4a, 0xaa, 0x16, 0x44, 0x0d, 0x3a, 0x07, 0x93, 0x94, 0xa2, 0xd1, 0xaa, 0x3b, 0xba, 0x55,
+		0xc7, 0x74, 0xcf, 0xc0, 0xa6, 0x21, 0xc7, 0x95, 0x6c, 0x82, 0x60, 0x2c, 0x73, 0x04, 0x22, 0x91,
+		0x8d, 0xf2, 0x70, 0x9c, 0x92, 0xd5, 0xb0, 0x81, 0x9b, 0x9a, 0x83, 0xcb, 0xf8, 0x1d, 0x2d, 0xad,
+		0x6e, 0x97, 0x35, 0xa3, 0x5a, 0xde, 0xd6, 0xec, 0xed, 0xf4, 0x14, 0x61, 0x90, 0x8b, 0xa4, 0x25,
+		0xf5, 0x08, 0x41, 0x5c, 0xe4, 0x78, 0x05, 0x8a, 0x96, 0x35, 0xaa, 0x97, 0x35, 0x7b, 0x1b, 0xcd,
+		0xc3, 0x21, 0xca, 0xc5, 0x76, 0x9a, 0xba, 0x51, 0x2b, 0x57, 0xb6, 0x71, 0xe5, 0x7a, 0xb9, 0xe5,
+		0x6c, 0x5d, 0x48, 0x1f, 0xf5, 0xb7, 0x4f, 0x25, 0x2c, 0x51, 0x9c, 0x05, 0x82, 0xb2, 0xe1, 0x6c,
+		0x5d, 0x40, 0x25, 0x18, 0x21, 0x83, 0xd1, 0xd0, 0x5f, 0xc6, 0xe5, 0x2d, 0xb3, 0x49, 0x63, 0xe8,
+		0x58, 0x07, 0xd7, 0xe4, 0xd3, 0xe0, 0xec, 0x2a, 0x27, 0x58, 0x36, 0xab, 0x78, 0x7e, 0xa8, 0xb4,
+		0x56, 0x28, 0xe4, 0xd5, 0x61, 0xc1, 0xe5, 0x92, 0xd9, 0x24, 0x06, 0x55, 0x33, 0x5d, 0x05, 0x0f,
+		0x33, 0x83, 0xaa, 0x99, 0x42, 0xbd, 0xe7, 0x60, 0xb2, 0x52, 0x61, 0x7d, 0xd6, 0x2b, 0x65, 0xbe,
+		0x22, 0xb7, 0xd3, 0x72, 0x40, 0x59, 0x95, 0xca, 0x22, 0x43, 0xe0, 0x36, 0x6e, 0xa3, 0x8b, 0x70,
+		0xd0, 0x53, 0x96, 0x9f, 0x70, 0xa2, 0xad, 0x97, 0x61, 0xd2, 0x73, 0x30, 0x69, 0xed, 0xb6, 0x13,
+		0xa2, 0x40, 0x8b, 0xd6, 0x6e, 0x98, 0xec, 0x41, 0xba, 0xcb, 0xd2, 0xc4, 0x15, 0x9a, 0xea, 0x1d,
+		0xf6, 0x63, 0xfb, 0x2a, 0xd0, 0x2c, 0xc8, 0x95, 0x4a, 0x19, 0x1b, 0xda, 0x66, 0x1d, 0x97, 0xb5,
+		0x26, 0x36, 0x34, 0x3b, 0x3d, 0x43, 0x91, 0x63, 0x4e, 0xb3, 0x85, 0xd5, 0xb1, 0x4a, 0xa5, 0x40,
+		0x2b, 0xb3, 0xb4, 0x0e, 0x9d, 0x86, 0x09, 0x73, 0xf3, 0xa5, 0x0a, 0x33, 0xac, 0xb2, 0xd5, 0xc4,
+		0x5b, 0xfa, 0xcd, 0xf4, 0x03, 0x54, 0x4b, 0xe3, 0xa4, 0x82, 0x9a, 0xd5, 0x1a, 0x05, 0xa3, 0x87,
+		0x41, 0xae, 0xd8, 0xdb, 0x5a, 0xd3, 0xa2, 0x9e, 0xd5, 0xb6, 0xb4, 0x0a, 0x4e, 0x3f, 0xc8, 0x50,
+		0x19, 0x7c, 0x45, 0x80, 0x89, 0x61, 0xdb, 0x37, 0xf4, 0x2d, 0x47, 0x70, 0x7c, 0x88, 0x19, 0x36,
+		0x85, 0x71, 0x6e, 0xa7, 0x40, 0xb6, 0xb6, 0xad, 0x60, 0xc3, 0xa7, 0x28, 0xda, 0x98, 0xb5, 0x6d,
+		0xf9, 0xdb, 0xbd, 0x1f, 0x46, 0x09, 0xa6, 0xd7, 0xe8, 0xc3, 0x2c, 0xff, 0xb2, 0xb6, 0x7d, 0x2d,
+		0x3e, 0x09, 0x87, 0x08, 0x52, 0x03, 0x3b, 0x5a, 0x55, 0x73, 0x34, 0x1f, 0xf6, 0xa3, 0x14, 0x7b,
+		0xca, 0xda, 0xb6, 0x96, 0x79, 0x65, 0x40, 0xce, 0x66, 0x6b, 0x73, 0xd7, 0xb5, 0x8f, 0xc7, 0x98,
+		0x9c, 0x04, 0x26, 0x2c, 0x64, 0xdf, 0xcb, 0x8f, 0x7b, 0xb6, 0xd8, 0x52, 0xe6, 0x61, 0xc4, 0x6f,
+		0xf7, 0x28, 0x05, 0xcc, 0xf2, 0x65, 0x89, 0x24, 0x41, 0x0b, 0xab, 0x79, 0x92, 0xbe, 0xbc, 0x58,
+		0x90, 0x23, 0x24, 0x8d, 0x5a, 0x2a, 0xae, 0x17, 0xca, 0xea, 0xc6, 0xca, 0x7a, 0x71, 0xb9, 0x20,
+		0x47, 0x7d, 0x89, 0xfd, 0x95, 0x58, 0xf2, 0xb4, 0xfc, 0xc8, 0x95, 0x58, 0xf2, 0xa4, 0xfc, 0x10,
+		0x55, 0x4f, 0x9b, 0x51, 0x2a, 0xdf, 0x88, 0xc2, 0x58, 0x70, 0x59, 0x8e, 0xde, 0x02, 0x87, 0xc5,
+		0xbe, 0x9b, 0x8d, 0x9d, 0xf2, 0x0d, 0xbd, 0x49, 0x27, 0x6b, 0x43, 0x63, 0x81, 0xd3, 0x35, 0xca,
+		0x29, 0x8e, 0x55, 0xc2, 0xce, 0x73, 0x7a, 0x93, 0x4c, 0xc5, 0x86, 0xe6, 0xa0, 0x25, 0x98, 0x31,
+		0xcc, 0xb2, 0xed, 0x68, 0x46, 0x55, 0x6b, 0x56, 0xfd, 0x1b, 0x99, 0x5a, 0xa5, 0x82, 0x6d, 0xdb,
+		0x64, 0x41, 0xd2, 0xe5, 0x72, 0xcc, 0x30, 0x4b, 0x1c, 0xd9, 0x8b, 0x1e, 0x59, 0x8e, 0x1a, 0x9a,
+		0x13, 0xd1, 0x6e, 0x73, 0xe2, 0x28, 0xa4, 0x1a, 0x9a, 0x55, 0xc6, 0x86, 0xd3, 0xdc, 0xa5, 0xb9,
+		0x7b, 0x52, 0x4d, 0x36, 0x34, 0xab, 0x40, 0xca, 0xe8, 0x1a, 0x9c, 0xf4, 0x50, 0xcb, 0x75, 0x5c,
+		0xd3, 0x2a, 0xbb, 0x65, 0x9a, 0xa8, 0xd3, 0x3d, 0xa2, 0x72, 0xc5, 0x34, 0xb6, 0xea, 0x7a, 0xc5,
+		0xb1, 0xa9, 0xef, 0x60, 0xfe, 0x4f, 0xf1, 0x28, 0x96, 0x28, 0xc1, 0x15, 0xdb, 0x34, 0x68, 0x7e,
+		0xbe, 0x20, 0xb0, 0x03, 0x66, 0x33, 0xf2, 0xa6, 0x30, 0x9b, 0xe0, 0xd0, 0xc7, 0xe4, 0xa1, 0x2b,
+		0xb1, 0xe4, 0x90, 0x1c, 0xbf, 0x12, 0x4b, 0xc6, 0xe5, 0xc4, 0x95, 0x58, 0x32, 0x29, 0xa7, 0xae,
+		0xc4, 0x92, 0x29, 0x19, 0x94, 0x5b, 0xa3, 0x30, 0xe2, 0x5f, 0x6e, 0x90, 0xd5, 0x5b, 0x85, 0x06,
+		0x5c, 0x89, 0xba, 0xe4, 0xfb, 0x7b, 0x2e, 0x4e, 0x66, 0x17, 0x48, 0x24, 0x9e, 0x8f, 0xb3, 0xdc,
+		0x5e, 0x65, 0x94, 0x24, 0x0b, 0x22, 0x93, 0x0c, 0xb3, 0x5c, 0x2a, 0xa9, 0xf2, 0x12, 0x5a, 0x84,
+		0xf8, 0x4b, 0x36, 0xe5, 0x1d, 0xa7, 0xbc, 0x1f, 0xe8, 0xcd, 0xfb, 0x4a, 0x89, 0x32, 0x4f, 0x5d,
+		0x29, 0x95, 0x57, 0x56, 0xd5, 0xe5, 0xec, 0x92, 0xca, 0xc9, 0xd1, 0x11, 0x88, 0xd5, 0xb5, 0x97,
+		0x77, 0x83, 0x31, 0x9b, 0x82, 0xd0, 0x2c, 0x8c, 0xb7, 0x0c, 0xb6, 0x56, 0x27, 0x63, 0x4c, 0xb0,
+		0xc6, 0xfd, 0x58, 0x63, 0x5e, 0xed, 0x12, 0xc1, 0x1f, 0xd0, 0xae, 0x8e, 0x40, 0xec, 0x06, 0xd6,
+		0xae, 0x07, 0x23, 0x2b, 0x05, 0xa1, 0x53, 0x30, 0x52, 0xc5, 0x9b, 0xad, 0x5a, 0xb9, 0x89, 0xab,
+		0x5a, 0xc5, 0x09, 0xc6, 0x93, 0x61, 0x5a, 0xa5, 0xd2, 0x1a, 0x74, 0x15, 0x52, 0x64, 0x8c, 0x0c,
+		0x3a, 0xc6, 0x13, 0x54, 0x05, 0x8f, 0xf5, 0x56, 0x01, 0x1f, 0x62, 0x41, 0xa4, 0x7a, 0xf4, 0xe8,
+		0x32, 0x24, 0x1c, 0xad, 0x59, 0xc3, 0x8e, 0x9d, 0x9e, 0x3c, 0x11, 0x3d, 0x35, 0xd6, 0x61, 0x8f,
+		0xac, 0x03, 0xab, 0x75, 0x4a, 0x42, 0x57, 0xca, 0x82, 0x1c, 0x3d, 0x07, 0x32, 0xdf, 0x8a, 0x2d,
+		0xf3, 0x65, 0xae, 0x9d, 0x9e, 0xa2, 0x06, 0xf8, 0x68, 0x6f, 0x96, 0x7c, 0x27, 0x37, 0xcf, 0x88,
+		0xd4, 0x71, 0x1c, 0x28, 0x07, 0xe7, 0xc5, 0xc1, 0xbd, 0xcc, 0x8b, 0x0d, 0x18, 0xe7, 0x7f, 0x97,
+		0xed, 0x96, 0x65, 0x99, 0x4d, 0x27, 0x7d, 0x88, 0xd2, 0xf7, 0x11, 0x48, 0x30, 0x63, 0x34, 0xea,
+		0xd8, 0x56, 0xa0, 0x7c, 0xef, 0xa6, 0x5b, 0xe6, 0x45, 0x18, 0x0b, 0x2a, 0xc3, 0xbf, 0x11, 0x1e,
+		0x1d, 0x70, 0x23, 0x9c, 0x2c, 0x4b, 0xc4, 0x42, 0x8d, 0x84, 0x26, 0x56, 0xc8, 0xfc, 0x70, 0x04,
+		0xc6, 0x82, 0x1d, 0x43, 0x8b, 0x80, 0xc4, 0x88, 0xe9, 0x86, 0xd3, 0x34, 0xab, 0xad, 0x0a, 0xae,
+		0xf2, 0x09, 0xdb, 0xbd, 0x9d, 0x09, 0x4e, 0x53, 0x74, 0x49, 0xfc, 0x8c, 0x7c, 0xb3, 0x20, 0x32,
+		0x20, 0xa3, 0xbc, 0x37, 0x3f, 0xce, 0xc0, 0xa4, 0x60, 0x40, 0x98, 0xdd, 0xd0, 0x9a, 0x06, 0x49,
+		0x91, 0x59, 0xd2, 0x8e, 0x7c, 0x55, 0xcf, 0xb1, 0x1a, 0x94, 0x05, 0x61, 0x2e, 0xe5, 0x26, 0x6e,
+		0x98, 0x3b, 0xb8, 0xca, 0xb7, 0x8b, 0xba, 0x37, 0x3b, 0xc6, 0x09, 0x54, 0x86, 0xaf, 0x9c, 0x81,
+		0x21, 0xea, 0x7e, 0x10, 0x00, 0x77, 0x40, 0xf2, 0x01, 0x94, 0x84, 0xd8, 0xc2, 0xaa, 0x4a, 0xc2,
+		0xa3, 0x0c, 0x23, 0x0c, 0x5a, 0x5e, 0x2b, 0x16, 0x16, 0x0a, 0x72, 0x44, 0x39, 0x07, 0x71, 0xe6,
+		0x53, 0x48, 0xe8, 0x74, 0xbd, 0x8a, 0x7c, 0x80, 0x17, 0x39, 0x0f, 0x49, 0xd4, 0x6e, 0x2c, 0xe7,
+		0x0a, 0xaa, 0x1c, 0x51, 0x36, 0x60, 0x3c, 0x34, 0x0f, 0xd1, 0x41, 0x98, 0x50, 0x0b, 0xeb, 0x85,
+		0x95, 0xf5, 0xe2, 0xea, 0x4a, 0x79, 0x63, 0xe5, 0xea, 0xca, 0xea, 0x73, 0x2b, 0xf2, 0x81, 0x20,
+		0x58, 0xc4, 0x61, 0x09, 0x4d, 0x81, 0xec, 0x81, 0x4b, 0xab, 0x1b, 0x2a, 0x95, 0xe6, 0x1f, 0x46,
+		0x40, 0x0e, 0x4f, 0x4a, 0x74, 0x18, 0x26, 0xd7, 0xb3, 0xea, 0x62, 0x61, 0xbd, 0xcc, 0x36, 0x3c,
+		0x5c, 0xd6, 0x53, 0x20, 0xfb, 0x2b, 0x2e, 0x15, 0xe9, 0x7e, 0xce, 0x0c, 0x1c, 0xf5, 0x43, 0x0b,
+		0xcf, 0xaf, 0x17, 0x56, 0x4a, 0xb4, 0xf1, 0xec, 0xca, 0x22, 0x49, 0x0a, 0x42, 0xfc, 0xc4, 0x16,
+		0x4b, 0x94, 0x88, 0x1a, 0xe4, 0x57, 0x58, 0xca, 0xcb, 0xb1, 0x30, 0x78, 0x75, 0xa5, 0xb0, 0x7a,
+		0x49, 0x1e, 0x0a, 0xb7, 0x4e, 0xb7, 0x5d, 0xe2, 0x28, 0x03, 0x87, 0xc2, 0xd0, 0x72, 0x61, 0x65,
+		0x5d, 0x7d, 0x41, 0x4e, 0x84, 0x1b, 0x2e, 0x15, 0xd4, 0x6b, 0xc5, 0x85, 0x82, 0x9c, 0x44, 0x87,
+		0x00, 0x05, 0x25, 0x5a, 0xbf, 0xbc, 0x9a, 0x97, 0x53, 0x9d, 0x22, 0x16, 0x92, 0x27, 0x95, 0x4f,
+		0x4b, 0x30, 0xe2, 0xdf, 0x02, 0x09, 0x38, 0x15, 0xe9, 0xcd, 0x16, 0x6c, 0x95, 0x2f, 0x44, 0x60,
+		0xd8, 0xb7, 0x17, 0x42, 0x16, 0xb1, 0x5a, 0xbd, 0x6e, 0xde, 0x28, 0x6b, 0x75, 0x5d, 0xb3, 0x79,
+		0x3c, 0x04, 0x0a, 0xca, 0x12, 0xc8, 0xa0, 0xf1, 0x67, 0xf0, 0xd4, 0x25, 0xbe, 0xef, 0xd4, 0x25,
+		0xf1, 0x26, 0x4c, 0x5d, 0x86, 0xe4, 0xb8, 0xf2, 0x27, 0x11, 0x90, 0xc3, 0xbb, 0x23, 0x21, 0xbd,
+		0x49, 0xdd, 0xf4, 0xe6, 0xef, 0x5f, 0x64, 0x2f, 0xfd, 0x0b, 0x47, 0xf5, 0x68, 0xd7, 0xa8, 0xde,
+		0x21, 0x58, 0xc5, 0xde, 0xcc, 0xc1, 0xca, 0x6f, 0xae, 0x7f, 0x24, 0xc1, 0x58, 0x70, 0x33, 0x27,
+		0xa0, 0x31, 0x65, 0x2f, 0x1a, 0x0b, 0x8e, 0xc8, 0x7d, 0xdd, 0x46, 0xe4, 0x6f, 0xa4, 0x5f, 0x1f,
+		0x8e, 0xc2, 0x68, 0x60, 0xef, 0x67, 0x50, 0xe9, 0xde, 0x01, 0x13, 0x7a, 0x15, 0x37, 0x2c, 0xd3,
+		0xc1, 0x46, 0x65, 0xb7, 0x5c, 0xc7, 0x3b, 0xb8, 0x4e, 0xd5, 0x30, 0xd6, 0xe1, 0x74, 0x35, 0xd0,
+		0xc2, 0x6c, 0xd1, 0xa3, 0x5b, 0x22, 0x64, 0xf3, 0x93, 0xc5, 0x7c, 0x61, 0x79, 0x6d, 0x75, 0xbd,
+		0xb0, 0xb2, 0xf0, 0x82, 0xf0, 0xe4, 0xaa, 0xac, 0x87, 0xd0, 0x02, 0x0a, 0xbf, 0xff, 0xcd, 0xb1,
+		0xe8, 0x5c, 0x03, 0x39, 0xdc, 0x1b, 0xe2, 0xd0, 0x3b, 0xf4, 0x47, 0x3e, 0x80, 0x26, 0x61, 0x7c,
+		0x65, 0xb5, 0x5c, 0x2a, 0xe6, 0x0b, 0xe5, 0xc2, 0xa5, 0x4b, 0x85, 0x85, 0xf5, 0x12, 0x3b, 0x68,
+		0x70, 0xb1, 0xd7, 0xe5, 0x88, 0x7f, 0x6c, 0x3e, 0x12, 0x85, 0xc9, 0x0e, 0x92, 0xa0, 0x2c, 0xdf,
+		0x22, 0x64, 0xbb, 0x96, 0x8f, 0x0d, 0x22, 0xfd, 0x2c, 0x59, 0xdd, 0xaf, 0x69, 0x4d, 0x87, 0xef,
+		0x28, 0x3e, 0x0c, 0x44, 0xbd, 0x86, 0x43, 0xd2, 0xfb, 0x26, 0x3f, 0xc0, 0x61, 0x29, 0xc8, 0xb8,
+		0x07, 0x67, 0x67, 0x38, 0x8f, 0x02, 0xb2, 0x4c, 0x5b, 0x77, 0xf4, 0x1d, 0x4c, 0x72, 0x28, 0x8e,
+		0x4c, 0x26, 0x6e, 0x4c, 0x95, 0x45, 0x4d, 0xd1, 0x70, 0x5c, 0x6c, 0x03, 0xd7, 0xb4, 0x10, 0x36,
+		0x59, 0x7e, 0x44, 0x55, 0x59, 0xd4, 0xb8, 0xd8, 0xf7, 0xc1, 0x48, 0xd5, 0x6c, 0x6d, 0xd6, 0x31,
+		0xc7, 0x23, 0x2e, 0x59, 0x52, 0x87, 0x19, 0xcc, 0x45, 0xe1, 0xdb, 0x66, 0xde, 0x31, 0xd3, 0x88,
+		0x3a, 0xcc, 0x60, 0x0c, 0xe5, 0x21, 0x18, 0xd7, 0x6a, 0xb5, 0x26, 0x61, 0x2e, 0x18, 0xb1, 0x8d,
+		0xc0, 0x31, 0x17, 0x4c, 0x11, 0x33, 0x57, 0x20, 0x29, 0xf4, 0x40, 0xd6, 0xbf, 0x44, 0x13, 0x65,
+		0x8b, 0xed, 0x6e, 0x47, 0x4e, 0xa5, 0xd4, 0xa4, 0x21, 0x2a, 0xef, 0x83, 0x11, 0xdd, 0xf6, 0xae,
+		0x10, 0xa5, 0x23, 0x27, 0x22, 0xa7, 0x92, 0xea, 0xb0, 0x6e, 0x7b, 0xd7, 0x84, 0x7e, 0x6e, 0x18,
+		0xc0, 0x33, 0x36, 0xf4, 0x41, 0x09, 0xc6, 0x58, 0x80, 0xb1, 0x9a, 0xd8, 0xc6, 0x46, 0x45, 0x2c,
+		0x0b, 0x1f, 0xee, 0x61, 0xa2, 0xcc, 0xcd, 0xad, 0x71, 0x82, 0xdc, 0x33, 0xaf, 0x4a, 0xd2, 0xeb,
+		0x52, 0xec, 0x75, 0x49, 0xfa, 0x09, 0x69, 0x14, 0x25, 0x0b, 0xcf, 0xaf, 0x2d, 0x15, 0x17, 0x8a,
+		0xeb, 0xe9, 0x77, 0x27, 0x68, 0xb9, 0xb8, 0xcc, 0xcb, 0x5f, 0x49, 0x04, 0xeb, 0xdf, 0x48, 0xfc,
+		0xb2, 0x14, 0x4d, 0xbe, 0x91, 0x50, 0x47, 0xb7, 0xfc, 0xfc, 0x50, 0xdd, 0x7f, 0x83, 0x22, 0xd2,
+		0x6d, 0x21, 0xe9, 0x49, 0x53, 0xe0, 0xf7, 0x26, 0x72, 0x0f, 0x53, 0x41, 0xe2, 0x54, 0x90, 0x61,
+		0x14, 0x5f, 0x58, 0x5a, 0x2d, 0x15, 0xf2, 0x54, 0x8c, 0x14, 0x8a, 0xad, 0xae, 0x15, 0x56, 0xd2,
+		0x5f, 0x11, 0x4d, 0x7a, 0x97, 0x2d, 0x5e, 0x97, 0xe0, 0xb0, 0x38, 0x65, 0xe5, 0xb1, 0x16, 0x1b,
+		0x15, 0xb3, 0x2a, 0xb2, 0xdb, 0xb1, 0xb9, 0xb3, 0xbd, 0x1a, 0x57, 0x39, 0x29, 0x55, 0x49, 0x81,
+		0x13, 0xe6, 0x1e, 0x6b, 0x53, 0x49, 0x76, 0x25, 0xcf, 0x65, 0x19, 0x46, 0xf1, 0xb5, 0xec, 0xc2,
+		0xd5, 0x42, 0xde, 0x93, 0xe6, 0x60, 0xb3, 0x13, 0x17, 0xf4, 0xbd, 0x30, 0xde, 0x72, 0xb6, 0x2e,
+		0x10, 0xdb, 0xd0, 0xab, 0xec, 0xd8, 0x3b, 0xd6, 0xed, 0xbc, 0xd4, 0x93, 0x68, 0xc3, 0xd9, 0xba,
+		0x70, 0xcd, 0xa5, 0xe0, 0x4a, 0x61, 0xa2, 0xa4, 0x50, 0x6c, 0x65, 0x75, 0xa5, 0x20, 0xc4, 0xa0,
+		0x47, 0xc4, 0x2f, 0x78, 0x62, 0x8c, 0xb5, 0x02, 0xa4, 0xe8, 0x7b, 0x41, 0x16, 0xdb, 0x43, 0xae,
+		0x4a, 0x86, 0xba, 0x1d, 0xf9, 0x7a, 0x02, 0xf0, 0x4d, 0x26, 0x57, 0x19, 0x27, 0x7d, 0x12, 0x4c,
+		0xa1, 0xf1, 0xa5, 0xc2, 0xca, 0xe2, 0xfa, 0xe5, 0xf2, 0x9a, 0x5a, 0xa0, 0x27, 0x77, 0xe9, 0x77,
+		0x8b, 0xe6, 0xc7, 0x1b, 0x41, 0x42, 0xf4, 0x2e, 0x09, 0x86, 0x59, 0x0a, 0xc4, 0xf6, 0xa4, 0xd8,
+		0xa6, 0xc2, 0xc9, 0x5e, 0x6d, 0xd3, 0x0c, 0x88, 0x62, 0xe7, 0x2e, 0xd2, 0x66, 0xa3, 0xc2, 0x20,
+		0x0e, 0x23, 0xb4, 0x54, 0x58, 0xcc, 0x2e, 0xbc, 0x50, 0xce, 0x15, 0x4a, 0xeb, 0xc4, 0x93, 0xad,
+		0xaa, 0xcc, 0x46, 0x01, 0x0d, 0x65, 0x97, 0x96, 0x56, 0x9f, 0xf3, 0x14, 0x01, 0x2f, 0xb9, 0x6c,
+		0x94, 0xb7, 0xc3, 0x68, 0xc0, 0xdc, 0x49, 0x52, 0x4c, 0x93, 0x69, 0xd2, 0x83, 0x52, 0x61, 0x65,
+		0xc1, 0x9f, 0xc4, 0x8f, 0x80, 0x6b, 0xde, 0xb2, 0x44, 0x4a, 0xc2, 0xf8, 0xe5, 0x08, 0x71, 0xa3,
+		0x5c, 0x00, 0xf7, 0x2c, 0x31, 0xaa, 0x3c, 0x05, 0x49, 0x61, 0xbe, 0x24, 0x35, 0xa7, 0x19, 0x76,
+		0x68, 0x61, 0x90, 0x04, 0x6a, 0xbb, 0xb2, 0x44, 0x96, 0x41, 0xcc, 0xa6, 0xe5, 0x88, 0x72, 0x0d,
+		0x0e, 0x76, 0x34, 0x3d, 0x74, 0x3f, 0xcc, 0x88, 0xf3, 0x4b, 0x96, 0xf4, 0x97, 0x0b, 0x2b, 0x0b,
+		0xab, 0x79, 0xb2, 0x4c, 0xf2, 0x78, 0x02, 0x70, 0x1b, 0x64, 0x52, 0x0a, 0xfb, 0x94, 0x23, 0x4a,
+		0x11, 0xc6, 0x82, 0x06, 0x84, 0x8e, 0xc2, 0xe1, 0x8d, 0xf5, 0x4b, 0x17, 0xca, 0xd7, 0xb2, 0x4b,
+		0xc5, 0x7c, 0x36, 0xb4, 0x20, 0x02, 0xe0, 0x56, 0x24, 0x47, 0x88, 0xa0, 0xc4, 0xba, 0xe4, 0xa8,
+		0x12, 0x4b, 0x4a, 0xb2, 0xa4, 0x94, 0x60, 0x3c, 0x64, 0x0a, 0xe8, 0x18, 0xa4, 0xf9, 0x0a, 0xa5,
+		0x93, 0x54, 0x54, 0x43, 0x01, 0xe3, 0x60, 0x6b, 0xb5, 0x7c, 0x61, 0xa9, 0xb8, 0x5c, 0x5c, 0xa7,
+		0xf2, 0x5d, 0x06, 0xf0, 0xc6, 0x98, 0xc4, 0xac, 0x2b, 0xa5, 0xd5, 0x95, 0xf2, 0x25, 0xb2, 0xd0,
+		0x5b, 0xf7, 0xb1, 0x4a, 0x01, 0x1b, 0x53, 0x59, 0x22, 0xeb, 0x91, 0xf6, 0x81, 0x97, 0x23, 0xa7,
+		0xdf, 0x27, 0x91, 0x90, 0xf5, 0xbe, 0x95, 0xcc, 0xbb, 0x24, 0x74, 0x3c, 0xf9, 0x46, 0x02, 0x25,
+		0x66, 0xad, 0xcd, 0xd9, 0x8a, 0x65, 0x65, 0xc6, 0xc9, 0x1f, 0x0b, 0x96, 0x75, 0x49, 0x04, 0xe2,
+		0x99, 0xe4, 0x57, 0x13, 0x28, 0x49, 0xa0, 0x2f, 0x69, 0x3b, 0x5a, 0x46, 0x26, 0x7f, 0x5d, 0xd1,
+		0x76, 0x34, 0x17, 0xe1, 0x68, 0xf2, 0x6b, 0x09, 0x14, 0x27, 0xe0, 0x9a, 0x99, 0x19, 0x23, 0xff,
+		0x2f, 0x9a, 0x6e, 0xe5, 0xfd, 0xc9, 0xf7, 0xac, 0x20, 0x20, 0x40, 0x6a, 0xb1, 0x67, 0x33, 0x88,
+		0xfc, 0x4d, 0x8f, 0xcd, 0xce, 0x0a, 0xa4, 0xd3, 0xf1, 0xe4, 0xfb, 0x56, 0xe4, 0x0f, 0xac, 0x9c,
+		0x8e, 0x27, 0x3f, 0xb0, 0x22, 0x7f, 0x70, 0xe5, 0x4a, 0x3c, 0xf9, 0x95, 0x84, 0xfc, 0x46, 0x42,
+		0xf9, 0xcb, 0x28, 0x20, 0xcf, 0xbe, 0xdd, 0x9d, 0x97, 0xe7, 0x21, 0xe9, 0x6e, 0xe5, 0xb0, 0x8b,
+		0xaa, 0x6f, 0xe9, 0x31, 0x2d, 0x04, 0x99, 0x0f, 0x14, 0xda, 0xda, 0x71, 0xb9, 0x91, 0x75, 0x7b,
+		0x43, 0x37, 0xf4, 0x46, 0xab, 0x51, 0x16, 0xfb, 0x1b, 0x7d, 0xd7, 0xed, 0x9c, 0x80, 0x97, 0x29,
+		0x0b, 0xed, 0x66, 0x80, 0xc5, 0x50, 0x5f, 0x16, 0x8c, 0x80, 0x97, 0x33, 0x7f, 0x2d, 0x41, 0xba,
+		0x9b, 0xb0, 0xfb, 0xda, 0x7a, 0x59, 0x81, 0x29, 0x73, 0x07, 0x37, 0x9b, 0x7a, 0x95, 0x9e, 0xa6,
+		0xb8, 0x09, 0x59, 0xac, 0x7f, 0x42, 0x36, 0xe9, 0x23, 0x74, 0x07, 0x35, 0x47, 0xe2, 0xe6, 0x4d,
+		0x12, 0x32, 0x04, 0xa7, 0xa1, 0xfe, 0x9c, 0x46, 0x29, 0x89, 0xe0, 0x71, 0x85, 0x4c, 0x13, 0xb2,
+		0x06, 0x8a, 0xc8, 0x51, 0x2f, 0xeb, 0x53, 0x3e, 0x1a, 0x85, 0xb1, 0xe0, 0xe5, 0x4c, 0x94, 0x87,
+		0x64, 0xdd, 0xe4, 0x17, 0x9f, 0xd8, 0x68, 0x9f, 0xea, 0x73, 0x9f, 0x73, 0x76, 0x89, 0xe3, 0xab,
+		0x2e, 0x65, 0xe6, 0x0f, 0x25, 0x48, 0x0a, 0x30, 0x3a, 0x04, 0x31, 0x4b, 0x73, 0xb6, 0x29, 0xbb,
+		0xa1, 0x5c, 0x44, 0x96, 0x54, 0x5a, 0x26, 0x70, 0xdb, 0xd2, 0xd8, 0xa5, 0x2f, 0x0e, 0x27, 0x65,
+		0x92, 0x79, 0xd5, 0xb1, 0x56, 0xa5, 0xe7, 0x80, 0x66, 0xa3, 0x81, 0x0d, 0xc7, 0x16, 0x99, 0x17,
+		0x87, 0x2f, 0x70, 0x30, 0x7a, 0x04, 0x26, 0x9c, 0xa6, 0xa6, 0xd7, 0x03, 0xb8, 0x31, 0x8a, 0x2b,
+		0x8b, 0x0a, 0x17, 0x79, 0x1e, 0x8e, 0x08, 0xbe, 0x55, 0xec, 0x68, 0x95, 0x6d, 0x5c, 0xf5, 0x88,
+		0xe2, 0xf4, 0xbc, 0xff, 0x30, 0x47, 0xc8, 0xf3, 0x7a, 0x41, 0x7b, 0xba, 0x15, 0xb8, 0x6f, 0x5d,
+		0x43, 0x98, 0xdf, 0xb7, 0x3e, 0xdb, 0xe5, 0xbe, 0x75, 0xf8, 0x6e, 0xac, 0xef, 0xb2, 0xf5, 0xe9,
+		0x0e, 0x24, 0x41, 0x8d, 0x7a, 0x29, 0xd4, 0xe7, 0x23, 0x30, 0x21, 0x0e, 0x4c, 0xab, 0xee, 0x18,
+		0x2d, 0x03, 0x68, 0x86, 0x61, 0x3a, 0xfe, 0x51, 0x6a, 0xcf, 0x71, 0xdb, 0xe8, 0x66, 0xb3, 0x2e,
+		0x91, 0xea, 0x63, 0x90, 0xf9, 0x0b, 0x09, 0xc0, 0xab, 0xea, 0x3a, 0x5c, 0x33, 0x30, 0xcc, 0x7b,
+		0x45, 0xef, 0xac, 0xb3, 0x7d, 0x45, 0x60, 0xa0, 0x4b, 0x7a, 0x9d, 0xde, 0x84, 0xd8, 0xc4, 0x35,
+		0xdd, 0xe0, 0x57, 0xb8, 0x58, 0x41, 0xdc, 0x84, 0x88, 0x79, 0x57, 0x1c, 0x55, 0x48, 0xda, 0xb8,
+		0xa1, 0x19, 0x8e, 0x5e, 0xe1, 0x93, 0xf5, 0xfc, 0x9e, 0x84, 0x9f, 0x2d, 0x71, 0x6a, 0xd5, 0xe5,
+		0xa3, 0x9c, 0x82, 0xa4, 0x80, 0xba, 0xc1, 0xe1, 0x00, 0x4a, 0x40, 0xb4, 0x54, 0x20, 0xe1, 0x91,
+		0xfa, 0xe8, 0x62, 0xb6, 0x24, 0x47, 0x4e, 0x7f, 0x32, 0x02, 0x09, 0xe1, 0x3d, 0x26, 0x61, 0xbc,
+		0x90, 0x2f, 0x86, 0xe2, 0xcc, 0x24, 0x8c, 0x09, 0x20, 0x73, 0xe6, 0xf2, 0xbb, 0x13, 0x7e, 0xe0,
+		0x9a, 0xba, 0xba, 0xbe, 0x3a, 0x27, 0xff, 0x79, 0x3b, 0xf0, 0x09, 0xf9, 0x2b, 0x09, 0x34, 0x01,
+		0x23, 0x02, 0x38, 0xf7, 0xf8, 0xdc, 0x13, 0xf2, 0x1b, 0x61, 0xd0, 0x93, 0xf2, 0x57, 0xe9, 0x96,
+		0x96, 0x00, 0x9d, 0x2d, 0xaf, 0x93, 0x60, 0xb1, 0xba, 0xb2, 0xf4, 0x82, 0x2c, 0xf9, 0x2b, 0xe6,
+		0x7c, 0x15, 0x11, 0x74, 0x1c, 0x0e, 0x8b, 0x8a, 0x8b, 0x17, 0x2f, 0x5e, 0x7c, 0xca, 0x57, 0x79,
+		0xeb, 0xfd, 0xf1, 0x70, 0xf5, 0x05, 0x5f, 0xf5, 0x47, 0xdb, 0xab, 0x2f, 0xfa, 0xaa, 0x7f, 0xfc,
+		0xfd, 0x71, 0x34, 0x09, 0xc3, 0xa2, 0x7a, 0x39, 0xfb, 0xbc, 0xfc, 0xad, 0x6f, 0x7d, 0xeb, 0x5b,
+		0x89, 0xdc, 0xf7, 0xc2, 0x64, 0xc5, 0x6c, 0x84, 0x87, 0x26, 0x27, 0x87, 0xee, 0x63, 0xd8, 0x97,
+		0xa5, 0x17, 0x1f, 0xe3, 0x48, 0x35, 0xb3, 0xae, 0x19, 0xb5, 0x59, 0xb3, 0x59, 0xf3, 0x1e, 0x47,
+		0x90, 0xdc, 0xda, 0xf6, 0x3d, 0x91, 0xb0, 0x36, 0xff, 0x5a, 0x92, 0x7e, 0x22, 0x12, 0x5d, 0x5c,
+		0xcb, 0x7d, 0x2a, 0x92, 0x59, 0x64, 0x84, 0x6b, 0x62, 0xe0, 0x55, 0xbc, 0x55, 0xc7, 0x15, 0x32,
+		0x3a, 0xf0, 0xb5, 0x47, 0x60, 0xaa, 0x66, 0xd6, 0x4c, 0xca, 0xe9, 0x0c, 0xf9, 0x8b, 0xbf, 0xae,
+		0x48, 0xb9, 0xd0, 0x4c, 0xdf, 0xa7, 0x18, 0xf3, 0x2b, 0x30, 0xc9, 0x91, 0xcb, 0x34, 0xd5, 0x67,
+		0x47, 0xc6, 0xa8, 0xe7, 0xb5, 0xa3, 0xf4, 0x2f, 0xfd, 0x19, 0xdd, 0xa3, 0x51, 0x27, 0x38, 0x29,
+		0xa9, 0x63, 0xa7, 0xca, 0xf3, 0x2a, 0x1c, 0x0c, 0xf0, 0x63, 0xcb, 0x2c, 0xdc, 0xec, 0xc3, 0xf1,
+		0x77, 0x38, 0xc7, 0x49, 0x1f, 0xc7, 0x12, 0x27, 0x9d, 0x5f, 0x80, 0xd1, 0xbd, 0xf0, 0xfa, 0x97,
+		0x9c, 0xd7, 0x08, 0xf6, 0x33, 0x59, 0x84, 0x71, 0xca, 0xa4, 0xd2, 0xb2, 0x1d, 0xb3, 0x41, 0xd7,
+		0xb0, 0xbd, 0xd9, 0xfc, 0xee, 0x9f, 0x31, 0xaf, 0x3a, 0x46, 0xc8, 0x16, 0x5c, 0xaa, 0xf9, 0x79,
+		0xa0, 0x4b, 0x96, 0x2a, 0xae, 0xd4, 0xfb, 0x70, 0xf8, 0x3d, 0x2e, 0x88, 0x8b, 0x3f, 0x7f, 0x0d,
+		0xa6, 0xc8, 0xdf, 0x74, 0x89, 0xe9, 0x97, 0xa4, 0xff, 0x1d, 0xa5, 0xf4, 0x17, 0xbe, 0x9f, 0x39,
+		0xee, 0x49, 0x97, 0x81, 0x4f, 0x26, 0xdf, 0x28, 0xd6, 0xb0, 0xe3, 0xe0, 0xa6, 0x5d, 0xd6, 0xea,
+		0x9d, 0xc4, 0xf3, 0x5d, 0xf2, 0x48, 0x7f, 0xf8, 0xeb, 0xc1, 0x51, 0x5c, 0x64, 0x94, 0xd9, 0x7a,
+		0x7d, 0x7e, 0x03, 0x0e, 0x77, 0xb0, 0x8a, 0x01, 0x78, 0x7e, 0x84, 0xf3, 0x9c, 0x6a, 0xb3, 0x0c,
+		0xc2, 0x76, 0x0d, 0x04, 0xdc, 0x1d, 0xcb, 0x01, 0x78, 0xfe, 0x28, 0xe7, 0x89, 0x38, 0xad, 0x18,
+		0x52, 0xc2, 0xf1, 0x0a, 0x4c, 0xec, 0xe0, 0xe6, 0xa6, 0x69, 0xf3, 0x8b, 0x35, 0x03, 0xb0, 0xfb,
+		0x31, 0xce, 0x6e, 0x9c, 0x13, 0xd2, 0x9b, 0x36, 0x84, 0xd7, 0x45, 0x48, 0x6e, 0x69, 0x15, 0x3c,
+		0x00, 0x8b, 0x5b, 0x9c, 0x45, 0x82, 0xe0, 0x13, 0xd2, 0x2c, 0x8c, 0xd4, 0x4c, 0xbe, 0xcb, 0xd0,
+		0x9f, 0xfc, 0xa3, 0x9c, 0x7c, 0x58, 0xd0, 0x70, 0x16, 0x96, 0x69, 0xb5, 0xea, 0x9a, 0x33, 0x88,
+		0x04, 0x3f, 0x2e, 0x58, 0x08, 0x1a, 0xce, 0x62, 0x0f, 0x6a, 0xfd, 0x98, 0x60, 0x61, 0xfb, 0xf4,
+		0xf9, 0x0c, 0x0c, 0x9b, 0x46, 0x7d, 0xd7, 0x34, 0x06, 0x11, 0xe2, 0xe3, 0x9c, 0x03, 0x70, 0x12,
+		0xc2, 0xe0, 0x69, 0x48, 0x0d, 0x3a, 0x10, 0x3f, 0xf5, 0x75, 0x31, 0x3d, 0xc4, 0x08, 0x2c, 0xc2,
+		0xb8, 0x70, 0x50, 0xba, 0x69, 0x0c, 0xc0, 0xe2, 0x1f, 0x73, 0x16, 0x63, 0x3e, 0x32, 0xde, 0x0d,
+		0x07, 0xdb, 0x4e, 0x0d, 0x0f, 0xc2, 0xe4, 0x93, 0xa2, 0x1b, 0x9c, 0x84, 0xab, 0x72, 0x13, 0x1b,
+		0x95, 0xed, 0xc1, 0x38, 0xfc, 0xb4, 0x50, 0xa5, 0xa0, 0x21, 0x2c, 0x16, 0x60, 0xb4, 0xa1, 0x35,
+		0xed, 0x6d, 0xad, 0x3e, 0xd0, 0x70, 0xfc, 0x0c, 0xe7, 0x31, 0xe2, 0x12, 0x71, 0x8d, 0xb4, 0x8c,
+		0xbd, 0xb0, 0xf9, 0x94, 0xd0, 0x88, 0x8f, 0x8c, 0x4f, 0x3d, 0xdb, 0xa1, 0x09, 0xf7, 0x5e, 0xb8,
+		0xfd, 0xac, 0x98, 0x7a, 0x8c, 0x76, 0xd9, 0xcf, 0xf1, 0x69, 0x48, 0xd9, 0xfa, 0xcb, 0x03, 0xb1,
+		0xf9, 0xb4, 0x18, 0x69, 0x4a, 0x40, 0x88, 0x5f, 0x80, 0x23, 0x1d, 0xc3, 0xc4, 0x00, 0xcc, 0x7e,
+		0x8e, 0x33, 0x3b, 0xd4, 0x21, 0x54, 0x70, 0x97, 0xb0, 0x57, 0x96, 0x3f, 0x2f, 0x5c, 0x02, 0x0e,
+		0xf1, 0x5a, 0x83, 0xa9, 0x96, 0x61, 0x6b, 0x5b, 0x7b, 0xd3, 0xda, 0x2f, 0x08, 0xad, 0x31, 0xda,
+		0x80, 0xd6, 0xd6, 0xe1, 0x10, 0xe7, 0xb8, 0xb7, 0x71, 0xfd, 0x45, 0xe1, 0x58, 0x19, 0xf5, 0x46,
+		0x70, 0x74, 0xbf, 0x13, 0x32, 0xae, 0x3a, 0x45, 0x76, 0x6c, 0x97, 0x1b, 0x9a, 0x35, 0x00, 0xe7,
+		0x5f, 0xe2, 0x9c, 0x85, 0xc7, 0x77, 0xd3, 0x6b, 0x7b, 0x59, 0xb3, 0x08, 0xf3, 0xe7, 0x21, 0x2d,
+		0x98, 0xb7, 0x8c, 0x26, 0xae, 0x98, 0x35, 0x43, 0x7f, 0x19, 0x57, 0x07, 0x60, 0xfd, 0xcb, 0xa1,
+		0xa1, 0xda, 0xf0, 0x91, 0x13, 0xce, 0x45, 0x90, 0xdd, 0x5c, 0xa5, 0xac, 0x37, 0xe8, 0x61, 0x4c,
+		0x6f, 0x8e, 0xbf, 0x22, 0x46, 0xca, 0xa5, 0x2b, 0x52, 0xb2, 0xf9, 0x02, 0xb0, 0xab, 0xf9, 0x83,
+		0x9a, 0xe4, 0x67, 0x38, 0xa3, 0x51, 0x8f, 0x8a, 0x3b, 0x8e, 0x8a, 0xd9, 0xb0, 0xb4, 0xe6, 0x20,
+		0xfe, 0xef, 0x9f, 0x08, 0xc7, 0xc1, 0x49, 0xb8, 0xe3, 0x20, 0x19, 0x1d, 0x89, 0xf6, 0x03, 0x70,
+		0xf8, 0x55, 0xe1, 0x38, 0x04, 0x0d, 0x67, 0x21, 0x12, 0x86, 0x01, 0x58, 0xfc, 0x9a, 0x60, 0x21,
+		0x68, 0x08, 0x8b, 0x67, 0xbd, 0x40, 0xdb, 0xc4, 0x35, 0xdd, 0x76, 0xf8, 0xe3, 0x99, 0xde, 0xac,
+		0x7e, 0xfd, 0xeb, 0xc1, 0x24, 0x4c, 0xf5, 0x91, 0x12, 0x4f, 0xc4, 0x77, 0x05, 0xe9, 0xae, 0x77,
+		0x7f, 0xc1, 0x7e, 0x43, 0x78, 0x22, 0x1f, 0x19, 0x91, 0xcd, 0x97, 0x21, 0x12, 0xb5, 0x57, 0xc8,
+		0x4a, 0x72, 0x00, 0x76, 0xff, 0x34, 0x24, 0x5c, 0x49, 0xd0, 0x12, 0x9e, 0xbe, 0xfc, 0xa7, 0x65,
+		0x5c, 0xc7, 0xbb, 0x03, 0x59, 0xe7, 0x3f, 0x0b, 0xe5, 0x3f, 0x1b, 0x8c, 0x92, 0xf9, 0x90, 0xf1,
+		0x50, 0x3e, 0x85, 0xfa, 0xbd, 0xac, 0x4b, 0x7f, 0xdf, 0x37, 0x78, 0x7f, 0x83, 0xe9, 0xd4, 0xfc,
+		0x12, 0x31, 0xf2, 0x60, 0xd2, 0xd3, 0x9f, 0xd9, 0xf7, 0x7f, 0xc3, 0xb5, 0xf3, 0x40, 0xce, 0x33,
+		0x7f, 0x09, 0x46, 0x03, 0x09, 0x4f, 0x7f, 0x56, 0xef, 0xe6, 0xac, 0x46, 0xfc, 0xf9, 0xce, 0xfc,
+		0x39, 0x88, 0x91, 0xe4, 0xa5, 0x3f, 0xf9, 0xff, 0xcf, 0xc9, 0x29, 0xfa, 0xfc, 0x5b, 0x21, 0x29,
+		0x92, 0x96, 0xfe, 0xa4, 0xef, 0xe1, 0xa4, 0x2e, 0x09, 0x21, 0x17, 0x09, 0x4b, 0x7f, 0xf2, 0x7f,
+		0x20, 0xc8, 0x05, 0x09, 0x21, 0x1f, 0x5c, 0x85, 0xbf, 0xf5, 0xbe, 0x18, 0x0f, 0x3a, 0x42, 0x77,
+		0x4f, 0x43, 0x82, 0x67, 0x2a, 0xfd, 0xa9, 0xdf, 0xcb, 0x1b, 0x17, 0x14, 0xf3, 0x4f, 0xc1, 0xd0,
+		0x80, 0x0a, 0x7f, 0x3f, 0x27, 0x65, 0xf8, 0xf3, 0x0b, 0x30, 0xec, 0xcb, 0x4e, 0xfa, 0x93, 0xff,
+		0x00, 0x27, 0xf7, 0x53, 0x11, 0xd1, 0x79, 0x76, 0xd2, 0x9f, 0xc1, 0x0f, 0x0a, 0xd1, 0x39, 0x05,
+		0x51, 0x9b, 0x48, 0x4c, 0xfa, 0x53, 0x7f, 0x40, 0x68, 0x5d, 0x90, 0xcc, 0x3f, 0x03, 0x29, 0x37,
+		0xd8, 0xf4, 0xa7, 0xff, 0x20, 0xa7, 0xf7, 0x68, 0x88, 0x06, 0x7c, 0xc1, 0xae, 0x3f, 0x8b, 0x7f,
+		0x24, 0x34, 0xe0, 0xa3, 0x22, 0xd3, 0x28, 0x9c, 0xc0, 0xf4, 0xe7, 0xf4, 0x21, 0x31, 0x8d, 0x42,
+		0xf9, 0x0b, 0x19, 0x4d, 0xea, 0xf3, 0xfb, 0xb3, 0xf8, 0x21, 0x31, 0x9a, 0x14, 0x9f, 0x88, 0x11,
+		0xce, 0x08, 0xfa, 0xf3, 0xf8, 0x11, 0x21, 0x46, 0x28, 0x21, 0x98, 0x5f, 0x03, 0xd4, 0x9e, 0x0d,
+		0xf4, 0xe7, 0xf7, 0x3a, 0xe7, 0x37, 0xd1, 0x96, 0x0c, 0xcc, 0x3f, 0x07, 0x87, 0x3a, 0x67, 0x02,
+		0xfd, 0xb9, 0x7e, 0xf8, 0x1b, 0xa1, 0xb5, 0x9b, 0x3f, 0x11, 0x98, 0x5f, 0xf7, 0x42, 0x8a, 0x3f,
+		0x0b, 0xe8, 0xcf, 0xf6, 0x23, 0xdf, 0x08, 0x3a, 0x6e, 0x7f, 0x12, 0x30, 0x9f, 0x05, 0xf0, 0x02,
+		0x70, 0x7f, 0x5e, 0x3f, 0xc6, 0x79, 0xf9, 0x88, 0xc8, 0xd4, 0xe0, 0xf1, 0xb7, 0x3f, 0xfd, 0x2d,
+		0x31, 0x35, 0x38, 0x05, 0x99, 0x1a, 0x22, 0xf4, 0xf6, 0xa7, 0xfe, 0xa8, 0x98, 0x1a, 0x82, 0x84,
+		0x58, 0xb6, 0x2f, 0xba, 0xf5, 0xe7, 0xf0, 0x71, 0x61, 0xd9, 0x3e, 0xaa, 0xf9, 0x15, 0x98, 0x68,
+		0x0b, 0x88, 0xfd, 0x59, 0xfd, 0x04, 0x67, 0x25, 0x87, 0xe3, 0xa1, 0x3f, 0x78, 0xf1, 0x60, 0xd8,
+		0x9f, 0xdb, 0x27, 0x42, 0xc1, 0x8b, 0xc7, 0xc2, 0xf9, 0xa7, 0x21, 0x69, 0xb4, 0xea, 0x75, 0x32,
+		0x79, 0x50, 0xef, 0xc7, 0x93, 0xe9, 0xaf, 0x7e, 0x93, 0x6b, 0x47, 0x10, 0xcc, 0x9f, 0x83, 0x21,
+		0xdc, 0xd8, 0xc4, 0xd5, 0x7e, 0x94, 0x5f, 0xfb, 0xa6, 0x70, 0x98, 0x04, 0x7b, 0xfe, 0x19, 0x00,
+		0xb6, 0x35, 0x42, 0x2f, 0x20, 0xf7, 0xa1, 0xfd, 0x8b, 0x6f, 0xf2, 0xd7, 0x4a, 0x1e, 0x89, 0xc7,
+		0x80, 0xbd, 0x7d, 0xea, 0xcd, 0xe0, 0xeb, 0x41, 0x06, 0x74, 0x44, 0x2e, 0x42, 0xe2, 0x25, 0xdb,
+		0x34, 0x1c, 0xad, 0xd6, 0x8f, 0xfa, 0xbf, 0x70, 0x6a, 0x81, 0x4f, 0x14, 0xd6, 0x30, 0x9b, 0xd8,
+		0xd1, 0x6a, 0x76, 0x3f, 0xda, 0xff, 0xca, 0x69, 0x5d, 0x02, 0x42, 0x5c, 0xd1, 0x6c, 0x67, 0x90,
+		0x7e, 0xff, 0xa5, 0x20, 0x16, 0x04, 0x44, 0x68, 0xf2, 0xf7, 0x75, 0xbc, 0xdb, 0x8f, 0xf6, 0xaf,
+		0x84, 0xd0, 0x1c, 0x7f, 0xfe, 0xad, 0x90, 0x22, 0x7f, 0xb2, 0x27, 0x88, 0x7d, 0x88, 0xff, 0x1b,
+		0x27, 0xf6, 0x28, 0x48, 0xcb, 0xb6, 0x53, 0x75, 0xf4, 0xfe, 0xca, 0xbe, 0xc3, 0x47, 0x5a, 0xe0,
+		0xcf, 0x67, 0x61, 0xd8, 0x76, 0xaa, 0xd5, 0x16, 0xcf, 0x4f, 0xfb, 0x90, 0xff, 0xf7, 0x6f, 0xba,
+		0x5b, 0x16, 0x2e, 0x0d, 0x19, 0xed, 0x1b, 0xd7, 0x1d, 0xcb, 0xa4, 0x57, 0x56, 0xfa, 0x71, 0xf8,
+		0x06, 0xe7, 0xe0, 0x23, 0x99, 0x5f, 0x80, 0x11, 0xd2, 0x17, 0x71, 0xf2, 0xdf, 0x8f, 0xc5, 0xff,
+		0xe0, 0x0a, 0x08, 0x10, 0xe5, 0xbe, 0xeb, 0xf7, 0xbe, 0x34, 0x2d, 0x7d, 0xfe, 0x4b, 0xd3, 0xd2,
+		0x7f, 0xfa, 0xd2, 0xb4, 0xf4, 0x81, 0x2f, 0x4f, 0x1f, 0xf8, 0xfc, 0x97, 0xa7, 0x0f, 0xfc, 0xc9,
+		0x97, 0xa7, 0x0f, 0x74, 0xde, 0x25, 0x86, 0x45, 0x73, 0xd1, 0x64, 0xfb, 0xc3, 0x2f, 0x2a, 0x35,
+		0xdd, 0xd9, 0x6e, 0x6d, 0xce, 0x56, 0xcc, 0x06, 0xdd, 0xc6, 0xf5, 0x76, 0x6b, 0xdd, 0x45, 0x0e,
+		0xbc, 0x2b, 0x0a, 0x47, 0x2a, 0xa6, 0xdd, 0x30, 0xed, 0x32, 0xdb, 0xef, 0x65, 0x05, 0xbe, 0xe3,
+		0x3b, 0xe2, 0xaf, 0x1a, 0x60, 0xd3, 0xf7, 0x32, 0x8c, 0xd1, 0xae, 0xd3, 0xed, 0x2e, 0x6a, 0x6d,
+		0x7d, 0x1d, 0xc4, 0x67, 0xff, 0x78, 0x88, 0xf6, 0x7a, 0xd4, 0x25, 0xa4, 0x2f, 0x06, 0xd6, 0x61,
+		0x4a, 0x6f, 0x58, 0x75, 0x4c, 0x8f, 0x81, 0xca, 0x6e, 0x5d, 0x7f, 0x7e, 0x9f, 0xe3, 0xfc, 0x26,
+		0x3d, 0xf2, 0xa2, 0xa0, 0x9e, 0x5f, 0x82, 0x09, 0xad, 0x52, 0xc1, 0x56, 0x80, 0x65, 0x9f, 0x61,
+		0x11, 0x02, 0xca, 0x9c, 0xd2, 0xe5, 0x96, 0x7b, 0xa6, 0xdb, 0xd0, 0xbc, 0xf8, 0xa0, 0x4f, 0xf3,
+		0x4d, 0x5c, 0xc3, 0xc6, 0x63, 0x06, 0x76, 0x6e, 0x98, 0xcd, 0xeb, 0x5c, 0xbd, 0x8f, 0xb1, 0xa6,
+		0xe2, 0xec, 0xd1, 0x37, 0xbc, 0x3b, 0x0a, 0xd3, 0xac, 0xe2, 0xcc, 0xa6, 0x66, 0xe3, 0x33, 0x3b,
+		0x67, 0x37, 0xb1, 0xa3, 0x9d, 0x3d, 0x53, 0x31, 0x75, 0x83, 0x8f, 0xc4, 0x24, 0x1f, 0x17, 0x52,
+		0x3f, 0xcb, 0xeb, 0x33, 0x1d, 0xb7, 0xe9, 0x95, 0x45, 0x88, 0x2d, 0x98, 0x3a, 0xbd, 0x8a, 0x5e,
+		0xc5, 0x86, 0xd9, 0xe0, 0xcf, 0x14, 0x59, 0x01, 0xdd, 0x0f, 0x71, 0xad, 0x61, 0xb6, 0x0c, 0x87,
+		0x9d, 0x24, 0xe5, 0x86, 0x7f, 0xef, 0xf6, 0xcc, 0x81, 0x3f, 0xbd, 0x3d, 0x13, 0x2d, 0x1a, 0x8e,
+		0xca, 0xab, 0xe6, 0x63, 0x6f, 0x7c, 0x6c, 0x46, 0x52, 0xae, 0x40, 0x22, 0x8f, 0x2b, 0xfb, 0xe1,
+		0x95, 0xc7, 0x95, 0x10, 0xaf, 0x87, 0x21, 0x59, 0x34, 0x1c, 0xf6, 0x90, 0xf4, 0x38, 0x44, 0x75,
+		0x83, 0xbd, 0x3f, 0x0a, 0xb5, 0x4f, 0xe0, 0x04, 0x35, 0x8f, 0x2b, 0x2e, 0x6a, 0x15, 0x57, 0xc2,
+		0xa8, 0x84, 0x3d, 0x81, 0xe7, 0xf2, 0x7f, 0xf2, 0x9f, 0xa7, 0x0f, 0xbc, 0xf2, 0xa5, 0xe9, 0x03,
+		0x5d, 0x47, 0xc2, 0x3f, 0x07, 0xb8, 0x8a, 0xf9, 0x10, 0xd8, 0xd5, 0xeb, 0xec, 0x8c, 0xc4, 0x1d,
+		0x86, 0x3f, 0x88, 0x83, 0xc2, 0x71, 0x6c, 0x47, 0xbb, 0xae, 0x1b, 0x35, 0x77, 0x24, 0xb4, 0x96,
+		0xb3, 0xfd, 0x32, 0x1f, 0x8a, 0x43, 0x7c, 0x28, 0x38, 0x4e, 0xef, 0xd1, 0xc8, 0x74, 0x9f, 0x5d,
+		0x99, 0x3e, 0x63, 0xae, 0xfc, 0x7e, 0x14, 0x50, 0xc9, 0xd1, 0xae, 0xe3, 0x6c, 0xcb, 0xd9, 0x36,
+		0x9b, 0xfa, 0xcb, 0xcc, 0x97, 0x61, 0x80, 0x86, 0x76, 0xb3, 0xec, 0x98, 0xd7, 0xb1, 0x21, 0xee,
+		0x4f, 0x1f, 0x99, 0xed, 0x60, 0x1f, 0xb3, 0x64, 0xe8, 0x72, 0x8f, 0x7c, 0xea, 0x8b, 0x33, 0x0f,
+		0xf5, 0xd7, 0x02, 0x45, 0x26, 0xc9, 0xf5, 0xcd, 0x75, 0xca, 0x18, 0x5d, 0x03, 0x76, 0xc7, 0xb9,
+		0x5c, 0xd7, 0x6d, 0x87, 0x5f, 0xbc, 0x3d, 0x37, 0xdb, 0xb9, 0xef, 0xb3, 0xed, 0x62, 0xce, 0xf2,
+		0xfb, 0x25, 0x66, 0xd3, 0xbe, 0x7c, 0x40, 0x4d, 0x51, 0x56, 0x4b, 0xba, 0xed, 0xa0, 0x75, 0x48,
+		0x55, 0xb1, 0xb1, 0xcb, 0xd8, 0x46, 0xbf, 0x3d, 0xb6, 0x49, 0xc2, 0x89, 0x72, 0x7d, 0x1e, 0x90,
+		0xe6, 0xc7, 0x13, 0x1f, 0xe7, 0x61, 0x17, 0xdd, 0xba, 0xb0, 0x0f, 0x70, 0xa6, 0x0f, 0x6a, 0x26,
+		0xb4, 0x30, 0x28, 0x73, 0x12, 0xc0, 0x6b, 0x13, 0xa5, 0x21, 0xa1, 0x55, 0xab, 0x4d, 0x6c, 0xb3,
+		0x4b, 0x19, 0x29, 0x55, 0x14, 0xe7, 0x27, 0xfe, 0xf5, 0x67, 0x1e, 0x1b, 0x0d, 0x70, 0xcc, 0x8d,
+		0x00, 0xec, 0xb8, 0xa4, 0xa7, 0x3f, 0x2a, 0xc1, 0x44, 0x5b, 0x8b, 0x48, 0x81, 0xe9, 0xec, 0xc6,
+		0xfa, 0xe5, 0x55, 0xb5, 0xf8, 0x22, 0xbb, 0x79, 0xc3, 0xef, 0x06, 0x95, 0xd6, 0x0a, 0x0b, 0xec,
+		0xeb, 0x1e, 0x07, 0xd0, 0x0c, 0x1c, 0xed, 0x80, 0x93, 0x2f, 0x2c, 0x15, 0x16, 0xb3, 0xeb, 0x05,
+		0x59, 0x42, 0xf7, 0xc1, 0xf1, 0x8e, 0x4c, 0x5c, 0x94, 0x48, 0x17, 0x14, 0xb5, 0xe0, 0xa2, 0x44,
+		0x73, 0x97, 0xba, 0xce, 0xa2, 0x47, 0x7b, 0xda, 0xcf, 0x4d, 0x77, 0xba, 0x04, 0xe7, 0xd3, 0xff,
+		0x96, 0xe0, 0x48, 0x38, 0x64, 0x68, 0xc6, 0x6e, 0xb7, 0x6f, 0xb5, 0x9d, 0x87, 0x68, 0xd6, 0xd8,
+		0x45, 0x47, 0x58, 0xe6, 0x5c, 0x6e, 0x35, 0xeb, 0xdc, 0xdb, 0x24, 0x48, 0x79, 0xa3, 0x59, 0x0f,
+		0x3e, 0xae, 0x19, 0xe1, 0x8f, 0x6b, 0x72, 0x3f, 0x20, 0xed, 0x2d, 0x44, 0x26, 0xb3, 0xc6, 0x2e,
+		0xf5, 0x2e, 0x6b, 0xd2, 0x8b, 0x8f, 0xf6, 0x3d, 0x40, 0xbd, 0x6e, 0x98, 0x37, 0x0c, 0x22, 0xb6,
+		0xb5, 0x29, 0x0e, 0x4f, 0xa7, 0xc3, 0x87, 0xa7, 0xcf, 0xe1, 0x7a, 0xfd, 0x2a, 0xc1, 0x5b, 0x0f,
+		0xf4, 0xff, 0x43, 0x11, 0x98, 0x6e, 0x0b, 0x99, 0x3c, 0xbb, 0xe8, 0xa6, 0x84, 0x79, 0x48, 0xe6,
+		0x45, 0xd2, 0x92, 0x86, 0x84, 0x8d, 0x2b, 0xa6, 0x51, 0x65, 0xb3, 0x3c, 0xaa, 0x8a, 0x22, 0x51,
+		0x84, 0xa1, 0x19, 0xa6, 0xcd, 0x3f, 0x51, 0xc0, 0x0a, 0xb9, 0x1f, 0xdd, 0xa3, 0x22, 0x46, 0x45,
+		0x4b, 0x42, 0x1b, 0x67, 0x07, 0xd4, 0x86, 0xe8, 0x44, 0xe0, 0x48, 0x79, 0x50, 0xad, 0xfc, 0x48,
+		0x04, 0x66, 0xc2, 0x5a, 0x21, 0x29, 0x9b, 0xed, 0x68, 0x0d, 0xab, 0x9b, 0x5a, 0x9e, 0x86, 0xd4,
+		0xba, 0xc0, 0xd9, 0xb3, 0x5e, 0x6e, 0xed, 0x51, 0x2f, 0x63, 0x6e, 0x53, 0x42, 0x31, 0x73, 0x03,
+		0x2a, 0xc6, 0xed, 0xc7, 0xbe, 0x34, 0xf3, 0xa9, 0x18, 0x1c, 0xa7, 0xdf, 0xb0, 0x69, 0x36, 0x74,
+		0xc3, 0x39, 0x53, 0x69, 0xee, 0x5a, 0x0e, 0x4d, 0xda, 0xcc, 0x2d, 0xae, 0x97, 0x09, 0xaf, 0x7a,
+		0x96, 0x55, 0x77, 0xc9, 0x01, 0xb6, 0x60, 0x68, 0x8d, 0xd0, 0x11, 0x8d, 0x38, 0xa6, 0xa3, 0xd5,
+		0xb9, 0xa6, 0x58, 0x81, 0x40, 0xd9, 0x77, 0x6f, 0x22, 0x0c, 0xaa, 0x8b, 0x4f, 0xde, 0xd4, 0xb1,
+		0xb6, 0xc5, 0x3e, 0x1f, 0x10, 0xa5, 0x53, 0x2c, 0x49, 0x00, 0xf4, 0x4b, 0x01, 0x53, 0x30, 0xa4,
+		0xb5, 0xd8, 0x35, 0x9f, 0x28, 0x99, 0x7b, 0xb4, 0xa0, 0x5c, 0x85, 0x04, 0x3f, 0x4c, 0x46, 0x32,
+		0x44, 0xaf, 0xe3, 0x5d, 0xda, 0xce, 0x88, 0x4a, 0xfe, 0x44, 0xb3, 0x30, 0x44, 0x85, 0xe7, 0xc1,
+		0x23, 0x3d, 0xdb, 0x26, 0xfd, 0x2c, 0x15, 0x52, 0x65, 0x68, 0xca, 0x15, 0x48, 0xe6, 0xcd, 0x86,
+		0x6e, 0x98, 0x41, 0x6e, 0x29, 0xc6, 0x8d, 0xca, 0x6c, 0xb5, 0x1c, 0xf1, 0xb2, 0x8e, 0x16, 0xd0,
+		0x21, 0x88, 0xb3, 0xcf, 0x49, 0xf0, 0xab, 0x4a, 0xbc, 0xa4, 0x2c, 0x40, 0x82, 0xf2, 0x5e, 0xb5,
+		0xdc, 0x6f, 0x34, 0x49, 0xbe, 0x6f, 0x34, 0x71, 0xf6, 0x11, 0x4f, 0x58, 0x04, 0xb1, 0xaa, 0xe6,
+		0x68, 0xbc, 0xdf, 0xf4, 0x6f, 0xe5, 0x6d, 0x90, 0xe4, 0x4c, 0x6c, 0x34, 0x07, 0x51, 0xd3, 0x12,
+		0x37, 0xf1, 0x32, 0xdd, 0xba, 0xb2, 0x6a, 0xe5, 0x62, 0x24, 0x4b, 0x51, 0x09, 0x72, 0x4e, 0xed,
+		0xea, 0x50, 0x2f, 0xf8, 0x1c, 0xaa, 0x6f, 0xc8, 0x7d, 0x7f, 0xb2, 0x21, 0x6d, 0x33, 0x07, 0xd7,
+		0x58, 0x3e, 0x1e, 0x81, 0x69, 0x5f, 0xed, 0x0e, 0x6e, 0xda, 0xba, 0x69, 0xf0, 0x58, 0xce, 0xac,
+		0x05, 0xf9, 0x84, 0xe4, 0xf5, 0x5d, 0xcc, 0xe5, 0xad, 0x10, 0xcd, 0x5a, 0x16, 0xca, 0x40, 0x92,
+		0x96, 0x2b, 0x26, 0xb3, 0x97, 0x98, 0xea, 0x96, 0x49, 0x9d, 0x6d, 0x6e, 0x39, 0x37, 0xb4, 0xa6,
+		0xfb, 0xc5, 0x25, 0x51, 0x56, 0x2e, 0x42, 0x6a, 0xc1, 0x34, 0x6c, 0x6c, 0xd8, 0x2d, 0x3a, 0x07,
+		0x37, 0xeb, 0x66, 0xe5, 0x3a, 0xe7, 0xc0, 0x0a, 0x44, 0xe1, 0x9a, 0x65, 0x51, 0xca, 0x98, 0x4a,
+		0xfe, 0x64, 0x79, 0x61, 0xae, 0xd4, 0x55, 0x45, 0x17, 0xf7, 0xae, 0x22, 0xde, 0x49, 0x7f, 0x00,
+		0x3a, 0xd6, 0x3e, 0xa1, 0xae, 0xe3, 0x5d, 0x7b, 0xaf, 0xf3, 0xe9, 0x79, 0x48, 0xad, 0xd1, 0x6f,
+		0x5f, 0x5e, 0xc5, 0xbb, 0x28, 0x03, 0x09, 0x5c, 0x9d, 0x3b, 0x77, 0xee, 0xec, 0x45, 0x66, 0xed,
+		0x97, 0x0f, 0xa8, 0x02, 0x80, 0xa6, 0x21, 0x65, 0xe3, 0x8a, 0x35, 0x77, 0xee, 0xfc, 0xf5, 0xb3,
+		0xcc, 0xbc, 0x48, 0xf6, 0xe3, 0x82, 0xe6, 0x93, 0xa4, 0xd7, 0x6f, 0x7c, 0x7c, 0x46, 0xca, 0x0d,
+		0x41, 0xd4, 0x6e, 0x35, 0xee, 0xa9, 0x8d, 0x7c, 0x64, 0x08, 0x4e, 0xf8, 0x29, 0xa9, 0xa7, 0x72,
+		0x33, 0x12, 0xae, 0x03, 0xd9, 0xa7, 0x03, 0x8a, 0xd1, 0x25, 0x91, 0xed, 0xa9, 0x49, 0xe5, 0x97,
+		0x25, 0x18, 0x71, 0xd3, 0xa4, 0x12, 0x76, 0xd0, 0xd3, 0xfe, 0xdc, 0x87, 0x4f, 0x9b, 0xa3, 0xb3,
+		0xe1, 0xb6, 0xbc, 0x74, 0x4e, 0xf5, 0xa1, 0xa3, 0xa7, 0xa8, 0x21, 0x5a, 0xa6, 0xcd, 0xbf, 0xc2,
+		0xd3, 0x87, 0xd4, 0x45, 0x46, 0x8f, 0x02, 0xa2, 0x1e, 0xae, 0xbc, 0x63, 0x3a, 0xba, 0x51, 0x2b,
+		0x5b, 0xe6, 0x0d, 0xfe, 0x6d, 0xb3, 0xa8, 0x2a, 0xd3, 0x9a, 0x6b, 0xb4, 0x62, 0x8d, 0xc0, 0x89,
+		0xd0, 0x29, 0x97, 0x4b, 0x30, 0xb5, 0x23, 0x4e, 0x40, 0x14, 0xd1, 0xd3, 0x90, 0xb0, 0x5a, 0x9b,
+		0x65, 0xe1, 0x31, 0x86, 0xe7, 0x8e, 0x75, 0x9a, 0xff, 0xc2, 0x3e, 0xb8, 0x07, 0x88, 0x5b, 0xad,
+		0x4d, 0x62, 0x2d, 0xf7, 0xc1, 0x48, 0x07, 0x61, 0x86, 0x77, 0x3c, 0x39, 0xe8, 0x27, 0x57, 0x79,
+		0x0f, 0xca, 0x56, 0x53, 0x37, 0x9b, 0xba, 0xb3, 0x4b, 0x73, 0xd7, 0xa8, 0x2a, 0x8b, 0x8a, 0x35,
+		0x0e, 0x57, 0xae, 0xc3, 0x78, 0x89, 0xae, 0x6d, 0x3d, 0xc9, 0xcf, 0x79, 0xf2, 0x49, 0xfd, 0xe5,
+		0xeb, 0x2a, 0x59, 0xa4, 0x4d, 0xb2, 0xdc, 0xb3, 0x5d, 0xad, 0xf3, 0xa9, 0xbd, 0x5b, 0x67, 0x30,
+		0x3b, 0xfc, 0xcb, 0x23, 0x81, 0xc9, 0xc9, 0x8c, 0xd3, 0xef, 0xbe, 0x06, 0x35, 0xcc, 0x7e, 0xd9,
+		0x44, 0xa6, 0x77, 0x50, 0xcd, 0xf4, 0x71, 0xa3, 0x99, 0xbe, 0x53, 0x48, 0xb9, 0x08, 0xa3, 0x6b,
+		0x5a, 0xd3, 0x29, 0x61, 0xe7, 0x32, 0xd6, 0xaa, 0xb8, 0x19, 0x8c, 0xba, 0xa3, 0x22, 0xea, 0x22,
+		0x88, 0xd1, 0xd0, 0xca, 0xa2, 0x0e, 0xfd, 0x5b, 0xd9, 0x86, 0x18, 0x7d, 0xd7, 0xe3, 0x46, 0x64,
+		0x4e, 0xc1, 0x22, 0x32, 0xf1, 0xa5, 0xbb, 0x0e, 0x7f, 0xf7, 0x38, 0xa2, 0xb2, 0x02, 0x7a, 0x52,
+		0xc4, 0xd5, 0x68, 0xef, 0xb8, 0xca, 0x0d, 0x91, 0x47, 0xd7, 0x3a, 0x24, 0x72, 0xc4, 0x15, 0x17,
+		0xf3, 0xae, 0x20, 0x92, 0x27, 0x08, 0x5a, 0x86, 0x71, 0x4b, 0x6b, 0x3a, 0xf4, 0x2b, 0x21, 0xdb,
+		0xb4, 0x17, 0xdc, 0xd6, 0x67, 0xda, 0x67, 0x5e, 0xa0, 0xb3, 0xbc, 0x95, 0x51, 0xcb, 0x0f, 0x54,
+		0xfe, 0x3c, 0x06, 0x71, 0xae, 0x8c, 0xb7, 0x42, 0x82, 0xab, 0x95, 0x5b, 0xe7, 0xf1, 0xd9, 0xf6,
+		0xc0, 0x34, 0xeb, 0x06, 0x10, 0xce, 0x4f, 0xd0, 0xa0, 0x93, 0x90, 0xac, 0x6c, 0x6b, 0xba, 0x51,
+		0xd6, 0xab, 0x62, 0x9b, 0xe1, 0x4b, 0xb7, 0x67, 0x12, 0x0b, 0x04, 0x56, 0xcc, 0xab, 0x09, 0x5a,
+		0x59, 0xac, 0x92, 0x4c, 0x60, 0x1b, 0xeb, 0xb5, 0x6d, 0x87, 0xcf, 0x30, 0x5e, 0x42, 0x17, 0x20,
+		0x46, 0x0c, 0x82, 0x5f, 0x03, 0xcf, 0xb4, 0x6d, 0xf6, 0xb8, 0xc9, 0x5e, 0x2e, 0x49, 0x1a, 0xfe,
+		0xc0, 0x17, 0x67, 0x24, 0x95, 0x52, 0xa0, 0x05, 0x18, 0xad, 0x6b, 0xb6, 0x53, 0xa6, 0x11, 0x8c,
+		0x34, 0x3f, 0xc4, 0xd7, 0xda, 0x6d, 0x0a, 0xe1, 0x8a, 0xe5, 0xa2, 0x0f, 0x13, 0x2a, 0x06, 0xaa,
+		0xa2, 0x53, 0x20, 0x53, 0x26, 0x15, 0xb3, 0xd1, 0xd0, 0x1d, 0x96, 0x5b, 0xc5, 0xa9, 0xde, 0xc7,
+		0x08, 0x7c, 0x81, 0x82, 0x69, 0x86, 0x75, 0x14, 0x52, 0xf4, 0x53, 0x38, 0x14, 0x85, 0x3d, 0x26,
+		0x4b, 0x12, 0x00, 0xad, 0x7c, 0x08, 0xc6, 0x3d, 0xff, 0xc8, 0x50, 0x92, 0x8c, 0x8b, 0x07, 0xa6,
+		0x88, 0x8f, 0xc3, 0x94, 0x81, 0x6f, 0x3a, 0xe5, 0x30, 0x76, 0x8a, 0x62, 0x23, 0x52, 0x77, 0x2d,
+		0x48, 0xf1, 0x20, 0x8c, 0x55, 0x84, 0xf2, 0x19, 0x2e, 0x50, 0xdc, 0x51, 0x17, 0x4a, 0xd1, 0x8e,
+		0x40, 0x52, 0xb3, 0x2c, 0x86, 0x30, 0xcc, 0xfd, 0xa3, 0x65, 0xd1, 0xaa, 0xd3, 0x30, 0x41, 0xfb,
+		0xd8, 0xc4, 0x76, 0xab, 0xee, 0x70, 0x26, 0x23, 0x14, 0x67, 0x9c, 0x54, 0xa8, 0x0c, 0x4e, 0x71,
+		0xef, 0x87, 0x51, 0xbc, 0xa3, 0x57, 0xb1, 0x51, 0xc1, 0x0c, 0x6f, 0x94, 0xe2, 0x8d, 0x08, 0x20,
+		0x45, 0x7a, 0x18, 0x5c, 0xbf, 0x57, 0x16, 0x3e, 0x79, 0x8c, 0xf1, 0x13, 0xf0, 0x2c, 0x03, 0x2b,
+		0x69, 0x88, 0xe5, 0x35, 0x47, 0x23, 0x09, 0x86, 0x73, 0x93, 0x05, 0x9a, 0x11, 0x95, 0xfc, 0xa9,
+		0xbc, 0x11, 0x81, 0xd8, 0x35, 0xd3, 0xc1, 0xe8, 0x09, 0x5f, 0x02, 0x38, 0xd6, 0xc9, 0x9e, 0x4b,
+		0x7a, 0xcd, 0xc0, 0xd5, 0x65, 0xbb, 0xe6, 0xfb, 0xfc, 0xa4, 0x67, 0x4e, 0x91, 0x80, 0x39, 0x4d,
+		0xc1, 0x50, 0xd3, 0x6c, 0x19, 0x55, 0x71, 0xdb, 0x9a, 0x16, 0x50, 0x01, 0x92, 0xae, 0x95, 0xc4,
+		0xfa, 0x59, 0xc9, 0x38, 0xb1, 0x12, 0x62, 0xc3, 0x1c, 0xa0, 0x26, 0x36, 0xb9, 0xb1, 0xe4, 0x20,
+		0xe5, 0x3a, 0x2f, 0x6e, 0x6d, 0x83, 0x19, 0xac, 0x47, 0x46, 0x82, 0x89, 0x3b, 0xf6, 0xae, 0xf2,
+		0x98, 0xc5, 0xc9, 0x6e, 0x05, 0xd7, 0x5e, 0xc0, 0xac, 0xf8, 0xa7, 0x30, 0x13, 0xb4, 0x5f, 0x9e,
+		0x59, 0xb1, 0xcf, 0x61, 0x1e, 0x83, 0x94, 0xad, 0xd7, 0x0c, 0xfa, 0x80, 0x81, 0x5b, 0x9e, 0x07,
+		0x50, 0x7e, 0x4b, 0x82, 0x38, 0xb3, 0x64, 0x9f, 0xde, 0xa4, 0xce, 0x7a, 0x8b, 0x74, 0xd3, 0x5b,
+		0x74, 0xff, 0x7a, 0xcb, 0x02, 0xb8, 0xc2, 0xd8, 0xfc, 0x0b, 0x85, 0x1d, 0x32, 0x06, 0x26, 0x62,
+		0x49, 0xaf, 0xf1, 0x89, 0xea, 0x23, 0x52, 0xfe, 0xa3, 0x44, 0x92, 0x58, 0x5e, 0x8f, 0xb2, 0x30,
+		0x2a, 0xe4, 0x2a, 0x6f, 0xd5, 0xb5, 0x1a, 0xb7, 0x9d, 0xe3, 0x5d, 0x85, 0xbb, 0x54, 0xd7, 0x6a,
+		0xea, 0x30, 0x97, 0x87, 0x14, 0x3a, 0x8f, 0x43, 0xa4, 0xcb, 0x38, 0x04, 0x06, 0x3e, 0xba, 0xbf,
+		0x81, 0x0f, 0x0c, 0x51, 0x2c, 0x3c, 0x44, 0xbf, 0x12, 0xa1, 0x8b, 0x19, 0xcb, 0xb4, 0xb5, 0xfa,
+		0xdf, 0xc4, 0x8c, 0x38, 0x0a, 0x29, 0xcb, 0xac, 0x97, 0x59, 0x0d, 0x7b, 0x85, 0x90, 0xb4, 0xcc,
+		0xba, 0xda, 0x36, 0xec, 0x43, 0x77, 0x69, 0xba, 0xc4, 0xef, 0x82, 0xd6, 0x12, 0x61, 0xad, 0x35,
+		0x61, 0x84, 0xa9, 0x82, 0xc7, 0xb2, 0xc7, 0x89, 0x0e, 0x68, 0x70, 0x94, 0xda, 0x63, 0x2f, 0x13,
+		0x9b, 0x61, 0xaa, 0x1c, 0x8f, 0x50, 0x30, 0xd7, 0xdf, 0x69, 0x15, 0xec, 0x37, 0x4b, 0x95, 0xe3,
+		0x29, 0x3f, 0x2c, 0x01, 0x2c, 0x11, 0xcd, 0xd2, 0xfe, 0x92, 0x28, 0x64, 0x53, 0x11, 0xca, 0x81,
+		0x96, 0xa7, 0xbb, 0x0d, 0x1a, 0x6f, 0x7f, 0xc4, 0xf6, 0xcb, 0xbd, 0x00, 0xa3, 0x9e, 0x31, 0xda,
+		0x58, 0x08, 0x33, 0xdd, 0x23, 0xab, 0x2e, 0x61, 0x47, 0x1d, 0xd9, 0xf1, 0x95, 0x94, 0x7f, 0x21,
+		0x41, 0x8a, 0xca, 0xb4, 0x8c, 0x1d, 0x2d, 0x30, 0x86, 0xd2, 0xfe, 0xc7, 0xf0, 0x38, 0x00, 0x63,
+		0x63, 0xeb, 0x2f, 0x63, 0x6e, 0x59, 0x29, 0x0a, 0x29, 0xe9, 0x2f, 0x63, 0x74, 0xde, 0x55, 0x78,
+		0xb4, 0xb7, 0xc2, 0x45, 0xd6, 0xcd, 0xd5, 0x7e, 0x18, 0x12, 0xf4, 0x81, 0xf1, 0x4d, 0x9b, 0x27,
+		0xd2, 0x71, 0xa3, 0xd5, 0x58, 0xbf, 0x69, 0x2b, 0x2f, 0x41, 0x62, 0xfd, 0x26, 0xdb, 0x1b, 0x39,
+		0x0a, 0xa9, 0xa6, 0x69, 0xf2, 0x98, 0xcc, 0x72, 0xa1, 0x24, 0x01, 0xd0, 0x10, 0x24, 0xf6, 0x03,
+		0x22, 0xde, 0x7e, 0x80, 0xb7, 0xa1, 0x11, 0x1d, 0x68, 0x43, 0xe3, 0xf4, 0xbf, 0x93, 0x60, 0xd8,
+		0xe7, 0x1f, 0xd0, 0x59, 0x38, 0x98, 0x5b, 0x5a, 0x5d, 0xb8, 0x5a, 0x2e, 0xe6, 0xcb, 0x97, 0x96,
+		0xb2, 0xbe, 0x87, 0x91, 0x99, 0x43, 0xaf, 0xdd, 0x3a, 0x81, 0x7c, 0xb8, 0x1b, 0x06, 0xdd, 0x51,
+		0x42, 0x67, 0x60, 0x2a, 0x48, 0x92, 0xcd, 0x95, 0x0a, 0x2b, 0xeb, 0xb2, 0x94, 0x39, 0xf8, 0xda,
+		0xad, 0x13, 0x13, 0x3e, 0x8a, 0xec, 0xa6, 0x8d, 0x0d, 0xa7, 0x9d, 0x60, 0x61, 0x75, 0x79, 0xb9,
+		0xb8, 0x2e, 0x47, 0xda, 0x08, 0xb8, 0xc3, 0x7e, 0x18, 0x26, 0x82, 0x04, 0x2b, 0xc5, 0x25, 0x39,
+		0x9a, 0x41, 0xaf, 0xdd, 0x3a, 0x31, 0xe6, 0xc3, 0x5e, 0xd1, 0xeb, 0x99, 0xe4, 0xab, 0x9f, 0x98,
+		0x3e, 0xf0, 0xd3, 0x3f, 0x39, 0x2d, 0x91, 0x9e, 0x8d, 0x06, 0x7c, 0x04, 0x7a, 0x14, 0x0e, 0x97,
+		0x8a, 0x8b, 0x2b, 0x85, 0x7c, 0x79, 0xb9, 0xb4, 0x18, 0x7a, 0xe0, 0x9a, 0x19, 0x7f, 0xed, 0xd6,
+		0x89, 0x61, 0xde, 0xa5, 0x6e, 0xd8, 0x6b, 0x6a, 0xe1, 0xda, 0xea, 0x7a, 0x41, 0x96, 0x18, 0xf6,
+		0x5a, 0x13, 0xef, 0x98, 0x0e, 0xfb, 0xdd, 0x87, 0xc7, 0xe1, 0x48, 0x07, 0x6c, 0xb7, 0x63, 0x13,
+		0xaf, 0xdd, 0x3a, 0x31, 0xba, 0xd6, 0xc4, 0x6c, 0xfe, 0x50, 0x8a, 0x59, 0x48, 0xb7, 0x53, 0xac,
+		0xae, 0xad, 0x96, 0xb2, 0x4b, 0xf2, 0x89, 0x8c, 0xfc, 0xda, 0xad, 0x13, 0x23, 0xc2, 0x19, 0xd2,
+		0x4d, 0x7e, 0xb7, 0x67, 0xf7, 0x72, 0xc5, 0xf3, 0x33, 0xe7, 0xe0, 0x81, 0x2e, 0xe7, 0x4b, 0xe2,
+		0x64, 0x62, 0x5f, 0x27, 0x4c, 0x5d, 0xf7, 0xd8, 0x33, 0x7d, 0xb6, 0x9f, 0xfb, 0x2f, 0x9d, 0xf6,
+		0x7f, 0x7a, 0x95, 0xe9, 0xb9, 0xb8, 0x53, 0xde, 0x2b, 0xc1, 0xd8, 0x65, 0xdd, 0x76, 0xcc, 0xa6,
+		0x5e, 0xd1, 0xea, 0xf4, 0x79, 0xdd, 0xf9, 0x41, 0x7d, 0x6b, 0x68, 0xaa, 0x3f, 0x03, 0xf1, 0x1d,
+		0xad, 0xce, 0x9c, 0x5a, 0x94, 0x7e, 0x97, 0xb7, 0xcb, 0x71, 0x8f, 0xeb, 0xda, 0x04, 0x03, 0x46,
+		0xa6, 0xfc, 0x42, 0x04, 0xc6, 0xe9, 0x64, 0xb0, 0xd9, 0x57, 0xe5, 0x1d, 0xfa, 0xf8, 0x33, 0xd6,
+		0xd4, 0x1c, 0xbe, 0x69, 0x98, 0x9b, 0xe5, 0x27, 0x8f, 0x27, 0x07, 0x38, 0x47, 0xcb, 0xe3, 0x8a,
+		0x4a, 0x69, 0xd1, 0xdb, 0x21, 0xd9, 0xd0, 0x6e, 0x96, 0x29, 0x1f, 0xb6, 0x72, 0xc9, 0xee, 0x8d,
+		0xcf, 0x9d, 0xdb, 0x33, 0xe3, 0xbb, 0x5a, 0xa3, 0x3e, 0xaf, 0x08, 0x3e, 0x8a, 0x9a, 0x68, 0x68,
+		0x37, 0x89, 0x88, 0xc8, 0xa2, 0x4f, 0x70, 0xcb, 0x95, 0x6d, 0xcd, 0xa8, 0x61, 0xd6, 0x08, 0xdd,
+		0x02, 0xcd, 0x5d, 0xde, 0x73, 0x23, 0x87, 0xbc, 0x46, 0x7c, 0xec, 0x14, 0x75, 0xb4, 0xa1, 0xdd,
+		0x5c, 0xa0, 0x00, 0xd2, 0xe2, 0x7c, 0xf2, 0xf5, 0x8f, 0xcd, 0x1c, 0xa0, 0xa7, 0xb9, 0x5f, 0x90,
+		0x00, 0x3c, 0x8d, 0xa1, 0xb7, 0x83, 0x5c, 0x71, 0x4b, 0x94, 0x56, 0x9c, 0x4b, 0x3e, 0xd4, 0x6d,
+		0x2c, 0x42, 0xfa, 0x66, 0xb1, 0xf9, 0xf3, 0xb7, 0x67, 0x24, 0x75, 0xbc, 0x12, 0x1a, 0x8a, 0xef,
+		0x84, 0xe1, 0x96, 0x55, 0xd5, 0x1c, 0x5c, 0xa6, 0xeb, 0xb8, 0x48, 0xdf, 0x38, 0x3f, 0x4d, 0x78,
+		0xdd, 0xb9, 0x3d, 0x83, 0x58, 0xb7, 0x7c, 0xc4, 0x0a, 0x8d, 0xfe, 0xc0, 0x20, 0x84, 0xc0, 0xd7,
+		0xa7, 0xcf, 0xd2, 0xdf, 0x03, 0xf0, 0xee, 0x53, 0xa6, 0x21, 0xd1, 0x30, 0x0d, 0xfd, 0x3a, 0xb7,
+		0xc7, 0x94, 0x2a, 0x8a, 0x28, 0x03, 0x49, 0xf6, 0x29, 0x12, 0x67, 0x57, 0x6c, 0x85, 0x8a, 0x32,
+		0xa1, 0xba, 0x81, 0x37, 0x6d, 0x5d, 0x8c, 0x86, 0x2a, 0x8a, 0xe8, 0x12, 0xc8, 0x36, 0xae, 0xb4,
+		0x9a, 0xba, 0xb3, 0x5b, 0xae, 0x98, 0x86, 0xa3, 0x55, 0xd8, 0x47, 0x86, 0x52, 0xb9, 0xa3, 0x77,
+		0x6e, 0xcf, 0x1c, 0x66, 0xb2, 0x86, 0x31, 0x14, 0x75, 0x5c, 0x80, 0x16, 0x18, 0x84, 0xb4, 0x50,
+		0xc5, 0x8e, 0xa6, 0xd7, 0xd9, 0x7b, 0xe4, 0x94, 0x2a, 0x8a, 0xbe, 0xbe, 0x7c, 0x3a, 0xe1, 0xdf,
+		0xd8, 0xba, 0x04, 0xb2, 0x69, 0xe1, 0x66, 0x20, 0x11, 0x95, 0xc2, 0x2d, 0x87, 0x31, 0x14, 0x75,
+		0x5c, 0x80, 0x44, 0x92, 0xea, 0x90, 0x61, 0x16, 0x0b, 0x45, 0xab, 0xb5, 0xe9, 0xed, 0x87, 0x4d,
+		0xb5, 0x8d, 0x46, 0xd6, 0xd8, 0xcd, 0x3d, 0xe1, 0x71, 0x0f, 0xd3, 0x29, 0x9f, 0xfb, 0xcc, 0x63,
+		0x53, 0xdc, 0x34, 0xbc, 0xfd, 0xa9, 0xab, 0x78, 0x97, 0x0c, 0x3f, 0x47, 0x5d, 0xa3, 0x98, 0x24,
+		0xed, 0x7c, 0x49, 0xd3, 0xeb, 0xe2, 0xcb, 0x5a, 0x2a, 0x2f, 0xa1, 0x79, 0x88, 0xdb, 0x8e, 0xe6,
+		0xb4, 0x6c, 0x7e, 0xca, 0xab, 0x74, 0x33, 0xb5, 0x9c, 0x69, 0x54, 0x4b, 0x14, 0x53, 0xe5, 0x14,
+		0xe8, 0x12, 0xc4, 0xf9, 0xf1, 0xf9, 0xd0, 0x9e, 0xe7, 0x37, 0xbd, 0x27, 0xc1, 0xa8, 0x89, 0x46,
+		0xaa, 0xb8, 0x8e, 0x6b, 0x2c, 0xad, 0xda, 0xd6, 0xc8, 0xea, 0x83, 0xfe, 0x54, 0x41, 0xae, 0xb8,
+		0xe7, 0x49, 0xc8, 0x35, 0x15, 0xe6, 0xa7, 0xa8, 0xe3, 0x2e, 0xa8, 0x44, 0x21, 0xe8, 0x6a, 0xe0,
+		0xe2, 0x2f, 0xff, 0xe6, 0xd7, 0xfd, 0xdd, 0xba, 0xef, 0xb3, 0x69, 0xb1, 0x3f, 0xe1, 0xbf, 0x36,
+		0x7c, 0x09, 0xe4, 0x96, 0xb1, 0x69, 0x1a, 0xf4, 0x7d, 0x36, 0xcf, 0xef, 0xc9, 0xfa, 0x2e, 0xea,
+		0x37, 0x8e, 0x30, 0x86, 0xa2, 0x8e, 0xbb, 0xa0, 0xcb, 0x6c, 0x15, 0x50, 0x85, 0x31, 0x0f, 0x8b,
+		0x4e, 0xd4, 0x54, 0xdf, 0x89, 0x7a, 0x1f, 0x9f, 0xa8, 0x07, 0xc3, 0xad, 0x78, 0x73, 0x75, 0xd4,
+		0x05, 0x12, 0x32, 0x74, 0x19, 0xc0, 0x73, 0x0f, 0x74, 0x9f, 0x62, 0xb8, 0xfb, 0xc0, 0x7b, 0x3e,
+		0x46, 0xac, 0xf7, 0x3c, 0x5a, 0xf4, 0xdd, 0x30, 0xd9, 0xd0, 0x8d, 0xb2, 0x8d, 0xeb, 0x5b, 0x65,
+		0xae, 0x60, 0xc2, 0x92, 0x7e, 0x71, 0x3a, 0xb7, 0xb4, 0x37, 0x7b, 0xb8, 0x73, 0x7b, 0x26, 0xc3,
+		0x5d, 0x68, 0x3b, 0x4b, 0x45, 0x9d, 0x68, 0xe8, 0x46, 0x09, 0xd7, 0xb7, 0xf2, 0x2e, 0x6c, 0x7e,
+		0xe4, 0xd5, 0x8f, 0xcd, 0x1c, 0xe0, 0xd3, 0xf5, 0x80, 0x72, 0x9e, 0xee, 0x9d, 0xf3, 0x69, 0x86,
+		0x6d, 0xb2, 0x26, 0xd1, 0x44, 0x81, 0x5f, 0x33, 0xf0, 0x00, 0x6c, 0x9a, 0xbf, 0xf2, 0x1f, 0x4e,
+		0x48, 0xca, 0xa7, 0x25, 0x88, 0xe7, 0xaf, 0xad, 0x69, 0x7a, 0x13, 0x15, 0x61, 0xc2, 0xb3, 0x9c,
+		0xe0, 0x24, 0x3f, 0x76, 0xe7, 0xf6, 0x4c, 0x3a, 0x6c, 0x5c, 0xee, 0x2c, 0xf7, 0x0c, 0x58, 0x4c,
+		0xf3, 0x62, 0xb7, 0x85, 0x6b, 0x80, 0x55, 0x1b, 0x8a, 0xd2, 0xbe, 0xac, 0x0d, 0x75, 0xb3, 0x00,
+		0x09, 0x26, 0xad, 0x8d, 0xe6, 0x61, 0xc8, 0x22, 0x7f, 0xf0, 0x83, 0x81, 0xe9, 0xae, 0xc6, 0x4b,
+		0xf1, 0xdd, 0x8d, 0x4c, 0x42, 0xa2, 0x7c, 0x30, 0x02, 0x90, 0xbf, 0x76, 0x6d, 0xbd, 0xa9, 0x5b,
+		0x75, 0xec, 0xdc, 0xcd, 0x9e, 0xaf, 0xc3, 0x41, 0xdf, 0x2a, 0xa9, 0x59, 0x09, 0xf5, 0xfe, 0xc4,
+		0x9d, 0xdb, 0x33, 0xc7, 0xc2, 0xbd, 0xf7, 0xa1, 0x29, 0xea, 0xa4, 0xb7, 0x5e, 0x6a, 0x56, 0x3a,
+		0x72, 0xad, 0xda, 0x8e, 0xcb, 0x35, 0xda, 0x9d, 0xab, 0x0f, 0xcd, 0xcf, 0x35, 0x6f, 0x3b, 0x9d,
+		0x55, 0x5b, 0x82, 0x61, 0x4f, 0x25, 0x36, 0xca, 0x43, 0xd2, 0xe1, 0x7f, 0x73, 0x0d, 0x2b, 0xdd,
+		0x35, 0x2c, 0xc8, 0xb8, 0x96, 0x5d, 0x4a, 0xe5, 0xaf, 0x25, 0x00, 0xcf, 0x66, 0xdf, 0x9c, 0x26,
+		0x46, 0x5c, 0x39, 0x77, 0xbc, 0xd1, 0x7d, 0xa5, 0x6a, 0x9c, 0x3a, 0xa4, 0xcf, 0xf7, 0x45, 0x60,
+		0x72, 0x43, 0x78, 0x9e, 0x37, 0xbd, 0x0e, 0xd6, 0x20, 0x81, 0x0d, 0xa7, 0xa9, 0x53, 0x25, 0x90,
+		0xd1, 0x7e, 0xbc, 0xdb, 0x68, 0x77, 0xe8, 0x13, 0xfd, 0xae, 0xb6, 0xd8, 0x74, 0xe7, 0x6c, 0x42,
+		0xda, 0xf8, 0xc1, 0x28, 0xa4, 0xbb, 0x51, 0xa2, 0x05, 0x18, 0xaf, 0x34, 0x31, 0xbb, 0x74, 0xe5,
+		0xdf, 0xf9, 0xcb, 0x65, 0xbc, 0xcc, 0x32, 0x84, 0xa0, 0xa8, 0x63, 0x02, 0xc2, 0xa3, 0x47, 0x0d,
+		0x48, 0xda, 0x47, 0xcc, 0x8e, 0xde, 0xdd, 0x1a, 0x2c, 0xcf, 0x53, 0x78, 0xf8, 0x10, 0x8d, 0x04,
+		0x19, 0xb0, 0xf8, 0x31, 0xe6, 0x41, 0x69, 0x00, 0x79, 0x07, 0x8c, 0xeb, 0x86, 0xee, 0xe8, 0x5a,
+		0xbd, 0xbc, 0xa9, 0xd5, 0x35, 0xa3, 0xb2, 0x9f, 0xac, 0x99, 0xb9, 0x7c, 0xde, 0x6c, 0x88, 0x9d,
+		0xa2, 0x8e, 0x71, 0x48, 0x8e, 0x01, 0xd0, 0x65, 0x48, 0x88, 0xa6, 0x62, 0xfb, 0xca, 0x36, 0x04,
+		0xb9, 0x2f, 0xc1, 0x7b, 0x7f, 0x14, 0x26, 0x54, 0x5c, 0xfd, 0x7f, 0x43, 0xb1, 0xb7, 0xa1, 0x58,
+		0x06, 0x60, 0xd3, 0x9d, 0x38, 0xd8, 0x7d, 0x8c, 0x06, 0x71, 0x18, 0x29, 0xc6, 0x21, 0x6f, 0x3b,
+		0xbe, 0xf1, 0xb8, 0x1d, 0x81, 0x11, 0xff, 0x78, 0xfc, 0x3d, 0x8d, 0x4a, 0xa8, 0xe8, 0x79, 0xa2,
+		0x18, 0xff, 0xa5, 0xa2, 0x2e, 0x9e, 0xa8, 0xcd, 0x7a, 0x7b, 0xbb, 0xa0, 0x5f, 0x8f, 0x41, 0x7c,
+		0x4d, 0x6b, 0x6a, 0x0d, 0x1b, 0x55, 0xda, 0x32, 0x4d, 0xb1, 0xfd, 0xd8, 0xf6, 0x03, 0x83, 0x7c,
+		0xb7, 0xa3, 0x4f, 0xa2, 0xf9, 0x7a, 0x87, 0x44, 0xf3, 0x3b, 0x60, 0x8c, 0x2c, 0x87, 0x7d, 0x57,
+		0x18, 0x88, 0xb6, 0x47, 0x73, 0x47, 0x3c, 0x2e, 0xc1, 0x7a, 0xb6, 0x5a, 0xbe, 0xe6, 0xbf, 0xc3,
+		0x30, 0x4c, 0x30, 0x3c, 0xc7, 0x4c, 0xc8, 0x0f, 0x79, 0xcb, 0x52, 0x5f, 0xa5, 0xa2, 0x42, 0x43,
+		0xbb, 0x59, 0x60, 0x05, 0xb4, 0x04, 0x68, 0xdb, 0xdd, 0x19, 0x29, 0x7b, 0xea, 0x24, 0xf4, 0xc7,
+		0xef, 0xdc, 0x9e, 0x39, 0xc2, 0xe8, 0xdb, 0x71, 0x14, 0x75, 0xc2, 0x03, 0x0a, 0x6e, 0x4f, 0x02,
+		0x90, 0x7e, 0x95, 0xd9, 0xf5, 0x6d, 0xb6, 0xdc, 0x39, 0x78, 0xe7, 0xf6, 0xcc, 0x04, 0xe3, 0xe2,
+		0xd5, 0x29, 0x6a, 0x8a, 0x14, 0xf2, 0xf4, 0x66, 0xf7, 0xdb, 0x21, 0x4d, 0xe4, 0x23, 0xc9, 0x32,
+		0xae, 0x96, 0x6b, 0xe6, 0x4e, 0xd9, 0xe2, 0xdb, 0x65, 0x6c, 0x81, 0x33, 0x9a, 0xbb, 0xff, 0xce,
+		0xed, 0x99, 0x19, 0xaf, 0x27, 0x9d, 0x30, 0x15, 0xf5, 0x60, 0x43, 0xbb, 0xb9, 0x4c, 0x6b, 0x16,
+		0xcd, 0x1d, 0xb1, 0xe1, 0x66, 0xa3, 0x4d, 0xc8, 0x04, 0x76, 0xa3, 0xcb, 0xd8, 0x32, 0x2b, 0xdb,
+		0xe5, 0x3a, 0x36, 0x6a, 0x0e, 0x3b, 0xfa, 0x8c, 0xe5, 0x1e, 0xbc, 0x73, 0x7b, 0xe6, 0xbe, 0x36,
+		0xb3, 0x0e, 0xe1, 0x2a, 0xea, 0x61, 0xff, 0x0e, 0x75, 0x81, 0x54, 0x2d, 0xd1, 0x1a, 0xdf, 0xdc,
+		0xfc, 0x84, 0x04, 0xc8, 0x0b, 0x5a, 0x2a, 0xb6, 0x2d, 0xb2, 0xc2, 0x24, 0x4b, 0x09, 0x5f, 0xde,
+		0x2f, 0xf5, 0x5e, 0x4a, 0x78, 0xf4, 0x62, 0x29, 0xe1, 0x9b, 0xeb, 0x17, 0x3d, 0x07, 0x1f, 0xe9,
+		0x77, 0x1b, 0x9b, 0x1b, 0x79, 0xd8, 0xa3, 0x1f, 0x50, 0xfe, 0x95, 0x04, 0x47, 0xda, 0xe6, 0x84,
+		0x2b, 0xec, 0xff, 0x07, 0xa8, 0xe9, 0xab, 0xe4, 0x3f, 0x8e, 0xc1, 0x84, 0xde, 0xf3, 0x14, 0x9b,
+		0x68, 0xb6, 0x45, 0x8e, 0xbb, 0x17, 0xa3, 0xd8, 0x75, 0xff, 0x7f, 0x2e, 0xc1, 0x94, 0xbf, 0x79,
+		0xb7, 0x23, 0x2b, 0x30, 0xe2, 0x6f, 0x9d, 0x77, 0xe1, 0x81, 0x41, 0xba, 0xc0, 0xa5, 0x0f, 0xd0,
+		0xa3, 0x67, 0x3d, 0x87, 0xc3, 0x76, 0xff, 0xce, 0x0e, 0xac, 0x0d, 0x21, 0x53, 0xd8, 0xf1, 0xc4,
+		0xe8, 0x78, 0x7c, 0x4b, 0x82, 0xd8, 0x9a, 0x69, 0xd6, 0x91, 0x09, 0x13, 0x86, 0xe9, 0x94, 0xc9,
+		0xdc, 0xc0, 0x55, 0xff, 0xad, 0xfb, 0x54, 0x6e, 0x61, 0x6f, 0x4a, 0xfa, 0xda, 0xed, 0x99, 0x76,
+		0x56, 0xea, 0xb8, 0x61, 0x3a, 0x39, 0x0a, 0xe1, 0x17, 0xef, 0xbf, 0x1b, 0x46, 0x83, 0x8d, 0x31,
+		0x3f, 0xff, 0xdc, 0x9e, 0x1b, 0x0b, 0xb2, 0xb9, 0x73, 0x7b, 0x66, 0xca, 0x9b, 0xf3, 0x2e, 0x58,
+		0x51, 0x47, 0x36, 0x7d, 0xad, 0xb3, 0x0b, 0x6a, 0x7f, 0x45, 0xc6, 0xf0, 0xc7, 0xa2, 0x80, 0x16,
+		0x4c, 0xc3, 0xe6, 0x1b, 0x33, 0xe2, 0x3b, 0x65, 0x77, 0x6b, 0x37, 0xc9, 0x82, 0x71, 0x93, 0x7d,
+		0xf5, 0x7c, 0xa0, 0xcd, 0xa4, 0x39, 0x2f, 0xcc, 0x87, 0xc8, 0xba, 0xef, 0x25, 0x8d, 0x9a, 0xf4,
+		0x3b, 0xe9, 0x62, 0x27, 0xc9, 0x82, 0x71, 0x03, 0xdf, 0x08, 0xb4, 0x18, 0x1d, 0xac, 0xc5, 0x10,
+		0x59, 0x8f, 0x16, 0x0d, 0x7c, 0xc3, 0xd7, 0xa2, 0x77, 0x64, 0x1a, 0xeb, 0x78, 0x27, 0x65, 0x68,
+		0xaf, 0x77, 0x52, 0xe6, 0x93, 0xaf, 0x0a, 0x87, 0xf1, 0x0b, 0x12, 0xc8, 0xc4, 0xab, 0x6a, 0x4d,
+		0x47, 0xaf, 0xe8, 0x96, 0x9b, 0x76, 0xb4, 0x2f, 0x2a, 0xa4, 0x7d, 0x2e, 0xac, 0x64, 0xee, 0xd4,
+		0x3d, 0xd7, 0xcf, 0x62, 0xa0, 0x6f, 0x9c, 0xc3, 0x18, 0x8a, 0x3a, 0xce, 0x40, 0xae, 0xb3, 0xf7,
+		0x49, 0xfc, 0x31, 0x09, 0x64, 0x37, 0x40, 0x2e, 0x9a, 0x3b, 0xf4, 0x72, 0xc6, 0x53, 0x30, 0x2c,
+		0xa8, 0xc5, 0x49, 0x62, 0xcc, 0x1f, 0x26, 0x7d, 0x95, 0x0a, 0x7d, 0x9e, 0x4b, 0x4b, 0xc5, 0xea,
+		0xdd, 0xdc, 0xa6, 0x70, 0x45, 0x3c, 0xfd, 0xab, 0x12, 0x80, 0xb7, 0x5f, 0x88, 0x1e, 0x85, 0xc3,
+		0xb9, 0xd5, 0x95, 0x7c, 0xb9, 0xb4, 0x9e, 0x5d, 0xdf, 0x28, 0x05, 0x5f, 0x65, 0x88, 0x43, 0x2d,
+		0xdb, 0xc2, 0x15, 0xfa, 0x5b, 0x34, 0xe8, 0x24, 0x4c, 0x05, 0xb1, 0x49, 0xa9, 0x90, 0x97, 0xa5,
+		0xcc, 0xc8, 0x6b, 0xb7, 0x4e, 0x24, 0xd9, 0x0a, 0x0a, 0x57, 0xd1, 0x29, 0x38, 0xd8, 0x8e, 0x57,
+		0x5c, 0x59, 0x94, 0x23, 0x99, 0xd1, 0xd7, 0x6e, 0x9d, 0x48, 0xb9, 0x4b, 0x2d, 0xa4, 0x00, 0xf2,
+		0x63, 0x72, 0x7e, 0xd1, 0x0c, 0xbc, 0x76, 0xeb, 0x44, 0x9c, 0x39, 0x8d, 0x4c, 0xec, 0xd5, 0x4f,
+		0x4c, 0x1f, 0xb8, 0xeb, 0x6f, 0x37, 0xee, 0x40, 0xd7, 0xb3, 0xaa, 0x1a, 0x36, 0xb0, 0xad, 0xdb,
+		0xfb, 0x3a, 0xab, 0x1a, 0xe8, 0xfc, 0x4b, 0xf9, 0xa3, 0x24, 0x8c, 0x2c, 0xb2, 0x56, 0xd8, 0xef,
+		0xdf, 0xbe, 0x05, 0xe2, 0x16, 0x4d, 0xfe, 0xdc, 0xc3, 0xef, 0x2e, 0x4e, 0x9e, 0xa5, 0x88, 0xee,
+		0x0d, 0x4c, 0x96, 0x30, 0xda, 0xfc, 0x0a, 0x16, 0xbb, 0x19, 0xea, 0xdd, 0x75, 0x1c, 0xd9, 0xd3,
+		0x2e, 0x2d, 0x5b, 0x69, 0x70, 0xbb, 0x0f, 0xf3, 0x53, 0xd8, 0x6d, 0xae, 0x75, 0x02, 0x61, 0x77,
+		0x3a, 0xdf, 0x2d, 0xc1, 0x41, 0x8a, 0xe5, 0x59, 0x20, 0xc5, 0x14, 0x4b, 0xf4, 0xd3, 0xdd, 0xba,
+		0xb0, 0xa4, 0xd9, 0xde, 0x0d, 0x2d, 0x76, 0x0b, 0xf3, 0x01, 0x9e, 0xbe, 0x1e, 0xf3, 0x35, 0x1e,
+		0x66, 0xab, 0xa8, 0x93, 0xf5, 0x36, 0x4a, 0x1b, 0x2d, 0x06, 0xae, 0xe1, 0xc6, 0xf6, 0x76, 0x40,
+		0xe6, 0xbf, 0x92, 0x7b, 0x05, 0x86, 0xbd, 0xf8, 0x69, 0xf3, 0x5f, 0x2b, 0x1f, 0x3c, 0x5f, 0xf2,
+		0x13, 0xa3, 0xf7, 0x48, 0x70, 0xd0, 0xcb, 0xc1, 0xfd, 0x6c, 0xd9, 0xaf, 0xba, 0x3f, 0xb2, 0x87,
+		0xed, 0x8b, 0xb0, 0x72, 0x3a, 0xf2, 0x55, 0xd4, 0xa9, 0x56, 0x3b, 0xa9, 0x8d, 0xd6, 0x60, 0xd4,
+		0x9f, 0x4d, 0xd8, 0x69, 0xf1, 0xd9, 0xfd, 0xc1, 0xd3, 0x91, 0x20, 0x03, 0xf6, 0xc3, 0xc4, 0x96,
+		0xd9, 0x74, 0x70, 0x95, 0x6e, 0xa3, 0x27, 0x55, 0xb7, 0x4c, 0x4d, 0xc2, 0x17, 0x45, 0xca, 0x4d,
+		0x1e, 0x52, 0x6d, 0xfe, 0x0b, 0xe9, 0xa7, 0xbb, 0x6f, 0x64, 0x87, 0xa3, 0x70, 0xb8, 0xd7, 0x1d,
+		0xd9, 0x2a, 0xea, 0x64, 0xc5, 0x8d, 0x45, 0x82, 0xd2, 0x46, 0x2f, 0x03, 0xa2, 0x69, 0xba, 0x3f,
+		0x70, 0xd8, 0xf4, 0xe7, 0xd4, 0x87, 0xe7, 0x4e, 0x75, 0x13, 0x21, 0x1c, 0x69, 0xdc, 0x25, 0x15,
+		0x5f, 0x8d, 0xb4, 0x73, 0x54, 0xd4, 0x89, 0x5a, 0x88, 0x88, 0xe4, 0x36, 0xde, 0xb2, 0x91, 0x2e,
+		0x16, 0x76, 0x4c, 0x07, 0xdb, 0xe9, 0xe1, 0xde, 0x8d, 0x87, 0x83, 0x86, 0xbb, 0xdd, 0x90, 0x09,
+		0x3b, 0x79, 0x97, 0xa5, 0xa2, 0x7a, 0xd1, 0x81, 0x53, 0xd9, 0xca, 0x0a, 0xa0, 0xf6, 0xd9, 0x15,
+		0xbe, 0xf7, 0xed, 0x3d, 0xe9, 0x43, 0x53, 0x30, 0xe4, 0xbf, 0x19, 0xcd, 0x0a, 0x5e, 0xb4, 0xb8,
+		0xeb, 0x4e, 0xf7, 0x8b, 0x11, 0x38, 0xed, 0x3f, 0x55, 0x7f, 0x47, 0x0b, 0x37, 0x77, 0x5d, 0x1f,
+		0x69, 0x69, 0x35, 0xdd, 0xf0, 0x3f, 0x1e, 0x3b, 0xe2, 0x5f, 0x65, 0x50, 0x5c, 0xa1, 0x31, 0xe5,
+		0x55, 0x09, 0x86, 0xd7, 0xb4, 0x1a, 0x56, 0xf1, 0x3b, 0x5a, 0xd8, 0x76, 0x3a, 0x3c, 0xce, 0x39,
+		0x04, 0x71, 0x73, 0x6b, 0x4b, 0x5c, 0x05, 0x8a, 0xa9, 0xbc, 0x44, 0xfa, 0x5c, 0xd7, 0x1b, 0x3a,
+		0xbb, 0x45, 0x1b, 0x53, 0x59, 0x01, 0xcd, 0xc0, 0x70, 0xc5, 0x6c, 0x19, 0xdc, 0xe7, 0xa5, 0x63,
+		0xe2, 0xf3, 0x54, 0x2d, 0x83, 0xf9, 0x3c, 0xa2, 0xc4, 0x26, 0xde, 0xc1, 0x4d, 0x1b, 0xf3, 0xdf,
+		0x3c, 0x17, 0x45, 0xe5, 0x19, 0x18, 0x61, 0x92, 0xf0, 0x15, 0xc0, 0x11, 0x48, 0xd2, 0x0b, 0xaa,
+		0x9e, 0x3c, 0x09, 0x52, 0xbe, 0xca, 0x9e, 0xf8, 0x30, 0xfe, 0x4c, 0x24, 0x56, 0xc8, 0xe5, 0xba,
+		0x6a, 0xf9, 0x54, 0x7f, 0xb7, 0xcd, 0x74, 0xe8, 0x6a, 0xf8, 0x77, 0x86, 0xe0, 0x20, 0xbf, 0xf3,
+		0xa0, 0x59, 0xfa, 0x99, 0x6d, 0xc7, 0x11, 0x4f, 0xce, 0x80, 0xe7, 0x60, 0x9a, 0xa5, 0x2b, 0xbb,
+		0x10, 0xbb, 0xec, 0x38, 0x16, 0x3a, 0x0d, 0x43, 0xcd, 0x56, 0x1d, 0x8b, 0x3d, 0x74, 0x37, 0x4d,
+		0xd4, 0x2c, 0x7d, 0x96, 0x20, 0xa8, 0xad, 0x3a, 0x56, 0x19, 0x0a, 0x2a, 0xc0, 0xcc, 0x56, 0xab,
+		0x5e, 0xdf, 0x2d, 0x57, 0x31, 0xfd, 0xfa, 0xb0, 0xfb, 0xb3, 0xc6, 0xf8, 0xa6, 0xa5, 0x89, 0xdf,
+		0x6a, 0x20, 0x8a, 0x39, 0x46, 0xd1, 0xf2, 0x14, 0x4b, 0xfc, 0xa4, 0x71, 0x41, 0xe0, 0x28, 0x7f,
+		0x1a, 0x81, 0xa4, 0x60, 0x4d, 0xdf, 0xdc, 0xe0, 0x3a, 0xae, 0x38, 0xa6, 0x38, 0x83, 0x76, 0xcb,
+		0x08, 0x41, 0xb4, 0xc6, 0x07, 0x2f, 0x75, 0xf9, 0x80, 0x4a, 0x0a, 0x04, 0xe6, 0xbe, 0x84, 0x22,
+		0x30, 0xab, 0x45, 0xc6, 0x33, 0x66, 0x99, 0x62, 0xb3, 0xeb, 0xf2, 0x01, 0x95, 0x96, 0x50, 0x1a,
+		0xe2, 0xc4, 0x6b, 0x39, 0x6c, 0xb4, 0x08, 0x9c, 0x97, 0xd1, 0x21, 0x18, 0xb2, 0x34, 0xa7, 0xc2,
+		0x2e, 0x29, 0x93, 0x0a, 0x56, 0x44, 0x4f, 0x41, 0x9c, 0x7d, 0xc8, 0x22, 0xfc, 0x8b, 0xe7, 0x44,
+		0x19, 0xec, 0x8b, 0xa1, 0x44, 0xee, 0x35, 0xcd, 0x71, 0x70, 0xd3, 0x20, 0x0c, 0x19, 0x3a, 0x42,
+		0x10, 0xdb, 0x34, 0xab, 0xbb, 0xfc, 0x57, 0xd8, 0xe9, 0xdf, 0xfc, 0x67, 0x9f, 0xa9, 0x3d, 0x94,
+		0x69, 0xe5, 0x08, 0xfb, 0xf2, 0x81, 0x00, 0xe6, 0x08, 0x52, 0x01, 0x26, 0xb5, 0x2a, 0xfb, 0x62,
+		0xb0, 0x56, 0x2f, 0x6f, 0xea, 0xd4, 0x7b, 0x0b, 0x5f, 0xd1, 0x79, 0x2c, 0x90, 0x47, 0x90, 0xe3,
+		0xf8, 0xb9, 0x14, 0x24, 0x2c, 0x26, 0x94, 0xf2, 0x34, 0x4c, 0xb4, 0x49, 0x4a, 0xe4, 0xbb, 0xae,
+		0x1b, 0x55, 0xf1, 0x3c, 0x8c, 0xfc, 0x4d, 0x60, 0xf4, 0x5b, 0xcc, 0xec, 0x74, 0x9f, 0xfe, 0x9d,
+		0x7b, 0x57, 0xf7, 0x57, 0x84, 0x63, 0xbe, 0x57, 0x84, 0x9a, 0xa5, 0xe7, 0x52, 0x94, 0x3f, 0x7f,
+		0x3b, 0x98, 0x6d, 0x7f, 0x3b, 0x58, 0xc3, 0x86, 0xc8, 0x8c, 0x48, 0x95, 0x66, 0xe9, 0x36, 0x35,
+		0x47, 0xef, 0xe3, 0xd0, 0xf6, 0xd3, 0xbe, 0xbf, 0xe9, 0x53, 0xc2, 0xd8, 0x62, 0x76, 0xad, 0xe8,
+		0xda, 0xf1, 0x6f, 0x47, 0xe0, 0x98, 0xcf, 0x8e, 0x7d, 0xc8, 0xed, 0xe6, 0x9c, 0xe9, 0x6c, 0xf1,
+		0x03, 0x7c, 0xce, 0xe1, 0x2a, 0xc4, 0x08, 0x3e, 0xea, 0xf3, 0xa3, 0xcc, 0xe9, 0x5f, 0xfc, 0xdc,
+		0x6f, 0x2a, 0xc1, 0x85, 0x54, 0x60, 0x54, 0x28, 0x93, 0xdc, 0x7b, 0x06, 0xd7, 0x9f, 0xec, 0x7d,
+		0x74, 0xda, 0xbe, 0x7b, 0x6a, 0x0c, 0xeb, 0xf0, 0xb7, 0x2e, 0x76, 0x7d, 0xee, 0xcf, 0x9c, 0x69,
+		0xef, 0x04, 0x77, 0x0f, 0x9e, 0xba, 0xdb, 0x8b, 0xaa, 0x5e, 0x23, 0x38, 0x60, 0xaa, 0x7c, 0x13,
+		0x0e, 0x3d, 0x4b, 0xda, 0xf6, 0x36, 0x1e, 0x85, 0xcb, 0x3f, 0xe4, 0xde, 0x8f, 0x60, 0x96, 0xed,
+		0xdd, 0x7d, 0x00, 0x4f, 0x3e, 0xbe, 0xe4, 0x3e, 0x39, 0xdb, 0x35, 0x94, 0xcc, 0xfa, 0xc2, 0x88,
+		0xea, 0xa3, 0x54, 0x7e, 0x56, 0x82, 0xc3, 0x6d, 0x4d, 0x73, 0x1f, 0xbf, 0xd8, 0xe1, 0xf1, 0xd7,
+		0xbe, 0xb2, 0xce, 0xc5, 0x0e, 0xc2, 0x3e, 0xd4, 0x57, 0x58, 0x26, 0x45, 0x40, 0xda, 0xb7, 0xc1,
+		0xc1, 0xa0, 0xb0, 0x42, 0x4d, 0x0f, 0xc2, 0x58, 0x70, 0x8d, 0xc8, 0xd5, 0x35, 0x1a, 0x58, 0x25,
+		0x2a, 0xe5, 0xb0, 0x9e, 0xdd, 0xbe, 0x16, 0x20, 0xe5, 0xa2, 0xf2, 0xe5, 0xc9, 0xc0, 0x5d, 0xf5,
+		0x28, 0x95, 0x0f, 0x4a, 0x70, 0x22, 0xd8, 0x82, 0x2f, 0x51, 0xdd, 0x9b, 0xb0, 0x77, 0x6d, 0x88,
+		0xdf, 0x90, 0xe0, 0xbe, 0x1e, 0x32, 0x71, 0x05, 0xbc, 0x0c, 0x53, 0xbe, 0x9d, 0x49, 0xe1, 0xc2,
+		0xc5, 0xb0, 0x9f, 0xee, 0xbf, 0x44, 0x70, 0x37, 0xe2, 0x8e, 0x12, 0xa5, 0x7c, 0xea, 0x8b, 0x33,
+		0x93, 0xed, 0x75, 0xb6, 0x3a, 0xd9, 0xbe, 0x9b, 0x78, 0x17, 0xed, 0xe3, 0x23, 0x12, 0x3c, 0x1c,
+		0xec, 0x6a, 0x87, 0xb5, 0xc6, 0xdf, 0xd6, 0x38, 0xfc, 0x7b, 0x09, 0x4e, 0x0f, 0x22, 0x1c, 0x1f,
+		0x90, 0x4d, 0x98, 0xf4, 0x56, 0x41, 0xe1, 0xf1, 0xd8, 0xd3, 0xda, 0x8a, 0x59, 0x29, 0x72, 0xb9,
+		0xdd, 0x03, 0xc5, 0x5b, 0x7c, 0x62, 0xf9, 0x87, 0xdc, 0x55, 0x72, 0xf0, 0x7c, 0x4c, 0x28, 0x39,
+		0x70, 0x42, 0xd6, 0x61, 0x2c, 0x22, 0x1d, 0xc6, 0xc2, 0xb7, 0x0d, 0xb5, 0xc3, 0xfd, 0x56, 0x87,
+		0x33, 0x81, 0xef, 0x84, 0xc9, 0x0e, 0xa6, 0xcc, 0x67, 0xf5, 0x1e, 0x2c, 0x59, 0x45, 0xed, 0xc6,
+		0xaa, 0xec, 0xc2, 0x0c, 0x6d, 0xb7, 0x83, 0xa2, 0xef, 0x75, 0x97, 0x1b, 0xdc, 0xb7, 0x74, 0x6c,
+		0x9a, 0xf7, 0xbd, 0x08, 0x71, 0x36, 0xce, 0xbc, 0xbb, 0xfb, 0x30, 0x14, 0xce, 0x40, 0xf9, 0x51,
+		0xe1, 0xcb, 0xf2, 0x42, 0xec, 0xce, 0x73, 0x68, 0x90, 0xbe, 0xde, 0xa5, 0x39, 0xe4, 0x53, 0xc6,
+		0x17, 0x84, 0x57, 0xeb, 0x2c, 0x1d, 0x57, 0x47, 0xe5, 0xae, 0x79, 0x35, 0xa6, 0x9b, 0x7b, 0xeb,
+		0xbe, 0x7e, 0x52, 0xb8, 0x2f, 0xb7, 0x4f, 0x7d, 0xdc, 0xd7, 0xdf, 0x8e, 0xea, 0x5d, 0x47, 0xd6,
+		0x47, 0xcc, 0xbf, 0x8b, 0x8e, 0xec, 0xaf, 0x24, 0x38, 0x42, 0xfb, 0xe6, 0xdf, 0x24, 0xda, 0xab,
+		0xca, 0x1f, 0x05, 0x64, 0x37, 0x2b, 0xe5, 0x8e, 0xb3, 0x5b, 0xb6, 0x9b, 0x95, 0x6b, 0x81, 0xf8,
+		0xf2, 0x28, 0xa0, 0x6a, 0x60, 0x2b, 0x90, 0x62, 0xb3, 0x7b, 0xc7, 0x72, 0xd5, 0xb7, 0xd1, 0xd1,
+		0x61, 0x38, 0x63, 0x77, 0x61, 0x38, 0x3f, 0x2f, 0x41, 0xa6, 0x53, 0x97, 0xf9, 0xf0, 0xe9, 0x70,
+		0x28, 0x70, 0x68, 0x19, 0x1e, 0xc1, 0x47, 0x07, 0xd9, 0x66, 0x0b, 0x4d, 0xa3, 0x83, 0x4d, 0x7c,
+		0xaf, 0xf3, 0x80, 0x99, 0xa0, 0x85, 0xb6, 0x67, 0xd6, 0x7f, 0x6b, 0xd3, 0xe7, 0x33, 0x6d, 0x7e,
+		0xf5, 0xef, 0x44, 0xee, 0x7d, 0x13, 0xa6, 0xbb, 0x48, 0x7d, 0xaf, 0xe3, 0xde, 0x76, 0xd7, 0xc1,
+		0xbc, 0xdb, 0xe9, 0xfb, 0x93, 0x7c, 0x26, 0x04, 0xdf, 0xb4, 0xf8, 0xd6, 0x62, 0x9d, 0x1e, 0xc5,
+		0x2a, 0x2f, 0xc0, 0xd1, 0x8e, 0x54, 0x5c, 0xb6, 0x79, 0x88, 0x6d, 0xeb, 0xb6, 0xc3, 0xc5, 0x3a,
+		0xd9, 0x4d, 0xac, 0x10, 0x35, 0xa5, 0x51, 0x10, 0xc8, 0x94, 0xf5, 0x9a, 0x69, 0xd6, 0xb9, 0x18,
+		0xca, 0x55, 0x98, 0xf0, 0xc1, 0x78, 0x23, 0xe7, 0x21, 0x66, 0x99, 0xfc, 0x83, 0x2f, 0xc3, 0x73,
+		0xc7, 0xba, 0x9e, 0xac, 0x98, 0x66, 0x9d, 0x77, 0x9b, 0xe2, 0x2b, 0x53, 0x80, 0x18, 0x33, 0x7a,
+		0xc8, 0x22, 0x9a, 0x28, 0xc1, 0x64, 0x00, 0xca, 0x1b, 0xf9, 0xb6, 0x0e, 0x70, 0x94, 0x15, 0x78,
+		0x30, 0x98, 0xfe, 0x86, 0x77, 0xa3, 0xf7, 0xb8, 0x98, 0xbb, 0x25, 0xc1, 0xc9, 0x7e, 0x0c, 0xdd,
+		0x8c, 0x70, 0xa2, 0x6d, 0x6b, 0x9b, 0xf7, 0x61, 0xf0, 0xbd, 0x72, 0xd6, 0x1b, 0x39, 0xbc, 0x1d,
+		0x4e, 0xcc, 0x42, 0xa3, 0xaf, 0xf6, 0xf8, 0xd6, 0x20, 0x2f, 0xcd, 0xfd, 0xf1, 0x61, 0x18, 0xa2,
+		0xf2, 0xa1, 0x0f, 0x4b, 0x81, 0xaf, 0xcf, 0xcd, 0x76, 0x6b, 0xb2, 0xf3, 0x1e, 0x40, 0xe6, 0xcc,
+		0xc0, 0xf8, 0x3c, 0x47, 0x3d, 0xfd, 0xae, 0x7f, 0xf3, 0x67, 0x1f, 0x8a, 0x3c, 0x80, 0x94, 0x33,
+		0x5d, 0x76, 0x1f, 0x7c, 0xfe, 0xe1, 0x93, 0x81, 0xaf, 0xa7, 0x3c, 0x36, 0x58, 0x53, 0x42, 0xb2,
+		0xd9, 0x41, 0xd1, 0xb9, 0x60, 0x4f, 0x53, 0xc1, 0xce, 0xa1, 0x27, 0xfa, 0x0b, 0x76, 0xe6, 0x9d,
+		0x41, 0x1b, 0xf8, 0x1e, 0xf4, 0x6f, 0x25, 0x98, 0xea, 0xb4, 0x84, 0x45, 0x17, 0x06, 0x93, 0xa2,
+		0x3d, 0x85, 0xca, 0x5c, 0xdc, 0x07, 0x25, 0xef, 0xca, 0x22, 0xed, 0x4a, 0x16, 0x3d, 0xb3, 0x8f,
+		0xae, 0x9c, 0xf1, 0x9f, 0x35, 0xfd, 0x2f, 0x09, 0x8e, 0xf7, 0x5c, 0x11, 0xa2, 0xec, 0x60, 0x52,
+		0xf6, 0xc8, 0x15, 0x33, 0xb9, 0x6f, 0x87, 0x05, 0xef, 0xf1, 0xb3, 0xb4, 0xc7, 0x57, 0x51, 0x71,
+		0x3f, 0x3d, 0xee, 0x78, 0xa0, 0x87, 0x7e, 0x37, 0x78, 0x37, 0xbd, 0xb7, 0x39, 0xb5, 0x2d, 0xb4,
+		0xfa, 0x4c, 0x8c, 0xf6, 0x24, 0x5e, 0x79, 0x9e, 0x76, 0x41, 0x45, 0x6b, 0xdf, 0xe6, 0xa0, 0x9d,
+		0x79, 0x67, 0x30, 0xd0, 0x7d, 0x0f, 0xfa, 0x9f, 0x52, 0xe7, 0xab, 0xe6, 0x4f, 0xf5, 0x14, 0xb1,
+		0xfb, 0x22, 0x32, 0x73, 0x61, 0xef, 0x84, 0xbc, 0x93, 0x0d, 0xda, 0xc9, 0x1a, 0xc2, 0x77, 0xbb,
+		0x93, 0x1d, 0x07, 0x11, 0x7d, 0x56, 0x82, 0xa9, 0x4e, 0x6b, 0xb0, 0x3e, 0xd3, 0xb2, 0xc7, 0xa2,
+		0xb2, 0xcf, 0xb4, 0xec, 0xb5, 0xe0, 0x53, 0xde, 0x42, 0x3b, 0x7f, 0x1e, 0x3d, 0xd9, 0xad, 0xf3,
+		0x3d, 0x47, 0x91, 0xcc, 0xc5, 0x9e, 0x8b, 0x9a, 0x3e, 0x73, 0x71, 0x90, 0x75, 0x5b, 0x9f, 0xb9,
+		0x38, 0xd0, 0x9a, 0xaa, 0xff, 0x5c, 0x74, 0x7b, 0x36, 0xe0, 0x30, 0xda, 0xe8, 0xb7, 0x25, 0x18,
+		0x0d, 0xac, 0x00, 0xd0, 0xd9, 0x9e, 0x82, 0x76, 0x5a, 0x20, 0x65, 0xe6, 0xf6, 0x42, 0xc2, 0xfb,
+		0x52, 0xa4, 0x7d, 0x59, 0x40, 0xd9, 0xfd, 0xf4, 0x25, 0x78, 0x6e, 0xff, 0x79, 0x09, 0x26, 0x3b,
+		0x64, 0xd5, 0x7d, 0x66, 0x61, 0xf7, 0x45, 0x42, 0xe6, 0xc2, 0xde, 0x09, 0x79, 0xaf, 0x2e, 0xd1,
+		0x5e, 0x7d, 0x07, 0x7a, 0xdb, 0x7e, 0x7a, 0xe5, 0x8b, 0xcf, 0xb7, 0xbd, 0x7b, 0xaf, 0xbe, 0x76,
+		0xd0, 0xf9, 0x3d, 0x0a, 0x26, 0x3a, 0xf4, 0xd4, 0x9e, 0xe9, 0x78, 0x7f, 0x9e, 0xa3, 0xfd, 0x79,
+		0x16, 0xad, 0x7e, 0x7b, 0xfd, 0x69, 0x0f, 0xeb, 0xbf, 0xd2, 0xfe, 0x86, 0xbc, 0xb7, 0x15, 0x75,
+		0x4c, 0xce, 0x33, 0x4f, 0xec, 0x89, 0x86, 0x77, 0xea, 0x02, 0xed, 0xd4, 0x1c, 0x7a, 0xbc, 0x5b,
+		0xa7, 0x7c, 0xd7, 0xb3, 0x75, 0x63, 0xcb, 0x3c, 0xf3, 0x4e, 0x96, 0xf2, 0x7f, 0x0f, 0xfa, 0x3e,
+		0x71, 0xb1, 0xf4, 0x54, 0xcf, 0x76, 0x7d, 0x79, 0x7b, 0xe6, 0xe1, 0x01, 0x30, 0xb9, 0x5c, 0x0f,
+		0x50, 0xb9, 0xa6, 0xd1, 0xb1, 0x6e, 0x72, 0x91, 0xdc, 0x1d, 0xbd, 0x57, 0x72, 0x6f, 0xd3, 0x9f,
+		0xee, 0xcd, 0xdb, 0x9f, 0xdc, 0x67, 0x1e, 0x19, 0x08, 0x97, 0x4b, 0x72, 0x92, 0x4a, 0x72, 0x02,
+		0x4d, 0x77, 0x95, 0x84, 0x09, 0xf0, 0x55, 0x09, 0x8e, 0x74, 0xcd, 0xc3, 0xd1, 0x5b, 0x07, 0x4b,
+		0x3f, 0xba, 0x2c, 0x08, 0x32, 0x6f, 0xdb, 0x2f, 0x39, 0xef, 0xc4, 0x32, 0xed, 0xc4, 0x22, 0x2a,
+		0xec, 0x27, 0x22, 0xb6, 0x2d, 0x1c, 0xee, 0xfa, 0x85, 0x91, 0xcf, 0x2a, 0x30, 0xd3, 0x45, 0x30,
+		0xe7, 0x66, 0x9f, 0xf3, 0xcb, 0x1e, 0x9f, 0x8d, 0xe8, 0xfb, 0x59, 0x88, 0xbb, 0xfd, 0xa9, 0xf3,
+		0x01, 0x0f, 0x3b, 0xff, 0x20, 0x06, 0x68, 0xd9, 0xae, 0x2d, 0x34, 0xb1, 0xe6, 0xf8, 0x3e, 0x7f,
+		0x18, 0x7a, 0x0f, 0x2d, 0x7d, 0x5b, 0xef, 0xa1, 0x97, 0x03, 0x2f, 0x8c, 0x23, 0x7b, 0xfb, 0x8a,
+		0xc1, 0xc0, 0xcf, 0x8c, 0xa3, 0x7f, 0x23, 0xcf, 0x8c, 0x3b, 0xbf, 0x42, 0x8a, 0xdd, 0xbd, 0xe7,
+		0x8a, 0x43, 0xfb, 0x7d, 0xb2, 0xc9, 0xaf, 0x5f, 0xc7, 0x7b, 0x5c, 0xbf, 0x4e, 0x77, 0xbd, 0x64,
+		0xcd, 0xa9, 0xd1, 0x39, 0xf1, 0x39, 0xf0, 0xc4, 0x60, 0xaf, 0x2e, 0x18, 0xb6, 0x6f, 0x7b, 0xe8,
+		0x18, 0x64, 0xda, 0xcd, 0xc9, 0x9d, 0xfb, 0x1f, 0x8a, 0x82, 0xbc, 0x6c, 0xd7, 0x0a, 0x55, 0xdd,
+		0xb9, 0x47, 0xb6, 0xf6, 0x4c, 0xf7, 0x2b, 0xcc, 0xe8, 0xce, 0xed, 0x99, 0x31, 0xa6, 0xd3, 0x1e,
+		0x9a, 0x6c, 0xc0, 0x78, 0xe8, 0xc3, 0x1b, 0xdc, 0xb2, 0xf2, 0xfb, 0xf9, 0xfe, 0x47, 0x88, 0x95,
+		0x42, 0x5f, 0xec, 0xf9, 0xec, 0x1b, 0xdd, 0xec, 0x6c, 0xcc, 0xcc, 0xa0, 0x2e, 0xdf, 0xcb, 0xf7,
+		0xf2, 0xde, 0x98, 0x65, 0x20, 0x1d, 0x1e, 0x14, 0x77, 0xc4, 0xfe, 0x5c, 0x82, 0xe1, 0x65, 0x5b,
+		0xa4, 0xbd, 0xf8, 0x4d, 0xfa, 0x5a, 0xf7, 0x29, 0xf7, 0x57, 0x33, 0xa2, 0x83, 0xd9, 0xad, 0xf8,
+		0x25, 0x0d, 0x4f, 0x09, 0x07, 0x61, 0xd2, 0xd7, 0x4f, 0xb7, 0xff, 0x7f, 0x18, 0xa1, 0xfe, 0x31,
+		0x87, 0x6b, 0xba, 0xe1, 0x66, 0xcc, 0xf8, 0xef, 0xeb, 0x5b, 0x44, 0x4f, 0xcf, 0xb1, 0xfd, 0xea,
+		0xf9, 0x3a, 0x75, 0x10, 0x21, 0x7d, 0xba, 0x7b, 0x83, 0xcb, 0xed, 0x2f, 0x65, 0xa5, 0x3d, 0x3c,
+		0xe8, 0x08, 0xbd, 0x87, 0x55, 0xde, 0x90, 0x60, 0x74, 0xd9, 0xae, 0x6d, 0x18, 0xd5, 0xff, 0xeb,
+		0xed, 0x77, 0x0b, 0x0e, 0x06, 0x7a, 0x7a, 0xaf, 0x54, 0xfa, 0x87, 0x12, 0x9d, 0x28, 0xf4, 0xee,
+		0x33, 0xf6, 0x6e, 0x51, 0xdf, 0xcd, 0x67, 0x32, 0x9b, 0x00, 0x06, 0xbe, 0x31, 0xc8, 0x0b, 0xa6,
+		0xc7, 0xbc, 0x57, 0x97, 0x1e, 0x45, 0xf7, 0xa7, 0x44, 0x29, 0x03, 0xdf, 0x60, 0x57, 0xb7, 0x7d,
+		0x8a, 0x3b, 0x0e, 0x47, 0x3b, 0xf4, 0xc7, 0x75, 0x00, 0xbf, 0x26, 0xc1, 0x61, 0x52, 0x8f, 0xf9,
+		0x6b, 0x3c, 0xff, 0xfe, 0xc3, 0x5d, 0x34, 0xa6, 0x45, 0x48, 0x38, 0x5a, 0xb3, 0x86, 0x1d, 0xf1,
+		0xe8, 0xee, 0xa1, 0xee, 0x27, 0x79, 0x5c, 0x92, 0x75, 0x8a, 0x2f, 0x9e, 0xda, 0x71, 0x6a, 0x5f,
+		0xc7, 0x7e, 0x5e, 0x82, 0xf1, 0x10, 0xf2, 0xdd, 0x7d, 0xcc, 0x14, 0xbf, 0xe1, 0x7d, 0xbb, 0x72,
+		0x1f, 0x5f, 0x89, 0x60, 0xd4, 0x3e, 0x81, 0x2d, 0x98, 0xe9, 0xa2, 0xe9, 0x7b, 0x65, 0xcc, 0xbf,
+		0x19, 0x81, 0x63, 0x24, 0x5d, 0x21, 0xcd, 0xd5, 0xff, 0xee, 0x7c, 0x9c, 0x62, 0xbf, 0xee, 0xa2,
+		0xd3, 0xd7, 0x0c, 0x62, 0x7b, 0xfd, 0x9a, 0x81, 0x6f, 0xc0, 0x4e, 0xc2, 0x03, 0xbd, 0xb4, 0x27,
+		0x46, 0x6d, 0xee, 0xa7, 0x12, 0x10, 0x5d, 0xb6, 0x6b, 0xe8, 0x1d, 0x30, 0x1e, 0x5e, 0x68, 0x74,
+		0x5d, 0x2b, 0xb7, 0x67, 0x91, 0xdd, 0xf7, 0xb3, 0xba, 0x67, 0x9c, 0xe8, 0x3a, 0x8c, 0x06, 0xb3,
+		0xcd, 0x53, 0x3d, 0x98, 0x04, 0x30, 0x33, 0x8f, 0x0f, 0x8a, 0xe9, 0x36, 0xf6, 0x76, 0x48, 0xba,
+		0x89, 0xd2, 0xfd, 0x3d, 0xa8, 0x05, 0x52, 0xf7, 0xd5, 0x7f, 0x87, 0x54, 0x84, 0x68, 0x2f, 0x9c,
+		0x86, 0xf4, 0xd2, 0x5e, 0x08, 0xb7, 0xa7, 0xf6, 0xba, 0x85, 0xe3, 0x4d, 0x00, 0x5f, 0xec, 0x7c,
+		0xb0, 0x07, 0x07, 0x0f, 0x2d, 0xf3, 0xd8, 0x40, 0x68, 0x6e, 0x1b, 0x0e, 0xc8, 0x6d, 0xc1, 0xa4,
+		0x97, 0x5e, 0xc2, 0xc8, 0xdd, 0x37, 0x9b, 0x7a, 0xb8, 0x75, 0xf4, 0x0a, 0x7d, 0x4d, 0xdd, 0xc1,
+		0xa7, 0x9f, 0xe9, 0xc5, 0xad, 0x03, 0x41, 0xf7, 0x4d, 0xbc, 0x7e, 0xbe, 0xec, 0x07, 0x25, 0x38,
+		0xd2, 0xdd, 0xf3, 0x3c, 0xd9, 0xcb, 0xd8, 0xbb, 0x51, 0x65, 0xde, 0xb2, 0x1f, 0x2a, 0xf7, 0x3e,
+		0xc8, 0x5d, 0xde, 0x4b, 0xf9, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x0c, 0x7d, 0xe2, 0x61,
+		0xb8, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2084,6 +2514,20 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.BondDenom != that1.BondDenom {
 		return false
 	}
+	if this.MaxMissedGovProposals != that1.MaxMissedGovProposals {
+		return false
+	}
+	if this.ValidatorSetEpochLength != that1.ValidatorSetEpochLength {
+		return false
+	}
+	if len(this.LockupTiers) != len(that1.LockupTiers) {
+		return false
+	}
+	for i := range this.LockupTiers {
+		if !this.LockupTiers[i].Equal(&that1.LockupTiers[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2140,6 +2584,33 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *LockupTier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LockupTier)
+	if !ok {
+		that2, ok := that.(LockupTier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	if !this.RewardMultiplier.Equal(that1.RewardMultiplier) {
+		return false
+	}
+	return true
+}
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2934,6 +3405,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockupTiers) > 0 {
+		for iNdEx := len(m.LockupTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ValidatorSetEpochLength != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.ValidatorSetEpochLength))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxMissedGovProposals != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxMissedGovProposals))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
@@ -3143,55 +3638,296 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ConsPubKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *HistoricalInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ConsPubKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsPubKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Header.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Valset) > 0 {
-		for _, e := range m.Valset {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintStaking(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewConsPubkey != nil {
+		{
+			size, err := m.NewConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.OldConsPubkey != nil {
+		{
+			size, err := m.OldConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CommissionRates) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GovParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxChangeRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Commission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *GovParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedProposals != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MissedProposals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorGovVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorGovVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorGovVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockupTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockupTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockupTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RewardMultiplier.Size()
+		i -= size
+		if _, err := m.RewardMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintStaking(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationLockup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationLockup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationLockup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintStaking(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RewardMultiplier.Size()
+		i -= size
+		if _, err := m.RewardMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintStaking(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HistoricalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Valset) > 0 {
+		for _, e := range m.Valset {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommissionRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Commission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = m.CommissionRates.Size()
 	n += 1 + l + sovStaking(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
@@ -3475,6 +4211,18 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
+	if m.MaxMissedGovProposals != 0 {
+		n += 1 + sovStaking(uint64(m.MaxMissedGovProposals))
+	}
+	if m.ValidatorSetEpochLength != 0 {
+		n += 1 + sovStaking(uint64(m.ValidatorSetEpochLength))
+	}
+	if len(m.LockupTiers) > 0 {
+		for _, e := range m.LockupTiers {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3534,36 +4282,130 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
-func sovStaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ConsPubKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.OldConsPubkey != nil {
+		l = m.OldConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.NewConsPubkey != nil {
+		l = m.NewConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
 }
-func sozStaking(x uint64) (n int) {
-	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *GovParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.MissedProposals != 0 {
+		n += 1 + sovStaking(uint64(m.MissedProposals))
+	}
+	return n
 }
-func (this *ValAddresses) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ValidatorGovVote) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ValAddresses{`,
-		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovStaking(uint64(m.ProposalId))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
 }
-func valueToStringStaking(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+func (m *LockupTier) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.RewardMultiplier.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
 }
-func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+
+func (m *DelegationLockup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.RewardMultiplier.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func sovStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStaking(x uint64) (n int) {
+	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ValAddresses) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValAddresses{`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringStaking(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowStaking
@@ -4757,9 +5599,875 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, DVPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Triplets = append(m.Triplets, DVVTriplet{})
+			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4769,23 +6477,23 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pairs = append(m.Pairs, DVPair{})
-			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4810,7 +6518,7 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4833,10 +6541,10 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4935,59 +6643,9 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5014,8 +6672,8 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Triplets = append(m.Triplets, DVVTriplet{})
-			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, RedelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5040,7 +6698,7 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Delegation) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5063,17 +6721,17 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5083,27 +6741,85 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			}
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			}
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5131,13 +6847,51 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedGovProposals", wireType)
+			}
+			m.MaxMissedGovProposals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedGovProposals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetEpochLength", wireType)
+			}
+			m.ValidatorSetEpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetEpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupTiers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5147,23 +6901,23 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LockupTiers = append(m.LockupTiers, LockupTier{})
+			if err := m.LockupTiers[len(m.LockupTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5188,7 +6942,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5211,49 +6965,17 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5263,27 +6985,28 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5310,8 +7033,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5336,7 +7058,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5359,34 +7081,15 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
-			}
-			m.CreationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5413,41 +7116,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5506,7 +7175,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5529,34 +7198,15 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
-			}
-			m.CreationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5583,49 +7233,15 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5635,23 +7251,23 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, RedelegationEntryResponse{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5676,7 +7292,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Redelegation) Unmarshal(dAtA []byte) error {
+func (m *Pool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5699,15 +7315,15 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5735,43 +7351,13 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5799,39 +7385,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Entries = append(m.Entries, RedelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5856,7 +7410,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ConsPubKeyRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5879,17 +7433,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsPubKeyRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsPubKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5899,30 +7453,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
 			}
-			m.MaxValidators = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5932,16 +7485,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxValidators |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldConsPubkey == nil {
+				m.OldConsPubkey = &types1.Any{}
+			}
+			if err := m.OldConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
 			}
-			m.MaxEntries = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5951,16 +7521,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxEntries |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewConsPubkey == nil {
+				m.NewConsPubkey = &types1.Any{}
+			}
+			if err := m.NewConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.HistoricalEntries = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5970,16 +7557,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5989,23 +7576,24 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6028,7 +7616,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *GovParticipation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6051,17 +7639,17 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GovParticipation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GovParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6071,30 +7659,29 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedProposals", wireType)
 			}
-			var msglen int
+			m.MissedProposals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6104,25 +7691,11 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MissedProposals |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6144,7 +7717,7 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorGovVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6167,17 +7740,17 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorGovVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorGovVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var msglen int
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6187,28 +7760,14 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6236,9 +7795,7 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6261,7 +7818,7 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *LockupTier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6284,15 +7841,15 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LockupTier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LockupTier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6319,15 +7876,15 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardMultiplier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6337,23 +7894,23 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntryResponse{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6378,7 +7935,7 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *DelegationLockup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6401,15 +7958,15 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationLockup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationLockup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6437,13 +7994,76 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardMultiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6471,7 +8091,40 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
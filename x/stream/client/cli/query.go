@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/stream"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	streamQueryCmd := &cobra.Command{
+		Use:                        stream.ModuleName,
+		Short:                      "Querying commands for the stream module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	streamQueryCmd.AddCommand(
+		GetCmdQueryStream(),
+		GetCmdQueryStreamsBySender(),
+		GetCmdQueryStreamsByRecipient(),
+	)
+
+	return streamQueryCmd
+}
+
+// GetCmdQueryStream returns cmd to query for a stream.
+func GetCmdQueryStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "stream [stream_id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query a stream and the amounts streamed and withdrawable",
+		Example: fmt.Sprintf("$ %s query %s stream 1", version.AppName, stream.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := stream.NewQueryClient(clientCtx)
+
+			id, err := parseStreamID(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Stream(cmd.Context(), &stream.QueryStreamRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryStreamsBySender returns cmd to query for the streams of a sender.
+func GetCmdQueryStreamsBySender() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "streams-by-sender [sender]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the streams funded by a sender",
+		Example: fmt.Sprintf("$ %s query %s streams-by-sender [sender]", version.AppName, stream.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := stream.NewQueryClient(clientCtx)
+
+			sender, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.StreamsBySender(cmd.Context(), &stream.QueryStreamsBySenderRequest{
+				Sender:     sender.String(),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "streams")
+
+	return cmd
+}
+
+// GetCmdQueryStreamsByRecipient returns cmd to query for the streams of a
+// recipient.
+func GetCmdQueryStreamsByRecipient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "streams-by-recipient [recipient]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the streams received by a recipient",
+		Example: fmt.Sprintf("$ %s query %s streams-by-recipient [recipient]", version.AppName, stream.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := stream.NewQueryClient(clientCtx)
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.StreamsByRecipient(cmd.Context(), &stream.QueryStreamsByRecipientRequest{
+				Recipient:  recipient.String(),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "streams")
+
+	return cmd
+}
@@ -0,0 +1,257 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/stream"
+)
+
+// FlagStartTime is the flag of the start time of a stream.
+const FlagStartTime = "start-time"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	streamTxCmd := &cobra.Command{
+		Use:                        stream.ModuleName,
+		Short:                      "Stream transactions subcommands",
+		Long:                       "Create, withdraw from and cancel payment streams",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	streamTxCmd.AddCommand(
+		NewCmdCreateStream(),
+		NewCmdWithdrawFromStream(),
+		NewCmdCancelStream(),
+	)
+
+	return streamTxCmd
+}
+
+// NewCmdCreateStream returns a CLI command handler for creating a MsgCreateStream transaction.
+func NewCmdCreateStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [recipient] [amount] [end_time] --from [sender]",
+		Short: "Stream funds to a recipient until an end time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Lock funds and stream them to a recipient linearly, every second, between the
+start time, by default the block time, and the end time, in RFC3339 format.
+
+Example:
+$ %s tx %s create cosmos1skjw... 1000stake 2030-01-30T15:04:05Z --from mykey
+`, version.AppName, stream.ModuleName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			end, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return err
+			}
+
+			var start *time.Time
+			if startStr, _ := cmd.Flags().GetString(FlagStartTime); startStr != "" {
+				parsed, err := time.Parse(time.RFC3339, startStr)
+				if err != nil {
+					return err
+				}
+				start = &parsed
+			}
+
+			msg := stream.NewMsgCreateStream(clientCtx.GetFromAddress(), recipient, amount, start, end)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagStartTime, "", "The start time of the stream, in RFC3339 format")
+
+	return cmd
+}
+
+// NewCmdWithdrawFromStream returns a CLI command handler for creating a MsgWithdrawFromStream transaction.
+func NewCmdWithdrawFromStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw [stream_id] --from [recipient]",
+		Short:   "Withdraw the streamed funds of a stream",
+		Example: fmt.Sprintf("$ %s tx %s withdraw 1 --from mykey", version.AppName, stream.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := parseStreamID(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := stream.NewMsgWithdrawFromStream(clientCtx.GetFromAddress(), id)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdCancelStream returns a CLI command handler for creating a MsgCancelStream transaction.
+func NewCmdCancelStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel [stream_id] --from [sender_or_recipient]",
+		Short:   "Cancel a stream, paying the streamed funds to the recipient and refunding the rest",
+		Example: fmt.Sprintf("$ %s tx %s cancel 1 --from mykey", version.AppName, stream.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := parseStreamID(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := stream.NewMsgCancelStream(clientCtx.GetFromAddress(), id)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CommunityPoolStreamProposalJSON defines a CommunityPoolStreamProposal with
+// a deposit, as read from a proposal file.
+type CommunityPoolStreamProposalJSON struct {
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Recipient   string     `json:"recipient"`
+	Amount      string     `json:"amount"`
+	StartTime   *time.Time `json:"start_time,omitempty"`
+	EndTime     time.Time  `json:"end_time"`
+	Deposit     string     `json:"deposit"`
+}
+
+// NewCmdSubmitCommunityPoolStreamProposal implements the command to submit a
+// community pool stream proposal.
+func NewCmdSubmitCommunityPoolStreamProposal() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "community-pool-stream [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool stream proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to stream funds of the community pool to a recipient, along
+with an initial deposit. The stream starts when the proposal is executed, or at
+the start time if it is later. The proposal details must be supplied via a JSON
+file.
+
+Example:
+$ %s tx gov submit-proposal community-pool-stream <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community Pool Stream",
+  "description": "Pay me some Atoms every second!",
+  "recipient": "%s1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "amount": "1000stake",
+  "end_time": "2030-01-30T15:04:05Z",
+  "deposit": "1000stake"
+}
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var proposal CommunityPoolStreamProposalJSON
+			if err := json.Unmarshal(contents, &proposal); err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(proposal.Amount)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(proposal.Recipient)
+			if err != nil {
+				return err
+			}
+
+			content := stream.NewCommunityPoolStreamProposal(
+				proposal.Title, proposal.Description, recipient, amount, proposal.StartTime, proposal.EndTime,
+			)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func parseStreamID(arg string) (uint64, error) {
+	id, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("stream id %s not a valid uint, please input a valid stream id", arg)
+	}
+
+	return id, nil
+}
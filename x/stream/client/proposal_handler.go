@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/cosmos/cosmos-sdk/x/stream/client/cli"
+)
+
+// ProposalHandler is the community pool stream proposal handler.
+var (
+	ProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitCommunityPoolStreamProposal)
+)
@@ -0,0 +1,16 @@
+/*
+Package stream provides continuous payment streams: a sender locks funds with
+MsgCreateStream, and the funds are streamed to the recipient linearly, every
+second, between a start and an end time, like a continuous vesting account.
+
+The recipient withdraws the streamed funds with MsgWithdrawFromStream, at any
+time. The sender or the recipient cancels a stream with MsgCancelStream, which
+transfers the streamed funds not yet withdrawn to the recipient and refunds the
+rest of the deposit to the sender.
+
+Streams funded by the community pool are created by a passed
+CommunityPoolStreamProposal, so that grants are paid out over time instead of
+at once. The unstreamed deposit of such streams returns to the community pool
+on cancellation.
+*/
+package stream
@@ -0,0 +1,15 @@
+package stream
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/stream module sentinel errors
+var (
+	// ErrStreamNotFound error if the stream doesn't exist
+	ErrStreamNotFound = sdkerrors.Register(ModuleName, 2, "stream not found")
+	// ErrInvalidPeriod error if the start or end time of a stream is invalid
+	ErrInvalidPeriod = sdkerrors.Register(ModuleName, 3, "invalid stream period")
+	// ErrNothingToWithdraw error if no funds were streamed since the last withdrawal
+	ErrNothingToWithdraw = sdkerrors.Register(ModuleName, 4, "nothing to withdraw")
+)
@@ -0,0 +1,15 @@
+package stream
+
+// stream module events
+const (
+	EventTypeCreateStream   = "create_stream"
+	EventTypeWithdrawStream = "withdraw_stream"
+	EventTypeCancelStream   = "cancel_stream"
+
+	AttributeKeyStreamID  = "stream_id"
+	AttributeKeySender    = "sender"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyRefund    = "refund"
+
+	AttributeValueCategory = ModuleName
+)
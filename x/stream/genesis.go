@@ -0,0 +1,43 @@
+package stream
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(nextStreamID uint64, streams []Stream) *GenesisState {
+	return &GenesisState{
+		NextStreamId: nextStreamID,
+		Streams:      streams,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the stream module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(1, nil)
+}
+
+// ValidateGenesis checks that the streams are valid, and that their
+// identifiers are unique and lower than the next one.
+func ValidateGenesis(data GenesisState) error {
+	if data.NextStreamId == 0 {
+		return fmt.Errorf("next stream id must be positive")
+	}
+
+	ids := make(map[uint64]bool, len(data.Streams))
+	for _, s := range data.Streams {
+		if s.Id == 0 || s.Id >= data.NextStreamId {
+			return fmt.Errorf("stream id %d must be positive and lower than the next stream id %d", s.Id, data.NextStreamId)
+		}
+		if ids[s.Id] {
+			return fmt.Errorf("duplicate stream id %d", s.Id)
+		}
+		ids[s.Id] = true
+
+		if err := s.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid stream %d: %w", s.Id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stream"
+)
+
+// InitGenesis initializes the stream module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *stream.GenesisState) error {
+	// ensure the module account exists, it holds the escrowed funds
+	k.authKeeper.GetModuleAccount(ctx, stream.ModuleName)
+
+	k.SetNextStreamID(ctx, data.NextStreamId)
+
+	for _, s := range data.Streams {
+		if _, found := k.GetStream(ctx, s.Id); found {
+			return fmt.Errorf("duplicate stream %d", s.Id)
+		}
+		k.SetStream(ctx, s)
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the stream module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) (*stream.GenesisState, error) {
+	var streams []stream.Stream
+	k.IterateStreams(ctx, func(s stream.Stream) bool {
+		streams = append(streams, s)
+		return false
+	})
+
+	return stream.NewGenesisState(k.GetNextStreamID(ctx), streams), nil
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/stream"
+)
+
+var _ stream.QueryServer = Keeper{}
+
+// Stream returns a stream and its state at the current block time.
+func (q Keeper) Stream(c context.Context, req *stream.QueryStreamRequest) (*stream.QueryStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	s, found := q.GetStream(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "stream %d doesn't exist", req.Id)
+	}
+
+	return &stream.QueryStreamResponse{
+		Stream:       s,
+		Streamed:     s.Streamed(ctx.BlockTime()),
+		Withdrawable: s.Withdrawable(ctx.BlockTime()),
+	}, nil
+}
+
+// StreamsBySender returns the streams funded by a sender.
+func (q Keeper) StreamsBySender(c context.Context, req *stream.QueryStreamsBySenderRequest) (*stream.QueryStreamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return q.paginateStreams(sdk.UnwrapSDKContext(c), stream.StreamsBySenderPrefix(sender), req.Pagination)
+}
+
+// StreamsByRecipient returns the streams received by a recipient.
+func (q Keeper) StreamsByRecipient(c context.Context, req *stream.QueryStreamsByRecipientRequest) (*stream.QueryStreamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return q.paginateStreams(sdk.UnwrapSDKContext(c), stream.StreamsByRecipientPrefix(recipient), req.Pagination)
+}
+
+// paginateStreams returns a page of the streams of an index.
+func (q Keeper) paginateStreams(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest) (*stream.QueryStreamsResponse, error) {
+	var streams []stream.Stream
+	pageRes, err := query.Paginate(q.streamsStore(ctx, indexPrefix), pageReq, func(key []byte, _ []byte) error {
+		s, found := q.GetStream(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return status.Errorf(codes.Internal, "stream %d doesn't exist", sdk.BigEndianToUint64(key))
+		}
+
+		streams = append(streams, s)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &stream.QueryStreamsResponse{Streams: streams, Pagination: pageRes}, nil
+}
@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/stream"
+)
+
+// Keeper manages the payment streams and the funds they escrow.
+type Keeper struct {
+	cdc         codec.BinaryCodec
+	storeKey    sdk.StoreKey
+	authKeeper  stream.AccountKeeper
+	bankKeeper  stream.BankKeeper
+	distrKeeper stream.DistributionKeeper
+}
+
+// NewKeeper creates a stream Keeper
+func NewKeeper(
+	cdc codec.BinaryCodec, storeKey sdk.StoreKey,
+	ak stream.AccountKeeper, bk stream.BankKeeper, dk stream.DistributionKeeper,
+) Keeper {
+	// ensure the stream module account is set
+	if addr := ak.GetModuleAddress(stream.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", stream.ModuleName))
+	}
+
+	return Keeper{
+		cdc:         cdc,
+		storeKey:    storeKey,
+		authKeeper:  ak,
+		bankKeeper:  bk,
+		distrKeeper: dk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", stream.ModuleName))
+}
+
+// GetNextStreamID returns the identifier of the next stream created.
+func (k Keeper) GetNextStreamID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(stream.NextStreamIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextStreamID sets the identifier of the next stream created.
+func (k Keeper) SetNextStreamID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(stream.NextStreamIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetStream returns an active stream.
+func (k Keeper) GetStream(ctx sdk.Context, id uint64) (s stream.Stream, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(stream.StreamKey(id))
+	if bz == nil {
+		return s, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &s)
+	return s, true
+}
+
+// SetStream sets a stream and indexes it by sender and recipient.
+func (k Keeper) SetStream(ctx sdk.Context, s stream.Stream) {
+	sender, recipient := mustAddresses(s)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(stream.StreamKey(s.Id), k.cdc.MustMarshal(&s))
+	store.Set(stream.StreamBySenderKey(sender, s.Id), []byte{})
+	store.Set(stream.StreamByRecipientKey(recipient, s.Id), []byte{})
+}
+
+// removeStream removes a stream and its indexes.
+func (k Keeper) removeStream(ctx sdk.Context, s stream.Stream) {
+	sender, recipient := mustAddresses(s)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(stream.StreamKey(s.Id))
+	store.Delete(stream.StreamBySenderKey(sender, s.Id))
+	store.Delete(stream.StreamByRecipientKey(recipient, s.Id))
+}
+
+// IterateStreams iterates over the active streams, in identifier order, until
+// cb returns true.
+func (k Keeper) IterateStreams(ctx sdk.Context, cb func(s stream.Stream) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), stream.StreamKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var s stream.Stream
+		k.cdc.MustUnmarshal(iterator.Value(), &s)
+		if cb(s) {
+			break
+		}
+	}
+}
+
+// CreateStream escrows the amount of a stream, from the sender or from the
+// community pool, and returns the identifier of the stream. The stream starts
+// at the block time if start is nil or earlier.
+func (k Keeper) CreateStream(
+	ctx sdk.Context, sender, recipient sdk.AccAddress, amount sdk.Coins, start *time.Time, end time.Time, communityPool bool,
+) (uint64, error) {
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipient)
+	}
+
+	startTime := ctx.BlockTime()
+	if start != nil && start.After(startTime) {
+		startTime = *start
+	}
+	if end.Unix() <= startTime.Unix() {
+		return 0, sdkerrors.Wrapf(stream.ErrInvalidPeriod, "end time %s must be at least one second after the start time %s", end, startTime)
+	}
+
+	if communityPool {
+		sender = k.authKeeper.GetModuleAddress(distrtypes.ModuleName)
+		if err := k.distrKeeper.DistributeFromFeePoolToModule(ctx, amount, stream.ModuleName); err != nil {
+			return 0, err
+		}
+	} else if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, stream.ModuleName, amount); err != nil {
+		return 0, err
+	}
+
+	id := k.GetNextStreamID(ctx)
+	k.SetNextStreamID(ctx, id+1)
+
+	k.SetStream(ctx, stream.Stream{
+		Id:            id,
+		Sender:        sender.String(),
+		Recipient:     recipient.String(),
+		Deposit:       amount,
+		Withdrawn:     sdk.NewCoins(),
+		StartTime:     startTime.UTC(),
+		EndTime:       end.UTC(),
+		CommunityPool: communityPool,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			stream.EventTypeCreateStream,
+			sdk.NewAttribute(stream.AttributeKeyStreamID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(stream.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(stream.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return id, nil
+}
+
+// WithdrawFromStream transfers the streamed funds not yet withdrawn to the
+// recipient, and returns the amount withdrawn. The stream is removed once the
+// whole deposit is withdrawn.
+func (k Keeper) WithdrawFromStream(ctx sdk.Context, recipient sdk.AccAddress, id uint64) (sdk.Coins, error) {
+	s, found := k.GetStream(ctx, id)
+	if !found {
+		return nil, sdkerrors.Wrapf(stream.ErrStreamNotFound, "%d", id)
+	}
+	if s.Recipient != recipient.String() {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not the recipient of stream %d", recipient, id)
+	}
+
+	amount := s.Withdrawable(ctx.BlockTime())
+	if amount.IsZero() {
+		return nil, stream.ErrNothingToWithdraw
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, stream.ModuleName, recipient, amount); err != nil {
+		return nil, err
+	}
+
+	s.Withdrawn = s.Withdrawn.Add(amount...)
+	if s.Withdrawn.IsEqual(s.Deposit) {
+		k.removeStream(ctx, s)
+	} else {
+		k.SetStream(ctx, s)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			stream.EventTypeWithdrawStream,
+			sdk.NewAttribute(stream.AttributeKeyStreamID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(stream.AttributeKeyRecipient, s.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return amount, nil
+}
+
+// CancelStream transfers the streamed funds not yet withdrawn to the
+// recipient, refunds the rest of the deposit to the sender, or to the
+// community pool, and removes the stream. It returns the amounts transferred
+// to the recipient and refunded.
+func (k Keeper) CancelStream(ctx sdk.Context, signer sdk.AccAddress, id uint64) (recipientAmount, refund sdk.Coins, err error) {
+	s, found := k.GetStream(ctx, id)
+	if !found {
+		return nil, nil, sdkerrors.Wrapf(stream.ErrStreamNotFound, "%d", id)
+	}
+	if s.Sender != signer.String() && s.Recipient != signer.String() {
+		return nil, nil, sdkerrors.ErrUnauthorized.Wrapf("%s is neither the sender nor the recipient of stream %d", signer, id)
+	}
+
+	sender, recipient := mustAddresses(s)
+	streamed := s.Streamed(ctx.BlockTime())
+	recipientAmount = streamed.Sub(s.Withdrawn)
+	refund = s.Deposit.Sub(streamed)
+
+	if !recipientAmount.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, stream.ModuleName, recipient, recipientAmount); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if !refund.IsZero() {
+		if s.CommunityPool {
+			err = k.distrKeeper.FundCommunityPoolFromModule(ctx, refund, stream.ModuleName)
+		} else {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, stream.ModuleName, sender, refund)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	k.removeStream(ctx, s)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			stream.EventTypeCancelStream,
+			sdk.NewAttribute(stream.AttributeKeyStreamID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(stream.AttributeKeyRecipient, s.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, recipientAmount.String()),
+			sdk.NewAttribute(stream.AttributeKeyRefund, refund.String()),
+		),
+	)
+
+	return recipientAmount, refund, nil
+}
+
+// streamsStore returns an index of the streams.
+func (k Keeper) streamsStore(ctx sdk.Context, indexPrefix []byte) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+}
+
+func mustAddresses(s stream.Stream) (sender, recipient sdk.AccAddress) {
+	sender, err := sdk.AccAddressFromBech32(s.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	recipient, err = sdk.AccAddressFromBech32(s.Recipient)
+	if err != nil {
+		panic(err)
+	}
+
+	return sender, recipient
+}
@@ -0,0 +1,211 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/stream"
+	"github.com/cosmos/cosmos-sdk/x/stream/keeper"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app     *simapp.SimApp
+	ctx     sdk.Context
+	addrs   []sdk.AccAddress
+	keeper  keeper.Keeper
+	msgSrvr stream.MsgServer
+	start   time.Time
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(suite.T(), false)
+	suite.start = time.Unix(1_000_000, 0).UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: suite.start})
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(1000))
+	suite.keeper = app.StreamKeeper
+	suite.msgSrvr = keeper.NewMsgServerImpl(suite.keeper)
+}
+
+func (suite *KeeperTestSuite) balance(addr sdk.AccAddress) int64 {
+	return suite.app.BankKeeper.GetBalance(suite.ctx, addr, sdk.DefaultBondDenom).Amount.Int64()
+}
+
+func (suite *KeeperTestSuite) communityPool() int64 {
+	return suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(sdk.DefaultBondDenom).TruncateInt64()
+}
+
+func (suite *KeeperTestSuite) elapse(d time.Duration) {
+	suite.ctx = suite.ctx.WithBlockTime(suite.start.Add(d))
+}
+
+func stake(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+}
+
+func (suite *KeeperTestSuite) TestCreateAndWithdraw() {
+	sender, recipient := suite.addrs[0], suite.addrs[1]
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	// the start time can't be in the past
+	past := suite.start.Add(-time.Hour)
+	_, err := suite.msgSrvr.CreateStream(goCtx, stream.NewMsgCreateStream(sender, recipient, stake(100), &past, suite.start))
+	suite.Require().ErrorIs(err, stream.ErrInvalidPeriod)
+
+	// the funds of blocked addresses can't be streamed to
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	_, err = suite.msgSrvr.CreateStream(goCtx, stream.NewMsgCreateStream(sender, feeCollector, stake(100), nil, suite.start.Add(time.Hour)))
+	suite.Require().Error(err)
+
+	res, err := suite.msgSrvr.CreateStream(goCtx, stream.NewMsgCreateStream(sender, recipient, stake(100), &past, suite.start.Add(100*time.Second)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Id)
+	suite.Require().Equal(int64(900), suite.balance(sender))
+
+	s, found := suite.keeper.GetStream(suite.ctx, res.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.start, s.StartTime)
+
+	// nothing is streamed at the start time
+	_, err = suite.msgSrvr.WithdrawFromStream(goCtx, stream.NewMsgWithdrawFromStream(recipient, res.Id))
+	suite.Require().ErrorIs(err, stream.ErrNothingToWithdraw)
+
+	suite.elapse(30 * time.Second)
+	goCtx = sdk.WrapSDKContext(suite.ctx)
+
+	// only the recipient can withdraw
+	_, err = suite.msgSrvr.WithdrawFromStream(goCtx, stream.NewMsgWithdrawFromStream(sender, res.Id))
+	suite.Require().Error(err)
+
+	withdrawRes, err := suite.msgSrvr.WithdrawFromStream(goCtx, stream.NewMsgWithdrawFromStream(recipient, res.Id))
+	suite.Require().NoError(err)
+	suite.Require().Equal(stake(30), withdrawRes.Amount)
+	suite.Require().Equal(int64(1030), suite.balance(recipient))
+
+	queryRes, err := suite.keeper.Stream(goCtx, &stream.QueryStreamRequest{Id: res.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(stake(30), queryRes.Stream.Withdrawn)
+	suite.Require().Equal(stake(30), queryRes.Streamed)
+	suite.Require().True(queryRes.Withdrawable.IsZero())
+
+	// the stream is removed once fully withdrawn
+	suite.elapse(time.Hour)
+	withdrawRes, err = suite.msgSrvr.WithdrawFromStream(sdk.WrapSDKContext(suite.ctx), stream.NewMsgWithdrawFromStream(recipient, res.Id))
+	suite.Require().NoError(err)
+	suite.Require().Equal(stake(70), withdrawRes.Amount)
+	suite.Require().Equal(int64(1100), suite.balance(recipient))
+
+	_, found = suite.keeper.GetStream(suite.ctx, res.Id)
+	suite.Require().False(found)
+	_, err = suite.keeper.Stream(sdk.WrapSDKContext(suite.ctx), &stream.QueryStreamRequest{Id: res.Id})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestCancel() {
+	sender, recipient := suite.addrs[0], suite.addrs[1]
+	start := suite.start.Add(10 * time.Second)
+
+	res, err := suite.msgSrvr.CreateStream(sdk.WrapSDKContext(suite.ctx), stream.NewMsgCreateStream(sender, recipient, stake(100), &start, start.Add(100*time.Second)))
+	suite.Require().NoError(err)
+
+	suite.elapse(50 * time.Second)
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	// only the sender or the recipient can cancel
+	_, err = suite.msgSrvr.CancelStream(goCtx, stream.NewMsgCancelStream(suite.addrs[2], res.Id))
+	suite.Require().Error(err)
+
+	cancelRes, err := suite.msgSrvr.CancelStream(goCtx, stream.NewMsgCancelStream(sender, res.Id))
+	suite.Require().NoError(err)
+	suite.Require().Equal(stake(40), cancelRes.RecipientAmount)
+	suite.Require().Equal(stake(60), cancelRes.RefundAmount)
+	suite.Require().Equal(int64(960), suite.balance(sender))
+	suite.Require().Equal(int64(1040), suite.balance(recipient))
+	suite.Require().Zero(suite.balance(suite.app.AccountKeeper.GetModuleAddress(stream.ModuleName)))
+
+	_, err = suite.msgSrvr.CancelStream(goCtx, stream.NewMsgCancelStream(sender, res.Id))
+	suite.Require().ErrorIs(err, stream.ErrStreamNotFound)
+}
+
+func (suite *KeeperTestSuite) TestCommunityPoolStreamProposal() {
+	recipient := suite.addrs[1]
+	suite.Require().NoError(suite.app.DistrKeeper.FundCommunityPool(suite.ctx, stake(500), suite.addrs[0]))
+	poolBefore := suite.communityPool()
+
+	// the community pool must have enough funds
+	p := stream.NewCommunityPoolStreamProposal("title", "description", recipient, stake(poolBefore+1), nil, suite.start.Add(100*time.Second))
+	suite.Require().Error(keeper.HandleCommunityPoolStreamProposal(suite.ctx, suite.keeper, p))
+
+	p = stream.NewCommunityPoolStreamProposal("title", "description", recipient, stake(100), nil, suite.start.Add(100*time.Second))
+	suite.Require().NoError(keeper.HandleCommunityPoolStreamProposal(suite.ctx, suite.keeper, p))
+	suite.Require().Equal(poolBefore-100, suite.communityPool())
+
+	s, found := suite.keeper.GetStream(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().True(s.CommunityPool)
+	suite.Require().Equal(suite.app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String(), s.Sender)
+
+	// the refund of a cancelled stream returns to the community pool
+	suite.elapse(25 * time.Second)
+	_, _, err := suite.keeper.CancelStream(suite.ctx, recipient, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(1025), suite.balance(recipient))
+	suite.Require().Equal(poolBefore-25, suite.communityPool())
+}
+
+func (suite *KeeperTestSuite) TestQueriesAndGenesis() {
+	sender := suite.addrs[0]
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	end := suite.start.Add(time.Hour)
+
+	for _, recipient := range suite.addrs[1:] {
+		_, err := suite.msgSrvr.CreateStream(goCtx, stream.NewMsgCreateStream(sender, recipient, stake(10), nil, end))
+		suite.Require().NoError(err)
+	}
+
+	res, err := suite.keeper.StreamsBySender(goCtx, &stream.QueryStreamsBySenderRequest{Sender: sender.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Streams, 2)
+
+	res, err = suite.keeper.StreamsByRecipient(goCtx, &stream.QueryStreamsByRecipientRequest{Recipient: suite.addrs[2].String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Streams, 1)
+	suite.Require().Equal(uint64(2), res.Streams[0].Id)
+
+	res, err = suite.keeper.StreamsByRecipient(goCtx, &stream.QueryStreamsByRecipientRequest{Recipient: sender.String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Streams)
+
+	genesis, err := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().NoError(stream.ValidateGenesis(*genesis))
+	suite.Require().Equal(uint64(3), genesis.NextStreamId)
+	suite.Require().Len(genesis.Streams, 2)
+
+	app := simapp.Setup(suite.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.Require().NoError(app.StreamKeeper.InitGenesis(ctx, genesis))
+
+	exported, err := app.StreamKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genesis, exported)
+
+	res, err = app.StreamKeeper.StreamsBySender(sdk.WrapSDKContext(ctx), &stream.QueryStreamsBySenderRequest{Sender: sender.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Streams, 2)
+}
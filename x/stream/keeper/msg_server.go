@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stream"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the stream MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) stream.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ stream.MsgServer = msgServer{}
+
+// CreateStream creates a stream funded by the sender.
+func (k msgServer) CreateStream(goCtx context.Context, msg *stream.MsgCreateStream) (*stream.MsgCreateStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := k.Keeper.CreateStream(ctx, sender, recipient, msg.Amount, msg.StartTime, msg.EndTime, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stream.MsgCreateStreamResponse{Id: id}, nil
+}
+
+// WithdrawFromStream withdraws the streamed funds of a stream.
+func (k msgServer) WithdrawFromStream(goCtx context.Context, msg *stream.MsgWithdrawFromStream) (*stream.MsgWithdrawFromStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := k.Keeper.WithdrawFromStream(ctx, recipient, msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stream.MsgWithdrawFromStreamResponse{Amount: amount}, nil
+}
+
+// CancelStream cancels a stream.
+func (k msgServer) CancelStream(goCtx context.Context, msg *stream.MsgCancelStream) (*stream.MsgCancelStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	recipientAmount, refund, err := k.Keeper.CancelStream(ctx, signer, msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stream.MsgCancelStreamResponse{RecipientAmount: recipientAmount, RefundAmount: refund}, nil
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stream"
+)
+
+// HandleCommunityPoolStreamProposal is a handler for executing a passed
+// community pool stream proposal.
+func HandleCommunityPoolStreamProposal(ctx sdk.Context, k Keeper, p *stream.CommunityPoolStreamProposal) error {
+	recipient, err := sdk.AccAddressFromBech32(p.Recipient)
+	if err != nil {
+		return err
+	}
+
+	id, err := k.CreateStream(ctx, nil, recipient, p.Amount, p.StartTime, p.EndTime, true)
+	if err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("streaming from the community pool to recipient", "stream", id, "amount", p.Amount.String(), "recipient", p.Recipient)
+
+	return nil
+}
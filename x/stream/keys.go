@@ -0,0 +1,66 @@
+package stream
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "stream"
+
+	// StoreKey is the store key string for stream
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for stream
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for stream
+	QuerierRoute = ModuleName
+)
+
+// Keys for stream store
+// Items are stored with the following key: values
+//
+// - 0x00: nextStreamID
+//
+// - 0x01<id_Bytes>: Stream
+//
+// - 0x02<sender_Bytes><id_Bytes>: []byte{}
+//
+// - 0x03<recipient_Bytes><id_Bytes>: []byte{}
+var (
+	NextStreamIDKey            = []byte{0x00}
+	StreamKeyPrefix            = []byte{0x01}
+	StreamBySenderKeyPrefix    = []byte{0x02}
+	StreamByRecipientKeyPrefix = []byte{0x03}
+)
+
+// StreamKey returns the key of a stream.
+func StreamKey(id uint64) []byte {
+	return append(StreamKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// StreamsBySenderPrefix returns the prefix of the index of the streams of a
+// sender.
+func StreamsBySenderPrefix(sender sdk.AccAddress) []byte {
+	return append(StreamBySenderKeyPrefix, address.MustLengthPrefix(sender)...)
+}
+
+// StreamBySenderKey returns the key of a stream in the index of the streams of
+// its sender.
+func StreamBySenderKey(sender sdk.AccAddress, id uint64) []byte {
+	return append(StreamsBySenderPrefix(sender), sdk.Uint64ToBigEndian(id)...)
+}
+
+// StreamsByRecipientPrefix returns the prefix of the index of the streams of a
+// recipient.
+func StreamsByRecipientPrefix(recipient sdk.AccAddress) []byte {
+	return append(StreamByRecipientKeyPrefix, address.MustLengthPrefix(recipient)...)
+}
+
+// StreamByRecipientKey returns the key of a stream in the index of the streams
+// of its recipient.
+func StreamByRecipientKey(recipient sdk.AccAddress, id uint64) []byte {
+	return append(StreamsByRecipientPrefix(recipient), sdk.Uint64ToBigEndian(id)...)
+}
@@ -0,0 +1,23 @@
+package module
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/stream"
+	"github.com/cosmos/cosmos-sdk/x/stream/keeper"
+)
+
+// NewCommunityPoolStreamProposalHandler returns the handler of the community
+// pool stream proposals.
+func NewCommunityPoolStreamProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *stream.CommunityPoolStreamProposal:
+			return keeper.HandleCommunityPoolStreamProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized stream proposal content type: %T", c)
+		}
+	}
+}
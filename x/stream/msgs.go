@@ -0,0 +1,145 @@
+package stream
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_, _, _ sdk.Msg            = &MsgCreateStream{}, &MsgWithdrawFromStream{}, &MsgCancelStream{}
+	_, _, _ legacytx.LegacyMsg = &MsgCreateStream{}, &MsgWithdrawFromStream{}, &MsgCancelStream{} // For amino support.
+)
+
+// NewMsgCreateStream creates a new MsgCreateStream. The stream starts at the
+// block time if start is nil.
+//nolint:interfacer
+func NewMsgCreateStream(sender, recipient sdk.AccAddress, amount sdk.Coins, start *time.Time, end time.Time) *MsgCreateStream {
+	return &MsgCreateStream{
+		Sender:    sender.String(),
+		Recipient: recipient.String(),
+		Amount:    amount,
+		StartTime: start,
+		EndTime:   end,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateStream) ValidateBasic() error {
+	if err := validateStream(msg.Sender, msg.Recipient, msg.Amount, msg.StartTime, msg.EndTime); err != nil {
+		return err
+	}
+	if msg.Sender == msg.Recipient {
+		return sdkerrors.ErrInvalidAddress.Wrap("cannot stream to self")
+	}
+
+	return nil
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCreateStream) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgCreateStream) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgCreateStream) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgCreateStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgWithdrawFromStream creates a new MsgWithdrawFromStream.
+//nolint:interfacer
+func NewMsgWithdrawFromStream(recipient sdk.AccAddress, streamID uint64) *MsgWithdrawFromStream {
+	return &MsgWithdrawFromStream{
+		Recipient: recipient.String(),
+		StreamId:  streamID,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWithdrawFromStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	if msg.StreamId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("stream id must be positive")
+	}
+
+	return nil
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgWithdrawFromStream) GetSigners() []sdk.AccAddress {
+	recipient, _ := sdk.AccAddressFromBech32(msg.Recipient)
+	return []sdk.AccAddress{recipient}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgWithdrawFromStream) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgWithdrawFromStream) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgWithdrawFromStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgCancelStream creates a new MsgCancelStream.
+//nolint:interfacer
+func NewMsgCancelStream(signer sdk.AccAddress, streamID uint64) *MsgCancelStream {
+	return &MsgCancelStream{
+		Signer:   signer.String(),
+		StreamId: streamID,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid signer address: %s", err)
+	}
+	if msg.StreamId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("stream id must be positive")
+	}
+
+	return nil
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCancelStream) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgCancelStream) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgCancelStream) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgCancelStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
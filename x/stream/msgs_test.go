@@ -0,0 +1,92 @@
+package stream_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stream"
+)
+
+var (
+	sender    = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	start     = time.Unix(1_000_000, 0).UTC()
+	coins     = sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 10))
+)
+
+func TestMsgCreateStreamValidateBasic(t *testing.T) {
+	before := start.Add(-time.Second)
+	testCases := []struct {
+		name   string
+		msg    *stream.MsgCreateStream
+		expErr bool
+	}{
+		{"valid", stream.NewMsgCreateStream(sender, recipient, coins, &start, start.Add(time.Hour)), false},
+		{"valid without start time", stream.NewMsgCreateStream(sender, recipient, coins, nil, start), false},
+		{"empty sender", stream.NewMsgCreateStream(nil, recipient, coins, nil, start), true},
+		{"empty recipient", stream.NewMsgCreateStream(sender, nil, coins, nil, start), true},
+		{"self stream", stream.NewMsgCreateStream(sender, sender, coins, nil, start), true},
+		{"zero amount", stream.NewMsgCreateStream(sender, recipient, sdk.NewCoins(), nil, start), true},
+		{"end before start", stream.NewMsgCreateStream(sender, recipient, coins, &start, before), true},
+		{"sub-second period", stream.NewMsgCreateStream(sender, recipient, coins, &start, start.Add(time.Millisecond)), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	require.Error(t, stream.NewMsgWithdrawFromStream(recipient, 0).ValidateBasic())
+	require.NoError(t, stream.NewMsgWithdrawFromStream(recipient, 1).ValidateBasic())
+	require.Error(t, stream.NewMsgCancelStream(nil, 1).ValidateBasic())
+	require.NoError(t, stream.NewMsgCancelStream(sender, 1).ValidateBasic())
+}
+
+func TestStreamed(t *testing.T) {
+	s := stream.Stream{
+		Id:        1,
+		Sender:    sender.String(),
+		Recipient: recipient.String(),
+		Deposit:   coins,
+		Withdrawn: sdk.NewCoins(sdk.NewInt64Coin("atom", 20)),
+		StartTime: start,
+		EndTime:   start.Add(100 * time.Second),
+	}
+	require.NoError(t, s.ValidateBasic())
+
+	require.True(t, s.Streamed(start.Add(-time.Second)).IsZero())
+	require.True(t, s.Streamed(start).IsZero())
+	// the amounts are rounded down
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 25), sdk.NewInt64Coin("stake", 2)), s.Streamed(start.Add(25*time.Second)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 2)), s.Withdrawable(start.Add(25*time.Second)))
+	require.Equal(t, coins, s.Streamed(start.Add(100*time.Second)))
+	require.Equal(t, coins, s.Streamed(start.Add(time.Hour)))
+
+	s.Withdrawn = coins.Add(coins...)
+	require.Error(t, s.ValidateBasic())
+}
+
+func TestCommunityPoolStreamProposal(t *testing.T) {
+	p := stream.NewCommunityPoolStreamProposal("title", "description", recipient, coins, nil, start)
+	require.NoError(t, p.ValidateBasic())
+	require.Equal(t, stream.RouterKey, p.ProposalRoute())
+	require.Equal(t, stream.ProposalTypeCommunityPoolStream, p.ProposalType())
+
+	p = stream.NewCommunityPoolStreamProposal("", "description", recipient, coins, nil, start)
+	require.Error(t, p.ValidateBasic())
+	p = stream.NewCommunityPoolStreamProposal("title", "description", nil, coins, nil, start)
+	require.Error(t, p.ValidateBasic())
+	p = stream.NewCommunityPoolStreamProposal("title", "description", recipient, coins, &start, start)
+	require.Error(t, p.ValidateBasic())
+}
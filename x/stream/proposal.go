@@ -0,0 +1,70 @@
+package stream
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeCommunityPoolStream defines the type for a CommunityPoolStreamProposal
+	ProposalTypeCommunityPoolStream = "CommunityPoolStream"
+)
+
+// Assert CommunityPoolStreamProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &CommunityPoolStreamProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeCommunityPoolStream)
+	govtypes.RegisterProposalTypeCodec(&CommunityPoolStreamProposal{}, "cosmos-sdk/CommunityPoolStreamProposal")
+}
+
+// NewCommunityPoolStreamProposal creates a new community pool stream proposal.
+// The stream starts at the execution of the proposal if start is nil.
+//nolint:interfacer
+func NewCommunityPoolStreamProposal(title, description string, recipient sdk.AccAddress, amount sdk.Coins, start *time.Time, end time.Time) *CommunityPoolStreamProposal {
+	return &CommunityPoolStreamProposal{title, description, recipient.String(), amount, start, end}
+}
+
+// GetTitle returns the title of a community pool stream proposal.
+func (csp *CommunityPoolStreamProposal) GetTitle() string { return csp.Title }
+
+// GetDescription returns the description of a community pool stream proposal.
+func (csp *CommunityPoolStreamProposal) GetDescription() string { return csp.Description }
+
+// ProposalRoute returns the routing key of a community pool stream proposal.
+func (csp *CommunityPoolStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool stream proposal.
+func (csp *CommunityPoolStreamProposal) ProposalType() string { return ProposalTypeCommunityPoolStream }
+
+// ValidateBasic runs basic stateless validity checks
+func (csp *CommunityPoolStreamProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(csp); err != nil {
+		return err
+	}
+
+	return validateRecipient(csp.Recipient, csp.Amount, csp.StartTime, csp.EndTime)
+}
+
+// String implements the Stringer interface.
+func (csp CommunityPoolStreamProposal) String() string {
+	start := "at execution"
+	if csp.StartTime != nil {
+		start = csp.StartTime.String()
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Community Pool Stream Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Amount:      %s
+  Start Time:  %s
+  End Time:    %s
+`, csp.Title, csp.Description, csp.Recipient, csp.Amount, start, csp.EndTime))
+	return b.String()
+}
@@ -0,0 +1,73 @@
+package stream
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Streamed returns the amount streamed at a time, including the withdrawn
+// amount. The deposit is streamed linearly, every second, between the start
+// and the end time.
+func (s Stream) Streamed(t time.Time) sdk.Coins {
+	if !t.After(s.StartTime) {
+		return sdk.NewCoins()
+	}
+	if !t.Before(s.EndTime) {
+		return s.Deposit
+	}
+
+	elapsed := t.Unix() - s.StartTime.Unix()
+	duration := s.EndTime.Unix() - s.StartTime.Unix()
+
+	streamed := sdk.NewCoins()
+	for _, coin := range s.Deposit {
+		amount := coin.Amount.MulRaw(elapsed).QuoRaw(duration)
+		streamed = streamed.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+
+	return streamed
+}
+
+// Withdrawable returns the amount the recipient can withdraw at a time.
+func (s Stream) Withdrawable(t time.Time) sdk.Coins {
+	return s.Streamed(t).Sub(s.Withdrawn)
+}
+
+// ValidateBasic performs stateless validation of the stream.
+func (s Stream) ValidateBasic() error {
+	if err := validateStream(s.Sender, s.Recipient, s.Deposit, &s.StartTime, s.EndTime); err != nil {
+		return err
+	}
+	if !s.Withdrawn.IsValid() || !s.Deposit.IsAllGTE(s.Withdrawn) {
+		return sdkerrors.ErrInvalidCoins.Wrapf("withdrawn amount %s must not exceed the deposit %s", s.Withdrawn, s.Deposit)
+	}
+
+	return nil
+}
+
+// validateStream checks the addresses, amount and period of a stream. The
+// start time is the current block time if it is nil.
+func validateStream(sender, recipient string, amount sdk.Coins, start *time.Time, end time.Time) error {
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	return validateRecipient(recipient, amount, start, end)
+}
+
+// validateRecipient checks the recipient, amount and period of a stream.
+func validateRecipient(recipient string, amount sdk.Coins, start *time.Time, end time.Time) error {
+	if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	if !amount.IsValid() || amount.IsZero() {
+		return sdkerrors.ErrInvalidCoins.Wrap(amount.String())
+	}
+	if start != nil && end.Unix() <= start.Unix() {
+		return sdkerrors.Wrapf(ErrInvalidPeriod, "end time %s must be at least one second after the start time %s", end, start)
+	}
+
+	return nil
+}
@@ -28,6 +28,13 @@ func BeginBlocker(k keeper.Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 	}
 	logger := ctx.Logger()
 
+	// Request a snapshot of the state committed at the block before the upgrade,
+	// from which the node can recover if the upgrade fails
+	if ctx.BlockHeight() == plan.Height-1 && !k.IsSkipHeight(plan.Height) {
+		logger.Info(fmt.Sprintf("requesting a snapshot of the state before upgrade \"%s\" at %d", plan.Name, plan.Height))
+		k.RequestPreUpgradeSnapshot(ctx)
+	}
+
 	// To make sure clear upgrade is executed at the same block
 	if plan.ShouldExecute(ctx) {
 		// If skip upgrade has been set for current height, we clear the upgrade plan
@@ -40,6 +47,24 @@ func BeginBlocker(k keeper.Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 			return
 		}
 
+		// If the preconditions of the plan do not hold, we clear the upgrade plan
+		// instead of halting the chain
+		if err := k.CheckPlanPreconditions(ctx, plan); err != nil {
+			logger.Info(fmt.Sprintf("UPGRADE \"%s\" PRECONDITION FAILED at %d: %s", plan.Name, plan.Height, err))
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeUpgradePreconditionFailed,
+					sdk.NewAttribute(types.AttributeKeyName, plan.Name),
+					sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", plan.Height)),
+					sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+				),
+			)
+
+			k.ClearUpgradePlan(ctx)
+			return
+		}
+
 		// Prepare shutdown if we don't have an upgrade handler for this upgrade name (meaning this software is out of date)
 		if !k.HasHandler(plan.Name) {
 			// Write the upgrade info to disk. The UpgradeStoreLoader uses this info to perform or skip
@@ -25,6 +25,7 @@ import (
 )
 
 type TestSuite struct {
+	app     *simapp.SimApp
 	module  module.AppModule
 	keeper  keeper.Keeper
 	querier sdk.Querier
@@ -49,6 +50,7 @@ func setupTest(height int64, skip map[int64]bool) TestSuite {
 		},
 	)
 
+	s.app = app
 	s.keeper = app.UpgradeKeeper
 	s.ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: height, Time: time.Now()})
 
@@ -167,6 +169,104 @@ func TestHaltIfTooNew(t *testing.T) {
 	VerifyCleared(t, futCtx)
 }
 
+func TestPlanPreconditions(t *testing.T) {
+	s := setupTest(10, map[int64]bool{})
+
+	proposal, err := s.app.GovKeeper.SubmitProposal(s.ctx, govtypes.NewTextProposal("title", "description"))
+	require.NoError(t, err)
+
+	bankVersion := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)["bank"]
+	testCases := []struct {
+		name          string
+		preconditions *types.PlanPreconditions
+		status        govtypes.ProposalStatus
+		expPass       bool
+	}{
+		{"proposal not found", &types.PlanPreconditions{PassedProposalIDs: []uint64{proposal.ProposalId + 1}}, govtypes.StatusPassed, false},
+		{"proposal not passed", &types.PlanPreconditions{PassedProposalIDs: []uint64{proposal.ProposalId}}, govtypes.StatusRejected, false},
+		{"module not found", &types.PlanPreconditions{MinModuleVersions: []types.ModuleVersion{{Name: "foo", Version: 1}}}, govtypes.StatusPassed, false},
+		{"module version too low", &types.PlanPreconditions{MinModuleVersions: []types.ModuleVersion{{Name: "bank", Version: bankVersion + 1}}}, govtypes.StatusPassed, false},
+		{"preconditions hold", &types.PlanPreconditions{
+			PassedProposalIDs: []uint64{proposal.ProposalId},
+			MinModuleVersions: []types.ModuleVersion{{Name: "bank", Version: bankVersion}},
+		}, govtypes.StatusPassed, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proposal.Status = tc.status
+			s.app.GovKeeper.SetProposal(s.ctx, proposal)
+
+			err := s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{
+				Name: "test", Height: s.ctx.BlockHeight() + 1, Preconditions: tc.preconditions,
+			}})
+			require.NoError(t, err)
+
+			newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(time.Now()).WithEventManager(sdk.NewEventManager())
+			if tc.expPass {
+				VerifyDoUpgradeWithCtx(t, newCtx, "test")
+				return
+			}
+
+			req := abci.RequestBeginBlock{Header: newCtx.BlockHeader()}
+			require.NotPanics(t, func() {
+				s.module.BeginBlock(newCtx, req)
+			})
+			VerifyCleared(t, newCtx)
+
+			events := newCtx.EventManager().Events()
+			require.Len(t, events, 1)
+			require.Equal(t, types.EventTypeUpgradePreconditionFailed, events[0].Type)
+		})
+	}
+}
+
+func TestPlanPreconditionsArchivedProposal(t *testing.T) {
+	s := setupTest(10, map[int64]bool{})
+
+	archived, err := s.app.GovKeeper.SubmitProposal(s.ctx, govtypes.NewTextProposal("title", "description"))
+	require.NoError(t, err)
+	archived.Status = govtypes.StatusPassed
+	s.app.GovKeeper.ArchiveProposal(s.ctx, archived)
+
+	pruned, err := s.app.GovKeeper.SubmitProposal(s.ctx, govtypes.NewTextProposal("title", "description"))
+	require.NoError(t, err)
+	pruned.Status = govtypes.StatusPassed
+	s.app.GovKeeper.PruneProposal(s.ctx, pruned)
+
+	// a pruned proposal fails its precondition with an explicit reason
+	err = s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{
+		Name: "test", Height: s.ctx.BlockHeight() + 1,
+		Preconditions: &types.PlanPreconditions{PassedProposalIDs: []uint64{archived.ProposalId, pruned.ProposalId}},
+	}})
+	require.NoError(t, err)
+
+	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(time.Now()).WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(newCtx, abci.RequestBeginBlock{Header: newCtx.BlockHeader()})
+	})
+	VerifyCleared(t, newCtx)
+
+	events := newCtx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeUpgradePreconditionFailed, events[0].Type)
+	for _, attr := range events[0].Attributes {
+		if string(attr.Key) == types.AttributeKeyReason {
+			require.Contains(t, string(attr.Value), fmt.Sprintf("proposal %d was removed", pruned.ProposalId))
+		}
+	}
+
+	// while an archived proposal which passed holds
+	err = s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{
+		Name: "test", Height: s.ctx.BlockHeight() + 1,
+		Preconditions: &types.PlanPreconditions{PassedProposalIDs: []uint64{archived.ProposalId}},
+	}})
+	require.NoError(t, err)
+
+	newCtx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(time.Now()).WithEventManager(sdk.NewEventManager())
+	VerifyDoUpgradeWithCtx(t, newCtx, "test")
+}
+
 func VerifyCleared(t *testing.T, newCtx sdk.Context) {
 	t.Log("Verify that the upgrade plan has been cleared")
 	bz, err := s.querier(newCtx, []string{types.QueryCurrent}, abci.RequestQuery{})
@@ -383,6 +483,41 @@ func TestUpgradeWithoutSkip(t *testing.T) {
 	VerifyDone(t, s.ctx, "test")
 }
 
+type preUpgradeSnapshotter struct {
+	heights []int64
+}
+
+func (s *preUpgradeSnapshotter) RequestPreUpgradeSnapshot(height int64) {
+	s.heights = append(s.heights, height)
+}
+
+func TestPreUpgradeSnapshot(t *testing.T) {
+	s := setupTest(10, map[int64]bool{})
+
+	snapshotter := &preUpgradeSnapshotter{}
+	k := keeper.NewKeeper(map[int64]bool{20: true}, s.app.GetKey(types.StoreKey), s.app.AppCodec(), t.TempDir(), s.app.BaseApp)
+	k.SetPreUpgradeSnapshotter(snapshotter)
+	am := upgrade.NewAppModule(k)
+	beginBlock := func(height int64) {
+		ctx := s.ctx.WithBlockHeight(height)
+		am.BeginBlock(ctx, abci.RequestBeginBlock{Header: ctx.BlockHeader()})
+	}
+
+	t.Log("Verify a snapshot is requested at the block before the upgrade height")
+	err := s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "test", Height: 15}})
+	require.NoError(t, err)
+	for height := int64(11); height < 15; height++ {
+		beginBlock(height)
+	}
+	require.Equal(t, []int64{14}, snapshotter.heights)
+
+	t.Log("Verify no snapshot is requested before a skipped upgrade")
+	err = s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "skipped", Height: 20}})
+	require.NoError(t, err)
+	beginBlock(19)
+	require.Equal(t, []int64{14}, snapshotter.heights)
+}
+
 func TestDumpUpgradeInfoToFile(t *testing.T) {
 	s := setupTest(10, map[int64]bool{})
 	require := require.New(t)
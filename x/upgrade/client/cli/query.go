@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
@@ -22,6 +23,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCurrentPlanCmd(),
 		GetAppliedPlanCmd(),
 		GetModuleVersionsCmd(),
+		GetUpgradeStatusCmd(),
+		GetVerifyBinaryCmd(),
 	)
 
 	return cmd
@@ -154,3 +157,100 @@ func GetModuleVersionsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetUpgradeStatusCmd returns the query upgrade status command.
+func GetUpgradeStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "get the status of the upgrade plan (if one exists)",
+		Long: "Gets the currently scheduled upgrade plan, if one exists, along with the number of blocks\n" +
+			"remaining until its height and the url and hash of the binary it requires for the platform.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			platform, err := cmd.Flags().GetString(FlagPlatform)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UpgradeStatus(cmd.Context(), &types.QueryUpgradeStatusRequest{Platform: platform})
+			if err != nil {
+				return err
+			}
+
+			if res.Plan == nil {
+				return fmt.Errorf("no upgrade scheduled")
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagPlatform, types.Platform(), "The os/arch platform of the binary")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetVerifyBinaryCmd returns the command verifying a candidate binary against
+// the hash required by the upgrade plan.
+func GetVerifyBinaryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-binary [path]",
+		Short: "verify a candidate binary against the hash required by the upgrade plan",
+		Long: "Verifies that the file at path, such as a binary or the archive downloaded from the binary url,\n" +
+			"matches the checksum of the binary the currently scheduled upgrade plan requires for the platform.\n" +
+			"It is meant to be run before the halt height, so that operators can make sure the binary\n" +
+			"they prepared is the one the upgrade requires.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			platform, err := cmd.Flags().GetString(FlagPlatform)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UpgradeStatus(cmd.Context(), &types.QueryUpgradeStatusRequest{Platform: platform})
+			if err != nil {
+				return err
+			}
+
+			if res.Plan == nil {
+				return fmt.Errorf("no upgrade scheduled")
+			}
+			if res.BinaryHash == "" {
+				return fmt.Errorf("upgrade plan %s doesn't require a binary hash for platform %s", res.Plan.Name, platform)
+			}
+
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			if err := types.VerifyChecksum(f, res.BinaryHash); err != nil {
+				return fmt.Errorf("binary %s doesn't match upgrade plan %s: %w", args[0], res.Plan.Name, err)
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf(
+				"binary %s matches the %s hash required by upgrade plan %s at height %d (%d blocks remaining)\n",
+				args[0], res.BinaryHash, res.Plan.Name, res.Plan.Height, res.BlocksRemaining,
+			))
+		},
+	}
+
+	cmd.Flags().String(FlagPlatform, types.Platform(), "The os/arch platform of the binary")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,10 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -14,6 +18,11 @@ import (
 const (
 	FlagUpgradeHeight = "upgrade-height"
 	FlagUpgradeInfo   = "upgrade-info"
+
+	FlagPreconditionProposals      = "precondition-proposals"
+	FlagPreconditionModuleVersions = "precondition-module-versions"
+
+	FlagPlatform = "platform"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -71,6 +80,8 @@ func NewCmdSubmitUpgradeProposal() *cobra.Command {
 	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
 	cmd.Flags().Int64(FlagUpgradeHeight, 0, "The height at which the upgrade must happen")
 	cmd.Flags().String(FlagUpgradeInfo, "", "Optional info for the planned upgrade such as commit hash, etc.")
+	cmd.Flags().UintSlice(FlagPreconditionProposals, nil, "Optional ids of governance proposals that must have passed for the upgrade to happen")
+	cmd.Flags().StringSlice(FlagPreconditionModuleVersions, nil, "Optional minimum module versions required for the upgrade to happen, as comma separated name=version pairs")
 
 	return cmd
 }
@@ -150,7 +161,51 @@ func parseArgsToContent(cmd *cobra.Command, name string) (gov.Content, error) {
 		return nil, err
 	}
 
-	plan := types.Plan{Name: name, Height: height, Info: info}
+	preconditions, err := parsePreconditions(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := types.Plan{Name: name, Height: height, Info: info, Preconditions: preconditions}
 	content := types.NewSoftwareUpgradeProposal(title, description, plan)
 	return content, nil
 }
+
+// parsePreconditions parses the precondition flags of an upgrade proposal. It
+// returns nil if no precondition is set.
+func parsePreconditions(cmd *cobra.Command) (*types.PlanPreconditions, error) {
+	proposalIDs, err := cmd.Flags().GetUintSlice(FlagPreconditionProposals)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleVersions, err := cmd.Flags().GetStringSlice(FlagPreconditionModuleVersions)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(proposalIDs) == 0 && len(moduleVersions) == 0 {
+		return nil, nil
+	}
+
+	preconditions := &types.PlanPreconditions{}
+	for _, id := range proposalIDs {
+		preconditions.PassedProposalIDs = append(preconditions.PassedProposalIDs, uint64(id))
+	}
+
+	for _, mv := range moduleVersions {
+		parts := strings.Split(mv, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid module version %q, expected name=version", mv)
+		}
+
+		version, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version of module %s: %w", parts[0], err)
+		}
+
+		preconditions.MinModuleVersions = append(preconditions.MinModuleVersions, types.ModuleVersion{Name: parts[0], Version: version})
+	}
+
+	return preconditions, nil
+}
@@ -2,6 +2,7 @@ package testutil
 
 import (
 	"fmt"
+	"path/filepath"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
@@ -114,3 +115,13 @@ func (s *IntegrationTestSuite) TestModuleVersionsCLI() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestVerifyBinaryCLI() {
+	val := s.network.Validators[0]
+
+	// no upgrade is scheduled on the test network
+	cmd := cli.GetVerifyBinaryCmd()
+	_, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, []string{filepath.Join(s.T().TempDir(), "simd")})
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "no upgrade scheduled")
+}
@@ -5,3 +5,10 @@ package exported
 type ProtocolVersionSetter interface {
 	SetProtocolVersion(uint64)
 }
+
+// PreUpgradeSnapshotter defines the interface fulfilled by BaseApp
+// which allows requesting a snapshot of the state committed at the
+// block before an upgrade height.
+type PreUpgradeSnapshotter interface {
+	RequestPreUpgradeSnapshot(height int64)
+}
@@ -70,3 +70,31 @@ func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersions
 		ModuleVersions: mv,
 	}, nil
 }
+
+// UpgradeStatus implements the Query/UpgradeStatus gRPC method
+func (k Keeper) UpgradeStatus(c context.Context, req *types.QueryUpgradeStatusRequest) (*types.QueryUpgradeStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return &types.QueryUpgradeStatusResponse{}, nil
+	}
+
+	res := &types.QueryUpgradeStatusResponse{Plan: &plan}
+	if remaining := plan.Height - ctx.BlockHeight(); remaining > 0 {
+		res.BlocksRemaining = remaining
+	}
+
+	// the platform defaults to the one of the node, which the supervisor
+	// polling it runs alongside
+	platform := req.Platform
+	if platform == "" {
+		platform = types.Platform()
+	}
+	if binaryURL, ok := plan.BinaryURL(platform); ok {
+		res.BinaryUrl = binaryURL
+		res.BinaryHash = types.BinaryChecksum(binaryURL)
+	}
+
+	return res, nil
+}
@@ -203,6 +203,88 @@ func (suite *UpgradeTestSuite) TestModuleVersions() {
 	}
 }
 
+func (suite *UpgradeTestSuite) TestUpgradeStatus() {
+	var (
+		req         *types.QueryUpgradeStatusRequest
+		expResponse types.QueryUpgradeStatusResponse
+	)
+
+	const (
+		linuxURL = "https://example.com/simd-linux.zip?checksum=sha256:aec070645fe53ee3b3763059376134f058cc337247c978add178b6ccdfb0019f"
+		anyURL   = "https://example.com/simd.zip?checksum=sha512:0123"
+	)
+	info := `{"binaries":{"linux/amd64":"` + linuxURL + `","any":"` + anyURL + `"}}`
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"without current upgrade plan",
+			func() {
+				req = &types.QueryUpgradeStatusRequest{}
+				expResponse = types.QueryUpgradeStatusResponse{}
+			},
+		},
+		{
+			"with a plan listing no binaries",
+			func() {
+				plan := types.Plan{Name: "test-plan", Height: 15, Info: "v2.0.0"}
+				suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, plan)
+
+				req = &types.QueryUpgradeStatusRequest{Platform: "linux/amd64"}
+				expResponse = types.QueryUpgradeStatusResponse{Plan: &plan, BlocksRemaining: 5}
+			},
+		},
+		{
+			"with the binary of the platform",
+			func() {
+				plan := types.Plan{Name: "test-plan", Height: 15, Info: info}
+				suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, plan)
+
+				req = &types.QueryUpgradeStatusRequest{Platform: "linux/amd64"}
+				expResponse = types.QueryUpgradeStatusResponse{
+					Plan:            &plan,
+					BlocksRemaining: 5,
+					BinaryUrl:       linuxURL,
+					BinaryHash:      "sha256:aec070645fe53ee3b3763059376134f058cc337247c978add178b6ccdfb0019f",
+				}
+			},
+		},
+		{
+			"with the binary of any platform",
+			func() {
+				plan := types.Plan{Name: "test-plan", Height: 15, Info: info}
+				suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, plan)
+
+				req = &types.QueryUpgradeStatusRequest{Platform: "darwin/arm64"}
+				expResponse = types.QueryUpgradeStatusResponse{
+					Plan:            &plan,
+					BlocksRemaining: 5,
+					BinaryUrl:       anyURL,
+					BinaryHash:      "sha512:0123",
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			suite.ctx = suite.ctx.WithBlockHeight(10)
+			queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+			types.RegisterQueryServer(queryHelper, suite.app.UpgradeKeeper)
+			suite.queryClient = types.NewQueryClient(queryHelper)
+
+			tc.malleate()
+
+			res, err := suite.queryClient.UpgradeStatus(gocontext.Background(), req)
+			suite.Require().NoError(err)
+			suite.Require().Equal(&expResponse, res)
+		})
+	}
+}
+
 func TestUpgradeTestSuite(t *testing.T) {
 	suite.Run(t, new(UpgradeTestSuite))
 }
@@ -3,10 +3,13 @@ package keeper
 import (
 	"encoding/binary"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
@@ -16,6 +19,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	xp "github.com/cosmos/cosmos-sdk/x/upgrade/exported"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
@@ -31,6 +35,8 @@ type Keeper struct {
 	cdc                codec.BinaryCodec               // App-wide binary codec
 	upgradeHandlers    map[string]types.UpgradeHandler // map of plan name to upgrade handler
 	versionSetter      xp.ProtocolVersionSetter        // implements setting the protocol version field on BaseApp
+	govKeeper          types.GovKeeper                 // used to check the proposal preconditions of plans
+	snapshotter        xp.PreUpgradeSnapshotter        // used to request a snapshot of the state before an upgrade
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -50,6 +56,40 @@ func NewKeeper(skipUpgradeHeights map[int64]bool, storeKey sdk.StoreKey, cdc cod
 	}
 }
 
+// SetGovKeeper sets the gov keeper used to check the proposal preconditions of
+// upgrade plans. It must be called before the upgrade keeper is passed to the
+// upgrade module.
+func (k *Keeper) SetGovKeeper(gk types.GovKeeper) *Keeper {
+	if k.govKeeper != nil {
+		panic("cannot set upgrade gov keeper twice")
+	}
+
+	k.govKeeper = gk
+	return k
+}
+
+// SetPreUpgradeSnapshotter sets the snapshotter requested to take a snapshot of
+// the state committed at the block before the height of an upgrade plan. It
+// must be called before the upgrade keeper is passed to the upgrade module.
+func (k *Keeper) SetPreUpgradeSnapshotter(s xp.PreUpgradeSnapshotter) *Keeper {
+	if k.snapshotter != nil {
+		panic("cannot set upgrade pre-upgrade snapshotter twice")
+	}
+
+	k.snapshotter = s
+	return k
+}
+
+// RequestPreUpgradeSnapshot requests the pre-upgrade snapshotter, if any, to
+// take a snapshot of the state committed at the end of the current block.
+func (k Keeper) RequestPreUpgradeSnapshot(ctx sdk.Context) {
+	if k.snapshotter == nil {
+		return
+	}
+
+	k.snapshotter.RequestPreUpgradeSnapshot(ctx.BlockHeight())
+}
+
 // SetUpgradeHandler sets an UpgradeHandler for the upgrade specified by name. This handler will be called when the upgrade
 // with this name is applied. In order for an upgrade with the given name to proceed, a handler for this upgrade
 // must be set even if it is a no-op function.
@@ -131,6 +171,47 @@ func (k Keeper) GetModuleVersions(ctx sdk.Context) []*types.ModuleVersion {
 	return mv
 }
 
+// CheckModuleVersionDrift compares the module consensus versions of the
+// running binary with the versions recorded in state. It returns an error if
+// the binary contains a module that is unknown to state or has a higher version
+// than recorded in state, unless an upgrade handled by the binary is pending.
+// Versions recorded before the module version map was introduced are not
+// checked.
+func (k Keeper) CheckModuleVersionDrift(ctx sdk.Context, binaryVM module.VersionMap) error {
+	stateVM := k.GetModuleVersionMap(ctx)
+	if len(stateVM) == 0 {
+		return nil
+	}
+
+	// the binary is expected to be ahead of state until the upgrade is applied
+	if plan, found := k.GetUpgradePlan(ctx); found && k.HasHandler(plan.Name) {
+		return nil
+	}
+
+	names := make([]string, 0, len(binaryVM))
+	for name := range binaryVM {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var drift []string
+	for _, name := range names {
+		stateVersion, found := stateVM[name]
+		switch {
+		case !found:
+			drift = append(drift, fmt.Sprintf("%s: binary version %d, not in state", name, binaryVM[name]))
+		case binaryVM[name] > stateVersion:
+			drift = append(drift, fmt.Sprintf("%s: binary version %d, state version %d", name, binaryVM[name], stateVersion))
+		}
+	}
+
+	if len(drift) > 0 {
+		return fmt.Errorf("module versions of the binary are ahead of state without a pending upgrade: %s", strings.Join(drift, "; "))
+	}
+
+	return nil
+}
+
 // gets the version for a given module, and returns true if it exists, false otherwise
 func (k Keeper) getModuleVersion(ctx sdk.Context, name string) (uint64, bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -147,6 +228,45 @@ func (k Keeper) getModuleVersion(ctx sdk.Context, name string) (uint64, bool) {
 	return 0, false
 }
 
+// CheckPlanPreconditions returns an error describing the first precondition of
+// the given plan that does not hold, if any. The proposals are looked up in the
+// governance archive as well, a proposal removed from the store (e.g. pruned
+// once finalized) failing its precondition as its status can't be checked.
+func (k Keeper) CheckPlanPreconditions(ctx sdk.Context, plan types.Plan) error {
+	if plan.Preconditions == nil {
+		return nil
+	}
+
+	for _, id := range plan.Preconditions.PassedProposalIDs {
+		if k.govKeeper == nil {
+			return fmt.Errorf("cannot check proposal %d: no gov keeper set", id)
+		}
+
+		proposal, found := k.govKeeper.GetProposalOrArchived(ctx, id)
+		if !found {
+			if nextID, err := k.govKeeper.GetProposalID(ctx); err == nil && id < nextID {
+				return fmt.Errorf("proposal %d was removed from the store, e.g. pruned once finalized, and its status can't be checked", id)
+			}
+			return fmt.Errorf("proposal %d not found", id)
+		}
+		if proposal.Status != govtypes.StatusPassed {
+			return fmt.Errorf("proposal %d has status %s", id, proposal.Status)
+		}
+	}
+
+	for _, mv := range plan.Preconditions.MinModuleVersions {
+		version, found := k.getModuleVersion(ctx, mv.Name)
+		if !found {
+			return fmt.Errorf("module %s not found", mv.Name)
+		}
+		if version < mv.Version {
+			return fmt.Errorf("module %s has version %d, expected at least %d", mv.Name, version, mv.Version)
+		}
+	}
+
+	return nil
+}
+
 // ScheduleUpgrade schedules an upgrade based on the specified plan.
 // If there is another Plan already scheduled, it will cancel and overwrite it.
 // ScheduleUpgrade will also write the upgraded client to the upgraded client path
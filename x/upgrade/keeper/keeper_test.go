@@ -232,6 +232,22 @@ func (s *KeeperTestSuite) TestMigrations() {
 	s.Require().Equal(vmBefore["bank"]+1, vm["bank"])
 }
 
+func (s *KeeperTestSuite) TestCheckModuleVersionDrift() {
+	s.app.UpgradeKeeper.SetModuleVersionMap(s.ctx, module.VersionMap{"bank": 2})
+
+	s.Require().NoError(s.app.UpgradeKeeper.CheckModuleVersionDrift(s.ctx, module.VersionMap{"bank": 1}))
+	s.Require().NoError(s.app.UpgradeKeeper.CheckModuleVersionDrift(s.ctx, module.VersionMap{"bank": 2}))
+	s.Require().Error(s.app.UpgradeKeeper.CheckModuleVersionDrift(s.ctx, module.VersionMap{"bank": 3}))
+	s.Require().Error(s.app.UpgradeKeeper.CheckModuleVersionDrift(s.ctx, module.VersionMap{"bank": 2, "foo": 1}))
+
+	s.T().Log("Verify the binary may be ahead of state if it handles the pending upgrade")
+	s.Require().NoError(s.app.UpgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "dummy", Height: s.ctx.BlockHeight() + 1}))
+	s.Require().Error(s.app.UpgradeKeeper.CheckModuleVersionDrift(s.ctx, module.VersionMap{"bank": 3}))
+
+	s.app.UpgradeKeeper.SetUpgradeHandler("dummy", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) { return vm, nil })
+	s.Require().NoError(s.app.UpgradeKeeper.CheckModuleVersionDrift(s.ctx, module.VersionMap{"bank": 3}))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
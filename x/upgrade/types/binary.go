@@ -0,0 +1,93 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"net/url"
+	"runtime"
+	"strings"
+)
+
+// AnyPlatform is the platform of the binaries listed in the plan info which run
+// on any os/arch.
+const AnyPlatform = "any"
+
+// planBinaries is the format of the plan info listing the binaries required by
+// an upgrade by os/arch, as understood by cosmovisor.
+type planBinaries struct {
+	Binaries map[string]string `json:"binaries"`
+}
+
+// Platform returns the os/arch platform of the running binary, such as
+// linux/amd64.
+func Platform() string {
+	return runtime.GOOS + "/" + runtime.GOARCH
+}
+
+// BinaryURL returns the url of the binary required by the plan for the given
+// os/arch platform, falling back to the binary for any platform. It returns
+// false if the plan info doesn't list the binaries as JSON, or lists none for
+// the platform.
+func (p Plan) BinaryURL(platform string) (string, bool) {
+	var info planBinaries
+	if err := json.Unmarshal([]byte(p.Info), &info); err != nil {
+		return "", false
+	}
+
+	if binaryURL, ok := info.Binaries[platform]; ok {
+		return binaryURL, true
+	}
+	binaryURL, ok := info.Binaries[AnyPlatform]
+	return binaryURL, ok
+}
+
+// BinaryChecksum returns the checksum parameter of a binary url, such as
+// sha256:<hex>, or an empty string if it has none.
+func BinaryChecksum(binaryURL string) string {
+	u, err := url.Parse(binaryURL)
+	if err != nil {
+		return ""
+	}
+
+	return u.Query().Get("checksum")
+}
+
+// VerifyChecksum verifies that the content read from r matches the given
+// sha256:<hex> or sha512:<hex> checksum.
+func VerifyChecksum(r io.Reader, checksum string) error {
+	parts := strings.SplitN(checksum, ":", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("invalid checksum %q, expected <type>:<hex>", checksum)
+	}
+
+	var h hash.Hash
+	switch parts[0] {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return fmt.Errorf("unsupported checksum type %q", parts[0])
+	}
+
+	expected, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return fmt.Errorf("invalid checksum %q: %w", checksum, err)
+	}
+
+	if _, err := io.Copy(h, r); err != nil {
+		return err
+	}
+
+	if actual := h.Sum(nil); !bytes.Equal(actual, expected) {
+		return fmt.Errorf("checksum mismatch: expected %s, got %s:%x", checksum, parts[0], actual)
+	}
+
+	return nil
+}
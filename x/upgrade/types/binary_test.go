@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func TestPlanBinaryURL(t *testing.T) {
+	info := `{"binaries":{"linux/amd64":"https://example.com/linux.zip?checksum=sha256:aa","any":"https://example.com/any.zip"}}`
+
+	cases := map[string]struct {
+		info     string
+		platform string
+		expURL   string
+		expFound bool
+	}{
+		"platform binary":    {info, "linux/amd64", "https://example.com/linux.zip?checksum=sha256:aa", true},
+		"any binary":         {info, "darwin/arm64", "https://example.com/any.zip", true},
+		"no binaries":        {`{"binaries":{}}`, "linux/amd64", "", false},
+		"info is not json":   {"v2.0.0", "linux/amd64", "", false},
+		"info is a json url": {"https://example.com/info.json", "linux/amd64", "", false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			binaryURL, found := types.Plan{Name: "all-good", Height: 10, Info: tc.info}.BinaryURL(tc.platform)
+			require.Equal(t, tc.expFound, found)
+			require.Equal(t, tc.expURL, binaryURL)
+		})
+	}
+
+	require.Equal(t, "sha256:aa", types.BinaryChecksum("https://example.com/linux.zip?checksum=sha256:aa"))
+	require.Equal(t, "", types.BinaryChecksum("https://example.com/any.zip"))
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	// sha256 and sha512 of "binary"
+	const (
+		sha256Hex = "9a3a45d01531a20e89ac6ae10b0b0beb0492acd7216a368aa062d1a5fecaf9cd"
+		sha512Hex = "a663ef6ed517b78896a7f0b78d578e0d9a470a7c7cf344c9cb0dec06ae832d48aa08a7b121d4ef1dfacee50c9fef72c5ab4a39b0e6e2d18534cd009ab908b9b0"
+	)
+
+	cases := map[string]struct {
+		checksum string
+		expErr   string
+	}{
+		"sha256 match":      {"sha256:" + sha256Hex, ""},
+		"sha256 mismatch":   {"sha256:" + strings.Repeat("0", 64), "checksum mismatch"},
+		"sha512 match":      {"sha512:" + sha512Hex, ""},
+		"unsupported type":  {"md5:00", "unsupported checksum type"},
+		"missing type":      {sha256Hex, "invalid checksum"},
+		"invalid hex value": {"sha256:xyz", "invalid checksum"},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := types.VerifyChecksum(strings.NewReader("binary"), tc.checksum)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package types
+
+// upgrade module event types
+const (
+	EventTypeUpgradePreconditionFailed = "upgrade_precondition_failed"
+
+	AttributeKeyName   = "name"
+	AttributeKeyHeight = "height"
+	AttributeKeyReason = "reason"
+)
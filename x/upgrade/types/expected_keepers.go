@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// GovKeeper defines the expected gov keeper used to check the proposal
+// preconditions of upgrade plans.
+type GovKeeper interface {
+	GetProposalOrArchived(ctx sdk.Context, proposalID uint64) (govtypes.Proposal, bool)
+	GetProposalID(ctx sdk.Context) (uint64, error)
+}
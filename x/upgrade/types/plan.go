@@ -32,6 +32,41 @@ func (p Plan) ValidateBasic() error {
 	if p.Height <= 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "height must be greater than 0")
 	}
+	if p.Preconditions != nil {
+		if err := p.Preconditions.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic does basic validation of PlanPreconditions
+func (pc PlanPreconditions) ValidateBasic() error {
+	proposalIDs := make(map[uint64]bool, len(pc.PassedProposalIDs))
+	for _, id := range pc.PassedProposalIDs {
+		if id == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "precondition proposal id cannot be 0")
+		}
+		if proposalIDs[id] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate precondition proposal id %d", id)
+		}
+		proposalIDs[id] = true
+	}
+
+	modules := make(map[string]bool, len(pc.MinModuleVersions))
+	for _, mv := range pc.MinModuleVersions {
+		if len(mv.Name) == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "precondition module name cannot be empty")
+		}
+		if mv.Version == 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "precondition version of module %s must be greater than 0", mv.Name)
+		}
+		if modules[mv.Name] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate precondition module %s", mv.Name)
+		}
+		modules[mv.Name] = true
+	}
 
 	return nil
 }
@@ -92,6 +92,54 @@ func TestPlanValid(t *testing.T) {
 				Height: -12345,
 			},
 		},
+		"proper preconditions": {
+			p: types.Plan{
+				Name:   "all-good",
+				Height: 123450000,
+				Preconditions: &types.PlanPreconditions{
+					PassedProposalIDs: []uint64{1, 2},
+					MinModuleVersions: []types.ModuleVersion{{Name: "bank", Version: 2}, {Name: "gov", Version: 2}},
+				},
+			},
+			valid: true,
+		},
+		"zero precondition proposal id": {
+			p: types.Plan{
+				Name:          "zero-id",
+				Height:        123450000,
+				Preconditions: &types.PlanPreconditions{PassedProposalIDs: []uint64{0}},
+			},
+		},
+		"duplicate precondition proposal id": {
+			p: types.Plan{
+				Name:          "duplicate-id",
+				Height:        123450000,
+				Preconditions: &types.PlanPreconditions{PassedProposalIDs: []uint64{1, 1}},
+			},
+		},
+		"empty precondition module name": {
+			p: types.Plan{
+				Name:          "empty-module",
+				Height:        123450000,
+				Preconditions: &types.PlanPreconditions{MinModuleVersions: []types.ModuleVersion{{Version: 1}}},
+			},
+		},
+		"zero precondition module version": {
+			p: types.Plan{
+				Name:          "zero-version",
+				Height:        123450000,
+				Preconditions: &types.PlanPreconditions{MinModuleVersions: []types.ModuleVersion{{Name: "bank"}}},
+			},
+		},
+		"duplicate precondition module": {
+			p: types.Plan{
+				Name:   "duplicate-module",
+				Height: 123450000,
+				Preconditions: &types.PlanPreconditions{
+					MinModuleVersions: []types.ModuleVersion{{Name: "bank", Version: 1}, {Name: "bank", Version: 2}},
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
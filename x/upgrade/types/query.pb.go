@@ -402,6 +402,130 @@ func (m *QueryModuleVersionsResponse) GetModuleVersions() []*ModuleVersion {
 	return nil
 }
 
+// QueryUpgradeStatusRequest is the request type for the Query/UpgradeStatus
+// RPC method.
+type QueryUpgradeStatusRequest struct {
+	// platform is the os/arch the binary is queried for, such as linux/amd64.
+	// Leaving it empty queries the binary for the platform of the node.
+	Platform string `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+}
+
+func (m *QueryUpgradeStatusRequest) Reset()         { *m = QueryUpgradeStatusRequest{} }
+func (m *QueryUpgradeStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeStatusRequest) ProtoMessage()    {}
+func (*QueryUpgradeStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{8}
+}
+func (m *QueryUpgradeStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeStatusRequest.Merge(m, src)
+}
+func (m *QueryUpgradeStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeStatusRequest proto.InternalMessageInfo
+
+func (m *QueryUpgradeStatusRequest) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
+}
+
+// QueryUpgradeStatusResponse is the response type for the Query/UpgradeStatus
+// RPC method.
+type QueryUpgradeStatusResponse struct {
+	// plan is the current upgrade plan, if any.
+	Plan *Plan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	// blocks_remaining is the number of blocks remaining until the plan height.
+	BlocksRemaining int64 `protobuf:"varint,2,opt,name=blocks_remaining,json=blocksRemaining,proto3" json:"blocks_remaining,omitempty"`
+	// binary_url is the url of the binary for the platform listed in the
+	// binaries of the plan info, if any.
+	BinaryUrl string `protobuf:"bytes,3,opt,name=binary_url,json=binaryUrl,proto3" json:"binary_url,omitempty"`
+	// binary_hash is the checksum of the binary required by the plan, such as
+	// sha256:<hex>, taken from the checksum parameter of the binary url.
+	BinaryHash string `protobuf:"bytes,4,opt,name=binary_hash,json=binaryHash,proto3" json:"binary_hash,omitempty"`
+}
+
+func (m *QueryUpgradeStatusResponse) Reset()         { *m = QueryUpgradeStatusResponse{} }
+func (m *QueryUpgradeStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeStatusResponse) ProtoMessage()    {}
+func (*QueryUpgradeStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{9}
+}
+func (m *QueryUpgradeStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeStatusResponse.Merge(m, src)
+}
+func (m *QueryUpgradeStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeStatusResponse proto.InternalMessageInfo
+
+func (m *QueryUpgradeStatusResponse) GetPlan() *Plan {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
+}
+
+func (m *QueryUpgradeStatusResponse) GetBlocksRemaining() int64 {
+	if m != nil {
+		return m.BlocksRemaining
+	}
+	return 0
+}
+
+func (m *QueryUpgradeStatusResponse) GetBinaryUrl() string {
+	if m != nil {
+		return m.BinaryUrl
+	}
+	return ""
+}
+
+func (m *QueryUpgradeStatusResponse) GetBinaryHash() string {
+	if m != nil {
+		return m.BinaryHash
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -411,6 +535,8 @@ func init() {
 	proto.RegisterType((*QueryUpgradedConsensusStateResponse)(nil), "cosmos.upgrade.v1beta1.QueryUpgradedConsensusStateResponse")
 	proto.RegisterType((*QueryModuleVersionsRequest)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsRequest")
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
+	proto.RegisterType((*QueryUpgradeStatusRequest)(nil), "cosmos.upgrade.v1beta1.QueryUpgradeStatusRequest")
+	proto.RegisterType((*QueryUpgradeStatusResponse)(nil), "cosmos.upgrade.v1beta1.QueryUpgradeStatusResponse")
 }
 
 func init() {
@@ -418,44 +544,52 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 588 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6f, 0xd3, 0x3c,
-	0x1c, 0xae, 0xbb, 0x6e, 0x7a, 0x5f, 0x17, 0x0d, 0xe4, 0x43, 0xc9, 0xc2, 0x14, 0x2a, 0x33, 0xa0,
-	0x88, 0x35, 0xde, 0xda, 0x0b, 0x1a, 0x02, 0x01, 0x93, 0x10, 0x43, 0x30, 0x41, 0x11, 0x1c, 0xb8,
-	0x54, 0x6e, 0x63, 0xda, 0x88, 0x24, 0xce, 0x62, 0x67, 0x62, 0x9a, 0x76, 0xe1, 0xc4, 0x11, 0x89,
-	0x3b, 0x37, 0x2e, 0x7c, 0x12, 0x8e, 0x93, 0xb8, 0x70, 0xd8, 0x01, 0xb5, 0x7c, 0x10, 0x14, 0xc7,
-	0x45, 0x29, 0x4d, 0x3a, 0xe0, 0xd4, 0xc6, 0x7e, 0xfe, 0xfd, 0xe2, 0xc7, 0x81, 0xb8, 0xcf, 0x85,
-	0xcf, 0x05, 0x89, 0xc3, 0x41, 0x44, 0x1d, 0x46, 0xf6, 0x37, 0x7b, 0x4c, 0xd2, 0x4d, 0xb2, 0x17,
-	0xb3, 0xe8, 0xc0, 0x0e, 0x23, 0x2e, 0x39, 0xaa, 0xa5, 0x18, 0x5b, 0x63, 0x6c, 0x8d, 0x31, 0x57,
-	0x06, 0x9c, 0x0f, 0x3c, 0x46, 0x14, 0xaa, 0x17, 0xbf, 0x22, 0x34, 0xd0, 0x14, 0x73, 0x55, 0x6f,
-	0xd1, 0xd0, 0x25, 0x34, 0x08, 0xb8, 0xa4, 0xd2, 0xe5, 0x81, 0xd0, 0xbb, 0x6b, 0x05, 0xa6, 0x13,
-	0x03, 0x85, 0xc2, 0x2b, 0xf0, 0xfc, 0xd3, 0x24, 0xc5, 0x76, 0x1c, 0x45, 0x2c, 0x90, 0x4f, 0x3c,
-	0x1a, 0x74, 0xd8, 0x5e, 0xcc, 0x84, 0xc4, 0x8f, 0xa0, 0x31, 0xbb, 0x25, 0x42, 0x1e, 0x08, 0x86,
-	0x36, 0x60, 0x25, 0xf4, 0x68, 0x60, 0x80, 0x3a, 0x68, 0x54, 0x5b, 0xab, 0x76, 0x7e, 0x78, 0x5b,
-	0x71, 0x14, 0x12, 0x37, 0xb5, 0xd1, 0xdd, 0x30, 0xf4, 0x5c, 0xe6, 0x64, 0x8c, 0x10, 0x82, 0x95,
-	0x80, 0xfa, 0x4c, 0x89, 0xfd, 0xdf, 0x51, 0xff, 0x71, 0x4b, 0x9b, 0x4f, 0xc1, 0xb5, 0x79, 0x0d,
-	0x2e, 0x0d, 0x99, 0x3b, 0x18, 0x4a, 0xc5, 0x58, 0xe8, 0xe8, 0x27, 0xbc, 0x03, 0xb1, 0xe2, 0x3c,
-	0x4f, 0x53, 0x38, 0xdb, 0x09, 0x3a, 0x10, 0xb1, 0x78, 0x26, 0xa9, 0x64, 0x13, 0xb7, 0x8b, 0xb0,
-	0xea, 0x51, 0x21, 0xbb, 0x53, 0x12, 0x30, 0x59, 0x7a, 0xa0, 0x56, 0xb6, 0xca, 0x06, 0xc0, 0x2e,
-	0xbc, 0x34, 0x57, 0x4a, 0x27, 0xb9, 0x01, 0x0d, 0x3d, 0xb2, 0xd3, 0xed, 0x4f, 0x20, 0x5d, 0x91,
-	0x60, 0x8c, 0x72, 0x1d, 0x34, 0xce, 0x74, 0x6a, 0x71, 0xae, 0x42, 0x62, 0xf2, 0xb0, 0xf2, 0x1f,
-	0x38, 0x57, 0xc6, 0xb7, 0xa0, 0xa9, 0xac, 0x1e, 0x73, 0x27, 0xf6, 0xd8, 0x0b, 0x16, 0x89, 0xe4,
-	0x10, 0x33, 0x69, 0x7d, 0xb5, 0xd1, 0xcd, 0xbc, 0x22, 0x98, 0x2e, 0xed, 0x26, 0x2f, 0xca, 0x87,
-	0x17, 0x72, 0xe9, 0x3a, 0xe1, 0x2e, 0x3c, 0xab, 0xf9, 0xfb, 0x7a, 0xcb, 0x00, 0xf5, 0x85, 0x46,
-	0xb5, 0x75, 0xb9, 0xe8, 0xcc, 0xa6, 0x84, 0x3a, 0xcb, 0xfe, 0x94, 0x6e, 0xeb, 0x64, 0x11, 0x2e,
-	0x2a, 0x3f, 0xf4, 0x11, 0xc0, 0x6a, 0xa6, 0x1a, 0x88, 0x14, 0x09, 0x16, 0xf4, 0xcb, 0xdc, 0xf8,
-	0x73, 0x42, 0x3a, 0x0c, 0x5e, 0x7f, 0xfb, 0xf5, 0xc7, 0x87, 0xf2, 0x15, 0xb4, 0x46, 0x0a, 0xba,
-	0xdd, 0x4f, 0x49, 0xdd, 0xa4, 0x71, 0xe8, 0x13, 0x80, 0xd5, 0x4c, 0x7d, 0x4e, 0x09, 0x38, 0xdb,
-	0xcb, 0x53, 0x02, 0xe6, 0x34, 0x13, 0xb7, 0x55, 0xc0, 0x26, 0xba, 0x5e, 0x14, 0x90, 0xa6, 0x24,
-	0x15, 0x90, 0x1c, 0x26, 0x47, 0x7a, 0x84, 0x4e, 0x00, 0xac, 0xe5, 0xf7, 0x0c, 0x6d, 0xcd, 0x4d,
-	0x30, 0xb7, 0xe7, 0xe6, 0xcd, 0x7f, 0xe2, 0xea, 0x41, 0x76, 0xd4, 0x20, 0x77, 0xd0, 0x6d, 0x32,
-	0xff, 0x2b, 0x32, 0x53, 0x7b, 0x72, 0x98, 0xb9, 0x5c, 0x47, 0xef, 0xca, 0x00, 0x7d, 0x06, 0x70,
-	0x79, 0xba, 0x9c, 0xa8, 0x35, 0x37, 0x5a, 0xee, 0x45, 0x30, 0xdb, 0x7f, 0xc5, 0xd1, 0x63, 0x10,
-	0x35, 0xc6, 0x35, 0x74, 0xb5, 0x68, 0x8c, 0xdf, 0xee, 0xc6, 0xbd, 0xfb, 0x5f, 0x46, 0x16, 0x38,
-	0x1e, 0x59, 0xe0, 0xfb, 0xc8, 0x02, 0xef, 0xc7, 0x56, 0xe9, 0x78, 0x6c, 0x95, 0xbe, 0x8d, 0xad,
-	0xd2, 0xcb, 0xf5, 0x81, 0x2b, 0x87, 0x71, 0xcf, 0xee, 0x73, 0x7f, 0x22, 0x96, 0xfe, 0x34, 0x85,
-	0xf3, 0x9a, 0xbc, 0xf9, 0xa5, 0x2c, 0x0f, 0x42, 0x26, 0x7a, 0x4b, 0xea, 0xeb, 0xda, 0xfe, 0x19,
-	0x00, 0x00, 0xff, 0xff, 0x80, 0xcd, 0x5d, 0xc6, 0xfa, 0x05, 0x00, 0x00,
+	// 712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0x66, 0xda, 0x8a, 0xf0, 0xaa, 0x40, 0xe6, 0x50, 0x97, 0x15, 0x2b, 0x59, 0x11, 0x4b, 0x84,
+	0x5d, 0x28, 0x07, 0x0d, 0x46, 0xa3, 0x92, 0x18, 0x30, 0x4a, 0x74, 0x0d, 0x1e, 0xbc, 0x6c, 0xa6,
+	0xed, 0xd0, 0x6e, 0xd8, 0x5f, 0xec, 0xcc, 0x12, 0x09, 0xe1, 0x62, 0x62, 0xe2, 0xd1, 0xc4, 0xbb,
+	0x37, 0x3d, 0xf8, 0x1f, 0xf8, 0x1f, 0x78, 0x24, 0xf1, 0xe2, 0xc1, 0x83, 0x01, 0xff, 0x10, 0xb3,
+	0xb3, 0x53, 0xb2, 0xb5, 0xdd, 0x52, 0x38, 0xb5, 0xfb, 0xde, 0xfb, 0xde, 0xfb, 0xde, 0xb7, 0xf3,
+	0xcd, 0x82, 0x56, 0xf7, 0x99, 0xeb, 0x33, 0x23, 0x0a, 0x9a, 0x21, 0x69, 0x50, 0x63, 0x77, 0xa9,
+	0x46, 0x39, 0x59, 0x32, 0x76, 0x22, 0x1a, 0xee, 0xe9, 0x41, 0xe8, 0x73, 0x1f, 0x97, 0x92, 0x1a,
+	0x5d, 0xd6, 0xe8, 0xb2, 0x46, 0x9d, 0x6c, 0xfa, 0x7e, 0xd3, 0xa1, 0x86, 0xa8, 0xaa, 0x45, 0x5b,
+	0x06, 0xf1, 0x24, 0x44, 0x9d, 0x92, 0x29, 0x12, 0xd8, 0x06, 0xf1, 0x3c, 0x9f, 0x13, 0x6e, 0xfb,
+	0x1e, 0x93, 0xd9, 0x99, 0x8c, 0xa1, 0xed, 0x01, 0xa2, 0x4a, 0x9b, 0x84, 0x2b, 0x2f, 0x63, 0x16,
+	0xab, 0x51, 0x18, 0x52, 0x8f, 0xbf, 0x70, 0x88, 0x67, 0xd2, 0x9d, 0x88, 0x32, 0xae, 0x3d, 0x03,
+	0xa5, 0x3b, 0xc5, 0x02, 0xdf, 0x63, 0x14, 0x2f, 0x42, 0x21, 0x70, 0x88, 0xa7, 0xa0, 0x69, 0x54,
+	0x29, 0x56, 0xa7, 0xf4, 0xde, 0xe4, 0x75, 0x81, 0x11, 0x95, 0xda, 0x82, 0x1c, 0xf4, 0x28, 0x08,
+	0x1c, 0x9b, 0x36, 0x52, 0x83, 0x30, 0x86, 0x82, 0x47, 0x5c, 0x2a, 0x9a, 0x8d, 0x9a, 0xe2, 0xbf,
+	0x56, 0x05, 0xa5, 0xbb, 0x5c, 0x0e, 0x2f, 0xc1, 0x70, 0x8b, 0xda, 0xcd, 0x16, 0x17, 0x88, 0xbc,
+	0x29, 0x9f, 0xb4, 0x75, 0xd0, 0x04, 0x66, 0x33, 0x61, 0xd1, 0x58, 0x8d, 0xab, 0x3d, 0x16, 0xb1,
+	0x57, 0x9c, 0x70, 0xda, 0x9e, 0x76, 0x1d, 0x8a, 0x0e, 0x61, 0xdc, 0xea, 0x68, 0x01, 0x71, 0x68,
+	0x4d, 0x44, 0x56, 0x72, 0x0a, 0xd2, 0x6c, 0xb8, 0xd1, 0xb7, 0x95, 0x64, 0x72, 0x17, 0x14, 0xb9,
+	0x72, 0xc3, 0xaa, 0xb7, 0x4b, 0x2c, 0x16, 0xd7, 0x28, 0xb9, 0x69, 0x54, 0xb9, 0x64, 0x96, 0xa2,
+	0x9e, 0x1d, 0xe2, 0x21, 0x4f, 0x0b, 0x23, 0x68, 0x22, 0xa7, 0xdd, 0x07, 0x55, 0x8c, 0x7a, 0xee,
+	0x37, 0x22, 0x87, 0xbe, 0xa6, 0x21, 0x8b, 0x5f, 0x62, 0x8a, 0xad, 0x2b, 0x12, 0x56, 0x4a, 0x22,
+	0x48, 0x42, 0x1b, 0xb1, 0x50, 0x2e, 0x5c, 0xed, 0x09, 0x97, 0x0c, 0x37, 0x60, 0x5c, 0xe2, 0x77,
+	0x65, 0x4a, 0x41, 0xd3, 0xf9, 0x4a, 0xb1, 0x7a, 0x33, 0xeb, 0x9d, 0x75, 0x34, 0x32, 0xc7, 0xdc,
+	0x8e, 0xbe, 0xda, 0x1d, 0x98, 0x4c, 0x0b, 0x13, 0x2f, 0x13, 0x9d, 0x90, 0x55, 0x61, 0x24, 0x70,
+	0x08, 0xdf, 0xf2, 0x43, 0x57, 0x32, 0x3d, 0x79, 0xd6, 0xbe, 0x23, 0x50, 0x7b, 0x21, 0xcf, 0x7b,
+	0xa0, 0xf0, 0x1c, 0x4c, 0xd4, 0x1c, 0xbf, 0xbe, 0xcd, 0xac, 0x90, 0xba, 0xc4, 0xf6, 0x6c, 0xaf,
+	0x29, 0x34, 0xcf, 0x9b, 0xe3, 0x49, 0xdc, 0x6c, 0x87, 0xf1, 0x35, 0x80, 0x9a, 0xed, 0x91, 0x70,
+	0xcf, 0x8a, 0x42, 0x47, 0xc9, 0x0b, 0x66, 0xa3, 0x49, 0x64, 0x33, 0x74, 0x62, 0x8d, 0x65, 0xba,
+	0x45, 0x58, 0x4b, 0x29, 0x24, 0x1a, 0x27, 0xa1, 0x35, 0xc2, 0x5a, 0xd5, 0xf7, 0x17, 0xe1, 0x82,
+	0xe0, 0x8e, 0x3f, 0x23, 0x28, 0xa6, 0xfc, 0x80, 0x8d, 0x2c, 0xa2, 0x19, 0xa6, 0x52, 0x17, 0x07,
+	0x07, 0x24, 0xca, 0x68, 0xf3, 0xef, 0x7e, 0xfe, 0xfd, 0x94, 0x9b, 0xc5, 0x33, 0x46, 0x86, 0xa1,
+	0xeb, 0x09, 0xc8, 0x12, 0xaa, 0x7c, 0x41, 0x50, 0x4c, 0x79, 0xe6, 0x14, 0x82, 0xdd, 0x66, 0x54,
+	0x17, 0x07, 0x07, 0x48, 0x82, 0xcb, 0x82, 0xe0, 0x02, 0xbe, 0x9d, 0x45, 0x90, 0x24, 0x20, 0x41,
+	0xd0, 0xd8, 0x8f, 0xcf, 0xf1, 0x01, 0xfe, 0x8d, 0xa0, 0xd4, 0xdb, 0x5c, 0x78, 0xa5, 0x2f, 0x83,
+	0xbe, 0xe6, 0x56, 0xef, 0x9d, 0x0b, 0x2b, 0x17, 0x59, 0x17, 0x8b, 0x3c, 0xc4, 0x0f, 0x8c, 0xfe,
+	0x57, 0x67, 0x97, 0xd7, 0x8d, 0xfd, 0xd4, 0x8d, 0x72, 0xf0, 0x21, 0x87, 0xf0, 0x37, 0x04, 0x63,
+	0x9d, 0x8e, 0xc4, 0xd5, 0xbe, 0xd4, 0x7a, 0xba, 0x5f, 0x5d, 0x3e, 0x13, 0x46, 0xae, 0x61, 0x88,
+	0x35, 0xe6, 0xf0, 0xad, 0xac, 0x35, 0xfe, 0xbb, 0x10, 0xf0, 0x57, 0x04, 0x97, 0x3b, 0x5c, 0x89,
+	0x97, 0x06, 0x91, 0xb1, 0xc3, 0xfb, 0x6a, 0xf5, 0x2c, 0x10, 0xc9, 0x54, 0x17, 0x4c, 0x2b, 0x78,
+	0xf6, 0x14, 0xc1, 0x85, 0xca, 0x11, 0x7b, 0xfc, 0xe4, 0xc7, 0x51, 0x19, 0x1d, 0x1e, 0x95, 0xd1,
+	0x9f, 0xa3, 0x32, 0xfa, 0x78, 0x5c, 0x1e, 0x3a, 0x3c, 0x2e, 0x0f, 0xfd, 0x3a, 0x2e, 0x0f, 0xbd,
+	0x99, 0x6f, 0xda, 0xbc, 0x15, 0xd5, 0xf4, 0xba, 0xef, 0xb6, 0x7b, 0x25, 0x3f, 0x0b, 0xac, 0xb1,
+	0x6d, 0xbc, 0x3d, 0x69, 0xcc, 0xf7, 0x02, 0xca, 0x6a, 0xc3, 0xe2, 0xdb, 0xb7, 0xfc, 0x6f, 0x00,
+	0xd2, 0xb4, 0x10, 0x75, 0x98, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -483,6 +617,11 @@ type QueryClient interface {
 	UpgradedConsensusState(ctx context.Context, in *QueryUpgradedConsensusStateRequest, opts ...grpc.CallOption) (*QueryUpgradedConsensusStateResponse, error)
 	// ModuleVersions queries the list of module versions from state.
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
+	// UpgradeStatus queries the current upgrade plan along with the number of
+	// blocks remaining until its height and the binary it requires. Process
+	// supervisors such as cosmovisor can poll it on the node gRPC server to
+	// prepare the upgrade ahead of the halt height.
+	UpgradeStatus(ctx context.Context, in *QueryUpgradeStatusRequest, opts ...grpc.CallOption) (*QueryUpgradeStatusResponse, error)
 }
 
 type queryClient struct {
@@ -530,6 +669,15 @@ func (c *queryClient) ModuleVersions(ctx context.Context, in *QueryModuleVersion
 	return out, nil
 }
 
+func (c *queryClient) UpgradeStatus(ctx context.Context, in *QueryUpgradeStatusRequest, opts ...grpc.CallOption) (*QueryUpgradeStatusResponse, error) {
+	out := new(QueryUpgradeStatusResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/UpgradeStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -545,6 +693,11 @@ type QueryServer interface {
 	UpgradedConsensusState(context.Context, *QueryUpgradedConsensusStateRequest) (*QueryUpgradedConsensusStateResponse, error)
 	// ModuleVersions queries the list of module versions from state.
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
+	// UpgradeStatus queries the current upgrade plan along with the number of
+	// blocks remaining until its height and the binary it requires. Process
+	// supervisors such as cosmovisor can poll it on the node gRPC server to
+	// prepare the upgrade ahead of the halt height.
+	UpgradeStatus(context.Context, *QueryUpgradeStatusRequest) (*QueryUpgradeStatusResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -563,6 +716,9 @@ func (*UnimplementedQueryServer) UpgradedConsensusState(ctx context.Context, req
 func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleVersions not implemented")
 }
+func (*UnimplementedQueryServer) UpgradeStatus(ctx context.Context, req *QueryUpgradeStatusRequest) (*QueryUpgradeStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeStatus not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -640,6 +796,24 @@ func _Query_ModuleVersions_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UpgradeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/UpgradeStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeStatus(ctx, req.(*QueryUpgradeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -660,6 +834,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ModuleVersions",
 			Handler:    _Query_ModuleVersions_Handler,
 		},
+		{
+			MethodName: "UpgradeStatus",
+			Handler:    _Query_UpgradeStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -906,6 +1084,90 @@ func (m *QueryModuleVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryUpgradeStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Platform) > 0 {
+		i -= len(m.Platform)
+		copy(dAtA[i:], m.Platform)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Platform)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BinaryHash) > 0 {
+		i -= len(m.BinaryHash)
+		copy(dAtA[i:], m.BinaryHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BinaryHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BinaryUrl) > 0 {
+		i -= len(m.BinaryUrl)
+		copy(dAtA[i:], m.BinaryUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BinaryUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlocksRemaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksRemaining))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Plan != nil {
+		{
+			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1017,6 +1279,43 @@ func (m *QueryModuleVersionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryUpgradeStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUpgradeStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlocksRemaining != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksRemaining))
+	}
+	l = len(m.BinaryUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BinaryHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1629,6 +1928,257 @@ func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryUpgradeStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plan == nil {
+				m.Plan = &Plan{}
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksRemaining", wireType)
+			}
+			m.BlocksRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksRemaining |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
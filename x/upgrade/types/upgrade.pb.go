@@ -52,6 +52,9 @@ type Plan struct {
 	// moved to the IBC module in the sub module 02-client.
 	// If this field is not empty, an error will be thrown.
 	UpgradedClientState *types.Any `protobuf:"bytes,5,opt,name=upgraded_client_state,json=upgradedClientState,proto3" json:"upgraded_client_state,omitempty" yaml:"upgraded_client_state"` // Deprecated: Do not use.
+	// Preconditions that must hold at the upgrade height for the upgrade to be
+	// performed. If any of them does not hold, the plan is cleared instead.
+	Preconditions *PlanPreconditions `protobuf:"bytes,6,opt,name=preconditions,proto3" json:"preconditions,omitempty"`
 }
 
 func (m *Plan) Reset()      { *m = Plan{} }
@@ -86,6 +89,48 @@ func (m *Plan) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Plan proto.InternalMessageInfo
 
+// PlanPreconditions specifies on-chain conditions an upgrade plan depends on.
+type PlanPreconditions struct {
+	// passed_proposal_ids are the ids of the governance proposals that must have
+	// passed.
+	PassedProposalIDs []uint64 `protobuf:"varint,1,rep,packed,name=passed_proposal_ids,json=passedProposalIds,proto3" json:"passed_proposal_ids,omitempty" yaml:"passed_proposal_ids"`
+	// min_module_versions are the minimum consensus versions of app modules.
+	MinModuleVersions []ModuleVersion `protobuf:"bytes,2,rep,name=min_module_versions,json=minModuleVersions,proto3" json:"min_module_versions" yaml:"min_module_versions"`
+}
+
+func (m *PlanPreconditions) Reset()         { *m = PlanPreconditions{} }
+func (m *PlanPreconditions) String() string { return proto.CompactTextString(m) }
+func (*PlanPreconditions) ProtoMessage()    {}
+func (*PlanPreconditions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{1}
+}
+func (m *PlanPreconditions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlanPreconditions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlanPreconditions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlanPreconditions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanPreconditions.Merge(m, src)
+}
+func (m *PlanPreconditions) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlanPreconditions) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanPreconditions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanPreconditions proto.InternalMessageInfo
+
 // SoftwareUpgradeProposal is a gov Content type for initiating a software
 // upgrade.
 type SoftwareUpgradeProposal struct {
@@ -97,7 +142,7 @@ type SoftwareUpgradeProposal struct {
 func (m *SoftwareUpgradeProposal) Reset()      { *m = SoftwareUpgradeProposal{} }
 func (*SoftwareUpgradeProposal) ProtoMessage() {}
 func (*SoftwareUpgradeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccf2a7d4d7b48dca, []int{1}
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{2}
 }
 func (m *SoftwareUpgradeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -136,7 +181,7 @@ type CancelSoftwareUpgradeProposal struct {
 func (m *CancelSoftwareUpgradeProposal) Reset()      { *m = CancelSoftwareUpgradeProposal{} }
 func (*CancelSoftwareUpgradeProposal) ProtoMessage() {}
 func (*CancelSoftwareUpgradeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccf2a7d4d7b48dca, []int{2}
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{3}
 }
 func (m *CancelSoftwareUpgradeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -177,7 +222,7 @@ func (m *ModuleVersion) Reset()         { *m = ModuleVersion{} }
 func (m *ModuleVersion) String() string { return proto.CompactTextString(m) }
 func (*ModuleVersion) ProtoMessage()    {}
 func (*ModuleVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccf2a7d4d7b48dca, []int{3}
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
 }
 func (m *ModuleVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -208,6 +253,7 @@ var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
+	proto.RegisterType((*PlanPreconditions)(nil), "cosmos.upgrade.v1beta1.PlanPreconditions")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
@@ -218,36 +264,44 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 462 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xf6, 0x51, 0xb7, 0xd0, 0x8b, 0x58, 0x8e, 0x50, 0x4c, 0x54, 0xec, 0xc8, 0x62, 0xc8, 0x00,
-	0x67, 0xb5, 0x48, 0x0c, 0xd9, 0x48, 0x07, 0x24, 0x24, 0xa4, 0xca, 0x05, 0x06, 0x96, 0xea, 0x62,
-	0x5f, 0x9c, 0x13, 0xe7, 0x3b, 0xcb, 0x77, 0x29, 0xe4, 0x5f, 0x54, 0x62, 0x61, 0xec, 0xcf, 0xc9,
-	0xd8, 0x91, 0x29, 0x40, 0xb2, 0x30, 0x33, 0x32, 0xa1, 0xbb, 0xb3, 0x51, 0x04, 0x19, 0x3b, 0xf9,
-	0xfd, 0x78, 0xde, 0xe7, 0x79, 0x3f, 0x7c, 0xf0, 0x71, 0x26, 0x55, 0x29, 0x55, 0x32, 0xab, 0x8a,
-	0x9a, 0xe4, 0x34, 0xb9, 0x38, 0x1a, 0x53, 0x4d, 0x8e, 0x5a, 0x1f, 0x57, 0xb5, 0xd4, 0x12, 0x1d,
-	0x38, 0x14, 0x6e, 0xa3, 0x0d, 0xaa, 0xf7, 0xb0, 0x90, 0xb2, 0xe0, 0x34, 0xb1, 0xa8, 0xf1, 0x6c,
-	0x92, 0x10, 0x31, 0x77, 0x25, 0xbd, 0x6e, 0x21, 0x0b, 0x69, 0xcd, 0xc4, 0x58, 0x4d, 0x34, 0xfa,
-	0xb7, 0x40, 0xb3, 0x92, 0x2a, 0x4d, 0xca, 0xca, 0x01, 0xe2, 0xdf, 0x00, 0xfa, 0xa7, 0x9c, 0x08,
-	0x84, 0xa0, 0x2f, 0x48, 0x49, 0x03, 0xd0, 0x07, 0x83, 0xfd, 0xd4, 0xda, 0x68, 0x08, 0x7d, 0x83,
-	0x0f, 0x6e, 0xf5, 0xc1, 0xa0, 0x73, 0xdc, 0xc3, 0x8e, 0x0c, 0xb7, 0x64, 0xf8, 0x4d, 0x4b, 0x36,
-	0x82, 0x8b, 0x65, 0xe4, 0x5d, 0x7e, 0x8b, 0x40, 0x00, 0x52, 0x5b, 0x83, 0x0e, 0xe0, 0xde, 0x94,
-	0xb2, 0x62, 0xaa, 0x83, 0x9d, 0x3e, 0x18, 0xec, 0xa4, 0x8d, 0x67, 0x74, 0x98, 0x98, 0xc8, 0xc0,
-	0x77, 0x3a, 0xc6, 0x46, 0x1c, 0xde, 0x6f, 0x26, 0xcd, 0xcf, 0x33, 0xce, 0xa8, 0xd0, 0xe7, 0x4a,
-	0x13, 0x4d, 0x83, 0x5d, 0x2b, 0xdc, 0xfd, 0x4f, 0xf8, 0x85, 0x98, 0x8f, 0xe2, 0x5f, 0xcb, 0xe8,
-	0x70, 0x4e, 0x4a, 0x3e, 0x8c, 0xb7, 0x16, 0xc7, 0x01, 0x48, 0xef, 0xb5, 0x99, 0x13, 0x9b, 0x38,
-	0x33, 0xf1, 0xe1, 0x9d, 0x2f, 0x57, 0x91, 0xf7, 0xf3, 0x2a, 0x02, 0xf1, 0x67, 0x00, 0x1f, 0x9c,
-	0xc9, 0x89, 0xfe, 0x48, 0x6a, 0xfa, 0xd6, 0x21, 0x4f, 0x6b, 0x59, 0x49, 0x45, 0x38, 0xea, 0xc2,
-	0x5d, 0xcd, 0x34, 0x6f, 0x17, 0xe2, 0x1c, 0xd4, 0x87, 0x9d, 0x9c, 0xaa, 0xac, 0x66, 0x95, 0x66,
-	0x52, 0xd8, 0xc5, 0xec, 0xa7, 0x9b, 0x21, 0xf4, 0x1c, 0xfa, 0x15, 0x27, 0xc2, 0x4e, 0xdd, 0x39,
-	0x3e, 0xc4, 0xdb, 0x2f, 0x89, 0xcd, 0xce, 0x47, 0xbe, 0xd9, 0x5a, 0x6a, 0xf1, 0x1b, 0x5d, 0x11,
-	0xf8, 0xe8, 0x84, 0x88, 0x8c, 0xf2, 0x1b, 0x6e, 0x6d, 0x43, 0xe2, 0x25, 0xbc, 0xfb, 0x5a, 0xe6,
-	0x33, 0x4e, 0xdf, 0xd1, 0x5a, 0x99, 0xae, 0xb7, 0x5d, 0x3f, 0x80, 0xb7, 0x2f, 0x5c, 0xda, 0x92,
-	0xf9, 0x69, 0xeb, 0x5a, 0x22, 0x60, 0x88, 0x46, 0xaf, 0x16, 0x3f, 0x42, 0x6f, 0xb1, 0x0a, 0xc1,
-	0xf5, 0x2a, 0x04, 0xdf, 0x57, 0x21, 0xb8, 0x5c, 0x87, 0xde, 0xf5, 0x3a, 0xf4, 0xbe, 0xae, 0x43,
-	0xef, 0xfd, 0x93, 0x82, 0xe9, 0xe9, 0x6c, 0x8c, 0x33, 0x59, 0x26, 0xcd, 0x7f, 0xef, 0x3e, 0x4f,
-	0x55, 0xfe, 0x21, 0xf9, 0xf4, 0xf7, 0x11, 0xe8, 0x79, 0x45, 0xd5, 0x78, 0xcf, 0x9e, 0xf7, 0xd9,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x67, 0xe1, 0x07, 0x23, 0x03, 0x00, 0x00,
+	// 585 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xf7, 0x35, 0x6e, 0xa1, 0x17, 0x75, 0xc8, 0xb5, 0x14, 0x13, 0x15, 0xdb, 0xb2, 0x40, 0x0a,
+	0x12, 0xd8, 0x6a, 0x2b, 0x31, 0x64, 0xc3, 0x45, 0x42, 0x20, 0x21, 0x22, 0x17, 0x18, 0x58, 0xac,
+	0x8b, 0x7d, 0x75, 0x4f, 0xd8, 0x77, 0x96, 0xef, 0x52, 0xc8, 0xb7, 0xa8, 0xc4, 0xc2, 0xd8, 0x8f,
+	0x53, 0x89, 0xa5, 0x23, 0x53, 0x80, 0x64, 0x61, 0xee, 0xc6, 0x86, 0x7c, 0xb6, 0x21, 0xa1, 0x81,
+	0x89, 0xc9, 0xf7, 0xde, 0xfb, 0xfd, 0xb9, 0xf7, 0xee, 0x7c, 0xf0, 0x4e, 0xc4, 0x45, 0xc6, 0x85,
+	0x37, 0xca, 0x93, 0x02, 0xc7, 0xc4, 0x3b, 0xd9, 0x1d, 0x12, 0x89, 0x77, 0x9b, 0xd8, 0xcd, 0x0b,
+	0x2e, 0x39, 0xda, 0xae, 0x50, 0x6e, 0x93, 0xad, 0x51, 0xdd, 0x5b, 0x09, 0xe7, 0x49, 0x4a, 0x3c,
+	0x85, 0x1a, 0x8e, 0x8e, 0x3c, 0xcc, 0xc6, 0x15, 0xa5, 0xbb, 0x95, 0xf0, 0x84, 0xab, 0xa5, 0x57,
+	0xae, 0xea, 0xac, 0xf5, 0x27, 0x41, 0xd2, 0x8c, 0x08, 0x89, 0xb3, 0xbc, 0x02, 0x38, 0x9f, 0x56,
+	0xa0, 0x3e, 0x48, 0x31, 0x43, 0x08, 0xea, 0x0c, 0x67, 0xc4, 0x00, 0x36, 0xe8, 0xad, 0x07, 0x6a,
+	0x8d, 0xfa, 0x50, 0x2f, 0xf1, 0xc6, 0x8a, 0x0d, 0x7a, 0xed, 0xbd, 0xae, 0x5b, 0x89, 0xb9, 0x8d,
+	0x98, 0xfb, 0xb2, 0x11, 0xf3, 0xe1, 0xf9, 0xc4, 0xd2, 0x4e, 0xbf, 0x58, 0xc0, 0x00, 0x81, 0xe2,
+	0xa0, 0x6d, 0xb8, 0x76, 0x4c, 0x68, 0x72, 0x2c, 0x8d, 0x96, 0x0d, 0x7a, 0xad, 0xa0, 0x8e, 0x4a,
+	0x1f, 0xca, 0x8e, 0xb8, 0xa1, 0x57, 0x3e, 0xe5, 0x1a, 0xa5, 0xf0, 0x46, 0xdd, 0x69, 0x1c, 0x46,
+	0x29, 0x25, 0x4c, 0x86, 0x42, 0x62, 0x49, 0x8c, 0x55, 0x65, 0xbc, 0x75, 0xc5, 0xf8, 0x11, 0x1b,
+	0xfb, 0xce, 0xe5, 0xc4, 0xda, 0x19, 0xe3, 0x2c, 0xed, 0x3b, 0x4b, 0xc9, 0x8e, 0x01, 0x82, 0xcd,
+	0xa6, 0x72, 0xa0, 0x0a, 0x87, 0x65, 0x1e, 0xbd, 0x80, 0x1b, 0x79, 0x41, 0x22, 0xce, 0x62, 0x2a,
+	0x29, 0x67, 0xc2, 0x58, 0x53, 0x2e, 0xf7, 0xdc, 0xe5, 0x43, 0x77, 0xcb, 0xf1, 0x0c, 0xe6, 0x09,
+	0xc1, 0x22, 0xbf, 0x7f, 0xfd, 0xe3, 0x99, 0xa5, 0x7d, 0x3f, 0xb3, 0x80, 0xf3, 0x03, 0xc0, 0xce,
+	0x15, 0x38, 0x8a, 0xe0, 0x66, 0x8e, 0x85, 0x20, 0x71, 0x98, 0x17, 0x3c, 0xe7, 0x02, 0xa7, 0x21,
+	0x8d, 0x85, 0x01, 0xec, 0x56, 0x4f, 0xf7, 0xf7, 0xa7, 0x13, 0xab, 0x33, 0x50, 0xe5, 0x41, 0x5d,
+	0x7d, 0xfa, 0x58, 0x5c, 0x4e, 0xac, 0x6e, 0xd5, 0xdb, 0x12, 0xa6, 0x13, 0x74, 0xf2, 0x45, 0x42,
+	0x2c, 0xd0, 0x18, 0x6e, 0x66, 0x94, 0x85, 0x19, 0x8f, 0x47, 0x29, 0x09, 0x4f, 0x48, 0x21, 0x54,
+	0x6f, 0x2b, 0x76, 0xab, 0xd7, 0xde, 0xbb, 0xfb, 0xb7, 0xde, 0x9e, 0x2b, 0xf8, 0xeb, 0x0a, 0xed,
+	0x3b, 0xe5, 0x29, 0xfe, 0xb6, 0x5e, 0xa2, 0xe7, 0x04, 0x9d, 0x8c, 0xb2, 0x05, 0x96, 0xe8, 0xeb,
+	0xaa, 0xf7, 0x0f, 0x00, 0xde, 0x3c, 0xe4, 0x47, 0xf2, 0x1d, 0x2e, 0xc8, 0xab, 0xca, 0xa6, 0xd9,
+	0x1f, 0xda, 0x82, 0xab, 0x92, 0xca, 0xb4, 0xb9, 0x5d, 0x55, 0x80, 0x6c, 0xd8, 0x8e, 0x89, 0x88,
+	0x0a, 0x9a, 0x97, 0x73, 0x52, 0xb7, 0x6c, 0x3d, 0x98, 0x4f, 0xa1, 0x87, 0x50, 0xcf, 0x53, 0xcc,
+	0xd4, 0x15, 0x6a, 0xef, 0xed, 0xfc, 0xeb, 0x84, 0x7c, 0xbd, 0xdc, 0x7c, 0xa0, 0xf0, 0x73, 0x27,
+	0x82, 0xe1, 0xed, 0x03, 0xcc, 0x22, 0x92, 0xfe, 0xe7, 0xad, 0xcd, 0x59, 0x3c, 0x81, 0x1b, 0x0b,
+	0x03, 0x59, 0xfa, 0x2b, 0x19, 0xf0, 0x5a, 0x3d, 0x43, 0x25, 0xa6, 0x07, 0x4d, 0xa8, 0x84, 0x40,
+	0x29, 0xe4, 0x3f, 0x3b, 0xff, 0x66, 0x6a, 0xe7, 0x53, 0x13, 0x5c, 0x4c, 0x4d, 0xf0, 0x75, 0x6a,
+	0x82, 0xd3, 0x99, 0xa9, 0x5d, 0xcc, 0x4c, 0xed, 0xf3, 0xcc, 0xd4, 0xde, 0xdc, 0x4f, 0xa8, 0x3c,
+	0x1e, 0x0d, 0xdd, 0x88, 0x67, 0x5e, 0xfd, 0x88, 0x54, 0x9f, 0x07, 0x22, 0x7e, 0xeb, 0xbd, 0xff,
+	0xf5, 0xa2, 0xc8, 0x71, 0x4e, 0xc4, 0x70, 0x4d, 0xfd, 0x2b, 0xfb, 0x3f, 0x03, 0x00, 0x00, 0xff,
+	0xff, 0xd8, 0x93, 0xb3, 0xee, 0x70, 0x04, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -284,6 +338,46 @@ func (this *Plan) Equal(that interface{}) bool {
 	if !this.UpgradedClientState.Equal(that1.UpgradedClientState) {
 		return false
 	}
+	if !this.Preconditions.Equal(that1.Preconditions) {
+		return false
+	}
+	return true
+}
+func (this *PlanPreconditions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PlanPreconditions)
+	if !ok {
+		that2, ok := that.(PlanPreconditions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.PassedProposalIDs) != len(that1.PassedProposalIDs) {
+		return false
+	}
+	for i := range this.PassedProposalIDs {
+		if this.PassedProposalIDs[i] != that1.PassedProposalIDs[i] {
+			return false
+		}
+	}
+	if len(this.MinModuleVersions) != len(that1.MinModuleVersions) {
+		return false
+	}
+	for i := range this.MinModuleVersions {
+		if !this.MinModuleVersions[i].Equal(&that1.MinModuleVersions[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *SoftwareUpgradeProposal) Equal(that interface{}) bool {
@@ -390,6 +484,18 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Preconditions != nil {
+		{
+			size, err := m.Preconditions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintUpgrade(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.UpgradedClientState != nil {
 		{
 			size, err := m.UpgradedClientState.MarshalToSizedBuffer(dAtA[:i])
@@ -414,12 +520,12 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintUpgrade(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintUpgrade(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Name) > 0 {
@@ -432,6 +538,61 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PlanPreconditions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanPreconditions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlanPreconditions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinModuleVersions) > 0 {
+		for iNdEx := len(m.MinModuleVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinModuleVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUpgrade(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PassedProposalIDs) > 0 {
+		dAtA5 := make([]byte, len(m.PassedProposalIDs)*10)
+		var j4 int
+		for _, num := range m.PassedProposalIDs {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintUpgrade(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SoftwareUpgradeProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -585,6 +746,32 @@ func (m *Plan) Size() (n int) {
 		l = m.UpgradedClientState.Size()
 		n += 1 + l + sovUpgrade(uint64(l))
 	}
+	if m.Preconditions != nil {
+		l = m.Preconditions.Size()
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	return n
+}
+
+func (m *PlanPreconditions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PassedProposalIDs) > 0 {
+		l = 0
+		for _, e := range m.PassedProposalIDs {
+			l += sovUpgrade(uint64(e))
+		}
+		n += 1 + sovUpgrade(uint64(l)) + l
+	}
+	if len(m.MinModuleVersions) > 0 {
+		for _, e := range m.MinModuleVersions {
+			l = e.Size()
+			n += 1 + l + sovUpgrade(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -827,6 +1014,202 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preconditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Preconditions == nil {
+				m.Preconditions = &PlanPreconditions{}
+			}
+			if err := m.Preconditions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlanPreconditions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlanPreconditions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlanPreconditions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowUpgrade
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PassedProposalIDs = append(m.PassedProposalIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowUpgrade
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthUpgrade
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthUpgrade
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PassedProposalIDs) == 0 {
+					m.PassedProposalIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowUpgrade
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PassedProposalIDs = append(m.PassedProposalIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassedProposalIDs", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinModuleVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinModuleVersions = append(m.MinModuleVersions, ModuleVersion{})
+			if err := m.MinModuleVersions[len(m.MinModuleVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUpgrade(dAtA[iNdEx:])